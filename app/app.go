@@ -214,6 +214,7 @@ var (
 		triggertypes.ModuleName:   nil,
 		metadatatypes.ModuleName:  {authtypes.Minter, authtypes.Burner},
 		nft.ModuleName:            nil,
+		sanction.ModuleName:       nil,
 	}
 )
 
@@ -603,6 +604,8 @@ func New(
 
 	// MarkerKeeper needs ExchangeKeeper for the MsgWithdrawRequest commitment feature.
 	app.MarkerKeeper.SetExchangeKeeper(app.ExchangeKeeper)
+	// MarkerKeeper needs HoldKeeper to report the held amounts in cap tables.
+	app.MarkerKeeper.SetHoldKeeper(app.HoldKeeper)
 
 	app.VaultKeeper = vaultkeeper.NewKeeper(
 		appCodec,
@@ -734,6 +737,9 @@ func New(
 
 	app.QuarantineKeeper = quarantinekeeper.NewKeeper(appCodec, keys[quarantine.StoreKey], authtypes.NewModuleAddress(quarantine.ModuleName))
 
+	app.MarkerKeeper.SetSanctionKeeper(app.SanctionKeeper)
+	app.MarkerKeeper.SetQuarantineKeeper(app.QuarantineKeeper)
+
 	// Light client modules
 	clientKeeper := app.IBCKeeper.ClientKeeper
 	storeProvider := app.IBCKeeper.ClientKeeper.GetStoreProvider()
@@ -840,8 +846,10 @@ func New(
 		stakingtypes.ModuleName,
 		ibcexported.ModuleName,
 		markertypes.ModuleName,
+		metadatatypes.ModuleName,
 		attributetypes.ModuleName,
 		authz.ModuleName,
+		sanction.ModuleName,
 		triggertypes.ModuleName,
 		vaulttypes.ModuleName,
 	)
@@ -8,15 +8,26 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/provenance-io/provenance/x/marker"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatakeeper "github.com/provenance-io/provenance/x/metadata/keeper"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
 // Profile with:
@@ -125,3 +136,85 @@ func BenchmarkInvariants(b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkMarkerBeginBlocker measures the marker module's begin blocker with a varying number of fixed-supply markers.
+// Only markers whose supply or status changed are reconciled, so the time per op should stay flat as the number grows.
+//
+// Run with:
+// go test -tags sims -benchmem -run=^$ github.com/provenance-io/provenance/app -bench ^BenchmarkMarkerBeginBlocker$
+func BenchmarkMarkerBeginBlocker(b *testing.B) {
+	for _, numMarkers := range []int{100, 1_000, 10_000} {
+		b.Run(fmt.Sprintf("markers=%d", numMarkers), func(b *testing.B) {
+			app := Setup(b)
+			ctx := app.NewContextLegacy(false, cmtproto.Header{Height: app.LastBlockHeight() + 1, Time: time.Now().UTC()})
+			for i := 0; i < numMarkers; i++ {
+				denom := fmt.Sprintf("benchcoin%d", i)
+				mAcc := &markertypes.MarkerAccount{
+					BaseAccount: authtypes.NewBaseAccountWithAddress(markertypes.MustGetMarkerAddress(denom)),
+					Status:      markertypes.StatusActive,
+					SupplyFixed: true,
+					Denom:       denom,
+					Supply:      sdkmath.NewInt(1_000),
+					MarkerType:  markertypes.MarkerType_Coin,
+				}
+				app.AccountKeeper.NewAccount(ctx, mAcc.BaseAccount)
+				require.NoError(b, app.MarkerKeeper.SetMarker(ctx, mAcc), "SetMarker(%q)", denom)
+			}
+			// The first begin block mints the supply of all the new markers.
+			marker.BeginBlocker(ctx, *app.MarkerKeeper, app.BankKeeper)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				marker.BeginBlocker(ctx, *app.MarkerKeeper, app.BankKeeper)
+			}
+		})
+	}
+}
+
+// BenchmarkMetadataMigrations measures the metadata module's 4 to 5 and 5 to 6 migrations with a varying number of scopes.
+// Both migrations iterate over every scope in the single upgrade block, so the time per op grows with the number of scopes.
+// Each scope has one owner, one session, and two records.
+//
+// Run with:
+// go test -tags sims -benchmem -run=^$ github.com/provenance-io/provenance/app -bench ^BenchmarkMetadataMigrations$
+func BenchmarkMetadataMigrations(b *testing.B) {
+	for _, numScopes := range []int{1_000, 10_000, 100_000} {
+		app := Setup(b)
+		ctx := app.NewContextLegacy(false, cmtproto.Header{Height: app.LastBlockHeight() + 1, Time: time.Now().UTC()})
+		owner := sdk.AccAddress("bench_scope_owner___").String()
+		owners := []metadatatypes.Party{{Address: owner, Role: metadatatypes.PartyType_PARTY_TYPE_OWNER}}
+		cSpecID := metadatatypes.ContractSpecMetadataAddress(uuid.New())
+		process := metadatatypes.NewProcess("process", &metadatatypes.Process_Hash{Hash: "hash"}, "method")
+		for i := 0; i < numScopes; i++ {
+			scopeUUID := uuid.New()
+			scopeID := metadatatypes.ScopeMetadataAddress(scopeUUID)
+			sessionID := metadatatypes.SessionMetadataAddress(scopeUUID, uuid.New())
+			scope := metadatatypes.NewScope(scopeID, nil, owners, nil, owner, false)
+			require.NoError(b, app.MetadataKeeper.SetScope(ctx, *scope), "SetScope %d", i)
+			app.MetadataKeeper.SetSession(ctx, *metadatatypes.NewSession("session", sessionID, cSpecID, owners, nil))
+			for _, name := range []string{"terms", "payments"} {
+				outputs := []metadatatypes.RecordOutput{{Hash: name + "hash", Status: metadatatypes.ResultStatus_RESULT_STATUS_PASS}}
+				app.MetadataKeeper.SetRecord(ctx, *metadatatypes.NewRecord(name, sessionID, *process, nil, outputs, nil))
+			}
+		}
+		migrator := metadatakeeper.NewMigrator(app.MetadataKeeper)
+
+		b.Run(fmt.Sprintf("Migrate4to5/scopes=%d", numScopes), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+				require.NoError(b, migrator.Migrate4to5(cacheCtx), "Migrate4to5")
+			}
+		})
+		b.Run(fmt.Sprintf("Migrate5to6/scopes=%d", numScopes), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+				require.NoError(b, migrator.Migrate5to6(cacheCtx), "Migrate5to6")
+			}
+		})
+	}
+}
@@ -72,7 +72,7 @@ type SetupOptions struct {
 	ChainID string
 }
 
-func setup(t *testing.T, withGenesis bool, invCheckPeriod uint, chainID string) (*App, GenesisState) {
+func setup(t testing.TB, withGenesis bool, invCheckPeriod uint, chainID string) (*App, GenesisState) {
 	db := dbm.NewMemDB()
 
 	appOpts := simtestutil.AppOptionsMap{
@@ -179,7 +179,7 @@ func NewAppWithCustomOptions(t *testing.T, isCheckTx bool, options SetupOptions)
 }
 
 // Setup initializes a new App. A Nop logger is set in App.
-func Setup(t *testing.T) *App {
+func Setup(t testing.TB) *App {
 	t.Helper()
 	privVal := mock.NewPV()
 	pubKey, err := privVal.GetPubKey()
@@ -202,7 +202,7 @@ func Setup(t *testing.T) *App {
 	return app
 }
 
-func genesisStateWithValSet(t *testing.T,
+func genesisStateWithValSet(t testing.TB,
 	app *App, genesisState GenesisState,
 	valSet *cmttypes.ValidatorSet, genAccs []authtypes.GenesisAccount,
 	balances ...banktypes.Balance,
@@ -276,7 +276,7 @@ func SetupQuerier(t *testing.T) *App {
 // that also act as delegators. For simplicity, each validator is bonded with a delegation
 // of one consensus engine unit in the default token of the app from first genesis
 // account. A Nop logger is set in App.
-func SetupWithGenesisValSet(t *testing.T, chainID string, valSet *cmttypes.ValidatorSet, genAccs []authtypes.GenesisAccount, balances ...banktypes.Balance) *App {
+func SetupWithGenesisValSet(t testing.TB, chainID string, valSet *cmttypes.ValidatorSet, genAccs []authtypes.GenesisAccount, balances ...banktypes.Balance) *App {
 	t.Helper()
 
 	app, genesisState := setup(t, true, 5, chainID)
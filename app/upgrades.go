@@ -12,10 +12,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	ibctmmigrations "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint/migrations"
 
 	flatfeestypes "github.com/provenance-io/provenance/x/flatfees/types"
+	"github.com/provenance-io/provenance/x/sanction"
 )
 
 // appUpgrade is an internal structure for defining all things for an upgrade.
@@ -103,6 +105,60 @@ var upgrades = map[string]appUpgrade{
 
 			setFees(ctx, app)
 
+			return vm, nil
+		},
+	},
+	"gardenia-rc1": { // Upgrade for v1.31.0-rc1
+		Handler: func(ctx sdk.Context, app *App, vm module.VersionMap) (module.VersionMap, error) {
+			// This runs the marker 3 to 4 and metadata 4 to 5 and 5 to 6 migrations.
+			// Both metadata migrations iterate over every scope in this one block;
+			// BenchmarkMetadataMigrations (in sim_bench_test.go) shows what that costs.
+			var err error
+			if vm, err = runModuleMigrations(ctx, app, vm); err != nil {
+				return nil, err
+			}
+
+			if err = createSanctionModuleAccount(ctx, app); err != nil {
+				return nil, err
+			}
+
+			if err = pruneIBCExpiredConsensusStates(ctx, app); err != nil {
+				return nil, err
+			}
+
+			removeInactiveValidatorDelegations(ctx, app)
+
+			if err = convertFinishedVestingAccountsToBase(ctx, app); err != nil {
+				return nil, err
+			}
+
+			return vm, nil
+		},
+	},
+	"gardenia": { // Upgrade for v1.31.0
+		Handler: func(ctx sdk.Context, app *App, vm module.VersionMap) (module.VersionMap, error) {
+			// This runs the marker 3 to 4 and metadata 4 to 5 and 5 to 6 migrations.
+			// Both metadata migrations iterate over every scope in this one block;
+			// BenchmarkMetadataMigrations (in sim_bench_test.go) shows what that costs.
+			var err error
+			if vm, err = runModuleMigrations(ctx, app, vm); err != nil {
+				return nil, err
+			}
+
+			if err = createSanctionModuleAccount(ctx, app); err != nil {
+				return nil, err
+			}
+
+			if err = pruneIBCExpiredConsensusStates(ctx, app); err != nil {
+				return nil, err
+			}
+
+			removeInactiveValidatorDelegations(ctx, app)
+
+			if err = convertFinishedVestingAccountsToBase(ctx, app); err != nil {
+				return nil, err
+			}
+
 			return vm, nil
 		},
 	},
@@ -321,6 +377,31 @@ func unlockVestingAccounts(ctx sdk.Context, app *App, addrs []sdk.AccAddress) {
 	ctx.Logger().Info("Done unlocking select vesting accounts.")
 }
 
+// createSanctionModuleAccount creates the sanction module account that holds seized funds in escrow.
+// If a base account already exists at the module's address (e.g. because funds were sent there), it is
+// converted into the module account so that it keeps its account number and balance.
+func createSanctionModuleAccount(ctx sdk.Context, app *App) error {
+	ctx.Logger().Info("Creating the sanction module account.")
+	addr := app.AccountKeeper.GetModuleAddress(sanction.ModuleName)
+	switch acct := app.AccountKeeper.GetAccount(ctx, addr).(type) {
+	case nil:
+		// GetModuleAccount creates the account when it doesn't exist yet.
+		app.AccountKeeper.GetModuleAccount(ctx, sanction.ModuleName)
+	case sdk.ModuleAccountI:
+		ctx.Logger().Info("The sanction module account already exists.")
+		return nil
+	case *authtypes.BaseAccount:
+		ctx.Logger().Info(fmt.Sprintf("Converting base account %s into the sanction module account.", addr))
+		app.AccountKeeper.SetAccount(ctx, authtypes.NewModuleAccount(acct, sanction.ModuleName))
+	default:
+		err := fmt.Errorf("cannot create the sanction module account: account %s already exists as a %T", addr, acct)
+		ctx.Logger().Error(err.Error())
+		return err
+	}
+	ctx.Logger().Info("Done creating the sanction module account.")
+	return nil
+}
+
 // Create a use of the standard helpers so that the linter neither complains about it not being used,
 // nor complains about a nolint:unused directive that isn't needed because the function is used.
 var (
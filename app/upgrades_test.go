@@ -26,6 +26,7 @@ import (
 
 	"github.com/provenance-io/provenance/internal"
 	internalsdk "github.com/provenance-io/provenance/internal/sdk"
+	"github.com/provenance-io/provenance/x/sanction"
 )
 
 type UpgradeTestSuite struct {
@@ -1025,12 +1026,87 @@ func (s *UpgradeTestSuite) TestUnlockVestingAccounts() {
 	}
 }
 
+func (s *UpgradeTestSuite) TestCreateSanctionModuleAccount() {
+	modAddr := s.app.AccountKeeper.GetModuleAddress(sanction.ModuleName)
+	tests := []struct {
+		name     string
+		existing sdk.AccountI
+		expErr   string
+	}{
+		{
+			name: "no account",
+		},
+		{
+			name:     "base account",
+			existing: authtypes.NewBaseAccountWithAddress(modAddr),
+		},
+		{
+			name:     "module account",
+			existing: authtypes.NewEmptyModuleAccount(sanction.ModuleName),
+		},
+		{
+			name: "other account type",
+			existing: &vesting.PermanentLockedAccount{
+				BaseVestingAccount: &vesting.BaseVestingAccount{BaseAccount: authtypes.NewBaseAccountWithAddress(modAddr)},
+			},
+			expErr: "cannot create the sanction module account: account " + modAddr.String() + " already exists as a *types.PermanentLockedAccount",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if acct := s.app.AccountKeeper.GetAccount(ctx, modAddr); acct != nil {
+				s.app.AccountKeeper.RemoveAccount(ctx, acct)
+			}
+			if tc.existing != nil {
+				s.app.AccountKeeper.SetAccount(ctx, s.app.AccountKeeper.NewAccount(ctx, tc.existing))
+			}
+
+			err := createSanctionModuleAccount(ctx, s.app)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "createSanctionModuleAccount error")
+				return
+			}
+			s.Require().NoError(err, "createSanctionModuleAccount error")
+			acct := s.app.AccountKeeper.GetAccount(ctx, modAddr)
+			modAcct, ok := acct.(sdk.ModuleAccountI)
+			if s.Assert().True(ok, "account is a module account, actual type %T", acct) {
+				s.Assert().Equal(sanction.ModuleName, modAcct.GetName(), "module account name")
+			}
+		})
+	}
+}
+
+func (s *UpgradeTestSuite) TestGardeniaRC1() {
+	expInLog := []string{
+		LogMsgRunModuleMigrations,
+		LogMsgCreateSanctionModuleAccount,
+		LogMsgPruneIBCExpiredConsensusStates,
+		LogMsgRemoveInactiveValidatorDelegations,
+		LogMsgConvertFinishedVestingAccountsToBase,
+	}
+	s.AssertUpgradeHandlerLogs("gardenia-rc1", expInLog, nil)
+}
+
+func (s *UpgradeTestSuite) TestGardenia() {
+	expInLog := []string{
+		LogMsgRunModuleMigrations,
+		LogMsgCreateSanctionModuleAccount,
+		LogMsgPruneIBCExpiredConsensusStates,
+		LogMsgRemoveInactiveValidatorDelegations,
+		LogMsgConvertFinishedVestingAccountsToBase,
+	}
+	s.AssertUpgradeHandlerLogs("gardenia", expInLog, nil)
+}
+
 // Create strings with the log statements that start off the reusable upgrade functions.
 var (
 	LogMsgRunModuleMigrations                  = "INF Starting module migrations. This may take a significant amount of time to complete. Do not restart node. module=baseapp"
 	LogMsgPruneIBCExpiredConsensusStates       = "INF Pruning expired consensus states for IBC. module=baseapp"
 	LogMsgRemoveInactiveValidatorDelegations   = "INF Removing inactive validator delegations. module=baseapp"
 	LogMsgConvertFinishedVestingAccountsToBase = "INF Converting completed vesting accounts into base accounts. module=baseapp"
+	LogMsgCreateSanctionModuleAccount          = "INF Creating the sanction module account. module=baseapp"
 )
 
 func (s *UpgradeTestSuite) TestForsythiaRC1() {
@@ -0,0 +1,30 @@
+package provutils
+
+import "math"
+
+// NavStaleHeight returns the first block height at which a net asset value that was last updated at updatedHeight
+// is stale, given the max age (in blocks) of the marker or scope that it belongs to.
+// Returns zero if the net asset value never becomes stale, i.e. if the max age is zero.
+func NavStaleHeight(updatedHeight, maxAge uint64) uint64 {
+	if maxAge == 0 || maxAge >= math.MaxUint64-updatedHeight {
+		return 0
+	}
+	return updatedHeight + maxAge + 1
+}
+
+// IsNavStale returns true if a net asset value that was last updated at updatedHeight is stale at the provided height.
+func IsNavStale(updatedHeight, maxAge uint64, height int64) bool {
+	staleHeight := NavStaleHeight(updatedHeight, maxAge)
+	return staleHeight > 0 && height > 0 && uint64(height) >= staleHeight //nolint:gosec // G115: Checked that height is positive.
+}
+
+// NavStaleQueueHeight returns the height at which to queue a net asset value so that it is looked at in the
+// block where it becomes stale. Returns zero if it should not be queued, i.e. if it never becomes stale,
+// or if it becomes stale at or before the current height.
+func NavStaleQueueHeight(updatedHeight, maxAge uint64, curHeight int64) uint64 {
+	staleHeight := NavStaleHeight(updatedHeight, maxAge)
+	if staleHeight == 0 || (curHeight > 0 && staleHeight <= uint64(curHeight)) { //nolint:gosec // G115: Checked that curHeight is positive.
+		return 0
+	}
+	return staleHeight
+}
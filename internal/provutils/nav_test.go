@@ -0,0 +1,83 @@
+package provutils
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNavStaleHeight(t *testing.T) {
+	tests := []struct {
+		updatedHeight uint64
+		maxAge        uint64
+		exp           uint64
+	}{
+		{updatedHeight: 0, maxAge: 0, exp: 0},
+		{updatedHeight: 10, maxAge: 0, exp: 0},
+		{updatedHeight: 10, maxAge: 1, exp: 12},
+		{updatedHeight: 10, maxAge: 5, exp: 16},
+		{updatedHeight: 0, maxAge: 5, exp: 6},
+		{updatedHeight: 10, maxAge: math.MaxUint64 - 12, exp: math.MaxUint64 - 1},
+		{updatedHeight: 10, maxAge: math.MaxUint64 - 11, exp: math.MaxUint64},
+		{updatedHeight: 10, maxAge: math.MaxUint64 - 10, exp: 0},
+		{updatedHeight: 10, maxAge: math.MaxUint64, exp: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d, %d", tc.updatedHeight, tc.maxAge), func(t *testing.T) {
+			actual := NavStaleHeight(tc.updatedHeight, tc.maxAge)
+			assert.Equal(t, tc.exp, actual, "NavStaleHeight(%d, %d)", tc.updatedHeight, tc.maxAge)
+		})
+	}
+}
+
+func TestIsNavStale(t *testing.T) {
+	tests := []struct {
+		updatedHeight uint64
+		maxAge        uint64
+		height        int64
+		exp           bool
+	}{
+		{updatedHeight: 10, maxAge: 0, height: 1000, exp: false},
+		{updatedHeight: 10, maxAge: 5, height: 14, exp: false},
+		{updatedHeight: 10, maxAge: 5, height: 15, exp: false},
+		{updatedHeight: 10, maxAge: 5, height: 16, exp: true},
+		{updatedHeight: 10, maxAge: 5, height: 17, exp: true},
+		{updatedHeight: 10, maxAge: 5, height: 0, exp: false},
+		{updatedHeight: 10, maxAge: 5, height: -20, exp: false},
+		{updatedHeight: 10, maxAge: math.MaxUint64, height: math.MaxInt64, exp: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d, %d, %d", tc.updatedHeight, tc.maxAge, tc.height), func(t *testing.T) {
+			actual := IsNavStale(tc.updatedHeight, tc.maxAge, tc.height)
+			assert.Equal(t, tc.exp, actual, "IsNavStale(%d, %d, %d)", tc.updatedHeight, tc.maxAge, tc.height)
+		})
+	}
+}
+
+func TestNavStaleQueueHeight(t *testing.T) {
+	tests := []struct {
+		updatedHeight uint64
+		maxAge        uint64
+		curHeight     int64
+		exp           uint64
+	}{
+		{updatedHeight: 10, maxAge: 0, curHeight: 10, exp: 0},
+		{updatedHeight: 10, maxAge: 5, curHeight: 10, exp: 16},
+		{updatedHeight: 10, maxAge: 5, curHeight: 15, exp: 16},
+		{updatedHeight: 10, maxAge: 5, curHeight: 16, exp: 0},
+		{updatedHeight: 10, maxAge: 5, curHeight: 20, exp: 0},
+		{updatedHeight: 0, maxAge: 5, curHeight: 0, exp: 6},
+		{updatedHeight: 0, maxAge: 5, curHeight: -1, exp: 6},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d, %d, %d", tc.updatedHeight, tc.maxAge, tc.curHeight), func(t *testing.T) {
+			actual := NavStaleQueueHeight(tc.updatedHeight, tc.maxAge, tc.curHeight)
+			assert.Equal(t, tc.exp, actual, "NavStaleQueueHeight(%d, %d, %d)", tc.updatedHeight, tc.maxAge, tc.curHeight)
+		})
+	}
+}
@@ -308,6 +308,9 @@ func init() {
 	setWhitelistedQuery("/provenance.metadata.v1.Query/OSAllLocators", &metadatatypes.OSAllLocatorsResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/AccountData", &metadatatypes.AccountDataResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeNetAssetValues", &metadatatypes.QueryScopeNetAssetValuesResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/RecordCommitment", &metadatatypes.RecordCommitmentResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeDataAccess", &metadatatypes.ScopeDataAccessResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeTombstone", &metadatatypes.ScopeTombstoneResponse{})
 
 	// flatfees
 	setWhitelistedQuery("/provenance.flatfees.v1.Query/Params", &flatfeestypes.QueryParamsResponse{})
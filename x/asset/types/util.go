@@ -150,6 +150,60 @@ func validateAgainstSchema(schema map[string]interface{}, jsonData interface{})
 	}
 }
 
+// ValidateJSONSchemaDefinition makes sure that a JSON schema only uses what ValidateDataWithJSONSchema can check:
+// - Every (sub)schema must have a type of object, array, or string (e.g. numbers can't be checked)
+// - An object's required must be a list of strings, and its properties must all be schemas
+// - An array's items must be a schema
+// Other keywords (e.g. enum or minLength) are not checked by ValidateDataWithJSONSchema, so they are ignored.
+func ValidateJSONSchemaDefinition(schema map[string]interface{}) error {
+	schemaType, ok := schema["type"].(string)
+	if !ok {
+		return fmt.Errorf("type is required")
+	}
+	switch schemaType {
+	case "object":
+		if reqVal, exists := schema["required"]; exists {
+			if _, err := toStringSlice(reqVal); err != nil {
+				return fmt.Errorf("invalid required definition: %w", err)
+			}
+		}
+		if propsVal, exists := schema["properties"]; exists {
+			props, ok := propsVal.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("invalid properties definition")
+			}
+			for _, key := range slices.Sorted(maps.Keys(props)) {
+				subSchema, ok := props[key].(map[string]interface{})
+				if !ok {
+					return fmt.Errorf("invalid property %s definition", key)
+				}
+				if err := ValidateJSONSchemaDefinition(subSchema); err != nil {
+					return fmt.Errorf("invalid property %s definition: %w", key, err)
+				}
+			}
+		}
+		return nil
+
+	case "array":
+		if itemsVal, exists := schema["items"]; exists {
+			itemSchema, ok := itemsVal.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("invalid array items definition")
+			}
+			if err := ValidateJSONSchemaDefinition(itemSchema); err != nil {
+				return fmt.Errorf("invalid array items definition: %w", err)
+			}
+		}
+		return nil
+
+	case "string":
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported type %q: expected object, array, or string", schemaType)
+	}
+}
+
 // toStringSlice converts a value to a slice of strings
 func toStringSlice(v interface{}) ([]string, error) {
 	if v == nil {
@@ -187,6 +187,79 @@ func TestValidateJSONSchema(t *testing.T) {
 	}
 }
 
+func TestValidateJSONSchemaDefinition(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema map[string]interface{}
+		expErr string
+	}{
+		{
+			name: "object with properties and required",
+			schema: map[string]interface{}{
+				"type":        "object",
+				"description": "ignored",
+				"properties": map[string]interface{}{
+					"name": map[string]interface{}{"type": "string"},
+					"tags": map[string]interface{}{
+						"type":  "array",
+						"items": map[string]interface{}{"type": "string"},
+					},
+				},
+				"required": []interface{}{"name"},
+			},
+		},
+		{
+			name:   "no type",
+			schema: map[string]interface{}{"properties": map[string]interface{}{}},
+			expErr: "type is required",
+		},
+		{
+			name:   "number type",
+			schema: map[string]interface{}{"type": "number"},
+			expErr: `unsupported type "number": expected object, array, or string`,
+		},
+		{
+			name: "property with unsupported type",
+			schema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"age": map[string]interface{}{"type": "integer"},
+				},
+			},
+			expErr: `invalid property age definition: unsupported type "integer": expected object, array, or string`,
+		},
+		{
+			name: "property that is not a schema",
+			schema: map[string]interface{}{
+				"type":       "object",
+				"properties": map[string]interface{}{"name": "string"},
+			},
+			expErr: "invalid property name definition",
+		},
+		{
+			name:   "required is not a list of strings",
+			schema: map[string]interface{}{"type": "object", "required": []interface{}{1}},
+			expErr: "invalid required definition: array_element: expected string in array",
+		},
+		{
+			name:   "items is not a schema",
+			schema: map[string]interface{}{"type": "array", "items": "string"},
+			expErr: "invalid array items definition",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateJSONSchemaDefinition(tc.schema)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateJSONSchemaDefinition error")
+			} else {
+				assert.NoError(t, err, "ValidateJSONSchemaDefinition error")
+			}
+		})
+	}
+}
+
 func TestNewDefaultMarker(t *testing.T) {
 	tests := []struct {
 		name     string
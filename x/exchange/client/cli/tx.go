@@ -42,6 +42,7 @@ func CmdTx() *cobra.Command {
 		CmdTxMarketUpdateUserSettle(),
 		CmdTxMarketUpdateAcceptingCommitments(),
 		CmdTxMarketUpdateIntermediaryDenom(),
+		CmdTxMarketUpdateRejectStaleNavs(),
 		CmdTxMarketManagePermissions(),
 		CmdTxMarketManageReqAttrs(),
 		CmdTxCreatePayment(),
@@ -309,6 +310,20 @@ func CmdTxMarketUpdateIntermediaryDenom() *cobra.Command {
 	return cmd
 }
 
+// CmdTxMarketUpdateRejectStaleNavs creates the market-reject-stale-navs sub-command for the exchange tx command.
+func CmdTxMarketUpdateRejectStaleNavs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-reject-stale-navs",
+		Aliases: []string{"market-update-reject-stale-navs", "update-market-reject-stale-navs", "update-reject-stale-navs"},
+		Short:   "Change whether a market rejects stale net asset values",
+		RunE:    genericTxRunE(MakeMsgMarketUpdateRejectStaleNavs),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketUpdateRejectStaleNavs(cmd)
+	return cmd
+}
+
 // CmdTxMarketManagePermissions creates the market-permissions sub-command for the exchange tx command.
 func CmdTxMarketManagePermissions() *cobra.Command {
 	cmd := &cobra.Command{
@@ -727,6 +727,37 @@ func MakeMsgMarketUpdateIntermediaryDenom(clientCtx client.Context, flagSet *pfl
 	return msg, errors.Join(errs...)
 }
 
+// SetupCmdTxMarketUpdateRejectStaleNavs adds all the flags needed for MakeMsgMarketUpdateRejectStaleNavs.
+func SetupCmdTxMarketUpdateRejectStaleNavs(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	AddFlagsEnableDisable(cmd, "reject_stale_navs")
+
+	MarkFlagsRequired(cmd, FlagMarket)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqEnableDisableUse,
+	)
+	AddUseDetails(cmd, ReqAdminDesc, ReqEnableDisableDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketUpdateRejectStaleNavs reads all the SetupCmdTxMarketUpdateRejectStaleNavs flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketUpdateRejectStaleNavs(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketUpdateRejectStaleNavsRequest, error) {
+	msg := &exchange.MsgMarketUpdateRejectStaleNavsRequest{}
+
+	errs := make([]error, 3)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.RejectStaleNavs, errs[2] = ReadFlagsEnableDisable(flagSet)
+
+	return msg, errors.Join(errs...)
+}
+
 // SetupCmdTxMarketManagePermissions adds all the flags needed for MakeMsgMarketManagePermissions.
 func SetupCmdTxMarketManagePermissions(cmd *cobra.Command) {
 	AddFlagsAdmin(cmd)
@@ -1719,6 +1719,88 @@ func TestMakeMsgMarketUpdateIntermediaryDenom(t *testing.T) {
 	}
 }
 
+func TestSetupCmdTxMarketUpdateRejectStaleNavs(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateRejectStaleNavs",
+		setup: cli.SetupCmdTxMarketUpdateRejectStaleNavs,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagEnable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+			cli.FlagDisable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
+			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateRejectStaleNavs(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateRejectStaleNavsRequest]{
+		makerName: "MakeMsgMarketUpdateRejectStaleNavs",
+		maker:     cli.MakeMsgMarketUpdateRejectStaleNavs,
+		setup:     cli.SetupCmdTxMarketUpdateRejectStaleNavs,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateRejectStaleNavsRequest]{
+		{
+			name:   "some errors",
+			flags:  []string{"--market", "56"},
+			expMsg: &exchange.MsgMarketUpdateRejectStaleNavsRequest{MarketId: 56},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"exactly one of --enable or --disable must be provided",
+			),
+		},
+		{
+			name:      "enable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--enable", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateRejectStaleNavsRequest{
+				Admin:           sdk.AccAddress("FromAddress_________").String(),
+				MarketId:        4,
+				RejectStaleNavs: true,
+			},
+		},
+		{
+			name:      "disable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
+			expMsg: &exchange.MsgMarketUpdateRejectStaleNavsRequest{
+				Admin:           "Blake",
+				MarketId:        94,
+				RejectStaleNavs: false,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
 func TestSetupCmdTxMarketManagePermissions(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
 		name:  "SetupCmdTxMarketManagePermissions",
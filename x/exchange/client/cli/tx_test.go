@@ -1028,6 +1028,51 @@ func (s *CmdTestSuite) TestCmdTxMarketUpdateIntermediaryDenom() {
 	}
 }
 
+func (s *CmdTestSuite) TestCmdTxMarketUpdateRejectStaleNavs() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no market",
+			args:     []string{"market-reject-stale-navs", "--from", s.addr1.String(), "--enable"},
+			expInErr: []string{"required flag(s) \"market\" not set"},
+		},
+		{
+			name: "market does not exist",
+			args: []string{"market-update-reject-stale-navs", "--market", "419",
+				"--from", s.addr4.String(), "--enable"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"account " + s.addr4.String() + " does not have permission to update market 419",
+			},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "enable reject stale navs",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.RejectStaleNavs = true
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args:         []string{"update-reject-stale-navs", "--enable", "--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+		{
+			name: "disable reject stale navs",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.RejectStaleNavs = false
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args:         []string{"update-reject-stale-navs", "--disable", "--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
 func (s *CmdTestSuite) TestCmdTxMarketManagePermissions() {
 	tests := []txCmdTestCase{
 		{
@@ -163,6 +163,14 @@ func NewEventMarketIntermediaryDenomUpdated(marketID uint32, updatedBy string) *
 	}
 }
 
+func NewEventMarketRejectStaleNavsUpdated(marketID uint32, updatedBy string, reject bool) *EventMarketRejectStaleNavsUpdated {
+	return &EventMarketRejectStaleNavsUpdated{
+		MarketId:        marketID,
+		UpdatedBy:       updatedBy,
+		RejectStaleNavs: reject,
+	}
+}
+
 func NewEventMarketPermissionsUpdated(marketID uint32, updatedBy string) *EventMarketPermissionsUpdated {
 	return &EventMarketPermissionsUpdated{
 		MarketId:  marketID,
@@ -1194,6 +1194,70 @@ func (m *EventMarketIntermediaryDenomUpdated) GetUpdatedBy() string {
 	return ""
 }
 
+// EventMarketRejectStaleNavsUpdated is an event emitted when a market updates its reject_stale_navs field.
+type EventMarketRejectStaleNavsUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the reject_stale_navs field.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// reject_stale_navs is the new value of the market's reject_stale_navs field.
+	RejectStaleNavs bool `protobuf:"varint,3,opt,name=reject_stale_navs,json=rejectStaleNavs,proto3" json:"reject_stale_navs,omitempty"`
+}
+
+func (m *EventMarketRejectStaleNavsUpdated) Reset()         { *m = EventMarketRejectStaleNavsUpdated{} }
+func (m *EventMarketRejectStaleNavsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketRejectStaleNavsUpdated) ProtoMessage()    {}
+func (*EventMarketRejectStaleNavsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{18}
+}
+func (m *EventMarketRejectStaleNavsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketRejectStaleNavsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketRejectStaleNavsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarketRejectStaleNavsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketRejectStaleNavsUpdated.Merge(m, src)
+}
+func (m *EventMarketRejectStaleNavsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketRejectStaleNavsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketRejectStaleNavsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketRejectStaleNavsUpdated proto.InternalMessageInfo
+
+func (m *EventMarketRejectStaleNavsUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventMarketRejectStaleNavsUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+func (m *EventMarketRejectStaleNavsUpdated) GetRejectStaleNavs() bool {
+	if m != nil {
+		return m.RejectStaleNavs
+	}
+	return false
+}
+
 // EventMarketPermissionsUpdated is an event emitted when a market's permissions are updated.
 type EventMarketPermissionsUpdated struct {
 	// market_id is the numerical identifier of the market.
@@ -1206,7 +1270,7 @@ func (m *EventMarketPermissionsUpdated) Reset()         { *m = EventMarketPermis
 func (m *EventMarketPermissionsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketPermissionsUpdated) ProtoMessage()    {}
 func (*EventMarketPermissionsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{18}
+	return fileDescriptor_c1b69385a348cffa, []int{19}
 }
 func (m *EventMarketPermissionsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1261,7 +1325,7 @@ func (m *EventMarketReqAttrUpdated) Reset()         { *m = EventMarketReqAttrUpd
 func (m *EventMarketReqAttrUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketReqAttrUpdated) ProtoMessage()    {}
 func (*EventMarketReqAttrUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{19}
+	return fileDescriptor_c1b69385a348cffa, []int{20}
 }
 func (m *EventMarketReqAttrUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1314,7 +1378,7 @@ func (m *EventMarketCreated) Reset()         { *m = EventMarketCreated{} }
 func (m *EventMarketCreated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketCreated) ProtoMessage()    {}
 func (*EventMarketCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{20}
+	return fileDescriptor_c1b69385a348cffa, []int{21}
 }
 func (m *EventMarketCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1424,7 @@ func (m *EventMarketFeesUpdated) Reset()         { *m = EventMarketFeesUpdated{}
 func (m *EventMarketFeesUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketFeesUpdated) ProtoMessage()    {}
 func (*EventMarketFeesUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{21}
+	return fileDescriptor_c1b69385a348cffa, []int{22}
 }
 func (m *EventMarketFeesUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1404,7 +1468,7 @@ func (m *EventParamsUpdated) Reset()         { *m = EventParamsUpdated{} }
 func (m *EventParamsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventParamsUpdated) ProtoMessage()    {}
 func (*EventParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{22}
+	return fileDescriptor_c1b69385a348cffa, []int{23}
 }
 func (m *EventParamsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1451,7 +1515,7 @@ func (m *EventPaymentCreated) Reset()         { *m = EventPaymentCreated{} }
 func (m *EventPaymentCreated) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentCreated) ProtoMessage()    {}
 func (*EventPaymentCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{23}
+	return fileDescriptor_c1b69385a348cffa, []int{24}
 }
 func (m *EventPaymentCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1535,7 +1599,7 @@ func (m *EventPaymentUpdated) Reset()         { *m = EventPaymentUpdated{} }
 func (m *EventPaymentUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentUpdated) ProtoMessage()    {}
 func (*EventPaymentUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{24}
+	return fileDescriptor_c1b69385a348cffa, []int{25}
 }
 func (m *EventPaymentUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1624,7 +1688,7 @@ func (m *EventPaymentAccepted) Reset()         { *m = EventPaymentAccepted{} }
 func (m *EventPaymentAccepted) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentAccepted) ProtoMessage()    {}
 func (*EventPaymentAccepted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{25}
+	return fileDescriptor_c1b69385a348cffa, []int{26}
 }
 func (m *EventPaymentAccepted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1702,7 +1766,7 @@ func (m *EventPaymentRejected) Reset()         { *m = EventPaymentRejected{} }
 func (m *EventPaymentRejected) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentRejected) ProtoMessage()    {}
 func (*EventPaymentRejected) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{26}
+	return fileDescriptor_c1b69385a348cffa, []int{27}
 }
 func (m *EventPaymentRejected) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1766,7 +1830,7 @@ func (m *EventPaymentCancelled) Reset()         { *m = EventPaymentCancelled{} }
 func (m *EventPaymentCancelled) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentCancelled) ProtoMessage()    {}
 func (*EventPaymentCancelled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{27}
+	return fileDescriptor_c1b69385a348cffa, []int{28}
 }
 func (m *EventPaymentCancelled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1835,6 +1899,7 @@ func init() {
 	proto.RegisterType((*EventMarketCommitmentsEnabled)(nil), "provenance.exchange.v1.EventMarketCommitmentsEnabled")
 	proto.RegisterType((*EventMarketCommitmentsDisabled)(nil), "provenance.exchange.v1.EventMarketCommitmentsDisabled")
 	proto.RegisterType((*EventMarketIntermediaryDenomUpdated)(nil), "provenance.exchange.v1.EventMarketIntermediaryDenomUpdated")
+	proto.RegisterType((*EventMarketRejectStaleNavsUpdated)(nil), "provenance.exchange.v1.EventMarketRejectStaleNavsUpdated")
 	proto.RegisterType((*EventMarketPermissionsUpdated)(nil), "provenance.exchange.v1.EventMarketPermissionsUpdated")
 	proto.RegisterType((*EventMarketReqAttrUpdated)(nil), "provenance.exchange.v1.EventMarketReqAttrUpdated")
 	proto.RegisterType((*EventMarketCreated)(nil), "provenance.exchange.v1.EventMarketCreated")
@@ -1852,63 +1917,65 @@ func init() {
 }
 
 var fileDescriptor_c1b69385a348cffa = []byte{
-	// 884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x31, 0x6f, 0xe4, 0x44,
-	0x14, 0xce, 0x6c, 0x92, 0xbd, 0xdb, 0x97, 0x9c, 0x74, 0x98, 0x10, 0x36, 0x1c, 0xb7, 0x44, 0x4e,
-	0x93, 0xe6, 0x76, 0x2f, 0x20, 0x14, 0xe9, 0xa8, 0xb2, 0x97, 0x44, 0x4a, 0x81, 0x58, 0xf9, 0x72,
-	0x42, 0xa2, 0x59, 0x4d, 0xec, 0xc7, 0x66, 0xc0, 0x9e, 0xf1, 0xcd, 0xcc, 0x6e, 0x62, 0xf1, 0x13,
-	0x68, 0xae, 0xa0, 0x83, 0x92, 0x0e, 0xd1, 0x21, 0xfe, 0x00, 0x0d, 0xe5, 0x89, 0x8a, 0x12, 0x25,
-	0xf0, 0x3f, 0x90, 0x3d, 0x76, 0xd6, 0xce, 0xe6, 0xec, 0x15, 0xc8, 0x22, 0xa2, 0x9b, 0x19, 0xbf,
-	0xf7, 0xbe, 0xef, 0x7b, 0x9e, 0x79, 0x6f, 0x06, 0xb6, 0x42, 0x29, 0x26, 0xc8, 0x29, 0x77, 0xb1,
-	0x87, 0xe7, 0xee, 0x29, 0xe5, 0x23, 0xec, 0x4d, 0x76, 0x7a, 0x38, 0x41, 0xae, 0x55, 0x37, 0x94,
-	0x42, 0x0b, 0x6b, 0x7d, 0x6a, 0xd4, 0xcd, 0x8c, 0xba, 0x93, 0x9d, 0x77, 0x36, 0x5c, 0xa1, 0x02,
-	0xa1, 0x86, 0x89, 0x55, 0xcf, 0x4c, 0x8c, 0x8b, 0xfd, 0x35, 0x81, 0x37, 0x0e, 0xe2, 0x18, 0x9f,
-	0x48, 0x0f, 0xe5, 0x53, 0x89, 0x54, 0xa3, 0x67, 0x6d, 0xc0, 0x5d, 0x11, 0xcf, 0x87, 0xcc, 0x6b,
-	0x93, 0x4d, 0xb2, 0xbd, 0xe4, 0xdc, 0x49, 0xe6, 0x47, 0x9e, 0xf5, 0x10, 0xc0, 0x7c, 0xd2, 0x51,
-	0x88, 0xed, 0xc6, 0x26, 0xd9, 0x6e, 0x39, 0xad, 0x64, 0xe5, 0x38, 0x0a, 0xd1, 0x7a, 0x00, 0xad,
-	0x80, 0xca, 0x2f, 0x51, 0xc7, 0xae, 0x8b, 0x9b, 0x64, 0xfb, 0x9e, 0x73, 0xd7, 0x2c, 0x1c, 0x79,
-	0xd6, 0x7b, 0xb0, 0x82, 0xe7, 0x1a, 0x25, 0xa7, 0x7e, 0xfc, 0x79, 0x29, 0x71, 0x86, 0x6c, 0xe9,
-	0xc8, 0xb3, 0x7f, 0x20, 0xf0, 0x66, 0x8e, 0x4d, 0x2c, 0xc4, 0xf7, 0xcb, 0xf9, 0x7c, 0x04, 0xab,
-	0x6e, 0x66, 0x37, 0x3c, 0x89, 0x0c, 0xa3, 0x7e, 0xfb, 0xb7, 0x9f, 0x1e, 0xad, 0xa5, 0x42, 0xf7,
-	0x3c, 0x4f, 0xa2, 0x52, 0xcf, 0xb4, 0x64, 0x7c, 0xe4, 0xac, 0x5c, 0x59, 0xf7, 0xa3, 0x7f, 0xc9,
-	0xf6, 0x47, 0x02, 0xf7, 0xa7, 0x6c, 0x0f, 0x59, 0x15, 0xd5, 0x75, 0x68, 0x52, 0xa5, 0x50, 0xab,
-	0x34, 0x6d, 0xe9, 0xcc, 0x5a, 0x83, 0xe5, 0x50, 0x32, 0x17, 0x13, 0x06, 0x2d, 0xc7, 0x4c, 0x2c,
-	0x0b, 0x96, 0x3e, 0x47, 0x54, 0x29, 0x6e, 0x32, 0x2e, 0xf2, 0x5d, 0x2e, 0xe7, 0xdb, 0x9c, 0xe1,
-	0xfb, 0x33, 0x81, 0x8d, 0x29, 0xdf, 0x01, 0x95, 0x9a, 0x51, 0xdf, 0x8f, 0x6e, 0x3f, 0xf1, 0x09,
-	0x3c, 0x98, 0xf2, 0x3e, 0xc8, 0xd6, 0xf7, 0x9f, 0x87, 0x5e, 0xd5, 0x6e, 0x2d, 0xe0, 0x36, 0xca,
-	0x71, 0x17, 0x67, 0x70, 0x5f, 0x66, 0xdb, 0xf1, 0x70, 0xcc, 0x3d, 0xf5, 0x54, 0x04, 0x01, 0xd3,
-	0x31, 0xe0, 0xfb, 0x70, 0x87, 0xba, 0xae, 0x18, 0x73, 0x9d, 0xe0, 0x95, 0x6d, 0xb7, 0xcc, 0xb0,
-	0x9c, 0x49, 0x9c, 0xe0, 0x20, 0x89, 0xb7, 0x98, 0x26, 0x38, 0x99, 0x59, 0xf7, 0x61, 0x51, 0xd3,
-	0x51, 0x9a, 0xc9, 0x78, 0x68, 0x7f, 0x43, 0xe0, 0xed, 0x84, 0x92, 0x61, 0x13, 0x20, 0xd7, 0x0e,
-	0xfa, 0x48, 0xd5, 0x7f, 0x4b, 0xeb, 0x97, 0x2c, 0x53, 0x1f, 0x27, 0xbe, 0x9f, 0x32, 0x7d, 0xea,
-	0x49, 0x7a, 0x56, 0x0c, 0x4f, 0x5e, 0x1b, 0xbe, 0x51, 0x08, 0xff, 0x04, 0x56, 0x3c, 0x54, 0x9a,
-	0x71, 0xaa, 0x99, 0xe0, 0x06, 0xbb, 0xec, 0x44, 0xe7, 0x8c, 0xe3, 0x72, 0x70, 0x96, 0x82, 0xf3,
-	0xb8, 0x1c, 0x2c, 0x55, 0x39, 0x5f, 0x59, 0xf7, 0x23, 0xfb, 0x45, 0x7a, 0x3e, 0x8c, 0x88, 0x7d,
-	0xd4, 0x94, 0xf9, 0x2a, 0xdb, 0x65, 0xa5, 0x52, 0x76, 0x01, 0xc6, 0xc6, 0x6e, 0x9e, 0x1a, 0xd4,
-	0x4a, 0x6d, 0xfb, 0x91, 0xcd, 0xc1, 0xca, 0x41, 0x1e, 0x70, 0x7a, 0xe2, 0xd7, 0x85, 0xf5, 0xa4,
-	0xd1, 0x26, 0xb6, 0x28, 0xfc, 0xa7, 0x7d, 0xa6, 0xea, 0x06, 0x0c, 0xa1, 0x9d, 0x03, 0x4c, 0x4e,
-	0xb0, 0xaa, 0x55, 0xe6, 0xb5, 0xbf, 0x68, 0x10, 0xeb, 0x15, 0x6a, 0x6b, 0x78, 0x37, 0x07, 0xf9,
-	0x5c, 0xa1, 0x7c, 0x86, 0x5a, 0xfb, 0x58, 0xaf, 0xd0, 0x31, 0x3c, 0xbc, 0x11, 0xb5, 0x66, 0xb1,
-	0x45, 0xd8, 0x69, 0x1d, 0xaa, 0xf9, 0xb7, 0x4e, 0xa0, 0x73, 0x33, 0x6c, 0xcd, 0x72, 0xbf, 0x82,
-	0xad, 0x1c, 0xee, 0x11, 0xd7, 0x28, 0x03, 0xf4, 0x18, 0x95, 0xd1, 0x3e, 0x72, 0x11, 0xd4, 0x5b,
-	0x1e, 0x8a, 0xb9, 0x1e, 0xa0, 0x0c, 0x98, 0x52, 0x4c, 0xf0, 0x9a, 0xab, 0x52, 0xf1, 0x08, 0x39,
-	0xf8, 0x62, 0x4f, 0x6b, 0x59, 0x2f, 0xe4, 0x4e, 0xa1, 0x10, 0x66, 0x17, 0xd1, 0x32, 0x2c, 0xfb,
-	0x43, 0x58, 0xcf, 0xb9, 0x1c, 0x22, 0xce, 0x95, 0x15, 0x7b, 0x2d, 0x45, 0x1a, 0x50, 0x49, 0x83,
-	0xcc, 0xc5, 0xfe, 0x33, 0xeb, 0x60, 0x03, 0x1a, 0xc5, 0xdb, 0x2a, 0x63, 0xf0, 0x18, 0x9a, 0x4a,
-	0x8c, 0xa5, 0x8b, 0x95, 0x3d, 0x35, 0xb5, 0xb3, 0xb6, 0xe0, 0x9e, 0x19, 0x0d, 0x0b, 0xdd, 0x6d,
-	0xd5, 0x2c, 0xee, 0x99, 0x1e, 0xf7, 0x18, 0x9a, 0x9a, 0xca, 0x11, 0xea, 0xca, 0xf6, 0x96, 0xda,
-	0xc5, 0x61, 0xcd, 0x28, 0x0b, 0x6b, 0xda, 0xef, 0xaa, 0x59, 0x4c, 0xc3, 0x5e, 0xbb, 0xd2, 0x2c,
-	0xcf, 0x5c, 0x69, 0xbe, 0x6f, 0x14, 0x65, 0x66, 0x19, 0xab, 0x49, 0xe6, 0x2e, 0x80, 0xf0, 0xbd,
-	0xe1, 0x9c, 0x52, 0x5b, 0xc2, 0xf7, 0x8e, 0x8d, 0xda, 0x5d, 0x00, 0x8e, 0x67, 0x99, 0x63, 0x55,
-	0x17, 0x6f, 0x71, 0x3c, 0x3b, 0x7e, 0x4d, 0x9a, 0x96, 0xab, 0xd3, 0x34, 0x7b, 0xe3, 0xfc, 0x8b,
-	0xc0, 0x5a, 0x3e, 0x4d, 0x7b, 0xae, 0x8b, 0xe1, 0xff, 0x70, 0x3b, 0x7c, 0x7b, 0x4d, 0xa7, 0x83,
-	0x5f, 0xa0, 0xfb, 0xcf, 0x74, 0x4e, 0x25, 0x34, 0xe6, 0x94, 0x50, 0x79, 0xff, 0xfe, 0x8e, 0xc0,
-	0x5b, 0x85, 0x33, 0x79, 0xf5, 0x20, 0xbc, 0x0d, 0xf4, 0xfa, 0xf8, 0xeb, 0x45, 0x87, 0xbc, 0xba,
-	0xe8, 0x90, 0x3f, 0x2e, 0x3a, 0xe4, 0xe5, 0x65, 0x67, 0xe1, 0xd5, 0x65, 0x67, 0xe1, 0xf7, 0xcb,
-	0xce, 0x02, 0x6c, 0x30, 0xd1, 0xbd, 0xf9, 0x2d, 0x3e, 0x20, 0x9f, 0x75, 0x47, 0x4c, 0x9f, 0x8e,
-	0x4f, 0xba, 0xae, 0x08, 0x7a, 0x53, 0xa3, 0x47, 0x4c, 0xe4, 0x66, 0xbd, 0xf3, 0xab, 0x57, 0xfe,
-	0x49, 0x33, 0x79, 0xa9, 0x7f, 0xf0, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x47, 0x79, 0x48, 0x20,
-	0x03, 0x10, 0x00, 0x00,
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xee, 0x24, 0x6d, 0xb7, 0x79, 0xed, 0x8a, 0x5d, 0x53, 0x4a, 0xc2, 0xb2, 0xa1, 0xb8, 0x97,
+	0x0a, 0x69, 0x13, 0x0a, 0x42, 0x95, 0x96, 0x53, 0xb3, 0x6d, 0xa5, 0x1e, 0x80, 0xc8, 0xed, 0x0a,
+	0x89, 0x4b, 0x34, 0xb5, 0x1f, 0xe9, 0x80, 0x3d, 0x93, 0x9d, 0x99, 0xb8, 0xb5, 0xf8, 0x09, 0x5c,
+	0xf6, 0xc0, 0x0d, 0x8e, 0x70, 0x42, 0xdc, 0x10, 0x7f, 0x80, 0x0b, 0xc7, 0x15, 0x27, 0x8e, 0xa8,
+	0x85, 0xff, 0x81, 0xec, 0xb1, 0x1b, 0xbb, 0xed, 0xc6, 0x11, 0xc8, 0x62, 0xb5, 0xb7, 0x99, 0x97,
+	0xf7, 0xde, 0xf7, 0x7d, 0xcf, 0xcf, 0x6f, 0x26, 0x86, 0x8d, 0x91, 0x14, 0x21, 0x72, 0xca, 0x5d,
+	0xec, 0xe2, 0x99, 0x7b, 0x42, 0xf9, 0x10, 0xbb, 0xe1, 0x56, 0x17, 0x43, 0xe4, 0x5a, 0x75, 0x46,
+	0x52, 0x68, 0x61, 0xad, 0x4d, 0x9c, 0x3a, 0x99, 0x53, 0x27, 0xdc, 0x7a, 0xa3, 0xe5, 0x0a, 0x15,
+	0x08, 0x35, 0x48, 0xbc, 0xba, 0x66, 0x63, 0x42, 0xec, 0xaf, 0x09, 0xdc, 0xdd, 0x8b, 0x73, 0x7c,
+	0x22, 0x3d, 0x94, 0x8f, 0x24, 0x52, 0x8d, 0x9e, 0xd5, 0x82, 0x25, 0x11, 0xef, 0x07, 0xcc, 0x6b,
+	0x92, 0x75, 0xb2, 0x39, 0xef, 0xdc, 0x4a, 0xf6, 0x07, 0x9e, 0x75, 0x1f, 0xc0, 0xfc, 0xa4, 0xa3,
+	0x11, 0x36, 0x6b, 0xeb, 0x64, 0xb3, 0xe1, 0x34, 0x12, 0xcb, 0x51, 0x34, 0x42, 0xeb, 0x1e, 0x34,
+	0x02, 0x2a, 0xbf, 0x44, 0x1d, 0x87, 0xd6, 0xd7, 0xc9, 0xe6, 0x6d, 0x67, 0xc9, 0x18, 0x0e, 0x3c,
+	0xeb, 0x2d, 0x58, 0xc6, 0x33, 0x8d, 0x92, 0x53, 0x3f, 0xfe, 0x79, 0x3e, 0x09, 0x86, 0xcc, 0x74,
+	0xe0, 0xd9, 0x3f, 0x12, 0x78, 0x35, 0xc7, 0x26, 0x16, 0xe2, 0xfb, 0xd3, 0xf9, 0x7c, 0x08, 0x2b,
+	0x6e, 0xe6, 0x37, 0x38, 0x8e, 0x0c, 0xa3, 0x5e, 0xf3, 0xf7, 0x9f, 0x1f, 0xac, 0xa6, 0x42, 0x77,
+	0x3c, 0x4f, 0xa2, 0x52, 0x87, 0x5a, 0x32, 0x3e, 0x74, 0x96, 0x2f, 0xbd, 0x7b, 0xd1, 0x7f, 0x64,
+	0xfb, 0x13, 0x81, 0x3b, 0x13, 0xb6, 0xfb, 0xac, 0x8c, 0xea, 0x1a, 0x2c, 0x52, 0xa5, 0x50, 0xab,
+	0xb4, 0x6c, 0xe9, 0xce, 0x5a, 0x85, 0x85, 0x91, 0x64, 0x2e, 0x26, 0x0c, 0x1a, 0x8e, 0xd9, 0x58,
+	0x16, 0xcc, 0x7f, 0x8e, 0xa8, 0x52, 0xdc, 0x64, 0x5d, 0xe4, 0xbb, 0x30, 0x9d, 0xef, 0xe2, 0x35,
+	0xbe, 0xbf, 0x10, 0x68, 0x4d, 0xf8, 0xf6, 0xa9, 0xd4, 0x8c, 0xfa, 0x7e, 0xf4, 0xe2, 0x13, 0x0f,
+	0xe1, 0xde, 0x84, 0xf7, 0x5e, 0x66, 0xdf, 0x7d, 0x3c, 0xf2, 0xca, 0xba, 0xb5, 0x80, 0x5b, 0x9b,
+	0x8e, 0x5b, 0xbf, 0x86, 0xfb, 0x34, 0x6b, 0xc7, 0xfd, 0x31, 0xf7, 0xd4, 0x23, 0x11, 0x04, 0x4c,
+	0xc7, 0x80, 0xef, 0xc1, 0x2d, 0xea, 0xba, 0x62, 0xcc, 0x75, 0x93, 0x94, 0xb4, 0x5b, 0xe6, 0x38,
+	0x9d, 0x49, 0x5c, 0xe0, 0x20, 0xc9, 0x57, 0x4f, 0x0b, 0x9c, 0xec, 0xac, 0x3b, 0x50, 0xd7, 0x74,
+	0x98, 0x56, 0x32, 0x5e, 0xda, 0xdf, 0x10, 0x78, 0x3d, 0xa1, 0x64, 0xd8, 0x04, 0xc8, 0xb5, 0x83,
+	0x3e, 0x52, 0xf5, 0xff, 0xd2, 0xfa, 0x35, 0xab, 0xd4, 0x47, 0x49, 0xec, 0xa7, 0x4c, 0x9f, 0x78,
+	0x92, 0x9e, 0x16, 0xd3, 0x93, 0xe7, 0xa6, 0xaf, 0x15, 0xd2, 0x3f, 0x84, 0x65, 0x0f, 0x95, 0x66,
+	0x9c, 0x6a, 0x26, 0x78, 0xb3, 0x5e, 0xa2, 0x25, 0xef, 0x1c, 0x8f, 0x83, 0xd3, 0x14, 0x9c, 0xc7,
+	0xe3, 0x60, 0xbe, 0x2c, 0xf8, 0xd2, 0xbb, 0x17, 0xd9, 0x4f, 0xa0, 0x95, 0x13, 0xb1, 0x8b, 0x9a,
+	0x32, 0x5f, 0x65, 0x5d, 0x36, 0x55, 0xca, 0x36, 0xc0, 0xd8, 0xf8, 0xcd, 0x32, 0x83, 0x1a, 0xa9,
+	0x6f, 0x2f, 0xb2, 0x39, 0x58, 0x39, 0xc8, 0x3d, 0x4e, 0x8f, 0xfd, 0xaa, 0xb0, 0x1e, 0xd6, 0x9a,
+	0xc4, 0x16, 0x85, 0xe7, 0xb4, 0xcb, 0x54, 0xd5, 0x80, 0x23, 0x68, 0xe6, 0x00, 0x93, 0x37, 0x58,
+	0x55, 0x2a, 0xf3, 0xca, 0x53, 0x34, 0x88, 0xd5, 0x0a, 0xb5, 0x35, 0xbc, 0x99, 0x83, 0x7c, 0xac,
+	0x50, 0x1e, 0xa2, 0xd6, 0x3e, 0x56, 0x2b, 0x74, 0x0c, 0xf7, 0x6f, 0x44, 0xad, 0x58, 0x6c, 0x11,
+	0x76, 0x32, 0x87, 0x2a, 0x7e, 0xac, 0x21, 0xb4, 0x6f, 0x86, 0xad, 0x58, 0xee, 0x57, 0xb0, 0x91,
+	0xc3, 0x3d, 0xe0, 0x1a, 0x65, 0x80, 0x1e, 0xa3, 0x32, 0xda, 0x45, 0x2e, 0x82, 0x6a, 0xc7, 0xc3,
+	0x0f, 0x04, 0xde, 0xce, 0xa1, 0x3b, 0xf8, 0x05, 0xba, 0xfa, 0x50, 0x53, 0x1f, 0x3f, 0xa6, 0x61,
+	0xb5, 0xa3, 0xc9, 0x7a, 0x07, 0xee, 0xca, 0x04, 0x6f, 0xa0, 0x62, 0xc0, 0x01, 0xa7, 0xa1, 0x4a,
+	0x86, 0xf1, 0x92, 0xf3, 0x8a, 0x2c, 0x12, 0xb9, 0xd2, 0x13, 0x7d, 0x94, 0x01, 0x53, 0x8a, 0x09,
+	0x5e, 0xf1, 0xf4, 0x2c, 0xbe, 0xea, 0x0e, 0x3e, 0xd9, 0xd1, 0x5a, 0x56, 0x0b, 0xb9, 0x55, 0x18,
+	0xd8, 0xd9, 0x85, 0x79, 0x1a, 0x96, 0xfd, 0x01, 0xac, 0xe5, 0x42, 0xf6, 0x11, 0x67, 0xaa, 0x8a,
+	0xbd, 0x9a, 0x22, 0xf5, 0xa9, 0xa4, 0x41, 0x16, 0x62, 0xff, 0x95, 0x9d, 0xb4, 0x7d, 0x1a, 0xc5,
+	0xed, 0x9f, 0x31, 0x78, 0x17, 0x16, 0x95, 0x18, 0x4b, 0x17, 0x4b, 0xcf, 0xfe, 0xd4, 0xcf, 0xda,
+	0x80, 0xdb, 0x66, 0x35, 0x28, 0x9c, 0xc2, 0x2b, 0xc6, 0xb8, 0x93, 0xd8, 0xe2, 0xb4, 0x9a, 0xca,
+	0x21, 0xea, 0xd2, 0x63, 0x38, 0xf5, 0x8b, 0xd3, 0x9a, 0x55, 0x96, 0xd6, 0x5c, 0x13, 0x56, 0x8c,
+	0x31, 0x4d, 0x7b, 0xe5, 0xea, 0xb5, 0x70, 0xed, 0xea, 0xf5, 0x7d, 0xad, 0x28, 0x33, 0xab, 0x58,
+	0x45, 0x32, 0xb7, 0x01, 0x84, 0xef, 0x0d, 0x66, 0x94, 0xda, 0x10, 0xbe, 0x77, 0x64, 0xd4, 0x6e,
+	0x03, 0x70, 0x3c, 0xcd, 0x02, 0xcb, 0x6e, 0x1b, 0x0d, 0x8e, 0xa7, 0x47, 0xcf, 0x29, 0xd3, 0x42,
+	0x79, 0x99, 0xae, 0xdf, 0x8c, 0xff, 0x26, 0xb0, 0x9a, 0x2f, 0xd3, 0x8e, 0xeb, 0xe2, 0xe8, 0x25,
+	0x6c, 0x87, 0x6f, 0xaf, 0xe8, 0x34, 0x83, 0xf0, 0x5f, 0xe9, 0x9c, 0x48, 0xa8, 0xcd, 0x28, 0xa1,
+	0xf4, 0x7f, 0xc2, 0x77, 0x04, 0x5e, 0x2b, 0xbc, 0x93, 0x97, 0x7f, 0x5c, 0x5f, 0x04, 0x7a, 0x3d,
+	0xfc, 0xed, 0xbc, 0x4d, 0x9e, 0x9d, 0xb7, 0xc9, 0x9f, 0xe7, 0x6d, 0xf2, 0xf4, 0xa2, 0x3d, 0xf7,
+	0xec, 0xa2, 0x3d, 0xf7, 0xc7, 0x45, 0x7b, 0x0e, 0x5a, 0x4c, 0x74, 0x6e, 0xfe, 0x66, 0xd0, 0x27,
+	0x9f, 0x75, 0x86, 0x4c, 0x9f, 0x8c, 0x8f, 0x3b, 0xae, 0x08, 0xba, 0x13, 0xa7, 0x07, 0x4c, 0xe4,
+	0x76, 0xdd, 0xb3, 0xcb, 0xaf, 0x11, 0xc7, 0x8b, 0xc9, 0x17, 0x85, 0xf7, 0xff, 0x19, 0x00, 0xfe,
+	0x26, 0x34, 0x5a, 0xab, 0x10, 0x00, 0x00,
 }
 
 func (m *EventOrderCreated) Marshal() (dAtA []byte, err error) {
@@ -2664,6 +2731,51 @@ func (m *EventMarketIntermediaryDenomUpdated) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventMarketRejectStaleNavsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketRejectStaleNavsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketRejectStaleNavsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectStaleNavs {
+		i--
+		if m.RejectStaleNavs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventMarketPermissionsUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3452,6 +3564,25 @@ func (m *EventMarketIntermediaryDenomUpdated) Size() (n int) {
 	return n
 }
 
+func (m *EventMarketRejectStaleNavsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.RejectStaleNavs {
+		n += 2
+	}
+	return n
+}
+
 func (m *EventMarketPermissionsUpdated) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6017,6 +6148,127 @@ func (m *EventMarketIntermediaryDenomUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventMarketRejectStaleNavsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketRejectStaleNavsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketRejectStaleNavsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectStaleNavs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RejectStaleNavs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventMarketPermissionsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
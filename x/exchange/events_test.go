@@ -668,6 +668,22 @@ func TestNewEventMarketIntermediaryDenomUpdated(t *testing.T) {
 	assertEverythingSet(t, event, "EventMarketIntermediaryDenomUpdated")
 }
 
+func TestNewEventMarketRejectStaleNavsUpdated(t *testing.T) {
+	marketID := uint32(4541)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+	reject := true
+
+	var event *EventMarketRejectStaleNavsUpdated
+	testFunc := func() {
+		event = NewEventMarketRejectStaleNavsUpdated(marketID, updatedBy, reject)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketRejectStaleNavsUpdated(%d, %q, %t)", marketID, updatedBy, reject)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assert.Equal(t, reject, event.RejectStaleNavs, "RejectStaleNavs")
+	assertEverythingSet(t, event, "EventMarketRejectStaleNavsUpdated")
+}
+
 func TestNewEventMarketPermissionsUpdated(t *testing.T) {
 	marketID := uint32(5432)
 	updatedBy := sdk.AccAddress("updatedBy___________").String()
@@ -1574,6 +1590,18 @@ func TestTypedEventToEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "EventMarketRejectStaleNavsUpdated",
+			tev:  NewEventMarketRejectStaleNavsUpdated(19, updatedBy, true),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketRejectStaleNavsUpdated",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "19"},
+					{Key: "reject_stale_navs", Value: "true"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
 		{
 			name: "EventMarketPermissionsUpdated",
 			tev:  NewEventMarketPermissionsUpdated(12, updatedBy),
@@ -265,13 +265,18 @@ func (k Keeper) ValidateAndCollectCommitmentCreationFee(ctx sdk.Context, marketI
 }
 
 // lookupNav gets a nav from the provided known navs, or if not known, gets it from the marker or metadata module.
-func (k Keeper) lookupNav(ctx sdk.Context, assetsDenom, priceDenom string, known []exchange.NetAssetPrice) *exchange.NetAssetPrice {
+// If rejectStale is true, an error is returned if the nav from the marker or metadata module is stale.
+func (k Keeper) lookupNav(ctx sdk.Context, assetsDenom, priceDenom string, known []exchange.NetAssetPrice, rejectStale bool) (*exchange.NetAssetPrice, error) {
 	for _, nav := range known {
 		if nav.Assets.Denom == assetsDenom && nav.Price.Denom == priceDenom {
-			return &nav
+			return &nav, nil
 		}
 	}
-	return k.GetNav(ctx, assetsDenom, priceDenom)
+	nav, stale := k.GetNav(ctx, assetsDenom, priceDenom)
+	if stale && rejectStale {
+		return nil, fmt.Errorf("nav from %q to %q is stale", assetsDenom, priceDenom)
+	}
+	return nav, nil
 }
 
 // CalculateCommitmentSettlementFee calculates the fee that the exchange must be paid (by the market) for the provided
@@ -297,9 +302,14 @@ func (k Keeper) CalculateCommitmentSettlementFee(ctx sdk.Context, req *exchange.
 		return nil, fmt.Errorf("market %d does not have an intermediary denom", req.MarketId)
 	}
 
+	rejectStale := isRejectingStaleNavs(store, req.MarketId)
 	feeDenom := pioconfig.GetProvConfig().FeeDenom
 	if convDenom != feeDenom {
-		rv.ToFeeNav = k.lookupNav(ctx, convDenom, feeDenom, req.Navs)
+		var err error
+		rv.ToFeeNav, err = k.lookupNav(ctx, convDenom, feeDenom, req.Navs, rejectStale)
+		if err != nil {
+			return nil, err
+		}
 		if rv.ToFeeNav == nil {
 			return nil, fmt.Errorf("no nav found from intermediary denom %q to fee denom %q", convDenom, feeDenom)
 		}
@@ -323,10 +333,13 @@ func (k Keeper) CalculateCommitmentSettlementFee(ctx sdk.Context, req *exchange.
 		case convDenom:
 			convDecAmt = convDecAmt.Add(sdkmath.LegacyNewDecFromInt(coin.Amount))
 		default:
-			nav := k.lookupNav(ctx, coin.Denom, convDenom, req.Navs)
-			if nav == nil {
+			nav, err := k.lookupNav(ctx, coin.Denom, convDenom, req.Navs, rejectStale)
+			switch {
+			case err != nil:
+				errs = append(errs, err)
+			case nav == nil:
 				errs = append(errs, fmt.Errorf("no nav found from assets denom %q to intermediary denom %q", coin.Denom, convDenom))
-			} else {
+			default:
 				newAmt := sdkmath.LegacyNewDecFromInt(coin.Amount.Mul(nav.Price.Amount)).QuoInt(nav.Assets.Amount)
 				convDecAmt = convDecAmt.Add(newAmt)
 				rv.ConversionNavs = append(rv.ConversionNavs, *nav)
@@ -1665,6 +1665,51 @@ func (s *TestSuite) TestKeeper_CalculateCommitmentSettlementFee() {
 			},
 			expErr: "no nav found from intermediary denom \"cherry\" to fee denom \"nhash\"",
 		},
+		{
+			name: "stale nav from intermediary denom to fee denom: market not rejecting stale navs",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                 3,
+					CommitmentSettlementBips: 10,
+					IntermediaryDenom:        "cherry",
+				})
+			},
+			markerKeeper: NewMockMarkerKeeper().WithGetNetAssetValueStaleResult(s.coin("10cherry"), s.coin("30nhash")),
+			expGetNav:    []*GetNetAssetValueArgs{{markerDenom: "cherry", priceDenom: "nhash"}},
+			req: &exchange.MsgMarketCommitmentSettleRequest{
+				MarketId: 3,
+				Inputs:   []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("20cherry")}},
+				Outputs:  []exchange.AccountAmount{{Account: s.addr3.String(), Amount: s.coins("20cherry")}},
+			},
+			expResp: &exchange.QueryCommitmentSettlementFeeCalcResponse{
+				InputTotal:     s.coins("20cherry"),
+				ConvertedTotal: s.coins("20cherry"),
+				// 20cherry*30nhash/10cherry = 60nhash
+				// 60nhash * 10/20000 = 0.03 => 1nhash
+				ExchangeFees:   s.coins("1nhash"),
+				ConversionNavs: nil,
+				ToFeeNav:       &exchange.NetAssetPrice{Assets: s.coin("10cherry"), Price: s.coin("30nhash")},
+			},
+		},
+		{
+			name: "stale nav from intermediary denom to fee denom: market rejecting stale navs",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                 3,
+					CommitmentSettlementBips: 10,
+					IntermediaryDenom:        "cherry",
+					RejectStaleNavs:          true,
+				})
+			},
+			markerKeeper: NewMockMarkerKeeper().WithGetNetAssetValueStaleResult(s.coin("10cherry"), s.coin("30nhash")),
+			expGetNav:    []*GetNetAssetValueArgs{{markerDenom: "cherry", priceDenom: "nhash"}},
+			req: &exchange.MsgMarketCommitmentSettleRequest{
+				MarketId: 3,
+				Inputs:   []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("10apple")}},
+				Outputs:  []exchange.AccountAmount{{Account: s.addr3.String(), Amount: s.coins("10apple")}},
+			},
+			expErr: "nav from \"cherry\" to \"nhash\" is stale",
+		},
 		{
 			name: "no inputs",
 			setup: func() {
@@ -1726,6 +1771,63 @@ func (s *TestSuite) TestKeeper_CalculateCommitmentSettlementFee() {
 				"no nav found from assets denom \"banana\" to intermediary denom \"cherry\"",
 			),
 		},
+		{
+			name: "two inputs: one stale nav: market not rejecting stale navs",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                 3,
+					CommitmentSettlementBips: 10,
+					IntermediaryDenom:        "cherry",
+				})
+			},
+			markerKeeper: NewMockMarkerKeeper().
+				WithGetNetAssetValueResult(s.coin("10cherry"), s.coin("30nhash")).
+				WithGetNetAssetValueStaleResult(s.coin("1apple"), s.coin("2cherry")),
+			expGetNav: []*GetNetAssetValueArgs{
+				{markerDenom: "cherry", priceDenom: "nhash"},
+				{markerDenom: "apple", priceDenom: "cherry"},
+			},
+			req: &exchange.MsgMarketCommitmentSettleRequest{
+				MarketId: 3,
+				Inputs:   []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("10apple,3cherry")}},
+				Outputs:  []exchange.AccountAmount{{Account: s.addr4.String(), Amount: s.coins("10apple,3cherry")}},
+			},
+			expResp: &exchange.QueryCommitmentSettlementFeeCalcResponse{
+				InputTotal: s.coins("10apple,3cherry"),
+				// 10apple*2cherry/1apple = 20cherry
+				// sum = 23cherry
+				ConvertedTotal: s.coins("23cherry"),
+				// 23cherry*30nhash/10cherry = 69nhash
+				// 69nhash * 10/20000 = 0.0345 => 1nhash
+				ExchangeFees:   s.coins("1nhash"),
+				ConversionNavs: []exchange.NetAssetPrice{{Assets: s.coin("1apple"), Price: s.coin("2cherry")}},
+				ToFeeNav:       &exchange.NetAssetPrice{Assets: s.coin("10cherry"), Price: s.coin("30nhash")},
+			},
+		},
+		{
+			name: "two inputs: one stale nav: market rejecting stale navs",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                 3,
+					CommitmentSettlementBips: 10,
+					IntermediaryDenom:        "cherry",
+					RejectStaleNavs:          true,
+				})
+			},
+			markerKeeper: NewMockMarkerKeeper().
+				WithGetNetAssetValueResult(s.coin("10cherry"), s.coin("30nhash")).
+				WithGetNetAssetValueStaleResult(s.coin("1apple"), s.coin("2cherry")),
+			expGetNav: []*GetNetAssetValueArgs{
+				{markerDenom: "cherry", priceDenom: "nhash"},
+				{markerDenom: "apple", priceDenom: "cherry"},
+			},
+			req: &exchange.MsgMarketCommitmentSettleRequest{
+				MarketId: 3,
+				Inputs:   []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("10apple,3cherry")}},
+				Outputs:  []exchange.AccountAmount{{Account: s.addr4.String(), Amount: s.coins("10apple,3cherry")}},
+			},
+			expErr: "nav from \"apple\" to \"cherry\" is stale",
+		},
 		{
 			name: "intermediary is fee: two inputs",
 			setup: func() {
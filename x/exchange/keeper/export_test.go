@@ -127,6 +127,8 @@ var (
 	SetMarketAcceptingOrders = setMarketAcceptingOrders
 	// SetUserSettlementAllowed is a test-only exposure of setUserSettlementAllowed.
 	SetUserSettlementAllowed = setUserSettlementAllowed
+	// SetRejectingStaleNavs is a test-only exposure of setRejectingStaleNavs.
+	SetRejectingStaleNavs = setRejectingStaleNavs
 	// SetMarketAcceptingCommitments is a test-only exposure of setMarketAcceptingCommitments.
 	SetMarketAcceptingCommitments = setMarketAcceptingCommitments
 	// GrantPermissions is a test-only exposure of grantPermissions.
@@ -442,27 +442,28 @@ func (k Keeper) emitMetadataNAVEvents(ctx sdk.Context, scopeID metadatatypes.Met
 	k.emitEvents(ctx, events)
 }
 
-// GetNav looks up a NAV from the marker or metadata module and returns it as a NetAssetPrice.
-func (k Keeper) GetNav(ctx sdk.Context, assetsDenom, priceDenom string) *exchange.NetAssetPrice {
+// GetNav looks up a NAV from the marker or metadata module and returns it as a NetAssetPrice, along with whether
+// it is stale, i.e. older than the max age defined by the marker or scope. Returns nil if there isn't one.
+func (k Keeper) GetNav(ctx sdk.Context, assetsDenom, priceDenom string) (*exchange.NetAssetPrice, bool) {
 	if strings.HasPrefix(assetsDenom, metadatatypes.DenomPrefix) {
 		// Get the nav from the metadata module.
 		nav, _ := k.metadataKeeper.GetNetAssetValue(ctx, assetsDenom, priceDenom)
 		if nav == nil {
-			return nil
+			return nil, false
 		}
 		return &exchange.NetAssetPrice{
 			Assets: sdk.Coin{Denom: assetsDenom, Amount: sdkmath.NewIntFromUint64(nav.Volume)},
 			Price:  nav.Price,
-		}
+		}, nav.Stale
 	}
 
 	// Look for the nav in the marker module.
 	nav, _ := k.markerKeeper.GetNetAssetValue(ctx, assetsDenom, priceDenom)
 	if nav == nil {
-		return nil
+		return nil, false
 	}
 	return &exchange.NetAssetPrice{
 		Assets: sdk.Coin{Denom: assetsDenom, Amount: sdkmath.NewIntFromUint64(nav.Volume)},
 		Price:  nav.Price,
-	}
+	}, nav.Stale
 }
@@ -2627,6 +2627,7 @@ func (s *TestSuite) TestKeeper_GetNav() {
 		assetsDenom     string
 		priceDenom      string
 		expNav          *exchange.NetAssetPrice
+		expStale        bool
 		expMetadataCall bool
 		expMarkerCall   bool
 	}{
@@ -2657,6 +2658,19 @@ func (s *TestSuite) TestKeeper_GetNav() {
 			},
 			expMarkerCall: true,
 		},
+		{
+			name: "marker: nav is stale",
+			markerKeeper: NewMockMarkerKeeper().
+				WithGetNetAssetValueStaleResult(sdk.NewInt64Coin("apple", 500), sdk.NewInt64Coin("pear", 12)),
+			assetsDenom: "apple",
+			priceDenom:  "pear",
+			expNav: &exchange.NetAssetPrice{
+				Assets: sdk.NewInt64Coin("apple", 500),
+				Price:  sdk.NewInt64Coin("pear", 12),
+			},
+			expStale:      true,
+			expMarkerCall: true,
+		},
 		{
 			name:            "metadata: error getting nav",
 			metadataKeeper:  NewMockMetadataKeeper().WithGetNetAssetValueErrors("injected test problem"),
@@ -2683,6 +2697,18 @@ func (s *TestSuite) TestKeeper_GetNav() {
 			},
 			expMetadataCall: true,
 		},
+		{
+			name:           "metadata: nav is stale",
+			metadataKeeper: NewMockMetadataKeeper().WithGetNetAssetValueStaleResult(sdk.NewInt64Coin("pear", 53)),
+			assetsDenom:    scopeDenom,
+			priceDenom:     "pear",
+			expNav: &exchange.NetAssetPrice{
+				Assets: sdk.NewInt64Coin(scopeDenom, 1),
+				Price:  sdk.NewInt64Coin("pear", 53),
+			},
+			expStale:        true,
+			expMetadataCall: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -2705,14 +2731,16 @@ func (s *TestSuite) TestKeeper_GetNav() {
 
 			kpr := s.k.WithMarkerKeeper(tc.markerKeeper).WithMetadataKeeper(tc.metadataKeeper)
 			var actNav *exchange.NetAssetPrice
+			var actStale bool
 			testFunc := func() {
-				actNav = kpr.GetNav(s.ctx, tc.assetsDenom, tc.priceDenom)
+				actNav, actStale = kpr.GetNav(s.ctx, tc.assetsDenom, tc.priceDenom)
 			}
 			s.Require().NotPanics(testFunc, "GetNav(%q, %q)", tc.assetsDenom, tc.priceDenom)
 			if !s.Assert().Equal(tc.expNav, actNav, "GetNav(%q, %q) result", tc.assetsDenom, tc.priceDenom) && tc.expNav != nil && actNav != nil {
 				s.Assert().Equal(tc.expNav.Assets.String(), actNav.Assets.String(), "assets (string)")
 				s.Assert().Equal(tc.expNav.Price.String(), actNav.Price.String(), "price (string)")
 			}
+			s.Assert().Equal(tc.expStale, actStale, "GetNav(%q, %q) stale", tc.assetsDenom, tc.priceDenom)
 			s.assertMetadataKeeperCalls(tc.metadataKeeper, expMetadataCalls, "GetNav(%q, %q)", tc.assetsDenom, tc.priceDenom)
 			s.assertMarkerKeeperCalls(tc.markerKeeper, expMarkerCalls, "GetNav(%q, %q)", tc.assetsDenom, tc.priceDenom)
 		})
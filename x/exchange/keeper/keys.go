@@ -144,6 +144,8 @@ const (
 	MarketKeyTypeCommitmentSettlementBips = byte(0x12)
 	// MarketKeyTypeIntermediaryDenom is the market-specific type byte for the intermediary denom used in fee calcs.
 	MarketKeyTypeIntermediaryDenom = byte(0x13)
+	// MarketKeyTypeRejectStaleNavs is the market-specific type byte for the reject-stale-navs indicators.
+	MarketKeyTypeRejectStaleNavs = byte(0x14)
 
 	// OrderKeyTypeAsk is the order-specific type byte for ask orders.
 	OrderKeyTypeAsk = exchange.OrderTypeByteAsk
@@ -613,6 +615,11 @@ func MakeKeyMarketIntermediaryDenom(marketID uint32) []byte {
 	return keyPrefixMarketType(marketID, MarketKeyTypeIntermediaryDenom, 0)
 }
 
+// MakeKeyMarketRejectStaleNavs creates the key to use to indicate that a market rejects stale navs.
+func MakeKeyMarketRejectStaleNavs(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeRejectStaleNavs, 0)
+}
+
 // keyPrefixOrder creates the key prefix for orders with the provided extra capacity for additional elements.
 func keyPrefixOrder(extraCap int) []byte {
 	return prepKey(KeyTypeOrder, nil, extraCap)
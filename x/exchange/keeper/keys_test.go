@@ -125,6 +125,7 @@ func TestKeyTypeUniqueness(t *testing.T) {
 				{name: "MarketKeyTypeCreateCommitmentFlat", value: keeper.MarketKeyTypeCreateCommitmentFlat},
 				{name: "MarketKeyTypeCommitmentSettlementBips", value: keeper.MarketKeyTypeCommitmentSettlementBips},
 				{name: "MarketKeyTypeIntermediaryDenom", value: keeper.MarketKeyTypeIntermediaryDenom},
+				{name: "MarketKeyTypeRejectStaleNavs", value: keeper.MarketKeyTypeRejectStaleNavs},
 			},
 		},
 		{
@@ -3231,6 +3232,72 @@ func TestMakeKeyMarketIntermediaryDenom(t *testing.T) {
 	}
 }
 
+func TestMakeKeyMarketRejectStaleNavs(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeRejectStaleNavs
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
+		},
+		{
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketRejectStaleNavs(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketRejectStaleNavs(%d)", tc.marketID)
+		})
+	}
+}
+
 func TestGetKeyPrefixOrder(t *testing.T) {
 	ktc := keyTestCase{
 		maker: func() []byte {
@@ -879,6 +879,22 @@ func setMarketAcceptingCommitments(store storetypes.KVStore, marketID uint32, al
 	}
 }
 
+// isRejectingStaleNavs gets whether a market rejects stale navs.
+func isRejectingStaleNavs(store storetypes.KVStore, marketID uint32) bool {
+	key := MakeKeyMarketRejectStaleNavs(marketID)
+	return store.Has(key)
+}
+
+// setRejectingStaleNavs sets whether a market rejects stale navs.
+func setRejectingStaleNavs(store storetypes.KVStore, marketID uint32, reject bool) {
+	key := MakeKeyMarketRejectStaleNavs(marketID)
+	if reject {
+		store.Set(key, []byte{})
+	} else {
+		store.Delete(key)
+	}
+}
+
 // IsMarketKnown returns true if the provided market id is a known market's id.
 func (k Keeper) IsMarketKnown(ctx sdk.Context, marketID uint32) bool {
 	return isMarketKnown(k.getStore(ctx), marketID)
@@ -924,6 +940,24 @@ func (k Keeper) UpdateUserSettlementAllowed(ctx sdk.Context, marketID uint32, al
 	return nil
 }
 
+// IsRejectingStaleNavs gets whether a market rejects stale navs.
+func (k Keeper) IsRejectingStaleNavs(ctx sdk.Context, marketID uint32) bool {
+	return isRejectingStaleNavs(k.getStore(ctx), marketID)
+}
+
+// UpdateRejectStaleNavs updates the reject-stale-navs flag for a market.
+// An error is returned if the setting is already what is provided.
+func (k Keeper) UpdateRejectStaleNavs(ctx sdk.Context, marketID uint32, reject bool, updatedBy string) error {
+	store := k.getStore(ctx)
+	current := isRejectingStaleNavs(store, marketID)
+	if current == reject {
+		return fmt.Errorf("market %d already has reject-stale-navs %t", marketID, reject)
+	}
+	setRejectingStaleNavs(store, marketID, reject)
+	k.emitEvent(ctx, exchange.NewEventMarketRejectStaleNavsUpdated(marketID, updatedBy, reject))
+	return nil
+}
+
 // IsMarketAcceptingCommitments gets whether commitments are allowed for a market.
 func (k Keeper) IsMarketAcceptingCommitments(ctx sdk.Context, marketID uint32) bool {
 	return isMarketAcceptingCommitments(k.getStore(ctx), marketID)
@@ -1420,6 +1454,7 @@ func storeMarket(store storetypes.KVStore, market exchange.Market) {
 	setMarketAcceptingCommitments(store, marketID, market.AcceptingCommitments)
 	setCommitmentSettlementBips(store, marketID, market.CommitmentSettlementBips)
 	setIntermediaryDenom(store, marketID, market.IntermediaryDenom)
+	setRejectingStaleNavs(store, marketID, market.RejectStaleNavs)
 }
 
 // initMarket is similar to CreateMarket but assumes the market has already been
@@ -1512,6 +1547,7 @@ func (k Keeper) GetMarket(ctx sdk.Context, marketID uint32) *exchange.Market {
 	market.AcceptingCommitments = isMarketAcceptingCommitments(store, marketID)
 	market.CommitmentSettlementBips = getCommitmentSettlementBips(store, marketID)
 	market.IntermediaryDenom = getIntermediaryDenom(store, marketID)
+	market.RejectStaleNavs = isRejectingStaleNavs(store, marketID)
 
 	if marketAcc := k.GetMarketAccount(ctx, marketID); marketAcc != nil {
 		market.MarketDetails = marketAcc.MarketDetails
@@ -1585,8 +1621,11 @@ func (k Keeper) ValidateMarket(ctx sdk.Context, marketID uint32) error {
 
 	if len(convDenom) > 0 {
 		feeDenom := pioconfig.GetProvConfig().FeeDenom
-		feeNav := k.GetNav(ctx, convDenom, feeDenom)
-		if feeNav == nil {
+		feeNav, stale := k.GetNav(ctx, convDenom, feeDenom)
+		switch {
+		case stale && isRejectingStaleNavs(store, marketID):
+			errs = append(errs, fmt.Errorf("nav from intermediary denom %q to fee denom %q is stale", convDenom, feeDenom))
+		case feeNav == nil:
 			errs = append(errs, fmt.Errorf("no nav exists from intermediary denom %q to fee denom %q", convDenom, feeDenom))
 		}
 	}
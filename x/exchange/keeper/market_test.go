@@ -4268,6 +4268,188 @@ func (s *TestSuite) TestKeeper_UpdateUserSettlementAllowed() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_IsRejectingStaleNavs() {
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected bool
+	}{
+		{
+			name:     "empty state",
+			marketID: 1,
+			expected: false,
+		},
+		{
+			name: "unknown market id",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetRejectingStaleNavs(store, 1, true)
+				keeper.SetRejectingStaleNavs(store, 3, true)
+			},
+			marketID: 2,
+			expected: false,
+		},
+		{
+			name: "not rejecting",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetRejectingStaleNavs(store, 1, true)
+				keeper.SetRejectingStaleNavs(store, 2, false)
+				keeper.SetRejectingStaleNavs(store, 3, true)
+			},
+			marketID: 2,
+			expected: false,
+		},
+		{
+			name: "rejecting",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetRejectingStaleNavs(store, 1, true)
+				keeper.SetRejectingStaleNavs(store, 2, true)
+				keeper.SetRejectingStaleNavs(store, 3, true)
+			},
+			marketID: 2,
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual bool
+			testFunc := func() {
+				actual = s.k.IsRejectingStaleNavs(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "IsRejectingStaleNavs(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected, actual, "IsRejectingStaleNavs(%d) result", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_UpdateRejectStaleNavs() {
+	tests := []struct {
+		name      string
+		setup     func()
+		marketID  uint32
+		reject    bool
+		updatedBy string
+		expErr    string
+	}{
+		{
+			name:      "empty state to rejecting",
+			marketID:  1,
+			reject:    true,
+			updatedBy: "updatedBy___________",
+			expErr:    "",
+		},
+		{
+			name:      "empty state to not rejecting",
+			marketID:  1,
+			reject:    false,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 1 already has reject-stale-navs false",
+		},
+		{
+			name: "rejecting to rejecting",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetRejectingStaleNavs(store, 1, true)
+				keeper.SetRejectingStaleNavs(store, 2, false)
+				keeper.SetRejectingStaleNavs(store, 3, true)
+				keeper.SetRejectingStaleNavs(store, 4, true)
+				keeper.SetRejectingStaleNavs(store, 5, false)
+			},
+			marketID:  3,
+			reject:    true,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 3 already has reject-stale-navs true",
+		},
+		{
+			name: "rejecting to not rejecting",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetRejectingStaleNavs(store, 1, true)
+				keeper.SetRejectingStaleNavs(store, 2, false)
+				keeper.SetRejectingStaleNavs(store, 3, true)
+				keeper.SetRejectingStaleNavs(store, 4, true)
+				keeper.SetRejectingStaleNavs(store, 5, false)
+			},
+			marketID:  3,
+			reject:    false,
+			updatedBy: "updated_by__________",
+			expErr:    "",
+		},
+		{
+			name: "not rejecting to rejecting",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetRejectingStaleNavs(store, 11, true)
+				keeper.SetRejectingStaleNavs(store, 12, false)
+				keeper.SetRejectingStaleNavs(store, 13, false)
+				keeper.SetRejectingStaleNavs(store, 14, true)
+				keeper.SetRejectingStaleNavs(store, 15, false)
+			},
+			marketID:  13,
+			reject:    true,
+			updatedBy: "updated___by________",
+			expErr:    "",
+		},
+		{
+			name: "not rejecting to not rejecting",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetRejectingStaleNavs(store, 11, true)
+				keeper.SetRejectingStaleNavs(store, 12, false)
+				keeper.SetRejectingStaleNavs(store, 13, false)
+				keeper.SetRejectingStaleNavs(store, 14, true)
+				keeper.SetRejectingStaleNavs(store, 15, false)
+			},
+			marketID:  13,
+			reject:    false,
+			updatedBy: "__updated_____by____",
+			expErr:    "market 13 already has reject-stale-navs false",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				event := exchange.NewEventMarketRejectStaleNavsUpdated(tc.marketID, tc.updatedBy, tc.reject)
+				expEvents = append(expEvents, s.untypeEvent(event))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.k.UpdateRejectStaleNavs(ctx, tc.marketID, tc.reject, tc.updatedBy)
+			}
+			s.Require().NotPanics(testFunc, "UpdateRejectStaleNavs(%d, %t, %s)", tc.marketID, tc.reject, tc.updatedBy)
+			s.assertErrorValue(err, tc.expErr, "UpdateRejectStaleNavs(%d, %t, %s)", tc.marketID, tc.reject, tc.updatedBy)
+
+			events := em.Events()
+			s.assertEqualEvents(expEvents, events, "events after UpdateRejectStaleNavs")
+
+			if len(tc.expErr) == 0 {
+				isActive := s.k.IsRejectingStaleNavs(s.ctx, tc.marketID)
+				s.Assert().Equal(tc.reject, isActive, "IsRejectingStaleNavs(%d) after UpdateRejectStaleNavs(%d, %t, ...)",
+					tc.marketID, tc.marketID, tc.reject)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestKeeper_IsMarketAcceptingCommitments() {
 	setter := keeper.SetMarketAcceptingCommitments
 	tests := []struct {
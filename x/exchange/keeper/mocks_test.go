@@ -802,6 +802,15 @@ func (k *MockMarkerKeeper) WithGetNetAssetValueResult(markerCoin, priceCoin sdk.
 	return k
 }
 
+// WithGetNetAssetValueStaleResult sets up this mock keepr to return the provided nav result, flagged as stale,
+// when GetNetAssetValue is called for the given denoms.
+// This method both updates the receiver and returns it.
+func (k *MockMarkerKeeper) WithGetNetAssetValueStaleResult(markerCoin, priceCoin sdk.Coin) *MockMarkerKeeper {
+	k.WithGetNetAssetValueResult(markerCoin, priceCoin)
+	k.GetNetAssetValueMap[markerCoin.Denom][priceCoin.Denom].nav.Stale = true
+	return k
+}
+
 // WithGetNetAssetValueError sets up this mock keepr to return the provided error when GetNetAssetValue is called for the given denoms.
 // This method both updates the receiver and returns it.
 func (k *MockMarkerKeeper) WithGetNetAssetValueError(markerDenom, priceDenom, errMsg string) *MockMarkerKeeper {
@@ -978,6 +987,14 @@ func (k *MockMetadataKeeper) WithGetNetAssetValueResult(price sdk.Coin) *MockMet
 	return k
 }
 
+// WithGetNetAssetValueStaleResult queues up a nav result, flagged as stale, to be returned from GetNetAssetValue.
+// This method both updates the receiver and returns it.
+func (k *MockMetadataKeeper) WithGetNetAssetValueStaleResult(price sdk.Coin) *MockMetadataKeeper {
+	k.GetNetAssetValueResultsQueue = append(k.GetNetAssetValueResultsQueue,
+		NewMDGetNetAssetValueResult(&metadatatypes.NetAssetValue{Price: price, Volume: 1, Stale: true}, ""))
+	return k
+}
+
 func (k *MockMetadataKeeper) AddSetNetAssetValues(_ sdk.Context, scopeID metadatatypes.MetadataAddress, navs []metadatatypes.NetAssetValue, source string) error {
 	k.Calls.WithAddSetNetAssetValues(scopeID, navs, source)
 	if len(k.AddSetNetAssetValuesResultsQueue) > 0 {
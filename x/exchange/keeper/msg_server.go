@@ -292,6 +292,19 @@ func (k MsgServer) MarketUpdateIntermediaryDenom(goCtx context.Context, msg *exc
 	return &exchange.MsgMarketUpdateIntermediaryDenomResponse{}, nil
 }
 
+// MarketUpdateRejectStaleNavs is a market endpoint to update whether it rejects stale navs.
+func (k MsgServer) MarketUpdateRejectStaleNavs(goCtx context.Context, msg *exchange.MsgMarketUpdateRejectStaleNavsRequest) (*exchange.MsgMarketUpdateRejectStaleNavsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdateRejectStaleNavs(ctx, msg.MarketId, msg.RejectStaleNavs, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketUpdateRejectStaleNavsResponse{}, nil
+}
+
 // MarketManagePermissions is a market endpoint to manage a market's user permissions.
 func (k MsgServer) MarketManagePermissions(goCtx context.Context, msg *exchange.MsgMarketManagePermissionsRequest) (*exchange.MsgMarketManagePermissionsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -4761,6 +4761,107 @@ func (s *TestSuite) TestMsgServer_MarketUpdateIntermediaryDenom() {
 	}
 }
 
+func (s *TestSuite) TestMsgServer_MarketUpdateRejectStaleNavs() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateRejectStaleNavsRequest, exchange.MsgMarketUpdateRejectStaleNavsResponse, struct{}]{
+		endpointName: "MarketUpdateRejectStaleNavs",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateRejectStaleNavs,
+		expResp:      &exchange.MsgMarketUpdateRejectStaleNavsResponse{},
+		followup: func(msg *exchange.MsgMarketUpdateRejectStaleNavsRequest, _ struct{}) {
+			rejecting := s.k.IsRejectingStaleNavs(s.ctx, msg.MarketId)
+			s.Assert().Equal(msg.RejectStaleNavs, rejecting, "IsRejectingStaleNavs(%d)", msg.MarketId)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgMarketUpdateRejectStaleNavsRequest, struct{}]{
+		{
+			name: "admin does not have permission to update market",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
+				})
+			},
+			msg: exchange.MsgMarketUpdateRejectStaleNavsRequest{
+				Admin:           s.addr5.String(),
+				MarketId:        3,
+				RejectStaleNavs: true,
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to update market 3"},
+		},
+		{
+			name: "false to false",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					RejectStaleNavs: false,
+				})
+			},
+			msg: exchange.MsgMarketUpdateRejectStaleNavsRequest{
+				Admin:           s.addr5.String(),
+				MarketId:        3,
+				RejectStaleNavs: false,
+			},
+			expInErr: []string{invReqErr, "market 3 already has reject-stale-navs false"},
+		},
+		{
+			name: "true to true",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					RejectStaleNavs: true,
+				})
+			},
+			msg: exchange.MsgMarketUpdateRejectStaleNavsRequest{
+				Admin:           s.addr5.String(),
+				MarketId:        3,
+				RejectStaleNavs: true,
+			},
+			expInErr: []string{invReqErr, "market 3 already has reject-stale-navs true"},
+		},
+		{
+			name: "false to true",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					RejectStaleNavs: false,
+				})
+			},
+			msg: exchange.MsgMarketUpdateRejectStaleNavsRequest{
+				Admin:           s.addr5.String(),
+				MarketId:        3,
+				RejectStaleNavs: true,
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketRejectStaleNavsUpdated{MarketId: 3, UpdatedBy: s.addr5.String(), RejectStaleNavs: true}),
+			},
+		},
+		{
+			name: "true to false",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					RejectStaleNavs: true,
+				})
+			},
+			msg: exchange.MsgMarketUpdateRejectStaleNavsRequest{
+				Admin:           s.addr5.String(),
+				MarketId:        3,
+				RejectStaleNavs: false,
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketRejectStaleNavsUpdated{MarketId: 3, UpdatedBy: s.addr5.String(), RejectStaleNavs: false}),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
 func (s *TestSuite) TestMsgServer_MarketManagePermissions() {
 	testDef := msgServerTestDef[exchange.MsgMarketManagePermissionsRequest, exchange.MsgMarketManagePermissionsResponse, []exchange.AccessGrant]{
 		endpointName: "MarketManagePermissions",
@@ -36,6 +36,7 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgMarketUpdateUserSettleRequest)(nil),
 	(*MsgMarketUpdateAcceptingCommitmentsRequest)(nil),
 	(*MsgMarketUpdateIntermediaryDenomRequest)(nil),
+	(*MsgMarketUpdateRejectStaleNavsRequest)(nil),
 	(*MsgMarketManagePermissionsRequest)(nil),
 	(*MsgMarketManageReqAttrsRequest)(nil),
 	(*MsgCreatePaymentRequest)(nil),
@@ -553,6 +554,17 @@ func (m MsgMarketUpdateIntermediaryDenomRequest) ValidateBasic() error {
 	return errors.Join(errs...)
 }
 
+func (m MsgMarketUpdateRejectStaleNavsRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	return errors.Join(errs...)
+}
+
 func (m MsgMarketManagePermissionsRequest) ValidateBasic() error {
 	var errs []error
 
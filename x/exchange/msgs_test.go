@@ -48,6 +48,7 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgMarketUpdateUserSettleRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateAcceptingCommitmentsRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateIntermediaryDenomRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketUpdateRejectStaleNavsRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketManagePermissionsRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketManageReqAttrsRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgCreatePaymentRequest{Payment: Payment{Source: signer}} },
@@ -2144,6 +2145,71 @@ func TestMsgMarketUpdateIntermediaryDenomRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgMarketUpdateRejectStaleNavsRequest_ValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketUpdateRejectStaleNavsRequest
+		expErr []string
+	}{
+		{
+			name: "control: true",
+			msg: MsgMarketUpdateRejectStaleNavsRequest{
+				Admin:           admin,
+				MarketId:        1,
+				RejectStaleNavs: true,
+			},
+			expErr: nil,
+		},
+		{
+			name: "control: false",
+			msg: MsgMarketUpdateRejectStaleNavsRequest{
+				Admin:           admin,
+				MarketId:        1,
+				RejectStaleNavs: false,
+			},
+			expErr: nil,
+		},
+		{
+			name: "empty admin",
+			msg: MsgMarketUpdateRejectStaleNavsRequest{
+				Admin:    "",
+				MarketId: 1,
+			},
+			expErr: []string{
+				`invalid administrator ""`, emptyAddrErr,
+			},
+		},
+		{
+			name: "bad admin",
+			msg: MsgMarketUpdateRejectStaleNavsRequest{
+				Admin:    "badadmin",
+				MarketId: 1,
+			},
+			expErr: []string{
+				`invalid administrator "badadmin"`, bech32Err,
+			},
+		},
+		{
+			name: "market id zero",
+			msg: MsgMarketUpdateRejectStaleNavsRequest{
+				Admin:    admin,
+				MarketId: 0,
+			},
+			expErr: []string{
+				"invalid market id", "cannot be zero",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
 func TestMsgMarketManagePermissionsRequest_ValidateBasic(t *testing.T) {
 	goodAdminAddr := sdk.AccAddress("goodAdminAddr_______").String()
 	goodAddr1 := sdk.AccAddress("goodAddr1___________").String()
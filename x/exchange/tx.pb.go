@@ -2067,6 +2067,110 @@ func (m *MsgMarketUpdateIntermediaryDenomResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMarketUpdateIntermediaryDenomResponse proto.InternalMessageInfo
 
+// MsgMarketUpdateRejectStaleNavsRequest is a request message for the MarketUpdateRejectStaleNavs endpoint.
+type MsgMarketUpdateRejectStaleNavsRequest struct {
+	// admin is the account with "update" permission requesting this change.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// market_id is the numerical identifier of the market to update.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// reject_stale_navs is whether this market rejects stale NAVs from the marker or metadata module
+	// when calculating commitment settlement fees.
+	RejectStaleNavs bool `protobuf:"varint,3,opt,name=reject_stale_navs,json=rejectStaleNavs,proto3" json:"reject_stale_navs,omitempty"`
+}
+
+func (m *MsgMarketUpdateRejectStaleNavsRequest) Reset()         { *m = MsgMarketUpdateRejectStaleNavsRequest{} }
+func (m *MsgMarketUpdateRejectStaleNavsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateRejectStaleNavsRequest) ProtoMessage()    {}
+func (*MsgMarketUpdateRejectStaleNavsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{36}
+}
+func (m *MsgMarketUpdateRejectStaleNavsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMarketUpdateRejectStaleNavsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMarketUpdateRejectStaleNavsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMarketUpdateRejectStaleNavsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateRejectStaleNavsRequest.Merge(m, src)
+}
+func (m *MsgMarketUpdateRejectStaleNavsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMarketUpdateRejectStaleNavsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateRejectStaleNavsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMarketUpdateRejectStaleNavsRequest proto.InternalMessageInfo
+
+func (m *MsgMarketUpdateRejectStaleNavsRequest) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgMarketUpdateRejectStaleNavsRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *MsgMarketUpdateRejectStaleNavsRequest) GetRejectStaleNavs() bool {
+	if m != nil {
+		return m.RejectStaleNavs
+	}
+	return false
+}
+
+// MsgMarketUpdateRejectStaleNavsResponse is a response message for the MarketUpdateRejectStaleNavs endpoint.
+type MsgMarketUpdateRejectStaleNavsResponse struct {
+}
+
+func (m *MsgMarketUpdateRejectStaleNavsResponse) Reset() {
+	*m = MsgMarketUpdateRejectStaleNavsResponse{}
+}
+func (m *MsgMarketUpdateRejectStaleNavsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateRejectStaleNavsResponse) ProtoMessage()    {}
+func (*MsgMarketUpdateRejectStaleNavsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{37}
+}
+func (m *MsgMarketUpdateRejectStaleNavsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMarketUpdateRejectStaleNavsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMarketUpdateRejectStaleNavsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMarketUpdateRejectStaleNavsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateRejectStaleNavsResponse.Merge(m, src)
+}
+func (m *MsgMarketUpdateRejectStaleNavsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMarketUpdateRejectStaleNavsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateRejectStaleNavsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMarketUpdateRejectStaleNavsResponse proto.InternalMessageInfo
+
 // MsgMarketManagePermissionsRequest is a request message for the MarketManagePermissions endpoint.
 type MsgMarketManagePermissionsRequest struct {
 	// admin is the account with "permissions" permission requesting this change.
@@ -2085,7 +2189,7 @@ func (m *MsgMarketManagePermissionsRequest) Reset()         { *m = MsgMarketMana
 func (m *MsgMarketManagePermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketManagePermissionsRequest) ProtoMessage()    {}
 func (*MsgMarketManagePermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{36}
+	return fileDescriptor_e333fcffc093bd1b, []int{38}
 }
 func (m *MsgMarketManagePermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2157,7 +2261,7 @@ func (m *MsgMarketManagePermissionsResponse) Reset()         { *m = MsgMarketMan
 func (m *MsgMarketManagePermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketManagePermissionsResponse) ProtoMessage()    {}
 func (*MsgMarketManagePermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{37}
+	return fileDescriptor_e333fcffc093bd1b, []int{39}
 }
 func (m *MsgMarketManagePermissionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2210,7 +2314,7 @@ func (m *MsgMarketManageReqAttrsRequest) Reset()         { *m = MsgMarketManageR
 func (m *MsgMarketManageReqAttrsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketManageReqAttrsRequest) ProtoMessage()    {}
 func (*MsgMarketManageReqAttrsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{38}
+	return fileDescriptor_e333fcffc093bd1b, []int{40}
 }
 func (m *MsgMarketManageReqAttrsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2303,7 +2407,7 @@ func (m *MsgMarketManageReqAttrsResponse) Reset()         { *m = MsgMarketManage
 func (m *MsgMarketManageReqAttrsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketManageReqAttrsResponse) ProtoMessage()    {}
 func (*MsgMarketManageReqAttrsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{39}
+	return fileDescriptor_e333fcffc093bd1b, []int{41}
 }
 func (m *MsgMarketManageReqAttrsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2342,7 +2446,7 @@ func (m *MsgCreatePaymentRequest) Reset()         { *m = MsgCreatePaymentRequest
 func (m *MsgCreatePaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgCreatePaymentRequest) ProtoMessage()    {}
 func (*MsgCreatePaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{40}
+	return fileDescriptor_e333fcffc093bd1b, []int{42}
 }
 func (m *MsgCreatePaymentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2386,7 +2490,7 @@ func (m *MsgCreatePaymentResponse) Reset()         { *m = MsgCreatePaymentRespon
 func (m *MsgCreatePaymentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreatePaymentResponse) ProtoMessage()    {}
 func (*MsgCreatePaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{41}
+	return fileDescriptor_e333fcffc093bd1b, []int{43}
 }
 func (m *MsgCreatePaymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2425,7 +2529,7 @@ func (m *MsgAcceptPaymentRequest) Reset()         { *m = MsgAcceptPaymentRequest
 func (m *MsgAcceptPaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgAcceptPaymentRequest) ProtoMessage()    {}
 func (*MsgAcceptPaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{42}
+	return fileDescriptor_e333fcffc093bd1b, []int{44}
 }
 func (m *MsgAcceptPaymentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2469,7 +2573,7 @@ func (m *MsgAcceptPaymentResponse) Reset()         { *m = MsgAcceptPaymentRespon
 func (m *MsgAcceptPaymentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAcceptPaymentResponse) ProtoMessage()    {}
 func (*MsgAcceptPaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{43}
+	return fileDescriptor_e333fcffc093bd1b, []int{45}
 }
 func (m *MsgAcceptPaymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2512,7 +2616,7 @@ func (m *MsgRejectPaymentRequest) Reset()         { *m = MsgRejectPaymentRequest
 func (m *MsgRejectPaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgRejectPaymentRequest) ProtoMessage()    {}
 func (*MsgRejectPaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{44}
+	return fileDescriptor_e333fcffc093bd1b, []int{46}
 }
 func (m *MsgRejectPaymentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2570,7 +2674,7 @@ func (m *MsgRejectPaymentResponse) Reset()         { *m = MsgRejectPaymentRespon
 func (m *MsgRejectPaymentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRejectPaymentResponse) ProtoMessage()    {}
 func (*MsgRejectPaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{45}
+	return fileDescriptor_e333fcffc093bd1b, []int{47}
 }
 func (m *MsgRejectPaymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2611,7 +2715,7 @@ func (m *MsgRejectPaymentsRequest) Reset()         { *m = MsgRejectPaymentsReque
 func (m *MsgRejectPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgRejectPaymentsRequest) ProtoMessage()    {}
 func (*MsgRejectPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{46}
+	return fileDescriptor_e333fcffc093bd1b, []int{48}
 }
 func (m *MsgRejectPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2662,7 +2766,7 @@ func (m *MsgRejectPaymentsResponse) Reset()         { *m = MsgRejectPaymentsResp
 func (m *MsgRejectPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRejectPaymentsResponse) ProtoMessage()    {}
 func (*MsgRejectPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{47}
+	return fileDescriptor_e333fcffc093bd1b, []int{49}
 }
 func (m *MsgRejectPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2703,7 +2807,7 @@ func (m *MsgCancelPaymentsRequest) Reset()         { *m = MsgCancelPaymentsReque
 func (m *MsgCancelPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelPaymentsRequest) ProtoMessage()    {}
 func (*MsgCancelPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{48}
+	return fileDescriptor_e333fcffc093bd1b, []int{50}
 }
 func (m *MsgCancelPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2754,7 +2858,7 @@ func (m *MsgCancelPaymentsResponse) Reset()         { *m = MsgCancelPaymentsResp
 func (m *MsgCancelPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelPaymentsResponse) ProtoMessage()    {}
 func (*MsgCancelPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{49}
+	return fileDescriptor_e333fcffc093bd1b, []int{51}
 }
 func (m *MsgCancelPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2797,7 +2901,7 @@ func (m *MsgChangePaymentTargetRequest) Reset()         { *m = MsgChangePaymentT
 func (m *MsgChangePaymentTargetRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgChangePaymentTargetRequest) ProtoMessage()    {}
 func (*MsgChangePaymentTargetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{50}
+	return fileDescriptor_e333fcffc093bd1b, []int{52}
 }
 func (m *MsgChangePaymentTargetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2855,7 +2959,7 @@ func (m *MsgChangePaymentTargetResponse) Reset()         { *m = MsgChangePayment
 func (m *MsgChangePaymentTargetResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChangePaymentTargetResponse) ProtoMessage()    {}
 func (*MsgChangePaymentTargetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{51}
+	return fileDescriptor_e333fcffc093bd1b, []int{53}
 }
 func (m *MsgChangePaymentTargetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2898,7 +3002,7 @@ func (m *MsgGovCreateMarketRequest) Reset()         { *m = MsgGovCreateMarketReq
 func (m *MsgGovCreateMarketRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgGovCreateMarketRequest) ProtoMessage()    {}
 func (*MsgGovCreateMarketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{52}
+	return fileDescriptor_e333fcffc093bd1b, []int{54}
 }
 func (m *MsgGovCreateMarketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2949,7 +3053,7 @@ func (m *MsgGovCreateMarketResponse) Reset()         { *m = MsgGovCreateMarketRe
 func (m *MsgGovCreateMarketResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovCreateMarketResponse) ProtoMessage()    {}
 func (*MsgGovCreateMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{53}
+	return fileDescriptor_e333fcffc093bd1b, []int{55}
 }
 func (m *MsgGovCreateMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3024,7 +3128,7 @@ func (m *MsgGovManageFeesRequest) Reset()         { *m = MsgGovManageFeesRequest
 func (m *MsgGovManageFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgGovManageFeesRequest) ProtoMessage()    {}
 func (*MsgGovManageFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{54}
+	return fileDescriptor_e333fcffc093bd1b, []int{56}
 }
 func (m *MsgGovManageFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3187,7 +3291,7 @@ func (m *MsgGovManageFeesResponse) Reset()         { *m = MsgGovManageFeesRespon
 func (m *MsgGovManageFeesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovManageFeesResponse) ProtoMessage()    {}
 func (*MsgGovManageFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{55}
+	return fileDescriptor_e333fcffc093bd1b, []int{57}
 }
 func (m *MsgGovManageFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3228,7 +3332,7 @@ func (m *MsgGovCloseMarketRequest) Reset()         { *m = MsgGovCloseMarketReque
 func (m *MsgGovCloseMarketRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgGovCloseMarketRequest) ProtoMessage()    {}
 func (*MsgGovCloseMarketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{56}
+	return fileDescriptor_e333fcffc093bd1b, []int{58}
 }
 func (m *MsgGovCloseMarketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3279,7 +3383,7 @@ func (m *MsgGovCloseMarketResponse) Reset()         { *m = MsgGovCloseMarketResp
 func (m *MsgGovCloseMarketResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovCloseMarketResponse) ProtoMessage()    {}
 func (*MsgGovCloseMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{57}
+	return fileDescriptor_e333fcffc093bd1b, []int{59}
 }
 func (m *MsgGovCloseMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3323,7 +3427,7 @@ func (m *MsgGovUpdateParamsRequest) Reset()         { *m = MsgGovUpdateParamsReq
 func (m *MsgGovUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgGovUpdateParamsRequest) ProtoMessage()    {}
 func (*MsgGovUpdateParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{58}
+	return fileDescriptor_e333fcffc093bd1b, []int{60}
 }
 func (m *MsgGovUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3377,7 +3481,7 @@ func (m *MsgGovUpdateParamsResponse) Reset()         { *m = MsgGovUpdateParamsRe
 func (m *MsgGovUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgGovUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{59}
+	return fileDescriptor_e333fcffc093bd1b, []int{61}
 }
 func (m *MsgGovUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3418,7 +3522,7 @@ func (m *MsgUpdateParamsRequest) Reset()         { *m = MsgUpdateParamsRequest{}
 func (m *MsgUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsRequest) ProtoMessage()    {}
 func (*MsgUpdateParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{60}
+	return fileDescriptor_e333fcffc093bd1b, []int{62}
 }
 func (m *MsgUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3469,7 +3573,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{61}
+	return fileDescriptor_e333fcffc093bd1b, []int{63}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3517,7 +3621,7 @@ func (m *MsgSendAndCommitRequest) Reset()         { *m = MsgSendAndCommitRequest
 func (m *MsgSendAndCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgSendAndCommitRequest) ProtoMessage()    {}
 func (*MsgSendAndCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{62}
+	return fileDescriptor_e333fcffc093bd1b, []int{64}
 }
 func (m *MsgSendAndCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3589,7 +3693,7 @@ func (m *MsgSendAndCommitResponse) Reset()         { *m = MsgSendAndCommitRespon
 func (m *MsgSendAndCommitResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendAndCommitResponse) ProtoMessage()    {}
 func (*MsgSendAndCommitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{63}
+	return fileDescriptor_e333fcffc093bd1b, []int{65}
 }
 func (m *MsgSendAndCommitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3655,6 +3759,8 @@ func init() {
 	proto.RegisterType((*MsgMarketUpdateAcceptingCommitmentsResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateAcceptingCommitmentsResponse")
 	proto.RegisterType((*MsgMarketUpdateIntermediaryDenomRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateIntermediaryDenomRequest")
 	proto.RegisterType((*MsgMarketUpdateIntermediaryDenomResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateIntermediaryDenomResponse")
+	proto.RegisterType((*MsgMarketUpdateRejectStaleNavsRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateRejectStaleNavsRequest")
+	proto.RegisterType((*MsgMarketUpdateRejectStaleNavsResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateRejectStaleNavsResponse")
 	proto.RegisterType((*MsgMarketManagePermissionsRequest)(nil), "provenance.exchange.v1.MsgMarketManagePermissionsRequest")
 	proto.RegisterType((*MsgMarketManagePermissionsResponse)(nil), "provenance.exchange.v1.MsgMarketManagePermissionsResponse")
 	proto.RegisterType((*MsgMarketManageReqAttrsRequest)(nil), "provenance.exchange.v1.MsgMarketManageReqAttrsRequest")
@@ -3688,194 +3794,196 @@ func init() {
 func init() { proto.RegisterFile("provenance/exchange/v1/tx.proto", fileDescriptor_e333fcffc093bd1b) }
 
 var fileDescriptor_e333fcffc093bd1b = []byte{
-	// 2989 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xcb, 0x8f, 0x1c, 0x57,
-	0xd5, 0x77, 0x75, 0xcf, 0xab, 0xcf, 0xcc, 0xf8, 0x71, 0xfd, 0xea, 0x69, 0xc7, 0x33, 0xed, 0x76,
-	0xfc, 0x7d, 0x66, 0xc2, 0x4c, 0xdb, 0x8e, 0x48, 0x60, 0x48, 0x48, 0xa6, 0xc7, 0x19, 0xcb, 0x91,
-	0x1c, 0xac, 0xb6, 0x03, 0x52, 0x58, 0xb4, 0x6a, 0xba, 0xae, 0xdb, 0xc5, 0x54, 0x57, 0x75, 0xea,
-	0x56, 0x8f, 0x67, 0x24, 0x10, 0x08, 0x45, 0xe2, 0x21, 0x45, 0x8a, 0x84, 0xd8, 0x20, 0x84, 0x04,
-	0x48, 0x08, 0xc8, 0x02, 0x23, 0x10, 0xe2, 0xb1, 0x44, 0x42, 0x59, 0x64, 0x11, 0xb1, 0x62, 0x05,
-	0x51, 0x22, 0xe1, 0x7f, 0x82, 0x05, 0xba, 0xf7, 0x9e, 0xea, 0xba, 0xf5, 0xae, 0x9e, 0xb8, 0x0d,
-	0x9b, 0xc4, 0x5d, 0x75, 0x1e, 0xbf, 0xdf, 0x39, 0xf7, 0x71, 0xea, 0x9e, 0x3b, 0xb0, 0x32, 0x70,
-	0x9d, 0x3d, 0x6a, 0xeb, 0x76, 0x97, 0x36, 0xe9, 0x7e, 0xf7, 0xbe, 0x6e, 0xf7, 0x68, 0x73, 0xef,
-	0x6a, 0xd3, 0xdb, 0x5f, 0x1f, 0xb8, 0x8e, 0xe7, 0x90, 0x33, 0x81, 0xc0, 0xba, 0x2f, 0xb0, 0xbe,
-	0x77, 0xb5, 0x76, 0x42, 0xef, 0x9b, 0xb6, 0xd3, 0x14, 0xff, 0x95, 0xa2, 0xb5, 0xe5, 0xae, 0xc3,
-	0xfa, 0x0e, 0x6b, 0xee, 0xe8, 0x8c, 0xdb, 0xd8, 0xa1, 0x9e, 0x7e, 0xb5, 0xd9, 0x75, 0x4c, 0x1b,
-	0xdf, 0x9f, 0xc5, 0xf7, 0x7d, 0xd6, 0xe3, 0x2e, 0xfa, 0xac, 0x87, 0x2f, 0x96, 0xe4, 0x8b, 0x8e,
-	0xf8, 0xd5, 0x94, 0x3f, 0xf0, 0xd5, 0xa9, 0x9e, 0xd3, 0x73, 0xe4, 0x73, 0xfe, 0x2f, 0x7c, 0x7a,
-	0x39, 0x05, 0x75, 0xd7, 0xe9, 0xf7, 0x4d, 0xaf, 0x4f, 0x6d, 0xcf, 0xd7, 0xbf, 0x98, 0x22, 0xd9,
-	0xd7, 0xdd, 0x5d, 0xea, 0xe5, 0x08, 0x39, 0xae, 0x41, 0xdd, 0x3c, 0x4b, 0x03, 0xdd, 0xd5, 0xfb,
-	0xbe, 0xd0, 0xa5, 0x54, 0xa1, 0x03, 0x05, 0x55, 0xe3, 0xb7, 0x1a, 0x9c, 0xbc, 0xc5, 0x7a, 0x5b,
-	0x2e, 0xd5, 0x3d, 0xba, 0xc9, 0x76, 0xdb, 0xf4, 0xcd, 0x21, 0x65, 0x1e, 0xd9, 0x82, 0x8a, 0xce,
-	0x76, 0x3b, 0xc2, 0x6f, 0x55, 0xab, 0x6b, 0x97, 0xe7, 0xaf, 0xd5, 0xd7, 0x93, 0x13, 0xb0, 0xbe,
-	0xc9, 0x76, 0xbf, 0xc8, 0xe5, 0x5a, 0x53, 0xef, 0xfd, 0x63, 0xe5, 0x48, 0x7b, 0x4e, 0xc7, 0xdf,
-	0xe4, 0x06, 0x10, 0x61, 0xa0, 0xd3, 0xe5, 0xe6, 0x4d, 0xc7, 0xee, 0xdc, 0xa3, 0xb4, 0x5a, 0x12,
-	0xd6, 0x96, 0xd6, 0x31, 0xba, 0x3c, 0x47, 0xeb, 0x98, 0xa3, 0xf5, 0x2d, 0xc7, 0xb4, 0xdb, 0xc7,
-	0x85, 0xd2, 0x16, 0xea, 0x6c, 0x53, 0xba, 0x71, 0xf4, 0x5b, 0x8f, 0x1e, 0xae, 0x06, 0x80, 0x1a,
-	0x57, 0xe1, 0x54, 0x18, 0x34, 0x1b, 0x38, 0x36, 0xa3, 0x64, 0x09, 0xe6, 0xa4, 0x43, 0xd3, 0x10,
-	0xa0, 0xa7, 0xda, 0xb3, 0xe2, 0xf7, 0x4d, 0x23, 0x4c, 0xb4, 0x65, 0x1a, 0x0a, 0xd1, 0x1d, 0xd3,
-	0x28, 0x46, 0xb4, 0x65, 0x1a, 0x21, 0xa2, 0x3b, 0xf8, 0xfb, 0x71, 0x13, 0x1d, 0x01, 0x0a, 0x11,
-	0x15, 0xa0, 0xf3, 0x89, 0xbe, 0x5f, 0x82, 0xd3, 0x5c, 0x47, 0x0c, 0xc0, 0xed, 0xa1, 0x6d, 0x30,
-	0x9f, 0xea, 0x35, 0x98, 0xd5, 0xbb, 0x5d, 0x67, 0x68, 0x7b, 0x42, 0xa7, 0xd2, 0xaa, 0xfe, 0xed,
-	0x77, 0x6b, 0xa7, 0x10, 0xdd, 0xa6, 0x61, 0xb8, 0x94, 0xb1, 0x3b, 0x9e, 0x6b, 0xda, 0xbd, 0xb6,
-	0x2f, 0x48, 0xce, 0x41, 0x45, 0x0e, 0x50, 0xee, 0x89, 0x13, 0x5a, 0x6c, 0xcf, 0xc9, 0x07, 0x37,
-	0x0d, 0x72, 0x00, 0x33, 0x7a, 0x5f, 0xd8, 0x2b, 0xd7, 0xcb, 0x99, 0x54, 0x5b, 0xdb, 0x3c, 0x62,
-	0xbf, 0xfa, 0xe7, 0xca, 0xe5, 0x9e, 0xe9, 0xdd, 0x1f, 0xee, 0xac, 0x77, 0x9d, 0x3e, 0x4e, 0x2f,
-	0xfc, 0xdf, 0x1a, 0x33, 0x76, 0x9b, 0xde, 0xc1, 0x80, 0x32, 0xa1, 0xc0, 0x7e, 0xf8, 0xe8, 0xe1,
-	0xea, 0x82, 0x45, 0x7b, 0x7a, 0xf7, 0xa0, 0xc3, 0x67, 0x2e, 0xfb, 0xc5, 0xa3, 0x87, 0xab, 0x5a,
-	0x1b, 0x1d, 0x92, 0x17, 0x60, 0x21, 0x14, 0xeb, 0xa9, 0xbc, 0x58, 0xcf, 0x77, 0x83, 0x30, 0x73,
-	0x56, 0x74, 0x8f, 0xda, 0x5e, 0xc7, 0xd3, 0x7b, 0xd5, 0x69, 0x1e, 0x8b, 0xf6, 0x9c, 0x78, 0x70,
-	0x57, 0xef, 0x6d, 0x2c, 0xf0, 0x1c, 0xf8, 0x01, 0x68, 0x54, 0xe1, 0x4c, 0x34, 0x9a, 0x32, 0x07,
-	0x8d, 0x37, 0x65, 0x9c, 0xf9, 0x28, 0xb1, 0xc4, 0x30, 0xf0, 0xe3, 0x7c, 0x05, 0x66, 0x98, 0xd9,
-	0xb3, 0x71, 0x3c, 0x65, 0x85, 0x19, 0xe5, 0x42, 0xe9, 0x2c, 0x85, 0xd2, 0xb9, 0x31, 0xcf, 0xd1,
-	0xa0, 0x9c, 0x0f, 0x46, 0x75, 0x89, 0x60, 0xfe, 0x52, 0x06, 0x72, 0x8b, 0xf5, 0xb6, 0x4d, 0xcb,
-	0x6a, 0x99, 0x41, 0xca, 0x39, 0x14, 0x6a, 0x59, 0x85, 0xa0, 0x08, 0xb9, 0xec, 0x84, 0xbf, 0xa5,
-	0xc1, 0x82, 0xe7, 0x78, 0xba, 0xd5, 0xd1, 0x19, 0xa3, 0x1e, 0x7b, 0x72, 0x79, 0x9f, 0x17, 0x6e,
-	0x37, 0x85, 0x57, 0xd2, 0x80, 0xc5, 0xd1, 0x14, 0xe9, 0x98, 0x06, 0xab, 0x4e, 0xd5, 0xcb, 0x97,
-	0xa7, 0xda, 0xf3, 0xfe, 0x7c, 0xbc, 0x69, 0x30, 0xf2, 0x25, 0xa8, 0x49, 0x46, 0x1d, 0x46, 0x3d,
-	0xcf, 0xa2, 0x7c, 0xd1, 0xeb, 0xdc, 0xb3, 0x74, 0x4f, 0x0c, 0x97, 0xe9, 0xbc, 0xe1, 0x72, 0x56,
-	0x2a, 0xdf, 0x19, 0xe9, 0x6e, 0x5b, 0xba, 0xc7, 0x87, 0xce, 0x6b, 0x70, 0x66, 0xb4, 0x0e, 0x85,
-	0xa7, 0xfb, 0x4c, 0x9e, 0xcd, 0x93, 0xfe, 0xc2, 0xa8, 0xce, 0x78, 0xcc, 0xaf, 0xf0, 0xd6, 0x38,
-	0x2d, 0xd6, 0xa8, 0x20, 0x89, 0x98, 0xdc, 0x3f, 0x07, 0xc9, 0xdd, 0x64, 0xbb, 0xa3, 0xe4, 0xae,
-	0xc3, 0xf4, 0xce, 0xf0, 0xa0, 0x40, 0x6e, 0xa5, 0x58, 0x76, 0x6a, 0x5f, 0x06, 0x19, 0xe2, 0xce,
-	0xc0, 0x35, 0xbb, 0xb4, 0x5a, 0xce, 0x21, 0x83, 0x4b, 0x20, 0x08, 0x9d, 0xdb, 0x5c, 0x85, 0x67,
-	0x25, 0x88, 0x8c, 0x92, 0x15, 0x9f, 0x35, 0xcf, 0xca, 0x0f, 0x34, 0x38, 0x2d, 0xc0, 0x84, 0xb2,
-	0x42, 0x29, 0xab, 0x4e, 0x3f, 0xa9, 0x91, 0x74, 0x52, 0xf8, 0x57, 0x12, 0x4b, 0x29, 0xe3, 0x59,
-	0x0d, 0x46, 0xd4, 0x98, 0x59, 0xf5, 0x47, 0x9d, 0x9a, 0x55, 0xe0, 0x59, 0x95, 0x61, 0x57, 0x92,
-	0x2a, 0x93, 0x87, 0x49, 0xfd, 0x50, 0x13, 0x93, 0xf9, 0x96, 0x48, 0x80, 0x84, 0xa3, 0x24, 0x56,
-	0x37, 0xfa, 0xa6, 0x9d, 0x9f, 0x58, 0x21, 0x96, 0x9d, 0xd8, 0x58, 0x5a, 0xca, 0xf1, 0xb4, 0x14,
-	0x99, 0x50, 0x97, 0xe0, 0x28, 0xdd, 0x1f, 0xd0, 0xae, 0xd7, 0x19, 0xe8, 0xae, 0x67, 0xea, 0x96,
-	0x98, 0x44, 0x73, 0xed, 0x45, 0xf9, 0xf4, 0xb6, 0x7c, 0x88, 0xcc, 0x05, 0xae, 0xc6, 0x12, 0x9c,
-	0x8d, 0x31, 0x44, 0xf6, 0x3f, 0x2f, 0x43, 0x7d, 0xf4, 0x6e, 0x6b, 0x54, 0x2c, 0x4d, 0x30, 0x0e,
-	0x5b, 0x30, 0x63, 0xda, 0x83, 0xe1, 0x68, 0xd1, 0xba, 0x94, 0x5a, 0xce, 0xc8, 0x95, 0x7f, 0x53,
-	0x6c, 0x34, 0x38, 0xce, 0x51, 0x95, 0xbc, 0x02, 0xb3, 0xce, 0xd0, 0x13, 0x56, 0xa6, 0xc6, 0xb7,
-	0xe2, 0xeb, 0x92, 0x97, 0x60, 0x4a, 0x19, 0xf4, 0x63, 0xd9, 0x10, 0x8a, 0xdc, 0x80, 0xad, 0xef,
-	0xb1, 0xea, 0x4c, 0xb6, 0x81, 0xd7, 0xa8, 0x27, 0x96, 0x4c, 0x31, 0x41, 0x7d, 0x03, 0x5c, 0x31,
-	0xbc, 0x03, 0xce, 0x46, 0x76, 0x40, 0x35, 0x87, 0x17, 0xe1, 0x42, 0x46, 0x9e, 0x30, 0x9b, 0xff,
-	0xd2, 0xa0, 0x31, 0x92, 0x6a, 0x53, 0x8b, 0xea, 0x8c, 0x06, 0xc2, 0x6c, 0x22, 0xf9, 0x7c, 0x15,
-	0xc0, 0x73, 0x3a, 0xae, 0x74, 0x76, 0x98, 0x9c, 0x56, 0x3c, 0x07, 0xa1, 0x86, 0xa3, 0x31, 0x95,
-	0x11, 0x8d, 0x4b, 0x70, 0x31, 0x93, 0x27, 0xc6, 0xe3, 0xdf, 0x25, 0x25, 0x1e, 0x77, 0x5d, 0xdd,
-	0x66, 0xf7, 0xa8, 0x1b, 0x08, 0x1e, 0x36, 0x1e, 0x4a, 0x01, 0x57, 0x2a, 0x5a, 0xc0, 0xfd, 0x17,
-	0x6b, 0xb4, 0x55, 0x38, 0xd1, 0x1d, 0xba, 0x2e, 0x8f, 0x6b, 0x90, 0xc6, 0x29, 0x91, 0xc6, 0x63,
-	0xf8, 0xe2, 0x96, 0xb2, 0x4a, 0xd9, 0xf4, 0x81, 0x22, 0x37, 0x2d, 0xe4, 0xe6, 0x6d, 0xfa, 0x60,
-	0x24, 0x13, 0xca, 0xd2, 0x4c, 0xc1, 0x2c, 0x25, 0x45, 0x1f, 0xb3, 0xf4, 0x47, 0x75, 0xd4, 0xde,
-	0xa1, 0x9e, 0x58, 0xea, 0x5e, 0xd9, 0xf7, 0xa8, 0x6b, 0xeb, 0xd6, 0xcd, 0xeb, 0x13, 0x19, 0xb5,
-	0x6a, 0xa5, 0x57, 0x0e, 0x55, 0x7a, 0x64, 0x05, 0xe6, 0x29, 0x3a, 0xf7, 0x03, 0x55, 0x69, 0x83,
-	0xff, 0xe8, 0xa6, 0x91, 0x4a, 0x31, 0x09, 0x3a, 0x52, 0x7c, 0xbb, 0x04, 0xd5, 0x91, 0xdc, 0x97,
-	0x4d, 0xef, 0xbe, 0xe1, 0xea, 0x0f, 0x26, 0x42, 0xec, 0xbc, 0x98, 0x8e, 0xba, 0xd4, 0x13, 0xd4,
-	0x2a, 0x7c, 0x86, 0xa1, 0x21, 0x65, 0x18, 0x4e, 0x3d, 0xe1, 0x61, 0x18, 0x0a, 0xdb, 0x39, 0x58,
-	0x4a, 0x08, 0x07, 0x06, 0xeb, 0x7d, 0x0d, 0xce, 0x8f, 0xde, 0xbe, 0x3e, 0x30, 0x74, 0x8f, 0x5e,
-	0xa7, 0x9e, 0x6e, 0x5a, 0x93, 0x59, 0xc0, 0xda, 0x70, 0x14, 0x5f, 0x1a, 0xd2, 0x0b, 0x16, 0x5d,
-	0xa9, 0x8b, 0x98, 0x04, 0x86, 0x90, 0x70, 0x11, 0x5b, 0xec, 0xab, 0x0f, 0x43, 0x5c, 0xeb, 0xb0,
-	0x9c, 0xc6, 0x06, 0x09, 0xff, 0x3a, 0x4e, 0xf8, 0x15, 0x5b, 0xdf, 0xb1, 0xa8, 0x11, 0x7c, 0x3f,
-	0x84, 0x08, 0xd7, 0xd2, 0x08, 0x57, 0x35, 0x9f, 0xf2, 0x4a, 0x8c, 0x72, 0xab, 0x54, 0xd5, 0x14,
-	0xda, 0x6b, 0x70, 0x5c, 0xef, 0x76, 0xe9, 0xc0, 0x33, 0xed, 0x9e, 0xac, 0x38, 0x24, 0xf1, 0x39,
-	0x21, 0x77, 0x6c, 0xf4, 0x4e, 0x0c, 0x69, 0x26, 0xbf, 0xc6, 0x7c, 0x10, 0x8d, 0xa7, 0x63, 0x9c,
-	0x46, 0x80, 0x25, 0xa7, 0x8d, 0x52, 0x55, 0x6b, 0xbc, 0xab, 0xc1, 0xa5, 0x88, 0xd8, 0x66, 0xd8,
-	0xec, 0x44, 0x12, 0xfa, 0xa9, 0x34, 0x66, 0x71, 0x56, 0x6a, 0x9e, 0x2e, 0xc3, 0xff, 0xe5, 0x81,
-	0x0d, 0xf2, 0x55, 0x8f, 0x88, 0xbe, 0xce, 0xfc, 0x5a, 0x76, 0x22, 0x94, 0xae, 0xc1, 0x69, 0xdd,
-	0xb2, 0x9c, 0x07, 0x9d, 0x21, 0x0b, 0xd5, 0xec, 0xc8, 0xeb, 0xa4, 0x78, 0x19, 0x60, 0xe0, 0xaf,
-	0x52, 0xab, 0x87, 0x38, 0x60, 0xa4, 0xf5, 0x27, 0x0d, 0x56, 0xd3, 0x22, 0x30, 0xe9, 0x2a, 0xe2,
-	0x59, 0x38, 0x1d, 0xe4, 0x4c, 0x39, 0xb4, 0x43, 0x82, 0xa7, 0xf4, 0x04, 0x20, 0x21, 0x86, 0x6b,
-	0xf0, 0x4c, 0x21, 0xec, 0xc8, 0xf5, 0x37, 0x1a, 0xfc, 0x7f, 0x44, 0xfe, 0xa6, 0xed, 0x51, 0xb7,
-	0x4f, 0x0d, 0x53, 0x77, 0x0f, 0xae, 0x53, 0xdb, 0xe9, 0x4f, 0x84, 0xe8, 0x1a, 0x10, 0x53, 0x71,
-	0xd4, 0x31, 0xb8, 0x27, 0x5c, 0xa7, 0x4f, 0x98, 0x51, 0x08, 0x21, 0x8a, 0xab, 0x70, 0x39, 0x1f,
-	0x32, 0xf2, 0xfb, 0x65, 0x49, 0xc9, 0xf8, 0x2d, 0xdd, 0xd6, 0x7b, 0xf4, 0x36, 0x75, 0xfb, 0x26,
-	0x63, 0xa6, 0x63, 0xb3, 0x49, 0xed, 0x3c, 0x2e, 0xdd, 0x73, 0x76, 0x69, 0x47, 0xb7, 0x2c, 0x51,
-	0xe5, 0x54, 0xda, 0x15, 0xf9, 0x64, 0xd3, 0xb2, 0xc8, 0x36, 0x54, 0x44, 0x9d, 0xc8, 0x7f, 0xe3,
-	0xe6, 0x73, 0x31, 0xa3, 0x4c, 0xa4, 0x8c, 0xdd, 0x70, 0xf5, 0x51, 0x91, 0x38, 0xc7, 0x8b, 0x44,
-	0xae, 0x4a, 0xae, 0xc3, 0x9c, 0xe7, 0x74, 0x7a, 0xfc, 0x1d, 0xd6, 0xed, 0x63, 0x98, 0x99, 0xf5,
-	0x1c, 0xf1, 0x33, 0x14, 0xd7, 0xa7, 0x95, 0xf2, 0x23, 0x21, 0x54, 0x7e, 0x44, 0xcb, 0xca, 0x9a,
-	0x27, 0xc5, 0xda, 0xf4, 0xcd, 0x4d, 0xcf, 0x9b, 0xd8, 0x2a, 0x76, 0x42, 0x7c, 0x00, 0xd3, 0x0e,
-	0xff, 0x6c, 0x94, 0x7b, 0x3a, 0x46, 0xf5, 0x68, 0xd7, 0x3f, 0x71, 0xbd, 0xcb, 0x37, 0x76, 0xd2,
-	0x84, 0x53, 0x61, 0x51, 0x97, 0xf6, 0x9d, 0x3d, 0x19, 0xe5, 0x4a, 0xfb, 0x84, 0x22, 0xdd, 0x16,
-	0x2f, 0x14, 0xdb, 0xfc, 0x73, 0x13, 0x6d, 0x4f, 0xab, 0xb6, 0x5b, 0xa6, 0x11, 0xb5, 0x8d, 0xa2,
-	0x68, 0x7b, 0x46, 0xb5, 0x2d, 0xa4, 0xd1, 0xf6, 0xf3, 0x50, 0x45, 0x85, 0x60, 0x1a, 0xfb, 0x2e,
-	0x66, 0x85, 0xd2, 0x69, 0xf9, 0x3e, 0x98, 0x96, 0xd2, 0xd3, 0x8b, 0x70, 0x2e, 0x51, 0x11, 0x1d,
-	0xce, 0x09, 0xdd, 0x6a, 0x5c, 0x57, 0xfa, 0x0d, 0x65, 0xf4, 0x02, 0xac, 0xa4, 0xa6, 0x0a, 0xd3,
-	0xf9, 0x86, 0xf8, 0x26, 0x96, 0x27, 0xba, 0xb7, 0xe5, 0x59, 0xbc, 0x9f, 0xc6, 0x97, 0x60, 0x16,
-	0x4f, 0xe7, 0xf1, 0x20, 0x7a, 0x25, 0x6d, 0x80, 0xa1, 0xa2, 0x3f, 0xb8, 0x50, 0xab, 0x51, 0x13,
-	0xc5, 0x5e, 0xc4, 0x76, 0xc8, 0xaf, 0x5c, 0x9b, 0x26, 0xe3, 0x37, 0x62, 0x1b, 0xfd, 0xbe, 0xab,
-	0x09, 0xc7, 0x6d, 0xfa, 0x55, 0x71, 0x48, 0x10, 0x72, 0x7c, 0x05, 0x66, 0x3c, 0xdd, 0xed, 0xd1,
-	0xfc, 0xf3, 0x68, 0x94, 0x13, 0xe7, 0x99, 0xce, 0xd0, 0xed, 0xd2, 0xdc, 0x0f, 0x20, 0x94, 0x8b,
-	0x56, 0xd5, 0xe5, 0x58, 0x55, 0x2d, 0x0f, 0xe0, 0xa4, 0x7d, 0x64, 0x12, 0x01, 0xeb, 0xd7, 0xd2,
-	0x5a, 0xfc, 0x25, 0x3b, 0x3c, 0x95, 0x6b, 0x30, 0x2b, 0x21, 0xb2, 0x6a, 0x89, 0x0f, 0xb1, 0xac,
-	0x8f, 0x39, 0x14, 0x0c, 0x63, 0x95, 0xb5, 0x6c, 0x14, 0x0e, 0x82, 0xfd, 0x9a, 0x1c, 0x0a, 0xe2,
-	0xa4, 0x38, 0x01, 0x2b, 0x06, 0x51, 0x2b, 0x18, 0xc4, 0x0b, 0xb0, 0xa0, 0x04, 0x11, 0x01, 0xb7,
-	0xe7, 0x83, 0x28, 0xfa, 0xd0, 0xa4, 0x3c, 0x42, 0x8b, 0x7a, 0x47, 0x68, 0x7f, 0x90, 0x55, 0xe7,
-	0x96, 0x18, 0x55, 0xf8, 0xf6, 0xae, 0xa0, 0x74, 0x78, 0x80, 0x91, 0x2c, 0x97, 0xa2, 0x59, 0x26,
-	0xcf, 0x03, 0xf0, 0xef, 0x4b, 0xcc, 0x51, 0x39, 0xc7, 0x6c, 0xc5, 0xa6, 0x0f, 0x24, 0xa4, 0x30,
-	0x2f, 0x59, 0x52, 0x27, 0x22, 0x47, 0x72, 0x3f, 0xd1, 0x04, 0xf5, 0x1b, 0xce, 0x9e, 0x9c, 0x86,
-	0xfe, 0x51, 0x81, 0x24, 0xf6, 0x1c, 0x54, 0xf4, 0xa1, 0x77, 0xdf, 0x71, 0x4d, 0xef, 0x20, 0x97,
-	0x5b, 0x20, 0x4a, 0x5e, 0x80, 0x19, 0xb9, 0x3e, 0x63, 0x4f, 0x69, 0x39, 0xfb, 0x13, 0xc1, 0x3f,
-	0xb4, 0x92, 0x3a, 0x7e, 0xf7, 0xcc, 0xb7, 0xd6, 0x78, 0x0a, 0x6a, 0x49, 0x10, 0x91, 0xc1, 0xef,
-	0x17, 0xc5, 0x84, 0xbd, 0xe1, 0xec, 0xc9, 0x15, 0x6c, 0x9b, 0x52, 0xf6, 0x49, 0xf1, 0x67, 0x6e,
-	0x38, 0xaf, 0xc3, 0x59, 0xdd, 0x30, 0x3a, 0xf7, 0x28, 0xed, 0x28, 0xbb, 0xc9, 0x3d, 0x4b, 0x2f,
-	0x70, 0x64, 0x21, 0x89, 0x9e, 0xd4, 0x0d, 0x63, 0x9b, 0xd2, 0x51, 0x3f, 0x70, 0xdb, 0xd2, 0x3d,
-	0xf2, 0x15, 0xa8, 0xc9, 0x15, 0x3c, 0xd1, 0xf2, 0x54, 0x31, 0xcb, 0x67, 0xa4, 0x89, 0x98, 0xf1,
-	0x38, 0x66, 0xbe, 0x4b, 0x09, 0xcb, 0xd3, 0x87, 0xc0, 0xdc, 0x32, 0x8d, 0x74, 0xcc, 0x23, 0xcb,
-	0x33, 0x87, 0xc3, 0xec, 0x1b, 0xef, 0xc2, 0xb2, 0x8f, 0x39, 0xb9, 0x33, 0x22, 0xb6, 0xc9, 0x02,
-	0x0e, 0x6a, 0x12, 0xfa, 0x9d, 0x84, 0x0e, 0x09, 0x31, 0xe1, 0x82, 0xc2, 0x20, 0xc5, 0xcf, 0x5c,
-	0x31, 0x3f, 0xe7, 0x47, 0x44, 0x12, 0x5d, 0xd9, 0x50, 0x4f, 0xe7, 0xe3, 0xea, 0x9e, 0xe9, 0xb0,
-	0x6a, 0x45, 0x78, 0x4a, 0x6d, 0xe8, 0x6e, 0x53, 0xda, 0xe6, 0x82, 0xe8, 0xf0, 0xa9, 0x64, 0x62,
-	0x42, 0x84, 0x11, 0x0f, 0x2e, 0x66, 0x52, 0x43, 0x97, 0x30, 0x96, 0xcb, 0x95, 0x54, 0x8e, 0xe8,
-	0x55, 0x87, 0xf3, 0x3e, 0xcb, 0x78, 0xe3, 0x84, 0x07, 0x73, 0xbe, 0x58, 0x30, 0x97, 0x24, 0xb7,
-	0x56, 0xa4, 0xf9, 0xc1, 0x03, 0xd9, 0x83, 0xba, 0x42, 0x2c, 0xd9, 0xcb, 0x42, 0x31, 0x2f, 0x4f,
-	0x8d, 0xe8, 0x24, 0x39, 0xb2, 0x60, 0x25, 0x95, 0x0b, 0x46, 0x6f, 0x71, 0xac, 0xe8, 0x9d, 0x4b,
-	0x24, 0x85, 0x91, 0x73, 0xa1, 0x91, 0x45, 0x0b, 0x1d, 0x1e, 0x1d, 0xcb, 0xe1, 0x72, 0x1a, 0x3f,
-	0xf4, 0xa9, 0xcc, 0xb1, 0x78, 0x4d, 0x29, 0x02, 0x79, 0x6c, 0xac, 0x39, 0xb6, 0x15, 0xa9, 0x3a,
-	0x13, 0xe6, 0x58, 0x8a, 0x9f, 0xe3, 0xe3, 0xce, 0xb1, 0x44, 0x57, 0xaf, 0x42, 0x83, 0x51, 0x4f,
-	0xfa, 0x09, 0x1c, 0x28, 0x51, 0xdc, 0x31, 0x07, 0xac, 0x7a, 0x42, 0xac, 0xe8, 0xcb, 0x8c, 0x7a,
-	0xdc, 0x4e, 0xa4, 0x49, 0x20, 0x0a, 0x46, 0x73, 0xc0, 0xc8, 0x6b, 0xf0, 0xf4, 0xd0, 0x2e, 0x60,
-	0x8d, 0x88, 0x2f, 0xef, 0xba, 0x90, 0xcd, 0xb0, 0x17, 0xdb, 0xd6, 0x64, 0xed, 0x16, 0xd9, 0xb7,
-	0x70, 0x53, 0xfb, 0x86, 0xff, 0x6e, 0xcb, 0x72, 0xd8, 0x63, 0xda, 0x94, 0xb3, 0x36, 0xb5, 0x18,
-	0xb8, 0x73, 0xa3, 0xb2, 0x40, 0x05, 0x80, 0xe8, 0x7e, 0x36, 0x2a, 0x1a, 0xe4, 0xe7, 0xf5, 0x6d,
-	0x71, 0x91, 0xe7, 0x31, 0x14, 0x0d, 0xf2, 0x46, 0x50, 0x5e, 0xd1, 0x20, 0xdd, 0xf9, 0x45, 0x83,
-	0xd4, 0xd9, 0x38, 0x1e, 0x26, 0x50, 0xd5, 0x1a, 0x75, 0xbf, 0x6c, 0x08, 0x83, 0x54, 0xce, 0xdd,
-	0x7e, 0x2c, 0x5b, 0x9a, 0xff, 0x3b, 0x24, 0xa2, 0x59, 0x90, 0x0d, 0xc9, 0x24, 0xfc, 0x8d, 0xbf,
-	0x96, 0xc4, 0xbb, 0x3b, 0xd4, 0x36, 0x36, 0x6d, 0x43, 0x0e, 0xba, 0xd0, 0x2d, 0x0a, 0xdb, 0x28,
-	0x76, 0x8b, 0x82, 0xcb, 0xf1, 0x72, 0x53, 0x39, 0x0d, 0xcf, 0xfb, 0x56, 0x49, 0x3c, 0x27, 0x7f,
-	0xe2, 0xed, 0x9a, 0xd0, 0x78, 0x9e, 0x8a, 0x14, 0x69, 0x99, 0x37, 0x66, 0xfc, 0x3b, 0x0c, 0x9c,
-	0x3a, 0x4e, 0xc3, 0x48, 0x1c, 0x65, 0x90, 0xaf, 0x7d, 0xaf, 0x0e, 0xe5, 0x5b, 0xac, 0x47, 0xee,
-	0x41, 0x65, 0x54, 0x4f, 0x91, 0x67, 0x52, 0x8b, 0xd9, 0xf8, 0xbd, 0xb4, 0xda, 0xa7, 0x8b, 0x09,
-	0xe3, 0x35, 0xa9, 0x91, 0x9f, 0x96, 0x69, 0x14, 0xf0, 0x13, 0x5c, 0x0b, 0x2b, 0xe0, 0x47, 0xbd,
-	0x8e, 0x65, 0xc1, 0xbc, 0x72, 0x43, 0x88, 0xac, 0x65, 0x29, 0xc7, 0xee, 0x65, 0xd5, 0xd6, 0x8b,
-	0x8a, 0x2b, 0xde, 0x82, 0x2b, 0x40, 0xd9, 0xde, 0x62, 0xb7, 0x93, 0xb2, 0xbd, 0xc5, 0x6f, 0x16,
-	0x91, 0x2e, 0xcc, 0xf9, 0x17, 0x52, 0xc8, 0x6a, 0x86, 0x6e, 0xe4, 0xea, 0x51, 0xed, 0x99, 0x42,
-	0xb2, 0x61, 0x27, 0x9b, 0x6c, 0x37, 0xdf, 0x89, 0x72, 0x05, 0x26, 0xd7, 0x89, 0x7a, 0xe3, 0x82,
-	0x38, 0xb0, 0xa0, 0xde, 0x45, 0x20, 0x59, 0x91, 0x48, 0xb8, 0x96, 0x51, 0x6b, 0x16, 0x96, 0x47,
-	0x87, 0x6f, 0xf3, 0xf5, 0x30, 0xb1, 0x73, 0x4e, 0x3e, 0x9b, 0x6b, 0x2b, 0xe5, 0x52, 0x44, 0xed,
-	0x73, 0x87, 0xd0, 0x44, 0x3c, 0xdf, 0xd7, 0xa0, 0x9a, 0xd6, 0xbb, 0x26, 0x1b, 0xb9, 0x76, 0x53,
-	0x1b, 0xfb, 0xb5, 0xcf, 0x1f, 0x4a, 0x37, 0x86, 0x2a, 0xde, 0xab, 0x2d, 0x80, 0x2a, 0xb5, 0xbd,
-	0x5e, 0x00, 0x55, 0x7a, 0x73, 0x58, 0x41, 0x15, 0x6f, 0xaf, 0x16, 0x40, 0x95, 0xda, 0x4e, 0x2e,
-	0x80, 0x2a, 0xbd, 0x9f, 0x4b, 0x86, 0x70, 0x34, 0xdc, 0xbc, 0x24, 0x57, 0x72, 0xcd, 0x45, 0xda,
-	0xbe, 0xb5, 0xab, 0x63, 0x68, 0xa0, 0xdb, 0xb7, 0x34, 0x38, 0x99, 0xd0, 0x48, 0x24, 0x9f, 0xc9,
-	0x35, 0x95, 0xd4, 0x46, 0xad, 0x3d, 0x37, 0xae, 0x1a, 0xc2, 0xf8, 0x6e, 0x04, 0x06, 0xf6, 0xfe,
-	0x0a, 0xc3, 0x08, 0x37, 0x37, 0x0b, 0xc3, 0x88, 0xb4, 0x18, 0x1b, 0xe5, 0xef, 0x94, 0x34, 0xf2,
-	0x23, 0x0d, 0xce, 0x65, 0xf4, 0xec, 0xc8, 0x8b, 0x05, 0x8d, 0x27, 0x37, 0x26, 0x6b, 0x5f, 0x38,
-	0xac, 0x7a, 0x6c, 0xe9, 0x89, 0xb6, 0xdd, 0x0a, 0x2c, 0x3d, 0x29, 0xad, 0xc5, 0x02, 0x4b, 0x4f,
-	0x5a, 0x8f, 0x8f, 0xbc, 0xab, 0x41, 0x3d, 0xaf, 0x49, 0x46, 0x5a, 0xe3, 0x92, 0x4e, 0x58, 0x8a,
-	0xb6, 0x3e, 0x91, 0x0d, 0x44, 0xfb, 0x53, 0x0d, 0xce, 0x67, 0xf6, 0xbb, 0xc8, 0x4b, 0x05, 0xdd,
-	0xa4, 0x35, 0xf7, 0x6a, 0x2f, 0x1f, 0xde, 0x00, 0x82, 0x7c, 0x47, 0x83, 0xb3, 0x29, 0xcd, 0x23,
-	0x92, 0x9f, 0xa9, 0xb4, 0xde, 0x5c, 0x6d, 0xe3, 0x30, 0xaa, 0x08, 0xe9, 0xdb, 0x1a, 0x9c, 0x4a,
-	0xea, 0x7e, 0x90, 0xe7, 0x0a, 0x1a, 0x8d, 0x74, 0xb6, 0x6a, 0xcf, 0x8f, 0xad, 0x87, 0x48, 0x5c,
-	0x58, 0x0c, 0xf5, 0x41, 0x48, 0x33, 0xb7, 0xa0, 0x0b, 0x37, 0x27, 0x6a, 0x57, 0x8a, 0x2b, 0x04,
-	0x3e, 0x43, 0x3d, 0x90, 0x4c, 0x9f, 0x49, 0x9d, 0x98, 0x4c, 0x9f, 0x89, 0xed, 0x15, 0xee, 0x33,
-	0xd4, 0x01, 0xc8, 0xf4, 0x99, 0xd4, 0x84, 0xc9, 0xf4, 0x99, 0xd8, 0x08, 0xe1, 0x9b, 0x50, 0xb8,
-	0xeb, 0x40, 0x0a, 0xdb, 0x60, 0x45, 0x36, 0xa1, 0xe4, 0x96, 0x06, 0x77, 0x1b, 0xee, 0x28, 0x64,
-	0xba, 0x4d, 0x6c, 0x7d, 0x64, 0xba, 0x4d, 0x6e, 0x57, 0x88, 0xbd, 0x2f, 0xe1, 0xc4, 0x3f, 0x73,
-	0xd3, 0x49, 0xef, 0x6d, 0x64, 0x6e, 0x3a, 0x19, 0x8d, 0x05, 0xb2, 0x0f, 0xc7, 0x22, 0x27, 0xf6,
-	0x24, 0x8b, 0x4c, 0x72, 0x03, 0xa2, 0x76, 0x6d, 0x1c, 0x95, 0x60, 0x88, 0x85, 0x0e, 0x55, 0x32,
-	0x87, 0x58, 0x52, 0xdb, 0x20, 0x73, 0x88, 0x25, 0x9e, 0xd7, 0xf0, 0x5c, 0x87, 0xcf, 0x4a, 0x48,
-	0x8e, 0x8d, 0xf8, 0xb9, 0x4e, 0xed, 0xea, 0x18, 0x1a, 0xe8, 0xf6, 0xeb, 0x22, 0xc8, 0xea, 0xf9,
-	0x40, 0x5e, 0x90, 0x13, 0xce, 0x3a, 0xf2, 0x82, 0x9c, 0x78, 0xfc, 0x20, 0x6a, 0x0a, 0x07, 0x16,
-	0x42, 0xbe, 0xb3, 0x3e, 0x50, 0x92, 0x1c, 0x37, 0x0b, 0xcb, 0x23, 0x5f, 0x0f, 0x16, 0x43, 0x1f,
-	0xea, 0x99, 0xa9, 0x4d, 0x3a, 0x1a, 0xc9, 0x4c, 0x6d, 0xe2, 0x19, 0x40, 0xe3, 0x48, 0x6d, 0xfa,
-	0x9b, 0x8f, 0x1e, 0xae, 0x6a, 0x2d, 0xfa, 0xde, 0x47, 0xcb, 0xda, 0x07, 0x1f, 0x2d, 0x6b, 0x1f,
-	0x7e, 0xb4, 0xac, 0xbd, 0xf3, 0xf1, 0xf2, 0x91, 0x0f, 0x3e, 0x5e, 0x3e, 0xf2, 0xf7, 0x8f, 0x97,
-	0x8f, 0xc0, 0x92, 0xe9, 0xa4, 0x98, 0xbd, 0xad, 0xbd, 0xb1, 0xae, 0x1c, 0x77, 0x04, 0x42, 0x6b,
-	0xa6, 0xa3, 0xfc, 0x6a, 0xee, 0x8f, 0xfe, 0xe6, 0x6d, 0x67, 0x46, 0xfc, 0xa1, 0xdb, 0xb3, 0xff,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xc0, 0x60, 0x29, 0x60, 0x38, 0x00, 0x00,
+	// 3020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xdb, 0x8f, 0x1b, 0x57,
+	0x19, 0xcf, 0xd8, 0xde, 0x8b, 0xbf, 0xdd, 0x4d, 0xb2, 0x93, 0x9b, 0x77, 0xd2, 0x78, 0x1d, 0xa7,
+	0x81, 0xb0, 0xe9, 0xda, 0xbb, 0x5b, 0x91, 0xd2, 0xed, 0x75, 0xbd, 0xe9, 0x46, 0xa9, 0x94, 0x12,
+	0x39, 0x29, 0x48, 0xe5, 0xc1, 0x9a, 0xf5, 0x9c, 0x38, 0xc3, 0x8e, 0x67, 0xdc, 0x39, 0xe3, 0x4d,
+	0x56, 0x02, 0x81, 0x50, 0x25, 0xe0, 0xa1, 0x52, 0x25, 0xc4, 0x0b, 0x42, 0x48, 0x80, 0x84, 0x28,
+	0x45, 0xa2, 0x08, 0x84, 0xb8, 0x3c, 0x22, 0xa1, 0x3e, 0xf4, 0xa1, 0xe2, 0x89, 0x27, 0xa8, 0x5a,
+	0x89, 0xfe, 0x13, 0x3c, 0xa0, 0x73, 0xce, 0x37, 0x9e, 0xfb, 0xc5, 0x6e, 0x1d, 0x78, 0x69, 0xe3,
+	0x39, 0xdf, 0xe5, 0xf7, 0xfb, 0xbe, 0x73, 0xce, 0x7c, 0x73, 0xbe, 0xb3, 0xb0, 0x3a, 0xb0, 0xad,
+	0x43, 0x62, 0xaa, 0x66, 0x97, 0x34, 0xc9, 0xc3, 0xee, 0x7d, 0xd5, 0xec, 0x91, 0xe6, 0xe1, 0x66,
+	0xd3, 0x79, 0xd8, 0x18, 0xd8, 0x96, 0x63, 0xc9, 0x67, 0x3d, 0x81, 0x86, 0x2b, 0xd0, 0x38, 0xdc,
+	0x54, 0x96, 0xd5, 0xbe, 0x6e, 0x5a, 0x4d, 0xfe, 0x5f, 0x21, 0xaa, 0x54, 0xbb, 0x16, 0xed, 0x5b,
+	0xb4, 0xb9, 0xaf, 0x52, 0x66, 0x63, 0x9f, 0x38, 0xea, 0x66, 0xb3, 0x6b, 0xe9, 0x26, 0x8e, 0x9f,
+	0xc3, 0xf1, 0x3e, 0xed, 0x31, 0x17, 0x7d, 0xda, 0xc3, 0x81, 0x15, 0x31, 0xd0, 0xe1, 0xbf, 0x9a,
+	0xe2, 0x07, 0x0e, 0x9d, 0xee, 0x59, 0x3d, 0x4b, 0x3c, 0x67, 0xff, 0xc2, 0xa7, 0x57, 0x12, 0x50,
+	0x77, 0xad, 0x7e, 0x5f, 0x77, 0xfa, 0xc4, 0x74, 0x5c, 0xfd, 0x4b, 0x09, 0x92, 0x7d, 0xd5, 0x3e,
+	0x20, 0x4e, 0x86, 0x90, 0x65, 0x6b, 0xc4, 0xce, 0xb2, 0x34, 0x50, 0x6d, 0xb5, 0xef, 0x0a, 0x5d,
+	0x4e, 0x14, 0x3a, 0xf2, 0xa1, 0xaa, 0xff, 0x4e, 0x82, 0x53, 0xb7, 0x68, 0x6f, 0xd7, 0x26, 0xaa,
+	0x43, 0x76, 0xe8, 0x41, 0x9b, 0xbc, 0x3e, 0x24, 0xd4, 0x91, 0x77, 0xa1, 0xac, 0xd2, 0x83, 0x0e,
+	0xf7, 0x5b, 0x91, 0x6a, 0xd2, 0x95, 0x85, 0xad, 0x5a, 0x23, 0x3e, 0x01, 0x8d, 0x1d, 0x7a, 0xf0,
+	0x65, 0x26, 0xd7, 0x2a, 0xbd, 0xf7, 0xcf, 0xd5, 0x63, 0xed, 0x79, 0x15, 0x7f, 0xcb, 0x37, 0x40,
+	0xe6, 0x06, 0x3a, 0x5d, 0x66, 0x5e, 0xb7, 0xcc, 0xce, 0x3d, 0x42, 0x2a, 0x05, 0x6e, 0x6d, 0xa5,
+	0x81, 0xd1, 0x65, 0x39, 0x6a, 0x60, 0x8e, 0x1a, 0xbb, 0x96, 0x6e, 0xb6, 0x4f, 0x72, 0xa5, 0x5d,
+	0xd4, 0xd9, 0x23, 0x64, 0xfb, 0xf8, 0x77, 0x3e, 0x79, 0x77, 0xcd, 0x03, 0x54, 0xdf, 0x84, 0xd3,
+	0x41, 0xd0, 0x74, 0x60, 0x99, 0x94, 0xc8, 0x2b, 0x30, 0x2f, 0x1c, 0xea, 0x1a, 0x07, 0x5d, 0x6a,
+	0xcf, 0xf1, 0xdf, 0x37, 0xb5, 0x20, 0xd1, 0x96, 0xae, 0xf9, 0x88, 0xee, 0xeb, 0x5a, 0x3e, 0xa2,
+	0x2d, 0x5d, 0x0b, 0x10, 0xdd, 0xd7, 0xb5, 0xa9, 0x10, 0x1d, 0x01, 0x0a, 0x10, 0xe5, 0xa0, 0xb3,
+	0x89, 0xbe, 0x5f, 0x80, 0x33, 0x4c, 0x87, 0x4f, 0xc0, 0xbd, 0xa1, 0xa9, 0x51, 0x97, 0xea, 0x16,
+	0xcc, 0xa9, 0xdd, 0xae, 0x35, 0x34, 0x1d, 0xae, 0x53, 0x6e, 0x55, 0xfe, 0xfe, 0xfb, 0xf5, 0xd3,
+	0x88, 0x6e, 0x47, 0xd3, 0x6c, 0x42, 0xe9, 0x1d, 0xc7, 0xd6, 0xcd, 0x5e, 0xdb, 0x15, 0x94, 0xcf,
+	0x43, 0x59, 0x4c, 0x50, 0xe6, 0x89, 0x11, 0x5a, 0x6a, 0xcf, 0x8b, 0x07, 0x37, 0x35, 0xf9, 0x08,
+	0x66, 0xd5, 0x3e, 0xb7, 0x57, 0xac, 0x15, 0x53, 0xa9, 0xb6, 0xf6, 0x58, 0xc4, 0x7e, 0xf5, 0xaf,
+	0xd5, 0x2b, 0x3d, 0xdd, 0xb9, 0x3f, 0xdc, 0x6f, 0x74, 0xad, 0x3e, 0x2e, 0x2f, 0xfc, 0xdf, 0x3a,
+	0xd5, 0x0e, 0x9a, 0xce, 0xd1, 0x80, 0x50, 0xae, 0x40, 0x7f, 0xf4, 0xc9, 0xbb, 0x6b, 0x8b, 0x06,
+	0xe9, 0xa9, 0xdd, 0xa3, 0x0e, 0x5b, 0xb9, 0xf4, 0x97, 0x9f, 0xbc, 0xbb, 0x26, 0xb5, 0xd1, 0xa1,
+	0xfc, 0x2c, 0x2c, 0x06, 0x62, 0x5d, 0xca, 0x8a, 0xf5, 0x42, 0xd7, 0x0b, 0x33, 0x63, 0x45, 0x0e,
+	0x89, 0xe9, 0x74, 0x1c, 0xb5, 0x57, 0x99, 0x61, 0xb1, 0x68, 0xcf, 0xf3, 0x07, 0x77, 0xd5, 0xde,
+	0xf6, 0x22, 0xcb, 0x81, 0x1b, 0x80, 0x7a, 0x05, 0xce, 0x86, 0xa3, 0x29, 0x72, 0x50, 0x7f, 0x5d,
+	0xc4, 0x99, 0xcd, 0x12, 0x83, 0x4f, 0x03, 0x37, 0xce, 0x1b, 0x30, 0x4b, 0xf5, 0x9e, 0x49, 0xec,
+	0xcc, 0x30, 0xa3, 0x5c, 0x20, 0x9d, 0x85, 0x40, 0x3a, 0xb7, 0x17, 0x18, 0x1a, 0x94, 0x73, 0xc1,
+	0xf8, 0x5d, 0x22, 0x98, 0xbf, 0x16, 0x41, 0xbe, 0x45, 0x7b, 0x7b, 0xba, 0x61, 0xb4, 0x74, 0x8d,
+	0xfa, 0xa1, 0x10, 0xc3, 0xc8, 0x05, 0x85, 0xcb, 0xa5, 0x27, 0xfc, 0x0d, 0x09, 0x16, 0x1d, 0xcb,
+	0x51, 0x8d, 0x8e, 0x4a, 0x29, 0x71, 0xe8, 0xa3, 0xcb, 0xfb, 0x02, 0x77, 0xbb, 0xc3, 0xbd, 0xca,
+	0x75, 0x58, 0x1a, 0x2d, 0x91, 0x8e, 0xae, 0xd1, 0x4a, 0xa9, 0x56, 0xbc, 0x52, 0x6a, 0x2f, 0xb8,
+	0xeb, 0xf1, 0xa6, 0x46, 0xe5, 0xaf, 0x80, 0x22, 0x18, 0x75, 0x28, 0x71, 0x1c, 0x83, 0xf4, 0x59,
+	0xba, 0xef, 0x19, 0xaa, 0xc3, 0xa7, 0xcb, 0x4c, 0xd6, 0x74, 0x39, 0x27, 0x94, 0xef, 0x8c, 0x74,
+	0xf7, 0x0c, 0xd5, 0x61, 0x53, 0xe7, 0x15, 0x38, 0x3b, 0xda, 0x87, 0x82, 0xcb, 0x7d, 0x36, 0xcb,
+	0xe6, 0x29, 0x77, 0x63, 0xf4, 0xaf, 0x78, 0xcc, 0x2f, 0xf7, 0x56, 0x3f, 0x03, 0xa7, 0x02, 0x49,
+	0xc4, 0xe4, 0xfe, 0xc5, 0x4b, 0xee, 0x0e, 0x3d, 0x18, 0x25, 0xb7, 0x01, 0x33, 0xfb, 0xc3, 0xa3,
+	0x1c, 0xb9, 0x15, 0x62, 0xe9, 0xa9, 0x7d, 0x11, 0x44, 0x88, 0x3b, 0x03, 0x5b, 0xef, 0x92, 0x4a,
+	0x31, 0x83, 0x0c, 0x6e, 0x81, 0xc0, 0x75, 0x6e, 0x33, 0x15, 0x96, 0x15, 0x2f, 0x32, 0xbe, 0xac,
+	0xb8, 0xac, 0x59, 0x56, 0x7e, 0x28, 0xc1, 0x19, 0x0e, 0x26, 0x90, 0x15, 0x42, 0x68, 0x65, 0xe6,
+	0x51, 0xcd, 0xa4, 0x53, 0xdc, 0xbf, 0x2f, 0xb1, 0x84, 0x50, 0x96, 0x55, 0x6f, 0x46, 0x8d, 0x99,
+	0x55, 0x77, 0xd6, 0xf9, 0xb3, 0x0a, 0x2c, 0xab, 0x22, 0xec, 0xbe, 0xa4, 0x8a, 0xe4, 0x61, 0x52,
+	0x3f, 0x94, 0xf8, 0x62, 0xbe, 0xc5, 0x13, 0x20, 0xe0, 0xf8, 0x12, 0xab, 0x6a, 0x7d, 0xdd, 0xcc,
+	0x4e, 0x2c, 0x17, 0x4b, 0x4f, 0x6c, 0x24, 0x2d, 0xc5, 0x68, 0x5a, 0xf2, 0x2c, 0xa8, 0xcb, 0x70,
+	0x9c, 0x3c, 0x1c, 0x90, 0xae, 0xd3, 0x19, 0xa8, 0xb6, 0xa3, 0xab, 0x06, 0x5f, 0x44, 0xf3, 0xed,
+	0x25, 0xf1, 0xf4, 0xb6, 0x78, 0x88, 0xcc, 0x39, 0xae, 0xfa, 0x0a, 0x9c, 0x8b, 0x30, 0x44, 0xf6,
+	0xbf, 0x28, 0x42, 0x6d, 0x34, 0xb6, 0x3b, 0x2a, 0x96, 0xa6, 0x18, 0x87, 0x5d, 0x98, 0xd5, 0xcd,
+	0xc1, 0x70, 0xb4, 0x69, 0x5d, 0x4e, 0x2c, 0x67, 0xc4, 0xce, 0xbf, 0xc3, 0x5f, 0x34, 0x38, 0xcf,
+	0x51, 0x55, 0x7e, 0x09, 0xe6, 0xac, 0xa1, 0xc3, 0xad, 0x94, 0xc6, 0xb7, 0xe2, 0xea, 0xca, 0x2f,
+	0x40, 0xc9, 0x37, 0xe9, 0xc7, 0xb2, 0xc1, 0x15, 0x99, 0x01, 0x53, 0x3d, 0xa4, 0x95, 0xd9, 0x74,
+	0x03, 0xaf, 0x10, 0x87, 0x6f, 0x99, 0x7c, 0x81, 0xba, 0x06, 0x98, 0x62, 0xf0, 0x0d, 0x38, 0x17,
+	0x7a, 0x03, 0xfa, 0x73, 0x78, 0x09, 0x2e, 0xa6, 0xe4, 0x09, 0xb3, 0xf9, 0x6f, 0x09, 0xea, 0x23,
+	0xa9, 0x36, 0x31, 0x88, 0x4a, 0x89, 0x27, 0x4c, 0xa7, 0x92, 0xcf, 0x97, 0x01, 0x1c, 0xab, 0x63,
+	0x0b, 0x67, 0x93, 0xe4, 0xb4, 0xec, 0x58, 0x08, 0x35, 0x18, 0x8d, 0x52, 0x4a, 0x34, 0x2e, 0xc3,
+	0xa5, 0x54, 0x9e, 0x18, 0x8f, 0xff, 0x14, 0x7c, 0xf1, 0xb8, 0x6b, 0xab, 0x26, 0xbd, 0x47, 0x6c,
+	0x4f, 0x70, 0xd2, 0x78, 0xf8, 0x0a, 0xb8, 0x42, 0xde, 0x02, 0xee, 0x7f, 0x58, 0xa3, 0xad, 0xc1,
+	0x72, 0x77, 0x68, 0xdb, 0x2c, 0xae, 0x5e, 0x1a, 0x4b, 0x3c, 0x8d, 0x27, 0x70, 0xe0, 0x96, 0x6f,
+	0x97, 0x32, 0xc9, 0x03, 0x9f, 0xdc, 0x0c, 0x97, 0x5b, 0x30, 0xc9, 0x83, 0x91, 0x4c, 0x20, 0x4b,
+	0xb3, 0x39, 0xb3, 0x14, 0x17, 0x7d, 0xcc, 0xd2, 0x9f, 0xfc, 0xb3, 0xf6, 0x0e, 0x71, 0xf8, 0x56,
+	0xf7, 0xd2, 0x43, 0x87, 0xd8, 0xa6, 0x6a, 0xdc, 0xbc, 0x3e, 0x95, 0x59, 0xeb, 0xaf, 0xf4, 0x8a,
+	0x81, 0x4a, 0x4f, 0x5e, 0x85, 0x05, 0x82, 0xce, 0xdd, 0x40, 0x95, 0xdb, 0xe0, 0x3e, 0xba, 0xa9,
+	0x25, 0x52, 0x8c, 0x83, 0x8e, 0x14, 0xdf, 0x2c, 0x40, 0x65, 0x24, 0xf7, 0x55, 0xdd, 0xb9, 0xaf,
+	0xd9, 0xea, 0x83, 0xa9, 0x10, 0xbb, 0xc0, 0x97, 0xa3, 0x2a, 0xf4, 0x38, 0xb5, 0x32, 0x5b, 0x61,
+	0x68, 0xc8, 0x37, 0x0d, 0x4b, 0x8f, 0x78, 0x1a, 0x06, 0xc2, 0x76, 0x1e, 0x56, 0x62, 0xc2, 0x81,
+	0xc1, 0x7a, 0x5f, 0x82, 0x0b, 0xa3, 0xd1, 0x57, 0x07, 0x9a, 0xea, 0x90, 0xeb, 0xc4, 0x51, 0x75,
+	0x63, 0x3a, 0x1b, 0x58, 0x1b, 0x8e, 0xe3, 0xa0, 0x26, 0xbc, 0x60, 0xd1, 0x95, 0xb8, 0x89, 0x09,
+	0x60, 0x08, 0x09, 0x37, 0xb1, 0xa5, 0xbe, 0xff, 0x61, 0x80, 0x6b, 0x0d, 0xaa, 0x49, 0x6c, 0x90,
+	0xf0, 0x6f, 0xa2, 0x84, 0x5f, 0x32, 0xd5, 0x7d, 0x83, 0x68, 0xde, 0xf7, 0x43, 0x80, 0xb0, 0x92,
+	0x44, 0xb8, 0x22, 0xb9, 0x94, 0x57, 0x23, 0x94, 0x5b, 0x85, 0x8a, 0xe4, 0xa3, 0xbd, 0x0e, 0x27,
+	0xd5, 0x6e, 0x97, 0x0c, 0x1c, 0xdd, 0xec, 0x89, 0x8a, 0x43, 0x10, 0x9f, 0xe7, 0x72, 0x27, 0x46,
+	0x63, 0x7c, 0x4a, 0x53, 0xf1, 0x35, 0xe6, 0x82, 0xa8, 0x3f, 0x0e, 0xd5, 0x24, 0xc0, 0x82, 0xd3,
+	0x76, 0xa1, 0x22, 0xd5, 0xdf, 0x91, 0xe0, 0x72, 0x48, 0x6c, 0x27, 0x68, 0x76, 0x2a, 0x09, 0xfd,
+	0x42, 0x12, 0xb3, 0x28, 0x2b, 0x7f, 0x9e, 0xae, 0xc0, 0xe7, 0xb2, 0xc0, 0x7a, 0xf9, 0xaa, 0x85,
+	0x44, 0x5f, 0xa5, 0x6e, 0x2d, 0x3b, 0x15, 0x4a, 0x5b, 0x70, 0x46, 0x35, 0x0c, 0xeb, 0x41, 0x67,
+	0x48, 0x03, 0x35, 0x3b, 0xf2, 0x3a, 0xc5, 0x07, 0x3d, 0x0c, 0x6c, 0x28, 0xb1, 0x7a, 0x88, 0x02,
+	0x46, 0x5a, 0x7f, 0x96, 0x60, 0x2d, 0x29, 0x02, 0xd3, 0xae, 0x22, 0x9e, 0x84, 0x33, 0x5e, 0xce,
+	0x7c, 0x87, 0x76, 0x48, 0xf0, 0xb4, 0x1a, 0x03, 0x24, 0xc0, 0x70, 0x1d, 0xae, 0xe6, 0xc2, 0x8e,
+	0x5c, 0x7f, 0x2b, 0xc1, 0xe7, 0x43, 0xf2, 0x37, 0x4d, 0x87, 0xd8, 0x7d, 0xa2, 0xe9, 0xaa, 0x7d,
+	0x74, 0x9d, 0x98, 0x56, 0x7f, 0x2a, 0x44, 0xd7, 0x41, 0xd6, 0x7d, 0x8e, 0x3a, 0x1a, 0xf3, 0x84,
+	0xfb, 0xf4, 0xb2, 0x1e, 0x86, 0x10, 0xa0, 0xb8, 0x06, 0x57, 0xb2, 0x21, 0x23, 0xbf, 0x5f, 0x47,
+	0x97, 0x5e, 0x9b, 0x7c, 0x9d, 0x74, 0x9d, 0x3b, 0x8e, 0x6a, 0x90, 0x57, 0xd4, 0xc3, 0xe9, 0xa4,
+	0x71, 0x0d, 0x96, 0x6d, 0xee, 0xa6, 0x43, 0x99, 0x9f, 0x0e, 0x2f, 0x8e, 0x71, 0xed, 0xd9, 0x41,
+	0xff, 0x19, 0x6b, 0x2f, 0x82, 0x16, 0x89, 0xbd, 0x5d, 0xf0, 0x4d, 0xe5, 0x5b, 0xaa, 0xa9, 0xf6,
+	0xc8, 0x6d, 0x62, 0xf7, 0x75, 0x4a, 0x75, 0xcb, 0xa4, 0xd3, 0x7a, 0xa5, 0xda, 0xe4, 0xd0, 0x3a,
+	0x20, 0x1d, 0xd5, 0x30, 0x78, 0xf9, 0x56, 0x6e, 0x97, 0xc5, 0x93, 0x1d, 0xc3, 0x90, 0xf7, 0xa0,
+	0xcc, 0x0b, 0x60, 0xf6, 0x1b, 0xdf, 0xaa, 0x97, 0x52, 0xea, 0x5f, 0x42, 0xe9, 0x0d, 0x5b, 0x1d,
+	0x55, 0xbf, 0xf3, 0xac, 0xfa, 0x65, 0xaa, 0xf2, 0x75, 0x98, 0x77, 0xac, 0x4e, 0x8f, 0x8d, 0x55,
+	0x66, 0xc6, 0x35, 0x33, 0xe7, 0x58, 0xfc, 0x67, 0x20, 0xaa, 0x8f, 0x43, 0x3d, 0x2d, 0x54, 0x6e,
+	0x44, 0x8b, 0x50, 0x0d, 0x89, 0xb5, 0xc9, 0xeb, 0x3b, 0x8e, 0x33, 0xb5, 0xed, 0x79, 0x99, 0x7f,
+	0xd9, 0x93, 0x0e, 0xfb, 0x1e, 0x16, 0xc5, 0x0a, 0x46, 0xf5, 0x78, 0xd7, 0x3d, 0x4a, 0xbe, 0xcb,
+	0x2a, 0x16, 0xb9, 0x09, 0xa7, 0x83, 0xa2, 0x36, 0xe9, 0x5b, 0x87, 0x22, 0xca, 0xe5, 0xf6, 0xb2,
+	0x4f, 0xba, 0xcd, 0x07, 0x7c, 0xb6, 0xd9, 0x77, 0x34, 0xda, 0x9e, 0xf1, 0xdb, 0x6e, 0xe9, 0x5a,
+	0xd8, 0x36, 0x8a, 0xa2, 0xed, 0x59, 0xbf, 0x6d, 0x2e, 0x8d, 0xb6, 0x9f, 0x82, 0x0a, 0x2a, 0x78,
+	0xfb, 0x93, 0xeb, 0x62, 0x8e, 0x2b, 0x9d, 0x11, 0xe3, 0xde, 0x7e, 0x23, 0x3c, 0x3d, 0x07, 0xe7,
+	0x63, 0x15, 0xd1, 0xe1, 0x3c, 0xd7, 0xad, 0x44, 0x75, 0x85, 0xdf, 0x40, 0x46, 0x2f, 0xc2, 0x6a,
+	0x62, 0xaa, 0x30, 0x9d, 0xaf, 0xf1, 0x8f, 0x7d, 0x71, 0x54, 0x7d, 0x5b, 0x34, 0x19, 0xdc, 0x34,
+	0xbe, 0x00, 0x73, 0xd8, 0x76, 0xc0, 0x13, 0xf6, 0xd5, 0xa4, 0x09, 0x86, 0x8a, 0xee, 0xe4, 0x42,
+	0xad, 0xba, 0x02, 0x95, 0xa8, 0xed, 0x80, 0x5f, 0xb1, 0xe9, 0x4e, 0xc7, 0x6f, 0xc8, 0x36, 0xfa,
+	0x7d, 0x47, 0xe2, 0x8e, 0xc5, 0x7e, 0x11, 0x72, 0xbc, 0x01, 0xb3, 0x8e, 0x6a, 0xf7, 0x48, 0xf6,
+	0x41, 0x3b, 0xca, 0x31, 0x0d, 0x6a, 0x0d, 0xed, 0x2e, 0xc9, 0xfc, 0xb2, 0x43, 0xb9, 0xf0, 0xe7,
+	0x42, 0x31, 0xf2, 0xb9, 0x20, 0x4e, 0x16, 0x85, 0x7d, 0x64, 0x12, 0x02, 0xeb, 0x7e, 0x24, 0x48,
+	0xd1, 0x41, 0x3a, 0x39, 0x95, 0x2d, 0x98, 0x13, 0x10, 0x69, 0xa5, 0x50, 0x2b, 0xa6, 0xaa, 0xb8,
+	0x82, 0x41, 0xac, 0xa2, 0x48, 0x0f, 0xc3, 0x41, 0xb0, 0xdf, 0x10, 0x53, 0x81, 0x1f, 0x81, 0xc7,
+	0x60, 0xc5, 0x20, 0x4a, 0x39, 0x83, 0x78, 0x11, 0x16, 0x7d, 0x41, 0x44, 0xc0, 0xed, 0x05, 0x2f,
+	0x8a, 0x2e, 0x34, 0x21, 0x8f, 0xd0, 0xc2, 0xde, 0x11, 0xda, 0x1f, 0x45, 0x39, 0xbd, 0xcb, 0x67,
+	0x15, 0x8e, 0xde, 0xe5, 0x94, 0x26, 0x07, 0x18, 0xca, 0x72, 0x21, 0x9c, 0x65, 0xf9, 0x29, 0x00,
+	0xf6, 0xe1, 0x8c, 0x39, 0x2a, 0x66, 0x98, 0x2d, 0x9b, 0xe4, 0x81, 0x80, 0x14, 0xe4, 0x25, 0xbe,
+	0x15, 0x62, 0x91, 0x23, 0xb9, 0x9f, 0x4a, 0x9c, 0xfa, 0x0d, 0xeb, 0x50, 0x2c, 0x43, 0xf7, 0x0c,
+	0x44, 0x10, 0xbb, 0x06, 0x65, 0x75, 0xe8, 0xdc, 0xb7, 0x6c, 0xdd, 0x39, 0xca, 0xe4, 0xe6, 0x89,
+	0xca, 0xcf, 0xc2, 0xac, 0xd8, 0x9f, 0xb1, 0x59, 0x56, 0x4d, 0xff, 0xf6, 0x71, 0x4f, 0xe3, 0x84,
+	0x8e, 0xdb, 0x16, 0x74, 0xad, 0xd5, 0x1f, 0x03, 0x25, 0x0e, 0x22, 0x32, 0xf8, 0xc3, 0x12, 0x5f,
+	0xb0, 0x37, 0xac, 0x43, 0xb1, 0x83, 0xed, 0x11, 0x42, 0x3f, 0x2d, 0xfe, 0xd4, 0x17, 0xce, 0xab,
+	0x70, 0x4e, 0xd5, 0x34, 0x76, 0x8a, 0xdc, 0xf1, 0xbd, 0x4d, 0x58, 0x0f, 0x22, 0xfb, 0x2c, 0x46,
+	0x10, 0x3d, 0xa5, 0x6a, 0xda, 0x1e, 0x21, 0xa3, 0x46, 0x27, 0x6b, 0x42, 0xc8, 0x5f, 0x03, 0x45,
+	0xec, 0xe0, 0xb1, 0x96, 0x4b, 0xf9, 0x2c, 0x9f, 0x15, 0x26, 0x22, 0xc6, 0xa3, 0x98, 0xd9, 0x5b,
+	0x8a, 0x5b, 0x9e, 0x99, 0x00, 0x73, 0x4b, 0xd7, 0x92, 0x31, 0x8f, 0x2c, 0xcf, 0x4e, 0x86, 0xd9,
+	0x35, 0xde, 0x85, 0xaa, 0x8b, 0x39, 0xbe, 0xe5, 0x53, 0x99, 0xcb, 0xe7, 0x40, 0x11, 0xd0, 0xef,
+	0xc4, 0xb4, 0x7e, 0x64, 0x1d, 0x2e, 0xfa, 0x18, 0x24, 0xf8, 0x99, 0xcf, 0xe7, 0xe7, 0xc2, 0x88,
+	0x48, 0xac, 0x2b, 0x13, 0x6a, 0xc9, 0x7c, 0x6c, 0xd6, 0x63, 0xa0, 0x95, 0x72, 0xad, 0x98, 0xd6,
+	0xa9, 0xde, 0x23, 0xa4, 0xcd, 0x04, 0xd1, 0xe1, 0x63, 0xf1, 0xc4, 0xb8, 0x08, 0x95, 0x1d, 0xb8,
+	0x94, 0x4a, 0x0d, 0x5d, 0xc2, 0x58, 0x2e, 0x57, 0x13, 0x39, 0xa2, 0x57, 0x15, 0x2e, 0xb8, 0x2c,
+	0xa3, 0x1d, 0x21, 0x16, 0xcc, 0x85, 0x7c, 0xc1, 0x5c, 0x11, 0xdc, 0x5a, 0xc3, 0xa3, 0x48, 0x20,
+	0x7b, 0x50, 0xf3, 0x11, 0x8b, 0xf7, 0xb2, 0x98, 0xcf, 0xcb, 0x63, 0x23, 0x3a, 0x71, 0x8e, 0x0c,
+	0x58, 0x4d, 0xe4, 0x82, 0xd1, 0x5b, 0x1a, 0x2b, 0x7a, 0xe7, 0x63, 0x49, 0x61, 0xe4, 0x6c, 0xa8,
+	0xa7, 0xd1, 0x42, 0x87, 0xc7, 0xc7, 0x72, 0x58, 0x4d, 0xe2, 0x87, 0x3e, 0x7d, 0x6b, 0x2c, 0x5a,
+	0x53, 0xf2, 0x40, 0x9e, 0x18, 0x6b, 0x8d, 0xed, 0x86, 0xaa, 0xce, 0x98, 0x35, 0x96, 0xe0, 0xe7,
+	0xe4, 0xb8, 0x6b, 0x2c, 0xd6, 0xd5, 0xcb, 0x50, 0xa7, 0xc4, 0x11, 0x7e, 0x3c, 0x07, 0xbe, 0x28,
+	0xee, 0xeb, 0x03, 0x5a, 0x59, 0xe6, 0x3b, 0x7a, 0x95, 0x12, 0x87, 0xd9, 0x09, 0x75, 0x3f, 0xd8,
+	0xbf, 0x5a, 0xfa, 0x80, 0x35, 0x0f, 0x1f, 0x1f, 0x9a, 0x39, 0xac, 0xc9, 0xfc, 0x7b, 0xb4, 0x36,
+	0x34, 0xd3, 0xed, 0x45, 0x5e, 0x6b, 0xa2, 0x76, 0x0b, 0xbd, 0xb7, 0xf0, 0xa5, 0xf6, 0x2d, 0x77,
+	0x6c, 0xd7, 0xb0, 0xe8, 0x67, 0xf4, 0x52, 0x4e, 0x7b, 0xa9, 0x45, 0xc0, 0x9d, 0x87, 0x95, 0x18,
+	0x00, 0x88, 0xee, 0xe7, 0xa3, 0xa2, 0x41, 0x7c, 0x5c, 0xdf, 0xe6, 0x37, 0x94, 0x3e, 0x83, 0xa2,
+	0x41, 0x5c, 0x75, 0xca, 0x2a, 0x1a, 0x84, 0x3b, 0xb7, 0x68, 0x10, 0x3a, 0xdb, 0x27, 0x83, 0x04,
+	0x2a, 0x52, 0xbd, 0x06, 0x4a, 0x1c, 0x48, 0xdf, 0x81, 0xe2, 0x4f, 0x44, 0xaf, 0xf6, 0xff, 0x87,
+	0x44, 0x38, 0x0b, 0xa2, 0xd3, 0x1a, 0x87, 0xbf, 0xfe, 0xb7, 0x02, 0x1f, 0xbb, 0x43, 0x4c, 0x6d,
+	0xc7, 0xd4, 0xc4, 0xa4, 0x0b, 0x5c, 0x0f, 0x31, 0xb5, 0x7c, 0xd7, 0x43, 0x98, 0x1c, 0x2b, 0x37,
+	0x7d, 0xc7, 0xfc, 0x59, 0xdf, 0x2a, 0xb1, 0x0d, 0x80, 0x47, 0xde, 0x87, 0x0a, 0xcc, 0xe7, 0x52,
+	0xa8, 0x48, 0x4b, 0xbd, 0x0a, 0xe4, 0x5e, 0xce, 0x60, 0xd4, 0x71, 0x19, 0x86, 0xe2, 0x28, 0x82,
+	0xbc, 0xf5, 0xf6, 0x45, 0x28, 0xde, 0xa2, 0x3d, 0xf9, 0x1e, 0x94, 0x47, 0xf5, 0x94, 0x7c, 0x35,
+	0xb1, 0x98, 0x8d, 0x5e, 0xb8, 0x53, 0x9e, 0xc8, 0x27, 0x2c, 0xfc, 0x79, 0x7e, 0x5a, 0xba, 0x96,
+	0xc3, 0x8f, 0x77, 0xdf, 0x4d, 0x79, 0x22, 0x9f, 0x30, 0xfa, 0x31, 0x60, 0xc1, 0x77, 0xf5, 0x49,
+	0x5e, 0x4f, 0x53, 0x8e, 0x5c, 0x38, 0x53, 0x1a, 0x79, 0xc5, 0x7d, 0xde, 0xbc, 0xbb, 0x4d, 0xe9,
+	0xde, 0x22, 0xd7, 0xae, 0x94, 0x46, 0x5e, 0x71, 0xf4, 0xd6, 0x85, 0x79, 0xf7, 0xa6, 0x8d, 0xbc,
+	0x96, 0xa2, 0x1b, 0xba, 0x53, 0xa5, 0x5c, 0xcd, 0x25, 0x1b, 0x74, 0xc2, 0x6e, 0x7e, 0x64, 0x3a,
+	0xf1, 0xdd, 0xed, 0x51, 0xae, 0xe6, 0x92, 0x45, 0x27, 0x16, 0x2c, 0xfa, 0x2f, 0x59, 0xc8, 0x69,
+	0x91, 0x88, 0xb9, 0x6f, 0xa2, 0x34, 0x73, 0xcb, 0xa3, 0xc3, 0x37, 0xd9, 0x7e, 0x18, 0x7b, 0x25,
+	0x40, 0xfe, 0x52, 0xa6, 0xad, 0x84, 0xdb, 0x1e, 0xca, 0xd3, 0x13, 0x68, 0x22, 0x9e, 0x1f, 0xb0,
+	0x13, 0x8c, 0x84, 0xa6, 0xbc, 0xbc, 0x9d, 0x69, 0x37, 0xf1, 0xc6, 0x82, 0xf2, 0xcc, 0x44, 0xba,
+	0x11, 0x54, 0xd1, 0x26, 0x74, 0x0e, 0x54, 0x89, 0xf7, 0x06, 0x94, 0x67, 0x26, 0xd2, 0x8d, 0xa0,
+	0x8a, 0xf6, 0x8d, 0x73, 0xa0, 0x4a, 0xec, 0x93, 0x2b, 0xcf, 0x4c, 0xa4, 0x8b, 0xa8, 0x86, 0x70,
+	0x3c, 0xd8, 0x95, 0x95, 0x37, 0x32, 0xcd, 0x85, 0xfa, 0xd9, 0xca, 0xe6, 0x18, 0x1a, 0xe8, 0xf6,
+	0x0d, 0x76, 0x2b, 0x38, 0xda, 0x21, 0x95, 0xbf, 0x98, 0x69, 0x2a, 0xae, 0x3f, 0xac, 0x5c, 0x1b,
+	0x57, 0x0d, 0x61, 0x7c, 0x3f, 0x04, 0x03, 0x9b, 0x9a, 0xb9, 0x61, 0x04, 0xbb, 0xb6, 0xca, 0xb5,
+	0x71, 0xd5, 0xb0, 0x54, 0x28, 0x7e, 0xaf, 0x20, 0xc9, 0x3f, 0x96, 0xe0, 0x7c, 0x4a, 0x33, 0x52,
+	0x7e, 0x2e, 0xa7, 0xf1, 0xf8, 0x8e, 0xab, 0xf2, 0xfc, 0xa4, 0xea, 0x91, 0xad, 0x27, 0xdc, 0x4f,
+	0xcc, 0xb1, 0xf5, 0x24, 0xf4, 0x4c, 0x95, 0xa7, 0x27, 0xd0, 0x44, 0x3c, 0xef, 0xb0, 0x9e, 0x6c,
+	0x46, 0xf7, 0x4f, 0x6e, 0x8d, 0x4b, 0x3a, 0x66, 0x2b, 0xda, 0xfd, 0x54, 0x36, 0x10, 0xed, 0xcf,
+	0xd8, 0x11, 0x65, 0x5a, 0x23, 0x4f, 0x7e, 0x21, 0xa7, 0x9b, 0xa4, 0xae, 0xa5, 0xf2, 0xe2, 0xe4,
+	0x06, 0x10, 0x64, 0x78, 0x06, 0x86, 0x5a, 0x72, 0xb9, 0x67, 0x60, 0x7c, 0xe3, 0x51, 0x79, 0x7e,
+	0x52, 0x75, 0x84, 0xf7, 0x16, 0x3b, 0xf8, 0x8f, 0xef, 0x6d, 0xc9, 0xd9, 0x13, 0x29, 0xa9, 0x75,
+	0xa8, 0x6c, 0x4f, 0xa2, 0x8a, 0x90, 0xbe, 0x2b, 0xc1, 0xe9, 0xb8, 0xe6, 0x8c, 0x7c, 0x2d, 0xa7,
+	0xd1, 0x50, 0xe3, 0x4d, 0x79, 0x6a, 0x6c, 0x3d, 0x44, 0x62, 0xc3, 0x52, 0xa0, 0x4d, 0x23, 0x37,
+	0x33, 0xeb, 0xcd, 0x60, 0xef, 0x44, 0xd9, 0xc8, 0xaf, 0xe0, 0xf9, 0x0c, 0xb4, 0x68, 0x52, 0x7d,
+	0xc6, 0x35, 0x8a, 0x94, 0x8d, 0xfc, 0x0a, 0x9e, 0xcf, 0x40, 0x83, 0x22, 0xd5, 0x67, 0x5c, 0x8f,
+	0x48, 0xd9, 0xc8, 0xaf, 0xe0, 0xbd, 0x23, 0x03, 0x03, 0x54, 0xce, 0x6d, 0x83, 0xe6, 0x79, 0x47,
+	0xc6, 0x77, 0x5c, 0x98, 0xdb, 0x60, 0xc3, 0x23, 0xd5, 0x6d, 0x6c, 0x67, 0x46, 0xd9, 0x1c, 0x43,
+	0xc3, 0xf7, 0x6a, 0x8e, 0x69, 0x48, 0xa4, 0xbe, 0x13, 0x93, 0x5b, 0x2f, 0xca, 0xb5, 0x71, 0xd5,
+	0x10, 0xc6, 0x43, 0x38, 0x11, 0x6a, 0x28, 0xc8, 0x69, 0x64, 0xe2, 0xfb, 0x23, 0xca, 0xd6, 0x38,
+	0x2a, 0xde, 0x14, 0x0b, 0x9c, 0xf9, 0xa4, 0x4e, 0xb1, 0xb8, 0xae, 0x86, 0xb2, 0x91, 0x5f, 0xc1,
+	0xcb, 0x75, 0xf0, 0x28, 0x47, 0xce, 0xb0, 0x11, 0x3d, 0x76, 0x52, 0x36, 0xc7, 0xd0, 0x40, 0xb7,
+	0xdf, 0xe4, 0x41, 0xf6, 0x1f, 0x5f, 0x64, 0x05, 0x39, 0xe6, 0x28, 0x46, 0xd9, 0x1a, 0x47, 0xc5,
+	0x5f, 0xf2, 0x58, 0xb0, 0x18, 0xf0, 0x9d, 0xf6, 0xfd, 0x14, 0xe7, 0xb8, 0x99, 0x5b, 0x1e, 0xf9,
+	0x3a, 0xb0, 0x14, 0x38, 0x47, 0x48, 0x4d, 0x6d, 0xdc, 0xc9, 0x8d, 0xb2, 0x91, 0x5f, 0x01, 0x99,
+	0x1e, 0x53, 0x66, 0xbe, 0xcd, 0x8e, 0x45, 0x5a, 0xe4, 0xbd, 0x8f, 0xaa, 0xd2, 0x07, 0x1f, 0x55,
+	0xa5, 0x0f, 0x3f, 0xaa, 0x4a, 0x6f, 0x7d, 0x5c, 0x3d, 0xf6, 0xc1, 0xc7, 0xd5, 0x63, 0xff, 0xf8,
+	0xb8, 0x7a, 0x0c, 0x56, 0x74, 0x2b, 0xc1, 0xec, 0x6d, 0xe9, 0xb5, 0x86, 0xef, 0x34, 0xc6, 0x13,
+	0x5a, 0xd7, 0x2d, 0xdf, 0xaf, 0xe6, 0xc3, 0xd1, 0xdf, 0x1a, 0xee, 0xcf, 0xf2, 0x3f, 0x30, 0x7c,
+	0xf2, 0xbf, 0x03, 0x00, 0x3d, 0x47, 0x37, 0x69, 0xd8, 0x39, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3927,6 +4035,8 @@ type MsgClient interface {
 	MarketUpdateAcceptingCommitments(ctx context.Context, in *MsgMarketUpdateAcceptingCommitmentsRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAcceptingCommitmentsResponse, error)
 	// MarketUpdateIntermediaryDenom sets a market's intermediary denom.
 	MarketUpdateIntermediaryDenom(ctx context.Context, in *MsgMarketUpdateIntermediaryDenomRequest, opts ...grpc.CallOption) (*MsgMarketUpdateIntermediaryDenomResponse, error)
+	// MarketUpdateRejectStaleNavs is a market endpoint to update whether it rejects stale NAVs.
+	MarketUpdateRejectStaleNavs(ctx context.Context, in *MsgMarketUpdateRejectStaleNavsRequest, opts ...grpc.CallOption) (*MsgMarketUpdateRejectStaleNavsResponse, error)
 	// MarketManagePermissions is a market endpoint to manage a market's user permissions.
 	MarketManagePermissions(ctx context.Context, in *MsgMarketManagePermissionsRequest, opts ...grpc.CallOption) (*MsgMarketManagePermissionsResponse, error)
 	// MarketManageReqAttrs is a market endpoint to manage the attributes required to interact with it.
@@ -4130,6 +4240,15 @@ func (c *msgClient) MarketUpdateIntermediaryDenom(ctx context.Context, in *MsgMa
 	return out, nil
 }
 
+func (c *msgClient) MarketUpdateRejectStaleNavs(ctx context.Context, in *MsgMarketUpdateRejectStaleNavsRequest, opts ...grpc.CallOption) (*MsgMarketUpdateRejectStaleNavsResponse, error) {
+	out := new(MsgMarketUpdateRejectStaleNavsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateRejectStaleNavs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) MarketManagePermissions(ctx context.Context, in *MsgMarketManagePermissionsRequest, opts ...grpc.CallOption) (*MsgMarketManagePermissionsResponse, error) {
 	out := new(MsgMarketManagePermissionsResponse)
 	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketManagePermissions", in, out, opts...)
@@ -4296,6 +4415,8 @@ type MsgServer interface {
 	MarketUpdateAcceptingCommitments(context.Context, *MsgMarketUpdateAcceptingCommitmentsRequest) (*MsgMarketUpdateAcceptingCommitmentsResponse, error)
 	// MarketUpdateIntermediaryDenom sets a market's intermediary denom.
 	MarketUpdateIntermediaryDenom(context.Context, *MsgMarketUpdateIntermediaryDenomRequest) (*MsgMarketUpdateIntermediaryDenomResponse, error)
+	// MarketUpdateRejectStaleNavs is a market endpoint to update whether it rejects stale NAVs.
+	MarketUpdateRejectStaleNavs(context.Context, *MsgMarketUpdateRejectStaleNavsRequest) (*MsgMarketUpdateRejectStaleNavsResponse, error)
 	// MarketManagePermissions is a market endpoint to manage a market's user permissions.
 	MarketManagePermissions(context.Context, *MsgMarketManagePermissionsRequest) (*MsgMarketManagePermissionsResponse, error)
 	// MarketManageReqAttrs is a market endpoint to manage the attributes required to interact with it.
@@ -4386,6 +4507,9 @@ func (*UnimplementedMsgServer) MarketUpdateAcceptingCommitments(ctx context.Cont
 func (*UnimplementedMsgServer) MarketUpdateIntermediaryDenom(ctx context.Context, req *MsgMarketUpdateIntermediaryDenomRequest) (*MsgMarketUpdateIntermediaryDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateIntermediaryDenom not implemented")
 }
+func (*UnimplementedMsgServer) MarketUpdateRejectStaleNavs(ctx context.Context, req *MsgMarketUpdateRejectStaleNavsRequest) (*MsgMarketUpdateRejectStaleNavsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateRejectStaleNavs not implemented")
+}
 func (*UnimplementedMsgServer) MarketManagePermissions(ctx context.Context, req *MsgMarketManagePermissionsRequest) (*MsgMarketManagePermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarketManagePermissions not implemented")
 }
@@ -4757,6 +4881,24 @@ func _Msg_MarketUpdateIntermediaryDenom_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MarketUpdateRejectStaleNavs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketUpdateRejectStaleNavsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MarketUpdateRejectStaleNavs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateRejectStaleNavs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketUpdateRejectStaleNavs(ctx, req.(*MsgMarketUpdateRejectStaleNavsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_MarketManagePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgMarketManagePermissionsRequest)
 	if err := dec(in); err != nil {
@@ -5086,6 +5228,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MarketUpdateIntermediaryDenom",
 			Handler:    _Msg_MarketUpdateIntermediaryDenom_Handler,
 		},
+		{
+			MethodName: "MarketUpdateRejectStaleNavs",
+			Handler:    _Msg_MarketUpdateRejectStaleNavs_Handler,
+		},
 		{
 			MethodName: "MarketManagePermissions",
 			Handler:    _Msg_MarketManagePermissions_Handler,
@@ -6617,6 +6763,74 @@ func (m *MsgMarketUpdateIntermediaryDenomResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMarketUpdateRejectStaleNavsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateRejectStaleNavsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateRejectStaleNavsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectStaleNavs {
+		i--
+		if m.RejectStaleNavs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketUpdateRejectStaleNavsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateRejectStaleNavsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateRejectStaleNavsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgMarketManagePermissionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8428,6 +8642,34 @@ func (m *MsgMarketUpdateIntermediaryDenomResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgMarketUpdateRejectStaleNavsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if m.RejectStaleNavs {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMarketUpdateRejectStaleNavsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgMarketManagePermissionsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12985,6 +13227,177 @@ func (m *MsgMarketUpdateIntermediaryDenomResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgMarketUpdateRejectStaleNavsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarketUpdateRejectStaleNavsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarketUpdateRejectStaleNavsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectStaleNavs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RejectStaleNavs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMarketUpdateRejectStaleNavsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarketUpdateRejectStaleNavsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarketUpdateRejectStaleNavsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgMarketManagePermissionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,39 +14,52 @@ import (
 // BeginBlocker returns the begin blocker for the marker module.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper, bk bankkeeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
-	// Iterate through all marker accounts and check for supply above or below expected targets.
-	var err error
-	k.IterateMarkers(ctx, func(record types.MarkerAccountI) bool {
+	// Check the supply of the fixed-supply markers whose supply or status changed, and adjust it where it isn't at the target.
+	for _, addr := range k.GetSupplyCheckQueue(ctx) {
+		k.DequeueSupplyCheck(ctx, addr)
+		record, err := k.GetMarker(ctx, addr)
 		// Supply checks are only done against active markers with a fixed supply.
-		if record.GetStatus() == types.StatusActive && record.HasFixedSupply() {
-			requiredSupply := record.GetSupply()
-			currentSupply := bk.GetSupply(ctx, record.GetDenom())
+		if err != nil || record == nil || record.GetStatus() != types.StatusActive || !record.HasFixedSupply() {
+			continue
+		}
+		requiredSupply := record.GetSupply()
+		currentSupply := bk.GetSupply(ctx, record.GetDenom())
 
-			// If the current amount of marker coin in circulation doesn't match configured supply, make adjustments
-			if !requiredSupply.Equal(currentSupply) {
-				ctx.Logger().Error(
-					fmt.Sprintf("Current %s supply is NOT at the required amount, adjusting %s to required supply level",
-						record.GetDenom(), currentSupply))
-				err = k.AdjustCirculation(ctx, record, requiredSupply)
+		// If the current amount of marker coin in circulation doesn't match configured supply, make adjustments
+		if !requiredSupply.Equal(currentSupply) {
+			ctx.Logger().Error(
+				fmt.Sprintf("Current %s supply is NOT at the required amount, adjusting %s to required supply level",
+					record.GetDenom(), currentSupply))
+			// We have no way of dealing with this and the invariant will fail soon from mismatch halting the chain.
+			if err = k.AdjustCirculation(ctx, record, requiredSupply); err != nil {
+				panic(err)
 			}
-			// else supply is equal, nothing to do here.
 		}
-		// Clear out markers that are in the destroyed status
-		if record.GetStatus() == types.StatusDestroyed {
-			k.RemoveMarker(ctx, record)
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					"beginblock",
-					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-					sdk.NewAttribute(sdk.AttributeKeyAction, types.EventTypeDestroy),
-					sdk.NewAttribute(types.EventAttributeDenomKey, record.GetDenom()),
-				),
-			)
+		// else supply is equal, nothing to do here.
+	}
+
+	// Clear out markers that are in the destroyed status
+	for _, addr := range k.GetDestroyedMarkers(ctx) {
+		record, err := k.GetMarker(ctx, addr)
+		if err != nil || record == nil {
+			continue
 		}
-		return err != nil
-	})
-	// We have no way of dealing with this and the invariant will fail soon from mismatch halting the chain.
-	if err != nil {
-		panic(err)
+		k.RemoveMarker(ctx, record)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"beginblock",
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(sdk.AttributeKeyAction, types.EventTypeDestroy),
+				sdk.NewAttribute(types.EventAttributeDenomKey, record.GetDenom()),
+			),
+		)
 	}
+
+	k.RemoveExpiredHolderFreezes(ctx)
+	k.RemoveExpiredAccessGrants(ctx)
+	k.RemoveExpiredOperations(ctx)
+	k.EmitNetAssetValueStaleEvents(ctx)
+	k.ProcessDistributionSnapshots(ctx, types.MaxDistributionSnapshotHoldersPerBlock)
+	k.ProcessDistributionPayments(ctx, types.MaxDistributionPaymentsPerBlock)
+	k.ProcessHolderSnapshots(ctx, types.MaxHolderSnapshotHoldersPerBlock)
 }
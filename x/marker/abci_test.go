@@ -67,3 +67,38 @@ func TestBeginBlocker(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, deleted)
 }
+
+func TestBeginBlockerOnlyChecksChangedMarkers(t *testing.T) {
+	app := piosimapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	testmint := &types.MarkerAccount{
+		BaseAccount: &authtypes.BaseAccount{
+			AccountNumber: 1,
+			Address:       types.MustGetMarkerAddress("testmint").String(),
+		},
+		Status:      types.StatusActive,
+		SupplyFixed: true,
+		Denom:       "testmint",
+		Supply:      sdkmath.NewInt(100),
+	}
+	require.NoError(t, app.MarkerKeeper.SetMarker(ctx, app.MarkerKeeper.NewMarker(ctx, testmint)), "SetMarker")
+	marker.BeginBlocker(ctx, *app.MarkerKeeper, app.BankKeeper)
+	require.Equal(t, sdkmath.NewInt(100), app.BankKeeper.GetSupply(ctx, "testmint").Amount, "supply after first begin block")
+	require.Empty(t, app.MarkerKeeper.GetSupplyCheckQueue(ctx), "supply check queue after first begin block")
+
+	// Saving the marker without changing its supply or status should not queue a supply check.
+	loaded, err := app.MarkerKeeper.GetMarker(ctx, testmint.GetAddress())
+	require.NoError(t, err, "GetMarker")
+	require.NoError(t, loaded.GrantAccess(types.NewAccessGrant(sdk.AccAddress("admin_______________"), []types.Access{types.Access_Mint})), "GrantAccess")
+	require.NoError(t, app.MarkerKeeper.SetMarker(ctx, loaded), "SetMarker with new access")
+	require.Empty(t, app.MarkerKeeper.GetSupplyCheckQueue(ctx), "supply check queue after access change")
+
+	// Changing the supply queues a check that's handled in the next begin block.
+	require.NoError(t, loaded.SetSupply(sdk.NewInt64Coin("testmint", 40)), "SetSupply")
+	require.NoError(t, app.MarkerKeeper.SetMarker(ctx, loaded), "SetMarker with new supply")
+	require.Equal(t, []sdk.AccAddress{testmint.GetAddress()}, app.MarkerKeeper.GetSupplyCheckQueue(ctx), "supply check queue after supply change")
+	marker.BeginBlocker(ctx, *app.MarkerKeeper, app.BankKeeper)
+	require.Equal(t, sdkmath.NewInt(40), app.BankKeeper.GetSupply(ctx, "testmint").Amount, "supply after supply change")
+	require.Empty(t, app.MarkerKeeper.GetSupplyCheckQueue(ctx), "supply check queue after second begin block")
+}
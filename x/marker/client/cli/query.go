@@ -3,9 +3,12 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -33,6 +36,23 @@ func GetQueryCmd() *cobra.Command {
 		MarkerSupplyCmd(),
 		AccountDataCmd(),
 		NetAssetValuesCmd(),
+		HolderFreezesCmd(),
+		DistributionCmd(),
+		DistributionClaimsCmd(),
+		RedemptionCmd(),
+		RedemptionsCmd(),
+		TransferLimitsCmd(),
+		AccessGrantTermsCmd(),
+		ApprovalThresholdsCmd(),
+		PendingOperationCmd(),
+		PendingOperationsCmd(),
+		AttributeRequirementsCmd(),
+		CapTableCmd(),
+		HolderSnapshotsCmd(),
+		HolderSnapshotCmd(),
+		ForcedTransfersCmd(),
+		AccountForcedTransfersCmd(),
+		IssuanceScheduleCmd(),
 	)
 	return queryCmd
 }
@@ -313,8 +333,330 @@ func NetAssetValuesCmd() *cobra.Command {
 		Use:     "net-asset-values [address|denom]",
 		Aliases: []string{"nav", "navs"},
 		Short:   "Get marker's net asset values'",
-		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
-		Example: fmt.Sprintf(`$ %s query marker net-asset-values "nhash"`, version.AppName),
+		Long: strings.TrimSpace(`Get a marker's current net asset values, the ones in effect at a given time (--as-of),
+or the recorded history of them (--history, optionally limited with --start-time and --end-time).
+Times must be in RFC 3339 format (e.g. 2026-01-02T15:04:05Z).
+Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %[1]s query marker net-asset-values "nhash"
+$ %[1]s query marker net-asset-values "nhash" --price-denom usd --as-of 2026-01-02T15:04:05Z
+$ %[1]s query marker net-asset-values "nhash" --history --start-time 2026-01-01T00:00:00Z`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryNetAssetValuesRequest{Id: strings.TrimSpace(args[0])}
+
+			flagSet := cmd.Flags()
+			if req.PriceDenom, err = flagSet.GetString(FlagPriceDenom); err != nil {
+				return err
+			}
+			if req.History, err = flagSet.GetBool(FlagHistory); err != nil {
+				return err
+			}
+			if req.AsOf, err = getTimeFlag(flagSet, FlagAsOf); err != nil {
+				return err
+			}
+			if req.StartTime, err = getTimeFlag(flagSet, FlagStartTime); err != nil {
+				return err
+			}
+			if req.EndTime, err = getTimeFlag(flagSet, FlagEndTime); err != nil {
+				return err
+			}
+
+			var response *types.QueryNetAssetValuesResponse
+			if response, err = queryClient.NetAssetValues(context.Background(), req); err != nil {
+				fmt.Printf("failed to query marker %q net asset values details: %v\n", req.Id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	cmd.Flags().String(FlagPriceDenom, "", "Only include net asset values with this price denom")
+	cmd.Flags().String(FlagAsOf, "", "Get the net asset values that were in effect at this time")
+	cmd.Flags().Bool(FlagHistory, false, "Get the recorded history of net asset values")
+	cmd.Flags().String(FlagStartTime, "", "Only include history recorded at or after this time")
+	cmd.Flags().String(FlagEndTime, "", "Only include history recorded before this time")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// getTimeFlag gets an optional RFC 3339 time from the provided flag. Returns nil if the flag is not set.
+func getTimeFlag(flagSet *pflag.FlagSet, name string) (*time.Time, error) {
+	val, err := flagSet.GetString(name)
+	if err != nil || len(val) == 0 {
+		return nil, err
+	}
+	rv, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s %q: %w", name, val, err)
+	}
+	return &rv, nil
+}
+
+// HolderFreezesCmd is the CLI command for querying the freezes of a marker's coins.
+func HolderFreezesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "freezes <address|denom> [holder]",
+		Aliases: []string{"freeze"},
+		Short:   "Get the freezes of a marker's coins, optionally limited to a single holder",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %[1]s query marker freezes mycoin
+$ %[1]s query marker freezes mycoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName)),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryHolderFreezesRequest{
+				Id:         strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			}
+			if len(args) > 1 {
+				req.Holder = strings.TrimSpace(args[1])
+			}
+
+			var response *types.QueryHolderFreezesResponse
+			if response, err = queryClient.HolderFreezes(context.Background(), req); err != nil {
+				fmt.Printf("failed to query marker %q freezes: %v\n", req.Id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "freezes")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DistributionCmd is the CLI command for querying a distribution to the holders of a marker's coin.
+func DistributionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "distribution <distribution-id>",
+		Short:   "Get a distribution to the holders of a marker's coin",
+		Example: fmt.Sprintf(`$ %[1]s query marker distribution 3`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid distribution id %q: %w", args[0], err)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryDistributionResponse
+			if response, err = queryClient.Distribution(context.Background(), &types.QueryDistributionRequest{DistributionId: id}); err != nil {
+				fmt.Printf("failed to query distribution %d: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DistributionClaimsCmd is the CLI command for querying the distribution payments waiting to be claimed by a holder.
+func DistributionClaimsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "distribution-claims <holder>",
+		Short:   "Get the distribution payments waiting to be claimed by a holder",
+		Example: fmt.Sprintf(`$ %[1]s query marker distribution-claims pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryDistributionClaimsRequest{
+				Holder:     strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			}
+
+			var response *types.QueryDistributionClaimsResponse
+			if response, err = queryClient.DistributionClaims(context.Background(), req); err != nil {
+				fmt.Printf("failed to query distribution claims of %q: %v\n", req.Holder, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "distribution claims")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// RedemptionCmd is the CLI command for querying a pending redemption.
+func RedemptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "redemption <redemption-id>",
+		Short:   "Get a pending redemption of a marker's coin",
+		Example: fmt.Sprintf(`$ %[1]s query marker redemption 3`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid redemption id %q: %w", args[0], err)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryRedemptionResponse
+			if response, err = queryClient.Redemption(context.Background(), &types.QueryRedemptionRequest{RedemptionId: id}); err != nil {
+				fmt.Printf("failed to query redemption %d: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// RedemptionsCmd is the CLI command for querying the pending redemptions of a marker and/or a holder.
+func RedemptionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemptions [address|denom]",
+		Short: "Get the pending redemptions of a marker's coin, a holder, or both",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %[1]s query marker redemptions mycoin
+$ %[1]s query marker redemptions mycoin --%[2]s pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %[1]s query marker redemptions --%[2]s pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName, FlagHolder)),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			holder, err := cmd.Flags().GetString(FlagHolder)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryRedemptionsRequest{
+				Holder:     strings.TrimSpace(holder),
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				req.Id = strings.TrimSpace(args[0])
+			}
+			if len(req.Id) == 0 && len(req.Holder) == 0 {
+				return fmt.Errorf("a marker address or denom, or the --%s flag, is required", FlagHolder)
+			}
+
+			var response *types.QueryRedemptionsResponse
+			if response, err = queryClient.Redemptions(context.Background(), req); err != nil {
+				fmt.Printf("failed to query redemptions: %v\n", err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	cmd.Flags().String(FlagHolder, "", "Only include the redemptions of this holder")
+	flags.AddPaginationFlagsToCmd(cmd, "redemptions")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// TransferLimitsCmd is the CLI command for querying the transfer limits of a marker.
+func TransferLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-limits <address|denom> [holder]",
+		Short: "Get the transfer limits of a marker and how much has been sent in the last 24 hours",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %[1]s query marker transfer-limits mycoin
+$ %[1]s query marker transfer-limits mycoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName)),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryTransferLimitsRequest{Id: strings.TrimSpace(args[0])}
+			if len(args) > 1 {
+				req.Holder = strings.TrimSpace(args[1])
+			}
+
+			var response *types.QueryTransferLimitsResponse
+			if response, err = queryClient.TransferLimits(context.Background(), req); err != nil {
+				fmt.Printf("failed to query marker %q transfer limits: %v\n", req.Id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// AccessGrantTermsCmd is the CLI command for querying the terms of an access grant on a marker.
+func AccessGrantTermsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "access-grant-terms <address|denom> <grantee>",
+		Short:   "Get the terms of an address's access grant on a marker and how much it has minted in the last 24 hours",
+		Example: fmt.Sprintf(`$ %s query marker access-grant-terms mycoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAccessGrantTermsRequest{
+				Id:      strings.TrimSpace(args[0]),
+				Address: strings.TrimSpace(args[1]),
+			}
+
+			var response *types.QueryAccessGrantTermsResponse
+			if response, err = queryClient.AccessGrantTerms(context.Background(), req); err != nil {
+				fmt.Printf("failed to query marker %q access grant terms for %s: %v\n", req.Id, req.Address, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ApprovalThresholdsCmd is the CLI command for querying the approval thresholds of a marker's permissions.
+func ApprovalThresholdsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "approval-thresholds <address|denom>",
+		Short:   "Get the number of approvals needed for a marker's mints, withdrawals and forced transfers",
+		Example: fmt.Sprintf(`$ %s query marker approval-thresholds mycoin`, version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -324,17 +666,322 @@ func NetAssetValuesCmd() *cobra.Command {
 			queryClient := types.NewQueryClient(clientCtx)
 			id := strings.TrimSpace(args[0])
 
-			var response *types.QueryNetAssetValuesResponse
-			if response, err = queryClient.NetAssetValues(
-				context.Background(),
-				&types.QueryNetAssetValuesRequest{Id: id},
-			); err != nil {
-				fmt.Printf("failed to query marker %q net asset values details: %v\n", id, err)
+			var response *types.QueryApprovalThresholdsResponse
+			if response, err = queryClient.ApprovalThresholds(context.Background(), &types.QueryApprovalThresholdsRequest{Id: id}); err != nil {
+				fmt.Printf("failed to query marker %q approval thresholds: %v\n", id, err)
 				return nil
 			}
 			return clientCtx.PrintProto(response)
 		},
 	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// PendingOperationCmd is the CLI command for querying an operation that is waiting for approvals.
+func PendingOperationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-operation <operation-id>",
+		Short:   "Get a marker operation that is waiting for approvals",
+		Example: fmt.Sprintf(`$ %s query marker pending-operation 3`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid operation id %q: %w", args[0], err)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryPendingOperationResponse
+			if response, err = queryClient.PendingOperation(context.Background(), &types.QueryPendingOperationRequest{OperationId: id}); err != nil {
+				fmt.Printf("failed to query pending operation %d: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// PendingOperationsCmd is the CLI command for querying the operations of a marker that are waiting for approvals.
+func PendingOperationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-operations <address|denom>",
+		Short:   "Get the operations of a marker that are waiting for approvals",
+		Example: fmt.Sprintf(`$ %s query marker pending-operations mycoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryPendingOperationsRequest{
+				Id:         strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			}
+
+			var response *types.QueryPendingOperationsResponse
+			if response, err = queryClient.PendingOperations(context.Background(), req); err != nil {
+				fmt.Printf("failed to query marker %q pending operations: %v\n", req.Id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "pending operations")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// AttributeRequirementsCmd is the CLI command for querying the attribute value requirements of a marker.
+func AttributeRequirementsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "attribute-requirements <address|denom>",
+		Short:   "Get the attribute value requirements that the recipients of a marker's coins must meet",
+		Example: fmt.Sprintf(`$ %s query marker attribute-requirements mycoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAttributeRequirementsRequest{Id: strings.TrimSpace(args[0])}
+
+			var response *types.QueryAttributeRequirementsResponse
+			if response, err = queryClient.AttributeRequirements(context.Background(), req); err != nil {
+				fmt.Printf("failed to query marker %q attribute requirements: %v\n", req.Id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CapTableCmd is the CLI command for querying the current holders of a marker's coin with a breakdown of their amounts.
+func CapTableCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cap-table <address|denom>",
+		Short:   "Get the current holders of a marker's coin with their spendable, held, frozen, quarantined and escrowed amounts",
+		Example: fmt.Sprintf(`$ %s query marker cap-table mycoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryCapTableRequest{
+				Id:         strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			}
+
+			var response *types.QueryCapTableResponse
+			if response, err = queryClient.CapTable(context.Background(), req); err != nil {
+				fmt.Printf("failed to query marker %q cap table: %v\n", req.Id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "cap table")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// HolderSnapshotsCmd is the CLI command for querying the holder snapshots that have been recorded for a marker.
+func HolderSnapshotsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "holder-snapshots <address|denom>",
+		Short:   "Get the holder snapshots that have been recorded for a marker",
+		Example: fmt.Sprintf(`$ %s query marker holder-snapshots mycoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryHolderSnapshotsRequest{
+				Id:         strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			}
+
+			var response *types.QueryHolderSnapshotsResponse
+			if response, err = queryClient.HolderSnapshots(context.Background(), req); err != nil {
+				fmt.Printf("failed to query marker %q holder snapshots: %v\n", req.Id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "holder snapshots")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// HolderSnapshotCmd is the CLI command for querying a recorded holder snapshot and its cap table.
+func HolderSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "holder-snapshot <snapshot-id>",
+		Short:   "Get a recorded holder snapshot and its cap table",
+		Example: fmt.Sprintf(`$ %s query marker holder-snapshot 3`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid snapshot id %q: %w", args[0], err)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryHolderSnapshotRequest{
+				SnapshotId: id,
+				Pagination: pageReq,
+			}
+
+			var response *types.QueryHolderSnapshotResponse
+			if response, err = queryClient.HolderSnapshot(context.Background(), req); err != nil {
+				fmt.Printf("failed to query holder snapshot %d: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "holder snapshot")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ForcedTransfersCmd is the CLI command for querying the audit records of the forced transfers of a marker's coins.
+func ForcedTransfersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "forced-transfers <address|denom>",
+		Short:   "Get the audit records of the forced transfers of a marker's coins",
+		Example: fmt.Sprintf(`$ %s query marker forced-transfers mycoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryForcedTransfersRequest{
+				Id:         strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			}
+
+			var response *types.QueryForcedTransfersResponse
+			if response, err = queryClient.ForcedTransfers(context.Background(), req); err != nil {
+				fmt.Printf("failed to query marker %q forced transfers: %v\n", req.Id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "forced transfers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// AccountForcedTransfersCmd is the CLI command for querying the audit records of the forced transfers taken from an account.
+func AccountForcedTransfersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-forced-transfers <address>",
+		Short:   "Get the audit records of the forced transfers taken from an account",
+		Example: fmt.Sprintf(`$ %s query marker account-forced-transfers pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryAccountForcedTransfersRequest{
+				Address:    strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			}
+
+			var response *types.QueryAccountForcedTransfersResponse
+			if response, err = queryClient.AccountForcedTransfers(context.Background(), req); err != nil {
+				fmt.Printf("failed to query forced transfers from %q: %v\n", req.Address, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "forced transfers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// IssuanceScheduleCmd is the CLI command for querying a marker's issuance schedule.
+func IssuanceScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "issuance-schedule <address|denom>",
+		Short:   "Get the issuance schedule of a marker along with the amounts that are mintable now and later",
+		Example: fmt.Sprintf(`$ %s query marker issuance-schedule mycoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryIssuanceScheduleRequest{Id: strings.TrimSpace(args[0])}
+
+			var response *types.QueryIssuanceScheduleResponse
+			if response, err = queryClient.IssuanceSchedule(context.Background(), req); err != nil {
+				fmt.Printf("failed to query marker %q issuance schedule: %v\n", req.Id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
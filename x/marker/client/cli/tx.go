@@ -64,6 +64,17 @@ const (
 	FlagVolume                 = "volume"
 	FlagTargetAddress          = "target-address"
 	FlagRequireDepositAccess   = "require-deposit-access"
+	FlagPriceDenom             = "price-denom"
+	FlagAsOf                   = "as-of"
+	FlagHistory                = "history"
+	FlagStartTime              = "start-time"
+	FlagEndTime                = "end-time"
+	FlagHolder                 = "holder"
+	FlagExpiresAt              = "expires-at"
+	FlagMintDailyLimit         = "mint-daily-limit"
+	FlagWithdrawAddresses      = "withdraw-addresses"
+	FlagReason                 = "reason"
+	FlagReference              = "reference"
 )
 
 const (
@@ -114,6 +125,23 @@ func NewTxCmd() *cobra.Command {
 		GetGrantMultiAuthzCmd(),
 		GetCmdSetDenomMetadata(),
 		GetCmdSetDenomMetadataProposal(),
+		GetCmdFreezeHolder(),
+		GetCmdUnfreezeHolder(),
+		GetCmdDistributeToHolders(),
+		GetCmdClaimDistribution(),
+		GetCmdSetRedemptionPayoutDenom(),
+		GetCmdRequestRedemption(),
+		GetCmdFulfillRedemption(),
+		GetCmdRejectRedemption(),
+		GetCmdSetTransferLimits(),
+		GetCmdSetAccessGrantTerms(),
+		GetCmdSetApprovalThreshold(),
+		GetCmdApproveOperation(),
+		GetCmdCancelOperation(),
+		GetCmdSetAttributeRequirements(),
+		GetCmdTakeHolderSnapshot(),
+		GetCmdSetIssuanceSchedule(),
+		GetCmdSetNetAssetValueMaxAge(),
 	)
 	return txCmd
 }
@@ -475,8 +503,11 @@ func GetNewTransferCmd() *cobra.Command {
 		Use:     "transfer [from] [to] [coins]",
 		Aliases: []string{"t"},
 		Short:   "Transfer coins from one account to another",
-		Example: fmt.Sprintf(`$ %s tx marker transfer tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx tp1z6403t8z42fpl760zguuf2pc24g5gq96sez0k4 100coindenom --from mykey`, version.AppName),
-		Args:    cobra.ExactArgs(3),
+		Long: `Transfer coins from one account to another.
+A forced transfer, i.e. one from an account other than the signer's, requires a --reason and --reference.`,
+		Example: fmt.Sprintf(`$ %[1]s tx marker transfer tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx tp1z6403t8z42fpl760zguuf2pc24g5gq96sez0k4 100coindenom --from mykey
+$ %[1]s tx marker transfer tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx tp1z6403t8z42fpl760zguuf2pc24g5gq96sez0k4 100coindenom --reason COURT_ORDER --reference "Case 24-CV-1234" --from mykey`, version.AppName),
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -498,9 +529,26 @@ func GetNewTransferCmd() *cobra.Command {
 				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[2])
 			}
 			msg := types.NewMsgTransferRequest(clientCtx.GetFromAddress(), from, to, coins[0])
+			reasonName, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+			if len(reasonName) > 0 {
+				reason, err := types.ForcedTransferReasonByName(reasonName)
+				if err != nil {
+					return err
+				}
+				reference, err := cmd.Flags().GetString(FlagReference)
+				if err != nil {
+					return err
+				}
+				msg.WithJustification(reason, reference)
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(FlagReason, "", "The reason for a forced transfer, e.g. COURT_ORDER, LOST_KEY_RECOVERY, REGULATORY_ACTION, ERROR_CORRECTION, ESTATE_SETTLEMENT or OTHER")
+	cmd.Flags().String(FlagReference, "", "The id of the document that justifies a forced transfer, e.g. a court order or recovery ticket id")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -1645,6 +1693,460 @@ func GetCmdSetDenomMetadata() *cobra.Command {
 	return cmd
 }
 
+// GetCmdFreezeHolder returns a CLI command for freezing an amount of a marker's coins in a holder's account.
+func GetCmdFreezeHolder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze <holder> <coin> <expires-at> <reason>",
+		Args:  cobra.ExactArgs(4),
+		Short: "Freeze an amount of a restricted marker's coins in a holder's account",
+		Long: strings.TrimSpace(`Freeze an amount of a restricted marker's coins in a holder's account.
+The frozen funds cannot be moved by the holder until the freeze expires or is removed; only a forced transfer can move them.
+The <expires-at> must be in RFC 3339 format (e.g. 2026-01-02T15:04:05Z). The signer must have freeze access on the marker.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker freeze pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk 500mycoin 2026-01-02T15:04:05Z "court order 1234" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			holder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return cerrs.Wrapf(err, "invalid holder address %s", args[0])
+			}
+			coin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[1])
+			}
+			expiresAt, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return fmt.Errorf("invalid expires at %q: %w", args[2], err)
+			}
+			msg := types.NewMsgFreezeHolderRequest(coin, clientCtx.GetFromAddress(), holder, expiresAt, args[3])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUnfreezeHolder returns a CLI command for removing a freeze of a marker's coins from a holder's account.
+func GetCmdUnfreezeHolder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unfreeze <holder> <denom>",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Remove the freeze of a marker's coins from a holder's account",
+		Long:    strings.TrimSpace(`Remove the freeze of a marker's coins from a holder's account. The signer must have freeze access on the marker.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker unfreeze pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk mycoin --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			holder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return cerrs.Wrapf(err, "invalid holder address %s", args[0])
+			}
+			msg := types.NewMsgUnfreezeHolderRequest(args[1], clientCtx.GetFromAddress(), holder)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDistributeToHolders returns a CLI command for paying out funds to the holders of a marker's coin.
+func GetCmdDistributeToHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribute <denom> <payout>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Distribute a payout to the holders of a marker's coin, pro-rata to their balances",
+		Long: strings.TrimSpace(`Distribute a payout to the holders of a marker's coin, pro-rata to their balances.
+The full payout is taken when the transaction is processed. The holder balances from that time are recorded,
+the payments are made, and any rounding remainder is returned over the following blocks.
+Holders that are sanctioned or quarantined must claim their payments. The signer must have admin access on the marker.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker distribute mycoin 10000usd --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			payout, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid payout %s", args[1])
+			}
+			msg := types.NewMsgDistributeToHoldersRequest(args[0], clientCtx.GetFromAddress(), payout)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdClaimDistribution returns a CLI command for claiming a distribution payment.
+func GetCmdClaimDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-distribution <distribution-id>",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Claim a distribution payment that could not be sent automatically",
+		Example: fmt.Sprintf(`$ %[1]s tx marker claim-distribution 3 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid distribution id %q: %w", args[0], err)
+			}
+			msg := types.NewMsgClaimDistributionRequest(clientCtx.GetFromAddress(), id)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetRedemptionPayoutDenom returns a CLI command for setting the denom that a marker's redemptions are paid out in.
+func GetCmdSetRedemptionPayoutDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-redemption-payout-denom <denom> [payout-denom]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Set the denom that a marker's redemptions are paid out in",
+		Long: strings.TrimSpace(`Set the denom that a marker's redemptions are paid out in.
+Omit the payout denom to stop accepting redemption requests. The signer must have admin access on the marker.`),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %[1]s tx marker set-redemption-payout-denom mycoin usd --from mykey
+$ %[1]s tx marker set-redemption-payout-denom mycoin --from mykey`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			payoutDenom := ""
+			if len(args) > 1 {
+				payoutDenom = args[1]
+			}
+			msg := types.NewMsgSetRedemptionPayoutDenomRequest(args[0], clientCtx.GetFromAddress(), payoutDenom)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRequestRedemption returns a CLI command for requesting the redemption of a marker's coin.
+func GetCmdRequestRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-redemption <amount>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Request the redemption of some of a marker's coin",
+		Long: strings.TrimSpace(`Request the redemption of some of a marker's coin.
+The coins are held in the marker account until an administrator fulfills or rejects the redemption.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker request-redemption 100mycoin --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid amount %s", args[0])
+			}
+			msg := types.NewMsgRequestRedemptionRequest(clientCtx.GetFromAddress(), amount)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdFulfillRedemption returns a CLI command for fulfilling a redemption.
+func GetCmdFulfillRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fulfill-redemption <redemption-id> [payout]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Burn the coins of a redemption and pay the holder",
+		Long: strings.TrimSpace(`Burn the coins of a redemption and pay the holder from the signer's account.
+If no payout is provided, it is calculated from the marker's net asset value in its redemption payout denom.
+The signer must have burn access on the marker.`),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %[1]s tx marker fulfill-redemption 3 --from mykey
+$ %[1]s tx marker fulfill-redemption 3 1050usd --from mykey`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid redemption id %q: %w", args[0], err)
+			}
+			var payout *sdk.Coin
+			if len(args) > 1 {
+				coin, coinErr := sdk.ParseCoinNormalized(args[1])
+				if coinErr != nil {
+					return sdkErrors.ErrInvalidCoins.Wrapf("invalid payout %s", args[1])
+				}
+				payout = &coin
+			}
+			msg := types.NewMsgFulfillRedemptionRequest(clientCtx.GetFromAddress(), id, payout)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRejectRedemption returns a CLI command for rejecting a redemption.
+func GetCmdRejectRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-redemption <redemption-id> <reason>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Reject a redemption and return the coins to the holder",
+		Long: strings.TrimSpace(`Reject a redemption and return the coins to the holder.
+The signer must have admin access on the marker.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker reject-redemption 3 "redemption window is closed" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid redemption id %q: %w", args[0], err)
+			}
+			msg := types.NewMsgRejectRedemptionRequest(clientCtx.GetFromAddress(), id, args[1])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetTransferLimits returns a CLI command for setting the transfer limits of a restricted marker.
+func GetCmdSetTransferLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-limits <denom> <holder-daily-limit> <marker-daily-limit>",
+		Args:  cobra.ExactArgs(3),
+		Short: "Set the rolling 24 hour transfer limits of a restricted marker",
+		Long: strings.TrimSpace(`Set the rolling 24 hour transfer limits of a restricted marker.
+The holder limit applies to each holder separately; the marker limit applies to all holders combined.
+A limit of 0 means no limit. Sends by accounts with transfer access are not limited.
+The signer must have admin access on the marker.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-transfer-limits mycoin 1000 50000 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			holderLimit, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid holder daily limit %q", args[1])
+			}
+			markerLimit, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid marker daily limit %q", args[2])
+			}
+			msg := types.NewMsgSetTransferLimitsRequest(args[0], clientCtx.GetFromAddress(), holderLimit, markerLimit)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetAccessGrantTerms returns a CLI command for setting the expiry and limits of an access grant on a marker.
+func GetCmdSetAccessGrantTerms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-access-grant-terms <denom> <address>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set the expiry and limits of an address's access grant on a marker",
+		Long: strings.TrimSpace(`Set the expiry and limits of an address's access grant on a marker.
+The address must already have an access grant on the marker. The grant is removed from the marker once it expires.
+The --expires-at value must be in RFC 3339 format (e.g. 2026-01-02T15:04:05Z).
+A mint daily limit of 0 means no limit. If no withdraw addresses are given, funds can be withdrawn to any account.
+Providing none of the flags removes the terms from the grant. The signer must have admin access on the marker.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-access-grant-terms mycoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --expires-at 2026-01-02T15:04:05Z --mint-daily-limit 10000 --from mykey
+$ %[1]s tx marker set-access-grant-terms mycoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --withdraw-addresses pb1tlxjm6ucvhfsew4emgq3hmvpnh2cq5fx7ypxqg --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return cerrs.Wrapf(err, "invalid address %s", args[1])
+			}
+			var expiresAt *time.Time
+			exp, err := cmd.Flags().GetString(FlagExpiresAt)
+			if err != nil {
+				return err
+			}
+			if len(exp) > 0 {
+				t, perr := time.Parse(time.RFC3339, exp)
+				if perr != nil {
+					return fmt.Errorf("invalid expires at %q: %w", exp, perr)
+				}
+				expiresAt = &t
+			}
+			limitStr, err := cmd.Flags().GetString(FlagMintDailyLimit)
+			if err != nil {
+				return err
+			}
+			mintLimit, ok := sdkmath.NewIntFromString(limitStr)
+			if !ok {
+				return fmt.Errorf("invalid mint daily limit %q", limitStr)
+			}
+			withdrawAddrs, err := cmd.Flags().GetStringSlice(FlagWithdrawAddresses)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetAccessGrantTermsRequest(args[0], clientCtx.GetFromAddress(), addr, expiresAt, mintLimit, withdrawAddrs)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExpiresAt, "", "The RFC 3339 timestamp at which the grant is removed from the marker")
+	cmd.Flags().String(FlagMintDailyLimit, "0", "The most the address can mint in 24 hours")
+	cmd.Flags().StringSlice(FlagWithdrawAddresses, []string{}, "The only addresses the grantee can withdraw the marker's funds to, separated by ,")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetApprovalThreshold returns a CLI command for setting the number of approvals needed for a marker permission.
+func GetCmdSetApprovalThreshold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-approval-threshold <denom> <permission> <threshold>",
+		Args:  cobra.ExactArgs(3),
+		Short: "Set the number of approvals needed for a marker's mints, withdrawals or forced transfers",
+		Long: strings.TrimSpace(`Set the number of approvals needed for a marker's mints, withdrawals or forced transfers.
+The permission must be one of mint, withdraw or forcetransfer. A threshold of 0 or 1 removes the threshold.
+Once set, a mint, withdrawal or forced transfer is held as a pending operation until enough addresses with
+the permission approve it. The signer must have admin access on the marker.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-approval-threshold mycoin mint 2 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			threshold, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid threshold %q: %w", args[2], err)
+			}
+			msg := types.NewMsgSetApprovalThresholdRequest(args[0], clientCtx.GetFromAddress(), types.AccessByName(args[1]), uint32(threshold))
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdApproveOperation returns a CLI command for approving a pending marker operation.
+func GetCmdApproveOperation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-operation <operation-id>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Approve a pending mint, withdrawal or forced transfer",
+		Long: strings.TrimSpace(`Approve a pending mint, withdrawal or forced transfer.
+The operation is executed once it has enough approvals. The signer must have the operation's permission on the marker.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker approve-operation 3 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid operation id %q: %w", args[0], err)
+			}
+			msg := types.NewMsgApproveOperationRequest(id, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelOperation returns a CLI command for cancelling a pending marker operation.
+func GetCmdCancelOperation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-operation <operation-id>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a pending mint, withdrawal or forced transfer",
+		Long: strings.TrimSpace(`Cancel a pending mint, withdrawal or forced transfer.
+The signer must be the proposer of the operation or have admin access on the marker.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker cancel-operation 3 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid operation id %q: %w", args[0], err)
+			}
+			msg := types.NewMsgCancelOperationRequest(id, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetAttributeRequirements returns a CLI command for setting the attribute value requirements of a restricted marker.
+func GetCmdSetAttributeRequirements() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-attribute-requirements <denom> [<requirements-json-file>]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Set the attribute value requirements of a restricted marker",
+		Long: strings.TrimSpace(`Set the attribute value requirements of a restricted marker.
+The recipients of the marker's coins must have attributes that meet all of the requirements.
+The file must contain a JSON object with a "requirements" list, e.g.
+{"requirements": [{"name": "kyc.pb", "condition": "ATTRIBUTE_CONDITION_IN", "json_path": "country", "values": ["US", "CA"]}]}
+If no file is provided, the marker's attribute requirements are removed.
+The signer must have transfer access on the marker, or it can be submitted as a governance proposal.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-attribute-requirements mycoin requirements.json --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgSetAttributeRequirementsRequest{Denom: args[0]}
+			if len(args) > 1 {
+				bz, err := os.ReadFile(args[1])
+				if err != nil {
+					return fmt.Errorf("could not read requirements file %q: %w", args[1], err)
+				}
+				var requirements types.AttributeRequirements
+				if err = clientCtx.Codec.UnmarshalJSON(bz, &requirements); err != nil {
+					return fmt.Errorf("could not parse requirements file %q: %w", args[1], err)
+				}
+				msg.Requirements = requirements.Requirements
+			}
+
+			authSetter := func(authority string) {
+				msg.TransferAuthority = authority
+			}
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // ParseNetAssetValueString splits string (example 1hotdog,1;2jackthecat100,...) to list of NetAssetValue's
 func ParseNetAssetValueString(netAssetValuesString string) ([]types.NetAssetValue, error) {
 	navs := strings.Split(netAssetValuesString, ";")
@@ -1799,11 +2301,12 @@ func generateOrBroadcastOptGovProp(clientCtx client.Context, flagSet *pflag.Flag
 // GetUpdateMarkerParamsCmd creates a command to update the marker module's params via governance proposal.
 func GetUpdateMarkerParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update-marker-params <enable-governance> <unrestricted-denom-regex> <max-supply>",
-		Short:   "Update the marker module's params via governance proposal",
-		Long:    "Submit an update marker params via governance proposal along with an initial deposit.",
-		Args:    cobra.ExactArgs(3),
-		Example: fmt.Sprintf(`%[1]s tx marker update-marker-params true "[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" 1000000000000 --deposit 50000nhash`, version.AppName),
+		Use:   "update-marker-params <enable-governance> <unrestricted-denom-regex> <max-supply> [max-nav-history]",
+		Short: "Update the marker module's params via governance proposal",
+		Long: fmt.Sprintf(`Submit an update marker params via governance proposal along with an initial deposit.
+If [max-nav-history] is not provided, it defaults to %d.`, types.DefaultMaxNavHistory),
+		Args:    cobra.RangeArgs(3, 4),
+		Example: fmt.Sprintf(`%[1]s tx marker update-marker-params true "[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" 1000000000000 50 --deposit 50000nhash`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -1825,10 +2328,20 @@ func GetUpdateMarkerParamsCmd() *cobra.Command {
 				return fmt.Errorf("invalid max supply: %q", args[2])
 			}
 
+			maxNavHistory := types.DefaultMaxNavHistory
+			if len(args) > 3 {
+				val, err := strconv.ParseUint(args[3], 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid max nav history %q: %w", args[3], err)
+				}
+				maxNavHistory = uint32(val) //nolint:gosec // G115: ParseUint limited it to 32 bits.
+			}
+
 			msg := types.NewMsgUpdateParamsRequest(
 				enableGovernance,
 				unrestrictedDenomRegex,
 				maxSupply,
+				maxNavHistory,
 				authority,
 			)
 			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
@@ -2015,3 +2528,107 @@ func parseAuthorizationsFromInput(clientCtx client.Context, authzJSON []byte, ms
 
 	return subAuths, nil
 }
+
+// GetCmdTakeHolderSnapshot returns a CLI command for recording the current cap table of a marker.
+func GetCmdTakeHolderSnapshot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "take-holder-snapshot <denom>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Record the current cap table of a marker",
+		Long: strings.TrimSpace(`Record the current cap table of a marker so it can be queried later.
+The signer must have admin or transfer access on the marker. Only one snapshot of a marker can be taken in each block.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker take-holder-snapshot mycoin --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgTakeHolderSnapshotRequest(strings.TrimSpace(args[0]), clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetIssuanceSchedule returns a CLI command for setting the hard cap and issuance schedule of a marker.
+func GetCmdSetIssuanceSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-issuance-schedule <denom> [<schedule-json-file>]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Set the hard cap and issuance schedule of a marker",
+		Long: strings.TrimSpace(`Set the hard cap and issuance schedule of a marker.
+The file must contain a JSON object with an optional "hard_cap" and a list of "tranches", each with an "amount"
+and either an "unlock_time" or an "unlock_height", e.g.
+{"hard_cap": "1000000", "tranches": [{"amount": "250000", "unlock_time": "2027-01-01T00:00:00Z"}, {"amount": "250000", "unlock_height": "5000000"}]}
+If no file is provided, the marker's issuance schedule is removed.
+The signer must have admin access on the marker, or it can be submitted as a governance proposal.
+Once an active marker has an issuance schedule, it can only be changed by a governance proposal.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-issuance-schedule mycoin schedule.json --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetIssuanceScheduleRequest(args[0], sdkmath.ZeroInt(), nil, "")
+			if len(args) > 1 {
+				bz, err := os.ReadFile(args[1])
+				if err != nil {
+					return fmt.Errorf("could not read schedule file %q: %w", args[1], err)
+				}
+				var schedule types.IssuanceSchedule
+				if err = clientCtx.Codec.UnmarshalJSON(bz, &schedule); err != nil {
+					return fmt.Errorf("could not parse schedule file %q: %w", args[1], err)
+				}
+				if !schedule.HardCap.IsNil() {
+					msg.HardCap = schedule.HardCap
+				}
+				msg.Tranches = schedule.Tranches
+			}
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetNetAssetValueMaxAge returns a CLI command for setting the max age of a marker's net asset values.
+func GetCmdSetNetAssetValueMaxAge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-nav-max-age <denom> <max-age>",
+		Aliases: []string{"set-net-asset-value-max-age"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Set the number of blocks after which a marker's net asset values are stale",
+		Long: strings.TrimSpace(`Set the number of blocks after which a marker's net asset values are stale.
+A max age of 0 means the marker's net asset values are never stale.
+The signer must have admin access on the marker, or it can be submitted as a governance proposal.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-nav-max-age mycoin 100000 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			maxAge, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid max age %q: %w", args[1], err)
+			}
+			msg := types.NewMsgSetNetAssetValueMaxAgeRequest(args[0], maxAge, "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
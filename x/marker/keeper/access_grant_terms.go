@@ -0,0 +1,326 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetAccessGrantTerms stores the terms of an access grant. If the terms don't have an expiry or any limits,
+// the terms and any recorded mint volumes of the grant are removed.
+func (k Keeper) SetAccessGrantTerms(ctx sdk.Context, terms types.AccessGrantTerms) error {
+	if err := terms.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(terms.Denom)
+	if err != nil {
+		return err
+	}
+	grantee := sdk.MustAccAddressFromBech32(terms.Address)
+	if !terms.HasTerms() {
+		return k.removeAccessGrantTerms(ctx, markerAddr, grantee)
+	}
+	existing, err := k.GetAccessGrantTerms(ctx, markerAddr, grantee)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ExpiresAt != nil && (terms.ExpiresAt == nil || !existing.ExpiresAt.Equal(*terms.ExpiresAt)) {
+		if err = k.accessGrantExpirations.Remove(ctx, collections.Join3(*existing.ExpiresAt, markerAddr, grantee)); err != nil {
+			return fmt.Errorf("failed to remove access grant expiration: %w", err)
+		}
+	}
+	if err = k.accessGrantTerms.Set(ctx, collections.Join(markerAddr, grantee), terms); err != nil {
+		return fmt.Errorf("failed to set access grant terms: %w", err)
+	}
+	if terms.ExpiresAt != nil {
+		if err = k.accessGrantExpirations.Set(ctx, collections.Join3(*terms.ExpiresAt, markerAddr, grantee), true); err != nil {
+			return fmt.Errorf("failed to set access grant expiration: %w", err)
+		}
+	}
+	// Mint volumes are only tracked while there's a mint limit.
+	if !terms.MintDailyLimit.IsPositive() {
+		return k.clearAccessGrantMintVolumes(ctx, markerAddr, grantee)
+	}
+	return nil
+}
+
+// removeAccessGrantTerms deletes the terms and recorded mint volumes of an access grant.
+func (k Keeper) removeAccessGrantTerms(ctx sdk.Context, markerAddr, grantee sdk.AccAddress) error {
+	existing, err := k.GetAccessGrantTerms(ctx, markerAddr, grantee)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return k.clearAccessGrantMintVolumes(ctx, markerAddr, grantee)
+	}
+	if existing.ExpiresAt != nil {
+		if err = k.accessGrantExpirations.Remove(ctx, collections.Join3(*existing.ExpiresAt, markerAddr, grantee)); err != nil {
+			return fmt.Errorf("failed to remove access grant expiration: %w", err)
+		}
+	}
+	if err = k.accessGrantTerms.Remove(ctx, collections.Join(markerAddr, grantee)); err != nil {
+		return fmt.Errorf("failed to remove access grant terms: %w", err)
+	}
+	return k.clearAccessGrantMintVolumes(ctx, markerAddr, grantee)
+}
+
+// clearAccessGrantMintVolumes deletes the recorded mint volumes of an access grant.
+func (k Keeper) clearAccessGrantMintVolumes(ctx sdk.Context, markerAddr, grantee sdk.AccAddress) error {
+	rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, sdk.AccAddress, uint64](markerAddr, grantee)
+	if err := k.accessGrantMintVolumes.Clear(ctx, rng); err != nil {
+		return fmt.Errorf("failed to remove access grant mint volumes: %w", err)
+	}
+	return nil
+}
+
+// ClearAccessGrantTerms removes the terms and recorded mint volumes of all access grants on a marker.
+func (k Keeper) ClearAccessGrantTerms(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	termsRng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](markerAddr)
+	var expirations []collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress]
+	err := k.accessGrantTerms.Walk(ctx, termsRng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], terms types.AccessGrantTerms) (bool, error) {
+		if terms.ExpiresAt != nil {
+			expirations = append(expirations, collections.Join3(*terms.ExpiresAt, key.K1(), key.K2()))
+		}
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	for _, key := range expirations {
+		if err = k.accessGrantExpirations.Remove(ctx, key); err != nil {
+			panic(fmt.Errorf("failed to remove access grant expiration: %w", err))
+		}
+	}
+	if err = k.accessGrantTerms.Clear(ctx, termsRng); err != nil {
+		panic(fmt.Errorf("failed to remove access grant terms: %w", err))
+	}
+	volumesRng := collections.NewPrefixedTripleRange[sdk.AccAddress, sdk.AccAddress, uint64](markerAddr)
+	if err := k.accessGrantMintVolumes.Clear(ctx, volumesRng); err != nil {
+		panic(fmt.Errorf("failed to remove access grant mint volumes: %w", err))
+	}
+}
+
+// removeRevokedAccessGrantTerms removes the terms of the grants that were on the previous version of a marker,
+// but are no longer on the marker.
+func (k Keeper) removeRevokedAccessGrantTerms(ctx sdk.Context, prev, marker types.MarkerAccountI) error {
+	if prev == nil {
+		return nil
+	}
+	for _, grant := range prev.GetAccessList() {
+		if len(types.GrantsForAddress(grant.GetAddress(), marker.GetAccessList()...).Address) > 0 {
+			continue
+		}
+		if err := k.removeAccessGrantTerms(ctx, marker.GetAddress(), grant.GetAddress()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAccessGrantTerms gets the terms of an address's access grant on a marker. Returns nil if the grant doesn't have any.
+func (k Keeper) GetAccessGrantTerms(ctx sdk.Context, markerAddr, grantee sdk.AccAddress) (*types.AccessGrantTerms, error) {
+	terms, err := k.accessGrantTerms.Get(ctx, collections.Join(markerAddr, grantee))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read access grant terms of %s on %s: %w", grantee, markerAddr, err)
+	}
+	return &terms, nil
+}
+
+// IterateAccessGrantTerms iterates over the terms of all access grants (for all markers).
+func (k Keeper) IterateAccessGrantTerms(ctx sdk.Context, cb func(terms types.AccessGrantTerms) (stop bool)) {
+	err := k.accessGrantTerms.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], terms types.AccessGrantTerms) (bool, error) {
+		return cb(terms), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// checkAccessGrantMintLimit makes sure that minting the provided amount of a marker's coin does not exceed
+// the mint limit of the grantee's access grant, and records the amount towards that limit.
+func (k Keeper) checkAccessGrantMintLimit(ctx sdk.Context, marker types.MarkerAccountI, grantee sdk.AccAddress, amount sdkmath.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+	terms, err := k.GetAccessGrantTerms(ctx, marker.GetAddress(), grantee)
+	if err != nil || terms == nil || !terms.MintDailyLimit.IsPositive() {
+		return err
+	}
+
+	hour := types.TransferVolumeHour(ctx.BlockTime())
+	rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, sdk.AccAddress, uint64](marker.GetAddress(), grantee)
+	minted, err := pruneHourlyVolumes(ctx, k.accessGrantMintVolumes, rng, hour,
+		func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, uint64]) uint64 { return key.K3() })
+	if err != nil {
+		return err
+	}
+	if minted.Add(amount).GT(terms.MintDailyLimit) {
+		return fmt.Errorf("cannot mint %s%s by %s: exceeds mint daily limit of %s%s (%s%s already minted)",
+			amount, marker.GetDenom(), grantee, terms.MintDailyLimit, marker.GetDenom(), minted, marker.GetDenom())
+	}
+	return addHourlyVolume(ctx, k.accessGrantMintVolumes, collections.Join3(marker.GetAddress(), grantee, hour), amount)
+}
+
+// canWithdrawTo returns true if the terms of the grantee's access grant allow withdrawing a marker's funds to the recipient.
+func (k Keeper) canWithdrawTo(ctx sdk.Context, marker types.MarkerAccountI, grantee, recipient sdk.AccAddress) (bool, error) {
+	terms, err := k.GetAccessGrantTerms(ctx, marker.GetAddress(), grantee)
+	if err != nil {
+		return false, err
+	}
+	return terms == nil || terms.AllowsWithdrawTo(recipient), nil
+}
+
+// validateAccessGrantWithdrawAddress returns an error if the terms of the grantee's access grant do not allow
+// withdrawing a marker's funds to the recipient.
+func (k Keeper) validateAccessGrantWithdrawAddress(ctx sdk.Context, marker types.MarkerAccountI, grantee, recipient sdk.AccAddress) error {
+	ok, err := k.canWithdrawTo(ctx, marker, grantee, recipient)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%s is not allowed to withdraw from %s marker (%s) to %s",
+			grantee, marker.GetDenom(), marker.GetAddress(), recipient)
+	}
+	return nil
+}
+
+// validateAtLeastOneAddrCanWithdrawTo returns an error if there isn't an entry in addrs that has withdraw access
+// on the marker with terms that allow withdrawing to the recipient.
+func (k Keeper) validateAtLeastOneAddrCanWithdrawTo(ctx sdk.Context, marker types.MarkerAccountI, addrs []sdk.AccAddress, recipient sdk.AccAddress) error {
+	for _, addr := range addrs {
+		if !marker.AddressHasAccess(addr, types.Access_Withdraw) {
+			continue
+		}
+		ok, err := k.canWithdrawTo(ctx, marker, addr, recipient)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+	}
+	if len(addrs) == 1 {
+		return fmt.Errorf("%s is not allowed to withdraw from %s marker (%s) to %s",
+			addrs[0], marker.GetDenom(), marker.GetAddress(), recipient)
+	}
+	return fmt.Errorf("none of %q are allowed to withdraw from %s marker (%s) to %s",
+		addrs, marker.GetDenom(), marker.GetAddress(), recipient)
+}
+
+// RemoveExpiredAccessGrants removes the access grants that have expired from their markers.
+// Only the terms in the expiration index up to the current block time are looked at.
+func (k Keeper) RemoveExpiredAccessGrants(ctx sdk.Context) {
+	var keys []collections.Pair[sdk.AccAddress, sdk.AccAddress]
+	blockTime := ctx.BlockTime()
+	err := k.accessGrantExpirations.Walk(ctx, nil, func(key collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], _ bool) (bool, error) {
+		if key.K1().After(blockTime) {
+			return true, nil
+		}
+		keys = append(keys, collections.Join(key.K2(), key.K3()))
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, key := range keys {
+		terms, err := k.GetAccessGrantTerms(ctx, key.K1(), key.K2())
+		if err != nil {
+			panic(err)
+		}
+		if terms == nil || !terms.IsExpired(blockTime) {
+			continue
+		}
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err = k.expireAccessGrant(cacheCtx, *terms); err != nil {
+			ctx.Logger().Error("failed to remove expired access grant", "denom", terms.Denom, "address", terms.Address, "error", err)
+			continue
+		}
+		writeCache()
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerAccessGrantExpired(terms.Denom, terms.Address)); err != nil {
+			ctx.Logger().Error("failed to emit access grant expired event", "denom", terms.Denom, "address", terms.Address, "error", err)
+		}
+	}
+}
+
+// expireAccessGrant revokes the grantee's access on the marker and removes the terms of the grant.
+func (k Keeper) expireAccessGrant(ctx sdk.Context, terms types.AccessGrantTerms) error {
+	markerAddr := types.MustGetMarkerAddress(terms.Denom)
+	grantee := sdk.MustAccAddressFromBech32(terms.Address)
+	marker, err := k.GetMarker(ctx, markerAddr)
+	if err != nil {
+		return err
+	}
+	if marker != nil {
+		if err = marker.RevokeAccess(grantee); err != nil {
+			return err
+		}
+		if err = k.SetMarker(ctx, marker); err != nil {
+			return err
+		}
+	}
+	return k.removeAccessGrantTerms(ctx, markerAddr, grantee)
+}
+
+// GetAccessGrantMintVolume gets the amount minted by the grantee in the window ending at the current block time.
+func (k Keeper) GetAccessGrantMintVolume(ctx sdk.Context, markerAddr, grantee sdk.AccAddress) (sdkmath.Int, error) {
+	first := windowStartHour(types.TransferVolumeHour(ctx.BlockTime()))
+	total := sdkmath.ZeroInt()
+	rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, sdk.AccAddress, uint64](markerAddr, grantee)
+	err := k.accessGrantMintVolumes.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, uint64], amount sdkmath.Int) (bool, error) {
+		if key.K3() >= first {
+			total = total.Add(amount)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return sdkmath.Int{}, fmt.Errorf("could not read access grant mint volumes: %w", err)
+	}
+	return total, nil
+}
+
+// GetAllAccessGrantMintVolumes gets all recorded hourly access grant mint volumes.
+func (k Keeper) GetAllAccessGrantMintVolumes(ctx sdk.Context) []types.AccessGrantMintVolume {
+	var rv []types.AccessGrantMintVolume
+	err := k.accessGrantMintVolumes.Walk(ctx, nil, func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, uint64], amount sdkmath.Int) (bool, error) {
+		terms, err := k.GetAccessGrantTerms(ctx, key.K1(), key.K2())
+		if err != nil {
+			return true, err
+		}
+		if terms == nil {
+			return true, fmt.Errorf("access grant terms of %s on %s not found", key.K2(), key.K1())
+		}
+		rv = append(rv, types.AccessGrantMintVolume{Denom: terms.Denom, Address: terms.Address, Hour: key.K3(), Amount: amount})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// SetAccessGrantMintVolume stores an hourly access grant mint volume.
+func (k Keeper) SetAccessGrantMintVolume(ctx sdk.Context, volume types.AccessGrantMintVolume) error {
+	if err := volume.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(volume.Denom)
+	if err != nil {
+		return err
+	}
+	grantee := sdk.MustAccAddressFromBech32(volume.Address)
+	if err = k.accessGrantMintVolumes.Set(ctx, collections.Join3(markerAddr, grantee, volume.Hour), volume.Amount); err != nil {
+		return fmt.Errorf("failed to set access grant mint volume: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// accessGrantTermsFixture is a coin marker with an admin and a hot wallet that can mint and withdraw.
+type accessGrantTermsFixture struct {
+	*MsgServerTestSuite
+
+	denom      string
+	markerAddr sdk.AccAddress
+	admin      sdk.AccAddress
+	hotWallet  sdk.AccAddress
+	treasury   sdk.AccAddress
+	other      sdk.AccAddress
+}
+
+func (s *MsgServerTestSuite) newAccessGrantTermsFixture() *accessGrantTermsFixture {
+	f := &accessGrantTermsFixture{
+		MsgServerTestSuite: s,
+		denom:              "grantcoin",
+		markerAddr:         types.MustGetMarkerAddress("grantcoin"),
+		admin:              sdk.AccAddress("grant_admin_________"),
+		hotWallet:          sdk.AccAddress("grant_hot_wallet____"),
+		treasury:           sdk.AccAddress("grant_treasury______"),
+		other:              sdk.AccAddress("grant_other_________"),
+	}
+	s.blockStartTime = time.Date(2026, 6, 1, 12, 30, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(s.blockStartTime)
+
+	storeTestMarker(s.T(), s.app, s.ctx, newTestMarker(f.denom, types.MarkerType_Coin, 0,
+		types.AccessGrant{Address: f.admin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Withdraw}},
+		types.AccessGrant{Address: f.hotWallet.String(), Permissions: types.AccessList{types.Access_Mint, types.Access_Withdraw}},
+	))
+	return f
+}
+
+func (f *accessGrantTermsFixture) setTerms(expiresAt *time.Time, mintLimit int64, withdrawAddrs ...sdk.AccAddress) {
+	var addrs []string
+	for _, addr := range withdrawAddrs {
+		addrs = append(addrs, addr.String())
+	}
+	msg := types.NewMsgSetAccessGrantTermsRequest(f.denom, f.admin, f.hotWallet, expiresAt, sdkmath.NewInt(mintLimit), addrs)
+	_, err := f.msgServer.SetAccessGrantTerms(f.ctx, msg)
+	f.Require().NoError(err, "SetAccessGrantTerms")
+}
+
+// mint mints coins by the hot wallet in a block that's the given number of hours after the start time.
+// The mint is only committed if it succeeds.
+func (f *accessGrantTermsFixture) mint(hours int, amount int64) error {
+	ctx, writeCache := f.ctx.WithBlockTime(f.blockStartTime.Add(time.Duration(hours) * time.Hour)).CacheContext()
+	err := f.app.MarkerKeeper.MintCoin(ctx, f.hotWallet, sdk.NewInt64Coin(f.denom, amount))
+	if err == nil {
+		writeCache()
+	}
+	return err
+}
+
+func (s *MsgServerTestSuite) TestSetAccessGrantTerms() {
+	f := s.newAccessGrantTermsFixture()
+	expiresAt := s.blockStartTime.Add(time.Hour)
+
+	s.Run("not an admin", func() {
+		msg := types.NewMsgSetAccessGrantTermsRequest(f.denom, f.hotWallet, f.hotWallet, &expiresAt, sdkmath.ZeroInt(), nil)
+		_, err := s.msgServer.SetAccessGrantTerms(s.ctx, msg)
+		s.Require().ErrorContains(err, "does not have ACCESS_ADMIN")
+	})
+	s.Run("address without a grant", func() {
+		msg := types.NewMsgSetAccessGrantTermsRequest(f.denom, f.admin, f.other, &expiresAt, sdkmath.ZeroInt(), nil)
+		_, err := s.msgServer.SetAccessGrantTerms(s.ctx, msg)
+		s.Require().ErrorContains(err, "does not have an access grant on grantcoin marker")
+	})
+	s.Run("already expired", func() {
+		msg := types.NewMsgSetAccessGrantTermsRequest(f.denom, f.admin, f.hotWallet, &s.blockStartTime, sdkmath.ZeroInt(), nil)
+		_, err := s.msgServer.SetAccessGrantTerms(s.ctx, msg)
+		s.Require().ErrorContains(err, "must be after the current block time")
+	})
+
+	f.setTerms(&expiresAt, 100)
+	terms, err := s.app.MarkerKeeper.GetAccessGrantTerms(s.ctx, f.markerAddr, f.hotWallet)
+	s.Require().NoError(err, "GetAccessGrantTerms")
+	s.Require().NotNil(terms, "GetAccessGrantTerms")
+	s.Assert().Equal(expiresAt, *terms.ExpiresAt, "expires at")
+	s.Assert().Equal("100", terms.MintDailyLimit.String(), "mint daily limit")
+
+	s.Require().NoError(f.mint(0, 10), "mint")
+	f.setTerms(nil, 0)
+	terms, err = s.app.MarkerKeeper.GetAccessGrantTerms(s.ctx, f.markerAddr, f.hotWallet)
+	s.Require().NoError(err, "GetAccessGrantTerms after clearing")
+	s.Assert().Nil(terms, "GetAccessGrantTerms after clearing")
+	s.Assert().Empty(s.app.MarkerKeeper.GetAllAccessGrantMintVolumes(s.ctx), "mint volumes after clearing")
+}
+
+func (s *MsgServerTestSuite) TestMintDailyLimit() {
+	f := s.newAccessGrantTermsFixture()
+	f.setTerms(nil, 100)
+
+	s.Require().NoError(f.mint(0, 60), "mint 60 at hour 0")
+	s.Require().NoError(f.mint(10, 40), "mint 40 at hour 10")
+	s.Assert().ErrorContains(f.mint(23, 1), "exceeds mint daily limit of 100grantcoin (100grantcoin already minted)", "mint 1 at hour 23")
+
+	resp, err := s.app.MarkerKeeper.AccessGrantTerms(s.ctx.WithBlockTime(s.blockStartTime.Add(23*time.Hour)),
+		&types.QueryAccessGrantTermsRequest{Id: f.denom, Address: f.hotWallet.String()})
+	s.Require().NoError(err, "AccessGrantTerms query")
+	s.Require().NotNil(resp.Terms, "AccessGrantTerms query terms")
+	s.Assert().Equal("100", resp.MintVolume.String(), "mint volume")
+
+	genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	s.Assert().Len(genState.AccessGrantTerms, 1, "exported access grant terms")
+	s.Assert().Len(genState.AccessGrantMintVolumes, 2, "exported access grant mint volumes")
+
+	s.Require().NoError(f.mint(24, 60), "mint 60 once the first mint leaves the window")
+}
+
+func (s *MsgServerTestSuite) TestWithdrawAddresses() {
+	f := s.newAccessGrantTermsFixture()
+	s.Require().NoError(f.mint(0, 100), "mint")
+	f.setTerms(nil, 0, f.treasury)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(f.denom, 10))
+	s.Assert().ErrorContains(s.app.MarkerKeeper.WithdrawCoins(s.ctx, f.hotWallet, f.other, f.denom, coins),
+		"is not allowed to withdraw from grantcoin marker", "withdraw to other address")
+	s.Assert().ErrorContains(s.app.MarkerKeeper.WithdrawCoins(s.ctx, f.hotWallet, nil, f.denom, coins),
+		"is not allowed to withdraw from grantcoin marker", "withdraw to self")
+	s.Require().NoError(s.app.MarkerKeeper.WithdrawCoins(s.ctx, f.hotWallet, f.treasury, f.denom, coins), "withdraw to treasury")
+	s.Require().NoError(s.app.MarkerKeeper.WithdrawCoins(s.ctx, f.admin, f.other, f.denom, coins), "withdraw by admin without terms")
+
+	ctx := types.WithTransferAgents(s.ctx, f.hotWallet)
+	s.Assert().ErrorContains(s.app.BankKeeper.SendCoins(ctx, f.markerAddr, f.other, coins),
+		"is not allowed to withdraw from grantcoin marker", "bank send to other address")
+	s.Require().NoError(s.app.BankKeeper.SendCoins(ctx, f.markerAddr, f.treasury, coins), "bank send to treasury")
+	ctx = types.WithTransferAgents(s.ctx, f.hotWallet, f.admin)
+	s.Require().NoError(s.app.BankKeeper.SendCoins(ctx, f.markerAddr, f.other, coins), "bank send with admin as a transfer agent")
+}
+
+func (s *MsgServerTestSuite) TestExpiredAccessGrants() {
+	f := s.newAccessGrantTermsFixture()
+	expiresAt := s.blockStartTime.Add(2 * time.Hour)
+	f.setTerms(&expiresAt, 0)
+
+	s.app.MarkerKeeper.RemoveExpiredAccessGrants(s.ctx.WithBlockTime(expiresAt.Add(-time.Second)))
+	marker, err := s.app.MarkerKeeper.GetMarker(s.ctx, f.markerAddr)
+	s.Require().NoError(err, "GetMarker before expiry")
+	s.Assert().True(marker.AddressHasAccess(f.hotWallet, types.Access_Mint), "hot wallet has mint access before expiry")
+
+	ctx := s.ctx.WithBlockTime(expiresAt).WithEventManager(sdk.NewEventManager())
+	s.app.MarkerKeeper.RemoveExpiredAccessGrants(ctx)
+	marker, err = s.app.MarkerKeeper.GetMarker(s.ctx, f.markerAddr)
+	s.Require().NoError(err, "GetMarker after expiry")
+	s.Assert().False(marker.AddressHasAccess(f.hotWallet, types.Access_Mint), "hot wallet has mint access after expiry")
+	s.Assert().True(marker.AddressHasAccess(f.admin, types.Access_Admin), "admin has admin access after expiry")
+	var eventTypes []string
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	s.Assert().Contains(eventTypes, "provenance.marker.v1.EventMarkerAccessGrantExpired", "emitted event types")
+
+	terms, err := s.app.MarkerKeeper.GetAccessGrantTerms(s.ctx, f.markerAddr, f.hotWallet)
+	s.Require().NoError(err, "GetAccessGrantTerms after expiry")
+	s.Assert().Nil(terms, "GetAccessGrantTerms after expiry")
+}
+
+func (s *MsgServerTestSuite) TestExpiredAccessGrantsAfterReplace() {
+	f := s.newAccessGrantTermsFixture()
+	firstExpiry := s.blockStartTime.Add(2 * time.Hour)
+	secondExpiry := s.blockStartTime.Add(4 * time.Hour)
+	f.setTerms(&firstExpiry, 0)
+	f.setTerms(&secondExpiry, 0)
+
+	s.app.MarkerKeeper.RemoveExpiredAccessGrants(s.ctx.WithBlockTime(firstExpiry))
+	marker, err := s.app.MarkerKeeper.GetMarker(s.ctx, f.markerAddr)
+	s.Require().NoError(err, "GetMarker at first expiry")
+	s.Assert().True(marker.AddressHasAccess(f.hotWallet, types.Access_Mint), "hot wallet has mint access at first expiry")
+
+	s.app.MarkerKeeper.RemoveExpiredAccessGrants(s.ctx.WithBlockTime(secondExpiry))
+	marker, err = s.app.MarkerKeeper.GetMarker(s.ctx, f.markerAddr)
+	s.Require().NoError(err, "GetMarker at second expiry")
+	s.Assert().False(marker.AddressHasAccess(f.hotWallet, types.Access_Mint), "hot wallet has mint access at second expiry")
+
+	// Terms without an expiry should not be removed, even once an old expiry has passed.
+	s.Require().NoError(s.app.MarkerKeeper.AddAccess(s.ctx, f.admin, f.denom,
+		types.NewAccessGrant(f.hotWallet, types.AccessList{types.Access_Mint})), "AddAccess")
+	f.setTerms(&firstExpiry, 100)
+	f.setTerms(nil, 100)
+	s.app.MarkerKeeper.RemoveExpiredAccessGrants(s.ctx.WithBlockTime(secondExpiry))
+	terms, err := s.app.MarkerKeeper.GetAccessGrantTerms(s.ctx, f.markerAddr, f.hotWallet)
+	s.Require().NoError(err, "GetAccessGrantTerms after removing the expiry")
+	s.Assert().NotNil(terms, "GetAccessGrantTerms after removing the expiry")
+}
+
+func (s *MsgServerTestSuite) TestDeleteAccessRemovesTerms() {
+	f := s.newAccessGrantTermsFixture()
+	f.setTerms(nil, 100)
+	s.Require().NoError(f.mint(0, 10), "mint")
+
+	_, err := s.msgServer.DeleteAccess(s.ctx, types.NewDeleteAccessRequest(f.denom, f.admin, f.hotWallet))
+	s.Require().NoError(err, "DeleteAccess")
+
+	terms, err := s.app.MarkerKeeper.GetAccessGrantTerms(s.ctx, f.markerAddr, f.hotWallet)
+	s.Require().NoError(err, "GetAccessGrantTerms after DeleteAccess")
+	s.Assert().Nil(terms, "GetAccessGrantTerms after DeleteAccess")
+	s.Assert().Empty(s.app.MarkerKeeper.GetAllAccessGrantMintVolumes(s.ctx), "mint volumes after DeleteAccess")
+}
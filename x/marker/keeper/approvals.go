@@ -0,0 +1,382 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// approvedOperationKey is the context key used to flag that a msg is being executed as an approved pending operation.
+type approvedOperationKey struct{}
+
+// withApprovedOperation returns a new context that lets a mint, withdraw or forced transfer run without
+// creating a pending operation.
+func withApprovedOperation(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(approvedOperationKey{}, true)
+}
+
+// isApprovedOperation checks the context to see if a msg is being executed as an approved pending operation.
+func isApprovedOperation(ctx sdk.Context) bool {
+	approved, isBool := ctx.Value(approvedOperationKey{}).(bool)
+	return isBool && approved
+}
+
+// SetApprovalThreshold sets the number of approvals needed for operations that use a permission on a marker.
+// A threshold of zero or one removes the threshold.
+func (k Keeper) SetApprovalThreshold(ctx sdk.Context, markerAddr sdk.AccAddress, permission types.Access, threshold uint32) error {
+	if !types.IsApprovalPermission(permission) {
+		return fmt.Errorf("cannot set an approval threshold for %s", permission)
+	}
+	key := collections.Join(markerAddr, permission)
+	if threshold < 2 {
+		if err := k.approvalThresholds.Remove(ctx, key); err != nil {
+			return fmt.Errorf("failed to remove %s approval threshold: %w", permission, err)
+		}
+		return nil
+	}
+	if err := k.approvalThresholds.Set(ctx, key, threshold); err != nil {
+		return fmt.Errorf("failed to set %s approval threshold: %w", permission, err)
+	}
+	return nil
+}
+
+// GetApprovalThreshold gets the number of approvals needed for operations that use a permission on a marker.
+// Returns zero if the permission does not have a threshold.
+func (k Keeper) GetApprovalThreshold(ctx sdk.Context, markerAddr sdk.AccAddress, permission types.Access) (uint32, error) {
+	threshold, err := k.approvalThresholds.Get(ctx, collections.Join(markerAddr, permission))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("could not read %s approval threshold: %w", permission, err)
+	}
+	return threshold, nil
+}
+
+// GetApprovalThresholds gets the approval thresholds of a marker's permissions.
+func (k Keeper) GetApprovalThresholds(ctx sdk.Context, marker types.MarkerAccountI) ([]types.ApprovalThreshold, error) {
+	var rv []types.ApprovalThreshold
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, types.Access](marker.GetAddress())
+	err := k.approvalThresholds.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, types.Access], threshold uint32) (bool, error) {
+		rv = append(rv, types.ApprovalThreshold{Denom: marker.GetDenom(), Permission: key.K2(), Threshold: threshold})
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read approval thresholds: %w", err)
+	}
+	return rv, nil
+}
+
+// GetAllApprovalThresholds gets the approval thresholds of all markers.
+func (k Keeper) GetAllApprovalThresholds(ctx sdk.Context) []types.ApprovalThreshold {
+	var rv []types.ApprovalThreshold
+	err := k.approvalThresholds.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, types.Access], threshold uint32) (bool, error) {
+		marker, err := k.GetMarker(ctx, key.K1())
+		if err != nil {
+			return true, err
+		}
+		if marker == nil {
+			return false, nil
+		}
+		rv = append(rv, types.ApprovalThreshold{Denom: marker.GetDenom(), Permission: key.K2(), Threshold: threshold})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// ClearApprovals removes all of a marker's approval thresholds and pending operations.
+func (k Keeper) ClearApprovals(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	thresholdsRng := collections.NewPrefixedPairRange[sdk.AccAddress, types.Access](markerAddr)
+	if err := k.approvalThresholds.Clear(ctx, thresholdsRng); err != nil {
+		panic(fmt.Errorf("failed to remove approval thresholds: %w", err))
+	}
+	var ids []uint64
+	opsRng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](markerAddr)
+	err := k.pendingOperationsByMarker.Walk(ctx, opsRng, func(key collections.Pair[sdk.AccAddress, uint64], _ bool) (bool, error) {
+		ids = append(ids, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to read pending operations: %w", err))
+	}
+	for _, id := range ids {
+		if err = k.pendingOperations.Remove(ctx, id); err != nil {
+			panic(fmt.Errorf("failed to remove pending operation %d: %w", id, err))
+		}
+	}
+	if err = k.pendingOperationsByMarker.Clear(ctx, opsRng); err != nil {
+		panic(fmt.Errorf("failed to remove pending operation marker index: %w", err))
+	}
+}
+
+// validateApprovalThreshold makes sure enough addresses have the permission on the marker to meet the threshold.
+func validateApprovalThreshold(marker types.MarkerAccountI, permission types.Access, threshold uint32) error {
+	if threshold < 2 {
+		return nil
+	}
+	holders := marker.AddressListForPermission(permission)
+	if len(holders) < int(threshold) {
+		return fmt.Errorf("approval threshold %d is more than the %d addresses with %s on %s marker",
+			threshold, len(holders), permission, marker.GetDenom())
+	}
+	return nil
+}
+
+// proposeOperation creates a pending operation for the msg if the marker requires more than one approval for any
+// of the provided permissions. The operation uses the permission with the highest threshold, and the proposer
+// is counted as its first approval. Returns the id of the new operation, or zero if the msg should be executed now.
+func (k Keeper) proposeOperation(ctx sdk.Context, denom, proposer string, msg sdk.Msg, permissions ...types.Access) (uint64, error) {
+	if isApprovedOperation(ctx) {
+		return 0, nil
+	}
+	// If there isn't a marker, let the msg fail the way it normally would.
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return 0, nil //nolint:nilerr // The msg handler will return a more meaningful error.
+	}
+
+	var permission types.Access
+	var threshold uint32
+	for _, perm := range permissions {
+		permThreshold, err := k.GetApprovalThreshold(ctx, marker.GetAddress(), perm)
+		if err != nil {
+			return 0, err
+		}
+		if permThreshold > threshold {
+			permission, threshold = perm, permThreshold
+		}
+	}
+	if threshold < 2 {
+		return 0, nil
+	}
+
+	for _, perm := range permissions {
+		if err = marker.ValidateHasAccess(proposer, perm); err != nil {
+			return 0, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+		}
+	}
+
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return 0, fmt.Errorf("could not pack %T: %w", msg, err)
+	}
+	id, err := k.getNextPendingOperationID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err = k.SetNextPendingOperationID(ctx, id+1); err != nil {
+		return 0, err
+	}
+	op := types.PendingOperation{
+		Id:                id,
+		Denom:             marker.GetDenom(),
+		Permission:        permission,
+		RequiredApprovals: threshold,
+		Msg:               msgAny,
+		Approvers:         []string{proposer},
+		ExpiresAt:         ctx.BlockTime().Add(types.PendingOperationLifetime),
+	}
+	if err = k.SetPendingOperation(ctx, op); err != nil {
+		return 0, err
+	}
+
+	return id, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerOperationProposed(op))
+}
+
+// isForcedTransfer returns true if the transfer would be done using the administrator's force transfer access.
+func (k Keeper) isForcedTransfer(ctx sdk.Context, msg *types.MsgTransferRequest) bool {
+	if msg.Administrator == msg.FromAddress {
+		return false
+	}
+	marker, err := k.GetMarkerByDenom(ctx, msg.Amount.Denom)
+	if err != nil {
+		return false
+	}
+	return marker.AllowsForcedTransfer() && marker.HasAccess(msg.Administrator, types.Access_ForceTransfer)
+}
+
+// validateWithdrawNeedsNoApproval returns an error if withdrawals from the marker require more than one approval.
+// Withdrawals by transfer agents can't be approved, so they aren't allowed on such markers.
+func (k Keeper) validateWithdrawNeedsNoApproval(ctx sdk.Context, marker types.MarkerAccountI) error {
+	threshold, err := k.GetApprovalThreshold(ctx, marker.GetAddress(), types.Access_Withdraw)
+	if err != nil {
+		return err
+	}
+	if threshold > 1 {
+		return fmt.Errorf("withdrawals from %s marker require %d approvals and must be made using the marker module",
+			marker.GetDenom(), threshold)
+	}
+	return nil
+}
+
+// ApproveOperation records an approval of a pending operation. Once the operation has enough approvals,
+// it is removed from state and true is returned; it's then up to the caller to execute it.
+func (k Keeper) ApproveOperation(ctx sdk.Context, op types.PendingOperation, approver string) (bool, error) {
+	if op.IsExpired(ctx.BlockTime()) {
+		return false, fmt.Errorf("pending operation %d expired at %s", op.Id, op.ExpiresAt)
+	}
+	if op.HasApproved(approver) {
+		return false, fmt.Errorf("%s has already approved pending operation %d", approver, op.Id)
+	}
+
+	op.Approvers = append(op.Approvers, approver)
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerOperationApproved(op, approver)); err != nil {
+		return false, err
+	}
+	if len(op.Approvers) < int(op.RequiredApprovals) {
+		return false, k.SetPendingOperation(ctx, op)
+	}
+	return true, k.removePendingOperation(ctx, op)
+}
+
+// CancelOperation removes a pending operation without executing it.
+func (k Keeper) CancelOperation(ctx sdk.Context, op types.PendingOperation, signer string) error {
+	if err := k.removePendingOperation(ctx, op); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerOperationCancelled(op, signer))
+}
+
+// RemoveExpiredOperations removes the pending operations that expired without getting enough approvals.
+func (k Keeper) RemoveExpiredOperations(ctx sdk.Context) {
+	// All operations have the same lifetime, so they expire in the order they were created (i.e. by id).
+	var expired []types.PendingOperation
+	blockTime := ctx.BlockTime()
+	k.IteratePendingOperations(ctx, func(op types.PendingOperation) bool {
+		if !op.IsExpired(blockTime) {
+			return true
+		}
+		expired = append(expired, op)
+		return false
+	})
+
+	for _, op := range expired {
+		if err := k.removePendingOperation(ctx, op); err != nil {
+			ctx.Logger().Error("failed to remove expired pending operation", "id", op.Id, "error", err)
+			continue
+		}
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerOperationExpired(op)); err != nil {
+			ctx.Logger().Error("failed to emit pending operation expired event", "id", op.Id, "error", err)
+		}
+	}
+}
+
+// getNextPendingOperationID gets the id to use for the next pending operation.
+func (k Keeper) getNextPendingOperationID(ctx sdk.Context) (uint64, error) {
+	id, err := k.nextPendingOperationID.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 1, nil
+		}
+		return 0, fmt.Errorf("could not read next pending operation id: %w", err)
+	}
+	return id, nil
+}
+
+// GetNextPendingOperationID gets the id that will be used for the next pending operation.
+func (k Keeper) GetNextPendingOperationID(ctx sdk.Context) uint64 {
+	id, err := k.getNextPendingOperationID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SetNextPendingOperationID sets the id that will be used for the next pending operation.
+func (k Keeper) SetNextPendingOperationID(ctx sdk.Context, id uint64) error {
+	if err := k.nextPendingOperationID.Set(ctx, id); err != nil {
+		return fmt.Errorf("failed to set next pending operation id: %w", err)
+	}
+	return nil
+}
+
+// SetPendingOperation stores the provided pending operation and indexes it by marker.
+func (k Keeper) SetPendingOperation(ctx sdk.Context, op types.PendingOperation) error {
+	if err := op.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(op.Denom)
+	if err != nil {
+		return err
+	}
+	if err = k.pendingOperations.Set(ctx, op.Id, op); err != nil {
+		return fmt.Errorf("failed to set pending operation %d: %w", op.Id, err)
+	}
+	if err = k.pendingOperationsByMarker.Set(ctx, collections.Join(markerAddr, op.Id), true); err != nil {
+		return fmt.Errorf("failed to index pending operation %d by marker: %w", op.Id, err)
+	}
+	return nil
+}
+
+// removePendingOperation deletes a pending operation and its index entry.
+func (k Keeper) removePendingOperation(ctx sdk.Context, op types.PendingOperation) error {
+	markerAddr, err := types.MarkerAddress(op.Denom)
+	if err != nil {
+		return err
+	}
+	if err = k.pendingOperations.Remove(ctx, op.Id); err != nil {
+		return fmt.Errorf("failed to remove pending operation %d: %w", op.Id, err)
+	}
+	if err = k.pendingOperationsByMarker.Remove(ctx, collections.Join(markerAddr, op.Id)); err != nil {
+		return fmt.Errorf("failed to remove pending operation %d marker index: %w", op.Id, err)
+	}
+	return nil
+}
+
+// GetPendingOperation gets the pending operation with the provided id. Returns nil if it doesn't exist.
+func (k Keeper) GetPendingOperation(ctx sdk.Context, id uint64) (*types.PendingOperation, error) {
+	op, err := k.pendingOperations.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read pending operation %d: %w", id, err)
+	}
+	return &op, nil
+}
+
+// IteratePendingOperations iterates over all pending operations in order of id.
+func (k Keeper) IteratePendingOperations(ctx sdk.Context, cb func(op types.PendingOperation) (stop bool)) {
+	err := k.pendingOperations.Walk(ctx, nil, func(_ uint64, op types.PendingOperation) (bool, error) {
+		return cb(op), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// executeOperation runs the msg of a pending operation that has enough approvals.
+func (k msgServer) executeOperation(ctx sdk.Context, op types.PendingOperation) error {
+	var msg sdk.Msg
+	if err := k.cdc.UnpackAny(op.Msg, &msg); err != nil {
+		return fmt.Errorf("could not unpack pending operation %d msg: %w", op.Id, err)
+	}
+
+	execCtx := withApprovedOperation(ctx)
+	var err error
+	switch m := msg.(type) {
+	case *types.MsgMintRequest:
+		_, err = k.Mint(execCtx, m)
+	case *types.MsgWithdrawRequest:
+		_, err = k.Withdraw(execCtx, m)
+	case *types.MsgTransferRequest:
+		_, err = k.Transfer(execCtx, m)
+	default:
+		err = fmt.Errorf("unsupported msg type %T", msg)
+	}
+	if err != nil {
+		return fmt.Errorf("could not execute pending operation %d: %w", op.Id, err)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerOperationExecuted(op))
+}
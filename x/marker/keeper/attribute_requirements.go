@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attrTypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetAttributeRequirements stores the attribute requirements of a marker.
+// If there aren't any requirements, the marker's entry is removed.
+func (k Keeper) SetAttributeRequirements(ctx sdk.Context, markerAddr sdk.AccAddress, requirements types.AttributeRequirements) error {
+	if err := requirements.Validate(); err != nil {
+		return err
+	}
+	if len(requirements.Requirements) == 0 {
+		return k.RemoveAttributeRequirements(ctx, markerAddr)
+	}
+	if err := k.attributeRequirements.Set(ctx, markerAddr, requirements); err != nil {
+		return fmt.Errorf("failed to set attribute requirements: %w", err)
+	}
+	return nil
+}
+
+// RemoveAttributeRequirements deletes the attribute requirements of a marker.
+func (k Keeper) RemoveAttributeRequirements(ctx sdk.Context, markerAddr sdk.AccAddress) error {
+	if err := k.attributeRequirements.Remove(ctx, markerAddr); err != nil {
+		return fmt.Errorf("failed to remove attribute requirements: %w", err)
+	}
+	return nil
+}
+
+// GetAttributeRequirements gets the attribute requirements of a marker. Returns nil if the marker doesn't have any.
+func (k Keeper) GetAttributeRequirements(ctx sdk.Context, markerAddr sdk.AccAddress) ([]types.AttributeRequirement, error) {
+	requirements, err := k.attributeRequirements.Get(ctx, markerAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read attribute requirements: %w", err)
+	}
+	return requirements.Requirements, nil
+}
+
+// IterateAttributeRequirements iterates over the attribute requirements of all markers.
+func (k Keeper) IterateAttributeRequirements(ctx sdk.Context, cb func(requirements types.AttributeRequirements) (stop bool)) {
+	err := k.attributeRequirements.Walk(ctx, nil, func(_ sdk.AccAddress, requirements types.AttributeRequirements) (bool, error) {
+		return cb(requirements), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// NormalizeAttributeRequirements returns a copy of the provided requirements with each name normalized
+// the same way as required attributes.
+func (k Keeper) NormalizeAttributeRequirements(ctx sdk.Context, requirements []types.AttributeRequirement) ([]types.AttributeRequirement, error) {
+	if len(requirements) == 0 {
+		return nil, nil
+	}
+	names := make([]string, len(requirements))
+	for i, req := range requirements {
+		names[i] = req.Name
+	}
+	names, err := k.NormalizeRequiredAttributes(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]types.AttributeRequirement, len(requirements))
+	for i, req := range requirements {
+		rv[i] = req
+		rv[i].Name = names[i]
+	}
+	return rv, nil
+}
+
+// findUnmetRequirements returns the descriptions of all requirements that are not met by at least
+// one of the provided attributes with a matching name.
+func findUnmetRequirements(ctx sdk.Context, requirements []types.AttributeRequirement, attributes []attrTypes.Attribute) []string {
+	var rv []string
+reqLoop:
+	for _, req := range requirements {
+		for _, attr := range attributes {
+			if MatchAttribute(req.Name, attr.Name) && req.IsMetByAttribute(attr, ctx.BlockTime()) {
+				continue reqLoop
+			}
+		}
+		rv = append(rv, req.Description())
+	}
+	return rv
+}
+
+// validateAttributeRequirements makes sure that the provided attributes meet all of the requirements.
+func validateAttributeRequirements(ctx sdk.Context, denom string, toAddr sdk.AccAddress, requirements []types.AttributeRequirement, attributes []attrTypes.Attribute) error {
+	unmet := findUnmetRequirements(ctx, requirements, attributes)
+	if len(unmet) == 0 {
+		return nil
+	}
+	pl := ""
+	if len(unmet) != 1 {
+		pl = "s"
+	}
+	return fmt.Errorf("address %s does not meet the %q attribute requirement%s: \"%s\"", toAddr.String(), denom, pl, strings.Join(unmet, `", "`))
+}
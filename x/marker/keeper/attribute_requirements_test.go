@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// attributeRequirementsFixture is a restricted marker with a funded holder and accounts with different kyc attributes.
+type attributeRequirementsFixture struct {
+	*MsgServerTestSuite
+
+	denom      string
+	markerAddr sdk.AccAddress
+	authority  sdk.AccAddress
+	holder     sdk.AccAddress
+	usAddr     sdk.AccAddress
+	frAddr     sdk.AccAddress
+	shortAddr  sdk.AccAddress
+	noAttrAddr sdk.AccAddress
+
+	inCountries types.AttributeRequirement
+}
+
+func (s *MsgServerTestSuite) newAttributeRequirementsFixture() *attributeRequirementsFixture {
+	f := &attributeRequirementsFixture{
+		MsgServerTestSuite: s,
+		denom:              "reqcoin",
+		markerAddr:         types.MustGetMarkerAddress("reqcoin"),
+		authority:          sdk.AccAddress("req_authority_______"),
+		holder:             sdk.AccAddress("req_holder__________"),
+		usAddr:             sdk.AccAddress("req_us______________"),
+		frAddr:             sdk.AccAddress("req_fr______________"),
+		shortAddr:          sdk.AccAddress("req_short___________"),
+		noAttrAddr:         sdk.AccAddress("req_no_attr_________"),
+		inCountries: types.AttributeRequirement{
+			Name:        "kyc.provenance.io",
+			Condition:   types.AttributeCondition_In,
+			JsonPath:    "country",
+			Values:      []string{"US", "CA"},
+			MinValidity: 30 * 24 * time.Hour,
+		},
+	}
+	s.blockStartTime = time.Date(2026, 6, 1, 12, 30, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(s.blockStartTime)
+
+	nameOwner := sdk.AccAddress("req_name_owner______")
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, nameOwner))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "kyc.provenance.io", nameOwner, false), "SetNameRecord")
+	inTwoMonths := s.blockStartTime.Add(60 * 24 * time.Hour)
+	inTenDays := s.blockStartTime.Add(10 * 24 * time.Hour)
+	attrs := []attrtypes.Attribute{
+		{Address: f.usAddr.String(), Value: []byte(`{"country":"US"}`), ExpirationDate: &inTwoMonths},
+		{Address: f.frAddr.String(), Value: []byte(`{"country":"FR"}`), ExpirationDate: &inTwoMonths},
+		{Address: f.shortAddr.String(), Value: []byte(`{"country":"CA"}`), ExpirationDate: &inTenDays},
+	}
+	for _, attr := range attrs {
+		attr.Name = "kyc.provenance.io"
+		attr.AttributeType = attrtypes.AttributeType_JSON
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, nameOwner), "SetAttribute %s", attr.Address)
+	}
+
+	storeTestMarker(s.T(), s.app, s.ctx, newTestMarker(f.denom, types.MarkerType_RestrictedCoin, 1000,
+		types.AccessGrant{Address: f.authority.String(), Permissions: types.AccessList{types.Access_Transfer}},
+	))
+	fundTestAccount(s.T(), s.app, s.ctx, f.holder, sdk.NewInt64Coin(f.denom, 100))
+	return f
+}
+
+func (f *attributeRequirementsFixture) setRequirements(requirements ...types.AttributeRequirement) {
+	msg := types.NewMsgSetAttributeRequirementsRequest(f.denom, f.authority, requirements)
+	_, err := f.msgServer.SetAttributeRequirements(f.ctx, msg)
+	f.Require().NoError(err, "SetAttributeRequirements")
+}
+
+func (f *attributeRequirementsFixture) send(to sdk.AccAddress) error {
+	ctx, writeCache := f.ctx.CacheContext()
+	err := f.app.BankKeeper.SendCoins(ctx, f.holder, to, sdk.NewCoins(sdk.NewInt64Coin(f.denom, 1)))
+	if err == nil {
+		writeCache()
+	}
+	return err
+}
+
+func (s *MsgServerTestSuite) TestSetAttributeRequirements() {
+	f := s.newAttributeRequirementsFixture()
+	s.Run("no transfer access", func() {
+		msg := types.NewMsgSetAttributeRequirementsRequest(f.denom, f.holder, []types.AttributeRequirement{f.inCountries})
+		_, err := s.msgServer.SetAttributeRequirements(s.ctx, msg)
+		s.Require().ErrorContains(err, "does not have ACCESS_TRANSFER")
+	})
+	s.Run("governance not enabled", func() {
+		msg := &types.MsgSetAttributeRequirementsRequest{Denom: f.denom, TransferAuthority: s.app.MarkerKeeper.GetAuthority()}
+		_, err := s.msgServer.SetAttributeRequirements(s.ctx, msg)
+		s.Require().ErrorContains(err, "reqcoin marker does not allow governance control")
+	})
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	msg := types.NewMsgSetAttributeRequirementsRequest(f.denom, f.authority, []types.AttributeRequirement{f.inCountries})
+	_, err := s.msgServer.SetAttributeRequirements(ctx, msg)
+	s.Require().NoError(err, "SetAttributeRequirements")
+	expEvent, err := sdk.TypedEventToEvent(&types.EventMarkerSetAttributeRequirements{
+		Denom:         f.denom,
+		Requirements:  []string{"kyc.provenance.io:country in {US, CA} and valid for 720h0m0s"},
+		Administrator: f.authority.String(),
+	})
+	s.Require().NoError(err, "TypedEventToEvent")
+	s.Assert().Contains(ctx.EventManager().Events(), expEvent, "emitted events")
+
+	resp, err := s.app.MarkerKeeper.AttributeRequirements(s.ctx, &types.QueryAttributeRequirementsRequest{Id: f.denom})
+	s.Require().NoError(err, "AttributeRequirements query")
+	s.Assert().Equal([]types.AttributeRequirement{f.inCountries}, resp.Requirements, "requirements")
+
+	genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	s.Assert().Len(genState.AttributeRequirements, 1, "exported attribute requirements")
+
+	f.setRequirements()
+	resp, err = s.app.MarkerKeeper.AttributeRequirements(s.ctx, &types.QueryAttributeRequirementsRequest{Id: f.denom})
+	s.Require().NoError(err, "AttributeRequirements query after removal")
+	s.Assert().Empty(resp.Requirements, "requirements after removal")
+}
+
+func (s *MsgServerTestSuite) TestSendWithRequirements() {
+	f := s.newAttributeRequirementsFixture()
+	s.Require().ErrorContains(f.send(f.usAddr), "does not have transfer permissions for reqcoin", "send before requirements are set")
+
+	f.setRequirements(f.inCountries)
+	s.Assert().NoError(f.send(f.usAddr), "send to an account in the US")
+	s.Assert().EqualError(f.send(f.frAddr),
+		`address `+f.frAddr.String()+` does not meet the "reqcoin" attribute requirement: "kyc.provenance.io:country in {US, CA} and valid for 720h0m0s"`,
+		"send to an account in FR")
+	s.Assert().ErrorContains(f.send(f.shortAddr), "does not meet the \"reqcoin\" attribute requirement", "send to an account with an attribute expiring soon")
+	s.Assert().ErrorContains(f.send(f.noAttrAddr), "does not meet the \"reqcoin\" attribute requirement", "send to an account without the attribute")
+
+	s.Require().NoError(s.app.MarkerKeeper.SetAttributeRequirements(s.ctx, f.markerAddr, types.AttributeRequirements{
+		Denom: f.denom,
+		Requirements: []types.AttributeRequirement{
+			f.inCountries,
+			{Name: "*.provenance.io", Condition: types.AttributeCondition_NotIn, JsonPath: "country", Values: []string{"US"}},
+		},
+	}), "SetAttributeRequirements with two requirements")
+	s.Assert().EqualError(f.send(f.noAttrAddr),
+		`address `+f.noAttrAddr.String()+` does not meet the "reqcoin" attribute requirements: `+
+			`"kyc.provenance.io:country in {US, CA} and valid for 720h0m0s", "*.provenance.io:country not in {US}"`,
+		"send to an account without the attribute with two requirements")
+	s.Assert().EqualError(f.send(f.usAddr),
+		`address `+f.usAddr.String()+` does not meet the "reqcoin" attribute requirement: "*.provenance.io:country not in {US}"`,
+		"send to US with two requirements")
+}
@@ -0,0 +1,420 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/quarantine"
+)
+
+// GetCapTable builds a page of the current cap table of a marker's coin, in the same order as the bank module's
+// denom owners. The page request is passed on to the bank module, so a page can have fewer entries than its limit.
+// The marker module account, the marker's own account and the quarantine funds holder are skipped. Instead, coins
+// escrowed for pending redemptions are attributed to the redeeming holders, and coins waiting in quarantine are
+// attributed to their recipients. Accounts without a balance of the coin are not looked at.
+func (k Keeper) GetCapTable(ctx sdk.Context, marker types.MarkerAccountI, pageReq *query.PageRequest) ([]types.CapTableEntry, *query.PageResponse, error) {
+	req := &banktypes.QueryDenomOwnersRequest{Denom: marker.GetDenom(), Pagination: pageReq}
+	resp, err := k.bankKeeper.DenomOwners(ctx, req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get holders of %s: %w", marker.GetDenom(), err)
+	}
+
+	fundsHolder := k.getQuarantineFundsHolder()
+	var entries []types.CapTableEntry
+	for _, owner := range resp.DenomOwners {
+		addr, err := sdk.AccAddressFromBech32(owner.Address)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid holder address %q: %w", owner.Address, err)
+		}
+		entry, err := k.getCapTableEntry(ctx, marker, fundsHolder, addr, owner.Balance.Amount)
+		if err != nil {
+			return nil, nil, err
+		}
+		if entry != nil {
+			entries = append(entries, *entry)
+		}
+	}
+
+	pageRes := resp.Pagination
+	if pageRes != nil && pageRes.Total > 0 {
+		for _, addr := range []sdk.AccAddress{k.markerModuleAddr, marker.GetAddress(), fundsHolder} {
+			if len(addr) > 0 && k.bankKeeper.GetBalance(ctx, addr, marker.GetDenom()).IsPositive() {
+				pageRes.Total--
+			}
+		}
+	}
+	return entries, pageRes, nil
+}
+
+// getQuarantineFundsHolder gets the address of the quarantine funds holder, or nil if there isn't a quarantine keeper.
+func (k Keeper) getQuarantineFundsHolder() sdk.AccAddress {
+	if k.quarantineKeeper == nil {
+		return nil
+	}
+	return k.quarantineKeeper.GetFundsHolder()
+}
+
+// getCapTableEntry builds the cap table entry of a holder with the provided balance of a marker's coin.
+// Returns nil if the address is not a holder or the entry's total isn't positive.
+func (k Keeper) getCapTableEntry(ctx sdk.Context, marker types.MarkerAccountI, fundsHolder, holder sdk.AccAddress, balance sdkmath.Int) (*types.CapTableEntry, error) {
+	if holder.Equals(k.markerModuleAddr) || holder.Equals(marker.GetAddress()) || holder.Equals(fundsHolder) {
+		return nil, nil
+	}
+
+	var err error
+	denom := marker.GetDenom()
+	entry := types.NewCapTableEntry(holder)
+	entry.Balance = balance
+	entry.Spendable = k.bankKeeper.SpendableCoin(ctx, holder, denom).Amount
+	if entry.Frozen, err = k.getFrozenAmount(ctx, marker.GetAddress(), holder); err != nil {
+		return nil, err
+	}
+	entry.Frozen = sdkmath.MinInt(entry.Frozen, entry.Balance)
+	if k.holdKeeper != nil {
+		held, err := k.holdKeeper.GetHoldCoin(ctx, holder, denom)
+		if err != nil {
+			return nil, fmt.Errorf("could not get %s on hold for %s: %w", denom, holder, err)
+		}
+		entry.Held = held.Amount
+	}
+
+	if k.quarantineKeeper != nil {
+		k.quarantineKeeper.IterateQuarantineRecords(ctx, holder, func(_, _ sdk.AccAddress, record *quarantine.QuarantineRecord) bool {
+			entry.Quarantined = entry.Quarantined.Add(record.Coins.AmountOf(denom))
+			return false
+		})
+	}
+
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](holder)
+	err = k.redemptionsByHolder.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, uint64], _ bool) (bool, error) {
+		redemption, err := k.redemptions.Get(ctx, key.K2())
+		if err != nil {
+			return true, fmt.Errorf("could not read redemption %d: %w", key.K2(), err)
+		}
+		if redemption.Denom == denom {
+			entry.Escrowed = entry.Escrowed.Add(redemption.Amount.Amount)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Total = entry.Balance.Add(entry.Quarantined).Add(entry.Escrowed)
+	if !entry.Total.IsPositive() {
+		return nil, nil
+	}
+	return entry, nil
+}
+
+// getFrozenAmount gets the amount of a marker's coin that is frozen in a holder's account.
+// Expired freezes are ignored (even if they haven't been removed from state yet).
+func (k Keeper) getFrozenAmount(ctx sdk.Context, markerAddr, holder sdk.AccAddress) (sdkmath.Int, error) {
+	freeze, err := k.GetHolderFreeze(ctx, markerAddr, holder)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	if freeze == nil || !freeze.ExpiresAt.After(ctx.BlockTime()) {
+		return sdkmath.ZeroInt(), nil
+	}
+	return freeze.GetCoin().Amount, nil
+}
+
+// TakeHolderSnapshot starts a snapshot of the current cap table of a marker. Only one snapshot of a marker can be
+// taken in each block, and not while another is still being recorded. The cap table entries are recorded over the
+// following blocks (see ProcessHolderSnapshots). Returns the id of the new snapshot.
+func (k Keeper) TakeHolderSnapshot(ctx sdk.Context, marker types.MarkerAccountI, takenBy sdk.AccAddress) (uint64, error) {
+	latest, err := k.getLatestHolderSnapshot(ctx, marker.GetAddress())
+	if err != nil {
+		return 0, err
+	}
+	if latest != nil && latest.Height == ctx.BlockHeight() {
+		return 0, fmt.Errorf("holder snapshot %d of %s was already taken at height %d", latest.Id, marker.GetDenom(), latest.Height)
+	}
+	if latest != nil && latest.Pending {
+		return 0, fmt.Errorf("holder snapshot %d of %s is still being recorded", latest.Id, marker.GetDenom())
+	}
+
+	id, err := k.getNextHolderSnapshotID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err = k.SetNextHolderSnapshotID(ctx, id+1); err != nil {
+		return 0, err
+	}
+	snapshot := types.HolderSnapshot{
+		Id:      id,
+		Denom:   marker.GetDenom(),
+		Height:  ctx.BlockHeight(),
+		TakenAt: ctx.BlockTime(),
+		TakenBy: takenBy.String(),
+		Supply:  k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount,
+		Pending: true,
+	}
+	if err = k.SetHolderSnapshot(ctx, snapshot, nil); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// ProcessHolderSnapshots records up to the provided number of cap table entries for the holder snapshots
+// that are still pending. Each snapshot continues from where it left off in the previous block.
+func (k Keeper) ProcessHolderSnapshots(ctx sdk.Context, limit int) {
+	var ids []uint64
+	err := k.pendingHolderSnapshots.Walk(ctx, nil, func(key collections.Pair[string, uint64], _ bool) (bool, error) {
+		ids = append(ids, key.K2())
+		return len(ids) >= limit, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, id := range ids {
+		if limit <= 0 {
+			break
+		}
+		snapshot, err := k.GetHolderSnapshot(ctx, id)
+		if err != nil || snapshot == nil {
+			panic(fmt.Errorf("could not get holder snapshot %d: %w", id, err))
+		}
+		limit -= k.continueHolderSnapshot(ctx, snapshot, limit)
+	}
+}
+
+// continueHolderSnapshot records up to limit cap table entries for a holder snapshot, and
+// returns the number of accounts that were looked at.
+func (k Keeper) continueHolderSnapshot(ctx sdk.Context, snapshot *types.HolderSnapshot, limit int) int {
+	marker, err := k.GetMarkerByDenom(ctx, snapshot.Denom)
+	if err != nil {
+		panic(fmt.Errorf("could not get marker for holder snapshot %d: %w", snapshot.Id, err))
+	}
+	req := &banktypes.QueryDenomOwnersRequest{
+		Denom:      snapshot.Denom,
+		Pagination: &query.PageRequest{Key: snapshot.NextKey, Limit: uint64(limit)},
+	}
+	resp, err := k.bankKeeper.DenomOwners(ctx, req)
+	if err != nil {
+		ctx.Logger().Error("could not get holders, will try again next block", "snapshot_id", snapshot.Id, "error", err)
+		return 0
+	}
+
+	fundsHolder := k.getQuarantineFundsHolder()
+	for _, owner := range resp.DenomOwners {
+		addr, err := sdk.AccAddressFromBech32(owner.Address)
+		if err != nil {
+			panic(fmt.Errorf("invalid holder address %q: %w", owner.Address, err))
+		}
+		entry, err := k.getCapTableEntry(ctx, marker, fundsHolder, addr, owner.Balance.Amount)
+		if err != nil {
+			panic(err)
+		}
+		if entry == nil {
+			continue
+		}
+		if err = k.holderSnapshotEntries.Set(ctx, collections.Join(snapshot.Id, addr), *entry); err != nil {
+			panic(fmt.Errorf("failed to set holder snapshot %d entry for %s: %w", snapshot.Id, entry.Address, err))
+		}
+		snapshot.HolderCount++
+	}
+
+	snapshot.NextKey = nil
+	if resp.Pagination != nil {
+		snapshot.NextKey = resp.Pagination.NextKey
+	}
+	if len(snapshot.NextKey) == 0 {
+		snapshot.Pending = false
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerHolderSnapshotTaken(*snapshot)); err != nil {
+			ctx.Logger().Error("failed to emit holder snapshot taken event", "snapshot_id", snapshot.Id, "error", err)
+		}
+	}
+	if err = k.SetHolderSnapshot(ctx, *snapshot, nil); err != nil {
+		panic(err)
+	}
+	return len(resp.DenomOwners)
+}
+
+// validateNoPendingHolderSnapshot returns an error if coins are being sent to or from a holder while a snapshot
+// of that coin's cap table is still being recorded. Blocking those sends keeps each holder's balance the same as it
+// was when the snapshot was taken until it has been recorded.
+func (k Keeper) validateNoPendingHolderSnapshot(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		var id uint64
+		found := false
+		rng := collections.NewPrefixedPairRange[string, uint64](coin.Denom)
+		err := k.pendingHolderSnapshots.Walk(ctx, rng, func(key collections.Pair[string, uint64], _ bool) (bool, error) {
+			id, found = key.K2(), true
+			return true, nil
+		})
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		markerAddr := types.MustGetMarkerAddress(coin.Denom)
+		for _, addr := range []sdk.AccAddress{fromAddr, toAddr} {
+			if !addr.Equals(k.markerModuleAddr) && !addr.Equals(markerAddr) {
+				return fmt.Errorf("cannot send %s: holder snapshot %d is still recording the holder balances", coin.Denom, id)
+			}
+		}
+	}
+	return nil
+}
+
+// getLatestHolderSnapshot gets the most recent holder snapshot of a marker. Returns nil if there aren't any.
+func (k Keeper) getLatestHolderSnapshot(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.HolderSnapshot, error) {
+	var rv *types.HolderSnapshot
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](markerAddr).Descending()
+	err := k.holderSnapshotsByMarker.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, uint64], _ bool) (bool, error) {
+		snapshot, err := k.holderSnapshots.Get(ctx, key.K2())
+		if err != nil {
+			return true, fmt.Errorf("could not read holder snapshot %d: %w", key.K2(), err)
+		}
+		rv = &snapshot
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// getNextHolderSnapshotID gets the id to use for the next holder snapshot.
+func (k Keeper) getNextHolderSnapshotID(ctx sdk.Context) (uint64, error) {
+	id, err := k.nextHolderSnapshotID.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 1, nil
+		}
+		return 0, fmt.Errorf("could not read next holder snapshot id: %w", err)
+	}
+	return id, nil
+}
+
+// GetNextHolderSnapshotID gets the id that will be used for the next holder snapshot.
+func (k Keeper) GetNextHolderSnapshotID(ctx sdk.Context) uint64 {
+	id, err := k.getNextHolderSnapshotID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SetNextHolderSnapshotID sets the id that will be used for the next holder snapshot.
+func (k Keeper) SetNextHolderSnapshotID(ctx sdk.Context, id uint64) error {
+	if err := k.nextHolderSnapshotID.Set(ctx, id); err != nil {
+		return fmt.Errorf("failed to set next holder snapshot id: %w", err)
+	}
+	return nil
+}
+
+// SetHolderSnapshot stores the provided holder snapshot along with its cap table entries and indexes it by marker.
+func (k Keeper) SetHolderSnapshot(ctx sdk.Context, snapshot types.HolderSnapshot, entries []types.CapTableEntry) error {
+	if err := snapshot.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(snapshot.Denom)
+	if err != nil {
+		return err
+	}
+	if err = k.holderSnapshots.Set(ctx, snapshot.Id, snapshot); err != nil {
+		return fmt.Errorf("failed to set holder snapshot %d: %w", snapshot.Id, err)
+	}
+	if err = k.holderSnapshotsByMarker.Set(ctx, collections.Join(markerAddr, snapshot.Id), true); err != nil {
+		return fmt.Errorf("failed to index holder snapshot %d by marker: %w", snapshot.Id, err)
+	}
+	pendingKey := collections.Join(snapshot.Denom, snapshot.Id)
+	if snapshot.Pending {
+		if err = k.pendingHolderSnapshots.Set(ctx, pendingKey, true); err != nil {
+			return fmt.Errorf("failed to set pending holder snapshot %d: %w", snapshot.Id, err)
+		}
+	} else if err = k.pendingHolderSnapshots.Remove(ctx, pendingKey); err != nil {
+		return fmt.Errorf("failed to remove pending holder snapshot %d: %w", snapshot.Id, err)
+	}
+	for _, entry := range entries {
+		if err = entry.Validate(); err != nil {
+			return fmt.Errorf("invalid holder snapshot %d entry: %w", snapshot.Id, err)
+		}
+		holder := sdk.MustAccAddressFromBech32(entry.Address)
+		if err = k.holderSnapshotEntries.Set(ctx, collections.Join(snapshot.Id, holder), entry); err != nil {
+			return fmt.Errorf("failed to set holder snapshot %d entry for %s: %w", snapshot.Id, entry.Address, err)
+		}
+	}
+	return nil
+}
+
+// GetHolderSnapshot gets the holder snapshot with the provided id. Returns nil if it doesn't exist.
+func (k Keeper) GetHolderSnapshot(ctx sdk.Context, id uint64) (*types.HolderSnapshot, error) {
+	snapshot, err := k.holderSnapshots.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read holder snapshot %d: %w", id, err)
+	}
+	return &snapshot, nil
+}
+
+// GetHolderSnapshotEntries gets all of the cap table entries of a holder snapshot, ordered by address.
+func (k Keeper) GetHolderSnapshotEntries(ctx sdk.Context, id uint64) ([]types.CapTableEntry, error) {
+	var rv []types.CapTableEntry
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](id)
+	err := k.holderSnapshotEntries.Walk(ctx, rng, func(_ collections.Pair[uint64, sdk.AccAddress], entry types.CapTableEntry) (bool, error) {
+		rv = append(rv, entry)
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read holder snapshot %d entries: %w", id, err)
+	}
+	return rv, nil
+}
+
+// IterateHolderSnapshots iterates over all holder snapshots in order of id.
+func (k Keeper) IterateHolderSnapshots(ctx sdk.Context, cb func(snapshot types.HolderSnapshot) (stop bool)) {
+	err := k.holderSnapshots.Walk(ctx, nil, func(_ uint64, snapshot types.HolderSnapshot) (bool, error) {
+		return cb(snapshot), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ClearHolderSnapshots removes all of a marker's holder snapshots and their cap table entries.
+func (k Keeper) ClearHolderSnapshots(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	var ids []uint64
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](markerAddr)
+	err := k.holderSnapshotsByMarker.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, uint64], _ bool) (bool, error) {
+		ids = append(ids, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to read holder snapshots: %w", err))
+	}
+	for _, id := range ids {
+		snapshot, err := k.holderSnapshots.Get(ctx, id)
+		if err != nil {
+			panic(fmt.Errorf("failed to read holder snapshot %d: %w", id, err))
+		}
+		if err = k.pendingHolderSnapshots.Remove(ctx, collections.Join(snapshot.Denom, id)); err != nil {
+			panic(fmt.Errorf("failed to remove pending holder snapshot %d: %w", id, err))
+		}
+		if err = k.holderSnapshots.Remove(ctx, id); err != nil {
+			panic(fmt.Errorf("failed to remove holder snapshot %d: %w", id, err))
+		}
+		if err = k.holderSnapshotEntries.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](id)); err != nil {
+			panic(fmt.Errorf("failed to remove holder snapshot %d entries: %w", id, err))
+		}
+	}
+	if err = k.holderSnapshotsByMarker.Clear(ctx, rng); err != nil {
+		panic(fmt.Errorf("failed to remove holder snapshot marker index: %w", err))
+	}
+}
@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// capTableFixture is a coin marker with holders that have held, frozen, quarantined and escrowed funds:
+// holder1 has 30 on hold and sent 25 to holder3, which is in quarantine (holder3 also has a balance of 5);
+// holder2 has 20 frozen and 10 escrowed in a pending redemption.
+type capTableFixture struct {
+	*MsgServerTestSuite
+
+	denom      string
+	marker     types.MarkerAccountI
+	markerAddr sdk.AccAddress
+	admin      sdk.AccAddress
+	holder1    sdk.AccAddress
+	holder2    sdk.AccAddress
+	holder3    sdk.AccAddress
+	other      sdk.AccAddress
+}
+
+func (s *MsgServerTestSuite) newCapTableFixture() *capTableFixture {
+	f := &capTableFixture{
+		MsgServerTestSuite: s,
+		denom:              "capcoin",
+		markerAddr:         types.MustGetMarkerAddress("capcoin"),
+		admin:              sdk.AccAddress("cap_admin___________"),
+		holder1:            sdk.AccAddress("cap_holder1_________"),
+		holder2:            sdk.AccAddress("cap_holder2_________"),
+		holder3:            sdk.AccAddress("cap_holder3_________"),
+		other:              sdk.AccAddress("cap_other___________"),
+	}
+	s.blockStartTime = time.Date(2026, 6, 1, 12, 30, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(s.blockStartTime).WithBlockHeight(5)
+
+	marker := newTestMarker(f.denom, types.MarkerType_Coin, 1000,
+		types.AccessGrant{Address: f.admin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Freeze}},
+	)
+	storeTestMarker(s.T(), s.app, s.ctx, marker)
+	f.marker = marker
+
+	fundTestAccount(s.T(), s.app, s.ctx, f.holder1, sdk.NewInt64Coin(f.denom, 100))
+	fundTestAccount(s.T(), s.app, s.ctx, f.holder2, sdk.NewInt64Coin(f.denom, 50))
+	fundTestAccount(s.T(), s.app, s.ctx, f.holder3, sdk.NewInt64Coin(f.denom, 5))
+
+	s.Require().NoError(s.app.HoldKeeper.AddHold(s.ctx, f.holder1, f.coins(30), "test"), "AddHold")
+	s.Require().NoError(s.app.QuarantineKeeper.SetOptIn(s.ctx, f.holder3), "SetOptIn")
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, f.holder1, f.holder3, f.coins(25)), "SendCoins to quarantined holder3")
+
+	s.Require().NoError(s.app.MarkerKeeper.SetHolderFreeze(s.ctx, types.HolderFreeze{
+		Denom:         f.denom,
+		Holder:        f.holder2.String(),
+		Amount:        sdkmath.NewInt(20),
+		ExpiresAt:     s.blockStartTime.Add(24 * time.Hour),
+		Reason:        "court order",
+		Administrator: f.admin.String(),
+	}), "SetHolderFreeze")
+	s.Require().NoError(s.app.MarkerKeeper.SetRedemptionPayoutDenom(s.ctx, f.markerAddr, "usd"), "SetRedemptionPayoutDenom")
+	_, err := s.app.MarkerKeeper.CreateRedemption(s.ctx, marker, f.holder2, sdk.NewInt64Coin(f.denom, 10))
+	s.Require().NoError(err, "CreateRedemption")
+	return f
+}
+
+func (f *capTableFixture) coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(f.denom, amount))
+}
+
+func (f *capTableFixture) entry(holder sdk.AccAddress, balance, spendable, held, frozen, quarantined, escrowed int64) types.CapTableEntry {
+	return types.CapTableEntry{
+		Address:     holder.String(),
+		Total:       sdkmath.NewInt(balance + quarantined + escrowed),
+		Balance:     sdkmath.NewInt(balance),
+		Spendable:   sdkmath.NewInt(spendable),
+		Held:        sdkmath.NewInt(held),
+		Frozen:      sdkmath.NewInt(frozen),
+		Quarantined: sdkmath.NewInt(quarantined),
+		Escrowed:    sdkmath.NewInt(escrowed),
+	}
+}
+
+func (f *capTableFixture) expEntries() []types.CapTableEntry {
+	return []types.CapTableEntry{
+		f.entry(f.holder1, 75, 45, 30, 0, 0, 0),
+		f.entry(f.holder2, 40, 20, 0, 20, 0, 10),
+		f.entry(f.holder3, 5, 5, 0, 0, 25, 0),
+	}
+}
+
+func (s *MsgServerTestSuite) TestCapTable() {
+	f := s.newCapTableFixture()
+
+	// The module account (escrow) and quarantine funds holder are denom owners too, so there are three pages.
+	var holders []types.CapTableEntry
+	pageReq := &query.PageRequest{Limit: 2, CountTotal: true}
+	pages := 0
+	for {
+		resp, err := s.app.MarkerKeeper.CapTable(s.ctx, &types.QueryCapTableRequest{Id: f.denom, Pagination: pageReq})
+		s.Require().NoError(err, "CapTable query page %d", pages+1)
+		if pages == 0 {
+			s.Assert().Equal(uint64(3), resp.HolderCount, "holder count")
+			s.Assert().Equal(sdkmath.NewInt(155), resp.Supply, "supply")
+		}
+		pages++
+		holders = append(holders, resp.Holders...)
+		if len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		s.Require().Less(pages, 5, "number of pages")
+		pageReq = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2}
+	}
+	s.Assert().Equal(3, pages, "number of pages")
+	s.Assert().ElementsMatch(f.expEntries(), holders, "holders from all pages")
+
+	resp, err := s.app.MarkerKeeper.CapTable(s.ctx, &types.QueryCapTableRequest{Id: f.markerAddr.String()})
+	s.Require().NoError(err, "CapTable query by address without pagination")
+	s.Assert().ElementsMatch(f.expEntries(), resp.Holders, "holders without pagination")
+	s.Assert().Zero(resp.HolderCount, "holder count without count total")
+
+	_, err = s.app.MarkerKeeper.CapTable(s.ctx, &types.QueryCapTableRequest{Id: f.denom, Pagination: &query.PageRequest{Key: []byte("x"), Offset: 1}})
+	s.Assert().ErrorContains(err, "either offset or key is expected, got both", "CapTable query with key and offset")
+}
+
+func (s *MsgServerTestSuite) TestTakeHolderSnapshot() {
+	f := s.newCapTableFixture()
+	_, err := s.msgServer.TakeHolderSnapshot(s.ctx, types.NewMsgTakeHolderSnapshotRequest(f.denom, f.other))
+	s.Require().ErrorContains(err, "does not have ACCESS_ADMIN or ACCESS_TRANSFER on capcoin marker", "TakeHolderSnapshot without access")
+
+	resp, err := s.msgServer.TakeHolderSnapshot(s.ctx, types.NewMsgTakeHolderSnapshotRequest(f.denom, f.admin))
+	s.Require().NoError(err, "TakeHolderSnapshot")
+	s.Require().Equal(uint64(1), resp.SnapshotId, "snapshot id")
+	snapshot, err := s.app.MarkerKeeper.GetHolderSnapshot(s.ctx, 1)
+	s.Require().NoError(err, "GetHolderSnapshot")
+	s.Assert().True(snapshot.Pending, "pending after TakeHolderSnapshot")
+	s.Assert().Zero(snapshot.HolderCount, "holder count after TakeHolderSnapshot")
+
+	_, err = s.msgServer.TakeHolderSnapshot(s.ctx, types.NewMsgTakeHolderSnapshotRequest(f.denom, f.admin))
+	s.Assert().ErrorContains(err, "holder snapshot 1 of capcoin was already taken at height 5", "second snapshot in the same block")
+	_, err = s.msgServer.TakeHolderSnapshot(s.ctx.WithBlockHeight(6), types.NewMsgTakeHolderSnapshotRequest(f.denom, f.admin))
+	s.Assert().ErrorContains(err, "holder snapshot 1 of capcoin is still being recorded", "second snapshot while the first is pending")
+	err = s.app.BankKeeper.SendCoins(s.ctx, f.holder1, f.other, f.coins(5))
+	s.Assert().EqualError(err, "cannot send capcoin: holder snapshot 1 is still recording the holder balances", "SendCoins while snapshot is pending")
+
+	s.app.MarkerKeeper.ProcessHolderSnapshots(s.ctx, 2)
+	snapshot, err = s.app.MarkerKeeper.GetHolderSnapshot(s.ctx, 1)
+	s.Require().NoError(err, "GetHolderSnapshot after first block")
+	s.Assert().True(snapshot.Pending, "pending after first block")
+	s.Assert().NotEmpty(snapshot.NextKey, "next key after first block")
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.app.MarkerKeeper.ProcessHolderSnapshots(ctx, types.MaxHolderSnapshotHoldersPerBlock)
+	expEvent, err := sdk.TypedEventToEvent(&types.EventMarkerHolderSnapshotTaken{
+		SnapshotId:    "1",
+		Denom:         f.denom,
+		Height:        "5",
+		HolderCount:   "3",
+		Administrator: f.admin.String(),
+	})
+	s.Require().NoError(err, "TypedEventToEvent")
+	s.Assert().Contains(ctx.EventManager().Events(), expEvent, "emitted events")
+
+	// Later changes to the balances do not affect the snapshot.
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, f.holder1, f.other, f.coins(5)), "SendCoins to other")
+	resp, err = s.msgServer.TakeHolderSnapshot(s.ctx.WithBlockHeight(6), types.NewMsgTakeHolderSnapshotRequest(f.denom, f.admin))
+	s.Require().NoError(err, "TakeHolderSnapshot in the next block")
+	s.Require().Equal(uint64(2), resp.SnapshotId, "next snapshot id")
+	s.app.MarkerKeeper.ProcessHolderSnapshots(s.ctx, types.MaxHolderSnapshotHoldersPerBlock)
+
+	snapshotsResp, err := s.app.MarkerKeeper.HolderSnapshots(s.ctx, &types.QueryHolderSnapshotsRequest{Id: f.denom})
+	s.Require().NoError(err, "HolderSnapshots query")
+	s.Require().Len(snapshotsResp.Snapshots, 2, "snapshots")
+	s.Assert().Equal(int64(5), snapshotsResp.Snapshots[0].Height, "first snapshot height")
+	s.Assert().Equal(uint64(3), snapshotsResp.Snapshots[0].HolderCount, "first snapshot holder count")
+	s.Assert().False(snapshotsResp.Snapshots[0].Pending, "first snapshot pending")
+	s.Assert().Empty(snapshotsResp.Snapshots[0].NextKey, "first snapshot next key")
+	s.Assert().Equal(uint64(4), snapshotsResp.Snapshots[1].HolderCount, "second snapshot holder count")
+
+	snapshotResp, err := s.app.MarkerKeeper.HolderSnapshot(s.ctx, &types.QueryHolderSnapshotRequest{SnapshotId: 1})
+	s.Require().NoError(err, "HolderSnapshot query")
+	s.Assert().Equal(f.admin.String(), snapshotResp.Snapshot.TakenBy, "taken by")
+	s.Assert().ElementsMatch(f.expEntries(), snapshotResp.Holders, "first snapshot holders")
+
+	genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	s.Assert().Len(genState.HolderSnapshots, 2, "exported holder snapshots")
+	s.Assert().Equal(uint64(3), genState.NextHolderSnapshotId, "exported next holder snapshot id")
+
+	s.app.MarkerKeeper.ClearHolderSnapshots(s.ctx, f.markerAddr)
+	_, err = s.app.MarkerKeeper.HolderSnapshot(s.ctx, &types.QueryHolderSnapshotRequest{SnapshotId: 1})
+	s.Assert().ErrorContains(err, "holder snapshot 1 not found", "HolderSnapshot query after clearing")
+	entries, err := s.app.MarkerKeeper.GetHolderSnapshotEntries(s.ctx, 2)
+	s.Require().NoError(err, "GetHolderSnapshotEntries after clearing")
+	s.Assert().Empty(entries, "entries after clearing")
+}
@@ -0,0 +1,508 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/quarantine"
+)
+
+// CreateDistribution takes the payout from the administrator and starts a pro-rata distribution of it to the
+// holders of a marker's coin. The marker module account and the marker's own account (escrow) are excluded.
+// The holder balances are recorded over the following blocks (see ProcessDistributionSnapshots), and the payments
+// are made once they have all been recorded (see ProcessDistributionPayments).
+func (k Keeper) CreateDistribution(ctx sdk.Context, marker types.MarkerAccountI, admin sdk.AccAddress, payout sdk.Coin) (uint64, error) {
+	denom := marker.GetDenom()
+	eligibleSupply := k.bankKeeper.GetSupply(ctx, denom).Amount.
+		Sub(k.bankKeeper.GetBalance(ctx, k.markerModuleAddr, denom).Amount).
+		Sub(k.bankKeeper.GetBalance(ctx, marker.GetAddress(), denom).Amount)
+	if !eligibleSupply.IsPositive() {
+		return 0, fmt.Errorf("marker %s does not have any eligible holders", denom)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, admin, types.CoinPoolName, sdk.NewCoins(payout)); err != nil {
+		return 0, fmt.Errorf("could not collect payout from %s: %w", admin, err)
+	}
+
+	id, err := k.getNextDistributionID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err = k.nextDistributionID.Set(ctx, id+1); err != nil {
+		return 0, fmt.Errorf("failed to set next distribution id: %w", err)
+	}
+
+	dist := types.Distribution{
+		Id:              id,
+		Denom:           denom,
+		Payout:          payout,
+		Allocated:       sdk.NewCoin(payout.Denom, sdkmath.ZeroInt()),
+		Administrator:   admin.String(),
+		SnapshotHeight:  ctx.BlockHeight(),
+		EligibleSupply:  eligibleSupply,
+		SnapshotPending: true,
+	}
+	if err = k.SetDistribution(ctx, dist); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// ProcessDistributionSnapshots records up to the provided number of holder balances for the distributions
+// whose snapshots are still pending. Each distribution continues from where it left off in the previous block.
+// Once all of a distribution's holders have been recorded, the rest of the payout (i.e. the rounding remainder)
+// is returned to the administrator and its payments can be made.
+func (k Keeper) ProcessDistributionSnapshots(ctx sdk.Context, limit int) {
+	var ids []uint64
+	err := k.pendingDistributionSnapshots.Walk(ctx, nil, func(key collections.Pair[string, uint64], _ bool) (bool, error) {
+		ids = append(ids, key.K2())
+		return len(ids) >= limit, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, id := range ids {
+		if limit <= 0 {
+			break
+		}
+		dist, err := k.GetDistribution(ctx, id)
+		if err != nil || dist == nil {
+			panic(fmt.Errorf("could not get distribution %d: %w", id, err))
+		}
+		limit -= k.continueDistributionSnapshot(ctx, dist, limit)
+	}
+}
+
+// continueDistributionSnapshot records up to limit holder balances for a distribution, and
+// returns the number of holders that were looked at.
+func (k Keeper) continueDistributionSnapshot(ctx sdk.Context, dist *types.Distribution, limit int) int {
+	req := &banktypes.QueryDenomOwnersRequest{
+		Denom:      dist.Denom,
+		Pagination: &query.PageRequest{Key: dist.SnapshotNextKey, Limit: uint64(limit)},
+	}
+	resp, err := k.bankKeeper.DenomOwners(ctx, req)
+	if err != nil {
+		ctx.Logger().Error("could not get distribution holders, will try again next block", "distribution_id", dist.Id, "error", err)
+		return 0
+	}
+
+	markerAddr := types.MustGetMarkerAddress(dist.Denom)
+	for _, owner := range resp.DenomOwners {
+		addr, err := sdk.AccAddressFromBech32(owner.Address)
+		if err != nil {
+			panic(fmt.Errorf("invalid holder address %q: %w", owner.Address, err))
+		}
+		if err = k.recordDistributionHolder(ctx, dist, markerAddr, addr, owner.Balance.Amount); err != nil {
+			panic(err)
+		}
+	}
+
+	dist.SnapshotNextKey = nil
+	if resp.Pagination != nil {
+		dist.SnapshotNextKey = resp.Pagination.NextKey
+	}
+	if len(dist.SnapshotNextKey) == 0 {
+		k.finishDistributionSnapshot(ctx, dist)
+	}
+	if err = k.SetDistribution(ctx, *dist); err != nil {
+		panic(err)
+	}
+	return len(resp.DenomOwners)
+}
+
+// recordDistributionHolder records the amount of a distribution owed to a holder with the provided balance.
+// It does nothing if the holder has already been recorded or is not eligible. A holder that is not owed anything
+// is still recorded (with a zero amount).
+func (k Keeper) recordDistributionHolder(ctx sdk.Context, dist *types.Distribution, markerAddr, holder sdk.AccAddress, balance sdkmath.Int) error {
+	if holder.Equals(k.markerModuleAddr) || holder.Equals(markerAddr) {
+		return nil
+	}
+	key := collections.Join(dist.Id, holder)
+	has, err := k.distributionPayments.Has(ctx, key)
+	if err != nil {
+		return fmt.Errorf("could not read distribution payment: %w", err)
+	}
+	if has {
+		return nil
+	}
+
+	share := sdkmath.ZeroInt()
+	if balance.IsPositive() {
+		share = dist.Payout.Amount.Mul(balance).Quo(dist.EligibleSupply)
+		share = sdkmath.MinInt(share, dist.Payout.Amount.Sub(dist.Allocated.Amount))
+	}
+	if err = k.distributionPayments.Set(ctx, key, share); err != nil {
+		return fmt.Errorf("failed to set distribution payment: %w", err)
+	}
+	if share.IsPositive() {
+		dist.Allocated = dist.Allocated.AddAmount(share)
+		dist.HolderCount++
+	}
+	return nil
+}
+
+// finishDistributionSnapshot marks the snapshot of a distribution as done and gives the part of
+// the payout that wasn't allocated back to the administrator (or records it as their claim).
+func (k Keeper) finishDistributionSnapshot(ctx sdk.Context, dist *types.Distribution) {
+	dist.SnapshotPending = false
+	dist.SnapshotNextKey = nil
+
+	if remainder := dist.Payout.Sub(dist.Allocated); remainder.IsPositive() {
+		payment := types.DistributionPayment{
+			DistributionId: dist.Id,
+			Holder:         dist.Administrator,
+			Amount:         remainder,
+		}
+		k.processDistributionPayment(ctx, sdk.MustAccAddressFromBech32(dist.Administrator), payment)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDistribution(*dist)); err != nil {
+		ctx.Logger().Error("failed to emit marker distribution event", "distribution_id", dist.Id, "error", err)
+	}
+	if dist.IsComplete() {
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDistributionCompleted(dist.Id, dist.Denom)); err != nil {
+			ctx.Logger().Error("failed to emit marker distribution completed event", "distribution_id", dist.Id, "error", err)
+		}
+	}
+}
+
+// validateNoPendingDistributionSnapshot returns an error if coins are being sent to or from an eligible holder
+// while a distribution of that coin is still recording holder balances. Blocking those sends keeps each holder's
+// balance the same as it was when the distribution was created until it has been recorded.
+func (k Keeper) validateNoPendingDistributionSnapshot(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		var id uint64
+		found := false
+		rng := collections.NewPrefixedPairRange[string, uint64](coin.Denom)
+		err := k.pendingDistributionSnapshots.Walk(ctx, rng, func(key collections.Pair[string, uint64], _ bool) (bool, error) {
+			id, found = key.K2(), true
+			return true, nil
+		})
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		markerAddr := types.MustGetMarkerAddress(coin.Denom)
+		for _, addr := range []sdk.AccAddress{fromAddr, toAddr} {
+			if !addr.Equals(k.markerModuleAddr) && !addr.Equals(markerAddr) {
+				return fmt.Errorf("cannot send %s: distribution %d is still recording the holder balances", coin.Denom, id)
+			}
+		}
+	}
+	return nil
+}
+
+// ProcessDistributionPayments makes up to the provided number of queued distribution payments.
+// A payment to a holder that is sanctioned or quarantined, or that cannot be sent, is instead
+// recorded as a claim that the holder can later claim using ClaimDistribution.
+// Payments of a distribution are not made until all of its holders have been recorded.
+func (k Keeper) ProcessDistributionPayments(ctx sdk.Context, limit int) {
+	var keys []collections.Pair[uint64, sdk.AccAddress]
+	var amounts []sdkmath.Int
+	dists := make(map[uint64]*types.Distribution)
+	var distIDs []uint64
+	var rng collections.Ranger[collections.Pair[uint64, sdk.AccAddress]]
+	for len(keys) < limit {
+		// pendingID is the id of a distribution that is still recording holders, whose payments are skipped.
+		pendingID := uint64(0)
+		err := k.distributionPayments.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], amount sdkmath.Int) (bool, error) {
+			dist, ok := dists[key.K1()]
+			if !ok {
+				var err error
+				dist, err = k.GetDistribution(ctx, key.K1())
+				if err != nil || dist == nil {
+					return true, fmt.Errorf("could not get distribution %d: %w", key.K1(), err)
+				}
+				dists[key.K1()] = dist
+				distIDs = append(distIDs, key.K1())
+			}
+			if dist.SnapshotPending {
+				pendingID = key.K1()
+				return true, nil
+			}
+			keys = append(keys, key)
+			amounts = append(amounts, amount)
+			return len(keys) >= limit, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+		if pendingID == 0 {
+			break
+		}
+		rng = new(collections.Range[collections.Pair[uint64, sdk.AccAddress]]).
+			StartInclusive(collections.PairPrefix[uint64, sdk.AccAddress](pendingID + 1))
+	}
+
+	processed := make(map[uint64]bool)
+	for i, key := range keys {
+		dist := dists[key.K1()]
+		if amounts[i].IsPositive() {
+			payment := types.DistributionPayment{
+				DistributionId: key.K1(),
+				Holder:         key.K2().String(),
+				Amount:         sdk.NewCoin(dist.Payout.Denom, amounts[i]),
+			}
+			k.processDistributionPayment(ctx, key.K2(), payment)
+			dist.ProcessedCount++
+			processed[dist.Id] = true
+		}
+		if err := k.distributionPayments.Remove(ctx, key); err != nil {
+			panic(fmt.Errorf("failed to remove distribution payment: %w", err))
+		}
+	}
+
+	for _, id := range distIDs {
+		if !processed[id] {
+			continue
+		}
+		dist := dists[id]
+		if err := k.SetDistribution(ctx, *dist); err != nil {
+			panic(err)
+		}
+		if dist.IsComplete() {
+			if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDistributionCompleted(dist.Id, dist.Denom)); err != nil {
+				ctx.Logger().Error("failed to emit marker distribution completed event", "distribution_id", dist.Id, "error", err)
+			}
+		}
+	}
+}
+
+// processDistributionPayment sends a single distribution payment to its holder, or records it as a claim.
+func (k Keeper) processDistributionPayment(ctx sdk.Context, holder sdk.AccAddress, payment types.DistributionPayment) {
+	if !k.mustClaimDistribution(ctx, holder) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.CoinPoolName, holder, sdk.NewCoins(payment.Amount))
+		if err == nil {
+			writeCache()
+			return
+		}
+		ctx.Logger().Error("could not send distribution payment, it must be claimed instead",
+			"distribution_id", payment.DistributionId, "holder", payment.Holder, "amount", payment.Amount.String(), "error", err)
+	}
+
+	// The administrator of a distribution might also be one of its holders, so add to any existing claim.
+	key := collections.Join(holder, payment.DistributionId)
+	amount, err := k.distributionClaims.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		amount = payment.Amount.Amount
+	case err != nil:
+		panic(fmt.Errorf("could not read distribution claim: %w", err))
+	default:
+		amount = amount.Add(payment.Amount.Amount)
+	}
+	if err = k.distributionClaims.Set(ctx, key, amount); err != nil {
+		panic(fmt.Errorf("failed to set distribution claim: %w", err))
+	}
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDistributionClaimable(payment)); err != nil {
+		ctx.Logger().Error("failed to emit marker distribution claimable event", "distribution_id", payment.DistributionId, "error", err)
+	}
+}
+
+// mustClaimDistribution returns true if distribution payments should not be sent directly to the provided holder.
+// That is the case when the holder is sanctioned or has opted into quarantine.
+func (k Keeper) mustClaimDistribution(ctx sdk.Context, holder sdk.AccAddress) bool {
+	if k.sanctionKeeper != nil && k.sanctionKeeper.IsSanctionedAddr(ctx, holder) {
+		return true
+	}
+	return k.quarantineKeeper != nil && k.quarantineKeeper.IsQuarantinedAddr(ctx, holder)
+}
+
+// ClaimDistribution sends a holder the distribution payment that they are waiting to claim.
+// Sanctioned holders cannot claim their payments. Since the holder is requesting the funds,
+// the payment is not quarantined.
+func (k Keeper) ClaimDistribution(ctx sdk.Context, holder sdk.AccAddress, distributionID uint64) (*types.DistributionPayment, error) {
+	key := collections.Join(holder, distributionID)
+	amount, err := k.distributionClaims.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("no claim of distribution %d found for %s", distributionID, holder)
+		}
+		return nil, fmt.Errorf("could not read claim of distribution %d for %s: %w", distributionID, holder, err)
+	}
+	if k.sanctionKeeper != nil && k.sanctionKeeper.IsSanctionedAddr(ctx, holder) {
+		return nil, fmt.Errorf("%s cannot claim distribution %d while sanctioned", holder, distributionID)
+	}
+
+	dist, err := k.GetDistribution(ctx, distributionID)
+	if err != nil {
+		return nil, err
+	}
+	if dist == nil {
+		return nil, fmt.Errorf("distribution %d not found", distributionID)
+	}
+
+	payment := &types.DistributionPayment{
+		DistributionId: distributionID,
+		Holder:         holder.String(),
+		Amount:         sdk.NewCoin(dist.Payout.Denom, amount),
+	}
+	if err = k.distributionClaims.Remove(ctx, key); err != nil {
+		return nil, fmt.Errorf("failed to remove distribution claim: %w", err)
+	}
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(quarantine.WithBypass(ctx), types.CoinPoolName, holder, sdk.NewCoins(payment.Amount)); err != nil {
+		return nil, fmt.Errorf("could not send distribution %d payment to %s: %w", distributionID, holder, err)
+	}
+
+	return payment, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDistributionClaimed(*payment))
+}
+
+// getNextDistributionID gets the id to use for the next distribution.
+func (k Keeper) getNextDistributionID(ctx sdk.Context) (uint64, error) {
+	id, err := k.nextDistributionID.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 1, nil
+		}
+		return 0, fmt.Errorf("could not read next distribution id: %w", err)
+	}
+	return id, nil
+}
+
+// GetNextDistributionID gets the id that will be used for the next distribution.
+func (k Keeper) GetNextDistributionID(ctx sdk.Context) uint64 {
+	id, err := k.getNextDistributionID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SetNextDistributionID sets the id that will be used for the next distribution.
+func (k Keeper) SetNextDistributionID(ctx sdk.Context, id uint64) error {
+	if err := k.nextDistributionID.Set(ctx, id); err != nil {
+		return fmt.Errorf("failed to set next distribution id: %w", err)
+	}
+	return nil
+}
+
+// SetDistribution stores the provided distribution.
+func (k Keeper) SetDistribution(ctx sdk.Context, dist types.Distribution) error {
+	if err := dist.Validate(); err != nil {
+		return err
+	}
+	if err := k.distributions.Set(ctx, dist.Id, dist); err != nil {
+		return fmt.Errorf("failed to set distribution %d: %w", dist.Id, err)
+	}
+	pendingKey := collections.Join(dist.Denom, dist.Id)
+	if dist.SnapshotPending {
+		if err := k.pendingDistributionSnapshots.Set(ctx, pendingKey, true); err != nil {
+			return fmt.Errorf("failed to set pending distribution snapshot %d: %w", dist.Id, err)
+		}
+	} else if err := k.pendingDistributionSnapshots.Remove(ctx, pendingKey); err != nil {
+		return fmt.Errorf("failed to remove pending distribution snapshot %d: %w", dist.Id, err)
+	}
+	return nil
+}
+
+// GetDistribution gets the distribution with the provided id. Returns nil if it doesn't exist.
+func (k Keeper) GetDistribution(ctx sdk.Context, id uint64) (*types.Distribution, error) {
+	dist, err := k.distributions.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read distribution %d: %w", id, err)
+	}
+	return &dist, nil
+}
+
+// IterateDistributions iterates over all distributions in order of id.
+func (k Keeper) IterateDistributions(ctx sdk.Context, cb func(dist types.Distribution) (stop bool)) {
+	err := k.distributions.Walk(ctx, nil, func(_ uint64, dist types.Distribution) (bool, error) {
+		return cb(dist), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// SetDistributionPayment stores a distribution payment that has not been processed yet.
+func (k Keeper) SetDistributionPayment(ctx sdk.Context, payment types.DistributionPayment) error {
+	if err := payment.Validate(); err != nil {
+		return err
+	}
+	key := collections.Join(payment.DistributionId, sdk.MustAccAddressFromBech32(payment.Holder))
+	if err := k.distributionPayments.Set(ctx, key, payment.Amount.Amount); err != nil {
+		return fmt.Errorf("failed to set distribution payment: %w", err)
+	}
+	return nil
+}
+
+// SetDistributionClaim stores a distribution payment that is waiting to be claimed by its holder.
+func (k Keeper) SetDistributionClaim(ctx sdk.Context, claim types.DistributionPayment) error {
+	if err := claim.Validate(); err != nil {
+		return err
+	}
+	key := collections.Join(sdk.MustAccAddressFromBech32(claim.Holder), claim.DistributionId)
+	if err := k.distributionClaims.Set(ctx, key, claim.Amount.Amount); err != nil {
+		return fmt.Errorf("failed to set distribution claim: %w", err)
+	}
+	return nil
+}
+
+// GetAllDistributionPayments gets all of the distribution payments that have not been processed yet.
+func (k Keeper) GetAllDistributionPayments(ctx sdk.Context) []types.DistributionPayment {
+	var rv []types.DistributionPayment
+	denoms := make(map[uint64]string)
+	err := k.distributionPayments.Walk(ctx, nil, func(key collections.Pair[uint64, sdk.AccAddress], amount sdkmath.Int) (bool, error) {
+		denom, err := k.getDistributionPayoutDenom(ctx, key.K1(), denoms)
+		if err != nil {
+			return true, err
+		}
+		rv = append(rv, types.DistributionPayment{DistributionId: key.K1(), Holder: key.K2().String(), Amount: sdk.NewCoin(denom, amount)})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// GetAllDistributionClaims gets all of the distribution payments that are waiting to be claimed.
+func (k Keeper) GetAllDistributionClaims(ctx sdk.Context) []types.DistributionPayment {
+	var rv []types.DistributionPayment
+	denoms := make(map[uint64]string)
+	err := k.distributionClaims.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, uint64], amount sdkmath.Int) (bool, error) {
+		denom, err := k.getDistributionPayoutDenom(ctx, key.K2(), denoms)
+		if err != nil {
+			return true, err
+		}
+		rv = append(rv, types.DistributionPayment{DistributionId: key.K2(), Holder: key.K1().String(), Amount: sdk.NewCoin(denom, amount)})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// getDistributionPayoutDenom gets the payout denom of a distribution, using (and updating) the provided cache.
+func (k Keeper) getDistributionPayoutDenom(ctx sdk.Context, id uint64, cache map[uint64]string) (string, error) {
+	if denom, ok := cache[id]; ok {
+		return denom, nil
+	}
+	dist, err := k.GetDistribution(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if dist == nil {
+		return "", fmt.Errorf("distribution %d not found", id)
+	}
+	cache[id] = dist.Payout.Denom
+	return dist.Payout.Denom, nil
+}
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// distributionFixture is a coin marker held by three accounts and the marker itself, with an admin that can pay out distributions.
+type distributionFixture struct {
+	*MsgServerTestSuite
+
+	denom      string
+	payDenom   string
+	markerAddr sdk.AccAddress
+	admin      sdk.AccAddress
+	holder1    sdk.AccAddress
+	holder2    sdk.AccAddress
+	holder3    sdk.AccAddress
+}
+
+func (s *MsgServerTestSuite) newDistributionFixture() *distributionFixture {
+	f := &distributionFixture{
+		MsgServerTestSuite: s,
+		denom:              "fundcoin",
+		payDenom:           "usdpay",
+		markerAddr:         types.MustGetMarkerAddress("fundcoin"),
+		admin:              sdk.AccAddress("dist_admin__________"),
+		holder1:            sdk.AccAddress("dist_holder1________"),
+		holder2:            sdk.AccAddress("dist_holder2________"),
+		holder3:            sdk.AccAddress("dist_holder3________"),
+	}
+	s.blockStartTime = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(s.blockStartTime).WithBlockHeight(10)
+
+	marker := newTestMarker(f.denom, types.MarkerType_Coin, 1050,
+		types.AccessGrant{Address: f.admin.String(), Permissions: types.AccessList{types.Access_Mint, types.Access_Admin}},
+	)
+	marker.AllowGovernanceControl = true
+	storeTestMarker(s.T(), s.app, s.ctx, marker)
+
+	fundTestAccount(s.T(), s.app, s.ctx, f.holder1, sdk.NewInt64Coin(f.denom, 100))
+	fundTestAccount(s.T(), s.app, s.ctx, f.holder2, sdk.NewInt64Coin(f.denom, 200))
+	fundTestAccount(s.T(), s.app, s.ctx, f.holder3, sdk.NewInt64Coin(f.denom, 700))
+	fundTestAccount(s.T(), s.app, s.ctx, f.markerAddr, sdk.NewInt64Coin(f.denom, 50))
+	fundTestAccount(s.T(), s.app, s.ctx, f.admin, sdk.NewInt64Coin(f.payDenom, 1000))
+	return f
+}
+
+func (f *distributionFixture) payBalance(addr sdk.AccAddress) int64 {
+	return f.app.BankKeeper.GetBalance(f.ctx, addr, f.payDenom).Amount.Int64()
+}
+
+func (f *distributionFixture) distribute(payout int64) uint64 {
+	msg := types.NewMsgDistributeToHoldersRequest(f.denom, f.admin, sdk.NewInt64Coin(f.payDenom, payout))
+	resp, err := f.msgServer.DistributeToHolders(f.ctx, msg)
+	f.Require().NoError(err, "DistributeToHolders")
+	return resp.DistributionId
+}
+
+func (s *MsgServerTestSuite) TestDistributeToHolders() {
+	f := s.newDistributionFixture()
+	s.Run("not an admin", func() {
+		msg := types.NewMsgDistributeToHoldersRequest(f.denom, f.holder1, sdk.NewInt64Coin(f.payDenom, 10))
+		_, err := s.msgServer.DistributeToHolders(s.ctx, msg)
+		s.Require().ErrorContains(err, "does not have ACCESS_ADMIN")
+	})
+
+	id := f.distribute(999)
+	s.Assert().Equal(uint64(1), id, "distribution id")
+	s.Assert().Equal(uint64(2), s.app.MarkerKeeper.GetNextDistributionID(s.ctx), "GetNextDistributionID")
+	s.Assert().Equal(int64(1), f.payBalance(f.admin), "admin balance after distribution")
+
+	dist, err := s.app.MarkerKeeper.GetDistribution(s.ctx, id)
+	s.Require().NoError(err, "GetDistribution")
+	s.Require().NotNil(dist, "GetDistribution")
+	s.Assert().True(dist.SnapshotPending, "snapshot pending")
+	s.Assert().Equal("1000", dist.EligibleSupply.String(), "eligible supply (excludes marker escrow)")
+	s.Assert().Equal(int64(10), dist.SnapshotHeight, "snapshot height")
+
+	// Record part of the holders. The coin can't be moved to or from holders until the rest have been recorded.
+	s.app.MarkerKeeper.ProcessDistributionSnapshots(s.ctx, 2)
+	dist, err = s.app.MarkerKeeper.GetDistribution(s.ctx, id)
+	s.Require().NoError(err, "GetDistribution after first snapshot batch")
+	s.Assert().True(dist.SnapshotPending, "snapshot pending after first snapshot batch")
+	s.Assert().NotEmpty(dist.SnapshotNextKey, "snapshot next key after first snapshot batch")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(f.denom, 300))
+	err = s.app.BankKeeper.SendCoins(s.ctx, f.holder3, f.holder1, coins)
+	s.Assert().EqualError(err, "cannot send fundcoin: distribution 1 is still recording the holder balances", "SendCoins while snapshot is pending")
+	err = s.app.BankKeeper.SendCoins(types.WithBypass(s.ctx), f.holder3, f.holder1, coins)
+	s.Assert().EqualError(err, "cannot send fundcoin: distribution 1 is still recording the holder balances", "SendCoins with bypass while snapshot is pending")
+
+	s.app.MarkerKeeper.ProcessDistributionPayments(s.ctx, types.MaxDistributionPaymentsPerBlock)
+	s.Assert().Equal(int64(0), f.payBalance(f.holder1), "holder1 balance while snapshot is pending")
+
+	s.app.MarkerKeeper.ProcessDistributionSnapshots(s.ctx, types.MaxDistributionSnapshotHoldersPerBlock)
+	// Once the snapshot is done, later transfers don't change what's owed.
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, f.holder3, f.holder1, coins), "SendCoins after snapshot")
+
+	// 999 * 100/1000 = 99, 999 * 200/1000 = 199, 999 * 700/1000 = 699; the remainder of 2 is returned to the admin.
+	s.Assert().Equal(int64(3), f.payBalance(f.admin), "admin balance after snapshot")
+	dist, err = s.app.MarkerKeeper.GetDistribution(s.ctx, id)
+	s.Require().NoError(err, "GetDistribution after snapshot")
+	s.Assert().False(dist.SnapshotPending, "snapshot pending after snapshot")
+	s.Assert().Empty(dist.SnapshotNextKey, "snapshot next key after snapshot")
+	s.Assert().Equal(sdk.NewInt64Coin(f.payDenom, 997).String(), dist.Allocated.String(), "allocated")
+	s.Assert().Equal(uint64(3), dist.HolderCount, "holder count")
+	s.Assert().Len(s.app.MarkerKeeper.GetAllDistributionPayments(s.ctx), 3, "pending payments")
+
+	s.app.MarkerKeeper.ProcessDistributionPayments(s.ctx, 2)
+	s.Assert().Len(s.app.MarkerKeeper.GetAllDistributionPayments(s.ctx), 1, "pending payments after first batch")
+	dist, err = s.app.MarkerKeeper.GetDistribution(s.ctx, id)
+	s.Require().NoError(err, "GetDistribution after first batch")
+	s.Assert().False(dist.IsComplete(), "IsComplete after first batch")
+
+	s.app.MarkerKeeper.ProcessDistributionPayments(s.ctx, types.MaxDistributionPaymentsPerBlock)
+	s.Assert().Empty(s.app.MarkerKeeper.GetAllDistributionPayments(s.ctx), "pending payments after second batch")
+	dist, err = s.app.MarkerKeeper.GetDistribution(s.ctx, id)
+	s.Require().NoError(err, "GetDistribution after second batch")
+	s.Assert().True(dist.IsComplete(), "IsComplete after second batch")
+
+	s.Assert().Equal(int64(99), f.payBalance(f.holder1), "holder1 balance")
+	s.Assert().Equal(int64(199), f.payBalance(f.holder2), "holder2 balance")
+	s.Assert().Equal(int64(699), f.payBalance(f.holder3), "holder3 balance")
+	s.Assert().Equal(int64(0), f.payBalance(f.markerAddr), "marker escrow balance")
+	s.Assert().Empty(s.app.MarkerKeeper.GetAllDistributionClaims(s.ctx), "claims")
+}
+
+func (s *MsgServerTestSuite) TestDistributeToHoldersPayoutTooSmall() {
+	f := s.newDistributionFixture()
+	id := f.distribute(1)
+	s.app.MarkerKeeper.ProcessDistributionSnapshots(s.ctx, types.MaxDistributionSnapshotHoldersPerBlock)
+
+	dist, err := s.app.MarkerKeeper.GetDistribution(s.ctx, id)
+	s.Require().NoError(err, "GetDistribution")
+	s.Require().NotNil(dist, "GetDistribution")
+	s.Assert().True(dist.Allocated.IsZero(), "allocated")
+	s.Assert().Zero(dist.HolderCount, "holder count")
+	s.Assert().True(dist.IsComplete(), "IsComplete")
+	s.Assert().Equal(int64(1000), f.payBalance(f.admin), "admin balance")
+
+	s.app.MarkerKeeper.ProcessDistributionPayments(s.ctx, types.MaxDistributionPaymentsPerBlock)
+	s.Assert().Empty(s.app.MarkerKeeper.GetAllDistributionPayments(s.ctx), "pending payments")
+	s.Assert().Empty(s.app.MarkerKeeper.GetAllDistributionClaims(s.ctx), "claims")
+}
+
+func (s *MsgServerTestSuite) TestClaimDistribution() {
+	f := s.newDistributionFixture()
+	s.Require().NoError(s.app.SanctionKeeper.SanctionAddresses(s.ctx, f.holder1), "SanctionAddresses")
+	s.Require().NoError(s.app.QuarantineKeeper.SetOptIn(s.ctx, f.holder2), "SetOptIn")
+
+	id := f.distribute(1000)
+	s.app.MarkerKeeper.ProcessDistributionSnapshots(s.ctx, types.MaxDistributionSnapshotHoldersPerBlock)
+	s.app.MarkerKeeper.ProcessDistributionPayments(s.ctx, types.MaxDistributionPaymentsPerBlock)
+
+	s.Assert().Equal(int64(0), f.payBalance(f.holder1), "sanctioned holder balance")
+	s.Assert().Equal(int64(0), f.payBalance(f.holder2), "quarantined holder balance")
+	s.Assert().Equal(int64(700), f.payBalance(f.holder3), "other holder balance")
+
+	expClaims := []types.DistributionPayment{
+		{DistributionId: id, Holder: f.holder1.String(), Amount: sdk.NewInt64Coin(f.payDenom, 100)},
+		{DistributionId: id, Holder: f.holder2.String(), Amount: sdk.NewInt64Coin(f.payDenom, 200)},
+	}
+	s.Assert().ElementsMatch(expClaims, s.app.MarkerKeeper.GetAllDistributionClaims(s.ctx), "claims")
+
+	claimsResp, err := s.app.MarkerKeeper.DistributionClaims(s.ctx, &types.QueryDistributionClaimsRequest{Holder: f.holder2.String()})
+	s.Require().NoError(err, "DistributionClaims")
+	s.Assert().Equal(expClaims[1:], claimsResp.Claims, "DistributionClaims holder2")
+
+	_, err = s.msgServer.ClaimDistribution(s.ctx, types.NewMsgClaimDistributionRequest(f.holder1, id))
+	s.Require().ErrorContains(err, "cannot claim distribution 1 while sanctioned")
+
+	_, err = s.msgServer.ClaimDistribution(s.ctx, types.NewMsgClaimDistributionRequest(f.holder3, id))
+	s.Require().ErrorContains(err, "no claim of distribution 1 found")
+
+	_, err = s.msgServer.ClaimDistribution(s.ctx, types.NewMsgClaimDistributionRequest(f.holder2, id))
+	s.Require().NoError(err, "ClaimDistribution holder2")
+	s.Assert().Equal(int64(200), f.payBalance(f.holder2), "quarantined holder balance after claim")
+
+	s.Require().NoError(s.app.SanctionKeeper.UnsanctionAddresses(s.ctx, f.holder1), "UnsanctionAddresses")
+	_, err = s.msgServer.ClaimDistribution(s.ctx, types.NewMsgClaimDistributionRequest(f.holder1, id))
+	s.Require().NoError(err, "ClaimDistribution holder1")
+	s.Assert().Equal(int64(100), f.payBalance(f.holder1), "unsanctioned holder balance after claim")
+	s.Assert().Empty(s.app.MarkerKeeper.GetAllDistributionClaims(s.ctx), "claims after claiming")
+}
+
+func (s *MsgServerTestSuite) TestDistributionGenesis() {
+	f := s.newDistributionFixture()
+	s.Require().NoError(s.app.SanctionKeeper.SanctionAddresses(s.ctx, f.holder1), "SanctionAddresses")
+	id := f.distribute(1000)
+	s.app.MarkerKeeper.ProcessDistributionSnapshots(s.ctx, types.MaxDistributionSnapshotHoldersPerBlock)
+	s.app.MarkerKeeper.ProcessDistributionPayments(s.ctx, 2)
+
+	genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	s.Require().Len(genState.Distributions, 1, "Distributions")
+	s.Assert().Equal(id, genState.Distributions[0].Id, "exported distribution id")
+	s.Assert().Len(genState.DistributionPayments, 1, "DistributionPayments")
+	s.Assert().Len(genState.DistributionClaims, 1, "DistributionClaims")
+	s.Assert().Equal(uint64(2), genState.NextDistributionId, "NextDistributionId")
+
+	genState.DistributionClaims = append(genState.DistributionClaims, genState.DistributionClaims[0])
+	s.Assert().ErrorContains(genState.Validate(), "distribution claims[1]: duplicate", "Validate with duplicate claim")
+}
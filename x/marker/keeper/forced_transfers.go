@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// RecordForcedTransfer stores the audit record of a forced transfer that was just done and emits an event for it.
+// Returns the id of the new record.
+func (k Keeper) RecordForcedTransfer(ctx sdk.Context, msg *types.MsgTransferRequest) (uint64, error) {
+	id, err := k.getNextForcedTransferID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err = k.SetNextForcedTransferID(ctx, id+1); err != nil {
+		return 0, err
+	}
+	record := types.ForcedTransferRecord{
+		Id:            id,
+		Denom:         msg.Amount.Denom,
+		FromAddress:   msg.FromAddress,
+		ToAddress:     msg.ToAddress,
+		Amount:        msg.Amount.Amount,
+		Administrator: msg.Administrator,
+		Reason:        msg.Reason,
+		Reference:     msg.Reference,
+		Height:        ctx.BlockHeight(),
+		BlockTime:     ctx.BlockTime(),
+	}
+	if err = k.SetForcedTransferRecord(ctx, record); err != nil {
+		return 0, err
+	}
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerForcedTransfer(record)); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// getNextForcedTransferID gets the id to use for the next forced transfer record.
+func (k Keeper) getNextForcedTransferID(ctx sdk.Context) (uint64, error) {
+	id, err := k.nextForcedTransferID.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 1, nil
+		}
+		return 0, fmt.Errorf("could not read next forced transfer id: %w", err)
+	}
+	return id, nil
+}
+
+// GetNextForcedTransferID gets the id that will be used for the next forced transfer record.
+func (k Keeper) GetNextForcedTransferID(ctx sdk.Context) uint64 {
+	id, err := k.getNextForcedTransferID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SetNextForcedTransferID sets the id that will be used for the next forced transfer record.
+func (k Keeper) SetNextForcedTransferID(ctx sdk.Context, id uint64) error {
+	if err := k.nextForcedTransferID.Set(ctx, id); err != nil {
+		return fmt.Errorf("failed to set next forced transfer id: %w", err)
+	}
+	return nil
+}
+
+// SetForcedTransferRecord stores the provided forced transfer record and indexes it by marker and by the
+// account the coins were taken from. Records are kept even if the marker is later deleted.
+func (k Keeper) SetForcedTransferRecord(ctx sdk.Context, record types.ForcedTransferRecord) error {
+	if err := record.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(record.Denom)
+	if err != nil {
+		return err
+	}
+	from := sdk.MustAccAddressFromBech32(record.FromAddress)
+	if err = k.forcedTransfers.Set(ctx, record.Id, record); err != nil {
+		return fmt.Errorf("failed to set forced transfer record %d: %w", record.Id, err)
+	}
+	if err = k.forcedTransfersByMarker.Set(ctx, collections.Join(markerAddr, record.Id), true); err != nil {
+		return fmt.Errorf("failed to index forced transfer record %d by marker: %w", record.Id, err)
+	}
+	if err = k.forcedTransfersByAccount.Set(ctx, collections.Join(from, record.Id), true); err != nil {
+		return fmt.Errorf("failed to index forced transfer record %d by account: %w", record.Id, err)
+	}
+	return nil
+}
+
+// GetForcedTransferRecord gets the forced transfer record with the provided id. Returns nil if it doesn't exist.
+func (k Keeper) GetForcedTransferRecord(ctx sdk.Context, id uint64) (*types.ForcedTransferRecord, error) {
+	record, err := k.forcedTransfers.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read forced transfer record %d: %w", id, err)
+	}
+	return &record, nil
+}
+
+// IterateForcedTransferRecords iterates over all forced transfer records in order of id.
+func (k Keeper) IterateForcedTransferRecords(ctx sdk.Context, cb func(record types.ForcedTransferRecord) (stop bool)) {
+	err := k.forcedTransfers.Walk(ctx, nil, func(_ uint64, record types.ForcedTransferRecord) (bool, error) {
+		return cb(record), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// forcedTransferFixture is a restricted marker that allows forced transfers, with a funded holder.
+type forcedTransferFixture struct {
+	*MsgServerTestSuite
+
+	denom  string
+	admin  sdk.AccAddress
+	holder sdk.AccAddress
+	other  sdk.AccAddress
+}
+
+func (s *MsgServerTestSuite) newForcedTransferFixture() *forcedTransferFixture {
+	f := &forcedTransferFixture{
+		MsgServerTestSuite: s,
+		denom:              "forcedcoin",
+		admin:              sdk.AccAddress("forced_admin________"),
+		holder:             sdk.AccAddress("forced_holder_______"),
+		other:              sdk.AccAddress("forced_other________"),
+	}
+	s.blockStartTime = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(s.blockStartTime).WithBlockHeight(7)
+
+	storeTestAccount(s.T(), s.app, s.ctx, f.holder, 1)
+	marker := newTestMarker(f.denom, types.MarkerType_RestrictedCoin, 1000,
+		types.AccessGrant{Address: f.admin.String(), Permissions: types.AccessList{types.Access_Transfer, types.Access_ForceTransfer}},
+		types.AccessGrant{Address: f.holder.String(), Permissions: types.AccessList{types.Access_Transfer}},
+	)
+	marker.AllowGovernanceControl = true
+	marker.AllowForcedTransfer = true
+	storeTestMarker(s.T(), s.app, s.ctx, marker)
+	fundTestAccount(s.T(), s.app, s.ctx, f.holder, sdk.NewInt64Coin(f.denom, 100))
+	return f
+}
+
+func (s *MsgServerTestSuite) TestForcedTransferRequiresJustification() {
+	f := s.newForcedTransferFixture()
+	msg := types.NewMsgTransferRequest(f.admin, f.holder, f.other, sdk.NewInt64Coin(f.denom, 10))
+	_, err := s.msgServer.Transfer(s.ctx, msg)
+	s.Assert().ErrorContains(err, "a reason and reference are required to force transfer forcedcoin from "+f.holder.String(), "Transfer without justification")
+
+	// Transfers of the signer's own funds don't need a justification and aren't recorded.
+	_, err = s.msgServer.Transfer(s.ctx, types.NewMsgTransferRequest(f.holder, f.holder, f.other, sdk.NewInt64Coin(f.denom, 5)))
+	s.Require().NoError(err, "Transfer by the holder")
+	s.Assert().Equal(uint64(1), s.app.MarkerKeeper.GetNextForcedTransferID(s.ctx), "next forced transfer id after a regular transfer")
+}
+
+func (s *MsgServerTestSuite) TestForcedTransferRecords() {
+	f := s.newForcedTransferFixture()
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	msg := types.NewMsgTransferRequest(f.admin, f.holder, f.other, sdk.NewInt64Coin(f.denom, 10)).
+		WithJustification(types.ForcedTransferReason_CourtOrder, "Case 24-CV-1234")
+	_, err := s.msgServer.Transfer(ctx, msg)
+	s.Require().NoError(err, "forced Transfer")
+
+	expRecord := types.ForcedTransferRecord{
+		Id:            1,
+		Denom:         f.denom,
+		FromAddress:   f.holder.String(),
+		ToAddress:     f.other.String(),
+		Amount:        sdkmath.NewInt(10),
+		Administrator: f.admin.String(),
+		Reason:        types.ForcedTransferReason_CourtOrder,
+		Reference:     "Case 24-CV-1234",
+		Height:        7,
+		BlockTime:     s.blockStartTime,
+	}
+	record, err := s.app.MarkerKeeper.GetForcedTransferRecord(s.ctx, 1)
+	s.Require().NoError(err, "GetForcedTransferRecord")
+	s.Assert().Equal(&expRecord, record, "GetForcedTransferRecord")
+	expEvent, err := sdk.TypedEventToEvent(types.NewEventMarkerForcedTransfer(expRecord))
+	s.Require().NoError(err, "TypedEventToEvent NewEventMarkerForcedTransfer")
+	s.Assert().Contains(ctx.EventManager().Events(), expEvent, "emitted events")
+
+	msg = types.NewMsgTransferRequest(f.admin, f.holder, f.admin, sdk.NewInt64Coin(f.denom, 20)).
+		WithJustification(types.ForcedTransferReason_LostKeyRecovery, "ticket 42")
+	_, err = s.msgServer.Transfer(s.ctx.WithBlockHeight(8), msg)
+	s.Require().NoError(err, "second forced Transfer")
+
+	resp, err := s.app.MarkerKeeper.ForcedTransfers(s.ctx, &types.QueryForcedTransfersRequest{Id: f.denom, Pagination: &query.PageRequest{Limit: 1}})
+	s.Require().NoError(err, "ForcedTransfers query first page")
+	s.Require().Len(resp.Records, 1, "first page records")
+	s.Assert().Equal(expRecord, resp.Records[0], "first page record")
+	s.Require().NotEmpty(resp.Pagination.NextKey, "first page next key")
+
+	resp, err = s.app.MarkerKeeper.ForcedTransfers(s.ctx, &types.QueryForcedTransfersRequest{Id: f.denom, Pagination: &query.PageRequest{Key: resp.Pagination.NextKey}})
+	s.Require().NoError(err, "ForcedTransfers query second page")
+	s.Require().Len(resp.Records, 1, "second page records")
+	s.Assert().Equal(uint64(2), resp.Records[0].Id, "second page record id")
+	s.Assert().Equal("ticket 42", resp.Records[0].Reference, "second page record reference")
+
+	accountResp, err := s.app.MarkerKeeper.AccountForcedTransfers(s.ctx, &types.QueryAccountForcedTransfersRequest{Address: f.holder.String()})
+	s.Require().NoError(err, "AccountForcedTransfers query")
+	s.Assert().Len(accountResp.Records, 2, "holder records")
+	accountResp, err = s.app.MarkerKeeper.AccountForcedTransfers(s.ctx, &types.QueryAccountForcedTransfersRequest{Address: f.other.String()})
+	s.Require().NoError(err, "AccountForcedTransfers query for recipient")
+	s.Assert().Empty(accountResp.Records, "recipient records")
+	_, err = s.app.MarkerKeeper.AccountForcedTransfers(s.ctx, &types.QueryAccountForcedTransfersRequest{Address: "bad"})
+	s.Assert().ErrorContains(err, "invalid address", "AccountForcedTransfers query with bad address")
+
+	genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	s.Assert().Len(genState.ForcedTransfers, 2, "exported forced transfers")
+	s.Assert().Equal(uint64(3), genState.NextForcedTransferId, "exported next forced transfer id")
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+var _ banktypes.GetLockedCoinsFn = Keeper{}.GetLockedCoins
+
+// GetLockedCoins gets all the marker coins that are frozen in the given address.
+// Expired freezes are ignored (even if they haven't been removed from state yet).
+func (k Keeper) GetLockedCoins(goCtx context.Context, addr sdk.AccAddress) sdk.Coins {
+	if types.HasFreezeBypass(goCtx) {
+		return nil
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blockTime := ctx.BlockTime()
+
+	var rv sdk.Coins
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](addr)
+	err := k.holderFreezeIndex.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ bool) (bool, error) {
+		freeze, err := k.holderFreezes.Get(ctx, collections.Join(key.K2(), key.K1()))
+		if err != nil {
+			return true, fmt.Errorf("could not read freeze on %s for %s: %w", key.K2(), key.K1(), err)
+		}
+		if freeze.ExpiresAt.After(blockTime) {
+			rv = rv.Add(freeze.GetCoin())
+		}
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// SetHolderFreeze stores the provided holder freeze, replacing any existing freeze for the same marker and holder.
+func (k Keeper) SetHolderFreeze(ctx sdk.Context, freeze types.HolderFreeze) error {
+	if err := freeze.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(freeze.Denom)
+	if err != nil {
+		return err
+	}
+	holder := sdk.MustAccAddressFromBech32(freeze.Holder)
+	existing, err := k.GetHolderFreeze(ctx, markerAddr, holder)
+	if err != nil {
+		return err
+	}
+	if existing != nil && !existing.ExpiresAt.Equal(freeze.ExpiresAt) {
+		if err = k.holderFreezeExpirations.Remove(ctx, collections.Join3(existing.ExpiresAt, markerAddr, holder)); err != nil {
+			return fmt.Errorf("failed to remove holder freeze expiration: %w", err)
+		}
+	}
+	if err = k.holderFreezes.Set(ctx, collections.Join(markerAddr, holder), freeze); err != nil {
+		return fmt.Errorf("failed to set holder freeze: %w", err)
+	}
+	if err = k.holderFreezeIndex.Set(ctx, collections.Join(holder, markerAddr), true); err != nil {
+		return fmt.Errorf("failed to set holder freeze index: %w", err)
+	}
+	if err = k.holderFreezeExpirations.Set(ctx, collections.Join3(freeze.ExpiresAt, markerAddr, holder), true); err != nil {
+		return fmt.Errorf("failed to set holder freeze expiration: %w", err)
+	}
+	return nil
+}
+
+// GetHolderFreeze gets the freeze of a marker's coins in the holder's account. Returns nil if there isn't one.
+func (k Keeper) GetHolderFreeze(ctx sdk.Context, markerAddr, holder sdk.AccAddress) (*types.HolderFreeze, error) {
+	freeze, err := k.holderFreezes.Get(ctx, collections.Join(markerAddr, holder))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read freeze on %s for %s: %w", markerAddr, holder, err)
+	}
+	return &freeze, nil
+}
+
+// RemoveHolderFreeze removes the freeze of a marker's coins in the holder's account.
+func (k Keeper) RemoveHolderFreeze(ctx sdk.Context, markerAddr, holder sdk.AccAddress) {
+	freeze, err := k.GetHolderFreeze(ctx, markerAddr, holder)
+	if err != nil {
+		panic(err)
+	}
+	if freeze != nil {
+		if err = k.holderFreezeExpirations.Remove(ctx, collections.Join3(freeze.ExpiresAt, markerAddr, holder)); err != nil {
+			panic(fmt.Errorf("failed to remove holder freeze expiration: %w", err))
+		}
+	}
+	if err = k.holderFreezes.Remove(ctx, collections.Join(markerAddr, holder)); err != nil {
+		panic(fmt.Errorf("failed to remove holder freeze: %w", err))
+	}
+	if err = k.holderFreezeIndex.Remove(ctx, collections.Join(holder, markerAddr)); err != nil {
+		panic(fmt.Errorf("failed to remove holder freeze index: %w", err))
+	}
+}
+
+// ClearHolderFreezes removes all of the holder freezes for a marker.
+func (k Keeper) ClearHolderFreezes(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	var holders []sdk.AccAddress
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](markerAddr)
+	err := k.holderFreezes.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ types.HolderFreeze) (bool, error) {
+		holders = append(holders, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	for _, holder := range holders {
+		k.RemoveHolderFreeze(ctx, markerAddr, holder)
+	}
+}
+
+// IterateHolderFreezes iterates over all holder freezes (for all markers).
+func (k Keeper) IterateHolderFreezes(ctx sdk.Context, cb func(freeze types.HolderFreeze) (stop bool)) {
+	err := k.holderFreezes.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], freeze types.HolderFreeze) (bool, error) {
+		return cb(freeze), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// RemoveExpiredHolderFreezes removes all holder freezes that have expired.
+// Only the freezes that have expired are read, using the index of holder freezes by expiration.
+func (k Keeper) RemoveExpiredHolderFreezes(ctx sdk.Context) {
+	var expired []collections.Pair[sdk.AccAddress, sdk.AccAddress]
+	blockTime := ctx.BlockTime()
+	err := k.holderFreezeExpirations.Walk(ctx, nil, func(key collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], _ bool) (bool, error) {
+		if key.K1().After(blockTime) {
+			return true, nil
+		}
+		expired = append(expired, collections.Join(key.K2(), key.K3()))
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, key := range expired {
+		freeze, err := k.GetHolderFreeze(ctx, key.K1(), key.K2())
+		if err != nil {
+			panic(err)
+		}
+		if freeze == nil {
+			continue
+		}
+		k.RemoveHolderFreeze(ctx, key.K1(), key.K2())
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerUnfreeze(freeze.Denom, freeze.Holder, "")); err != nil {
+			ctx.Logger().Error("failed to emit marker unfreeze event", "denom", freeze.Denom, "holder", freeze.Holder, "error", err)
+		}
+	}
+}
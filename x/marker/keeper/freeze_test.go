@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// freezeFixture is a restricted marker with a funded holder that can be frozen and force transferred.
+type freezeFixture struct {
+	*MsgServerTestSuite
+
+	denom  string
+	admin  sdk.AccAddress
+	holder sdk.AccAddress
+	other  sdk.AccAddress
+}
+
+func (s *MsgServerTestSuite) newFreezeFixture() *freezeFixture {
+	f := &freezeFixture{
+		MsgServerTestSuite: s,
+		denom:              "frozencoin",
+		admin:              sdk.AccAddress("freeze_admin________"),
+		holder:             sdk.AccAddress("freeze_holder_______"),
+		other:              sdk.AccAddress("freeze_other________"),
+	}
+	s.blockStartTime = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(s.blockStartTime)
+
+	storeTestAccount(s.T(), s.app, s.ctx, f.holder, 1)
+	marker := newTestMarker(f.denom, types.MarkerType_RestrictedCoin, 1000,
+		types.AccessGrant{
+			Address: f.admin.String(),
+			Permissions: types.AccessList{
+				types.Access_Mint, types.Access_Admin, types.Access_Transfer,
+				types.Access_ForceTransfer, types.Access_Freeze,
+			},
+		},
+		types.AccessGrant{Address: f.holder.String(), Permissions: types.AccessList{types.Access_Transfer}},
+	)
+	marker.AllowGovernanceControl = true
+	marker.AllowForcedTransfer = true
+	storeTestMarker(s.T(), s.app, s.ctx, marker)
+	fundTestAccount(s.T(), s.app, s.ctx, f.holder, sdk.NewInt64Coin(f.denom, 100))
+	return f
+}
+
+// newFreeze creates a HolderFreeze of the fixture's denom in the fixture's holder account.
+func (f *freezeFixture) newFreeze(amount int64, expiresAt time.Time) types.HolderFreeze {
+	return types.HolderFreeze{
+		Denom:         f.denom,
+		Holder:        f.holder.String(),
+		Amount:        sdkmath.NewInt(amount),
+		ExpiresAt:     expiresAt,
+		Reason:        "court order",
+		Administrator: f.admin.String(),
+	}
+}
+
+func (s *MsgServerTestSuite) TestFreezeHolder() {
+	f := s.newFreezeFixture()
+	expiresAt := s.blockStartTime.Add(24 * time.Hour)
+	markerAddr := types.MustGetMarkerAddress(f.denom)
+
+	s.Run("signer without freeze access", func() {
+		ctx, _ := s.ctx.CacheContext()
+		msg := types.NewMsgFreezeHolderRequest(sdk.NewInt64Coin(f.denom, 60), f.holder, f.holder, expiresAt, "nope")
+		_, err := s.msgServer.FreezeHolder(ctx, msg)
+		s.Assert().ErrorContains(err, "does not have ACCESS_FREEZE on frozencoin marker")
+	})
+
+	s.Run("expiration not in the future", func() {
+		ctx, _ := s.ctx.CacheContext()
+		msg := types.NewMsgFreezeHolderRequest(sdk.NewInt64Coin(f.denom, 60), f.admin, f.holder, s.blockStartTime, "court order")
+		_, err := s.msgServer.FreezeHolder(ctx, msg)
+		s.Assert().ErrorContains(err, "must be after the current block time")
+	})
+
+	s.Run("unknown marker", func() {
+		ctx, _ := s.ctx.CacheContext()
+		msg := types.NewMsgFreezeHolderRequest(sdk.NewInt64Coin("nosuchcoin", 60), f.admin, f.holder, expiresAt, "court order")
+		_, err := s.msgServer.FreezeHolder(ctx, msg)
+		s.Assert().ErrorContains(err, "marker nosuchcoin not found")
+	})
+
+	s.Run("freeze then unfreeze", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		msg := types.NewMsgFreezeHolderRequest(sdk.NewInt64Coin(f.denom, 60), f.admin, f.holder, expiresAt, "court order")
+		_, err := s.msgServer.FreezeHolder(ctx, msg)
+		s.Require().NoError(err, "FreezeHolder")
+
+		expFreeze := f.newFreeze(60, expiresAt)
+		freeze, err := s.app.MarkerKeeper.GetHolderFreeze(ctx, markerAddr, f.holder)
+		s.Require().NoError(err, "GetHolderFreeze")
+		s.Assert().Equal(&expFreeze, freeze, "GetHolderFreeze")
+		expEvent, err := sdk.TypedEventToEvent(types.NewEventMarkerFreeze(expFreeze))
+		s.Require().NoError(err, "TypedEventToEvent NewEventMarkerFreeze")
+		s.Assert().Contains(ctx.EventManager().Events(), expEvent, "events emitted")
+
+		locked := s.app.MarkerKeeper.GetLockedCoins(ctx, f.holder)
+		s.Assert().Equal("60frozencoin", locked.String(), "GetLockedCoins")
+		spendable := s.app.BankKeeper.SpendableCoins(ctx, f.holder)
+		s.Assert().Equal("40frozencoin", spendable.String(), "SpendableCoins")
+
+		// The holder can move unfrozen funds, but not frozen ones.
+		err = s.app.MarkerKeeper.TransferCoin(ctx, f.holder, f.other, f.holder, sdk.NewInt64Coin(f.denom, 50))
+		s.Assert().ErrorContains(err, "insufficient funds", "TransferCoin of frozen funds")
+		err = s.app.MarkerKeeper.TransferCoin(ctx, f.holder, f.other, f.holder, sdk.NewInt64Coin(f.denom, 40))
+		s.Require().NoError(err, "TransferCoin of unfrozen funds")
+
+		// A forced transfer can still move the frozen funds.
+		err = s.app.MarkerKeeper.TransferCoin(ctx, f.holder, f.other, f.admin, sdk.NewInt64Coin(f.denom, 10))
+		s.Require().NoError(err, "forced TransferCoin of frozen funds")
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		_, err = s.msgServer.UnfreezeHolder(ctx, types.NewMsgUnfreezeHolderRequest(f.denom, f.admin, f.holder))
+		s.Require().NoError(err, "UnfreezeHolder")
+		freeze, err = s.app.MarkerKeeper.GetHolderFreeze(ctx, markerAddr, f.holder)
+		s.Require().NoError(err, "GetHolderFreeze after unfreeze")
+		s.Assert().Nil(freeze, "GetHolderFreeze after unfreeze")
+		expEvent, err = sdk.TypedEventToEvent(types.NewEventMarkerUnfreeze(f.denom, f.holder.String(), f.admin.String()))
+		s.Require().NoError(err, "TypedEventToEvent NewEventMarkerUnfreeze")
+		s.Assert().Equal(sdk.Events{expEvent}, ctx.EventManager().Events(), "events emitted by UnfreezeHolder")
+		s.Assert().Empty(s.app.MarkerKeeper.GetLockedCoins(ctx, f.holder), "GetLockedCoins after unfreeze")
+
+		_, err = s.msgServer.UnfreezeHolder(ctx, types.NewMsgUnfreezeHolderRequest(f.denom, f.admin, f.holder))
+		s.Assert().ErrorContains(err, "no freeze of frozencoin found for "+f.holder.String(), "second UnfreezeHolder")
+	})
+}
+
+func (s *MsgServerTestSuite) TestRemoveExpiredHolderFreezes() {
+	f := s.newFreezeFixture()
+	expiresAt := s.blockStartTime.Add(time.Hour)
+	s.Require().NoError(s.app.MarkerKeeper.SetHolderFreeze(s.ctx, f.newFreeze(25, expiresAt)), "SetHolderFreeze")
+
+	s.Run("before expiration", func() {
+		ctx := s.ctx.WithBlockTime(expiresAt.Add(-time.Second)).WithEventManager(sdk.NewEventManager())
+		s.Assert().Equal("25frozencoin", s.app.MarkerKeeper.GetLockedCoins(ctx, f.holder).String(), "GetLockedCoins")
+		s.app.MarkerKeeper.RemoveExpiredHolderFreezes(ctx)
+		s.Assert().Empty(ctx.EventManager().Events(), "events emitted")
+	})
+
+	s.Run("at expiration", func() {
+		ctx := s.ctx.WithBlockTime(expiresAt).WithEventManager(sdk.NewEventManager())
+		s.Assert().Empty(s.app.MarkerKeeper.GetLockedCoins(ctx, f.holder), "GetLockedCoins of expired freeze")
+		s.app.MarkerKeeper.RemoveExpiredHolderFreezes(ctx)
+		expEvent, err := sdk.TypedEventToEvent(types.NewEventMarkerUnfreeze(f.denom, f.holder.String(), ""))
+		s.Require().NoError(err, "TypedEventToEvent NewEventMarkerUnfreeze")
+		s.Assert().Equal(sdk.Events{expEvent}, ctx.EventManager().Events(), "events emitted")
+		freeze, err := s.app.MarkerKeeper.GetHolderFreeze(ctx, types.MustGetMarkerAddress(f.denom), f.holder)
+		s.Require().NoError(err, "GetHolderFreeze")
+		s.Assert().Nil(freeze, "GetHolderFreeze")
+	})
+}
+
+func (s *MsgServerTestSuite) TestRemoveExpiredHolderFreezesAfterReplace() {
+	f := s.newFreezeFixture()
+	firstExpiresAt := s.blockStartTime.Add(time.Hour)
+	secondExpiresAt := s.blockStartTime.Add(2 * time.Hour)
+	s.Require().NoError(s.app.MarkerKeeper.SetHolderFreeze(s.ctx, f.newFreeze(25, firstExpiresAt)), "SetHolderFreeze first")
+	s.Require().NoError(s.app.MarkerKeeper.SetHolderFreeze(s.ctx, f.newFreeze(30, secondExpiresAt)), "SetHolderFreeze second")
+
+	// The replaced freeze's expiration should no longer be indexed, so nothing is removed at its old expiration.
+	ctx := s.ctx.WithBlockTime(firstExpiresAt).WithEventManager(sdk.NewEventManager())
+	s.app.MarkerKeeper.RemoveExpiredHolderFreezes(ctx)
+	s.Assert().Empty(ctx.EventManager().Events(), "events emitted at first expiration")
+	s.Assert().Equal("30frozencoin", s.app.MarkerKeeper.GetLockedCoins(ctx, f.holder).String(), "GetLockedCoins at first expiration")
+
+	ctx = s.ctx.WithBlockTime(secondExpiresAt).WithEventManager(sdk.NewEventManager())
+	s.app.MarkerKeeper.RemoveExpiredHolderFreezes(ctx)
+	expEvent, err := sdk.TypedEventToEvent(types.NewEventMarkerUnfreeze(f.denom, f.holder.String(), ""))
+	s.Require().NoError(err, "TypedEventToEvent NewEventMarkerUnfreeze")
+	s.Assert().Equal(sdk.Events{expEvent}, ctx.EventManager().Events(), "events emitted at second expiration")
+	freeze, err := s.app.MarkerKeeper.GetHolderFreeze(ctx, types.MustGetMarkerAddress(f.denom), f.holder)
+	s.Require().NoError(err, "GetHolderFreeze")
+	s.Assert().Nil(freeze, "GetHolderFreeze")
+
+	// A removed freeze should not leave anything behind in the expiration index.
+	ctx = s.ctx.WithBlockTime(secondExpiresAt.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	s.app.MarkerKeeper.RemoveExpiredHolderFreezes(ctx)
+	s.Assert().Empty(ctx.EventManager().Events(), "events emitted after removal")
+}
+
+func (s *MsgServerTestSuite) TestHolderFreezesQuery() {
+	f := s.newFreezeFixture()
+	freeze := f.newFreeze(25, s.blockStartTime.Add(time.Hour))
+	s.Require().NoError(s.app.MarkerKeeper.SetHolderFreeze(s.ctx, freeze), "SetHolderFreeze")
+
+	resp, err := s.app.MarkerKeeper.HolderFreezes(s.ctx, &types.QueryHolderFreezesRequest{Id: f.denom})
+	s.Require().NoError(err, "HolderFreezes by denom")
+	s.Assert().Equal([]types.HolderFreeze{freeze}, resp.Freezes, "HolderFreezes by denom")
+
+	resp, err = s.app.MarkerKeeper.HolderFreezes(s.ctx, &types.QueryHolderFreezesRequest{Id: f.denom, Holder: f.holder.String()})
+	s.Require().NoError(err, "HolderFreezes by holder")
+	s.Assert().Equal([]types.HolderFreeze{freeze}, resp.Freezes, "HolderFreezes by holder")
+
+	resp, err = s.app.MarkerKeeper.HolderFreezes(s.ctx, &types.QueryHolderFreezesRequest{Id: f.denom, Holder: f.other.String()})
+	s.Require().NoError(err, "HolderFreezes for other")
+	s.Assert().Empty(resp.Freezes, "HolderFreezes for other")
+
+	_, err = s.app.MarkerKeeper.HolderFreezes(s.ctx, &types.QueryHolderFreezesRequest{Id: f.denom, Holder: "bad"})
+	s.Assert().ErrorContains(err, "invalid holder", "HolderFreezes with bad holder")
+}
+
+func (s *MsgServerTestSuite) TestHolderFreezesGenesis() {
+	f := s.newFreezeFixture()
+	freeze := f.newFreeze(25, s.blockStartTime.Add(time.Hour))
+	s.Require().NoError(s.app.MarkerKeeper.SetHolderFreeze(s.ctx, freeze), "SetHolderFreeze")
+
+	genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+	s.Assert().Equal([]types.HolderFreeze{freeze}, genState.HolderFreezes, "exported HolderFreezes")
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+
+	genState.HolderFreezes = append(genState.HolderFreezes, freeze)
+	s.Assert().ErrorContains(genState.Validate(), "holder freezes[1]: duplicate freeze", "Validate with duplicate freeze")
+}
@@ -25,6 +25,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 				if err := k.markers.Set(ctx, m.GetAddress(), m.GetAddress()); err != nil {
 					panic(err)
 				}
+				k.updateSupplyIndexes(ctx, m, true)
 			}
 		}
 	}
@@ -57,10 +58,124 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		address := sdk.MustAccAddressFromBech32(mNavs.Address)
 		for _, nav := range mNavs.NetAssetValues {
 			navCopy := nav
+			navCopy.Stale = false
 			if err := k.navs.Set(ctx, collections.Join(address, navCopy.Price.Denom), navCopy); err != nil {
 				panic(err)
 			}
 		}
+		for _, record := range mNavs.History {
+			if err := k.SetNetAssetValueRecord(ctx, address, record); err != nil {
+				panic(err)
+			}
+		}
+		if err := k.SetNetAssetValueMaxAge(ctx, address, mNavs.MaxAge); err != nil {
+			panic(err)
+		}
+	}
+	for _, freeze := range data.HolderFreezes {
+		if err := k.SetHolderFreeze(ctx, freeze); err != nil {
+			panic(err)
+		}
+	}
+	for _, dist := range data.Distributions {
+		if err := k.SetDistribution(ctx, dist); err != nil {
+			panic(err)
+		}
+	}
+	for _, payment := range data.DistributionPayments {
+		if err := k.SetDistributionPayment(ctx, payment); err != nil {
+			panic(err)
+		}
+	}
+	for _, claim := range data.DistributionClaims {
+		if err := k.SetDistributionClaim(ctx, claim); err != nil {
+			panic(err)
+		}
+	}
+	if data.NextDistributionId > 0 {
+		if err := k.SetNextDistributionID(ctx, data.NextDistributionId); err != nil {
+			panic(err)
+		}
+	}
+	for _, redemption := range data.Redemptions {
+		if err := k.SetRedemption(ctx, redemption); err != nil {
+			panic(err)
+		}
+	}
+	if data.NextRedemptionId > 0 {
+		if err := k.SetNextRedemptionID(ctx, data.NextRedemptionId); err != nil {
+			panic(err)
+		}
+	}
+	for _, payoutDenom := range data.RedemptionPayoutDenoms {
+		if err := k.SetRedemptionPayoutDenom(ctx, types.MustGetMarkerAddress(payoutDenom.Denom), payoutDenom.PayoutDenom); err != nil {
+			panic(err)
+		}
+	}
+	for _, limits := range data.TransferLimits {
+		if err := k.SetTransferLimits(ctx, types.MustGetMarkerAddress(limits.Denom), limits); err != nil {
+			panic(err)
+		}
+	}
+	for _, volume := range data.TransferVolumes {
+		if err := k.SetTransferVolume(ctx, volume); err != nil {
+			panic(err)
+		}
+	}
+	for _, terms := range data.AccessGrantTerms {
+		if err := k.SetAccessGrantTerms(ctx, terms); err != nil {
+			panic(err)
+		}
+	}
+	for _, volume := range data.AccessGrantMintVolumes {
+		if err := k.SetAccessGrantMintVolume(ctx, volume); err != nil {
+			panic(err)
+		}
+	}
+	for _, threshold := range data.ApprovalThresholds {
+		if err := k.SetApprovalThreshold(ctx, types.MustGetMarkerAddress(threshold.Denom), threshold.Permission, threshold.Threshold); err != nil {
+			panic(err)
+		}
+	}
+	for _, op := range data.PendingOperations {
+		if err := k.SetPendingOperation(ctx, op); err != nil {
+			panic(err)
+		}
+	}
+	if data.NextPendingOperationId > 0 {
+		if err := k.SetNextPendingOperationID(ctx, data.NextPendingOperationId); err != nil {
+			panic(err)
+		}
+	}
+	for _, requirements := range data.AttributeRequirements {
+		if err := k.SetAttributeRequirements(ctx, types.MustGetMarkerAddress(requirements.Denom), requirements); err != nil {
+			panic(err)
+		}
+	}
+	for _, snapshot := range data.HolderSnapshots {
+		if err := k.SetHolderSnapshot(ctx, snapshot.Snapshot, snapshot.Holders); err != nil {
+			panic(err)
+		}
+	}
+	if data.NextHolderSnapshotId > 0 {
+		if err := k.SetNextHolderSnapshotID(ctx, data.NextHolderSnapshotId); err != nil {
+			panic(err)
+		}
+	}
+	for _, record := range data.ForcedTransfers {
+		if err := k.SetForcedTransferRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+	if data.NextForcedTransferId > 0 {
+		if err := k.SetNextForcedTransferID(ctx, data.NextForcedTransferId); err != nil {
+			panic(err)
+		}
+	}
+	for _, schedule := range data.IssuanceSchedules {
+		if err := k.SetIssuanceSchedule(ctx, types.MustGetMarkerAddress(schedule.Denom), schedule); err != nil {
+			panic(err)
+		}
 	}
 }
 
@@ -113,10 +228,92 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		var history []types.NetAssetValueRecord
+		err = k.IterateNetAssetValueHistory(ctx, markers[i].GetAddress(), "", func(record types.NetAssetValueRecord) (stop bool) {
+			history = append(history, record)
+			return false
+		})
+		if err != nil {
+			panic(err)
+		}
+		maxAge, err := k.GetNetAssetValueMaxAge(ctx, markers[i].GetAddress())
+		if err != nil {
+			panic(err)
+		}
 		markerNavs.Address = markers[i].GetAddress().String()
 		markerNavs.NetAssetValues = navs
+		markerNavs.History = history
+		markerNavs.MaxAge = maxAge
 		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues)
+	var holderFreezes []types.HolderFreeze
+	k.IterateHolderFreezes(ctx, func(freeze types.HolderFreeze) bool {
+		holderFreezes = append(holderFreezes, freeze)
+		return false
+	})
+
+	rv := types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues)
+	rv.HolderFreezes = holderFreezes
+
+	k.IterateDistributions(ctx, func(dist types.Distribution) bool {
+		rv.Distributions = append(rv.Distributions, dist)
+		return false
+	})
+	rv.DistributionPayments = k.GetAllDistributionPayments(ctx)
+	rv.DistributionClaims = k.GetAllDistributionClaims(ctx)
+	rv.NextDistributionId = k.GetNextDistributionID(ctx)
+
+	k.IterateRedemptions(ctx, func(redemption types.Redemption) bool {
+		rv.Redemptions = append(rv.Redemptions, redemption)
+		return false
+	})
+	rv.NextRedemptionId = k.GetNextRedemptionID(ctx)
+	rv.RedemptionPayoutDenoms = k.GetAllRedemptionPayoutDenoms(ctx)
+
+	k.IterateTransferLimits(ctx, func(limits types.TransferLimits) bool {
+		rv.TransferLimits = append(rv.TransferLimits, limits)
+		return false
+	})
+	rv.TransferVolumes = k.GetAllTransferVolumes(ctx)
+
+	k.IterateAccessGrantTerms(ctx, func(terms types.AccessGrantTerms) bool {
+		rv.AccessGrantTerms = append(rv.AccessGrantTerms, terms)
+		return false
+	})
+	rv.AccessGrantMintVolumes = k.GetAllAccessGrantMintVolumes(ctx)
+
+	rv.ApprovalThresholds = k.GetAllApprovalThresholds(ctx)
+	k.IteratePendingOperations(ctx, func(op types.PendingOperation) bool {
+		rv.PendingOperations = append(rv.PendingOperations, op)
+		return false
+	})
+	rv.NextPendingOperationId = k.GetNextPendingOperationID(ctx)
+
+	k.IterateAttributeRequirements(ctx, func(requirements types.AttributeRequirements) bool {
+		rv.AttributeRequirements = append(rv.AttributeRequirements, requirements)
+		return false
+	})
+
+	k.IterateHolderSnapshots(ctx, func(snapshot types.HolderSnapshot) bool {
+		holders, err := k.GetHolderSnapshotEntries(ctx, snapshot.Id)
+		if err != nil {
+			panic(err)
+		}
+		rv.HolderSnapshots = append(rv.HolderSnapshots, types.GenesisHolderSnapshot{Snapshot: snapshot, Holders: holders})
+		return false
+	})
+	rv.NextHolderSnapshotId = k.GetNextHolderSnapshotID(ctx)
+
+	k.IterateForcedTransferRecords(ctx, func(record types.ForcedTransferRecord) bool {
+		rv.ForcedTransfers = append(rv.ForcedTransfers, record)
+		return false
+	})
+	rv.NextForcedTransferId = k.GetNextForcedTransferID(ctx)
+
+	k.IterateIssuanceSchedules(ctx, func(schedule types.IssuanceSchedule) bool {
+		rv.IssuanceSchedules = append(rv.IssuanceSchedules, schedule)
+		return false
+	})
+	return rv
 }
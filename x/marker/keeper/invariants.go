@@ -29,7 +29,7 @@ func supplyInvariant(mk Keeper, bk bankkeeper.Keeper) sdk.Invariant { //nolint:s
 	return func(ctx sdk.Context) (string, bool) {
 		statusMessage := ""
 		isBroken := false
-		mk.IterateMarkers(ctx, func(record types.MarkerAccountI) bool {
+		mk.IterateFixedSupplyMarkers(ctx, func(record types.MarkerAccountI) bool {
 			// Invariant checks are only done against active markers.
 			if record.GetStatus() == types.StatusActive && record.HasFixedSupply() {
 				requiredSupply := record.GetSupply()
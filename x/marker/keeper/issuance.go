@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetIssuanceSchedule stores the issuance schedule of a marker. If the schedule has neither a hard cap
+// nor any tranches, the marker's issuance schedule is removed.
+func (k Keeper) SetIssuanceSchedule(ctx sdk.Context, markerAddr sdk.AccAddress, schedule types.IssuanceSchedule) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+	if schedule.IsEmpty() {
+		return k.RemoveIssuanceSchedule(ctx, markerAddr)
+	}
+	if err := k.issuanceSchedules.Set(ctx, markerAddr, schedule); err != nil {
+		return fmt.Errorf("failed to set issuance schedule: %w", err)
+	}
+	return nil
+}
+
+// RemoveIssuanceSchedule deletes the issuance schedule of a marker.
+func (k Keeper) RemoveIssuanceSchedule(ctx sdk.Context, markerAddr sdk.AccAddress) error {
+	if err := k.issuanceSchedules.Remove(ctx, markerAddr); err != nil {
+		return fmt.Errorf("failed to remove issuance schedule: %w", err)
+	}
+	return nil
+}
+
+// GetIssuanceSchedule gets the issuance schedule of a marker. Returns nil if the marker doesn't have one.
+func (k Keeper) GetIssuanceSchedule(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.IssuanceSchedule, error) {
+	schedule, err := k.issuanceSchedules.Get(ctx, markerAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read issuance schedule: %w", err)
+	}
+	return &schedule, nil
+}
+
+// IterateIssuanceSchedules iterates over the issuance schedules of all markers.
+func (k Keeper) IterateIssuanceSchedules(ctx sdk.Context, cb func(schedule types.IssuanceSchedule) (stop bool)) {
+	err := k.issuanceSchedules.Walk(ctx, nil, func(_ sdk.AccAddress, schedule types.IssuanceSchedule) (bool, error) {
+		return cb(schedule), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// getIssuedSupply gets the current supply of a marker's coin. Markers that aren't active yet don't have any
+// coins in circulation, so the supply recorded in the marker is used for them.
+func (k Keeper) getIssuedSupply(ctx sdk.Context, marker types.MarkerAccountI) sdkmath.Int {
+	if marker.GetStatus() == types.StatusProposed || marker.GetStatus() == types.StatusFinalized {
+		return marker.GetSupply().Amount
+	}
+	return k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount
+}
+
+// validateHardCap returns an error if the provided total supply of a marker's coin exceeds the hard cap
+// of the marker's issuance schedule.
+func (k Keeper) validateHardCap(ctx sdk.Context, marker types.MarkerAccountI, total sdkmath.Int) error {
+	schedule, err := k.GetIssuanceSchedule(ctx, marker.GetAddress())
+	if err != nil {
+		return err
+	}
+	if schedule == nil || !schedule.HasHardCap() || total.LTE(schedule.HardCap) {
+		return nil
+	}
+	return fmt.Errorf("requested supply %s exceeds the %s hard cap of %s", total, marker.GetDenom(), schedule.HardCap)
+}
+
+// applyIssuanceSchedule returns an error if the amount is more than the marker's issuance schedule
+// allows to be minted now. Otherwise, the amount is added to the schedule's issued amount.
+func (k Keeper) applyIssuanceSchedule(ctx sdk.Context, marker types.MarkerAccountI, amount sdkmath.Int) error {
+	schedule, err := k.GetIssuanceSchedule(ctx, marker.GetAddress())
+	if err != nil {
+		return err
+	}
+	if schedule == nil || len(schedule.Tranches) == 0 {
+		return nil
+	}
+
+	unlocked, _ := schedule.Unlocked(ctx.BlockTime(), ctx.BlockHeight())
+	available := unlocked.Sub(schedule.Issued)
+	if amount.GT(available) {
+		return fmt.Errorf("cannot mint %s%s: only %s is mintable now under the issuance schedule",
+			amount, marker.GetDenom(), sdkmath.MaxInt(available, sdkmath.ZeroInt()))
+	}
+	schedule.Issued = schedule.Issued.Add(amount)
+	return k.SetIssuanceSchedule(ctx, marker.GetAddress(), *schedule)
+}
+
+// GetMintable gets the amount of a marker's coin that can be minted now under its issuance schedule,
+// along with the current supply and the totals of the unlocked and still scheduled tranches.
+func (k Keeper) GetMintable(ctx sdk.Context, marker types.MarkerAccountI, schedule types.IssuanceSchedule) (mintable, supply, unlocked, scheduled sdkmath.Int) {
+	supply = k.getIssuedSupply(ctx, marker)
+	unlocked, scheduled = schedule.Unlocked(ctx.BlockTime(), ctx.BlockHeight())
+
+	mintable = k.GetMaxSupply(ctx).Sub(supply)
+	if len(schedule.Tranches) > 0 {
+		mintable = sdkmath.MinInt(mintable, unlocked.Sub(schedule.Issued))
+	}
+	if schedule.HasHardCap() {
+		mintable = sdkmath.MinInt(mintable, schedule.HardCap.Sub(supply))
+	}
+	return sdkmath.MaxInt(mintable, sdkmath.ZeroInt()), supply, unlocked, scheduled
+}
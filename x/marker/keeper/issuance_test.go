@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// issuanceFixture is a coin marker without any supply yet, and a schedule with one unlocked and one locked tranche.
+type issuanceFixture struct {
+	*MsgServerTestSuite
+
+	denom      string
+	markerAddr sdk.AccAddress
+	admin      sdk.AccAddress
+	tranches   []types.IssuanceTranche
+}
+
+func (s *MsgServerTestSuite) newIssuanceFixture() *issuanceFixture {
+	s.blockStartTime = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(s.blockStartTime).WithBlockHeight(10)
+
+	unlockTime := s.blockStartTime.Add(-1 * time.Hour)
+	f := &issuanceFixture{
+		MsgServerTestSuite: s,
+		denom:              "issuecoin",
+		markerAddr:         types.MustGetMarkerAddress("issuecoin"),
+		admin:              sdk.AccAddress("issuance_admin______"),
+		tranches: []types.IssuanceTranche{
+			{Amount: sdkmath.NewInt(100), UnlockTime: &unlockTime},
+			{Amount: sdkmath.NewInt(200), UnlockHeight: 20},
+		},
+	}
+
+	marker := newTestMarker(f.denom, types.MarkerType_Coin, 0,
+		types.AccessGrant{Address: f.admin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Mint}},
+	)
+	marker.AllowGovernanceControl = true
+	storeTestMarker(s.T(), s.app, s.ctx, marker)
+	return f
+}
+
+func (f *issuanceFixture) coin(amount int64) sdk.Coin {
+	return sdk.NewInt64Coin(f.denom, amount)
+}
+
+func (s *MsgServerTestSuite) TestIssuanceSchedule() {
+	f := s.newIssuanceFixture()
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	msg := types.NewMsgSetIssuanceScheduleRequest(f.denom, sdkmath.NewInt(250), f.tranches, f.admin.String())
+	_, err := s.msgServer.SetIssuanceSchedule(ctx, msg)
+	s.Require().NoError(err, "SetIssuanceSchedule")
+	expEvent, err := sdk.TypedEventToEvent(&types.EventMarkerSetIssuanceSchedule{
+		Denom:         f.denom,
+		HardCap:       "250",
+		Scheduled:     "300",
+		TrancheCount:  "2",
+		Administrator: f.admin.String(),
+	})
+	s.Require().NoError(err, "TypedEventToEvent")
+	s.Assert().Contains(ctx.EventManager().Events(), expEvent, "emitted events")
+
+	err = s.app.MarkerKeeper.MintCoin(s.ctx, f.admin, f.coin(150))
+	s.Assert().EqualError(err, "cannot mint 150issuecoin: only 100 is mintable now under the issuance schedule", "MintCoin more than unlocked")
+	s.Require().NoError(s.app.MarkerKeeper.MintCoin(s.ctx, f.admin, f.coin(100)), "MintCoin unlocked amount")
+	err = s.app.MarkerKeeper.MintCoin(s.ctx, f.admin, f.coin(1))
+	s.Assert().EqualError(err, "cannot mint 1issuecoin: only 0 is mintable now under the issuance schedule", "MintCoin after the unlocked amount was issued")
+
+	resp, err := s.app.MarkerKeeper.IssuanceSchedule(s.ctx, &types.QueryIssuanceScheduleRequest{Id: f.denom})
+	s.Require().NoError(err, "IssuanceSchedule query")
+	s.Assert().Equal(sdkmath.NewInt(100), resp.Schedule.Issued, "issued")
+	s.Assert().Equal(sdkmath.NewInt(100), resp.Supply, "supply")
+	s.Assert().Equal(sdkmath.NewInt(100), resp.Unlocked, "unlocked")
+	s.Assert().Equal(sdkmath.NewInt(200), resp.Scheduled, "scheduled")
+	s.Assert().Equal(sdkmath.ZeroInt(), resp.Mintable, "mintable")
+
+	// Once the second tranche unlocks, the hard cap is what limits minting.
+	later := s.ctx.WithBlockHeight(20)
+	resp, err = s.app.MarkerKeeper.IssuanceSchedule(later, &types.QueryIssuanceScheduleRequest{Id: f.markerAddr.String()})
+	s.Require().NoError(err, "IssuanceSchedule query at the unlock height")
+	s.Assert().Equal(sdkmath.NewInt(300), resp.Unlocked, "unlocked at the unlock height")
+	s.Assert().Equal(sdkmath.NewInt(150), resp.Mintable, "mintable at the unlock height")
+	err = s.app.MarkerKeeper.MintCoin(later, f.admin, f.coin(200))
+	s.Assert().EqualError(err, "requested supply 300 exceeds the issuecoin hard cap of 250", "MintCoin over the hard cap")
+}
+
+func (s *MsgServerTestSuite) TestChangingIssuanceSchedule() {
+	f := s.newIssuanceFixture()
+	_, err := s.msgServer.SetIssuanceSchedule(s.ctx, types.NewMsgSetIssuanceScheduleRequest(f.denom, sdkmath.NewInt(250), nil, sdk.AccAddress("issuance_other______").String()))
+	s.Assert().ErrorContains(err, "does not have ACCESS_ADMIN on issuecoin marker", "SetIssuanceSchedule without access")
+
+	_, err = s.msgServer.SetIssuanceSchedule(s.ctx, types.NewMsgSetIssuanceScheduleRequest(f.denom, sdkmath.NewInt(250), f.tranches, f.admin.String()))
+	s.Require().NoError(err, "SetIssuanceSchedule")
+	s.Require().NoError(s.app.MarkerKeeper.MintCoin(s.ctx, f.admin, f.coin(60)), "MintCoin")
+
+	_, err = s.msgServer.SetIssuanceSchedule(s.ctx, types.NewMsgSetIssuanceScheduleRequest(f.denom, sdkmath.NewInt(500), f.tranches, f.admin.String()))
+	s.Assert().ErrorContains(err, "the issuance schedule of active marker issuecoin can only be changed by governance", "SetIssuanceSchedule change by admin")
+
+	authority := s.app.MarkerKeeper.GetAuthority()
+	_, err = s.msgServer.SetIssuanceSchedule(s.ctx, types.NewMsgSetIssuanceScheduleRequest(f.denom, sdkmath.NewInt(50), f.tranches, authority))
+	s.Assert().ErrorContains(err, "hard cap 50 is less than the current issuecoin supply 60", "SetIssuanceSchedule with hard cap below supply")
+	_, err = s.msgServer.SetIssuanceSchedule(s.ctx, types.NewMsgSetIssuanceScheduleRequest(f.denom, sdkmath.NewInt(80), nil, authority))
+	s.Require().NoError(err, "SetIssuanceSchedule by governance")
+
+	schedule, err := s.app.MarkerKeeper.GetIssuanceSchedule(s.ctx, f.markerAddr)
+	s.Require().NoError(err, "GetIssuanceSchedule")
+	s.Require().NotNil(schedule, "GetIssuanceSchedule")
+	s.Assert().Equal(sdkmath.NewInt(60), schedule.Issued, "issued amount carried over")
+	s.Assert().Empty(schedule.Tranches, "tranches")
+
+	marker, err := s.app.MarkerKeeper.GetMarker(s.ctx, f.markerAddr)
+	s.Require().NoError(err, "GetMarker")
+	err = s.app.MarkerKeeper.IncreaseSupply(s.ctx, marker, f.coin(21))
+	s.Assert().EqualError(err, "requested supply 81 exceeds the issuecoin hard cap of 80", "IncreaseSupply over the hard cap")
+
+	genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	s.Assert().Equal([]types.IssuanceSchedule{*schedule}, genState.IssuanceSchedules, "exported issuance schedules")
+
+	// Removing the hard cap and tranches removes the schedule.
+	_, err = s.msgServer.SetIssuanceSchedule(s.ctx, types.NewMsgSetIssuanceScheduleRequest(f.denom, sdkmath.ZeroInt(), nil, authority))
+	s.Require().NoError(err, "SetIssuanceSchedule removal")
+	schedule, err = s.app.MarkerKeeper.GetIssuanceSchedule(s.ctx, f.markerAddr)
+	s.Require().NoError(err, "GetIssuanceSchedule after removal")
+	s.Assert().Nil(schedule, "GetIssuanceSchedule after removal")
+}
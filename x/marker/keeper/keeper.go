@@ -4,10 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -84,6 +87,167 @@ type Keeper struct {
 	// Key layout: [0x05] → proto(Params)
 	params collections.Item[types.Params]
 
+	// holderFreezes stores freezes of marker coins in holder accounts: key = (markerAddr, holderAddr), value = HolderFreeze.
+	// Key layout: [0x06][len(marker)][marker][len(holder)][holder] → proto(HolderFreeze)
+	holderFreezes collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.HolderFreeze]
+
+	// holderFreezeIndex indexes the holder freezes by holder: key = (holderAddr, markerAddr), value = sentinel.
+	// Key layout: [0x07][len(holder)][holder][len(marker)][marker] → []byte{}
+	holderFreezeIndex collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], bool]
+
+	// holderFreezeExpirations indexes the holder freezes by expiration: key = (expiresAt, markerAddr, holderAddr), value = sentinel.
+	// Key layout: [0x29][expiresAt][len(marker)][marker][len(holder)][holder] → []byte{}
+	holderFreezeExpirations collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+
+	// distributions stores distributions to marker holders: key = distribution id, value = Distribution.
+	// Key layout: [0x08][id (8 bytes)] → proto(Distribution)
+	distributions collections.Map[uint64, types.Distribution]
+
+	// distributionPayments stores the payments that have not been processed yet: key = (id, holderAddr), value = amount.
+	// Key layout: [0x09][id (8 bytes)][len(holder)][holder] → amount
+	distributionPayments collections.Map[collections.Pair[uint64, sdk.AccAddress], sdkmath.Int]
+
+	// distributionClaims stores the payments waiting to be claimed: key = (holderAddr, id), value = amount.
+	// Key layout: [0x0A][len(holder)][holder][id (8 bytes)] → amount
+	distributionClaims collections.Map[collections.Pair[sdk.AccAddress, uint64], sdkmath.Int]
+
+	// pendingDistributionSnapshots indexes the distributions whose holder balances are still being recorded: key = (denom, id), value = sentinel.
+	// Key layout: [0x2A][denom][0x00][id (8 bytes)] → []byte{}
+	pendingDistributionSnapshots collections.Map[collections.Pair[string, uint64], bool]
+
+	// nextDistributionID stores the id to use for the next distribution.
+	// Key layout: [0x0B] → id (8 bytes)
+	nextDistributionID collections.Item[uint64]
+
+	// navHistory stores the history of net asset values: key = (markerAddr, priceDenom, blockTime), value = NetAssetValueRecord.
+	// Key layout: [0x0C][len(marker)][marker][denom][0x00][time] → proto(NetAssetValueRecord)
+	navHistory collections.Map[collections.Triple[sdk.AccAddress, string, time.Time], types.NetAssetValueRecord]
+
+	// fixedSupplyMarkers indexes the active markers that have a fixed supply: key = markerAddr, value = sentinel.
+	// Key layout: [0x0D][len(marker)][marker] → []byte{}
+	fixedSupplyMarkers collections.Map[sdk.AccAddress, bool]
+
+	// supplyCheckQueue holds the markers whose supply needs to be reconciled in the next begin block: key = markerAddr, value = sentinel.
+	// Key layout: [0x0E][len(marker)][marker] → []byte{}
+	supplyCheckQueue collections.Map[sdk.AccAddress, bool]
+
+	// destroyedMarkers indexes the markers waiting to be removed in the next begin block: key = markerAddr, value = sentinel.
+	// Key layout: [0x0F][len(marker)][marker] → []byte{}
+	destroyedMarkers collections.Map[sdk.AccAddress, bool]
+
+	// redemptions stores the pending redemptions of marker coins: key = redemption id, value = Redemption.
+	// Key layout: [0x10][id (8 bytes)] → proto(Redemption)
+	redemptions collections.Map[uint64, types.Redemption]
+
+	// redemptionsByMarker indexes the pending redemptions by marker: key = (markerAddr, id), value = sentinel.
+	// Key layout: [0x11][len(marker)][marker][id (8 bytes)] → []byte{}
+	redemptionsByMarker collections.Map[collections.Pair[sdk.AccAddress, uint64], bool]
+
+	// redemptionsByHolder indexes the pending redemptions by holder: key = (holderAddr, id), value = sentinel.
+	// Key layout: [0x12][len(holder)][holder][id (8 bytes)] → []byte{}
+	redemptionsByHolder collections.Map[collections.Pair[sdk.AccAddress, uint64], bool]
+
+	// nextRedemptionID stores the id to use for the next redemption.
+	// Key layout: [0x13] → id (8 bytes)
+	nextRedemptionID collections.Item[uint64]
+
+	// redemptionPayoutDenoms stores the denom each marker's redemptions are paid out in: key = markerAddr, value = payout denom.
+	// Key layout: [0x14][len(marker)][marker] → denom
+	redemptionPayoutDenoms collections.Map[sdk.AccAddress, string]
+
+	// transferLimits stores the transfer limits of restricted markers: key = markerAddr, value = TransferLimits.
+	// Key layout: [0x15][len(marker)][marker] → proto(TransferLimits)
+	transferLimits collections.Map[sdk.AccAddress, types.TransferLimits]
+
+	// holderTransferVolumes stores the hourly amounts sent by each holder: key = (markerAddr, holderAddr, hour), value = amount.
+	// Key layout: [0x16][len(marker)][marker][len(holder)][holder][hour (8 bytes)] → amount
+	holderTransferVolumes collections.Map[collections.Triple[sdk.AccAddress, sdk.AccAddress, uint64], sdkmath.Int]
+
+	// markerTransferVolumes stores the hourly amounts sent by all holders: key = (markerAddr, hour), value = amount.
+	// Key layout: [0x17][len(marker)][marker][hour (8 bytes)] → amount
+	markerTransferVolumes collections.Map[collections.Pair[sdk.AccAddress, uint64], sdkmath.Int]
+
+	// accessGrantTerms stores the terms of marker access grants: key = (markerAddr, grantee), value = AccessGrantTerms.
+	// Key layout: [0x18][len(marker)][marker][len(grantee)][grantee] → proto(AccessGrantTerms)
+	accessGrantTerms collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.AccessGrantTerms]
+
+	// accessGrantExpirations indexes the access grant terms by expiration: key = (expiresAt, markerAddr, grantee), value = sentinel.
+	// Key layout: [0x2B][expiresAt][len(marker)][marker][len(grantee)][grantee] → []byte{}
+	accessGrantExpirations collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+
+	// accessGrantMintVolumes stores the hourly amounts minted by each grantee: key = (markerAddr, grantee, hour), value = amount.
+	// Key layout: [0x19][len(marker)][marker][len(grantee)][grantee][hour (8 bytes)] → amount
+	accessGrantMintVolumes collections.Map[collections.Triple[sdk.AccAddress, sdk.AccAddress, uint64], sdkmath.Int]
+
+	// approvalThresholds stores the approvals needed for each marker permission: key = (markerAddr, permission), value = threshold.
+	// Key layout: [0x1A][len(marker)][marker][permission (4 bytes)] → threshold (4 bytes)
+	approvalThresholds collections.Map[collections.Pair[sdk.AccAddress, types.Access], uint32]
+
+	// pendingOperations stores the operations waiting for approvals: key = operation id, value = PendingOperation.
+	// Key layout: [0x1B][id (8 bytes)] → proto(PendingOperation)
+	pendingOperations collections.Map[uint64, types.PendingOperation]
+
+	// pendingOperationsByMarker indexes the pending operations by marker: key = (markerAddr, id), value = sentinel.
+	// Key layout: [0x1C][len(marker)][marker][id (8 bytes)] → []byte{}
+	pendingOperationsByMarker collections.Map[collections.Pair[sdk.AccAddress, uint64], bool]
+
+	// nextPendingOperationID stores the id to use for the next pending operation.
+	// Key layout: [0x1D] → id (8 bytes)
+	nextPendingOperationID collections.Item[uint64]
+
+	// attributeRequirements stores the attribute value requirements of restricted markers: key = markerAddr, value = AttributeRequirements.
+	// Key layout: [0x1E][len(marker)][marker] → proto(AttributeRequirements)
+	attributeRequirements collections.Map[sdk.AccAddress, types.AttributeRequirements]
+
+	// holderSnapshots stores the holder snapshots recorded for markers: key = snapshot id, value = HolderSnapshot.
+	// Key layout: [0x1F][id (8 bytes)] → proto(HolderSnapshot)
+	holderSnapshots collections.Map[uint64, types.HolderSnapshot]
+
+	// holderSnapshotsByMarker indexes the holder snapshots by marker: key = (markerAddr, id), value = sentinel.
+	// Key layout: [0x20][len(marker)][marker][id (8 bytes)] → []byte{}
+	holderSnapshotsByMarker collections.Map[collections.Pair[sdk.AccAddress, uint64], bool]
+
+	// holderSnapshotEntries stores the cap table of each holder snapshot: key = (id, holderAddr), value = CapTableEntry.
+	// Key layout: [0x21][id (8 bytes)][len(holder)][holder] → proto(CapTableEntry)
+	holderSnapshotEntries collections.Map[collections.Pair[uint64, sdk.AccAddress], types.CapTableEntry]
+
+	// pendingHolderSnapshots indexes the holder snapshots whose cap table entries are still being recorded: key = (denom, id), value = sentinel.
+	// Key layout: [0x2D][denom][0x00][id (8 bytes)] → []byte{}
+	pendingHolderSnapshots collections.Map[collections.Pair[string, uint64], bool]
+
+	// nextHolderSnapshotID stores the id to use for the next holder snapshot.
+	// Key layout: [0x22] → id (8 bytes)
+	nextHolderSnapshotID collections.Item[uint64]
+
+	// forcedTransfers stores the audit records of forced transfers: key = record id, value = ForcedTransferRecord.
+	// Key layout: [0x23][id (8 bytes)] → proto(ForcedTransferRecord)
+	forcedTransfers collections.Map[uint64, types.ForcedTransferRecord]
+
+	// forcedTransfersByMarker indexes the forced transfer records by marker: key = (markerAddr, id), value = sentinel.
+	// Key layout: [0x24][len(marker)][marker][id (8 bytes)] → []byte{}
+	forcedTransfersByMarker collections.Map[collections.Pair[sdk.AccAddress, uint64], bool]
+
+	// forcedTransfersByAccount indexes the forced transfer records by the account the coins were taken from:
+	// key = (fromAddr, id), value = sentinel.
+	// Key layout: [0x25][len(from)][from][id (8 bytes)] → []byte{}
+	forcedTransfersByAccount collections.Map[collections.Pair[sdk.AccAddress, uint64], bool]
+
+	// nextForcedTransferID stores the id to use for the next forced transfer record.
+	// Key layout: [0x26] → id (8 bytes)
+	nextForcedTransferID collections.Item[uint64]
+
+	// issuanceSchedules stores the issuance schedules of markers: key = markerAddr, value = IssuanceSchedule.
+	// Key layout: [0x27][len(marker)][marker] → proto(IssuanceSchedule)
+	issuanceSchedules collections.Map[sdk.AccAddress, types.IssuanceSchedule]
+
+	// navMaxAges stores the number of blocks after which a marker's NAVs are stale: key = markerAddr, value = max age.
+	// Key layout: [0x28][len(marker)][marker] → uint64
+	navMaxAges collections.Map[sdk.AccAddress, uint64]
+
+	// navStaleQueue indexes the NAVs by the height they become stale at: key = (staleHeight, markerAddr, priceDenom), value = sentinel.
+	// Key layout: [0x2C][staleHeight (8 bytes)][len(marker)][marker][denom] → []byte{}
+	navStaleQueue collections.Map[collections.Triple[uint64, sdk.AccAddress, string], bool]
+
 	// the signing authority for the gov proposals
 	authority string
 
@@ -108,6 +272,16 @@ type Keeper struct {
 
 	// exchangeKeeper is an optional keeper for committing funds to exchange markets.
 	exchangeKeeper types.ExchangeKeeper
+
+	// sanctionKeeper is an optional keeper used to identify sanctioned distribution recipients.
+	sanctionKeeper types.SanctionKeeper
+
+	// quarantineKeeper is an optional keeper used to identify quarantined distribution recipients
+	// and to look up the quarantined amounts of cap table holders.
+	quarantineKeeper types.QuarantineKeeper
+
+	// holdKeeper is an optional keeper used to look up the held amounts of cap table holders.
+	holdKeeper types.HoldKeeper
 }
 
 // NewKeeper returns a marker keeper. It handles:
@@ -177,6 +351,281 @@ func NewKeeper(
 			"params",
 			codec.CollValue[types.Params](cdc),
 		),
+		holderFreezes: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.HolderFreezePrefix), // [0x06]
+			"holder_freezes",
+			pairAddrCodec,
+			codec.CollValue[types.HolderFreeze](cdc),
+		),
+		holderFreezeIndex: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.HolderFreezeIndexPrefix), // [0x07]
+			"holder_freeze_index",
+			pairAddrCodec,
+			types.SentinelValue,
+		),
+		holderFreezeExpirations: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.HolderFreezeExpirationPrefix), // [0x29]
+			"holder_freeze_expirations",
+			collections.TripleKeyCodec(sdk.TimeKey, addrCodec, addrCodec),
+			types.SentinelValue,
+		),
+		distributions: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.DistributionPrefix), // [0x08]
+			"distributions",
+			collections.Uint64Key,
+			codec.CollValue[types.Distribution](cdc),
+		),
+		distributionPayments: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.DistributionPaymentPrefix), // [0x09]
+			"distribution_payments",
+			collections.PairKeyCodec(collections.Uint64Key, addrCodec),
+			sdk.IntValue,
+		),
+		distributionClaims: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.DistributionClaimPrefix), // [0x0A]
+			"distribution_claims",
+			collections.PairKeyCodec(addrCodec, collections.Uint64Key),
+			sdk.IntValue,
+		),
+		pendingDistributionSnapshots: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.PendingDistributionSnapshotPrefix), // [0x2A]
+			"pending_distribution_snapshots",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			types.SentinelValue,
+		),
+		nextDistributionID: collections.NewItem(
+			sb,
+			collections.NewPrefix(types.NextDistributionIDKey), // [0x0B]
+			"next_distribution_id",
+			collections.Uint64Value,
+		),
+		navHistory: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.NetAssetValueHistoryPrefix), // [0x0C]
+			"net_asset_value_history",
+			collections.TripleKeyCodec(addrCodec, collections.StringKey, sdk.TimeKey),
+			codec.CollValue[types.NetAssetValueRecord](cdc),
+		),
+		fixedSupplyMarkers: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.FixedSupplyMarkerPrefix), // [0x0D]
+			"fixed_supply_markers",
+			addrCodec,
+			types.SentinelValue,
+		),
+		supplyCheckQueue: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.SupplyCheckQueuePrefix), // [0x0E]
+			"supply_check_queue",
+			addrCodec,
+			types.SentinelValue,
+		),
+		destroyedMarkers: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.DestroyedMarkerPrefix), // [0x0F]
+			"destroyed_markers",
+			addrCodec,
+			types.SentinelValue,
+		),
+		redemptions: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.RedemptionPrefix), // [0x10]
+			"redemptions",
+			collections.Uint64Key,
+			codec.CollValue[types.Redemption](cdc),
+		),
+		redemptionsByMarker: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.RedemptionMarkerIndexPrefix), // [0x11]
+			"redemptions_by_marker",
+			collections.PairKeyCodec(addrCodec, collections.Uint64Key),
+			types.SentinelValue,
+		),
+		redemptionsByHolder: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.RedemptionHolderIndexPrefix), // [0x12]
+			"redemptions_by_holder",
+			collections.PairKeyCodec(addrCodec, collections.Uint64Key),
+			types.SentinelValue,
+		),
+		nextRedemptionID: collections.NewItem(
+			sb,
+			collections.NewPrefix(types.NextRedemptionIDKey), // [0x13]
+			"next_redemption_id",
+			collections.Uint64Value,
+		),
+		redemptionPayoutDenoms: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.RedemptionPayoutDenomPrefix), // [0x14]
+			"redemption_payout_denoms",
+			addrCodec,
+			collections.StringValue,
+		),
+		transferLimits: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.TransferLimitsPrefix), // [0x15]
+			"transfer_limits",
+			addrCodec,
+			codec.CollValue[types.TransferLimits](cdc),
+		),
+		holderTransferVolumes: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.HolderTransferVolumePrefix), // [0x16]
+			"holder_transfer_volumes",
+			collections.TripleKeyCodec(addrCodec, addrCodec, collections.Uint64Key),
+			sdk.IntValue,
+		),
+		markerTransferVolumes: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.MarkerTransferVolumePrefix), // [0x17]
+			"marker_transfer_volumes",
+			collections.PairKeyCodec(addrCodec, collections.Uint64Key),
+			sdk.IntValue,
+		),
+		accessGrantTerms: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.AccessGrantTermsPrefix), // [0x18]
+			"access_grant_terms",
+			pairAddrCodec,
+			codec.CollValue[types.AccessGrantTerms](cdc),
+		),
+		accessGrantExpirations: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.AccessGrantExpirationPrefix), // [0x2B]
+			"access_grant_expirations",
+			collections.TripleKeyCodec(sdk.TimeKey, addrCodec, addrCodec),
+			types.SentinelValue,
+		),
+		accessGrantMintVolumes: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.AccessGrantMintVolumePrefix), // [0x19]
+			"access_grant_mint_volumes",
+			collections.TripleKeyCodec(addrCodec, addrCodec, collections.Uint64Key),
+			sdk.IntValue,
+		),
+		approvalThresholds: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.ApprovalThresholdPrefix), // [0x1A]
+			"approval_thresholds",
+			collections.PairKeyCodec(addrCodec, collcodec.NewInt32Key[types.Access]()),
+			collections.Uint32Value,
+		),
+		pendingOperations: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.PendingOperationPrefix), // [0x1B]
+			"pending_operations",
+			collections.Uint64Key,
+			codec.CollValue[types.PendingOperation](cdc),
+		),
+		pendingOperationsByMarker: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.PendingOperationMarkerIndexPrefix), // [0x1C]
+			"pending_operations_by_marker",
+			collections.PairKeyCodec(addrCodec, collections.Uint64Key),
+			types.SentinelValue,
+		),
+		nextPendingOperationID: collections.NewItem(
+			sb,
+			collections.NewPrefix(types.NextPendingOperationIDKey), // [0x1D]
+			"next_pending_operation_id",
+			collections.Uint64Value,
+		),
+		attributeRequirements: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.AttributeRequirementsPrefix), // [0x1E]
+			"attribute_requirements",
+			addrCodec,
+			codec.CollValue[types.AttributeRequirements](cdc),
+		),
+		holderSnapshots: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.HolderSnapshotPrefix), // [0x1F]
+			"holder_snapshots",
+			collections.Uint64Key,
+			codec.CollValue[types.HolderSnapshot](cdc),
+		),
+		holderSnapshotsByMarker: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.HolderSnapshotMarkerIndexPrefix), // [0x20]
+			"holder_snapshots_by_marker",
+			collections.PairKeyCodec(addrCodec, collections.Uint64Key),
+			types.SentinelValue,
+		),
+		holderSnapshotEntries: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.HolderSnapshotEntryPrefix), // [0x21]
+			"holder_snapshot_entries",
+			collections.PairKeyCodec(collections.Uint64Key, addrCodec),
+			codec.CollValue[types.CapTableEntry](cdc),
+		),
+		pendingHolderSnapshots: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.PendingHolderSnapshotPrefix), // [0x2D]
+			"pending_holder_snapshots",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			types.SentinelValue,
+		),
+		nextHolderSnapshotID: collections.NewItem(
+			sb,
+			collections.NewPrefix(types.NextHolderSnapshotIDKey), // [0x22]
+			"next_holder_snapshot_id",
+			collections.Uint64Value,
+		),
+		forcedTransfers: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.ForcedTransferPrefix), // [0x23]
+			"forced_transfers",
+			collections.Uint64Key,
+			codec.CollValue[types.ForcedTransferRecord](cdc),
+		),
+		forcedTransfersByMarker: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.ForcedTransferMarkerIndexPrefix), // [0x24]
+			"forced_transfers_by_marker",
+			collections.PairKeyCodec(addrCodec, collections.Uint64Key),
+			types.SentinelValue,
+		),
+		forcedTransfersByAccount: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.ForcedTransferAccountIndexPrefix), // [0x25]
+			"forced_transfers_by_account",
+			collections.PairKeyCodec(addrCodec, collections.Uint64Key),
+			types.SentinelValue,
+		),
+		nextForcedTransferID: collections.NewItem(
+			sb,
+			collections.NewPrefix(types.NextForcedTransferIDKey), // [0x26]
+			"next_forced_transfer_id",
+			collections.Uint64Value,
+		),
+		issuanceSchedules: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.IssuanceSchedulePrefix), // [0x27]
+			"issuance_schedules",
+			addrCodec,
+			codec.CollValue[types.IssuanceSchedule](cdc),
+		),
+		navMaxAges: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.NetAssetValueMaxAgePrefix), // [0x28]
+			"nav_max_ages",
+			addrCodec,
+			collections.Uint64Value,
+		),
+		navStaleQueue: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.NetAssetValueStaleQueuePrefix), // [0x2C]
+			"nav_stale_queue",
+			collections.TripleKeyCodec(collections.Uint64Key, addrCodec, collections.StringKey),
+			types.SentinelValue,
+		),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -184,6 +633,7 @@ func NewKeeper(
 	}
 	rv.schema = schema
 	bankKeeper.AppendSendRestriction(rv.SendRestrictionFn)
+	bankKeeper.AppendLockedCoinsGetter(rv.GetLockedCoins)
 	return rv
 }
 
@@ -194,6 +644,24 @@ func (k *Keeper) SetExchangeKeeper(ek types.ExchangeKeeper) {
 	k.exchangeKeeper = ek
 }
 
+// SetSanctionKeeper sets the sanction keeper used to identify sanctioned distribution recipients.
+// This must be called after the sanction keeper is constructed since it is created after the marker keeper.
+func (k *Keeper) SetSanctionKeeper(sk types.SanctionKeeper) {
+	k.sanctionKeeper = sk
+}
+
+// SetQuarantineKeeper sets the quarantine keeper used to identify quarantined distribution recipients.
+// This must be called after the quarantine keeper is constructed since it is created after the marker keeper.
+func (k *Keeper) SetQuarantineKeeper(qk types.QuarantineKeeper) {
+	k.quarantineKeeper = qk
+}
+
+// SetHoldKeeper sets the hold keeper used to look up the held amounts of cap table holders.
+// This must be called after the hold keeper is constructed since it is created after the marker keeper.
+func (k *Keeper) SetHoldKeeper(hk types.HoldKeeper) {
+	k.holdKeeper = hk
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -225,11 +693,17 @@ func (k Keeper) SetMarker(ctx sdk.Context, marker types.MarkerAccountI) error {
 	if err := marker.Validate(); err != nil {
 		return err
 	}
+	// Only queue a supply check if something that affects the supply changed.
+	prev, _ := k.GetMarker(ctx, marker.GetAddress())
+	supplyChanged := prev == nil || prev.GetStatus() != marker.GetStatus() ||
+		prev.HasFixedSupply() != marker.HasFixedSupply() || !prev.GetSupply().Equal(marker.GetSupply())
+
 	k.authKeeper.SetAccount(ctx, marker)
 	if err := k.markers.Set(ctx, marker.GetAddress(), marker.GetAddress()); err != nil {
 		panic(fmt.Errorf("failed to set marker index: %w", err))
 	}
-	return nil
+	k.updateSupplyIndexes(ctx, marker, supplyChanged)
+	return k.removeRevokedAccessGrantTerms(ctx, prev, marker)
 }
 
 // RemoveMarker removes a marker from the auth account store. Note: if the account holds coins this will
@@ -237,10 +711,24 @@ func (k Keeper) SetMarker(ctx sdk.Context, marker types.MarkerAccountI) error {
 func (k Keeper) RemoveMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	k.authKeeper.RemoveAccount(ctx, marker)
 	k.RemoveNetAssetValues(ctx, marker.GetAddress())
+	if err := k.SetNetAssetValueMaxAge(ctx, marker.GetAddress(), 0); err != nil {
+		panic(err)
+	}
 	k.ClearSendDeny(ctx, marker.GetAddress())
+	k.ClearHolderFreezes(ctx, marker.GetAddress())
+	k.ClearAccessGrantTerms(ctx, marker.GetAddress())
+	k.ClearApprovals(ctx, marker.GetAddress())
+	if err := k.RemoveAttributeRequirements(ctx, marker.GetAddress()); err != nil {
+		panic(err)
+	}
+	k.ClearHolderSnapshots(ctx, marker.GetAddress())
+	if err := k.RemoveIssuanceSchedule(ctx, marker.GetAddress()); err != nil {
+		panic(err)
+	}
 	if err := k.markers.Remove(ctx, marker.GetAddress()); err != nil {
 		panic(fmt.Errorf("failed to remove marker index: %w", err))
 	}
+	k.removeSupplyIndexes(ctx, marker.GetAddress())
 }
 
 // IterateMarkers iterates all markers with the given handler function.
@@ -368,6 +856,7 @@ func (k Keeper) AddSetNetAssetValues(ctx sdk.Context, marker types.MarkerAccount
 // SetNetAssetValue adds/updates a net asset value to marker
 func (k Keeper) SetNetAssetValue(ctx sdk.Context, marker types.MarkerAccountI, netAssetValue types.NetAssetValue, source string) error {
 	netAssetValue.UpdatedBlockHeight = uint64(ctx.BlockHeight()) //nolint:gosec // G115: ctx.BlockHeight() is always non-negative.
+	netAssetValue.Stale = false
 	if err := netAssetValue.Validate(); err != nil {
 		return err
 	}
@@ -377,12 +866,41 @@ func (k Keeper) SetNetAssetValue(ctx sdk.Context, marker types.MarkerAccountI, n
 		return err
 	}
 
-	return k.navs.Set(ctx, collections.Join(marker.GetAddress(), netAssetValue.Price.Denom), netAssetValue)
+	if err := k.setNetAssetValue(ctx, marker.GetAddress(), netAssetValue); err != nil {
+		return err
+	}
+
+	return k.addNetAssetValueRecord(ctx, marker.GetAddress(), netAssetValue, source)
+}
+
+// setNetAssetValue stores a marker's net asset value and moves it to the height it now becomes stale at in the stale queue.
+func (k Keeper) setNetAssetValue(ctx sdk.Context, markerAddr sdk.AccAddress, netAssetValue types.NetAssetValue) error {
+	maxAge, err := k.GetNetAssetValueMaxAge(ctx, markerAddr)
+	if err != nil {
+		return err
+	}
+	key := collections.Join(markerAddr, netAssetValue.Price.Denom)
+	existing, err := k.navs.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+	case err != nil:
+		return fmt.Errorf("could not read nav for marker %s with price denom %q: %w", markerAddr, netAssetValue.Price.Denom, err)
+	default:
+		if err = k.dequeueNetAssetValueStaleness(ctx, markerAddr, existing, maxAge); err != nil {
+			return err
+		}
+	}
+
+	if err = k.navs.Set(ctx, key, netAssetValue); err != nil {
+		return err
+	}
+	return k.queueNetAssetValueStaleness(ctx, markerAddr, netAssetValue, maxAge)
 }
 
 // SetNetAssetValueWithBlockHeight adds/updates a net asset value to marker with a specific block height
 func (k Keeper) SetNetAssetValueWithBlockHeight(ctx sdk.Context, marker types.MarkerAccountI, netAssetValue types.NetAssetValue, source string, blockHeight uint64) error {
 	netAssetValue.UpdatedBlockHeight = blockHeight
+	netAssetValue.Stale = false
 	if err := netAssetValue.Validate(); err != nil {
 		return err
 	}
@@ -392,10 +910,11 @@ func (k Keeper) SetNetAssetValueWithBlockHeight(ctx sdk.Context, marker types.Ma
 		return err
 	}
 
-	return k.navs.Set(ctx, collections.Join(marker.GetAddress(), netAssetValue.Price.Denom), netAssetValue)
+	return k.setNetAssetValue(ctx, marker.GetAddress(), netAssetValue)
 }
 
 // GetNetAssetValue gets the NetAssetValue for a marker denom with a specific price denom.
+// The result is flagged as stale if it is older than the marker's net asset value max age.
 func (k Keeper) GetNetAssetValue(ctx sdk.Context, markerDenom, priceDenom string) (*types.NetAssetValue, error) {
 	markerAddr, err := types.MarkerAddress(markerDenom)
 	if err != nil {
@@ -410,6 +929,12 @@ func (k Keeper) GetNetAssetValue(ctx sdk.Context, markerDenom, priceDenom string
 		return nil, fmt.Errorf("could not read nav for marker %q with price denom %q: %w", markerDenom, priceDenom, err)
 	}
 
+	maxAge, err := k.GetNetAssetValueMaxAge(ctx, markerAddr)
+	if err != nil {
+		return nil, err
+	}
+	markerNav.Stale = markerNav.IsStale(maxAge, ctx.BlockHeight())
+
 	return &markerNav, nil
 }
 
@@ -430,20 +955,30 @@ func (k Keeper) IterateAllNetAssetValues(ctx sdk.Context, handler func(sdk.AccAd
 
 // RemoveNetAssetValues removes all net asset values for a marker
 func (k Keeper) RemoveNetAssetValues(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	maxAge, err := k.GetNetAssetValueMaxAge(ctx, markerAddr)
+	if err != nil {
+		panic(err)
+	}
 	rng := collections.NewPrefixedPairRange[sdk.AccAddress, string](markerAddr)
 	var keys []collections.Pair[sdk.AccAddress, string]
-	err := k.navs.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, string], _ types.NetAssetValue) (bool, error) {
+	var navs []types.NetAssetValue
+	err = k.navs.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, string], nav types.NetAssetValue) (bool, error) {
 		keys = append(keys, key)
+		navs = append(navs, nav)
 		return false, nil
 	})
 	if err != nil {
 		panic(err)
 	}
-	for _, key := range keys {
-		if err := k.navs.Remove(ctx, key); err != nil {
+	for i, key := range keys {
+		if err = k.navs.Remove(ctx, key); err != nil {
+			panic(err)
+		}
+		if err = k.dequeueNetAssetValueStaleness(ctx, markerAddr, navs[i], maxAge); err != nil {
 			panic(err)
 		}
 	}
+	k.RemoveNetAssetValueHistory(ctx, markerAddr)
 }
 
 // GetReqAttrBypassAddrs returns a deep copy of the addresses that bypass the required attributes checking.
@@ -66,6 +66,39 @@ func getAllMarkerHolders(t *testing.T, ctx context.Context, app *simapp.App, den
 	return rv
 }
 
+// newTestMarker creates an active marker with the given type, supply and access grants.
+// Other fields can be set on it before it is stored using storeTestMarker.
+func newTestMarker(denom string, markerType types.MarkerType, supply int64, grants ...types.AccessGrant) *types.MarkerAccount {
+	return &types.MarkerAccount{
+		BaseAccount:   authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+		AccessControl: grants,
+		Status:        types.StatusActive,
+		Denom:         denom,
+		Supply:        sdkmath.NewInt(supply),
+		MarkerType:    markerType,
+	}
+}
+
+// storeTestMarker creates the marker's account and stores the marker.
+func storeTestMarker(t *testing.T, app *simapp.App, ctx sdk.Context, marker *types.MarkerAccount) {
+	app.AccountKeeper.NewAccount(ctx, marker.BaseAccount)
+	require.NoError(t, app.MarkerKeeper.SetMarker(ctx, marker), "SetMarker(%s)", marker.Denom)
+}
+
+// storeTestAccount creates and stores an account for the address with the given sequence.
+// Forced transfers are only allowed out of accounts with a non-zero sequence.
+func storeTestAccount(t *testing.T, app *simapp.App, ctx sdk.Context, addr sdk.AccAddress, sequence uint64) {
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetSequence(sequence), "SetSequence(%d)", sequence)
+	app.AccountKeeper.SetAccount(ctx, acc)
+}
+
+// fundTestAccount gives coins to an address without applying any marker send restrictions.
+func fundTestAccount(t *testing.T, app *simapp.App, ctx sdk.Context, addr sdk.AccAddress, coins ...sdk.Coin) {
+	funds := sdk.NewCoins(coins...)
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, addr, funds), "FundAccount(%s, %s)", addr, funds)
+}
+
 func TestAccountMapperGetSet(t *testing.T) {
 	app := simapp.Setup(t)
 	ctx := app.BaseApp.NewContext(false)
@@ -3218,6 +3251,10 @@ func (d dummyBankKeeper) GetBalance(_ context.Context, _ sdk.AccAddress, _ strin
 	return sdk.Coin{}
 }
 
+func (d dummyBankKeeper) SpendableCoin(_ context.Context, _ sdk.AccAddress, _ string) sdk.Coin {
+	return sdk.Coin{}
+}
+
 func (d dummyBankKeeper) GetSupply(_ context.Context, _ string) sdk.Coin { return sdk.Coin{} }
 
 func (d dummyBankKeeper) DenomOwners(_ context.Context, _ *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error) {
@@ -3242,6 +3279,8 @@ func (d dummyBankKeeper) BurnCoins(_ context.Context, _ string, _ sdk.Coins) err
 
 func (d dummyBankKeeper) AppendSendRestriction(_ banktypes.SendRestrictionFn) {}
 
+func (d dummyBankKeeper) AppendLockedCoinsGetter(_ banktypes.GetLockedCoinsFn) {}
+
 func (d dummyBankKeeper) BlockedAddr(_ sdk.AccAddress) bool { return false }
 
 func (d dummyBankKeeper) GetDenomMetaData(_ context.Context, _ string) (banktypes.Metadata, bool) {
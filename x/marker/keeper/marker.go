@@ -202,6 +202,10 @@ func (k Keeper) WithdrawCoins(
 		recipient = caller
 	}
 
+	if err = k.validateAccessGrantWithdrawAddress(ctx, m, caller, recipient); err != nil {
+		return err
+	}
+
 	if k.bankKeeper.BlockedAddr(recipient) {
 		return fmt.Errorf("%s is not allowed to receive funds", recipient)
 	}
@@ -229,12 +233,21 @@ func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err = m.ValidateAddressHasAccess(caller, types.Access_Mint); err != nil {
 		return err
 	}
+	if err = k.checkAccessGrantMintLimit(ctx, m, caller, coin.Amount); err != nil {
+		return err
+	}
+	if err = k.applyIssuanceSchedule(ctx, m, coin.Amount); err != nil {
+		return err
+	}
 
 	switch {
 	// For proposed, finalized accounts we allow adjusting the total_supply of the marker but we do not
 	// mint actual coin.
 	case m.GetStatus() == types.StatusProposed || m.GetStatus() == types.StatusFinalized:
 		total := m.GetSupply().Add(coin)
+		if err = k.validateHardCap(ctx, m, total.Amount); err != nil {
+			return err
+		}
 		if err = m.SetSupply(total); err != nil {
 			return err
 		}
@@ -361,6 +374,9 @@ func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 		return fmt.Errorf(
 			"requested supply %s exceeds maximum allowed value %s", total.Amount.String(), maxAllowed.Amount.String())
 	}
+	if err := k.validateHardCap(ctx, marker, total.Amount); err != nil {
+		return err
+	}
 
 	// If the marker has a fixed supply then adjust the supply to match the new total
 	if marker.HasFixedSupply() {
@@ -670,6 +686,8 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 		return err
 	}
 
+	// set context to having access to bypass attribute restriction test
+	sendCtx := types.WithBypass(ctx)
 	if !admin.Equals(from) {
 		switch {
 		case !m.AllowsForcedTransfer() || !adminCanForceTransfer:
@@ -684,6 +702,9 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 			}
 		case !k.canForceTransferFrom(ctx, from):
 			return fmt.Errorf("funds are not allowed to be removed from %s", from)
+		default:
+			// A forced transfer can also move funds that are frozen in the from account.
+			sendCtx = types.WithFreezeBypass(sendCtx)
 		}
 	}
 
@@ -691,9 +712,8 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 		return fmt.Errorf("%s is not allowed to receive funds", to)
 	}
 
-	// set context to having access to bypass attribute restriction test
 	// send the coins between accounts (does not check send_enabled on coin denom)
-	if err = k.bankKeeper.SendCoins(types.WithBypass(ctx), from, to, sdk.NewCoins(amount)); err != nil {
+	if err = k.bankKeeper.SendCoins(sendCtx, from, to, sdk.NewCoins(amount)); err != nil {
 		return err
 	}
 
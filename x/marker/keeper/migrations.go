@@ -20,3 +20,11 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	m.keeper.Logger(sdkCtx).Info("migrating marker module from version 2 to 3 (no-op, byte-identity)")
 	return nil
 }
+
+// Migrate3to4 builds the indexes of fixed-supply and destroyed markers used by the begin blocker.
+// The max nav history param didn't exist before version 4, so it's left at zero (i.e. history disabled)
+// until governance changes it.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.Logger(ctx).Info("migrating marker module from version 3 to 4 (building supply indexes)")
+	return m.keeper.RebuildSupplyIndexes(ctx)
+}
@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+)
+
+func TestMigrate3to4MaxNavHistory(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	migrator := markerkeeper.NewMigrator(*app.MarkerKeeper)
+
+	// Params stored before version 4 don't have a max nav history, so it decodes as zero.
+	params := app.MarkerKeeper.GetParams(ctx)
+	params.MaxNavHistory = 0
+	app.MarkerKeeper.SetParams(ctx, params)
+	require.Equal(t, uint32(0), app.MarkerKeeper.GetMaxNavHistory(ctx), "GetMaxNavHistory before Migrate3to4")
+
+	require.NoError(t, migrator.Migrate3to4(ctx), "Migrate3to4")
+	assert.Equal(t, uint32(0), app.MarkerKeeper.GetMaxNavHistory(ctx), "GetMaxNavHistory after Migrate3to4")
+	assert.Equal(t, params, app.MarkerKeeper.GetParams(ctx), "GetParams after Migrate3to4")
+
+	// A value that has already been set is kept.
+	params.MaxNavHistory = 7
+	app.MarkerKeeper.SetParams(ctx, params)
+	require.NoError(t, migrator.Migrate3to4(ctx), "Migrate3to4 with max nav history set")
+	assert.Equal(t, uint32(7), app.MarkerKeeper.GetMaxNavHistory(ctx), "GetMaxNavHistory after Migrate3to4 with it set")
+}
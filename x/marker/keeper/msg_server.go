@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-metrics"
 
@@ -267,6 +268,19 @@ func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMintRequest) (*type
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	// A mint to a recipient is also a withdrawal, so it needs the approvals required for either.
+	permissions := []types.Access{types.Access_Mint}
+	if len(msg.Recipient) > 0 {
+		permissions = append(permissions, types.Access_Withdraw)
+	}
+	opID, err := k.proposeOperation(ctx, msg.Amount.Denom, msg.Administrator, msg, permissions...)
+	if err != nil {
+		return nil, err
+	}
+	if opID != 0 {
+		return &types.MsgMintResponse{PendingOperationId: opID}, nil
+	}
+
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
 	if err := k.MintCoin(ctx, admin, msg.Amount); err != nil {
@@ -352,6 +366,14 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdrawRequest
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	opID, err := k.proposeOperation(ctx, msg.Denom, msg.Administrator, msg, types.Access_Withdraw)
+	if err != nil {
+		return nil, err
+	}
+	if opID != 0 {
+		return &types.MsgWithdrawResponse{PendingOperationId: opID}, nil
+	}
+
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 	to := sdk.MustAccAddressFromBech32(msg.ToAddress)
 
@@ -404,6 +426,21 @@ func (k msgServer) Transfer(goCtx context.Context, msg *types.MsgTransferRequest
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	isForced := k.isForcedTransfer(ctx, msg)
+	if isForced {
+		if msg.Reason == types.ForcedTransferReason_Unspecified {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("a reason and reference are required to force transfer %s from %s",
+				msg.Amount.Denom, msg.FromAddress)
+		}
+		opID, err := k.proposeOperation(ctx, msg.Amount.Denom, msg.Administrator, msg, types.Access_ForceTransfer)
+		if err != nil {
+			return nil, err
+		}
+		if opID != 0 {
+			return &types.MsgTransferResponse{PendingOperationId: opID}, nil
+		}
+	}
+
 	from := sdk.MustAccAddressFromBech32(msg.FromAddress)
 	to := sdk.MustAccAddressFromBech32(msg.ToAddress)
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
@@ -412,6 +449,11 @@ func (k msgServer) Transfer(goCtx context.Context, msg *types.MsgTransferRequest
 	if err != nil {
 		return nil, err
 	}
+	if isForced {
+		if _, err = k.RecordForcedTransfer(ctx, msg); err != nil {
+			return nil, err
+		}
+	}
 
 	defer func() {
 		telemetry.IncrCounterWithLabels(
@@ -959,3 +1001,478 @@ func (k msgServer) RevokeGrantAllowance(goCtx context.Context, msg *types.MsgRev
 
 	return &types.MsgRevokeGrantAllowanceResponse{}, nil
 }
+
+// FreezeHolder freezes an amount of a restricted marker's coin in a holder's account. Signer must have freeze access.
+func (k msgServer) FreezeHolder(goCtx context.Context, msg *types.MsgFreezeHolderRequest) (*types.MsgFreezeHolderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m, err := k.GetMarkerByDenom(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("marker %s is not a restricted marker", msg.Amount.Denom)
+	}
+	if m.GetStatus() != types.StatusActive {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("marker %s is not active", msg.Amount.Denom)
+	}
+	if err = m.ValidateHasAccess(msg.Administrator, types.Access_Freeze); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+	if !msg.ExpiresAt.After(ctx.BlockTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("expires at %s must be after the current block time %s",
+			msg.ExpiresAt.UTC().Format(time.RFC3339), ctx.BlockTime().UTC().Format(time.RFC3339))
+	}
+
+	freeze := types.HolderFreeze{
+		Denom:         msg.Amount.Denom,
+		Holder:        msg.Holder,
+		Amount:        msg.Amount.Amount,
+		ExpiresAt:     msg.ExpiresAt.UTC(),
+		Reason:        msg.Reason,
+		Administrator: msg.Administrator,
+	}
+	if err = k.SetHolderFreeze(ctx, freeze); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerFreeze(freeze)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeHolderResponse{}, nil
+}
+
+// UnfreezeHolder removes a freeze from a holder's account. Signer must have freeze access.
+func (k msgServer) UnfreezeHolder(goCtx context.Context, msg *types.MsgUnfreezeHolderRequest) (*types.MsgUnfreezeHolderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = m.ValidateHasAccess(msg.Administrator, types.Access_Freeze); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid holder: %v", err)
+	}
+	freeze, err := k.GetHolderFreeze(ctx, m.GetAddress(), holder)
+	if err != nil {
+		return nil, err
+	}
+	if freeze == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no freeze of %s found for %s", msg.Denom, msg.Holder)
+	}
+
+	k.RemoveHolderFreeze(ctx, m.GetAddress(), holder)
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerUnfreeze(msg.Denom, msg.Holder, msg.Administrator)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnfreezeHolderResponse{}, nil
+}
+
+// DistributeToHolders pays out funds to the holders of a marker's coin, pro-rata to their balances.
+// Signer must have admin access.
+func (k msgServer) DistributeToHolders(goCtx context.Context, msg *types.MsgDistributeToHoldersRequest) (*types.MsgDistributeToHoldersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if m.GetStatus() != types.StatusActive {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("marker %s is not active", msg.Denom)
+	}
+	if err = m.ValidateHasAccess(msg.Administrator, types.Access_Admin); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	id, err := k.CreateDistribution(ctx, m, admin, msg.Payout)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgDistributeToHoldersResponse{DistributionId: id}, nil
+}
+
+// ClaimDistribution sends a holder a distribution payment that could not be sent to them automatically.
+func (k msgServer) ClaimDistribution(goCtx context.Context, msg *types.MsgClaimDistributionRequest) (*types.MsgClaimDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid holder: %v", err)
+	}
+	if _, err = k.Keeper.ClaimDistribution(ctx, holder, msg.DistributionId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimDistributionResponse{}, nil
+}
+
+// SetRedemptionPayoutDenom sets the denom that a marker's redemptions are paid out in.
+func (k msgServer) SetRedemptionPayoutDenom(goCtx context.Context, msg *types.MsgSetRedemptionPayoutDenomRequest) (*types.MsgSetRedemptionPayoutDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = m.ValidateHasAccess(msg.Administrator, types.Access_Admin); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+	if err = k.Keeper.SetRedemptionPayoutDenom(ctx, m.GetAddress(), msg.PayoutDenom); err != nil {
+		return nil, err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSetRedemptionPayoutDenom(msg.Denom, msg.PayoutDenom, msg.Administrator)); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetRedemptionPayoutDenomResponse{}, nil
+}
+
+// RequestRedemption escrows a holder's marker coins in the marker module account until the redemption is fulfilled or rejected.
+func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgRequestRedemptionRequest) (*types.MsgRequestRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid holder: %v", err)
+	}
+	m, err := k.GetMarkerByDenom(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	id, err := k.CreateRedemption(ctx, m, holder, msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgRequestRedemptionResponse{RedemptionId: id}, nil
+}
+
+// FulfillRedemption burns the escrowed coins of a redemption and pays out the holder.
+func (k msgServer) FulfillRedemption(goCtx context.Context, msg *types.MsgFulfillRedemptionRequest) (*types.MsgFulfillRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, m, err := k.getRedemptionForAdmin(ctx, msg.RedemptionId, msg.Administrator, types.Access_Burn)
+	if err != nil {
+		return nil, err
+	}
+	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	payout, err := k.Keeper.FulfillRedemption(ctx, *redemption, m, admin, msg.Payout)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgFulfillRedemptionResponse{Payout: payout}, nil
+}
+
+// RejectRedemption returns the escrowed coins of a redemption to the holder.
+func (k msgServer) RejectRedemption(goCtx context.Context, msg *types.MsgRejectRedemptionRequest) (*types.MsgRejectRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, m, err := k.getRedemptionForAdmin(ctx, msg.RedemptionId, msg.Administrator, types.Access_Admin)
+	if err != nil {
+		return nil, err
+	}
+	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	if err = k.Keeper.RejectRedemption(ctx, *redemption, m, admin, msg.Reason); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgRejectRedemptionResponse{}, nil
+}
+
+// SetTransferLimits sets the rolling 24 hour transfer limits of a restricted marker.
+func (k msgServer) SetTransferLimits(goCtx context.Context, msg *types.MsgSetTransferLimitsRequest) (*types.MsgSetTransferLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("marker %s is not a restricted coin", msg.Denom)
+	}
+	if err = m.ValidateHasAccess(msg.Administrator, types.Access_Admin); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	limits := types.TransferLimits{
+		Denom:            msg.Denom,
+		HolderDailyLimit: msg.HolderDailyLimit,
+		MarkerDailyLimit: msg.MarkerDailyLimit,
+	}
+	if err = k.Keeper.SetTransferLimits(ctx, m.GetAddress(), limits); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSetTransferLimits(limits, msg.Administrator)); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetTransferLimitsResponse{}, nil
+}
+
+// SetAccessGrantTerms sets the expiry and limits of an address's access grant on a marker.
+func (k msgServer) SetAccessGrantTerms(goCtx context.Context, msg *types.MsgSetAccessGrantTermsRequest) (*types.MsgSetAccessGrantTermsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = m.ValidateHasAccess(msg.Administrator, types.Access_Admin); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+	if len(types.GrantsForAddress(sdk.MustAccAddressFromBech32(msg.Address), m.GetAccessList()...).Address) == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrapf("%s does not have an access grant on %s marker", msg.Address, msg.Denom)
+	}
+
+	terms := msg.GetTerms()
+	if terms.IsExpired(ctx.BlockTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("expires at %s must be after the current block time %s",
+			terms.ExpiresAt.UTC().Format(time.RFC3339), ctx.BlockTime().UTC().Format(time.RFC3339))
+	}
+	if err = k.Keeper.SetAccessGrantTerms(ctx, terms); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSetAccessGrantTerms(terms, msg.Administrator)); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetAccessGrantTermsResponse{}, nil
+}
+
+// SetApprovalThreshold sets the number of approvals needed for operations that use a permission on a marker.
+func (k msgServer) SetApprovalThreshold(goCtx context.Context, msg *types.MsgSetApprovalThresholdRequest) (*types.MsgSetApprovalThresholdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = m.ValidateHasAccess(msg.Administrator, types.Access_Admin); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+	if err = validateApprovalThreshold(m, msg.Permission, msg.Threshold); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = k.Keeper.SetApprovalThreshold(ctx, m.GetAddress(), msg.Permission, msg.Threshold); err != nil {
+		return nil, err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSetApprovalThreshold(msg.Denom, msg.Permission, msg.Threshold, msg.Administrator)); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetApprovalThresholdResponse{}, nil
+}
+
+// ApproveOperation approves a pending operation, executing it once it has enough approvals.
+func (k msgServer) ApproveOperation(goCtx context.Context, msg *types.MsgApproveOperationRequest) (*types.MsgApproveOperationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	op, err := k.GetPendingOperation(ctx, msg.OperationId)
+	if err != nil {
+		return nil, err
+	}
+	if op == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("pending operation %d not found", msg.OperationId)
+	}
+	m, err := k.GetMarkerByDenom(ctx, op.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = m.ValidateHasAccess(msg.Approver, op.Permission); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	ready, err := k.Keeper.ApproveOperation(ctx, *op, msg.Approver)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if !ready {
+		return &types.MsgApproveOperationResponse{}, nil
+	}
+	if err = k.executeOperation(ctx, *op); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgApproveOperationResponse{Executed: true}, nil
+}
+
+// CancelOperation removes a pending operation without executing it.
+func (k msgServer) CancelOperation(goCtx context.Context, msg *types.MsgCancelOperationRequest) (*types.MsgCancelOperationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	op, err := k.GetPendingOperation(ctx, msg.OperationId)
+	if err != nil {
+		return nil, err
+	}
+	if op == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("pending operation %d not found", msg.OperationId)
+	}
+	if op.Approvers[0] != msg.Signer {
+		m, err := k.GetMarkerByDenom(ctx, op.Denom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		if err = m.ValidateHasAccess(msg.Signer, types.Access_Admin); err != nil {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not the proposer of pending operation %d: %v", msg.Signer, op.Id, err)
+		}
+	}
+
+	if err = k.Keeper.CancelOperation(ctx, *op, msg.Signer); err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelOperationResponse{}, nil
+}
+
+// SetAttributeRequirements sets the attribute value conditions that the recipients of a restricted marker's coins must meet.
+func (k msgServer) SetAttributeRequirements(goCtx context.Context, msg *types.MsgSetAttributeRequirementsRequest) (*types.MsgSetAttributeRequirementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("marker %s is not a restricted coin", msg.Denom)
+	}
+
+	if msg.TransferAuthority == k.GetAuthority() {
+		if !m.HasGovernanceEnabled() {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else if err = m.ValidateHasAccess(msg.TransferAuthority, types.Access_Transfer); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	reqs, err := k.NormalizeAttributeRequirements(ctx, msg.Requirements)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	requirements := types.AttributeRequirements{Denom: msg.Denom, Requirements: reqs}
+	if err = k.Keeper.SetAttributeRequirements(ctx, m.GetAddress(), requirements); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSetAttributeRequirements(requirements, msg.TransferAuthority)); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetAttributeRequirementsResponse{}, nil
+}
+
+// TakeHolderSnapshot starts recording the current cap table of a marker so it can be queried later.
+func (k msgServer) TakeHolderSnapshot(goCtx context.Context, msg *types.MsgTakeHolderSnapshotRequest) (*types.MsgTakeHolderSnapshotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid administrator: %v", err)
+	}
+	m, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if !m.AddressHasAccess(admin, types.Access_Admin) && !m.AddressHasAccess(admin, types.Access_Transfer) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s does not have %s or %s on %s marker",
+			msg.Administrator, types.Access_Admin, types.Access_Transfer, msg.Denom)
+	}
+
+	id, err := k.Keeper.TakeHolderSnapshot(ctx, m, admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgTakeHolderSnapshotResponse{SnapshotId: id}, nil
+}
+
+// SetIssuanceSchedule sets the hard cap and the schedule of amounts that can be minted over time for a marker.
+func (k msgServer) SetIssuanceSchedule(goCtx context.Context, msg *types.MsgSetIssuanceScheduleRequest) (*types.MsgSetIssuanceScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	existing, err := k.GetIssuanceSchedule(ctx, m.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Authority == k.GetAuthority() {
+		if !m.HasGovernanceEnabled() {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else {
+		if err = m.ValidateHasAccess(msg.Authority, types.Access_Admin); err != nil {
+			return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+		}
+		if existing != nil && m.GetStatus() == types.StatusActive {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("the issuance schedule of active marker %s can only be changed by governance", msg.Denom)
+		}
+	}
+
+	schedule := types.IssuanceSchedule{
+		Denom:    msg.Denom,
+		HardCap:  msg.HardCap,
+		Tranches: msg.Tranches,
+		Issued:   sdkmath.ZeroInt(),
+	}
+	if existing != nil {
+		schedule.Issued = existing.Issued
+	}
+	if supply := k.getIssuedSupply(ctx, m); schedule.HasHardCap() && schedule.HardCap.LT(supply) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("hard cap %s is less than the current %s supply %s", schedule.HardCap, msg.Denom, supply)
+	}
+	if err = k.Keeper.SetIssuanceSchedule(ctx, m.GetAddress(), schedule); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSetIssuanceSchedule(schedule, msg.Authority)); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetIssuanceScheduleResponse{}, nil
+}
+
+// SetNetAssetValueMaxAge sets the number of blocks after which a marker's net asset values are stale.
+func (k msgServer) SetNetAssetValueMaxAge(goCtx context.Context, msg *types.MsgSetNetAssetValueMaxAgeRequest) (*types.MsgSetNetAssetValueMaxAgeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if msg.Authority == k.GetAuthority() {
+		if !m.HasGovernanceEnabled() {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else if err = m.ValidateHasAccess(msg.Authority, types.Access_Admin); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	prevMaxAge, err := k.GetNetAssetValueMaxAge(ctx, m.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	if err = k.Keeper.SetNetAssetValueMaxAge(ctx, m.GetAddress(), msg.MaxAge); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventSetNetAssetValueMaxAge(msg.Denom, msg.MaxAge, msg.Authority)); err != nil {
+		return nil, err
+	}
+	// Net asset values that are only stale because of the new max age won't be caught by the begin blocker.
+	k.emitStaleNetAssetValueEvents(ctx, m, msg.MaxAge, func(nav types.NetAssetValue) bool {
+		return nav.IsStale(prevMaxAge, ctx.BlockHeight())
+	})
+	return &types.MsgSetNetAssetValueMaxAgeResponse{}, nil
+}
@@ -1246,6 +1246,186 @@ func (s *MsgServerTestSuite) TestMsgWithdrawMarkerRequest() {
 	}
 }
 
+// addApprovalMarker adds an active coin marker that an admin manages, and that owner1 and owner2 can mint and withdraw.
+func (s *MsgServerTestSuite) addApprovalMarker(denom string, admin sdk.AccAddress) {
+	storeTestMarker(s.T(), s.app, s.ctx, newTestMarker(denom, types.MarkerType_Coin, 0,
+		types.AccessGrant{Address: admin.String(), Permissions: types.AccessList{types.Access_Admin}},
+		types.AccessGrant{Address: s.owner1, Permissions: types.AccessList{types.Access_Mint, types.Access_Withdraw}},
+		types.AccessGrant{Address: s.owner2, Permissions: types.AccessList{types.Access_Mint, types.Access_Withdraw}},
+	))
+}
+
+// setApprovalThreshold sets the number of approvals that a permission needs on a marker.
+func (s *MsgServerTestSuite) setApprovalThreshold(denom string, admin sdk.AccAddress, permission types.Access, threshold uint32) {
+	msg := types.NewMsgSetApprovalThresholdRequest(denom, admin, permission, threshold)
+	_, err := s.msgServer.SetApprovalThreshold(s.ctx, msg)
+	s.Require().NoError(err, "SetApprovalThreshold(%s, %s, %d)", denom, permission, threshold)
+}
+
+// eventTypes gets the types of the provided events.
+func (s *MsgServerTestSuite) eventTypes(events sdk.Events) []string {
+	rv := make([]string, len(events))
+	for i, event := range events {
+		rv[i] = event.Type
+	}
+	return rv
+}
+
+func (s *MsgServerTestSuite) TestMsgSetApprovalThresholdRequest() {
+	denom := "approvecoin"
+	admin := sdk.AccAddress("approve_admin_______")
+	s.addApprovalMarker(denom, admin)
+
+	testcases := []struct {
+		name          string
+		msg           *types.MsgSetApprovalThresholdRequest
+		expErr        string
+		expThresholds []types.ApprovalThreshold
+	}{
+		{
+			name:   "not an admin",
+			msg:    types.NewMsgSetApprovalThresholdRequest(denom, s.owner1Addr, types.Access_Mint, 2),
+			expErr: "does not have ACCESS_ADMIN",
+		},
+		{
+			name:   "more than the permission holders",
+			msg:    types.NewMsgSetApprovalThresholdRequest(denom, admin, types.Access_Mint, 3),
+			expErr: "approval threshold 3 is more than the 2 addresses with ACCESS_MINT on approvecoin marker",
+		},
+		{
+			name:          "set",
+			msg:           types.NewMsgSetApprovalThresholdRequest(denom, admin, types.Access_Mint, 2),
+			expThresholds: []types.ApprovalThreshold{{Denom: denom, Permission: types.Access_Mint, Threshold: 2}},
+		},
+		{
+			name:          "removed by setting it to one",
+			msg:           types.NewMsgSetApprovalThresholdRequest(denom, admin, types.Access_Mint, 1),
+			expThresholds: nil,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.SetApprovalThreshold(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Require().ErrorContains(err, tc.expErr, "SetApprovalThreshold error")
+				return
+			}
+			s.Require().NoError(err, "SetApprovalThreshold error")
+			resp, err := s.app.MarkerKeeper.ApprovalThresholds(s.ctx, &types.QueryApprovalThresholdsRequest{Id: denom})
+			s.Require().NoError(err, "ApprovalThresholds query")
+			if len(tc.expThresholds) == 0 {
+				s.Assert().Empty(resp.Thresholds, "thresholds")
+			} else {
+				s.Assert().Equal(tc.expThresholds, resp.Thresholds, "thresholds")
+			}
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestMsgMintRequestNeedsApproval() {
+	denom := "approvecoin"
+	admin := sdk.AccAddress("approve_admin_______")
+	s.addApprovalMarker(denom, admin)
+	s.setApprovalThreshold(denom, admin, types.Access_Mint, 2)
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	mintResp, err := s.msgServer.Mint(ctx, types.NewMsgMintRequest(s.owner1Addr, sdk.NewInt64Coin(denom, 100), nil))
+	s.Require().NoError(err, "Mint")
+	s.Require().NotZero(mintResp.PendingOperationId, "pending operation id")
+	s.Assert().True(s.app.BankKeeper.GetSupply(s.ctx, denom).IsZero(), "supply after proposing the mint")
+	s.Assert().Contains(s.eventTypes(ctx.EventManager().Events()), "provenance.marker.v1.EventMarkerOperationProposed", "emitted event types")
+
+	opID := mintResp.PendingOperationId
+	_, err = s.msgServer.ApproveOperation(s.ctx, types.NewMsgApproveOperationRequest(opID, s.owner1Addr))
+	s.Assert().ErrorContains(err, "has already approved pending operation", "approve by proposer")
+	_, err = s.msgServer.ApproveOperation(s.ctx, types.NewMsgApproveOperationRequest(opID, admin))
+	s.Assert().ErrorContains(err, "does not have ACCESS_MINT", "approve by admin")
+
+	genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	s.Assert().Len(genState.ApprovalThresholds, 1, "exported approval thresholds")
+	s.Assert().Len(genState.PendingOperations, 1, "exported pending operations")
+
+	approveResp, err := s.msgServer.ApproveOperation(s.ctx, types.NewMsgApproveOperationRequest(opID, s.owner2Addr))
+	s.Require().NoError(err, "approve by second minter")
+	s.Assert().True(approveResp.Executed, "executed")
+	s.Assert().Equal("100", s.app.BankKeeper.GetSupply(s.ctx, denom).Amount.String(), "supply after approval")
+
+	op, err := s.app.MarkerKeeper.GetPendingOperation(s.ctx, opID)
+	s.Require().NoError(err, "GetPendingOperation after execution")
+	s.Assert().Nil(op, "pending operation after execution")
+}
+
+func (s *MsgServerTestSuite) TestMsgWithdrawRequestNeedsApproval() {
+	denom := "approvecoin"
+	admin := sdk.AccAddress("approve_admin_______")
+	other := sdk.AccAddress("approve_other_______")
+	s.addApprovalMarker(denom, admin)
+
+	_, err := s.msgServer.Mint(s.ctx, types.NewMsgMintRequest(s.owner1Addr, sdk.NewInt64Coin(denom, 100), nil))
+	s.Require().NoError(err, "Mint before setting a threshold")
+	s.setApprovalThreshold(denom, admin, types.Access_Withdraw, 2)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	withdrawResp, err := s.msgServer.Withdraw(s.ctx, types.NewMsgWithdrawRequest(s.owner1Addr, other, denom, coins))
+	s.Require().NoError(err, "Withdraw")
+	s.Require().NotZero(withdrawResp.PendingOperationId, "pending operation id")
+	s.Assert().True(s.app.BankKeeper.GetAllBalances(s.ctx, other).IsZero(), "recipient balance after proposing the withdrawal")
+
+	// A mint to a recipient is also a withdrawal.
+	mintResp, err := s.msgServer.Mint(s.ctx, types.NewMsgMintRequest(s.owner1Addr, sdk.NewInt64Coin(denom, 5), other))
+	s.Require().NoError(err, "Mint with a recipient")
+	s.Require().NotZero(mintResp.PendingOperationId, "mint with a recipient pending operation id")
+
+	ctx := types.WithTransferAgents(s.ctx, s.owner1Addr)
+	s.Assert().ErrorContains(s.app.BankKeeper.SendCoins(ctx, types.MustGetMarkerAddress(denom), other, coins),
+		"withdrawals from approvecoin marker require 2 approvals", "bank send by a transfer agent")
+
+	resp, err := s.app.MarkerKeeper.PendingOperations(s.ctx, &types.QueryPendingOperationsRequest{Id: denom})
+	s.Require().NoError(err, "PendingOperations query")
+	s.Assert().Len(resp.Operations, 2, "pending operations")
+
+	_, err = s.msgServer.CancelOperation(s.ctx, types.NewMsgCancelOperationRequest(withdrawResp.PendingOperationId, s.owner2Addr))
+	s.Assert().ErrorContains(err, "is not the proposer of pending operation", "cancel by another signer")
+	_, err = s.msgServer.CancelOperation(s.ctx, types.NewMsgCancelOperationRequest(withdrawResp.PendingOperationId, s.owner1Addr))
+	s.Require().NoError(err, "cancel by proposer")
+	_, err = s.msgServer.CancelOperation(s.ctx, types.NewMsgCancelOperationRequest(mintResp.PendingOperationId, admin))
+	s.Require().NoError(err, "cancel by admin")
+
+	resp, err = s.app.MarkerKeeper.PendingOperations(s.ctx, &types.QueryPendingOperationsRequest{Id: denom})
+	s.Require().NoError(err, "PendingOperations query after cancelling")
+	s.Assert().Empty(resp.Operations, "pending operations after cancelling")
+}
+
+func (s *MsgServerTestSuite) TestMsgApproveOperationRequestExpired() {
+	denom := "approvecoin"
+	admin := sdk.AccAddress("approve_admin_______")
+	s.addApprovalMarker(denom, admin)
+	s.setApprovalThreshold(denom, admin, types.Access_Mint, 2)
+
+	mintResp, err := s.msgServer.Mint(s.ctx, types.NewMsgMintRequest(s.owner1Addr, sdk.NewInt64Coin(denom, 100), nil))
+	s.Require().NoError(err, "Mint")
+	opID := mintResp.PendingOperationId
+	expiresAt := s.ctx.BlockTime().Add(types.PendingOperationLifetime)
+
+	s.app.MarkerKeeper.RemoveExpiredOperations(s.ctx.WithBlockTime(expiresAt.Add(-time.Second)))
+	op, err := s.app.MarkerKeeper.GetPendingOperation(s.ctx, opID)
+	s.Require().NoError(err, "GetPendingOperation before expiry")
+	s.Assert().NotNil(op, "pending operation before expiry")
+
+	ctx := s.ctx.WithBlockTime(expiresAt).WithEventManager(sdk.NewEventManager())
+	s.app.MarkerKeeper.RemoveExpiredOperations(ctx)
+	op, err = s.app.MarkerKeeper.GetPendingOperation(s.ctx, opID)
+	s.Require().NoError(err, "GetPendingOperation after expiry")
+	s.Assert().Nil(op, "pending operation after expiry")
+	s.Assert().Contains(s.eventTypes(ctx.EventManager().Events()), "provenance.marker.v1.EventMarkerOperationExpired", "emitted event types")
+
+	_, err = s.msgServer.ApproveOperation(s.ctx, types.NewMsgApproveOperationRequest(opID, s.owner2Addr))
+	s.Assert().ErrorContains(err, "not found", "approve after expiry")
+	s.Assert().True(s.app.BankKeeper.GetSupply(s.ctx, denom).IsZero(), "supply after expiry")
+}
+
 func (s *MsgServerTestSuite) TestMsgTransferMarkerRequest() {
 	hotdogDenom := "hotdog"
 	access := types.AccessGrant{
@@ -2046,6 +2226,7 @@ func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					types.DefaultMaxNavHistory,
 				),
 			},
 		},
@@ -2057,6 +2238,7 @@ func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					types.DefaultMaxNavHistory,
 				),
 			},
 			expErr: `expected "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn" got "invalidAuthority": expected gov account as only signer for proposal message`,
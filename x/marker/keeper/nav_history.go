@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// navHistoryKey is the key type of the net asset value history.
+type navHistoryKey = collections.Triple[sdk.AccAddress, string, time.Time]
+
+// addNetAssetValueRecord records a net asset value in the marker's history, then removes the oldest
+// entries (for that price denom) beyond the max nav history param. Nothing is recorded if that param is zero.
+func (k Keeper) addNetAssetValueRecord(ctx sdk.Context, markerAddr sdk.AccAddress, nav types.NetAssetValue, source string) error {
+	maxHistory := k.GetMaxNavHistory(ctx)
+	if maxHistory == 0 {
+		return nil
+	}
+
+	record := types.NetAssetValueRecord{NetAssetValue: nav, BlockTime: ctx.BlockTime().UTC(), Source: source}
+	if err := k.navHistory.Set(ctx, collections.Join3(markerAddr, nav.Price.Denom, record.BlockTime), record); err != nil {
+		return fmt.Errorf("failed to set net asset value history: %w", err)
+	}
+
+	var toRemove []navHistoryKey
+	count := uint32(0)
+	rng := collections.NewSuperPrefixedTripleRangeReversed[sdk.AccAddress, string, time.Time](markerAddr, nav.Price.Denom)
+	err := k.navHistory.Walk(ctx, rng, func(key navHistoryKey, _ types.NetAssetValueRecord) (bool, error) {
+		count++
+		if count > maxHistory {
+			toRemove = append(toRemove, key)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not read net asset value history: %w", err)
+	}
+	for _, key := range toRemove {
+		if err = k.navHistory.Remove(ctx, key); err != nil {
+			return fmt.Errorf("failed to prune net asset value history: %w", err)
+		}
+	}
+	return nil
+}
+
+// SetNetAssetValueRecord stores an entry in a marker's net asset value history.
+func (k Keeper) SetNetAssetValueRecord(ctx sdk.Context, markerAddr sdk.AccAddress, record types.NetAssetValueRecord) error {
+	if err := record.Validate(); err != nil {
+		return err
+	}
+	record.BlockTime = record.BlockTime.UTC()
+	key := collections.Join3(markerAddr, record.NetAssetValue.Price.Denom, record.BlockTime)
+	if err := k.navHistory.Set(ctx, key, record); err != nil {
+		return fmt.Errorf("failed to set net asset value history: %w", err)
+	}
+	return nil
+}
+
+// IterateNetAssetValueHistory iterates over a marker's net asset value history, ordered by price denom, then time.
+// If a price denom is provided, only the history for that price denom is iterated.
+func (k Keeper) IterateNetAssetValueHistory(ctx sdk.Context, markerAddr sdk.AccAddress, priceDenom string, handler func(record types.NetAssetValueRecord) (stop bool)) error {
+	var rng collections.Ranger[navHistoryKey]
+	if len(priceDenom) > 0 {
+		rng = collections.NewSuperPrefixedTripleRange[sdk.AccAddress, string, time.Time](markerAddr, priceDenom)
+	} else {
+		rng = collections.NewPrefixedTripleRange[sdk.AccAddress, string, time.Time](markerAddr)
+	}
+	return k.navHistory.Walk(ctx, rng, func(_ navHistoryKey, record types.NetAssetValueRecord) (bool, error) {
+		return handler(record), nil
+	})
+}
+
+// GetNetAssetValueHistory gets a marker's net asset value history, optionally limited to a price denom
+// and to entries recorded at or after the start time and before the end time.
+func (k Keeper) GetNetAssetValueHistory(ctx sdk.Context, markerAddr sdk.AccAddress, priceDenom string, start, end *time.Time) ([]types.NetAssetValueRecord, error) {
+	var rv []types.NetAssetValueRecord
+	err := k.IterateNetAssetValueHistory(ctx, markerAddr, priceDenom, func(record types.NetAssetValueRecord) bool {
+		if (start == nil || !record.BlockTime.Before(*start)) && (end == nil || record.BlockTime.Before(*end)) {
+			rv = append(rv, record)
+		}
+		return false
+	})
+	return rv, err
+}
+
+// GetNetAssetValuesAsOf gets the net asset values (from the history) that were in effect for a marker at the given time.
+// There is at most one result per price denom; price denoms without any history at or before that time are not included.
+func (k Keeper) GetNetAssetValuesAsOf(ctx sdk.Context, markerAddr sdk.AccAddress, priceDenom string, asOf time.Time) ([]types.NetAssetValue, error) {
+	var rv []types.NetAssetValue
+	lastDenom := ""
+	err := k.IterateNetAssetValueHistory(ctx, markerAddr, priceDenom, func(record types.NetAssetValueRecord) bool {
+		if record.BlockTime.After(asOf) {
+			return false
+		}
+		if len(rv) > 0 && lastDenom == record.NetAssetValue.Price.Denom {
+			rv[len(rv)-1] = record.NetAssetValue
+		} else {
+			rv = append(rv, record.NetAssetValue)
+			lastDenom = record.NetAssetValue.Price.Denom
+		}
+		return false
+	})
+	return rv, err
+}
+
+// RemoveNetAssetValueHistory removes all of a marker's net asset value history.
+func (k Keeper) RemoveNetAssetValueHistory(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	if err := k.navHistory.Clear(ctx, collections.NewPrefixedTripleRange[sdk.AccAddress, string, time.Time](markerAddr)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// navHistoryFixture is a coin marker without any net asset values.
+type navHistoryFixture struct {
+	*KeeperTestSuite
+
+	denom      string
+	markerAddr sdk.AccAddress
+}
+
+func (s *KeeperTestSuite) newNavHistoryFixture() *navHistoryFixture {
+	f := &navHistoryFixture{
+		KeeperTestSuite: s,
+		denom:           "navcoin",
+		markerAddr:      types.MustGetMarkerAddress("navcoin"),
+	}
+	s.startBlockTime = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(s.startBlockTime).WithBlockHeight(10)
+
+	storeTestMarker(s.T(), s.app, s.ctx, newTestMarker(f.denom, types.MarkerType_Coin, 1000))
+	return f
+}
+
+func (f *navHistoryFixture) setMaxNavHistory(maxNavHistory uint32) {
+	params := f.app.MarkerKeeper.GetParams(f.ctx)
+	params.MaxNavHistory = maxNavHistory
+	f.app.MarkerKeeper.SetParams(f.ctx, params)
+}
+
+// setNav sets a net asset value for the marker in a block that's the given number of hours after the start time.
+func (f *navHistoryFixture) setNav(hours int, price string) {
+	ctx := f.ctx.WithBlockTime(f.startBlockTime.Add(time.Duration(hours) * time.Hour))
+	coin, err := sdk.ParseCoinNormalized(price)
+	f.Require().NoError(err, "ParseCoinNormalized(%q)", price)
+	marker, err := f.app.MarkerKeeper.GetMarker(ctx, f.markerAddr)
+	f.Require().NoError(err, "GetMarker")
+	err = f.app.MarkerKeeper.SetNetAssetValue(ctx, marker, types.NewNetAssetValue(coin, 1), "test")
+	f.Require().NoError(err, "SetNetAssetValue(%d, %q)", hours, price)
+}
+
+func (f *navHistoryFixture) at(hours int) *time.Time {
+	rv := f.startBlockTime.Add(time.Duration(hours) * time.Hour)
+	return &rv
+}
+
+func (f *navHistoryFixture) prices(records []types.NetAssetValueRecord) []string {
+	rv := make([]string, len(records))
+	for i, record := range records {
+		rv[i] = record.NetAssetValue.Price.String()
+	}
+	return rv
+}
+
+func (s *KeeperTestSuite) TestHistoryDisabled() {
+	f := s.newNavHistoryFixture()
+	f.setMaxNavHistory(0)
+	f.setNav(0, "10usd")
+	f.setNav(1, "11usd")
+
+	history, err := s.app.MarkerKeeper.GetNetAssetValueHistory(s.ctx, f.markerAddr, "", nil, nil)
+	s.Require().NoError(err, "GetNetAssetValueHistory")
+	s.Assert().Empty(history, "history")
+}
+
+func (s *KeeperTestSuite) TestHistoryPruning() {
+	f := s.newNavHistoryFixture()
+	f.setMaxNavHistory(3)
+	f.setNav(0, "10usd")
+	f.setNav(1, "11usd")
+	f.setNav(2, "5nhash")
+	f.setNav(3, "12usd")
+	f.setNav(4, "13usd")
+
+	history, err := s.app.MarkerKeeper.GetNetAssetValueHistory(s.ctx, f.markerAddr, "", nil, nil)
+	s.Require().NoError(err, "GetNetAssetValueHistory")
+	s.Assert().Equal([]string{"5nhash", "11usd", "12usd", "13usd"}, f.prices(history), "history prices")
+	s.Assert().Equal("test", history[0].Source, "source")
+	s.Assert().Equal(*f.at(2), history[0].BlockTime, "block time")
+
+	s.app.MarkerKeeper.RemoveNetAssetValues(s.ctx, f.markerAddr)
+	history, err = s.app.MarkerKeeper.GetNetAssetValueHistory(s.ctx, f.markerAddr, "", nil, nil)
+	s.Require().NoError(err, "GetNetAssetValueHistory after removal")
+	s.Assert().Empty(history, "history after removal")
+}
+
+func (s *KeeperTestSuite) TestNetAssetValuesQuery() {
+	f := s.newNavHistoryFixture()
+	f.setNav(0, "10usd")
+	f.setNav(1, "2nhash")
+	f.setNav(2, "11usd")
+	f.setNav(4, "12usd")
+
+	tests := []struct {
+		name    string
+		req     *types.QueryNetAssetValuesRequest
+		expNavs []string
+		expHist []string
+		expErr  string
+	}{
+		{
+			name:    "current",
+			req:     &types.QueryNetAssetValuesRequest{Id: f.denom},
+			expNavs: []string{"2nhash", "12usd"},
+		},
+		{
+			name:    "current with price denom",
+			req:     &types.QueryNetAssetValuesRequest{Id: f.denom, PriceDenom: "usd"},
+			expNavs: []string{"12usd"},
+		},
+		{
+			name:    "as of before any",
+			req:     &types.QueryNetAssetValuesRequest{Id: f.denom, AsOf: f.at(-1)},
+			expNavs: []string{},
+		},
+		{
+			name:    "as of exact time",
+			req:     &types.QueryNetAssetValuesRequest{Id: f.denom, AsOf: f.at(2)},
+			expNavs: []string{"2nhash", "11usd"},
+		},
+		{
+			name:    "as of between",
+			req:     &types.QueryNetAssetValuesRequest{Id: f.denom, AsOf: f.at(3), PriceDenom: "usd"},
+			expNavs: []string{"11usd"},
+		},
+		{
+			name:    "history",
+			req:     &types.QueryNetAssetValuesRequest{Id: f.denom, History: true},
+			expHist: []string{"2nhash", "10usd", "11usd", "12usd"},
+		},
+		{
+			name:    "history in range",
+			req:     &types.QueryNetAssetValuesRequest{Id: f.denom, History: true, PriceDenom: "usd", StartTime: f.at(1), EndTime: f.at(4)},
+			expHist: []string{"11usd"},
+		},
+		{
+			name:   "as of with history",
+			req:    &types.QueryNetAssetValuesRequest{Id: f.denom, History: true, AsOf: f.at(1)},
+			expErr: "as of cannot be combined with a history request",
+		},
+		{
+			name:   "start without history",
+			req:    &types.QueryNetAssetValuesRequest{Id: f.denom, StartTime: f.at(1)},
+			expErr: "start and end times are only allowed with a history request",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.MarkerKeeper.NetAssetValues(s.ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				s.Require().ErrorContains(err, tc.expErr, "NetAssetValues error")
+				return
+			}
+			s.Require().NoError(err, "NetAssetValues")
+			navs := make([]string, len(resp.NetAssetValues))
+			for i, nav := range resp.NetAssetValues {
+				navs[i] = nav.Price.String()
+			}
+			if tc.expNavs != nil {
+				s.Assert().Equal(tc.expNavs, navs, "net asset values")
+			} else {
+				s.Assert().Empty(navs, "net asset values")
+			}
+			if tc.expHist != nil {
+				s.Assert().Equal(tc.expHist, f.prices(resp.History), "history")
+			} else {
+				s.Assert().Empty(resp.History, "history")
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestNavHistoryGenesis() {
+	f := s.newNavHistoryFixture()
+	f.setNav(0, "10usd")
+	f.setNav(1, "11usd")
+
+	genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	var history []types.NetAssetValueRecord
+	for _, mNavs := range genState.NetAssetValues {
+		if mNavs.Address == f.markerAddr.String() {
+			history = mNavs.History
+		}
+	}
+	s.Assert().Equal([]string{"10usd", "11usd"}, f.prices(history), "exported history")
+
+	history[0].NetAssetValue.Volume = 0
+	s.Assert().ErrorContains(genState.Validate(), "invalid net asset value record", "Validate with invalid history")
+}
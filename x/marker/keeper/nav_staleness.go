@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/provutils"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetNetAssetValueMaxAge sets the number of blocks after which a marker's net asset values are stale.
+// A max age of zero removes it. The marker's net asset values are moved to the heights they now become stale at
+// in the stale queue.
+func (k Keeper) SetNetAssetValueMaxAge(ctx sdk.Context, markerAddr sdk.AccAddress, maxAge uint64) error {
+	prevMaxAge, err := k.GetNetAssetValueMaxAge(ctx, markerAddr)
+	if err != nil {
+		return err
+	}
+	if maxAge == 0 {
+		if err = k.navMaxAges.Remove(ctx, markerAddr); err != nil {
+			return fmt.Errorf("failed to remove net asset value max age: %w", err)
+		}
+	} else if err = k.navMaxAges.Set(ctx, markerAddr, maxAge); err != nil {
+		return fmt.Errorf("failed to set net asset value max age: %w", err)
+	}
+	if maxAge == prevMaxAge {
+		return nil
+	}
+
+	var navs []types.NetAssetValue
+	err = k.IterateNetAssetValues(ctx, markerAddr, func(nav types.NetAssetValue) bool {
+		navs = append(navs, nav)
+		return false
+	})
+	if err != nil {
+		return fmt.Errorf("could not read net asset values: %w", err)
+	}
+	for _, nav := range navs {
+		if err = k.dequeueNetAssetValueStaleness(ctx, markerAddr, nav, prevMaxAge); err != nil {
+			return err
+		}
+		if err = k.queueNetAssetValueStaleness(ctx, markerAddr, nav, maxAge); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetNetAssetValueMaxAge gets the number of blocks after which a marker's net asset values are stale.
+// Returns zero if the marker doesn't have a max age.
+func (k Keeper) GetNetAssetValueMaxAge(ctx sdk.Context, markerAddr sdk.AccAddress) (uint64, error) {
+	maxAge, err := k.navMaxAges.Get(ctx, markerAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("could not read net asset value max age: %w", err)
+	}
+	return maxAge, nil
+}
+
+// queueNetAssetValueStaleness adds a marker's net asset value to the stale queue at the height it becomes stale at.
+// It does nothing if the net asset value never becomes stale or is already stale.
+func (k Keeper) queueNetAssetValueStaleness(ctx sdk.Context, markerAddr sdk.AccAddress, nav types.NetAssetValue, maxAge uint64) error {
+	staleHeight := provutils.NavStaleQueueHeight(nav.UpdatedBlockHeight, maxAge, ctx.BlockHeight())
+	if staleHeight == 0 {
+		return nil
+	}
+	if err := k.navStaleQueue.Set(ctx, collections.Join3(staleHeight, markerAddr, nav.Price.Denom), true); err != nil {
+		return fmt.Errorf("failed to set net asset value stale queue entry: %w", err)
+	}
+	return nil
+}
+
+// dequeueNetAssetValueStaleness removes a marker's net asset value from the stale queue.
+func (k Keeper) dequeueNetAssetValueStaleness(ctx sdk.Context, markerAddr sdk.AccAddress, nav types.NetAssetValue, maxAge uint64) error {
+	staleHeight := provutils.NavStaleHeight(nav.UpdatedBlockHeight, maxAge)
+	if staleHeight == 0 {
+		return nil
+	}
+	if err := k.navStaleQueue.Remove(ctx, collections.Join3(staleHeight, markerAddr, nav.Price.Denom)); err != nil {
+		return fmt.Errorf("failed to remove net asset value stale queue entry: %w", err)
+	}
+	return nil
+}
+
+// EmitNetAssetValueStaleEvents emits an EventNetAssetValueStale for each marker net asset value that
+// became stale in the current block. Only the entries in the stale queue for the current height are looked at.
+func (k Keeper) EmitNetAssetValueStaleEvents(ctx sdk.Context) {
+	if ctx.BlockHeight() <= 0 {
+		return
+	}
+	height := uint64(ctx.BlockHeight())
+
+	var keys []collections.Triple[uint64, sdk.AccAddress, string]
+	rng := collections.NewPrefixedTripleRange[uint64, sdk.AccAddress, string](height)
+	err := k.navStaleQueue.Walk(ctx, rng, func(key collections.Triple[uint64, sdk.AccAddress, string], _ bool) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, key := range keys {
+		if err = k.navStaleQueue.Remove(ctx, key); err != nil {
+			panic(fmt.Errorf("failed to remove net asset value stale queue entry: %w", err))
+		}
+		var nav types.NetAssetValue
+		nav, err = k.navs.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				ctx.Logger().Error("failed to read net asset value", "marker", key.K2().String(), "price_denom", key.K3(), "error", err)
+			}
+			continue
+		}
+		var maxAge uint64
+		maxAge, err = k.GetNetAssetValueMaxAge(ctx, key.K2())
+		if err != nil || provutils.NavStaleHeight(nav.UpdatedBlockHeight, maxAge) != height {
+			continue
+		}
+		var marker types.MarkerAccountI
+		marker, err = k.GetMarker(ctx, key.K2())
+		if err != nil || marker == nil {
+			continue
+		}
+		k.emitNetAssetValueStaleEvent(ctx, marker.GetDenom(), nav, maxAge)
+	}
+}
+
+// emitStaleNetAssetValueEvents emits an EventNetAssetValueStale for each of a marker's net asset values
+// that is stale with the provided max age, but for which wasStale returns false.
+func (k Keeper) emitStaleNetAssetValueEvents(ctx sdk.Context, marker types.MarkerAccountI, maxAge uint64, wasStale func(nav types.NetAssetValue) bool) {
+	var navs []types.NetAssetValue
+	err := k.IterateNetAssetValues(ctx, marker.GetAddress(), func(nav types.NetAssetValue) bool {
+		if nav.IsStale(maxAge, ctx.BlockHeight()) && !wasStale(nav) {
+			navs = append(navs, nav)
+		}
+		return false
+	})
+	if err != nil {
+		ctx.Logger().Error("failed to read net asset values", "denom", marker.GetDenom(), "error", err)
+		return
+	}
+	for _, nav := range navs {
+		k.emitNetAssetValueStaleEvent(ctx, marker.GetDenom(), nav, maxAge)
+	}
+}
+
+// emitNetAssetValueStaleEvent emits an EventNetAssetValueStale for a marker's net asset value.
+func (k Keeper) emitNetAssetValueStaleEvent(ctx sdk.Context, denom string, nav types.NetAssetValue, maxAge uint64) {
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventNetAssetValueStale(denom, nav, maxAge)); err != nil {
+		ctx.Logger().Error("failed to emit net asset value stale event", "denom", denom, "price_denom", nav.Price.Denom, "error", err)
+	}
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// navStalenessFixture is a coin marker with a usd net asset value that was set at height 10.
+type navStalenessFixture struct {
+	*MsgServerTestSuite
+
+	denom      string
+	marker     types.MarkerAccountI
+	markerAddr sdk.AccAddress
+	admin      sdk.AccAddress
+	other      sdk.AccAddress
+	nav        types.NetAssetValue
+}
+
+func (s *MsgServerTestSuite) newNavStalenessFixture() *navStalenessFixture {
+	f := &navStalenessFixture{
+		MsgServerTestSuite: s,
+		denom:              "navcoin",
+		markerAddr:         types.MustGetMarkerAddress("navcoin"),
+		admin:              sdk.AccAddress("nav_admin___________"),
+		other:              sdk.AccAddress("nav_other___________"),
+		nav:                types.NetAssetValue{Price: sdk.NewInt64Coin("usd", 100), Volume: 1, UpdatedBlockHeight: 10},
+	}
+	s.ctx = s.ctx.WithBlockHeight(10)
+
+	marker := newTestMarker(f.denom, types.MarkerType_Coin, 1000,
+		types.AccessGrant{Address: f.admin.String(), Permissions: types.AccessList{types.Access_Admin}},
+	)
+	storeTestMarker(s.T(), s.app, s.ctx, marker)
+	f.marker = marker
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValue(s.ctx, marker, types.NewNetAssetValue(sdk.NewInt64Coin("usd", 100), 1), "test"), "SetNetAssetValue")
+	return f
+}
+
+func (f *navStalenessFixture) staleEvent() sdk.Event {
+	event, err := sdk.TypedEventToEvent(types.NewEventNetAssetValueStale(f.denom, f.nav, 5))
+	f.Require().NoError(err, "TypedEventToEvent NewEventNetAssetValueStale")
+	return event
+}
+
+func (s *MsgServerTestSuite) TestGetNetAssetValueStale() {
+	f := s.newNavStalenessFixture()
+	nav, err := s.app.MarkerKeeper.GetNetAssetValue(s.ctx.WithBlockHeight(100), f.denom, "usd")
+	s.Require().NoError(err, "GetNetAssetValue without a max age")
+	s.Assert().False(nav.Stale, "stale without a max age")
+
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValueMaxAge(s.ctx, f.markerAddr, 5), "SetNetAssetValueMaxAge")
+	nav, err = s.app.MarkerKeeper.GetNetAssetValue(s.ctx.WithBlockHeight(15), f.denom, "usd")
+	s.Require().NoError(err, "GetNetAssetValue at height 15")
+	s.Assert().False(nav.Stale, "stale at height 15")
+	nav, err = s.app.MarkerKeeper.GetNetAssetValue(s.ctx.WithBlockHeight(16), f.denom, "usd")
+	s.Require().NoError(err, "GetNetAssetValue at height 16")
+	s.Assert().True(nav.Stale, "stale at height 16")
+
+	resp, err := s.app.MarkerKeeper.NetAssetValues(s.ctx.WithBlockHeight(16), &types.QueryNetAssetValuesRequest{Id: f.denom})
+	s.Require().NoError(err, "NetAssetValues query")
+	s.Assert().Equal(uint64(5), resp.MaxAge, "query max age")
+	s.Require().Len(resp.NetAssetValues, 1, "query net asset values")
+	s.Assert().True(resp.NetAssetValues[0].Stale, "query net asset value stale")
+
+	// Updating the value makes it fresh again.
+	ctx := s.ctx.WithBlockHeight(20)
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValue(ctx, f.marker, types.NewNetAssetValue(sdk.NewInt64Coin("usd", 110), 1), "test"), "SetNetAssetValue at height 20")
+	nav, err = s.app.MarkerKeeper.GetNetAssetValue(ctx, f.denom, "usd")
+	s.Require().NoError(err, "GetNetAssetValue after update")
+	s.Assert().False(nav.Stale, "stale after update")
+}
+
+func (s *MsgServerTestSuite) TestEmitNetAssetValueStaleEvents() {
+	f := s.newNavStalenessFixture()
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValueMaxAge(s.ctx, f.markerAddr, 5), "SetNetAssetValueMaxAge")
+
+	for height := int64(14); height <= 17; height++ {
+		ctx := s.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		s.app.MarkerKeeper.EmitNetAssetValueStaleEvents(ctx)
+		if height == 16 {
+			s.Assert().Equal(sdk.Events{f.staleEvent()}, ctx.EventManager().Events(), "events at height %d", height)
+		} else {
+			s.Assert().Empty(ctx.EventManager().Events(), "events at height %d", height)
+		}
+	}
+}
+
+func (s *MsgServerTestSuite) TestEmitNetAssetValueStaleEventsAfterUpdate() {
+	f := s.newNavStalenessFixture()
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValueMaxAge(s.ctx, f.markerAddr, 5), "SetNetAssetValueMaxAge")
+	ctx := s.ctx.WithBlockHeight(13)
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValue(ctx, f.marker, types.NewNetAssetValue(sdk.NewInt64Coin("usd", 100), 1), "test"), "SetNetAssetValue at height 13")
+	f.nav.UpdatedBlockHeight = 13
+
+	for height := int64(14); height <= 20; height++ {
+		ctx = s.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		s.app.MarkerKeeper.EmitNetAssetValueStaleEvents(ctx)
+		if height == 19 {
+			s.Assert().Equal(sdk.Events{f.staleEvent()}, ctx.EventManager().Events(), "events at height %d", height)
+		} else {
+			s.Assert().Empty(ctx.EventManager().Events(), "events at height %d", height)
+		}
+	}
+}
+
+func (s *MsgServerTestSuite) TestEmitNetAssetValueStaleEventsAfterMaxAgeChange() {
+	f := s.newNavStalenessFixture()
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValueMaxAge(s.ctx, f.markerAddr, 3), "SetNetAssetValueMaxAge 3")
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValueMaxAge(s.ctx, f.markerAddr, 5), "SetNetAssetValueMaxAge 5")
+
+	for height := int64(14); height <= 17; height++ {
+		ctx := s.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		s.app.MarkerKeeper.EmitNetAssetValueStaleEvents(ctx)
+		if height == 16 {
+			s.Assert().Equal(sdk.Events{f.staleEvent()}, ctx.EventManager().Events(), "events at height %d", height)
+		} else {
+			s.Assert().Empty(ctx.EventManager().Events(), "events at height %d", height)
+		}
+	}
+
+	// Without a max age, the value is no longer queued.
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValue(s.ctx, f.marker, types.NewNetAssetValue(sdk.NewInt64Coin("usd", 100), 1), "test"), "SetNetAssetValue again")
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValueMaxAge(s.ctx, f.markerAddr, 0), "SetNetAssetValueMaxAge 0")
+	ctx := s.ctx.WithBlockHeight(16).WithEventManager(sdk.NewEventManager())
+	s.app.MarkerKeeper.EmitNetAssetValueStaleEvents(ctx)
+	s.Assert().Empty(ctx.EventManager().Events(), "events without a max age")
+}
+
+func (s *MsgServerTestSuite) TestSetNetAssetValueMaxAge() {
+	f := s.newNavStalenessFixture()
+	_, err := s.msgServer.SetNetAssetValueMaxAge(s.ctx, types.NewMsgSetNetAssetValueMaxAgeRequest(f.denom, 5, f.other.String()))
+	s.Assert().ErrorContains(err, "does not have ACCESS_ADMIN on navcoin marker", "SetNetAssetValueMaxAge without access")
+
+	ctx := s.ctx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.SetNetAssetValueMaxAge(ctx, types.NewMsgSetNetAssetValueMaxAgeRequest(f.denom, 5, f.admin.String()))
+	s.Require().NoError(err, "SetNetAssetValueMaxAge")
+	setEvent, err := sdk.TypedEventToEvent(types.NewEventSetNetAssetValueMaxAge(f.denom, 5, f.admin.String()))
+	s.Require().NoError(err, "TypedEventToEvent NewEventSetNetAssetValueMaxAge")
+	s.Assert().Equal(sdk.Events{setEvent, f.staleEvent()}, ctx.EventManager().Events(), "events when lowering the max age")
+
+	// Values that were already stale don't get another event.
+	ctx = s.ctx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.SetNetAssetValueMaxAge(ctx, types.NewMsgSetNetAssetValueMaxAgeRequest(f.denom, 4, f.admin.String()))
+	s.Require().NoError(err, "SetNetAssetValueMaxAge again")
+	s.Assert().Len(ctx.EventManager().Events(), 1, "events when the value was already stale")
+
+	genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	found := false
+	for _, mNavs := range genState.NetAssetValues {
+		if mNavs.Address == f.markerAddr.String() {
+			found = true
+			s.Assert().Equal(uint64(4), mNavs.MaxAge, "exported max age")
+		}
+	}
+	s.Assert().True(found, "exported net asset values for %s", f.denom)
+
+	_, err = s.msgServer.SetNetAssetValueMaxAge(s.ctx, types.NewMsgSetNetAssetValueMaxAgeRequest(f.denom, 0, f.admin.String()))
+	s.Require().NoError(err, "SetNetAssetValueMaxAge removal")
+	maxAge, err := s.app.MarkerKeeper.GetNetAssetValueMaxAge(s.ctx, f.markerAddr)
+	s.Require().NoError(err, "GetNetAssetValueMaxAge after removal")
+	s.Assert().Zero(maxAge, "max age after removal")
+}
@@ -64,3 +64,8 @@ func (k Keeper) ValidateUnrestrictedDenom(ctx sdk.Context, denom string) error {
 	}
 	return nil
 }
+
+// GetMaxNavHistory returns the number of past net asset values to keep for each marker (or scope) and price denom.
+func (k Keeper) GetMaxNavHistory(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxNavHistory
+}
@@ -6,6 +6,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -194,16 +197,361 @@ func (k Keeper) NetAssetValues(c context.Context, req *types.QueryNetAssetValues
 		return nil, err
 	}
 
+	if req.AsOf != nil && (req.History || req.StartTime != nil || req.EndTime != nil) {
+		return nil, status.Error(codes.InvalidArgument, "as of cannot be combined with a history request")
+	}
+	if !req.History && (req.StartTime != nil || req.EndTime != nil) {
+		return nil, status.Error(codes.InvalidArgument, "start and end times are only allowed with a history request")
+	}
+
+	maxAge, err := k.GetNetAssetValueMaxAge(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if req.History {
+		history, histErr := k.GetNetAssetValueHistory(ctx, marker.GetAddress(), req.PriceDenom, req.StartTime, req.EndTime)
+		if histErr != nil {
+			return nil, status.Error(codes.Internal, histErr.Error())
+		}
+		return &types.QueryNetAssetValuesResponse{History: history, MaxAge: maxAge}, nil
+	}
+
+	if req.AsOf != nil {
+		navs, asOfErr := k.GetNetAssetValuesAsOf(ctx, marker.GetAddress(), req.PriceDenom, *req.AsOf)
+		if asOfErr != nil {
+			return nil, status.Error(codes.Internal, asOfErr.Error())
+		}
+		return &types.QueryNetAssetValuesResponse{NetAssetValues: navs, MaxAge: maxAge}, nil
+	}
+
 	var navs []types.NetAssetValue
 	err = k.IterateNetAssetValues(ctx, marker.GetAddress(), func(nav types.NetAssetValue) (stop bool) {
-		navs = append(navs, nav)
+		if len(req.PriceDenom) == 0 || nav.Price.Denom == req.PriceDenom {
+			nav.Stale = nav.IsStale(maxAge, ctx.BlockHeight())
+			navs = append(navs, nav)
+		}
 		return false
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.QueryNetAssetValuesResponse{NetAssetValues: navs}, nil
+	return &types.QueryNetAssetValuesResponse{NetAssetValues: navs, MaxAge: maxAge}, nil
+}
+
+// HolderFreezes returns the freezes of a marker's coins in holder accounts.
+func (k Keeper) HolderFreezes(c context.Context, req *types.QueryHolderFreezesRequest) (*types.QueryHolderFreezesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Holder) > 0 {
+		holder, addrErr := sdk.AccAddressFromBech32(req.Holder)
+		if addrErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid holder: %v", addrErr)
+		}
+		freeze, getErr := k.GetHolderFreeze(ctx, marker.GetAddress(), holder)
+		if getErr != nil {
+			return nil, status.Error(codes.Internal, getErr.Error())
+		}
+		resp := &types.QueryHolderFreezesResponse{}
+		if freeze != nil {
+			resp.Freezes = append(resp.Freezes, *freeze)
+		}
+		return resp, nil
+	}
+
+	freezes, pageRes, err := query.CollectionPaginate(ctx, k.holderFreezes, req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], freeze types.HolderFreeze) (types.HolderFreeze, error) {
+			return freeze, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](marker.GetAddress()),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHolderFreezesResponse{Freezes: freezes, Pagination: pageRes}, nil
+}
+
+// Distribution returns a distribution of funds to the holders of a marker's coin.
+func (k Keeper) Distribution(c context.Context, req *types.QueryDistributionRequest) (*types.QueryDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	dist, err := k.GetDistribution(ctx, req.DistributionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if dist == nil {
+		return nil, status.Errorf(codes.NotFound, "distribution %d not found", req.DistributionId)
+	}
+
+	return &types.QueryDistributionResponse{Distribution: *dist}, nil
+}
+
+// DistributionClaims returns the distribution payments waiting to be claimed by a holder.
+func (k Keeper) DistributionClaims(c context.Context, req *types.QueryDistributionClaimsRequest) (*types.QueryDistributionClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	holder, err := sdk.AccAddressFromBech32(req.Holder)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid holder: %v", err)
+	}
+
+	denoms := make(map[uint64]string)
+	claims, pageRes, err := query.CollectionPaginate(ctx, k.distributionClaims, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], amount sdkmath.Int) (types.DistributionPayment, error) {
+			denom, denomErr := k.getDistributionPayoutDenom(ctx, key.K2(), denoms)
+			if denomErr != nil {
+				return types.DistributionPayment{}, denomErr
+			}
+			return types.DistributionPayment{DistributionId: key.K2(), Holder: req.Holder, Amount: sdk.NewCoin(denom, amount)}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](holder),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDistributionClaimsResponse{Claims: claims, Pagination: pageRes}, nil
+}
+
+// Redemption returns a pending redemption of a marker's coin.
+func (k Keeper) Redemption(c context.Context, req *types.QueryRedemptionRequest) (*types.QueryRedemptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	redemption, err := k.GetRedemption(ctx, req.RedemptionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if redemption == nil {
+		return nil, status.Errorf(codes.NotFound, "redemption %d not found", req.RedemptionId)
+	}
+
+	return &types.QueryRedemptionResponse{Redemption: *redemption}, nil
+}
+
+// Redemptions returns the pending redemptions of a marker and/or a holder.
+func (k Keeper) Redemptions(c context.Context, req *types.QueryRedemptionsRequest) (*types.QueryRedemptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.Id) == 0 && len(req.Holder) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "a marker id or holder is required")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var holder sdk.AccAddress
+	if len(req.Holder) > 0 {
+		var err error
+		holder, err = sdk.AccAddressFromBech32(req.Holder)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid holder: %v", err)
+		}
+	}
+
+	getRedemption := func(key collections.Pair[sdk.AccAddress, uint64], _ bool) (types.Redemption, error) {
+		return k.redemptions.Get(ctx, key.K2())
+	}
+
+	var redemptions []types.Redemption
+	var pageRes *query.PageResponse
+	var err error
+	if len(req.Id) > 0 {
+		marker, markerErr := accountForDenomOrAddress(ctx, k, req.Id)
+		if markerErr != nil {
+			return nil, markerErr
+		}
+		redemptions, pageRes, err = query.CollectionFilteredPaginate(ctx, k.redemptionsByMarker, req.Pagination,
+			func(key collections.Pair[sdk.AccAddress, uint64], _ bool) (bool, error) {
+				if len(holder) == 0 {
+					return true, nil
+				}
+				return k.redemptionsByHolder.Has(ctx, collections.Join(holder, key.K2()))
+			},
+			getRedemption,
+			query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](marker.GetAddress()),
+		)
+	} else {
+		redemptions, pageRes, err = query.CollectionPaginate(ctx, k.redemptionsByHolder, req.Pagination,
+			getRedemption,
+			query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](holder),
+		)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedemptionsResponse{Redemptions: redemptions, Pagination: pageRes}, nil
+}
+
+// TransferLimits returns the transfer limits of a marker and how much has been sent in the current window.
+func (k Keeper) TransferLimits(c context.Context, req *types.QueryTransferLimitsRequest) (*types.QueryTransferLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.QueryTransferLimitsResponse{
+		Limits: types.TransferLimits{
+			Denom:            marker.GetDenom(),
+			HolderDailyLimit: sdkmath.ZeroInt(),
+			MarkerDailyLimit: sdkmath.ZeroInt(),
+		},
+		HolderVolume: sdkmath.ZeroInt(),
+	}
+	limits, err := k.GetTransferLimits(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if limits != nil {
+		resp.Limits = *limits
+	}
+	resp.MarkerVolume, err = k.GetTransferVolume(ctx, marker.GetAddress(), nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(req.Holder) > 0 {
+		holder, addrErr := sdk.AccAddressFromBech32(req.Holder)
+		if addrErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid holder: %v", addrErr)
+		}
+		resp.HolderVolume, err = k.GetTransferVolume(ctx, marker.GetAddress(), holder)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+// AccessGrantTerms returns the terms of an address's access grant on a marker and how much it has minted in the current window.
+func (k Keeper) AccessGrantTerms(c context.Context, req *types.QueryAccessGrantTermsRequest) (*types.QueryAccessGrantTermsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	resp := &types.QueryAccessGrantTermsResponse{}
+	resp.Terms, err = k.GetAccessGrantTerms(ctx, marker.GetAddress(), grantee)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp.MintVolume, err = k.GetAccessGrantMintVolume(ctx, marker.GetAddress(), grantee)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+// ApprovalThresholds returns the approval thresholds of a marker's permissions.
+func (k Keeper) ApprovalThresholds(c context.Context, req *types.QueryApprovalThresholdsRequest) (*types.QueryApprovalThresholdsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	thresholds, err := k.GetApprovalThresholds(ctx, marker)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryApprovalThresholdsResponse{Thresholds: thresholds}, nil
+}
+
+// PendingOperation returns an operation that is waiting for approvals.
+func (k Keeper) PendingOperation(c context.Context, req *types.QueryPendingOperationRequest) (*types.QueryPendingOperationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	op, err := k.GetPendingOperation(ctx, req.OperationId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if op == nil {
+		return nil, status.Errorf(codes.NotFound, "pending operation %d not found", req.OperationId)
+	}
+
+	return &types.QueryPendingOperationResponse{Operation: *op}, nil
+}
+
+// PendingOperations returns the operations of a marker that are waiting for approvals.
+func (k Keeper) PendingOperations(c context.Context, req *types.QueryPendingOperationsRequest) (*types.QueryPendingOperationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	ops, pageRes, err := query.CollectionPaginate(ctx, k.pendingOperationsByMarker, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ bool) (types.PendingOperation, error) {
+			return k.pendingOperations.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](marker.GetAddress()),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingOperationsResponse{Operations: ops, Pagination: pageRes}, nil
+}
+
+// AttributeRequirements returns the attribute value conditions that the recipients of a marker's coins must meet.
+func (k Keeper) AttributeRequirements(c context.Context, req *types.QueryAttributeRequirementsRequest) (*types.QueryAttributeRequirementsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	requirements, err := k.GetAttributeRequirements(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAttributeRequirementsResponse{Requirements: requirements}, nil
 }
 
 // accountForDenomOrAddress attempts to first get a marker by account address and then by denom.
@@ -223,3 +571,162 @@ func accountForDenomOrAddress(ctx sdk.Context, keeper Keeper, lookup string) (ty
 	}
 	return account, nil
 }
+
+// CapTable returns the current holders of a marker's coin with a breakdown of their amounts.
+func (k Keeper) CapTable(c context.Context, req *types.QueryCapTableRequest) (*types.QueryCapTableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	holders, pageRes, err := k.GetCapTable(ctx, marker, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	var holderCount uint64
+	if pageRes != nil {
+		holderCount = pageRes.Total
+	}
+
+	return &types.QueryCapTableResponse{
+		Holders:     holders,
+		HolderCount: holderCount,
+		Supply:      k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// HolderSnapshots returns the holder snapshots that have been recorded for a marker.
+func (k Keeper) HolderSnapshots(c context.Context, req *types.QueryHolderSnapshotsRequest) (*types.QueryHolderSnapshotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, pageRes, err := query.CollectionPaginate(ctx, k.holderSnapshotsByMarker, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ bool) (types.HolderSnapshot, error) {
+			return k.holderSnapshots.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](marker.GetAddress()),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHolderSnapshotsResponse{Snapshots: snapshots, Pagination: pageRes}, nil
+}
+
+// HolderSnapshot returns a recorded holder snapshot and its cap table.
+func (k Keeper) HolderSnapshot(c context.Context, req *types.QueryHolderSnapshotRequest) (*types.QueryHolderSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	snapshot, err := k.GetHolderSnapshot(ctx, req.SnapshotId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if snapshot == nil {
+		return nil, status.Errorf(codes.NotFound, "holder snapshot %d not found", req.SnapshotId)
+	}
+	holders, pageRes, err := query.CollectionPaginate(ctx, k.holderSnapshotEntries, req.Pagination,
+		func(_ collections.Pair[uint64, sdk.AccAddress], entry types.CapTableEntry) (types.CapTableEntry, error) {
+			return entry, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, sdk.AccAddress](req.SnapshotId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHolderSnapshotResponse{Snapshot: *snapshot, Holders: holders, Pagination: pageRes}, nil
+}
+
+// ForcedTransfers returns the audit records of the forced transfers of a marker's coins.
+func (k Keeper) ForcedTransfers(c context.Context, req *types.QueryForcedTransfersRequest) (*types.QueryForcedTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Records are kept after a marker is deleted, so the marker doesn't have to exist.
+	markerAddr, err := sdk.AccAddressFromBech32(req.Id)
+	if err != nil {
+		if markerAddr, err = types.MarkerAddress(req.Id); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid marker id %q: must be a denom or address", req.Id)
+		}
+	}
+	records, pageRes, err := query.CollectionPaginate(ctx, k.forcedTransfersByMarker, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ bool) (types.ForcedTransferRecord, error) {
+			return k.forcedTransfers.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](markerAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryForcedTransfersResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// AccountForcedTransfers returns the audit records of the forced transfers taken from an account.
+func (k Keeper) AccountForcedTransfers(c context.Context, req *types.QueryAccountForcedTransfersRequest) (*types.QueryAccountForcedTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	records, pageRes, err := query.CollectionPaginate(ctx, k.forcedTransfersByAccount, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ bool) (types.ForcedTransferRecord, error) {
+			return k.forcedTransfers.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountForcedTransfersResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// IssuanceSchedule returns a marker's issuance schedule along with the amounts that are mintable now and later.
+func (k Keeper) IssuanceSchedule(c context.Context, req *types.QueryIssuanceScheduleRequest) (*types.QueryIssuanceScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := k.GetIssuanceSchedule(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if schedule == nil {
+		schedule = &types.IssuanceSchedule{Denom: marker.GetDenom(), HardCap: sdkmath.ZeroInt(), Issued: sdkmath.ZeroInt()}
+	}
+	mintable, supply, unlocked, scheduled := k.GetMintable(ctx, marker, *schedule)
+
+	return &types.QueryIssuanceScheduleResponse{
+		Schedule:  *schedule,
+		Supply:    supply,
+		Unlocked:  unlocked,
+		Mintable:  mintable,
+		Scheduled: scheduled,
+	}, nil
+}
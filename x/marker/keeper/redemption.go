@@ -0,0 +1,329 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/quarantine"
+)
+
+// SetRedemptionPayoutDenom sets the denom that a marker's redemptions are paid out in.
+// An empty payout denom stops the marker from accepting new redemption requests.
+func (k Keeper) SetRedemptionPayoutDenom(ctx sdk.Context, markerAddr sdk.AccAddress, payoutDenom string) error {
+	if len(payoutDenom) == 0 {
+		if err := k.redemptionPayoutDenoms.Remove(ctx, markerAddr); err != nil {
+			return fmt.Errorf("failed to remove redemption payout denom: %w", err)
+		}
+		return nil
+	}
+	if err := k.redemptionPayoutDenoms.Set(ctx, markerAddr, payoutDenom); err != nil {
+		return fmt.Errorf("failed to set redemption payout denom: %w", err)
+	}
+	return nil
+}
+
+// GetRedemptionPayoutDenom gets the denom that a marker's redemptions are paid out in.
+// Returns an empty string if the marker is not accepting redemptions.
+func (k Keeper) GetRedemptionPayoutDenom(ctx sdk.Context, markerAddr sdk.AccAddress) (string, error) {
+	payoutDenom, err := k.redemptionPayoutDenoms.Get(ctx, markerAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("could not read redemption payout denom: %w", err)
+	}
+	return payoutDenom, nil
+}
+
+// GetAllRedemptionPayoutDenoms gets the redemption payout denoms of all markers.
+func (k Keeper) GetAllRedemptionPayoutDenoms(ctx sdk.Context) []types.RedemptionPayoutDenom {
+	var rv []types.RedemptionPayoutDenom
+	err := k.redemptionPayoutDenoms.Walk(ctx, nil, func(markerAddr sdk.AccAddress, payoutDenom string) (bool, error) {
+		marker, err := k.GetMarker(ctx, markerAddr)
+		if err != nil {
+			return true, err
+		}
+		if marker == nil {
+			return false, nil
+		}
+		rv = append(rv, types.RedemptionPayoutDenom{Denom: marker.GetDenom(), PayoutDenom: payoutDenom})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// CreateRedemption moves the amount from the holder into the marker module account and records a pending redemption.
+// The coins are held by the module (instead of the marker account) so that they can't be burned or withdrawn
+// before the redemption is fulfilled or rejected.
+func (k Keeper) CreateRedemption(ctx sdk.Context, marker types.MarkerAccountI, holder sdk.AccAddress, amount sdk.Coin) (uint64, error) {
+	if marker.GetStatus() != types.StatusActive {
+		return 0, fmt.Errorf("marker %s is not active", marker.GetDenom())
+	}
+	payoutDenom, err := k.GetRedemptionPayoutDenom(ctx, marker.GetAddress())
+	if err != nil {
+		return 0, err
+	}
+	if len(payoutDenom) == 0 {
+		return 0, fmt.Errorf("marker %s is not accepting redemptions", marker.GetDenom())
+	}
+	if err = k.validateRedemptionHolder(ctx, marker, holder); err != nil {
+		return 0, err
+	}
+
+	// The bypass is needed since the holder usually won't have transfer access, and the checks
+	// that still apply to the holder have already been done.
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(types.WithBypass(ctx), holder, types.CoinPoolName, sdk.NewCoins(amount)); err != nil {
+		return 0, fmt.Errorf("could not escrow %s from %s: %w", amount, holder, err)
+	}
+
+	id, err := k.getNextRedemptionID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err = k.SetNextRedemptionID(ctx, id+1); err != nil {
+		return 0, err
+	}
+
+	redemption := types.Redemption{
+		Id:              id,
+		Denom:           marker.GetDenom(),
+		Holder:          holder.String(),
+		Amount:          amount,
+		RequestedHeight: ctx.BlockHeight(),
+	}
+	if err = k.SetRedemption(ctx, redemption); err != nil {
+		return 0, err
+	}
+
+	return id, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRedemptionRequested(redemption))
+}
+
+// validateRedemptionHolder makes sure the holder is allowed to send a restricted marker's coin, i.e. that
+// they're not on the marker's send-deny list and, unless they have transfer access, that they have the
+// marker's required attributes and meet its attribute requirements.
+func (k Keeper) validateRedemptionHolder(ctx sdk.Context, marker types.MarkerAccountI, holder sdk.AccAddress) error {
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil
+	}
+	if k.IsSendDeny(ctx, marker.GetAddress(), holder) {
+		return fmt.Errorf("%s is on deny list for sending restricted marker", holder)
+	}
+	if marker.AddressHasAccess(holder, types.Access_Transfer) {
+		return nil
+	}
+
+	reqAttr := marker.GetRequiredAttributes()
+	requirements, err := k.GetAttributeRequirements(ctx, marker.GetAddress())
+	if err != nil {
+		return err
+	}
+	if len(reqAttr) == 0 && len(requirements) == 0 {
+		return nil
+	}
+	attributes, err := k.attrKeeper.GetAllAttributesAddr(ctx, holder)
+	if err != nil {
+		return fmt.Errorf("could not get attributes for %s: %w", holder, err)
+	}
+	if missing := findMissingAttributes(reqAttr, attributes); len(missing) != 0 {
+		pl := ""
+		if len(missing) != 1 {
+			pl = "s"
+		}
+		return fmt.Errorf("address %s does not contain the %q required attribute%s: \"%s\"", holder, marker.GetDenom(), pl, strings.Join(missing, `", "`))
+	}
+	return validateAttributeRequirements(ctx, marker.GetDenom(), holder, requirements, attributes)
+}
+
+// FulfillRedemption burns the escrowed coins of a redemption and pays the holder from the administrator.
+// If the payout is nil, it is calculated from the marker's net asset value in its redemption payout denom.
+func (k Keeper) FulfillRedemption(ctx sdk.Context, redemption types.Redemption, marker types.MarkerAccountI, admin sdk.AccAddress, payout *sdk.Coin) (sdk.Coin, error) {
+	payoutDenom, err := k.GetRedemptionPayoutDenom(ctx, marker.GetAddress())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if len(payoutDenom) == 0 {
+		return sdk.Coin{}, fmt.Errorf("marker %s does not have a redemption payout denom", marker.GetDenom())
+	}
+
+	var toPay sdk.Coin
+	if payout != nil {
+		if payout.Denom != payoutDenom {
+			return sdk.Coin{}, fmt.Errorf("payout %s must be in the redemption payout denom %s", payout, payoutDenom)
+		}
+		toPay = *payout
+	} else {
+		toPay, err = k.calculateRedemptionPayout(ctx, redemption, payoutDenom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	// The escrowed coins are moved back into the marker account so that they're burned the same way as any other burn.
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(types.WithBypass(ctx), types.CoinPoolName, marker.GetAddress(), sdk.NewCoins(redemption.Amount)); err != nil {
+		return sdk.Coin{}, fmt.Errorf("could not release %s from escrow: %w", redemption.Amount, err)
+	}
+	if err = k.DecreaseSupply(ctx, marker, redemption.Amount); err != nil {
+		return sdk.Coin{}, fmt.Errorf("could not burn %s: %w", redemption.Amount, err)
+	}
+	holder := sdk.MustAccAddressFromBech32(redemption.Holder)
+	if err = k.bankKeeper.SendCoins(quarantine.WithBypass(ctx), admin, holder, sdk.NewCoins(toPay)); err != nil {
+		return sdk.Coin{}, fmt.Errorf("could not pay %s to %s: %w", toPay, holder, err)
+	}
+	if err = k.removeRedemption(ctx, redemption); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return toPay, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRedemptionFulfilled(redemption, toPay, admin.String()))
+}
+
+// calculateRedemptionPayout calculates the payout of a redemption using the marker's net asset value in the payout denom.
+// Any fractional amount is truncated.
+func (k Keeper) calculateRedemptionPayout(ctx sdk.Context, redemption types.Redemption, payoutDenom string) (sdk.Coin, error) {
+	nav, err := k.GetNetAssetValue(ctx, redemption.Denom, payoutDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if nav == nil || nav.Volume == 0 {
+		return sdk.Coin{}, fmt.Errorf("marker %s does not have a net asset value in %s", redemption.Denom, payoutDenom)
+	}
+	amount := redemption.Amount.Amount.Mul(nav.Price.Amount).Quo(sdkmath.NewIntFromUint64(nav.Volume))
+	if !amount.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("redemption %d is worth less than 1%s at the current net asset value", redemption.Id, payoutDenom)
+	}
+	return sdk.NewCoin(payoutDenom, amount), nil
+}
+
+// RejectRedemption returns the escrowed coins of a redemption to the holder.
+func (k Keeper) RejectRedemption(ctx sdk.Context, redemption types.Redemption, marker types.MarkerAccountI, admin sdk.AccAddress, reason string) error {
+	holder := sdk.MustAccAddressFromBech32(redemption.Holder)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(types.WithBypass(quarantine.WithBypass(ctx)), types.CoinPoolName, holder, sdk.NewCoins(redemption.Amount)); err != nil {
+		return fmt.Errorf("could not return %s to %s: %w", redemption.Amount, holder, err)
+	}
+	if err := k.removeRedemption(ctx, redemption); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRedemptionRejected(redemption, admin.String(), reason))
+}
+
+// getNextRedemptionID gets the id to use for the next redemption.
+func (k Keeper) getNextRedemptionID(ctx sdk.Context) (uint64, error) {
+	id, err := k.nextRedemptionID.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 1, nil
+		}
+		return 0, fmt.Errorf("could not read next redemption id: %w", err)
+	}
+	return id, nil
+}
+
+// GetNextRedemptionID gets the id that will be used for the next redemption.
+func (k Keeper) GetNextRedemptionID(ctx sdk.Context) uint64 {
+	id, err := k.getNextRedemptionID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SetNextRedemptionID sets the id that will be used for the next redemption.
+func (k Keeper) SetNextRedemptionID(ctx sdk.Context, id uint64) error {
+	if err := k.nextRedemptionID.Set(ctx, id); err != nil {
+		return fmt.Errorf("failed to set next redemption id: %w", err)
+	}
+	return nil
+}
+
+// SetRedemption stores the provided pending redemption and indexes it by marker and holder.
+func (k Keeper) SetRedemption(ctx sdk.Context, redemption types.Redemption) error {
+	if err := redemption.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(redemption.Denom)
+	if err != nil {
+		return err
+	}
+	holder := sdk.MustAccAddressFromBech32(redemption.Holder)
+	if err = k.redemptions.Set(ctx, redemption.Id, redemption); err != nil {
+		return fmt.Errorf("failed to set redemption %d: %w", redemption.Id, err)
+	}
+	if err = k.redemptionsByMarker.Set(ctx, collections.Join(markerAddr, redemption.Id), true); err != nil {
+		return fmt.Errorf("failed to index redemption %d by marker: %w", redemption.Id, err)
+	}
+	if err = k.redemptionsByHolder.Set(ctx, collections.Join(holder, redemption.Id), true); err != nil {
+		return fmt.Errorf("failed to index redemption %d by holder: %w", redemption.Id, err)
+	}
+	return nil
+}
+
+// removeRedemption deletes a pending redemption and its index entries.
+func (k Keeper) removeRedemption(ctx sdk.Context, redemption types.Redemption) error {
+	markerAddr, err := types.MarkerAddress(redemption.Denom)
+	if err != nil {
+		return err
+	}
+	holder := sdk.MustAccAddressFromBech32(redemption.Holder)
+	if err = k.redemptions.Remove(ctx, redemption.Id); err != nil {
+		return fmt.Errorf("failed to remove redemption %d: %w", redemption.Id, err)
+	}
+	if err = k.redemptionsByMarker.Remove(ctx, collections.Join(markerAddr, redemption.Id)); err != nil {
+		return fmt.Errorf("failed to remove redemption %d marker index: %w", redemption.Id, err)
+	}
+	if err = k.redemptionsByHolder.Remove(ctx, collections.Join(holder, redemption.Id)); err != nil {
+		return fmt.Errorf("failed to remove redemption %d holder index: %w", redemption.Id, err)
+	}
+	return nil
+}
+
+// GetRedemption gets the pending redemption with the provided id. Returns nil if it doesn't exist.
+func (k Keeper) GetRedemption(ctx sdk.Context, id uint64) (*types.Redemption, error) {
+	redemption, err := k.redemptions.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read redemption %d: %w", id, err)
+	}
+	return &redemption, nil
+}
+
+// getRedemptionForAdmin gets a pending redemption and its marker, making sure the admin has the required access.
+func (k Keeper) getRedemptionForAdmin(ctx sdk.Context, id uint64, admin string, access types.Access) (*types.Redemption, types.MarkerAccountI, error) {
+	redemption, err := k.GetRedemption(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if redemption == nil {
+		return nil, nil, sdkerrors.ErrNotFound.Wrapf("redemption %d not found", id)
+	}
+	marker, err := k.GetMarkerByDenom(ctx, redemption.Denom)
+	if err != nil {
+		return nil, nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = marker.ValidateHasAccess(admin, access); err != nil {
+		return nil, nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+	return redemption, marker, nil
+}
+
+// IterateRedemptions iterates over all pending redemptions in order of id.
+func (k Keeper) IterateRedemptions(ctx sdk.Context, cb func(redemption types.Redemption) (stop bool)) {
+	err := k.redemptions.Walk(ctx, nil, func(_ uint64, redemption types.Redemption) (bool, error) {
+		return cb(redemption), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
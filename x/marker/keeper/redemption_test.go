@@ -0,0 +1,255 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// redemptionFixture is a coin marker with two holders and a burner that pays out redemptions.
+type redemptionFixture struct {
+	*MsgServerTestSuite
+
+	denom      string
+	payDenom   string
+	markerAddr sdk.AccAddress
+	escrowAddr sdk.AccAddress
+	admin      sdk.AccAddress
+	burner     sdk.AccAddress
+	holder1    sdk.AccAddress
+	holder2    sdk.AccAddress
+}
+
+func (s *MsgServerTestSuite) newRedemptionFixture() *redemptionFixture {
+	f := &redemptionFixture{
+		MsgServerTestSuite: s,
+		denom:              "redeemcoin",
+		payDenom:           "usdpay",
+		markerAddr:         types.MustGetMarkerAddress("redeemcoin"),
+		escrowAddr:         authtypes.NewModuleAddress(types.CoinPoolName),
+		admin:              sdk.AccAddress("redeem_admin________"),
+		burner:             sdk.AccAddress("redeem_burner_______"),
+		holder1:            sdk.AccAddress("redeem_holder1______"),
+		holder2:            sdk.AccAddress("redeem_holder2______"),
+	}
+	s.blockStartTime = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(s.blockStartTime).WithBlockHeight(10)
+
+	storeTestMarker(s.T(), s.app, s.ctx, newTestMarker(f.denom, types.MarkerType_Coin, 300,
+		types.AccessGrant{Address: f.admin.String(), Permissions: types.AccessList{types.Access_Admin}},
+		types.AccessGrant{Address: f.burner.String(), Permissions: types.AccessList{types.Access_Burn}},
+	))
+
+	fundTestAccount(s.T(), s.app, s.ctx, f.holder1, sdk.NewInt64Coin(f.denom, 100))
+	fundTestAccount(s.T(), s.app, s.ctx, f.holder2, sdk.NewInt64Coin(f.denom, 200))
+	fundTestAccount(s.T(), s.app, s.ctx, f.burner, sdk.NewInt64Coin(f.payDenom, 1000))
+	return f
+}
+
+func (f *redemptionFixture) balance(addr sdk.AccAddress, denom string) int64 {
+	return f.app.BankKeeper.GetBalance(f.ctx, addr, denom).Amount.Int64()
+}
+
+func (f *redemptionFixture) setPayoutDenom(payoutDenom string) {
+	msg := types.NewMsgSetRedemptionPayoutDenomRequest(f.denom, f.admin, payoutDenom)
+	_, err := f.msgServer.SetRedemptionPayoutDenom(f.ctx, msg)
+	f.Require().NoError(err, "SetRedemptionPayoutDenom(%q)", payoutDenom)
+}
+
+func (f *redemptionFixture) request(holder sdk.AccAddress, amount int64) uint64 {
+	msg := types.NewMsgRequestRedemptionRequest(holder, sdk.NewInt64Coin(f.denom, amount))
+	resp, err := f.msgServer.RequestRedemption(f.ctx, msg)
+	f.Require().NoError(err, "RequestRedemption(%s, %d)", holder, amount)
+	return resp.RedemptionId
+}
+
+func (f *redemptionFixture) redemptionIDs(req *types.QueryRedemptionsRequest) []uint64 {
+	resp, err := f.app.MarkerKeeper.Redemptions(f.ctx, req)
+	f.Require().NoError(err, "Redemptions")
+	var rv []uint64
+	for _, redemption := range resp.Redemptions {
+		rv = append(rv, redemption.Id)
+	}
+	return rv
+}
+
+func (s *MsgServerTestSuite) TestRequestRedemption() {
+	f := s.newRedemptionFixture()
+	s.Run("payout denom not set", func() {
+		msg := types.NewMsgRequestRedemptionRequest(f.holder1, sdk.NewInt64Coin(f.denom, 10))
+		_, err := s.msgServer.RequestRedemption(s.ctx, msg)
+		s.Require().ErrorContains(err, "is not accepting redemptions")
+	})
+
+	s.Run("set payout denom without admin access", func() {
+		msg := types.NewMsgSetRedemptionPayoutDenomRequest(f.denom, f.burner, f.payDenom)
+		_, err := s.msgServer.SetRedemptionPayoutDenom(s.ctx, msg)
+		s.Require().ErrorContains(err, "does not have ACCESS_ADMIN")
+	})
+
+	f.setPayoutDenom(f.payDenom)
+
+	s.Run("insufficient funds", func() {
+		msg := types.NewMsgRequestRedemptionRequest(f.holder1, sdk.NewInt64Coin(f.denom, 101))
+		_, err := s.msgServer.RequestRedemption(s.ctx, msg)
+		s.Require().ErrorContains(err, "could not escrow")
+	})
+
+	id1 := f.request(f.holder1, 40)
+	id2 := f.request(f.holder2, 50)
+	id3 := f.request(f.holder1, 10)
+	s.Assert().Equal([]uint64{1, 2, 3}, []uint64{id1, id2, id3}, "redemption ids")
+	s.Assert().Equal(uint64(4), s.app.MarkerKeeper.GetNextRedemptionID(s.ctx), "GetNextRedemptionID")
+	s.Assert().Equal(int64(50), f.balance(f.holder1, f.denom), "holder1 balance")
+	s.Assert().Equal(int64(100), f.balance(f.escrowAddr, f.denom), "escrow balance")
+	s.Assert().Equal(int64(0), f.balance(f.markerAddr, f.denom), "marker account balance")
+
+	s.Run("escrowed coins cannot be burned", func() {
+		err := s.app.MarkerKeeper.BurnCoin(s.ctx, f.burner, sdk.NewInt64Coin(f.denom, 10))
+		s.Require().ErrorContains(err, "marker account contains insufficient funds to burn")
+	})
+
+	redemption, err := s.app.MarkerKeeper.GetRedemption(s.ctx, id1)
+	s.Require().NoError(err, "GetRedemption")
+	s.Require().NotNil(redemption, "GetRedemption")
+	s.Assert().Equal(f.holder1.String(), redemption.Holder, "holder")
+	s.Assert().Equal(int64(10), redemption.RequestedHeight, "requested height")
+
+	s.Assert().Equal([]uint64{1, 2, 3}, f.redemptionIDs(&types.QueryRedemptionsRequest{Id: f.denom}), "by marker")
+	s.Assert().Equal([]uint64{1, 3}, f.redemptionIDs(&types.QueryRedemptionsRequest{Holder: f.holder1.String()}), "by holder")
+	s.Assert().Equal([]uint64{2}, f.redemptionIDs(&types.QueryRedemptionsRequest{Id: f.markerAddr.String(), Holder: f.holder2.String()}), "by marker and holder")
+	_, err = s.app.MarkerKeeper.Redemptions(s.ctx, &types.QueryRedemptionsRequest{})
+	s.Assert().ErrorContains(err, "a marker id or holder is required", "Redemptions without filters")
+
+	f.setPayoutDenom("")
+	msg := types.NewMsgRequestRedemptionRequest(f.holder1, sdk.NewInt64Coin(f.denom, 10))
+	_, err = s.msgServer.RequestRedemption(s.ctx, msg)
+	s.Assert().ErrorContains(err, "is not accepting redemptions", "RequestRedemption after payout denom is cleared")
+}
+
+func (s *MsgServerTestSuite) TestRequestRedemptionRestrictedMarker() {
+	f := s.newRedemptionFixture()
+	denom := "redeemrestricted"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	denied := sdk.AccAddress("redeem_denied_______")
+	transferer := sdk.AccAddress("redeem_transferer___")
+
+	marker := newTestMarker(denom, types.MarkerType_RestrictedCoin, 300,
+		types.AccessGrant{Address: f.admin.String(), Permissions: types.AccessList{types.Access_Admin}},
+		types.AccessGrant{Address: transferer.String(), Permissions: types.AccessList{types.Access_Transfer}},
+	)
+	marker.RequiredAttributes = []string{"kyc.redeem.io"}
+	storeTestMarker(s.T(), s.app, s.ctx, marker)
+	for _, addr := range []sdk.AccAddress{f.holder1, denied, transferer} {
+		fundTestAccount(s.T(), s.app, s.ctx, addr, sdk.NewInt64Coin(denom, 100))
+	}
+	s.app.MarkerKeeper.AddSendDeny(s.ctx, markerAddr, denied)
+	setMsg := types.NewMsgSetRedemptionPayoutDenomRequest(denom, f.admin, f.payDenom)
+	_, err := s.msgServer.SetRedemptionPayoutDenom(s.ctx, setMsg)
+	s.Require().NoError(err, "SetRedemptionPayoutDenom")
+
+	tests := []struct {
+		name   string
+		holder sdk.AccAddress
+		expErr string
+	}{
+		{name: "on send deny list", holder: denied, expErr: "is on deny list for sending restricted marker"},
+		{name: "missing required attribute", holder: f.holder1, expErr: `does not contain the "redeemrestricted" required attribute: "kyc.redeem.io"`},
+		{name: "transfer access", holder: transferer},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			msg := types.NewMsgRequestRedemptionRequest(tc.holder, sdk.NewInt64Coin(denom, 10))
+			_, err := s.msgServer.RequestRedemption(s.ctx, msg)
+			if len(tc.expErr) > 0 {
+				s.Require().ErrorContains(err, tc.expErr, "RequestRedemption")
+				s.Assert().Equal(int64(100), f.balance(tc.holder, denom), "holder balance")
+			} else {
+				s.Require().NoError(err, "RequestRedemption")
+				s.Assert().Equal(int64(90), f.balance(tc.holder, denom), "holder balance")
+			}
+		})
+	}
+	s.Assert().Equal(int64(10), f.balance(f.escrowAddr, denom), "escrow balance")
+}
+
+func (s *MsgServerTestSuite) TestFulfillRedemption() {
+	f := s.newRedemptionFixture()
+	f.setPayoutDenom(f.payDenom)
+	id1 := f.request(f.holder1, 40)
+	id2 := f.request(f.holder2, 50)
+
+	s.Run("no burn access", func() {
+		_, err := s.msgServer.FulfillRedemption(s.ctx, types.NewMsgFulfillRedemptionRequest(f.admin, id1, nil))
+		s.Require().ErrorContains(err, "does not have ACCESS_BURN")
+	})
+
+	s.Run("no net asset value", func() {
+		_, err := s.msgServer.FulfillRedemption(s.ctx, types.NewMsgFulfillRedemptionRequest(f.burner, id1, nil))
+		s.Require().ErrorContains(err, "does not have a net asset value in usdpay")
+	})
+
+	s.Run("payout in wrong denom", func() {
+		payout := sdk.NewInt64Coin("other", 5)
+		_, err := s.msgServer.FulfillRedemption(s.ctx, types.NewMsgFulfillRedemptionRequest(f.burner, id1, &payout))
+		s.Require().ErrorContains(err, "must be in the redemption payout denom usdpay")
+	})
+
+	marker, err := s.app.MarkerKeeper.GetMarker(s.ctx, f.markerAddr)
+	s.Require().NoError(err, "GetMarker")
+	nav := types.NewNetAssetValue(sdk.NewInt64Coin(f.payDenom, 25), 10)
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValue(s.ctx, marker, nav, "test"), "SetNetAssetValue")
+
+	resp, err := s.msgServer.FulfillRedemption(s.ctx, types.NewMsgFulfillRedemptionRequest(f.burner, id1, nil))
+	s.Require().NoError(err, "FulfillRedemption at nav")
+	s.Assert().Equal("100usdpay", resp.Payout.String(), "payout at nav")
+	s.Assert().Equal(int64(100), f.balance(f.holder1, f.payDenom), "holder1 payout balance")
+
+	payout := sdk.NewInt64Coin(f.payDenom, 7)
+	resp, err = s.msgServer.FulfillRedemption(s.ctx, types.NewMsgFulfillRedemptionRequest(f.burner, id2, &payout))
+	s.Require().NoError(err, "FulfillRedemption with payout")
+	s.Assert().Equal("7usdpay", resp.Payout.String(), "given payout")
+	s.Assert().Equal(int64(7), f.balance(f.holder2, f.payDenom), "holder2 payout balance")
+
+	s.Assert().Equal(int64(893), f.balance(f.burner, f.payDenom), "burner payout balance")
+	s.Assert().Equal(int64(0), f.balance(f.escrowAddr, f.denom), "escrow balance")
+	s.Assert().Equal(int64(210), s.app.BankKeeper.GetSupply(s.ctx, f.denom).Amount.Int64(), "supply")
+	s.Assert().Empty(f.redemptionIDs(&types.QueryRedemptionsRequest{Id: f.denom}), "pending redemptions")
+
+	_, err = s.msgServer.FulfillRedemption(s.ctx, types.NewMsgFulfillRedemptionRequest(f.burner, id1, nil))
+	s.Assert().ErrorContains(err, "redemption 1 not found", "FulfillRedemption again")
+}
+
+func (s *MsgServerTestSuite) TestRejectRedemption() {
+	f := s.newRedemptionFixture()
+	f.setPayoutDenom(f.payDenom)
+	id := f.request(f.holder1, 40)
+
+	_, err := s.msgServer.RejectRedemption(s.ctx, types.NewMsgRejectRedemptionRequest(f.burner, id, "nope"))
+	s.Require().ErrorContains(err, "does not have ACCESS_ADMIN", "RejectRedemption without admin access")
+
+	_, err = s.msgServer.RejectRedemption(s.ctx, types.NewMsgRejectRedemptionRequest(f.admin, id, "window closed"))
+	s.Require().NoError(err, "RejectRedemption")
+	s.Assert().Equal(int64(100), f.balance(f.holder1, f.denom), "holder1 balance")
+	s.Assert().Equal(int64(0), f.balance(f.escrowAddr, f.denom), "escrow balance")
+	s.Assert().Empty(f.redemptionIDs(&types.QueryRedemptionsRequest{Holder: f.holder1.String()}), "pending redemptions")
+}
+
+func (s *MsgServerTestSuite) TestRedemptionGenesis() {
+	f := s.newRedemptionFixture()
+	f.setPayoutDenom(f.payDenom)
+	f.request(f.holder1, 40)
+	f.request(f.holder2, 50)
+
+	genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	s.Assert().Len(genState.Redemptions, 2, "exported redemptions")
+	s.Assert().Equal(uint64(3), genState.NextRedemptionId, "exported next redemption id")
+	s.Assert().Equal([]types.RedemptionPayoutDenom{{Denom: f.denom, PayoutDenom: f.payDenom}}, genState.RedemptionPayoutDenoms, "exported payout denoms")
+
+	genState.NextRedemptionId = 2
+	s.Assert().ErrorContains(genState.Validate(), "must be less than the next redemption id 2", "Validate with bad next redemption id")
+}
@@ -17,6 +17,14 @@ var _ banktypes.SendRestrictionFn = Keeper{}.SendRestrictionFn
 
 func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Holder balances can't change while a distribution or holder snapshot is recording them, even for sends with a bypass.
+	if err := k.validateNoPendingDistributionSnapshot(ctx, fromAddr, toAddr, amt); err != nil {
+		return nil, err
+	}
+	if err := k.validateNoPendingHolderSnapshot(ctx, fromAddr, toAddr, amt); err != nil {
+		return nil, err
+	}
+
 	// In some cases, it might not be possible to add a bypass to the context.
 	// If it's from either the Marker or IBC Transfer module accounts, assume proper validation has been done elsewhere.
 	if types.HasBypass(ctx) || fromAddr.Equals(k.markerModuleAddr) || fromAddr.Equals(k.ibcTransferModuleAddr) {
@@ -56,6 +64,12 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 			if err := types.ValidateAtLeastOneAddrHasAccess(fromMarker, admins, types.Access_Withdraw); err != nil {
 				return nil, err
 			}
+			if err := k.validateAtLeastOneAddrCanWithdrawTo(ctx, fromMarker, admins, toAddr); err != nil {
+				return nil, err
+			}
+			if err := k.validateWithdrawNeedsNoApproval(ctx, fromMarker); err != nil {
+				return nil, err
+			}
 		}
 
 		// Check to see if marker is active; the coins created by a marker can only be withdrawn when it is active.
@@ -86,7 +100,7 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 
 	// Check the ability to send each denom involved.
 	for _, coin := range amt {
-		if err := k.validateSendDenom(ctx, fromAddr, toAddr, admins, coin.Denom, toMarker); err != nil {
+		if err := k.validateSendDenom(ctx, fromAddr, toAddr, admins, coin, toMarker); err != nil {
 			return nil, err
 		}
 	}
@@ -94,9 +108,11 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 	return toAddr, nil
 }
 
-// validateSendDenom makes sure a send of the given denom is allowed for the given addresses.
+// validateSendDenom makes sure a send of the given coin is allowed for the given addresses.
+// If the marker has transfer limits, the amount is also recorded towards them.
 // This is NOT the validation that is needed for the marker Transfer endpoint.
-func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, admins []sdk.AccAddress, denom string, toMarker types.MarkerAccountI) error {
+func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, admins []sdk.AccAddress, coin sdk.Coin, toMarker types.MarkerAccountI) error {
+	denom := coin.Denom
 	markerAddr := types.MustGetMarkerAddress(denom)
 	marker, err := k.GetMarker(ctx, markerAddr)
 	if err != nil {
@@ -131,7 +147,7 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 		return fmt.Errorf("%s is on deny list for sending restricted marker", fromAddr.String())
 	}
 
-	// If the fromAddr has transfer access, there's nothing left to check.
+	// If the fromAddr has transfer access, there's nothing left to check (and transfer limits don't apply).
 	if marker.AddressHasAccess(fromAddr, types.Access_Transfer) {
 		return nil
 	}
@@ -157,10 +173,15 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	// If there aren't any required attributes, transfer permission is required unless coming from a bypass account.
 	// It's assumed that the only way the restricted coins without required attributes can get into a bypass
 	// account is by someone with transfer permission, which is then conveyed for this transfer too.
+	// Attribute requirements are treated the same as required attributes here.
 	reqAttr := marker.GetRequiredAttributes()
-	if len(reqAttr) == 0 {
+	requirements, err := k.GetAttributeRequirements(ctx, markerAddr)
+	if err != nil {
+		return err
+	}
+	if len(reqAttr) == 0 && len(requirements) == 0 {
 		if k.IsReqAttrBypassAddr(fromAddr) {
-			return nil
+			return k.checkTransferLimits(ctx, marker, fromAddr, coin.Amount)
 		}
 		return fmt.Errorf("%s does not have transfer permissions for %s", fromAddr.String(), denom)
 	}
@@ -169,7 +190,7 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	// If the toAddress has a bypass, skip checking the attributes and allow the transfer.
 	// When these funds are then being moved out of the bypass account, attributes are checked on that destination.
 	if k.IsReqAttrBypassAddr(toAddr) {
-		return nil
+		return k.checkTransferLimits(ctx, marker, fromAddr, coin.Amount)
 	}
 
 	attributes, err := k.attrKeeper.GetAllAttributesAddr(ctx, toAddr)
@@ -184,8 +205,11 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 		}
 		return fmt.Errorf("address %s does not contain the %q required attribute%s: \"%s\"", toAddr.String(), denom, pl, strings.Join(missing, `", "`))
 	}
+	if err = validateAttributeRequirements(ctx, denom, toAddr, requirements, attributes); err != nil {
+		return err
+	}
 
-	return nil
+	return k.checkTransferLimits(ctx, marker, fromAddr, coin.Amount)
 }
 
 // findMissingAttributes returns all entries in required that don't pass
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// updateSupplyIndexes updates the fixed supply and destroyed marker indexes for the given marker.
+// If queueCheck is true, and the marker is an active fixed-supply marker, its supply will be reconciled in the next begin block.
+func (k Keeper) updateSupplyIndexes(ctx sdk.Context, marker types.MarkerAccountI, queueCheck bool) {
+	addr := marker.GetAddress()
+	var err error
+	if marker.GetStatus() == types.StatusActive && marker.HasFixedSupply() {
+		err = k.fixedSupplyMarkers.Set(ctx, addr, true)
+		if err == nil && queueCheck {
+			err = k.supplyCheckQueue.Set(ctx, addr, true)
+		}
+	} else {
+		err = k.fixedSupplyMarkers.Remove(ctx, addr)
+		if err == nil {
+			err = k.supplyCheckQueue.Remove(ctx, addr)
+		}
+	}
+	if err != nil {
+		panic(fmt.Errorf("failed to update fixed supply marker index: %w", err))
+	}
+
+	if marker.GetStatus() == types.StatusDestroyed {
+		err = k.destroyedMarkers.Set(ctx, addr, true)
+	} else {
+		err = k.destroyedMarkers.Remove(ctx, addr)
+	}
+	if err != nil {
+		panic(fmt.Errorf("failed to update destroyed marker index: %w", err))
+	}
+}
+
+// removeSupplyIndexes removes a marker from the fixed supply and destroyed marker indexes.
+func (k Keeper) removeSupplyIndexes(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	if err := k.fixedSupplyMarkers.Remove(ctx, markerAddr); err != nil {
+		panic(fmt.Errorf("failed to remove fixed supply marker index: %w", err))
+	}
+	if err := k.supplyCheckQueue.Remove(ctx, markerAddr); err != nil {
+		panic(fmt.Errorf("failed to remove supply check queue entry: %w", err))
+	}
+	if err := k.destroyedMarkers.Remove(ctx, markerAddr); err != nil {
+		panic(fmt.Errorf("failed to remove destroyed marker index: %w", err))
+	}
+}
+
+// RebuildSupplyIndexes rebuilds the fixed supply and destroyed marker indexes from all markers.
+// Every active fixed-supply marker is queued to have its supply reconciled in the next begin block.
+func (k Keeper) RebuildSupplyIndexes(ctx sdk.Context) error {
+	if err := k.fixedSupplyMarkers.Clear(ctx, nil); err != nil {
+		return fmt.Errorf("failed to clear fixed supply marker index: %w", err)
+	}
+	if err := k.supplyCheckQueue.Clear(ctx, nil); err != nil {
+		return fmt.Errorf("failed to clear supply check queue: %w", err)
+	}
+	if err := k.destroyedMarkers.Clear(ctx, nil); err != nil {
+		return fmt.Errorf("failed to clear destroyed marker index: %w", err)
+	}
+	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		k.updateSupplyIndexes(ctx, marker, true)
+		return false
+	})
+	return nil
+}
+
+// IterateFixedSupplyMarkers iterates over all active markers that have a fixed supply.
+func (k Keeper) IterateFixedSupplyMarkers(ctx sdk.Context, cb func(marker types.MarkerAccountI) (stop bool)) {
+	for _, addr := range k.getIndexedAddrs(ctx, k.fixedSupplyMarkers) {
+		marker, err := k.GetMarker(ctx, addr)
+		if err != nil || marker == nil {
+			continue
+		}
+		if cb(marker) {
+			return
+		}
+	}
+}
+
+// GetSupplyCheckQueue gets the addresses of the markers that need their supply reconciled.
+func (k Keeper) GetSupplyCheckQueue(ctx sdk.Context) []sdk.AccAddress {
+	return k.getIndexedAddrs(ctx, k.supplyCheckQueue)
+}
+
+// DequeueSupplyCheck removes a marker from the supply check queue.
+func (k Keeper) DequeueSupplyCheck(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	if err := k.supplyCheckQueue.Remove(ctx, markerAddr); err != nil {
+		panic(fmt.Errorf("failed to remove supply check queue entry: %w", err))
+	}
+}
+
+// GetDestroyedMarkers gets the addresses of the markers that are waiting to be removed.
+func (k Keeper) GetDestroyedMarkers(ctx sdk.Context) []sdk.AccAddress {
+	return k.getIndexedAddrs(ctx, k.destroyedMarkers)
+}
+
+// getIndexedAddrs gets all the marker addresses in the provided index.
+func (k Keeper) getIndexedAddrs(ctx sdk.Context, index collections.Map[sdk.AccAddress, bool]) []sdk.AccAddress {
+	var rv []sdk.AccAddress
+	err := index.Walk(ctx, nil, func(addr sdk.AccAddress, _ bool) (bool, error) {
+		rv = append(rv, addr)
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to read marker index: %w", err))
+	}
+	return rv
+}
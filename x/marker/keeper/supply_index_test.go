@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSupplyIndexes(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	newMarker := func(denom string, status types.MarkerStatus, fixed bool) *types.MarkerAccount {
+		rv := &types.MarkerAccount{
+			BaseAccount: authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+			Status:      status,
+			SupplyFixed: fixed,
+			Denom:       denom,
+			Supply:      sdkmath.NewInt(100),
+			MarkerType:  types.MarkerType_Coin,
+		}
+		app.AccountKeeper.NewAccount(ctx, rv.BaseAccount)
+		require.NoError(t, app.MarkerKeeper.SetMarker(ctx, rv), "SetMarker(%q)", denom)
+		return rv
+	}
+	fixedMarker := newMarker("fixedcoin", types.StatusActive, true)
+	newMarker("floatingcoin", types.StatusActive, false)
+	newMarker("proposedcoin", types.StatusProposed, true)
+	destroyedMarker := newMarker("destroyedcoin", types.StatusDestroyed, true)
+
+	getFixed := func() []sdk.AccAddress {
+		var rv []sdk.AccAddress
+		app.MarkerKeeper.IterateFixedSupplyMarkers(ctx, func(marker types.MarkerAccountI) bool {
+			rv = append(rv, marker.GetAddress())
+			return false
+		})
+		return rv
+	}
+	assertIndexes := func(msg string) {
+		t.Helper()
+		assert.Equal(t, []sdk.AccAddress{fixedMarker.GetAddress()}, getFixed(), "fixed supply markers %s", msg)
+		assert.Equal(t, []sdk.AccAddress{fixedMarker.GetAddress()}, app.MarkerKeeper.GetSupplyCheckQueue(ctx), "supply check queue %s", msg)
+		assert.Equal(t, []sdk.AccAddress{destroyedMarker.GetAddress()}, app.MarkerKeeper.GetDestroyedMarkers(ctx), "destroyed markers %s", msg)
+	}
+	assertIndexes("after SetMarker")
+
+	app.MarkerKeeper.DequeueSupplyCheck(ctx, fixedMarker.GetAddress())
+	assert.Empty(t, app.MarkerKeeper.GetSupplyCheckQueue(ctx), "supply check queue after dequeue")
+
+	migrator := markerkeeper.NewMigrator(*app.MarkerKeeper)
+	require.NoError(t, migrator.Migrate3to4(ctx), "Migrate3to4")
+	assertIndexes("after Migrate3to4")
+
+	app.MarkerKeeper.RemoveMarker(ctx, fixedMarker)
+	app.MarkerKeeper.RemoveMarker(ctx, destroyedMarker)
+	assert.Empty(t, getFixed(), "fixed supply markers after RemoveMarker")
+	assert.Empty(t, app.MarkerKeeper.GetSupplyCheckQueue(ctx), "supply check queue after RemoveMarker")
+	assert.Empty(t, app.MarkerKeeper.GetDestroyedMarkers(ctx), "destroyed markers after RemoveMarker")
+}
@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetTransferLimits stores the transfer limits of a marker. If neither limit is set, the limits and
+// any recorded transfer volumes of the marker are removed.
+func (k Keeper) SetTransferLimits(ctx sdk.Context, markerAddr sdk.AccAddress, limits types.TransferLimits) error {
+	if err := limits.Validate(); err != nil {
+		return err
+	}
+	if !limits.HasLimits() {
+		return k.removeTransferLimits(ctx, markerAddr)
+	}
+	if err := k.transferLimits.Set(ctx, markerAddr, limits); err != nil {
+		return fmt.Errorf("failed to set transfer limits: %w", err)
+	}
+	return nil
+}
+
+// removeTransferLimits deletes the transfer limits and recorded transfer volumes of a marker.
+func (k Keeper) removeTransferLimits(ctx sdk.Context, markerAddr sdk.AccAddress) error {
+	if err := k.transferLimits.Remove(ctx, markerAddr); err != nil {
+		return fmt.Errorf("failed to remove transfer limits: %w", err)
+	}
+	holderRng := collections.NewPrefixedTripleRange[sdk.AccAddress, sdk.AccAddress, uint64](markerAddr)
+	if err := k.holderTransferVolumes.Clear(ctx, holderRng); err != nil {
+		return fmt.Errorf("failed to remove holder transfer volumes: %w", err)
+	}
+	markerRng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](markerAddr)
+	if err := k.markerTransferVolumes.Clear(ctx, markerRng); err != nil {
+		return fmt.Errorf("failed to remove marker transfer volumes: %w", err)
+	}
+	return nil
+}
+
+// GetTransferLimits gets the transfer limits of a marker. Returns nil if the marker doesn't have any.
+func (k Keeper) GetTransferLimits(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.TransferLimits, error) {
+	limits, err := k.transferLimits.Get(ctx, markerAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read transfer limits: %w", err)
+	}
+	return &limits, nil
+}
+
+// IterateTransferLimits iterates over the transfer limits of all markers.
+func (k Keeper) IterateTransferLimits(ctx sdk.Context, cb func(limits types.TransferLimits) (stop bool)) {
+	err := k.transferLimits.Walk(ctx, nil, func(_ sdk.AccAddress, limits types.TransferLimits) (bool, error) {
+		return cb(limits), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// checkTransferLimits makes sure that a send of the provided amount of a marker's coin from the holder
+// does not exceed the marker's transfer limits, and records the amount towards those limits.
+// Volumes that have fallen out of the window are removed along the way.
+func (k Keeper) checkTransferLimits(ctx sdk.Context, marker types.MarkerAccountI, holder sdk.AccAddress, amount sdkmath.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+	limits, err := k.GetTransferLimits(ctx, marker.GetAddress())
+	if err != nil || limits == nil {
+		return err
+	}
+
+	markerAddr := marker.GetAddress()
+	hour := types.TransferVolumeHour(ctx.BlockTime())
+	holderKey := collections.Join3(markerAddr, holder, hour)
+	markerKey := collections.Join(markerAddr, hour)
+
+	if limits.HolderDailyLimit.IsPositive() {
+		rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, sdk.AccAddress, uint64](markerAddr, holder)
+		sent, err := pruneHourlyVolumes(ctx, k.holderTransferVolumes, rng, hour,
+			func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, uint64]) uint64 { return key.K3() })
+		if err != nil {
+			return err
+		}
+		if sent.Add(amount).GT(limits.HolderDailyLimit) {
+			return fmt.Errorf("cannot send %s%s from %s: exceeds holder daily limit of %s%s (%s%s already sent)",
+				amount, marker.GetDenom(), holder, limits.HolderDailyLimit, marker.GetDenom(), sent, marker.GetDenom())
+		}
+	}
+	if limits.MarkerDailyLimit.IsPositive() {
+		rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](markerAddr)
+		sent, err := pruneHourlyVolumes(ctx, k.markerTransferVolumes, rng, hour,
+			func(key collections.Pair[sdk.AccAddress, uint64]) uint64 { return key.K2() })
+		if err != nil {
+			return err
+		}
+		if sent.Add(amount).GT(limits.MarkerDailyLimit) {
+			return fmt.Errorf("cannot send %s%s from %s: exceeds marker daily limit of %s%s (%s%s already sent)",
+				amount, marker.GetDenom(), holder, limits.MarkerDailyLimit, marker.GetDenom(), sent, marker.GetDenom())
+		}
+	}
+
+	if limits.HolderDailyLimit.IsPositive() {
+		if err = addHourlyVolume(ctx, k.holderTransferVolumes, holderKey, amount); err != nil {
+			return err
+		}
+	}
+	if limits.MarkerDailyLimit.IsPositive() {
+		if err = addHourlyVolume(ctx, k.markerTransferVolumes, markerKey, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetTransferVolume gets the amount sent in the window ending at the current block time.
+// If the holder is empty, the marker-wide amount is returned.
+func (k Keeper) GetTransferVolume(ctx sdk.Context, markerAddr, holder sdk.AccAddress) (sdkmath.Int, error) {
+	first := windowStartHour(types.TransferVolumeHour(ctx.BlockTime()))
+	total := sdkmath.ZeroInt()
+	var err error
+	if len(holder) > 0 {
+		rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, sdk.AccAddress, uint64](markerAddr, holder)
+		err = k.holderTransferVolumes.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, uint64], amount sdkmath.Int) (bool, error) {
+			if key.K3() >= first {
+				total = total.Add(amount)
+			}
+			return false, nil
+		})
+	} else {
+		rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](markerAddr)
+		err = k.markerTransferVolumes.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, uint64], amount sdkmath.Int) (bool, error) {
+			if key.K2() >= first {
+				total = total.Add(amount)
+			}
+			return false, nil
+		})
+	}
+	if err != nil {
+		return sdkmath.Int{}, fmt.Errorf("could not read transfer volumes: %w", err)
+	}
+	return total, nil
+}
+
+// GetAllTransferVolumes gets all recorded hourly transfer volumes.
+func (k Keeper) GetAllTransferVolumes(ctx sdk.Context) []types.TransferVolume {
+	var rv []types.TransferVolume
+	denoms := make(map[string]string)
+	getDenom := func(markerAddr sdk.AccAddress) (string, error) {
+		if denom, ok := denoms[string(markerAddr)]; ok {
+			return denom, nil
+		}
+		marker, err := k.GetMarker(ctx, markerAddr)
+		if err != nil {
+			return "", err
+		}
+		if marker == nil {
+			return "", fmt.Errorf("marker %s not found", markerAddr)
+		}
+		denoms[string(markerAddr)] = marker.GetDenom()
+		return marker.GetDenom(), nil
+	}
+
+	err := k.holderTransferVolumes.Walk(ctx, nil, func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, uint64], amount sdkmath.Int) (bool, error) {
+		denom, err := getDenom(key.K1())
+		if err != nil {
+			return true, err
+		}
+		rv = append(rv, types.TransferVolume{Denom: denom, Holder: key.K2().String(), Hour: key.K3(), Amount: amount})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = k.markerTransferVolumes.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, uint64], amount sdkmath.Int) (bool, error) {
+		denom, err := getDenom(key.K1())
+		if err != nil {
+			return true, err
+		}
+		rv = append(rv, types.TransferVolume{Denom: denom, Hour: key.K2(), Amount: amount})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// SetTransferVolume stores an hourly transfer volume.
+func (k Keeper) SetTransferVolume(ctx sdk.Context, volume types.TransferVolume) error {
+	if err := volume.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(volume.Denom)
+	if err != nil {
+		return err
+	}
+	if len(volume.Holder) == 0 {
+		err = k.markerTransferVolumes.Set(ctx, collections.Join(markerAddr, volume.Hour), volume.Amount)
+	} else {
+		holder := sdk.MustAccAddressFromBech32(volume.Holder)
+		err = k.holderTransferVolumes.Set(ctx, collections.Join3(markerAddr, holder, volume.Hour), volume.Amount)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set transfer volume: %w", err)
+	}
+	return nil
+}
+
+// windowStartHour returns the first hour that is in the transfer limit window that ends in the provided hour.
+func windowStartHour(hour uint64) uint64 {
+	if hour < types.TransferLimitWindowHours {
+		return 0
+	}
+	return hour - types.TransferLimitWindowHours + 1
+}
+
+// pruneHourlyVolumes removes the volumes in the range that are before the window ending in the
+// provided hour, and returns the sum of the rest. It's used for both transfer and mint volumes.
+func pruneHourlyVolumes[K any](ctx sdk.Context, volumes collections.Map[K, sdkmath.Int], rng collections.Ranger[K], hour uint64, getHour func(K) uint64) (sdkmath.Int, error) {
+	first := windowStartHour(hour)
+	total := sdkmath.ZeroInt()
+	var stale []K
+	err := volumes.Walk(ctx, rng, func(key K, amount sdkmath.Int) (bool, error) {
+		if getHour(key) < first {
+			stale = append(stale, key)
+		} else {
+			total = total.Add(amount)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return sdkmath.Int{}, fmt.Errorf("could not read hourly volumes: %w", err)
+	}
+	for _, key := range stale {
+		if err = volumes.Remove(ctx, key); err != nil {
+			return sdkmath.Int{}, fmt.Errorf("failed to remove hourly volume: %w", err)
+		}
+	}
+	return total, nil
+}
+
+// addHourlyVolume adds the amount to the volume with the provided key.
+func addHourlyVolume[K any](ctx sdk.Context, volumes collections.Map[K, sdkmath.Int], key K, amount sdkmath.Int) error {
+	cur, err := volumes.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("could not read hourly volume: %w", err)
+		}
+		cur = sdkmath.ZeroInt()
+	}
+	if err = volumes.Set(ctx, key, cur.Add(amount)); err != nil {
+		return fmt.Errorf("failed to set hourly volume: %w", err)
+	}
+	return nil
+}
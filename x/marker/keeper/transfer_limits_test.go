@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// transferLimitsFixture is a restricted marker that requires an attribute, with three funded accounts that have it.
+type transferLimitsFixture struct {
+	*MsgServerTestSuite
+
+	denom      string
+	markerAddr sdk.AccAddress
+	admin      sdk.AccAddress
+	holder1    sdk.AccAddress
+	holder2    sdk.AccAddress
+	receiver   sdk.AccAddress
+}
+
+func (s *MsgServerTestSuite) newTransferLimitsFixture() *transferLimitsFixture {
+	f := &transferLimitsFixture{
+		MsgServerTestSuite: s,
+		denom:              "limitcoin",
+		markerAddr:         types.MustGetMarkerAddress("limitcoin"),
+		admin:              sdk.AccAddress("limit_admin_________"),
+		holder1:            sdk.AccAddress("limit_holder1_______"),
+		holder2:            sdk.AccAddress("limit_holder2_______"),
+		receiver:           sdk.AccAddress("limit_receiver______"),
+	}
+	s.blockStartTime = time.Date(2026, 6, 1, 12, 30, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(s.blockStartTime)
+
+	nameOwner := sdk.AccAddress("limit_name_owner____")
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, nameOwner))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "kyc.provenance.io", nameOwner, false), "SetNameRecord")
+	for _, addr := range []sdk.AccAddress{f.holder1, f.holder2, f.receiver} {
+		attr := attrtypes.Attribute{
+			Name:          "kyc.provenance.io",
+			Value:         []byte("ok"),
+			Address:       addr.String(),
+			AttributeType: attrtypes.AttributeType_String,
+		}
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, nameOwner), "SetAttribute %s", addr)
+	}
+
+	marker := newTestMarker(f.denom, types.MarkerType_RestrictedCoin, 1000,
+		types.AccessGrant{Address: f.admin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Transfer}},
+	)
+	marker.RequiredAttributes = []string{"kyc.provenance.io"}
+	storeTestMarker(s.T(), s.app, s.ctx, marker)
+
+	for _, addr := range []sdk.AccAddress{f.admin, f.holder1, f.holder2} {
+		fundTestAccount(s.T(), s.app, s.ctx, addr, sdk.NewInt64Coin(f.denom, 100))
+	}
+	return f
+}
+
+func (f *transferLimitsFixture) setLimits(holderLimit, markerLimit int64) {
+	msg := types.NewMsgSetTransferLimitsRequest(f.denom, f.admin, sdkmath.NewInt(holderLimit), sdkmath.NewInt(markerLimit))
+	_, err := f.msgServer.SetTransferLimits(f.ctx, msg)
+	f.Require().NoError(err, "SetTransferLimits(%d, %d)", holderLimit, markerLimit)
+}
+
+// send sends coins to the receiver in a block that's the given number of hours after the start time.
+// The send is only committed if it succeeds.
+func (f *transferLimitsFixture) send(hours int, from sdk.AccAddress, amount int64) error {
+	ctx, writeCache := f.ctx.WithBlockTime(f.blockStartTime.Add(time.Duration(hours) * time.Hour)).CacheContext()
+	err := f.app.BankKeeper.SendCoins(ctx, from, f.receiver, sdk.NewCoins(sdk.NewInt64Coin(f.denom, amount)))
+	if err == nil {
+		writeCache()
+	}
+	return err
+}
+
+func (s *MsgServerTestSuite) TestSetTransferLimits() {
+	f := s.newTransferLimitsFixture()
+	s.Run("not an admin", func() {
+		msg := types.NewMsgSetTransferLimitsRequest(f.denom, f.holder1, sdkmath.NewInt(1), sdkmath.NewInt(1))
+		_, err := s.msgServer.SetTransferLimits(s.ctx, msg)
+		s.Require().ErrorContains(err, "does not have ACCESS_ADMIN")
+	})
+
+	f.setLimits(50, 0)
+	limits, err := s.app.MarkerKeeper.GetTransferLimits(s.ctx, f.markerAddr)
+	s.Require().NoError(err, "GetTransferLimits")
+	s.Require().NotNil(limits, "GetTransferLimits")
+	s.Assert().Equal("50", limits.HolderDailyLimit.String(), "holder daily limit")
+
+	s.Require().NoError(f.send(0, f.holder1, 20), "send")
+	f.setLimits(0, 0)
+	limits, err = s.app.MarkerKeeper.GetTransferLimits(s.ctx, f.markerAddr)
+	s.Require().NoError(err, "GetTransferLimits after clearing")
+	s.Assert().Nil(limits, "GetTransferLimits after clearing")
+	s.Assert().Empty(s.app.MarkerKeeper.GetAllTransferVolumes(s.ctx), "transfer volumes after clearing")
+}
+
+func (s *MsgServerTestSuite) TestHolderDailyLimit() {
+	f := s.newTransferLimitsFixture()
+	f.setLimits(50, 0)
+
+	s.Require().NoError(f.send(0, f.holder1, 30), "send 30 at hour 0")
+	s.Require().NoError(f.send(10, f.holder1, 20), "send 20 at hour 10")
+	s.Assert().ErrorContains(f.send(23, f.holder1, 1), "exceeds holder daily limit of 50limitcoin (50limitcoin already sent)", "send 1 at hour 23")
+	s.Require().NoError(f.send(23, f.holder2, 50), "holder2 send 50 at hour 23")
+
+	s.Require().NoError(f.send(24, f.holder1, 30), "send 30 at hour 24")
+	s.Assert().ErrorContains(f.send(24, f.holder1, 1), "exceeds holder daily limit", "send 1 at hour 24")
+
+	s.Require().NoError(f.send(0, f.admin, 100), "send by admin with transfer access")
+}
+
+func (s *MsgServerTestSuite) TestMarkerDailyLimit() {
+	f := s.newTransferLimitsFixture()
+	f.setLimits(0, 60)
+
+	s.Require().NoError(f.send(0, f.holder1, 40), "holder1 send 40")
+	s.Assert().ErrorContains(f.send(1, f.holder2, 21), "exceeds marker daily limit of 60limitcoin (40limitcoin already sent)", "holder2 send 21")
+	s.Require().NoError(f.send(1, f.holder2, 20), "holder2 send 20")
+
+	resp, err := s.app.MarkerKeeper.TransferLimits(s.ctx.WithBlockTime(s.blockStartTime.Add(time.Hour)),
+		&types.QueryTransferLimitsRequest{Id: f.denom, Holder: f.holder2.String()})
+	s.Require().NoError(err, "TransferLimits query")
+	s.Assert().Equal("60", resp.Limits.MarkerDailyLimit.String(), "marker daily limit")
+	s.Assert().Equal("60", resp.MarkerVolume.String(), "marker volume")
+	s.Assert().Equal("0", resp.HolderVolume.String(), "holder volume (not tracked without a holder limit)")
+
+	genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	s.Assert().Len(genState.TransferLimits, 1, "exported transfer limits")
+	s.Assert().Len(genState.TransferVolumes, 2, "exported transfer volumes")
+
+	s.Require().NoError(f.send(24, f.holder2, 40), "holder2 send 40 once the first send leaves the window")
+}
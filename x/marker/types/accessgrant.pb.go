@@ -56,6 +56,10 @@ const (
 	// ACCESS_FORCE_TRANSFER is the ability to transfer restricted coins from a 3rd-party account without their signature.
 	// This access right is only supported on RESTRICTED markers and only has meaning when allow_forced_transfer is true.
 	Access_ForceTransfer Access = 8
+	// ACCESS_FREEZE is the ability to freeze an amount of the marker's coins in a holder's account.
+	// Frozen funds are locked: they cannot be sent or used for exchange orders until the freeze is removed or expires.
+	// This access right is only supported on RESTRICTED markers.
+	Access_Freeze Access = 9
 )
 
 var Access_name = map[int32]string{
@@ -68,6 +72,7 @@ var Access_name = map[int32]string{
 	6: "ACCESS_ADMIN",
 	7: "ACCESS_TRANSFER",
 	8: "ACCESS_FORCE_TRANSFER",
+	9: "ACCESS_FREEZE",
 }
 
 var Access_value = map[string]int32{
@@ -80,6 +85,7 @@ var Access_value = map[string]int32{
 	"ACCESS_ADMIN":          6,
 	"ACCESS_TRANSFER":       7,
 	"ACCESS_FORCE_TRANSFER": 8,
+	"ACCESS_FREEZE":         9,
 }
 
 func (x Access) String() string {
@@ -138,39 +144,39 @@ func init() {
 }
 
 var fileDescriptor_7242c30a84644575 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x31, 0x6f, 0xd3, 0x4c,
-	0x18, 0xc7, 0xed, 0xb4, 0x4d, 0xd2, 0x4b, 0x9a, 0xd7, 0xef, 0xa9, 0x88, 0xd4, 0x14, 0xc7, 0x80,
-	0x84, 0x2a, 0x44, 0x6d, 0xb5, 0x6c, 0x6c, 0x4e, 0xec, 0x80, 0xa5, 0xc6, 0x8d, 0x1c, 0x47, 0x91,
-	0x58, 0x2a, 0xd7, 0x39, 0xd2, 0x53, 0xc9, 0x5d, 0x74, 0xe7, 0xa6, 0xf4, 0x1b, 0x20, 0x4f, 0x2c,
-	0x48, 0x2c, 0x96, 0x32, 0x33, 0xf7, 0x43, 0x20, 0xa6, 0x8e, 0x6c, 0xa0, 0x64, 0xe1, 0x63, 0xa0,
-	0xe4, 0x12, 0xe2, 0xa1, 0xdb, 0xf3, 0xdc, 0xff, 0x77, 0x3f, 0x3d, 0xba, 0x7b, 0xc0, 0xf3, 0x11,
-	0xa3, 0x63, 0x44, 0x42, 0x12, 0x21, 0x73, 0x18, 0xb2, 0x4b, 0xc4, 0xcc, 0xf1, 0x91, 0x19, 0x46,
-	0x11, 0xe2, 0x7c, 0xc0, 0x42, 0x12, 0x1b, 0x23, 0x46, 0x63, 0x0a, 0x77, 0xd7, 0x9c, 0x21, 0x38,
-	0x63, 0x7c, 0xa4, 0xee, 0x0e, 0xe8, 0x80, 0x2e, 0x00, 0x73, 0x5e, 0x09, 0x56, 0xdd, 0x8b, 0x28,
-	0x1f, 0x52, 0x7e, 0x26, 0x02, 0xd1, 0x88, 0xe8, 0xe9, 0x17, 0x19, 0x94, 0xac, 0x85, 0xfc, 0xcd,
-	0x5c, 0x0e, 0xab, 0xa0, 0x10, 0xf6, 0xfb, 0x0c, 0x71, 0x5e, 0x95, 0x75, 0xf9, 0x60, 0xdb, 0x5f,
-	0xb5, 0xd0, 0x03, 0xa5, 0x11, 0x62, 0x43, 0xcc, 0x39, 0xa6, 0x84, 0x57, 0x73, 0xfa, 0xc6, 0x41,
-	0xe5, 0x78, 0xdf, 0xb8, 0x6f, 0x0c, 0x43, 0x18, 0xeb, 0x95, 0x6f, 0xbf, 0x6a, 0x40, 0xd4, 0x27,
-	0x98, 0xc7, 0x7e, 0x56, 0xf0, 0x7a, 0xff, 0xd3, 0xa4, 0x26, 0x7d, 0x9d, 0xd4, 0xa4, 0x3f, 0x93,
-	0x9a, 0xfc, 0xe3, 0xf6, 0xb0, 0x9c, 0x19, 0xc3, 0x7d, 0x71, 0x9b, 0x03, 0x79, 0x71, 0x00, 0x9f,
-	0x01, 0x68, 0x35, 0x1a, 0x4e, 0xa7, 0x73, 0xd6, 0xf5, 0x3a, 0x6d, 0xa7, 0xe1, 0x36, 0x5d, 0xc7,
-	0x56, 0x24, 0xb5, 0x94, 0xa4, 0x7a, 0xa1, 0x4b, 0x2e, 0x09, 0xbd, 0x26, 0x70, 0x0f, 0x94, 0x96,
-	0x50, 0xcb, 0xf5, 0x02, 0x45, 0x56, 0x8b, 0x49, 0xaa, 0x6f, 0xb6, 0x30, 0x89, 0x33, 0x51, 0xbd,
-	0xeb, 0x7b, 0x4a, 0x4e, 0x44, 0xf5, 0x2b, 0x46, 0x60, 0x0d, 0x54, 0x96, 0x91, 0xed, 0xb4, 0x4f,
-	0x3b, 0x6e, 0xa0, 0x6c, 0x08, 0xad, 0x8d, 0x46, 0x94, 0xe3, 0x18, 0x3e, 0x01, 0xff, 0x2d, 0x81,
-	0x9e, 0x1b, 0xbc, 0xb5, 0x7d, 0xab, 0xa7, 0x6c, 0xaa, 0xe5, 0x24, 0xd5, 0x8b, 0x3d, 0x1c, 0x5f,
-	0xf4, 0x59, 0x78, 0x0d, 0x1f, 0x83, 0x9d, 0x7f, 0x8e, 0x13, 0x27, 0x70, 0x94, 0x2d, 0x15, 0x24,
-	0xa9, 0x9e, 0xb7, 0xd1, 0x07, 0x14, 0x23, 0xf8, 0x08, 0x94, 0x97, 0xb1, 0x65, 0xb7, 0x5c, 0x4f,
-	0xc9, 0xab, 0xdb, 0x49, 0xaa, 0x6f, 0x59, 0xfd, 0x21, 0x26, 0x19, 0x7d, 0xe0, 0x5b, 0x5e, 0xa7,
-	0xe9, 0xf8, 0x4a, 0x41, 0xe8, 0x03, 0x16, 0x12, 0xfe, 0x1e, 0x31, 0xf8, 0x12, 0x3c, 0x58, 0x22,
-	0xcd, 0x53, 0xbf, 0xe1, 0xac, 0xc1, 0xa2, 0xfa, 0x7f, 0x92, 0xea, 0x3b, 0x4d, 0xca, 0x22, 0xb4,
-	0xa2, 0xeb, 0x37, 0xdf, 0xa7, 0x9a, 0x7c, 0x37, 0xd5, 0xe4, 0xdf, 0x53, 0x4d, 0xfe, 0x3c, 0xd3,
-	0xa4, 0xbb, 0x99, 0x26, 0xfd, 0x9c, 0x69, 0x12, 0x78, 0x88, 0xe9, 0xbd, 0x7f, 0x55, 0x57, 0x32,
-	0xef, 0xde, 0x9e, 0xef, 0x44, 0x5b, 0x7e, 0x77, 0x3c, 0xc0, 0xf1, 0xc5, 0xd5, 0xb9, 0x11, 0xd1,
-	0xa1, 0xb9, 0xbe, 0x74, 0x88, 0x69, 0xa6, 0x33, 0x3f, 0xae, 0xf6, 0x33, 0xbe, 0x19, 0x21, 0x7e,
-	0x9e, 0x5f, 0x2c, 0xd4, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x70, 0xf5, 0x75, 0xc1,
-	0x02, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xed, 0xb4, 0xcd, 0x9f, 0x4b, 0x1a, 0x8c, 0x55, 0x44, 0x6a, 0x8a, 0x63, 0x40, 0x42,
+	0x15, 0xa2, 0xb6, 0x5a, 0x36, 0x36, 0x27, 0x3e, 0x83, 0xa5, 0xc6, 0x8d, 0x1c, 0x47, 0x91, 0xba,
+	0x54, 0xae, 0x73, 0xa4, 0x56, 0xc9, 0x5d, 0x74, 0xe7, 0xa6, 0x94, 0x4f, 0x80, 0x3c, 0xb1, 0x20,
+	0xb1, 0x58, 0xca, 0xcc, 0xcc, 0x87, 0x40, 0x4c, 0x95, 0x58, 0xd8, 0x40, 0xc9, 0xc2, 0xc7, 0x40,
+	0xc9, 0xa5, 0x8d, 0x87, 0x6e, 0xf7, 0xfa, 0xf9, 0xf9, 0xa7, 0x47, 0x7a, 0x5f, 0xf0, 0x7c, 0x44,
+	0xc9, 0x18, 0xe1, 0x00, 0x87, 0xc8, 0x18, 0x06, 0xf4, 0x1c, 0x51, 0x63, 0xbc, 0x6f, 0x04, 0x61,
+	0x88, 0x18, 0x1b, 0xd0, 0x00, 0xc7, 0xfa, 0x88, 0x92, 0x98, 0xc8, 0x5b, 0x2b, 0x4e, 0xe7, 0x9c,
+	0x3e, 0xde, 0x57, 0xb6, 0x06, 0x64, 0x40, 0x16, 0x80, 0x31, 0x7f, 0x71, 0x56, 0xd9, 0x0e, 0x09,
+	0x1b, 0x12, 0x76, 0xc2, 0x03, 0x3e, 0xf0, 0xe8, 0xe9, 0x17, 0x11, 0x94, 0xcd, 0x85, 0xfc, 0xcd,
+	0x5c, 0x2e, 0xd7, 0x40, 0x21, 0xe8, 0xf7, 0x29, 0x62, 0xac, 0x26, 0x6a, 0xe2, 0x6e, 0xc9, 0xbb,
+	0x19, 0x65, 0x17, 0x94, 0x47, 0x88, 0x0e, 0x23, 0xc6, 0x22, 0x82, 0x59, 0x2d, 0xa7, 0xad, 0xed,
+	0x56, 0x0f, 0x76, 0xf4, 0xbb, 0x6a, 0xe8, 0xdc, 0xd8, 0xa8, 0x7e, 0xfb, 0x53, 0x07, 0xfc, 0x7d,
+	0x18, 0xb1, 0xd8, 0xcb, 0x0a, 0x5e, 0xef, 0x7c, 0x9a, 0xd4, 0x85, 0xaf, 0x93, 0xba, 0xf0, 0x6f,
+	0x52, 0x17, 0x7f, 0x7e, 0xdf, 0xab, 0x64, 0x6a, 0x38, 0x2f, 0x7e, 0xe5, 0x40, 0x9e, 0x7f, 0x90,
+	0x9f, 0x01, 0xd9, 0x6c, 0x36, 0x61, 0xa7, 0x73, 0xd2, 0x75, 0x3b, 0x6d, 0xd8, 0x74, 0x6c, 0x07,
+	0x5a, 0x92, 0xa0, 0x94, 0x93, 0x54, 0x2b, 0x74, 0xf1, 0x39, 0x26, 0x97, 0x58, 0xde, 0x06, 0xe5,
+	0x25, 0xd4, 0x72, 0x5c, 0x5f, 0x12, 0x95, 0x62, 0x92, 0x6a, 0xeb, 0xad, 0x08, 0xc7, 0x99, 0xa8,
+	0xd1, 0xf5, 0x5c, 0x29, 0xc7, 0xa3, 0xc6, 0x05, 0xc5, 0x72, 0x1d, 0x54, 0x97, 0x91, 0x05, 0xdb,
+	0x47, 0x1d, 0xc7, 0x97, 0xd6, 0xb8, 0xd6, 0x42, 0x23, 0xc2, 0xa2, 0x58, 0x7e, 0x02, 0xee, 0x2d,
+	0x81, 0x9e, 0xe3, 0xbf, 0xb5, 0x3c, 0xb3, 0x27, 0xad, 0x2b, 0x95, 0x24, 0xd5, 0x8a, 0xbd, 0x28,
+	0x3e, 0xeb, 0xd3, 0xe0, 0x52, 0x7e, 0x0c, 0x36, 0x6f, 0x1d, 0x87, 0xd0, 0x87, 0xd2, 0x86, 0x02,
+	0x92, 0x54, 0xcb, 0x5b, 0xe8, 0x3d, 0x8a, 0x91, 0xfc, 0x08, 0x54, 0x96, 0xb1, 0x69, 0xb5, 0x1c,
+	0x57, 0xca, 0x2b, 0xa5, 0x24, 0xd5, 0x36, 0xcc, 0xfe, 0x30, 0xc2, 0x19, 0xbd, 0xef, 0x99, 0x6e,
+	0xc7, 0x86, 0x9e, 0x54, 0xe0, 0x7a, 0x9f, 0x06, 0x98, 0xbd, 0x43, 0x54, 0x7e, 0x09, 0x1e, 0x2c,
+	0x11, 0xfb, 0xc8, 0x6b, 0xc2, 0x15, 0x58, 0x54, 0xee, 0x27, 0xa9, 0xb6, 0x69, 0x13, 0x1a, 0xa2,
+	0x5b, 0x7a, 0x55, 0xc6, 0xf6, 0x20, 0x3c, 0x86, 0x52, 0x89, 0x97, 0xb1, 0x29, 0x42, 0x1f, 0x51,
+	0xe3, 0xea, 0xc7, 0x54, 0x15, 0xaf, 0xa7, 0xaa, 0xf8, 0x77, 0xaa, 0x8a, 0x9f, 0x67, 0xaa, 0x70,
+	0x3d, 0x53, 0x85, 0xdf, 0x33, 0x55, 0x00, 0x0f, 0x23, 0x72, 0xe7, 0x2a, 0x1b, 0x52, 0x66, 0x2d,
+	0xed, 0xf9, 0xc9, 0xb4, 0xc5, 0xe3, 0x83, 0x41, 0x14, 0x9f, 0x5d, 0x9c, 0xea, 0x21, 0x19, 0x1a,
+	0xab, 0x9f, 0xf6, 0x22, 0x92, 0x99, 0x8c, 0x0f, 0x37, 0xe7, 0x1b, 0x5f, 0x8d, 0x10, 0x3b, 0xcd,
+	0x2f, 0xee, 0xed, 0xd5, 0xff, 0x01, 0x00, 0x0d, 0xe3, 0x5a, 0xc5, 0xe0, 0x02, 0x00, 0x00,
 }
 
 func (this *AccessGrant) Equal(that interface{}) bool {
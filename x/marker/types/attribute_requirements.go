@@ -0,0 +1,286 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// MaxAttributeRequirements is the most attribute requirements that a single marker can have.
+const MaxAttributeRequirements = 20
+
+// requirementNumberPrecision is the precision (in bits) used when comparing numeric attribute values.
+const requirementNumberPrecision = 256
+
+// Validate checks that the attribute requirements are valid.
+func (r AttributeRequirements) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return fmt.Errorf("invalid attribute requirements denom: %w", err)
+	}
+	if err := ValidateAttributeRequirements(r.Requirements); err != nil {
+		return fmt.Errorf("invalid %s attribute requirements: %w", r.Denom, err)
+	}
+	return nil
+}
+
+// Descriptions returns the description of each of the requirements.
+func (r AttributeRequirements) Descriptions() []string {
+	rv := make([]string, len(r.Requirements))
+	for i, req := range r.Requirements {
+		rv[i] = req.Description()
+	}
+	return rv
+}
+
+// ValidateAttributeRequirements checks that there aren't too many requirements and that each is valid.
+func ValidateAttributeRequirements(requirements []AttributeRequirement) error {
+	if len(requirements) > MaxAttributeRequirements {
+		return fmt.Errorf("cannot have more than %d attribute requirements, found %d", MaxAttributeRequirements, len(requirements))
+	}
+	for i, req := range requirements {
+		if err := req.Validate(); err != nil {
+			return fmt.Errorf("requirement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks that the attribute requirement is valid.
+func (r AttributeRequirement) Validate() error {
+	if len(strings.TrimSpace(strings.TrimPrefix(r.Name, "*."))) == 0 {
+		return fmt.Errorf("attribute name cannot be empty")
+	}
+	if len(r.JsonPath) > 0 {
+		for _, key := range strings.Split(r.JsonPath, ".") {
+			if len(key) == 0 {
+				return fmt.Errorf("invalid %s json path %q: keys cannot be empty", r.Name, r.JsonPath)
+			}
+		}
+	}
+	if r.MinValidity < 0 {
+		return fmt.Errorf("invalid %s min validity %s: cannot be negative", r.Name, r.MinValidity)
+	}
+
+	switch r.Condition {
+	case AttributeCondition_Present:
+		if len(r.Values) != 0 {
+			return fmt.Errorf("%s condition on %s cannot have any values", r.Condition, r.Name)
+		}
+	case AttributeCondition_Equal:
+		if len(r.Values) != 1 {
+			return fmt.Errorf("%s condition on %s must have exactly one value, found %d", r.Condition, r.Name, len(r.Values))
+		}
+	case AttributeCondition_In, AttributeCondition_NotIn:
+		if len(r.Values) == 0 {
+			return fmt.Errorf("%s condition on %s must have at least one value", r.Condition, r.Name)
+		}
+	case AttributeCondition_GreaterThan, AttributeCondition_GreaterThanOrEqual,
+		AttributeCondition_LessThan, AttributeCondition_LessThanOrEqual:
+		if len(r.Values) != 1 {
+			return fmt.Errorf("%s condition on %s must have exactly one value, found %d", r.Condition, r.Name, len(r.Values))
+		}
+		if _, ok := parseRequirementNumber(r.Values[0]); !ok {
+			return fmt.Errorf("%s condition on %s must have a numeric value, found %q", r.Condition, r.Name, r.Values[0])
+		}
+	default:
+		return fmt.Errorf("unknown attribute condition %s on %s", r.Condition, r.Name)
+	}
+	return nil
+}
+
+// Description returns a human-readable form of the requirement, e.g. `kyc.pb:country in {US, CA}`.
+func (r AttributeRequirement) Description() string {
+	subject := r.Name
+	if len(r.JsonPath) > 0 {
+		subject += ":" + r.JsonPath
+	}
+
+	var rv string
+	switch r.Condition {
+	case AttributeCondition_Present:
+		rv = subject + " is present"
+	case AttributeCondition_In:
+		rv = fmt.Sprintf("%s in {%s}", subject, strings.Join(r.Values, ", "))
+	case AttributeCondition_NotIn:
+		rv = fmt.Sprintf("%s not in {%s}", subject, strings.Join(r.Values, ", "))
+	default:
+		op := map[AttributeCondition]string{
+			AttributeCondition_Equal:              "==",
+			AttributeCondition_GreaterThan:        ">",
+			AttributeCondition_GreaterThanOrEqual: ">=",
+			AttributeCondition_LessThan:           "<",
+			AttributeCondition_LessThanOrEqual:    "<=",
+		}[r.Condition]
+		if len(op) == 0 || len(r.Values) == 0 {
+			rv = fmt.Sprintf("%s %s", subject, r.Condition)
+		} else {
+			rv = fmt.Sprintf("%s %s %s", subject, op, r.Values[0])
+		}
+	}
+
+	if r.MinValidity > 0 {
+		rv += fmt.Sprintf(" and valid for %s", r.MinValidity)
+	}
+	return rv
+}
+
+// IsMetByAttribute returns true if the provided attribute is unexpired and its value passes this requirement.
+// It is assumed that the attribute's name has already been matched against this requirement's name.
+func (r AttributeRequirement) IsMetByAttribute(attr attrtypes.Attribute, blockTime time.Time) bool {
+	switch {
+	case attr.ExpirationDate != nil && !attr.ExpirationDate.After(blockTime):
+		return false
+	case r.MinValidity <= 0:
+	case attr.ExpirationDate == nil || attr.ExpirationDate.Before(blockTime.Add(r.MinValidity)):
+		return false
+	}
+
+	if r.Condition == AttributeCondition_Present {
+		if len(r.JsonPath) == 0 {
+			return true
+		}
+		val, ok := attributeJSONValue(attr, r.JsonPath)
+		return ok && val != nil
+	}
+
+	value, isNumber, ok := attributeRequirementValue(attr, r.JsonPath)
+	if !ok {
+		return false
+	}
+
+	switch r.Condition {
+	case AttributeCondition_Equal, AttributeCondition_In:
+		for _, expected := range r.Values {
+			if requirementValuesEqual(value, isNumber, expected) {
+				return true
+			}
+		}
+		return false
+	case AttributeCondition_NotIn:
+		for _, expected := range r.Values {
+			if requirementValuesEqual(value, isNumber, expected) {
+				return false
+			}
+		}
+		return true
+	case AttributeCondition_GreaterThan, AttributeCondition_GreaterThanOrEqual,
+		AttributeCondition_LessThan, AttributeCondition_LessThanOrEqual:
+		if !isNumber || len(r.Values) != 1 {
+			return false
+		}
+		actual, okA := parseRequirementNumber(value)
+		expected, okE := parseRequirementNumber(r.Values[0])
+		if !okA || !okE {
+			return false
+		}
+		cmp := actual.Cmp(expected)
+		switch r.Condition {
+		case AttributeCondition_GreaterThan:
+			return cmp > 0
+		case AttributeCondition_GreaterThanOrEqual:
+			return cmp >= 0
+		case AttributeCondition_LessThan:
+			return cmp < 0
+		default:
+			return cmp <= 0
+		}
+	}
+	return false
+}
+
+// attributeRequirementValue gets the string form of the value of an attribute (at the json path) that
+// requirements are checked against. It also returns whether that value is numeric.
+// The returned ok is false if the attribute does not have a value that can be checked.
+func attributeRequirementValue(attr attrtypes.Attribute, jsonPath string) (value string, isNumber bool, ok bool) {
+	switch attr.AttributeType {
+	case attrtypes.AttributeType_Int, attrtypes.AttributeType_Float:
+		if len(jsonPath) > 0 {
+			return "", false, false
+		}
+		return strings.TrimSpace(string(attr.Value)), true, true
+	case attrtypes.AttributeType_String, attrtypes.AttributeType_UUID, attrtypes.AttributeType_Uri:
+		if len(jsonPath) > 0 {
+			return "", false, false
+		}
+		return string(attr.Value), false, true
+	case attrtypes.AttributeType_JSON:
+		val, found := attributeJSONValue(attr, jsonPath)
+		if !found {
+			return "", false, false
+		}
+		switch v := val.(type) {
+		case string:
+			return v, false, true
+		case json.Number:
+			return v.String(), true, true
+		case bool:
+			return strconv.FormatBool(v), false, true
+		}
+	}
+	return "", false, false
+}
+
+// attributeJSONValue decodes a JSON attribute and returns the value at the provided dot-separated path.
+// The returned bool is false if the attribute is not JSON or the path does not exist in it.
+func attributeJSONValue(attr attrtypes.Attribute, jsonPath string) (interface{}, bool) {
+	if attr.AttributeType != attrtypes.AttributeType_JSON {
+		return nil, false
+	}
+	dec := json.NewDecoder(bytes.NewReader(attr.Value))
+	dec.UseNumber()
+	var val interface{}
+	if err := dec.Decode(&val); err != nil {
+		return nil, false
+	}
+	if len(jsonPath) == 0 {
+		return val, true
+	}
+	for _, key := range strings.Split(jsonPath, ".") {
+		switch node := val.(type) {
+		case map[string]interface{}:
+			var found bool
+			if val, found = node[key]; !found {
+				return nil, false
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, false
+			}
+			val = node[i]
+		default:
+			return nil, false
+		}
+	}
+	return val, true
+}
+
+// requirementValuesEqual returns true if the actual value equals the expected one.
+// Numeric values are compared numerically, so "1.50" equals "1.5".
+func requirementValuesEqual(actual string, isNumber bool, expected string) bool {
+	if isNumber {
+		a, okA := parseRequirementNumber(actual)
+		e, okE := parseRequirementNumber(expected)
+		if okA && okE {
+			return a.Cmp(e) == 0
+		}
+	}
+	return actual == expected
+}
+
+// parseRequirementNumber parses the provided string as a number for comparison in a requirement.
+func parseRequirementNumber(str string) (*big.Float, bool) {
+	rv, ok := new(big.Float).SetPrec(requirementNumberPrecision).SetString(strings.TrimSpace(str))
+	if !ok || rv.IsInf() {
+		return nil, false
+	}
+	return rv, true
+}
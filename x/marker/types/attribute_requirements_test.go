@@ -0,0 +1,283 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+)
+
+func TestAttributeRequirementValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    AttributeRequirement
+		expErr string
+	}{
+		{
+			name:   "empty name",
+			req:    AttributeRequirement{Name: "*.", Condition: AttributeCondition_Present},
+			expErr: "attribute name cannot be empty",
+		},
+		{
+			name:   "empty json path key",
+			req:    AttributeRequirement{Name: "kyc.pb", Condition: AttributeCondition_Present, JsonPath: "address..country"},
+			expErr: "invalid kyc.pb json path \"address..country\": keys cannot be empty",
+		},
+		{
+			name:   "negative min validity",
+			req:    AttributeRequirement{Name: "kyc.pb", Condition: AttributeCondition_Present, MinValidity: -time.Hour},
+			expErr: "invalid kyc.pb min validity -1h0m0s: cannot be negative",
+		},
+		{
+			name:   "unspecified condition",
+			req:    AttributeRequirement{Name: "kyc.pb"},
+			expErr: "unknown attribute condition ATTRIBUTE_CONDITION_UNSPECIFIED on kyc.pb",
+		},
+		{
+			name:   "present with values",
+			req:    AttributeRequirement{Name: "kyc.pb", Condition: AttributeCondition_Present, Values: []string{"x"}},
+			expErr: "ATTRIBUTE_CONDITION_PRESENT condition on kyc.pb cannot have any values",
+		},
+		{
+			name:   "equal with two values",
+			req:    AttributeRequirement{Name: "kyc.pb", Condition: AttributeCondition_Equal, Values: []string{"x", "y"}},
+			expErr: "ATTRIBUTE_CONDITION_EQUAL condition on kyc.pb must have exactly one value, found 2",
+		},
+		{
+			name:   "not in without values",
+			req:    AttributeRequirement{Name: "kyc.pb", Condition: AttributeCondition_NotIn},
+			expErr: "ATTRIBUTE_CONDITION_NOT_IN condition on kyc.pb must have at least one value",
+		},
+		{
+			name:   "less than with a non-number",
+			req:    AttributeRequirement{Name: "kyc.pb", Condition: AttributeCondition_LessThan, Values: []string{"Inf"}},
+			expErr: "ATTRIBUTE_CONDITION_LESS_THAN condition on kyc.pb must have a numeric value, found \"Inf\"",
+		},
+		{
+			name: "valid in",
+			req:  AttributeRequirement{Name: "*.kyc.pb", Condition: AttributeCondition_In, JsonPath: "country", Values: []string{"US", "CA"}},
+		},
+		{
+			name: "valid greater than or equal",
+			req:  AttributeRequirement{Name: "acc.pb", Condition: AttributeCondition_GreaterThanOrEqual, Values: []string{"1.5e3"}, MinValidity: time.Hour},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.req.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestAttributeRequirementDescription(t *testing.T) {
+	tests := []struct {
+		req AttributeRequirement
+		exp string
+	}{
+		{
+			req: AttributeRequirement{Name: "kyc.pb", Condition: AttributeCondition_Present},
+			exp: "kyc.pb is present",
+		},
+		{
+			req: AttributeRequirement{Name: "kyc.pb", Condition: AttributeCondition_In, JsonPath: "country", Values: []string{"US", "CA"}},
+			exp: "kyc.pb:country in {US, CA}",
+		},
+		{
+			req: AttributeRequirement{Name: "kyc.pb", Condition: AttributeCondition_NotIn, JsonPath: "country", Values: []string{"KP"}},
+			exp: "kyc.pb:country not in {KP}",
+		},
+		{
+			req: AttributeRequirement{Name: "acc.pb", Condition: AttributeCondition_GreaterThanOrEqual, Values: []string{"3"}, MinValidity: 720 * time.Hour},
+			exp: "acc.pb >= 3 and valid for 720h0m0s",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.exp, func(t *testing.T) {
+			assert.Equal(t, tc.exp, tc.req.Description(), "Description")
+		})
+	}
+}
+
+func TestAttributeRequirementIsMetByAttribute(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	nextWeek := now.Add(7 * 24 * time.Hour)
+	lastWeek := now.Add(-7 * 24 * time.Hour)
+	jsonAttr := func(value string) attrtypes.Attribute {
+		return attrtypes.Attribute{Name: "kyc.pb", AttributeType: attrtypes.AttributeType_JSON, Value: []byte(value)}
+	}
+	kyc := jsonAttr(`{"country": "US", "level": 3, "accredited": true, "holders": [{"name": "a"}]}`)
+
+	tests := []struct {
+		name string
+		req  AttributeRequirement
+		attr attrtypes.Attribute
+		exp  bool
+	}{
+		{
+			name: "present",
+			req:  AttributeRequirement{Condition: AttributeCondition_Present},
+			attr: attrtypes.Attribute{AttributeType: attrtypes.AttributeType_Bytes, Value: []byte{1}},
+			exp:  true,
+		},
+		{
+			name: "present: expired",
+			req:  AttributeRequirement{Condition: AttributeCondition_Present},
+			attr: attrtypes.Attribute{AttributeType: attrtypes.AttributeType_String, Value: []byte("x"), ExpirationDate: &now},
+			exp:  false,
+		},
+		{
+			name: "present: json path exists",
+			req:  AttributeRequirement{Condition: AttributeCondition_Present, JsonPath: "holders.0.name"},
+			attr: kyc,
+			exp:  true,
+		},
+		{
+			name: "present: json path missing",
+			req:  AttributeRequirement{Condition: AttributeCondition_Present, JsonPath: "holders.1.name"},
+			attr: kyc,
+			exp:  false,
+		},
+		{
+			name: "min validity: no expiration",
+			req:  AttributeRequirement{Condition: AttributeCondition_Present, MinValidity: time.Hour},
+			attr: attrtypes.Attribute{AttributeType: attrtypes.AttributeType_String, Value: []byte("x")},
+			exp:  false,
+		},
+		{
+			name: "min validity: expires too soon",
+			req:  AttributeRequirement{Condition: AttributeCondition_Present, MinValidity: 8 * 24 * time.Hour},
+			attr: attrtypes.Attribute{AttributeType: attrtypes.AttributeType_String, Value: []byte("x"), ExpirationDate: &nextWeek},
+			exp:  false,
+		},
+		{
+			name: "min validity: expires late enough",
+			req:  AttributeRequirement{Condition: AttributeCondition_Present, MinValidity: 7 * 24 * time.Hour},
+			attr: attrtypes.Attribute{AttributeType: attrtypes.AttributeType_String, Value: []byte("x"), ExpirationDate: &nextWeek},
+			exp:  true,
+		},
+		{
+			name: "expired long ago",
+			req:  AttributeRequirement{Condition: AttributeCondition_Equal, Values: []string{"x"}},
+			attr: attrtypes.Attribute{AttributeType: attrtypes.AttributeType_String, Value: []byte("x"), ExpirationDate: &lastWeek},
+			exp:  false,
+		},
+		{
+			name: "equal: string",
+			req:  AttributeRequirement{Condition: AttributeCondition_Equal, Values: []string{"US"}},
+			attr: attrtypes.Attribute{AttributeType: attrtypes.AttributeType_String, Value: []byte("US")},
+			exp:  true,
+		},
+		{
+			name: "equal: string with json path",
+			req:  AttributeRequirement{Condition: AttributeCondition_Equal, JsonPath: "country", Values: []string{"US"}},
+			attr: attrtypes.Attribute{AttributeType: attrtypes.AttributeType_String, Value: []byte("US")},
+			exp:  false,
+		},
+		{
+			name: "equal: numeric",
+			req:  AttributeRequirement{Condition: AttributeCondition_Equal, Values: []string{"1.5"}},
+			attr: attrtypes.Attribute{AttributeType: attrtypes.AttributeType_Float, Value: []byte(" 1.50 ")},
+			exp:  true,
+		},
+		{
+			name: "equal: json bool",
+			req:  AttributeRequirement{Condition: AttributeCondition_Equal, JsonPath: "accredited", Values: []string{"true"}},
+			attr: kyc,
+			exp:  true,
+		},
+		{
+			name: "in: json string",
+			req:  AttributeRequirement{Condition: AttributeCondition_In, JsonPath: "country", Values: []string{"CA", "US"}},
+			attr: kyc,
+			exp:  true,
+		},
+		{
+			name: "in: not listed",
+			req:  AttributeRequirement{Condition: AttributeCondition_In, JsonPath: "country", Values: []string{"CA", "MX"}},
+			attr: kyc,
+			exp:  false,
+		},
+		{
+			name: "in: json object",
+			req:  AttributeRequirement{Condition: AttributeCondition_In, JsonPath: "holders", Values: []string{"a"}},
+			attr: kyc,
+			exp:  false,
+		},
+		{
+			name: "not in: not listed",
+			req:  AttributeRequirement{Condition: AttributeCondition_NotIn, JsonPath: "country", Values: []string{"KP", "IR"}},
+			attr: kyc,
+			exp:  true,
+		},
+		{
+			name: "not in: listed",
+			req:  AttributeRequirement{Condition: AttributeCondition_NotIn, JsonPath: "country", Values: []string{"US"}},
+			attr: kyc,
+			exp:  false,
+		},
+		{
+			name: "not in: missing path",
+			req:  AttributeRequirement{Condition: AttributeCondition_NotIn, JsonPath: "region", Values: []string{"US"}},
+			attr: kyc,
+			exp:  false,
+		},
+		{
+			name: "greater than: json number",
+			req:  AttributeRequirement{Condition: AttributeCondition_GreaterThan, JsonPath: "level", Values: []string{"2"}},
+			attr: kyc,
+			exp:  true,
+		},
+		{
+			name: "greater than: equal",
+			req:  AttributeRequirement{Condition: AttributeCondition_GreaterThan, JsonPath: "level", Values: []string{"3"}},
+			attr: kyc,
+			exp:  false,
+		},
+		{
+			name: "greater than or equal: int",
+			req:  AttributeRequirement{Condition: AttributeCondition_GreaterThanOrEqual, Values: []string{"100000000000000000000"}},
+			attr: attrtypes.Attribute{AttributeType: attrtypes.AttributeType_Int, Value: []byte("100000000000000000000")},
+			exp:  true,
+		},
+		{
+			name: "less than: float",
+			req:  AttributeRequirement{Condition: AttributeCondition_LessThan, Values: []string{"1e3"}},
+			attr: attrtypes.Attribute{AttributeType: attrtypes.AttributeType_Float, Value: []byte("999.99")},
+			exp:  true,
+		},
+		{
+			name: "less than or equal: string value",
+			req:  AttributeRequirement{Condition: AttributeCondition_LessThanOrEqual, Values: []string{"5"}},
+			attr: attrtypes.Attribute{AttributeType: attrtypes.AttributeType_String, Value: []byte("4")},
+			exp:  false,
+		},
+		{
+			name: "less than or equal: json string",
+			req:  AttributeRequirement{Condition: AttributeCondition_LessThanOrEqual, JsonPath: "country", Values: []string{"5"}},
+			attr: kyc,
+			exp:  false,
+		},
+		{
+			name: "invalid json",
+			req:  AttributeRequirement{Condition: AttributeCondition_Present, JsonPath: "country"},
+			attr: jsonAttr(`{"country":`),
+			exp:  false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act := tc.req.IsMetByAttribute(tc.attr, now)
+			assert.Equal(t, tc.exp, act, "IsMetByAttribute")
+		})
+	}
+}
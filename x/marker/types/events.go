@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -192,3 +193,266 @@ func NewEventMarkerParamsUpdated(allowGovControl bool, denomRegex string, maxSup
 		MaxSupply:              maxSupply.String(),
 	}
 }
+
+// NewEventMarkerFreeze returns a new instance of EventMarkerFreeze
+func NewEventMarkerFreeze(freeze HolderFreeze) *EventMarkerFreeze {
+	return &EventMarkerFreeze{
+		Denom:         freeze.Denom,
+		Holder:        freeze.Holder,
+		Amount:        freeze.Amount.String(),
+		ExpiresAt:     freeze.ExpiresAt.UTC().Format(time.RFC3339Nano),
+		Reason:        freeze.Reason,
+		Administrator: freeze.Administrator,
+	}
+}
+
+// NewEventMarkerUnfreeze returns a new instance of EventMarkerUnfreeze
+func NewEventMarkerUnfreeze(denom string, holder string, administrator string) *EventMarkerUnfreeze {
+	return &EventMarkerUnfreeze{
+		Denom:         denom,
+		Holder:        holder,
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerDistribution returns a new instance of EventMarkerDistribution
+func NewEventMarkerDistribution(dist Distribution) *EventMarkerDistribution {
+	return &EventMarkerDistribution{
+		DistributionId: strconv.FormatUint(dist.Id, 10),
+		Denom:          dist.Denom,
+		Payout:         dist.Payout.String(),
+		Allocated:      dist.Allocated.String(),
+		Administrator:  dist.Administrator,
+		SnapshotHeight: strconv.FormatInt(dist.SnapshotHeight, 10),
+		HolderCount:    strconv.FormatUint(dist.HolderCount, 10),
+	}
+}
+
+// NewEventMarkerDistributionCompleted returns a new instance of EventMarkerDistributionCompleted
+func NewEventMarkerDistributionCompleted(distributionID uint64, denom string) *EventMarkerDistributionCompleted {
+	return &EventMarkerDistributionCompleted{
+		DistributionId: strconv.FormatUint(distributionID, 10),
+		Denom:          denom,
+	}
+}
+
+// NewEventMarkerDistributionClaimable returns a new instance of EventMarkerDistributionClaimable
+func NewEventMarkerDistributionClaimable(payment DistributionPayment) *EventMarkerDistributionClaimable {
+	return &EventMarkerDistributionClaimable{
+		DistributionId: strconv.FormatUint(payment.DistributionId, 10),
+		Holder:         payment.Holder,
+		Amount:         payment.Amount.String(),
+	}
+}
+
+// NewEventMarkerDistributionClaimed returns a new instance of EventMarkerDistributionClaimed
+func NewEventMarkerDistributionClaimed(payment DistributionPayment) *EventMarkerDistributionClaimed {
+	return &EventMarkerDistributionClaimed{
+		DistributionId: strconv.FormatUint(payment.DistributionId, 10),
+		Holder:         payment.Holder,
+		Amount:         payment.Amount.String(),
+	}
+}
+
+// NewEventMarkerSetRedemptionPayoutDenom returns a new instance of EventMarkerSetRedemptionPayoutDenom
+func NewEventMarkerSetRedemptionPayoutDenom(denom, payoutDenom, administrator string) *EventMarkerSetRedemptionPayoutDenom {
+	return &EventMarkerSetRedemptionPayoutDenom{
+		Denom:         denom,
+		PayoutDenom:   payoutDenom,
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerRedemptionRequested returns a new instance of EventMarkerRedemptionRequested
+func NewEventMarkerRedemptionRequested(redemption Redemption) *EventMarkerRedemptionRequested {
+	return &EventMarkerRedemptionRequested{
+		RedemptionId: strconv.FormatUint(redemption.Id, 10),
+		Denom:        redemption.Denom,
+		Holder:       redemption.Holder,
+		Amount:       redemption.Amount.String(),
+	}
+}
+
+// NewEventMarkerRedemptionFulfilled returns a new instance of EventMarkerRedemptionFulfilled
+func NewEventMarkerRedemptionFulfilled(redemption Redemption, payout sdk.Coin, administrator string) *EventMarkerRedemptionFulfilled {
+	return &EventMarkerRedemptionFulfilled{
+		RedemptionId:  strconv.FormatUint(redemption.Id, 10),
+		Denom:         redemption.Denom,
+		Holder:        redemption.Holder,
+		Amount:        redemption.Amount.String(),
+		Payout:        payout.String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerRedemptionRejected returns a new instance of EventMarkerRedemptionRejected
+func NewEventMarkerRedemptionRejected(redemption Redemption, administrator, reason string) *EventMarkerRedemptionRejected {
+	return &EventMarkerRedemptionRejected{
+		RedemptionId:  strconv.FormatUint(redemption.Id, 10),
+		Denom:         redemption.Denom,
+		Holder:        redemption.Holder,
+		Amount:        redemption.Amount.String(),
+		Administrator: administrator,
+		Reason:        reason,
+	}
+}
+
+// NewEventMarkerSetTransferLimits returns a new instance of EventMarkerSetTransferLimits
+func NewEventMarkerSetTransferLimits(limits TransferLimits, administrator string) *EventMarkerSetTransferLimits {
+	return &EventMarkerSetTransferLimits{
+		Denom:            limits.Denom,
+		HolderDailyLimit: limits.HolderDailyLimit.String(),
+		MarkerDailyLimit: limits.MarkerDailyLimit.String(),
+		Administrator:    administrator,
+	}
+}
+
+// NewEventMarkerSetAccessGrantTerms returns a new instance of EventMarkerSetAccessGrantTerms
+func NewEventMarkerSetAccessGrantTerms(terms AccessGrantTerms, administrator string) *EventMarkerSetAccessGrantTerms {
+	rv := &EventMarkerSetAccessGrantTerms{
+		Denom:          terms.Denom,
+		Address:        terms.Address,
+		MintDailyLimit: terms.MintDailyLimit.String(),
+		Administrator:  administrator,
+	}
+	if terms.ExpiresAt != nil {
+		rv.ExpiresAt = terms.ExpiresAt.UTC().Format(time.RFC3339Nano)
+	}
+	return rv
+}
+
+// NewEventMarkerAccessGrantExpired returns a new instance of EventMarkerAccessGrantExpired
+func NewEventMarkerAccessGrantExpired(denom, address string) *EventMarkerAccessGrantExpired {
+	return &EventMarkerAccessGrantExpired{
+		Denom:   denom,
+		Address: address,
+	}
+}
+
+// NewEventMarkerSetApprovalThreshold returns a new instance of EventMarkerSetApprovalThreshold
+func NewEventMarkerSetApprovalThreshold(denom string, permission Access, threshold uint32, administrator string) *EventMarkerSetApprovalThreshold {
+	return &EventMarkerSetApprovalThreshold{
+		Denom:         denom,
+		Permission:    permission.String(),
+		Threshold:     strconv.FormatUint(uint64(threshold), 10),
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerOperationProposed returns a new instance of EventMarkerOperationProposed
+func NewEventMarkerOperationProposed(op PendingOperation) *EventMarkerOperationProposed {
+	rv := &EventMarkerOperationProposed{
+		OperationId: strconv.FormatUint(op.Id, 10),
+		Denom:       op.Denom,
+		Permission:  op.Permission.String(),
+	}
+	if op.Msg != nil {
+		rv.MsgTypeUrl = op.Msg.TypeUrl
+	}
+	if len(op.Approvers) > 0 {
+		rv.Proposer = op.Approvers[0]
+	}
+	return rv
+}
+
+// NewEventMarkerOperationApproved returns a new instance of EventMarkerOperationApproved
+func NewEventMarkerOperationApproved(op PendingOperation, approver string) *EventMarkerOperationApproved {
+	return &EventMarkerOperationApproved{
+		OperationId: strconv.FormatUint(op.Id, 10),
+		Denom:       op.Denom,
+		Approver:    approver,
+	}
+}
+
+// NewEventMarkerOperationExecuted returns a new instance of EventMarkerOperationExecuted
+func NewEventMarkerOperationExecuted(op PendingOperation) *EventMarkerOperationExecuted {
+	return &EventMarkerOperationExecuted{
+		OperationId: strconv.FormatUint(op.Id, 10),
+		Denom:       op.Denom,
+	}
+}
+
+// NewEventMarkerOperationCancelled returns a new instance of EventMarkerOperationCancelled
+func NewEventMarkerOperationCancelled(op PendingOperation, signer string) *EventMarkerOperationCancelled {
+	return &EventMarkerOperationCancelled{
+		OperationId: strconv.FormatUint(op.Id, 10),
+		Denom:       op.Denom,
+		Signer:      signer,
+	}
+}
+
+// NewEventMarkerOperationExpired returns a new instance of EventMarkerOperationExpired
+func NewEventMarkerOperationExpired(op PendingOperation) *EventMarkerOperationExpired {
+	return &EventMarkerOperationExpired{
+		OperationId: strconv.FormatUint(op.Id, 10),
+		Denom:       op.Denom,
+	}
+}
+
+// NewEventMarkerSetAttributeRequirements returns a new instance of EventMarkerSetAttributeRequirements
+func NewEventMarkerSetAttributeRequirements(requirements AttributeRequirements, administrator string) *EventMarkerSetAttributeRequirements {
+	return &EventMarkerSetAttributeRequirements{
+		Denom:         requirements.Denom,
+		Requirements:  requirements.Descriptions(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerHolderSnapshotTaken returns a new instance of EventMarkerHolderSnapshotTaken
+func NewEventMarkerHolderSnapshotTaken(snapshot HolderSnapshot) *EventMarkerHolderSnapshotTaken {
+	return &EventMarkerHolderSnapshotTaken{
+		SnapshotId:    strconv.FormatUint(snapshot.Id, 10),
+		Denom:         snapshot.Denom,
+		Height:        strconv.FormatInt(snapshot.Height, 10),
+		HolderCount:   strconv.FormatUint(snapshot.HolderCount, 10),
+		Administrator: snapshot.TakenBy,
+	}
+}
+
+// NewEventMarkerForcedTransfer returns a new instance of EventMarkerForcedTransfer
+func NewEventMarkerForcedTransfer(record ForcedTransferRecord) *EventMarkerForcedTransfer {
+	return &EventMarkerForcedTransfer{
+		RecordId:      strconv.FormatUint(record.Id, 10),
+		Denom:         record.Denom,
+		Amount:        record.Amount.String(),
+		FromAddress:   record.FromAddress,
+		ToAddress:     record.ToAddress,
+		Administrator: record.Administrator,
+		Reason:        record.Reason.String(),
+		Reference:     record.Reference,
+	}
+}
+
+// NewEventMarkerSetIssuanceSchedule returns a new instance of EventMarkerSetIssuanceSchedule
+func NewEventMarkerSetIssuanceSchedule(schedule IssuanceSchedule, administrator string) *EventMarkerSetIssuanceSchedule {
+	scheduled := sdkmath.ZeroInt()
+	for _, tranche := range schedule.Tranches {
+		scheduled = scheduled.Add(tranche.Amount)
+	}
+	return &EventMarkerSetIssuanceSchedule{
+		Denom:         schedule.Denom,
+		HardCap:       schedule.HardCap.String(),
+		Scheduled:     scheduled.String(),
+		TrancheCount:  strconv.Itoa(len(schedule.Tranches)),
+		Administrator: administrator,
+	}
+}
+
+// NewEventSetNetAssetValueMaxAge returns a new instance of EventSetNetAssetValueMaxAge
+func NewEventSetNetAssetValueMaxAge(denom string, maxAge uint64, administrator string) *EventSetNetAssetValueMaxAge {
+	return &EventSetNetAssetValueMaxAge{
+		Denom:         denom,
+		MaxAge:        strconv.FormatUint(maxAge, 10),
+		Administrator: administrator,
+	}
+}
+
+// NewEventNetAssetValueStale returns a new instance of EventNetAssetValueStale
+func NewEventNetAssetValueStale(denom string, nav NetAssetValue, maxAge uint64) *EventNetAssetValueStale {
+	return &EventNetAssetValueStale{
+		Denom:              denom,
+		PriceDenom:         nav.Price.Denom,
+		UpdatedBlockHeight: strconv.FormatUint(nav.UpdatedBlockHeight, 10),
+		MaxAge:             strconv.FormatUint(maxAge, 10),
+	}
+}
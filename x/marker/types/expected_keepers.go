@@ -12,6 +12,7 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 
 	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/quarantine"
 )
 
 // AccountKeeper defines the auth/account functionality needed by the marker keeper.
@@ -35,6 +36,7 @@ type AuthzKeeper interface {
 type BankKeeper interface {
 	GetAllBalances(context context.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(context context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SpendableCoin(context context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetSupply(context context.Context, denom string) sdk.Coin
 	DenomOwners(context context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)
 
@@ -45,6 +47,7 @@ type BankKeeper interface {
 	BurnCoins(context context.Context, moduleName string, amt sdk.Coins) error
 
 	AppendSendRestriction(restriction banktypes.SendRestrictionFn)
+	AppendLockedCoinsGetter(getter banktypes.GetLockedCoinsFn)
 	BlockedAddr(addr sdk.AccAddress) bool
 
 	GetDenomMetaData(context context.Context, denom string) (banktypes.Metadata, bool)
@@ -84,6 +87,23 @@ type GroupChecker interface {
 	IsGroupAddress(sdk.Context, sdk.AccAddress) bool
 }
 
+// SanctionKeeper defines the sanction module functionality needed by the marker module.
+type SanctionKeeper interface {
+	IsSanctionedAddr(goCtx context.Context, addr sdk.AccAddress) bool
+}
+
+// QuarantineKeeper defines the quarantine module functionality needed by the marker module.
+type QuarantineKeeper interface {
+	IsQuarantinedAddr(ctx sdk.Context, toAddr sdk.AccAddress) bool
+	GetFundsHolder() sdk.AccAddress
+	IterateQuarantineRecords(ctx sdk.Context, toAddr sdk.AccAddress, cb func(toAddr, recordSuffix sdk.AccAddress, record *quarantine.QuarantineRecord) (stop bool))
+}
+
+// HoldKeeper defines the hold module functionality needed by the marker module.
+type HoldKeeper interface {
+	GetHoldCoin(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error)
+}
+
 // ExchangeKeeper defines the exchange module functionality needed by the marker module.
 type ExchangeKeeper interface {
 	AddCommitment(ctx sdk.Context, marketID uint32, addr sdk.AccAddress, amount sdk.Coins, eventtag string) error
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -29,8 +30,210 @@ func (state GenesisState) Validate() error {
 				return err
 			}
 		}
+		for i, record := range mNav.History {
+			if err := record.Validate(); err != nil {
+				return fmt.Errorf("net asset value history of %s [%d]: %w", mNav.Address, i, err)
+			}
+		}
+	}
+	seenFreezes := make(map[string]bool, len(state.HolderFreezes))
+	for i, freeze := range state.HolderFreezes {
+		if err := freeze.Validate(); err != nil {
+			return fmt.Errorf("holder freezes[%d]: %w", i, err)
+		}
+		key := freeze.Denom + " " + freeze.Holder
+		if seenFreezes[key] {
+			return fmt.Errorf("holder freezes[%d]: duplicate freeze of %s for %s", i, freeze.Denom, freeze.Holder)
+		}
+		seenFreezes[key] = true
+	}
+
+	distDenoms := make(map[uint64]string, len(state.Distributions))
+	for i, dist := range state.Distributions {
+		if err := dist.Validate(); err != nil {
+			return fmt.Errorf("distributions[%d]: %w", i, err)
+		}
+		if _, seen := distDenoms[dist.Id]; seen {
+			return fmt.Errorf("distributions[%d]: duplicate distribution id %d", i, dist.Id)
+		}
+		if dist.Id >= state.NextDistributionId {
+			return fmt.Errorf("distributions[%d]: id %d must be less than the next distribution id %d", i, dist.Id, state.NextDistributionId)
+		}
+		distDenoms[dist.Id] = dist.Payout.Denom
+	}
+	if err := validateDistributionPayments("distribution payments", state.DistributionPayments, distDenoms); err != nil {
+		return err
+	}
+	if err := validateDistributionPayments("distribution claims", state.DistributionClaims, distDenoms); err != nil {
+		return err
 	}
 
+	seenRedemptions := make(map[uint64]bool, len(state.Redemptions))
+	for i, redemption := range state.Redemptions {
+		if err := redemption.Validate(); err != nil {
+			return fmt.Errorf("redemptions[%d]: %w", i, err)
+		}
+		if seenRedemptions[redemption.Id] {
+			return fmt.Errorf("redemptions[%d]: duplicate redemption id %d", i, redemption.Id)
+		}
+		if redemption.Id >= state.NextRedemptionId {
+			return fmt.Errorf("redemptions[%d]: id %d must be less than the next redemption id %d", i, redemption.Id, state.NextRedemptionId)
+		}
+		seenRedemptions[redemption.Id] = true
+	}
+	seenPayoutDenoms := make(map[string]bool, len(state.RedemptionPayoutDenoms))
+	for i, payoutDenom := range state.RedemptionPayoutDenoms {
+		if err := payoutDenom.Validate(); err != nil {
+			return fmt.Errorf("redemption payout denoms[%d]: %w", i, err)
+		}
+		if seenPayoutDenoms[payoutDenom.Denom] {
+			return fmt.Errorf("redemption payout denoms[%d]: duplicate entry for %s", i, payoutDenom.Denom)
+		}
+		seenPayoutDenoms[payoutDenom.Denom] = true
+	}
+	seenLimits := make(map[string]bool, len(state.TransferLimits))
+	for i, limits := range state.TransferLimits {
+		if err := limits.Validate(); err != nil {
+			return fmt.Errorf("transfer limits[%d]: %w", i, err)
+		}
+		if seenLimits[limits.Denom] {
+			return fmt.Errorf("transfer limits[%d]: duplicate entry for %s", i, limits.Denom)
+		}
+		seenLimits[limits.Denom] = true
+	}
+	seenVolumes := make(map[string]bool, len(state.TransferVolumes))
+	for i, volume := range state.TransferVolumes {
+		if err := volume.Validate(); err != nil {
+			return fmt.Errorf("transfer volumes[%d]: %w", i, err)
+		}
+		if !seenLimits[volume.Denom] {
+			return fmt.Errorf("transfer volumes[%d]: %s does not have transfer limits", i, volume.Denom)
+		}
+		key := fmt.Sprintf("%s %s %d", volume.Denom, volume.Holder, volume.Hour)
+		if seenVolumes[key] {
+			return fmt.Errorf("transfer volumes[%d]: duplicate volume of %s for %q in hour %d", i, volume.Denom, volume.Holder, volume.Hour)
+		}
+		seenVolumes[key] = true
+	}
+	seenTerms := make(map[string]bool, len(state.AccessGrantTerms))
+	for i, terms := range state.AccessGrantTerms {
+		if err := terms.Validate(); err != nil {
+			return fmt.Errorf("access grant terms[%d]: %w", i, err)
+		}
+		key := terms.Denom + " " + terms.Address
+		if seenTerms[key] {
+			return fmt.Errorf("access grant terms[%d]: duplicate entry of %s for %s", i, terms.Denom, terms.Address)
+		}
+		seenTerms[key] = true
+	}
+	seenMintVolumes := make(map[string]bool, len(state.AccessGrantMintVolumes))
+	for i, volume := range state.AccessGrantMintVolumes {
+		if err := volume.Validate(); err != nil {
+			return fmt.Errorf("access grant mint volumes[%d]: %w", i, err)
+		}
+		if !seenTerms[volume.Denom+" "+volume.Address] {
+			return fmt.Errorf("access grant mint volumes[%d]: %s does not have access grant terms for %s", i, volume.Denom, volume.Address)
+		}
+		key := fmt.Sprintf("%s %s %d", volume.Denom, volume.Address, volume.Hour)
+		if seenMintVolumes[key] {
+			return fmt.Errorf("access grant mint volumes[%d]: duplicate volume of %s for %s in hour %d", i, volume.Denom, volume.Address, volume.Hour)
+		}
+		seenMintVolumes[key] = true
+	}
+	seenThresholds := make(map[string]bool, len(state.ApprovalThresholds))
+	for i, threshold := range state.ApprovalThresholds {
+		if err := threshold.Validate(); err != nil {
+			return fmt.Errorf("approval thresholds[%d]: %w", i, err)
+		}
+		key := threshold.Denom + " " + threshold.Permission.String()
+		if seenThresholds[key] {
+			return fmt.Errorf("approval thresholds[%d]: duplicate %s entry for %s", i, threshold.Permission, threshold.Denom)
+		}
+		seenThresholds[key] = true
+	}
+	seenOperations := make(map[uint64]bool, len(state.PendingOperations))
+	for i, op := range state.PendingOperations {
+		if err := op.Validate(); err != nil {
+			return fmt.Errorf("pending operations[%d]: %w", i, err)
+		}
+		if seenOperations[op.Id] {
+			return fmt.Errorf("pending operations[%d]: duplicate pending operation id %d", i, op.Id)
+		}
+		if op.Id >= state.NextPendingOperationId {
+			return fmt.Errorf("pending operations[%d]: id %d must be less than the next pending operation id %d", i, op.Id, state.NextPendingOperationId)
+		}
+		seenOperations[op.Id] = true
+	}
+	seenRequirements := make(map[string]bool, len(state.AttributeRequirements))
+	for i, reqs := range state.AttributeRequirements {
+		if err := reqs.Validate(); err != nil {
+			return fmt.Errorf("attribute requirements[%d]: %w", i, err)
+		}
+		if seenRequirements[reqs.Denom] {
+			return fmt.Errorf("attribute requirements[%d]: duplicate entry for %s", i, reqs.Denom)
+		}
+		seenRequirements[reqs.Denom] = true
+	}
+	seenSnapshots := make(map[uint64]bool, len(state.HolderSnapshots))
+	for i, snapshot := range state.HolderSnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return fmt.Errorf("holder snapshots[%d]: %w", i, err)
+		}
+		if seenSnapshots[snapshot.Snapshot.Id] {
+			return fmt.Errorf("holder snapshots[%d]: duplicate holder snapshot id %d", i, snapshot.Snapshot.Id)
+		}
+		if snapshot.Snapshot.Id >= state.NextHolderSnapshotId {
+			return fmt.Errorf("holder snapshots[%d]: id %d must be less than the next holder snapshot id %d", i, snapshot.Snapshot.Id, state.NextHolderSnapshotId)
+		}
+		seenSnapshots[snapshot.Snapshot.Id] = true
+	}
+	seenForcedTransfers := make(map[uint64]bool, len(state.ForcedTransfers))
+	for i, record := range state.ForcedTransfers {
+		if err := record.Validate(); err != nil {
+			return fmt.Errorf("forced transfers[%d]: %w", i, err)
+		}
+		if seenForcedTransfers[record.Id] {
+			return fmt.Errorf("forced transfers[%d]: duplicate forced transfer id %d", i, record.Id)
+		}
+		if record.Id >= state.NextForcedTransferId {
+			return fmt.Errorf("forced transfers[%d]: id %d must be less than the next forced transfer id %d", i, record.Id, state.NextForcedTransferId)
+		}
+		seenForcedTransfers[record.Id] = true
+	}
+	seenSchedules := make(map[string]bool, len(state.IssuanceSchedules))
+	for i, schedule := range state.IssuanceSchedules {
+		if err := schedule.Validate(); err != nil {
+			return fmt.Errorf("issuance schedules[%d]: %w", i, err)
+		}
+		if seenSchedules[schedule.Denom] {
+			return fmt.Errorf("issuance schedules[%d]: duplicate entry for %s", i, schedule.Denom)
+		}
+		seenSchedules[schedule.Denom] = true
+	}
+
+	return nil
+}
+
+// validateDistributionPayments makes sure each payment is valid, unique, and part of a known distribution.
+func validateDistributionPayments(name string, payments []DistributionPayment, distDenoms map[uint64]string) error {
+	seen := make(map[string]bool, len(payments))
+	for i, payment := range payments {
+		if err := payment.Validate(); err != nil {
+			return fmt.Errorf("%s[%d]: %w", name, i, err)
+		}
+		denom, known := distDenoms[payment.DistributionId]
+		if !known {
+			return fmt.Errorf("%s[%d]: unknown distribution id %d", name, i, payment.DistributionId)
+		}
+		if payment.Amount.Denom != denom {
+			return fmt.Errorf("%s[%d]: amount denom %q does not match distribution payout denom %q", name, i, payment.Amount.Denom, denom)
+		}
+		key := fmt.Sprintf("%d %s", payment.DistributionId, payment.Holder)
+		if seen[key] {
+			return fmt.Errorf("%s[%d]: duplicate payment of distribution %d to %s", name, i, payment.DistributionId, payment.Holder)
+		}
+		seen[key] = true
+	}
 	return nil
 }
 
@@ -50,3 +253,24 @@ func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMe
 
 	return genesisState
 }
+
+// Validate checks that the holder snapshot and its cap table entries are valid.
+func (s GenesisHolderSnapshot) Validate() error {
+	if err := s.Snapshot.Validate(); err != nil {
+		return err
+	}
+	if s.Snapshot.HolderCount != uint64(len(s.Holders)) {
+		return fmt.Errorf("holder snapshot %d has a holder count of %d but %d holders", s.Snapshot.Id, s.Snapshot.HolderCount, len(s.Holders))
+	}
+	seen := make(map[string]bool, len(s.Holders))
+	for i, entry := range s.Holders {
+		if err := entry.Validate(); err != nil {
+			return fmt.Errorf("holder snapshot %d holders[%d]: %w", s.Snapshot.Id, i, err)
+		}
+		if seen[entry.Address] {
+			return fmt.Errorf("holder snapshot %d holders[%d]: duplicate holder %s", s.Snapshot.Id, i, entry.Address)
+		}
+		seen[entry.Address] = true
+	}
+	return nil
+}
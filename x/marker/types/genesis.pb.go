@@ -33,6 +33,48 @@ type GenesisState struct {
 	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,3,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
 	// list of denom based denied send addresses
 	DenySendAddresses []DenySendAddress `protobuf:"bytes,4,rep,name=deny_send_addresses,json=denySendAddresses,proto3" json:"deny_send_addresses"`
+	// list of freezes of marker coins in holder accounts
+	HolderFreezes []HolderFreeze `protobuf:"bytes,5,rep,name=holder_freezes,json=holderFreezes,proto3" json:"holder_freezes"`
+	// list of distributions to marker holders
+	Distributions []Distribution `protobuf:"bytes,6,rep,name=distributions,proto3" json:"distributions"`
+	// list of distribution payments that have not been processed yet
+	DistributionPayments []DistributionPayment `protobuf:"bytes,7,rep,name=distribution_payments,json=distributionPayments,proto3" json:"distribution_payments"`
+	// list of distribution payments that are waiting to be claimed by their holders
+	DistributionClaims []DistributionPayment `protobuf:"bytes,8,rep,name=distribution_claims,json=distributionClaims,proto3" json:"distribution_claims"`
+	// next_distribution_id is the id that will be used for the next distribution
+	NextDistributionId uint64 `protobuf:"varint,9,opt,name=next_distribution_id,json=nextDistributionId,proto3" json:"next_distribution_id,omitempty"`
+	// list of redemptions that have not been fulfilled or rejected yet
+	Redemptions []Redemption `protobuf:"bytes,10,rep,name=redemptions,proto3" json:"redemptions"`
+	// next_redemption_id is the id that will be used for the next redemption
+	NextRedemptionId uint64 `protobuf:"varint,11,opt,name=next_redemption_id,json=nextRedemptionId,proto3" json:"next_redemption_id,omitempty"`
+	// list of the denoms that marker redemptions are paid out in
+	RedemptionPayoutDenoms []RedemptionPayoutDenom `protobuf:"bytes,12,rep,name=redemption_payout_denoms,json=redemptionPayoutDenoms,proto3" json:"redemption_payout_denoms"`
+	// list of the transfer limits of restricted markers
+	TransferLimits []TransferLimits `protobuf:"bytes,13,rep,name=transfer_limits,json=transferLimits,proto3" json:"transfer_limits"`
+	// list of the hourly amounts sent that are still within a transfer limit window
+	TransferVolumes []TransferVolume `protobuf:"bytes,14,rep,name=transfer_volumes,json=transferVolumes,proto3" json:"transfer_volumes"`
+	// list of the terms of marker access grants
+	AccessGrantTerms []AccessGrantTerms `protobuf:"bytes,15,rep,name=access_grant_terms,json=accessGrantTerms,proto3" json:"access_grant_terms"`
+	// list of the hourly amounts minted by grantees that are still within a mint limit window
+	AccessGrantMintVolumes []AccessGrantMintVolume `protobuf:"bytes,16,rep,name=access_grant_mint_volumes,json=accessGrantMintVolumes,proto3" json:"access_grant_mint_volumes"`
+	// list of the approval thresholds of marker permissions
+	ApprovalThresholds []ApprovalThreshold `protobuf:"bytes,17,rep,name=approval_thresholds,json=approvalThresholds,proto3" json:"approval_thresholds"`
+	// list of operations that are waiting for approvals
+	PendingOperations []PendingOperation `protobuf:"bytes,18,rep,name=pending_operations,json=pendingOperations,proto3" json:"pending_operations"`
+	// next_pending_operation_id is the id that will be used for the next pending operation
+	NextPendingOperationId uint64 `protobuf:"varint,19,opt,name=next_pending_operation_id,json=nextPendingOperationId,proto3" json:"next_pending_operation_id,omitempty"`
+	// list of the attribute requirements of restricted markers
+	AttributeRequirements []AttributeRequirements `protobuf:"bytes,20,rep,name=attribute_requirements,json=attributeRequirements,proto3" json:"attribute_requirements"`
+	// list of the holder snapshots that have been recorded for markers
+	HolderSnapshots []GenesisHolderSnapshot `protobuf:"bytes,21,rep,name=holder_snapshots,json=holderSnapshots,proto3" json:"holder_snapshots"`
+	// next_holder_snapshot_id is the id that will be used for the next holder snapshot
+	NextHolderSnapshotId uint64 `protobuf:"varint,22,opt,name=next_holder_snapshot_id,json=nextHolderSnapshotId,proto3" json:"next_holder_snapshot_id,omitempty"`
+	// list of the audit records of forced transfers
+	ForcedTransfers []ForcedTransferRecord `protobuf:"bytes,23,rep,name=forced_transfers,json=forcedTransfers,proto3" json:"forced_transfers"`
+	// next_forced_transfer_id is the id that will be used for the next forced transfer record
+	NextForcedTransferId uint64 `protobuf:"varint,24,opt,name=next_forced_transfer_id,json=nextForcedTransferId,proto3" json:"next_forced_transfer_id,omitempty"`
+	// list of the issuance schedules of markers
+	IssuanceSchedules []IssuanceSchedule `protobuf:"bytes,25,rep,name=issuance_schedules,json=issuanceSchedules,proto3" json:"issuance_schedules"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -115,6 +157,10 @@ type MarkerNetAssetValues struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// net_asset_values that are assigned to marker
 	NetAssetValues []NetAssetValue `protobuf:"bytes,2,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+	// history is the recorded history of the marker's net asset values
+	History []NetAssetValueRecord `protobuf:"bytes,3,rep,name=history,proto3" json:"history"`
+	// max_age is the number of blocks after which the marker's net asset values are stale. Zero means they never are.
+	MaxAge uint64 `protobuf:"varint,4,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
 }
 
 func (m *MarkerNetAssetValues) Reset()         { *m = MarkerNetAssetValues{} }
@@ -150,10 +196,52 @@ func (m *MarkerNetAssetValues) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarkerNetAssetValues proto.InternalMessageInfo
 
+// GenesisHolderSnapshot defines a holder snapshot along with its cap table.
+type GenesisHolderSnapshot struct {
+	// snapshot is the holder snapshot.
+	Snapshot HolderSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot"`
+	// holders are the entries of the snapshot's cap table
+	Holders []CapTableEntry `protobuf:"bytes,2,rep,name=holders,proto3" json:"holders"`
+}
+
+func (m *GenesisHolderSnapshot) Reset()         { *m = GenesisHolderSnapshot{} }
+func (m *GenesisHolderSnapshot) String() string { return proto.CompactTextString(m) }
+func (*GenesisHolderSnapshot) ProtoMessage()    {}
+func (*GenesisHolderSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{3}
+}
+func (m *GenesisHolderSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisHolderSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisHolderSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisHolderSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisHolderSnapshot.Merge(m, src)
+}
+func (m *GenesisHolderSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisHolderSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisHolderSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisHolderSnapshot proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.marker.v1.GenesisState")
 	proto.RegisterType((*DenySendAddress)(nil), "provenance.marker.v1.DenySendAddress")
 	proto.RegisterType((*MarkerNetAssetValues)(nil), "provenance.marker.v1.MarkerNetAssetValues")
+	proto.RegisterType((*GenesisHolderSnapshot)(nil), "provenance.marker.v1.GenesisHolderSnapshot")
 }
 
 func init() {
@@ -161,33 +249,72 @@ func init() {
 }
 
 var fileDescriptor_5dcc4ab7c9d2f78f = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0xaa, 0xda, 0x40,
-	0x18, 0x86, 0x13, 0x15, 0x6d, 0x47, 0x6b, 0xdb, 0xa9, 0xd0, 0x20, 0x25, 0xfe, 0x14, 0x41, 0x0a,
-	0x4d, 0xd0, 0xee, 0xdc, 0x69, 0x0b, 0x5d, 0xb5, 0x88, 0x42, 0x17, 0x76, 0x11, 0xc6, 0xe4, 0x23,
-	0x0d, 0xad, 0x33, 0x21, 0x33, 0x86, 0x7a, 0x07, 0xdd, 0xb5, 0x97, 0xe0, 0xe5, 0xb8, 0x94, 0xae,
-	0xce, 0xea, 0x70, 0xd0, 0xcd, 0xb9, 0x8c, 0x83, 0x93, 0x04, 0xcd, 0x61, 0x70, 0x37, 0xf3, 0xf1,
-	0xbc, 0xcf, 0x3b, 0x99, 0x0c, 0xea, 0x86, 0x11, 0x8b, 0x81, 0x12, 0xea, 0x82, 0xbd, 0x22, 0xd1,
-	0x4f, 0x88, 0xec, 0x78, 0x60, 0xfb, 0x40, 0x81, 0x07, 0xdc, 0x0a, 0x23, 0x26, 0x18, 0x6e, 0x9c,
-	0x19, 0x2b, 0x61, 0xac, 0x78, 0xd0, 0x6c, 0xf8, 0xcc, 0x67, 0x12, 0xb0, 0x4f, 0xab, 0x84, 0x6d,
-	0x76, 0x94, 0xbe, 0x34, 0x25, 0x91, 0xee, 0xff, 0x02, 0xaa, 0x7d, 0x4e, 0x0a, 0xe6, 0x82, 0x08,
-	0xc0, 0x23, 0x54, 0x0e, 0x49, 0x44, 0x56, 0xdc, 0xd0, 0xdb, 0x7a, 0xbf, 0x3a, 0x7c, 0x63, 0xa9,
-	0x0a, 0xad, 0xa9, 0x64, 0x26, 0xa5, 0xdd, 0x6d, 0x4b, 0x9b, 0xa5, 0x09, 0xfc, 0x11, 0x55, 0x12,
-	0x82, 0x1b, 0x85, 0x76, 0xb1, 0x5f, 0x1d, 0xbe, 0x55, 0x87, 0xbf, 0xc8, 0xd5, 0xd8, 0x75, 0xd9,
-	0x9a, 0x8a, 0xd4, 0x91, 0x25, 0xf1, 0x02, 0xbd, 0xa0, 0x20, 0x1c, 0xc2, 0x39, 0x08, 0x27, 0x26,
-	0xbf, 0xd6, 0xc0, 0x8d, 0xa2, 0xb4, 0xbd, 0xbb, 0x66, 0xfb, 0x0a, 0x62, 0x7c, 0x8a, 0x7c, 0x93,
-	0x89, 0x54, 0x5a, 0xa7, 0xb9, 0x29, 0xfe, 0x8e, 0x5e, 0x79, 0x40, 0x37, 0x0e, 0x07, 0xea, 0x39,
-	0xc4, 0xf3, 0x22, 0xe0, 0x1c, 0xb8, 0x51, 0x92, 0xfa, 0x9e, 0x5a, 0xff, 0x09, 0xe8, 0x66, 0x0e,
-	0xd4, 0x1b, 0x27, 0x78, 0x6a, 0x7e, 0xe9, 0xe5, 0xc7, 0xc0, 0x47, 0x4f, 0xfe, 0x6c, 0x5b, 0xda,
-	0xfd, 0xb6, 0xa5, 0x75, 0x01, 0x3d, 0x7f, 0x94, 0xc2, 0x3d, 0x54, 0x4f, 0x94, 0x59, 0xad, 0xbc,
-	0xde, 0xa7, 0xb3, 0x67, 0xc9, 0x34, 0xc3, 0x3a, 0xa8, 0x26, 0x0f, 0x98, 0x41, 0x05, 0x09, 0x55,
-	0x4f, 0xb3, 0x14, 0xb9, 0xa8, 0xf9, 0xab, 0xa3, 0x86, 0xea, 0xe3, 0xb1, 0x81, 0x2a, 0xf9, 0x96,
-	0x6c, 0x8b, 0xe7, 0x8a, 0xcb, 0xbd, 0xfa, 0xab, 0x72, 0x66, 0xf5, 0xad, 0x9e, 0x4f, 0x34, 0xf1,
-	0x77, 0x07, 0x53, 0xdf, 0x1f, 0x4c, 0xfd, 0xee, 0x60, 0xea, 0xff, 0x8e, 0xa6, 0xb6, 0x3f, 0x9a,
-	0xda, 0xcd, 0xd1, 0xd4, 0xd0, 0xeb, 0x80, 0x29, 0x0b, 0xa6, 0xfa, 0x62, 0xe8, 0x07, 0xe2, 0xc7,
-	0x7a, 0x69, 0xb9, 0x6c, 0x65, 0x9f, 0x91, 0xf7, 0x01, 0xbb, 0xd8, 0xd9, 0xbf, 0xb3, 0x07, 0x2c,
-	0x36, 0x21, 0xf0, 0x65, 0x59, 0xbe, 0xde, 0x0f, 0x0f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x2d,
-	0x05, 0xd5, 0x32, 0x03, 0x00, 0x00,
+	// 1038 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x72, 0xdb, 0x36,
+	0x10, 0x96, 0x12, 0xd7, 0x76, 0xe0, 0x3f, 0x05, 0x96, 0x6d, 0x38, 0xd3, 0x91, 0x1d, 0x37, 0x69,
+	0xdd, 0x3f, 0xa9, 0x71, 0xa7, 0x87, 0xe6, 0xe6, 0xd8, 0x75, 0xa2, 0x99, 0x26, 0xf1, 0x48, 0x6e,
+	0x0e, 0x49, 0xa7, 0x2c, 0x4c, 0xae, 0x25, 0x4e, 0x44, 0x90, 0xc5, 0x42, 0x1e, 0xab, 0x4f, 0xd0,
+	0x63, 0x1f, 0x21, 0x4f, 0xd0, 0xe9, 0x63, 0xe4, 0x98, 0x63, 0x4f, 0x9d, 0x8e, 0x7d, 0xe9, 0xbd,
+	0x2f, 0xd0, 0x01, 0x08, 0x58, 0xa4, 0xc2, 0xd0, 0x9d, 0xde, 0xc8, 0xc5, 0xf7, 0xb3, 0x5c, 0x2e,
+	0x80, 0x25, 0x5b, 0x89, 0x8c, 0x4f, 0x41, 0x70, 0xe1, 0x43, 0x2b, 0xe2, 0xf2, 0x25, 0xc8, 0xd6,
+	0xe9, 0xbd, 0x56, 0x0f, 0x04, 0x60, 0x88, 0xcd, 0x44, 0xc6, 0x2a, 0xa6, 0xf5, 0x31, 0xa6, 0x99,
+	0x62, 0x9a, 0xa7, 0xf7, 0x6e, 0xd5, 0x7b, 0x71, 0x2f, 0x36, 0x80, 0x96, 0x7e, 0x4a, 0xb1, 0xb7,
+	0x6e, 0x17, 0xea, 0x59, 0x96, 0x81, 0x6c, 0xfd, 0x5e, 0x23, 0xf3, 0x0f, 0x53, 0x83, 0xae, 0xe2,
+	0x0a, 0xe8, 0x7d, 0x32, 0x9d, 0x70, 0xc9, 0x23, 0x64, 0xd5, 0xcd, 0xea, 0xf6, 0xdc, 0xce, 0xfb,
+	0xcd, 0x22, 0xc3, 0xe6, 0xa1, 0xc1, 0x3c, 0x98, 0x7a, 0xfd, 0xe7, 0x46, 0xa5, 0x63, 0x19, 0x74,
+	0x8f, 0xcc, 0xa4, 0x08, 0x64, 0xd7, 0x36, 0xaf, 0x6f, 0xcf, 0xed, 0x7c, 0x50, 0x4c, 0x7e, 0x6c,
+	0x9e, 0x76, 0x7d, 0x3f, 0x1e, 0x0a, 0x65, 0x35, 0x1c, 0x93, 0x3e, 0x27, 0x35, 0x01, 0xca, 0xe3,
+	0x88, 0xa0, 0xbc, 0x53, 0x3e, 0x18, 0x02, 0xb2, 0xeb, 0x46, 0xed, 0x93, 0x32, 0xb5, 0x27, 0xa0,
+	0x76, 0x35, 0xe5, 0x99, 0x61, 0x58, 0xd1, 0x45, 0x91, 0x8b, 0xd2, 0x17, 0x64, 0x39, 0x00, 0x31,
+	0xf2, 0x10, 0x44, 0xe0, 0xf1, 0x20, 0x90, 0x80, 0x08, 0xc8, 0xa6, 0x8c, 0xfc, 0xdd, 0x62, 0xf9,
+	0x7d, 0x10, 0xa3, 0x2e, 0x88, 0x60, 0x37, 0x85, 0x5b, 0xe5, 0x9b, 0x41, 0x3e, 0x0c, 0x48, 0x9f,
+	0x92, 0xc5, 0x7e, 0x3c, 0x08, 0x40, 0x7a, 0x27, 0x12, 0xe0, 0x67, 0x40, 0xf6, 0x9e, 0xd1, 0xdd,
+	0x2a, 0xd6, 0x7d, 0x64, 0xb0, 0x07, 0x06, 0x6a, 0x45, 0x17, 0xfa, 0x99, 0x18, 0xd2, 0x27, 0x64,
+	0x21, 0x08, 0x51, 0xc9, 0xf0, 0x78, 0xa8, 0xc2, 0x58, 0x20, 0x9b, 0x2e, 0xd3, 0xdb, 0xcf, 0x40,
+	0x9d, 0x5e, 0x8e, 0x4e, 0x03, 0xb2, 0x92, 0x0d, 0x78, 0x09, 0x1f, 0x45, 0x20, 0x14, 0xb2, 0x19,
+	0xa3, 0xfb, 0xf1, 0xd5, 0xba, 0x87, 0x29, 0xc3, 0xca, 0xd7, 0x83, 0xb7, 0x97, 0x90, 0xfe, 0x48,
+	0x96, 0x73, 0x2e, 0xfe, 0x80, 0x87, 0x11, 0xb2, 0xd9, 0xff, 0xe7, 0x41, 0xb3, 0x5a, 0x7b, 0x46,
+	0x8a, 0x7e, 0x41, 0xea, 0x02, 0xce, 0x94, 0x97, 0xb3, 0x09, 0x03, 0x76, 0x63, 0xb3, 0xba, 0x3d,
+	0xd5, 0xa1, 0x7a, 0x2d, 0x2b, 0xd8, 0x0e, 0xe8, 0x23, 0x32, 0x27, 0x21, 0x80, 0x28, 0x49, 0xeb,
+	0x48, 0x4c, 0x2e, 0x9b, 0xc5, 0xb9, 0x74, 0x2e, 0x81, 0x36, 0x85, 0x2c, 0x95, 0x7e, 0x46, 0x8c,
+	0xbe, 0x37, 0x8e, 0x69, 0xe7, 0x39, 0xe3, 0x5c, 0xd3, 0x2b, 0x63, 0x7a, 0x3b, 0xa0, 0x2f, 0x09,
+	0xcb, 0x00, 0x13, 0x3e, 0x8a, 0x87, 0xca, 0x0b, 0x40, 0xc4, 0x11, 0xb2, 0x79, 0x93, 0xc4, 0xa7,
+	0x57, 0x25, 0x71, 0x68, 0x48, 0xfb, 0x9a, 0x63, 0xf3, 0x59, 0x95, 0x45, 0x8b, 0x48, 0xbb, 0x64,
+	0x49, 0x49, 0x2e, 0xf0, 0x04, 0xa4, 0x37, 0x08, 0xa3, 0x50, 0x21, 0x5b, 0x30, 0x1e, 0x77, 0x8a,
+	0x3d, 0x8e, 0x2c, 0xf8, 0x5b, 0x83, 0x75, 0x3b, 0x46, 0xe5, 0xa2, 0xf4, 0x3b, 0x52, 0xbb, 0x14,
+	0x3d, 0x8d, 0x07, 0xc3, 0x08, 0x90, 0x2d, 0xfe, 0x17, 0xd5, 0x67, 0x06, 0x6c, 0x55, 0x97, 0x54,
+	0x2e, 0xaa, 0x37, 0x39, 0xe5, 0xbe, 0x0f, 0x88, 0x5e, 0x4f, 0x72, 0xa1, 0x3c, 0x05, 0x32, 0x42,
+	0xb6, 0x64, 0x84, 0x3f, 0x2c, 0x16, 0xde, 0x35, 0xf8, 0x87, 0x1a, 0x7e, 0xa4, 0xd1, 0x56, 0xba,
+	0xc6, 0x27, 0xe2, 0x74, 0x40, 0xd6, 0x73, 0xda, 0x51, 0x28, 0xd4, 0x65, 0xee, 0xb5, 0xb2, 0xaa,
+	0x67, 0x2c, 0x1e, 0x87, 0x42, 0xe5, 0x3e, 0x61, 0x95, 0x17, 0x2d, 0x22, 0xfd, 0x81, 0x2c, 0xf3,
+	0x44, 0xab, 0xf1, 0x81, 0xa7, 0xfa, 0x12, 0x50, 0xef, 0x61, 0x64, 0x37, 0x8d, 0xcf, 0x47, 0xef,
+	0xf0, 0xb1, 0x84, 0x23, 0x87, 0x77, 0xcd, 0xce, 0x27, 0x17, 0xf4, 0x91, 0x45, 0x13, 0x10, 0x41,
+	0x28, 0x7a, 0x5e, 0x9c, 0x80, 0xe4, 0x69, 0x07, 0xd3, 0xb2, 0x4a, 0x1d, 0xa6, 0xf8, 0xa7, 0x0e,
+	0xee, 0x8e, 0xac, 0x64, 0x22, 0x8e, 0xf4, 0x6b, 0xb2, 0x6e, 0xba, 0xf9, 0x2d, 0x07, 0xdd, 0xd4,
+	0xcb, 0xa6, 0xa9, 0x57, 0x35, 0x60, 0x52, 0xb1, 0x1d, 0xd0, 0x3e, 0x59, 0xe5, 0x2a, 0xdd, 0x63,
+	0xe0, 0x49, 0xf8, 0x69, 0x18, 0x4a, 0x48, 0x4f, 0x93, 0x7a, 0x69, 0x89, 0x1d, 0xa7, 0x93, 0xa1,
+	0xd8, 0x04, 0x57, 0x78, 0xd1, 0x22, 0xfd, 0x9e, 0xd4, 0xec, 0xb9, 0x8a, 0x82, 0x27, 0xd8, 0x8f,
+	0x15, 0xb2, 0x95, 0x32, 0x0f, 0x7b, 0x9f, 0xa5, 0x07, 0x6c, 0xd7, 0x72, 0x5c, 0x27, 0xf6, 0x73,
+	0x51, 0xa4, 0x5f, 0x91, 0x35, 0x53, 0x82, 0x09, 0x0b, 0x5d, 0x80, 0x55, 0x53, 0x00, 0x73, 0xd6,
+	0xe4, 0xb5, 0xda, 0x01, 0x7d, 0x41, 0x6a, 0x27, 0xb1, 0xf4, 0x21, 0xf0, 0x5c, 0x6b, 0x23, 0x5b,
+	0x2b, 0xbb, 0xa5, 0x0e, 0x0c, 0xda, 0xed, 0x8e, 0x0e, 0xf8, 0xb1, 0x74, 0xbf, 0x7d, 0xe9, 0x24,
+	0xb7, 0x36, 0xce, 0x69, 0xc2, 0x41, 0xe7, 0xc4, 0xc6, 0x39, 0xe5, 0x15, 0x4d, 0x4e, 0x34, 0x44,
+	0x1c, 0x6a, 0x63, 0x0f, 0xfd, 0x3e, 0x04, 0xc3, 0x01, 0x20, 0x5b, 0x2f, 0x6b, 0x95, 0xb6, 0xc5,
+	0x77, 0x2d, 0xdc, 0xb5, 0x4a, 0x38, 0x11, 0xc7, 0xfb, 0xb3, 0xbf, 0xbc, 0xda, 0xa8, 0xfc, 0xfd,
+	0x6a, 0xa3, 0xb2, 0x05, 0x64, 0x69, 0xe2, 0x4e, 0xa4, 0x77, 0xc9, 0x62, 0xaa, 0xe9, 0x2e, 0x55,
+	0x33, 0x3c, 0xdc, 0xe8, 0x2c, 0xa4, 0x51, 0x07, 0xbb, 0x4d, 0xe6, 0xcd, 0xf5, 0xeb, 0x40, 0xd7,
+	0x0c, 0x68, 0x4e, 0xc7, 0x2c, 0x24, 0x63, 0xf3, 0x4f, 0x95, 0xd4, 0x8b, 0xae, 0x76, 0xca, 0xc8,
+	0x4c, 0xde, 0xc5, 0xbd, 0xd2, 0x6e, 0xc1, 0xe8, 0x50, 0x3a, 0x88, 0xe4, 0x94, 0xdf, 0x31, 0x33,
+	0xb4, 0xc9, 0x4c, 0x3f, 0x44, 0x15, 0xcb, 0x11, 0xbb, 0x5e, 0x76, 0x87, 0xe5, 0xb4, 0x72, 0xff,
+	0xd7, 0xf1, 0xe9, 0x9a, 0x9e, 0x8f, 0xce, 0x3c, 0xde, 0x03, 0x36, 0x65, 0xfe, 0xe3, 0x74, 0xc4,
+	0xcf, 0x76, 0x7b, 0x90, 0xf9, 0xea, 0xdf, 0xaa, 0x64, 0xa5, 0xb0, 0x7f, 0xe9, 0x01, 0x99, 0x75,
+	0xcd, 0x69, 0x47, 0xb3, 0x3b, 0x65, 0x83, 0xc5, 0x44, 0xdf, 0x5f, 0x72, 0xf5, 0x90, 0x96, 0xf6,
+	0xfa, 0x15, 0xb5, 0xd9, 0xe3, 0xc9, 0x11, 0x3f, 0x1e, 0xc0, 0x37, 0x42, 0xc9, 0xd1, 0xe5, 0x97,
+	0xa4, 0xcc, 0x71, 0xc2, 0x0f, 0x7a, 0xaf, 0xcf, 0x1b, 0xd5, 0x37, 0xe7, 0x8d, 0xea, 0x5f, 0xe7,
+	0x8d, 0xea, 0xaf, 0x17, 0x8d, 0xca, 0x9b, 0x8b, 0x46, 0xe5, 0x8f, 0x8b, 0x46, 0x85, 0xac, 0x85,
+	0x71, 0xa1, 0xf2, 0x61, 0xf5, 0xf9, 0x4e, 0x2f, 0x54, 0xfd, 0xe1, 0x71, 0xd3, 0x8f, 0xa3, 0xd6,
+	0x18, 0xf2, 0x79, 0x18, 0x67, 0xde, 0x5a, 0x67, 0x6e, 0x66, 0x55, 0xa3, 0x04, 0xf0, 0x78, 0xda,
+	0x0c, 0xac, 0x5f, 0xfe, 0x3b, 0x00, 0x9f, 0x5a, 0xd8, 0x4f, 0x25, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -210,6 +337,275 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IssuanceSchedules) > 0 {
+		for iNdEx := len(m.IssuanceSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssuanceSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.NextForcedTransferId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextForcedTransferId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.ForcedTransfers) > 0 {
+		for iNdEx := len(m.ForcedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForcedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.NextHolderSnapshotId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextHolderSnapshotId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.HolderSnapshots) > 0 {
+		for iNdEx := len(m.HolderSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HolderSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.AttributeRequirements) > 0 {
+		for iNdEx := len(m.AttributeRequirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeRequirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.NextPendingOperationId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPendingOperationId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.PendingOperations) > 0 {
+		for iNdEx := len(m.PendingOperations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingOperations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ApprovalThresholds) > 0 {
+		for iNdEx := len(m.ApprovalThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApprovalThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.AccessGrantMintVolumes) > 0 {
+		for iNdEx := len(m.AccessGrantMintVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessGrantMintVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.AccessGrantTerms) > 0 {
+		for iNdEx := len(m.AccessGrantTerms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessGrantTerms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.TransferVolumes) > 0 {
+		for iNdEx := len(m.TransferVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.TransferLimits) > 0 {
+		for iNdEx := len(m.TransferLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RedemptionPayoutDenoms) > 0 {
+		for iNdEx := len(m.RedemptionPayoutDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionPayoutDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.NextRedemptionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRedemptionId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Redemptions) > 0 {
+		for iNdEx := len(m.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.NextDistributionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextDistributionId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.DistributionClaims) > 0 {
+		for iNdEx := len(m.DistributionClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DistributionPayments) > 0 {
+		for iNdEx := len(m.DistributionPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.HolderFreezes) > 0 {
+		for iNdEx := len(m.HolderFreezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HolderFreezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DenySendAddresses) > 0 {
 		for iNdEx := len(m.DenySendAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -322,6 +718,25 @@ func (m *MarkerNetAssetValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxAge))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.NetAssetValues) > 0 {
 		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -346,6 +761,53 @@ func (m *MarkerNetAssetValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisHolderSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisHolderSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisHolderSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holders) > 0 {
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -383,8 +845,119 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.HolderFreezes) > 0 {
+		for _, e := range m.HolderFreezes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionPayments) > 0 {
+		for _, e := range m.DistributionPayments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionClaims) > 0 {
+		for _, e := range m.DistributionClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextDistributionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextDistributionId))
+	}
+	if len(m.Redemptions) > 0 {
+		for _, e := range m.Redemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRedemptionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextRedemptionId))
+	}
+	if len(m.RedemptionPayoutDenoms) > 0 {
+		for _, e := range m.RedemptionPayoutDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferLimits) > 0 {
+		for _, e := range m.TransferLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferVolumes) > 0 {
+		for _, e := range m.TransferVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccessGrantTerms) > 0 {
+		for _, e := range m.AccessGrantTerms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccessGrantMintVolumes) > 0 {
+		for _, e := range m.AccessGrantMintVolumes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ApprovalThresholds) > 0 {
+		for _, e := range m.ApprovalThresholds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingOperations) > 0 {
+		for _, e := range m.PendingOperations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPendingOperationId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextPendingOperationId))
+	}
+	if len(m.AttributeRequirements) > 0 {
+		for _, e := range m.AttributeRequirements {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HolderSnapshots) > 0 {
+		for _, e := range m.HolderSnapshots {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextHolderSnapshotId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextHolderSnapshotId))
+	}
+	if len(m.ForcedTransfers) > 0 {
+		for _, e := range m.ForcedTransfers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextForcedTransferId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextForcedTransferId))
+	}
+	if len(m.IssuanceSchedules) > 0 {
+		for _, e := range m.IssuanceSchedules {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
 
 func (m *DenySendAddress) Size() (n int) {
 	if m == nil {
@@ -419,6 +992,32 @@ func (m *MarkerNetAssetValues) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxAge != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxAge))
+	}
+	return n
+}
+
+func (m *GenesisHolderSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Snapshot.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Holders) > 0 {
+		for _, e := range m.Holders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -438,28 +1037,628 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markers = append(m.Markers, MarkerAccount{})
+			if err := m.Markers[len(m.Markers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAssetValues = append(m.NetAssetValues, MarkerNetAssetValues{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenySendAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenySendAddresses = append(m.DenySendAddresses, DenySendAddress{})
+			if err := m.DenySendAddresses[len(m.DenySendAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderFreezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderFreezes = append(m.HolderFreezes, HolderFreeze{})
+			if err := m.HolderFreezes[len(m.HolderFreezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, Distribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionPayments = append(m.DistributionPayments, DistributionPayment{})
+			if err := m.DistributionPayments[len(m.DistributionPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionClaims = append(m.DistributionClaims, DistributionPayment{})
+			if err := m.DistributionClaims[len(m.DistributionClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDistributionId", wireType)
+			}
+			m.NextDistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextDistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemptions = append(m.Redemptions, Redemption{})
+			if err := m.Redemptions[len(m.Redemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRedemptionId", wireType)
+			}
+			m.NextRedemptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRedemptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionPayoutDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionPayoutDenoms = append(m.RedemptionPayoutDenoms, RedemptionPayoutDenom{})
+			if err := m.RedemptionPayoutDenoms[len(m.RedemptionPayoutDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferLimits = append(m.TransferLimits, TransferLimits{})
+			if err := m.TransferLimits[len(m.TransferLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferVolumes = append(m.TransferVolumes, TransferVolume{})
+			if err := m.TransferVolumes[len(m.TransferVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessGrantTerms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessGrantTerms = append(m.AccessGrantTerms, AccessGrantTerms{})
+			if err := m.AccessGrantTerms[len(m.AccessGrantTerms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessGrantMintVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessGrantMintVolumes = append(m.AccessGrantMintVolumes, AccessGrantMintVolume{})
+			if err := m.AccessGrantMintVolumes[len(m.AccessGrantMintVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalThresholds = append(m.ApprovalThresholds, ApprovalThreshold{})
+			if err := m.ApprovalThresholds[len(m.ApprovalThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOperations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingOperations = append(m.PendingOperations, PendingOperation{})
+			if err := m.PendingOperations[len(m.PendingOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPendingOperationId", wireType)
+			}
+			m.NextPendingOperationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPendingOperationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeRequirements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -486,13 +1685,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AttributeRequirements = append(m.AttributeRequirements, AttributeRequirements{})
+			if err := m.AttributeRequirements[len(m.AttributeRequirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderSnapshots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -519,14 +1719,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markers = append(m.Markers, MarkerAccount{})
-			if err := m.Markers[len(m.Markers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HolderSnapshots = append(m.HolderSnapshots, GenesisHolderSnapshot{})
+			if err := m.HolderSnapshots[len(m.HolderSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHolderSnapshotId", wireType)
+			}
+			m.NextHolderSnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHolderSnapshotId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForcedTransfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -553,14 +1772,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetAssetValues = append(m.NetAssetValues, MarkerNetAssetValues{})
-			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForcedTransfers = append(m.ForcedTransfers, ForcedTransferRecord{})
+			if err := m.ForcedTransfers[len(m.ForcedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextForcedTransferId", wireType)
+			}
+			m.NextForcedTransferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextForcedTransferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenySendAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceSchedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -587,8 +1825,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenySendAddresses = append(m.DenySendAddresses, DenySendAddress{})
-			if err := m.DenySendAddresses[len(m.DenySendAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IssuanceSchedules = append(m.IssuanceSchedules, IssuanceSchedule{})
+			if err := m.IssuanceSchedules[len(m.IssuanceSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -822,6 +2060,176 @@ func (m *MarkerNetAssetValues) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, NetAssetValueRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			m.MaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisHolderSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisHolderSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisHolderSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holders = append(m.Holders, CapTableEntry{})
+			if err := m.Holders[len(m.Holders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
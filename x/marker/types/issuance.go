@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxIssuanceTranches is the most tranches that the issuance schedule of a single marker can have.
+const MaxIssuanceTranches = 100
+
+// Validate checks that the tranche has a positive amount and exactly one unlock condition.
+func (t IssuanceTranche) Validate() error {
+	if t.Amount.IsNil() || !t.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount %q: must be positive", t.Amount)
+	}
+	if t.UnlockHeight < 0 {
+		return fmt.Errorf("invalid unlock height %d: cannot be negative", t.UnlockHeight)
+	}
+	hasTime := t.UnlockTime != nil && !t.UnlockTime.IsZero()
+	if hasTime == (t.UnlockHeight > 0) {
+		return fmt.Errorf("exactly one of unlock time and unlock height must be set")
+	}
+	return nil
+}
+
+// IsUnlocked returns true if the tranche's amount is mintable at the provided block time and height.
+func (t IssuanceTranche) IsUnlocked(blockTime time.Time, height int64) bool {
+	if t.UnlockTime != nil && !t.UnlockTime.IsZero() {
+		return !blockTime.Before(*t.UnlockTime)
+	}
+	return height >= t.UnlockHeight
+}
+
+// ValidateIssuanceTranches checks that there aren't too many tranches and that each is valid.
+func ValidateIssuanceTranches(tranches []IssuanceTranche) error {
+	if len(tranches) > MaxIssuanceTranches {
+		return fmt.Errorf("cannot have more than %d issuance tranches, found %d", MaxIssuanceTranches, len(tranches))
+	}
+	for i, tranche := range tranches {
+		if err := tranche.Validate(); err != nil {
+			return fmt.Errorf("tranche[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks that the issuance schedule is valid.
+func (s IssuanceSchedule) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return fmt.Errorf("invalid issuance schedule denom: %w", err)
+	}
+	if s.HardCap.IsNil() || s.HardCap.IsNegative() {
+		return fmt.Errorf("invalid %s issuance schedule hard cap %q: cannot be negative", s.Denom, s.HardCap)
+	}
+	if err := ValidateIssuanceTranches(s.Tranches); err != nil {
+		return fmt.Errorf("invalid %s issuance schedule: %w", s.Denom, err)
+	}
+	if s.Issued.IsNil() || s.Issued.IsNegative() {
+		return fmt.Errorf("invalid %s issuance schedule issued amount %q: cannot be negative", s.Denom, s.Issued)
+	}
+	return nil
+}
+
+// HasHardCap returns true if the schedule limits the supply of the marker's coin.
+func (s IssuanceSchedule) HasHardCap() bool {
+	return !s.HardCap.IsNil() && s.HardCap.IsPositive()
+}
+
+// IsEmpty returns true if the schedule has neither a hard cap nor any tranches.
+func (s IssuanceSchedule) IsEmpty() bool {
+	return !s.HasHardCap() && len(s.Tranches) == 0
+}
+
+// Unlocked returns the total of the tranches that are mintable at the provided block time and height,
+// and the total of the ones that are not.
+func (s IssuanceSchedule) Unlocked(blockTime time.Time, height int64) (unlocked, scheduled sdkmath.Int) {
+	unlocked, scheduled = sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for _, tranche := range s.Tranches {
+		if tranche.IsUnlocked(blockTime, height) {
+			unlocked = unlocked.Add(tranche.Amount)
+		} else {
+			scheduled = scheduled.Add(tranche.Amount)
+		}
+	}
+	return unlocked, scheduled
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestIssuanceTrancheValidate(t *testing.T) {
+	unlockTime := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		tranche IssuanceTranche
+		expErr  string
+	}{
+		{
+			name:    "unlocked by time",
+			tranche: IssuanceTranche{Amount: sdkmath.NewInt(100), UnlockTime: &unlockTime},
+		},
+		{
+			name:    "unlocked by height",
+			tranche: IssuanceTranche{Amount: sdkmath.NewInt(100), UnlockHeight: 50},
+		},
+		{
+			name:    "nil amount",
+			tranche: IssuanceTranche{UnlockHeight: 50},
+			expErr:  "invalid amount \"<nil>\": must be positive",
+		},
+		{
+			name:    "zero amount",
+			tranche: IssuanceTranche{Amount: sdkmath.ZeroInt(), UnlockHeight: 50},
+			expErr:  "invalid amount \"0\": must be positive",
+		},
+		{
+			name:    "negative unlock height",
+			tranche: IssuanceTranche{Amount: sdkmath.NewInt(100), UnlockHeight: -1},
+			expErr:  "invalid unlock height -1: cannot be negative",
+		},
+		{
+			name:    "no unlock condition",
+			tranche: IssuanceTranche{Amount: sdkmath.NewInt(100)},
+			expErr:  "exactly one of unlock time and unlock height must be set",
+		},
+		{
+			name:    "both unlock conditions",
+			tranche: IssuanceTranche{Amount: sdkmath.NewInt(100), UnlockTime: &unlockTime, UnlockHeight: 50},
+			expErr:  "exactly one of unlock time and unlock height must be set",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.tranche.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestIssuanceSchedule(t *testing.T) {
+	unlockTime := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := IssuanceSchedule{
+		Denom:   "issuecoin",
+		HardCap: sdkmath.NewInt(1000),
+		Tranches: []IssuanceTranche{
+			{Amount: sdkmath.NewInt(100), UnlockTime: &unlockTime},
+			{Amount: sdkmath.NewInt(200), UnlockHeight: 50},
+		},
+		Issued: sdkmath.ZeroInt(),
+	}
+	require.NoError(t, schedule.Validate(), "Validate valid schedule")
+	assert.True(t, schedule.HasHardCap(), "HasHardCap")
+	assert.False(t, schedule.IsEmpty(), "IsEmpty")
+
+	unlocked, scheduled := schedule.Unlocked(unlockTime.Add(-time.Second), 49)
+	assert.Equal(t, "0", unlocked.String(), "unlocked before either tranche")
+	assert.Equal(t, "300", scheduled.String(), "scheduled before either tranche")
+	unlocked, scheduled = schedule.Unlocked(unlockTime, 49)
+	assert.Equal(t, "100", unlocked.String(), "unlocked at the unlock time")
+	assert.Equal(t, "200", scheduled.String(), "scheduled at the unlock time")
+	unlocked, scheduled = schedule.Unlocked(unlockTime, 50)
+	assert.Equal(t, "300", unlocked.String(), "unlocked at the unlock height")
+	assert.Equal(t, "0", scheduled.String(), "scheduled at the unlock height")
+
+	schedule.Issued = sdkmath.NewInt(-1)
+	assert.EqualError(t, schedule.Validate(), "invalid issuecoin issuance schedule issued amount \"-1\": cannot be negative", "Validate with negative issued")
+	schedule.Issued = sdkmath.ZeroInt()
+	schedule.Tranches[1].UnlockHeight = 0
+	assert.EqualError(t, schedule.Validate(), "invalid issuecoin issuance schedule: tranche[1]: exactly one of unlock time and unlock height must be set", "Validate with bad tranche")
+	schedule.HardCap = sdkmath.Int{}
+	assert.EqualError(t, schedule.Validate(), "invalid issuecoin issuance schedule hard cap \"<nil>\": cannot be negative", "Validate with nil hard cap")
+
+	empty := IssuanceSchedule{Denom: "issuecoin", HardCap: sdkmath.ZeroInt(), Issued: sdkmath.NewInt(5)}
+	assert.True(t, empty.IsEmpty(), "IsEmpty without a hard cap or tranches")
+}
@@ -21,6 +21,15 @@ const (
 
 	// CoinPoolName to be used for coin pool associated with mint/burn activities.
 	CoinPoolName = ModuleName
+
+	// MaxDistributionPaymentsPerBlock is the maximum number of distribution payments processed in a single block.
+	MaxDistributionPaymentsPerBlock = 200
+
+	// MaxDistributionSnapshotHoldersPerBlock is the maximum number of holder balances recorded for distributions in a single block.
+	MaxDistributionSnapshotHoldersPerBlock = 1000
+
+	// MaxHolderSnapshotHoldersPerBlock is the maximum number of cap table entries recorded for holder snapshots in a single block.
+	MaxHolderSnapshotHoldersPerBlock = 1000
 )
 
 var (
@@ -35,6 +44,126 @@ var (
 
 	// MarkerParamStoreKey key for marker module's params
 	MarkerParamStoreKey = []byte{0x05}
+
+	// HolderFreezePrefix prefix for freezes of marker coins in holder accounts
+	HolderFreezePrefix = []byte{0x06}
+
+	// HolderFreezeIndexPrefix prefix for the index of holder freezes by holder address
+	HolderFreezeIndexPrefix = []byte{0x07}
+
+	// DistributionPrefix prefix for distributions to marker holders
+	DistributionPrefix = []byte{0x08}
+
+	// DistributionPaymentPrefix prefix for distribution payments that have not been processed yet
+	DistributionPaymentPrefix = []byte{0x09}
+
+	// DistributionClaimPrefix prefix for distribution payments waiting to be claimed by their holders
+	DistributionClaimPrefix = []byte{0x0A}
+
+	// NextDistributionIDKey key for the id to use for the next distribution
+	NextDistributionIDKey = []byte{0x0B}
+
+	// NetAssetValueHistoryPrefix prefix for the history of net asset values of markers
+	NetAssetValueHistoryPrefix = []byte{0x0C}
+
+	// FixedSupplyMarkerPrefix prefix for the index of active markers with a fixed supply
+	FixedSupplyMarkerPrefix = []byte{0x0D}
+
+	// SupplyCheckQueuePrefix prefix for the markers that need their supply reconciled at the start of the next block
+	SupplyCheckQueuePrefix = []byte{0x0E}
+
+	// DestroyedMarkerPrefix prefix for the index of markers waiting to be removed at the start of the next block
+	DestroyedMarkerPrefix = []byte{0x0F}
+
+	// RedemptionPrefix prefix for pending redemptions of marker coins
+	RedemptionPrefix = []byte{0x10}
+
+	// RedemptionMarkerIndexPrefix prefix for the index of pending redemptions by marker address
+	RedemptionMarkerIndexPrefix = []byte{0x11}
+
+	// RedemptionHolderIndexPrefix prefix for the index of pending redemptions by holder address
+	RedemptionHolderIndexPrefix = []byte{0x12}
+
+	// NextRedemptionIDKey key for the id to use for the next redemption
+	NextRedemptionIDKey = []byte{0x13}
+
+	// RedemptionPayoutDenomPrefix prefix for the denoms that marker redemptions are paid out in
+	RedemptionPayoutDenomPrefix = []byte{0x14}
+
+	// TransferLimitsPrefix prefix for the transfer limits of restricted markers
+	TransferLimitsPrefix = []byte{0x15}
+
+	// HolderTransferVolumePrefix prefix for the hourly amounts of a marker's coin sent by each holder
+	HolderTransferVolumePrefix = []byte{0x16}
+
+	// MarkerTransferVolumePrefix prefix for the hourly amounts of a marker's coin sent by all holders
+	MarkerTransferVolumePrefix = []byte{0x17}
+
+	// AccessGrantTermsPrefix prefix for the terms of marker access grants
+	AccessGrantTermsPrefix = []byte{0x18}
+
+	// AccessGrantMintVolumePrefix prefix for the hourly amounts of a marker's coin minted by each grantee
+	AccessGrantMintVolumePrefix = []byte{0x19}
+
+	// ApprovalThresholdPrefix prefix for the approval thresholds of marker permissions
+	ApprovalThresholdPrefix = []byte{0x1A}
+
+	// PendingOperationPrefix prefix for operations that are waiting for approvals
+	PendingOperationPrefix = []byte{0x1B}
+
+	// PendingOperationMarkerIndexPrefix prefix for the index of pending operations by marker address
+	PendingOperationMarkerIndexPrefix = []byte{0x1C}
+
+	// NextPendingOperationIDKey key for the id to use for the next pending operation
+	NextPendingOperationIDKey = []byte{0x1D}
+
+	// AttributeRequirementsPrefix prefix for the attribute value requirements of restricted markers
+	AttributeRequirementsPrefix = []byte{0x1E}
+
+	// HolderSnapshotPrefix prefix for the holder snapshots recorded for markers
+	HolderSnapshotPrefix = []byte{0x1F}
+
+	// HolderSnapshotMarkerIndexPrefix prefix for the index of holder snapshots by marker address
+	HolderSnapshotMarkerIndexPrefix = []byte{0x20}
+
+	// HolderSnapshotEntryPrefix prefix for the cap table entries of holder snapshots
+	HolderSnapshotEntryPrefix = []byte{0x21}
+
+	// NextHolderSnapshotIDKey key for the id to use for the next holder snapshot
+	NextHolderSnapshotIDKey = []byte{0x22}
+
+	// ForcedTransferPrefix prefix for the audit records of forced transfers
+	ForcedTransferPrefix = []byte{0x23}
+
+	// ForcedTransferMarkerIndexPrefix prefix for the index of forced transfer records by marker address
+	ForcedTransferMarkerIndexPrefix = []byte{0x24}
+
+	// ForcedTransferAccountIndexPrefix prefix for the index of forced transfer records by the address coins were taken from
+	ForcedTransferAccountIndexPrefix = []byte{0x25}
+
+	// NextForcedTransferIDKey key for the id to use for the next forced transfer record
+	NextForcedTransferIDKey = []byte{0x26}
+
+	// IssuanceSchedulePrefix prefix for the issuance schedules of markers
+	IssuanceSchedulePrefix = []byte{0x27}
+
+	// NetAssetValueMaxAgePrefix prefix for the maximum ages of the net asset values of markers
+	NetAssetValueMaxAgePrefix = []byte{0x28}
+
+	// HolderFreezeExpirationPrefix prefix for the index of holder freezes by expiration time
+	HolderFreezeExpirationPrefix = []byte{0x29}
+
+	// PendingDistributionSnapshotPrefix prefix for the index of distributions whose holder balances are still being recorded
+	PendingDistributionSnapshotPrefix = []byte{0x2A}
+
+	// AccessGrantExpirationPrefix prefix for the index of access grant terms by expiration time
+	AccessGrantExpirationPrefix = []byte{0x2B}
+
+	// NetAssetValueStaleQueuePrefix prefix for the queue of net asset values by the block height they become stale at
+	NetAssetValueStaleQueuePrefix = []byte{0x2C}
+
+	// PendingHolderSnapshotPrefix prefix for the index of holder snapshots whose cap table entries are still being recorded
+	PendingHolderSnapshotPrefix = []byte{0x2D}
 )
 
 // MarkerAddress returns the module account address for the given denomination
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -12,6 +13,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	proto "github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/internal/provutils"
 )
 
 var (
@@ -304,10 +307,10 @@ func ValidateGrantsForMarkerType(markerType MarkerType, grants ...AccessGrant) e
 						return fmt.Errorf("%v is not supported for marker type %v", access, markerType)
 					}
 				}
-			// Restricted Coins also support Transfer access
+			// Restricted Coins also support Transfer, ForceTransfer and Freeze access
 			case MarkerType_RestrictedCoin:
 				{
-					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw, Access_Transfer, Access_ForceTransfer) {
+					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw, Access_Transfer, Access_ForceTransfer, Access_Freeze) {
 						return fmt.Errorf("%v is not supported for marker type %v", access, markerType)
 					}
 				}
@@ -538,3 +541,447 @@ func (mnav *NetAssetValue) Validate() error {
 
 	return nil
 }
+
+// IsStale returns true if the net asset value is more than maxAge blocks old at the provided height.
+// A max age of zero means that net asset values are never stale.
+func (mnav *NetAssetValue) IsStale(maxAge uint64, height int64) bool {
+	return provutils.IsNavStale(mnav.UpdatedBlockHeight, maxAge, height)
+}
+
+// Validate returns error if NetAssetValueRecord is not in a valid state
+func (r NetAssetValueRecord) Validate() error {
+	if err := r.NetAssetValue.Validate(); err != nil {
+		return fmt.Errorf("invalid net asset value record: %w", err)
+	}
+	return nil
+}
+
+// Validate returns error if HolderFreeze is not in a valid state
+func (f HolderFreeze) Validate() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return fmt.Errorf("invalid holder freeze denom: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(f.Holder); err != nil {
+		return fmt.Errorf("invalid holder freeze holder %q: %w", f.Holder, err)
+	}
+	if f.Amount.IsNil() || !f.Amount.IsPositive() {
+		return fmt.Errorf("invalid holder freeze amount %q: must be positive", f.Amount)
+	}
+	if f.ExpiresAt.IsZero() {
+		return errors.New("invalid holder freeze expires at: cannot be zero")
+	}
+	if len(strings.TrimSpace(f.Reason)) == 0 {
+		return errors.New("invalid holder freeze reason: cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(f.Administrator); err != nil {
+		return fmt.Errorf("invalid holder freeze administrator %q: %w", f.Administrator, err)
+	}
+	return nil
+}
+
+// GetCoin returns the frozen funds as a coin.
+func (f HolderFreeze) GetCoin() sdk.Coin {
+	return sdk.NewCoin(f.Denom, f.Amount)
+}
+
+// Validate checks that the distribution is valid.
+func (d Distribution) Validate() error {
+	if d.Id == 0 {
+		return errors.New("invalid distribution id: cannot be zero")
+	}
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return fmt.Errorf("invalid distribution %d denom: %w", d.Id, err)
+	}
+	if err := d.Payout.Validate(); err != nil {
+		return fmt.Errorf("invalid distribution %d payout: %w", d.Id, err)
+	}
+	if err := d.Allocated.Validate(); err != nil {
+		return fmt.Errorf("invalid distribution %d allocated: %w", d.Id, err)
+	}
+	if d.Allocated.Denom != d.Payout.Denom || d.Allocated.Amount.GT(d.Payout.Amount) {
+		return fmt.Errorf("invalid distribution %d allocated %q: must not exceed payout %q", d.Id, d.Allocated, d.Payout)
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Administrator); err != nil {
+		return fmt.Errorf("invalid distribution %d administrator %q: %w", d.Id, d.Administrator, err)
+	}
+	if d.EligibleSupply.IsNil() || !d.EligibleSupply.IsPositive() {
+		return fmt.Errorf("invalid distribution %d eligible supply %q: must be positive", d.Id, d.EligibleSupply)
+	}
+	if d.ProcessedCount > d.HolderCount {
+		return fmt.Errorf("invalid distribution %d processed count %d: cannot exceed holder count %d", d.Id, d.ProcessedCount, d.HolderCount)
+	}
+	if !d.SnapshotPending && len(d.SnapshotNextKey) > 0 {
+		return fmt.Errorf("invalid distribution %d snapshot next key: must be empty when the snapshot is not pending", d.Id)
+	}
+	return nil
+}
+
+// IsComplete returns true if all the holders of this distribution have been recorded and processed.
+func (d Distribution) IsComplete() bool {
+	return !d.SnapshotPending && d.ProcessedCount >= d.HolderCount
+}
+
+// Validate checks that the distribution payment is valid.
+// A zero amount is allowed since it marks a holder that has been recorded, but is not owed anything.
+func (p DistributionPayment) Validate() error {
+	if p.DistributionId == 0 {
+		return errors.New("invalid distribution payment distribution id: cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Holder); err != nil {
+		return fmt.Errorf("invalid distribution payment holder %q: %w", p.Holder, err)
+	}
+	if err := p.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid distribution payment amount: %w", err)
+	}
+	return nil
+}
+
+// Validate checks that the redemption is valid.
+func (r Redemption) Validate() error {
+	if r.Id == 0 {
+		return errors.New("invalid redemption id: cannot be zero")
+	}
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return fmt.Errorf("invalid redemption %d denom: %w", r.Id, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Holder); err != nil {
+		return fmt.Errorf("invalid redemption %d holder %q: %w", r.Id, r.Holder, err)
+	}
+	if err := r.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid redemption %d amount: %w", r.Id, err)
+	}
+	if r.Amount.Denom != r.Denom || !r.Amount.IsPositive() {
+		return fmt.Errorf("invalid redemption %d amount %q: must be a positive amount of %s", r.Id, r.Amount, r.Denom)
+	}
+	if r.RequestedHeight < 0 {
+		return fmt.Errorf("invalid redemption %d requested height %d: cannot be negative", r.Id, r.RequestedHeight)
+	}
+	return nil
+}
+
+// Validate checks that the redemption payout denom is valid.
+func (d RedemptionPayoutDenom) Validate() error {
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return fmt.Errorf("invalid redemption payout marker denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(d.PayoutDenom); err != nil {
+		return fmt.Errorf("invalid redemption payout denom for %s: %w", d.Denom, err)
+	}
+	if d.PayoutDenom == d.Denom {
+		return fmt.Errorf("invalid redemption payout denom for %s: cannot be the marker's denom", d.Denom)
+	}
+	return nil
+}
+
+// TransferLimitWindowHours is the number of hourly transfer volumes that are counted towards a transfer limit.
+const TransferLimitWindowHours = 24
+
+// TransferVolumeHour returns the hour (since the unix epoch) that a transfer at the provided time is counted in.
+func TransferVolumeHour(t time.Time) uint64 {
+	if t.Unix() < 0 {
+		return 0
+	}
+	return uint64(t.Unix() / 3600) //nolint:gosec // G115: Checked above that it's not negative.
+}
+
+// Validate checks that the transfer limits are valid.
+func (l TransferLimits) Validate() error {
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return fmt.Errorf("invalid transfer limits denom: %w", err)
+	}
+	if l.HolderDailyLimit.IsNil() || l.HolderDailyLimit.IsNegative() {
+		return fmt.Errorf("invalid %s holder daily limit %q: cannot be negative", l.Denom, l.HolderDailyLimit)
+	}
+	if l.MarkerDailyLimit.IsNil() || l.MarkerDailyLimit.IsNegative() {
+		return fmt.Errorf("invalid %s marker daily limit %q: cannot be negative", l.Denom, l.MarkerDailyLimit)
+	}
+	return nil
+}
+
+// HasLimits returns true if at least one of the limits is set.
+func (l TransferLimits) HasLimits() bool {
+	return l.HolderDailyLimit.IsPositive() || l.MarkerDailyLimit.IsPositive()
+}
+
+// Validate checks that the transfer volume is valid.
+func (v TransferVolume) Validate() error {
+	if err := sdk.ValidateDenom(v.Denom); err != nil {
+		return fmt.Errorf("invalid transfer volume denom: %w", err)
+	}
+	if len(v.Holder) > 0 {
+		if _, err := sdk.AccAddressFromBech32(v.Holder); err != nil {
+			return fmt.Errorf("invalid %s transfer volume holder %q: %w", v.Denom, v.Holder, err)
+		}
+	}
+	if v.Amount.IsNil() || !v.Amount.IsPositive() {
+		return fmt.Errorf("invalid %s transfer volume amount %q: must be positive", v.Denom, v.Amount)
+	}
+	return nil
+}
+
+// Validate checks that the access grant terms are valid.
+func (t AccessGrantTerms) Validate() error {
+	if err := sdk.ValidateDenom(t.Denom); err != nil {
+		return fmt.Errorf("invalid access grant terms denom: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(t.Address); err != nil {
+		return fmt.Errorf("invalid %s access grant terms address %q: %w", t.Denom, t.Address, err)
+	}
+	if t.ExpiresAt != nil && t.ExpiresAt.IsZero() {
+		return fmt.Errorf("invalid %s access grant terms for %s: expires at cannot be the zero time", t.Denom, t.Address)
+	}
+	if t.MintDailyLimit.IsNil() || t.MintDailyLimit.IsNegative() {
+		return fmt.Errorf("invalid %s access grant terms for %s: mint daily limit %q cannot be negative", t.Denom, t.Address, t.MintDailyLimit)
+	}
+	seen := make(map[string]bool, len(t.WithdrawAddresses))
+	for _, addr := range t.WithdrawAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid %s access grant terms for %s: invalid withdraw address %q: %w", t.Denom, t.Address, addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("invalid %s access grant terms for %s: duplicate withdraw address %s", t.Denom, t.Address, addr)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
+
+// HasTerms returns true if the grant expires or has at least one limit.
+func (t AccessGrantTerms) HasTerms() bool {
+	return t.ExpiresAt != nil || t.MintDailyLimit.IsPositive() || len(t.WithdrawAddresses) > 0
+}
+
+// IsExpired returns true if the grant expires at or before the provided time.
+func (t AccessGrantTerms) IsExpired(blockTime time.Time) bool {
+	return t.ExpiresAt != nil && !t.ExpiresAt.After(blockTime)
+}
+
+// AllowsWithdrawTo returns true if the grantee is allowed to withdraw the marker's funds to the provided address.
+func (t AccessGrantTerms) AllowsWithdrawTo(addr sdk.AccAddress) bool {
+	if len(t.WithdrawAddresses) == 0 {
+		return true
+	}
+	for _, allowed := range t.WithdrawAddresses {
+		if allowed == addr.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the access grant mint volume is valid.
+func (v AccessGrantMintVolume) Validate() error {
+	if err := sdk.ValidateDenom(v.Denom); err != nil {
+		return fmt.Errorf("invalid access grant mint volume denom: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(v.Address); err != nil {
+		return fmt.Errorf("invalid %s access grant mint volume address %q: %w", v.Denom, v.Address, err)
+	}
+	if v.Amount.IsNil() || !v.Amount.IsPositive() {
+		return fmt.Errorf("invalid %s access grant mint volume amount %q: must be positive", v.Denom, v.Amount)
+	}
+	return nil
+}
+
+// PendingOperationLifetime is how long an operation waits for approvals before it is removed.
+const PendingOperationLifetime = 7 * 24 * time.Hour
+
+// IsApprovalPermission returns true if an approval threshold can be set for the provided permission.
+func IsApprovalPermission(permission Access) bool {
+	return permission == Access_Mint || permission == Access_Withdraw || permission == Access_ForceTransfer
+}
+
+// Validate checks that the approval threshold is valid.
+func (t ApprovalThreshold) Validate() error {
+	if err := sdk.ValidateDenom(t.Denom); err != nil {
+		return fmt.Errorf("invalid approval threshold denom: %w", err)
+	}
+	if !IsApprovalPermission(t.Permission) {
+		return fmt.Errorf("invalid %s approval threshold permission %s: must be one of %s, %s or %s",
+			t.Denom, t.Permission, Access_Mint, Access_Withdraw, Access_ForceTransfer)
+	}
+	if t.Threshold < 2 {
+		return fmt.Errorf("invalid %s %s approval threshold %d: must be at least 2", t.Denom, t.Permission, t.Threshold)
+	}
+	return nil
+}
+
+// Validate checks that the pending operation is valid.
+func (o PendingOperation) Validate() error {
+	if o.Id == 0 {
+		return errors.New("invalid pending operation id: cannot be zero")
+	}
+	if err := sdk.ValidateDenom(o.Denom); err != nil {
+		return fmt.Errorf("invalid pending operation %d denom: %w", o.Id, err)
+	}
+	if !IsApprovalPermission(o.Permission) {
+		return fmt.Errorf("invalid pending operation %d permission %s", o.Id, o.Permission)
+	}
+	if o.RequiredApprovals < 2 {
+		return fmt.Errorf("invalid pending operation %d required approvals %d: must be at least 2", o.Id, o.RequiredApprovals)
+	}
+	if o.Msg == nil || len(o.Msg.TypeUrl) == 0 {
+		return fmt.Errorf("invalid pending operation %d: msg cannot be empty", o.Id)
+	}
+	if len(o.Approvers) == 0 {
+		return fmt.Errorf("invalid pending operation %d: must have at least one approver", o.Id)
+	}
+	if len(o.Approvers) >= int(o.RequiredApprovals) {
+		return fmt.Errorf("invalid pending operation %d: has %d approvals but only needs %d", o.Id, len(o.Approvers), o.RequiredApprovals)
+	}
+	seen := make(map[string]bool, len(o.Approvers))
+	for _, approver := range o.Approvers {
+		if _, err := sdk.AccAddressFromBech32(approver); err != nil {
+			return fmt.Errorf("invalid pending operation %d approver %q: %w", o.Id, approver, err)
+		}
+		if seen[approver] {
+			return fmt.Errorf("invalid pending operation %d: duplicate approver %s", o.Id, approver)
+		}
+		seen[approver] = true
+	}
+	if o.ExpiresAt.IsZero() {
+		return fmt.Errorf("invalid pending operation %d: expires at cannot be the zero time", o.Id)
+	}
+	return nil
+}
+
+// HasApproved returns true if the provided address has already approved the operation.
+func (o PendingOperation) HasApproved(addr string) bool {
+	for _, approver := range o.Approvers {
+		if approver == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExpired returns true if the operation expires at or before the provided time.
+func (o PendingOperation) IsExpired(blockTime time.Time) bool {
+	return !o.ExpiresAt.After(blockTime)
+}
+
+// NewCapTableEntry creates a cap table entry for the provided holder with all amounts set to zero.
+func NewCapTableEntry(holder sdk.AccAddress) *CapTableEntry {
+	return &CapTableEntry{
+		Address:     holder.String(),
+		Total:       sdkmath.ZeroInt(),
+		Balance:     sdkmath.ZeroInt(),
+		Spendable:   sdkmath.ZeroInt(),
+		Held:        sdkmath.ZeroInt(),
+		Frozen:      sdkmath.ZeroInt(),
+		Quarantined: sdkmath.ZeroInt(),
+		Escrowed:    sdkmath.ZeroInt(),
+	}
+}
+
+// Validate checks that the cap table entry is valid.
+func (e CapTableEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return fmt.Errorf("invalid cap table entry address %q: %w", e.Address, err)
+	}
+	amounts := []struct {
+		name   string
+		amount sdkmath.Int
+	}{
+		{name: "total", amount: e.Total},
+		{name: "balance", amount: e.Balance},
+		{name: "spendable", amount: e.Spendable},
+		{name: "held", amount: e.Held},
+		{name: "frozen", amount: e.Frozen},
+		{name: "quarantined", amount: e.Quarantined},
+		{name: "escrowed", amount: e.Escrowed},
+	}
+	for _, a := range amounts {
+		if a.amount.IsNil() || a.amount.IsNegative() {
+			return fmt.Errorf("invalid %s cap table entry %s %q: cannot be negative", e.Address, a.name, a.amount)
+		}
+	}
+	if !e.Total.IsPositive() {
+		return fmt.Errorf("invalid %s cap table entry total %q: must be positive", e.Address, e.Total)
+	}
+	if !e.Total.Equal(e.Balance.Add(e.Quarantined).Add(e.Escrowed)) {
+		return fmt.Errorf("invalid %s cap table entry total %q: must equal the balance %q plus the quarantined %q and escrowed %q amounts",
+			e.Address, e.Total, e.Balance, e.Quarantined, e.Escrowed)
+	}
+	return nil
+}
+
+// Validate checks that the holder snapshot is valid.
+func (s HolderSnapshot) Validate() error {
+	if s.Id == 0 {
+		return errors.New("invalid holder snapshot id: cannot be zero")
+	}
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return fmt.Errorf("invalid holder snapshot %d denom: %w", s.Id, err)
+	}
+	if s.Height < 0 {
+		return fmt.Errorf("invalid holder snapshot %d height %d: cannot be negative", s.Id, s.Height)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.TakenBy); err != nil {
+		return fmt.Errorf("invalid holder snapshot %d taken by %q: %w", s.Id, s.TakenBy, err)
+	}
+	if s.Supply.IsNil() || s.Supply.IsNegative() {
+		return fmt.Errorf("invalid holder snapshot %d supply %q: cannot be negative", s.Id, s.Supply)
+	}
+	if !s.Pending && len(s.NextKey) > 0 {
+		return fmt.Errorf("invalid holder snapshot %d next key: must be empty when the snapshot is not pending", s.Id)
+	}
+	return nil
+}
+
+// ForcedTransferReasonByName returns the ForcedTransferReason with the given name.
+// The "FORCED_TRANSFER_REASON_" prefix is optional and the name is not case-sensitive.
+func ForcedTransferReasonByName(name string) (ForcedTransferReason, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if !strings.HasPrefix(name, "FORCED_TRANSFER_REASON_") {
+		name = "FORCED_TRANSFER_REASON_" + name
+	}
+	reason, ok := ForcedTransferReason_value[name]
+	if !ok || reason == int32(ForcedTransferReason_Unspecified) {
+		return ForcedTransferReason_Unspecified, fmt.Errorf("unknown forced transfer reason %q", name)
+	}
+	return ForcedTransferReason(reason), nil
+}
+
+// Validate returns an error if the reason is not a known value. The unspecified reason is allowed.
+func (r ForcedTransferReason) Validate() error {
+	if _, ok := ForcedTransferReason_name[int32(r)]; !ok {
+		return fmt.Errorf("unknown forced transfer reason %d", r)
+	}
+	return nil
+}
+
+// Validate checks that the forced transfer record is valid.
+func (r ForcedTransferRecord) Validate() error {
+	if r.Id == 0 {
+		return errors.New("invalid forced transfer record id: cannot be zero")
+	}
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return fmt.Errorf("invalid forced transfer record %d denom: %w", r.Id, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.FromAddress); err != nil {
+		return fmt.Errorf("invalid forced transfer record %d from address %q: %w", r.Id, r.FromAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.ToAddress); err != nil {
+		return fmt.Errorf("invalid forced transfer record %d to address %q: %w", r.Id, r.ToAddress, err)
+	}
+	if r.Amount.IsNil() || !r.Amount.IsPositive() {
+		return fmt.Errorf("invalid forced transfer record %d amount %q: must be positive", r.Id, r.Amount)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Administrator); err != nil {
+		return fmt.Errorf("invalid forced transfer record %d administrator %q: %w", r.Id, r.Administrator, err)
+	}
+	if r.Reason == ForcedTransferReason_Unspecified {
+		return fmt.Errorf("invalid forced transfer record %d reason: cannot be unspecified", r.Id)
+	}
+	if err := r.Reason.Validate(); err != nil {
+		return fmt.Errorf("invalid forced transfer record %d: %w", r.Id, err)
+	}
+	if len(strings.TrimSpace(r.Reference)) == 0 {
+		return fmt.Errorf("invalid forced transfer record %d reference: cannot be empty", r.Id)
+	}
+	if r.Height < 0 {
+		return fmt.Errorf("invalid forced transfer record %d height %d: cannot be negative", r.Id, r.Height)
+	}
+	return nil
+}
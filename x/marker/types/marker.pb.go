@@ -7,19 +7,25 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types2 "github.com/cosmos/cosmos-sdk/codec/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -100,6 +106,112 @@ func (MarkerStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{1}
 }
 
+// AttributeCondition defines how the value of an attribute is checked by an AttributeRequirement.
+type AttributeCondition int32
+
+const (
+	// ATTRIBUTE_CONDITION_UNSPECIFIED defines a no-op condition (invalid).
+	AttributeCondition_Unspecified AttributeCondition = 0
+	// ATTRIBUTE_CONDITION_PRESENT requires that the attribute exists, regardless of its value.
+	AttributeCondition_Present AttributeCondition = 1
+	// ATTRIBUTE_CONDITION_EQUAL requires that the value equals the single requirement value.
+	AttributeCondition_Equal AttributeCondition = 2
+	// ATTRIBUTE_CONDITION_IN requires that the value equals one of the requirement values.
+	AttributeCondition_In AttributeCondition = 3
+	// ATTRIBUTE_CONDITION_NOT_IN requires that the value does not equal any of the requirement values.
+	AttributeCondition_NotIn AttributeCondition = 4
+	// ATTRIBUTE_CONDITION_GREATER_THAN requires a numeric value greater than the single requirement value.
+	AttributeCondition_GreaterThan AttributeCondition = 5
+	// ATTRIBUTE_CONDITION_GREATER_THAN_OR_EQUAL requires a numeric value greater than or equal to the single
+	// requirement value.
+	AttributeCondition_GreaterThanOrEqual AttributeCondition = 6
+	// ATTRIBUTE_CONDITION_LESS_THAN requires a numeric value less than the single requirement value.
+	AttributeCondition_LessThan AttributeCondition = 7
+	// ATTRIBUTE_CONDITION_LESS_THAN_OR_EQUAL requires a numeric value less than or equal to the single
+	// requirement value.
+	AttributeCondition_LessThanOrEqual AttributeCondition = 8
+)
+
+var AttributeCondition_name = map[int32]string{
+	0: "ATTRIBUTE_CONDITION_UNSPECIFIED",
+	1: "ATTRIBUTE_CONDITION_PRESENT",
+	2: "ATTRIBUTE_CONDITION_EQUAL",
+	3: "ATTRIBUTE_CONDITION_IN",
+	4: "ATTRIBUTE_CONDITION_NOT_IN",
+	5: "ATTRIBUTE_CONDITION_GREATER_THAN",
+	6: "ATTRIBUTE_CONDITION_GREATER_THAN_OR_EQUAL",
+	7: "ATTRIBUTE_CONDITION_LESS_THAN",
+	8: "ATTRIBUTE_CONDITION_LESS_THAN_OR_EQUAL",
+}
+
+var AttributeCondition_value = map[string]int32{
+	"ATTRIBUTE_CONDITION_UNSPECIFIED":           0,
+	"ATTRIBUTE_CONDITION_PRESENT":               1,
+	"ATTRIBUTE_CONDITION_EQUAL":                 2,
+	"ATTRIBUTE_CONDITION_IN":                    3,
+	"ATTRIBUTE_CONDITION_NOT_IN":                4,
+	"ATTRIBUTE_CONDITION_GREATER_THAN":          5,
+	"ATTRIBUTE_CONDITION_GREATER_THAN_OR_EQUAL": 6,
+	"ATTRIBUTE_CONDITION_LESS_THAN":             7,
+	"ATTRIBUTE_CONDITION_LESS_THAN_OR_EQUAL":    8,
+}
+
+func (x AttributeCondition) String() string {
+	return proto.EnumName(AttributeCondition_name, int32(x))
+}
+
+func (AttributeCondition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{2}
+}
+
+// ForcedTransferReason is the reason given by an administrator for a forced transfer.
+type ForcedTransferReason int32
+
+const (
+	// FORCED_TRANSFER_REASON_UNSPECIFIED defines a no-op reason (invalid).
+	ForcedTransferReason_Unspecified ForcedTransferReason = 0
+	// FORCED_TRANSFER_REASON_COURT_ORDER is used for transfers ordered by a court.
+	ForcedTransferReason_CourtOrder ForcedTransferReason = 1
+	// FORCED_TRANSFER_REASON_LOST_KEY_RECOVERY is used to recover the funds of a holder that lost their keys.
+	ForcedTransferReason_LostKeyRecovery ForcedTransferReason = 2
+	// FORCED_TRANSFER_REASON_REGULATORY_ACTION is used for transfers required by a regulator.
+	ForcedTransferReason_RegulatoryAction ForcedTransferReason = 3
+	// FORCED_TRANSFER_REASON_ERROR_CORRECTION is used to reverse a transfer that was made in error.
+	ForcedTransferReason_ErrorCorrection ForcedTransferReason = 4
+	// FORCED_TRANSFER_REASON_ESTATE_SETTLEMENT is used to move the funds of a deceased holder.
+	ForcedTransferReason_EstateSettlement ForcedTransferReason = 5
+	// FORCED_TRANSFER_REASON_OTHER is used for any other reason. The reference should describe it.
+	ForcedTransferReason_Other ForcedTransferReason = 6
+)
+
+var ForcedTransferReason_name = map[int32]string{
+	0: "FORCED_TRANSFER_REASON_UNSPECIFIED",
+	1: "FORCED_TRANSFER_REASON_COURT_ORDER",
+	2: "FORCED_TRANSFER_REASON_LOST_KEY_RECOVERY",
+	3: "FORCED_TRANSFER_REASON_REGULATORY_ACTION",
+	4: "FORCED_TRANSFER_REASON_ERROR_CORRECTION",
+	5: "FORCED_TRANSFER_REASON_ESTATE_SETTLEMENT",
+	6: "FORCED_TRANSFER_REASON_OTHER",
+}
+
+var ForcedTransferReason_value = map[string]int32{
+	"FORCED_TRANSFER_REASON_UNSPECIFIED":       0,
+	"FORCED_TRANSFER_REASON_COURT_ORDER":       1,
+	"FORCED_TRANSFER_REASON_LOST_KEY_RECOVERY": 2,
+	"FORCED_TRANSFER_REASON_REGULATORY_ACTION": 3,
+	"FORCED_TRANSFER_REASON_ERROR_CORRECTION":  4,
+	"FORCED_TRANSFER_REASON_ESTATE_SETTLEMENT": 5,
+	"FORCED_TRANSFER_REASON_OTHER":             6,
+}
+
+func (x ForcedTransferReason) String() string {
+	return proto.EnumName(ForcedTransferReason_name, int32(x))
+}
+
+func (ForcedTransferReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{3}
+}
+
 // Params defines the set of params for the account module.
 type Params struct {
 	// Deprecated: Prefer to use `max_supply` instead. Maximum amount of supply to allow a marker to be created with
@@ -111,6 +223,9 @@ type Params struct {
 	UnrestrictedDenomRegex string `protobuf:"bytes,3,opt,name=unrestricted_denom_regex,json=unrestrictedDenomRegex,proto3" json:"unrestricted_denom_regex,omitempty"`
 	// maximum amount of supply to allow a marker to be created with
 	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// max_nav_history is the number of past net asset values to keep for each marker (or scope) and price denom.
+	// Zero disables the net asset value history.
+	MaxNavHistory uint32 `protobuf:"varint,5,opt,name=max_nav_history,json=maxNavHistory,proto3" json:"max_nav_history,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -168,6 +283,13 @@ func (m *Params) GetUnrestrictedDenomRegex() string {
 	return ""
 }
 
+func (m *Params) GetMaxNavHistory() uint32 {
+	if m != nil {
+		return m.MaxNavHistory
+	}
+	return 0
+}
+
 // MarkerAccount holds the marker configuration information in addition to a base account structure.
 type MarkerAccount struct {
 	// base cosmos account information including address and coin holdings.
@@ -242,6 +364,9 @@ type NetAssetValue struct {
 	Volume uint64 `protobuf:"varint,2,opt,name=volume,proto3" json:"volume,omitempty"`
 	// updated_block_height is the block height of last update
 	UpdatedBlockHeight uint64 `protobuf:"varint,3,opt,name=updated_block_height,json=updatedBlockHeight,proto3" json:"updated_block_height,omitempty"`
+	// stale is true if the net asset value is older than the marker's maximum net asset value age.
+	// It is only populated when a net asset value is read, and is never stored.
+	Stale bool `protobuf:"varint,4,opt,name=stale,proto3" json:"stale,omitempty"`
 }
 
 func (m *NetAssetValue) Reset()         { *m = NetAssetValue{} }
@@ -298,28 +423,35 @@ func (m *NetAssetValue) GetUpdatedBlockHeight() uint64 {
 	return 0
 }
 
-// EventMarkerAdd event emitted when marker is added
-type EventMarkerAdd struct {
-	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount     string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Manager    string `protobuf:"bytes,4,opt,name=manager,proto3" json:"manager,omitempty"`
-	MarkerType string `protobuf:"bytes,5,opt,name=marker_type,json=markerType,proto3" json:"marker_type,omitempty"`
-	Address    string `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+func (m *NetAssetValue) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
 }
 
-func (m *EventMarkerAdd) Reset()         { *m = EventMarkerAdd{} }
-func (m *EventMarkerAdd) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerAdd) ProtoMessage()    {}
-func (*EventMarkerAdd) Descriptor() ([]byte, []int) {
+// NetAssetValueRecord is an entry in the history of a marker's net asset values.
+type NetAssetValueRecord struct {
+	// net_asset_value is the net asset value that was set.
+	NetAssetValue NetAssetValue `protobuf:"bytes,1,opt,name=net_asset_value,json=netAssetValue,proto3" json:"net_asset_value"`
+	// block_time is the time of the block in which the net asset value was set.
+	BlockTime time.Time `protobuf:"bytes,2,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// source is where the net asset value came from, e.g. an exchange market, an administrator, or a module.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (m *NetAssetValueRecord) Reset()         { *m = NetAssetValueRecord{} }
+func (m *NetAssetValueRecord) String() string { return proto.CompactTextString(m) }
+func (*NetAssetValueRecord) ProtoMessage()    {}
+func (*NetAssetValueRecord) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{3}
 }
-func (m *EventMarkerAdd) XXX_Unmarshal(b []byte) error {
+func (m *NetAssetValueRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NetAssetValueRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerAdd.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NetAssetValueRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -329,79 +461,163 @@ func (m *EventMarkerAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerAdd) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerAdd.Merge(m, src)
+func (m *NetAssetValueRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetAssetValueRecord.Merge(m, src)
 }
-func (m *EventMarkerAdd) XXX_Size() int {
+func (m *NetAssetValueRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerAdd) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerAdd.DiscardUnknown(m)
+func (m *NetAssetValueRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetAssetValueRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerAdd proto.InternalMessageInfo
+var xxx_messageInfo_NetAssetValueRecord proto.InternalMessageInfo
 
-func (m *EventMarkerAdd) GetDenom() string {
+func (m *NetAssetValueRecord) GetNetAssetValue() NetAssetValue {
 	if m != nil {
-		return m.Denom
+		return m.NetAssetValue
 	}
-	return ""
+	return NetAssetValue{}
 }
 
-func (m *EventMarkerAdd) GetAmount() string {
+func (m *NetAssetValueRecord) GetBlockTime() time.Time {
 	if m != nil {
-		return m.Amount
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *NetAssetValueRecord) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
 	return ""
 }
 
-func (m *EventMarkerAdd) GetStatus() string {
+// HolderFreeze defines an amount of a restricted marker's coin that is frozen in a holder's account.
+type HolderFreeze struct {
+	// denom is the marker's denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// holder is the bech32 address of the account that the funds are frozen in.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// amount is the amount of the marker's denom that is frozen.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// expires_at is the time at which the freeze is no longer in effect.
+	ExpiresAt time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	// reason is a description of why the funds are frozen.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// administrator is the bech32 address of the account that created the freeze.
+	Administrator string `protobuf:"bytes,6,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *HolderFreeze) Reset()         { *m = HolderFreeze{} }
+func (m *HolderFreeze) String() string { return proto.CompactTextString(m) }
+func (*HolderFreeze) ProtoMessage()    {}
+func (*HolderFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{4}
+}
+func (m *HolderFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HolderFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HolderFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HolderFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HolderFreeze.Merge(m, src)
+}
+func (m *HolderFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *HolderFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_HolderFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HolderFreeze proto.InternalMessageInfo
+
+func (m *HolderFreeze) GetDenom() string {
 	if m != nil {
-		return m.Status
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerAdd) GetManager() string {
+func (m *HolderFreeze) GetHolder() string {
 	if m != nil {
-		return m.Manager
+		return m.Holder
 	}
 	return ""
 }
 
-func (m *EventMarkerAdd) GetMarkerType() string {
+func (m *HolderFreeze) GetExpiresAt() time.Time {
 	if m != nil {
-		return m.MarkerType
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+func (m *HolderFreeze) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *EventMarkerAdd) GetAddress() string {
+func (m *HolderFreeze) GetAdministrator() string {
 	if m != nil {
-		return m.Address
+		return m.Administrator
 	}
 	return ""
 }
 
-// EventMarkerAddAccess event emitted when marker access is added
-type EventMarkerAddAccess struct {
-	Access        EventMarkerAccess `protobuf:"bytes,1,opt,name=access,proto3" json:"access"`
-	Denom         string            `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string            `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+// Distribution is a pro-rata distribution of a payout to the holders of a marker's coin.
+type Distribution struct {
+	// id is the unique identifier of this distribution.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// denom is the denom of the marker whose holders receive the payout.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// payout is the amount that was requested to be distributed.
+	Payout types1.Coin `protobuf:"bytes,3,opt,name=payout,proto3" json:"payout"`
+	// allocated is the amount of the payout owed to the holders recorded so far.
+	// Once the snapshot is done, the rest of the payout (the rounding remainder) is returned to the administrator.
+	Allocated types1.Coin `protobuf:"bytes,4,opt,name=allocated,proto3" json:"allocated"`
+	// administrator is the bech32 address of the account that funded the distribution.
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// snapshot_height is the block height of the holder balances that are used for this distribution.
+	SnapshotHeight int64 `protobuf:"varint,6,opt,name=snapshot_height,json=snapshotHeight,proto3" json:"snapshot_height,omitempty"`
+	// eligible_supply is the supply of the marker's coin at the snapshot height, less the amounts held
+	// by the marker's own account and the marker module account.
+	EligibleSupply cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=eligible_supply,json=eligibleSupply,proto3,customtype=cosmossdk.io/math.Int" json:"eligible_supply"`
+	// holder_count is the number of holders that are owed part of the payout.
+	HolderCount uint64 `protobuf:"varint,8,opt,name=holder_count,json=holderCount,proto3" json:"holder_count,omitempty"`
+	// processed_count is the number of holders that have either been paid or been given a claim.
+	ProcessedCount uint64 `protobuf:"varint,9,opt,name=processed_count,json=processedCount,proto3" json:"processed_count,omitempty"`
+	// snapshot_pending is true while the holder balances are still being recorded.
+	SnapshotPending bool `protobuf:"varint,10,opt,name=snapshot_pending,json=snapshotPending,proto3" json:"snapshot_pending,omitempty"`
+	// snapshot_next_key is where the recording of holder balances will continue from in the next block.
+	SnapshotNextKey []byte `protobuf:"bytes,11,opt,name=snapshot_next_key,json=snapshotNextKey,proto3" json:"snapshot_next_key,omitempty"`
 }
 
-func (m *EventMarkerAddAccess) Reset()         { *m = EventMarkerAddAccess{} }
-func (m *EventMarkerAddAccess) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerAddAccess) ProtoMessage()    {}
-func (*EventMarkerAddAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{4}
+func (m *Distribution) Reset()         { *m = Distribution{} }
+func (m *Distribution) String() string { return proto.CompactTextString(m) }
+func (*Distribution) ProtoMessage()    {}
+func (*Distribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{5}
 }
-func (m *EventMarkerAddAccess) XXX_Unmarshal(b []byte) error {
+func (m *Distribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerAddAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Distribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerAddAccess.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Distribution.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -411,57 +627,110 @@ func (m *EventMarkerAddAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerAddAccess) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerAddAccess.Merge(m, src)
+func (m *Distribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Distribution.Merge(m, src)
 }
-func (m *EventMarkerAddAccess) XXX_Size() int {
+func (m *Distribution) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerAddAccess) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerAddAccess.DiscardUnknown(m)
+func (m *Distribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Distribution.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerAddAccess proto.InternalMessageInfo
+var xxx_messageInfo_Distribution proto.InternalMessageInfo
 
-func (m *EventMarkerAddAccess) GetAccess() EventMarkerAccess {
+func (m *Distribution) GetId() uint64 {
 	if m != nil {
-		return m.Access
+		return m.Id
 	}
-	return EventMarkerAccess{}
+	return 0
 }
 
-func (m *EventMarkerAddAccess) GetDenom() string {
+func (m *Distribution) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerAddAccess) GetAdministrator() string {
+func (m *Distribution) GetPayout() types1.Coin {
+	if m != nil {
+		return m.Payout
+	}
+	return types1.Coin{}
+}
+
+func (m *Distribution) GetAllocated() types1.Coin {
+	if m != nil {
+		return m.Allocated
+	}
+	return types1.Coin{}
+}
+
+func (m *Distribution) GetAdministrator() string {
 	if m != nil {
 		return m.Administrator
 	}
 	return ""
 }
 
-// EventMarkerAccess event access permissions for address
-type EventMarkerAccess struct {
-	Address     string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+func (m *Distribution) GetSnapshotHeight() int64 {
+	if m != nil {
+		return m.SnapshotHeight
+	}
+	return 0
 }
 
-func (m *EventMarkerAccess) Reset()         { *m = EventMarkerAccess{} }
-func (m *EventMarkerAccess) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerAccess) ProtoMessage()    {}
-func (*EventMarkerAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{5}
+func (m *Distribution) GetHolderCount() uint64 {
+	if m != nil {
+		return m.HolderCount
+	}
+	return 0
 }
-func (m *EventMarkerAccess) XXX_Unmarshal(b []byte) error {
+
+func (m *Distribution) GetProcessedCount() uint64 {
+	if m != nil {
+		return m.ProcessedCount
+	}
+	return 0
+}
+
+func (m *Distribution) GetSnapshotPending() bool {
+	if m != nil {
+		return m.SnapshotPending
+	}
+	return false
+}
+
+func (m *Distribution) GetSnapshotNextKey() []byte {
+	if m != nil {
+		return m.SnapshotNextKey
+	}
+	return nil
+}
+
+// DistributionPayment is the amount of a distribution owed to a single holder.
+type DistributionPayment struct {
+	// distribution_id is the id of the distribution this payment is part of.
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	// holder is the bech32 address of the account that is owed the payment.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// amount is the amount owed to the holder.
+	Amount types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *DistributionPayment) Reset()         { *m = DistributionPayment{} }
+func (m *DistributionPayment) String() string { return proto.CompactTextString(m) }
+func (*DistributionPayment) ProtoMessage()    {}
+func (*DistributionPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{6}
+}
+func (m *DistributionPayment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DistributionPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerAccess.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DistributionPayment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -471,51 +740,65 @@ func (m *EventMarkerAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerAccess) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerAccess.Merge(m, src)
+func (m *DistributionPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionPayment.Merge(m, src)
 }
-func (m *EventMarkerAccess) XXX_Size() int {
+func (m *DistributionPayment) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerAccess) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerAccess.DiscardUnknown(m)
+func (m *DistributionPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionPayment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerAccess proto.InternalMessageInfo
+var xxx_messageInfo_DistributionPayment proto.InternalMessageInfo
 
-func (m *EventMarkerAccess) GetAddress() string {
+func (m *DistributionPayment) GetDistributionId() uint64 {
 	if m != nil {
-		return m.Address
+		return m.DistributionId
+	}
+	return 0
+}
+
+func (m *DistributionPayment) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
 	return ""
 }
 
-func (m *EventMarkerAccess) GetPermissions() []string {
+func (m *DistributionPayment) GetAmount() types1.Coin {
 	if m != nil {
-		return m.Permissions
+		return m.Amount
 	}
-	return nil
+	return types1.Coin{}
 }
 
-// EventMarkerDeleteAccess event emitted when marker access is revoked
-type EventMarkerDeleteAccess struct {
-	RemoveAddress string `protobuf:"bytes,1,opt,name=remove_address,json=removeAddress,proto3" json:"remove_address,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+// Redemption is a holder's request to redeem some of a marker's coin for the marker's payout denom.
+type Redemption struct {
+	// id is the unique identifier of this redemption.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// denom is the denom of the marker being redeemed.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// holder is the bech32 address of the account that requested the redemption.
+	Holder string `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	// amount is the amount of the marker's coin held in escrow in the marker module account.
+	Amount types1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// requested_height is the block height at which the redemption was requested.
+	RequestedHeight int64 `protobuf:"varint,5,opt,name=requested_height,json=requestedHeight,proto3" json:"requested_height,omitempty"`
 }
 
-func (m *EventMarkerDeleteAccess) Reset()         { *m = EventMarkerDeleteAccess{} }
-func (m *EventMarkerDeleteAccess) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerDeleteAccess) ProtoMessage()    {}
-func (*EventMarkerDeleteAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{6}
+func (m *Redemption) Reset()         { *m = Redemption{} }
+func (m *Redemption) String() string { return proto.CompactTextString(m) }
+func (*Redemption) ProtoMessage()    {}
+func (*Redemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{7}
 }
-func (m *EventMarkerDeleteAccess) XXX_Unmarshal(b []byte) error {
+func (m *Redemption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerDeleteAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Redemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerDeleteAccess.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Redemption.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -525,57 +808,73 @@ func (m *EventMarkerDeleteAccess) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerDeleteAccess) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerDeleteAccess.Merge(m, src)
+func (m *Redemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redemption.Merge(m, src)
 }
-func (m *EventMarkerDeleteAccess) XXX_Size() int {
+func (m *Redemption) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerDeleteAccess) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerDeleteAccess.DiscardUnknown(m)
+func (m *Redemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redemption.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerDeleteAccess proto.InternalMessageInfo
+var xxx_messageInfo_Redemption proto.InternalMessageInfo
 
-func (m *EventMarkerDeleteAccess) GetRemoveAddress() string {
+func (m *Redemption) GetId() uint64 {
 	if m != nil {
-		return m.RemoveAddress
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *EventMarkerDeleteAccess) GetDenom() string {
+func (m *Redemption) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerDeleteAccess) GetAdministrator() string {
+func (m *Redemption) GetHolder() string {
 	if m != nil {
-		return m.Administrator
+		return m.Holder
 	}
 	return ""
 }
 
-// EventMarkerFinalize event emitted when marker is finalized
-type EventMarkerFinalize struct {
-	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+func (m *Redemption) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
 }
 
-func (m *EventMarkerFinalize) Reset()         { *m = EventMarkerFinalize{} }
-func (m *EventMarkerFinalize) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerFinalize) ProtoMessage()    {}
-func (*EventMarkerFinalize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{7}
+func (m *Redemption) GetRequestedHeight() int64 {
+	if m != nil {
+		return m.RequestedHeight
+	}
+	return 0
 }
-func (m *EventMarkerFinalize) XXX_Unmarshal(b []byte) error {
+
+// RedemptionPayoutDenom is the denom that a marker's holders are paid in when their redemptions are fulfilled.
+type RedemptionPayoutDenom struct {
+	// denom is the marker's denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// payout_denom is the denom that redemptions are paid out in.
+	PayoutDenom string `protobuf:"bytes,2,opt,name=payout_denom,json=payoutDenom,proto3" json:"payout_denom,omitempty"`
+}
+
+func (m *RedemptionPayoutDenom) Reset()         { *m = RedemptionPayoutDenom{} }
+func (m *RedemptionPayoutDenom) String() string { return proto.CompactTextString(m) }
+func (*RedemptionPayoutDenom) ProtoMessage()    {}
+func (*RedemptionPayoutDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{8}
+}
+func (m *RedemptionPayoutDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerFinalize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RedemptionPayoutDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerFinalize.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RedemptionPayoutDenom.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -585,50 +884,55 @@ func (m *EventMarkerFinalize) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerFinalize) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerFinalize.Merge(m, src)
+func (m *RedemptionPayoutDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionPayoutDenom.Merge(m, src)
 }
-func (m *EventMarkerFinalize) XXX_Size() int {
+func (m *RedemptionPayoutDenom) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerFinalize) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerFinalize.DiscardUnknown(m)
+func (m *RedemptionPayoutDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionPayoutDenom.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerFinalize proto.InternalMessageInfo
+var xxx_messageInfo_RedemptionPayoutDenom proto.InternalMessageInfo
 
-func (m *EventMarkerFinalize) GetDenom() string {
+func (m *RedemptionPayoutDenom) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerFinalize) GetAdministrator() string {
+func (m *RedemptionPayoutDenom) GetPayoutDenom() string {
 	if m != nil {
-		return m.Administrator
+		return m.PayoutDenom
 	}
 	return ""
 }
 
-// EventMarkerActivate event emitted when marker is activated
-type EventMarkerActivate struct {
-	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+// TransferLimits are the limits on how much of a restricted marker's coin can be sent in a rolling 24 hour window.
+// Sends by, or on behalf of, accounts with transfer access on the marker are not limited or counted.
+type TransferLimits struct {
+	// denom is the marker's denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// holder_daily_limit is the most that a single holder can send in 24 hours. Zero means no limit.
+	HolderDailyLimit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=holder_daily_limit,json=holderDailyLimit,proto3,customtype=cosmossdk.io/math.Int" json:"holder_daily_limit"`
+	// marker_daily_limit is the most that all holders combined can send in 24 hours. Zero means no limit.
+	MarkerDailyLimit cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=marker_daily_limit,json=markerDailyLimit,proto3,customtype=cosmossdk.io/math.Int" json:"marker_daily_limit"`
 }
 
-func (m *EventMarkerActivate) Reset()         { *m = EventMarkerActivate{} }
-func (m *EventMarkerActivate) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerActivate) ProtoMessage()    {}
-func (*EventMarkerActivate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{8}
+func (m *TransferLimits) Reset()         { *m = TransferLimits{} }
+func (m *TransferLimits) String() string { return proto.CompactTextString(m) }
+func (*TransferLimits) ProtoMessage()    {}
+func (*TransferLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{9}
 }
-func (m *EventMarkerActivate) XXX_Unmarshal(b []byte) error {
+func (m *TransferLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerActivate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TransferLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerActivate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TransferLimits.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -638,50 +942,49 @@ func (m *EventMarkerActivate) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerActivate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerActivate.Merge(m, src)
+func (m *TransferLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLimits.Merge(m, src)
 }
-func (m *EventMarkerActivate) XXX_Size() int {
+func (m *TransferLimits) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerActivate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerActivate.DiscardUnknown(m)
+func (m *TransferLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLimits.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerActivate proto.InternalMessageInfo
+var xxx_messageInfo_TransferLimits proto.InternalMessageInfo
 
-func (m *EventMarkerActivate) GetDenom() string {
+func (m *TransferLimits) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerActivate) GetAdministrator() string {
-	if m != nil {
-		return m.Administrator
-	}
-	return ""
-}
-
-// EventMarkerCancel event emitted when marker is cancelled
-type EventMarkerCancel struct {
-	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+// TransferVolume is the amount of a marker's coin sent during a single hour.
+type TransferVolume struct {
+	// denom is the marker's denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// holder is the bech32 address of the account that sent the coins. Empty for the marker-wide volume.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// hour is the number of hours since the unix epoch that the volume was sent in.
+	Hour uint64 `protobuf:"varint,3,opt,name=hour,proto3" json:"hour,omitempty"`
+	// amount is the amount of the marker's coin that was sent.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *EventMarkerCancel) Reset()         { *m = EventMarkerCancel{} }
-func (m *EventMarkerCancel) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerCancel) ProtoMessage()    {}
-func (*EventMarkerCancel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{9}
+func (m *TransferVolume) Reset()         { *m = TransferVolume{} }
+func (m *TransferVolume) String() string { return proto.CompactTextString(m) }
+func (*TransferVolume) ProtoMessage()    {}
+func (*TransferVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{10}
 }
-func (m *EventMarkerCancel) XXX_Unmarshal(b []byte) error {
+func (m *TransferVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TransferVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerCancel.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TransferVolume.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -691,50 +994,66 @@ func (m *EventMarkerCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerCancel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerCancel.Merge(m, src)
+func (m *TransferVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferVolume.Merge(m, src)
 }
-func (m *EventMarkerCancel) XXX_Size() int {
+func (m *TransferVolume) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerCancel) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerCancel.DiscardUnknown(m)
+func (m *TransferVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferVolume.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerCancel proto.InternalMessageInfo
+var xxx_messageInfo_TransferVolume proto.InternalMessageInfo
 
-func (m *EventMarkerCancel) GetDenom() string {
+func (m *TransferVolume) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerCancel) GetAdministrator() string {
+func (m *TransferVolume) GetHolder() string {
 	if m != nil {
-		return m.Administrator
+		return m.Holder
 	}
 	return ""
 }
 
-// EventMarkerDelete event emitted when marker is deleted
-type EventMarkerDelete struct {
-	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+func (m *TransferVolume) GetHour() uint64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
 }
 
-func (m *EventMarkerDelete) Reset()         { *m = EventMarkerDelete{} }
-func (m *EventMarkerDelete) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerDelete) ProtoMessage()    {}
-func (*EventMarkerDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{10}
+// AccessGrantTerms are the optional conditions placed on the access grant of an address on a marker.
+type AccessGrantTerms struct {
+	// denom is the marker's denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address is the bech32 address of the account that the access is granted to.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// expires_at is the time at which the grant is removed from the marker. If not set, the grant does not expire.
+	ExpiresAt *time.Time `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// mint_daily_limit is the most that the address can mint in 24 hours. Zero means no limit.
+	MintDailyLimit cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=mint_daily_limit,json=mintDailyLimit,proto3,customtype=cosmossdk.io/math.Int" json:"mint_daily_limit"`
+	// withdraw_addresses are the only accounts that the address can withdraw the marker's funds to.
+	// If empty, funds can be withdrawn to any account.
+	WithdrawAddresses []string `protobuf:"bytes,5,rep,name=withdraw_addresses,json=withdrawAddresses,proto3" json:"withdraw_addresses,omitempty"`
 }
-func (m *EventMarkerDelete) XXX_Unmarshal(b []byte) error {
+
+func (m *AccessGrantTerms) Reset()         { *m = AccessGrantTerms{} }
+func (m *AccessGrantTerms) String() string { return proto.CompactTextString(m) }
+func (*AccessGrantTerms) ProtoMessage()    {}
+func (*AccessGrantTerms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{11}
+}
+func (m *AccessGrantTerms) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AccessGrantTerms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerDelete.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AccessGrantTerms.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -744,51 +1063,70 @@ func (m *EventMarkerDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerDelete) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerDelete.Merge(m, src)
+func (m *AccessGrantTerms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessGrantTerms.Merge(m, src)
 }
-func (m *EventMarkerDelete) XXX_Size() int {
+func (m *AccessGrantTerms) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerDelete) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerDelete.DiscardUnknown(m)
+func (m *AccessGrantTerms) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessGrantTerms.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerDelete proto.InternalMessageInfo
+var xxx_messageInfo_AccessGrantTerms proto.InternalMessageInfo
 
-func (m *EventMarkerDelete) GetDenom() string {
+func (m *AccessGrantTerms) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerDelete) GetAdministrator() string {
+func (m *AccessGrantTerms) GetAddress() string {
 	if m != nil {
-		return m.Administrator
+		return m.Address
 	}
 	return ""
 }
 
-// EventMarkerMint event emitted when additional marker supply is minted
-type EventMarkerMint struct {
-	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+func (m *AccessGrantTerms) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
 }
 
-func (m *EventMarkerMint) Reset()         { *m = EventMarkerMint{} }
-func (m *EventMarkerMint) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerMint) ProtoMessage()    {}
-func (*EventMarkerMint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{11}
+func (m *AccessGrantTerms) GetWithdrawAddresses() []string {
+	if m != nil {
+		return m.WithdrawAddresses
+	}
+	return nil
 }
-func (m *EventMarkerMint) XXX_Unmarshal(b []byte) error {
+
+// AccessGrantMintVolume is the amount of a marker's coin minted by a grantee during a single hour.
+type AccessGrantMintVolume struct {
+	// denom is the marker's denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address is the bech32 address of the account that minted the coins.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// hour is the number of hours since the unix epoch that the volume was minted in.
+	Hour uint64 `protobuf:"varint,3,opt,name=hour,proto3" json:"hour,omitempty"`
+	// amount is the amount of the marker's coin that was minted.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *AccessGrantMintVolume) Reset()         { *m = AccessGrantMintVolume{} }
+func (m *AccessGrantMintVolume) String() string { return proto.CompactTextString(m) }
+func (*AccessGrantMintVolume) ProtoMessage()    {}
+func (*AccessGrantMintVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{12}
+}
+func (m *AccessGrantMintVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AccessGrantMintVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerMint.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AccessGrantMintVolume.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -798,58 +1136,62 @@ func (m *EventMarkerMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerMint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerMint.Merge(m, src)
+func (m *AccessGrantMintVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessGrantMintVolume.Merge(m, src)
 }
-func (m *EventMarkerMint) XXX_Size() int {
+func (m *AccessGrantMintVolume) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerMint) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerMint.DiscardUnknown(m)
+func (m *AccessGrantMintVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessGrantMintVolume.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerMint proto.InternalMessageInfo
+var xxx_messageInfo_AccessGrantMintVolume proto.InternalMessageInfo
 
-func (m *EventMarkerMint) GetAmount() string {
+func (m *AccessGrantMintVolume) GetDenom() string {
 	if m != nil {
-		return m.Amount
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerMint) GetDenom() string {
+func (m *AccessGrantMintVolume) GetAddress() string {
 	if m != nil {
-		return m.Denom
+		return m.Address
 	}
 	return ""
 }
 
-func (m *EventMarkerMint) GetAdministrator() string {
+func (m *AccessGrantMintVolume) GetHour() uint64 {
 	if m != nil {
-		return m.Administrator
+		return m.Hour
 	}
-	return ""
+	return 0
 }
 
-// EventMarkerBurn event emitted when coin is burned from marker
-type EventMarkerBurn struct {
-	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+// ApprovalThreshold is the number of holders of a permission on a marker that must approve an operation that uses it.
+type ApprovalThreshold struct {
+	// denom is the marker's denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// permission is the access that the threshold applies to. One of ACCESS_MINT, ACCESS_WITHDRAW or
+	// ACCESS_FORCE_TRANSFER.
+	Permission Access `protobuf:"varint,2,opt,name=permission,proto3,enum=provenance.marker.v1.Access" json:"permission,omitempty"`
+	// threshold is the number of approvals needed before an operation is executed.
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func (m *EventMarkerBurn) Reset()         { *m = EventMarkerBurn{} }
-func (m *EventMarkerBurn) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerBurn) ProtoMessage()    {}
-func (*EventMarkerBurn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{12}
+func (m *ApprovalThreshold) Reset()         { *m = ApprovalThreshold{} }
+func (m *ApprovalThreshold) String() string { return proto.CompactTextString(m) }
+func (*ApprovalThreshold) ProtoMessage()    {}
+func (*ApprovalThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{13}
 }
-func (m *EventMarkerBurn) XXX_Unmarshal(b []byte) error {
+func (m *ApprovalThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApprovalThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerBurn.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApprovalThreshold.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -859,59 +1201,69 @@ func (m *EventMarkerBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerBurn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerBurn.Merge(m, src)
+func (m *ApprovalThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovalThreshold.Merge(m, src)
 }
-func (m *EventMarkerBurn) XXX_Size() int {
+func (m *ApprovalThreshold) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerBurn) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerBurn.DiscardUnknown(m)
+func (m *ApprovalThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovalThreshold.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerBurn proto.InternalMessageInfo
+var xxx_messageInfo_ApprovalThreshold proto.InternalMessageInfo
 
-func (m *EventMarkerBurn) GetAmount() string {
+func (m *ApprovalThreshold) GetDenom() string {
 	if m != nil {
-		return m.Amount
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerBurn) GetDenom() string {
+func (m *ApprovalThreshold) GetPermission() Access {
 	if m != nil {
-		return m.Denom
+		return m.Permission
 	}
-	return ""
+	return Access_Unknown
 }
 
-func (m *EventMarkerBurn) GetAdministrator() string {
+func (m *ApprovalThreshold) GetThreshold() uint32 {
 	if m != nil {
-		return m.Administrator
+		return m.Threshold
 	}
-	return ""
+	return 0
 }
 
-// EventMarkerWithdraw event emitted when coins are withdrew from marker
-type EventMarkerWithdraw struct {
-	Coins         string `protobuf:"bytes,1,opt,name=coins,proto3" json:"coins,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
-	ToAddress     string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+// PendingOperation is a mint, withdraw or forced transfer that is waiting for enough approvals to be executed.
+type PendingOperation struct {
+	// id is the unique identifier of this operation.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// denom is the marker's denom.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// permission is the access that approvers must have on the marker.
+	Permission Access `protobuf:"varint,3,opt,name=permission,proto3,enum=provenance.marker.v1.Access" json:"permission,omitempty"`
+	// required_approvals is the number of approvals needed before the operation is executed.
+	RequiredApprovals uint32 `protobuf:"varint,4,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	// msg is the MsgMintRequest, MsgWithdrawRequest or MsgTransferRequest to execute once approved.
+	Msg *types2.Any `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
+	// approvers are the bech32 addresses of the accounts that have approved the operation, starting with the proposer.
+	Approvers []string `protobuf:"bytes,6,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	// expires_at is the time at which the operation is removed if it has not been executed.
+	ExpiresAt time.Time `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
 }
 
-func (m *EventMarkerWithdraw) Reset()         { *m = EventMarkerWithdraw{} }
-func (m *EventMarkerWithdraw) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerWithdraw) ProtoMessage()    {}
-func (*EventMarkerWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{13}
+func (m *PendingOperation) Reset()         { *m = PendingOperation{} }
+func (m *PendingOperation) String() string { return proto.CompactTextString(m) }
+func (*PendingOperation) ProtoMessage()    {}
+func (*PendingOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{14}
 }
-func (m *EventMarkerWithdraw) XXX_Unmarshal(b []byte) error {
+func (m *PendingOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PendingOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerWithdraw.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PendingOperation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -921,67 +1273,97 @@ func (m *EventMarkerWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerWithdraw) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerWithdraw.Merge(m, src)
+func (m *PendingOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingOperation.Merge(m, src)
 }
-func (m *EventMarkerWithdraw) XXX_Size() int {
+func (m *PendingOperation) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerWithdraw) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerWithdraw.DiscardUnknown(m)
+func (m *PendingOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingOperation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerWithdraw proto.InternalMessageInfo
+var xxx_messageInfo_PendingOperation proto.InternalMessageInfo
 
-func (m *EventMarkerWithdraw) GetCoins() string {
+func (m *PendingOperation) GetId() uint64 {
 	if m != nil {
-		return m.Coins
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *EventMarkerWithdraw) GetDenom() string {
+func (m *PendingOperation) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerWithdraw) GetAdministrator() string {
+func (m *PendingOperation) GetPermission() Access {
 	if m != nil {
-		return m.Administrator
+		return m.Permission
 	}
-	return ""
+	return Access_Unknown
 }
 
-func (m *EventMarkerWithdraw) GetToAddress() string {
+func (m *PendingOperation) GetRequiredApprovals() uint32 {
 	if m != nil {
-		return m.ToAddress
+		return m.RequiredApprovals
 	}
-	return ""
+	return 0
 }
 
-// EventMarkerTransfer event emitted when coins are transfered to from account to another
-type EventMarkerTransfer struct {
-	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
-	ToAddress     string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
-	FromAddress   string `protobuf:"bytes,5,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+func (m *PendingOperation) GetMsg() *types2.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
 }
 
-func (m *EventMarkerTransfer) Reset()         { *m = EventMarkerTransfer{} }
-func (m *EventMarkerTransfer) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerTransfer) ProtoMessage()    {}
-func (*EventMarkerTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{14}
+func (m *PendingOperation) GetApprovers() []string {
+	if m != nil {
+		return m.Approvers
+	}
+	return nil
 }
-func (m *EventMarkerTransfer) XXX_Unmarshal(b []byte) error {
+
+func (m *PendingOperation) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+// AttributeRequirement is a condition on the value of an attribute that the recipient of a restricted marker's
+// coins must have. It is met if at least one unexpired attribute on the recipient with a matching name passes it.
+type AttributeRequirement struct {
+	// name is the attribute name. It can start with "*." to match any attribute with that suffix.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// condition is how the attribute's value is checked.
+	Condition AttributeCondition `protobuf:"varint,2,opt,name=condition,proto3,enum=provenance.marker.v1.AttributeCondition" json:"condition,omitempty"`
+	// json_path is the dot-separated path to the value to check in a JSON attribute, e.g. "address.country".
+	// Array elements are selected using their index. If empty, the whole attribute value is checked.
+	JsonPath string `protobuf:"bytes,3,opt,name=json_path,json=jsonPath,proto3" json:"json_path,omitempty"`
+	// values are the values that the attribute value is compared against. Numeric conditions and EQUAL
+	// require exactly one value, IN and NOT_IN require at least one, and PRESENT does not allow any.
+	Values []string `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+	// min_validity is how long the attribute must remain unexpired after the send.
+	// If greater than zero, the attribute must have an expiration date.
+	MinValidity time.Duration `protobuf:"bytes,5,opt,name=min_validity,json=minValidity,proto3,stdduration" json:"min_validity"`
+}
+
+func (m *AttributeRequirement) Reset()         { *m = AttributeRequirement{} }
+func (m *AttributeRequirement) String() string { return proto.CompactTextString(m) }
+func (*AttributeRequirement) ProtoMessage()    {}
+func (*AttributeRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{15}
+}
+func (m *AttributeRequirement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AttributeRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerTransfer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AttributeRequirement.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -991,76 +1373,74 @@ func (m *EventMarkerTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerTransfer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerTransfer.Merge(m, src)
+func (m *AttributeRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeRequirement.Merge(m, src)
 }
-func (m *EventMarkerTransfer) XXX_Size() int {
+func (m *AttributeRequirement) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerTransfer) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerTransfer.DiscardUnknown(m)
+func (m *AttributeRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeRequirement.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerTransfer proto.InternalMessageInfo
+var xxx_messageInfo_AttributeRequirement proto.InternalMessageInfo
 
-func (m *EventMarkerTransfer) GetAmount() string {
+func (m *AttributeRequirement) GetName() string {
 	if m != nil {
-		return m.Amount
+		return m.Name
 	}
 	return ""
 }
 
-func (m *EventMarkerTransfer) GetDenom() string {
+func (m *AttributeRequirement) GetCondition() AttributeCondition {
 	if m != nil {
-		return m.Denom
+		return m.Condition
 	}
-	return ""
+	return AttributeCondition_Unspecified
 }
 
-func (m *EventMarkerTransfer) GetAdministrator() string {
+func (m *AttributeRequirement) GetJsonPath() string {
 	if m != nil {
-		return m.Administrator
+		return m.JsonPath
 	}
 	return ""
 }
 
-func (m *EventMarkerTransfer) GetToAddress() string {
+func (m *AttributeRequirement) GetValues() []string {
 	if m != nil {
-		return m.ToAddress
+		return m.Values
 	}
-	return ""
+	return nil
 }
 
-func (m *EventMarkerTransfer) GetFromAddress() string {
+func (m *AttributeRequirement) GetMinValidity() time.Duration {
 	if m != nil {
-		return m.FromAddress
+		return m.MinValidity
 	}
-	return ""
+	return 0
 }
 
-// EventMarkerSetDenomMetadata event emitted when metadata is set on marker with denom
-type EventMarkerSetDenomMetadata struct {
-	MetadataBase        string            `protobuf:"bytes,1,opt,name=metadata_base,json=metadataBase,proto3" json:"metadata_base,omitempty"`
-	MetadataDescription string            `protobuf:"bytes,2,opt,name=metadata_description,json=metadataDescription,proto3" json:"metadata_description,omitempty"`
-	MetadataDisplay     string            `protobuf:"bytes,3,opt,name=metadata_display,json=metadataDisplay,proto3" json:"metadata_display,omitempty"`
-	MetadataDenomUnits  []*EventDenomUnit `protobuf:"bytes,4,rep,name=metadata_denom_units,json=metadataDenomUnits,proto3" json:"metadata_denom_units,omitempty"`
-	Administrator       string            `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
-	MetadataName        string            `protobuf:"bytes,6,opt,name=metadata_name,json=metadataName,proto3" json:"metadata_name,omitempty"`
-	MetadataSymbol      string            `protobuf:"bytes,7,opt,name=metadata_symbol,json=metadataSymbol,proto3" json:"metadata_symbol,omitempty"`
+// AttributeRequirements are the attribute value conditions placed on the recipients of a restricted marker's coins.
+// They are checked in addition to the marker's required attributes.
+type AttributeRequirements struct {
+	// denom is the marker's denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// requirements are the conditions that must all be met by the recipient.
+	Requirements []AttributeRequirement `protobuf:"bytes,2,rep,name=requirements,proto3" json:"requirements"`
 }
 
-func (m *EventMarkerSetDenomMetadata) Reset()         { *m = EventMarkerSetDenomMetadata{} }
-func (m *EventMarkerSetDenomMetadata) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerSetDenomMetadata) ProtoMessage()    {}
-func (*EventMarkerSetDenomMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{15}
+func (m *AttributeRequirements) Reset()         { *m = AttributeRequirements{} }
+func (m *AttributeRequirements) String() string { return proto.CompactTextString(m) }
+func (*AttributeRequirements) ProtoMessage()    {}
+func (*AttributeRequirements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{16}
 }
-func (m *EventMarkerSetDenomMetadata) XXX_Unmarshal(b []byte) error {
+func (m *AttributeRequirements) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerSetDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AttributeRequirements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerSetDenomMetadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AttributeRequirements.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1070,86 +1450,239 @@ func (m *EventMarkerSetDenomMetadata) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerSetDenomMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerSetDenomMetadata.Merge(m, src)
+func (m *AttributeRequirements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeRequirements.Merge(m, src)
 }
-func (m *EventMarkerSetDenomMetadata) XXX_Size() int {
+func (m *AttributeRequirements) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerSetDenomMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerSetDenomMetadata.DiscardUnknown(m)
+func (m *AttributeRequirements) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeRequirements.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerSetDenomMetadata proto.InternalMessageInfo
+var xxx_messageInfo_AttributeRequirements proto.InternalMessageInfo
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataBase() string {
+func (m *AttributeRequirements) GetDenom() string {
 	if m != nil {
-		return m.MetadataBase
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataDescription() string {
+func (m *AttributeRequirements) GetRequirements() []AttributeRequirement {
 	if m != nil {
-		return m.MetadataDescription
+		return m.Requirements
 	}
-	return ""
+	return nil
 }
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataDisplay() string {
+// CapTableEntry is the breakdown of the amount of a marker's coin that belongs to a single holder.
+type CapTableEntry struct {
+	// address is the bech32 address of the holder.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// total is the holder's balance plus the amounts that are quarantined for or escrowed on behalf of the holder.
+	Total cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total,proto3,customtype=cosmossdk.io/math.Int" json:"total"`
+	// balance is the amount in the holder's account.
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+	// spendable is the part of the balance that is not locked by a hold, freeze or vesting schedule.
+	Spendable cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=spendable,proto3,customtype=cosmossdk.io/math.Int" json:"spendable"`
+	// held is the part of the balance that is on hold in the x/hold module, e.g. committed to an exchange market.
+	Held cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=held,proto3,customtype=cosmossdk.io/math.Int" json:"held"`
+	// frozen is the part of the balance that is frozen by the marker.
+	Frozen cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=frozen,proto3,customtype=cosmossdk.io/math.Int" json:"frozen"`
+	// quarantined is the amount sent to the holder that is waiting in quarantine.
+	Quarantined cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=quarantined,proto3,customtype=cosmossdk.io/math.Int" json:"quarantined"`
+	// escrowed is the amount held in the marker module account for the holder's pending redemptions.
+	Escrowed cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=escrowed,proto3,customtype=cosmossdk.io/math.Int" json:"escrowed"`
+}
+
+func (m *CapTableEntry) Reset()         { *m = CapTableEntry{} }
+func (m *CapTableEntry) String() string { return proto.CompactTextString(m) }
+func (*CapTableEntry) ProtoMessage()    {}
+func (*CapTableEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{17}
+}
+func (m *CapTableEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CapTableEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CapTableEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CapTableEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapTableEntry.Merge(m, src)
+}
+func (m *CapTableEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CapTableEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapTableEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapTableEntry proto.InternalMessageInfo
+
+func (m *CapTableEntry) GetAddress() string {
 	if m != nil {
-		return m.MetadataDisplay
+		return m.Address
 	}
 	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataDenomUnits() []*EventDenomUnit {
+// HolderSnapshot is a cap table of a marker that was recorded on chain.
+type HolderSnapshot struct {
+	// id is the unique identifier of this snapshot.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// denom is the marker's denom.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// height is the block height at which the snapshot was taken.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// taken_at is the time of the block in which the snapshot was taken.
+	TakenAt time.Time `protobuf:"bytes,4,opt,name=taken_at,json=takenAt,proto3,stdtime" json:"taken_at"`
+	// taken_by is the bech32 address of the account that took the snapshot.
+	TakenBy string `protobuf:"bytes,5,opt,name=taken_by,json=takenBy,proto3" json:"taken_by,omitempty"`
+	// holder_count is the number of holders recorded in the snapshot so far.
+	HolderCount uint64 `protobuf:"varint,6,opt,name=holder_count,json=holderCount,proto3" json:"holder_count,omitempty"`
+	// supply is the total supply of the marker's coin when the snapshot was taken.
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	// pending is true while the cap table entries are still being recorded.
+	Pending bool `protobuf:"varint,8,opt,name=pending,proto3" json:"pending,omitempty"`
+	// next_key is where the recording of cap table entries will continue from in the next block.
+	NextKey []byte `protobuf:"bytes,9,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+}
+
+func (m *HolderSnapshot) Reset()         { *m = HolderSnapshot{} }
+func (m *HolderSnapshot) String() string { return proto.CompactTextString(m) }
+func (*HolderSnapshot) ProtoMessage()    {}
+func (*HolderSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{18}
+}
+func (m *HolderSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HolderSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HolderSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HolderSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HolderSnapshot.Merge(m, src)
+}
+func (m *HolderSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *HolderSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_HolderSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HolderSnapshot proto.InternalMessageInfo
+
+func (m *HolderSnapshot) GetId() uint64 {
 	if m != nil {
-		return m.MetadataDenomUnits
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-func (m *EventMarkerSetDenomMetadata) GetAdministrator() string {
+func (m *HolderSnapshot) GetDenom() string {
 	if m != nil {
-		return m.Administrator
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataName() string {
+func (m *HolderSnapshot) GetHeight() int64 {
 	if m != nil {
-		return m.MetadataName
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataSymbol() string {
+func (m *HolderSnapshot) GetTakenAt() time.Time {
 	if m != nil {
-		return m.MetadataSymbol
+		return m.TakenAt
+	}
+	return time.Time{}
+}
+
+func (m *HolderSnapshot) GetTakenBy() string {
+	if m != nil {
+		return m.TakenBy
 	}
 	return ""
 }
 
-// EventDenomUnit denom units for set denom metadata event
-type EventDenomUnit struct {
-	Denom    string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Exponent string   `protobuf:"bytes,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
-	Aliases  []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+func (m *HolderSnapshot) GetHolderCount() uint64 {
+	if m != nil {
+		return m.HolderCount
+	}
+	return 0
 }
 
-func (m *EventDenomUnit) Reset()         { *m = EventDenomUnit{} }
-func (m *EventDenomUnit) String() string { return proto.CompactTextString(m) }
-func (*EventDenomUnit) ProtoMessage()    {}
-func (*EventDenomUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{16}
+func (m *HolderSnapshot) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
 }
-func (m *EventDenomUnit) XXX_Unmarshal(b []byte) error {
+
+func (m *HolderSnapshot) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+// ForcedTransferRecord is the audit record of a forced transfer of a marker's coins.
+type ForcedTransferRecord struct {
+	// id is the unique identifier of this record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// denom is the marker's denom.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// from_address is the bech32 address of the account the coins were taken from.
+	FromAddress string `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// to_address is the bech32 address of the account the coins were sent to.
+	ToAddress string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// amount is the amount of the marker's coin that was transferred.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// administrator is the bech32 address of the account that did the transfer.
+	Administrator string `protobuf:"bytes,6,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// reason is the reason given for the transfer.
+	Reason ForcedTransferReason `protobuf:"varint,7,opt,name=reason,proto3,enum=provenance.marker.v1.ForcedTransferReason" json:"reason,omitempty"`
+	// reference identifies the document that justifies the transfer, e.g. a court order or recovery ticket id.
+	Reference string `protobuf:"bytes,8,opt,name=reference,proto3" json:"reference,omitempty"`
+	// height is the block height at which the transfer was done.
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	// block_time is the time of the block in which the transfer was done.
+	BlockTime time.Time `protobuf:"bytes,10,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+}
+
+func (m *ForcedTransferRecord) Reset()         { *m = ForcedTransferRecord{} }
+func (m *ForcedTransferRecord) String() string { return proto.CompactTextString(m) }
+func (*ForcedTransferRecord) ProtoMessage()    {}
+func (*ForcedTransferRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{19}
+}
+func (m *ForcedTransferRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventDenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ForcedTransferRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventDenomUnit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ForcedTransferRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1159,59 +1692,104 @@ func (m *EventDenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventDenomUnit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventDenomUnit.Merge(m, src)
+func (m *ForcedTransferRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForcedTransferRecord.Merge(m, src)
 }
-func (m *EventDenomUnit) XXX_Size() int {
+func (m *ForcedTransferRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventDenomUnit) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventDenomUnit.DiscardUnknown(m)
+func (m *ForcedTransferRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForcedTransferRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventDenomUnit proto.InternalMessageInfo
+var xxx_messageInfo_ForcedTransferRecord proto.InternalMessageInfo
 
-func (m *EventDenomUnit) GetDenom() string {
+func (m *ForcedTransferRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ForcedTransferRecord) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventDenomUnit) GetExponent() string {
+func (m *ForcedTransferRecord) GetFromAddress() string {
 	if m != nil {
-		return m.Exponent
+		return m.FromAddress
 	}
 	return ""
 }
 
-func (m *EventDenomUnit) GetAliases() []string {
+func (m *ForcedTransferRecord) GetToAddress() string {
 	if m != nil {
-		return m.Aliases
+		return m.ToAddress
 	}
-	return nil
+	return ""
 }
 
-// EventSetNetAssetValue event emitted when Net Asset Value for marker is update or added
-type EventSetNetAssetValue struct {
-	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Price  string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
-	Volume string `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume,omitempty"`
-	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+func (m *ForcedTransferRecord) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
 }
 
-func (m *EventSetNetAssetValue) Reset()         { *m = EventSetNetAssetValue{} }
-func (m *EventSetNetAssetValue) String() string { return proto.CompactTextString(m) }
-func (*EventSetNetAssetValue) ProtoMessage()    {}
-func (*EventSetNetAssetValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{17}
+func (m *ForcedTransferRecord) GetReason() ForcedTransferReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ForcedTransferReason_Unspecified
 }
-func (m *EventSetNetAssetValue) XXX_Unmarshal(b []byte) error {
+
+func (m *ForcedTransferRecord) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *ForcedTransferRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ForcedTransferRecord) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+// IssuanceTranche is an amount of a marker's coin that becomes mintable at a given time or height.
+type IssuanceTranche struct {
+	// amount is the amount of the marker's coin that becomes mintable.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// unlock_time is the block time at which the amount becomes mintable.
+	// Exactly one of unlock_time and unlock_height must be set.
+	UnlockTime *time.Time `protobuf:"bytes,2,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time,omitempty"`
+	// unlock_height is the block height at which the amount becomes mintable.
+	UnlockHeight int64 `protobuf:"varint,3,opt,name=unlock_height,json=unlockHeight,proto3" json:"unlock_height,omitempty"`
+}
+
+func (m *IssuanceTranche) Reset()         { *m = IssuanceTranche{} }
+func (m *IssuanceTranche) String() string { return proto.CompactTextString(m) }
+func (*IssuanceTranche) ProtoMessage()    {}
+func (*IssuanceTranche) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{20}
+}
+func (m *IssuanceTranche) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSetNetAssetValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IssuanceTranche) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSetNetAssetValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IssuanceTranche.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1221,65 +1799,113 @@ func (m *EventSetNetAssetValue) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventSetNetAssetValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSetNetAssetValue.Merge(m, src)
+func (m *IssuanceTranche) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssuanceTranche.Merge(m, src)
 }
-func (m *EventSetNetAssetValue) XXX_Size() int {
+func (m *IssuanceTranche) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSetNetAssetValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSetNetAssetValue.DiscardUnknown(m)
+func (m *IssuanceTranche) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssuanceTranche.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSetNetAssetValue proto.InternalMessageInfo
+var xxx_messageInfo_IssuanceTranche proto.InternalMessageInfo
 
-func (m *EventSetNetAssetValue) GetDenom() string {
+func (m *IssuanceTranche) GetUnlockTime() *time.Time {
 	if m != nil {
-		return m.Denom
+		return m.UnlockTime
 	}
-	return ""
+	return nil
 }
 
-func (m *EventSetNetAssetValue) GetPrice() string {
+func (m *IssuanceTranche) GetUnlockHeight() int64 {
 	if m != nil {
-		return m.Price
+		return m.UnlockHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *EventSetNetAssetValue) GetVolume() string {
+// IssuanceSchedule limits when and how much of a marker's coin can be minted.
+type IssuanceSchedule struct {
+	// denom is the marker's denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// hard_cap is the most that the supply of the marker's coin can ever be. Zero means there is no cap.
+	HardCap cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=hard_cap,json=hardCap,proto3,customtype=cosmossdk.io/math.Int" json:"hard_cap"`
+	// tranches are the amounts that become mintable over time. If empty, minting is only limited by the hard cap.
+	Tranches []IssuanceTranche `protobuf:"bytes,3,rep,name=tranches,proto3" json:"tranches"`
+	// issued is the amount that has been minted against the tranches.
+	Issued cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=issued,proto3,customtype=cosmossdk.io/math.Int" json:"issued"`
+}
+
+func (m *IssuanceSchedule) Reset()         { *m = IssuanceSchedule{} }
+func (m *IssuanceSchedule) String() string { return proto.CompactTextString(m) }
+func (*IssuanceSchedule) ProtoMessage()    {}
+func (*IssuanceSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{21}
+}
+func (m *IssuanceSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IssuanceSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssuanceSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IssuanceSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssuanceSchedule.Merge(m, src)
+}
+func (m *IssuanceSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *IssuanceSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssuanceSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssuanceSchedule proto.InternalMessageInfo
+
+func (m *IssuanceSchedule) GetDenom() string {
 	if m != nil {
-		return m.Volume
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventSetNetAssetValue) GetSource() string {
+func (m *IssuanceSchedule) GetTranches() []IssuanceTranche {
 	if m != nil {
-		return m.Source
+		return m.Tranches
 	}
-	return ""
+	return nil
 }
 
-// EventMarkerParamsUpdated event emitted when marker params are updated.
-type EventMarkerParamsUpdated struct {
-	EnableGovernance       string `protobuf:"bytes,1,opt,name=enable_governance,json=enableGovernance,proto3" json:"enable_governance,omitempty"`
-	UnrestrictedDenomRegex string `protobuf:"bytes,2,opt,name=unrestricted_denom_regex,json=unrestrictedDenomRegex,proto3" json:"unrestricted_denom_regex,omitempty"`
-	MaxSupply              string `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+// EventMarkerAdd event emitted when marker is added
+type EventMarkerAdd struct {
+	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount     string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Manager    string `protobuf:"bytes,4,opt,name=manager,proto3" json:"manager,omitempty"`
+	MarkerType string `protobuf:"bytes,5,opt,name=marker_type,json=markerType,proto3" json:"marker_type,omitempty"`
+	Address    string `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *EventMarkerParamsUpdated) Reset()         { *m = EventMarkerParamsUpdated{} }
-func (m *EventMarkerParamsUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerParamsUpdated) ProtoMessage()    {}
-func (*EventMarkerParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{18}
+func (m *EventMarkerAdd) Reset()         { *m = EventMarkerAdd{} }
+func (m *EventMarkerAdd) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAdd) ProtoMessage()    {}
+func (*EventMarkerAdd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{22}
 }
-func (m *EventMarkerParamsUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventMarkerAdd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarkerAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerParamsUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarkerAdd.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1289,1663 +1915,16405 @@ func (m *EventMarkerParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerParamsUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerParamsUpdated.Merge(m, src)
+func (m *EventMarkerAdd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAdd.Merge(m, src)
 }
-func (m *EventMarkerParamsUpdated) XXX_Size() int {
+func (m *EventMarkerAdd) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerParamsUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerParamsUpdated.DiscardUnknown(m)
+func (m *EventMarkerAdd) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAdd.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerParamsUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventMarkerAdd proto.InternalMessageInfo
 
-func (m *EventMarkerParamsUpdated) GetEnableGovernance() string {
+func (m *EventMarkerAdd) GetDenom() string {
 	if m != nil {
-		return m.EnableGovernance
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerParamsUpdated) GetUnrestrictedDenomRegex() string {
+func (m *EventMarkerAdd) GetAmount() string {
 	if m != nil {
-		return m.UnrestrictedDenomRegex
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *EventMarkerParamsUpdated) GetMaxSupply() string {
+func (m *EventMarkerAdd) GetStatus() string {
 	if m != nil {
-		return m.MaxSupply
+		return m.Status
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("provenance.marker.v1.MarkerType", MarkerType_name, MarkerType_value)
-	proto.RegisterEnum("provenance.marker.v1.MarkerStatus", MarkerStatus_name, MarkerStatus_value)
-	proto.RegisterType((*Params)(nil), "provenance.marker.v1.Params")
-	proto.RegisterType((*MarkerAccount)(nil), "provenance.marker.v1.MarkerAccount")
-	proto.RegisterType((*NetAssetValue)(nil), "provenance.marker.v1.NetAssetValue")
-	proto.RegisterType((*EventMarkerAdd)(nil), "provenance.marker.v1.EventMarkerAdd")
-	proto.RegisterType((*EventMarkerAddAccess)(nil), "provenance.marker.v1.EventMarkerAddAccess")
-	proto.RegisterType((*EventMarkerAccess)(nil), "provenance.marker.v1.EventMarkerAccess")
-	proto.RegisterType((*EventMarkerDeleteAccess)(nil), "provenance.marker.v1.EventMarkerDeleteAccess")
-	proto.RegisterType((*EventMarkerFinalize)(nil), "provenance.marker.v1.EventMarkerFinalize")
-	proto.RegisterType((*EventMarkerActivate)(nil), "provenance.marker.v1.EventMarkerActivate")
-	proto.RegisterType((*EventMarkerCancel)(nil), "provenance.marker.v1.EventMarkerCancel")
-	proto.RegisterType((*EventMarkerDelete)(nil), "provenance.marker.v1.EventMarkerDelete")
-	proto.RegisterType((*EventMarkerMint)(nil), "provenance.marker.v1.EventMarkerMint")
-	proto.RegisterType((*EventMarkerBurn)(nil), "provenance.marker.v1.EventMarkerBurn")
-	proto.RegisterType((*EventMarkerWithdraw)(nil), "provenance.marker.v1.EventMarkerWithdraw")
-	proto.RegisterType((*EventMarkerTransfer)(nil), "provenance.marker.v1.EventMarkerTransfer")
-	proto.RegisterType((*EventMarkerSetDenomMetadata)(nil), "provenance.marker.v1.EventMarkerSetDenomMetadata")
-	proto.RegisterType((*EventDenomUnit)(nil), "provenance.marker.v1.EventDenomUnit")
-	proto.RegisterType((*EventSetNetAssetValue)(nil), "provenance.marker.v1.EventSetNetAssetValue")
-	proto.RegisterType((*EventMarkerParamsUpdated)(nil), "provenance.marker.v1.EventMarkerParamsUpdated")
+func (m *EventMarkerAdd) GetManager() string {
+	if m != nil {
+		return m.Manager
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("provenance/marker/v1/marker.proto", fileDescriptor_f7e2c25c71db7f99) }
-
-var fileDescriptor_f7e2c25c71db7f99 = []byte{
-	// 1558 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xbd, 0x6f, 0x1b, 0x47,
-	0x16, 0xe7, 0x52, 0x14, 0x25, 0x0d, 0x25, 0x9a, 0x1e, 0xd1, 0xf2, 0x9a, 0x07, 0x53, 0x34, 0xcf,
-	0x77, 0xd6, 0xf9, 0xce, 0xa4, 0xa5, 0x3b, 0x03, 0x07, 0x23, 0x0d, 0xbf, 0xe4, 0x10, 0xb1, 0x3e,
-	0xb2, 0xa4, 0x1c, 0xd8, 0x08, 0xb0, 0x18, 0xee, 0x8e, 0xa8, 0x85, 0x76, 0x77, 0x98, 0x9d, 0x21,
-	0x2d, 0x05, 0xa9, 0x0d, 0x43, 0x95, 0xcb, 0xa4, 0x10, 0x20, 0x20, 0x2e, 0x02, 0xa4, 0x4d, 0x9d,
-	0xda, 0x48, 0xe5, 0x32, 0x48, 0x61, 0x24, 0x76, 0x93, 0x22, 0x7f, 0x44, 0x30, 0x1f, 0x5c, 0x2e,
-	0x2d, 0xda, 0x4e, 0xa0, 0xb8, 0xdb, 0xf7, 0x39, 0xef, 0xbd, 0xf9, 0xbd, 0x79, 0x6f, 0xc1, 0x95,
-	0x5e, 0x40, 0x06, 0xd8, 0x47, 0xbe, 0x85, 0xcb, 0x1e, 0x0a, 0xf6, 0x71, 0x50, 0x1e, 0xac, 0xaa,
-	0xaf, 0x52, 0x2f, 0x20, 0x8c, 0xc0, 0xec, 0x48, 0xa5, 0xa4, 0x04, 0x83, 0xd5, 0x5c, 0xb6, 0x4b,
-	0xba, 0x44, 0x28, 0x94, 0xf9, 0x97, 0xd4, 0xcd, 0xe5, 0x2d, 0x42, 0x3d, 0x42, 0xcb, 0xa8, 0xcf,
-	0xf6, 0xca, 0x83, 0xd5, 0x0e, 0x66, 0x68, 0x55, 0x10, 0x4a, 0x7e, 0x49, 0xca, 0x4d, 0x69, 0x28,
-	0x89, 0xd7, 0x4c, 0x3b, 0x88, 0xe2, 0xd0, 0xd4, 0x22, 0x8e, 0xaf, 0xe4, 0xff, 0x9c, 0x18, 0x29,
-	0xb2, 0x2c, 0x4c, 0x69, 0x37, 0x40, 0x3e, 0x93, 0x7a, 0xc5, 0x5f, 0x34, 0x90, 0xdc, 0x46, 0x01,
-	0xf2, 0x28, 0xfc, 0x0f, 0xc8, 0x78, 0xe8, 0xc0, 0x64, 0x84, 0x21, 0xd7, 0xa4, 0xfd, 0x5e, 0xcf,
-	0x3d, 0xd4, 0xb5, 0x82, 0xb6, 0x92, 0xa8, 0xc6, 0x75, 0xcd, 0x48, 0x7b, 0xe8, 0xa0, 0xcd, 0x45,
-	0x2d, 0x21, 0x81, 0xff, 0x06, 0xe7, 0xb1, 0x8f, 0x3a, 0x2e, 0x36, 0xbb, 0x64, 0x80, 0x03, 0x71,
-	0x92, 0x1e, 0x2f, 0x68, 0x2b, 0xb3, 0x46, 0x46, 0x0a, 0xee, 0x84, 0x7c, 0xf8, 0x7f, 0xa0, 0xf7,
-	0xfd, 0x00, 0x53, 0x16, 0x38, 0x16, 0xc3, 0xb6, 0x69, 0x63, 0x9f, 0x78, 0x66, 0x80, 0xbb, 0xf8,
-	0x40, 0x9f, 0x2a, 0x68, 0x2b, 0x73, 0xc6, 0x52, 0x54, 0x5e, 0xe7, 0x62, 0x83, 0x4b, 0xe1, 0x07,
-	0x00, 0xf0, 0xa0, 0x54, 0x38, 0x09, 0xae, 0x5b, 0xbd, 0xfc, 0xec, 0xc5, 0x72, 0xec, 0xa7, 0x17,
-	0xcb, 0x17, 0x64, 0x0d, 0xa8, 0xbd, 0x5f, 0x72, 0x48, 0xd9, 0x43, 0x6c, 0xaf, 0xd4, 0xf4, 0x99,
-	0x31, 0xe7, 0xa1, 0x03, 0x19, 0xe4, 0xed, 0xc4, 0xaf, 0x27, 0xcb, 0x5a, 0xf1, 0xe9, 0x34, 0x58,
-	0xd8, 0x10, 0x35, 0xa8, 0x58, 0x16, 0xe9, 0xfb, 0x0c, 0x36, 0xc1, 0x3c, 0x2f, 0x9c, 0x89, 0x24,
-	0x2d, 0xd2, 0x4c, 0xad, 0x15, 0x4a, 0xaa, 0xc4, 0xe2, 0x0a, 0x54, 0x51, 0x4b, 0x55, 0x44, 0xb1,
-	0xb2, 0xab, 0x26, 0x9e, 0xbf, 0x58, 0xd6, 0x8c, 0x54, 0x67, 0xc4, 0x82, 0x3a, 0x98, 0xf1, 0x90,
-	0x8f, 0xba, 0x38, 0x10, 0xd9, 0xcf, 0x19, 0x43, 0x12, 0x6e, 0x82, 0xb4, 0xac, 0xb7, 0x69, 0x11,
-	0x9f, 0x05, 0xc4, 0xd5, 0xa7, 0x0a, 0x53, 0x2b, 0xa9, 0xb5, 0x2b, 0xa5, 0x49, 0x10, 0x29, 0x55,
-	0x84, 0xee, 0x1d, 0x7e, 0x37, 0xd5, 0x04, 0xcf, 0xd0, 0x58, 0x90, 0xe6, 0x35, 0x69, 0x0d, 0x6f,
-	0x83, 0x24, 0x65, 0x88, 0xf5, 0xa9, 0x28, 0x43, 0x7a, 0xad, 0x38, 0xd9, 0x8f, 0xcc, 0xb4, 0x25,
-	0x34, 0x0d, 0x65, 0x01, 0xb3, 0x60, 0x5a, 0xd4, 0x5c, 0x9f, 0x16, 0x31, 0x4a, 0x02, 0xde, 0x02,
-	0x49, 0x55, 0xd8, 0xe4, 0x1f, 0x29, 0xac, 0x52, 0x86, 0x15, 0x90, 0x92, 0xc7, 0x99, 0xec, 0xb0,
-	0x87, 0xf5, 0x19, 0x11, 0x4d, 0xe1, 0x6d, 0xd1, 0xb4, 0x0f, 0x7b, 0xd8, 0x00, 0x5e, 0xf8, 0x0d,
-	0xaf, 0x80, 0x79, 0xe9, 0xcc, 0xdc, 0x75, 0x0e, 0xb0, 0xad, 0xcf, 0x0a, 0xe0, 0xa4, 0x24, 0x6f,
-	0x9d, 0xb3, 0x38, 0x66, 0x90, 0xeb, 0x92, 0x87, 0x11, 0x7c, 0x85, 0x85, 0x9c, 0x13, 0xea, 0x4b,
-	0x42, 0x3e, 0x82, 0xd9, 0xb0, 0x50, 0x6b, 0xe0, 0x82, 0xb4, 0xdc, 0x25, 0x81, 0x85, 0x6d, 0x93,
-	0x05, 0xc8, 0xa7, 0xbb, 0x38, 0xd0, 0x81, 0x30, 0x5b, 0x14, 0xc2, 0x75, 0x21, 0x6b, 0x2b, 0x11,
-	0x2c, 0x83, 0xc5, 0x00, 0x7f, 0xd6, 0x77, 0x02, 0x6c, 0x9b, 0x88, 0xb1, 0xc0, 0xe9, 0xf4, 0x19,
-	0xa6, 0x7a, 0xaa, 0x30, 0xb5, 0x32, 0x67, 0xc0, 0xa1, 0xa8, 0x12, 0x4a, 0xe0, 0xff, 0xc0, 0x92,
-	0xe2, 0x9a, 0x36, 0xee, 0x11, 0xea, 0x30, 0x53, 0x5e, 0x97, 0x3e, 0x2f, 0x4e, 0xc9, 0x2a, 0x69,
-	0x5d, 0x0a, 0xe5, 0xed, 0xde, 0xce, 0x3d, 0x3e, 0x59, 0x8e, 0x7d, 0x79, 0xb2, 0x1c, 0xfb, 0xe1,
-	0xbb, 0x1b, 0xe9, 0x31, 0x4c, 0x36, 0x8b, 0x4f, 0x34, 0xb0, 0xb0, 0x89, 0x59, 0x85, 0x52, 0xcc,
-	0xee, 0x21, 0xb7, 0x8f, 0xe1, 0x2d, 0x30, 0xdd, 0x0b, 0x1c, 0x0b, 0x2b, 0x7c, 0x5e, 0x1a, 0xe2,
-	0x93, 0xe3, 0x2f, 0xc4, 0x67, 0x8d, 0x38, 0xbe, 0x02, 0x8c, 0xd4, 0x86, 0x4b, 0x20, 0x39, 0x20,
-	0x6e, 0xdf, 0x93, 0xfd, 0x98, 0x30, 0x14, 0x05, 0x6f, 0x82, 0x6c, 0xbf, 0x67, 0x23, 0xde, 0x80,
-	0x1d, 0x97, 0x58, 0xfb, 0xe6, 0x1e, 0x76, 0xba, 0x7b, 0x4c, 0x74, 0x60, 0xc2, 0x80, 0x4a, 0x56,
-	0xe5, 0xa2, 0x0f, 0x85, 0xa4, 0xf8, 0xad, 0x06, 0xd2, 0x8d, 0x01, 0xf6, 0x99, 0x0a, 0xd5, 0xb6,
-	0x47, 0x48, 0xd2, 0xa2, 0x48, 0x5a, 0x02, 0x49, 0xe4, 0x89, 0x56, 0x92, 0x4d, 0xa0, 0x28, 0xce,
-	0x57, 0x98, 0x95, 0x6d, 0x3e, 0xc4, 0x63, 0xa4, 0x6b, 0x12, 0xe3, 0x5d, 0xb3, 0x3c, 0x0e, 0x2e,
-	0x89, 0xd7, 0x28, 0x74, 0x74, 0x30, 0x83, 0x6c, 0x3b, 0xe0, 0x95, 0x4e, 0x4a, 0x53, 0x45, 0x16,
-	0xbf, 0xd2, 0x40, 0x76, 0x3c, 0x5a, 0x59, 0x75, 0xd8, 0x00, 0x49, 0x75, 0x37, 0xb2, 0x90, 0xd7,
-	0x26, 0x63, 0x35, 0x6a, 0x2b, 0xd4, 0x55, 0x59, 0x95, 0xf1, 0x28, 0xf5, 0x78, 0x34, 0xf5, 0xab,
-	0x60, 0x01, 0xd9, 0x9e, 0xe3, 0x3b, 0x94, 0x05, 0x88, 0x91, 0x40, 0x65, 0x3a, 0xce, 0x2c, 0x6e,
-	0x81, 0xf3, 0xa7, 0xdc, 0x47, 0x53, 0xd1, 0xc6, 0x52, 0x81, 0x05, 0x90, 0xea, 0xe1, 0xc0, 0x73,
-	0x28, 0x75, 0x88, 0x4f, 0xf5, 0xb8, 0x80, 0x61, 0x94, 0x55, 0xfc, 0x02, 0x5c, 0x8c, 0x38, 0xac,
-	0x63, 0x17, 0x33, 0xac, 0xdc, 0xfe, 0x03, 0xa4, 0x03, 0xec, 0x91, 0x01, 0x36, 0xc7, 0xbd, 0x2f,
-	0x48, 0x6e, 0x45, 0x9d, 0x71, 0x96, 0x74, 0x3e, 0x06, 0x8b, 0x91, 0xd3, 0xd7, 0x1d, 0x1f, 0xb9,
-	0xce, 0xe7, 0xf8, 0x0d, 0xe0, 0x38, 0xe5, 0x32, 0xfe, 0x6e, 0x97, 0x15, 0x8b, 0x39, 0x03, 0xc4,
-	0xce, 0xe6, 0x72, 0xbc, 0xe8, 0x35, 0x7e, 0xdd, 0xee, 0x5f, 0xe8, 0x50, 0x16, 0xfd, 0x4c, 0x0e,
-	0x31, 0x38, 0x17, 0x71, 0xb8, 0xe1, 0xc8, 0x96, 0x51, 0xad, 0xa4, 0x8d, 0xb5, 0xd2, 0x59, 0xae,
-	0x6b, 0xfc, 0x98, 0x6a, 0x3f, 0xf0, 0xdf, 0xcb, 0x31, 0x8f, 0xb4, 0xb1, 0x3b, 0xfc, 0xc4, 0x61,
-	0x7b, 0x76, 0x80, 0x1e, 0x72, 0x9f, 0x7c, 0x35, 0x19, 0xe2, 0x50, 0x12, 0x67, 0x39, 0x09, 0x5e,
-	0x06, 0x80, 0x91, 0x10, 0xde, 0xf2, 0x09, 0x99, 0x63, 0x44, 0x41, 0x9b, 0xbf, 0x5b, 0xd1, 0x40,
-	0xc2, 0x57, 0xfe, 0x3d, 0x24, 0xfd, 0x8e, 0x50, 0xf8, 0xa4, 0xdb, 0x0d, 0x88, 0x17, 0x2a, 0xc8,
-	0x07, 0x2d, 0xc5, 0x79, 0xc3, 0x68, 0x7f, 0x8b, 0x83, 0xbf, 0x45, 0xa2, 0x6d, 0x61, 0x26, 0x16,
-	0xa0, 0x0d, 0xcc, 0x90, 0x8d, 0x18, 0x82, 0x7f, 0x07, 0x0b, 0x9e, 0xfa, 0x36, 0xf9, 0xd3, 0xaf,
-	0x82, 0x9f, 0x1f, 0x32, 0xf9, 0x86, 0x02, 0x57, 0x41, 0x36, 0x54, 0xb2, 0x31, 0xb5, 0x02, 0xa7,
-	0xc7, 0x1c, 0xe2, 0xab, 0x8c, 0x16, 0x87, 0xb2, 0xfa, 0x48, 0x04, 0xff, 0x05, 0x32, 0x23, 0x13,
-	0x87, 0xf6, 0x5c, 0x74, 0xa8, 0x52, 0x3c, 0x17, 0xaa, 0x4b, 0x36, 0xbc, 0x37, 0xe6, 0x9d, 0x2f,
-	0x6f, 0x7d, 0xdf, 0x61, 0x3c, 0x5d, 0xbe, 0xd1, 0x5c, 0x7d, 0xcb, 0x7b, 0x2a, 0x52, 0xd9, 0xf1,
-	0x1d, 0x66, 0xc0, 0x51, 0x0c, 0x8a, 0x45, 0x4f, 0x97, 0x78, 0x7a, 0x52, 0x89, 0xa3, 0x05, 0xf0,
-	0x91, 0x87, 0xd5, 0xc3, 0x1f, 0x16, 0x60, 0x13, 0x79, 0x18, 0x5e, 0x03, 0x61, 0xd4, 0x26, 0x3d,
-	0xf4, 0x3a, 0xc4, 0x15, 0x9b, 0xc9, 0x9c, 0x91, 0x1e, 0xb2, 0x5b, 0x82, 0x5b, 0xfc, 0x54, 0xcd,
-	0xb4, 0x30, 0x8c, 0x37, 0x74, 0x70, 0x0e, 0xcc, 0xe2, 0x83, 0x1e, 0xf1, 0x71, 0x38, 0xd5, 0x42,
-	0x5a, 0xbc, 0xdc, 0xae, 0x83, 0x28, 0xa6, 0x62, 0xa9, 0xe3, 0x2f, 0xb7, 0x24, 0x8b, 0x14, 0x5c,
-	0x10, 0xde, 0x5b, 0x98, 0x8d, 0x0f, 0xf3, 0xc9, 0x87, 0x64, 0x87, 0x23, 0x5e, 0x21, 0xef, 0xf5,
-	0x09, 0xae, 0xc6, 0xa6, 0x9a, 0xe0, 0x7c, 0x9c, 0x92, 0x7e, 0x60, 0x61, 0x85, 0x33, 0x45, 0x15,
-	0x4f, 0x34, 0xa0, 0x47, 0x10, 0x24, 0x17, 0xfa, 0x1d, 0x39, 0xcf, 0x27, 0x6f, 0xea, 0x32, 0x88,
-	0x3f, 0xb7, 0xa9, 0xc7, 0xdf, 0xba, 0xa9, 0x5f, 0x1e, 0xdb, 0xd4, 0x65, 0xdc, 0xa3, 0x55, 0xfc,
-	0xfa, 0x23, 0x0d, 0x80, 0xd1, 0x32, 0x08, 0x57, 0xc0, 0xc5, 0x8d, 0x8a, 0xf1, 0x51, 0xc3, 0x30,
-	0xdb, 0xf7, 0xb7, 0x1b, 0xe6, 0xce, 0x66, 0x6b, 0xbb, 0x51, 0x6b, 0xae, 0x37, 0x1b, 0xf5, 0x4c,
-	0x2c, 0x97, 0x3a, 0x3a, 0x2e, 0xcc, 0xec, 0xf8, 0xfb, 0x3e, 0x79, 0xe8, 0xc3, 0x3c, 0xc8, 0x44,
-	0x35, 0x6b, 0x5b, 0xcd, 0xcd, 0x8c, 0x96, 0x9b, 0x3d, 0x3a, 0x2e, 0x24, 0xf8, 0xea, 0x03, 0x4b,
-	0x60, 0x29, 0x2a, 0x37, 0x1a, 0xad, 0xb6, 0xd1, 0xac, 0xb5, 0x1b, 0xf5, 0x4c, 0x3c, 0x07, 0x8f,
-	0x8e, 0x0b, 0x69, 0x23, 0x8c, 0x96, 0xeb, 0x5f, 0xff, 0x3e, 0x0e, 0xe6, 0xa3, 0x3b, 0x32, 0x5c,
-	0x03, 0x97, 0x94, 0x83, 0x56, 0xbb, 0xd2, 0xde, 0x69, 0xbd, 0x16, 0xcc, 0xe2, 0xd1, 0x71, 0xe1,
-	0x9c, 0x54, 0xdd, 0xf1, 0x6d, 0xbc, 0xeb, 0xf8, 0xd8, 0x8e, 0x1c, 0xaa, 0x6c, 0xb6, 0x8d, 0xad,
-	0xed, 0xad, 0x56, 0xa3, 0x9e, 0xd1, 0xe4, 0xa1, 0xd2, 0x60, 0x3b, 0x20, 0x3d, 0x42, 0xb1, 0x0d,
-	0x6f, 0x86, 0xe9, 0x2a, 0xfd, 0xf5, 0xe6, 0x66, 0xe5, 0x6e, 0xf3, 0x81, 0x88, 0x32, 0x72, 0xc2,
-	0x70, 0x92, 0xda, 0xf0, 0x3a, 0xc8, 0x8e, 0x5b, 0x54, 0x6a, 0xed, 0xe6, 0xbd, 0x46, 0x66, 0x2a,
-	0x97, 0x39, 0x3a, 0x2e, 0xcc, 0x4b, 0x75, 0x31, 0x25, 0xf1, 0x69, 0xef, 0xb5, 0xca, 0x66, 0xad,
-	0x71, 0xf7, 0x6e, 0xa3, 0x9e, 0x49, 0x44, 0xbd, 0xcb, 0x09, 0xe8, 0x4e, 0x8a, 0xa7, 0xce, 0xcb,
-	0xb6, 0x75, 0xbf, 0x51, 0xcf, 0x4c, 0x47, 0x2d, 0xea, 0xbc, 0x76, 0xe4, 0x10, 0xdb, 0xb9, 0xd9,
-	0xc7, 0x5f, 0xe7, 0x63, 0xdf, 0x3c, 0xcd, 0xc7, 0xaa, 0xdd, 0x67, 0x2f, 0xf3, 0xda, 0xf3, 0x97,
-	0x79, 0xed, 0xe7, 0x97, 0x79, 0xed, 0xc9, 0xab, 0x7c, 0xec, 0xf9, 0xab, 0x7c, 0xec, 0xc7, 0x57,
-	0xf9, 0x18, 0xb8, 0xe8, 0x90, 0x89, 0x2f, 0xc1, 0xb6, 0xf6, 0x60, 0xad, 0xeb, 0xb0, 0xbd, 0x7e,
-	0xa7, 0x64, 0x11, 0xaf, 0x3c, 0x52, 0xb9, 0xe1, 0x90, 0x08, 0x55, 0x3e, 0x18, 0xfe, 0xaa, 0xf2,
-	0xd5, 0x8f, 0x76, 0x92, 0xe2, 0x17, 0xf5, 0xbf, 0xbf, 0x07, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xf9,
-	0xc8, 0x60, 0x76, 0x0f, 0x00, 0x00,
+func (m *EventMarkerAdd) GetMarkerType() string {
+	if m != nil {
+		return m.MarkerType
+	}
+	return ""
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *EventMarkerAdd) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
+	return ""
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// EventMarkerAddAccess event emitted when marker access is added
+type EventMarkerAddAccess struct {
+	Access        EventMarkerAccess `protobuf:"bytes,1,opt,name=access,proto3" json:"access"`
+	Denom         string            `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string            `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerAddAccess) Reset()         { *m = EventMarkerAddAccess{} }
+func (m *EventMarkerAddAccess) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAddAccess) ProtoMessage()    {}
+func (*EventMarkerAddAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{23}
+}
+func (m *EventMarkerAddAccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerAddAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerAddAccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.MaxTotalSupply != that1.MaxTotalSupply {
-		return false
-	}
-	if this.EnableGovernance != that1.EnableGovernance {
-		return false
-	}
-	if this.UnrestrictedDenomRegex != that1.UnrestrictedDenomRegex {
-		return false
+}
+func (m *EventMarkerAddAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAddAccess.Merge(m, src)
+}
+func (m *EventMarkerAddAccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerAddAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAddAccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerAddAccess proto.InternalMessageInfo
+
+func (m *EventMarkerAddAccess) GetAccess() EventMarkerAccess {
+	if m != nil {
+		return m.Access
 	}
-	if !this.MaxSupply.Equal(that1.MaxSupply) {
-		return false
+	return EventMarkerAccess{}
+}
+
+func (m *EventMarkerAddAccess) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return true
+	return ""
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *EventMarkerAddAccess) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerAccess event access permissions for address
+type EventMarkerAccess struct {
+	Address     string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MaxSupply.Size()
-		i -= size
-		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMarker(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.UnrestrictedDenomRegex) > 0 {
-		i -= len(m.UnrestrictedDenomRegex)
-		copy(dAtA[i:], m.UnrestrictedDenomRegex)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.UnrestrictedDenomRegex)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.EnableGovernance {
-		i--
-		if m.EnableGovernance {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventMarkerAccess) Reset()         { *m = EventMarkerAccess{} }
+func (m *EventMarkerAccess) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAccess) ProtoMessage()    {}
+func (*EventMarkerAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{24}
+}
+func (m *EventMarkerAccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerAccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.MaxTotalSupply != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.MaxTotalSupply))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventMarkerAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAccess.Merge(m, src)
+}
+func (m *EventMarkerAccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerAccess proto.InternalMessageInfo
+
+func (m *EventMarkerAccess) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MarkerAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerAccess) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MarkerAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerDeleteAccess event emitted when marker access is revoked
+type EventMarkerDeleteAccess struct {
+	RemoveAddress string `protobuf:"bytes,1,opt,name=remove_address,json=removeAddress,proto3" json:"remove_address,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MarkerAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequireDepositAccess {
-		i--
-		if m.RequireDepositAccess {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.RequiredAttributes) > 0 {
-		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
-			i = encodeVarintMarker(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x5a
+func (m *EventMarkerDeleteAccess) Reset()         { *m = EventMarkerDeleteAccess{} }
+func (m *EventMarkerDeleteAccess) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDeleteAccess) ProtoMessage()    {}
+func (*EventMarkerDeleteAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{25}
+}
+func (m *EventMarkerDeleteAccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerDeleteAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerDeleteAccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.AllowForcedTransfer {
-		i--
-		if m.AllowForcedTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.AllowGovernanceControl {
-		i--
-		if m.AllowGovernanceControl {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.SupplyFixed {
-		i--
-		if m.SupplyFixed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.MarkerType != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.MarkerType))
-		i--
-		dAtA[i] = 0x38
-	}
-	{
-		size := m.Supply.Size()
-		i -= size
-		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMarker(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Status != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.AccessControl) > 0 {
-		for iNdEx := len(m.AccessControl) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccessControl[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMarker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventMarkerDeleteAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDeleteAccess.Merge(m, src)
+}
+func (m *EventMarkerDeleteAccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerDeleteAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDeleteAccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerDeleteAccess proto.InternalMessageInfo
+
+func (m *EventMarkerDeleteAccess) GetRemoveAddress() string {
+	if m != nil {
+		return m.RemoveAddress
 	}
-	if m.BaseAccount != nil {
-		{
-			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMarker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerDeleteAccess) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *NetAssetValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerDeleteAccess) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *NetAssetValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerFinalize event emitted when marker is finalized
+type EventMarkerFinalize struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *NetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdatedBlockHeight != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.UpdatedBlockHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Volume != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.Volume))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventMarkerFinalize) Reset()         { *m = EventMarkerFinalize{} }
+func (m *EventMarkerFinalize) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerFinalize) ProtoMessage()    {}
+func (*EventMarkerFinalize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{26}
+}
+func (m *EventMarkerFinalize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerFinalize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerFinalize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMarker(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *EventMarkerAdd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventMarkerFinalize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerFinalize.Merge(m, src)
 }
-
-func (m *EventMarkerAdd) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerFinalize) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerFinalize) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerFinalize.DiscardUnknown(m)
 }
 
-func (m *EventMarkerAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.MarkerType) > 0 {
-		i -= len(m.MarkerType)
-		copy(dAtA[i:], m.MarkerType)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MarkerType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventMarkerFinalize proto.InternalMessageInfo
+
+func (m *EventMarkerFinalize) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerAddAccess) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerFinalize) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerAddAccess) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerActivate event emitted when marker is activated
+type EventMarkerActivate struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerAddAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Access.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventMarkerActivate) Reset()         { *m = EventMarkerActivate{} }
+func (m *EventMarkerActivate) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerActivate) ProtoMessage()    {}
+func (*EventMarkerActivate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{27}
+}
+func (m *EventMarkerActivate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerActivate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerActivate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMarker(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *EventMarkerAccess) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventMarkerActivate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerActivate.Merge(m, src)
 }
-
-func (m *EventMarkerAccess) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerActivate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerActivate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerActivate.DiscardUnknown(m)
 }
 
-func (m *EventMarkerAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Permissions[iNdEx])
-			copy(dAtA[i:], m.Permissions[iNdEx])
-			i = encodeVarintMarker(dAtA, i, uint64(len(m.Permissions[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventMarkerActivate proto.InternalMessageInfo
+
+func (m *EventMarkerActivate) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerDeleteAccess) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerActivate) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerDeleteAccess) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerCancel event emitted when marker is cancelled
+type EventMarkerCancel struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerDeleteAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarkerCancel) Reset()         { *m = EventMarkerCancel{} }
+func (m *EventMarkerCancel) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerCancel) ProtoMessage()    {}
+func (*EventMarkerCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{28}
+}
+func (m *EventMarkerCancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerCancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.RemoveAddress) > 0 {
-		i -= len(m.RemoveAddress)
-		copy(dAtA[i:], m.RemoveAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.RemoveAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarkerCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerCancel.Merge(m, src)
+}
+func (m *EventMarkerCancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerCancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerCancel proto.InternalMessageInfo
+
+func (m *EventMarkerCancel) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerFinalize) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerCancel) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerFinalize) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerDelete event emitted when marker is deleted
+type EventMarkerDelete struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerFinalize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventMarkerDelete) Reset()         { *m = EventMarkerDelete{} }
+func (m *EventMarkerDelete) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDelete) ProtoMessage()    {}
+func (*EventMarkerDelete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{29}
+}
+func (m *EventMarkerDelete) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerDelete.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerDelete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDelete.Merge(m, src)
+}
+func (m *EventMarkerDelete) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerDelete) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDelete.DiscardUnknown(m)
 }
 
-func (m *EventMarkerActivate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerDelete proto.InternalMessageInfo
+
+func (m *EventMarkerDelete) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerActivate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerDelete) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
 }
 
-func (m *EventMarkerActivate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// EventMarkerMint event emitted when additional marker supply is minted
+type EventMarkerMint struct {
+	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerCancel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventMarkerMint) Reset()         { *m = EventMarkerMint{} }
+func (m *EventMarkerMint) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerMint) ProtoMessage()    {}
+func (*EventMarkerMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{30}
 }
-
-func (m *EventMarkerCancel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventMarkerCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventMarkerMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *EventMarkerDelete) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventMarkerMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerMint.Merge(m, src)
 }
-
-func (m *EventMarkerDelete) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerMint.DiscardUnknown(m)
 }
 
-func (m *EventMarkerDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventMarkerMint proto.InternalMessageInfo
+
+func (m *EventMarkerMint) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerMint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerMint) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerMint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerMint) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
 }
 
-func (m *EventMarkerMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// EventMarkerBurn event emitted when coin is burned from marker
+type EventMarkerBurn struct {
+	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerBurn) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerBurn) Reset()         { *m = EventMarkerBurn{} }
+func (m *EventMarkerBurn) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerBurn) ProtoMessage()    {}
+func (*EventMarkerBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{31}
+}
+func (m *EventMarkerBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventMarkerBurn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerBurn.Merge(m, src)
+}
+func (m *EventMarkerBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerBurn.DiscardUnknown(m)
 }
 
-func (m *EventMarkerBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventMarkerBurn proto.InternalMessageInfo
+
+func (m *EventMarkerBurn) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerBurn) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerBurn) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
 }
 
-func (m *EventMarkerWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Coins) > 0 {
-		i -= len(m.Coins)
-		copy(dAtA[i:], m.Coins)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Coins)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// EventMarkerWithdraw event emitted when coins are withdrew from marker
+type EventMarkerWithdraw struct {
+	Coins         string `protobuf:"bytes,1,opt,name=coins,proto3" json:"coins,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	ToAddress     string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
 }
 
-func (m *EventMarkerTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerWithdraw) Reset()         { *m = EventMarkerWithdraw{} }
+func (m *EventMarkerWithdraw) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerWithdraw) ProtoMessage()    {}
+func (*EventMarkerWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{32}
+}
+func (m *EventMarkerWithdraw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerWithdraw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventMarkerTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerWithdraw.Merge(m, src)
+}
+func (m *EventMarkerWithdraw) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerWithdraw.DiscardUnknown(m)
 }
 
-func (m *EventMarkerTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_EventMarkerWithdraw proto.InternalMessageInfo
+
+func (m *EventMarkerWithdraw) GetCoins() string {
+	if m != nil {
+		return m.Coins
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventMarkerWithdraw) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerWithdraw) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerWithdraw) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerTransfer event emitted when coins are transfered to from account to another
+type EventMarkerTransfer struct {
+	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	ToAddress     string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	FromAddress   string `protobuf:"bytes,5,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 }
 
-func (m *EventMarkerSetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MetadataSymbol) > 0 {
-		i -= len(m.MetadataSymbol)
-		copy(dAtA[i:], m.MetadataSymbol)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataSymbol)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.MetadataName) > 0 {
-		i -= len(m.MetadataName)
-		copy(dAtA[i:], m.MetadataName)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataName)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.MetadataDenomUnits) > 0 {
-		for iNdEx := len(m.MetadataDenomUnits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MetadataDenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMarker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *EventMarkerTransfer) Reset()         { *m = EventMarkerTransfer{} }
+func (m *EventMarkerTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerTransfer) ProtoMessage()    {}
+func (*EventMarkerTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{33}
+}
+func (m *EventMarkerTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.MetadataDisplay) > 0 {
-		i -= len(m.MetadataDisplay)
-		copy(dAtA[i:], m.MetadataDisplay)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDisplay)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.MetadataDescription) > 0 {
-		i -= len(m.MetadataDescription)
-		copy(dAtA[i:], m.MetadataDescription)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDescription)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MetadataBase) > 0 {
-		i -= len(m.MetadataBase)
-		copy(dAtA[i:], m.MetadataBase)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataBase)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarkerTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerTransfer.Merge(m, src)
+}
+func (m *EventMarkerTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerTransfer proto.InternalMessageInfo
+
+func (m *EventMarkerTransfer) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDenomUnit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDenomUnit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerTransfer) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
 }
 
-func (m *EventDenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Aliases) > 0 {
-		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Aliases[iNdEx])
-			copy(dAtA[i:], m.Aliases[iNdEx])
-			i = encodeVarintMarker(dAtA, i, uint64(len(m.Aliases[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *EventMarkerTransfer) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	if len(m.Exponent) > 0 {
-		i -= len(m.Exponent)
-		copy(dAtA[i:], m.Exponent)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Exponent)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventMarkerTransfer) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+// EventMarkerSetDenomMetadata event emitted when metadata is set on marker with denom
+type EventMarkerSetDenomMetadata struct {
+	MetadataBase        string            `protobuf:"bytes,1,opt,name=metadata_base,json=metadataBase,proto3" json:"metadata_base,omitempty"`
+	MetadataDescription string            `protobuf:"bytes,2,opt,name=metadata_description,json=metadataDescription,proto3" json:"metadata_description,omitempty"`
+	MetadataDisplay     string            `protobuf:"bytes,3,opt,name=metadata_display,json=metadataDisplay,proto3" json:"metadata_display,omitempty"`
+	MetadataDenomUnits  []*EventDenomUnit `protobuf:"bytes,4,rep,name=metadata_denom_units,json=metadataDenomUnits,proto3" json:"metadata_denom_units,omitempty"`
+	Administrator       string            `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	MetadataName        string            `protobuf:"bytes,6,opt,name=metadata_name,json=metadataName,proto3" json:"metadata_name,omitempty"`
+	MetadataSymbol      string            `protobuf:"bytes,7,opt,name=metadata_symbol,json=metadataSymbol,proto3" json:"metadata_symbol,omitempty"`
+}
+
+func (m *EventMarkerSetDenomMetadata) Reset()         { *m = EventMarkerSetDenomMetadata{} }
+func (m *EventMarkerSetDenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSetDenomMetadata) ProtoMessage()    {}
+func (*EventMarkerSetDenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{34}
+}
+func (m *EventMarkerSetDenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSetDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSetDenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerSetDenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSetDenomMetadata.Merge(m, src)
+}
+func (m *EventMarkerSetDenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSetDenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSetDenomMetadata.DiscardUnknown(m)
 }
 
-func (m *EventSetNetAssetValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerSetDenomMetadata proto.InternalMessageInfo
+
+func (m *EventMarkerSetDenomMetadata) GetMetadataBase() string {
+	if m != nil {
+		return m.MetadataBase
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSetNetAssetValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerSetDenomMetadata) GetMetadataDescription() string {
+	if m != nil {
+		return m.MetadataDescription
+	}
+	return ""
 }
 
-func (m *EventSetNetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x22
+func (m *EventMarkerSetDenomMetadata) GetMetadataDisplay() string {
+	if m != nil {
+		return m.MetadataDisplay
 	}
-	if len(m.Volume) > 0 {
-		i -= len(m.Volume)
-		copy(dAtA[i:], m.Volume)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Volume)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventMarkerSetDenomMetadata) GetMetadataDenomUnits() []*EventDenomUnit {
+	if m != nil {
+		return m.MetadataDenomUnits
 	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *EventMarkerSetDenomMetadata) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerSetDenomMetadata) GetMetadataName() string {
+	if m != nil {
+		return m.MetadataName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerParamsUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerSetDenomMetadata) GetMetadataSymbol() string {
+	if m != nil {
+		return m.MetadataSymbol
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventDenomUnit denom units for set denom metadata event
+type EventDenomUnit struct {
+	Denom    string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Exponent string   `protobuf:"bytes,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	Aliases  []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
 }
 
-func (m *EventMarkerParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MaxSupply) > 0 {
-		i -= len(m.MaxSupply)
-		copy(dAtA[i:], m.MaxSupply)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MaxSupply)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.UnrestrictedDenomRegex) > 0 {
-		i -= len(m.UnrestrictedDenomRegex)
-		copy(dAtA[i:], m.UnrestrictedDenomRegex)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.UnrestrictedDenomRegex)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventDenomUnit) Reset()         { *m = EventDenomUnit{} }
+func (m *EventDenomUnit) String() string { return proto.CompactTextString(m) }
+func (*EventDenomUnit) ProtoMessage()    {}
+func (*EventDenomUnit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{35}
+}
+func (m *EventDenomUnit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDenomUnit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.EnableGovernance) > 0 {
-		i -= len(m.EnableGovernance)
-		copy(dAtA[i:], m.EnableGovernance)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.EnableGovernance)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventDenomUnit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDenomUnit.Merge(m, src)
+}
+func (m *EventDenomUnit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDenomUnit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDenomUnit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDenomUnit proto.InternalMessageInfo
+
+func (m *EventDenomUnit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintMarker(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMarker(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventDenomUnit) GetExponent() string {
+	if m != nil {
+		return m.Exponent
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventDenomUnit) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
 	}
-	var l int
-	_ = l
-	if m.MaxTotalSupply != 0 {
-		n += 1 + sovMarker(uint64(m.MaxTotalSupply))
-	}
-	if m.EnableGovernance {
-		n += 2
-	}
-	l = len(m.UnrestrictedDenomRegex)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = m.MaxSupply.Size()
-	n += 1 + l + sovMarker(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MarkerAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseAccount != nil {
-		l = m.BaseAccount.Size()
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	if len(m.AccessControl) > 0 {
-		for _, e := range m.AccessControl {
-			l = e.Size()
-			n += 1 + l + sovMarker(uint64(l))
+// EventSetNetAssetValue event emitted when Net Asset Value for marker is update or added
+type EventSetNetAssetValue struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Price  string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	Volume string `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (m *EventSetNetAssetValue) Reset()         { *m = EventSetNetAssetValue{} }
+func (m *EventSetNetAssetValue) String() string { return proto.CompactTextString(m) }
+func (*EventSetNetAssetValue) ProtoMessage()    {}
+func (*EventSetNetAssetValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{36}
+}
+func (m *EventSetNetAssetValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetNetAssetValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetNetAssetValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		n += 1 + sovMarker(uint64(m.Status))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = m.Supply.Size()
-	n += 1 + l + sovMarker(uint64(l))
-	if m.MarkerType != 0 {
-		n += 1 + sovMarker(uint64(m.MarkerType))
+}
+func (m *EventSetNetAssetValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetNetAssetValue.Merge(m, src)
+}
+func (m *EventSetNetAssetValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetNetAssetValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetNetAssetValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetNetAssetValue proto.InternalMessageInfo
+
+func (m *EventSetNetAssetValue) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.SupplyFixed {
-		n += 2
+	return ""
+}
+
+func (m *EventSetNetAssetValue) GetPrice() string {
+	if m != nil {
+		return m.Price
 	}
-	if m.AllowGovernanceControl {
-		n += 2
+	return ""
+}
+
+func (m *EventSetNetAssetValue) GetVolume() string {
+	if m != nil {
+		return m.Volume
 	}
-	if m.AllowForcedTransfer {
-		n += 2
+	return ""
+}
+
+func (m *EventSetNetAssetValue) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	if len(m.RequiredAttributes) > 0 {
-		for _, s := range m.RequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerParamsUpdated event emitted when marker params are updated.
+type EventMarkerParamsUpdated struct {
+	EnableGovernance       string `protobuf:"bytes,1,opt,name=enable_governance,json=enableGovernance,proto3" json:"enable_governance,omitempty"`
+	UnrestrictedDenomRegex string `protobuf:"bytes,2,opt,name=unrestricted_denom_regex,json=unrestrictedDenomRegex,proto3" json:"unrestricted_denom_regex,omitempty"`
+	MaxSupply              string `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+}
+
+func (m *EventMarkerParamsUpdated) Reset()         { *m = EventMarkerParamsUpdated{} }
+func (m *EventMarkerParamsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerParamsUpdated) ProtoMessage()    {}
+func (*EventMarkerParamsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{37}
+}
+func (m *EventMarkerParamsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerParamsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.RequireDepositAccess {
-		n += 2
-	}
-	return n
+}
+func (m *EventMarkerParamsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerParamsUpdated.Merge(m, src)
+}
+func (m *EventMarkerParamsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerParamsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerParamsUpdated.DiscardUnknown(m)
 }
 
-func (m *NetAssetValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovMarker(uint64(l))
-	if m.Volume != 0 {
-		n += 1 + sovMarker(uint64(m.Volume))
+var xxx_messageInfo_EventMarkerParamsUpdated proto.InternalMessageInfo
+
+func (m *EventMarkerParamsUpdated) GetEnableGovernance() string {
+	if m != nil {
+		return m.EnableGovernance
 	}
-	if m.UpdatedBlockHeight != 0 {
-		n += 1 + sovMarker(uint64(m.UpdatedBlockHeight))
+	return ""
+}
+
+func (m *EventMarkerParamsUpdated) GetUnrestrictedDenomRegex() string {
+	if m != nil {
+		return m.UnrestrictedDenomRegex
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerAdd) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerParamsUpdated) GetMaxSupply() string {
+	if m != nil {
+		return m.MaxSupply
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerFreeze event emitted when an amount of a marker's coin is frozen in a holder's account.
+type EventMarkerFreeze struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Holder        string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	Amount        string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExpiresAt     string `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Administrator string `protobuf:"bytes,6,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerFreeze) Reset()         { *m = EventMarkerFreeze{} }
+func (m *EventMarkerFreeze) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerFreeze) ProtoMessage()    {}
+func (*EventMarkerFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{38}
+}
+func (m *EventMarkerFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerFreeze.Merge(m, src)
+}
+func (m *EventMarkerFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerFreeze proto.InternalMessageInfo
+
+func (m *EventMarkerFreeze) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerFreeze) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerFreeze) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	l = len(m.MarkerType)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerFreeze) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerFreeze) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerAddAccess) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerFreeze) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	var l int
-	_ = l
-	l = m.Access.Size()
-	n += 1 + l + sovMarker(uint64(l))
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerAccess) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	if len(m.Permissions) > 0 {
-		for _, s := range m.Permissions {
-			l = len(s)
-			n += 1 + l + sovMarker(uint64(l))
+// EventMarkerUnfreeze event emitted when a freeze is removed from a holder's account.
+// If administrator is empty, the freeze was removed because it expired.
+type EventMarkerUnfreeze struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Holder        string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerUnfreeze) Reset()         { *m = EventMarkerUnfreeze{} }
+func (m *EventMarkerUnfreeze) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerUnfreeze) ProtoMessage()    {}
+func (*EventMarkerUnfreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{39}
+}
+func (m *EventMarkerUnfreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerUnfreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerUnfreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarkerUnfreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerUnfreeze.Merge(m, src)
+}
+func (m *EventMarkerUnfreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerUnfreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerUnfreeze.DiscardUnknown(m)
 }
 
-func (m *EventMarkerDeleteAccess) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RemoveAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+var xxx_messageInfo_EventMarkerUnfreeze proto.InternalMessageInfo
+
+func (m *EventMarkerUnfreeze) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerFinalize) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerUnfreeze) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerActivate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerUnfreeze) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerCancel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+// EventMarkerDistribution event emitted when the holder balances of a distribution have all been recorded.
+type EventMarkerDistribution struct {
+	DistributionId string `protobuf:"bytes,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	Denom          string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Payout         string `protobuf:"bytes,3,opt,name=payout,proto3" json:"payout,omitempty"`
+	Allocated      string `protobuf:"bytes,4,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	Administrator  string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	SnapshotHeight string `protobuf:"bytes,6,opt,name=snapshot_height,json=snapshotHeight,proto3" json:"snapshot_height,omitempty"`
+	HolderCount    string `protobuf:"bytes,7,opt,name=holder_count,json=holderCount,proto3" json:"holder_count,omitempty"`
+}
+
+func (m *EventMarkerDistribution) Reset()         { *m = EventMarkerDistribution{} }
+func (m *EventMarkerDistribution) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDistribution) ProtoMessage()    {}
+func (*EventMarkerDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{40}
+}
+func (m *EventMarkerDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDistribution.Merge(m, src)
+}
+func (m *EventMarkerDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerDistribution proto.InternalMessageInfo
+
+func (m *EventMarkerDistribution) GetDistributionId() string {
+	if m != nil {
+		return m.DistributionId
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerDelete) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerDistribution) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerDistribution) GetPayout() string {
+	if m != nil {
+		return m.Payout
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerDistribution) GetAllocated() string {
+	if m != nil {
+		return m.Allocated
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerMint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerDistribution) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerDistribution) GetSnapshotHeight() string {
+	if m != nil {
+		return m.SnapshotHeight
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerDistribution) GetHolderCount() string {
+	if m != nil {
+		return m.HolderCount
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerDistributionCompleted event emitted when all the holders of a distribution have been processed.
+type EventMarkerDistributionCompleted struct {
+	DistributionId string `protobuf:"bytes,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	Denom          string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EventMarkerDistributionCompleted) Reset()         { *m = EventMarkerDistributionCompleted{} }
+func (m *EventMarkerDistributionCompleted) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDistributionCompleted) ProtoMessage()    {}
+func (*EventMarkerDistributionCompleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{41}
+}
+func (m *EventMarkerDistributionCompleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerDistributionCompleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerDistributionCompleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarkerDistributionCompleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDistributionCompleted.Merge(m, src)
+}
+func (m *EventMarkerDistributionCompleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerDistributionCompleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDistributionCompleted.DiscardUnknown(m)
 }
 
-func (m *EventMarkerBurn) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventMarkerDistributionCompleted proto.InternalMessageInfo
+
+func (m *EventMarkerDistributionCompleted) GetDistributionId() string {
+	if m != nil {
+		return m.DistributionId
 	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerDistributionCompleted) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerDistributionClaimable event emitted when a holder's distribution payment must be claimed by the holder.
+type EventMarkerDistributionClaimable struct {
+	DistributionId string `protobuf:"bytes,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	Holder         string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventMarkerDistributionClaimable) Reset()         { *m = EventMarkerDistributionClaimable{} }
+func (m *EventMarkerDistributionClaimable) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDistributionClaimable) ProtoMessage()    {}
+func (*EventMarkerDistributionClaimable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{42}
+}
+func (m *EventMarkerDistributionClaimable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerDistributionClaimable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerDistributionClaimable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerDistributionClaimable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDistributionClaimable.Merge(m, src)
+}
+func (m *EventMarkerDistributionClaimable) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerDistributionClaimable) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDistributionClaimable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerDistributionClaimable proto.InternalMessageInfo
+
+func (m *EventMarkerDistributionClaimable) GetDistributionId() string {
+	if m != nil {
+		return m.DistributionId
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerDistributionClaimable) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	var l int
-	_ = l
-	l = len(m.Coins)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerDistributionClaimable) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerDistributionClaimed event emitted when a holder claims their distribution payment.
+type EventMarkerDistributionClaimed struct {
+	DistributionId string `protobuf:"bytes,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	Holder         string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventMarkerDistributionClaimed) Reset()         { *m = EventMarkerDistributionClaimed{} }
+func (m *EventMarkerDistributionClaimed) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDistributionClaimed) ProtoMessage()    {}
+func (*EventMarkerDistributionClaimed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{43}
+}
+func (m *EventMarkerDistributionClaimed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerDistributionClaimed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerDistributionClaimed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerDistributionClaimed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDistributionClaimed.Merge(m, src)
+}
+func (m *EventMarkerDistributionClaimed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerDistributionClaimed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDistributionClaimed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerDistributionClaimed proto.InternalMessageInfo
+
+func (m *EventMarkerDistributionClaimed) GetDistributionId() string {
+	if m != nil {
+		return m.DistributionId
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerDistributionClaimed) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerDistributionClaimed) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+// EventMarkerSetRedemptionPayoutDenom event emitted when a marker's redemption payout denom is set.
+type EventMarkerSetRedemptionPayoutDenom struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PayoutDenom   string `protobuf:"bytes,2,opt,name=payout_denom,json=payoutDenom,proto3" json:"payout_denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerSetRedemptionPayoutDenom) Reset()         { *m = EventMarkerSetRedemptionPayoutDenom{} }
+func (m *EventMarkerSetRedemptionPayoutDenom) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSetRedemptionPayoutDenom) ProtoMessage()    {}
+func (*EventMarkerSetRedemptionPayoutDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{44}
+}
+func (m *EventMarkerSetRedemptionPayoutDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSetRedemptionPayoutDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSetRedemptionPayoutDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.MetadataBase)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerSetRedemptionPayoutDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSetRedemptionPayoutDenom.Merge(m, src)
+}
+func (m *EventMarkerSetRedemptionPayoutDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSetRedemptionPayoutDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSetRedemptionPayoutDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerSetRedemptionPayoutDenom proto.InternalMessageInfo
+
+func (m *EventMarkerSetRedemptionPayoutDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.MetadataDescription)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerSetRedemptionPayoutDenom) GetPayoutDenom() string {
+	if m != nil {
+		return m.PayoutDenom
 	}
-	l = len(m.MetadataDisplay)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerSetRedemptionPayoutDenom) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.MetadataDenomUnits) > 0 {
-		for _, e := range m.MetadataDenomUnits {
-			l = e.Size()
-			n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerRedemptionRequested event emitted when a holder requests a redemption.
+type EventMarkerRedemptionRequested struct {
+	RedemptionId string `protobuf:"bytes,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Holder       string `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	Amount       string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventMarkerRedemptionRequested) Reset()         { *m = EventMarkerRedemptionRequested{} }
+func (m *EventMarkerRedemptionRequested) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerRedemptionRequested) ProtoMessage()    {}
+func (*EventMarkerRedemptionRequested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{45}
+}
+func (m *EventMarkerRedemptionRequested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerRedemptionRequested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerRedemptionRequested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.MetadataName)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.MetadataSymbol)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerRedemptionRequested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerRedemptionRequested.Merge(m, src)
+}
+func (m *EventMarkerRedemptionRequested) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerRedemptionRequested) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerRedemptionRequested.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerRedemptionRequested proto.InternalMessageInfo
+
+func (m *EventMarkerRedemptionRequested) GetRedemptionId() string {
+	if m != nil {
+		return m.RedemptionId
 	}
-	return n
+	return ""
 }
 
-func (m *EventDenomUnit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerRedemptionRequested) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerRedemptionRequested) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	l = len(m.Exponent)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerRedemptionRequested) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.Aliases) > 0 {
-		for _, s := range m.Aliases {
-			l = len(s)
-			n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerRedemptionFulfilled event emitted when a redemption is burned and paid out.
+type EventMarkerRedemptionFulfilled struct {
+	RedemptionId  string `protobuf:"bytes,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Holder        string `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	Amount        string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Payout        string `protobuf:"bytes,5,opt,name=payout,proto3" json:"payout,omitempty"`
+	Administrator string `protobuf:"bytes,6,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerRedemptionFulfilled) Reset()         { *m = EventMarkerRedemptionFulfilled{} }
+func (m *EventMarkerRedemptionFulfilled) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerRedemptionFulfilled) ProtoMessage()    {}
+func (*EventMarkerRedemptionFulfilled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{46}
+}
+func (m *EventMarkerRedemptionFulfilled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerRedemptionFulfilled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerRedemptionFulfilled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarkerRedemptionFulfilled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerRedemptionFulfilled.Merge(m, src)
+}
+func (m *EventMarkerRedemptionFulfilled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerRedemptionFulfilled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerRedemptionFulfilled.DiscardUnknown(m)
 }
 
-func (m *EventSetNetAssetValue) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventMarkerRedemptionFulfilled proto.InternalMessageInfo
+
+func (m *EventMarkerRedemptionFulfilled) GetRedemptionId() string {
+	if m != nil {
+		return m.RedemptionId
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerRedemptionFulfilled) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerRedemptionFulfilled) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	l = len(m.Volume)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerRedemptionFulfilled) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerRedemptionFulfilled) GetPayout() string {
+	if m != nil {
+		return m.Payout
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerParamsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerRedemptionFulfilled) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	var l int
-	_ = l
-	l = len(m.EnableGovernance)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerRedemptionRejected event emitted when a redemption is rejected and the escrowed coins are returned.
+type EventMarkerRedemptionRejected struct {
+	RedemptionId  string `protobuf:"bytes,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Holder        string `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	Amount        string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventMarkerRedemptionRejected) Reset()         { *m = EventMarkerRedemptionRejected{} }
+func (m *EventMarkerRedemptionRejected) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerRedemptionRejected) ProtoMessage()    {}
+func (*EventMarkerRedemptionRejected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{47}
+}
+func (m *EventMarkerRedemptionRejected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerRedemptionRejected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerRedemptionRejected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.UnrestrictedDenomRegex)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerRedemptionRejected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerRedemptionRejected.Merge(m, src)
+}
+func (m *EventMarkerRedemptionRejected) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerRedemptionRejected) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerRedemptionRejected.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerRedemptionRejected proto.InternalMessageInfo
+
+func (m *EventMarkerRedemptionRejected) GetRedemptionId() string {
+	if m != nil {
+		return m.RedemptionId
 	}
-	l = len(m.MaxSupply)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerRedemptionRejected) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func sovMarker(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventMarkerRedemptionRejected) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
 }
-func sozMarker(x uint64) (n int) {
-	return sovMarker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EventMarkerRedemptionRejected) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventMarkerRedemptionRejected) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+func (m *EventMarkerRedemptionRejected) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventMarkerSetTransferLimits event emitted when a marker's transfer limits are set.
+type EventMarkerSetTransferLimits struct {
+	Denom            string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	HolderDailyLimit string `protobuf:"bytes,2,opt,name=holder_daily_limit,json=holderDailyLimit,proto3" json:"holder_daily_limit,omitempty"`
+	MarkerDailyLimit string `protobuf:"bytes,3,opt,name=marker_daily_limit,json=markerDailyLimit,proto3" json:"marker_daily_limit,omitempty"`
+	Administrator    string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerSetTransferLimits) Reset()         { *m = EventMarkerSetTransferLimits{} }
+func (m *EventMarkerSetTransferLimits) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSetTransferLimits) ProtoMessage()    {}
+func (*EventMarkerSetTransferLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{48}
+}
+func (m *EventMarkerSetTransferLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSetTransferLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSetTransferLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerSetTransferLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSetTransferLimits.Merge(m, src)
+}
+func (m *EventMarkerSetTransferLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSetTransferLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSetTransferLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerSetTransferLimits proto.InternalMessageInfo
+
+func (m *EventMarkerSetTransferLimits) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerSetTransferLimits) GetHolderDailyLimit() string {
+	if m != nil {
+		return m.HolderDailyLimit
+	}
+	return ""
+}
+
+func (m *EventMarkerSetTransferLimits) GetMarkerDailyLimit() string {
+	if m != nil {
+		return m.MarkerDailyLimit
+	}
+	return ""
+}
+
+func (m *EventMarkerSetTransferLimits) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventMarkerSetAccessGrantTerms event emitted when the terms of an access grant are set.
+type EventMarkerSetAccessGrantTerms struct {
+	Denom          string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ExpiresAt      string `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MintDailyLimit string `protobuf:"bytes,4,opt,name=mint_daily_limit,json=mintDailyLimit,proto3" json:"mint_daily_limit,omitempty"`
+	Administrator  string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerSetAccessGrantTerms) Reset()         { *m = EventMarkerSetAccessGrantTerms{} }
+func (m *EventMarkerSetAccessGrantTerms) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSetAccessGrantTerms) ProtoMessage()    {}
+func (*EventMarkerSetAccessGrantTerms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{49}
+}
+func (m *EventMarkerSetAccessGrantTerms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSetAccessGrantTerms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSetAccessGrantTerms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerSetAccessGrantTerms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSetAccessGrantTerms.Merge(m, src)
+}
+func (m *EventMarkerSetAccessGrantTerms) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSetAccessGrantTerms) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSetAccessGrantTerms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerSetAccessGrantTerms proto.InternalMessageInfo
+
+func (m *EventMarkerSetAccessGrantTerms) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerSetAccessGrantTerms) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventMarkerSetAccessGrantTerms) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
+func (m *EventMarkerSetAccessGrantTerms) GetMintDailyLimit() string {
+	if m != nil {
+		return m.MintDailyLimit
+	}
+	return ""
+}
+
+func (m *EventMarkerSetAccessGrantTerms) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventMarkerAccessGrantExpired event emitted when an expired access grant is removed from a marker.
+type EventMarkerAccessGrantExpired struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *EventMarkerAccessGrantExpired) Reset()         { *m = EventMarkerAccessGrantExpired{} }
+func (m *EventMarkerAccessGrantExpired) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAccessGrantExpired) ProtoMessage()    {}
+func (*EventMarkerAccessGrantExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{50}
+}
+func (m *EventMarkerAccessGrantExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerAccessGrantExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerAccessGrantExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerAccessGrantExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAccessGrantExpired.Merge(m, src)
+}
+func (m *EventMarkerAccessGrantExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerAccessGrantExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAccessGrantExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerAccessGrantExpired proto.InternalMessageInfo
+
+func (m *EventMarkerAccessGrantExpired) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerAccessGrantExpired) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// EventMarkerSetApprovalThreshold event emitted when the approval threshold of a marker permission is set.
+type EventMarkerSetApprovalThreshold struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Permission    string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	Threshold     string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerSetApprovalThreshold) Reset()         { *m = EventMarkerSetApprovalThreshold{} }
+func (m *EventMarkerSetApprovalThreshold) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSetApprovalThreshold) ProtoMessage()    {}
+func (*EventMarkerSetApprovalThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{51}
+}
+func (m *EventMarkerSetApprovalThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSetApprovalThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSetApprovalThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerSetApprovalThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSetApprovalThreshold.Merge(m, src)
+}
+func (m *EventMarkerSetApprovalThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSetApprovalThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSetApprovalThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerSetApprovalThreshold proto.InternalMessageInfo
+
+func (m *EventMarkerSetApprovalThreshold) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerSetApprovalThreshold) GetPermission() string {
+	if m != nil {
+		return m.Permission
+	}
+	return ""
+}
+
+func (m *EventMarkerSetApprovalThreshold) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *EventMarkerSetApprovalThreshold) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventMarkerOperationProposed event emitted when a mint, withdraw or forced transfer needs more approvals.
+type EventMarkerOperationProposed struct {
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Permission  string `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`
+	MsgTypeUrl  string `protobuf:"bytes,4,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Proposer    string `protobuf:"bytes,5,opt,name=proposer,proto3" json:"proposer,omitempty"`
+}
+
+func (m *EventMarkerOperationProposed) Reset()         { *m = EventMarkerOperationProposed{} }
+func (m *EventMarkerOperationProposed) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerOperationProposed) ProtoMessage()    {}
+func (*EventMarkerOperationProposed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{52}
+}
+func (m *EventMarkerOperationProposed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerOperationProposed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerOperationProposed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerOperationProposed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerOperationProposed.Merge(m, src)
+}
+func (m *EventMarkerOperationProposed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerOperationProposed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerOperationProposed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerOperationProposed proto.InternalMessageInfo
+
+func (m *EventMarkerOperationProposed) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+func (m *EventMarkerOperationProposed) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerOperationProposed) GetPermission() string {
+	if m != nil {
+		return m.Permission
+	}
+	return ""
+}
+
+func (m *EventMarkerOperationProposed) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventMarkerOperationProposed) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+// EventMarkerOperationApproved event emitted when a pending operation is approved.
+type EventMarkerOperationApproved struct {
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Approver    string `protobuf:"bytes,3,opt,name=approver,proto3" json:"approver,omitempty"`
+}
+
+func (m *EventMarkerOperationApproved) Reset()         { *m = EventMarkerOperationApproved{} }
+func (m *EventMarkerOperationApproved) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerOperationApproved) ProtoMessage()    {}
+func (*EventMarkerOperationApproved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{53}
+}
+func (m *EventMarkerOperationApproved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerOperationApproved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerOperationApproved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerOperationApproved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerOperationApproved.Merge(m, src)
+}
+func (m *EventMarkerOperationApproved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerOperationApproved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerOperationApproved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerOperationApproved proto.InternalMessageInfo
+
+func (m *EventMarkerOperationApproved) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+func (m *EventMarkerOperationApproved) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerOperationApproved) GetApprover() string {
+	if m != nil {
+		return m.Approver
+	}
+	return ""
+}
+
+// EventMarkerOperationExecuted event emitted when a pending operation has enough approvals and is executed.
+type EventMarkerOperationExecuted struct {
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EventMarkerOperationExecuted) Reset()         { *m = EventMarkerOperationExecuted{} }
+func (m *EventMarkerOperationExecuted) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerOperationExecuted) ProtoMessage()    {}
+func (*EventMarkerOperationExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{54}
+}
+func (m *EventMarkerOperationExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerOperationExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerOperationExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerOperationExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerOperationExecuted.Merge(m, src)
+}
+func (m *EventMarkerOperationExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerOperationExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerOperationExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerOperationExecuted proto.InternalMessageInfo
+
+func (m *EventMarkerOperationExecuted) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+func (m *EventMarkerOperationExecuted) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// EventMarkerOperationCancelled event emitted when a pending operation is cancelled.
+type EventMarkerOperationCancelled struct {
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Signer      string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventMarkerOperationCancelled) Reset()         { *m = EventMarkerOperationCancelled{} }
+func (m *EventMarkerOperationCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerOperationCancelled) ProtoMessage()    {}
+func (*EventMarkerOperationCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{55}
+}
+func (m *EventMarkerOperationCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerOperationCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerOperationCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerOperationCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerOperationCancelled.Merge(m, src)
+}
+func (m *EventMarkerOperationCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerOperationCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerOperationCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerOperationCancelled proto.InternalMessageInfo
+
+func (m *EventMarkerOperationCancelled) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+func (m *EventMarkerOperationCancelled) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerOperationCancelled) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// EventMarkerOperationExpired event emitted when a pending operation expires without being executed.
+type EventMarkerOperationExpired struct {
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EventMarkerOperationExpired) Reset()         { *m = EventMarkerOperationExpired{} }
+func (m *EventMarkerOperationExpired) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerOperationExpired) ProtoMessage()    {}
+func (*EventMarkerOperationExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{56}
+}
+func (m *EventMarkerOperationExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerOperationExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerOperationExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerOperationExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerOperationExpired.Merge(m, src)
+}
+func (m *EventMarkerOperationExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerOperationExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerOperationExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerOperationExpired proto.InternalMessageInfo
+
+func (m *EventMarkerOperationExpired) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+func (m *EventMarkerOperationExpired) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// EventMarkerSetAttributeRequirements event emitted when a marker's attribute requirements are set.
+type EventMarkerSetAttributeRequirements struct {
+	Denom         string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Requirements  []string `protobuf:"bytes,2,rep,name=requirements,proto3" json:"requirements,omitempty"`
+	Administrator string   `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerSetAttributeRequirements) Reset()         { *m = EventMarkerSetAttributeRequirements{} }
+func (m *EventMarkerSetAttributeRequirements) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSetAttributeRequirements) ProtoMessage()    {}
+func (*EventMarkerSetAttributeRequirements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{57}
+}
+func (m *EventMarkerSetAttributeRequirements) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSetAttributeRequirements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSetAttributeRequirements.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerSetAttributeRequirements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSetAttributeRequirements.Merge(m, src)
+}
+func (m *EventMarkerSetAttributeRequirements) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSetAttributeRequirements) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSetAttributeRequirements.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerSetAttributeRequirements proto.InternalMessageInfo
+
+func (m *EventMarkerSetAttributeRequirements) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerSetAttributeRequirements) GetRequirements() []string {
+	if m != nil {
+		return m.Requirements
+	}
+	return nil
+}
+
+func (m *EventMarkerSetAttributeRequirements) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventMarkerHolderSnapshotTaken event emitted when a snapshot of a marker's holders is recorded.
+type EventMarkerHolderSnapshotTaken struct {
+	SnapshotId    string `protobuf:"bytes,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Height        string `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"`
+	HolderCount   string `protobuf:"bytes,4,opt,name=holder_count,json=holderCount,proto3" json:"holder_count,omitempty"`
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerHolderSnapshotTaken) Reset()         { *m = EventMarkerHolderSnapshotTaken{} }
+func (m *EventMarkerHolderSnapshotTaken) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerHolderSnapshotTaken) ProtoMessage()    {}
+func (*EventMarkerHolderSnapshotTaken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{58}
+}
+func (m *EventMarkerHolderSnapshotTaken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerHolderSnapshotTaken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerHolderSnapshotTaken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerHolderSnapshotTaken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerHolderSnapshotTaken.Merge(m, src)
+}
+func (m *EventMarkerHolderSnapshotTaken) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerHolderSnapshotTaken) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerHolderSnapshotTaken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerHolderSnapshotTaken proto.InternalMessageInfo
+
+func (m *EventMarkerHolderSnapshotTaken) GetSnapshotId() string {
+	if m != nil {
+		return m.SnapshotId
+	}
+	return ""
+}
+
+func (m *EventMarkerHolderSnapshotTaken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerHolderSnapshotTaken) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *EventMarkerHolderSnapshotTaken) GetHolderCount() string {
+	if m != nil {
+		return m.HolderCount
+	}
+	return ""
+}
+
+func (m *EventMarkerHolderSnapshotTaken) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventMarkerForcedTransfer event emitted when a forced transfer is recorded.
+type EventMarkerForcedTransfer struct {
+	RecordId      string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount        string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	FromAddress   string `protobuf:"bytes,4,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress     string `protobuf:"bytes,5,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Administrator string `protobuf:"bytes,6,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	Reason        string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Reference     string `protobuf:"bytes,8,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (m *EventMarkerForcedTransfer) Reset()         { *m = EventMarkerForcedTransfer{} }
+func (m *EventMarkerForcedTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerForcedTransfer) ProtoMessage()    {}
+func (*EventMarkerForcedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{59}
+}
+func (m *EventMarkerForcedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerForcedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerForcedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerForcedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerForcedTransfer.Merge(m, src)
+}
+func (m *EventMarkerForcedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerForcedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerForcedTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerForcedTransfer proto.InternalMessageInfo
+
+func (m *EventMarkerForcedTransfer) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
+}
+
+func (m *EventMarkerForcedTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerForcedTransfer) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventMarkerForcedTransfer) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *EventMarkerForcedTransfer) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *EventMarkerForcedTransfer) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+func (m *EventMarkerForcedTransfer) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventMarkerForcedTransfer) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+// EventMarkerSetIssuanceSchedule event emitted when a marker's issuance schedule is set.
+type EventMarkerSetIssuanceSchedule struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	HardCap       string `protobuf:"bytes,2,opt,name=hard_cap,json=hardCap,proto3" json:"hard_cap,omitempty"`
+	Scheduled     string `protobuf:"bytes,3,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	TrancheCount  string `protobuf:"bytes,4,opt,name=tranche_count,json=trancheCount,proto3" json:"tranche_count,omitempty"`
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerSetIssuanceSchedule) Reset()         { *m = EventMarkerSetIssuanceSchedule{} }
+func (m *EventMarkerSetIssuanceSchedule) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSetIssuanceSchedule) ProtoMessage()    {}
+func (*EventMarkerSetIssuanceSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{60}
+}
+func (m *EventMarkerSetIssuanceSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSetIssuanceSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSetIssuanceSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerSetIssuanceSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSetIssuanceSchedule.Merge(m, src)
+}
+func (m *EventMarkerSetIssuanceSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSetIssuanceSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSetIssuanceSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerSetIssuanceSchedule proto.InternalMessageInfo
+
+func (m *EventMarkerSetIssuanceSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerSetIssuanceSchedule) GetHardCap() string {
+	if m != nil {
+		return m.HardCap
+	}
+	return ""
+}
+
+func (m *EventMarkerSetIssuanceSchedule) GetScheduled() string {
+	if m != nil {
+		return m.Scheduled
+	}
+	return ""
+}
+
+func (m *EventMarkerSetIssuanceSchedule) GetTrancheCount() string {
+	if m != nil {
+		return m.TrancheCount
+	}
+	return ""
+}
+
+func (m *EventMarkerSetIssuanceSchedule) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventSetNetAssetValueMaxAge event emitted when the maximum age of a marker's net asset values is set.
+type EventSetNetAssetValueMaxAge struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MaxAge        string `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventSetNetAssetValueMaxAge) Reset()         { *m = EventSetNetAssetValueMaxAge{} }
+func (m *EventSetNetAssetValueMaxAge) String() string { return proto.CompactTextString(m) }
+func (*EventSetNetAssetValueMaxAge) ProtoMessage()    {}
+func (*EventSetNetAssetValueMaxAge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{61}
+}
+func (m *EventSetNetAssetValueMaxAge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetNetAssetValueMaxAge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetNetAssetValueMaxAge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetNetAssetValueMaxAge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetNetAssetValueMaxAge.Merge(m, src)
+}
+func (m *EventSetNetAssetValueMaxAge) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetNetAssetValueMaxAge) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetNetAssetValueMaxAge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetNetAssetValueMaxAge proto.InternalMessageInfo
+
+func (m *EventSetNetAssetValueMaxAge) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventSetNetAssetValueMaxAge) GetMaxAge() string {
+	if m != nil {
+		return m.MaxAge
+	}
+	return ""
+}
+
+func (m *EventSetNetAssetValueMaxAge) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventNetAssetValueStale event emitted when a marker's net asset value becomes older than the maximum age.
+type EventNetAssetValueStale struct {
+	Denom              string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PriceDenom         string `protobuf:"bytes,2,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	UpdatedBlockHeight string `protobuf:"bytes,3,opt,name=updated_block_height,json=updatedBlockHeight,proto3" json:"updated_block_height,omitempty"`
+	MaxAge             string `protobuf:"bytes,4,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+}
+
+func (m *EventNetAssetValueStale) Reset()         { *m = EventNetAssetValueStale{} }
+func (m *EventNetAssetValueStale) String() string { return proto.CompactTextString(m) }
+func (*EventNetAssetValueStale) ProtoMessage()    {}
+func (*EventNetAssetValueStale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{62}
+}
+func (m *EventNetAssetValueStale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNetAssetValueStale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNetAssetValueStale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNetAssetValueStale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNetAssetValueStale.Merge(m, src)
+}
+func (m *EventNetAssetValueStale) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNetAssetValueStale) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNetAssetValueStale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNetAssetValueStale proto.InternalMessageInfo
+
+func (m *EventNetAssetValueStale) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventNetAssetValueStale) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *EventNetAssetValueStale) GetUpdatedBlockHeight() string {
+	if m != nil {
+		return m.UpdatedBlockHeight
+	}
+	return ""
+}
+
+func (m *EventNetAssetValueStale) GetMaxAge() string {
+	if m != nil {
+		return m.MaxAge
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("provenance.marker.v1.MarkerType", MarkerType_name, MarkerType_value)
+	proto.RegisterEnum("provenance.marker.v1.MarkerStatus", MarkerStatus_name, MarkerStatus_value)
+	proto.RegisterEnum("provenance.marker.v1.AttributeCondition", AttributeCondition_name, AttributeCondition_value)
+	proto.RegisterEnum("provenance.marker.v1.ForcedTransferReason", ForcedTransferReason_name, ForcedTransferReason_value)
+	proto.RegisterType((*Params)(nil), "provenance.marker.v1.Params")
+	proto.RegisterType((*MarkerAccount)(nil), "provenance.marker.v1.MarkerAccount")
+	proto.RegisterType((*NetAssetValue)(nil), "provenance.marker.v1.NetAssetValue")
+	proto.RegisterType((*NetAssetValueRecord)(nil), "provenance.marker.v1.NetAssetValueRecord")
+	proto.RegisterType((*HolderFreeze)(nil), "provenance.marker.v1.HolderFreeze")
+	proto.RegisterType((*Distribution)(nil), "provenance.marker.v1.Distribution")
+	proto.RegisterType((*DistributionPayment)(nil), "provenance.marker.v1.DistributionPayment")
+	proto.RegisterType((*Redemption)(nil), "provenance.marker.v1.Redemption")
+	proto.RegisterType((*RedemptionPayoutDenom)(nil), "provenance.marker.v1.RedemptionPayoutDenom")
+	proto.RegisterType((*TransferLimits)(nil), "provenance.marker.v1.TransferLimits")
+	proto.RegisterType((*TransferVolume)(nil), "provenance.marker.v1.TransferVolume")
+	proto.RegisterType((*AccessGrantTerms)(nil), "provenance.marker.v1.AccessGrantTerms")
+	proto.RegisterType((*AccessGrantMintVolume)(nil), "provenance.marker.v1.AccessGrantMintVolume")
+	proto.RegisterType((*ApprovalThreshold)(nil), "provenance.marker.v1.ApprovalThreshold")
+	proto.RegisterType((*PendingOperation)(nil), "provenance.marker.v1.PendingOperation")
+	proto.RegisterType((*AttributeRequirement)(nil), "provenance.marker.v1.AttributeRequirement")
+	proto.RegisterType((*AttributeRequirements)(nil), "provenance.marker.v1.AttributeRequirements")
+	proto.RegisterType((*CapTableEntry)(nil), "provenance.marker.v1.CapTableEntry")
+	proto.RegisterType((*HolderSnapshot)(nil), "provenance.marker.v1.HolderSnapshot")
+	proto.RegisterType((*ForcedTransferRecord)(nil), "provenance.marker.v1.ForcedTransferRecord")
+	proto.RegisterType((*IssuanceTranche)(nil), "provenance.marker.v1.IssuanceTranche")
+	proto.RegisterType((*IssuanceSchedule)(nil), "provenance.marker.v1.IssuanceSchedule")
+	proto.RegisterType((*EventMarkerAdd)(nil), "provenance.marker.v1.EventMarkerAdd")
+	proto.RegisterType((*EventMarkerAddAccess)(nil), "provenance.marker.v1.EventMarkerAddAccess")
+	proto.RegisterType((*EventMarkerAccess)(nil), "provenance.marker.v1.EventMarkerAccess")
+	proto.RegisterType((*EventMarkerDeleteAccess)(nil), "provenance.marker.v1.EventMarkerDeleteAccess")
+	proto.RegisterType((*EventMarkerFinalize)(nil), "provenance.marker.v1.EventMarkerFinalize")
+	proto.RegisterType((*EventMarkerActivate)(nil), "provenance.marker.v1.EventMarkerActivate")
+	proto.RegisterType((*EventMarkerCancel)(nil), "provenance.marker.v1.EventMarkerCancel")
+	proto.RegisterType((*EventMarkerDelete)(nil), "provenance.marker.v1.EventMarkerDelete")
+	proto.RegisterType((*EventMarkerMint)(nil), "provenance.marker.v1.EventMarkerMint")
+	proto.RegisterType((*EventMarkerBurn)(nil), "provenance.marker.v1.EventMarkerBurn")
+	proto.RegisterType((*EventMarkerWithdraw)(nil), "provenance.marker.v1.EventMarkerWithdraw")
+	proto.RegisterType((*EventMarkerTransfer)(nil), "provenance.marker.v1.EventMarkerTransfer")
+	proto.RegisterType((*EventMarkerSetDenomMetadata)(nil), "provenance.marker.v1.EventMarkerSetDenomMetadata")
+	proto.RegisterType((*EventDenomUnit)(nil), "provenance.marker.v1.EventDenomUnit")
+	proto.RegisterType((*EventSetNetAssetValue)(nil), "provenance.marker.v1.EventSetNetAssetValue")
+	proto.RegisterType((*EventMarkerParamsUpdated)(nil), "provenance.marker.v1.EventMarkerParamsUpdated")
+	proto.RegisterType((*EventMarkerFreeze)(nil), "provenance.marker.v1.EventMarkerFreeze")
+	proto.RegisterType((*EventMarkerUnfreeze)(nil), "provenance.marker.v1.EventMarkerUnfreeze")
+	proto.RegisterType((*EventMarkerDistribution)(nil), "provenance.marker.v1.EventMarkerDistribution")
+	proto.RegisterType((*EventMarkerDistributionCompleted)(nil), "provenance.marker.v1.EventMarkerDistributionCompleted")
+	proto.RegisterType((*EventMarkerDistributionClaimable)(nil), "provenance.marker.v1.EventMarkerDistributionClaimable")
+	proto.RegisterType((*EventMarkerDistributionClaimed)(nil), "provenance.marker.v1.EventMarkerDistributionClaimed")
+	proto.RegisterType((*EventMarkerSetRedemptionPayoutDenom)(nil), "provenance.marker.v1.EventMarkerSetRedemptionPayoutDenom")
+	proto.RegisterType((*EventMarkerRedemptionRequested)(nil), "provenance.marker.v1.EventMarkerRedemptionRequested")
+	proto.RegisterType((*EventMarkerRedemptionFulfilled)(nil), "provenance.marker.v1.EventMarkerRedemptionFulfilled")
+	proto.RegisterType((*EventMarkerRedemptionRejected)(nil), "provenance.marker.v1.EventMarkerRedemptionRejected")
+	proto.RegisterType((*EventMarkerSetTransferLimits)(nil), "provenance.marker.v1.EventMarkerSetTransferLimits")
+	proto.RegisterType((*EventMarkerSetAccessGrantTerms)(nil), "provenance.marker.v1.EventMarkerSetAccessGrantTerms")
+	proto.RegisterType((*EventMarkerAccessGrantExpired)(nil), "provenance.marker.v1.EventMarkerAccessGrantExpired")
+	proto.RegisterType((*EventMarkerSetApprovalThreshold)(nil), "provenance.marker.v1.EventMarkerSetApprovalThreshold")
+	proto.RegisterType((*EventMarkerOperationProposed)(nil), "provenance.marker.v1.EventMarkerOperationProposed")
+	proto.RegisterType((*EventMarkerOperationApproved)(nil), "provenance.marker.v1.EventMarkerOperationApproved")
+	proto.RegisterType((*EventMarkerOperationExecuted)(nil), "provenance.marker.v1.EventMarkerOperationExecuted")
+	proto.RegisterType((*EventMarkerOperationCancelled)(nil), "provenance.marker.v1.EventMarkerOperationCancelled")
+	proto.RegisterType((*EventMarkerOperationExpired)(nil), "provenance.marker.v1.EventMarkerOperationExpired")
+	proto.RegisterType((*EventMarkerSetAttributeRequirements)(nil), "provenance.marker.v1.EventMarkerSetAttributeRequirements")
+	proto.RegisterType((*EventMarkerHolderSnapshotTaken)(nil), "provenance.marker.v1.EventMarkerHolderSnapshotTaken")
+	proto.RegisterType((*EventMarkerForcedTransfer)(nil), "provenance.marker.v1.EventMarkerForcedTransfer")
+	proto.RegisterType((*EventMarkerSetIssuanceSchedule)(nil), "provenance.marker.v1.EventMarkerSetIssuanceSchedule")
+	proto.RegisterType((*EventSetNetAssetValueMaxAge)(nil), "provenance.marker.v1.EventSetNetAssetValueMaxAge")
+	proto.RegisterType((*EventNetAssetValueStale)(nil), "provenance.marker.v1.EventNetAssetValueStale")
+}
+
+func init() { proto.RegisterFile("provenance/marker/v1/marker.proto", fileDescriptor_f7e2c25c71db7f99) }
+
+var fileDescriptor_f7e2c25c71db7f99 = []byte{
+	// 3966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3b, 0x4d, 0x6f, 0x23, 0xc9,
+	0x75, 0xd3, 0x14, 0x45, 0x89, 0x8f, 0x94, 0xc4, 0xa9, 0xd1, 0x6a, 0x38, 0x9a, 0x19, 0x89, 0xd3,
+	0xbb, 0xde, 0x95, 0xc7, 0x3b, 0xd2, 0x8e, 0xd6, 0xeb, 0x71, 0xd6, 0x4e, 0x36, 0x14, 0xd9, 0x9a,
+	0x21, 0x56, 0x23, 0x6a, 0x9b, 0xd4, 0x18, 0x63, 0x04, 0x68, 0x94, 0xd8, 0x25, 0xb2, 0x3d, 0xec,
+	0x6e, 0x6e, 0x77, 0x51, 0x23, 0x3a, 0x39, 0x18, 0x88, 0x6d, 0xd8, 0xca, 0xc5, 0x47, 0x07, 0x86,
+	0x80, 0x05, 0x62, 0x04, 0x49, 0x1c, 0x20, 0x39, 0x6c, 0x82, 0x20, 0x87, 0x7c, 0x1c, 0x02, 0x6c,
+	0x7c, 0x5a, 0x24, 0x39, 0x04, 0x39, 0x6c, 0x92, 0xdd, 0x43, 0x7c, 0x08, 0x90, 0xbf, 0x10, 0x54,
+	0x57, 0xf5, 0x17, 0x3f, 0xa4, 0xd6, 0x68, 0xd7, 0x37, 0xd6, 0xab, 0xf7, 0xaa, 0x5e, 0xbd, 0x7a,
+	0xf5, 0x3e, 0x9b, 0x70, 0xa7, 0xe7, 0xd8, 0x47, 0xc4, 0xc2, 0x56, 0x8b, 0x6c, 0x98, 0xd8, 0x79,
+	0x46, 0x9c, 0x8d, 0xa3, 0xfb, 0xe2, 0xd7, 0x7a, 0xcf, 0xb1, 0xa9, 0x8d, 0x16, 0x43, 0x94, 0x75,
+	0x31, 0x71, 0x74, 0x7f, 0x79, 0xb1, 0x6d, 0xb7, 0x6d, 0x0f, 0x61, 0x83, 0xfd, 0xe2, 0xb8, 0xcb,
+	0x2b, 0x2d, 0xdb, 0x35, 0x6d, 0x77, 0x03, 0xf7, 0x69, 0x67, 0xe3, 0xe8, 0xfe, 0x01, 0xa1, 0xf8,
+	0xbe, 0x37, 0x10, 0xf3, 0x37, 0xf8, 0xbc, 0xc6, 0x09, 0xf9, 0x60, 0x88, 0xf4, 0x00, 0xbb, 0x24,
+	0x20, 0x6d, 0xd9, 0x86, 0xe5, 0x93, 0xb6, 0x6d, 0xbb, 0xdd, 0x25, 0x1b, 0xde, 0xe8, 0xa0, 0x7f,
+	0xb8, 0x81, 0xad, 0x81, 0x4f, 0x3a, 0x3c, 0xa5, 0xf7, 0x1d, 0x4c, 0x0d, 0xdb, 0x27, 0x5d, 0x1d,
+	0x9e, 0xa7, 0x86, 0x49, 0x5c, 0x8a, 0xcd, 0x9e, 0x40, 0x78, 0x75, 0xac, 0x14, 0x70, 0xab, 0x45,
+	0x5c, 0xb7, 0xed, 0x60, 0x8b, 0x72, 0x3c, 0xf9, 0xc7, 0x29, 0xc8, 0xec, 0x61, 0x07, 0x9b, 0x2e,
+	0x7a, 0x1d, 0x0a, 0x26, 0x3e, 0xd6, 0xa8, 0x4d, 0x71, 0x57, 0x73, 0xfb, 0xbd, 0x5e, 0x77, 0x50,
+	0x94, 0x4a, 0xd2, 0x5a, 0x7a, 0x2b, 0x55, 0x94, 0xd4, 0x79, 0x13, 0x1f, 0x37, 0xd9, 0x54, 0xc3,
+	0x9b, 0x41, 0x5f, 0x81, 0xab, 0xc4, 0xc2, 0x07, 0x5d, 0xa2, 0xb5, 0xed, 0x23, 0xe2, 0x78, 0x3b,
+	0x15, 0x53, 0x25, 0x69, 0x6d, 0x56, 0x2d, 0xf0, 0x89, 0x87, 0x01, 0x1c, 0x7d, 0x1d, 0x8a, 0x7d,
+	0xcb, 0x21, 0x2e, 0x75, 0x8c, 0x16, 0x25, 0xba, 0xa6, 0x13, 0xcb, 0x36, 0x35, 0x87, 0xb4, 0xc9,
+	0x71, 0x71, 0xaa, 0x24, 0xad, 0x65, 0xd5, 0xa5, 0xe8, 0x7c, 0x95, 0x4d, 0xab, 0x6c, 0x16, 0x7d,
+	0x13, 0x80, 0x31, 0x25, 0xd8, 0x49, 0x33, 0xdc, 0xad, 0xdb, 0x1f, 0x7d, 0xb2, 0x7a, 0xe5, 0x3f,
+	0x3e, 0x59, 0x7d, 0x89, 0xcb, 0xd7, 0xd5, 0x9f, 0xad, 0x1b, 0xf6, 0x86, 0x89, 0x69, 0x67, 0xbd,
+	0x66, 0x51, 0x35, 0x6b, 0xe2, 0x63, 0xc1, 0xe4, 0xab, 0xb0, 0xc0, 0xa8, 0x2d, 0x7c, 0xa4, 0x75,
+	0x0c, 0x97, 0xda, 0xce, 0xa0, 0x38, 0x5d, 0x92, 0xd6, 0xe6, 0xd4, 0x39, 0x13, 0x1f, 0xef, 0xe2,
+	0xa3, 0x47, 0x1c, 0xf8, 0x76, 0xfa, 0x57, 0x1f, 0xac, 0x4a, 0xf2, 0xcf, 0xa7, 0x61, 0xee, 0xb1,
+	0x27, 0xab, 0x72, 0xab, 0x65, 0xf7, 0x2d, 0x8a, 0x6a, 0x90, 0x67, 0x97, 0xa7, 0x61, 0x3e, 0xf6,
+	0xc4, 0x91, 0xdb, 0x2c, 0xad, 0x8b, 0x6b, 0xf6, 0xd4, 0x40, 0x5c, 0xec, 0xfa, 0x16, 0x76, 0x89,
+	0xa0, 0xdb, 0x4a, 0x7f, 0xfc, 0xc9, 0xaa, 0xa4, 0xe6, 0x0e, 0x42, 0x10, 0x2a, 0xc2, 0x8c, 0x89,
+	0x2d, 0xdc, 0x26, 0x8e, 0x27, 0xa5, 0xac, 0xea, 0x0f, 0xd1, 0x2e, 0xcc, 0xf3, 0x7b, 0xd1, 0x5a,
+	0xb6, 0x45, 0x1d, 0xbb, 0x5b, 0x9c, 0x2a, 0x4d, 0xad, 0xe5, 0x36, 0xef, 0xac, 0x8f, 0x53, 0xd3,
+	0xf5, 0xb2, 0x87, 0xfb, 0x90, 0xdd, 0xe1, 0x56, 0x9a, 0x49, 0x42, 0x9d, 0xe3, 0xe4, 0x15, 0x4e,
+	0x8d, 0xde, 0x86, 0x8c, 0x4b, 0x31, 0xed, 0xbb, 0x9e, 0xb8, 0xe6, 0x37, 0xe5, 0xf1, 0xeb, 0xf0,
+	0x93, 0x36, 0x3c, 0x4c, 0x55, 0x50, 0xa0, 0x45, 0x98, 0xf6, 0xee, 0xc6, 0x13, 0x53, 0x56, 0xe5,
+	0x03, 0xf4, 0x16, 0x64, 0xc4, 0x05, 0x64, 0x92, 0x5c, 0x80, 0x40, 0x46, 0x65, 0xc8, 0xf1, 0xed,
+	0x34, 0x3a, 0xe8, 0x91, 0xe2, 0x8c, 0xc7, 0x4d, 0xe9, 0x2c, 0x6e, 0x9a, 0x83, 0x1e, 0x51, 0xc1,
+	0x0c, 0x7e, 0xa3, 0x3b, 0x90, 0xe7, 0x8b, 0x69, 0x87, 0xc6, 0x31, 0xd1, 0x8b, 0xb3, 0x9e, 0x82,
+	0xe5, 0x38, 0x6c, 0x9b, 0x81, 0x98, 0x6e, 0xe1, 0x6e, 0xd7, 0x7e, 0x1e, 0xd1, 0xc3, 0x40, 0x90,
+	0x59, 0x0f, 0x7d, 0xc9, 0x9b, 0x0f, 0xd5, 0xd1, 0x17, 0xd4, 0x26, 0xbc, 0xc4, 0x29, 0x0f, 0x6d,
+	0xa7, 0x45, 0x74, 0x8d, 0x3a, 0xd8, 0x72, 0x0f, 0x89, 0x53, 0x04, 0x8f, 0xec, 0x9a, 0x37, 0xb9,
+	0xed, 0xcd, 0x35, 0xc5, 0x14, 0xda, 0x80, 0x6b, 0x0e, 0x79, 0xbf, 0x6f, 0x38, 0x44, 0xd7, 0x30,
+	0xa5, 0x8e, 0x71, 0xd0, 0xa7, 0xc4, 0x2d, 0xe6, 0x4a, 0x53, 0x6b, 0x59, 0x15, 0xf9, 0x53, 0xe5,
+	0x60, 0x06, 0x7d, 0x15, 0x96, 0x04, 0x54, 0xd3, 0x49, 0xcf, 0x76, 0x0d, 0xaa, 0xf1, 0xeb, 0x2a,
+	0xe6, 0xbd, 0x5d, 0x16, 0xc5, 0x6c, 0x95, 0x4f, 0xf2, 0xdb, 0x7d, 0x7b, 0xf9, 0x47, 0x1f, 0xac,
+	0x5e, 0xf9, 0xe9, 0x07, 0xab, 0x57, 0x7e, 0xf9, 0xe1, 0xbd, 0xf9, 0x98, 0x4e, 0xd6, 0xe4, 0x3f,
+	0x96, 0x60, 0x6e, 0x97, 0xd0, 0xb2, 0xeb, 0x12, 0xfa, 0x04, 0x77, 0xfb, 0x04, 0xbd, 0x05, 0xd3,
+	0x3d, 0xc7, 0x68, 0x11, 0xa1, 0x9f, 0x37, 0x7c, 0xfd, 0x64, 0xfa, 0x17, 0xe8, 0x67, 0xc5, 0x36,
+	0x2c, 0xa1, 0x30, 0x1c, 0x1b, 0x2d, 0x41, 0xe6, 0xc8, 0xee, 0xf6, 0x4d, 0xfe, 0x6e, 0xd3, 0xaa,
+	0x18, 0xa1, 0x37, 0x60, 0xb1, 0xdf, 0xd3, 0x31, 0x7b, 0xa8, 0x07, 0x5d, 0xbb, 0xf5, 0x4c, 0xeb,
+	0x10, 0xa3, 0xdd, 0xa1, 0xde, 0x4b, 0x4d, 0xab, 0x48, 0xcc, 0x6d, 0xb1, 0xa9, 0x47, 0xde, 0x0c,
+	0x53, 0x1b, 0x97, 0xe2, 0x2e, 0xf1, 0x34, 0x6e, 0x56, 0xe5, 0x03, 0xf9, 0x9f, 0x24, 0xb8, 0x16,
+	0x63, 0x54, 0x25, 0x2d, 0xdb, 0xd1, 0xd1, 0x7b, 0xb0, 0x60, 0x11, 0xaa, 0x61, 0x06, 0xd7, 0x8e,
+	0xd8, 0x84, 0x60, 0xfc, 0xe5, 0xf1, 0xba, 0x11, 0x5b, 0xc3, 0xd7, 0x79, 0x2b, 0x26, 0x81, 0x0a,
+	0x00, 0x67, 0x95, 0x1a, 0xe2, 0x38, 0xb9, 0xcd, 0xe5, 0x75, 0x6e, 0x24, 0xd7, 0x7d, 0x23, 0xb9,
+	0xde, 0xf4, 0x8d, 0xe4, 0xd6, 0x2c, 0x5b, 0xe4, 0x27, 0xff, 0xb9, 0x2a, 0xa9, 0x59, 0x8f, 0x8e,
+	0xcd, 0x30, 0x79, 0xb8, 0x76, 0xdf, 0x69, 0x11, 0x61, 0x93, 0xc4, 0x48, 0xfe, 0xb3, 0x14, 0xe4,
+	0x1f, 0xd9, 0x5d, 0x9d, 0x38, 0xdb, 0x0e, 0x21, 0xdf, 0x25, 0xe1, 0x2b, 0x91, 0xa2, 0xaf, 0xe4,
+	0x0d, 0xc8, 0x74, 0x3c, 0x2c, 0xfe, 0xc0, 0xb7, 0x8a, 0xff, 0xf2, 0xe1, 0xbd, 0x45, 0x71, 0x13,
+	0x65, 0x5d, 0x77, 0x88, 0xeb, 0x36, 0xa8, 0x63, 0x58, 0x6d, 0x55, 0xe0, 0xb1, 0x77, 0x85, 0x4d,
+	0xcf, 0xb0, 0x4c, 0x25, 0x7a, 0x57, 0x1c, 0x99, 0x1d, 0x96, 0x1c, 0xf7, 0x0c, 0x87, 0xb8, 0x1a,
+	0xa6, 0xc5, 0xf4, 0x45, 0x0e, 0x2b, 0xe8, 0xca, 0x94, 0x1d, 0xd6, 0x21, 0xd8, 0xb5, 0x2d, 0xf1,
+	0xd4, 0xc5, 0x08, 0xfd, 0x16, 0xcc, 0x61, 0xdd, 0x34, 0x2c, 0xc3, 0xa5, 0x0e, 0xa6, 0xb6, 0x53,
+	0xcc, 0x9c, 0x73, 0x98, 0x38, 0xba, 0xfc, 0xbd, 0x34, 0xe4, 0xab, 0x86, 0xcb, 0xf5, 0xdf, 0xb0,
+	0x2d, 0x34, 0x0f, 0x29, 0x43, 0xe7, 0x8e, 0x44, 0x4d, 0x19, 0x7a, 0x28, 0xbc, 0x54, 0x54, 0x78,
+	0x0f, 0x20, 0xd3, 0xc3, 0x03, 0xbb, 0xcf, 0x45, 0x91, 0x40, 0x87, 0x05, 0x3a, 0xfa, 0x4d, 0xc8,
+	0xb2, 0x77, 0xda, 0x62, 0x2a, 0x59, 0x4c, 0x27, 0xa3, 0x0d, 0x29, 0x46, 0x8f, 0x3b, 0x7d, 0xa1,
+	0xe3, 0xa2, 0xd7, 0x60, 0xc1, 0xb5, 0x70, 0xcf, 0xed, 0xd8, 0xd4, 0x7f, 0x26, 0x4c, 0x60, 0x53,
+	0xea, 0xbc, 0x0f, 0x16, 0x4f, 0x64, 0x1b, 0x16, 0x48, 0xd7, 0x68, 0x1b, 0xcc, 0x63, 0x0a, 0x63,
+	0x3a, 0x93, 0xe4, 0xd2, 0xe7, 0x7d, 0x2a, 0xe1, 0xd2, 0xee, 0x40, 0x9e, 0x6b, 0x8f, 0xc6, 0x5d,
+	0xd2, 0xac, 0x27, 0xd8, 0x1c, 0x87, 0x55, 0x18, 0x88, 0xf1, 0xd4, 0x73, 0x6c, 0x66, 0x47, 0x88,
+	0x2e, 0xb0, 0xb2, 0x1e, 0xd6, 0x7c, 0x00, 0xe6, 0x88, 0x5f, 0x86, 0x42, 0xc0, 0x7c, 0x8f, 0x58,
+	0xba, 0x61, 0xb5, 0x85, 0xed, 0x0b, 0x0e, 0xb5, 0xc7, 0xc1, 0xe8, 0x2e, 0x5c, 0x0d, 0x50, 0x2d,
+	0x72, 0x4c, 0xb5, 0x67, 0x64, 0x50, 0xcc, 0x95, 0xa4, 0xb5, 0x7c, 0x88, 0xbb, 0x4b, 0x8e, 0xe9,
+	0xbb, 0x64, 0x20, 0xff, 0xa9, 0x04, 0xd7, 0xa2, 0x2a, 0xb0, 0x87, 0x07, 0x26, 0xe1, 0x7c, 0xe9,
+	0x11, 0xb0, 0x16, 0xa8, 0xc5, 0x7c, 0x14, 0x5c, 0xd3, 0x5f, 0xe0, 0x25, 0x3d, 0x88, 0xbd, 0xa4,
+	0x24, 0xea, 0xc3, 0xd1, 0xe5, 0x8f, 0x24, 0x00, 0x95, 0xe8, 0xc4, 0xec, 0x5d, 0x40, 0x59, 0x43,
+	0xfe, 0xa6, 0x2e, 0xcc, 0x5f, 0xfa, 0x42, 0xfc, 0xb1, 0x2b, 0x62, 0x0e, 0x82, 0xb8, 0xcc, 0x1a,
+	0x0b, 0x05, 0x9b, 0xf6, 0x14, 0x6c, 0x21, 0x80, 0x73, 0x0d, 0x93, 0xf7, 0xe0, 0xa5, 0xf0, 0x24,
+	0x7b, 0xde, 0xeb, 0xf0, 0x02, 0xa9, 0x09, 0xe6, 0xea, 0x0e, 0xe4, 0xf9, 0x13, 0xd2, 0xa2, 0x27,
+	0xcc, 0xf5, 0x42, 0x42, 0xf9, 0x1f, 0x24, 0x98, 0xf7, 0x3d, 0xdf, 0x8e, 0x61, 0x1a, 0xd4, 0x9d,
+	0xb0, 0xd6, 0xbb, 0x80, 0x84, 0x52, 0xea, 0xd8, 0xe8, 0x0e, 0xb4, 0x2e, 0x43, 0x2e, 0xa6, 0x92,
+	0xe8, 0x77, 0x81, 0x13, 0x56, 0x19, 0x9d, 0xb7, 0x07, 0x5b, 0x4c, 0x84, 0x0d, 0xd1, 0xc5, 0x12,
+	0x59, 0xc8, 0x02, 0x27, 0x0c, 0x17, 0x93, 0x7f, 0x1c, 0x39, 0xc2, 0x13, 0xee, 0xde, 0xc6, 0x1f,
+	0x61, 0x29, 0xae, 0x73, 0xc1, 0xcd, 0x21, 0x48, 0x77, 0xec, 0xbe, 0x23, 0x9c, 0x9f, 0xf7, 0x1b,
+	0xbd, 0x15, 0xbb, 0xcd, 0xa4, 0x76, 0x5b, 0xfe, 0x8b, 0x14, 0x14, 0x22, 0xd1, 0x5b, 0x93, 0x38,
+	0xe6, 0x24, 0x81, 0x6e, 0xc2, 0x0c, 0xe6, 0x8a, 0x74, 0xee, 0x13, 0xf0, 0x11, 0xd1, 0x3b, 0x31,
+	0xb7, 0x30, 0x75, 0xae, 0x5b, 0x48, 0x0f, 0xbb, 0x84, 0x87, 0x50, 0x30, 0x0d, 0x8b, 0xc6, 0xc4,
+	0x9e, 0xe8, 0x80, 0xf3, 0x8c, 0x2c, 0x72, 0x83, 0x0f, 0x01, 0x3d, 0x37, 0x68, 0x47, 0x77, 0xf0,
+	0x73, 0x4d, 0x70, 0x47, 0xdc, 0xe2, 0x74, 0x69, 0xea, 0xcc, 0x83, 0x5c, 0xf5, 0x69, 0xca, 0x3e,
+	0x89, 0xfc, 0xe7, 0x12, 0xbc, 0x14, 0x91, 0xd8, 0x63, 0xc3, 0xa2, 0x67, 0x5e, 0xe2, 0x8b, 0x88,
+	0xed, 0x73, 0xbc, 0xe0, 0x1f, 0x48, 0x70, 0xb5, 0xdc, 0x63, 0x31, 0x0c, 0xee, 0x36, 0x3b, 0x0e,
+	0x71, 0x99, 0x0e, 0x4d, 0x60, 0xf5, 0x9b, 0x00, 0x3d, 0xe2, 0x98, 0x86, 0xeb, 0x1a, 0xb6, 0xe5,
+	0x71, 0x3b, 0xbf, 0x79, 0xeb, 0xac, 0x88, 0x5f, 0x8d, 0xe0, 0xa3, 0x5b, 0x90, 0xa5, 0xfe, 0x06,
+	0x1e, 0xe7, 0x73, 0x6a, 0x08, 0x90, 0xff, 0x27, 0x05, 0x05, 0x61, 0xb8, 0xeb, 0x3d, 0xe2, 0xe0,
+	0x0b, 0x98, 0xb6, 0x38, 0x5b, 0x53, 0x17, 0x64, 0xeb, 0x1e, 0xa0, 0x30, 0x3a, 0x16, 0x82, 0xe0,
+	0x69, 0xc8, 0x9c, 0x7a, 0xd5, 0x9f, 0xf1, 0x25, 0xe4, 0xa2, 0x0a, 0x4c, 0x99, 0x6e, 0xdb, 0xb3,
+	0x67, 0xb9, 0xcd, 0xc5, 0x11, 0x55, 0x2d, 0x5b, 0x83, 0xad, 0x9b, 0xbf, 0xfc, 0xf0, 0xde, 0xf5,
+	0x71, 0xb6, 0xf2, 0xb1, 0xdb, 0x56, 0x19, 0x35, 0xfa, 0x1a, 0x64, 0xf9, 0x56, 0xc4, 0x71, 0x8b,
+	0x99, 0x73, 0x74, 0x2c, 0x44, 0x1d, 0x8a, 0xa2, 0x66, 0x5e, 0x28, 0x8a, 0x92, 0xff, 0x4f, 0x82,
+	0xc5, 0x20, 0xd8, 0x57, 0xf9, 0x01, 0x3d, 0x5f, 0x87, 0x20, 0x6d, 0x61, 0x93, 0x88, 0x3b, 0xf7,
+	0x7e, 0xa3, 0x6d, 0xc8, 0xb6, 0x6c, 0x4b, 0x37, 0x68, 0x78, 0xe3, 0x6b, 0x13, 0x44, 0xeb, 0x2f,
+	0x59, 0xf1, 0xf1, 0xd5, 0x90, 0x14, 0xdd, 0x84, 0xec, 0x77, 0x5c, 0xdb, 0xd2, 0x7a, 0x98, 0x76,
+	0x44, 0xa8, 0x3a, 0xcb, 0x00, 0x7b, 0x98, 0x76, 0xbc, 0xa0, 0x9e, 0x85, 0xc4, 0x4c, 0xec, 0x2c,
+	0x27, 0x11, 0x23, 0xb4, 0x0d, 0x79, 0xd3, 0xb0, 0x58, 0xb8, 0x6d, 0xe8, 0x06, 0x1d, 0x08, 0xa1,
+	0xdf, 0x18, 0x39, 0x70, 0x55, 0x14, 0x1a, 0xf8, 0x79, 0x7f, 0xca, 0xce, 0x9b, 0x33, 0x0d, 0xeb,
+	0x89, 0xa0, 0x93, 0x7f, 0x9f, 0x3d, 0xc9, 0x31, 0x27, 0x9e, 0x64, 0xc9, 0x9a, 0x90, 0x77, 0x22,
+	0x58, 0xc5, 0x94, 0x97, 0xdb, 0xde, 0x3d, 0xe7, 0xdc, 0x91, 0x85, 0x85, 0x43, 0x8c, 0xad, 0x22,
+	0xff, 0xdb, 0x14, 0xcc, 0x55, 0x70, 0xaf, 0xc9, 0xea, 0x0c, 0x8a, 0x45, 0x9d, 0x41, 0xf4, 0xe9,
+	0x4b, 0x49, 0x9f, 0xfe, 0x9b, 0x30, 0xed, 0x55, 0x3b, 0x92, 0x79, 0x2a, 0x8e, 0x8b, 0x1e, 0xc0,
+	0xcc, 0x01, 0xee, 0x7a, 0xe5, 0x8e, 0x44, 0x3e, 0xc9, 0xc7, 0x46, 0xdf, 0x80, 0xac, 0xcb, 0xa2,
+	0x2c, 0xc6, 0x73, 0xc2, 0x4a, 0x46, 0x80, 0x8f, 0xee, 0x43, 0xba, 0x43, 0xba, 0x7a, 0x71, 0x3a,
+	0x09, 0x9d, 0x87, 0xca, 0x8c, 0xd8, 0xa1, 0x63, 0x7f, 0x97, 0x58, 0x09, 0xb3, 0x76, 0x8e, 0x8c,
+	0xde, 0x81, 0xdc, 0xfb, 0x7d, 0xcc, 0xcc, 0xad, 0x61, 0x11, 0x3d, 0x59, 0x90, 0x1a, 0xa5, 0x40,
+	0xbf, 0x01, 0xb3, 0xc4, 0x6d, 0x39, 0xf6, 0x73, 0x91, 0xaf, 0x9f, 0x4b, 0x1d, 0xa0, 0xcb, 0xff,
+	0x9a, 0x82, 0x79, 0x9e, 0x69, 0x35, 0x44, 0x4c, 0x99, 0xd0, 0x6c, 0x31, 0xef, 0x1d, 0x26, 0xa9,
+	0x53, 0xaa, 0x18, 0xa1, 0x77, 0x60, 0x96, 0xe2, 0x67, 0xc4, 0xba, 0x68, 0xa2, 0x34, 0xe3, 0x51,
+	0x95, 0x29, 0x7a, 0xd3, 0x5f, 0xe0, 0x60, 0x70, 0x6e, 0x6a, 0xc0, 0x89, 0xb6, 0x46, 0x63, 0xf4,
+	0xcc, 0x68, 0x8c, 0x1e, 0x96, 0x54, 0x66, 0x2e, 0x52, 0x52, 0x29, 0xc2, 0x8c, 0x1f, 0xa8, 0xf3,
+	0x52, 0x88, 0x3f, 0x44, 0x37, 0x60, 0x36, 0x88, 0xcb, 0xb3, 0x5e, 0x5c, 0x3e, 0x63, 0x89, 0x78,
+	0xfc, 0x57, 0x53, 0xb0, 0x18, 0x2f, 0x63, 0x88, 0x44, 0x3c, 0x99, 0x6c, 0xbf, 0x01, 0xf9, 0x43,
+	0xc7, 0x36, 0x7d, 0x4f, 0x7e, 0x6e, 0xcc, 0x9b, 0x63, 0xd8, 0x02, 0x84, 0x1e, 0x00, 0x50, 0x3b,
+	0x20, 0x4d, 0x9f, 0x43, 0x9a, 0xa5, 0xb6, 0x4f, 0x18, 0xba, 0xe0, 0xe9, 0x8b, 0xe4, 0xc6, 0x97,
+	0x4c, 0x5f, 0xd1, 0x56, 0x90, 0x16, 0xf3, 0x72, 0xd5, 0x04, 0x43, 0x35, 0x2c, 0x4e, 0x46, 0x11,
+	0xa4, 0xd0, 0xb7, 0x20, 0xeb, 0x90, 0x43, 0xe2, 0x10, 0x66, 0x23, 0xbc, 0x17, 0xa0, 0x86, 0x80,
+	0x88, 0xaa, 0x66, 0x63, 0xaa, 0x1a, 0x2f, 0x61, 0xc0, 0x0b, 0x95, 0x30, 0xe4, 0xbf, 0x94, 0x60,
+	0xa1, 0xe6, 0xba, 0x7d, 0xc6, 0x2e, 0xe3, 0xae, 0xd5, 0x21, 0x11, 0x49, 0x4a, 0x17, 0x91, 0x64,
+	0x19, 0x72, 0x7d, 0xeb, 0x22, 0x35, 0x15, 0x1e, 0x4f, 0x02, 0x27, 0x62, 0x60, 0xf4, 0x32, 0xcc,
+	0x89, 0x25, 0x62, 0x8f, 0x33, 0xcf, 0x81, 0x22, 0x6d, 0xf9, 0x54, 0x82, 0x82, 0xcf, 0x72, 0xa3,
+	0xd5, 0x21, 0x7a, 0xbf, 0x3b, 0x29, 0xbc, 0xfb, 0x3a, 0xcc, 0x76, 0xb0, 0xa3, 0x6b, 0x2d, 0xdc,
+	0x4b, 0x66, 0xb2, 0x67, 0x18, 0x7a, 0x05, 0xf7, 0xd0, 0x43, 0x98, 0xa5, 0x5c, 0x1c, 0xae, 0xa8,
+	0xae, 0x7e, 0x69, 0xfc, 0xc5, 0x0e, 0x09, 0x4f, 0x38, 0x9f, 0x80, 0x98, 0x09, 0xd3, 0x70, 0xdd,
+	0xbe, 0xa8, 0x35, 0x9c, 0x2f, 0x4c, 0x8e, 0x2c, 0xff, 0x42, 0x82, 0x79, 0xe5, 0x88, 0x58, 0x54,
+	0xd4, 0xf2, 0x74, 0x7d, 0x72, 0x1a, 0x22, 0x2e, 0x4b, 0xa4, 0x21, 0x7c, 0xc4, 0xe0, 0xa2, 0xa8,
+	0xeb, 0xd7, 0xa6, 0xbc, 0x51, 0xb4, 0xac, 0x9c, 0x8e, 0x97, 0x95, 0x57, 0xe3, 0xd5, 0x57, 0x5e,
+	0xe5, 0x89, 0xd6, 0x56, 0x8b, 0xa1, 0xc7, 0xcc, 0x70, 0x52, 0x31, 0x94, 0xff, 0x50, 0x82, 0xc5,
+	0x38, 0xb7, 0x3c, 0xd6, 0x43, 0x0a, 0x64, 0x44, 0xf1, 0x92, 0x17, 0xec, 0x5e, 0x1b, 0x2f, 0xc4,
+	0x28, 0xad, 0x87, 0x1e, 0x24, 0xb5, 0x7c, 0x99, 0xf1, 0x76, 0xe6, 0x95, 0xe1, 0xa7, 0xcb, 0x4f,
+	0x1a, 0x07, 0xca, 0x75, 0xb8, 0x3a, 0xb2, 0x7c, 0xf4, 0x28, 0x52, 0xec, 0x28, 0xa8, 0x04, 0xb9,
+	0x30, 0x3e, 0xe5, 0xd1, 0x47, 0x56, 0x8d, 0x82, 0xe4, 0xdf, 0x83, 0xeb, 0x91, 0x05, 0xab, 0xa4,
+	0x4b, 0x28, 0x11, 0xcb, 0x7e, 0x09, 0xe6, 0x1d, 0x62, 0xda, 0x47, 0x44, 0x8b, 0xaf, 0x3e, 0xc7,
+	0xa1, 0xbe, 0xa9, 0xba, 0xcc, 0x71, 0xde, 0x83, 0x6b, 0x91, 0xdd, 0xb7, 0x0d, 0x0b, 0x77, 0x8d,
+	0x89, 0x15, 0xc6, 0x91, 0x25, 0x53, 0xe7, 0x2f, 0x59, 0x6e, 0x51, 0xe3, 0x08, 0xd3, 0xcb, 0x2d,
+	0x19, 0x17, 0x7a, 0x85, 0x5d, 0x77, 0xf7, 0x73, 0x5c, 0x90, 0x0b, 0xfd, 0x52, 0x0b, 0x12, 0x58,
+	0x88, 0x2c, 0xf8, 0xd8, 0xe0, 0x4f, 0x26, 0x6a, 0xf7, 0x82, 0xa7, 0x74, 0x99, 0xeb, 0x8a, 0x6f,
+	0xb3, 0xd5, 0x77, 0xac, 0x2f, 0x64, 0x9b, 0x1f, 0x4a, 0xb1, 0x3b, 0xfc, 0x96, 0x48, 0x8c, 0xd9,
+	0x9a, 0xac, 0x7f, 0xe8, 0xeb, 0x21, 0x1f, 0x5c, 0x66, 0x27, 0x74, 0x7b, 0xd4, 0x3f, 0x47, 0xbc,
+	0xb0, 0xfc, 0x8b, 0x38, 0x23, 0x41, 0x1b, 0xe4, 0x0b, 0x38, 0xf4, 0x39, 0xac, 0xb0, 0xa0, 0x2a,
+	0x16, 0x86, 0x70, 0x83, 0x16, 0x0d, 0x36, 0xe4, 0xff, 0x4d, 0xc1, 0xcd, 0x08, 0xb7, 0x0d, 0xc2,
+	0xeb, 0x58, 0x8f, 0x09, 0xc5, 0x3a, 0xa6, 0x98, 0xf9, 0x23, 0x53, 0xfc, 0xd6, 0x58, 0x32, 0x29,
+	0x98, 0xcf, 0xfb, 0x40, 0xd6, 0xc2, 0x43, 0xf7, 0x61, 0x31, 0x40, 0xd2, 0x59, 0x64, 0x6a, 0xf4,
+	0x82, 0x84, 0x2d, 0xab, 0x5e, 0xf3, 0xe7, 0xaa, 0xe1, 0x14, 0x2b, 0xd2, 0x85, 0x24, 0x86, 0xdb,
+	0xeb, 0xe2, 0x81, 0x38, 0xe2, 0x42, 0x80, 0xce, 0xc1, 0xe8, 0x49, 0x6c, 0x75, 0xd6, 0x05, 0xed,
+	0x5b, 0x06, 0xe5, 0xc9, 0x5a, 0x6e, 0xf3, 0x95, 0x33, 0xec, 0xa9, 0x77, 0x94, 0x7d, 0xcb, 0xa0,
+	0x2a, 0x0a, 0x79, 0x10, 0x20, 0x77, 0x54, 0xc4, 0xd3, 0xe3, 0x44, 0x1c, 0x15, 0x80, 0x97, 0x9e,
+	0x66, 0xe2, 0x02, 0xd8, 0x65, 0x69, 0xea, 0x6b, 0x10, 0x70, 0xad, 0xb9, 0x03, 0xf3, 0xc0, 0xee,
+	0xf2, 0x18, 0x55, 0x9d, 0xf7, 0xc1, 0x0d, 0x0f, 0x2a, 0xff, 0x8e, 0xf0, 0x69, 0x01, 0x1b, 0x13,
+	0x5e, 0xf0, 0x32, 0xcc, 0x92, 0xe3, 0x9e, 0x6d, 0x91, 0xc0, 0xab, 0x05, 0x63, 0xcf, 0x72, 0x77,
+	0x0d, 0xec, 0x0a, 0xbf, 0x9c, 0x55, 0xfd, 0xa1, 0xec, 0xc2, 0x4b, 0xde, 0xea, 0x0d, 0x42, 0xe3,
+	0xdd, 0xae, 0xf1, 0x9b, 0x2c, 0xfa, 0x3d, 0x30, 0xa1, 0x79, 0xc3, 0x2d, 0x2e, 0xe1, 0x36, 0xf9,
+	0x28, 0xd2, 0xea, 0x49, 0xc7, 0x5a, 0x3d, 0x1f, 0x48, 0x50, 0x8c, 0x68, 0x10, 0xef, 0x8c, 0xef,
+	0xf3, 0x86, 0xd7, 0xf8, 0x96, 0x37, 0x67, 0xe2, 0x62, 0x2d, 0xef, 0xd4, 0x99, 0x2d, 0xef, 0xdb,
+	0xb1, 0x96, 0x37, 0xe7, 0x3b, 0xec, 0x69, 0xcb, 0x7f, 0x2d, 0xc5, 0x6c, 0xe7, 0x99, 0x2d, 0xa9,
+	0x49, 0x45, 0xcd, 0xa5, 0x78, 0xe3, 0x29, 0x78, 0xbe, 0xb7, 0x47, 0x3a, 0x4b, 0xd9, 0x24, 0x3d,
+	0xa3, 0x57, 0xc6, 0x06, 0xdd, 0xc3, 0x46, 0xcd, 0x88, 0x99, 0x92, 0x7d, 0xeb, 0xf0, 0x45, 0x38,
+	0x4f, 0x66, 0x3f, 0xbf, 0x97, 0x8a, 0x3b, 0xf5, 0x68, 0x3f, 0x6a, 0x42, 0x17, 0x22, 0x3b, 0xd2,
+	0x85, 0x98, 0x98, 0x69, 0x46, 0x1a, 0x55, 0xd9, 0xa0, 0x0f, 0x75, 0x6b, 0xb8, 0x0f, 0x95, 0x8d,
+	0xb6, 0x99, 0x92, 0x3d, 0xcf, 0x09, 0xcd, 0xa4, 0xec, 0x48, 0x33, 0x69, 0x38, 0xc1, 0xe4, 0xef,
+	0x33, 0x9a, 0x60, 0xca, 0x18, 0x4a, 0x13, 0x24, 0x50, 0xb1, 0xcd, 0x1e, 0xf3, 0xb7, 0xfa, 0x25,
+	0x45, 0x21, 0xff, 0xee, 0xe4, 0x2d, 0xba, 0xd8, 0x30, 0xbd, 0xba, 0x45, 0xe2, 0x2d, 0x2e, 0xa8,
+	0xaa, 0xf2, 0x00, 0x56, 0xce, 0xda, 0x9c, 0xe8, 0x5f, 0xdc, 0xd6, 0xdf, 0x97, 0xe0, 0xe5, 0xb8,
+	0x9b, 0xf9, 0x7c, 0xfb, 0x2e, 0x09, 0x95, 0xfc, 0x0f, 0xa4, 0x98, 0x08, 0x42, 0x1e, 0x54, 0xbf,
+	0x31, 0xc4, 0xec, 0xbd, 0x13, 0x80, 0x43, 0x01, 0xe4, 0x43, 0xe0, 0x59, 0x7a, 0x1e, 0xed, 0x71,
+	0x8d, 0x11, 0x4a, 0x3a, 0x26, 0x94, 0x7f, 0x9e, 0xc4, 0xcd, 0x76, 0xbf, 0x7b, 0x68, 0x74, 0xbb,
+	0xbf, 0x56, 0x6e, 0x22, 0xaf, 0x74, 0x3a, 0xf6, 0x4a, 0x93, 0x59, 0xaa, 0x8f, 0x24, 0xb8, 0x3d,
+	0x41, 0xb2, 0xdf, 0x21, 0xad, 0x5f, 0xaf, 0x60, 0x13, 0x9a, 0x8e, 0xd0, 0x34, 0x67, 0xa2, 0xa6,
+	0x99, 0x79, 0x8b, 0x5b, 0x71, 0x5d, 0x4d, 0xd4, 0xd0, 0x7b, 0x7d, 0x72, 0x43, 0x6f, 0x4c, 0xc7,
+	0xee, 0xf5, 0xc9, 0x1d, 0xbb, 0xd1, 0x96, 0xdc, 0xe8, 0x81, 0xd2, 0xe3, 0xee, 0xe0, 0xef, 0xe3,
+	0xfa, 0xd4, 0x20, 0x34, 0x61, 0xeb, 0xac, 0x38, 0xd4, 0x03, 0x0a, 0x73, 0xc1, 0xdb, 0x23, 0x0d,
+	0xb2, 0x98, 0x77, 0x5b, 0x9b, 0xd4, 0xfe, 0x1a, 0xe9, 0x6f, 0x25, 0xba, 0x12, 0xb9, 0x1e, 0x53,
+	0xa2, 0x08, 0xf7, 0x8a, 0xb7, 0xa5, 0x7e, 0x51, 0xfe, 0xe5, 0x9f, 0x49, 0xb0, 0x3a, 0x24, 0x92,
+	0x84, 0xcd, 0xa6, 0x95, 0x91, 0x66, 0x53, 0xf6, 0xec, 0x76, 0x52, 0x36, 0xd2, 0x4e, 0x4a, 0x78,
+	0x61, 0x7f, 0x15, 0xd7, 0xb4, 0xa0, 0xf1, 0xb4, 0xe7, 0xd8, 0x3d, 0xdb, 0x25, 0x3a, 0x33, 0x7c,
+	0xb6, 0x0f, 0x0c, 0x9f, 0x4c, 0x2e, 0x80, 0x4d, 0x7c, 0x31, 0x2b, 0x23, 0x3d, 0xa9, 0x38, 0xf7,
+	0x25, 0xc8, 0x9b, 0x6e, 0xdb, 0x2b, 0x73, 0x68, 0x7d, 0xa7, 0x2b, 0xd8, 0x03, 0xd3, 0x6d, 0xb3,
+	0x3a, 0xc7, 0xbe, 0xd3, 0x65, 0x11, 0x68, 0x8f, 0xb3, 0xe1, 0xdf, 0x55, 0x30, 0x96, 0xdd, 0xf1,
+	0x6c, 0x73, 0xd1, 0x5e, 0x86, 0xed, 0x65, 0x98, 0xf5, 0xbb, 0x4d, 0x7e, 0x97, 0xc6, 0x1f, 0xcb,
+	0xdf, 0x1a, 0xbf, 0xa9, 0x72, 0x4c, 0x5a, 0x7d, 0x7a, 0x89, 0x4d, 0xe5, 0x1e, 0xdc, 0x1e, 0xb7,
+	0x30, 0x4f, 0xd9, 0xbb, 0x97, 0x39, 0x0e, 0x0b, 0x99, 0x8d, 0xb6, 0x15, 0xda, 0x2d, 0x3e, 0x92,
+	0x9f, 0xc0, 0xcd, 0x71, 0x3b, 0xfa, 0x4a, 0xfe, 0xc2, 0x27, 0xf9, 0xc1, 0x88, 0x97, 0xbd, 0x48,
+	0xdb, 0x49, 0x1e, 0xd3, 0x76, 0xca, 0xc6, 0x9b, 0x48, 0x09, 0xdd, 0xec, 0xdf, 0xc4, 0x0d, 0x51,
+	0xbc, 0x3d, 0xd1, 0x64, 0x15, 0x7f, 0x56, 0x6a, 0x0b, 0xe2, 0xb6, 0xe0, 0x88, 0xe0, 0x83, 0x6a,
+	0xc9, 0x9a, 0x16, 0xd9, 0xa0, 0x12, 0x3c, 0x1c, 0xdd, 0xa5, 0x47, 0xa2, 0xbb, 0x84, 0x16, 0xe8,
+	0xfb, 0x29, 0xb8, 0x11, 0x4d, 0x15, 0xe2, 0x9f, 0x32, 0xde, 0x64, 0x65, 0x6a, 0xd6, 0x07, 0x08,
+	0x79, 0x9e, 0xe5, 0x80, 0xb3, 0x38, 0x1e, 0x9b, 0x37, 0x0c, 0xe7, 0xe6, 0xe9, 0x91, 0xdc, 0x7c,
+	0x28, 0xbb, 0x9f, 0x1e, 0xce, 0xee, 0x13, 0x39, 0xe6, 0x88, 0x97, 0x9b, 0x89, 0x25, 0x20, 0x67,
+	0x56, 0xdc, 0xe5, 0xbf, 0x1d, 0x71, 0x25, 0x09, 0xeb, 0xcd, 0x37, 0x86, 0xeb, 0xcd, 0x61, 0x41,
+	0xf9, 0x16, 0x64, 0x5d, 0x41, 0x1c, 0x58, 0xcc, 0x00, 0xc0, 0xc2, 0x03, 0x51, 0x31, 0x8e, 0x5d,
+	0x61, 0x5e, 0x00, 0x2f, 0x72, 0x87, 0x0e, 0xdc, 0x1c, 0x9b, 0x06, 0x3f, 0xc6, 0xc7, 0xe5, 0xf6,
+	0x24, 0xc6, 0xaf, 0xb3, 0xaa, 0xf0, 0xb1, 0x86, 0xdb, 0x7e, 0x3a, 0x9c, 0x31, 0x39, 0x7a, 0x32,
+	0x95, 0xff, 0x99, 0x24, 0xd2, 0xa7, 0xd8, 0x8e, 0x0d, 0x8a, 0x27, 0x4a, 0x6a, 0x15, 0x72, 0x5e,
+	0xc2, 0x1d, 0x8b, 0x69, 0xc1, 0x03, 0x55, 0xc5, 0x27, 0x53, 0x93, 0xbf, 0x29, 0xcd, 0x8e, 0xfd,
+	0xa6, 0x34, 0x72, 0x86, 0x74, 0xf4, 0x0c, 0x77, 0x7f, 0x28, 0x01, 0x84, 0x9f, 0x0b, 0xa3, 0x35,
+	0xb8, 0xfe, 0xb8, 0xac, 0xbe, 0xab, 0xa8, 0x5a, 0xf3, 0xe9, 0x9e, 0xa2, 0xed, 0xef, 0x36, 0xf6,
+	0x94, 0x4a, 0x6d, 0xbb, 0xa6, 0x54, 0x0b, 0x57, 0x96, 0x73, 0x27, 0xa7, 0xa5, 0x99, 0x7d, 0xeb,
+	0x99, 0x65, 0x3f, 0xb7, 0xd0, 0x0a, 0x14, 0xa2, 0x98, 0x95, 0x7a, 0x6d, 0xb7, 0x20, 0x2d, 0xcf,
+	0x9e, 0x9c, 0x96, 0xd2, 0xec, 0xcb, 0x2b, 0xb4, 0x0e, 0x4b, 0xd1, 0x79, 0x55, 0x69, 0x34, 0xd5,
+	0x5a, 0xa5, 0xa9, 0x54, 0x0b, 0xa9, 0x65, 0x74, 0x72, 0x5a, 0x9a, 0x57, 0x83, 0x74, 0x9d, 0xe1,
+	0xdf, 0xfd, 0xbb, 0x14, 0xe4, 0xa3, 0x5f, 0x51, 0xa3, 0x4d, 0xb8, 0x21, 0x16, 0x68, 0x34, 0xcb,
+	0xcd, 0xfd, 0xc6, 0x10, 0x33, 0xd7, 0x4e, 0x4e, 0x4b, 0x0b, 0x1c, 0x75, 0xdf, 0xd2, 0xc9, 0xa1,
+	0xd7, 0x2d, 0x0d, 0x37, 0x15, 0x34, 0x7b, 0x6a, 0x7d, 0xaf, 0xde, 0x50, 0xaa, 0x05, 0x89, 0x6f,
+	0xca, 0x09, 0x02, 0x2f, 0xfa, 0x06, 0x5c, 0x8f, 0xe3, 0x6f, 0xd7, 0x76, 0xcb, 0x3b, 0xb5, 0x6f,
+	0x7b, 0x5c, 0x46, 0x76, 0xf0, 0x4b, 0xc9, 0x3a, 0xba, 0x0b, 0x8b, 0x71, 0x8a, 0x72, 0xa5, 0x59,
+	0x7b, 0xa2, 0x14, 0xa6, 0x96, 0x0b, 0x27, 0xa7, 0xa5, 0x3c, 0x47, 0xf7, 0xca, 0xc4, 0x64, 0x74,
+	0xf5, 0x4a, 0x79, 0xb7, 0xa2, 0xec, 0xec, 0x28, 0xd5, 0x42, 0x3a, 0xba, 0x7a, 0xe8, 0x4f, 0x46,
+	0x28, 0xaa, 0x4c, 0x6c, 0xf5, 0xa7, 0x4a, 0xb5, 0x30, 0x1d, 0xa5, 0xa8, 0x32, 0xd9, 0xd9, 0x03,
+	0xa2, 0x2f, 0xcf, 0xfe, 0xe8, 0x8f, 0x56, 0xae, 0xfc, 0xc9, 0xcf, 0x57, 0xae, 0xdc, 0xfd, 0xef,
+	0x29, 0x40, 0xa3, 0x9f, 0x3a, 0xa0, 0xaf, 0xc2, 0x6a, 0xb9, 0xd9, 0x54, 0x6b, 0x5b, 0xfb, 0x4d,
+	0x76, 0x4b, 0xbb, 0xd5, 0x5a, 0xb3, 0x56, 0xdf, 0x1d, 0x12, 0xe6, 0xc2, 0xc9, 0x69, 0x29, 0xb7,
+	0x6f, 0xb9, 0x3d, 0xd2, 0x32, 0x0e, 0x0d, 0xa2, 0xa3, 0xd7, 0xe1, 0xe6, 0x38, 0xaa, 0x3d, 0x55,
+	0x69, 0x28, 0xbb, 0xcd, 0x82, 0xc4, 0x75, 0x61, 0xcf, 0x21, 0x2e, 0xb1, 0x58, 0xb0, 0x77, 0x63,
+	0x1c, 0xb6, 0xf2, 0xde, 0x7e, 0x79, 0xa7, 0x90, 0x5a, 0xce, 0x9e, 0x9c, 0x96, 0xa6, 0x95, 0xf7,
+	0xfb, 0xb8, 0x8b, 0x64, 0x58, 0x1a, 0x87, 0x59, 0xdb, 0x2d, 0x4c, 0x2d, 0x67, 0x4e, 0x4e, 0x4b,
+	0xa9, 0x1a, 0x2b, 0x00, 0x2e, 0x8f, 0xc3, 0xd9, 0xad, 0x37, 0x19, 0x5e, 0x9a, 0x2f, 0xb7, 0x6b,
+	0xd3, 0x9a, 0x85, 0xde, 0x82, 0xd2, 0x38, 0xd4, 0x87, 0xaa, 0x52, 0x6e, 0x32, 0xcd, 0x7b, 0x54,
+	0xde, 0x2d, 0x4c, 0xf3, 0xd3, 0x3d, 0x74, 0x08, 0xa6, 0xc4, 0x69, 0x76, 0xb0, 0x85, 0x14, 0xf8,
+	0xf2, 0x79, 0x64, 0x5a, 0x5d, 0x15, 0xfc, 0x67, 0x96, 0x97, 0x4e, 0x4e, 0x4b, 0x28, 0x42, 0x5f,
+	0x77, 0xf8, 0x61, 0x36, 0xe0, 0xf6, 0xb8, 0x65, 0x76, 0x94, 0x46, 0x83, 0x6f, 0x3d, 0xb3, 0x9c,
+	0x3f, 0x39, 0x2d, 0xcd, 0xee, 0x10, 0xd7, 0xf5, 0xf6, 0x7d, 0x07, 0x5e, 0x3d, 0x93, 0x20, 0xdc,
+	0x74, 0x96, 0xdf, 0xb6, 0x4f, 0x29, 0x76, 0xbc, 0xfb, 0x8f, 0x63, 0x9a, 0xcf, 0x9e, 0xcd, 0x7e,
+	0x00, 0xf2, 0x76, 0x5d, 0xad, 0x28, 0x55, 0xad, 0xa9, 0x96, 0x77, 0x1b, 0xdb, 0x8a, 0xaa, 0xa9,
+	0x4a, 0xb9, 0x71, 0xfe, 0x45, 0x7f, 0x6d, 0x22, 0x61, 0xa5, 0xbe, 0xaf, 0x36, 0xb5, 0xba, 0x5a,
+	0x55, 0xd4, 0x82, 0xb4, 0x3c, 0x7f, 0x72, 0x5a, 0x82, 0x8a, 0xdd, 0x77, 0x68, 0xdd, 0x61, 0x09,
+	0x56, 0x19, 0xd6, 0x26, 0xd0, 0xed, 0xd4, 0x1b, 0x4d, 0xed, 0x5d, 0xe5, 0xa9, 0xa6, 0x2a, 0x95,
+	0xfa, 0x13, 0x45, 0x7d, 0xea, 0x3f, 0xa5, 0x1d, 0xdb, 0x65, 0x1d, 0x74, 0xd6, 0x2e, 0x3f, 0x22,
+	0xce, 0x00, 0x6d, 0x4d, 0x5c, 0x42, 0x55, 0x1e, 0xee, 0xef, 0x94, 0x9b, 0x75, 0xf5, 0xa9, 0xf7,
+	0xbc, 0xea, 0x4c, 0x3b, 0x16, 0x4f, 0x4e, 0x4b, 0x05, 0x95, 0xb4, 0xfb, 0x5d, 0x66, 0x55, 0x07,
+	0xec, 0x89, 0xd9, 0x16, 0xfa, 0x6d, 0x78, 0x6d, 0xc2, 0x1a, 0x8a, 0xaa, 0xd6, 0x55, 0xad, 0x52,
+	0x57, 0x55, 0x85, 0x2f, 0x21, 0x9e, 0x9c, 0xe2, 0x38, 0xb6, 0x53, 0xb1, 0x1d, 0x87, 0xf0, 0x15,
+	0x26, 0x73, 0xa1, 0xb0, 0x37, 0xa8, 0x68, 0x0d, 0xa5, 0xd9, 0xdc, 0x51, 0x1e, 0x33, 0xb5, 0x9f,
+	0xe6, 0x5c, 0x28, 0x2e, 0xc5, 0x94, 0x34, 0x08, 0xa5, 0x5d, 0xfe, 0x7d, 0xd2, 0x57, 0xe0, 0xd6,
+	0x84, 0x35, 0xea, 0xcd, 0x47, 0x8a, 0x5a, 0xc8, 0x70, 0x9d, 0xad, 0xd3, 0x0e, 0x71, 0xb6, 0xda,
+	0x1f, 0x7d, 0xba, 0x22, 0x7d, 0xfc, 0xe9, 0x8a, 0xf4, 0x5f, 0x9f, 0xae, 0x48, 0x3f, 0xf9, 0x6c,
+	0xe5, 0xca, 0xc7, 0x9f, 0xad, 0x5c, 0xf9, 0xf7, 0xcf, 0x56, 0xae, 0xc0, 0x75, 0xc3, 0x1e, 0x5b,
+	0xb2, 0xde, 0x93, 0xbe, 0xbd, 0xd9, 0x36, 0x68, 0xa7, 0x7f, 0xb0, 0xde, 0xb2, 0xcd, 0x8d, 0x10,
+	0xe5, 0x9e, 0x61, 0x47, 0x46, 0x1b, 0xc7, 0xfe, 0x9f, 0x93, 0x58, 0xf0, 0xee, 0x1e, 0x64, 0xbc,
+	0xb6, 0xf2, 0x9b, 0xff, 0x3f, 0x00, 0x52, 0xf1, 0x3d, 0x88, 0xc4, 0x35, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxTotalSupply != that1.MaxTotalSupply {
+		return false
+	}
+	if this.EnableGovernance != that1.EnableGovernance {
+		return false
+	}
+	if this.UnrestrictedDenomRegex != that1.UnrestrictedDenomRegex {
+		return false
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if this.MaxNavHistory != that1.MaxNavHistory {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxNavHistory != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.MaxNavHistory))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.UnrestrictedDenomRegex) > 0 {
+		i -= len(m.UnrestrictedDenomRegex)
+		copy(dAtA[i:], m.UnrestrictedDenomRegex)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.UnrestrictedDenomRegex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EnableGovernance {
+		i--
+		if m.EnableGovernance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxTotalSupply != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.MaxTotalSupply))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkerAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequireDepositAccess {
+		i--
+		if m.RequireDepositAccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MarkerType != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AccessControl) > 0 {
+		for iNdEx := len(m.AccessControl) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessControl[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetAssetValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetAssetValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UpdatedBlockHeight != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.UpdatedBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Volume != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NetAssetValueRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetAssetValueRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetAssetValueRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMarker(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.NetAssetValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HolderFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HolderFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HolderFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintMarker(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Distribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Distribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SnapshotNextKey) > 0 {
+		i -= len(m.SnapshotNextKey)
+		copy(dAtA[i:], m.SnapshotNextKey)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.SnapshotNextKey)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.SnapshotPending {
+		i--
+		if m.SnapshotPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ProcessedCount != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.ProcessedCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HolderCount != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.HolderCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.EligibleSupply.Size()
+		i -= size
+		if _, err := m.EligibleSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.SnapshotHeight != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.SnapshotHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Allocated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Payout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DistributionId != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Redemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestedHeight != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.RequestedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedemptionPayoutDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionPayoutDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionPayoutDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayoutDenom) > 0 {
+		i -= len(m.PayoutDenom)
+		copy(dAtA[i:], m.PayoutDenom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.PayoutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MarkerDailyLimit.Size()
+		i -= size
+		if _, err := m.MarkerDailyLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.HolderDailyLimit.Size()
+		i -= size
+		if _, err := m.HolderDailyLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Hour != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Hour))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessGrantTerms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessGrantTerms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessGrantTerms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddresses) > 0 {
+		for iNdEx := len(m.WithdrawAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WithdrawAddresses[iNdEx])
+			copy(dAtA[i:], m.WithdrawAddresses[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.WithdrawAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.MintDailyLimit.Size()
+		i -= size
+		if _, err := m.MintDailyLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ExpiresAt != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintMarker(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessGrantMintVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessGrantMintVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessGrantMintVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Hour != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Hour))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApprovalThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApprovalThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApprovalThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Permission != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintMarker(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Approvers) > 0 {
+		for iNdEx := len(m.Approvers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvers[iNdEx])
+			copy(dAtA[i:], m.Approvers[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Approvers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RequiredApprovals != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.RequiredApprovals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Permission != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeRequirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeRequirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinValidity, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinValidity):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintMarker(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.JsonPath) > 0 {
+		i -= len(m.JsonPath)
+		copy(dAtA[i:], m.JsonPath)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.JsonPath)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Condition != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Condition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeRequirements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeRequirements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeRequirements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requirements) > 0 {
+		for iNdEx := len(m.Requirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CapTableEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CapTableEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CapTableEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Escrowed.Size()
+		i -= size
+		if _, err := m.Escrowed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Quarantined.Size()
+		i -= size
+		if _, err := m.Quarantined.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Frozen.Size()
+		i -= size
+		if _, err := m.Frozen.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Held.Size()
+		i -= size
+		if _, err := m.Held.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Spendable.Size()
+		i -= size
+		if _, err := m.Spendable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HolderSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HolderSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HolderSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.HolderCount != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.HolderCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TakenBy) > 0 {
+		i -= len(m.TakenBy)
+		copy(dAtA[i:], m.TakenBy)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.TakenBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TakenAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TakenAt):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintMarker(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForcedTransferRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForcedTransferRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForcedTransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintMarker(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x52
+	if m.Height != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Reason != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IssuanceTranche) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssuanceTranche) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssuanceTranche) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockHeight != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.UnlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UnlockTime != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.UnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UnlockTime):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintMarker(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IssuanceSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssuanceSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssuanceSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Issued.Size()
+		i -= size
+		if _, err := m.Issued.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Tranches) > 0 {
+		for iNdEx := len(m.Tranches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tranches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.HardCap.Size()
+		i -= size
+		if _, err := m.HardCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAdd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAdd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MarkerType) > 0 {
+		i -= len(m.MarkerType)
+		copy(dAtA[i:], m.MarkerType)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MarkerType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAddAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAddAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAddAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Access.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDeleteAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDeleteAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDeleteAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RemoveAddress) > 0 {
+		i -= len(m.RemoveAddress)
+		copy(dAtA[i:], m.RemoveAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RemoveAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerFinalize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerFinalize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerFinalize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerActivate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerActivate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerActivate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDelete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Coins) > 0 {
+		i -= len(m.Coins)
+		copy(dAtA[i:], m.Coins)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Coins)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSetDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetadataSymbol) > 0 {
+		i -= len(m.MetadataSymbol)
+		copy(dAtA[i:], m.MetadataSymbol)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataSymbol)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MetadataName) > 0 {
+		i -= len(m.MetadataName)
+		copy(dAtA[i:], m.MetadataName)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MetadataDenomUnits) > 0 {
+		for iNdEx := len(m.MetadataDenomUnits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetadataDenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MetadataDisplay) > 0 {
+		i -= len(m.MetadataDisplay)
+		copy(dAtA[i:], m.MetadataDisplay)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDisplay)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MetadataDescription) > 0 {
+		i -= len(m.MetadataDescription)
+		copy(dAtA[i:], m.MetadataDescription)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDescription)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MetadataBase) > 0 {
+		i -= len(m.MetadataBase)
+		copy(dAtA[i:], m.MetadataBase)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataBase)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDenomUnit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDenomUnit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aliases[iNdEx])
+			copy(dAtA[i:], m.Aliases[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Aliases[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Exponent) > 0 {
+		i -= len(m.Exponent)
+		copy(dAtA[i:], m.Exponent)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Exponent)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetNetAssetValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetNetAssetValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetNetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Volume) > 0 {
+		i -= len(m.Volume)
+		copy(dAtA[i:], m.Volume)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Volume)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxSupply) > 0 {
+		i -= len(m.MaxSupply)
+		copy(dAtA[i:], m.MaxSupply)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MaxSupply)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UnrestrictedDenomRegex) > 0 {
+		i -= len(m.UnrestrictedDenomRegex)
+		copy(dAtA[i:], m.UnrestrictedDenomRegex)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.UnrestrictedDenomRegex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EnableGovernance) > 0 {
+		i -= len(m.EnableGovernance)
+		copy(dAtA[i:], m.EnableGovernance)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.EnableGovernance)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ExpiresAt) > 0 {
+		i -= len(m.ExpiresAt)
+		copy(dAtA[i:], m.ExpiresAt)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ExpiresAt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerUnfreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerUnfreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerUnfreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HolderCount) > 0 {
+		i -= len(m.HolderCount)
+		copy(dAtA[i:], m.HolderCount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.HolderCount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SnapshotHeight) > 0 {
+		i -= len(m.SnapshotHeight)
+		copy(dAtA[i:], m.SnapshotHeight)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.SnapshotHeight)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Allocated) > 0 {
+		i -= len(m.Allocated)
+		copy(dAtA[i:], m.Allocated)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Allocated)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Payout) > 0 {
+		i -= len(m.Payout)
+		copy(dAtA[i:], m.Payout)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Payout)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DistributionId) > 0 {
+		i -= len(m.DistributionId)
+		copy(dAtA[i:], m.DistributionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.DistributionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDistributionCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDistributionCompleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDistributionCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DistributionId) > 0 {
+		i -= len(m.DistributionId)
+		copy(dAtA[i:], m.DistributionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.DistributionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDistributionClaimable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDistributionClaimable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDistributionClaimable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DistributionId) > 0 {
+		i -= len(m.DistributionId)
+		copy(dAtA[i:], m.DistributionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.DistributionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDistributionClaimed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDistributionClaimed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDistributionClaimed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DistributionId) > 0 {
+		i -= len(m.DistributionId)
+		copy(dAtA[i:], m.DistributionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.DistributionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSetRedemptionPayoutDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSetRedemptionPayoutDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSetRedemptionPayoutDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PayoutDenom) > 0 {
+		i -= len(m.PayoutDenom)
+		copy(dAtA[i:], m.PayoutDenom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.PayoutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerRedemptionRequested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerRedemptionRequested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerRedemptionRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RedemptionId) > 0 {
+		i -= len(m.RedemptionId)
+		copy(dAtA[i:], m.RedemptionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RedemptionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerRedemptionFulfilled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerRedemptionFulfilled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerRedemptionFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Payout) > 0 {
+		i -= len(m.Payout)
+		copy(dAtA[i:], m.Payout)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Payout)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RedemptionId) > 0 {
+		i -= len(m.RedemptionId)
+		copy(dAtA[i:], m.RedemptionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RedemptionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerRedemptionRejected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerRedemptionRejected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerRedemptionRejected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RedemptionId) > 0 {
+		i -= len(m.RedemptionId)
+		copy(dAtA[i:], m.RedemptionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RedemptionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSetTransferLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSetTransferLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSetTransferLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MarkerDailyLimit) > 0 {
+		i -= len(m.MarkerDailyLimit)
+		copy(dAtA[i:], m.MarkerDailyLimit)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MarkerDailyLimit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HolderDailyLimit) > 0 {
+		i -= len(m.HolderDailyLimit)
+		copy(dAtA[i:], m.HolderDailyLimit)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.HolderDailyLimit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSetAccessGrantTerms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSetAccessGrantTerms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSetAccessGrantTerms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MintDailyLimit) > 0 {
+		i -= len(m.MintDailyLimit)
+		copy(dAtA[i:], m.MintDailyLimit)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MintDailyLimit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ExpiresAt) > 0 {
+		i -= len(m.ExpiresAt)
+		copy(dAtA[i:], m.ExpiresAt)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ExpiresAt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAccessGrantExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAccessGrantExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAccessGrantExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSetApprovalThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSetApprovalThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSetApprovalThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Permission) > 0 {
+		i -= len(m.Permission)
+		copy(dAtA[i:], m.Permission)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Permission)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerOperationProposed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerOperationProposed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerOperationProposed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Permission) > 0 {
+		i -= len(m.Permission)
+		copy(dAtA[i:], m.Permission)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Permission)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperationId) > 0 {
+		i -= len(m.OperationId)
+		copy(dAtA[i:], m.OperationId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.OperationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerOperationApproved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerOperationApproved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerOperationApproved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperationId) > 0 {
+		i -= len(m.OperationId)
+		copy(dAtA[i:], m.OperationId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.OperationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerOperationExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerOperationExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerOperationExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperationId) > 0 {
+		i -= len(m.OperationId)
+		copy(dAtA[i:], m.OperationId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.OperationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerOperationCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerOperationCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerOperationCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperationId) > 0 {
+		i -= len(m.OperationId)
+		copy(dAtA[i:], m.OperationId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.OperationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerOperationExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerOperationExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerOperationExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperationId) > 0 {
+		i -= len(m.OperationId)
+		copy(dAtA[i:], m.OperationId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.OperationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSetAttributeRequirements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSetAttributeRequirements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSetAttributeRequirements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Requirements) > 0 {
+		for iNdEx := len(m.Requirements) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Requirements[iNdEx])
+			copy(dAtA[i:], m.Requirements[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Requirements[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerHolderSnapshotTaken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerHolderSnapshotTaken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerHolderSnapshotTaken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.HolderCount) > 0 {
+		i -= len(m.HolderCount)
+		copy(dAtA[i:], m.HolderCount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.HolderCount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SnapshotId) > 0 {
+		i -= len(m.SnapshotId)
+		copy(dAtA[i:], m.SnapshotId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.SnapshotId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerForcedTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerForcedTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerForcedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSetIssuanceSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSetIssuanceSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSetIssuanceSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TrancheCount) > 0 {
+		i -= len(m.TrancheCount)
+		copy(dAtA[i:], m.TrancheCount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.TrancheCount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Scheduled) > 0 {
+		i -= len(m.Scheduled)
+		copy(dAtA[i:], m.Scheduled)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Scheduled)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HardCap) > 0 {
+		i -= len(m.HardCap)
+		copy(dAtA[i:], m.HardCap)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.HardCap)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetNetAssetValueMaxAge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetNetAssetValueMaxAge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetNetAssetValueMaxAge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MaxAge) > 0 {
+		i -= len(m.MaxAge)
+		copy(dAtA[i:], m.MaxAge)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MaxAge)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNetAssetValueStale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNetAssetValueStale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNetAssetValueStale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxAge) > 0 {
+		i -= len(m.MaxAge)
+		copy(dAtA[i:], m.MaxAge)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MaxAge)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UpdatedBlockHeight) > 0 {
+		i -= len(m.UpdatedBlockHeight)
+		copy(dAtA[i:], m.UpdatedBlockHeight)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.UpdatedBlockHeight)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTotalSupply != 0 {
+		n += 1 + sovMarker(uint64(m.MaxTotalSupply))
+	}
+	if m.EnableGovernance {
+		n += 2
+	}
+	l = len(m.UnrestrictedDenomRegex)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if m.MaxNavHistory != 0 {
+		n += 1 + sovMarker(uint64(m.MaxNavHistory))
+	}
+	return n
+}
+
+func (m *MarkerAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.AccessControl) > 0 {
+		for _, e := range m.AccessControl {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovMarker(uint64(m.Status))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if m.MarkerType != 0 {
+		n += 1 + sovMarker(uint64(m.MarkerType))
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for _, s := range m.RequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	if m.RequireDepositAccess {
+		n += 2
+	}
+	return n
+}
+
+func (m *NetAssetValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if m.Volume != 0 {
+		n += 1 + sovMarker(uint64(m.Volume))
+	}
+	if m.UpdatedBlockHeight != 0 {
+		n += 1 + sovMarker(uint64(m.UpdatedBlockHeight))
+	}
+	if m.Stale {
+		n += 2
+	}
+	return n
+}
+
+func (m *NetAssetValueRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetAssetValue.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *HolderFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *Distribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarker(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.Payout.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.Allocated.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.SnapshotHeight != 0 {
+		n += 1 + sovMarker(uint64(m.SnapshotHeight))
+	}
+	l = m.EligibleSupply.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if m.HolderCount != 0 {
+		n += 1 + sovMarker(uint64(m.HolderCount))
+	}
+	if m.ProcessedCount != 0 {
+		n += 1 + sovMarker(uint64(m.ProcessedCount))
+	}
+	if m.SnapshotPending {
+		n += 2
+	}
+	l = len(m.SnapshotNextKey)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *DistributionPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovMarker(uint64(m.DistributionId))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *Redemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarker(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if m.RequestedHeight != 0 {
+		n += 1 + sovMarker(uint64(m.RequestedHeight))
+	}
+	return n
+}
+
+func (m *RedemptionPayoutDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.PayoutDenom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.HolderDailyLimit.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.MarkerDailyLimit.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *TransferVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Hour != 0 {
+		n += 1 + sovMarker(uint64(m.Hour))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *AccessGrantTerms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.MintDailyLimit.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if len(m.WithdrawAddresses) > 0 {
+		for _, s := range m.WithdrawAddresses {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccessGrantMintVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Hour != 0 {
+		n += 1 + sovMarker(uint64(m.Hour))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *ApprovalThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovMarker(uint64(m.Permission))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovMarker(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *PendingOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarker(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovMarker(uint64(m.Permission))
+	}
+	if m.RequiredApprovals != 0 {
+		n += 1 + sovMarker(uint64(m.RequiredApprovals))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Approvers) > 0 {
+		for _, s := range m.Approvers {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *AttributeRequirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Condition != 0 {
+		n += 1 + sovMarker(uint64(m.Condition))
+	}
+	l = len(m.JsonPath)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinValidity)
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *AttributeRequirements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Requirements) > 0 {
+		for _, e := range m.Requirements {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CapTableEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.Spendable.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.Held.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.Frozen.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.Quarantined.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.Escrowed.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *HolderSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarker(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMarker(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TakenAt)
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.TakenBy)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.HolderCount != 0 {
+		n += 1 + sovMarker(uint64(m.HolderCount))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if m.Pending {
+		n += 2
+	}
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *ForcedTransferRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarker(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovMarker(uint64(m.Reason))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMarker(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *IssuanceTranche) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if m.UnlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UnlockTime)
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.UnlockHeight != 0 {
+		n += 1 + sovMarker(uint64(m.UnlockHeight))
+	}
+	return n
+}
+
+func (m *IssuanceSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.HardCap.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if len(m.Tranches) > 0 {
+		for _, e := range m.Tranches {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = m.Issued.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *EventMarkerAdd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MarkerType)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerAddAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Access.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventMarkerDeleteAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RemoveAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerFinalize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerActivate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coins)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSetDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetadataBase)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataDescription)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataDisplay)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.MetadataDenomUnits) > 0 {
+		for _, e := range m.MetadataDenomUnits {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataName)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataSymbol)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDenomUnit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Exponent)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Aliases) > 0 {
+		for _, s := range m.Aliases {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSetNetAssetValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Volume)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EnableGovernance)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.UnrestrictedDenomRegex)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MaxSupply)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ExpiresAt)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerUnfreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DistributionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Payout)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Allocated)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.SnapshotHeight)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.HolderCount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerDistributionCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DistributionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerDistributionClaimable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DistributionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerDistributionClaimed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DistributionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSetRedemptionPayoutDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.PayoutDenom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerRedemptionRequested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RedemptionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerRedemptionFulfilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RedemptionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Payout)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerRedemptionRejected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RedemptionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSetTransferLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.HolderDailyLimit)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MarkerDailyLimit)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSetAccessGrantTerms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ExpiresAt)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MintDailyLimit)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerAccessGrantExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSetApprovalThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Permission)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerOperationProposed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperationId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Permission)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerOperationApproved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperationId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerOperationExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperationId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerOperationCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperationId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerOperationExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperationId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSetAttributeRequirements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Requirements) > 0 {
+		for _, s := range m.Requirements {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerHolderSnapshotTaken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SnapshotId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.HolderCount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerForcedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSetIssuanceSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.HardCap)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Scheduled)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.TrancheCount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetNetAssetValueMaxAge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MaxAge)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNetAssetValueStale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.UpdatedBlockHeight)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MaxAge)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func sovMarker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMarker(x uint64) (n int) {
+	return sovMarker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSupply", wireType)
+			}
+			m.MaxTotalSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovernance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableGovernance = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedDenomRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnrestrictedDenomRegex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNavHistory", wireType)
+			}
+			m.MaxNavHistory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNavHistory |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkerAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkerAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &types.BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessControl = append(m.AccessControl, AccessGrant{})
+			if err := m.AccessControl[len(m.AccessControl)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForcedTransfer = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireDepositAccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireDepositAccess = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetAssetValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
+			}
+			m.UpdatedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetAssetValueRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetAssetValueRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetAssetValueRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAssetValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HolderFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HolderFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HolderFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Distribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotHeight", wireType)
+			}
+			m.SnapshotHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibleSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EligibleSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderCount", wireType)
+			}
+			m.HolderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HolderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedCount", wireType)
+			}
+			m.ProcessedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SnapshotPending = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotNextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotNextKey = append(m.SnapshotNextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SnapshotNextKey == nil {
+				m.SnapshotNextKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			m.DistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedHeight", wireType)
+			}
+			m.RequestedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedemptionPayoutDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionPayoutDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionPayoutDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderDailyLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HolderDailyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerDailyLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkerDailyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hour", wireType)
+			}
+			m.Hour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hour |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessGrantTerms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessGrantTerms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessGrantTerms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDailyLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintDailyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddresses = append(m.WithdrawAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessGrantMintVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessGrantMintVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessGrantMintVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hour", wireType)
+			}
+			m.Hour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hour |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApprovalThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApprovalThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApprovalThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= Access(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= Access(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredApprovals", wireType)
+			}
+			m.RequiredApprovals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredApprovals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types2.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvers = append(m.Approvers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeRequirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeRequirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			m.Condition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Condition |= AttributeCondition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinValidity, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeRequirements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeRequirements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeRequirements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requirements = append(m.Requirements, AttributeRequirement{})
+			if err := m.Requirements[len(m.Requirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CapTableEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CapTableEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CapTableEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spendable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spendable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Held", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Held.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Frozen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quarantined", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quarantined.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HolderSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HolderSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HolderSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakenAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.TakenAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakenBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakenBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderCount", wireType)
+			}
+			m.HolderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HolderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForcedTransferRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForcedTransferRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForcedTransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= ForcedTransferReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IssuanceTranche) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IssuanceTranche: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IssuanceTranche: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnlockTime == nil {
+				m.UnlockTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockHeight", wireType)
+			}
+			m.UnlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IssuanceSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IssuanceSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IssuanceSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HardCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tranches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tranches = append(m.Tranches, IssuanceTranche{})
+			if err := m.Tranches[len(m.Tranches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Issued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAdd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAddAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAddAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Access.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDeleteAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDeleteAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerFinalize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerFinalize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerActivate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerActivate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerCancel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDelete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerSetDenomMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataBase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDescription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDisplay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataDisplay = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDenomUnits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataDenomUnits = append(m.MetadataDenomUnits, &EventDenomUnit{})
+			if err := m.MetadataDenomUnits[len(m.MetadataDenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSymbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataSymbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDenomUnit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exponent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetNetAssetValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetNetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerParamsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerParamsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovernance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnableGovernance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedDenomRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnrestrictedDenomRegex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerUnfreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerUnfreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocated = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDistributionCompleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDistributionCompleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDistributionCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDistributionClaimable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDistributionClaimable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDistributionClaimable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDistributionClaimed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDistributionClaimed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDistributionClaimed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerSetRedemptionPayoutDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerSetRedemptionPayoutDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerSetRedemptionPayoutDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerRedemptionRequested) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2968,17 +18336,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerRedemptionRequested: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerRedemptionRequested: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSupply", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
 			}
-			m.MaxTotalSupply = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2988,16 +18356,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTotalSupply |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovernance", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3007,15 +18388,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableGovernance = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedDenomRegex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3043,11 +18436,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnrestrictedDenomRegex = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3075,9 +18468,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3100,7 +18491,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerRedemptionFulfilled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3123,17 +18514,17 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarkerAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerRedemptionFulfilled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarkerAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerRedemptionFulfilled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3143,31 +18534,27 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAccount == nil {
-				m.BaseAccount = &types.BaseAccount{}
-			}
-			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RedemptionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3195,13 +18582,13 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessControl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3211,31 +18598,29 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessControl = append(m.AccessControl, AccessGrant{})
-			if err := m.AccessControl[len(m.AccessControl)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3245,14 +18630,27 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= MarkerStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3280,11 +18678,11 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Payout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3312,54 +18710,63 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
 			}
-			m.MarkerType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarkerType |= MarkerType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerRedemptionRejected) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SupplyFixed = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var v int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerRedemptionRejected: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerRedemptionRejected: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3369,35 +18776,27 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowGovernanceControl = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
 			}
-			m.AllowForcedTransfer = bool(v != 0)
-		case 11:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3425,13 +18824,13 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequireDepositAccess", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3441,67 +18840,29 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			m.RequireDepositAccess = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMarker
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NetAssetValue: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3511,30 +18872,29 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
-			m.Volume = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3544,16 +18904,29 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
 			}
-			m.UpdatedBlockHeight = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3563,11 +18936,24 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedBlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarker(dAtA[iNdEx:])
@@ -3589,7 +18975,7 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerSetTransferLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3612,10 +18998,10 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerAdd: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerSetTransferLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerSetTransferLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3652,7 +19038,7 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderDailyLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3680,11 +19066,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.HolderDailyLimit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerDailyLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3712,11 +19098,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.MarkerDailyLimit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3744,11 +19130,61 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerSetAccessGrantTerms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerSetAccessGrantTerms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerSetAccessGrantTerms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3776,9 +19212,9 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarkerType = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -3810,61 +19246,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMarker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMarker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerAddAccess: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerAddAccess: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3874,28 +19260,27 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Access.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDailyLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3923,9 +19308,9 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.MintDailyLimit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -3978,7 +19363,7 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerAccessGrantExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4001,15 +19386,15 @@ func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerAccess: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerAccessGrantExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerAccessGrantExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4037,11 +19422,11 @@ func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4069,7 +19454,7 @@ func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4092,7 +19477,7 @@ func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerSetApprovalThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4115,15 +19500,15 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerDeleteAccess: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerSetApprovalThreshold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerDeleteAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerSetApprovalThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4151,11 +19536,11 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4183,9 +19568,41 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Permission = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -4238,7 +19655,7 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerOperationProposed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4261,15 +19678,15 @@ func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerFinalize: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerOperationProposed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerFinalize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerOperationProposed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4297,11 +19714,11 @@ func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.OperationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4329,61 +19746,43 @@ func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMarker
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMarker
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerActivate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerActivate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Permission = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4411,11 +19810,11 @@ func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4443,7 +19842,7 @@ func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4466,7 +19865,7 @@ func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerOperationApproved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4489,13 +19888,45 @@ func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerCancel: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerOperationApproved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerOperationApproved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -4527,9 +19958,9 @@ func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4557,7 +19988,7 @@ func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Approver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4580,7 +20011,7 @@ func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerOperationExecuted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4603,15 +20034,15 @@ func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerDelete: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerOperationExecuted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerOperationExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4639,11 +20070,11 @@ func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.OperationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4671,7 +20102,7 @@ func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4694,7 +20125,7 @@ func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerOperationCancelled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4717,15 +20148,15 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerOperationCancelled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerOperationCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4753,7 +20184,7 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.OperationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4789,7 +20220,7 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4817,7 +20248,7 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4840,7 +20271,7 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerOperationExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4863,15 +20294,15 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerOperationExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerOperationExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4899,7 +20330,7 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.OperationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4933,38 +20364,6 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarker(dAtA[iNdEx:])
@@ -4986,7 +20385,7 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerSetAttributeRequirements) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5005,49 +20404,17 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerWithdraw: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Coins = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerSetAttributeRequirements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerSetAttributeRequirements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -5079,9 +20446,9 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requirements", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5109,11 +20476,11 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Requirements = append(m.Requirements, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5141,7 +20508,7 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5164,7 +20531,7 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerHolderSnapshotTaken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5187,15 +20554,15 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerHolderSnapshotTaken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerHolderSnapshotTaken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5223,7 +20590,7 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.SnapshotId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5259,7 +20626,7 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5287,11 +20654,11 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Height = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderCount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5319,11 +20686,11 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.HolderCount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5351,7 +20718,7 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5374,7 +20741,7 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerForcedTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5397,15 +20764,15 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerSetDenomMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerForcedTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerForcedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5433,11 +20800,11 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataBase = string(dAtA[iNdEx:postIndex])
+			m.RecordId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDescription", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5465,11 +20832,11 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataDescription = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDisplay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5497,13 +20864,13 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataDisplay = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDenomUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -5513,29 +20880,27 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataDenomUnits = append(m.MetadataDenomUnits, &EventDenomUnit{})
-			if err := m.MetadataDenomUnits[len(m.MetadataDenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5563,11 +20928,11 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5595,11 +20960,11 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataName = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSymbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5627,7 +20992,39 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataSymbol = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5650,7 +21047,7 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerSetIssuanceSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5673,10 +21070,10 @@ func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDenomUnit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerSetIssuanceSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerSetIssuanceSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5713,7 +21110,7 @@ func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HardCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5741,11 +21138,11 @@ func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exponent = string(dAtA[iNdEx:postIndex])
+			m.HardCap = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduled", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5773,7 +21170,71 @@ func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			m.Scheduled = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5796,7 +21257,7 @@ func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
+func (m *EventSetNetAssetValueMaxAge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5819,10 +21280,10 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetNetAssetValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetNetAssetValueMaxAge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetNetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetNetAssetValueMaxAge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5859,7 +21320,7 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5887,43 +21348,11 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.MaxAge = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Volume = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5951,7 +21380,7 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5974,7 +21403,7 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerParamsUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventNetAssetValueStale) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5997,15 +21426,15 @@ func (m *EventMarkerParamsUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerParamsUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNetAssetValueStale: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNetAssetValueStale: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovernance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6033,11 +21462,11 @@ func (m *EventMarkerParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnableGovernance = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedDenomRegex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6065,11 +21494,11 @@ func (m *EventMarkerParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnrestrictedDenomRegex = string(dAtA[iNdEx:postIndex])
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6097,7 +21526,39 @@ func (m *EventMarkerParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxSupply = string(dAtA[iNdEx:postIndex])
+			m.UpdatedBlockHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAge = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3,12 +3,14 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	sdkmath "cosmossdk.io/math"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -498,6 +500,448 @@ func TestNetAssetValueValidate(t *testing.T) {
 	}
 }
 
+func TestNetAssetValueRecordValidate(t *testing.T) {
+	blockTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name   string
+		record NetAssetValueRecord
+		expErr string
+	}{
+		{
+			name: "invalid net asset value",
+			record: NetAssetValueRecord{
+				NetAssetValue: NetAssetValue{Price: sdk.NewInt64Coin("jackthecat", 420)},
+				BlockTime:     blockTime,
+			},
+			expErr: "invalid net asset value record: marker net asset value volume must be positive value",
+		},
+		{
+			name: "successful",
+			record: NetAssetValueRecord{
+				NetAssetValue: NewNetAssetValue(sdk.NewInt64Coin("jackthecat", 420), 406),
+				BlockTime:     blockTime,
+				Source:        "exchange",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.record.Validate()
+			if len(tt.expErr) > 0 {
+				assert.EqualError(t, err, tt.expErr, "NetAssetValueRecord validate expected error")
+			} else {
+				assert.NoError(t, err, "NetAssetValueRecord validate should have passed")
+			}
+		})
+	}
+}
+
+func TestHolderFreezeValidate(t *testing.T) {
+	holder := sdk.AccAddress("holder______________").String()
+	admin := sdk.AccAddress("admin_______________").String()
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	newFreeze := func(modify func(f *HolderFreeze)) HolderFreeze {
+		rv := HolderFreeze{
+			Denom:         "frozencoin",
+			Holder:        holder,
+			Amount:        sdkmath.NewInt(10),
+			ExpiresAt:     expiresAt,
+			Reason:        "court order",
+			Administrator: admin,
+		}
+		if modify != nil {
+			modify(&rv)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name   string
+		freeze HolderFreeze
+		expErr string
+	}{
+		{
+			name:   "invalid denom",
+			freeze: newFreeze(func(f *HolderFreeze) { f.Denom = "x" }),
+			expErr: "invalid holder freeze denom: invalid denom: x",
+		},
+		{
+			name:   "invalid holder",
+			freeze: newFreeze(func(f *HolderFreeze) { f.Holder = "" }),
+			expErr: "invalid holder freeze holder \"\": empty address string is not allowed",
+		},
+		{
+			name:   "nil amount",
+			freeze: newFreeze(func(f *HolderFreeze) { f.Amount = sdkmath.Int{} }),
+			expErr: "invalid holder freeze amount \"<nil>\": must be positive",
+		},
+		{
+			name:   "zero amount",
+			freeze: newFreeze(func(f *HolderFreeze) { f.Amount = sdkmath.ZeroInt() }),
+			expErr: "invalid holder freeze amount \"0\": must be positive",
+		},
+		{
+			name:   "zero expires at",
+			freeze: newFreeze(func(f *HolderFreeze) { f.ExpiresAt = time.Time{} }),
+			expErr: "invalid holder freeze expires at: cannot be zero",
+		},
+		{
+			name:   "empty reason",
+			freeze: newFreeze(func(f *HolderFreeze) { f.Reason = "" }),
+			expErr: "invalid holder freeze reason: cannot be empty",
+		},
+		{
+			name:   "invalid administrator",
+			freeze: newFreeze(func(f *HolderFreeze) { f.Administrator = "bad" }),
+			expErr: "invalid holder freeze administrator \"bad\": decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "valid",
+			freeze: newFreeze(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.freeze.Validate()
+			if len(tt.expErr) > 0 {
+				assert.EqualError(t, err, tt.expErr, "HolderFreeze Validate")
+			} else {
+				assert.NoError(t, err, "HolderFreeze Validate")
+			}
+		})
+	}
+}
+
+func TestDistributionValidate(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+	newDist := func(modify func(d *Distribution)) Distribution {
+		rv := Distribution{
+			Id:             1,
+			Denom:          "fundcoin",
+			Payout:         sdk.NewInt64Coin("usd", 1000),
+			Allocated:      sdk.NewInt64Coin("usd", 999),
+			Administrator:  admin,
+			SnapshotHeight: 10,
+			EligibleSupply: sdkmath.NewInt(300),
+			HolderCount:    3,
+			ProcessedCount: 1,
+		}
+		if modify != nil {
+			modify(&rv)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name   string
+		dist   Distribution
+		expErr string
+	}{
+		{
+			name:   "zero id",
+			dist:   newDist(func(d *Distribution) { d.Id = 0 }),
+			expErr: "invalid distribution id: cannot be zero",
+		},
+		{
+			name:   "invalid denom",
+			dist:   newDist(func(d *Distribution) { d.Denom = "x" }),
+			expErr: "invalid distribution 1 denom: invalid denom: x",
+		},
+		{
+			name:   "invalid payout",
+			dist:   newDist(func(d *Distribution) { d.Payout = sdk.Coin{Denom: "usd", Amount: sdkmath.NewInt(-1)} }),
+			expErr: "invalid distribution 1 payout: negative coin amount: -1",
+		},
+		{
+			name:   "allocated more than payout",
+			dist:   newDist(func(d *Distribution) { d.Allocated = sdk.NewInt64Coin("usd", 1001) }),
+			expErr: "invalid distribution 1 allocated \"1001usd\": must not exceed payout \"1000usd\"",
+		},
+		{
+			name:   "allocated in other denom",
+			dist:   newDist(func(d *Distribution) { d.Allocated = sdk.NewInt64Coin("eur", 5) }),
+			expErr: "invalid distribution 1 allocated \"5eur\": must not exceed payout \"1000usd\"",
+		},
+		{
+			name:   "invalid administrator",
+			dist:   newDist(func(d *Distribution) { d.Administrator = "bad" }),
+			expErr: "invalid distribution 1 administrator \"bad\": decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "zero eligible supply",
+			dist:   newDist(func(d *Distribution) { d.EligibleSupply = sdkmath.ZeroInt() }),
+			expErr: "invalid distribution 1 eligible supply \"0\": must be positive",
+		},
+		{
+			name:   "processed more than holders",
+			dist:   newDist(func(d *Distribution) { d.ProcessedCount = 4 }),
+			expErr: "invalid distribution 1 processed count 4: cannot exceed holder count 3",
+		},
+		{
+			name:   "next key without pending snapshot",
+			dist:   newDist(func(d *Distribution) { d.SnapshotNextKey = []byte{1} }),
+			expErr: "invalid distribution 1 snapshot next key: must be empty when the snapshot is not pending",
+		},
+		{
+			name: "valid",
+			dist: newDist(nil),
+		},
+		{
+			name: "valid with pending snapshot",
+			dist: newDist(func(d *Distribution) {
+				d.SnapshotPending = true
+				d.SnapshotNextKey = []byte{1}
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.dist.Validate()
+			if len(tt.expErr) > 0 {
+				assert.EqualError(t, err, tt.expErr, "Distribution Validate")
+			} else {
+				assert.NoError(t, err, "Distribution Validate")
+			}
+		})
+	}
+}
+
+func TestDistributionPaymentValidate(t *testing.T) {
+	holder := sdk.AccAddress("holder______________").String()
+
+	tests := []struct {
+		name    string
+		payment DistributionPayment
+		expErr  string
+	}{
+		{
+			name:    "zero distribution id",
+			payment: DistributionPayment{Holder: holder, Amount: sdk.NewInt64Coin("usd", 5)},
+			expErr:  "invalid distribution payment distribution id: cannot be zero",
+		},
+		{
+			name:    "invalid holder",
+			payment: DistributionPayment{DistributionId: 1, Holder: "", Amount: sdk.NewInt64Coin("usd", 5)},
+			expErr:  "invalid distribution payment holder \"\": empty address string is not allowed",
+		},
+		{
+			name:    "invalid amount",
+			payment: DistributionPayment{DistributionId: 1, Holder: holder, Amount: sdk.Coin{Denom: "x", Amount: sdkmath.NewInt(5)}},
+			expErr:  "invalid distribution payment amount: invalid denom: x",
+		},
+		{
+			name:    "negative amount",
+			payment: DistributionPayment{DistributionId: 1, Holder: holder, Amount: sdk.Coin{Denom: "usd", Amount: sdkmath.NewInt(-1)}},
+			expErr:  "invalid distribution payment amount: negative coin amount: -1",
+		},
+		{
+			name:    "zero amount",
+			payment: DistributionPayment{DistributionId: 1, Holder: holder, Amount: sdk.NewInt64Coin("usd", 0)},
+		},
+		{
+			name:    "valid",
+			payment: DistributionPayment{DistributionId: 1, Holder: holder, Amount: sdk.NewInt64Coin("usd", 5)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.payment.Validate()
+			if len(tt.expErr) > 0 {
+				assert.EqualError(t, err, tt.expErr, "DistributionPayment Validate")
+			} else {
+				assert.NoError(t, err, "DistributionPayment Validate")
+			}
+		})
+	}
+}
+
+func TestRedemptionValidate(t *testing.T) {
+	holder := sdk.AccAddress("holder______________").String()
+	amount := sdk.NewInt64Coin("fundcoin", 5)
+
+	tests := []struct {
+		name       string
+		redemption Redemption
+		expErr     string
+	}{
+		{
+			name:       "zero id",
+			redemption: Redemption{Denom: "fundcoin", Holder: holder, Amount: amount},
+			expErr:     "invalid redemption id: cannot be zero",
+		},
+		{
+			name:       "invalid holder",
+			redemption: Redemption{Id: 1, Denom: "fundcoin", Holder: "", Amount: amount},
+			expErr:     "invalid redemption 1 holder \"\": empty address string is not allowed",
+		},
+		{
+			name:       "amount in other denom",
+			redemption: Redemption{Id: 1, Denom: "fundcoin", Holder: holder, Amount: sdk.NewInt64Coin("usd", 5)},
+			expErr:     "invalid redemption 1 amount \"5usd\": must be a positive amount of fundcoin",
+		},
+		{
+			name:       "zero amount",
+			redemption: Redemption{Id: 1, Denom: "fundcoin", Holder: holder, Amount: sdk.NewInt64Coin("fundcoin", 0)},
+			expErr:     "invalid redemption 1 amount \"0fundcoin\": must be a positive amount of fundcoin",
+		},
+		{
+			name:       "valid",
+			redemption: Redemption{Id: 1, Denom: "fundcoin", Holder: holder, Amount: amount, RequestedHeight: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.redemption.Validate()
+			if len(tt.expErr) > 0 {
+				assert.EqualError(t, err, tt.expErr, "Redemption Validate")
+			} else {
+				assert.NoError(t, err, "Redemption Validate")
+			}
+		})
+	}
+}
+
+func TestAccessGrantTerms(t *testing.T) {
+	grantee := sdk.AccAddress("grantee_____________").String()
+	allowed := sdk.AccAddress("allowed_____________")
+	other := sdk.AccAddress("other_______________")
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	zeroTime := time.Time{}
+
+	terms := AccessGrantTerms{Denom: "grantcoin", Address: grantee, MintDailyLimit: sdkmath.ZeroInt()}
+	require.NoError(t, terms.Validate(), "Validate without terms")
+	assert.False(t, terms.HasTerms(), "HasTerms without terms")
+	assert.False(t, terms.IsExpired(now), "IsExpired without expiry")
+	assert.True(t, terms.AllowsWithdrawTo(other), "AllowsWithdrawTo without withdraw addresses")
+
+	terms.ExpiresAt = &now
+	assert.True(t, terms.HasTerms(), "HasTerms with expiry")
+	assert.True(t, terms.IsExpired(now), "IsExpired at expiry")
+	assert.False(t, terms.IsExpired(now.Add(-time.Second)), "IsExpired before expiry")
+
+	terms.ExpiresAt = &zeroTime
+	assert.EqualError(t, terms.Validate(), "invalid grantcoin access grant terms for "+grantee+": expires at cannot be the zero time", "Validate with zero expiry")
+
+	terms.ExpiresAt = nil
+	terms.WithdrawAddresses = []string{allowed.String()}
+	require.NoError(t, terms.Validate(), "Validate with withdraw addresses")
+	assert.True(t, terms.HasTerms(), "HasTerms with withdraw addresses")
+	assert.True(t, terms.AllowsWithdrawTo(allowed), "AllowsWithdrawTo allowed address")
+	assert.False(t, terms.AllowsWithdrawTo(other), "AllowsWithdrawTo other address")
+
+	terms.WithdrawAddresses = []string{"bad"}
+	assert.EqualError(t, terms.Validate(), "invalid grantcoin access grant terms for "+grantee+": invalid withdraw address \"bad\": decoding bech32 failed: invalid bech32 string length 3", "Validate with bad withdraw address")
+
+	volume := AccessGrantMintVolume{Denom: "grantcoin", Address: grantee, Hour: 5, Amount: sdkmath.ZeroInt()}
+	assert.EqualError(t, volume.Validate(), "invalid grantcoin access grant mint volume amount \"0\": must be positive", "AccessGrantMintVolume Validate")
+}
+
+func TestApprovalThresholdAndPendingOperation(t *testing.T) {
+	threshold := ApprovalThreshold{Denom: "approvecoin", Permission: Access_Burn, Threshold: 2}
+	assert.EqualError(t, threshold.Validate(), "invalid approvecoin approval threshold permission ACCESS_BURN: must be one of ACCESS_MINT, ACCESS_WITHDRAW or ACCESS_FORCE_TRANSFER", "Validate burn threshold")
+	threshold.Permission = Access_Mint
+	threshold.Threshold = 1
+	assert.EqualError(t, threshold.Validate(), "invalid approvecoin ACCESS_MINT approval threshold 1: must be at least 2", "Validate threshold of 1")
+	threshold.Threshold = 2
+	require.NoError(t, threshold.Validate(), "Validate valid threshold")
+
+	proposer := sdk.AccAddress("proposer____________").String()
+	approver := sdk.AccAddress("approver____________").String()
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	op := PendingOperation{
+		Id:                1,
+		Denom:             "approvecoin",
+		Permission:        Access_Withdraw,
+		RequiredApprovals: 2,
+		Msg:               &codectypes.Any{TypeUrl: "/provenance.marker.v1.MsgWithdrawRequest"},
+		Approvers:         []string{proposer},
+		ExpiresAt:         now,
+	}
+	require.NoError(t, op.Validate(), "Validate valid operation")
+	assert.True(t, op.HasApproved(proposer), "HasApproved proposer")
+	assert.False(t, op.HasApproved(approver), "HasApproved other approver")
+	assert.True(t, op.IsExpired(now), "IsExpired at expiry")
+	assert.False(t, op.IsExpired(now.Add(-time.Second)), "IsExpired before expiry")
+
+	op.Approvers = append(op.Approvers, approver)
+	assert.EqualError(t, op.Validate(), "invalid pending operation 1: has 2 approvals but only needs 2", "Validate with enough approvals")
+	op.RequiredApprovals = 3
+	op.Approvers = []string{proposer, proposer}
+	assert.EqualError(t, op.Validate(), "invalid pending operation 1: duplicate approver "+proposer, "Validate with duplicate approver")
+	op.Approvers = []string{proposer}
+	op.Msg = nil
+	assert.EqualError(t, op.Validate(), "invalid pending operation 1: msg cannot be empty", "Validate without msg")
+}
+
+func TestCapTableEntryAndHolderSnapshot(t *testing.T) {
+	holder := sdk.AccAddress("holder______________")
+	entry := NewCapTableEntry(holder)
+	assert.EqualError(t, entry.Validate(), "invalid "+holder.String()+" cap table entry total \"0\": must be positive", "Validate new entry")
+	entry.Balance = sdkmath.NewInt(10)
+	entry.Escrowed = sdkmath.NewInt(5)
+	entry.Total = sdkmath.NewInt(10)
+	assert.EqualError(t, entry.Validate(), "invalid "+holder.String()+" cap table entry total \"10\": must equal the balance \"10\" plus the quarantined \"0\" and escrowed \"5\" amounts", "Validate with wrong total")
+	entry.Total = sdkmath.NewInt(15)
+	require.NoError(t, entry.Validate(), "Validate valid entry")
+	entry.Held = sdkmath.NewInt(-1)
+	assert.EqualError(t, entry.Validate(), "invalid "+holder.String()+" cap table entry held \"-1\": cannot be negative", "Validate with negative held")
+	entry.Held = sdkmath.Int{}
+	assert.EqualError(t, entry.Validate(), "invalid "+holder.String()+" cap table entry held \"<nil>\": cannot be negative", "Validate with nil held")
+
+	snapshot := HolderSnapshot{Id: 1, Denom: "capcoin", Height: 5, TakenBy: holder.String(), HolderCount: 1, Supply: sdkmath.NewInt(15)}
+	require.NoError(t, snapshot.Validate(), "Validate valid snapshot")
+	snapshot.NextKey = []byte{1}
+	assert.EqualError(t, snapshot.Validate(), "invalid holder snapshot 1 next key: must be empty when the snapshot is not pending", "Validate with next key")
+	snapshot.Pending = true
+	require.NoError(t, snapshot.Validate(), "Validate pending snapshot")
+	snapshot.TakenBy = ""
+	assert.EqualError(t, snapshot.Validate(), "invalid holder snapshot 1 taken by \"\": empty address string is not allowed", "Validate without taken by")
+	snapshot.TakenBy = holder.String()
+	snapshot.Supply = sdkmath.Int{}
+	assert.EqualError(t, snapshot.Validate(), "invalid holder snapshot 1 supply \"<nil>\": cannot be negative", "Validate without supply")
+	snapshot.Id = 0
+	assert.EqualError(t, snapshot.Validate(), "invalid holder snapshot id: cannot be zero", "Validate with zero id")
+}
+
+func TestForcedTransferReasonAndRecord(t *testing.T) {
+	reason, err := ForcedTransferReasonByName("court_order")
+	require.NoError(t, err, "ForcedTransferReasonByName short name")
+	assert.Equal(t, ForcedTransferReason_CourtOrder, reason, "ForcedTransferReasonByName short name")
+	reason, err = ForcedTransferReasonByName("FORCED_TRANSFER_REASON_ESTATE_SETTLEMENT")
+	require.NoError(t, err, "ForcedTransferReasonByName full name")
+	assert.Equal(t, ForcedTransferReason_EstateSettlement, reason, "ForcedTransferReasonByName full name")
+	_, err = ForcedTransferReasonByName("unspecified")
+	assert.EqualError(t, err, "unknown forced transfer reason \"FORCED_TRANSFER_REASON_UNSPECIFIED\"", "ForcedTransferReasonByName unspecified")
+	_, err = ForcedTransferReasonByName("whim")
+	assert.EqualError(t, err, "unknown forced transfer reason \"FORCED_TRANSFER_REASON_WHIM\"", "ForcedTransferReasonByName unknown")
+
+	from := sdk.AccAddress("from________________")
+	to := sdk.AccAddress("to__________________")
+	admin := sdk.AccAddress("admin_______________")
+	record := ForcedTransferRecord{
+		Id:            1,
+		Denom:         "restrictedcoin",
+		FromAddress:   from.String(),
+		ToAddress:     to.String(),
+		Amount:        sdkmath.NewInt(10),
+		Administrator: admin.String(),
+		Reason:        ForcedTransferReason_CourtOrder,
+		Reference:     "Case 24-CV-1234",
+		Height:        5,
+	}
+	require.NoError(t, record.Validate(), "Validate valid record")
+	record.Reference = " "
+	assert.EqualError(t, record.Validate(), "invalid forced transfer record 1 reference: cannot be empty", "Validate without reference")
+	record.Reason = ForcedTransferReason_Unspecified
+	assert.EqualError(t, record.Validate(), "invalid forced transfer record 1 reason: cannot be unspecified", "Validate without reason")
+	record.Amount = sdkmath.ZeroInt()
+	assert.EqualError(t, record.Validate(), "invalid forced transfer record 1 amount \"0\": must be positive", "Validate with zero amount")
+	record.FromAddress = ""
+	assert.EqualError(t, record.Validate(), "invalid forced transfer record 1 from address \"\": empty address string is not allowed", "Validate without from address")
+	record.Id = 0
+	assert.EqualError(t, record.Validate(), "invalid forced transfer record id: cannot be zero", "Validate with zero id")
+}
+
 func TestHasAccess(t *testing.T) {
 	addrAll := sdk.AccAddress("addrAll_____________")
 	addrAllButWithdraw := sdk.AccAddress("addrAllButWithdraw__")
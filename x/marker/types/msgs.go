@@ -3,6 +3,8 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	feegranttypes "cosmossdk.io/x/feegrant"
@@ -50,6 +52,23 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgWithdrawEscrowProposalRequest)(nil),
 	(*MsgSetDenomMetadataProposalRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgFreezeHolderRequest)(nil),
+	(*MsgUnfreezeHolderRequest)(nil),
+	(*MsgDistributeToHoldersRequest)(nil),
+	(*MsgClaimDistributionRequest)(nil),
+	(*MsgSetRedemptionPayoutDenomRequest)(nil),
+	(*MsgRequestRedemptionRequest)(nil),
+	(*MsgFulfillRedemptionRequest)(nil),
+	(*MsgRejectRedemptionRequest)(nil),
+	(*MsgSetTransferLimitsRequest)(nil),
+	(*MsgSetAccessGrantTermsRequest)(nil),
+	(*MsgSetApprovalThresholdRequest)(nil),
+	(*MsgApproveOperationRequest)(nil),
+	(*MsgCancelOperationRequest)(nil),
+	(*MsgSetAttributeRequirementsRequest)(nil),
+	(*MsgTakeHolderSnapshotRequest)(nil),
+	(*MsgSetIssuanceScheduleRequest)(nil),
+	(*MsgSetNetAssetValueMaxAgeRequest)(nil),
 }
 
 func NewMsgFinalizeRequest(denom string, admin sdk.AccAddress) *MsgFinalizeRequest {
@@ -293,9 +312,26 @@ func (msg MsgTransferRequest) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
 		return err
 	}
+	if err := msg.Reason.Validate(); err != nil {
+		return err
+	}
+	hasReference := len(strings.TrimSpace(msg.Reference)) > 0
+	if msg.Reason != ForcedTransferReason_Unspecified && !hasReference {
+		return fmt.Errorf("a reference is required with forced transfer reason %s", msg.Reason)
+	}
+	if msg.Reason == ForcedTransferReason_Unspecified && hasReference {
+		return errors.New("a forced transfer reason is required with a reference")
+	}
 	return msg.Amount.Validate()
 }
 
+// WithJustification sets the reason and reference that are required when the transfer is a forced transfer.
+func (msg *MsgTransferRequest) WithJustification(reason ForcedTransferReason, reference string) *MsgTransferRequest {
+	msg.Reason = reason
+	msg.Reference = reference
+	return msg
+}
+
 func NewMsgIbcTransferRequest(
 	administrator string,
 	sourcePort,
@@ -666,6 +702,10 @@ func (msg MsgAddNetAssetValuesRequest) ValidateBasic() error {
 			return fmt.Errorf("marker net asset value must not have update height set")
 		}
 
+		if nav.Stale {
+			return fmt.Errorf("marker net asset value must not have stale set")
+		}
+
 		if seen[nav.Price.Denom] {
 			return fmt.Errorf("list of net asset values contains duplicates")
 		}
@@ -796,6 +836,7 @@ func NewMsgUpdateParamsRequest(
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
 	maxSupply sdkmath.Int,
+	maxNavHistory uint32,
 	authority string,
 ) *MsgUpdateParamsRequest {
 	return &MsgUpdateParamsRequest{
@@ -804,6 +845,7 @@ func NewMsgUpdateParamsRequest(
 			enableGovernance,
 			unrestrictedDenomRegex,
 			maxSupply,
+			maxNavHistory,
 		),
 	}
 }
@@ -815,3 +857,408 @@ func (msg MsgUpdateParamsRequest) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Authority)
 	return err
 }
+
+// NewMsgFreezeHolderRequest creates a new MsgFreezeHolderRequest.
+func NewMsgFreezeHolderRequest(amount sdk.Coin, admin, holder sdk.AccAddress, expiresAt time.Time, reason string) *MsgFreezeHolderRequest {
+	return &MsgFreezeHolderRequest{
+		Amount:        amount,
+		Administrator: admin.String(),
+		Holder:        holder.String(),
+		ExpiresAt:     expiresAt,
+		Reason:        reason,
+	}
+}
+
+func (msg MsgFreezeHolderRequest) ValidateBasic() error {
+	if err := msg.Amount.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid amount: %v", err)
+	}
+	if !msg.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap("amount must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid administrator: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder: %v", err)
+	}
+	if msg.ExpiresAt.IsZero() {
+		return sdkerrors.ErrInvalidRequest.Wrap("expires at cannot be zero")
+	}
+	if len(strings.TrimSpace(msg.Reason)) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("reason cannot be empty")
+	}
+	return nil
+}
+
+// NewMsgUnfreezeHolderRequest creates a new MsgUnfreezeHolderRequest.
+func NewMsgUnfreezeHolderRequest(denom string, admin, holder sdk.AccAddress) *MsgUnfreezeHolderRequest {
+	return &MsgUnfreezeHolderRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Holder:        holder.String(),
+	}
+}
+
+func (msg MsgUnfreezeHolderRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid administrator: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder: %v", err)
+	}
+	return nil
+}
+
+// NewMsgDistributeToHoldersRequest creates a new MsgDistributeToHoldersRequest.
+func NewMsgDistributeToHoldersRequest(denom string, admin sdk.AccAddress, payout sdk.Coin) *MsgDistributeToHoldersRequest {
+	return &MsgDistributeToHoldersRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Payout:        payout,
+	}
+}
+
+func (msg MsgDistributeToHoldersRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid administrator: %v", err)
+	}
+	if err := msg.Payout.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid payout: %v", err)
+	}
+	if !msg.Payout.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap("payout must be positive")
+	}
+	if msg.Payout.Denom == msg.Denom {
+		return sdkerrors.ErrInvalidCoins.Wrapf("payout cannot be in the marker's denom %s", msg.Denom)
+	}
+	return nil
+}
+
+// NewMsgClaimDistributionRequest creates a new MsgClaimDistributionRequest.
+func NewMsgClaimDistributionRequest(holder sdk.AccAddress, distributionID uint64) *MsgClaimDistributionRequest {
+	return &MsgClaimDistributionRequest{
+		Holder:         holder.String(),
+		DistributionId: distributionID,
+	}
+}
+
+func (msg MsgClaimDistributionRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder: %v", err)
+	}
+	if msg.DistributionId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("distribution id cannot be zero")
+	}
+	return nil
+}
+
+// NewMsgSetRedemptionPayoutDenomRequest creates a new MsgSetRedemptionPayoutDenomRequest.
+func NewMsgSetRedemptionPayoutDenomRequest(denom string, admin sdk.AccAddress, payoutDenom string) *MsgSetRedemptionPayoutDenomRequest {
+	return &MsgSetRedemptionPayoutDenomRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		PayoutDenom:   payoutDenom,
+	}
+}
+
+func (msg MsgSetRedemptionPayoutDenomRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid administrator: %v", err)
+	}
+	if len(msg.PayoutDenom) > 0 {
+		if err := sdk.ValidateDenom(msg.PayoutDenom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid payout denom: %v", err)
+		}
+		if msg.PayoutDenom == msg.Denom {
+			return sdkerrors.ErrInvalidRequest.Wrapf("payout denom cannot be the marker's denom %s", msg.Denom)
+		}
+	}
+	return nil
+}
+
+// NewMsgRequestRedemptionRequest creates a new MsgRequestRedemptionRequest.
+func NewMsgRequestRedemptionRequest(holder sdk.AccAddress, amount sdk.Coin) *MsgRequestRedemptionRequest {
+	return &MsgRequestRedemptionRequest{
+		Holder: holder.String(),
+		Amount: amount,
+	}
+}
+
+func (msg MsgRequestRedemptionRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder: %v", err)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid amount: %v", err)
+	}
+	if !msg.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap("amount must be positive")
+	}
+	return nil
+}
+
+// NewMsgFulfillRedemptionRequest creates a new MsgFulfillRedemptionRequest.
+// The payout is optional; if nil, it is calculated from the marker's net asset value.
+func NewMsgFulfillRedemptionRequest(admin sdk.AccAddress, redemptionID uint64, payout *sdk.Coin) *MsgFulfillRedemptionRequest {
+	return &MsgFulfillRedemptionRequest{
+		Administrator: admin.String(),
+		RedemptionId:  redemptionID,
+		Payout:        payout,
+	}
+}
+
+func (msg MsgFulfillRedemptionRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid administrator: %v", err)
+	}
+	if msg.RedemptionId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("redemption id cannot be zero")
+	}
+	if msg.Payout != nil {
+		if err := msg.Payout.Validate(); err != nil {
+			return sdkerrors.ErrInvalidCoins.Wrapf("invalid payout: %v", err)
+		}
+		if !msg.Payout.IsPositive() {
+			return sdkerrors.ErrInvalidCoins.Wrap("payout must be positive")
+		}
+	}
+	return nil
+}
+
+// NewMsgRejectRedemptionRequest creates a new MsgRejectRedemptionRequest.
+func NewMsgRejectRedemptionRequest(admin sdk.AccAddress, redemptionID uint64, reason string) *MsgRejectRedemptionRequest {
+	return &MsgRejectRedemptionRequest{
+		Administrator: admin.String(),
+		RedemptionId:  redemptionID,
+		Reason:        reason,
+	}
+}
+
+func (msg MsgRejectRedemptionRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid administrator: %v", err)
+	}
+	if msg.RedemptionId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("redemption id cannot be zero")
+	}
+	if len(strings.TrimSpace(msg.Reason)) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("reason cannot be empty")
+	}
+	return nil
+}
+
+// NewMsgSetTransferLimitsRequest creates a new MsgSetTransferLimitsRequest.
+func NewMsgSetTransferLimitsRequest(denom string, admin sdk.AccAddress, holderDailyLimit, markerDailyLimit sdkmath.Int) *MsgSetTransferLimitsRequest {
+	return &MsgSetTransferLimitsRequest{
+		Denom:            denom,
+		Administrator:    admin.String(),
+		HolderDailyLimit: holderDailyLimit,
+		MarkerDailyLimit: markerDailyLimit,
+	}
+}
+
+func (msg MsgSetTransferLimitsRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid administrator: %v", err)
+	}
+	if msg.HolderDailyLimit.IsNil() || msg.HolderDailyLimit.IsNegative() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("holder daily limit %q cannot be negative", msg.HolderDailyLimit)
+	}
+	if msg.MarkerDailyLimit.IsNil() || msg.MarkerDailyLimit.IsNegative() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("marker daily limit %q cannot be negative", msg.MarkerDailyLimit)
+	}
+	return nil
+}
+
+// NewMsgSetAccessGrantTermsRequest creates a new MsgSetAccessGrantTermsRequest.
+func NewMsgSetAccessGrantTermsRequest(
+	denom string, admin, addr sdk.AccAddress, expiresAt *time.Time, mintDailyLimit sdkmath.Int, withdrawAddrs []string,
+) *MsgSetAccessGrantTermsRequest {
+	return &MsgSetAccessGrantTermsRequest{
+		Denom:             denom,
+		Administrator:     admin.String(),
+		Address:           addr.String(),
+		ExpiresAt:         expiresAt,
+		MintDailyLimit:    mintDailyLimit,
+		WithdrawAddresses: withdrawAddrs,
+	}
+}
+
+func (msg MsgSetAccessGrantTermsRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid administrator: %v", err)
+	}
+	if err := msg.GetTerms().Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return nil
+}
+
+// GetTerms returns the access grant terms defined by this msg.
+func (msg MsgSetAccessGrantTermsRequest) GetTerms() AccessGrantTerms {
+	return AccessGrantTerms{
+		Denom:             msg.Denom,
+		Address:           msg.Address,
+		ExpiresAt:         msg.ExpiresAt,
+		MintDailyLimit:    msg.MintDailyLimit,
+		WithdrawAddresses: msg.WithdrawAddresses,
+	}
+}
+
+// NewMsgSetApprovalThresholdRequest creates a new MsgSetApprovalThresholdRequest.
+func NewMsgSetApprovalThresholdRequest(denom string, admin sdk.AccAddress, permission Access, threshold uint32) *MsgSetApprovalThresholdRequest {
+	return &MsgSetApprovalThresholdRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Permission:    permission,
+		Threshold:     threshold,
+	}
+}
+
+func (msg MsgSetApprovalThresholdRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid administrator: %v", err)
+	}
+	if !IsApprovalPermission(msg.Permission) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid permission %s: must be one of %s, %s or %s",
+			msg.Permission, Access_Mint, Access_Withdraw, Access_ForceTransfer)
+	}
+	return nil
+}
+
+// NewMsgApproveOperationRequest creates a new MsgApproveOperationRequest.
+func NewMsgApproveOperationRequest(operationID uint64, approver sdk.AccAddress) *MsgApproveOperationRequest {
+	return &MsgApproveOperationRequest{
+		OperationId: operationID,
+		Approver:    approver.String(),
+	}
+}
+
+func (msg MsgApproveOperationRequest) ValidateBasic() error {
+	if msg.OperationId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("operation id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Approver); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid approver: %v", err)
+	}
+	return nil
+}
+
+// NewMsgCancelOperationRequest creates a new MsgCancelOperationRequest.
+func NewMsgCancelOperationRequest(operationID uint64, signer sdk.AccAddress) *MsgCancelOperationRequest {
+	return &MsgCancelOperationRequest{
+		OperationId: operationID,
+		Signer:      signer.String(),
+	}
+}
+
+func (msg MsgCancelOperationRequest) ValidateBasic() error {
+	if msg.OperationId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("operation id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid signer: %v", err)
+	}
+	return nil
+}
+
+// NewMsgSetAttributeRequirementsRequest creates a new MsgSetAttributeRequirementsRequest.
+func NewMsgSetAttributeRequirementsRequest(denom string, transferAuthority sdk.AccAddress, requirements []AttributeRequirement) *MsgSetAttributeRequirementsRequest {
+	return &MsgSetAttributeRequirementsRequest{
+		Denom:             denom,
+		Requirements:      requirements,
+		TransferAuthority: transferAuthority.String(),
+	}
+}
+
+func (msg MsgSetAttributeRequirementsRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.TransferAuthority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid transfer authority: %v", err)
+	}
+	if err := ValidateAttributeRequirements(msg.Requirements); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return nil
+}
+
+// NewMsgTakeHolderSnapshotRequest creates a new MsgTakeHolderSnapshotRequest.
+func NewMsgTakeHolderSnapshotRequest(denom string, admin sdk.AccAddress) *MsgTakeHolderSnapshotRequest {
+	return &MsgTakeHolderSnapshotRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+	}
+}
+
+func (msg MsgTakeHolderSnapshotRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid administrator: %v", err)
+	}
+	return nil
+}
+
+// NewMsgSetIssuanceScheduleRequest creates a new MsgSetIssuanceScheduleRequest.
+func NewMsgSetIssuanceScheduleRequest(denom string, hardCap sdkmath.Int, tranches []IssuanceTranche, authority string) *MsgSetIssuanceScheduleRequest {
+	return &MsgSetIssuanceScheduleRequest{
+		Denom:     denom,
+		HardCap:   hardCap,
+		Tranches:  tranches,
+		Authority: authority,
+	}
+}
+
+func (msg MsgSetIssuanceScheduleRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority: %v", err)
+	}
+	if msg.HardCap.IsNil() || msg.HardCap.IsNegative() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid hard cap %q: cannot be negative", msg.HardCap)
+	}
+	if err := ValidateIssuanceTranches(msg.Tranches); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return nil
+}
+
+// NewMsgSetNetAssetValueMaxAgeRequest creates a new MsgSetNetAssetValueMaxAgeRequest.
+func NewMsgSetNetAssetValueMaxAgeRequest(denom string, maxAge uint64, authority string) *MsgSetNetAssetValueMaxAgeRequest {
+	return &MsgSetNetAssetValueMaxAgeRequest{
+		Denom:     denom,
+		MaxAge:    maxAge,
+		Authority: authority,
+	}
+}
+
+func (msg MsgSetNetAssetValueMaxAgeRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority: %v", err)
+	}
+	return nil
+}
@@ -53,6 +53,23 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgWithdrawEscrowProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgSetDenomMetadataProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgFreezeHolderRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgUnfreezeHolderRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgDistributeToHoldersRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgClaimDistributionRequest{Holder: signer} },
+		func(signer string) sdk.Msg { return &MsgSetRedemptionPayoutDenomRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgRequestRedemptionRequest{Holder: signer} },
+		func(signer string) sdk.Msg { return &MsgFulfillRedemptionRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgRejectRedemptionRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgSetTransferLimitsRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgSetAccessGrantTermsRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgSetApprovalThresholdRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgApproveOperationRequest{Approver: signer} },
+		func(signer string) sdk.Msg { return &MsgCancelOperationRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgSetAttributeRequirementsRequest{TransferAuthority: signer} },
+		func(signer string) sdk.Msg { return &MsgTakeHolderSnapshotRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgSetIssuanceScheduleRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetNetAssetValueMaxAgeRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -958,6 +975,7 @@ func TestMsgAddNetAssetValueValidateBasic(t *testing.T) {
 	netAssetValue2 := NetAssetValue{Price: sdk.NewInt64Coin("hotdog", 100), Volume: uint64(100)}
 	invalidNetAssetValue := NetAssetValue{Price: sdk.NewInt64Coin("hotdog", 100), Volume: uint64(0)}
 	invalidNetAssetValue2 := NetAssetValue{Price: sdk.NewInt64Coin("hotdog", 100), Volume: uint64(1), UpdatedBlockHeight: 1}
+	staleNetAssetValue := NetAssetValue{Price: sdk.NewInt64Coin("hotdog", 100), Volume: uint64(1), Stale: true}
 
 	tests := []struct {
 		name   string
@@ -973,6 +991,11 @@ func TestMsgAddNetAssetValueValidateBasic(t *testing.T) {
 			msg:    MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{invalidNetAssetValue2}, Administrator: addr},
 			expErr: "marker net asset value must not have update height set",
 		},
+		{
+			name:   "stale is set",
+			msg:    MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{staleNetAssetValue}, Administrator: addr},
+			expErr: "marker net asset value must not have stale set",
+		},
 		{
 			name:   "validation of net asset value failure",
 			msg:    MsgAddNetAssetValuesRequest{Denom: denom, NetAssetValues: []NetAssetValue{invalidNetAssetValue}, Administrator: addr},
@@ -1409,6 +1432,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					DefaultMaxNavHistory,
 				),
 			},
 			expectError: false,
@@ -1421,6 +1445,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"^invalidregex$",
 					sdkmath.NewInt(1000000000000),
+					DefaultMaxNavHistory,
 				),
 			},
 			expectError:   true,
@@ -1434,6 +1459,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					DefaultMaxNavHistory,
 				),
 			},
 			expectError:   true,
@@ -1505,3 +1531,716 @@ func TestMsgWithdrawRequest_WithMarketCommitment(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgFreezeHolderRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+	holder := sdk.AccAddress("holder______________").String()
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		msg    MsgFreezeHolderRequest
+		expErr string
+	}{
+		{
+			name:   "invalid amount",
+			msg:    MsgFreezeHolderRequest{Amount: sdk.Coin{Denom: "x", Amount: sdkmath.NewInt(1)}, Administrator: admin, Holder: holder, ExpiresAt: expiresAt, Reason: "r"},
+			expErr: "invalid amount: invalid denom: x: invalid coins",
+		},
+		{
+			name:   "zero amount",
+			msg:    MsgFreezeHolderRequest{Amount: sdk.NewInt64Coin("frozencoin", 0), Administrator: admin, Holder: holder, ExpiresAt: expiresAt, Reason: "r"},
+			expErr: "amount must be positive: invalid coins",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    MsgFreezeHolderRequest{Amount: sdk.NewInt64Coin("frozencoin", 5), Administrator: "bad", Holder: holder, ExpiresAt: expiresAt, Reason: "r"},
+			expErr: "invalid administrator: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name:   "invalid holder",
+			msg:    MsgFreezeHolderRequest{Amount: sdk.NewInt64Coin("frozencoin", 5), Administrator: admin, Holder: "", ExpiresAt: expiresAt, Reason: "r"},
+			expErr: "invalid holder: empty address string is not allowed: invalid address",
+		},
+		{
+			name:   "zero expiration",
+			msg:    MsgFreezeHolderRequest{Amount: sdk.NewInt64Coin("frozencoin", 5), Administrator: admin, Holder: holder, Reason: "r"},
+			expErr: "expires at cannot be zero: invalid request",
+		},
+		{
+			name:   "empty reason",
+			msg:    MsgFreezeHolderRequest{Amount: sdk.NewInt64Coin("frozencoin", 5), Administrator: admin, Holder: holder, ExpiresAt: expiresAt, Reason: "  "},
+			expErr: "reason cannot be empty: invalid request",
+		},
+		{
+			name: "valid",
+			msg:  *NewMsgFreezeHolderRequest(sdk.NewInt64Coin("frozencoin", 5), sdk.AccAddress("admin_______________"), sdk.AccAddress("holder______________"), expiresAt, "court order"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgUnfreezeHolderRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	holder := sdk.AccAddress("holder______________")
+
+	tests := []struct {
+		name   string
+		msg    *MsgUnfreezeHolderRequest
+		expErr string
+	}{
+		{
+			name:   "invalid denom",
+			msg:    NewMsgUnfreezeHolderRequest("x", admin, holder),
+			expErr: "invalid denom: invalid denom: x: invalid request",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    &MsgUnfreezeHolderRequest{Denom: "frozencoin", Administrator: "bad", Holder: holder.String()},
+			expErr: "invalid administrator: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name:   "invalid holder",
+			msg:    &MsgUnfreezeHolderRequest{Denom: "frozencoin", Administrator: admin.String(), Holder: ""},
+			expErr: "invalid holder: empty address string is not allowed: invalid address",
+		},
+		{
+			name: "valid",
+			msg:  NewMsgUnfreezeHolderRequest("frozencoin", admin, holder),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgDistributeToHoldersRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	payout := sdk.NewInt64Coin("usd", 1000)
+
+	tests := []struct {
+		name   string
+		msg    *MsgDistributeToHoldersRequest
+		expErr string
+	}{
+		{
+			name:   "invalid denom",
+			msg:    NewMsgDistributeToHoldersRequest("x", admin, payout),
+			expErr: "invalid denom: invalid denom: x: invalid request",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    &MsgDistributeToHoldersRequest{Denom: "fundcoin", Administrator: "bad", Payout: payout},
+			expErr: "invalid administrator: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name:   "invalid payout",
+			msg:    NewMsgDistributeToHoldersRequest("fundcoin", admin, sdk.Coin{Denom: "usd", Amount: sdkmath.NewInt(-1)}),
+			expErr: "invalid payout: negative coin amount: -1: invalid coins",
+		},
+		{
+			name:   "zero payout",
+			msg:    NewMsgDistributeToHoldersRequest("fundcoin", admin, sdk.NewInt64Coin("usd", 0)),
+			expErr: "payout must be positive: invalid coins",
+		},
+		{
+			name:   "payout in marker denom",
+			msg:    NewMsgDistributeToHoldersRequest("fundcoin", admin, sdk.NewInt64Coin("fundcoin", 5)),
+			expErr: "payout cannot be in the marker's denom fundcoin: invalid coins",
+		},
+		{
+			name: "valid",
+			msg:  NewMsgDistributeToHoldersRequest("fundcoin", admin, payout),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgClaimDistributionRequestValidateBasic(t *testing.T) {
+	holder := sdk.AccAddress("holder______________")
+
+	tests := []struct {
+		name   string
+		msg    *MsgClaimDistributionRequest
+		expErr string
+	}{
+		{
+			name:   "invalid holder",
+			msg:    &MsgClaimDistributionRequest{Holder: "", DistributionId: 1},
+			expErr: "invalid holder: empty address string is not allowed: invalid address",
+		},
+		{
+			name:   "zero distribution id",
+			msg:    NewMsgClaimDistributionRequest(holder, 0),
+			expErr: "distribution id cannot be zero: invalid request",
+		},
+		{
+			name: "valid",
+			msg:  NewMsgClaimDistributionRequest(holder, 3),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgRedemptionRequestsValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	payout := sdk.NewInt64Coin("usd", 10)
+	zeroPayout := sdk.NewInt64Coin("usd", 0)
+
+	tests := []struct {
+		name   string
+		msg    sdk.HasValidateBasic
+		expErr string
+	}{
+		{
+			name:   "set payout denom: invalid denom",
+			msg:    NewMsgSetRedemptionPayoutDenomRequest("x", addr, "usd"),
+			expErr: "invalid denom: invalid denom: x: invalid request",
+		},
+		{
+			name:   "set payout denom: invalid payout denom",
+			msg:    NewMsgSetRedemptionPayoutDenomRequest("fundcoin", addr, "x"),
+			expErr: "invalid payout denom: invalid denom: x: invalid request",
+		},
+		{
+			name:   "set payout denom: marker denom",
+			msg:    NewMsgSetRedemptionPayoutDenomRequest("fundcoin", addr, "fundcoin"),
+			expErr: "payout denom cannot be the marker's denom fundcoin: invalid request",
+		},
+		{
+			name: "set payout denom: empty payout denom",
+			msg:  NewMsgSetRedemptionPayoutDenomRequest("fundcoin", addr, ""),
+		},
+		{
+			name:   "request: invalid holder",
+			msg:    &MsgRequestRedemptionRequest{Holder: "", Amount: sdk.NewInt64Coin("fundcoin", 1)},
+			expErr: "invalid holder: empty address string is not allowed: invalid address",
+		},
+		{
+			name:   "request: zero amount",
+			msg:    NewMsgRequestRedemptionRequest(addr, sdk.NewInt64Coin("fundcoin", 0)),
+			expErr: "amount must be positive: invalid coins",
+		},
+		{
+			name: "request: valid",
+			msg:  NewMsgRequestRedemptionRequest(addr, sdk.NewInt64Coin("fundcoin", 1)),
+		},
+		{
+			name:   "fulfill: zero id",
+			msg:    NewMsgFulfillRedemptionRequest(addr, 0, nil),
+			expErr: "redemption id cannot be zero: invalid request",
+		},
+		{
+			name:   "fulfill: zero payout",
+			msg:    NewMsgFulfillRedemptionRequest(addr, 1, &zeroPayout),
+			expErr: "payout must be positive: invalid coins",
+		},
+		{
+			name: "fulfill: valid with payout",
+			msg:  NewMsgFulfillRedemptionRequest(addr, 1, &payout),
+		},
+		{
+			name: "fulfill: valid without payout",
+			msg:  NewMsgFulfillRedemptionRequest(addr, 1, nil),
+		},
+		{
+			name:   "reject: invalid administrator",
+			msg:    &MsgRejectRedemptionRequest{Administrator: "bad", RedemptionId: 1, Reason: "no"},
+			expErr: "invalid administrator: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name:   "reject: empty reason",
+			msg:    NewMsgRejectRedemptionRequest(addr, 1, " "),
+			expErr: "reason cannot be empty: invalid request",
+		},
+		{
+			name: "reject: valid",
+			msg:  NewMsgRejectRedemptionRequest(addr, 1, "no"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetTransferLimitsRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+
+	tests := []struct {
+		name   string
+		msg    *MsgSetTransferLimitsRequest
+		expErr string
+	}{
+		{
+			name:   "invalid denom",
+			msg:    NewMsgSetTransferLimitsRequest("x", admin, sdkmath.NewInt(1), sdkmath.NewInt(1)),
+			expErr: "invalid denom: invalid denom: x: invalid request",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    &MsgSetTransferLimitsRequest{Denom: "limitcoin", Administrator: "bad", HolderDailyLimit: sdkmath.NewInt(1), MarkerDailyLimit: sdkmath.NewInt(1)},
+			expErr: "invalid administrator: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name:   "negative holder limit",
+			msg:    NewMsgSetTransferLimitsRequest("limitcoin", admin, sdkmath.NewInt(-1), sdkmath.NewInt(1)),
+			expErr: "holder daily limit \"-1\" cannot be negative: invalid request",
+		},
+		{
+			name:   "nil marker limit",
+			msg:    &MsgSetTransferLimitsRequest{Denom: "limitcoin", Administrator: admin.String(), HolderDailyLimit: sdkmath.NewInt(1)},
+			expErr: "marker daily limit \"<nil>\" cannot be negative: invalid request",
+		},
+		{
+			name: "no limits",
+			msg:  NewMsgSetTransferLimitsRequest("limitcoin", admin, sdkmath.ZeroInt(), sdkmath.ZeroInt()),
+		},
+		{
+			name: "valid",
+			msg:  NewMsgSetTransferLimitsRequest("limitcoin", admin, sdkmath.NewInt(10), sdkmath.NewInt(100)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetAccessGrantTermsRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	grantee := sdk.AccAddress("grantee_____________")
+	withdrawAddr := sdk.AccAddress("withdraw_addr_______").String()
+	expiresAt := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		msg    *MsgSetAccessGrantTermsRequest
+		expErr string
+	}{
+		{
+			name:   "invalid administrator",
+			msg:    &MsgSetAccessGrantTermsRequest{Denom: "grantcoin", Administrator: "bad", Address: grantee.String(), MintDailyLimit: sdkmath.ZeroInt()},
+			expErr: "invalid administrator: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name:   "invalid denom",
+			msg:    NewMsgSetAccessGrantTermsRequest("x", admin, grantee, nil, sdkmath.ZeroInt(), nil),
+			expErr: "invalid access grant terms denom: invalid denom: x: invalid request",
+		},
+		{
+			name:   "invalid address",
+			msg:    &MsgSetAccessGrantTermsRequest{Denom: "grantcoin", Administrator: admin.String(), MintDailyLimit: sdkmath.ZeroInt()},
+			expErr: "invalid grantcoin access grant terms address \"\": empty address string is not allowed: invalid request",
+		},
+		{
+			name:   "negative mint limit",
+			msg:    NewMsgSetAccessGrantTermsRequest("grantcoin", admin, grantee, nil, sdkmath.NewInt(-1), nil),
+			expErr: "invalid grantcoin access grant terms for " + grantee.String() + ": mint daily limit \"-1\" cannot be negative: invalid request",
+		},
+		{
+			name:   "duplicate withdraw address",
+			msg:    NewMsgSetAccessGrantTermsRequest("grantcoin", admin, grantee, nil, sdkmath.ZeroInt(), []string{withdrawAddr, withdrawAddr}),
+			expErr: "invalid grantcoin access grant terms for " + grantee.String() + ": duplicate withdraw address " + withdrawAddr + ": invalid request",
+		},
+		{
+			name: "no terms",
+			msg:  NewMsgSetAccessGrantTermsRequest("grantcoin", admin, grantee, nil, sdkmath.ZeroInt(), nil),
+		},
+		{
+			name: "valid",
+			msg:  NewMsgSetAccessGrantTermsRequest("grantcoin", admin, grantee, &expiresAt, sdkmath.NewInt(100), []string{withdrawAddr}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetApprovalThresholdRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+
+	tests := []struct {
+		name   string
+		msg    *MsgSetApprovalThresholdRequest
+		expErr string
+	}{
+		{
+			name:   "invalid denom",
+			msg:    NewMsgSetApprovalThresholdRequest("x", admin, Access_Mint, 2),
+			expErr: "invalid denom: invalid denom: x: invalid request",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    &MsgSetApprovalThresholdRequest{Denom: "approvecoin", Administrator: "bad", Permission: Access_Mint, Threshold: 2},
+			expErr: "invalid administrator: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name:   "unsupported permission",
+			msg:    NewMsgSetApprovalThresholdRequest("approvecoin", admin, Access_Admin, 2),
+			expErr: "invalid permission ACCESS_ADMIN: must be one of ACCESS_MINT, ACCESS_WITHDRAW or ACCESS_FORCE_TRANSFER: invalid request",
+		},
+		{
+			name: "removing a threshold",
+			msg:  NewMsgSetApprovalThresholdRequest("approvecoin", admin, Access_Withdraw, 0),
+		},
+		{
+			name: "valid",
+			msg:  NewMsgSetApprovalThresholdRequest("approvecoin", admin, Access_ForceTransfer, 3),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgApproveAndCancelOperationRequestValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress("signer______________")
+
+	tests := []struct {
+		name   string
+		msg    sdk.HasValidateBasic
+		expErr string
+	}{
+		{
+			name:   "approve: zero id",
+			msg:    NewMsgApproveOperationRequest(0, signer),
+			expErr: "operation id cannot be zero: invalid request",
+		},
+		{
+			name:   "approve: invalid approver",
+			msg:    &MsgApproveOperationRequest{OperationId: 1, Approver: "bad"},
+			expErr: "invalid approver: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name: "approve: valid",
+			msg:  NewMsgApproveOperationRequest(1, signer),
+		},
+		{
+			name:   "cancel: zero id",
+			msg:    NewMsgCancelOperationRequest(0, signer),
+			expErr: "operation id cannot be zero: invalid request",
+		},
+		{
+			name:   "cancel: invalid signer",
+			msg:    &MsgCancelOperationRequest{OperationId: 1, Signer: "bad"},
+			expErr: "invalid signer: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name: "cancel: valid",
+			msg:  NewMsgCancelOperationRequest(1, signer),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetAttributeRequirementsRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("transfer_authority__")
+	inCountries := AttributeRequirement{Name: "kyc.pb", Condition: AttributeCondition_In, JsonPath: "country", Values: []string{"US", "CA"}}
+	tooMany := make([]AttributeRequirement, MaxAttributeRequirements+1)
+	for i := range tooMany {
+		tooMany[i] = inCountries
+	}
+
+	tests := []struct {
+		name   string
+		msg    *MsgSetAttributeRequirementsRequest
+		expErr string
+	}{
+		{
+			name:   "invalid denom",
+			msg:    NewMsgSetAttributeRequirementsRequest("x", authority, []AttributeRequirement{inCountries}),
+			expErr: "invalid denom: invalid denom: x: invalid request",
+		},
+		{
+			name:   "invalid transfer authority",
+			msg:    &MsgSetAttributeRequirementsRequest{Denom: "reqcoin", TransferAuthority: "bad"},
+			expErr: "invalid transfer authority: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name:   "too many requirements",
+			msg:    NewMsgSetAttributeRequirementsRequest("reqcoin", authority, tooMany),
+			expErr: "cannot have more than 20 attribute requirements, found 21: invalid request",
+		},
+		{
+			name: "invalid requirement",
+			msg: NewMsgSetAttributeRequirementsRequest("reqcoin", authority, []AttributeRequirement{
+				inCountries,
+				{Name: "acc.pb", Condition: AttributeCondition_GreaterThan, Values: []string{"three"}},
+			}),
+			expErr: "requirement[1]: ATTRIBUTE_CONDITION_GREATER_THAN condition on acc.pb must have a numeric value, found \"three\": invalid request",
+		},
+		{
+			name: "no requirements",
+			msg:  NewMsgSetAttributeRequirementsRequest("reqcoin", authority, nil),
+		},
+		{
+			name: "valid",
+			msg:  NewMsgSetAttributeRequirementsRequest("reqcoin", authority, []AttributeRequirement{inCountries}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgTakeHolderSnapshotRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("snapshot_admin______")
+
+	tests := []struct {
+		name   string
+		msg    *MsgTakeHolderSnapshotRequest
+		expErr string
+	}{
+		{
+			name:   "invalid denom",
+			msg:    NewMsgTakeHolderSnapshotRequest("x", admin),
+			expErr: "invalid denom: invalid denom: x: invalid request",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    &MsgTakeHolderSnapshotRequest{Denom: "capcoin", Administrator: "bad"},
+			expErr: "invalid administrator: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name: "valid",
+			msg:  NewMsgTakeHolderSnapshotRequest("capcoin", admin),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgTransferRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("transfer_admin______")
+	from := sdk.AccAddress("transfer_from_______")
+	to := sdk.AccAddress("transfer_to_________")
+	coin := sdk.NewInt64Coin("restrictedcoin", 10)
+
+	tests := []struct {
+		name   string
+		msg    *MsgTransferRequest
+		expErr string
+	}{
+		{
+			name: "without justification",
+			msg:  NewMsgTransferRequest(admin, from, to, coin),
+		},
+		{
+			name: "with justification",
+			msg:  NewMsgTransferRequest(admin, from, to, coin).WithJustification(ForcedTransferReason_CourtOrder, "Case 24-CV-1234"),
+		},
+		{
+			name:   "unknown reason",
+			msg:    NewMsgTransferRequest(admin, from, to, coin).WithJustification(ForcedTransferReason(99), "ticket 7"),
+			expErr: "unknown forced transfer reason 99",
+		},
+		{
+			name:   "reason without reference",
+			msg:    NewMsgTransferRequest(admin, from, to, coin).WithJustification(ForcedTransferReason_LostKeyRecovery, "  "),
+			expErr: "a reference is required with forced transfer reason FORCED_TRANSFER_REASON_LOST_KEY_RECOVERY",
+		},
+		{
+			name:   "reference without reason",
+			msg:    NewMsgTransferRequest(admin, from, to, coin).WithJustification(ForcedTransferReason_Unspecified, "ticket 7"),
+			expErr: "a forced transfer reason is required with a reference",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetIssuanceScheduleRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("issuance_authority__").String()
+	tranches := []IssuanceTranche{{Amount: sdkmath.NewInt(100), UnlockHeight: 10}}
+
+	tests := []struct {
+		name   string
+		msg    *MsgSetIssuanceScheduleRequest
+		expErr string
+	}{
+		{
+			name:   "invalid denom",
+			msg:    NewMsgSetIssuanceScheduleRequest("x", sdkmath.ZeroInt(), tranches, authority),
+			expErr: "invalid denom: invalid denom: x: invalid request",
+		},
+		{
+			name:   "invalid authority",
+			msg:    NewMsgSetIssuanceScheduleRequest("issuecoin", sdkmath.ZeroInt(), tranches, "bad"),
+			expErr: "invalid authority: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name:   "negative hard cap",
+			msg:    NewMsgSetIssuanceScheduleRequest("issuecoin", sdkmath.NewInt(-1), tranches, authority),
+			expErr: "invalid hard cap \"-1\": cannot be negative: invalid request",
+		},
+		{
+			name:   "invalid tranche",
+			msg:    NewMsgSetIssuanceScheduleRequest("issuecoin", sdkmath.ZeroInt(), []IssuanceTranche{{Amount: sdkmath.NewInt(100)}}, authority),
+			expErr: "tranche[0]: exactly one of unlock time and unlock height must be set: invalid request",
+		},
+		{
+			name: "hard cap and tranches",
+			msg:  NewMsgSetIssuanceScheduleRequest("issuecoin", sdkmath.NewInt(1000), tranches, authority),
+		},
+		{
+			name: "removal",
+			msg:  NewMsgSetIssuanceScheduleRequest("issuecoin", sdkmath.ZeroInt(), nil, authority),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetNetAssetValueMaxAgeRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("nav_max_age_auth____").String()
+
+	tests := []struct {
+		name   string
+		msg    *MsgSetNetAssetValueMaxAgeRequest
+		expErr string
+	}{
+		{
+			name:   "invalid denom",
+			msg:    NewMsgSetNetAssetValueMaxAgeRequest("x", 100, authority),
+			expErr: "invalid denom: invalid denom: x: invalid request",
+		},
+		{
+			name:   "invalid authority",
+			msg:    NewMsgSetNetAssetValueMaxAgeRequest("navcoin", 100, "bad"),
+			expErr: "invalid authority: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name: "max age",
+			msg:  NewMsgSetNetAssetValueMaxAgeRequest("navcoin", 100, authority),
+		},
+		{
+			name: "removal",
+			msg:  NewMsgSetNetAssetValueMaxAgeRequest("navcoin", 0, authority),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
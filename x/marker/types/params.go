@@ -14,6 +14,8 @@ const (
 	DefaultMaxSupply = "100000000000000000000"
 	// DefaultUnrestrictedDenomRegex is a regex that denoms created by normal requests must pass.
 	DefaultUnrestrictedDenomRegex = `[a-zA-Z][a-zA-Z0-9\-\.]{2,83}`
+	// DefaultMaxNavHistory is the number of past net asset values to keep for each marker (or scope) and price denom.
+	DefaultMaxNavHistory = uint32(100)
 )
 
 // NewParams creates a new parameter object
@@ -21,11 +23,13 @@ func NewParams(
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
 	maxSupply sdkmath.Int,
+	maxNavHistory uint32,
 ) Params {
 	return Params{
 		EnableGovernance:       enableGovernance,
 		UnrestrictedDenomRegex: unrestrictedDenomRegex,
 		MaxSupply:              maxSupply,
+		MaxNavHistory:          maxNavHistory,
 	}
 }
 
@@ -35,6 +39,7 @@ func DefaultParams() Params {
 		DefaultEnableGovernance,
 		DefaultUnrestrictedDenomRegex,
 		StringToBigInt(DefaultMaxSupply),
+		DefaultMaxNavHistory,
 	)
 }
 
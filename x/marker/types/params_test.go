@@ -18,11 +18,13 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultUnrestrictedDenomRegex, p.UnrestrictedDenomRegex)
 	require.Equal(t, DefaultEnableGovernance, p.EnableGovernance)
 	require.Equal(t, DefaultMaxSupply, p.MaxSupply.String())
+	require.Equal(t, DefaultMaxNavHistory, p.MaxNavHistory)
 
-	require.True(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(false, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, "a-z", StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt("1000"))))
+	require.True(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), DefaultMaxNavHistory)))
+	require.False(t, p.Equal(NewParams(false, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), DefaultMaxNavHistory)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, "a-z", StringToBigInt(DefaultMaxSupply), DefaultMaxNavHistory)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt("1000"), DefaultMaxNavHistory)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), 0)))
 	require.False(t, p.Equal(nil))
 
 	var p2 *Params
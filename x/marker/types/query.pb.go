@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
@@ -16,19 +17,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -935,6 +940,16 @@ var xxx_messageInfo_Balance proto.InternalMessageInfo
 type QueryNetAssetValuesRequest struct {
 	// address or denom for the marker
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// price_denom, if provided, limits the results to net asset values with this price denom.
+	PriceDenom string `protobuf:"bytes,2,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// as_of, if provided, returns the net asset values (from the history) that were in effect at this time.
+	AsOf *time.Time `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3,stdtime" json:"as_of,omitempty"`
+	// history, if true, returns the recorded history of net asset values instead of the current ones.
+	History bool `protobuf:"varint,4,opt,name=history,proto3" json:"history,omitempty"`
+	// start_time, if provided, limits the history to entries recorded at or after this time.
+	StartTime *time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// end_time, if provided, limits the history to entries recorded before this time.
+	EndTime *time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
 }
 
 func (m *QueryNetAssetValuesRequest) Reset()         { *m = QueryNetAssetValuesRequest{} }
@@ -977,10 +992,49 @@ func (m *QueryNetAssetValuesRequest) GetId() string {
 	return ""
 }
 
+func (m *QueryNetAssetValuesRequest) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *QueryNetAssetValuesRequest) GetAsOf() *time.Time {
+	if m != nil {
+		return m.AsOf
+	}
+	return nil
+}
+
+func (m *QueryNetAssetValuesRequest) GetHistory() bool {
+	if m != nil {
+		return m.History
+	}
+	return false
+}
+
+func (m *QueryNetAssetValuesRequest) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *QueryNetAssetValuesRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
 // QueryNetAssetValuesRequest is the response type for the Query/NetAssetValues method.
 type QueryNetAssetValuesResponse struct {
 	// net asset values for marker denom
 	NetAssetValues []NetAssetValue `protobuf:"bytes,1,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+	// history is the recorded history of net asset values (only populated when history is requested).
+	History []NetAssetValueRecord `protobuf:"bytes,2,rep,name=history,proto3" json:"history"`
+	// max_age is the number of blocks after which the marker's net asset values are stale. Zero means they never are.
+	MaxAge uint64 `protobuf:"varint,3,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
 }
 
 func (m *QueryNetAssetValuesResponse) Reset()         { *m = QueryNetAssetValuesResponse{} }
@@ -1023,1585 +1077,10111 @@ func (m *QueryNetAssetValuesResponse) GetNetAssetValues() []NetAssetValue {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.marker.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.marker.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryAllMarkersRequest)(nil), "provenance.marker.v1.QueryAllMarkersRequest")
-	proto.RegisterType((*QueryAllMarkersResponse)(nil), "provenance.marker.v1.QueryAllMarkersResponse")
-	proto.RegisterType((*QueryMarkerRequest)(nil), "provenance.marker.v1.QueryMarkerRequest")
-	proto.RegisterType((*QueryMarkerResponse)(nil), "provenance.marker.v1.QueryMarkerResponse")
-	proto.RegisterType((*QueryHoldingRequest)(nil), "provenance.marker.v1.QueryHoldingRequest")
-	proto.RegisterType((*QueryHoldingResponse)(nil), "provenance.marker.v1.QueryHoldingResponse")
-	proto.RegisterType((*QuerySupplyRequest)(nil), "provenance.marker.v1.QuerySupplyRequest")
-	proto.RegisterType((*QuerySupplyResponse)(nil), "provenance.marker.v1.QuerySupplyResponse")
-	proto.RegisterType((*QueryEscrowRequest)(nil), "provenance.marker.v1.QueryEscrowRequest")
-	proto.RegisterType((*QueryEscrowResponse)(nil), "provenance.marker.v1.QueryEscrowResponse")
-	proto.RegisterType((*QueryAccessRequest)(nil), "provenance.marker.v1.QueryAccessRequest")
-	proto.RegisterType((*QueryAccessResponse)(nil), "provenance.marker.v1.QueryAccessResponse")
-	proto.RegisterType((*QueryDenomMetadataRequest)(nil), "provenance.marker.v1.QueryDenomMetadataRequest")
-	proto.RegisterType((*QueryDenomMetadataResponse)(nil), "provenance.marker.v1.QueryDenomMetadataResponse")
-	proto.RegisterType((*QueryAccountDataRequest)(nil), "provenance.marker.v1.QueryAccountDataRequest")
-	proto.RegisterType((*QueryAccountDataResponse)(nil), "provenance.marker.v1.QueryAccountDataResponse")
-	proto.RegisterType((*Balance)(nil), "provenance.marker.v1.Balance")
-	proto.RegisterType((*QueryNetAssetValuesRequest)(nil), "provenance.marker.v1.QueryNetAssetValuesRequest")
-	proto.RegisterType((*QueryNetAssetValuesResponse)(nil), "provenance.marker.v1.QueryNetAssetValuesResponse")
+func (m *QueryNetAssetValuesResponse) GetHistory() []NetAssetValueRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("provenance/marker/v1/query.proto", fileDescriptor_a76fb1fac8494cdc) }
-
-var fileDescriptor_a76fb1fac8494cdc = []byte{
-	// 1191 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x86, 0x38, 0x61, 0x52, 0x22, 0x18, 0x2c, 0xea, 0x38, 0xa9, 0xd3, 0x6c, 0xab,
-	0xe0, 0x84, 0x66, 0x37, 0x0e, 0x02, 0xa4, 0x70, 0x80, 0xa4, 0xa5, 0x85, 0x43, 0x51, 0xea, 0x48,
-	0x20, 0x55, 0x42, 0xd1, 0x78, 0x3d, 0x6c, 0x57, 0x59, 0xcf, 0xb8, 0xbb, 0xe3, 0x14, 0xab, 0xea,
-	0x85, 0x53, 0x2e, 0x95, 0x82, 0xe0, 0x84, 0x38, 0xe4, 0x80, 0x10, 0x42, 0x42, 0xf4, 0xc0, 0x87,
-	0xa8, 0x38, 0x55, 0xe2, 0xc2, 0x09, 0x50, 0x82, 0x54, 0x3e, 0x06, 0xda, 0x99, 0x37, 0x59, 0x2f,
-	0x5e, 0x6f, 0x5d, 0xa9, 0xe2, 0x92, 0x78, 0x76, 0xdf, 0x9b, 0xf7, 0x9b, 0xff, 0x7b, 0x9e, 0xbf,
-	0xf1, 0xf9, 0x6e, 0x28, 0xf6, 0x19, 0xa7, 0xdc, 0x65, 0x4e, 0x87, 0x86, 0x7b, 0x2c, 0x74, 0xf6,
-	0x1b, 0xce, 0xed, 0x1e, 0x0b, 0xfb, 0x76, 0x37, 0x14, 0x52, 0x90, 0x72, 0x12, 0x61, 0xeb, 0x08,
-	0x7b, 0xbf, 0x51, 0x7d, 0x99, 0x76, 0x7c, 0x2e, 0x1c, 0xf5, 0x57, 0x07, 0x56, 0xcb, 0x9e, 0xf0,
-	0x84, 0xfa, 0xe8, 0xc4, 0x9f, 0xe0, 0xe9, 0xac, 0x27, 0x84, 0x17, 0x30, 0x47, 0xad, 0x5a, 0xbd,
-	0xcf, 0x1c, 0xca, 0x61, 0xe7, 0xea, 0x8a, 0x2b, 0xa2, 0x8e, 0x88, 0x9c, 0x16, 0x8d, 0x98, 0x2e,
-	0xe9, 0xec, 0x37, 0x5a, 0x4c, 0xd2, 0x86, 0xd3, 0xa5, 0x9e, 0xcf, 0xa9, 0xf4, 0x05, 0x87, 0xd8,
-	0xda, 0x60, 0xac, 0x89, 0x72, 0x85, 0x3f, 0xfc, 0x9e, 0xef, 0x9d, 0xbe, 0x8f, 0x17, 0x06, 0x43,
-	0xbf, 0xdf, 0xd5, 0x7c, 0x7a, 0x01, 0xaf, 0xe6, 0x81, 0x90, 0x76, 0x7d, 0x87, 0x72, 0x2e, 0xa4,
-	0xaa, 0x6b, 0xde, 0x2e, 0x66, 0x0a, 0x04, 0x42, 0xe8, 0x90, 0xa5, 0xcc, 0x10, 0xea, 0xba, 0x2c,
-	0x8a, 0xbc, 0x90, 0x72, 0x09, 0x71, 0x73, 0xc0, 0x68, 0x8e, 0x3a, 0x28, 0xb3, 0x55, 0xc6, 0xe4,
-	0x46, 0xbc, 0xdc, 0xa6, 0x21, 0xed, 0x44, 0x4d, 0x76, 0xbb, 0xc7, 0x22, 0x69, 0xdd, 0xc0, 0xaf,
-	0xa4, 0x9e, 0x46, 0x5d, 0xc1, 0x23, 0x46, 0x36, 0x70, 0xa9, 0xab, 0x9e, 0x54, 0xd0, 0x79, 0x54,
-	0x9f, 0x5e, 0x9f, 0xb7, 0xb3, 0x9a, 0x64, 0xeb, 0xac, 0xad, 0xe7, 0x1f, 0xfe, 0xb1, 0x50, 0x68,
-	0x42, 0x86, 0xf5, 0x2d, 0xc2, 0xaf, 0xaa, 0x3d, 0x37, 0x83, 0xe0, 0xba, 0x0a, 0x35, 0xd5, 0xe2,
-	0x6d, 0x23, 0x49, 0x65, 0x4f, 0x6f, 0x3b, 0xb3, 0x6e, 0x65, 0x6f, 0xab, 0xb3, 0x76, 0x54, 0x64,
-	0x13, 0x32, 0xc8, 0x55, 0x8c, 0x93, 0xa6, 0x55, 0x8a, 0x0a, 0x6b, 0xc9, 0x06, 0xa1, 0xe3, 0xae,
-	0xd9, 0xfa, 0xb4, 0xd0, 0x1b, 0x7b, 0x9b, 0x7a, 0x0c, 0xea, 0x36, 0x07, 0x32, 0xad, 0xef, 0x11,
-	0x3e, 0x3b, 0x84, 0x07, 0xc7, 0xde, 0xc2, 0x93, 0x9a, 0x22, 0x06, 0x7c, 0xae, 0x3e, 0xbd, 0x5e,
-	0xb6, 0x75, 0xef, 0x6c, 0x33, 0x5d, 0xf6, 0x26, 0xef, 0x6f, 0x91, 0x5f, 0x7f, 0x59, 0x9d, 0xd1,
-	0xb9, 0x9b, 0xae, 0x2b, 0x7a, 0x5c, 0x7e, 0xd8, 0x34, 0x89, 0xe4, 0x5a, 0x06, 0xe7, 0x6b, 0x4f,
-	0xe4, 0xd4, 0x00, 0x29, 0xd0, 0x8b, 0xd0, 0x30, 0x5d, 0xc8, 0x48, 0x38, 0x83, 0x8b, 0x7e, 0x5b,
-	0xc9, 0xf7, 0x42, 0xb3, 0xe8, 0xb7, 0xad, 0x4f, 0xa0, 0x81, 0x26, 0x0a, 0x4e, 0xf2, 0x1e, 0x2e,
-	0x69, 0x20, 0x68, 0xe0, 0xf8, 0x07, 0x81, 0x3c, 0xab, 0x03, 0x1b, 0x7f, 0x20, 0x82, 0xb6, 0xcf,
-	0xbd, 0x11, 0xf5, 0x9f, 0x59, 0x5b, 0x8e, 0x10, 0x2e, 0xa7, 0xeb, 0xc1, 0x49, 0xde, 0xc5, 0x53,
-	0x2d, 0x1a, 0xc4, 0x13, 0x62, 0x9a, 0x72, 0x2e, 0x7b, 0x6a, 0xb6, 0x74, 0x14, 0x4c, 0xe3, 0x69,
-	0xd2, 0xb3, 0x6b, 0x48, 0x1d, 0x1a, 0xb2, 0xd3, 0xeb, 0x76, 0x83, 0xfe, 0x08, 0x41, 0x36, 0x8a,
-	0x15, 0x64, 0x35, 0x41, 0x3b, 0x13, 0x09, 0x47, 0x79, 0x1b, 0x97, 0x68, 0x27, 0x56, 0x19, 0x9a,
-	0x32, 0x9b, 0xa2, 0x30, 0xf5, 0x2f, 0x0b, 0x9f, 0x9b, 0xaf, 0x94, 0x0e, 0x57, 0x7b, 0x9a, 0x71,
-	0x78, 0x3f, 0x72, 0x43, 0x71, 0x67, 0xd4, 0x38, 0x1c, 0x22, 0x28, 0x6d, 0xc2, 0xa0, 0x74, 0x1f,
-	0x97, 0x98, 0x7a, 0x02, 0x1a, 0xe6, 0x94, 0xbe, 0x1a, 0x97, 0xfe, 0xf1, 0xcf, 0x85, 0xba, 0xe7,
-	0xcb, 0x5b, 0xbd, 0x96, 0xed, 0x8a, 0x0e, 0xdc, 0x67, 0xf0, 0x6f, 0x35, 0x6a, 0xef, 0x39, 0xb2,
-	0xdf, 0x65, 0x91, 0x4a, 0x88, 0xbe, 0x79, 0xfc, 0x60, 0xe5, 0x4c, 0xc0, 0x3c, 0xea, 0xf6, 0x77,
-	0xe3, 0x1b, 0x33, 0xfa, 0xe1, 0xf1, 0x83, 0x15, 0xd4, 0x84, 0x82, 0xa7, 0xe0, 0x9b, 0xea, 0xbe,
-	0x1a, 0x05, 0x7e, 0x13, 0xb8, 0x4d, 0x14, 0x70, 0x5f, 0xc6, 0x53, 0x54, 0x4f, 0xa6, 0xe9, 0xfe,
-	0x62, 0x76, 0xf7, 0x75, 0xde, 0xb5, 0xf8, 0x36, 0x34, 0x13, 0x60, 0x12, 0xad, 0x06, 0x9e, 0x55,
-	0x7b, 0x5f, 0x61, 0x5c, 0x74, 0xae, 0x33, 0x49, 0xdb, 0x54, 0x52, 0x03, 0x52, 0xc6, 0x13, 0xed,
-	0xf8, 0x39, 0xb0, 0xe8, 0x85, 0xf5, 0x29, 0xae, 0x66, 0xa5, 0x24, 0x33, 0xd9, 0x81, 0x67, 0xd0,
-	0xca, 0x73, 0x89, 0x9e, 0x7c, 0xef, 0x54, 0x4f, 0x93, 0x68, 0x88, 0x4c, 0x92, 0xe5, 0x98, 0x3b,
-	0x48, 0x23, 0x5e, 0x79, 0x22, 0xcf, 0x1a, 0xae, 0x0c, 0x27, 0x00, 0x4d, 0x19, 0x4f, 0xec, 0xd3,
-	0xa0, 0xc7, 0x4c, 0x86, 0x5a, 0xc4, 0xf7, 0xdc, 0x24, 0x7c, 0x25, 0x48, 0x05, 0x4f, 0xd2, 0x76,
-	0x3b, 0x64, 0x51, 0x04, 0x31, 0x66, 0x49, 0xee, 0xe0, 0x09, 0xd5, 0xb2, 0x4a, 0xf1, 0xff, 0x1a,
-	0x0b, 0x5d, 0x6f, 0x63, 0xea, 0xe0, 0x68, 0xa1, 0xf0, 0xcf, 0xd1, 0x42, 0xc1, 0xba, 0x04, 0x52,
-	0x7f, 0xc4, 0xe4, 0x66, 0x14, 0x31, 0xf9, 0x71, 0x8c, 0x3f, 0x72, 0x4e, 0x42, 0x3c, 0x97, 0x19,
-	0x0d, 0x5a, 0xec, 0xe0, 0x97, 0x38, 0x93, 0xbb, 0x34, 0x7e, 0xb5, 0xab, 0x84, 0x30, 0x73, 0x73,
-	0x21, 0x7b, 0x6e, 0x52, 0xfb, 0x40, 0x9f, 0x66, 0x78, 0x6a, 0xf3, 0xf5, 0xfb, 0x67, 0xf0, 0x84,
-	0x2a, 0x4a, 0x0e, 0x10, 0x2e, 0x69, 0xd3, 0x23, 0xf5, 0xec, 0xfd, 0x86, 0x3d, 0xb6, 0xba, 0x3c,
-	0x46, 0xa4, 0xc6, 0xb7, 0x96, 0x0f, 0x62, 0x8d, 0xbe, 0xf8, 0xed, 0xef, 0xaf, 0x8a, 0x35, 0x32,
-	0xef, 0x64, 0xfa, 0xbe, 0xb6, 0x59, 0xf2, 0x25, 0xc2, 0x38, 0xb1, 0x30, 0x72, 0x29, 0xa7, 0xc8,
-	0x90, 0x11, 0x57, 0x57, 0xc7, 0x8c, 0x06, 0xac, 0xa5, 0x04, 0x6b, 0x8e, 0xcc, 0x66, 0x63, 0xd1,
-	0x20, 0x20, 0xf7, 0x11, 0x2e, 0xe9, 0xdc, 0x5c, 0x79, 0x52, 0x8e, 0x96, 0x2b, 0x4f, 0xda, 0xd5,
-	0x2c, 0x3b, 0xe1, 0xb8, 0x40, 0x16, 0xb3, 0x39, 0xda, 0x4c, 0x52, 0x3f, 0x70, 0xee, 0xfa, 0xed,
-	0x7b, 0xb1, 0x46, 0x93, 0xe0, 0x27, 0x24, 0xaf, 0x4c, 0xda, 0xe3, 0xaa, 0x2b, 0xe3, 0x84, 0x02,
-	0x92, 0x93, 0x20, 0x5d, 0x24, 0x56, 0x36, 0xd2, 0x2d, 0x9d, 0xa3, 0x99, 0x0e, 0x11, 0x2e, 0x69,
-	0x5f, 0xc8, 0xd5, 0x28, 0x65, 0x32, 0xb9, 0x1a, 0xa5, 0x4d, 0xc6, 0x6a, 0x8c, 0xa1, 0x51, 0xa4,
-	0x52, 0x14, 0xcf, 0x41, 0x11, 0xa9, 0xb6, 0x69, 0xbf, 0xc8, 0x45, 0x4a, 0x39, 0x4f, 0x2e, 0x52,
-	0xda, 0x7c, 0xc6, 0x6a, 0x9b, 0x36, 0x0b, 0x2d, 0xd1, 0xd7, 0x08, 0x97, 0xf4, 0x7d, 0x9e, 0xcb,
-	0x93, 0x32, 0x94, 0x5c, 0x9e, 0xb4, 0xa9, 0x58, 0x6f, 0x26, 0x3c, 0x2b, 0xa4, 0xee, 0xe4, 0xfc,
-	0xba, 0x76, 0x05, 0x97, 0xa1, 0x80, 0x69, 0xfa, 0x19, 0xe1, 0x17, 0x53, 0x7e, 0x40, 0x9c, 0x9c,
-	0x9a, 0x59, 0x66, 0x53, 0x5d, 0x1b, 0x3f, 0x01, 0x58, 0xdf, 0x49, 0x58, 0xd7, 0x88, 0x9d, 0xcd,
-	0xea, 0x31, 0xa9, 0x5c, 0xc2, 0xd8, 0x8b, 0x73, 0x57, 0x2d, 0xef, 0x91, 0xef, 0x10, 0x9e, 0x1e,
-	0x70, 0x0c, 0xb2, 0x9a, 0xaf, 0xd1, 0x7f, 0xac, 0xa8, 0x6a, 0x8f, 0x1b, 0x0e, 0xac, 0x6f, 0x25,
-	0xac, 0xaf, 0x93, 0xe5, 0x91, 0xba, 0xc6, 0x79, 0x29, 0xcc, 0x9f, 0x10, 0x9e, 0x49, 0xdf, 0xe7,
-	0x24, 0x4f, 0xa8, 0x4c, 0xa3, 0xa8, 0x36, 0x9e, 0x22, 0xe3, 0x29, 0x78, 0x39, 0x93, 0xca, 0x4c,
-	0xb4, 0x97, 0xa8, 0x41, 0xd8, 0xf2, 0x1e, 0x1e, 0xd7, 0xd0, 0xa3, 0xe3, 0x1a, 0xfa, 0xeb, 0xb8,
-	0x86, 0x0e, 0x4f, 0x6a, 0x85, 0x47, 0x27, 0xb5, 0xc2, 0xef, 0x27, 0xb5, 0x02, 0x3e, 0xeb, 0x8b,
-	0x4c, 0x8c, 0x6d, 0x74, 0x73, 0x7d, 0xc0, 0x37, 0x93, 0x90, 0x55, 0x5f, 0x0c, 0xd6, 0xfd, 0xdc,
-	0x54, 0x56, 0x3e, 0xda, 0x2a, 0xa9, 0x5f, 0xeb, 0x6f, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x6c,
-	0xeb, 0xb8, 0xfb, 0x45, 0x0f, 0x00, 0x00,
+func (m *QueryNetAssetValuesResponse) GetMaxAge() uint64 {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of x/bank module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Returns a list of all markers on the blockchain
-	AllMarkers(ctx context.Context, in *QueryAllMarkersRequest, opts ...grpc.CallOption) (*QueryAllMarkersResponse, error)
-	// query for a single marker by denom or address
-	Marker(ctx context.Context, in *QueryMarkerRequest, opts ...grpc.CallOption) (*QueryMarkerResponse, error)
-	// query for all accounts holding the given marker coins
-	Holding(ctx context.Context, in *QueryHoldingRequest, opts ...grpc.CallOption) (*QueryHoldingResponse, error)
-	// query for supply of coin on a marker account
-	// This endpoint returns the initial/target supply from marker account, not actual circulating supply.
-	// Deprecated: This query is deprecated in favor of the bank module's SupplyOf query.
-	Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error)
-	// query for coins on a marker account
-	Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error)
-	// query for access records on an account
-	Access(ctx context.Context, in *QueryAccessRequest, opts ...grpc.CallOption) (*QueryAccessResponse, error)
-	// query for access records on an account
-	DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error)
-	// query for account data associated with a denom
-	AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error)
-	// NetAssetValues returns net asset values for marker
-	NetAssetValues(ctx context.Context, in *QueryNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryNetAssetValuesResponse, error)
+// QueryHolderFreezesRequest is the request type for the Query/HolderFreezes method.
+type QueryHolderFreezesRequest struct {
+	// the address or denom of the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// holder is an optional address to limit the results to.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryHolderFreezesRequest) Reset()         { *m = QueryHolderFreezesRequest{} }
+func (m *QueryHolderFreezesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHolderFreezesRequest) ProtoMessage()    {}
+func (*QueryHolderFreezesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{21}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryHolderFreezesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHolderFreezesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHolderFreezesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHolderFreezesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHolderFreezesRequest.Merge(m, src)
+}
+func (m *QueryHolderFreezesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHolderFreezesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHolderFreezesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllMarkers(ctx context.Context, in *QueryAllMarkersRequest, opts ...grpc.CallOption) (*QueryAllMarkersResponse, error) {
-	out := new(QueryAllMarkersResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/AllMarkers", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHolderFreezesRequest proto.InternalMessageInfo
+
+func (m *QueryHolderFreezesRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Marker(ctx context.Context, in *QueryMarkerRequest, opts ...grpc.CallOption) (*QueryMarkerResponse, error) {
-	out := new(QueryMarkerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Marker", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHolderFreezesRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Holding(ctx context.Context, in *QueryHoldingRequest, opts ...grpc.CallOption) (*QueryHoldingResponse, error) {
-	out := new(QueryHoldingResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Holding", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHolderFreezesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// Deprecated: Do not use.
-func (c *queryClient) Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error) {
-	out := new(QuerySupplyResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Supply", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryHolderFreezesResponse is the response type for the Query/HolderFreezes method.
+type QueryHolderFreezesResponse struct {
+	// freezes are the holder freezes on the marker (including any that have expired but not yet been removed).
+	Freezes []HolderFreeze `protobuf:"bytes,1,rep,name=freezes,proto3" json:"freezes"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error) {
-	out := new(QueryEscrowResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Escrow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHolderFreezesResponse) Reset()         { *m = QueryHolderFreezesResponse{} }
+func (m *QueryHolderFreezesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHolderFreezesResponse) ProtoMessage()    {}
+func (*QueryHolderFreezesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{22}
+}
+func (m *QueryHolderFreezesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHolderFreezesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHolderFreezesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHolderFreezesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHolderFreezesResponse.Merge(m, src)
+}
+func (m *QueryHolderFreezesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHolderFreezesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHolderFreezesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Access(ctx context.Context, in *QueryAccessRequest, opts ...grpc.CallOption) (*QueryAccessResponse, error) {
-	out := new(QueryAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Access", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHolderFreezesResponse proto.InternalMessageInfo
+
+func (m *QueryHolderFreezesResponse) GetFreezes() []HolderFreeze {
+	if m != nil {
+		return m.Freezes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error) {
-	out := new(QueryDenomMetadataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/DenomMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHolderFreezesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error) {
-	out := new(QueryAccountDataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/AccountData", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDistributionRequest is the request type for the Query/Distribution method.
+type QueryDistributionRequest struct {
+	// distribution_id is the id of the distribution to look up.
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
 }
 
-func (c *queryClient) NetAssetValues(ctx context.Context, in *QueryNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryNetAssetValuesResponse, error) {
-	out := new(QueryNetAssetValuesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/NetAssetValues", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDistributionRequest) Reset()         { *m = QueryDistributionRequest{} }
+func (m *QueryDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionRequest) ProtoMessage()    {}
+func (*QueryDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{23}
+}
+func (m *QueryDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionRequest.Merge(m, src)
+}
+func (m *QueryDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of x/bank module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Returns a list of all markers on the blockchain
-	AllMarkers(context.Context, *QueryAllMarkersRequest) (*QueryAllMarkersResponse, error)
-	// query for a single marker by denom or address
-	Marker(context.Context, *QueryMarkerRequest) (*QueryMarkerResponse, error)
-	// query for all accounts holding the given marker coins
-	Holding(context.Context, *QueryHoldingRequest) (*QueryHoldingResponse, error)
-	// query for supply of coin on a marker account
-	// This endpoint returns the initial/target supply from marker account, not actual circulating supply.
-	// Deprecated: This query is deprecated in favor of the bank module's SupplyOf query.
-	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
-	// query for coins on a marker account
-	Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error)
-	// query for access records on an account
-	Access(context.Context, *QueryAccessRequest) (*QueryAccessResponse, error)
-	// query for access records on an account
-	DenomMetadata(context.Context, *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error)
-	// query for account data associated with a denom
-	AccountData(context.Context, *QueryAccountDataRequest) (*QueryAccountDataResponse, error)
-	// NetAssetValues returns net asset values for marker
-	NetAssetValues(context.Context, *QueryNetAssetValuesRequest) (*QueryNetAssetValuesResponse, error)
+var xxx_messageInfo_QueryDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryDistributionRequest) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryDistributionResponse is the response type for the Query/Distribution method.
+type QueryDistributionResponse struct {
+	// distribution is the requested distribution.
+	Distribution Distribution `protobuf:"bytes,1,opt,name=distribution,proto3" json:"distribution"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryDistributionResponse) Reset()         { *m = QueryDistributionResponse{} }
+func (m *QueryDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionResponse) ProtoMessage()    {}
+func (*QueryDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{24}
 }
-func (*UnimplementedQueryServer) AllMarkers(ctx context.Context, req *QueryAllMarkersRequest) (*QueryAllMarkersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllMarkers not implemented")
+func (m *QueryDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Marker(ctx context.Context, req *QueryMarkerRequest) (*QueryMarkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Marker not implemented")
+func (m *QueryDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Holding(ctx context.Context, req *QueryHoldingRequest) (*QueryHoldingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Holding not implemented")
+func (m *QueryDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Supply(ctx context.Context, req *QuerySupplyRequest) (*QuerySupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Supply not implemented")
+func (m *QueryDistributionResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Escrow(ctx context.Context, req *QueryEscrowRequest) (*QueryEscrowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Escrow not implemented")
+func (m *QueryDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Access(ctx context.Context, req *QueryAccessRequest) (*QueryAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Access not implemented")
+
+var xxx_messageInfo_QueryDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionResponse) GetDistribution() Distribution {
+	if m != nil {
+		return m.Distribution
+	}
+	return Distribution{}
 }
-func (*UnimplementedQueryServer) DenomMetadata(ctx context.Context, req *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomMetadata not implemented")
+
+// QueryDistributionClaimsRequest is the request type for the Query/DistributionClaims method.
+type QueryDistributionClaimsRequest struct {
+	// holder is the address of the account to get the claims of.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) AccountData(ctx context.Context, req *QueryAccountDataRequest) (*QueryAccountDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountData not implemented")
+
+func (m *QueryDistributionClaimsRequest) Reset()         { *m = QueryDistributionClaimsRequest{} }
+func (m *QueryDistributionClaimsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionClaimsRequest) ProtoMessage()    {}
+func (*QueryDistributionClaimsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{25}
 }
-func (*UnimplementedQueryServer) NetAssetValues(ctx context.Context, req *QueryNetAssetValuesRequest) (*QueryNetAssetValuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NetAssetValues not implemented")
+func (m *QueryDistributionClaimsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryDistributionClaimsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionClaimsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionClaimsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionClaimsRequest.Merge(m, src)
+}
+func (m *QueryDistributionClaimsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionClaimsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionClaimsRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryDistributionClaimsRequest proto.InternalMessageInfo
+
+func (m *QueryDistributionClaimsRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AllMarkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMarkersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllMarkers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/AllMarkers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllMarkers(ctx, req.(*QueryAllMarkersRequest))
+func (m *QueryDistributionClaimsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Marker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMarkerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Marker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/Marker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Marker(ctx, req.(*QueryMarkerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDistributionClaimsResponse is the response type for the Query/DistributionClaims method.
+type QueryDistributionClaimsResponse struct {
+	// claims are the distribution payments waiting to be claimed by the holder.
+	Claims []DistributionPayment `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Holding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHoldingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Holding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/Holding",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Holding(ctx, req.(*QueryHoldingRequest))
+func (m *QueryDistributionClaimsResponse) Reset()         { *m = QueryDistributionClaimsResponse{} }
+func (m *QueryDistributionClaimsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionClaimsResponse) ProtoMessage()    {}
+func (*QueryDistributionClaimsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{26}
+}
+func (m *QueryDistributionClaimsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionClaimsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionClaimsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDistributionClaimsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionClaimsResponse.Merge(m, src)
+}
+func (m *QueryDistributionClaimsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionClaimsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionClaimsResponse.DiscardUnknown(m)
 }
 
-func _Query_Supply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySupplyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Supply(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/Supply",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Supply(ctx, req.(*QuerySupplyRequest))
+var xxx_messageInfo_QueryDistributionClaimsResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionClaimsResponse) GetClaims() []DistributionPayment {
+	if m != nil {
+		return m.Claims
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Escrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEscrowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Escrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/Escrow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Escrow(ctx, req.(*QueryEscrowRequest))
+func (m *QueryDistributionClaimsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Access_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Access(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/Access",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Access(ctx, req.(*QueryAccessRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryRedemptionRequest is the request type for the Query/Redemption method.
+type QueryRedemptionRequest struct {
+	// redemption_id is the id of the redemption to look up.
+	RedemptionId uint64 `protobuf:"varint,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
 }
 
-func _Query_DenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/DenomMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomMetadata(ctx, req.(*QueryDenomMetadataRequest))
+func (m *QueryRedemptionRequest) Reset()         { *m = QueryRedemptionRequest{} }
+func (m *QueryRedemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRequest) ProtoMessage()    {}
+func (*QueryRedemptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{27}
+}
+func (m *QueryRedemptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRedemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRequest.Merge(m, src)
+}
+func (m *QueryRedemptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRequest.DiscardUnknown(m)
 }
 
-func _Query_AccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/AccountData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountData(ctx, req.(*QueryAccountDataRequest))
+var xxx_messageInfo_QueryRedemptionRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionRequest) GetRedemptionId() uint64 {
+	if m != nil {
+		return m.RedemptionId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_NetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNetAssetValuesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NetAssetValues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/NetAssetValues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NetAssetValues(ctx, req.(*QueryNetAssetValuesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryRedemptionResponse is the response type for the Query/Redemption method.
+type QueryRedemptionResponse struct {
+	// redemption is the requested redemption.
+	Redemption Redemption `protobuf:"bytes,1,opt,name=redemption,proto3" json:"redemption"`
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.marker.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AllMarkers",
-			Handler:    _Query_AllMarkers_Handler,
-		},
-		{
-			MethodName: "Marker",
-			Handler:    _Query_Marker_Handler,
-		},
-		{
-			MethodName: "Holding",
-			Handler:    _Query_Holding_Handler,
-		},
-		{
-			MethodName: "Supply",
-			Handler:    _Query_Supply_Handler,
-		},
-		{
-			MethodName: "Escrow",
-			Handler:    _Query_Escrow_Handler,
-		},
-		{
-			MethodName: "Access",
-			Handler:    _Query_Access_Handler,
-		},
-		{
-			MethodName: "DenomMetadata",
-			Handler:    _Query_DenomMetadata_Handler,
-		},
-		{
-			MethodName: "AccountData",
-			Handler:    _Query_AccountData_Handler,
-		},
-		{
-			MethodName: "NetAssetValues",
-			Handler:    _Query_NetAssetValues_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/marker/v1/query.proto",
+func (m *QueryRedemptionResponse) Reset()         { *m = QueryRedemptionResponse{} }
+func (m *QueryRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionResponse) ProtoMessage()    {}
+func (*QueryRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{28}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRedemptionResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionResponse) GetRedemption() Redemption {
+	if m != nil {
+		return m.Redemption
 	}
-	return dAtA[:n], nil
+	return Redemption{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRedemptionsRequest is the request type for the Query/Redemptions method.
+// At least one of id and holder must be provided.
+type QueryRedemptionsRequest struct {
+	// id is the address or denom of the marker to get the redemptions of.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// holder is the address of the account to get the redemptions of.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRedemptionsRequest) Reset()         { *m = QueryRedemptionsRequest{} }
+func (m *QueryRedemptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionsRequest) ProtoMessage()    {}
+func (*QueryRedemptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{29}
+}
+func (m *QueryRedemptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllMarkersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRedemptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionsRequest.Merge(m, src)
 }
-
-func (m *QueryAllMarkersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRedemptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllMarkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryRedemptionsRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *QueryRedemptionsRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAllMarkersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllMarkersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRedemptionsResponse is the response type for the Query/Redemptions method.
+type QueryRedemptionsResponse struct {
+	// redemptions are the pending redemptions.
+	Redemptions []Redemption `protobuf:"bytes,1,rep,name=redemptions,proto3" json:"redemptions"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllMarkersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Markers) > 0 {
-		for iNdEx := len(m.Markers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRedemptionsResponse) Reset()         { *m = QueryRedemptionsResponse{} }
+func (m *QueryRedemptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionsResponse) ProtoMessage()    {}
+func (*QueryRedemptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{30}
+}
+func (m *QueryRedemptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryMarkerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRedemptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionsResponse.Merge(m, src)
 }
-
-func (m *QueryMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRedemptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryRedemptionsResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionsResponse) GetRedemptions() []Redemption {
+	if m != nil {
+		return m.Redemptions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryMarkerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTransferLimitsRequest is the request type for the Query/TransferLimits method.
+type QueryTransferLimitsRequest struct {
+	// id is the address or denom of the marker.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// holder is the optional address of an account to get the sent volume of.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
 }
 
-func (m *QueryMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Marker != nil {
-		{
-			size, err := m.Marker.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTransferLimitsRequest) Reset()         { *m = QueryTransferLimitsRequest{} }
+func (m *QueryTransferLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferLimitsRequest) ProtoMessage()    {}
+func (*QueryTransferLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{31}
+}
+func (m *QueryTransferLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTransferLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferLimitsRequest.Merge(m, src)
+}
+func (m *QueryTransferLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferLimitsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryHoldingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTransferLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryTransferLimitsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryHoldingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTransferLimitsRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
 }
 
-func (m *QueryHoldingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryTransferLimitsResponse is the response type for the Query/TransferLimits method.
+type QueryTransferLimitsResponse struct {
+	// limits are the marker's transfer limits. Both limits are zero if the marker does not have any.
+	Limits TransferLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits"`
+	// marker_volume is the amount sent by all holders in the current 24 hour window.
+	MarkerVolume cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=marker_volume,json=markerVolume,proto3,customtype=cosmossdk.io/math.Int" json:"marker_volume"`
+	// holder_volume is the amount sent by the requested holder in the current 24 hour window.
+	HolderVolume cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=holder_volume,json=holderVolume,proto3,customtype=cosmossdk.io/math.Int" json:"holder_volume"`
+}
+
+func (m *QueryTransferLimitsResponse) Reset()         { *m = QueryTransferLimitsResponse{} }
+func (m *QueryTransferLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferLimitsResponse) ProtoMessage()    {}
+func (*QueryTransferLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{32}
+}
+func (m *QueryTransferLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTransferLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferLimitsResponse.Merge(m, src)
+}
+func (m *QueryTransferLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferLimitsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryHoldingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTransferLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryTransferLimitsResponse) GetLimits() TransferLimits {
+	if m != nil {
+		return m.Limits
 	}
-	return dAtA[:n], nil
+	return TransferLimits{}
 }
 
-func (m *QueryHoldingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAccessGrantTermsRequest is the request type for the Query/AccessGrantTerms method.
+type QueryAccessGrantTermsRequest struct {
+	// id is the address or denom of the marker.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address is the address of the account with the access grant.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryHoldingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Balances) > 0 {
-		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAccessGrantTermsRequest) Reset()         { *m = QueryAccessGrantTermsRequest{} }
+func (m *QueryAccessGrantTermsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccessGrantTermsRequest) ProtoMessage()    {}
+func (*QueryAccessGrantTermsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{33}
+}
+func (m *QueryAccessGrantTermsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccessGrantTermsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccessGrantTermsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAccessGrantTermsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccessGrantTermsRequest.Merge(m, src)
 }
-
-func (m *QuerySupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAccessGrantTermsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccessGrantTermsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccessGrantTermsRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAccessGrantTermsRequest proto.InternalMessageInfo
+
+func (m *QueryAccessGrantTermsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QuerySupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccessGrantTermsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAccessGrantTermsResponse is the response type for the Query/AccessGrantTerms method.
+type QueryAccessGrantTermsResponse struct {
+	// terms are the terms of the access grant. Nil if the grant does not have any.
+	Terms *AccessGrantTerms `protobuf:"bytes,1,opt,name=terms,proto3" json:"terms,omitempty"`
+	// mint_volume is the amount minted by the address in the current 24 hour window.
+	MintVolume cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=mint_volume,json=mintVolume,proto3,customtype=cosmossdk.io/math.Int" json:"mint_volume"`
 }
 
-func (m *QuerySupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAccessGrantTermsResponse) Reset()         { *m = QueryAccessGrantTermsResponse{} }
+func (m *QueryAccessGrantTermsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccessGrantTermsResponse) ProtoMessage()    {}
+func (*QueryAccessGrantTermsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{34}
+}
+func (m *QueryAccessGrantTermsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccessGrantTermsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccessGrantTermsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAccessGrantTermsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccessGrantTermsResponse.Merge(m, src)
+}
+func (m *QueryAccessGrantTermsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccessGrantTermsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccessGrantTermsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEscrowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccessGrantTermsResponse proto.InternalMessageInfo
+
+func (m *QueryAccessGrantTermsResponse) GetTerms() *AccessGrantTerms {
+	if m != nil {
+		return m.Terms
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryApprovalThresholdsRequest is the request type for the Query/ApprovalThresholds method.
+type QueryApprovalThresholdsRequest struct {
+	// id is the address or denom of the marker.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryApprovalThresholdsRequest) Reset()         { *m = QueryApprovalThresholdsRequest{} }
+func (m *QueryApprovalThresholdsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryApprovalThresholdsRequest) ProtoMessage()    {}
+func (*QueryApprovalThresholdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{35}
+}
+func (m *QueryApprovalThresholdsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryApprovalThresholdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryApprovalThresholdsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryApprovalThresholdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryApprovalThresholdsRequest.Merge(m, src)
+}
+func (m *QueryApprovalThresholdsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryApprovalThresholdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryApprovalThresholdsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEscrowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryApprovalThresholdsRequest proto.InternalMessageInfo
+
+func (m *QueryApprovalThresholdsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryApprovalThresholdsResponse is the response type for the Query/ApprovalThresholds method.
+type QueryApprovalThresholdsResponse struct {
+	// thresholds are the marker's approval thresholds. Permissions without a threshold are not included.
+	Thresholds []ApprovalThreshold `protobuf:"bytes,1,rep,name=thresholds,proto3" json:"thresholds"`
 }
 
-func (m *QueryEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Escrow) > 0 {
-		for iNdEx := len(m.Escrow) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Escrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryApprovalThresholdsResponse) Reset()         { *m = QueryApprovalThresholdsResponse{} }
+func (m *QueryApprovalThresholdsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryApprovalThresholdsResponse) ProtoMessage()    {}
+func (*QueryApprovalThresholdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{36}
+}
+func (m *QueryApprovalThresholdsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryApprovalThresholdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryApprovalThresholdsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryApprovalThresholdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryApprovalThresholdsResponse.Merge(m, src)
+}
+func (m *QueryApprovalThresholdsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryApprovalThresholdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryApprovalThresholdsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryApprovalThresholdsResponse proto.InternalMessageInfo
+
+func (m *QueryApprovalThresholdsResponse) GetThresholds() []ApprovalThreshold {
+	if m != nil {
+		return m.Thresholds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingOperationRequest is the request type for the Query/PendingOperation method.
+type QueryPendingOperationRequest struct {
+	// operation_id is the id of the pending operation to look up.
+	OperationId uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 }
 
-func (m *QueryAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryPendingOperationRequest) Reset()         { *m = QueryPendingOperationRequest{} }
+func (m *QueryPendingOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingOperationRequest) ProtoMessage()    {}
+func (*QueryPendingOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{37}
+}
+func (m *QueryPendingOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingOperationRequest.Merge(m, src)
+}
+func (m *QueryPendingOperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingOperationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingOperationRequest proto.InternalMessageInfo
+
+func (m *QueryPendingOperationRequest) GetOperationId() uint64 {
+	if m != nil {
+		return m.OperationId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingOperationResponse is the response type for the Query/PendingOperation method.
+type QueryPendingOperationResponse struct {
+	// operation is the requested pending operation.
+	Operation PendingOperation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation"`
 }
 
-func (m *QueryAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPendingOperationResponse) Reset()         { *m = QueryPendingOperationResponse{} }
+func (m *QueryPendingOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingOperationResponse) ProtoMessage()    {}
+func (*QueryPendingOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{38}
+}
+func (m *QueryPendingOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingOperationResponse.Merge(m, src)
+}
+func (m *QueryPendingOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingOperationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingOperationResponse proto.InternalMessageInfo
+
+func (m *QueryPendingOperationResponse) GetOperation() PendingOperation {
+	if m != nil {
+		return m.Operation
 	}
-	return dAtA[:n], nil
+	return PendingOperation{}
 }
 
-func (m *QueryDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingOperationsRequest is the request type for the Query/PendingOperations method.
+type QueryPendingOperationsRequest struct {
+	// id is the address or denom of the marker to get the pending operations of.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryPendingOperationsRequest) Reset()         { *m = QueryPendingOperationsRequest{} }
+func (m *QueryPendingOperationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingOperationsRequest) ProtoMessage()    {}
+func (*QueryPendingOperationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{39}
+}
+func (m *QueryPendingOperationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingOperationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingOperationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingOperationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingOperationsRequest.Merge(m, src)
+}
+func (m *QueryPendingOperationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingOperationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingOperationsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingOperationsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingOperationsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingOperationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+// QueryPendingOperationsResponse is the response type for the Query/PendingOperations method.
+type QueryPendingOperationsResponse struct {
+	// operations are the marker's pending operations.
+	Operations []PendingOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingOperationsResponse) Reset()         { *m = QueryPendingOperationsResponse{} }
+func (m *QueryPendingOperationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingOperationsResponse) ProtoMessage()    {}
+func (*QueryPendingOperationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{40}
+}
+func (m *QueryPendingOperationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingOperationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingOperationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAccountDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPendingOperationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingOperationsResponse.Merge(m, src)
 }
-
-func (m *QueryAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingOperationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingOperationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingOperationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryPendingOperationsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingOperationsResponse) GetOperations() []PendingOperation {
+	if m != nil {
+		return m.Operations
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAccountDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingOperationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAttributeRequirementsRequest is the request type for the Query/AttributeRequirements method.
+type QueryAttributeRequirementsRequest struct {
+	// id is the address or denom of the marker.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAttributeRequirementsRequest) Reset()         { *m = QueryAttributeRequirementsRequest{} }
+func (m *QueryAttributeRequirementsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeRequirementsRequest) ProtoMessage()    {}
+func (*QueryAttributeRequirementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{41}
+}
+func (m *QueryAttributeRequirementsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeRequirementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeRequirementsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAttributeRequirementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeRequirementsRequest.Merge(m, src)
+}
+func (m *QueryAttributeRequirementsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeRequirementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeRequirementsRequest.DiscardUnknown(m)
 }
 
-func (m *Balance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAttributeRequirementsRequest proto.InternalMessageInfo
+
+func (m *QueryAttributeRequirementsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Balance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAttributeRequirementsResponse is the response type for the Query/AttributeRequirements method.
+type QueryAttributeRequirementsResponse struct {
+	// requirements are the marker's attribute requirements.
+	Requirements []AttributeRequirement `protobuf:"bytes,1,rep,name=requirements,proto3" json:"requirements"`
 }
 
-func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryAttributeRequirementsResponse) Reset()         { *m = QueryAttributeRequirementsResponse{} }
+func (m *QueryAttributeRequirementsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeRequirementsResponse) ProtoMessage()    {}
+func (*QueryAttributeRequirementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{42}
+}
+func (m *QueryAttributeRequirementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeRequirementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeRequirementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAttributeRequirementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeRequirementsResponse.Merge(m, src)
+}
+func (m *QueryAttributeRequirementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeRequirementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeRequirementsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAttributeRequirementsResponse proto.InternalMessageInfo
+
+func (m *QueryAttributeRequirementsResponse) GetRequirements() []AttributeRequirement {
+	if m != nil {
+		return m.Requirements
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCapTableRequest is the request type for the Query/CapTable method.
+type QueryCapTableRequest struct {
+	// id is the address or denom of the marker.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCapTableRequest) Reset()         { *m = QueryCapTableRequest{} }
+func (m *QueryCapTableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCapTableRequest) ProtoMessage()    {}
+func (*QueryCapTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{43}
+}
+func (m *QueryCapTableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCapTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCapTableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCapTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCapTableRequest.Merge(m, src)
+}
+func (m *QueryCapTableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCapTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCapTableRequest.DiscardUnknown(m)
 }
 
-func (m *QueryNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCapTableRequest proto.InternalMessageInfo
+
+func (m *QueryCapTableRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCapTableRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// QueryCapTableResponse is the response type for the Query/CapTable method.
+type QueryCapTableResponse struct {
+	// holders are the entries of the cap table, in the same order as the bank module's denom owners.
+	// A page can have fewer entries than requested since the accounts that aren't holders are skipped.
+	Holders []CapTableEntry `protobuf:"bytes,1,rep,name=holders,proto3" json:"holders"`
+	// holder_count is the total number of holders of the marker's coin. It is only set if pagination.count_total is true.
+	HolderCount uint64 `protobuf:"varint,2,opt,name=holder_count,json=holderCount,proto3" json:"holder_count,omitempty"`
+	// supply is the total supply of the marker's coin.
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCapTableResponse) Reset()         { *m = QueryCapTableResponse{} }
+func (m *QueryCapTableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCapTableResponse) ProtoMessage()    {}
+func (*QueryCapTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{44}
+}
+func (m *QueryCapTableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCapTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCapTableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCapTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCapTableResponse.Merge(m, src)
+}
+func (m *QueryCapTableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCapTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCapTableResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryCapTableResponse proto.InternalMessageInfo
+
+func (m *QueryCapTableResponse) GetHolders() []CapTableEntry {
+	if m != nil {
+		return m.Holders
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryCapTableResponse) GetHolderCount() uint64 {
+	if m != nil {
+		return m.HolderCount
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCapTableResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllMarkersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryHolderSnapshotsRequest is the request type for the Query/HolderSnapshots method.
+type QueryHolderSnapshotsRequest struct {
+	// id is the address or denom of the marker.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllMarkersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Markers) > 0 {
-		for _, e := range m.Markers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryHolderSnapshotsRequest) Reset()         { *m = QueryHolderSnapshotsRequest{} }
+func (m *QueryHolderSnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHolderSnapshotsRequest) ProtoMessage()    {}
+func (*QueryHolderSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{45}
+}
+func (m *QueryHolderSnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHolderSnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHolderSnapshotsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryHolderSnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHolderSnapshotsRequest.Merge(m, src)
+}
+func (m *QueryHolderSnapshotsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHolderSnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHolderSnapshotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHolderSnapshotsRequest proto.InternalMessageInfo
+
+func (m *QueryHolderSnapshotsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return ""
 }
 
-func (m *QueryMarkerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHolderSnapshotsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return nil
 }
 
-func (m *QueryMarkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Marker != nil {
-		l = m.Marker.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryHolderSnapshotsResponse is the response type for the Query/HolderSnapshots method.
+type QueryHolderSnapshotsResponse struct {
+	// snapshots are the marker's holder snapshots, oldest first.
+	Snapshots []HolderSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryHoldingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryHolderSnapshotsResponse) Reset()         { *m = QueryHolderSnapshotsResponse{} }
+func (m *QueryHolderSnapshotsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHolderSnapshotsResponse) ProtoMessage()    {}
+func (*QueryHolderSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{46}
+}
+func (m *QueryHolderSnapshotsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHolderSnapshotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHolderSnapshotsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryHolderSnapshotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHolderSnapshotsResponse.Merge(m, src)
+}
+func (m *QueryHolderSnapshotsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHolderSnapshotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHolderSnapshotsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHolderSnapshotsResponse proto.InternalMessageInfo
+
+func (m *QueryHolderSnapshotsResponse) GetSnapshots() []HolderSnapshot {
+	if m != nil {
+		return m.Snapshots
 	}
-	return n
+	return nil
 }
 
-func (m *QueryHoldingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHolderSnapshotsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryHolderSnapshotRequest is the request type for the Query/HolderSnapshot method.
+type QueryHolderSnapshotRequest struct {
+	// snapshot_id is the id of the holder snapshot to look up.
+	SnapshotId uint64 `protobuf:"varint,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	// pagination defines an optional pagination for the snapshot's holders.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHolderSnapshotRequest) Reset()         { *m = QueryHolderSnapshotRequest{} }
+func (m *QueryHolderSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHolderSnapshotRequest) ProtoMessage()    {}
+func (*QueryHolderSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{47}
+}
+func (m *QueryHolderSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHolderSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHolderSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryHolderSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHolderSnapshotRequest.Merge(m, src)
+}
+func (m *QueryHolderSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHolderSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHolderSnapshotRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryHolderSnapshotRequest proto.InternalMessageInfo
+
+func (m *QueryHolderSnapshotRequest) GetSnapshotId() uint64 {
+	if m != nil {
+		return m.SnapshotId
 	}
-	return n
+	return 0
 }
 
-func (m *QuerySupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHolderSnapshotRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryEscrowRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryHolderSnapshotResponse is the response type for the Query/HolderSnapshot method.
+type QueryHolderSnapshotResponse struct {
+	// snapshot is the requested holder snapshot.
+	Snapshot HolderSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot"`
+	// holders are the entries of the snapshot's cap table, ordered by address.
+	Holders []CapTableEntry `protobuf:"bytes,2,rep,name=holders,proto3" json:"holders"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEscrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Escrow) > 0 {
-		for _, e := range m.Escrow {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryHolderSnapshotResponse) Reset()         { *m = QueryHolderSnapshotResponse{} }
+func (m *QueryHolderSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHolderSnapshotResponse) ProtoMessage()    {}
+func (*QueryHolderSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{48}
+}
+func (m *QueryHolderSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHolderSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHolderSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryHolderSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHolderSnapshotResponse.Merge(m, src)
+}
+func (m *QueryHolderSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHolderSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHolderSnapshotResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryHolderSnapshotResponse proto.InternalMessageInfo
+
+func (m *QueryHolderSnapshotResponse) GetSnapshot() HolderSnapshot {
+	if m != nil {
+		return m.Snapshot
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return HolderSnapshot{}
+}
+
+func (m *QueryHolderSnapshotResponse) GetHolders() []CapTableEntry {
+	if m != nil {
+		return m.Holders
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHolderSnapshotResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryForcedTransfersRequest is the request type for the Query/ForcedTransfers method.
+type QueryForcedTransfersRequest struct {
+	// id is the address or denom of the marker.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryForcedTransfersRequest) Reset()         { *m = QueryForcedTransfersRequest{} }
+func (m *QueryForcedTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryForcedTransfersRequest) ProtoMessage()    {}
+func (*QueryForcedTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{49}
+}
+func (m *QueryForcedTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForcedTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForcedTransfersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryForcedTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForcedTransfersRequest.Merge(m, src)
+}
+func (m *QueryForcedTransfersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForcedTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForcedTransfersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDenomMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryForcedTransfersRequest proto.InternalMessageInfo
+
+func (m *QueryForcedTransfersRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return ""
 }
 
-func (m *QueryDenomMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryForcedTransfersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Metadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAccountDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryForcedTransfersResponse is the response type for the Query/ForcedTransfers method.
+type QueryForcedTransfersResponse struct {
+	// records are the marker's forced transfer records, oldest first.
+	Records []ForcedTransferRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAccountDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryForcedTransfersResponse) Reset()         { *m = QueryForcedTransfersResponse{} }
+func (m *QueryForcedTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForcedTransfersResponse) ProtoMessage()    {}
+func (*QueryForcedTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{50}
+}
+func (m *QueryForcedTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForcedTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForcedTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
+func (m *QueryForcedTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForcedTransfersResponse.Merge(m, src)
+}
+func (m *QueryForcedTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForcedTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForcedTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForcedTransfersResponse proto.InternalMessageInfo
+
+func (m *QueryForcedTransfersResponse) GetRecords() []ForcedTransferRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryForcedTransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountForcedTransfersRequest is the request type for the Query/AccountForcedTransfers method.
+type QueryAccountForcedTransfersRequest struct {
+	// address is the bech32 address of the account that coins were taken from.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountForcedTransfersRequest) Reset()         { *m = QueryAccountForcedTransfersRequest{} }
+func (m *QueryAccountForcedTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountForcedTransfersRequest) ProtoMessage()    {}
+func (*QueryAccountForcedTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{51}
+}
+func (m *QueryAccountForcedTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountForcedTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountForcedTransfersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountForcedTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountForcedTransfersRequest.Merge(m, src)
+}
+func (m *QueryAccountForcedTransfersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountForcedTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountForcedTransfersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountForcedTransfersRequest proto.InternalMessageInfo
+
+func (m *QueryAccountForcedTransfersRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAccountForcedTransfersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountForcedTransfersResponse is the response type for the Query/AccountForcedTransfers method.
+type QueryAccountForcedTransfersResponse struct {
+	// records are the forced transfer records of the account, oldest first.
+	Records []ForcedTransferRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountForcedTransfersResponse) Reset()         { *m = QueryAccountForcedTransfersResponse{} }
+func (m *QueryAccountForcedTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountForcedTransfersResponse) ProtoMessage()    {}
+func (*QueryAccountForcedTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{52}
+}
+func (m *QueryAccountForcedTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountForcedTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountForcedTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountForcedTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountForcedTransfersResponse.Merge(m, src)
+}
+func (m *QueryAccountForcedTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountForcedTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountForcedTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountForcedTransfersResponse proto.InternalMessageInfo
+
+func (m *QueryAccountForcedTransfersResponse) GetRecords() []ForcedTransferRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryAccountForcedTransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIssuanceScheduleRequest is the request type for the Query/IssuanceSchedule method.
+type QueryIssuanceScheduleRequest struct {
+	// id is the address or denom of the marker.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryIssuanceScheduleRequest) Reset()         { *m = QueryIssuanceScheduleRequest{} }
+func (m *QueryIssuanceScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuanceScheduleRequest) ProtoMessage()    {}
+func (*QueryIssuanceScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{53}
+}
+func (m *QueryIssuanceScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIssuanceScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIssuanceScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIssuanceScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuanceScheduleRequest.Merge(m, src)
+}
+func (m *QueryIssuanceScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIssuanceScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuanceScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIssuanceScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryIssuanceScheduleRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryIssuanceScheduleResponse is the response type for the Query/IssuanceSchedule method.
+type QueryIssuanceScheduleResponse struct {
+	// schedule is the marker's issuance schedule.
+	Schedule IssuanceSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
+	// supply is the current supply of the marker's coin.
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	// unlocked is the total of the tranches that have become mintable.
+	Unlocked cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=unlocked,proto3,customtype=cosmossdk.io/math.Int" json:"unlocked"`
+	// mintable is the amount that can be minted now, taking both the tranches and the hard cap into account.
+	Mintable cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=mintable,proto3,customtype=cosmossdk.io/math.Int" json:"mintable"`
+	// scheduled is the total of the tranches that have not become mintable yet.
+	Scheduled cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=scheduled,proto3,customtype=cosmossdk.io/math.Int" json:"scheduled"`
+}
+
+func (m *QueryIssuanceScheduleResponse) Reset()         { *m = QueryIssuanceScheduleResponse{} }
+func (m *QueryIssuanceScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuanceScheduleResponse) ProtoMessage()    {}
+func (*QueryIssuanceScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{54}
+}
+func (m *QueryIssuanceScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIssuanceScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIssuanceScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIssuanceScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuanceScheduleResponse.Merge(m, src)
+}
+func (m *QueryIssuanceScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIssuanceScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuanceScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIssuanceScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryIssuanceScheduleResponse) GetSchedule() IssuanceSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return IssuanceSchedule{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.marker.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.marker.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAllMarkersRequest)(nil), "provenance.marker.v1.QueryAllMarkersRequest")
+	proto.RegisterType((*QueryAllMarkersResponse)(nil), "provenance.marker.v1.QueryAllMarkersResponse")
+	proto.RegisterType((*QueryMarkerRequest)(nil), "provenance.marker.v1.QueryMarkerRequest")
+	proto.RegisterType((*QueryMarkerResponse)(nil), "provenance.marker.v1.QueryMarkerResponse")
+	proto.RegisterType((*QueryHoldingRequest)(nil), "provenance.marker.v1.QueryHoldingRequest")
+	proto.RegisterType((*QueryHoldingResponse)(nil), "provenance.marker.v1.QueryHoldingResponse")
+	proto.RegisterType((*QuerySupplyRequest)(nil), "provenance.marker.v1.QuerySupplyRequest")
+	proto.RegisterType((*QuerySupplyResponse)(nil), "provenance.marker.v1.QuerySupplyResponse")
+	proto.RegisterType((*QueryEscrowRequest)(nil), "provenance.marker.v1.QueryEscrowRequest")
+	proto.RegisterType((*QueryEscrowResponse)(nil), "provenance.marker.v1.QueryEscrowResponse")
+	proto.RegisterType((*QueryAccessRequest)(nil), "provenance.marker.v1.QueryAccessRequest")
+	proto.RegisterType((*QueryAccessResponse)(nil), "provenance.marker.v1.QueryAccessResponse")
+	proto.RegisterType((*QueryDenomMetadataRequest)(nil), "provenance.marker.v1.QueryDenomMetadataRequest")
+	proto.RegisterType((*QueryDenomMetadataResponse)(nil), "provenance.marker.v1.QueryDenomMetadataResponse")
+	proto.RegisterType((*QueryAccountDataRequest)(nil), "provenance.marker.v1.QueryAccountDataRequest")
+	proto.RegisterType((*QueryAccountDataResponse)(nil), "provenance.marker.v1.QueryAccountDataResponse")
+	proto.RegisterType((*Balance)(nil), "provenance.marker.v1.Balance")
+	proto.RegisterType((*QueryNetAssetValuesRequest)(nil), "provenance.marker.v1.QueryNetAssetValuesRequest")
+	proto.RegisterType((*QueryNetAssetValuesResponse)(nil), "provenance.marker.v1.QueryNetAssetValuesResponse")
+	proto.RegisterType((*QueryHolderFreezesRequest)(nil), "provenance.marker.v1.QueryHolderFreezesRequest")
+	proto.RegisterType((*QueryHolderFreezesResponse)(nil), "provenance.marker.v1.QueryHolderFreezesResponse")
+	proto.RegisterType((*QueryDistributionRequest)(nil), "provenance.marker.v1.QueryDistributionRequest")
+	proto.RegisterType((*QueryDistributionResponse)(nil), "provenance.marker.v1.QueryDistributionResponse")
+	proto.RegisterType((*QueryDistributionClaimsRequest)(nil), "provenance.marker.v1.QueryDistributionClaimsRequest")
+	proto.RegisterType((*QueryDistributionClaimsResponse)(nil), "provenance.marker.v1.QueryDistributionClaimsResponse")
+	proto.RegisterType((*QueryRedemptionRequest)(nil), "provenance.marker.v1.QueryRedemptionRequest")
+	proto.RegisterType((*QueryRedemptionResponse)(nil), "provenance.marker.v1.QueryRedemptionResponse")
+	proto.RegisterType((*QueryRedemptionsRequest)(nil), "provenance.marker.v1.QueryRedemptionsRequest")
+	proto.RegisterType((*QueryRedemptionsResponse)(nil), "provenance.marker.v1.QueryRedemptionsResponse")
+	proto.RegisterType((*QueryTransferLimitsRequest)(nil), "provenance.marker.v1.QueryTransferLimitsRequest")
+	proto.RegisterType((*QueryTransferLimitsResponse)(nil), "provenance.marker.v1.QueryTransferLimitsResponse")
+	proto.RegisterType((*QueryAccessGrantTermsRequest)(nil), "provenance.marker.v1.QueryAccessGrantTermsRequest")
+	proto.RegisterType((*QueryAccessGrantTermsResponse)(nil), "provenance.marker.v1.QueryAccessGrantTermsResponse")
+	proto.RegisterType((*QueryApprovalThresholdsRequest)(nil), "provenance.marker.v1.QueryApprovalThresholdsRequest")
+	proto.RegisterType((*QueryApprovalThresholdsResponse)(nil), "provenance.marker.v1.QueryApprovalThresholdsResponse")
+	proto.RegisterType((*QueryPendingOperationRequest)(nil), "provenance.marker.v1.QueryPendingOperationRequest")
+	proto.RegisterType((*QueryPendingOperationResponse)(nil), "provenance.marker.v1.QueryPendingOperationResponse")
+	proto.RegisterType((*QueryPendingOperationsRequest)(nil), "provenance.marker.v1.QueryPendingOperationsRequest")
+	proto.RegisterType((*QueryPendingOperationsResponse)(nil), "provenance.marker.v1.QueryPendingOperationsResponse")
+	proto.RegisterType((*QueryAttributeRequirementsRequest)(nil), "provenance.marker.v1.QueryAttributeRequirementsRequest")
+	proto.RegisterType((*QueryAttributeRequirementsResponse)(nil), "provenance.marker.v1.QueryAttributeRequirementsResponse")
+	proto.RegisterType((*QueryCapTableRequest)(nil), "provenance.marker.v1.QueryCapTableRequest")
+	proto.RegisterType((*QueryCapTableResponse)(nil), "provenance.marker.v1.QueryCapTableResponse")
+	proto.RegisterType((*QueryHolderSnapshotsRequest)(nil), "provenance.marker.v1.QueryHolderSnapshotsRequest")
+	proto.RegisterType((*QueryHolderSnapshotsResponse)(nil), "provenance.marker.v1.QueryHolderSnapshotsResponse")
+	proto.RegisterType((*QueryHolderSnapshotRequest)(nil), "provenance.marker.v1.QueryHolderSnapshotRequest")
+	proto.RegisterType((*QueryHolderSnapshotResponse)(nil), "provenance.marker.v1.QueryHolderSnapshotResponse")
+	proto.RegisterType((*QueryForcedTransfersRequest)(nil), "provenance.marker.v1.QueryForcedTransfersRequest")
+	proto.RegisterType((*QueryForcedTransfersResponse)(nil), "provenance.marker.v1.QueryForcedTransfersResponse")
+	proto.RegisterType((*QueryAccountForcedTransfersRequest)(nil), "provenance.marker.v1.QueryAccountForcedTransfersRequest")
+	proto.RegisterType((*QueryAccountForcedTransfersResponse)(nil), "provenance.marker.v1.QueryAccountForcedTransfersResponse")
+	proto.RegisterType((*QueryIssuanceScheduleRequest)(nil), "provenance.marker.v1.QueryIssuanceScheduleRequest")
+	proto.RegisterType((*QueryIssuanceScheduleResponse)(nil), "provenance.marker.v1.QueryIssuanceScheduleResponse")
+}
+
+func init() { proto.RegisterFile("provenance/marker/v1/query.proto", fileDescriptor_a76fb1fac8494cdc) }
+
+var fileDescriptor_a76fb1fac8494cdc = []byte{
+	// 2784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xf7, 0xdd, 0xd8, 0x6b, 0xe7, 0xd8, 0x71, 0xd3, 0x1b, 0xb7, 0xd9, 0x4c, 0x63, 0x3b, 0x99,
+	0x58, 0xa9, 0xed, 0xd6, 0x3b, 0xfe, 0x88, 0x9b, 0x8f, 0xd2, 0x0f, 0xdb, 0x69, 0x52, 0xa3, 0x96,
+	0xa6, 0x6b, 0xab, 0x48, 0x95, 0xd0, 0x6a, 0xbc, 0x7b, 0xbd, 0x1e, 0x79, 0x77, 0x66, 0x3b, 0x33,
+	0xeb, 0xd6, 0xb5, 0x2c, 0x21, 0x24, 0xa4, 0x20, 0x84, 0x14, 0x04, 0xe2, 0x01, 0x15, 0x29, 0x12,
+	0x08, 0x15, 0xa4, 0x42, 0xa1, 0x15, 0x95, 0xfa, 0x80, 0x80, 0x17, 0xaa, 0x3e, 0x55, 0x14, 0x21,
+	0xc4, 0x43, 0x8b, 0x1a, 0xa4, 0xc2, 0x33, 0x7f, 0x00, 0x68, 0xee, 0x3d, 0x77, 0x3e, 0x76, 0x67,
+	0xc6, 0xb3, 0xc1, 0x01, 0x5e, 0x12, 0xcf, 0x9d, 0xf3, 0x3b, 0xe7, 0x77, 0xce, 0xb9, 0x5f, 0x73,
+	0xce, 0xc2, 0x99, 0xa6, 0x6d, 0xed, 0x30, 0x53, 0x37, 0x2b, 0x4c, 0x6b, 0xe8, 0xf6, 0x36, 0xb3,
+	0xb5, 0x9d, 0x39, 0xed, 0x95, 0x16, 0xb3, 0x77, 0x8b, 0x4d, 0xdb, 0x72, 0x2d, 0x3a, 0x12, 0x48,
+	0x14, 0x85, 0x44, 0x71, 0x67, 0x4e, 0xb9, 0x5f, 0x6f, 0x18, 0xa6, 0xa5, 0xf1, 0x7f, 0x85, 0xa0,
+	0x32, 0x52, 0xb3, 0x6a, 0x16, 0xff, 0x53, 0xf3, 0xfe, 0xc2, 0xd1, 0x53, 0x35, 0xcb, 0xaa, 0xd5,
+	0x99, 0xc6, 0x9f, 0x36, 0x5a, 0x9b, 0x9a, 0x6e, 0xa2, 0x66, 0x65, 0xba, 0x62, 0x39, 0x0d, 0xcb,
+	0xd1, 0x36, 0x74, 0x87, 0x09, 0x93, 0xda, 0xce, 0xdc, 0x06, 0x73, 0xf5, 0x39, 0xad, 0xa9, 0xd7,
+	0x0c, 0x53, 0x77, 0x0d, 0xcb, 0x44, 0xd9, 0xb1, 0xb0, 0xac, 0x94, 0xaa, 0x58, 0x46, 0xe7, 0x7b,
+	0x73, 0xdb, 0x7f, 0xef, 0x3d, 0x48, 0x1a, 0xe2, 0x7d, 0x59, 0xf0, 0x13, 0x0f, 0xf8, 0xea, 0x34,
+	0x32, 0xd4, 0x9b, 0x86, 0xa6, 0x9b, 0xa6, 0xe5, 0x72, 0xbb, 0xf2, 0xed, 0x78, 0x3b, 0x7f, 0xd7,
+	0x68, 0x30, 0xc7, 0xd5, 0x1b, 0x4d, 0x14, 0x38, 0x1b, 0x1b, 0x41, 0xf1, 0x17, 0x8a, 0x9c, 0x8f,
+	0x15, 0xd1, 0x2b, 0x15, 0xe6, 0x38, 0x35, 0x5b, 0x37, 0x5d, 0x94, 0x7b, 0x08, 0x9d, 0x90, 0xb1,
+	0x08, 0xe7, 0x41, 0x1d, 0x01, 0xfa, 0xa2, 0xf7, 0x78, 0x43, 0xb7, 0xf5, 0x86, 0x53, 0x62, 0xaf,
+	0xb4, 0x98, 0xe3, 0xaa, 0x2f, 0xc2, 0x89, 0xc8, 0xa8, 0xd3, 0xb4, 0x4c, 0x87, 0xd1, 0x2b, 0x90,
+	0x6f, 0xf2, 0x91, 0x02, 0x39, 0x43, 0x26, 0x07, 0xe7, 0x4f, 0x17, 0xe3, 0xb2, 0x58, 0x14, 0xa8,
+	0xe5, 0xde, 0x0f, 0x3e, 0x19, 0xef, 0x29, 0x21, 0x42, 0x7d, 0x83, 0xc0, 0x83, 0x5c, 0xe7, 0x52,
+	0xbd, 0xfe, 0x3c, 0x17, 0x95, 0xd6, 0x3c, 0xb5, 0x8e, 0xab, 0xbb, 0x2d, 0xa1, 0x76, 0x78, 0x5e,
+	0x8d, 0x57, 0x2b, 0x50, 0x6b, 0x5c, 0xb2, 0x84, 0x08, 0x7a, 0x0d, 0x20, 0xc8, 0x6a, 0x21, 0xc7,
+	0x69, 0x9d, 0x2f, 0x62, 0x26, 0xbc, 0xb4, 0x16, 0x85, 0xb7, 0x98, 0xbc, 0xe2, 0x0d, 0xbd, 0xc6,
+	0xd0, 0x6e, 0x29, 0x84, 0x54, 0x7f, 0x4c, 0xe0, 0x64, 0x07, 0x3d, 0x74, 0x7b, 0x19, 0xfa, 0x05,
+	0x0b, 0x8f, 0xe0, 0x91, 0xc9, 0xc1, 0xf9, 0x91, 0xa2, 0x48, 0x5f, 0x51, 0xa6, 0xaf, 0xb8, 0x64,
+	0xee, 0x2e, 0xd3, 0x0f, 0xdf, 0x9d, 0x19, 0x16, 0xd8, 0xa5, 0x4a, 0xc5, 0x6a, 0x99, 0xee, 0x6a,
+	0x49, 0x02, 0xe9, 0xf5, 0x18, 0x9e, 0x0f, 0x1f, 0xc8, 0x53, 0x10, 0x88, 0x10, 0x9d, 0xc0, 0x84,
+	0x09, 0x43, 0x32, 0x84, 0xc3, 0x90, 0x33, 0xaa, 0x3c, 0x7c, 0x47, 0x4b, 0x39, 0xa3, 0xaa, 0x7e,
+	0x19, 0x4e, 0x44, 0xa4, 0xd0, 0x93, 0xa7, 0x21, 0x2f, 0x08, 0x61, 0x02, 0xb3, 0x3b, 0x82, 0x38,
+	0xb5, 0x81, 0x8a, 0x9f, 0xb5, 0xea, 0x55, 0xc3, 0xac, 0x25, 0xd8, 0x3f, 0xb4, 0xb4, 0xdc, 0x26,
+	0x30, 0x12, 0xb5, 0x87, 0x9e, 0x3c, 0x05, 0x03, 0x1b, 0x7a, 0xdd, 0x9b, 0x21, 0x32, 0x29, 0xa3,
+	0xf1, 0xb3, 0x66, 0x59, 0x48, 0xe1, 0x6c, 0xf4, 0x41, 0x87, 0x97, 0x90, 0x49, 0x4c, 0xc8, 0x5a,
+	0xab, 0xd9, 0xac, 0xef, 0x26, 0x04, 0xe4, 0x4a, 0xae, 0x40, 0xd4, 0x12, 0x9c, 0x88, 0x48, 0xa2,
+	0x2b, 0x17, 0x21, 0xaf, 0x37, 0xbc, 0x28, 0x63, 0x52, 0x4e, 0x45, 0x58, 0x48, 0xfb, 0x2b, 0x96,
+	0x61, 0xca, 0x25, 0x25, 0xc4, 0xb9, 0x4e, 0x39, 0x1d, 0x9e, 0x71, 0x2a, 0xb6, 0xf5, 0x6a, 0xd2,
+	0x74, 0xb8, 0x45, 0xe0, 0x44, 0x44, 0x0c, 0x4d, 0xef, 0x42, 0x9e, 0xf1, 0x11, 0x8c, 0x61, 0x8a,
+	0xe9, 0x6b, 0x9e, 0xe9, 0x9f, 0x7e, 0x3a, 0x3e, 0x59, 0x33, 0xdc, 0xad, 0xd6, 0x46, 0xb1, 0x62,
+	0x35, 0x70, 0xc3, 0xc3, 0xff, 0x66, 0x9c, 0xea, 0xb6, 0xe6, 0xee, 0x36, 0x99, 0xc3, 0x01, 0xce,
+	0xf7, 0x3f, 0x7f, 0x7b, 0x7a, 0xa8, 0xce, 0x6a, 0x7a, 0x65, 0xb7, 0xec, 0x6d, 0xa9, 0xce, 0x9b,
+	0x9f, 0xbf, 0x3d, 0x4d, 0x4a, 0x68, 0xd0, 0x27, 0xbe, 0xc4, 0xf7, 0xab, 0x24, 0xe2, 0x2f, 0xc3,
+	0x89, 0x88, 0x14, 0xf2, 0x5e, 0x81, 0x01, 0x5d, 0xcc, 0x4c, 0x99, 0xfd, 0xb3, 0xf1, 0xd9, 0x17,
+	0xb8, 0xeb, 0xde, 0x6e, 0x28, 0x67, 0x80, 0x04, 0xaa, 0x73, 0x70, 0x8a, 0xeb, 0xbe, 0xca, 0x4c,
+	0xab, 0xf1, 0x3c, 0x73, 0xf5, 0xaa, 0xee, 0xea, 0x92, 0xc8, 0x08, 0xf4, 0x55, 0xbd, 0x71, 0xe4,
+	0x22, 0x1e, 0xd4, 0xaf, 0x80, 0x12, 0x07, 0x09, 0xe6, 0x64, 0x03, 0xc7, 0x30, 0x95, 0xa3, 0x41,
+	0x3c, 0xcd, 0x6d, 0x3f, 0x9e, 0x12, 0x28, 0x19, 0x49, 0x90, 0xaa, 0xc9, 0x3d, 0x48, 0x50, 0xbc,
+	0x7a, 0x20, 0x9f, 0x59, 0x28, 0x74, 0x02, 0x90, 0xcd, 0x08, 0xf4, 0xed, 0xe8, 0xf5, 0x16, 0x93,
+	0x08, 0xfe, 0xe0, 0xed, 0x73, 0xfd, 0xb8, 0x24, 0x68, 0x01, 0xfa, 0xf5, 0x6a, 0xd5, 0x66, 0x8e,
+	0x83, 0x32, 0xf2, 0x91, 0xbe, 0x0a, 0x7d, 0x3c, 0x65, 0x85, 0xdc, 0x7f, 0x6b, 0x5a, 0x08, 0x7b,
+	0x57, 0x06, 0x6e, 0xde, 0x1e, 0xef, 0xf9, 0xfb, 0xed, 0xf1, 0x1e, 0xf5, 0x8d, 0x1c, 0xc6, 0xfa,
+	0x4b, 0xcc, 0x5d, 0x72, 0x1c, 0xe6, 0xbe, 0xe4, 0xf1, 0x4f, 0x9a, 0x28, 0x74, 0x1c, 0x06, 0x9b,
+	0xb6, 0x51, 0x61, 0x65, 0x11, 0xa5, 0x1c, 0x7f, 0x01, 0x7c, 0x88, 0x27, 0x8b, 0x2e, 0x42, 0x9f,
+	0xee, 0x94, 0xad, 0xcd, 0xc2, 0x11, 0x9e, 0x19, 0xa5, 0x63, 0xe7, 0x5b, 0x97, 0x27, 0xf0, 0x72,
+	0xef, 0xad, 0x4f, 0xc7, 0x49, 0xa9, 0x57, 0x77, 0x5e, 0xd8, 0xf4, 0x62, 0xb4, 0x65, 0x38, 0xae,
+	0x65, 0xef, 0x16, 0x7a, 0xcf, 0x90, 0xc9, 0x81, 0x92, 0x7c, 0xa4, 0x4f, 0x01, 0x38, 0xae, 0x6e,
+	0xbb, 0x65, 0xef, 0xe8, 0x2e, 0xf4, 0x65, 0xd4, 0x7a, 0x94, 0x63, 0xbc, 0x51, 0xfa, 0x38, 0x0c,
+	0x30, 0xb3, 0x2a, 0xe0, 0xf9, 0x8c, 0xf0, 0x7e, 0x66, 0x56, 0xbd, 0x31, 0xf5, 0x4f, 0x04, 0x1e,
+	0x8a, 0x0d, 0x0f, 0x66, 0x7f, 0x0d, 0x8e, 0x9b, 0xcc, 0x2d, 0xeb, 0xde, 0xab, 0x32, 0x4f, 0xbd,
+	0x5c, 0x29, 0xe7, 0xe2, 0x57, 0x4a, 0x44, 0x0f, 0xce, 0xcc, 0x61, 0x33, 0xa2, 0x9c, 0xae, 0x06,
+	0xc1, 0x10, 0x13, 0x63, 0x2a, 0x83, 0xae, 0x12, 0xab, 0x58, 0x76, 0x15, 0x35, 0xfa, 0xd1, 0x3b,
+	0xe9, 0x9d, 0xa9, 0xaf, 0x95, 0xf5, 0x1a, 0xe3, 0x09, 0xe9, 0xf5, 0x0e, 0x98, 0xd7, 0x96, 0x6a,
+	0x4c, 0xfd, 0x26, 0x81, 0x53, 0xfe, 0x8e, 0xcf, 0xec, 0x6b, 0x36, 0x63, 0xaf, 0x27, 0xa7, 0xfd,
+	0x41, 0xc8, 0x6f, 0x71, 0x39, 0xcc, 0x38, 0x3e, 0xb5, 0x9d, 0x3f, 0x47, 0xee, 0xfa, 0xfc, 0xf9,
+	0x09, 0x01, 0x25, 0x8e, 0x4d, 0x70, 0x33, 0xd8, 0x14, 0x43, 0x18, 0xdc, 0x84, 0xab, 0x4b, 0x18,
+	0x2d, 0x23, 0x81, 0xc0, 0xc3, 0x3b, 0x88, 0x56, 0x70, 0x33, 0xb8, 0x6a, 0x38, 0xae, 0x6d, 0x6c,
+	0xb4, 0xbc, 0x41, 0x19, 0xb7, 0x87, 0xe1, 0xbe, 0x6a, 0x68, 0xb8, 0x8c, 0x41, 0xec, 0x2d, 0x0d,
+	0x87, 0x87, 0x57, 0xab, 0xaa, 0x21, 0x37, 0xc5, 0x88, 0x12, 0x74, 0xf7, 0x39, 0x18, 0x0a, 0x8b,
+	0xe3, 0x26, 0x97, 0xe0, 0x73, 0x58, 0x03, 0xfa, 0x1c, 0x41, 0xab, 0x5f, 0x25, 0x30, 0xd6, 0x61,
+	0x6b, 0xa5, 0xae, 0x1b, 0xfe, 0x3d, 0x34, 0x94, 0x5e, 0x92, 0x92, 0xde, 0xbb, 0xbf, 0x5e, 0xbc,
+	0x43, 0x60, 0x3c, 0x91, 0x02, 0x3a, 0x7d, 0x1d, 0xf2, 0x15, 0x3e, 0x52, 0x20, 0x69, 0x73, 0x3e,
+	0xac, 0xe1, 0x86, 0xbe, 0xdb, 0x60, 0xfe, 0x89, 0x83, 0xf0, 0xc3, 0x4b, 0xf4, 0x13, 0x78, 0x93,
+	0x2e, 0xb1, 0x2a, 0x6b, 0x34, 0xc3, 0x69, 0x3e, 0x07, 0xc7, 0x6c, 0x7f, 0x30, 0x48, 0xf2, 0x50,
+	0x30, 0xb8, 0x5a, 0x55, 0x75, 0x38, 0xd9, 0x01, 0x47, 0x5f, 0xaf, 0x01, 0x04, 0xa2, 0x98, 0xde,
+	0x33, 0xf1, 0xfe, 0x06, 0x68, 0x74, 0x33, 0x84, 0x54, 0xbf, 0x41, 0x3a, 0x6c, 0xfc, 0xcf, 0x96,
+	0xf0, 0x5b, 0x04, 0x0a, 0x9d, 0x5c, 0xd0, 0xe1, 0x67, 0x61, 0x30, 0xa0, 0x2d, 0x33, 0x9c, 0xd5,
+	0xe3, 0x30, 0xf4, 0xf0, 0xb2, 0x7b, 0x15, 0x77, 0x9c, 0x75, 0x5b, 0x37, 0x9d, 0x4d, 0x66, 0x3f,
+	0x67, 0x34, 0x0c, 0xb7, 0xdb, 0xe8, 0xa9, 0xff, 0x90, 0xe7, 0x43, 0xbb, 0x1a, 0x7f, 0xe7, 0xca,
+	0xd7, 0xf9, 0x08, 0x66, 0x79, 0x22, 0xde, 0xe7, 0x28, 0x5a, 0x4e, 0x68, 0x81, 0xa4, 0xcb, 0x70,
+	0x4c, 0x48, 0x96, 0x77, 0xac, 0x7a, 0xab, 0xc1, 0x04, 0x85, 0xe5, 0x51, 0x4f, 0xe8, 0x2f, 0x9f,
+	0x8c, 0x3f, 0x20, 0x9c, 0x77, 0xaa, 0xdb, 0x45, 0xc3, 0xd2, 0x1a, 0xba, 0xbb, 0x55, 0x5c, 0x35,
+	0xdd, 0xd2, 0x90, 0xc0, 0xbc, 0xc4, 0x21, 0x9e, 0x0e, 0xc1, 0x58, 0xea, 0x38, 0x92, 0x49, 0x87,
+	0xc0, 0x08, 0x1d, 0xea, 0xb3, 0x70, 0x3a, 0x74, 0x49, 0xe4, 0x97, 0xbd, 0x75, 0x66, 0x37, 0x12,
+	0x63, 0x16, 0xba, 0xf7, 0xe4, 0x22, 0xf7, 0x1e, 0xf5, 0x07, 0x04, 0x46, 0x13, 0x54, 0x61, 0xdc,
+	0xbe, 0x00, 0x7d, 0xae, 0x37, 0x80, 0x61, 0x3b, 0x7f, 0xe0, 0xb5, 0x53, 0xc0, 0x05, 0x88, 0x3e,
+	0x09, 0x83, 0x0d, 0xc3, 0x74, 0xbb, 0x8a, 0x17, 0x78, 0x08, 0xf4, 0x74, 0x16, 0x77, 0xcc, 0xa5,
+	0xa6, 0x67, 0x56, 0xaf, 0xaf, 0x6f, 0xd9, 0xcc, 0xf1, 0x62, 0x91, 0x78, 0x81, 0x6e, 0xc2, 0x78,
+	0x22, 0x02, 0x5d, 0x7a, 0x1e, 0xc0, 0xf5, 0x47, 0x71, 0x09, 0x3c, 0x9c, 0xe0, 0x57, 0xbb, 0x16,
+	0xb9, 0xf6, 0x03, 0x05, 0xea, 0x12, 0x66, 0xe3, 0x06, 0x33, 0xbd, 0x2f, 0xb6, 0x17, 0x9a, 0xcc,
+	0xd6, 0xc3, 0x7b, 0xd4, 0x59, 0x18, 0xb2, 0xe4, 0x58, 0xb0, 0x45, 0x0d, 0xfa, 0x63, 0xab, 0x55,
+	0x75, 0x1b, 0x46, 0x13, 0x54, 0x20, 0xe5, 0x2f, 0xc2, 0x51, 0x5f, 0x3e, 0x3d, 0x13, 0xed, 0x2a,
+	0x90, 0x70, 0x00, 0x57, 0x5f, 0x4d, 0x30, 0xe6, 0xdc, 0xeb, 0x6f, 0xdb, 0xf7, 0xe4, 0xf9, 0x17,
+	0x63, 0xd9, 0x3f, 0x70, 0xc1, 0x27, 0x2a, 0x53, 0xd3, 0x9d, 0xa3, 0x21, 0xfc, 0xe1, 0x6d, 0x51,
+	0x0b, 0x70, 0x56, 0x4c, 0x2a, 0x57, 0x1c, 0x79, 0xdc, 0x3d, 0xc3, 0x66, 0xde, 0xa1, 0x97, 0x38,
+	0x13, 0x5f, 0x07, 0x35, 0x0d, 0x84, 0x1e, 0xaf, 0xc3, 0x90, 0x1d, 0x1a, 0x47, 0x9f, 0xa7, 0x13,
+	0xa6, 0x63, 0x8c, 0x2a, 0x79, 0xd5, 0x08, 0x6b, 0x51, 0x4d, 0xac, 0x22, 0xac, 0xe8, 0xcd, 0x75,
+	0x7d, 0xa3, 0xce, 0xee, 0x75, 0x6a, 0xff, 0x45, 0xe0, 0x81, 0x36, 0x83, 0xfe, 0x97, 0x6b, 0xbf,
+	0xd8, 0xbb, 0x0e, 0xb8, 0x8e, 0x4b, 0xe0, 0x33, 0xa6, 0x6b, 0xef, 0xfa, 0x97, 0x67, 0x81, 0xf4,
+	0x96, 0x10, 0x6e, 0x9a, 0xfc, 0xb3, 0x8f, 0x13, 0xed, 0x2d, 0x0d, 0x8a, 0xb1, 0x15, 0x6f, 0x88,
+	0x2e, 0x42, 0xde, 0xe1, 0x65, 0x86, 0x6c, 0x1b, 0x2a, 0x0a, 0xb7, 0x4d, 0x91, 0xde, 0xbb, 0x9f,
+	0x22, 0x2d, 0x3c, 0x7e, 0xc4, 0xcd, 0x77, 0xcd, 0xd4, 0x9b, 0xce, 0x96, 0xe5, 0xde, 0xf3, 0x35,
+	0xf5, 0x0b, 0x02, 0xa7, 0xe3, 0xed, 0xfa, 0x07, 0xfe, 0x51, 0x47, 0x0e, 0x62, 0x06, 0x26, 0xd2,
+	0xee, 0xec, 0x52, 0x83, 0xdc, 0x37, 0x7c, 0xf0, 0xe1, 0xad, 0xa6, 0xaf, 0x47, 0xbf, 0x31, 0xa4,
+	0x45, 0x19, 0xaa, 0x71, 0x18, 0x94, 0x46, 0x83, 0xed, 0x12, 0xe4, 0xd0, 0xea, 0xe1, 0xc5, 0xee,
+	0x9f, 0x24, 0x36, 0x67, 0xa1, 0xcb, 0xe1, 0x80, 0xb4, 0x9a, 0x7e, 0x69, 0x88, 0x8d, 0x9c, 0x8f,
+	0x0d, 0x2f, 0x81, 0xdc, 0x5d, 0x2f, 0x81, 0xeb, 0x31, 0xb7, 0xc3, 0xff, 0x68, 0xa2, 0x5e, 0xb3,
+	0xec, 0x0a, 0xab, 0xca, 0xfb, 0xce, 0x3d, 0x9f, 0xa8, 0xef, 0xc8, 0x89, 0xda, 0x61, 0xd7, 0x3f,
+	0xe2, 0xfa, 0x6d, 0xfe, 0xe5, 0x7c, 0xc0, 0x1e, 0x18, 0xc5, 0x47, 0x3f, 0xb6, 0x51, 0xc1, 0xe1,
+	0x4d, 0xd5, 0xdb, 0x04, 0xd4, 0x70, 0xc1, 0x29, 0x21, 0x68, 0xf3, 0x6d, 0x85, 0xa5, 0xe5, 0xc2,
+	0x1f, 0xde, 0x9d, 0x19, 0x41, 0x7b, 0x4b, 0xe2, 0xcd, 0x9a, 0x6b, 0x7b, 0xf5, 0x5c, 0x29, 0x78,
+	0x68, 0x81, 0x7d, 0x9f, 0xc0, 0xb9, 0x54, 0x8a, 0xff, 0xcf, 0xf1, 0x2d, 0xe2, 0xa4, 0x58, 0x75,
+	0x9c, 0x96, 0xc7, 0x63, 0xad, 0xb2, 0xc5, 0xaa, 0xad, 0xc4, 0xf3, 0x4a, 0xfd, 0x38, 0x07, 0xa3,
+	0x09, 0x00, 0x7f, 0xbf, 0x1b, 0x70, 0x70, 0x2c, 0xfd, 0xa2, 0xd4, 0xae, 0xc1, 0x5f, 0xb6, 0xf8,
+	0x1c, 0x3a, 0x51, 0x72, 0xdd, 0x9c, 0x28, 0x97, 0x61, 0xa0, 0x65, 0xd6, 0xad, 0xca, 0x36, 0xab,
+	0x66, 0x3b, 0x8a, 0x7c, 0x71, 0x0f, 0xea, 0xdd, 0x7d, 0xbd, 0x3d, 0xa0, 0xd0, 0x9b, 0x09, 0x2a,
+	0xc5, 0xe9, 0xe3, 0x70, 0x54, 0x12, 0xaf, 0x16, 0xfa, 0xb2, 0x60, 0x03, 0xf9, 0xf9, 0xdf, 0x4f,
+	0x40, 0x1f, 0x8f, 0x2a, 0xbd, 0x49, 0x20, 0x2f, 0xba, 0x59, 0x74, 0x32, 0x3e, 0x6c, 0x9d, 0xcd,
+	0x33, 0x65, 0x2a, 0x83, 0xa4, 0xc8, 0x8e, 0x3a, 0x75, 0xd3, 0x2b, 0x7e, 0x7e, 0xed, 0xe3, 0xbf,
+	0x7d, 0x27, 0x37, 0x46, 0x4f, 0x6b, 0xb1, 0x0d, 0x3d, 0xd1, 0x3f, 0xa3, 0xdf, 0x26, 0x00, 0x41,
+	0x6f, 0x8a, 0x3e, 0x9a, 0x62, 0xa4, 0xa3, 0xc3, 0xa6, 0xcc, 0x64, 0x94, 0x46, 0x5a, 0xe7, 0x03,
+	0x5a, 0x0f, 0xd1, 0x53, 0xf1, 0xb4, 0xf4, 0x7a, 0x9d, 0x7e, 0x8b, 0x40, 0x5e, 0x60, 0x53, 0xc3,
+	0x13, 0x69, 0x55, 0x29, 0x53, 0x19, 0x24, 0x91, 0x47, 0x31, 0xe0, 0x71, 0x8e, 0x9e, 0x8d, 0xe7,
+	0x51, 0x65, 0xae, 0x6e, 0xd4, 0xb5, 0x3d, 0xa3, 0xba, 0xef, 0xc5, 0xa8, 0x1f, 0x1b, 0x45, 0x34,
+	0xcd, 0x4c, 0xb4, 0x79, 0xa5, 0x4c, 0x67, 0x11, 0x45, 0x4a, 0x5a, 0x40, 0x69, 0x82, 0xaa, 0xf1,
+	0x94, 0xb6, 0x04, 0x46, 0x70, 0xba, 0x45, 0x20, 0x2f, 0x1a, 0x3e, 0xa9, 0x31, 0x8a, 0x74, 0x8f,
+	0x94, 0xa9, 0x0c, 0x92, 0x48, 0x68, 0x2e, 0x43, 0x8c, 0xc4, 0x52, 0xe4, 0x7c, 0x6e, 0xe6, 0x08,
+	0x4f, 0x9b, 0x68, 0x04, 0xa5, 0x52, 0x8a, 0xb4, 0x94, 0x94, 0xa9, 0x0c, 0x92, 0x5d, 0xa4, 0x4d,
+	0x74, 0x81, 0x44, 0x88, 0xbe, 0x4b, 0x20, 0x2f, 0xbe, 0x98, 0x53, 0xf9, 0x44, 0x3a, 0x45, 0xca,
+	0x54, 0x06, 0x49, 0xe4, 0xb3, 0x18, 0xf0, 0x99, 0xa6, 0x93, 0x5a, 0x4a, 0xdb, 0xbc, 0x62, 0x99,
+	0xae, 0x6d, 0xe1, 0x6c, 0xfa, 0x39, 0x81, 0x63, 0x91, 0x46, 0x0f, 0xd5, 0x52, 0x6c, 0xc6, 0x75,
+	0x91, 0x94, 0xd9, 0xec, 0x00, 0xe4, 0xfa, 0x78, 0xc0, 0x75, 0x96, 0x16, 0xe3, 0xb9, 0xd6, 0x98,
+	0xcb, 0xbb, 0x1c, 0xb2, 0x6f, 0xa4, 0xed, 0xf1, 0xc7, 0x7d, 0xfa, 0x23, 0x02, 0x83, 0xa1, 0x56,
+	0x10, 0x9d, 0x49, 0x8f, 0x51, 0x5b, 0x8f, 0x49, 0x29, 0x66, 0x15, 0x47, 0xae, 0x8f, 0x05, 0x5c,
+	0x1f, 0xa1, 0x53, 0x89, 0x71, 0xf5, 0x70, 0x11, 0x9a, 0x6f, 0x11, 0x18, 0x8e, 0xb6, 0x2d, 0x68,
+	0x5a, 0xa0, 0x62, 0x1b, 0x40, 0xca, 0x5c, 0x17, 0x88, 0x2e, 0xf8, 0x9a, 0xcc, 0xe5, 0x3d, 0x13,
+	0xd1, 0x32, 0x11, 0x13, 0xe1, 0x87, 0x04, 0x8e, 0x45, 0xea, 0xff, 0xa9, 0x13, 0x21, 0xae, 0x6f,
+	0xa1, 0xcc, 0x66, 0x07, 0x74, 0xb1, 0xd1, 0x60, 0x0b, 0x41, 0xb0, 0xfc, 0x25, 0x81, 0xa1, 0x70,
+	0x11, 0x9a, 0xa6, 0xa5, 0x33, 0xa6, 0x47, 0xa0, 0x68, 0x99, 0xe5, 0x91, 0xe2, 0x52, 0x40, 0xf1,
+	0x31, 0x7a, 0x21, 0x61, 0x7b, 0x0e, 0x01, 0xb5, 0xbd, 0xb6, 0x1e, 0xc4, 0x3e, 0xfd, 0x1d, 0x01,
+	0xda, 0x59, 0x7b, 0xa7, 0x17, 0x32, 0x52, 0x89, 0x74, 0x0b, 0x94, 0xc5, 0x2e, 0x51, 0xe8, 0xc6,
+	0x53, 0x81, 0x1b, 0x17, 0xe8, 0xfc, 0xc1, 0x6e, 0x94, 0x45, 0x3d, 0x5f, 0xdb, 0x13, 0xdf, 0x22,
+	0xfb, 0xf4, 0x4d, 0x02, 0x10, 0x14, 0x87, 0x53, 0x8f, 0xe6, 0x8e, 0x92, 0xbd, 0x32, 0x93, 0x51,
+	0x1a, 0xc9, 0x3e, 0x11, 0x90, 0x9d, 0xa7, 0xb3, 0xf1, 0x64, 0x83, 0xb2, 0xb4, 0xb6, 0x17, 0x69,
+	0x07, 0xec, 0xd3, 0x37, 0x08, 0x0c, 0x96, 0x42, 0x55, 0xeb, 0x6c, 0xd6, 0x9d, 0x2c, 0x3b, 0x44,
+	0x4c, 0x79, 0x3d, 0xd3, 0x49, 0x10, 0x2e, 0xa2, 0xff, 0x8c, 0xc0, 0x70, 0xb4, 0xe4, 0x9c, 0xba,
+	0x33, 0xc4, 0x96, 0xc8, 0x95, 0xb9, 0x2e, 0x10, 0xc8, 0xf3, 0x62, 0xc0, 0xf3, 0x51, 0x3a, 0x1d,
+	0xcf, 0xd3, 0x45, 0x68, 0x59, 0x54, 0xbf, 0xc5, 0xa2, 0xfb, 0x2d, 0x81, 0xe3, 0xed, 0xc5, 0x5e,
+	0x3a, 0x7f, 0xe0, 0xd1, 0xd4, 0x51, 0xa3, 0x56, 0x16, 0xba, 0xc2, 0x20, 0xed, 0xab, 0x01, 0xed,
+	0xcb, 0xf4, 0x62, 0xda, 0xc1, 0x56, 0xe6, 0x3f, 0x08, 0x2b, 0xf3, 0x2a, 0x34, 0x67, 0xae, 0xed,
+	0xe1, 0x87, 0xd7, 0x3e, 0xfd, 0x35, 0x01, 0xda, 0x59, 0x1e, 0x4e, 0x5d, 0x83, 0x89, 0xf5, 0x67,
+	0x65, 0xb1, 0x4b, 0x54, 0x17, 0xc7, 0x9e, 0x8e, 0xf0, 0x72, 0x50, 0x6c, 0x16, 0x49, 0xf8, 0x0d,
+	0x81, 0xe3, 0xed, 0xe5, 0xcf, 0xd4, 0x24, 0x24, 0x94, 0xa6, 0x95, 0x85, 0xae, 0x30, 0x48, 0x7d,
+	0x25, 0xa0, 0x7e, 0x89, 0x3e, 0x96, 0x70, 0x87, 0x17, 0xe0, 0xb2, 0x5f, 0x8c, 0xd5, 0xf6, 0xc2,
+	0x35, 0xf0, 0x7d, 0xfa, 0x3e, 0x81, 0xfb, 0xdb, 0x2d, 0x38, 0xb4, 0x1b, 0x3e, 0x7e, 0x06, 0x2e,
+	0x74, 0x07, 0x42, 0x2f, 0xae, 0x04, 0x5e, 0x68, 0x74, 0x26, 0xa3, 0x17, 0x18, 0xff, 0x0f, 0x09,
+	0x3c, 0x10, 0x5b, 0xd5, 0xa5, 0x17, 0xd3, 0x66, 0x43, 0x4a, 0xf1, 0x58, 0xb9, 0xd4, 0x3d, 0x10,
+	0x1d, 0x79, 0x32, 0x70, 0x64, 0x81, 0xce, 0x25, 0xcc, 0x24, 0xa9, 0xa1, 0x1c, 0xae, 0x12, 0x0b,
+	0x67, 0xbe, 0x47, 0x60, 0x40, 0x56, 0x9e, 0x68, 0xda, 0x97, 0x41, 0x5b, 0x2d, 0x59, 0x79, 0x24,
+	0x93, 0x6c, 0xe7, 0xad, 0xfd, 0x3c, 0x9d, 0x88, 0x67, 0x59, 0xd1, 0x9b, 0x65, 0xfe, 0x35, 0xeb,
+	0x9f, 0xef, 0xf7, 0xb5, 0x55, 0x35, 0xe9, 0xdc, 0x81, 0xd7, 0x8a, 0xf6, 0xca, 0xab, 0x32, 0xdf,
+	0x0d, 0x04, 0xd9, 0x5e, 0x0a, 0xd8, 0xce, 0xd0, 0x47, 0x92, 0x3f, 0x7a, 0x98, 0x5d, 0xf6, 0xeb,
+	0xa3, 0x82, 0xf4, 0x7b, 0x04, 0x86, 0xa3, 0x5a, 0xe9, 0x6c, 0x66, 0x02, 0x59, 0x36, 0xf4, 0xf8,
+	0x5a, 0xa5, 0xfa, 0x74, 0xc0, 0x78, 0x91, 0x2e, 0x64, 0x62, 0xac, 0xed, 0x85, 0xca, 0xac, 0x22,
+	0xdc, 0x6d, 0xb5, 0xa3, 0xd4, 0x70, 0xc7, 0x97, 0xc2, 0x94, 0xf9, 0x6e, 0x20, 0x5d, 0x84, 0x7b,
+	0x93, 0x63, 0xcb, 0xf2, 0x50, 0xc2, 0x70, 0xff, 0x91, 0xc0, 0x83, 0xf1, 0x75, 0x2f, 0x7a, 0xe9,
+	0xe0, 0xcb, 0x7d, 0x82, 0x0b, 0x97, 0xef, 0x02, 0x89, 0x9e, 0x3c, 0x13, 0x78, 0x72, 0x85, 0x5e,
+	0x4a, 0xfd, 0x42, 0x28, 0x77, 0x7a, 0xe4, 0x9f, 0x50, 0xbf, 0x22, 0x70, 0xbc, 0xbd, 0x3e, 0x95,
+	0xba, 0xc1, 0x27, 0xd4, 0xcf, 0x94, 0x85, 0xae, 0x30, 0xe8, 0xc4, 0xe5, 0xc0, 0x89, 0x22, 0x7d,
+	0x34, 0xde, 0x09, 0x03, 0xc1, 0x65, 0x59, 0x45, 0xe2, 0xf9, 0x58, 0xae, 0x7d, 0xf0, 0xd9, 0x18,
+	0xf9, 0xe8, 0xb3, 0x31, 0xf2, 0xd7, 0xcf, 0xc6, 0xc8, 0xad, 0x3b, 0x63, 0x3d, 0x1f, 0xdd, 0x19,
+	0xeb, 0xf9, 0xf3, 0x9d, 0xb1, 0x1e, 0x38, 0x69, 0x58, 0xb1, 0x5c, 0x6e, 0x90, 0x97, 0xe7, 0x43,
+	0x3f, 0xa5, 0x0b, 0x44, 0x66, 0x0c, 0x2b, 0x6c, 0xfa, 0x35, 0x69, 0x9c, 0xff, 0xb4, 0x6e, 0x23,
+	0xcf, 0x7f, 0x31, 0xb6, 0xf0, 0xef, 0x01, 0x00, 0xe2, 0x89, 0x53, 0x29, 0x79, 0x2f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of x/bank module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Returns a list of all markers on the blockchain
+	AllMarkers(ctx context.Context, in *QueryAllMarkersRequest, opts ...grpc.CallOption) (*QueryAllMarkersResponse, error)
+	// query for a single marker by denom or address
+	Marker(ctx context.Context, in *QueryMarkerRequest, opts ...grpc.CallOption) (*QueryMarkerResponse, error)
+	// query for all accounts holding the given marker coins
+	Holding(ctx context.Context, in *QueryHoldingRequest, opts ...grpc.CallOption) (*QueryHoldingResponse, error)
+	// query for supply of coin on a marker account
+	// This endpoint returns the initial/target supply from marker account, not actual circulating supply.
+	// Deprecated: This query is deprecated in favor of the bank module's SupplyOf query.
+	Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error)
+	// query for coins on a marker account
+	Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error)
+	// query for access records on an account
+	Access(ctx context.Context, in *QueryAccessRequest, opts ...grpc.CallOption) (*QueryAccessResponse, error)
+	// query for access records on an account
+	DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error)
+	// query for account data associated with a denom
+	AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error)
+	// NetAssetValues returns net asset values for marker
+	NetAssetValues(ctx context.Context, in *QueryNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryNetAssetValuesResponse, error)
+	// HolderFreezes returns the freezes of a marker's coins in holder accounts.
+	HolderFreezes(ctx context.Context, in *QueryHolderFreezesRequest, opts ...grpc.CallOption) (*QueryHolderFreezesResponse, error)
+	// Distribution returns a distribution to a marker's holders.
+	Distribution(ctx context.Context, in *QueryDistributionRequest, opts ...grpc.CallOption) (*QueryDistributionResponse, error)
+	// DistributionClaims returns the distribution payments that are waiting to be claimed by a holder.
+	DistributionClaims(ctx context.Context, in *QueryDistributionClaimsRequest, opts ...grpc.CallOption) (*QueryDistributionClaimsResponse, error)
+	// Redemption returns a pending redemption.
+	Redemption(ctx context.Context, in *QueryRedemptionRequest, opts ...grpc.CallOption) (*QueryRedemptionResponse, error)
+	// Redemptions returns the pending redemptions of a marker and/or a holder.
+	Redemptions(ctx context.Context, in *QueryRedemptionsRequest, opts ...grpc.CallOption) (*QueryRedemptionsResponse, error)
+	// TransferLimits returns the transfer limits of a marker and how much has been sent in the current window.
+	TransferLimits(ctx context.Context, in *QueryTransferLimitsRequest, opts ...grpc.CallOption) (*QueryTransferLimitsResponse, error)
+	// AccessGrantTerms returns the terms of an address's access grant on a marker and how much it has minted in the
+	// current window.
+	AccessGrantTerms(ctx context.Context, in *QueryAccessGrantTermsRequest, opts ...grpc.CallOption) (*QueryAccessGrantTermsResponse, error)
+	// ApprovalThresholds returns the approval thresholds of a marker's permissions.
+	ApprovalThresholds(ctx context.Context, in *QueryApprovalThresholdsRequest, opts ...grpc.CallOption) (*QueryApprovalThresholdsResponse, error)
+	// PendingOperation returns an operation that is waiting for approvals.
+	PendingOperation(ctx context.Context, in *QueryPendingOperationRequest, opts ...grpc.CallOption) (*QueryPendingOperationResponse, error)
+	// PendingOperations returns the operations of a marker that are waiting for approvals.
+	PendingOperations(ctx context.Context, in *QueryPendingOperationsRequest, opts ...grpc.CallOption) (*QueryPendingOperationsResponse, error)
+	// AttributeRequirements returns the attribute value conditions that the recipients of a marker's coins must meet.
+	AttributeRequirements(ctx context.Context, in *QueryAttributeRequirementsRequest, opts ...grpc.CallOption) (*QueryAttributeRequirementsResponse, error)
+	// CapTable returns the current holders of a marker's coin with a breakdown of their amounts.
+	CapTable(ctx context.Context, in *QueryCapTableRequest, opts ...grpc.CallOption) (*QueryCapTableResponse, error)
+	// HolderSnapshots returns the holder snapshots that have been recorded for a marker.
+	HolderSnapshots(ctx context.Context, in *QueryHolderSnapshotsRequest, opts ...grpc.CallOption) (*QueryHolderSnapshotsResponse, error)
+	// HolderSnapshot returns a recorded holder snapshot and its cap table.
+	HolderSnapshot(ctx context.Context, in *QueryHolderSnapshotRequest, opts ...grpc.CallOption) (*QueryHolderSnapshotResponse, error)
+	// ForcedTransfers returns the audit records of the forced transfers of a marker's coins.
+	ForcedTransfers(ctx context.Context, in *QueryForcedTransfersRequest, opts ...grpc.CallOption) (*QueryForcedTransfersResponse, error)
+	// AccountForcedTransfers returns the audit records of the forced transfers taken from an account.
+	AccountForcedTransfers(ctx context.Context, in *QueryAccountForcedTransfersRequest, opts ...grpc.CallOption) (*QueryAccountForcedTransfersResponse, error)
+	// IssuanceSchedule returns a marker's issuance schedule along with the amounts that are mintable now and later.
+	IssuanceSchedule(ctx context.Context, in *QueryIssuanceScheduleRequest, opts ...grpc.CallOption) (*QueryIssuanceScheduleResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllMarkers(ctx context.Context, in *QueryAllMarkersRequest, opts ...grpc.CallOption) (*QueryAllMarkersResponse, error) {
+	out := new(QueryAllMarkersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/AllMarkers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Marker(ctx context.Context, in *QueryMarkerRequest, opts ...grpc.CallOption) (*QueryMarkerResponse, error) {
+	out := new(QueryMarkerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Marker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Holding(ctx context.Context, in *QueryHoldingRequest, opts ...grpc.CallOption) (*QueryHoldingResponse, error) {
+	out := new(QueryHoldingResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Holding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *queryClient) Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error) {
+	out := new(QuerySupplyResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Supply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error) {
+	out := new(QueryEscrowResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Escrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Access(ctx context.Context, in *QueryAccessRequest, opts ...grpc.CallOption) (*QueryAccessResponse, error) {
+	out := new(QueryAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Access", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error) {
+	out := new(QueryDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/DenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error) {
+	out := new(QueryAccountDataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/AccountData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NetAssetValues(ctx context.Context, in *QueryNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryNetAssetValuesResponse, error) {
+	out := new(QueryNetAssetValuesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/NetAssetValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HolderFreezes(ctx context.Context, in *QueryHolderFreezesRequest, opts ...grpc.CallOption) (*QueryHolderFreezesResponse, error) {
+	out := new(QueryHolderFreezesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/HolderFreezes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Distribution(ctx context.Context, in *QueryDistributionRequest, opts ...grpc.CallOption) (*QueryDistributionResponse, error) {
+	out := new(QueryDistributionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Distribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionClaims(ctx context.Context, in *QueryDistributionClaimsRequest, opts ...grpc.CallOption) (*QueryDistributionClaimsResponse, error) {
+	out := new(QueryDistributionClaimsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/DistributionClaims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Redemption(ctx context.Context, in *QueryRedemptionRequest, opts ...grpc.CallOption) (*QueryRedemptionResponse, error) {
+	out := new(QueryRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Redemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Redemptions(ctx context.Context, in *QueryRedemptionsRequest, opts ...grpc.CallOption) (*QueryRedemptionsResponse, error) {
+	out := new(QueryRedemptionsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Redemptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferLimits(ctx context.Context, in *QueryTransferLimitsRequest, opts ...grpc.CallOption) (*QueryTransferLimitsResponse, error) {
+	out := new(QueryTransferLimitsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/TransferLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccessGrantTerms(ctx context.Context, in *QueryAccessGrantTermsRequest, opts ...grpc.CallOption) (*QueryAccessGrantTermsResponse, error) {
+	out := new(QueryAccessGrantTermsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/AccessGrantTerms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ApprovalThresholds(ctx context.Context, in *QueryApprovalThresholdsRequest, opts ...grpc.CallOption) (*QueryApprovalThresholdsResponse, error) {
+	out := new(QueryApprovalThresholdsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/ApprovalThresholds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingOperation(ctx context.Context, in *QueryPendingOperationRequest, opts ...grpc.CallOption) (*QueryPendingOperationResponse, error) {
+	out := new(QueryPendingOperationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/PendingOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingOperations(ctx context.Context, in *QueryPendingOperationsRequest, opts ...grpc.CallOption) (*QueryPendingOperationsResponse, error) {
+	out := new(QueryPendingOperationsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/PendingOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttributeRequirements(ctx context.Context, in *QueryAttributeRequirementsRequest, opts ...grpc.CallOption) (*QueryAttributeRequirementsResponse, error) {
+	out := new(QueryAttributeRequirementsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/AttributeRequirements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CapTable(ctx context.Context, in *QueryCapTableRequest, opts ...grpc.CallOption) (*QueryCapTableResponse, error) {
+	out := new(QueryCapTableResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/CapTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HolderSnapshots(ctx context.Context, in *QueryHolderSnapshotsRequest, opts ...grpc.CallOption) (*QueryHolderSnapshotsResponse, error) {
+	out := new(QueryHolderSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/HolderSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HolderSnapshot(ctx context.Context, in *QueryHolderSnapshotRequest, opts ...grpc.CallOption) (*QueryHolderSnapshotResponse, error) {
+	out := new(QueryHolderSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/HolderSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForcedTransfers(ctx context.Context, in *QueryForcedTransfersRequest, opts ...grpc.CallOption) (*QueryForcedTransfersResponse, error) {
+	out := new(QueryForcedTransfersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/ForcedTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountForcedTransfers(ctx context.Context, in *QueryAccountForcedTransfersRequest, opts ...grpc.CallOption) (*QueryAccountForcedTransfersResponse, error) {
+	out := new(QueryAccountForcedTransfersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/AccountForcedTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IssuanceSchedule(ctx context.Context, in *QueryIssuanceScheduleRequest, opts ...grpc.CallOption) (*QueryIssuanceScheduleResponse, error) {
+	out := new(QueryIssuanceScheduleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/IssuanceSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/bank module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Returns a list of all markers on the blockchain
+	AllMarkers(context.Context, *QueryAllMarkersRequest) (*QueryAllMarkersResponse, error)
+	// query for a single marker by denom or address
+	Marker(context.Context, *QueryMarkerRequest) (*QueryMarkerResponse, error)
+	// query for all accounts holding the given marker coins
+	Holding(context.Context, *QueryHoldingRequest) (*QueryHoldingResponse, error)
+	// query for supply of coin on a marker account
+	// This endpoint returns the initial/target supply from marker account, not actual circulating supply.
+	// Deprecated: This query is deprecated in favor of the bank module's SupplyOf query.
+	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
+	// query for coins on a marker account
+	Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error)
+	// query for access records on an account
+	Access(context.Context, *QueryAccessRequest) (*QueryAccessResponse, error)
+	// query for access records on an account
+	DenomMetadata(context.Context, *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error)
+	// query for account data associated with a denom
+	AccountData(context.Context, *QueryAccountDataRequest) (*QueryAccountDataResponse, error)
+	// NetAssetValues returns net asset values for marker
+	NetAssetValues(context.Context, *QueryNetAssetValuesRequest) (*QueryNetAssetValuesResponse, error)
+	// HolderFreezes returns the freezes of a marker's coins in holder accounts.
+	HolderFreezes(context.Context, *QueryHolderFreezesRequest) (*QueryHolderFreezesResponse, error)
+	// Distribution returns a distribution to a marker's holders.
+	Distribution(context.Context, *QueryDistributionRequest) (*QueryDistributionResponse, error)
+	// DistributionClaims returns the distribution payments that are waiting to be claimed by a holder.
+	DistributionClaims(context.Context, *QueryDistributionClaimsRequest) (*QueryDistributionClaimsResponse, error)
+	// Redemption returns a pending redemption.
+	Redemption(context.Context, *QueryRedemptionRequest) (*QueryRedemptionResponse, error)
+	// Redemptions returns the pending redemptions of a marker and/or a holder.
+	Redemptions(context.Context, *QueryRedemptionsRequest) (*QueryRedemptionsResponse, error)
+	// TransferLimits returns the transfer limits of a marker and how much has been sent in the current window.
+	TransferLimits(context.Context, *QueryTransferLimitsRequest) (*QueryTransferLimitsResponse, error)
+	// AccessGrantTerms returns the terms of an address's access grant on a marker and how much it has minted in the
+	// current window.
+	AccessGrantTerms(context.Context, *QueryAccessGrantTermsRequest) (*QueryAccessGrantTermsResponse, error)
+	// ApprovalThresholds returns the approval thresholds of a marker's permissions.
+	ApprovalThresholds(context.Context, *QueryApprovalThresholdsRequest) (*QueryApprovalThresholdsResponse, error)
+	// PendingOperation returns an operation that is waiting for approvals.
+	PendingOperation(context.Context, *QueryPendingOperationRequest) (*QueryPendingOperationResponse, error)
+	// PendingOperations returns the operations of a marker that are waiting for approvals.
+	PendingOperations(context.Context, *QueryPendingOperationsRequest) (*QueryPendingOperationsResponse, error)
+	// AttributeRequirements returns the attribute value conditions that the recipients of a marker's coins must meet.
+	AttributeRequirements(context.Context, *QueryAttributeRequirementsRequest) (*QueryAttributeRequirementsResponse, error)
+	// CapTable returns the current holders of a marker's coin with a breakdown of their amounts.
+	CapTable(context.Context, *QueryCapTableRequest) (*QueryCapTableResponse, error)
+	// HolderSnapshots returns the holder snapshots that have been recorded for a marker.
+	HolderSnapshots(context.Context, *QueryHolderSnapshotsRequest) (*QueryHolderSnapshotsResponse, error)
+	// HolderSnapshot returns a recorded holder snapshot and its cap table.
+	HolderSnapshot(context.Context, *QueryHolderSnapshotRequest) (*QueryHolderSnapshotResponse, error)
+	// ForcedTransfers returns the audit records of the forced transfers of a marker's coins.
+	ForcedTransfers(context.Context, *QueryForcedTransfersRequest) (*QueryForcedTransfersResponse, error)
+	// AccountForcedTransfers returns the audit records of the forced transfers taken from an account.
+	AccountForcedTransfers(context.Context, *QueryAccountForcedTransfersRequest) (*QueryAccountForcedTransfersResponse, error)
+	// IssuanceSchedule returns a marker's issuance schedule along with the amounts that are mintable now and later.
+	IssuanceSchedule(context.Context, *QueryIssuanceScheduleRequest) (*QueryIssuanceScheduleResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) AllMarkers(ctx context.Context, req *QueryAllMarkersRequest) (*QueryAllMarkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllMarkers not implemented")
+}
+func (*UnimplementedQueryServer) Marker(ctx context.Context, req *QueryMarkerRequest) (*QueryMarkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Marker not implemented")
+}
+func (*UnimplementedQueryServer) Holding(ctx context.Context, req *QueryHoldingRequest) (*QueryHoldingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Holding not implemented")
+}
+func (*UnimplementedQueryServer) Supply(ctx context.Context, req *QuerySupplyRequest) (*QuerySupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Supply not implemented")
+}
+func (*UnimplementedQueryServer) Escrow(ctx context.Context, req *QueryEscrowRequest) (*QueryEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrow not implemented")
+}
+func (*UnimplementedQueryServer) Access(ctx context.Context, req *QueryAccessRequest) (*QueryAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Access not implemented")
+}
+func (*UnimplementedQueryServer) DenomMetadata(ctx context.Context, req *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMetadata not implemented")
+}
+func (*UnimplementedQueryServer) AccountData(ctx context.Context, req *QueryAccountDataRequest) (*QueryAccountDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountData not implemented")
+}
+func (*UnimplementedQueryServer) NetAssetValues(ctx context.Context, req *QueryNetAssetValuesRequest) (*QueryNetAssetValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetAssetValues not implemented")
+}
+func (*UnimplementedQueryServer) HolderFreezes(ctx context.Context, req *QueryHolderFreezesRequest) (*QueryHolderFreezesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HolderFreezes not implemented")
+}
+func (*UnimplementedQueryServer) Distribution(ctx context.Context, req *QueryDistributionRequest) (*QueryDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Distribution not implemented")
+}
+func (*UnimplementedQueryServer) DistributionClaims(ctx context.Context, req *QueryDistributionClaimsRequest) (*QueryDistributionClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionClaims not implemented")
+}
+func (*UnimplementedQueryServer) Redemption(ctx context.Context, req *QueryRedemptionRequest) (*QueryRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redemption not implemented")
+}
+func (*UnimplementedQueryServer) Redemptions(ctx context.Context, req *QueryRedemptionsRequest) (*QueryRedemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redemptions not implemented")
+}
+func (*UnimplementedQueryServer) TransferLimits(ctx context.Context, req *QueryTransferLimitsRequest) (*QueryTransferLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLimits not implemented")
+}
+func (*UnimplementedQueryServer) AccessGrantTerms(ctx context.Context, req *QueryAccessGrantTermsRequest) (*QueryAccessGrantTermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccessGrantTerms not implemented")
+}
+func (*UnimplementedQueryServer) ApprovalThresholds(ctx context.Context, req *QueryApprovalThresholdsRequest) (*QueryApprovalThresholdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovalThresholds not implemented")
+}
+func (*UnimplementedQueryServer) PendingOperation(ctx context.Context, req *QueryPendingOperationRequest) (*QueryPendingOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingOperation not implemented")
+}
+func (*UnimplementedQueryServer) PendingOperations(ctx context.Context, req *QueryPendingOperationsRequest) (*QueryPendingOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingOperations not implemented")
+}
+func (*UnimplementedQueryServer) AttributeRequirements(ctx context.Context, req *QueryAttributeRequirementsRequest) (*QueryAttributeRequirementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeRequirements not implemented")
+}
+func (*UnimplementedQueryServer) CapTable(ctx context.Context, req *QueryCapTableRequest) (*QueryCapTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CapTable not implemented")
+}
+func (*UnimplementedQueryServer) HolderSnapshots(ctx context.Context, req *QueryHolderSnapshotsRequest) (*QueryHolderSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HolderSnapshots not implemented")
+}
+func (*UnimplementedQueryServer) HolderSnapshot(ctx context.Context, req *QueryHolderSnapshotRequest) (*QueryHolderSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HolderSnapshot not implemented")
+}
+func (*UnimplementedQueryServer) ForcedTransfers(ctx context.Context, req *QueryForcedTransfersRequest) (*QueryForcedTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForcedTransfers not implemented")
+}
+func (*UnimplementedQueryServer) AccountForcedTransfers(ctx context.Context, req *QueryAccountForcedTransfersRequest) (*QueryAccountForcedTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountForcedTransfers not implemented")
+}
+func (*UnimplementedQueryServer) IssuanceSchedule(ctx context.Context, req *QueryIssuanceScheduleRequest) (*QueryIssuanceScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuanceSchedule not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllMarkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMarkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllMarkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/AllMarkers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllMarkers(ctx, req.(*QueryAllMarkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Marker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Marker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Marker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Marker(ctx, req.(*QueryMarkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Holding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHoldingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Holding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Holding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Holding(ctx, req.(*QueryHoldingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Supply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Supply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Supply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Supply(ctx, req.(*QuerySupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Escrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Escrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrow(ctx, req.(*QueryEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Access_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Access(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Access",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Access(ctx, req.(*QueryAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/DenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMetadata(ctx, req.(*QueryDenomMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/AccountData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountData(ctx, req.(*QueryAccountDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetAssetValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetAssetValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/NetAssetValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetAssetValues(ctx, req.(*QueryNetAssetValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HolderFreezes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHolderFreezesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HolderFreezes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/HolderFreezes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HolderFreezes(ctx, req.(*QueryHolderFreezesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Distribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Distribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Distribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Distribution(ctx, req.(*QueryDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/DistributionClaims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionClaims(ctx, req.(*QueryDistributionClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Redemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Redemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Redemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Redemption(ctx, req.(*QueryRedemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Redemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Redemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Redemptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Redemptions(ctx, req.(*QueryRedemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/TransferLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferLimits(ctx, req.(*QueryTransferLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccessGrantTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccessGrantTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccessGrantTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/AccessGrantTerms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccessGrantTerms(ctx, req.(*QueryAccessGrantTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ApprovalThresholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryApprovalThresholdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ApprovalThresholds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/ApprovalThresholds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ApprovalThresholds(ctx, req.(*QueryApprovalThresholdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/PendingOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingOperation(ctx, req.(*QueryPendingOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/PendingOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingOperations(ctx, req.(*QueryPendingOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttributeRequirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeRequirementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeRequirements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/AttributeRequirements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeRequirements(ctx, req.(*QueryAttributeRequirementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CapTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCapTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CapTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/CapTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CapTable(ctx, req.(*QueryCapTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HolderSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHolderSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HolderSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/HolderSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HolderSnapshots(ctx, req.(*QueryHolderSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HolderSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHolderSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HolderSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/HolderSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HolderSnapshot(ctx, req.(*QueryHolderSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForcedTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForcedTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForcedTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/ForcedTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForcedTransfers(ctx, req.(*QueryForcedTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountForcedTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountForcedTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountForcedTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/AccountForcedTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountForcedTransfers(ctx, req.(*QueryAccountForcedTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IssuanceSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIssuanceScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IssuanceSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/IssuanceSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IssuanceSchedule(ctx, req.(*QueryIssuanceScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.marker.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AllMarkers",
+			Handler:    _Query_AllMarkers_Handler,
+		},
+		{
+			MethodName: "Marker",
+			Handler:    _Query_Marker_Handler,
+		},
+		{
+			MethodName: "Holding",
+			Handler:    _Query_Holding_Handler,
+		},
+		{
+			MethodName: "Supply",
+			Handler:    _Query_Supply_Handler,
+		},
+		{
+			MethodName: "Escrow",
+			Handler:    _Query_Escrow_Handler,
+		},
+		{
+			MethodName: "Access",
+			Handler:    _Query_Access_Handler,
+		},
+		{
+			MethodName: "DenomMetadata",
+			Handler:    _Query_DenomMetadata_Handler,
+		},
+		{
+			MethodName: "AccountData",
+			Handler:    _Query_AccountData_Handler,
+		},
+		{
+			MethodName: "NetAssetValues",
+			Handler:    _Query_NetAssetValues_Handler,
+		},
+		{
+			MethodName: "HolderFreezes",
+			Handler:    _Query_HolderFreezes_Handler,
+		},
+		{
+			MethodName: "Distribution",
+			Handler:    _Query_Distribution_Handler,
+		},
+		{
+			MethodName: "DistributionClaims",
+			Handler:    _Query_DistributionClaims_Handler,
+		},
+		{
+			MethodName: "Redemption",
+			Handler:    _Query_Redemption_Handler,
+		},
+		{
+			MethodName: "Redemptions",
+			Handler:    _Query_Redemptions_Handler,
+		},
+		{
+			MethodName: "TransferLimits",
+			Handler:    _Query_TransferLimits_Handler,
+		},
+		{
+			MethodName: "AccessGrantTerms",
+			Handler:    _Query_AccessGrantTerms_Handler,
+		},
+		{
+			MethodName: "ApprovalThresholds",
+			Handler:    _Query_ApprovalThresholds_Handler,
+		},
+		{
+			MethodName: "PendingOperation",
+			Handler:    _Query_PendingOperation_Handler,
+		},
+		{
+			MethodName: "PendingOperations",
+			Handler:    _Query_PendingOperations_Handler,
+		},
+		{
+			MethodName: "AttributeRequirements",
+			Handler:    _Query_AttributeRequirements_Handler,
+		},
+		{
+			MethodName: "CapTable",
+			Handler:    _Query_CapTable_Handler,
+		},
+		{
+			MethodName: "HolderSnapshots",
+			Handler:    _Query_HolderSnapshots_Handler,
+		},
+		{
+			MethodName: "HolderSnapshot",
+			Handler:    _Query_HolderSnapshot_Handler,
+		},
+		{
+			MethodName: "ForcedTransfers",
+			Handler:    _Query_ForcedTransfers_Handler,
+		},
+		{
+			MethodName: "AccountForcedTransfers",
+			Handler:    _Query_AccountForcedTransfers_Handler,
+		},
+		{
+			MethodName: "IssuanceSchedule",
+			Handler:    _Query_IssuanceSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/marker/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMarkersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMarkersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMarkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMarkersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMarkersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMarkersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Markers) > 0 {
+		for iNdEx := len(m.Markers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarkerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Marker != nil {
+		{
+			size, err := m.Marker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHoldingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHoldingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHoldingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHoldingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHoldingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHoldingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Escrow) > 0 {
+		for iNdEx := len(m.Escrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Balance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Balance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintQuery(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StartTime != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintQuery(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.History {
+		i--
+		if m.History {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AsOf != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.AsOf, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AsOf):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintQuery(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAge != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxAge))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHolderFreezesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHolderFreezesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHolderFreezesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHolderFreezesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHolderFreezesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHolderFreezesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Freezes) > 0 {
+		for iNdEx := len(m.Freezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Freezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionClaimsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionClaimsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionClaimsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionClaimsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionClaimsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionClaimsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RedemptionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RedemptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Redemption.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Redemptions) > 0 {
+		for iNdEx := len(m.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.HolderVolume.Size()
+		i -= size
+		if _, err := m.HolderVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MarkerVolume.Size()
+		i -= size
+		if _, err := m.MarkerVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccessGrantTermsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessGrantTermsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessGrantTermsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccessGrantTermsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessGrantTermsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessGrantTermsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MintVolume.Size()
+		i -= size
+		if _, err := m.MintVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Terms != nil {
+		{
+			size, err := m.Terms.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryApprovalThresholdsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryApprovalThresholdsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryApprovalThresholdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryApprovalThresholdsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryApprovalThresholdsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryApprovalThresholdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Thresholds) > 0 {
+		for iNdEx := len(m.Thresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Thresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OperationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingOperationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeRequirementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeRequirementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeRequirementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeRequirementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeRequirementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeRequirementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requirements) > 0 {
+		for iNdEx := len(m.Requirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCapTableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCapTableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCapTableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCapTableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCapTableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCapTableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.HolderCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HolderCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Holders) > 0 {
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHolderSnapshotsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHolderSnapshotsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHolderSnapshotsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHolderSnapshotsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHolderSnapshotsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHolderSnapshotsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHolderSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHolderSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHolderSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SnapshotId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SnapshotId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHolderSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHolderSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHolderSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holders) > 0 {
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForcedTransfersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForcedTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForcedTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForcedTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForcedTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForcedTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountForcedTransfersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountForcedTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountForcedTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountForcedTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountForcedTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountForcedTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuanceScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuanceScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuanceScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuanceScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuanceScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuanceScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Scheduled.Size()
+		i -= size
+		if _, err := m.Scheduled.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Mintable.Size()
+		i -= size
+		if _, err := m.Mintable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Unlocked.Size()
+		i -= size
+		if _, err := m.Unlocked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMarkersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMarkersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markers) > 0 {
+		for _, e := range m.Markers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Marker != nil {
+		l = m.Marker.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHoldingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHoldingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Escrow) > 0 {
+		for _, e := range m.Escrow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Balance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNetAssetValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AsOf != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AsOf)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.History {
+		n += 2
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNetAssetValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxAge != 0 {
+		n += 1 + sovQuery(uint64(m.MaxAge))
+	}
+	return n
+}
+
+func (m *QueryHolderFreezesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHolderFreezesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Freezes) > 0 {
+		for _, e := range m.Freezes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovQuery(uint64(m.DistributionId))
+	}
+	return n
+}
+
+func (m *QueryDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Distribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDistributionClaimsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributionClaimsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RedemptionId != 0 {
+		n += 1 + sovQuery(uint64(m.RedemptionId))
+	}
+	return n
+}
+
+func (m *QueryRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redemption.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRedemptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redemptions) > 0 {
+		for _, e := range m.Redemptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MarkerVolume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.HolderVolume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccessGrantTermsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccessGrantTermsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Terms != nil {
+		l = m.Terms.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.MintVolume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryApprovalThresholdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryApprovalThresholdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Thresholds) > 0 {
+		for _, e := range m.Thresholds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationId != 0 {
+		n += 1 + sovQuery(uint64(m.OperationId))
+	}
+	return n
+}
+
+func (m *QueryPendingOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Operation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingOperationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeRequirementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeRequirementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requirements) > 0 {
+		for _, e := range m.Requirements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCapTableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCapTableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Holders) > 0 {
+		for _, e := range m.Holders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.HolderCount != 0 {
+		n += 1 + sovQuery(uint64(m.HolderCount))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHolderSnapshotsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHolderSnapshotsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHolderSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotId != 0 {
+		n += 1 + sovQuery(uint64(m.SnapshotId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHolderSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Snapshot.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Holders) > 0 {
+		for _, e := range m.Holders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForcedTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForcedTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountForcedTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountForcedTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuanceScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuanceScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Unlocked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Mintable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Scheduled.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMarkersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMarkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMarkersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMarkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markers = append(m.Markers, &types.Any{})
+			if err := m.Markers[len(m.Markers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarkerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarkerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Marker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Marker == nil {
+				m.Marker = &types.Any{}
+			}
+			if err := m.Marker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrow = append(m.Escrow, types1.Coin{})
+			if err := m.Escrow[len(m.Escrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, AccessGrant{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Balance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetAssetValuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AsOf == nil {
+				m.AsOf = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.AsOf, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.History = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetAssetValuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, NetAssetValueRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			m.MaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHolderFreezesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHolderFreezesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHolderFreezesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHolderFreezesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHolderFreezesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHolderFreezesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freezes = append(m.Freezes, HolderFreeze{})
+			if err := m.Freezes[len(m.Freezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			m.DistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionClaimsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionClaimsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionClaimsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionClaimsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionClaimsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionClaimsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, DistributionPayment{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
+			}
+			m.RedemptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redemption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemptions = append(m.Redemptions, Redemption{})
+			if err := m.Redemptions[len(m.Redemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkerVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HolderVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccessGrantTermsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessGrantTermsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessGrantTermsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccessGrantTermsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessGrantTermsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessGrantTermsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Terms == nil {
+				m.Terms = &AccessGrantTerms{}
+			}
+			if err := m.Terms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Balance) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryApprovalThresholdsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryApprovalThresholdsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryApprovalThresholdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryApprovalThresholdsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryApprovalThresholdsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryApprovalThresholdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Thresholds = append(m.Thresholds, ApprovalThreshold{})
+			if err := m.Thresholds[len(m.Thresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryNetAssetValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryNetAssetValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for _, e := range m.NetAssetValues {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingOperationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2624,12 +11204,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingOperationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
+			}
+			m.OperationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2651,7 +11250,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingOperationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2674,15 +11273,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingOperationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2709,7 +11308,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2734,7 +11333,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingOperationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2757,17 +11356,17 @@ func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMarkersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingOperationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMarkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2777,11 +11376,24 @@ func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= MarkerStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -2839,7 +11451,7 @@ func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingOperationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2862,15 +11474,15 @@ func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMarkersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingOperationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMarkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2897,8 +11509,8 @@ func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markers = append(m.Markers, &types.Any{})
-			if err := m.Markers[len(m.Markers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Operations = append(m.Operations, PendingOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2959,7 +11571,7 @@ func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarkerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeRequirementsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2982,10 +11594,10 @@ func (m *QueryMarkerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarkerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeRequirementsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeRequirementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3041,7 +11653,7 @@ func (m *QueryMarkerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeRequirementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3064,15 +11676,15 @@ func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeRequirementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeRequirementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Marker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requirements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3099,10 +11711,8 @@ func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Marker == nil {
-				m.Marker = &types.Any{}
-			}
-			if err := m.Marker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requirements = append(m.Requirements, AttributeRequirement{})
+			if err := m.Requirements[len(m.Requirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3127,7 +11737,7 @@ func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCapTableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3150,10 +11760,10 @@ func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHoldingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCapTableRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHoldingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCapTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3245,7 +11855,7 @@ func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCapTableResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3268,15 +11878,15 @@ func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHoldingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCapTableResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHoldingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCapTableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3303,16 +11913,16 @@ func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, Balance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Holders = append(m.Holders, CapTableEntry{})
+			if err := m.Holders[len(m.Holders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderCount", wireType)
 			}
-			var msglen int
+			m.HolderCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3322,81 +11932,14 @@ func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.HolderCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3424,61 +11967,13 @@ func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3505,7 +12000,10 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3530,7 +12028,7 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHolderSnapshotsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3553,10 +12051,10 @@ func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHolderSnapshotsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHolderSnapshotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3591,6 +12089,42 @@ func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3612,7 +12146,7 @@ func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHolderSnapshotsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3635,15 +12169,15 @@ func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHolderSnapshotsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHolderSnapshotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3670,8 +12204,44 @@ func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Escrow = append(m.Escrow, types1.Coin{})
-			if err := m.Escrow[len(m.Escrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Snapshots = append(m.Snapshots, HolderSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3696,7 +12266,7 @@ func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHolderSnapshotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3719,17 +12289,36 @@ func (m *QueryAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHolderSnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHolderSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotId", wireType)
+			}
+			m.SnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3739,23 +12328,27 @@ func (m *QueryAccessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3778,7 +12371,7 @@ func (m *QueryAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHolderSnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3801,15 +12394,15 @@ func (m *QueryAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHolderSnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHolderSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3836,66 +12429,49 @@ func (m *QueryAccessResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, AccessGrant{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Holders = append(m.Holders, CapTableEntry{})
+			if err := m.Holders[len(m.Holders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3905,23 +12481,27 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3944,7 +12524,7 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryForcedTransfersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3967,15 +12547,47 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryForcedTransfersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryForcedTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4002,7 +12614,10 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4027,7 +12642,7 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryForcedTransfersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4050,17 +12665,17 @@ func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryForcedTransfersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryForcedTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4070,23 +12685,61 @@ func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Records = append(m.Records, ForcedTransferRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4109,7 +12762,7 @@ func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountForcedTransfersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4132,15 +12785,15 @@ func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountForcedTransfersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountForcedTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4168,7 +12821,43 @@ func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4191,7 +12880,7 @@ func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Balance) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountForcedTransfersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4214,17 +12903,17 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountForcedTransfersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountForcedTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4234,27 +12923,29 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Records = append(m.Records, ForcedTransferRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4281,8 +12972,10 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4307,7 +13000,7 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuanceScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4330,10 +13023,10 @@ func (m *QueryNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetAssetValuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIssuanceScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIssuanceScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4389,7 +13082,7 @@ func (m *QueryNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuanceScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4412,15 +13105,15 @@ func (m *QueryNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetAssetValuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIssuanceScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIssuanceScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4447,8 +13140,143 @@ func (m *QueryNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
-			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unlocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Mintable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduled", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scheduled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11,6 +11,7 @@ import (
 type (
 	bypassKey        struct{}
 	transferAgentKey struct{}
+	freezeBypassKey  struct{}
 )
 
 // WithBypass returns a new context that will cause the marker bank send restriction to be skipped.
@@ -38,6 +39,24 @@ func HasBypass[C context.Context](ctx C) bool {
 	return isBool && bypass
 }
 
+// WithFreezeBypass returns a new context that will cause holder freezes to be ignored when calculating locked coins.
+func WithFreezeBypass[C context.Context](ctx C) C {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx = sdkCtx.WithValue(freezeBypassKey{}, true)
+	return context.Context(sdkCtx).(C)
+}
+
+// HasFreezeBypass checks the context to see if holder freezes should be ignored when calculating locked coins.
+func HasFreezeBypass[C context.Context](ctx C) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	bypassValue := sdkCtx.Value(freezeBypassKey{})
+	if bypassValue == nil {
+		return false
+	}
+	bypass, isBool := bypassValue.(bool)
+	return isBool && bypass
+}
+
 // WithTransferAgents returns a new context that contains the provided marker transfer agent.
 // This will overwrite any existing transfer agents in the context.
 func WithTransferAgents[C context.Context](ctx C, transferAgents ...sdk.AccAddress) C {
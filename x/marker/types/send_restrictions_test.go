@@ -127,6 +127,19 @@ func TestBypassFuncsDoNotModifyProvided(t *testing.T) {
 	assert.False(t, HasBypass(origCtx), "HasBypass(origCtx) after giving afterWith to WithoutBypass")
 }
 
+func TestFreezeBypassFuncs(t *testing.T) {
+	origCtx := sdk.NewContext(nil, cmtproto.Header{}, false, nil)
+	assert.False(t, HasFreezeBypass(origCtx), "HasFreezeBypass(origCtx)")
+	afterWith := WithFreezeBypass(origCtx)
+	assert.True(t, HasFreezeBypass(afterWith), "HasFreezeBypass(afterWith)")
+	assert.False(t, HasFreezeBypass(origCtx), "HasFreezeBypass(origCtx) after giving it to WithFreezeBypass")
+
+	// The freeze bypass is independent of the send restriction bypass.
+	assert.False(t, HasBypass(afterWith), "HasBypass(afterWith)")
+	assert.False(t, HasFreezeBypass(WithBypass(origCtx)), "HasFreezeBypass(WithBypass(origCtx))")
+	assert.True(t, HasFreezeBypass(WithoutBypass(afterWith)), "HasFreezeBypass(WithoutBypass(afterWith))")
+}
+
 func TestTransferAgentFuncs(t *testing.T) {
 	newCtx := func() sdk.Context {
 		return sdk.NewContext(nil, cmtproto.Header{}, false, nil)
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
@@ -17,20 +18,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	github_com_cosmos_ibc_go_v10_modules_apps_transfer_types "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -954,6 +959,9 @@ func (m *MsgMintRequest) GetRecipient() string {
 
 // MsgMintResponse defines the Msg/Mint response type
 type MsgMintResponse struct {
+	// pending_operation_id is the id of the operation that was created because the marker requires more approvals.
+	// Zero if the mint was executed.
+	PendingOperationId uint64 `protobuf:"varint,1,opt,name=pending_operation_id,json=pendingOperationId,proto3" json:"pending_operation_id,omitempty"`
 }
 
 func (m *MsgMintResponse) Reset()         { *m = MsgMintResponse{} }
@@ -989,6 +997,13 @@ func (m *MsgMintResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMintResponse proto.InternalMessageInfo
 
+func (m *MsgMintResponse) GetPendingOperationId() uint64 {
+	if m != nil {
+		return m.PendingOperationId
+	}
+	return 0
+}
+
 // MsgBurnRequest defines the Msg/Burn request type
 type MsgBurnRequest struct {
 	Amount        types1.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
@@ -1170,6 +1185,9 @@ func (m *MsgWithdrawRequest) GetEventTag() string {
 
 // MsgWithdrawResponse defines the Msg/Withdraw response type
 type MsgWithdrawResponse struct {
+	// pending_operation_id is the id of the operation that was created because the marker requires more approvals.
+	// Zero if the withdrawal was executed.
+	PendingOperationId uint64 `protobuf:"varint,1,opt,name=pending_operation_id,json=pendingOperationId,proto3" json:"pending_operation_id,omitempty"`
 }
 
 func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
@@ -1205,12 +1223,25 @@ func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
 
+func (m *MsgWithdrawResponse) GetPendingOperationId() uint64 {
+	if m != nil {
+		return m.PendingOperationId
+	}
+	return 0
+}
+
 // MsgTransferRequest defines the Msg/Transfer request type
 type MsgTransferRequest struct {
 	Amount        types1.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
 	Administrator string      `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 	FromAddress   string      `protobuf:"bytes,4,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 	ToAddress     string      `protobuf:"bytes,5,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// reason is why the coins are being transferred. Required when the transfer uses the administrator's
+	// ACCESS_FORCE_TRANSFER permission.
+	Reason ForcedTransferReason `protobuf:"varint,6,opt,name=reason,proto3,enum=provenance.marker.v1.ForcedTransferReason" json:"reason,omitempty"`
+	// reference identifies the document that justifies a forced transfer, e.g. a court order or recovery ticket id.
+	// Required when reason is set.
+	Reference string `protobuf:"bytes,7,opt,name=reference,proto3" json:"reference,omitempty"`
 }
 
 func (m *MsgTransferRequest) Reset()         { *m = MsgTransferRequest{} }
@@ -1274,8 +1305,25 @@ func (m *MsgTransferRequest) GetToAddress() string {
 	return ""
 }
 
+func (m *MsgTransferRequest) GetReason() ForcedTransferReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ForcedTransferReason_Unspecified
+}
+
+func (m *MsgTransferRequest) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
 // MsgTransferResponse defines the Msg/Transfer response type
 type MsgTransferResponse struct {
+	// pending_operation_id is the id of the operation that was created because the marker requires more approvals.
+	// Zero if the transfer was executed.
+	PendingOperationId uint64 `protobuf:"varint,1,opt,name=pending_operation_id,json=pendingOperationId,proto3" json:"pending_operation_id,omitempty"`
 }
 
 func (m *MsgTransferResponse) Reset()         { *m = MsgTransferResponse{} }
@@ -1311,6 +1359,13 @@ func (m *MsgTransferResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTransferResponse proto.InternalMessageInfo
 
+func (m *MsgTransferResponse) GetPendingOperationId() uint64 {
+	if m != nil {
+		return m.PendingOperationId
+	}
+	return 0
+}
+
 // MsgIbcTransferRequest defines the Msg/IbcTransfer request type for markers.
 type MsgIbcTransferRequest struct {
 	Transfer      github_com_cosmos_ibc_go_v10_modules_apps_transfer_types.MsgTransfer `protobuf:"bytes,1,opt,name=transfer,proto3,customtype=github.com/cosmos/ibc-go/v10/modules/apps/transfer/types.MsgTransfer" json:"transfer"`
@@ -3149,3525 +3204,4371 @@ func (m *MsgRevokeGrantAllowanceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeGrantAllowanceResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgGrantAllowanceRequest)(nil), "provenance.marker.v1.MsgGrantAllowanceRequest")
-	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "provenance.marker.v1.MsgGrantAllowanceResponse")
-	proto.RegisterType((*MsgAddMarkerRequest)(nil), "provenance.marker.v1.MsgAddMarkerRequest")
-	proto.RegisterType((*MsgAddMarkerResponse)(nil), "provenance.marker.v1.MsgAddMarkerResponse")
-	proto.RegisterType((*MsgAddAccessRequest)(nil), "provenance.marker.v1.MsgAddAccessRequest")
-	proto.RegisterType((*MsgAddAccessResponse)(nil), "provenance.marker.v1.MsgAddAccessResponse")
-	proto.RegisterType((*MsgDeleteAccessRequest)(nil), "provenance.marker.v1.MsgDeleteAccessRequest")
-	proto.RegisterType((*MsgDeleteAccessResponse)(nil), "provenance.marker.v1.MsgDeleteAccessResponse")
-	proto.RegisterType((*MsgFinalizeRequest)(nil), "provenance.marker.v1.MsgFinalizeRequest")
-	proto.RegisterType((*MsgFinalizeResponse)(nil), "provenance.marker.v1.MsgFinalizeResponse")
-	proto.RegisterType((*MsgActivateRequest)(nil), "provenance.marker.v1.MsgActivateRequest")
-	proto.RegisterType((*MsgActivateResponse)(nil), "provenance.marker.v1.MsgActivateResponse")
-	proto.RegisterType((*MsgCancelRequest)(nil), "provenance.marker.v1.MsgCancelRequest")
-	proto.RegisterType((*MsgCancelResponse)(nil), "provenance.marker.v1.MsgCancelResponse")
-	proto.RegisterType((*MsgDeleteRequest)(nil), "provenance.marker.v1.MsgDeleteRequest")
-	proto.RegisterType((*MsgDeleteResponse)(nil), "provenance.marker.v1.MsgDeleteResponse")
-	proto.RegisterType((*MsgMintRequest)(nil), "provenance.marker.v1.MsgMintRequest")
-	proto.RegisterType((*MsgMintResponse)(nil), "provenance.marker.v1.MsgMintResponse")
-	proto.RegisterType((*MsgBurnRequest)(nil), "provenance.marker.v1.MsgBurnRequest")
-	proto.RegisterType((*MsgBurnResponse)(nil), "provenance.marker.v1.MsgBurnResponse")
-	proto.RegisterType((*MsgWithdrawRequest)(nil), "provenance.marker.v1.MsgWithdrawRequest")
-	proto.RegisterType((*MsgWithdrawResponse)(nil), "provenance.marker.v1.MsgWithdrawResponse")
-	proto.RegisterType((*MsgTransferRequest)(nil), "provenance.marker.v1.MsgTransferRequest")
-	proto.RegisterType((*MsgTransferResponse)(nil), "provenance.marker.v1.MsgTransferResponse")
-	proto.RegisterType((*MsgIbcTransferRequest)(nil), "provenance.marker.v1.MsgIbcTransferRequest")
-	proto.RegisterType((*MsgIbcTransferResponse)(nil), "provenance.marker.v1.MsgIbcTransferResponse")
-	proto.RegisterType((*MsgSetDenomMetadataRequest)(nil), "provenance.marker.v1.MsgSetDenomMetadataRequest")
-	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataResponse")
-	proto.RegisterType((*MsgAddFinalizeActivateMarkerRequest)(nil), "provenance.marker.v1.MsgAddFinalizeActivateMarkerRequest")
-	proto.RegisterType((*MsgAddFinalizeActivateMarkerResponse)(nil), "provenance.marker.v1.MsgAddFinalizeActivateMarkerResponse")
-	proto.RegisterType((*MsgSupplyIncreaseProposalRequest)(nil), "provenance.marker.v1.MsgSupplyIncreaseProposalRequest")
-	proto.RegisterType((*MsgSupplyIncreaseProposalResponse)(nil), "provenance.marker.v1.MsgSupplyIncreaseProposalResponse")
-	proto.RegisterType((*MsgSupplyDecreaseProposalRequest)(nil), "provenance.marker.v1.MsgSupplyDecreaseProposalRequest")
-	proto.RegisterType((*MsgSupplyDecreaseProposalResponse)(nil), "provenance.marker.v1.MsgSupplyDecreaseProposalResponse")
-	proto.RegisterType((*MsgUpdateRequiredAttributesRequest)(nil), "provenance.marker.v1.MsgUpdateRequiredAttributesRequest")
-	proto.RegisterType((*MsgUpdateRequiredAttributesResponse)(nil), "provenance.marker.v1.MsgUpdateRequiredAttributesResponse")
-	proto.RegisterType((*MsgUpdateForcedTransferRequest)(nil), "provenance.marker.v1.MsgUpdateForcedTransferRequest")
-	proto.RegisterType((*MsgUpdateForcedTransferResponse)(nil), "provenance.marker.v1.MsgUpdateForcedTransferResponse")
-	proto.RegisterType((*MsgUpdateRequireDepositAccessRequest)(nil), "provenance.marker.v1.MsgUpdateRequireDepositAccessRequest")
-	proto.RegisterType((*MsgUpdateRequireDepositAccessResponse)(nil), "provenance.marker.v1.MsgUpdateRequireDepositAccessResponse")
-	proto.RegisterType((*MsgSetAccountDataRequest)(nil), "provenance.marker.v1.MsgSetAccountDataRequest")
-	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.marker.v1.MsgSetAccountDataResponse")
-	proto.RegisterType((*MsgUpdateSendDenyListRequest)(nil), "provenance.marker.v1.MsgUpdateSendDenyListRequest")
-	proto.RegisterType((*MsgUpdateSendDenyListResponse)(nil), "provenance.marker.v1.MsgUpdateSendDenyListResponse")
-	proto.RegisterType((*MsgAddNetAssetValuesRequest)(nil), "provenance.marker.v1.MsgAddNetAssetValuesRequest")
-	proto.RegisterType((*MsgAddNetAssetValuesResponse)(nil), "provenance.marker.v1.MsgAddNetAssetValuesResponse")
-	proto.RegisterType((*MsgSetAdministratorProposalRequest)(nil), "provenance.marker.v1.MsgSetAdministratorProposalRequest")
-	proto.RegisterType((*MsgSetAdministratorProposalResponse)(nil), "provenance.marker.v1.MsgSetAdministratorProposalResponse")
-	proto.RegisterType((*MsgRemoveAdministratorProposalRequest)(nil), "provenance.marker.v1.MsgRemoveAdministratorProposalRequest")
-	proto.RegisterType((*MsgRemoveAdministratorProposalResponse)(nil), "provenance.marker.v1.MsgRemoveAdministratorProposalResponse")
-	proto.RegisterType((*MsgChangeStatusProposalRequest)(nil), "provenance.marker.v1.MsgChangeStatusProposalRequest")
-	proto.RegisterType((*MsgChangeStatusProposalResponse)(nil), "provenance.marker.v1.MsgChangeStatusProposalResponse")
-	proto.RegisterType((*MsgWithdrawEscrowProposalRequest)(nil), "provenance.marker.v1.MsgWithdrawEscrowProposalRequest")
-	proto.RegisterType((*MsgWithdrawEscrowProposalResponse)(nil), "provenance.marker.v1.MsgWithdrawEscrowProposalResponse")
-	proto.RegisterType((*MsgSetDenomMetadataProposalRequest)(nil), "provenance.marker.v1.MsgSetDenomMetadataProposalRequest")
-	proto.RegisterType((*MsgSetDenomMetadataProposalResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataProposalResponse")
-	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.marker.v1.MsgUpdateParamsRequest")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.marker.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgRevokeGrantAllowanceRequest)(nil), "provenance.marker.v1.MsgRevokeGrantAllowanceRequest")
-	proto.RegisterType((*MsgRevokeGrantAllowanceResponse)(nil), "provenance.marker.v1.MsgRevokeGrantAllowanceResponse")
+// MsgFreezeHolderRequest defines the Msg/FreezeHolder request type.
+// If the holder already has a freeze for the marker, it is replaced.
+type MsgFreezeHolderRequest struct {
+	// amount is the amount of the marker's coin to freeze.
+	Amount types1.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// administrator is the signer of the message. Must have freeze access on the marker.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// holder is the address of the account to freeze the funds in.
+	Holder string `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	// expires_at is the time at which the freeze is no longer in effect. It must be in the future.
+	ExpiresAt time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	// reason is a description of why the funds are being frozen.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func init() { proto.RegisterFile("provenance/marker/v1/tx.proto", fileDescriptor_bcb203fb73175ed3) }
-
-var fileDescriptor_bcb203fb73175ed3 = []byte{
-	// 2522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x4f, 0x8f, 0x3f, 0xe2, 0x79, 0x93, 0x38, 0x71, 0xc5, 0x71, 0x3a, 0xed, 0xc4, 0x76, 0x9c,
-	0x2f, 0x6f, 0x58, 0xcf, 0xc4, 0xde, 0x25, 0xbb, 0x6b, 0x56, 0x42, 0xe3, 0x78, 0x13, 0x22, 0xed,
-	0xa0, 0x68, 0x1c, 0x40, 0x70, 0x19, 0xd5, 0x4c, 0x57, 0xda, 0x2d, 0xcf, 0x74, 0x4f, 0xba, 0x6a,
-	0xc6, 0xf1, 0x4a, 0x48, 0x88, 0x3d, 0xed, 0x5e, 0x58, 0x40, 0x20, 0x84, 0x38, 0xc0, 0x05, 0x21,
-	0x4e, 0x11, 0x5a, 0x10, 0x67, 0x24, 0xc4, 0x02, 0x02, 0xad, 0xf6, 0x84, 0x38, 0x2c, 0x28, 0x39,
-	0x04, 0xf1, 0x57, 0xa0, 0xae, 0xaa, 0xee, 0x99, 0x9e, 0xa9, 0xae, 0x19, 0xdb, 0x93, 0x65, 0x2f,
-	0xbb, 0xee, 0xaa, 0x7a, 0xf5, 0xde, 0xef, 0xd5, 0x7b, 0xaf, 0x5e, 0xfd, 0x26, 0x70, 0xb1, 0x19,
-	0xf8, 0x6d, 0xe2, 0x61, 0xaf, 0x46, 0x0a, 0x0d, 0x1c, 0xec, 0x92, 0xa0, 0xd0, 0x5e, 0x2b, 0xb0,
-	0xc7, 0xf9, 0x66, 0xe0, 0x33, 0x1f, 0xcd, 0x76, 0xa6, 0xf3, 0x62, 0x3a, 0xdf, 0x5e, 0xb3, 0x66,
-	0x70, 0xc3, 0xf5, 0xfc, 0x02, 0xff, 0xaf, 0x58, 0x68, 0x9d, 0x77, 0x7c, 0xdf, 0xa9, 0x93, 0x02,
-	0xff, 0xaa, 0xb6, 0x1e, 0x16, 0xb0, 0xb7, 0x1f, 0x4d, 0xd5, 0x7c, 0xda, 0xf0, 0x69, 0x85, 0x7f,
-	0x15, 0xc4, 0x87, 0x9c, 0x9a, 0x75, 0x7c, 0xc7, 0x17, 0xe3, 0xe1, 0x5f, 0x72, 0x74, 0x41, 0xac,
-	0x29, 0x54, 0x31, 0x25, 0x85, 0xf6, 0x5a, 0x95, 0x30, 0xbc, 0x56, 0xa8, 0xf9, 0xae, 0xd7, 0x37,
-	0xef, 0xed, 0xc6, 0xf3, 0xe1, 0x87, 0x9c, 0x3f, 0x27, 0xe7, 0x1b, 0xd4, 0x09, 0xc1, 0x34, 0xa8,
-	0x23, 0x27, 0xae, 0xba, 0xd5, 0x5a, 0x01, 0x37, 0x9b, 0x75, 0xb7, 0x86, 0x99, 0xeb, 0x7b, 0xb4,
-	0xc0, 0x02, 0xec, 0xd1, 0x87, 0x49, 0xd0, 0xd6, 0x25, 0xa5, 0x4f, 0x24, 0x7c, 0xb1, 0xe4, 0x9a,
-	0x72, 0x09, 0xae, 0xd5, 0x08, 0xa5, 0x4e, 0x80, 0x3d, 0x26, 0xd6, 0x2d, 0xff, 0xd5, 0x00, 0xb3,
-	0x44, 0x9d, 0xbb, 0xe1, 0x50, 0xb1, 0x5e, 0xf7, 0xf7, 0x42, 0x89, 0x32, 0x79, 0xd4, 0x22, 0x94,
-	0xa1, 0x59, 0x98, 0xb0, 0x89, 0xe7, 0x37, 0x4c, 0x63, 0xc9, 0x58, 0xc9, 0x96, 0xc5, 0x07, 0xba,
-	0x02, 0x27, 0xb1, 0xdd, 0x70, 0x3d, 0x97, 0xb2, 0x00, 0x33, 0x3f, 0x30, 0x33, 0x7c, 0x36, 0x39,
-	0x88, 0x4c, 0x38, 0xce, 0xf5, 0x10, 0x62, 0x8e, 0xf1, 0xf9, 0xe8, 0x13, 0xbd, 0x05, 0x59, 0x1c,
-	0x69, 0x32, 0xc7, 0x97, 0x8c, 0x95, 0xdc, 0xfa, 0x6c, 0x5e, 0x9c, 0x4e, 0x3e, 0x3a, 0x9d, 0x7c,
-	0xd1, 0xdb, 0xdf, 0x9c, 0xf9, 0xcb, 0x87, 0xab, 0x27, 0xef, 0x10, 0x12, 0xdb, 0x75, 0xaf, 0xdc,
-	0x91, 0xdc, 0x40, 0xdf, 0x7d, 0xfe, 0xe4, 0x46, 0x52, 0xe9, 0xf2, 0x3c, 0x9c, 0x57, 0x80, 0xa1,
-	0x4d, 0xdf, 0xa3, 0x64, 0xf9, 0xb7, 0x13, 0x70, 0xa6, 0x44, 0x9d, 0xa2, 0x6d, 0x97, 0xb8, 0x43,
-	0x22, 0x94, 0xaf, 0xc1, 0x24, 0x6e, 0xf8, 0x2d, 0x8f, 0x71, 0x98, 0xb9, 0xf5, 0xf3, 0x79, 0x19,
-	0x02, 0xe1, 0xf1, 0xe6, 0xe5, 0xf1, 0xe5, 0x6f, 0xfb, 0xae, 0xb7, 0x39, 0xfe, 0xd1, 0xa7, 0x8b,
-	0xc7, 0xca, 0x72, 0x79, 0x08, 0xb1, 0x81, 0x3d, 0xec, 0x90, 0x20, 0x82, 0x28, 0x3f, 0xd1, 0x25,
-	0x38, 0xf1, 0x30, 0xf0, 0x1b, 0x15, 0x6c, 0xdb, 0x01, 0xa1, 0x94, 0xa3, 0xcc, 0x96, 0x73, 0xe1,
-	0x58, 0x51, 0x0c, 0xa1, 0x0d, 0x98, 0xa4, 0x0c, 0xb3, 0x16, 0x35, 0x27, 0x96, 0x8c, 0x95, 0xe9,
-	0xf5, 0xe5, 0xbc, 0x2a, 0x92, 0xf3, 0xc2, 0xd4, 0x6d, 0xbe, 0xb2, 0x2c, 0x25, 0x50, 0x11, 0x72,
-	0x62, 0x45, 0x85, 0xed, 0x37, 0x89, 0x39, 0xc9, 0x37, 0x58, 0xd2, 0x6d, 0xf0, 0x60, 0xbf, 0x49,
-	0xca, 0xd0, 0x88, 0xff, 0x46, 0x5f, 0x81, 0x9c, 0x08, 0x86, 0x4a, 0xdd, 0xa5, 0xcc, 0x3c, 0xbe,
-	0x34, 0xb6, 0x92, 0x5b, 0xbf, 0xa4, 0xde, 0xa2, 0xc8, 0x17, 0x72, 0xaf, 0x4a, 0x0f, 0x80, 0x90,
-	0x7d, 0xdb, 0xa5, 0x2c, 0xc4, 0x4a, 0x5b, 0xcd, 0x66, 0x7d, 0xbf, 0xf2, 0xd0, 0x7d, 0x4c, 0x6c,
-	0x73, 0x6a, 0xc9, 0x58, 0x99, 0x2a, 0xe7, 0xc4, 0xd8, 0x9d, 0x70, 0x08, 0xbd, 0x0e, 0x26, 0x3f,
-	0xb7, 0x8a, 0xe3, 0xb7, 0x49, 0xc0, 0xb7, 0xaf, 0xd4, 0x7c, 0x8f, 0x05, 0x7e, 0xdd, 0xcc, 0xf2,
-	0xe5, 0x73, 0x7c, 0xfe, 0x6e, 0x3c, 0x7d, 0x5b, 0xcc, 0xa2, 0x75, 0x38, 0x2b, 0x24, 0x1f, 0xfa,
-	0x41, 0x8d, 0xd8, 0x95, 0x28, 0x1d, 0x4c, 0xe0, 0x62, 0x67, 0xf8, 0xe4, 0x1d, 0x3e, 0xf7, 0x40,
-	0x4e, 0xa1, 0x02, 0x9c, 0x09, 0xc8, 0xa3, 0x96, 0x1b, 0x10, 0xbb, 0x82, 0x19, 0x0b, 0xdc, 0x6a,
-	0x8b, 0x11, 0x6a, 0xe6, 0x96, 0xc6, 0x56, 0xb2, 0x65, 0x14, 0x4d, 0x15, 0xe3, 0x19, 0xb4, 0x08,
-	0xd9, 0x16, 0xb5, 0x2b, 0x35, 0xe2, 0x31, 0x6a, 0x9e, 0x58, 0x32, 0x56, 0xc6, 0x37, 0x33, 0xa6,
-	0x51, 0x9e, 0x6a, 0x51, 0xfb, 0x76, 0x38, 0x86, 0xe6, 0x60, 0xb2, 0xed, 0xd7, 0x5b, 0x0d, 0x62,
-	0x9e, 0x0c, 0x67, 0xcb, 0xf2, 0x0b, 0xcd, 0x0b, 0xc1, 0x86, 0x5b, 0xaf, 0x53, 0x73, 0x9a, 0x4f,
-	0x85, 0x42, 0xa5, 0xf0, 0x1b, 0xbd, 0x0a, 0x73, 0x52, 0x57, 0xc5, 0x26, 0x4d, 0x9f, 0xba, 0xac,
-	0x22, 0xbc, 0x66, 0x9e, 0xe2, 0xb6, 0xcf, 0xca, 0xd9, 0x2d, 0x31, 0x29, 0x9c, 0xbc, 0x31, 0x13,
-	0x46, 0x75, 0x22, 0x78, 0x96, 0xe7, 0x60, 0x36, 0x19, 0xb6, 0x32, 0x9e, 0x7f, 0x69, 0x44, 0xf1,
-	0x2c, 0x64, 0x47, 0x91, 0xb5, 0x5f, 0x86, 0x49, 0x69, 0xe4, 0xd8, 0xc1, 0x22, 0x42, 0x8a, 0x29,
-	0xb3, 0x32, 0x06, 0x10, 0xd9, 0x29, 0x01, 0x7c, 0xdf, 0x80, 0xb9, 0x12, 0x75, 0xb6, 0x48, 0x9d,
-	0x30, 0x32, 0x3a, 0x0c, 0xd7, 0xe1, 0x54, 0x40, 0x1a, 0x7e, 0x3b, 0x3c, 0x7e, 0x99, 0x7f, 0x22,
-	0x3d, 0xa7, 0xe5, 0xb0, 0x4c, 0x41, 0xa5, 0xad, 0xe7, 0xe1, 0x5c, 0x9f, 0x49, 0xd2, 0x5c, 0x1b,
-	0x50, 0x89, 0x3a, 0x77, 0x5c, 0x0f, 0xd7, 0xdd, 0x77, 0x46, 0x51, 0x23, 0x95, 0x06, 0x9c, 0xe5,
-	0x87, 0xda, 0xd1, 0x92, 0x50, 0x5e, 0xac, 0x31, 0xb7, 0x8d, 0xd9, 0x0b, 0x56, 0xde, 0xd1, 0x22,
-	0x95, 0x57, 0xe1, 0x74, 0x89, 0x3a, 0xb7, 0xc3, 0x20, 0xa8, 0xbf, 0x28, 0xd5, 0x67, 0x60, 0xa6,
-	0x4b, 0x47, 0x42, 0xb1, 0x38, 0x8d, 0x17, 0xab, 0x38, 0xd2, 0x21, 0x15, 0xff, 0xc2, 0x80, 0xe9,
-	0x12, 0x75, 0x4a, 0xae, 0xc7, 0x8e, 0x7c, 0x4d, 0x0c, 0x17, 0xb5, 0x17, 0x20, 0x1b, 0x90, 0x9a,
-	0xdb, 0x74, 0x89, 0xc7, 0x64, 0xbc, 0x76, 0x06, 0x94, 0x86, 0xcf, 0xc0, 0xa9, 0xd8, 0x44, 0x69,
-	0xf6, 0xbb, 0xc2, 0xec, 0xcd, 0x56, 0xe0, 0x7d, 0x36, 0x66, 0x6b, 0x0c, 0x13, 0x46, 0x48, 0xc3,
-	0x7e, 0x9f, 0xe1, 0xf1, 0xfb, 0x0d, 0x97, 0xed, 0xd8, 0x01, 0xde, 0x1b, 0x45, 0x9a, 0x5f, 0x04,
-	0x60, 0x7e, 0x4f, 0x86, 0x67, 0x99, 0x1f, 0xdd, 0xaf, 0xfb, 0x31, 0xee, 0x71, 0x5e, 0xc9, 0x34,
-	0xb8, 0xef, 0x84, 0xb8, 0x7f, 0xfd, 0xaf, 0xc5, 0x15, 0xc7, 0x65, 0x3b, 0xad, 0x6a, 0xbe, 0xe6,
-	0x37, 0x64, 0x17, 0x28, 0xff, 0xb7, 0x4a, 0xed, 0xdd, 0x42, 0x78, 0xd5, 0x52, 0x2e, 0x40, 0x7f,
-	0x1a, 0xd6, 0xe8, 0x3a, 0x71, 0x70, 0x6d, 0xbf, 0x12, 0xb6, 0x7d, 0xf4, 0x57, 0xcf, 0x9f, 0xdc,
-	0x30, 0x62, 0xcf, 0xcd, 0x43, 0x96, 0x97, 0x4a, 0x56, 0x71, 0x6d, 0x7e, 0xbb, 0x9f, 0x2c, 0x4f,
-	0x89, 0x81, 0x7b, 0x76, 0x38, 0x49, 0xda, 0xc4, 0x63, 0x15, 0x86, 0x1d, 0x7e, 0x73, 0x67, 0xcb,
-	0x53, 0x7c, 0xe0, 0x01, 0x76, 0x34, 0x39, 0xd9, 0xf1, 0x9c, 0xf4, 0xe8, 0x9f, 0x0d, 0xee, 0xd1,
-	0xe8, 0xd6, 0x1b, 0xfd, 0x71, 0x8f, 0xa9, 0x9c, 0x3e, 0x44, 0x63, 0x93, 0x3c, 0x97, 0x89, 0x9e,
-	0x73, 0xd1, 0x40, 0xec, 0x40, 0x91, 0x10, 0x9f, 0x1b, 0x70, 0xb6, 0x44, 0x9d, 0x7b, 0xd5, 0x5a,
-	0x2f, 0xca, 0x1f, 0x1a, 0x30, 0x15, 0xb7, 0x02, 0x02, 0xe8, 0x4b, 0x79, 0xb7, 0x5a, 0xcb, 0x77,
-	0xf7, 0xce, 0xf9, 0x68, 0x05, 0x6f, 0x83, 0x3a, 0xfb, 0x6f, 0xbe, 0x1d, 0x02, 0xff, 0xe7, 0xa7,
-	0x8b, 0x5b, 0xfd, 0xe7, 0xed, 0x56, 0x6b, 0xab, 0x8e, 0x5f, 0x68, 0xaf, 0xdd, 0x2c, 0x34, 0x7c,
-	0xbb, 0x55, 0x27, 0x34, 0x6c, 0xc7, 0xbb, 0xda, 0x70, 0x11, 0x05, 0xdd, 0xd6, 0xc6, 0x86, 0x1c,
-	0x21, 0x63, 0x4c, 0x7e, 0x11, 0x26, 0x80, 0x4a, 0x1f, 0xfc, 0xcd, 0x00, 0xab, 0x44, 0x9d, 0x6d,
-	0xc2, 0xb6, 0xc2, 0xdc, 0x28, 0x11, 0x86, 0x6d, 0xcc, 0x70, 0xe4, 0x88, 0x16, 0x4c, 0x35, 0xe4,
-	0x90, 0xf4, 0xc3, 0xc5, 0xce, 0x81, 0x7b, 0xbb, 0xf1, 0x81, 0x47, 0x72, 0x9b, 0x1b, 0x12, 0xfb,
-	0xba, 0x36, 0xd6, 0x1f, 0x8b, 0xa7, 0x8b, 0x04, 0x1b, 0xe9, 0x8c, 0x55, 0x1d, 0x01, 0xe9, 0x45,
-	0x98, 0x57, 0xc2, 0x91, 0x70, 0xdf, 0x9b, 0x80, 0xcb, 0xa2, 0x57, 0x88, 0x6e, 0xc0, 0xe8, 0x32,
-	0xfa, 0x3c, 0xf4, 0xec, 0x3d, 0x7d, 0xf7, 0xc4, 0xd1, 0xfb, 0xee, 0xc9, 0xd1, 0xf5, 0xdd, 0xc7,
-	0x0f, 0xd6, 0x77, 0x4f, 0x1d, 0xae, 0xef, 0xce, 0x1e, 0xb8, 0xef, 0x86, 0xe1, 0xfa, 0xee, 0x9c,
-	0xb6, 0xef, 0x3e, 0x91, 0xde, 0x77, 0x9f, 0x1c, 0xba, 0xef, 0x9e, 0x3e, 0x58, 0xdf, 0x7d, 0x0d,
-	0xae, 0xe8, 0x43, 0x51, 0xc6, 0xec, 0xdf, 0x0d, 0x58, 0x0a, 0x63, 0x9a, 0x3b, 0xfe, 0x9e, 0x57,
-	0x0b, 0x08, 0xa6, 0xe4, 0x7e, 0xe0, 0x37, 0x7d, 0x8a, 0xeb, 0x47, 0x0e, 0xd8, 0xab, 0x30, 0xcd,
-	0x70, 0xe0, 0x10, 0x16, 0x07, 0xa6, 0xcc, 0x35, 0x31, 0x1a, 0x85, 0xe6, 0x2d, 0xc8, 0xe2, 0x16,
-	0xdb, 0xf1, 0x03, 0x97, 0xed, 0x8b, 0xc8, 0xde, 0x34, 0x3f, 0xf9, 0x70, 0x75, 0x56, 0x6a, 0x91,
-	0xcb, 0xb6, 0x59, 0xe0, 0x7a, 0x4e, 0xb9, 0xb3, 0x74, 0x03, 0xfd, 0xe7, 0xe7, 0x8b, 0x46, 0x88,
-	0xbd, 0x33, 0xb6, 0x7c, 0x19, 0x2e, 0x69, 0xf0, 0x48, 0xd4, 0x9f, 0x74, 0xa3, 0xde, 0x22, 0x6a,
-	0xd4, 0xd5, 0xe1, 0x51, 0x17, 0x64, 0x61, 0xba, 0x3e, 0xe4, 0x25, 0x1c, 0x3b, 0x28, 0x81, 0x3c,
-	0x33, 0x3a, 0xe4, 0xfd, 0x98, 0x24, 0xf2, 0x1f, 0x67, 0x60, 0xb9, 0x44, 0x9d, 0xaf, 0x35, 0x6d,
-	0xd9, 0x89, 0x27, 0xc3, 0x5a, 0xdf, 0xdb, 0xbc, 0x09, 0x96, 0x78, 0x85, 0x54, 0x54, 0xb9, 0x92,
-	0xe1, 0xb9, 0x62, 0x8a, 0x15, 0xfd, 0x5b, 0xa3, 0x5b, 0x70, 0x0e, 0xdb, 0xb6, 0x52, 0x74, 0x8c,
-	0x8b, 0x9e, 0xc5, 0xb6, 0xad, 0x90, 0xbb, 0x0b, 0x28, 0xca, 0xe0, 0x4a, 0xc7, 0x59, 0xe3, 0x03,
-	0x9c, 0x35, 0x13, 0xc9, 0x14, 0x63, 0xa7, 0xcd, 0x47, 0x4e, 0x53, 0xec, 0xb7, 0x7c, 0x95, 0xd7,
-	0xee, 0x74, 0xbf, 0x48, 0xff, 0xfd, 0xce, 0x80, 0x85, 0x78, 0x5d, 0xb2, 0x86, 0xe8, 0x7d, 0x97,
-	0x5a, 0x94, 0x32, 0xe9, 0x45, 0x69, 0x94, 0x79, 0x71, 0x09, 0x16, 0x53, 0xed, 0x8e, 0x38, 0x26,
-	0x83, 0x17, 0x8d, 0x84, 0x0f, 0x12, 0x85, 0x46, 0x8f, 0x30, 0xbd, 0x76, 0x65, 0xd2, 0x6b, 0x17,
-	0xba, 0x09, 0x93, 0xd4, 0x75, 0xbc, 0xe8, 0x4a, 0xd3, 0x00, 0x94, 0xeb, 0x36, 0x4e, 0x45, 0xe8,
-	0xe4, 0xc0, 0xf2, 0x75, 0xb8, 0x3a, 0xc0, 0xec, 0xe8, 0x82, 0x16, 0x7c, 0xe1, 0x36, 0x09, 0x27,
-	0xc2, 0xfc, 0xdb, 0xea, 0xea, 0x46, 0xd4, 0xa0, 0x66, 0x61, 0xa2, 0x8d, 0xeb, 0x2d, 0x22, 0x0b,
-	0x97, 0xf8, 0x18, 0x85, 0xd1, 0x82, 0xed, 0xeb, 0x35, 0x45, 0x1a, 0xfa, 0x5f, 0x03, 0x2e, 0xc4,
-	0x90, 0xb6, 0x89, 0x67, 0x6f, 0x11, 0x6f, 0x3f, 0xbc, 0x38, 0xf5, 0xc6, 0xde, 0x82, 0x73, 0x32,
-	0x3f, 0x6d, 0xe2, 0xb9, 0x1d, 0x0a, 0x21, 0x4e, 0xce, 0xb3, 0x62, 0x7a, 0x8b, 0xcf, 0x16, 0xa3,
-	0x49, 0x74, 0x13, 0x66, 0xc3, 0xcc, 0xec, 0x13, 0x12, 0x69, 0x89, 0xb0, 0x6d, 0xf7, 0x4a, 0x24,
-	0x22, 0x73, 0xfc, 0x68, 0x91, 0xb9, 0x08, 0x17, 0x53, 0xb0, 0x4a, 0x6f, 0xfc, 0xc1, 0xe0, 0x7d,
-	0x57, 0xd1, 0xb6, 0xbf, 0x4a, 0x58, 0x91, 0x52, 0xc2, 0xbe, 0x1e, 0x9e, 0xc2, 0x48, 0xf8, 0x96,
-	0x6d, 0x38, 0xed, 0x85, 0xd7, 0x53, 0xb8, 0x6b, 0x85, 0x1f, 0x6e, 0xc4, 0x1e, 0x5d, 0x56, 0xf7,
-	0x35, 0x09, 0x13, 0xe4, 0x75, 0x37, 0xed, 0x25, 0xec, 0x52, 0xf6, 0x8e, 0x0b, 0xfc, 0x44, 0x15,
-	0x18, 0x24, 0xc8, 0x3f, 0x19, 0xbc, 0x30, 0x87, 0x01, 0xd1, 0x2d, 0xd7, 0x7b, 0x29, 0xa9, 0xb1,
-	0x76, 0x98, 0xaf, 0xcc, 0xa1, 0x98, 0xaf, 0x91, 0x56, 0x1a, 0x51, 0x49, 0xd3, 0x81, 0x48, 0xc0,
-	0xbf, 0x31, 0x78, 0xda, 0x96, 0x79, 0x44, 0x1e, 0x02, 0xb3, 0x82, 0x29, 0x13, 0x41, 0xde, 0xc3,
-	0x94, 0x8d, 0x14, 0xdb, 0x0a, 0x5c, 0x1b, 0x64, 0xb3, 0x84, 0xf7, 0x47, 0x71, 0x51, 0xdc, 0xde,
-	0xc1, 0x9e, 0x43, 0x04, 0x05, 0x3e, 0x1c, 0xae, 0x22, 0x80, 0x47, 0xf6, 0x2a, 0x92, 0x5f, 0xcf,
-	0x0c, 0xcd, 0xaf, 0x67, 0x3d, 0xb2, 0x27, 0xfe, 0x7c, 0x01, 0xf7, 0x86, 0x1a, 0x86, 0x84, 0xfa,
-	0x41, 0x86, 0x77, 0x53, 0xd1, 0x2b, 0xff, 0x2d, 0x5a, 0x0b, 0xfc, 0xbd, 0xe1, 0xc0, 0xd6, 0xe2,
-	0x1e, 0x2b, 0x33, 0x88, 0xe8, 0xb8, 0x79, 0x50, 0xa2, 0x43, 0xd3, 0x85, 0x8e, 0x0d, 0xec, 0x42,
-	0xc7, 0x47, 0xd1, 0x8b, 0xa5, 0x79, 0x44, 0xfa, 0xed, 0x59, 0x9c, 0xf2, 0x89, 0xf7, 0x64, 0xaf,
-	0xe7, 0xfe, 0x4f, 0xcf, 0xe4, 0xc3, 0xb6, 0xa6, 0xd3, 0x69, 0xe5, 0x20, 0x05, 0xa4, 0x74, 0xc6,
-	0xcf, 0x04, 0x9f, 0x2e, 0xae, 0x81, 0xfb, 0x38, 0xc0, 0x8d, 0xb8, 0xbe, 0x27, 0x2c, 0x31, 0x86,
-	0xb6, 0x04, 0x6d, 0xc0, 0x64, 0x93, 0x6f, 0xc4, 0xcd, 0xcf, 0xad, 0x5f, 0x50, 0x67, 0x91, 0x50,
-	0x16, 0x15, 0x44, 0x21, 0xd1, 0x87, 0x42, 0x50, 0xeb, 0x49, 0xeb, 0xa4, 0xe5, 0xef, 0x8b, 0x4c,
-	0x2f, 0x93, 0xb6, 0xbf, 0x4b, 0x3e, 0xc3, 0xdf, 0x22, 0x95, 0xd7, 0x8c, 0x48, 0x57, 0xb5, 0x2d,
-	0xc2, 0xde, 0xf5, 0xf7, 0x2f, 0xc0, 0x58, 0x89, 0x3a, 0xa8, 0x02, 0x53, 0xd1, 0xe3, 0x10, 0xad,
-	0xa4, 0x14, 0x98, 0xbe, 0x9f, 0x0c, 0xac, 0x97, 0x86, 0x58, 0x29, 0x14, 0x85, 0x0a, 0xa2, 0x57,
-	0xa7, 0x46, 0x41, 0xcf, 0xcf, 0x02, 0x1a, 0x05, 0xbd, 0xd4, 0x3e, 0xfa, 0x26, 0x4c, 0x0a, 0xce,
-	0x1d, 0x5d, 0x4b, 0x15, 0x4a, 0x10, 0xff, 0xd6, 0xf5, 0x81, 0xeb, 0x3a, 0x5b, 0x0b, 0x56, 0x5d,
-	0xb3, 0x75, 0x82, 0xda, 0xd7, 0x6c, 0x9d, 0xa4, 0xe7, 0xd1, 0x36, 0x8c, 0x97, 0x5c, 0x8f, 0xa1,
-	0x2b, 0xa9, 0x02, 0x5d, 0xcc, 0xbd, 0x75, 0x75, 0xc0, 0xaa, 0xce, 0xa6, 0x9b, 0xad, 0xc0, 0xd3,
-	0x6c, 0xda, 0xc5, 0xab, 0x6b, 0x36, 0xed, 0x26, 0xbe, 0x51, 0x15, 0xb2, 0xf1, 0x0f, 0x5f, 0x48,
-	0x73, 0x2e, 0x3d, 0x3f, 0xe2, 0x59, 0x37, 0x86, 0x59, 0x2a, 0x75, 0xec, 0xc2, 0x89, 0xee, 0x1f,
-	0xac, 0xd0, 0xcb, 0x03, 0xdc, 0x98, 0xd4, 0xb4, 0x3a, 0xe4, 0xea, 0x4e, 0x44, 0x46, 0x35, 0x59,
-	0x13, 0x91, 0x3d, 0x44, 0xbf, 0x26, 0x22, 0x7b, 0x89, 0x6d, 0xe9, 0x31, 0x71, 0x2f, 0xeb, 0x3d,
-	0x96, 0xa0, 0x04, 0xf5, 0x1e, 0x4b, 0x52, 0x36, 0x21, 0x88, 0xf8, 0x85, 0x98, 0x0e, 0xa2, 0xe7,
-	0x55, 0xaa, 0x01, 0xd1, 0xfb, 0x0e, 0x44, 0x3b, 0x90, 0xeb, 0x62, 0x73, 0xd1, 0x17, 0x52, 0x25,
-	0xfb, 0xc9, 0x6d, 0xeb, 0xe5, 0xe1, 0x16, 0x4b, 0x4d, 0x7b, 0x70, 0xba, 0xf7, 0x62, 0x40, 0x37,
-	0x53, 0x77, 0x48, 0xe1, 0x91, 0xad, 0xb5, 0x03, 0x48, 0x48, 0xc5, 0x8f, 0x60, 0x3a, 0x59, 0x1d,
-	0x51, 0x3e, 0x75, 0x13, 0x65, 0x49, 0xb7, 0x0a, 0x43, 0xaf, 0x97, 0x2a, 0x7f, 0x60, 0xc0, 0xf9,
-	0x54, 0x3e, 0x0e, 0xbd, 0xa1, 0x0b, 0x00, 0x2d, 0x9d, 0x6c, 0x6d, 0x1c, 0x46, 0x54, 0x1a, 0xf5,
-	0x9e, 0x01, 0x73, 0x6a, 0xae, 0x0c, 0xdd, 0x4a, 0xf7, 0xaa, 0x8e, 0x2c, 0xb4, 0x5e, 0x3b, 0xb0,
-	0x5c, 0x9f, 0x2d, 0xbd, 0xec, 0xd5, 0x40, 0x5b, 0x52, 0x28, 0xbc, 0x81, 0xb6, 0xa4, 0xd1, 0x64,
-	0xe8, 0x7b, 0x06, 0x98, 0x69, 0x5c, 0x10, 0x7a, 0x3d, 0x75, 0xd7, 0x01, 0xb4, 0x9a, 0xf5, 0xc6,
-	0x21, 0x24, 0xa5, 0x45, 0xef, 0x1a, 0x30, 0xab, 0x62, 0x6f, 0xd0, 0xab, 0x03, 0xf6, 0x54, 0x92,
-	0x54, 0xd6, 0x17, 0x0f, 0x28, 0x25, 0xad, 0xf8, 0x91, 0x01, 0x56, 0x3a, 0xd1, 0x82, 0x36, 0x86,
-	0xc3, 0xa7, 0x22, 0x95, 0xac, 0x2f, 0x1d, 0x4a, 0xb6, 0x93, 0xcf, 0x49, 0x2a, 0x45, 0x93, 0xcf,
-	0x4a, 0xfa, 0x47, 0x93, 0xcf, 0x6a, 0x8e, 0x06, 0x7d, 0x1b, 0x50, 0x3f, 0x67, 0x81, 0xd6, 0x07,
-	0xa0, 0x50, 0x90, 0x39, 0xd6, 0x2b, 0x07, 0x92, 0x91, 0xea, 0xdf, 0x81, 0x99, 0x3e, 0x32, 0x01,
-	0xad, 0xe9, 0x4a, 0x81, 0x92, 0x3c, 0xb1, 0xd6, 0x0f, 0x22, 0xd2, 0x95, 0x1d, 0x69, 0xef, 0x7b,
-	0x4d, 0x76, 0x0c, 0xe0, 0x36, 0x34, 0xd9, 0x31, 0x88, 0x4c, 0x40, 0x3f, 0x31, 0x60, 0x5e, 0xf3,
-	0x2a, 0x47, 0xe9, 0xc1, 0x35, 0x98, 0x7f, 0xb0, 0xde, 0x3c, 0x9c, 0x70, 0x57, 0xe2, 0xaa, 0x9e,
-	0xcf, 0x9a, 0xc4, 0xd5, 0x90, 0x06, 0x9a, 0xc4, 0xd5, 0xbd, 0xd1, 0x79, 0x71, 0x55, 0x3f, 0x47,
-	0x35, 0xc5, 0x55, 0xfb, 0xa2, 0xd7, 0x14, 0x57, 0xfd, 0xbb, 0x37, 0x0a, 0x1f, 0xe5, 0x7b, 0x50,
-	0x1f, 0x3e, 0xba, 0x77, 0xb2, 0x3e, 0x7c, 0xb4, 0x8f, 0xcf, 0xb0, 0x09, 0xed, 0x7e, 0xda, 0x69,
-	0x9a, 0x50, 0xc5, 0xfb, 0x54, 0xd3, 0x84, 0xaa, 0xde, 0x8b, 0x3c, 0x20, 0x54, 0x0f, 0x34, 0x4d,
-	0x40, 0x68, 0xde, 0x96, 0x9a, 0x80, 0xd0, 0xbd, 0x02, 0xad, 0x89, 0xef, 0x3c, 0x7f, 0x72, 0xc3,
-	0xd8, 0x74, 0x3e, 0x7a, 0xba, 0x60, 0x7c, 0xfc, 0x74, 0xc1, 0xf8, 0xf7, 0xd3, 0x05, 0xe3, 0x83,
-	0x67, 0x0b, 0xc7, 0x3e, 0x7e, 0xb6, 0x70, 0xec, 0x1f, 0xcf, 0x16, 0x8e, 0xc1, 0x39, 0xd7, 0x57,
-	0xee, 0x7c, 0xdf, 0xf8, 0x56, 0x37, 0xb1, 0xd0, 0x59, 0xb2, 0xea, 0xfa, 0x5d, 0x5f, 0x85, 0xc7,
-	0xd1, 0x3f, 0xdd, 0xe5, 0x0c, 0x43, 0x75, 0x92, 0xff, 0xeb, 0xd8, 0x57, 0xfe, 0x17, 0x00, 0x00,
-	0xff, 0xff, 0x23, 0xc7, 0xf2, 0xaa, 0x13, 0x2d, 0x00, 0x00,
+func (m *MsgFreezeHolderRequest) Reset()         { *m = MsgFreezeHolderRequest{} }
+func (m *MsgFreezeHolderRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeHolderRequest) ProtoMessage()    {}
+func (*MsgFreezeHolderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{60}
 }
-
-func (this *MsgSupplyIncreaseProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*MsgSupplyIncreaseProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgSupplyIncreaseProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgFreezeHolderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeHolderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeHolderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *MsgFreezeHolderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeHolderRequest.Merge(m, src)
+}
+func (m *MsgFreezeHolderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeHolderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeHolderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeHolderRequest proto.InternalMessageInfo
+
+func (m *MsgFreezeHolderRequest) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	if !this.Amount.Equal(&that1.Amount) {
-		return false
+	return types1.Coin{}
+}
+
+func (m *MsgFreezeHolderRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if this.TargetAddress != that1.TargetAddress {
-		return false
+	return ""
+}
+
+func (m *MsgFreezeHolderRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	if this.Authority != that1.Authority {
-		return false
+	return ""
+}
+
+func (m *MsgFreezeHolderRequest) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return true
+	return time.Time{}
 }
-func (this *MsgSupplyDecreaseProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgFreezeHolderRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgSupplyDecreaseProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgSupplyDecreaseProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgFreezeHolderResponse defines the Msg/FreezeHolder response type.
+type MsgFreezeHolderResponse struct {
+}
+
+func (m *MsgFreezeHolderResponse) Reset()         { *m = MsgFreezeHolderResponse{} }
+func (m *MsgFreezeHolderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeHolderResponse) ProtoMessage()    {}
+func (*MsgFreezeHolderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{61}
+}
+func (m *MsgFreezeHolderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeHolderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeHolderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	return true
 }
-func (this *MsgUpdateRequiredAttributesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgFreezeHolderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeHolderResponse.Merge(m, src)
+}
+func (m *MsgFreezeHolderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeHolderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeHolderResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgUpdateRequiredAttributesRequest)
-	if !ok {
-		that2, ok := that.(MsgUpdateRequiredAttributesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_MsgFreezeHolderResponse proto.InternalMessageInfo
+
+// MsgUnfreezeHolderRequest defines the Msg/UnfreezeHolder request type.
+type MsgUnfreezeHolderRequest struct {
+	// denom is the denom of the marker.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// administrator is the signer of the message. Must have freeze access on the marker.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// holder is the address of the account to remove the freeze from.
+	Holder string `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+}
+
+func (m *MsgUnfreezeHolderRequest) Reset()         { *m = MsgUnfreezeHolderRequest{} }
+func (m *MsgUnfreezeHolderRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeHolderRequest) ProtoMessage()    {}
+func (*MsgUnfreezeHolderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{62}
+}
+func (m *MsgUnfreezeHolderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeHolderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeHolderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *MsgUnfreezeHolderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeHolderRequest.Merge(m, src)
+}
+func (m *MsgUnfreezeHolderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeHolderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeHolderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeHolderRequest proto.InternalMessageInfo
+
+func (m *MsgUnfreezeHolderRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if this.Denom != that1.Denom {
-		return false
+	return ""
+}
+
+func (m *MsgUnfreezeHolderRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(this.RemoveRequiredAttributes) != len(that1.RemoveRequiredAttributes) {
-		return false
+	return ""
+}
+
+func (m *MsgUnfreezeHolderRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	for i := range this.RemoveRequiredAttributes {
-		if this.RemoveRequiredAttributes[i] != that1.RemoveRequiredAttributes[i] {
-			return false
+	return ""
+}
+
+// MsgUnfreezeHolderResponse defines the Msg/UnfreezeHolder response type.
+type MsgUnfreezeHolderResponse struct {
+}
+
+func (m *MsgUnfreezeHolderResponse) Reset()         { *m = MsgUnfreezeHolderResponse{} }
+func (m *MsgUnfreezeHolderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeHolderResponse) ProtoMessage()    {}
+func (*MsgUnfreezeHolderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{63}
+}
+func (m *MsgUnfreezeHolderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeHolderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeHolderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.AddRequiredAttributes) != len(that1.AddRequiredAttributes) {
-		return false
-	}
-	for i := range this.AddRequiredAttributes {
-		if this.AddRequiredAttributes[i] != that1.AddRequiredAttributes[i] {
-			return false
-		}
-	}
-	if this.TransferAuthority != that1.TransferAuthority {
-		return false
-	}
-	return true
 }
-func (this *MsgUpdateForcedTransferRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgUnfreezeHolderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeHolderResponse.Merge(m, src)
+}
+func (m *MsgUnfreezeHolderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeHolderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeHolderResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgUpdateForcedTransferRequest)
-	if !ok {
-		that2, ok := that.(MsgUpdateForcedTransferRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.AllowForcedTransfer != that1.AllowForcedTransfer {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	return true
+var xxx_messageInfo_MsgUnfreezeHolderResponse proto.InternalMessageInfo
+
+// MsgDistributeToHoldersRequest defines the Msg/DistributeToHolders request type.
+// The holder balances are recorded when this message is processed, and the payments are made over the following blocks.
+type MsgDistributeToHoldersRequest struct {
+	// denom is the denom of the marker whose holders will receive the payout.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// administrator is the signer of the message and the source of the payout funds. Must have admin access on the marker.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// payout is the amount to distribute. It cannot be in the marker's denom.
+	Payout types1.Coin `protobuf:"bytes,3,opt,name=payout,proto3" json:"payout"`
 }
-func (this *MsgUpdateRequireDepositAccessRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgUpdateRequireDepositAccessRequest)
-	if !ok {
-		that2, ok := that.(MsgUpdateRequireDepositAccessRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgDistributeToHoldersRequest) Reset()         { *m = MsgDistributeToHoldersRequest{} }
+func (m *MsgDistributeToHoldersRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDistributeToHoldersRequest) ProtoMessage()    {}
+func (*MsgDistributeToHoldersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{64}
+}
+func (m *MsgDistributeToHoldersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDistributeToHoldersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDistributeToHoldersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.RequireDepositAccess != that1.RequireDepositAccess {
-		return false
+}
+func (m *MsgDistributeToHoldersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDistributeToHoldersRequest.Merge(m, src)
+}
+func (m *MsgDistributeToHoldersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDistributeToHoldersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDistributeToHoldersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDistributeToHoldersRequest proto.InternalMessageInfo
+
+func (m *MsgDistributeToHoldersRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if this.Signer != that1.Signer {
-		return false
+	return ""
+}
+
+func (m *MsgDistributeToHoldersRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return true
+	return ""
 }
-func (this *MsgSetAccountDataRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgDistributeToHoldersRequest) GetPayout() types1.Coin {
+	if m != nil {
+		return m.Payout
 	}
+	return types1.Coin{}
+}
 
-	that1, ok := that.(*MsgSetAccountDataRequest)
-	if !ok {
-		that2, ok := that.(MsgSetAccountDataRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgDistributeToHoldersResponse defines the Msg/DistributeToHolders response type.
+type MsgDistributeToHoldersResponse struct {
+	// distribution_id is the id of the newly created distribution.
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+}
+
+func (m *MsgDistributeToHoldersResponse) Reset()         { *m = MsgDistributeToHoldersResponse{} }
+func (m *MsgDistributeToHoldersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDistributeToHoldersResponse) ProtoMessage()    {}
+func (*MsgDistributeToHoldersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{65}
+}
+func (m *MsgDistributeToHoldersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDistributeToHoldersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDistributeToHoldersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
-	}
-	if this.Signer != that1.Signer {
-		return false
-	}
-	return true
 }
-func (this *MsgUpdateSendDenyListRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *MsgDistributeToHoldersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDistributeToHoldersResponse.Merge(m, src)
+}
+func (m *MsgDistributeToHoldersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDistributeToHoldersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDistributeToHoldersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDistributeToHoldersResponse proto.InternalMessageInfo
+
+func (m *MsgDistributeToHoldersResponse) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
 	}
+	return 0
+}
 
-	that1, ok := that.(*MsgUpdateSendDenyListRequest)
-	if !ok {
-		that2, ok := that.(MsgUpdateSendDenyListRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgClaimDistributionRequest defines the Msg/ClaimDistribution request type.
+type MsgClaimDistributionRequest struct {
+	// holder is the signer of the message and the account that is owed the payment.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// distribution_id is the id of the distribution to claim the payment of.
+	DistributionId uint64 `protobuf:"varint,2,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+}
+
+func (m *MsgClaimDistributionRequest) Reset()         { *m = MsgClaimDistributionRequest{} }
+func (m *MsgClaimDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimDistributionRequest) ProtoMessage()    {}
+func (*MsgClaimDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{66}
+}
+func (m *MsgClaimDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
+}
+func (m *MsgClaimDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimDistributionRequest.Merge(m, src)
+}
+func (m *MsgClaimDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimDistributionRequest proto.InternalMessageInfo
+
+func (m *MsgClaimDistributionRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	if len(this.RemoveDeniedAddresses) != len(that1.RemoveDeniedAddresses) {
-		return false
+	return ""
+}
+
+func (m *MsgClaimDistributionRequest) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
 	}
-	for i := range this.RemoveDeniedAddresses {
-		if this.RemoveDeniedAddresses[i] != that1.RemoveDeniedAddresses[i] {
-			return false
+	return 0
+}
+
+// MsgClaimDistributionResponse defines the Msg/ClaimDistribution response type.
+type MsgClaimDistributionResponse struct {
+}
+
+func (m *MsgClaimDistributionResponse) Reset()         { *m = MsgClaimDistributionResponse{} }
+func (m *MsgClaimDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimDistributionResponse) ProtoMessage()    {}
+func (*MsgClaimDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{67}
+}
+func (m *MsgClaimDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.AddDeniedAddresses) != len(that1.AddDeniedAddresses) {
-		return false
-	}
-	for i := range this.AddDeniedAddresses {
-		if this.AddDeniedAddresses[i] != that1.AddDeniedAddresses[i] {
-			return false
+}
+func (m *MsgClaimDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimDistributionResponse.Merge(m, src)
+}
+func (m *MsgClaimDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimDistributionResponse proto.InternalMessageInfo
+
+// MsgSetRedemptionPayoutDenomRequest defines the Msg/SetRedemptionPayoutDenom request type.
+type MsgSetRedemptionPayoutDenomRequest struct {
+	// denom is the denom of the marker.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// administrator is the signer of the message. Must have admin access on the marker.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// payout_denom is the denom that redemptions are paid out in. Leave empty to stop accepting redemption requests.
+	PayoutDenom string `protobuf:"bytes,3,opt,name=payout_denom,json=payoutDenom,proto3" json:"payout_denom,omitempty"`
+}
+
+func (m *MsgSetRedemptionPayoutDenomRequest) Reset()         { *m = MsgSetRedemptionPayoutDenomRequest{} }
+func (m *MsgSetRedemptionPayoutDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRedemptionPayoutDenomRequest) ProtoMessage()    {}
+func (*MsgSetRedemptionPayoutDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{68}
+}
+func (m *MsgSetRedemptionPayoutDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRedemptionPayoutDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRedemptionPayoutDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Authority != that1.Authority {
-		return false
+}
+func (m *MsgSetRedemptionPayoutDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRedemptionPayoutDenomRequest.Merge(m, src)
+}
+func (m *MsgSetRedemptionPayoutDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRedemptionPayoutDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRedemptionPayoutDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRedemptionPayoutDenomRequest proto.InternalMessageInfo
+
+func (m *MsgSetRedemptionPayoutDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return true
+	return ""
 }
-func (this *MsgSetAdministratorProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgSetRedemptionPayoutDenomRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgSetAdministratorProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgSetAdministratorProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if len(this.Access) != len(that1.Access) {
-		return false
+func (m *MsgSetRedemptionPayoutDenomRequest) GetPayoutDenom() string {
+	if m != nil {
+		return m.PayoutDenom
 	}
-	for i := range this.Access {
-		if !this.Access[i].Equal(&that1.Access[i]) {
-			return false
+	return ""
+}
+
+// MsgSetRedemptionPayoutDenomResponse defines the Msg/SetRedemptionPayoutDenom response type.
+type MsgSetRedemptionPayoutDenomResponse struct {
+}
+
+func (m *MsgSetRedemptionPayoutDenomResponse) Reset()         { *m = MsgSetRedemptionPayoutDenomResponse{} }
+func (m *MsgSetRedemptionPayoutDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRedemptionPayoutDenomResponse) ProtoMessage()    {}
+func (*MsgSetRedemptionPayoutDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{69}
+}
+func (m *MsgSetRedemptionPayoutDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRedemptionPayoutDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRedemptionPayoutDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	return true
 }
-func (this *MsgRemoveAdministratorProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgSetRedemptionPayoutDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRedemptionPayoutDenomResponse.Merge(m, src)
+}
+func (m *MsgSetRedemptionPayoutDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRedemptionPayoutDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRedemptionPayoutDenomResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgRemoveAdministratorProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgRemoveAdministratorProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_MsgSetRedemptionPayoutDenomResponse proto.InternalMessageInfo
+
+// MsgRequestRedemptionRequest defines the Msg/RequestRedemption request type.
+type MsgRequestRedemptionRequest struct {
+	// holder is the signer of the message and the account the coins are taken from.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// amount is the amount of a marker's coin to redeem.
+	Amount types1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRequestRedemptionRequest) Reset()         { *m = MsgRequestRedemptionRequest{} }
+func (m *MsgRequestRedemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestRedemptionRequest) ProtoMessage()    {}
+func (*MsgRequestRedemptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{70}
+}
+func (m *MsgRequestRedemptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestRedemptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
+}
+func (m *MsgRequestRedemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestRedemptionRequest.Merge(m, src)
+}
+func (m *MsgRequestRedemptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestRedemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestRedemptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestRedemptionRequest proto.InternalMessageInfo
+
+func (m *MsgRequestRedemptionRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	if len(this.RemovedAddress) != len(that1.RemovedAddress) {
-		return false
+	return ""
+}
+
+func (m *MsgRequestRedemptionRequest) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	for i := range this.RemovedAddress {
-		if this.RemovedAddress[i] != that1.RemovedAddress[i] {
-			return false
+	return types1.Coin{}
+}
+
+// MsgRequestRedemptionResponse defines the Msg/RequestRedemption response type.
+type MsgRequestRedemptionResponse struct {
+	// redemption_id is the id of the newly created redemption.
+	RedemptionId uint64 `protobuf:"varint,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+}
+
+func (m *MsgRequestRedemptionResponse) Reset()         { *m = MsgRequestRedemptionResponse{} }
+func (m *MsgRequestRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestRedemptionResponse) ProtoMessage()    {}
+func (*MsgRequestRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{71}
+}
+func (m *MsgRequestRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	return true
 }
-func (this *MsgChangeStatusProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *MsgRequestRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestRedemptionResponse.Merge(m, src)
+}
+func (m *MsgRequestRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestRedemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestRedemptionResponse proto.InternalMessageInfo
+
+func (m *MsgRequestRedemptionResponse) GetRedemptionId() uint64 {
+	if m != nil {
+		return m.RedemptionId
 	}
+	return 0
+}
 
-	that1, ok := that.(*MsgChangeStatusProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgChangeStatusProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgFulfillRedemptionRequest defines the Msg/FulfillRedemption request type.
+type MsgFulfillRedemptionRequest struct {
+	// administrator is the signer of the message and the source of the payout funds. Must have burn access on the marker.
+	Administrator string `protobuf:"bytes,1,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// redemption_id is the id of the redemption to fulfill.
+	RedemptionId uint64 `protobuf:"varint,2,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	// payout is the amount to pay the holder. It must be in the marker's payout denom.
+	// If not provided, the payout is calculated from the marker's net asset value in the payout denom.
+	Payout *types1.Coin `protobuf:"bytes,3,opt,name=payout,proto3" json:"payout,omitempty"`
+}
+
+func (m *MsgFulfillRedemptionRequest) Reset()         { *m = MsgFulfillRedemptionRequest{} }
+func (m *MsgFulfillRedemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillRedemptionRequest) ProtoMessage()    {}
+func (*MsgFulfillRedemptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{72}
+}
+func (m *MsgFulfillRedemptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillRedemptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.NewStatus != that1.NewStatus {
-		return false
+}
+func (m *MsgFulfillRedemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillRedemptionRequest.Merge(m, src)
+}
+func (m *MsgFulfillRedemptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillRedemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillRedemptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillRedemptionRequest proto.InternalMessageInfo
+
+func (m *MsgFulfillRedemptionRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if this.Authority != that1.Authority {
-		return false
+	return ""
+}
+
+func (m *MsgFulfillRedemptionRequest) GetRedemptionId() uint64 {
+	if m != nil {
+		return m.RedemptionId
 	}
-	return true
+	return 0
 }
-func (this *MsgWithdrawEscrowProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgFulfillRedemptionRequest) GetPayout() *types1.Coin {
+	if m != nil {
+		return m.Payout
 	}
+	return nil
+}
 
-	that1, ok := that.(*MsgWithdrawEscrowProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgWithdrawEscrowProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgFulfillRedemptionResponse defines the Msg/FulfillRedemption response type.
+type MsgFulfillRedemptionResponse struct {
+	// payout is the amount that was paid to the holder.
+	Payout types1.Coin `protobuf:"bytes,1,opt,name=payout,proto3" json:"payout"`
+}
+
+func (m *MsgFulfillRedemptionResponse) Reset()         { *m = MsgFulfillRedemptionResponse{} }
+func (m *MsgFulfillRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillRedemptionResponse) ProtoMessage()    {}
+func (*MsgFulfillRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{73}
+}
+func (m *MsgFulfillRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if len(this.Amount) != len(that1.Amount) {
-		return false
+}
+func (m *MsgFulfillRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillRedemptionResponse.Merge(m, src)
+}
+func (m *MsgFulfillRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillRedemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillRedemptionResponse proto.InternalMessageInfo
+
+func (m *MsgFulfillRedemptionResponse) GetPayout() types1.Coin {
+	if m != nil {
+		return m.Payout
 	}
-	for i := range this.Amount {
-		if !this.Amount[i].Equal(&that1.Amount[i]) {
-			return false
+	return types1.Coin{}
+}
+
+// MsgRejectRedemptionRequest defines the Msg/RejectRedemption request type.
+type MsgRejectRedemptionRequest struct {
+	// administrator is the signer of the message. Must have admin access on the marker.
+	Administrator string `protobuf:"bytes,1,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// redemption_id is the id of the redemption to reject.
+	RedemptionId uint64 `protobuf:"varint,2,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	// reason is a description of why the redemption was rejected.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgRejectRedemptionRequest) Reset()         { *m = MsgRejectRedemptionRequest{} }
+func (m *MsgRejectRedemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectRedemptionRequest) ProtoMessage()    {}
+func (*MsgRejectRedemptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{74}
+}
+func (m *MsgRejectRedemptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectRedemptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.TargetAddress != that1.TargetAddress {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	return true
+}
+func (m *MsgRejectRedemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectRedemptionRequest.Merge(m, src)
+}
+func (m *MsgRejectRedemptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectRedemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectRedemptionRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgRejectRedemptionRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgRejectRedemptionRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Finalize
-	Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error)
-	// Activate
-	Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error)
-	// Cancel
-	Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error)
-	// Delete
-	Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error)
-	// Mint
-	Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error)
-	// Burn
-	Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error)
-	// AddAccess
-	AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error)
-	// DeleteAccess
-	DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error)
-	// Withdraw
-	Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
-	// AddMarker
-	AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error)
-	// Transfer marker denominated coin between accounts
-	Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error)
-	// Transfer over ibc any marker(including restricted markers) between ibc accounts.
-	// The relayer is still needed to accomplish ibc middleware relays.
-	IbcTransfer(ctx context.Context, in *MsgIbcTransferRequest, opts ...grpc.CallOption) (*MsgIbcTransferResponse, error)
-	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
-	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
-	// GrantAllowance grants fee allowance to the grantee on the granter's
-	// account with the provided expiration time.
-	GrantAllowance(ctx context.Context, in *MsgGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error)
-	// AddFinalizeActivateMarker
-	AddFinalizeActivateMarker(ctx context.Context, in *MsgAddFinalizeActivateMarkerRequest, opts ...grpc.CallOption) (*MsgAddFinalizeActivateMarkerResponse, error)
-	// SupplyIncreaseProposal can only be called via gov proposal
-	SupplyIncreaseProposal(ctx context.Context, in *MsgSupplyIncreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyIncreaseProposalResponse, error)
-	// SupplyDecreaseProposal can only be called via gov proposal
-	SupplyDecreaseProposal(ctx context.Context, in *MsgSupplyDecreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyDecreaseProposalResponse, error)
-	// UpdateRequiredAttributes will only succeed if signer has transfer authority
-	UpdateRequiredAttributes(ctx context.Context, in *MsgUpdateRequiredAttributesRequest, opts ...grpc.CallOption) (*MsgUpdateRequiredAttributesResponse, error)
-	// UpdateForcedTransfer updates the allow_forced_transfer field of a marker via governance proposal.
-	UpdateForcedTransfer(ctx context.Context, in *MsgUpdateForcedTransferRequest, opts ...grpc.CallOption) (*MsgUpdateForcedTransferResponse, error)
-	// UpdateRequireDepositAccess updates the require_deposit_access field of a marker. Signer must have admin access or be
-	// the governance module account.
-	UpdateRequireDepositAccess(ctx context.Context, in *MsgUpdateRequireDepositAccessRequest, opts ...grpc.CallOption) (*MsgUpdateRequireDepositAccessResponse, error)
-	// SetAccountData sets the accountdata for a denom. Signer must have deposit authority.
-	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
-	// UpdateSendDenyList will only succeed if signer has admin authority
-	UpdateSendDenyList(ctx context.Context, in *MsgUpdateSendDenyListRequest, opts ...grpc.CallOption) (*MsgUpdateSendDenyListResponse, error)
-	// AddNetAssetValues sets the net asset value for a marker.
-	AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error)
-	// SetAdministratorProposal sets administrators with specific access on the marker
-	SetAdministratorProposal(ctx context.Context, in *MsgSetAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgSetAdministratorProposalResponse, error)
-	// RemoveAdministratorProposal removes administrators with specific access on the marker
-	RemoveAdministratorProposal(ctx context.Context, in *MsgRemoveAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgRemoveAdministratorProposalResponse, error)
-	// ChangeStatusProposal is a governance proposal change marker status
-	ChangeStatusProposal(ctx context.Context, in *MsgChangeStatusProposalRequest, opts ...grpc.CallOption) (*MsgChangeStatusProposalResponse, error)
-	// WithdrawEscrowProposal is a governance proposal to withdraw escrow coins from a marker
-	WithdrawEscrowProposal(ctx context.Context, in *MsgWithdrawEscrowProposalRequest, opts ...grpc.CallOption) (*MsgWithdrawEscrowProposalResponse, error)
-	// SetDenomMetadataProposal is a governance proposal to set marker metadata
-	SetDenomMetadataProposal(ctx context.Context, in *MsgSetDenomMetadataProposalRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataProposalResponse, error)
-	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
-	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// RevokeGrantAllowance revokes a fee allowance granted by a admin to a grantee.
-	RevokeGrantAllowance(ctx context.Context, in *MsgRevokeGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgRevokeGrantAllowanceResponse, error)
+func (m *MsgRejectRedemptionRequest) GetRedemptionId() uint64 {
+	if m != nil {
+		return m.RedemptionId
+	}
+	return 0
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRejectRedemptionRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgRejectRedemptionResponse defines the Msg/RejectRedemption response type.
+type MsgRejectRedemptionResponse struct {
 }
 
-func (c *msgClient) Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error) {
-	out := new(MsgFinalizeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Finalize", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRejectRedemptionResponse) Reset()         { *m = MsgRejectRedemptionResponse{} }
+func (m *MsgRejectRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectRedemptionResponse) ProtoMessage()    {}
+func (*MsgRejectRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{75}
 }
-
-func (c *msgClient) Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error) {
-	out := new(MsgActivateResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Activate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRejectRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectRedemptionResponse.Merge(m, src)
+}
+func (m *MsgRejectRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectRedemptionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error) {
-	out := new(MsgCancelResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Cancel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRejectRedemptionResponse proto.InternalMessageInfo
+
+// MsgSetTransferLimitsRequest defines the Msg/SetTransferLimits request type.
+type MsgSetTransferLimitsRequest struct {
+	// denom is the denom of the restricted marker.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// administrator is the signer of the message. Must have admin access on the marker.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// holder_daily_limit is the most that a single holder can send in 24 hours. Zero means no limit.
+	HolderDailyLimit cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=holder_daily_limit,json=holderDailyLimit,proto3,customtype=cosmossdk.io/math.Int" json:"holder_daily_limit"`
+	// marker_daily_limit is the most that all holders combined can send in 24 hours. Zero means no limit.
+	MarkerDailyLimit cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=marker_daily_limit,json=markerDailyLimit,proto3,customtype=cosmossdk.io/math.Int" json:"marker_daily_limit"`
 }
 
-func (c *msgClient) Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error) {
-	out := new(MsgDeleteResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetTransferLimitsRequest) Reset()         { *m = MsgSetTransferLimitsRequest{} }
+func (m *MsgSetTransferLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferLimitsRequest) ProtoMessage()    {}
+func (*MsgSetTransferLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{76}
+}
+func (m *MsgSetTransferLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetTransferLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferLimitsRequest.Merge(m, src)
+}
+func (m *MsgSetTransferLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferLimitsRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error) {
-	out := new(MsgMintResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Mint", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetTransferLimitsRequest proto.InternalMessageInfo
+
+func (m *MsgSetTransferLimitsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
-	out := new(MsgBurnResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Burn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetTransferLimitsRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error) {
-	out := new(MsgAddAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgSetTransferLimitsResponse defines the Msg/SetTransferLimits response type.
+type MsgSetTransferLimitsResponse struct {
 }
 
-func (c *msgClient) DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error) {
-	out := new(MsgDeleteAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/DeleteAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetTransferLimitsResponse) Reset()         { *m = MsgSetTransferLimitsResponse{} }
+func (m *MsgSetTransferLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferLimitsResponse) ProtoMessage()    {}
+func (*MsgSetTransferLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{77}
+}
+func (m *MsgSetTransferLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetTransferLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferLimitsResponse.Merge(m, src)
+}
+func (m *MsgSetTransferLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferLimitsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
-	out := new(MsgWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Withdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetTransferLimitsResponse proto.InternalMessageInfo
+
+// MsgSetAccessGrantTermsRequest defines the Msg/SetAccessGrantTerms request type.
+type MsgSetAccessGrantTermsRequest struct {
+	// denom is the denom of the marker.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// administrator is the signer of the message. Must have admin access on the marker.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// address is the bech32 address of the account with the access grant. It must already have a grant on the marker.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// expires_at is the time at which the grant is removed from the marker. If not set, the grant does not expire.
+	ExpiresAt *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// mint_daily_limit is the most that the address can mint in 24 hours. Zero means no limit.
+	MintDailyLimit cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=mint_daily_limit,json=mintDailyLimit,proto3,customtype=cosmossdk.io/math.Int" json:"mint_daily_limit"`
+	// withdraw_addresses are the only accounts that the address can withdraw the marker's funds to.
+	// If empty, funds can be withdrawn to any account.
+	WithdrawAddresses []string `protobuf:"bytes,6,rep,name=withdraw_addresses,json=withdrawAddresses,proto3" json:"withdraw_addresses,omitempty"`
+}
+
+func (m *MsgSetAccessGrantTermsRequest) Reset()         { *m = MsgSetAccessGrantTermsRequest{} }
+func (m *MsgSetAccessGrantTermsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAccessGrantTermsRequest) ProtoMessage()    {}
+func (*MsgSetAccessGrantTermsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{78}
+}
+func (m *MsgSetAccessGrantTermsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAccessGrantTermsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAccessGrantTermsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetAccessGrantTermsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAccessGrantTermsRequest.Merge(m, src)
+}
+func (m *MsgSetAccessGrantTermsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAccessGrantTermsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAccessGrantTermsRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error) {
-	out := new(MsgAddMarkerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddMarker", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetAccessGrantTermsRequest proto.InternalMessageInfo
+
+func (m *MsgSetAccessGrantTermsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error) {
-	out := new(MsgTransferResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Transfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAccessGrantTermsRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) IbcTransfer(ctx context.Context, in *MsgIbcTransferRequest, opts ...grpc.CallOption) (*MsgIbcTransferResponse, error) {
-	out := new(MsgIbcTransferResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/IbcTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAccessGrantTermsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
-	out := new(MsgSetDenomMetadataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAccessGrantTermsRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) GrantAllowance(ctx context.Context, in *MsgGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error) {
-	out := new(MsgGrantAllowanceResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/GrantAllowance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAccessGrantTermsRequest) GetWithdrawAddresses() []string {
+	if m != nil {
+		return m.WithdrawAddresses
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) AddFinalizeActivateMarker(ctx context.Context, in *MsgAddFinalizeActivateMarkerRequest, opts ...grpc.CallOption) (*MsgAddFinalizeActivateMarkerResponse, error) {
-	out := new(MsgAddFinalizeActivateMarkerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddFinalizeActivateMarker", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgSetAccessGrantTermsResponse defines the Msg/SetAccessGrantTerms response type.
+type MsgSetAccessGrantTermsResponse struct {
 }
 
-func (c *msgClient) SupplyIncreaseProposal(ctx context.Context, in *MsgSupplyIncreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyIncreaseProposalResponse, error) {
-	out := new(MsgSupplyIncreaseProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SupplyIncreaseProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAccessGrantTermsResponse) Reset()         { *m = MsgSetAccessGrantTermsResponse{} }
+func (m *MsgSetAccessGrantTermsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAccessGrantTermsResponse) ProtoMessage()    {}
+func (*MsgSetAccessGrantTermsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{79}
+}
+func (m *MsgSetAccessGrantTermsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAccessGrantTermsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAccessGrantTermsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetAccessGrantTermsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAccessGrantTermsResponse.Merge(m, src)
+}
+func (m *MsgSetAccessGrantTermsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAccessGrantTermsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAccessGrantTermsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SupplyDecreaseProposal(ctx context.Context, in *MsgSupplyDecreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyDecreaseProposalResponse, error) {
-	out := new(MsgSupplyDecreaseProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SupplyDecreaseProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetAccessGrantTermsResponse proto.InternalMessageInfo
+
+// MsgSetApprovalThresholdRequest defines the Msg/SetApprovalThreshold request type.
+type MsgSetApprovalThresholdRequest struct {
+	// denom is the denom of the marker.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// administrator is the signer of the message. Must have admin access on the marker.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// permission is the access that the threshold applies to. One of ACCESS_MINT, ACCESS_WITHDRAW or
+	// ACCESS_FORCE_TRANSFER.
+	Permission Access `protobuf:"varint,3,opt,name=permission,proto3,enum=provenance.marker.v1.Access" json:"permission,omitempty"`
+	// threshold is the number of approvals needed before an operation is executed. Zero or one removes the threshold.
+	Threshold uint32 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func (c *msgClient) UpdateRequiredAttributes(ctx context.Context, in *MsgUpdateRequiredAttributesRequest, opts ...grpc.CallOption) (*MsgUpdateRequiredAttributesResponse, error) {
-	out := new(MsgUpdateRequiredAttributesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateRequiredAttributes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetApprovalThresholdRequest) Reset()         { *m = MsgSetApprovalThresholdRequest{} }
+func (m *MsgSetApprovalThresholdRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetApprovalThresholdRequest) ProtoMessage()    {}
+func (*MsgSetApprovalThresholdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{80}
+}
+func (m *MsgSetApprovalThresholdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetApprovalThresholdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetApprovalThresholdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetApprovalThresholdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetApprovalThresholdRequest.Merge(m, src)
+}
+func (m *MsgSetApprovalThresholdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetApprovalThresholdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetApprovalThresholdRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateForcedTransfer(ctx context.Context, in *MsgUpdateForcedTransferRequest, opts ...grpc.CallOption) (*MsgUpdateForcedTransferResponse, error) {
-	out := new(MsgUpdateForcedTransferResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateForcedTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetApprovalThresholdRequest proto.InternalMessageInfo
+
+func (m *MsgSetApprovalThresholdRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateRequireDepositAccess(ctx context.Context, in *MsgUpdateRequireDepositAccessRequest, opts ...grpc.CallOption) (*MsgUpdateRequireDepositAccessResponse, error) {
-	out := new(MsgUpdateRequireDepositAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateRequireDepositAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetApprovalThresholdRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error) {
-	out := new(MsgSetAccountDataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAccountData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetApprovalThresholdRequest) GetPermission() Access {
+	if m != nil {
+		return m.Permission
 	}
-	return out, nil
+	return Access_Unknown
 }
 
-func (c *msgClient) UpdateSendDenyList(ctx context.Context, in *MsgUpdateSendDenyListRequest, opts ...grpc.CallOption) (*MsgUpdateSendDenyListResponse, error) {
-	out := new(MsgUpdateSendDenyListResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateSendDenyList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetApprovalThresholdRequest) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error) {
-	out := new(MsgAddNetAssetValuesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddNetAssetValues", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgSetApprovalThresholdResponse defines the Msg/SetApprovalThreshold response type.
+type MsgSetApprovalThresholdResponse struct {
 }
 
-func (c *msgClient) SetAdministratorProposal(ctx context.Context, in *MsgSetAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgSetAdministratorProposalResponse, error) {
-	out := new(MsgSetAdministratorProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAdministratorProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetApprovalThresholdResponse) Reset()         { *m = MsgSetApprovalThresholdResponse{} }
+func (m *MsgSetApprovalThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetApprovalThresholdResponse) ProtoMessage()    {}
+func (*MsgSetApprovalThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{81}
+}
+func (m *MsgSetApprovalThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetApprovalThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetApprovalThresholdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetApprovalThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetApprovalThresholdResponse.Merge(m, src)
+}
+func (m *MsgSetApprovalThresholdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetApprovalThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetApprovalThresholdResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RemoveAdministratorProposal(ctx context.Context, in *MsgRemoveAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgRemoveAdministratorProposalResponse, error) {
-	out := new(MsgRemoveAdministratorProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RemoveAdministratorProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetApprovalThresholdResponse proto.InternalMessageInfo
+
+// MsgApproveOperationRequest defines the Msg/ApproveOperation request type.
+type MsgApproveOperationRequest struct {
+	// operation_id is the id of the pending operation to approve.
+	OperationId uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// approver is the signer of the message. Must have the operation's permission on the marker.
+	Approver string `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
 }
 
-func (c *msgClient) ChangeStatusProposal(ctx context.Context, in *MsgChangeStatusProposalRequest, opts ...grpc.CallOption) (*MsgChangeStatusProposalResponse, error) {
-	out := new(MsgChangeStatusProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ChangeStatusProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgApproveOperationRequest) Reset()         { *m = MsgApproveOperationRequest{} }
+func (m *MsgApproveOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveOperationRequest) ProtoMessage()    {}
+func (*MsgApproveOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{82}
+}
+func (m *MsgApproveOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgApproveOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveOperationRequest.Merge(m, src)
+}
+func (m *MsgApproveOperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveOperationRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) WithdrawEscrowProposal(ctx context.Context, in *MsgWithdrawEscrowProposalRequest, opts ...grpc.CallOption) (*MsgWithdrawEscrowProposalResponse, error) {
-	out := new(MsgWithdrawEscrowProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/WithdrawEscrowProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgApproveOperationRequest proto.InternalMessageInfo
+
+func (m *MsgApproveOperationRequest) GetOperationId() uint64 {
+	if m != nil {
+		return m.OperationId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SetDenomMetadataProposal(ctx context.Context, in *MsgSetDenomMetadataProposalRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataProposalResponse, error) {
-	out := new(MsgSetDenomMetadataProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomMetadataProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgApproveOperationRequest) GetApprover() string {
+	if m != nil {
+		return m.Approver
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgApproveOperationResponse defines the Msg/ApproveOperation response type.
+type MsgApproveOperationResponse struct {
+	// executed is true if this approval met the threshold and the operation was executed.
+	Executed bool `protobuf:"varint,1,opt,name=executed,proto3" json:"executed,omitempty"`
 }
 
-func (c *msgClient) RevokeGrantAllowance(ctx context.Context, in *MsgRevokeGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgRevokeGrantAllowanceResponse, error) {
-	out := new(MsgRevokeGrantAllowanceResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RevokeGrantAllowance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgApproveOperationResponse) Reset()         { *m = MsgApproveOperationResponse{} }
+func (m *MsgApproveOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveOperationResponse) ProtoMessage()    {}
+func (*MsgApproveOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{83}
+}
+func (m *MsgApproveOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgApproveOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveOperationResponse.Merge(m, src)
+}
+func (m *MsgApproveOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveOperationResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Finalize
-	Finalize(context.Context, *MsgFinalizeRequest) (*MsgFinalizeResponse, error)
-	// Activate
-	Activate(context.Context, *MsgActivateRequest) (*MsgActivateResponse, error)
-	// Cancel
-	Cancel(context.Context, *MsgCancelRequest) (*MsgCancelResponse, error)
-	// Delete
-	Delete(context.Context, *MsgDeleteRequest) (*MsgDeleteResponse, error)
-	// Mint
-	Mint(context.Context, *MsgMintRequest) (*MsgMintResponse, error)
-	// Burn
-	Burn(context.Context, *MsgBurnRequest) (*MsgBurnResponse, error)
-	// AddAccess
-	AddAccess(context.Context, *MsgAddAccessRequest) (*MsgAddAccessResponse, error)
-	// DeleteAccess
-	DeleteAccess(context.Context, *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error)
-	// Withdraw
-	Withdraw(context.Context, *MsgWithdrawRequest) (*MsgWithdrawResponse, error)
-	// AddMarker
-	AddMarker(context.Context, *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error)
-	// Transfer marker denominated coin between accounts
-	Transfer(context.Context, *MsgTransferRequest) (*MsgTransferResponse, error)
-	// Transfer over ibc any marker(including restricted markers) between ibc accounts.
-	// The relayer is still needed to accomplish ibc middleware relays.
-	IbcTransfer(context.Context, *MsgIbcTransferRequest) (*MsgIbcTransferResponse, error)
-	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
-	SetDenomMetadata(context.Context, *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error)
-	// GrantAllowance grants fee allowance to the grantee on the granter's
-	// account with the provided expiration time.
-	GrantAllowance(context.Context, *MsgGrantAllowanceRequest) (*MsgGrantAllowanceResponse, error)
-	// AddFinalizeActivateMarker
-	AddFinalizeActivateMarker(context.Context, *MsgAddFinalizeActivateMarkerRequest) (*MsgAddFinalizeActivateMarkerResponse, error)
-	// SupplyIncreaseProposal can only be called via gov proposal
-	SupplyIncreaseProposal(context.Context, *MsgSupplyIncreaseProposalRequest) (*MsgSupplyIncreaseProposalResponse, error)
-	// SupplyDecreaseProposal can only be called via gov proposal
-	SupplyDecreaseProposal(context.Context, *MsgSupplyDecreaseProposalRequest) (*MsgSupplyDecreaseProposalResponse, error)
-	// UpdateRequiredAttributes will only succeed if signer has transfer authority
-	UpdateRequiredAttributes(context.Context, *MsgUpdateRequiredAttributesRequest) (*MsgUpdateRequiredAttributesResponse, error)
-	// UpdateForcedTransfer updates the allow_forced_transfer field of a marker via governance proposal.
-	UpdateForcedTransfer(context.Context, *MsgUpdateForcedTransferRequest) (*MsgUpdateForcedTransferResponse, error)
-	// UpdateRequireDepositAccess updates the require_deposit_access field of a marker. Signer must have admin access or be
-	// the governance module account.
-	UpdateRequireDepositAccess(context.Context, *MsgUpdateRequireDepositAccessRequest) (*MsgUpdateRequireDepositAccessResponse, error)
-	// SetAccountData sets the accountdata for a denom. Signer must have deposit authority.
-	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
-	// UpdateSendDenyList will only succeed if signer has admin authority
-	UpdateSendDenyList(context.Context, *MsgUpdateSendDenyListRequest) (*MsgUpdateSendDenyListResponse, error)
-	// AddNetAssetValues sets the net asset value for a marker.
-	AddNetAssetValues(context.Context, *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error)
-	// SetAdministratorProposal sets administrators with specific access on the marker
-	SetAdministratorProposal(context.Context, *MsgSetAdministratorProposalRequest) (*MsgSetAdministratorProposalResponse, error)
-	// RemoveAdministratorProposal removes administrators with specific access on the marker
-	RemoveAdministratorProposal(context.Context, *MsgRemoveAdministratorProposalRequest) (*MsgRemoveAdministratorProposalResponse, error)
-	// ChangeStatusProposal is a governance proposal change marker status
-	ChangeStatusProposal(context.Context, *MsgChangeStatusProposalRequest) (*MsgChangeStatusProposalResponse, error)
-	// WithdrawEscrowProposal is a governance proposal to withdraw escrow coins from a marker
-	WithdrawEscrowProposal(context.Context, *MsgWithdrawEscrowProposalRequest) (*MsgWithdrawEscrowProposalResponse, error)
-	// SetDenomMetadataProposal is a governance proposal to set marker metadata
-	SetDenomMetadataProposal(context.Context, *MsgSetDenomMetadataProposalRequest) (*MsgSetDenomMetadataProposalResponse, error)
-	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
-	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
-	// RevokeGrantAllowance revokes a fee allowance granted by a admin to a grantee.
-	RevokeGrantAllowance(context.Context, *MsgRevokeGrantAllowanceRequest) (*MsgRevokeGrantAllowanceResponse, error)
+var xxx_messageInfo_MsgApproveOperationResponse proto.InternalMessageInfo
+
+func (m *MsgApproveOperationResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgCancelOperationRequest defines the Msg/CancelOperation request type.
+type MsgCancelOperationRequest struct {
+	// operation_id is the id of the pending operation to cancel.
+	OperationId uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// signer is the signer of the message. Must be the proposer of the operation or have admin access on the marker.
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (*UnimplementedMsgServer) Finalize(ctx context.Context, req *MsgFinalizeRequest) (*MsgFinalizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Finalize not implemented")
+func (m *MsgCancelOperationRequest) Reset()         { *m = MsgCancelOperationRequest{} }
+func (m *MsgCancelOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOperationRequest) ProtoMessage()    {}
+func (*MsgCancelOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{84}
 }
-func (*UnimplementedMsgServer) Activate(ctx context.Context, req *MsgActivateRequest) (*MsgActivateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
+func (m *MsgCancelOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Cancel(ctx context.Context, req *MsgCancelRequest) (*MsgCancelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+func (m *MsgCancelOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Delete(ctx context.Context, req *MsgDeleteRequest) (*MsgDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (m *MsgCancelOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOperationRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMintRequest) (*MsgMintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+func (m *MsgCancelOperationRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurnRequest) (*MsgBurnResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+func (m *MsgCancelOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOperationRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddAccess(ctx context.Context, req *MsgAddAccessRequest) (*MsgAddAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAccess not implemented")
+
+var xxx_messageInfo_MsgCancelOperationRequest proto.InternalMessageInfo
+
+func (m *MsgCancelOperationRequest) GetOperationId() uint64 {
+	if m != nil {
+		return m.OperationId
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) DeleteAccess(ctx context.Context, req *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccess not implemented")
+
+func (m *MsgCancelOperationRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdrawRequest) (*MsgWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+
+// MsgCancelOperationResponse defines the Msg/CancelOperation response type.
+type MsgCancelOperationResponse struct {
 }
-func (*UnimplementedMsgServer) AddMarker(ctx context.Context, req *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMarker not implemented")
+
+func (m *MsgCancelOperationResponse) Reset()         { *m = MsgCancelOperationResponse{} }
+func (m *MsgCancelOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOperationResponse) ProtoMessage()    {}
+func (*MsgCancelOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{85}
 }
-func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransferRequest) (*MsgTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+func (m *MsgCancelOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) IbcTransfer(ctx context.Context, req *MsgIbcTransferRequest) (*MsgIbcTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IbcTransfer not implemented")
+func (m *MsgCancelOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+func (m *MsgCancelOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOperationResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrantAllowanceRequest) (*MsgGrantAllowanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GrantAllowance not implemented")
+func (m *MsgCancelOperationResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) AddFinalizeActivateMarker(ctx context.Context, req *MsgAddFinalizeActivateMarkerRequest) (*MsgAddFinalizeActivateMarkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddFinalizeActivateMarker not implemented")
+func (m *MsgCancelOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOperationResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) SupplyIncreaseProposal(ctx context.Context, req *MsgSupplyIncreaseProposalRequest) (*MsgSupplyIncreaseProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SupplyIncreaseProposal not implemented")
+
+var xxx_messageInfo_MsgCancelOperationResponse proto.InternalMessageInfo
+
+// MsgSetAttributeRequirementsRequest defines the Msg/SetAttributeRequirements request type.
+type MsgSetAttributeRequirementsRequest struct {
+	// denom is the denom of the restricted marker.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// requirements are the conditions that replace the marker's current attribute requirements.
+	// An empty list removes them.
+	Requirements []AttributeRequirement `protobuf:"bytes,2,rep,name=requirements,proto3" json:"requirements"`
+	// transfer_authority is the signer of the message. Must have transfer access on the marker or be the
+	// governance module account address.
+	TransferAuthority string `protobuf:"bytes,3,opt,name=transfer_authority,json=transferAuthority,proto3" json:"transfer_authority,omitempty"`
 }
-func (*UnimplementedMsgServer) SupplyDecreaseProposal(ctx context.Context, req *MsgSupplyDecreaseProposalRequest) (*MsgSupplyDecreaseProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SupplyDecreaseProposal not implemented")
+
+func (m *MsgSetAttributeRequirementsRequest) Reset()         { *m = MsgSetAttributeRequirementsRequest{} }
+func (m *MsgSetAttributeRequirementsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttributeRequirementsRequest) ProtoMessage()    {}
+func (*MsgSetAttributeRequirementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{86}
 }
-func (*UnimplementedMsgServer) UpdateRequiredAttributes(ctx context.Context, req *MsgUpdateRequiredAttributesRequest) (*MsgUpdateRequiredAttributesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRequiredAttributes not implemented")
+func (m *MsgSetAttributeRequirementsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateForcedTransfer(ctx context.Context, req *MsgUpdateForcedTransferRequest) (*MsgUpdateForcedTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateForcedTransfer not implemented")
+func (m *MsgSetAttributeRequirementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttributeRequirementsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateRequireDepositAccess(ctx context.Context, req *MsgUpdateRequireDepositAccessRequest) (*MsgUpdateRequireDepositAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRequireDepositAccess not implemented")
+func (m *MsgSetAttributeRequirementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttributeRequirementsRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAccountData not implemented")
+func (m *MsgSetAttributeRequirementsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateSendDenyList(ctx context.Context, req *MsgUpdateSendDenyListRequest) (*MsgUpdateSendDenyListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSendDenyList not implemented")
+func (m *MsgSetAttributeRequirementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttributeRequirementsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddNetAssetValues(ctx context.Context, req *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddNetAssetValues not implemented")
+
+var xxx_messageInfo_MsgSetAttributeRequirementsRequest proto.InternalMessageInfo
+
+func (m *MsgSetAttributeRequirementsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) SetAdministratorProposal(ctx context.Context, req *MsgSetAdministratorProposalRequest) (*MsgSetAdministratorProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAdministratorProposal not implemented")
+
+func (m *MsgSetAttributeRequirementsRequest) GetRequirements() []AttributeRequirement {
+	if m != nil {
+		return m.Requirements
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) RemoveAdministratorProposal(ctx context.Context, req *MsgRemoveAdministratorProposalRequest) (*MsgRemoveAdministratorProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveAdministratorProposal not implemented")
+
+func (m *MsgSetAttributeRequirementsRequest) GetTransferAuthority() string {
+	if m != nil {
+		return m.TransferAuthority
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) ChangeStatusProposal(ctx context.Context, req *MsgChangeStatusProposalRequest) (*MsgChangeStatusProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeStatusProposal not implemented")
+
+// MsgSetAttributeRequirementsResponse defines the Msg/SetAttributeRequirements response type.
+type MsgSetAttributeRequirementsResponse struct {
 }
-func (*UnimplementedMsgServer) WithdrawEscrowProposal(ctx context.Context, req *MsgWithdrawEscrowProposalRequest) (*MsgWithdrawEscrowProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawEscrowProposal not implemented")
+
+func (m *MsgSetAttributeRequirementsResponse) Reset()         { *m = MsgSetAttributeRequirementsResponse{} }
+func (m *MsgSetAttributeRequirementsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttributeRequirementsResponse) ProtoMessage()    {}
+func (*MsgSetAttributeRequirementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{87}
 }
-func (*UnimplementedMsgServer) SetDenomMetadataProposal(ctx context.Context, req *MsgSetDenomMetadataProposalRequest) (*MsgSetDenomMetadataProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadataProposal not implemented")
+func (m *MsgSetAttributeRequirementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgSetAttributeRequirementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttributeRequirementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RevokeGrantAllowance(ctx context.Context, req *MsgRevokeGrantAllowanceRequest) (*MsgRevokeGrantAllowanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeGrantAllowance not implemented")
+func (m *MsgSetAttributeRequirementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttributeRequirementsResponse.Merge(m, src)
+}
+func (m *MsgSetAttributeRequirementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttributeRequirementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttributeRequirementsResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetAttributeRequirementsResponse proto.InternalMessageInfo
+
+// MsgTakeHolderSnapshotRequest defines the Msg/TakeHolderSnapshot request type.
+type MsgTakeHolderSnapshotRequest struct {
+	// denom is the denom of the marker to take a snapshot of.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// administrator is the signer of the message. Must have admin or transfer access on the marker.
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func _Msg_Finalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFinalizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Finalize(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Finalize",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Finalize(ctx, req.(*MsgFinalizeRequest))
+func (m *MsgTakeHolderSnapshotRequest) Reset()         { *m = MsgTakeHolderSnapshotRequest{} }
+func (m *MsgTakeHolderSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgTakeHolderSnapshotRequest) ProtoMessage()    {}
+func (*MsgTakeHolderSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{88}
+}
+func (m *MsgTakeHolderSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTakeHolderSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTakeHolderSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgTakeHolderSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTakeHolderSnapshotRequest.Merge(m, src)
+}
+func (m *MsgTakeHolderSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTakeHolderSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTakeHolderSnapshotRequest.DiscardUnknown(m)
 }
 
-func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgActivateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Activate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Activate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Activate(ctx, req.(*MsgActivateRequest))
+var xxx_messageInfo_MsgTakeHolderSnapshotRequest proto.InternalMessageInfo
+
+func (m *MsgTakeHolderSnapshotRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Cancel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Cancel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Cancel(ctx, req.(*MsgCancelRequest))
+func (m *MsgTakeHolderSnapshotRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Delete(ctx, req.(*MsgDeleteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgTakeHolderSnapshotResponse defines the Msg/TakeHolderSnapshot response type.
+type MsgTakeHolderSnapshotResponse struct {
+	// snapshot_id is the id of the new snapshot.
+	SnapshotId uint64 `protobuf:"varint,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
 }
 
-func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMintRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Mint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Mint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Mint(ctx, req.(*MsgMintRequest))
+func (m *MsgTakeHolderSnapshotResponse) Reset()         { *m = MsgTakeHolderSnapshotResponse{} }
+func (m *MsgTakeHolderSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTakeHolderSnapshotResponse) ProtoMessage()    {}
+func (*MsgTakeHolderSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{89}
+}
+func (m *MsgTakeHolderSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTakeHolderSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTakeHolderSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgTakeHolderSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTakeHolderSnapshotResponse.Merge(m, src)
+}
+func (m *MsgTakeHolderSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTakeHolderSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTakeHolderSnapshotResponse.DiscardUnknown(m)
 }
 
-func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurnRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Burn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Burn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Burn(ctx, req.(*MsgBurnRequest))
+var xxx_messageInfo_MsgTakeHolderSnapshotResponse proto.InternalMessageInfo
+
+func (m *MsgTakeHolderSnapshotResponse) GetSnapshotId() uint64 {
+	if m != nil {
+		return m.SnapshotId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_AddAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/AddAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddAccess(ctx, req.(*MsgAddAccessRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSetIssuanceScheduleRequest defines the Msg/SetIssuanceSchedule request type.
+type MsgSetIssuanceScheduleRequest struct {
+	// denom is the denom of the marker.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// hard_cap is the most that the supply of the marker's coin can ever be. Zero means there is no cap.
+	HardCap cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=hard_cap,json=hardCap,proto3,customtype=cosmossdk.io/math.Int" json:"hard_cap"`
+	// tranches are the amounts that become mintable over time. If empty, minting is only limited by the hard cap.
+	// An empty list with a zero hard_cap removes the marker's issuance schedule.
+	Tranches []IssuanceTranche `protobuf:"bytes,3,rep,name=tranches,proto3" json:"tranches"`
+	// authority is the signer of the message. Must have admin access on the marker or be the governance module
+	// account address. Once an active marker has an issuance schedule, only governance can change it.
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func _Msg_DeleteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/DeleteAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteAccess(ctx, req.(*MsgDeleteAccessRequest))
+func (m *MsgSetIssuanceScheduleRequest) Reset()         { *m = MsgSetIssuanceScheduleRequest{} }
+func (m *MsgSetIssuanceScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIssuanceScheduleRequest) ProtoMessage()    {}
+func (*MsgSetIssuanceScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{90}
+}
+func (m *MsgSetIssuanceScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIssuanceScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIssuanceScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetIssuanceScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIssuanceScheduleRequest.Merge(m, src)
+}
+func (m *MsgSetIssuanceScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIssuanceScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIssuanceScheduleRequest.DiscardUnknown(m)
 }
 
-func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Withdraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdrawRequest))
+var xxx_messageInfo_MsgSetIssuanceScheduleRequest proto.InternalMessageInfo
+
+func (m *MsgSetIssuanceScheduleRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AddMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddMarkerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddMarker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/AddMarker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddMarker(ctx, req.(*MsgAddMarkerRequest))
+func (m *MsgSetIssuanceScheduleRequest) GetTranches() []IssuanceTranche {
+	if m != nil {
+		return m.Tranches
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Transfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Transfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Transfer(ctx, req.(*MsgTransferRequest))
+func (m *MsgSetIssuanceScheduleRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_IbcTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgIbcTransferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).IbcTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/IbcTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).IbcTransfer(ctx, req.(*MsgIbcTransferRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSetIssuanceScheduleResponse defines the Msg/SetIssuanceSchedule response type.
+type MsgSetIssuanceScheduleResponse struct {
 }
 
-func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetDenomMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetDenomMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SetDenomMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadataRequest))
+func (m *MsgSetIssuanceScheduleResponse) Reset()         { *m = MsgSetIssuanceScheduleResponse{} }
+func (m *MsgSetIssuanceScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIssuanceScheduleResponse) ProtoMessage()    {}
+func (*MsgSetIssuanceScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{91}
+}
+func (m *MsgSetIssuanceScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIssuanceScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIssuanceScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetIssuanceScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIssuanceScheduleResponse.Merge(m, src)
+}
+func (m *MsgSetIssuanceScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIssuanceScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIssuanceScheduleResponse.DiscardUnknown(m)
 }
 
-func _Msg_GrantAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGrantAllowanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GrantAllowance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/GrantAllowance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GrantAllowance(ctx, req.(*MsgGrantAllowanceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSetIssuanceScheduleResponse proto.InternalMessageInfo
+
+// MsgSetNetAssetValueMaxAgeRequest defines the Msg/SetNetAssetValueMaxAge request type.
+type MsgSetNetAssetValueMaxAgeRequest struct {
+	// denom is the denom of the marker.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// max_age is the number of blocks after which the marker's net asset values are stale. Zero means they never are.
+	MaxAge uint64 `protobuf:"varint,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// authority is the signer of the message. Must have admin access on the marker or be the governance module
+	// account address.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func _Msg_AddFinalizeActivateMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddFinalizeActivateMarkerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddFinalizeActivateMarker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/AddFinalizeActivateMarker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddFinalizeActivateMarker(ctx, req.(*MsgAddFinalizeActivateMarkerRequest))
+func (m *MsgSetNetAssetValueMaxAgeRequest) Reset()         { *m = MsgSetNetAssetValueMaxAgeRequest{} }
+func (m *MsgSetNetAssetValueMaxAgeRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNetAssetValueMaxAgeRequest) ProtoMessage()    {}
+func (*MsgSetNetAssetValueMaxAgeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{92}
+}
+func (m *MsgSetNetAssetValueMaxAgeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNetAssetValueMaxAgeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNetAssetValueMaxAgeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetNetAssetValueMaxAgeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNetAssetValueMaxAgeRequest.Merge(m, src)
+}
+func (m *MsgSetNetAssetValueMaxAgeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNetAssetValueMaxAgeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNetAssetValueMaxAgeRequest.DiscardUnknown(m)
 }
 
-func _Msg_SupplyIncreaseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSupplyIncreaseProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SupplyIncreaseProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SupplyIncreaseProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SupplyIncreaseProposal(ctx, req.(*MsgSupplyIncreaseProposalRequest))
+var xxx_messageInfo_MsgSetNetAssetValueMaxAgeRequest proto.InternalMessageInfo
+
+func (m *MsgSetNetAssetValueMaxAgeRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SupplyDecreaseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSupplyDecreaseProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SupplyDecreaseProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SupplyDecreaseProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SupplyDecreaseProposal(ctx, req.(*MsgSupplyDecreaseProposalRequest))
+func (m *MsgSetNetAssetValueMaxAgeRequest) GetMaxAge() uint64 {
+	if m != nil {
+		return m.MaxAge
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateRequiredAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRequiredAttributesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSetNetAssetValueMaxAgeRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRequiredAttributes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/UpdateRequiredAttributes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRequiredAttributes(ctx, req.(*MsgUpdateRequiredAttributesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateForcedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateForcedTransferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateForcedTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/UpdateForcedTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateForcedTransfer(ctx, req.(*MsgUpdateForcedTransferRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSetNetAssetValueMaxAgeResponse defines the Msg/SetNetAssetValueMaxAge response type.
+type MsgSetNetAssetValueMaxAgeResponse struct {
 }
 
-func _Msg_UpdateRequireDepositAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRequireDepositAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRequireDepositAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/UpdateRequireDepositAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRequireDepositAccess(ctx, req.(*MsgUpdateRequireDepositAccessRequest))
+func (m *MsgSetNetAssetValueMaxAgeResponse) Reset()         { *m = MsgSetNetAssetValueMaxAgeResponse{} }
+func (m *MsgSetNetAssetValueMaxAgeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNetAssetValueMaxAgeResponse) ProtoMessage()    {}
+func (*MsgSetNetAssetValueMaxAgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{93}
+}
+func (m *MsgSetNetAssetValueMaxAgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNetAssetValueMaxAgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNetAssetValueMaxAgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetNetAssetValueMaxAgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNetAssetValueMaxAgeResponse.Merge(m, src)
+}
+func (m *MsgSetNetAssetValueMaxAgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNetAssetValueMaxAgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNetAssetValueMaxAgeResponse.DiscardUnknown(m)
 }
 
-func _Msg_SetAccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetAccountDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetNetAssetValueMaxAgeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgGrantAllowanceRequest)(nil), "provenance.marker.v1.MsgGrantAllowanceRequest")
+	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "provenance.marker.v1.MsgGrantAllowanceResponse")
+	proto.RegisterType((*MsgAddMarkerRequest)(nil), "provenance.marker.v1.MsgAddMarkerRequest")
+	proto.RegisterType((*MsgAddMarkerResponse)(nil), "provenance.marker.v1.MsgAddMarkerResponse")
+	proto.RegisterType((*MsgAddAccessRequest)(nil), "provenance.marker.v1.MsgAddAccessRequest")
+	proto.RegisterType((*MsgAddAccessResponse)(nil), "provenance.marker.v1.MsgAddAccessResponse")
+	proto.RegisterType((*MsgDeleteAccessRequest)(nil), "provenance.marker.v1.MsgDeleteAccessRequest")
+	proto.RegisterType((*MsgDeleteAccessResponse)(nil), "provenance.marker.v1.MsgDeleteAccessResponse")
+	proto.RegisterType((*MsgFinalizeRequest)(nil), "provenance.marker.v1.MsgFinalizeRequest")
+	proto.RegisterType((*MsgFinalizeResponse)(nil), "provenance.marker.v1.MsgFinalizeResponse")
+	proto.RegisterType((*MsgActivateRequest)(nil), "provenance.marker.v1.MsgActivateRequest")
+	proto.RegisterType((*MsgActivateResponse)(nil), "provenance.marker.v1.MsgActivateResponse")
+	proto.RegisterType((*MsgCancelRequest)(nil), "provenance.marker.v1.MsgCancelRequest")
+	proto.RegisterType((*MsgCancelResponse)(nil), "provenance.marker.v1.MsgCancelResponse")
+	proto.RegisterType((*MsgDeleteRequest)(nil), "provenance.marker.v1.MsgDeleteRequest")
+	proto.RegisterType((*MsgDeleteResponse)(nil), "provenance.marker.v1.MsgDeleteResponse")
+	proto.RegisterType((*MsgMintRequest)(nil), "provenance.marker.v1.MsgMintRequest")
+	proto.RegisterType((*MsgMintResponse)(nil), "provenance.marker.v1.MsgMintResponse")
+	proto.RegisterType((*MsgBurnRequest)(nil), "provenance.marker.v1.MsgBurnRequest")
+	proto.RegisterType((*MsgBurnResponse)(nil), "provenance.marker.v1.MsgBurnResponse")
+	proto.RegisterType((*MsgWithdrawRequest)(nil), "provenance.marker.v1.MsgWithdrawRequest")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "provenance.marker.v1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgTransferRequest)(nil), "provenance.marker.v1.MsgTransferRequest")
+	proto.RegisterType((*MsgTransferResponse)(nil), "provenance.marker.v1.MsgTransferResponse")
+	proto.RegisterType((*MsgIbcTransferRequest)(nil), "provenance.marker.v1.MsgIbcTransferRequest")
+	proto.RegisterType((*MsgIbcTransferResponse)(nil), "provenance.marker.v1.MsgIbcTransferResponse")
+	proto.RegisterType((*MsgSetDenomMetadataRequest)(nil), "provenance.marker.v1.MsgSetDenomMetadataRequest")
+	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataResponse")
+	proto.RegisterType((*MsgAddFinalizeActivateMarkerRequest)(nil), "provenance.marker.v1.MsgAddFinalizeActivateMarkerRequest")
+	proto.RegisterType((*MsgAddFinalizeActivateMarkerResponse)(nil), "provenance.marker.v1.MsgAddFinalizeActivateMarkerResponse")
+	proto.RegisterType((*MsgSupplyIncreaseProposalRequest)(nil), "provenance.marker.v1.MsgSupplyIncreaseProposalRequest")
+	proto.RegisterType((*MsgSupplyIncreaseProposalResponse)(nil), "provenance.marker.v1.MsgSupplyIncreaseProposalResponse")
+	proto.RegisterType((*MsgSupplyDecreaseProposalRequest)(nil), "provenance.marker.v1.MsgSupplyDecreaseProposalRequest")
+	proto.RegisterType((*MsgSupplyDecreaseProposalResponse)(nil), "provenance.marker.v1.MsgSupplyDecreaseProposalResponse")
+	proto.RegisterType((*MsgUpdateRequiredAttributesRequest)(nil), "provenance.marker.v1.MsgUpdateRequiredAttributesRequest")
+	proto.RegisterType((*MsgUpdateRequiredAttributesResponse)(nil), "provenance.marker.v1.MsgUpdateRequiredAttributesResponse")
+	proto.RegisterType((*MsgUpdateForcedTransferRequest)(nil), "provenance.marker.v1.MsgUpdateForcedTransferRequest")
+	proto.RegisterType((*MsgUpdateForcedTransferResponse)(nil), "provenance.marker.v1.MsgUpdateForcedTransferResponse")
+	proto.RegisterType((*MsgUpdateRequireDepositAccessRequest)(nil), "provenance.marker.v1.MsgUpdateRequireDepositAccessRequest")
+	proto.RegisterType((*MsgUpdateRequireDepositAccessResponse)(nil), "provenance.marker.v1.MsgUpdateRequireDepositAccessResponse")
+	proto.RegisterType((*MsgSetAccountDataRequest)(nil), "provenance.marker.v1.MsgSetAccountDataRequest")
+	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.marker.v1.MsgSetAccountDataResponse")
+	proto.RegisterType((*MsgUpdateSendDenyListRequest)(nil), "provenance.marker.v1.MsgUpdateSendDenyListRequest")
+	proto.RegisterType((*MsgUpdateSendDenyListResponse)(nil), "provenance.marker.v1.MsgUpdateSendDenyListResponse")
+	proto.RegisterType((*MsgAddNetAssetValuesRequest)(nil), "provenance.marker.v1.MsgAddNetAssetValuesRequest")
+	proto.RegisterType((*MsgAddNetAssetValuesResponse)(nil), "provenance.marker.v1.MsgAddNetAssetValuesResponse")
+	proto.RegisterType((*MsgSetAdministratorProposalRequest)(nil), "provenance.marker.v1.MsgSetAdministratorProposalRequest")
+	proto.RegisterType((*MsgSetAdministratorProposalResponse)(nil), "provenance.marker.v1.MsgSetAdministratorProposalResponse")
+	proto.RegisterType((*MsgRemoveAdministratorProposalRequest)(nil), "provenance.marker.v1.MsgRemoveAdministratorProposalRequest")
+	proto.RegisterType((*MsgRemoveAdministratorProposalResponse)(nil), "provenance.marker.v1.MsgRemoveAdministratorProposalResponse")
+	proto.RegisterType((*MsgChangeStatusProposalRequest)(nil), "provenance.marker.v1.MsgChangeStatusProposalRequest")
+	proto.RegisterType((*MsgChangeStatusProposalResponse)(nil), "provenance.marker.v1.MsgChangeStatusProposalResponse")
+	proto.RegisterType((*MsgWithdrawEscrowProposalRequest)(nil), "provenance.marker.v1.MsgWithdrawEscrowProposalRequest")
+	proto.RegisterType((*MsgWithdrawEscrowProposalResponse)(nil), "provenance.marker.v1.MsgWithdrawEscrowProposalResponse")
+	proto.RegisterType((*MsgSetDenomMetadataProposalRequest)(nil), "provenance.marker.v1.MsgSetDenomMetadataProposalRequest")
+	proto.RegisterType((*MsgSetDenomMetadataProposalResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataProposalResponse")
+	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.marker.v1.MsgUpdateParamsRequest")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.marker.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRevokeGrantAllowanceRequest)(nil), "provenance.marker.v1.MsgRevokeGrantAllowanceRequest")
+	proto.RegisterType((*MsgRevokeGrantAllowanceResponse)(nil), "provenance.marker.v1.MsgRevokeGrantAllowanceResponse")
+	proto.RegisterType((*MsgFreezeHolderRequest)(nil), "provenance.marker.v1.MsgFreezeHolderRequest")
+	proto.RegisterType((*MsgFreezeHolderResponse)(nil), "provenance.marker.v1.MsgFreezeHolderResponse")
+	proto.RegisterType((*MsgUnfreezeHolderRequest)(nil), "provenance.marker.v1.MsgUnfreezeHolderRequest")
+	proto.RegisterType((*MsgUnfreezeHolderResponse)(nil), "provenance.marker.v1.MsgUnfreezeHolderResponse")
+	proto.RegisterType((*MsgDistributeToHoldersRequest)(nil), "provenance.marker.v1.MsgDistributeToHoldersRequest")
+	proto.RegisterType((*MsgDistributeToHoldersResponse)(nil), "provenance.marker.v1.MsgDistributeToHoldersResponse")
+	proto.RegisterType((*MsgClaimDistributionRequest)(nil), "provenance.marker.v1.MsgClaimDistributionRequest")
+	proto.RegisterType((*MsgClaimDistributionResponse)(nil), "provenance.marker.v1.MsgClaimDistributionResponse")
+	proto.RegisterType((*MsgSetRedemptionPayoutDenomRequest)(nil), "provenance.marker.v1.MsgSetRedemptionPayoutDenomRequest")
+	proto.RegisterType((*MsgSetRedemptionPayoutDenomResponse)(nil), "provenance.marker.v1.MsgSetRedemptionPayoutDenomResponse")
+	proto.RegisterType((*MsgRequestRedemptionRequest)(nil), "provenance.marker.v1.MsgRequestRedemptionRequest")
+	proto.RegisterType((*MsgRequestRedemptionResponse)(nil), "provenance.marker.v1.MsgRequestRedemptionResponse")
+	proto.RegisterType((*MsgFulfillRedemptionRequest)(nil), "provenance.marker.v1.MsgFulfillRedemptionRequest")
+	proto.RegisterType((*MsgFulfillRedemptionResponse)(nil), "provenance.marker.v1.MsgFulfillRedemptionResponse")
+	proto.RegisterType((*MsgRejectRedemptionRequest)(nil), "provenance.marker.v1.MsgRejectRedemptionRequest")
+	proto.RegisterType((*MsgRejectRedemptionResponse)(nil), "provenance.marker.v1.MsgRejectRedemptionResponse")
+	proto.RegisterType((*MsgSetTransferLimitsRequest)(nil), "provenance.marker.v1.MsgSetTransferLimitsRequest")
+	proto.RegisterType((*MsgSetTransferLimitsResponse)(nil), "provenance.marker.v1.MsgSetTransferLimitsResponse")
+	proto.RegisterType((*MsgSetAccessGrantTermsRequest)(nil), "provenance.marker.v1.MsgSetAccessGrantTermsRequest")
+	proto.RegisterType((*MsgSetAccessGrantTermsResponse)(nil), "provenance.marker.v1.MsgSetAccessGrantTermsResponse")
+	proto.RegisterType((*MsgSetApprovalThresholdRequest)(nil), "provenance.marker.v1.MsgSetApprovalThresholdRequest")
+	proto.RegisterType((*MsgSetApprovalThresholdResponse)(nil), "provenance.marker.v1.MsgSetApprovalThresholdResponse")
+	proto.RegisterType((*MsgApproveOperationRequest)(nil), "provenance.marker.v1.MsgApproveOperationRequest")
+	proto.RegisterType((*MsgApproveOperationResponse)(nil), "provenance.marker.v1.MsgApproveOperationResponse")
+	proto.RegisterType((*MsgCancelOperationRequest)(nil), "provenance.marker.v1.MsgCancelOperationRequest")
+	proto.RegisterType((*MsgCancelOperationResponse)(nil), "provenance.marker.v1.MsgCancelOperationResponse")
+	proto.RegisterType((*MsgSetAttributeRequirementsRequest)(nil), "provenance.marker.v1.MsgSetAttributeRequirementsRequest")
+	proto.RegisterType((*MsgSetAttributeRequirementsResponse)(nil), "provenance.marker.v1.MsgSetAttributeRequirementsResponse")
+	proto.RegisterType((*MsgTakeHolderSnapshotRequest)(nil), "provenance.marker.v1.MsgTakeHolderSnapshotRequest")
+	proto.RegisterType((*MsgTakeHolderSnapshotResponse)(nil), "provenance.marker.v1.MsgTakeHolderSnapshotResponse")
+	proto.RegisterType((*MsgSetIssuanceScheduleRequest)(nil), "provenance.marker.v1.MsgSetIssuanceScheduleRequest")
+	proto.RegisterType((*MsgSetIssuanceScheduleResponse)(nil), "provenance.marker.v1.MsgSetIssuanceScheduleResponse")
+	proto.RegisterType((*MsgSetNetAssetValueMaxAgeRequest)(nil), "provenance.marker.v1.MsgSetNetAssetValueMaxAgeRequest")
+	proto.RegisterType((*MsgSetNetAssetValueMaxAgeResponse)(nil), "provenance.marker.v1.MsgSetNetAssetValueMaxAgeResponse")
+}
+
+func init() { proto.RegisterFile("provenance/marker/v1/tx.proto", fileDescriptor_bcb203fb73175ed3) }
+
+var fileDescriptor_bcb203fb73175ed3 = []byte{
+	// 3699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x1c, 0x5d, 0x6f, 0x1c, 0x57,
+	0x35, 0xb3, 0xfe, 0x88, 0xf7, 0xac, 0xed, 0xc4, 0x13, 0x27, 0xd9, 0x4c, 0xe2, 0x8f, 0x6c, 0xbe,
+	0x9c, 0x50, 0xef, 0xc6, 0x6e, 0x3e, 0x1a, 0x53, 0x51, 0xd6, 0x76, 0x93, 0x5a, 0x74, 0x21, 0x5a,
+	0xbb, 0x54, 0xf0, 0xb2, 0x1a, 0xef, 0x5c, 0x8f, 0x07, 0xef, 0xce, 0x6c, 0xe7, 0xce, 0x3a, 0x76,
+	0xa4, 0x4a, 0x11, 0x05, 0x89, 0xf2, 0x42, 0x01, 0x15, 0x01, 0xe2, 0x01, 0x5e, 0x10, 0x02, 0x21,
+	0x15, 0x54, 0x10, 0xcf, 0x48, 0x88, 0x02, 0x02, 0x55, 0x7d, 0x42, 0x3c, 0xb4, 0xa8, 0x91, 0x28,
+	0xe2, 0x91, 0x5f, 0x80, 0xee, 0xdc, 0x3b, 0x5f, 0xbb, 0x77, 0xee, 0xce, 0x6c, 0xec, 0x96, 0x97,
+	0x64, 0xe7, 0xde, 0x73, 0xee, 0xf9, 0xb8, 0xe7, 0xdc, 0x7b, 0xee, 0x39, 0x27, 0x81, 0xa9, 0x96,
+	0x6d, 0xed, 0x22, 0x53, 0x35, 0xeb, 0xa8, 0xd4, 0x54, 0xed, 0x1d, 0x64, 0x97, 0x76, 0x17, 0x4a,
+	0xce, 0x5e, 0xb1, 0x65, 0x5b, 0x8e, 0x25, 0x4f, 0x06, 0xd3, 0x45, 0x3a, 0x5d, 0xdc, 0x5d, 0x50,
+	0x26, 0xd4, 0xa6, 0x61, 0x5a, 0x25, 0xf7, 0x4f, 0x0a, 0xa8, 0x9c, 0xd1, 0x2d, 0x4b, 0x6f, 0xa0,
+	0x92, 0xfb, 0xb5, 0xd9, 0xde, 0x2a, 0xa9, 0xe6, 0xbe, 0x37, 0x55, 0xb7, 0x70, 0xd3, 0xc2, 0x35,
+	0xf7, 0xab, 0x44, 0x3f, 0xd8, 0xd4, 0xa4, 0x6e, 0xe9, 0x16, 0x1d, 0x27, 0xbf, 0xd8, 0xe8, 0x34,
+	0x85, 0x29, 0x6d, 0xaa, 0x18, 0x95, 0x76, 0x17, 0x36, 0x91, 0xa3, 0x2e, 0x94, 0xea, 0x96, 0x61,
+	0x76, 0xcd, 0x9b, 0x3b, 0xfe, 0x3c, 0xf9, 0x60, 0xf3, 0xa7, 0xd9, 0x7c, 0x13, 0xeb, 0x44, 0x98,
+	0x26, 0xd6, 0xd9, 0xc4, 0x4c, 0x27, 0x93, 0x8e, 0xd1, 0x44, 0xd8, 0x51, 0x9b, 0x2d, 0x06, 0x70,
+	0xc9, 0xd8, 0xac, 0x97, 0xd4, 0x56, 0xab, 0x61, 0xd4, 0x55, 0xc7, 0xb0, 0x4c, 0x5c, 0x72, 0x6c,
+	0xd5, 0xc4, 0x5b, 0x51, 0xad, 0x28, 0xe7, 0xb9, 0x4a, 0xa3, 0xbf, 0x18, 0xc8, 0x65, 0x2e, 0x88,
+	0x5a, 0xaf, 0x23, 0x8c, 0x75, 0x5b, 0x35, 0x1d, 0x0a, 0x57, 0xf8, 0x8b, 0x04, 0xf9, 0x0a, 0xd6,
+	0xef, 0x91, 0xa1, 0x72, 0xa3, 0x61, 0x3d, 0x20, 0x18, 0x55, 0xf4, 0x4a, 0x1b, 0x61, 0x47, 0x9e,
+	0x84, 0x21, 0x0d, 0x99, 0x56, 0x33, 0x2f, 0xcd, 0x4a, 0x73, 0xd9, 0x2a, 0xfd, 0x90, 0x2f, 0xc2,
+	0x98, 0xaa, 0x35, 0x0d, 0xd3, 0xc0, 0x8e, 0xad, 0x3a, 0x96, 0x9d, 0xcf, 0xb8, 0xb3, 0xd1, 0x41,
+	0x39, 0x0f, 0x47, 0x5d, 0x3a, 0x08, 0xe5, 0x07, 0xdc, 0x79, 0xef, 0x53, 0x7e, 0x1e, 0xb2, 0xaa,
+	0x47, 0x29, 0x3f, 0x38, 0x2b, 0xcd, 0xe5, 0x16, 0x27, 0x8b, 0x54, 0x33, 0x45, 0x4f, 0x33, 0xc5,
+	0xb2, 0xb9, 0xbf, 0x3c, 0xf1, 0xe7, 0xb7, 0xe7, 0xc7, 0xee, 0x22, 0xe4, 0xf3, 0xb5, 0x56, 0x0d,
+	0x30, 0x97, 0xe4, 0xaf, 0x7e, 0xf4, 0xd6, 0xb5, 0x28, 0xd1, 0xc2, 0x59, 0x38, 0xc3, 0x11, 0x06,
+	0xb7, 0x2c, 0x13, 0xa3, 0xc2, 0x6f, 0x86, 0xe0, 0x44, 0x05, 0xeb, 0x65, 0x4d, 0xab, 0xb8, 0x0a,
+	0xf1, 0xa4, 0xbc, 0x0d, 0xc3, 0x6a, 0xd3, 0x6a, 0x9b, 0x8e, 0x2b, 0x66, 0x6e, 0xf1, 0x4c, 0x91,
+	0xd9, 0x08, 0xd9, 0xff, 0x22, 0xdb, 0xdf, 0xe2, 0x8a, 0x65, 0x98, 0xcb, 0x83, 0xef, 0xbc, 0x3f,
+	0x73, 0xa4, 0xca, 0xc0, 0x89, 0x88, 0x4d, 0xd5, 0x54, 0x75, 0x64, 0x7b, 0x22, 0xb2, 0x4f, 0xf9,
+	0x3c, 0x8c, 0x6e, 0xd9, 0x56, 0xb3, 0xa6, 0x6a, 0x9a, 0x8d, 0x30, 0x76, 0xa5, 0xcc, 0x56, 0x73,
+	0x64, 0xac, 0x4c, 0x87, 0xe4, 0x25, 0x18, 0xc6, 0x8e, 0xea, 0xb4, 0x71, 0x7e, 0x68, 0x56, 0x9a,
+	0x1b, 0x5f, 0x2c, 0x14, 0x79, 0xa6, 0x5e, 0xa4, 0xac, 0xae, 0xbb, 0x90, 0x55, 0x86, 0x21, 0x97,
+	0x21, 0x47, 0x21, 0x6a, 0xce, 0x7e, 0x0b, 0xe5, 0x87, 0xdd, 0x05, 0x66, 0x45, 0x0b, 0x6c, 0xec,
+	0xb7, 0x50, 0x15, 0x9a, 0xfe, 0x6f, 0xf9, 0x05, 0xc8, 0x51, 0x63, 0xa8, 0x35, 0x0c, 0xec, 0xe4,
+	0x8f, 0xce, 0x0e, 0xcc, 0xe5, 0x16, 0xcf, 0xf3, 0x97, 0x28, 0xbb, 0x80, 0xae, 0x56, 0x99, 0x06,
+	0x80, 0xe2, 0xbe, 0x68, 0x60, 0x87, 0xc8, 0x8a, 0xdb, 0xad, 0x56, 0x63, 0xbf, 0xb6, 0x65, 0xec,
+	0x21, 0x2d, 0x3f, 0x32, 0x2b, 0xcd, 0x8d, 0x54, 0x73, 0x74, 0xec, 0x2e, 0x19, 0x92, 0x9f, 0x81,
+	0xbc, 0xbb, 0x6f, 0x35, 0xdd, 0xda, 0x45, 0xb6, 0xbb, 0x7c, 0xad, 0x6e, 0x99, 0x8e, 0x6d, 0x35,
+	0xf2, 0x59, 0x17, 0xfc, 0x94, 0x3b, 0x7f, 0xcf, 0x9f, 0x5e, 0xa1, 0xb3, 0xf2, 0x22, 0x9c, 0xa4,
+	0x98, 0x5b, 0x96, 0x5d, 0x47, 0x5a, 0xcd, 0x73, 0x87, 0x3c, 0xb8, 0x68, 0x27, 0xdc, 0xc9, 0xbb,
+	0xee, 0xdc, 0x06, 0x9b, 0x92, 0x4b, 0x70, 0xc2, 0x46, 0xaf, 0xb4, 0x0d, 0x1b, 0x69, 0x35, 0xd5,
+	0x71, 0x6c, 0x63, 0xb3, 0xed, 0x20, 0x9c, 0xcf, 0xcd, 0x0e, 0xcc, 0x65, 0xab, 0xb2, 0x37, 0x55,
+	0xf6, 0x67, 0xe4, 0x19, 0xc8, 0xb6, 0xb1, 0x56, 0xab, 0x23, 0xd3, 0xc1, 0xf9, 0xd1, 0x59, 0x69,
+	0x6e, 0x70, 0x39, 0x93, 0x97, 0xaa, 0x23, 0x6d, 0xac, 0xad, 0x90, 0x31, 0xf9, 0x14, 0x0c, 0xef,
+	0x5a, 0x8d, 0x76, 0x13, 0xe5, 0xc7, 0xc8, 0x6c, 0x95, 0x7d, 0xc9, 0x67, 0x29, 0x62, 0xd3, 0x68,
+	0x34, 0x70, 0x7e, 0xdc, 0x9d, 0x22, 0x48, 0x15, 0xf2, 0x2d, 0xdf, 0x80, 0x53, 0x8c, 0x56, 0x4d,
+	0x43, 0x2d, 0x0b, 0x1b, 0x4e, 0x8d, 0x6a, 0x2d, 0x7f, 0xcc, 0xe5, 0x7d, 0x92, 0xcd, 0xae, 0xd2,
+	0x49, 0xaa, 0xe4, 0xa5, 0x09, 0x62, 0xd5, 0x11, 0xe3, 0x29, 0x9c, 0x82, 0xc9, 0xa8, 0xd9, 0x32,
+	0x7b, 0xfe, 0xa9, 0xe4, 0xd9, 0x33, 0xc5, 0x3d, 0x08, 0xaf, 0x7d, 0x0e, 0x86, 0x19, 0x93, 0x03,
+	0xe9, 0x2c, 0x82, 0xa1, 0x71, 0xbd, 0xd2, 0x17, 0xc0, 0xe3, 0x93, 0x09, 0xf0, 0x6d, 0x09, 0x4e,
+	0x55, 0xb0, 0xbe, 0x8a, 0x1a, 0xc8, 0x41, 0x07, 0x27, 0xc3, 0x15, 0x38, 0x66, 0xa3, 0xa6, 0xb5,
+	0x8b, 0x34, 0x4f, 0x85, 0xcc, 0x3d, 0xc7, 0xd9, 0x30, 0x73, 0x41, 0x2e, 0xaf, 0x67, 0xe0, 0x74,
+	0x17, 0x4b, 0x8c, 0x5d, 0x0d, 0xe4, 0x0a, 0xd6, 0xef, 0x1a, 0xa6, 0xda, 0x30, 0x1e, 0x1e, 0xc4,
+	0x19, 0xc9, 0x65, 0xe0, 0x24, 0x9c, 0x88, 0x50, 0x89, 0x10, 0x2f, 0xd7, 0x1d, 0x63, 0x57, 0x75,
+	0x0e, 0x99, 0x78, 0x40, 0x85, 0x11, 0xdf, 0x84, 0xe3, 0x15, 0xac, 0xaf, 0x10, 0x23, 0x68, 0x1c,
+	0x16, 0xe9, 0x13, 0x30, 0x11, 0xa2, 0x11, 0x21, 0x4c, 0x77, 0xe3, 0x70, 0x09, 0x7b, 0x34, 0x18,
+	0xe1, 0x9f, 0x48, 0x30, 0x5e, 0xc1, 0x7a, 0xc5, 0x30, 0x9d, 0x27, 0xbe, 0x26, 0x92, 0x59, 0xed,
+	0x39, 0xc8, 0xda, 0xa8, 0x6e, 0xb4, 0x0c, 0x64, 0x3a, 0xcc, 0x5e, 0x83, 0x01, 0x2e, 0xe3, 0x2b,
+	0x70, 0xcc, 0x67, 0x91, 0xb2, 0x2d, 0x5f, 0x87, 0xc9, 0x16, 0x32, 0x35, 0xc3, 0xd4, 0x6b, 0x56,
+	0x0b, 0xd9, 0x6e, 0x0c, 0x51, 0x33, 0x34, 0x97, 0xe3, 0xc1, 0xaa, 0xcc, 0xe6, 0xbe, 0xe0, 0x4d,
+	0xad, 0x69, 0x85, 0xd7, 0xa8, 0xa0, 0xcb, 0x6d, 0xdb, 0xfc, 0x78, 0x04, 0xe5, 0x8a, 0x32, 0x01,
+	0xc7, 0x7c, 0x26, 0xd8, 0x0e, 0xfc, 0x2e, 0xe3, 0x5a, 0xfc, 0xcb, 0x86, 0xb3, 0xad, 0xd9, 0xea,
+	0x83, 0x83, 0x38, 0x18, 0xa6, 0x00, 0x1c, 0xab, 0xe3, 0x4c, 0xc8, 0x3a, 0x96, 0x77, 0x23, 0xef,
+	0xfb, 0x72, 0x0f, 0xce, 0x0e, 0x88, 0xe5, 0xbe, 0x4b, 0xe4, 0xfe, 0xf9, 0x07, 0x33, 0x73, 0xba,
+	0xe1, 0x6c, 0xb7, 0x37, 0x8b, 0x75, 0xab, 0xc9, 0x02, 0x4b, 0xf6, 0xd7, 0x3c, 0xd6, 0x76, 0x4a,
+	0xe4, 0x72, 0xc6, 0x2e, 0x02, 0xfe, 0x21, 0x39, 0xd5, 0x1b, 0x48, 0x57, 0xeb, 0xfb, 0x35, 0x12,
+	0x49, 0xe2, 0x9f, 0x7d, 0xf4, 0xd6, 0x35, 0xc9, 0xd7, 0xdc, 0x59, 0xc8, 0xba, 0x87, 0xab, 0x43,
+	0x36, 0x8b, 0xc4, 0x03, 0x63, 0xd5, 0x11, 0x3a, 0xb0, 0xa6, 0x91, 0x49, 0xb4, 0x8b, 0x4c, 0xa7,
+	0xe6, 0xa8, 0xba, 0x7b, 0xd7, 0x67, 0xab, 0x23, 0xee, 0xc0, 0x86, 0xaa, 0x73, 0xb5, 0x79, 0x0f,
+	0x4e, 0x44, 0x34, 0xd7, 0xb7, 0x71, 0xfc, 0x82, 0xee, 0x81, 0x77, 0xb3, 0x1e, 0xbc, 0x81, 0x0c,
+	0xf0, 0xb6, 0x29, 0x41, 0xf0, 0x14, 0xdd, 0xc9, 0xa1, 0xce, 0x9d, 0x5c, 0x86, 0x61, 0x1b, 0xa9,
+	0xd8, 0x32, 0x59, 0x68, 0x74, 0x8d, 0x7f, 0x8b, 0x45, 0xe3, 0x86, 0xaa, 0x8b, 0x51, 0x65, 0x98,
+	0xd4, 0x1f, 0xb7, 0x90, 0x8d, 0x48, 0x94, 0x7a, 0xd4, 0xf3, 0x47, 0x36, 0x20, 0x50, 0x7b, 0xb0,
+	0x5c, 0xdf, 0x6a, 0xff, 0x48, 0x82, 0x93, 0x15, 0xac, 0xaf, 0x6d, 0xd6, 0x3b, 0x35, 0xff, 0x5d,
+	0x09, 0x46, 0xfc, 0x10, 0x88, 0x2a, 0xff, 0x6a, 0xd1, 0xd8, 0xac, 0x17, 0xc3, 0x6f, 0x86, 0xa2,
+	0x07, 0xe1, 0x86, 0x7f, 0x01, 0x47, 0xcb, 0x2f, 0x92, 0xcd, 0xf8, 0xc7, 0xfb, 0x33, 0xab, 0xdd,
+	0x56, 0x6b, 0x6c, 0xd6, 0xe7, 0x75, 0xab, 0xb4, 0xbb, 0x70, 0xbd, 0xd4, 0xb4, 0xb4, 0x76, 0x03,
+	0x61, 0xf2, 0x0c, 0x09, 0x3d, 0x3f, 0xa8, 0x2d, 0x87, 0xe5, 0xf3, 0x19, 0x79, 0x02, 0xbf, 0xcf,
+	0xc3, 0xa9, 0x4e, 0x41, 0x99, 0xfb, 0xff, 0x55, 0x02, 0xa5, 0x82, 0xf5, 0x75, 0xe4, 0xac, 0x12,
+	0x0f, 0xaf, 0x20, 0x47, 0xd5, 0x54, 0x47, 0xf5, 0x14, 0xd1, 0x86, 0x91, 0x26, 0x1b, 0x62, 0x7a,
+	0x98, 0x0a, 0x8c, 0xd0, 0xdc, 0xf1, 0x8d, 0xd0, 0xc3, 0x5b, 0x5e, 0x62, 0xb2, 0x2f, 0x0a, 0x3d,
+	0x76, 0x8f, 0xbe, 0xe9, 0x98, 0xb0, 0x1e, 0x4d, 0x9f, 0xd4, 0x13, 0x48, 0x3a, 0x05, 0x67, 0xb9,
+	0xe2, 0x30, 0x71, 0x5f, 0x1f, 0x82, 0x0b, 0x34, 0x46, 0xf2, 0x6e, 0x7e, 0xef, 0x12, 0xfe, 0x7f,
+	0x78, 0xab, 0x74, 0xbc, 0x37, 0x86, 0x9e, 0xfc, 0xbd, 0x31, 0x7c, 0x70, 0xef, 0x8d, 0xa3, 0xe9,
+	0xde, 0x1b, 0x23, 0xfd, 0xbd, 0x37, 0xb2, 0xa9, 0xdf, 0x1b, 0x90, 0xec, 0xbd, 0x91, 0x13, 0xbe,
+	0x37, 0x46, 0xe3, 0xdf, 0x1b, 0x63, 0x89, 0xdf, 0x1b, 0xe3, 0xe9, 0xde, 0x1b, 0x97, 0xe1, 0xa2,
+	0xd8, 0x14, 0x99, 0xcd, 0xfe, 0x4d, 0x82, 0x59, 0x62, 0xd3, 0xae, 0xe2, 0xd7, 0xcc, 0xba, 0x8d,
+	0x54, 0x8c, 0xee, 0xdb, 0x56, 0xcb, 0xc2, 0x6a, 0xe3, 0x89, 0x0d, 0xf6, 0x12, 0x8c, 0x3b, 0xaa,
+	0xad, 0x23, 0xc7, 0x37, 0x4c, 0xe6, 0x6b, 0x74, 0xd4, 0x33, 0xcd, 0x5b, 0x90, 0x55, 0xdb, 0xce,
+	0xb6, 0x65, 0x1b, 0xce, 0x3e, 0xb5, 0xec, 0xe5, 0xfc, 0x7b, 0x6f, 0xcf, 0x4f, 0x32, 0x2a, 0x0c,
+	0x6c, 0xdd, 0xb1, 0x0d, 0x53, 0xaf, 0x06, 0xa0, 0x4b, 0xf2, 0xbf, 0x7f, 0x3c, 0x23, 0x11, 0xd9,
+	0x83, 0xb1, 0xc2, 0x05, 0x38, 0x2f, 0x90, 0x87, 0x49, 0xfd, 0x5e, 0x58, 0xea, 0x55, 0xc4, 0x97,
+	0x7a, 0x33, 0xb9, 0xd4, 0x25, 0x76, 0x30, 0x5d, 0x49, 0x18, 0x4a, 0xf8, 0x0a, 0x8a, 0x48, 0x9e,
+	0x39, 0x38, 0xc9, 0x57, 0x51, 0x8c, 0xe4, 0xdf, 0xcb, 0x40, 0xa1, 0x82, 0xf5, 0x97, 0x5a, 0x1a,
+	0x7b, 0x81, 0x44, 0xcd, 0x5a, 0x1c, 0xa1, 0x3d, 0x0b, 0x0a, 0x7d, 0x7d, 0xd5, 0x78, 0xbe, 0x92,
+	0x71, 0x7d, 0x25, 0x4f, 0x21, 0xba, 0x97, 0x96, 0x6f, 0xc1, 0x69, 0x55, 0xd3, 0xb8, 0xa8, 0x03,
+	0x2e, 0xea, 0x49, 0x55, 0xd3, 0x38, 0x78, 0xf7, 0x40, 0xf6, 0x3c, 0xb8, 0x16, 0x28, 0x6b, 0xb0,
+	0x87, 0xb2, 0x26, 0x3c, 0x9c, 0xb2, 0xaf, 0xb4, 0xb3, 0x9e, 0xd2, 0x38, 0xeb, 0x15, 0x2e, 0xc1,
+	0x05, 0xa1, 0x5e, 0x98, 0xfe, 0x7e, 0x2b, 0xc1, 0xb4, 0x0f, 0xd7, 0x19, 0x7b, 0x88, 0x74, 0x17,
+	0x7b, 0x28, 0x65, 0xe2, 0x0f, 0xa5, 0x83, 0xf4, 0x8b, 0xf3, 0x30, 0x13, 0xcb, 0xb7, 0x97, 0x5b,
+	0x93, 0xe0, 0xa2, 0x0f, 0x53, 0xe5, 0x1c, 0x34, 0x62, 0x09, 0xe3, 0xcf, 0xae, 0x4c, 0xfc, 0xd9,
+	0x25, 0x5f, 0x87, 0x61, 0x6c, 0xe8, 0x26, 0xb2, 0x7b, 0x0a, 0xc8, 0xe0, 0x96, 0x8e, 0x79, 0xd2,
+	0xb1, 0x81, 0xc2, 0x15, 0xb8, 0xd4, 0x83, 0x6d, 0xef, 0x82, 0xa6, 0x79, 0xd2, 0x75, 0x44, 0x26,
+	0x88, 0xff, 0xad, 0x86, 0xa2, 0x11, 0xbe, 0x50, 0x93, 0x30, 0xb4, 0xab, 0x36, 0xda, 0x88, 0x1d,
+	0x5c, 0xf4, 0xe3, 0x20, 0x98, 0xa6, 0x59, 0xce, 0x4e, 0x56, 0x18, 0xa3, 0xff, 0x91, 0xe0, 0x9c,
+	0x2f, 0xd2, 0x3a, 0x32, 0xb5, 0x55, 0x64, 0xee, 0x93, 0x8b, 0x53, 0xcc, 0xec, 0x2d, 0x38, 0xcd,
+	0xfc, 0x53, 0x43, 0xa6, 0x11, 0xa4, 0x4e, 0x7c, 0xe7, 0x3c, 0x49, 0xa7, 0x57, 0xdd, 0xd9, 0xb2,
+	0x37, 0x49, 0xa2, 0x5b, 0xe2, 0x99, 0x5d, 0x48, 0xd4, 0x2d, 0x65, 0x55, 0xd3, 0x3a, 0x31, 0x22,
+	0x96, 0x39, 0xf8, 0x64, 0x96, 0x39, 0x03, 0x53, 0x31, 0xb2, 0x32, 0x6d, 0xfc, 0x5e, 0x72, 0xe3,
+	0xae, 0xb2, 0xa6, 0x7d, 0x1e, 0x39, 0x65, 0x8c, 0x91, 0xf3, 0x45, 0xb2, 0x0b, 0x07, 0x92, 0x67,
+	0x5a, 0x87, 0xe3, 0x26, 0xb9, 0x9e, 0xc8, 0xaa, 0x35, 0x77, 0x73, 0xbd, 0xac, 0xd9, 0x05, 0x7e,
+	0x5c, 0x13, 0x61, 0x81, 0x5d, 0x77, 0xe3, 0x66, 0x84, 0x2f, 0x6e, 0xec, 0x38, 0x0d, 0xe7, 0xf8,
+	0x32, 0x30, 0x21, 0xff, 0x28, 0x41, 0x81, 0x19, 0x44, 0x18, 0xaf, 0xf3, 0x52, 0xe2, 0xcb, 0x1a,
+	0x64, 0xfc, 0x32, 0x7d, 0x65, 0xfc, 0x0e, 0xf4, 0xa4, 0xa1, 0x27, 0x69, 0xbc, 0x20, 0x4c, 0xe0,
+	0x5f, 0x4b, 0xae, 0xdb, 0x56, 0x5d, 0x8b, 0xec, 0x43, 0x66, 0x4e, 0x86, 0x90, 0x1a, 0x79, 0x47,
+	0x86, 0xf0, 0x40, 0x65, 0x9b, 0x83, 0xcb, 0xbd, 0x78, 0x66, 0xe2, 0xfd, 0x81, 0x5e, 0x14, 0x2b,
+	0xdb, 0xaa, 0xa9, 0x23, 0x9a, 0xfa, 0x4f, 0x26, 0x57, 0x19, 0xc0, 0x44, 0x0f, 0x6a, 0xac, 0xae,
+	0x90, 0x49, 0x5c, 0x57, 0xc8, 0x9a, 0xe8, 0x01, 0xfd, 0x79, 0x08, 0xf7, 0x06, 0x5f, 0x0c, 0x26,
+	0xea, 0x1b, 0x19, 0x98, 0x0d, 0xe5, 0x2a, 0x9e, 0xc7, 0x75, 0xdb, 0x7a, 0x90, 0x4c, 0xd8, 0xba,
+	0x1f, 0x63, 0x65, 0x7a, 0xa5, 0x6b, 0xae, 0xa7, 0x4d, 0xd7, 0x08, 0xa2, 0xd0, 0x81, 0x9e, 0x51,
+	0xe8, 0xe0, 0x41, 0xc4, 0x62, 0x71, 0x1a, 0x61, 0x7a, 0x7b, 0xec, 0xbb, 0x7c, 0xe4, 0x3d, 0xd9,
+	0xa9, 0xb9, 0x4f, 0xe8, 0x99, 0xdc, 0x6f, 0x68, 0x3a, 0x1e, 0x77, 0x1c, 0xc4, 0x08, 0xc9, 0x94,
+	0xf1, 0x23, 0x5a, 0x47, 0xa0, 0xd7, 0xc0, 0x7d, 0xd5, 0x56, 0x9b, 0xfe, 0xf9, 0x1e, 0xe1, 0x44,
+	0x4a, 0xcc, 0x09, 0xa9, 0xce, 0xb5, 0xdc, 0x85, 0x5c, 0xf6, 0x73, 0x8b, 0xe7, 0xf8, 0x5e, 0x44,
+	0x89, 0x79, 0x07, 0x22, 0xc5, 0xe8, 0x92, 0x82, 0x96, 0x14, 0xa2, 0xdc, 0x31, 0xce, 0xbf, 0x49,
+	0x3d, 0xbd, 0x8a, 0x76, 0xad, 0x1d, 0xf4, 0x31, 0xd6, 0x60, 0xb9, 0xd7, 0x0c, 0x75, 0x57, 0x3e,
+	0x2f, 0x8c, 0xdf, 0xb7, 0x33, 0xae, 0xa6, 0xef, 0xda, 0x08, 0x3d, 0x44, 0x2f, 0x58, 0x0d, 0xed,
+	0x00, 0x32, 0x13, 0x9f, 0xe1, 0x8a, 0x22, 0xd8, 0xa6, 0x0e, 0x21, 0xaf, 0xc3, 0xf0, 0xb6, 0xcb,
+	0x49, 0xef, 0x80, 0x8a, 0xc2, 0xc9, 0x2b, 0x00, 0x68, 0xaf, 0x65, 0xd8, 0x08, 0xd7, 0x54, 0x87,
+	0x55, 0xa0, 0x95, 0xae, 0x0a, 0xf4, 0x86, 0x57, 0x9b, 0x5f, 0x1e, 0x21, 0xfc, 0xbe, 0xf1, 0xc1,
+	0x8c, 0x54, 0xcd, 0x32, 0xbc, 0xb2, 0x43, 0xde, 0xe8, 0x2c, 0xc7, 0x48, 0xd3, 0x8f, 0xec, 0x4b,
+	0x50, 0x54, 0x8a, 0x6a, 0x2d, 0x78, 0x14, 0x90, 0xb8, 0xf2, 0x25, 0x73, 0x8b, 0xa3, 0x53, 0xfe,
+	0xde, 0x7f, 0xec, 0x0a, 0x13, 0x94, 0xda, 0x3b, 0xf9, 0x0e, 0xc2, 0x2e, 0x12, 0x98, 0xad, 0x1a,
+	0x98, 0xbd, 0x82, 0x36, 0x2c, 0x0a, 0x81, 0x0f, 0x57, 0xb4, 0xdb, 0xc4, 0x6d, 0xf7, 0xad, 0x36,
+	0xad, 0xa0, 0x24, 0x31, 0x42, 0x0a, 0xce, 0x95, 0x70, 0x0d, 0xa6, 0xe3, 0x64, 0x60, 0xa9, 0xdd,
+	0x2b, 0x70, 0x4c, 0xf3, 0xa6, 0x23, 0x59, 0xdd, 0xf1, 0xf0, 0xf0, 0x9a, 0x56, 0xf8, 0x3a, 0x0d,
+	0x43, 0x57, 0x1a, 0xaa, 0xd1, 0x5c, 0x0d, 0x4d, 0x79, 0xda, 0x08, 0xb6, 0x44, 0x4a, 0x68, 0xc3,
+	0x1c, 0xd2, 0x19, 0x1e, 0xe9, 0xa5, 0x9c, 0xfb, 0x72, 0xa0, 0x58, 0x2c, 0x92, 0xe4, 0xb0, 0xc1,
+	0xf6, 0xed, 0x57, 0xfe, 0xb5, 0x52, 0x45, 0x1a, 0x6a, 0xb6, 0xc8, 0xe4, 0x7d, 0x57, 0x43, 0xee,
+	0x09, 0x7c, 0xb8, 0x9b, 0x77, 0x1e, 0x46, 0xe9, 0x6e, 0xd4, 0xe8, 0xe2, 0xf4, 0xc8, 0xca, 0xb5,
+	0x02, 0xfa, 0xdc, 0x6d, 0xf2, 0x2f, 0x89, 0x18, 0x96, 0x99, 0x68, 0x6f, 0xd2, 0x2d, 0x60, 0x22,
+	0x04, 0xb0, 0xfd, 0x6f, 0xc1, 0xed, 0x50, 0x00, 0x92, 0xe6, 0xc4, 0x8b, 0x6e, 0xc9, 0x8a, 0xbb,
+	0x25, 0x1c, 0xb6, 0x98, 0x8d, 0x5d, 0x80, 0x31, 0xdb, 0x1f, 0x0d, 0x2c, 0x6c, 0x34, 0x18, 0x5c,
+	0xd3, 0x0a, 0x7f, 0xa2, 0xc2, 0xdd, 0x6d, 0x37, 0xb6, 0x8c, 0x46, 0xa3, 0x5b, 0xb8, 0xae, 0xad,
+	0x91, 0xd2, 0x6d, 0x4d, 0x17, 0x13, 0x99, 0x6e, 0x26, 0xe4, 0x85, 0xc4, 0xce, 0x27, 0x74, 0xbb,
+	0x97, 0xe1, 0x1c, 0x5f, 0x14, 0xa6, 0x90, 0xc0, 0xc7, 0xa5, 0x54, 0x3e, 0x5e, 0xf8, 0x25, 0x2d,
+	0x29, 0x54, 0xd1, 0x57, 0x50, 0xdd, 0xf9, 0x84, 0x74, 0x14, 0xdc, 0x1a, 0x03, 0x3d, 0x6f, 0x8d,
+	0x29, 0x38, 0xcb, 0x65, 0xd7, 0x2b, 0x51, 0x67, 0xbc, 0x92, 0x82, 0x97, 0x8d, 0x79, 0xd1, 0x68,
+	0x1a, 0xce, 0x21, 0x9f, 0xb0, 0x9f, 0x03, 0x99, 0x1a, 0x6e, 0x4d, 0x53, 0x8d, 0xc6, 0x7e, 0xad,
+	0x41, 0x68, 0xb2, 0x8b, 0x64, 0x8a, 0x05, 0x8f, 0x27, 0xe9, 0x42, 0x58, 0xdb, 0x29, 0x1a, 0x56,
+	0xa9, 0xa9, 0x3a, 0xdb, 0xc5, 0x35, 0xd3, 0xa9, 0x1e, 0xa7, 0x88, 0xab, 0x04, 0xcf, 0x65, 0x95,
+	0x2c, 0xc6, 0xea, 0x0a, 0xe1, 0xc5, 0x06, 0x13, 0x2d, 0x46, 0x11, 0x83, 0xc5, 0x04, 0x2f, 0x67,
+	0x8e, 0x8a, 0xbc, 0xe7, 0xc7, 0x00, 0x4c, 0xb1, 0x07, 0x67, 0xf0, 0xbe, 0xdd, 0x40, 0x76, 0xf3,
+	0x90, 0xb5, 0xb8, 0x08, 0x47, 0x23, 0xef, 0x09, 0x01, 0xa6, 0x07, 0x28, 0x3f, 0x97, 0x32, 0x6a,
+	0x19, 0xec, 0x8c, 0x58, 0xee, 0xc1, 0xf1, 0xa6, 0x61, 0x3a, 0x11, 0x5d, 0x0f, 0x25, 0xd1, 0xf5,
+	0x38, 0x41, 0x0b, 0x6d, 0xdb, 0x3d, 0x90, 0x1f, 0xb0, 0xe7, 0x49, 0x28, 0xe3, 0x43, 0x4a, 0x3a,
+	0xc2, 0xac, 0xaa, 0x87, 0x53, 0xf6, 0x50, 0xb8, 0x5b, 0x36, 0x0b, 0xd3, 0x71, 0x3b, 0xc2, 0x36,
+	0xed, 0x5f, 0x92, 0x0f, 0xd2, 0x22, 0x51, 0xb9, 0xda, 0xd8, 0xd8, 0xb6, 0x11, 0x26, 0xd6, 0x75,
+	0xb8, 0xbb, 0xf6, 0x2c, 0x40, 0x0b, 0xd9, 0x4d, 0x03, 0x63, 0x83, 0x39, 0xf0, 0x78, 0xdc, 0xc3,
+	0x80, 0x65, 0x0f, 0x43, 0xf0, 0xa4, 0xa0, 0xec, 0x78, 0x7c, 0xba, 0xdb, 0x37, 0x56, 0x0d, 0x06,
+	0x04, 0x01, 0x39, 0x5f, 0x4e, 0xa6, 0x8b, 0xaf, 0xd1, 0x33, 0x8d, 0x02, 0x20, 0xbf, 0x88, 0xec,
+	0xe9, 0xe1, 0x3c, 0x8c, 0x72, 0x6a, 0xce, 0x39, 0x2b, 0x28, 0x36, 0xcb, 0x37, 0x60, 0x44, 0xa5,
+	0xd8, 0xbd, 0xf5, 0xe1, 0x43, 0x2e, 0x8d, 0x11, 0x76, 0xfd, 0xcf, 0xc2, 0x1d, 0x9a, 0x65, 0xeb,
+	0xe2, 0x82, 0x1d, 0xd9, 0x0a, 0x8c, 0xa0, 0x3d, 0x54, 0x6f, 0x3b, 0x88, 0xb2, 0x30, 0x52, 0xf5,
+	0xbf, 0x0b, 0xaf, 0xc2, 0x19, 0xbf, 0xef, 0xa7, 0x1f, 0xfe, 0x83, 0x7c, 0x6a, 0x26, 0x61, 0x3e,
+	0x35, 0x17, 0xce, 0xa5, 0x9e, 0x03, 0x85, 0x47, 0x9e, 0xa9, 0xf7, 0xbf, 0x41, 0x66, 0xcd, 0xcb,
+	0xe7, 0xb3, 0x2c, 0x71, 0x13, 0x99, 0xbd, 0x8e, 0xda, 0x0d, 0x18, 0xb5, 0x43, 0xc0, 0x2c, 0x4d,
+	0x11, 0xd3, 0x8b, 0xc0, 0x5b, 0x9f, 0xdd, 0x5f, 0x91, 0x55, 0x62, 0x4a, 0x1a, 0x03, 0xe9, 0x4b,
+	0x1a, 0xa7, 0xc5, 0xe5, 0x8c, 0x78, 0x99, 0x99, 0x6e, 0xbe, 0x41, 0x13, 0xcd, 0x1b, 0xea, 0x0e,
+	0x8b, 0xfe, 0xd7, 0x4d, 0xb5, 0x85, 0xb7, 0x2d, 0xe7, 0x50, 0x9d, 0x90, 0xeb, 0x28, 0x9f, 0x85,
+	0xa9, 0x18, 0x4e, 0x98, 0x01, 0xce, 0x40, 0x0e, 0xb3, 0xb1, 0xc0, 0x8c, 0xc0, 0x1b, 0x5a, 0xd3,
+	0x0a, 0x8f, 0x32, 0xde, 0x45, 0xb0, 0x86, 0x71, 0x9b, 0xec, 0xcd, 0x7a, 0x7d, 0x1b, 0x91, 0x46,
+	0x08, 0xb1, 0x34, 0xcf, 0xc0, 0xc8, 0xb6, 0x6a, 0x6b, 0xb5, 0xba, 0xda, 0xca, 0x67, 0x92, 0x9c,
+	0xa5, 0x47, 0x09, 0xf8, 0x8a, 0xda, 0x92, 0xef, 0xd1, 0x4e, 0x8e, 0xfa, 0xb6, 0x9f, 0x35, 0xbe,
+	0xc4, 0xb7, 0x0c, 0x8f, 0xa1, 0x0d, 0x0a, 0xcd, 0x8c, 0xc2, 0x47, 0xee, 0x3b, 0xf7, 0xd4, 0x99,
+	0xa6, 0xf0, 0x0f, 0xde, 0x6e, 0x0d, 0xb0, 0x1d, 0xff, 0x01, 0x2b, 0x7d, 0x22, 0x27, 0x92, 0x88,
+	0xae, 0xa8, 0x7b, 0x65, 0xbd, 0x87, 0x9e, 0x4e, 0x93, 0xf6, 0x83, 0xbd, 0x9a, 0xaa, 0x23, 0x16,
+	0x16, 0x0d, 0x37, 0x5d, 0xac, 0xbe, 0xf3, 0x8d, 0xe3, 0xfc, 0x0a, 0x66, 0x0c, 0x6b, 0x54, 0x80,
+	0xc5, 0x47, 0xf3, 0x30, 0x50, 0xc1, 0xba, 0x5c, 0x83, 0x11, 0xaf, 0xb6, 0x2d, 0xcf, 0xc5, 0xe4,
+	0x47, 0xbb, 0x3a, 0x3d, 0x95, 0xab, 0x09, 0x20, 0x99, 0xbd, 0xd5, 0x60, 0xc4, 0x2b, 0x9a, 0x0b,
+	0x08, 0x74, 0x74, 0x73, 0x2a, 0x57, 0x13, 0x40, 0x32, 0x02, 0x5f, 0x82, 0x61, 0x7a, 0x66, 0xc9,
+	0x97, 0x63, 0x91, 0x22, 0xfd, 0x9a, 0xca, 0x95, 0x9e, 0x70, 0xc1, 0xd2, 0xb4, 0x19, 0x52, 0xb0,
+	0x74, 0xa4, 0x23, 0x53, 0xb9, 0xd2, 0x13, 0x8e, 0x2d, 0xbd, 0x0e, 0x83, 0xa4, 0x5d, 0x51, 0xbe,
+	0x18, 0x8b, 0x10, 0x6a, 0xb8, 0x54, 0x2e, 0xf5, 0x80, 0x0a, 0x16, 0x25, 0x8d, 0x83, 0x82, 0x45,
+	0x43, 0xcd, 0x8d, 0xca, 0xa5, 0x1e, 0x50, 0x6c, 0xd1, 0x4d, 0xc8, 0xfa, 0xfd, 0xca, 0xb2, 0x60,
+	0x5f, 0x3a, 0x7a, 0xaf, 0x95, 0x6b, 0x49, 0x40, 0x19, 0x8d, 0x1d, 0x18, 0x0d, 0xf7, 0x19, 0xcb,
+	0x4f, 0xf5, 0x50, 0x63, 0x94, 0xd2, 0x7c, 0x42, 0xe8, 0xc0, 0x22, 0xbd, 0x94, 0xb2, 0xc0, 0x22,
+	0x3b, 0xba, 0x2d, 0x95, 0xab, 0x09, 0x20, 0x23, 0x1a, 0xa3, 0x65, 0x05, 0xb1, 0xc6, 0x22, 0x1d,
+	0x4d, 0xca, 0xb5, 0x24, 0xa0, 0x81, 0x10, 0x7e, 0x81, 0x3b, 0x5e, 0x88, 0x8e, 0xa2, 0xba, 0x72,
+	0x35, 0x01, 0x24, 0x23, 0xb0, 0x0d, 0xb9, 0x50, 0x33, 0x9a, 0xfc, 0xa9, 0x58, 0xcc, 0xee, 0xde,
+	0x3c, 0xe5, 0xa9, 0x64, 0xc0, 0x8c, 0xd2, 0x03, 0x38, 0xde, 0x99, 0xd7, 0x96, 0xaf, 0xc7, 0xae,
+	0x10, 0xd3, 0x06, 0xa7, 0x2c, 0xa4, 0xc0, 0x60, 0x84, 0x5f, 0x81, 0xf1, 0x68, 0x72, 0x57, 0x2e,
+	0xc6, 0x2e, 0xc2, 0xcd, 0x48, 0x2b, 0xa5, 0xc4, 0xf0, 0x8c, 0xe4, 0x77, 0x24, 0x38, 0x13, 0xdb,
+	0x4e, 0x24, 0xdf, 0x11, 0x19, 0x80, 0xb0, 0x1b, 0x4e, 0x59, 0xea, 0x07, 0x95, 0x31, 0xf5, 0xba,
+	0x04, 0xa7, 0xf8, 0xad, 0x3e, 0xf2, 0xad, 0x78, 0xad, 0x8a, 0x7a, 0x9d, 0x94, 0xdb, 0xa9, 0xf1,
+	0xba, 0x78, 0x59, 0x45, 0x29, 0x79, 0x59, 0x45, 0xfd, 0xf1, 0x12, 0xd7, 0xe5, 0x23, 0x7f, 0x4b,
+	0x82, 0x7c, 0x5c, 0x2b, 0x8b, 0xfc, 0x4c, 0xec, 0xaa, 0x3d, 0xba, 0x82, 0x94, 0x3b, 0x7d, 0x60,
+	0x32, 0x8e, 0x5e, 0x93, 0x60, 0x92, 0xd7, 0x7c, 0x22, 0xdf, 0xe8, 0xb1, 0x26, 0xb7, 0xc7, 0x46,
+	0xb9, 0x99, 0x12, 0x8b, 0x71, 0xf1, 0xa6, 0x04, 0x4a, 0x7c, 0x9f, 0x88, 0xbc, 0x94, 0x4c, 0x3e,
+	0x5e, 0x4f, 0x8c, 0xf2, 0xe9, 0xbe, 0x70, 0x03, 0x7f, 0x8e, 0x76, 0x82, 0x08, 0xfc, 0x99, 0xdb,
+	0xbd, 0xa2, 0x94, 0x12, 0xc3, 0x33, 0x92, 0xaf, 0x82, 0xdc, 0xdd, 0x72, 0x21, 0x2f, 0xf6, 0x90,
+	0x82, 0xd3, 0x8b, 0xa2, 0x3c, 0x9d, 0x0a, 0x87, 0x91, 0x7f, 0x08, 0x13, 0x5d, 0xbd, 0x10, 0xf2,
+	0x82, 0xe8, 0x28, 0xe0, 0xf6, 0x7e, 0x28, 0x8b, 0x69, 0x50, 0x42, 0xde, 0x11, 0xd7, 0x9e, 0x20,
+	0xf0, 0x8e, 0x1e, 0xad, 0x19, 0xca, 0x9d, 0x3e, 0x30, 0x19, 0x47, 0xdf, 0x97, 0xe0, 0xac, 0xa0,
+	0xa9, 0x40, 0x8e, 0x37, 0xae, 0xde, 0xed, 0x13, 0xca, 0xb3, 0xfd, 0x21, 0x87, 0x1c, 0x97, 0x57,
+	0xfd, 0x17, 0x38, 0xae, 0xa0, 0xe7, 0x41, 0xb9, 0x99, 0x12, 0x2b, 0x74, 0xb8, 0xf2, 0xab, 0xe9,
+	0x82, 0xc3, 0x55, 0xd8, 0x90, 0xa0, 0xdc, 0x4e, 0x8d, 0x17, 0x35, 0x1f, 0x6e, 0x39, 0x5b, 0x6c,
+	0x3e, 0xa2, 0x32, 0xbf, 0x72, 0xa7, 0x0f, 0xcc, 0x20, 0x08, 0x0d, 0x57, 0xa6, 0x05, 0x41, 0x28,
+	0xa7, 0xbc, 0xae, 0xcc, 0x27, 0x84, 0x0e, 0x19, 0x04, 0xaf, 0xbe, 0x2c, 0x30, 0x08, 0x41, 0x69,
+	0x5c, 0xb9, 0x99, 0x12, 0x2b, 0x10, 0x39, 0x5c, 0x8a, 0x15, 0x88, 0xcc, 0xa9, 0x73, 0x2b, 0xf3,
+	0x09, 0xa1, 0x83, 0xe3, 0x39, 0x5a, 0x23, 0x15, 0x1c, 0xcf, 0xdc, 0x22, 0xb0, 0x52, 0x4a, 0x0c,
+	0xcf, 0x48, 0x3e, 0x92, 0xe0, 0x04, 0xa7, 0x6a, 0x29, 0xc7, 0x1f, 0xb6, 0xf1, 0x75, 0x5a, 0xe5,
+	0x46, 0x3a, 0xa4, 0xe0, 0x88, 0xee, 0x2a, 0x32, 0x0a, 0x8e, 0xe8, 0xb8, 0xba, 0xa8, 0xb2, 0x98,
+	0x06, 0x25, 0xea, 0x63, 0xdc, 0x6a, 0xa0, 0xd8, 0xc7, 0x44, 0x35, 0x4f, 0xe5, 0x4e, 0x1f, 0x98,
+	0x81, 0x36, 0xba, 0xea, 0x7b, 0x02, 0x6d, 0xc4, 0x95, 0x28, 0x05, 0xda, 0x88, 0x2f, 0x1f, 0x3e,
+	0x84, 0x89, 0xae, 0x52, 0x9a, 0x80, 0x76, 0x5c, 0x05, 0x51, 0x59, 0x4c, 0x83, 0x12, 0xbc, 0x71,
+	0x3a, 0xab, 0x57, 0x82, 0x37, 0x4e, 0x4c, 0x5d, 0x4e, 0x59, 0x48, 0x81, 0x11, 0x08, 0xdd, 0x55,
+	0xf3, 0x91, 0x85, 0x6f, 0x25, 0x6e, 0x09, 0x4d, 0x59, 0x4c, 0x83, 0x12, 0xf2, 0x3e, 0x4e, 0xf5,
+	0x42, 0xe0, 0x7d, 0xf1, 0xd5, 0x27, 0xe5, 0x46, 0x3a, 0xa4, 0xd0, 0x31, 0xcb, 0xab, 0x1a, 0xc8,
+	0xe2, 0xe5, 0x62, 0x8a, 0x29, 0xca, 0xcd, 0x94, 0x58, 0xc1, 0xee, 0x77, 0x16, 0x04, 0x04, 0xbb,
+	0x1f, 0x53, 0xc1, 0x50, 0x16, 0x52, 0x60, 0x30, 0xc2, 0x0e, 0x1c, 0xeb, 0xc8, 0xe7, 0xcb, 0xa5,
+	0x1e, 0xc9, 0xaf, 0x2e, 0xb2, 0xd7, 0x93, 0x23, 0x74, 0x44, 0x86, 0xbc, 0x9c, 0x79, 0x8f, 0xc8,
+	0x50, 0x50, 0x5a, 0x50, 0xee, 0xf4, 0x81, 0x19, 0x84, 0xe9, 0xdd, 0x29, 0x71, 0x41, 0x98, 0x1e,
+	0x9b, 0xc9, 0x57, 0x9e, 0x4e, 0x85, 0x13, 0x75, 0x84, 0xce, 0x6c, 0xb2, 0xd8, 0x11, 0x62, 0xb2,
+	0xef, 0xca, 0x8d, 0x74, 0x48, 0xe1, 0x77, 0x35, 0x37, 0x25, 0x2c, 0x7a, 0x57, 0x8b, 0xd2, 0xdb,
+	0xca, 0xed, 0xd4, 0x78, 0x94, 0x17, 0x65, 0xe8, 0x11, 0xf9, 0x17, 0xbf, 0xcb, 0xfa, 0x3b, 0x1f,
+	0x4e, 0x4b, 0xef, 0x7e, 0x38, 0x2d, 0xfd, 0xf3, 0xc3, 0x69, 0xe9, 0x8d, 0xc7, 0xd3, 0x47, 0xde,
+	0x7d, 0x3c, 0x7d, 0xe4, 0xef, 0x8f, 0xa7, 0x8f, 0xc0, 0x69, 0xc3, 0xe2, 0xae, 0x7d, 0x5f, 0xfa,
+	0x72, 0xb8, 0x1b, 0x33, 0x00, 0x99, 0x37, 0xac, 0xd0, 0x57, 0x69, 0xcf, 0xfb, 0x7f, 0x5e, 0xdc,
+	0xb6, 0xcc, 0xcd, 0x61, 0xb7, 0x24, 0xfc, 0xf4, 0xff, 0x06, 0x00, 0xcf, 0xa8, 0xeb, 0xb6, 0x61,
+	0x47, 0x00, 0x00,
+}
+
+func (this *MsgSupplyIncreaseProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetAccountData(ctx, in)
+
+	that1, ok := that.(*MsgSupplyIncreaseProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgSupplyIncreaseProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SetAccountData",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetAccountData(ctx, req.(*MsgSetAccountDataRequest))
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if this.TargetAddress != that1.TargetAddress {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
 }
+func (this *MsgSupplyDecreaseProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_UpdateSendDenyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateSendDenyListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgSupplyDecreaseProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgSupplyDecreaseProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateSendDenyList(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/UpdateSendDenyList",
+	if !this.Amount.Equal(that1.Amount) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateSendDenyList(ctx, req.(*MsgUpdateSendDenyListRequest))
+	if this.Authority != that1.Authority {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *MsgUpdateRequiredAttributesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_AddNetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddNetAssetValuesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgUpdateRequiredAttributesRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateRequiredAttributesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddNetAssetValues(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/AddNetAssetValues",
+	if this.Denom != that1.Denom {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddNetAssetValues(ctx, req.(*MsgAddNetAssetValuesRequest))
+	if len(this.RemoveRequiredAttributes) != len(that1.RemoveRequiredAttributes) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Msg_SetAdministratorProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetAdministratorProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	for i := range this.RemoveRequiredAttributes {
+		if this.RemoveRequiredAttributes[i] != that1.RemoveRequiredAttributes[i] {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetAdministratorProposal(ctx, in)
+	if len(this.AddRequiredAttributes) != len(that1.AddRequiredAttributes) {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SetAdministratorProposal",
+	for i := range this.AddRequiredAttributes {
+		if this.AddRequiredAttributes[i] != that1.AddRequiredAttributes[i] {
+			return false
+		}
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetAdministratorProposal(ctx, req.(*MsgSetAdministratorProposalRequest))
+	if this.TransferAuthority != that1.TransferAuthority {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *MsgUpdateForcedTransferRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_RemoveAdministratorProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveAdministratorProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgUpdateForcedTransferRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateForcedTransferRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveAdministratorProposal(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/RemoveAdministratorProposal",
+	if this.Denom != that1.Denom {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveAdministratorProposal(ctx, req.(*MsgRemoveAdministratorProposalRequest))
+	if this.AllowForcedTransfer != that1.AllowForcedTransfer {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
 }
+func (this *MsgUpdateRequireDepositAccessRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_ChangeStatusProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChangeStatusProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgUpdateRequireDepositAccessRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateRequireDepositAccessRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangeStatusProposal(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/ChangeStatusProposal",
+	if this.Denom != that1.Denom {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangeStatusProposal(ctx, req.(*MsgChangeStatusProposalRequest))
+	if this.RequireDepositAccess != that1.RequireDepositAccess {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if this.Signer != that1.Signer {
+		return false
+	}
+	return true
 }
+func (this *MsgSetAccountDataRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_WithdrawEscrowProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawEscrowProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgSetAccountDataRequest)
+	if !ok {
+		that2, ok := that.(MsgSetAccountDataRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawEscrowProposal(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/WithdrawEscrowProposal",
+	if this.Denom != that1.Denom {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawEscrowProposal(ctx, req.(*MsgWithdrawEscrowProposalRequest))
+	if this.Value != that1.Value {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if this.Signer != that1.Signer {
+		return false
+	}
+	return true
 }
+func (this *MsgUpdateSendDenyListRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_SetDenomMetadataProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetDenomMetadataProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgUpdateSendDenyListRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateSendDenyListRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetDenomMetadataProposal(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SetDenomMetadataProposal",
+	if this.Denom != that1.Denom {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetDenomMetadataProposal(ctx, req.(*MsgSetDenomMetadataProposalRequest))
+	if len(this.RemoveDeniedAddresses) != len(that1.RemoveDeniedAddresses) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	for i := range this.RemoveDeniedAddresses {
+		if this.RemoveDeniedAddresses[i] != that1.RemoveDeniedAddresses[i] {
+			return false
+		}
+	}
+	if len(this.AddDeniedAddresses) != len(that1.AddDeniedAddresses) {
+		return false
+	}
+	for i := range this.AddDeniedAddresses {
+		if this.AddDeniedAddresses[i] != that1.AddDeniedAddresses[i] {
+			return false
+		}
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
 }
+func (this *MsgSetAdministratorProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgSetAdministratorProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgSetAdministratorProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/UpdateParams",
+	if this.Denom != that1.Denom {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
+	if len(this.Access) != len(that1.Access) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	for i := range this.Access {
+		if !this.Access[i].Equal(&that1.Access[i]) {
+			return false
+		}
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
 }
+func (this *MsgRemoveAdministratorProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_RevokeGrantAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokeGrantAllowanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgRemoveAdministratorProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgRemoveAdministratorProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevokeGrantAllowance(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/RevokeGrantAllowance",
+	if this.Denom != that1.Denom {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokeGrantAllowance(ctx, req.(*MsgRevokeGrantAllowanceRequest))
+	if len(this.RemovedAddress) != len(that1.RemovedAddress) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	for i := range this.RemovedAddress {
+		if this.RemovedAddress[i] != that1.RemovedAddress[i] {
+			return false
+		}
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
 }
+func (this *MsgChangeStatusProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.marker.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Finalize",
-			Handler:    _Msg_Finalize_Handler,
-		},
-		{
-			MethodName: "Activate",
-			Handler:    _Msg_Activate_Handler,
-		},
-		{
-			MethodName: "Cancel",
-			Handler:    _Msg_Cancel_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _Msg_Delete_Handler,
-		},
-		{
-			MethodName: "Mint",
-			Handler:    _Msg_Mint_Handler,
-		},
-		{
-			MethodName: "Burn",
-			Handler:    _Msg_Burn_Handler,
-		},
-		{
-			MethodName: "AddAccess",
-			Handler:    _Msg_AddAccess_Handler,
-		},
-		{
-			MethodName: "DeleteAccess",
-			Handler:    _Msg_DeleteAccess_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-		{
-			MethodName: "AddMarker",
-			Handler:    _Msg_AddMarker_Handler,
-		},
-		{
-			MethodName: "Transfer",
-			Handler:    _Msg_Transfer_Handler,
-		},
-		{
-			MethodName: "IbcTransfer",
-			Handler:    _Msg_IbcTransfer_Handler,
-		},
-		{
-			MethodName: "SetDenomMetadata",
-			Handler:    _Msg_SetDenomMetadata_Handler,
-		},
-		{
-			MethodName: "GrantAllowance",
-			Handler:    _Msg_GrantAllowance_Handler,
-		},
-		{
-			MethodName: "AddFinalizeActivateMarker",
-			Handler:    _Msg_AddFinalizeActivateMarker_Handler,
-		},
-		{
-			MethodName: "SupplyIncreaseProposal",
-			Handler:    _Msg_SupplyIncreaseProposal_Handler,
-		},
-		{
-			MethodName: "SupplyDecreaseProposal",
-			Handler:    _Msg_SupplyDecreaseProposal_Handler,
-		},
-		{
-			MethodName: "UpdateRequiredAttributes",
-			Handler:    _Msg_UpdateRequiredAttributes_Handler,
-		},
-		{
-			MethodName: "UpdateForcedTransfer",
-			Handler:    _Msg_UpdateForcedTransfer_Handler,
-		},
-		{
-			MethodName: "UpdateRequireDepositAccess",
-			Handler:    _Msg_UpdateRequireDepositAccess_Handler,
-		},
-		{
-			MethodName: "SetAccountData",
-			Handler:    _Msg_SetAccountData_Handler,
-		},
-		{
-			MethodName: "UpdateSendDenyList",
-			Handler:    _Msg_UpdateSendDenyList_Handler,
-		},
-		{
-			MethodName: "AddNetAssetValues",
-			Handler:    _Msg_AddNetAssetValues_Handler,
-		},
-		{
-			MethodName: "SetAdministratorProposal",
-			Handler:    _Msg_SetAdministratorProposal_Handler,
-		},
-		{
-			MethodName: "RemoveAdministratorProposal",
-			Handler:    _Msg_RemoveAdministratorProposal_Handler,
-		},
-		{
-			MethodName: "ChangeStatusProposal",
-			Handler:    _Msg_ChangeStatusProposal_Handler,
-		},
-		{
-			MethodName: "WithdrawEscrowProposal",
-			Handler:    _Msg_WithdrawEscrowProposal_Handler,
-		},
-		{
-			MethodName: "SetDenomMetadataProposal",
-			Handler:    _Msg_SetDenomMetadataProposal_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "RevokeGrantAllowance",
-			Handler:    _Msg_RevokeGrantAllowance_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/marker/v1/tx.proto",
-}
-
-func (m *MsgGrantAllowanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgGrantAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgGrantAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		{
-			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	that1, ok := that.(*MsgChangeStatusProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgChangeStatusProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+	if this.Denom != that1.Denom {
+		return false
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	if this.NewStatus != that1.NewStatus {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgGrantAllowanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Authority != that1.Authority {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgGrantAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgGrantAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MsgAddMarkerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MsgWithdrawEscrowProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MsgAddMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequireDepositAccess {
-		i--
-		if m.RequireDepositAccess {
-			dAtA[i] = 1
+	that1, ok := that.(*MsgWithdrawEscrowProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgWithdrawEscrowProposalRequest)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x78
 	}
-	if m.UsdMills != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
-		i--
-		dAtA[i] = 0x70
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Volume != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Volume))
-		i--
-		dAtA[i] = 0x68
+	if this.Denom != that1.Denom {
+		return false
 	}
-	if m.UsdCents != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UsdCents))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.RequiredAttributes) > 0 {
-		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if m.AllowForcedTransfer {
-		i--
-		if m.AllowForcedTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.AllowGovernanceControl {
-		i--
-		if m.AllowGovernanceControl {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.SupplyFixed {
-		i--
-		if m.SupplyFixed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
+	if len(this.Amount) != len(that1.Amount) {
+		return false
 	}
-	if len(m.AccessList) > 0 {
-		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
 		}
 	}
-	if m.MarkerType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarkerType))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x1a
+	if this.TargetAddress != that1.TargetAddress {
+		return false
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if this.Authority != that1.Authority {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
 
-func (m *MsgAddMarkerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Finalize
+	Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error)
+	// Activate
+	Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error)
+	// Cancel
+	Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error)
+	// Delete
+	Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error)
+	// Mint
+	Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error)
+	// Burn
+	Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	// AddAccess
+	AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error)
+	// DeleteAccess
+	DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error)
+	// Withdraw
+	Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// AddMarker
+	AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error)
+	// Transfer marker denominated coin between accounts
+	Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error)
+	// Transfer over ibc any marker(including restricted markers) between ibc accounts.
+	// The relayer is still needed to accomplish ibc middleware relays.
+	IbcTransfer(ctx context.Context, in *MsgIbcTransferRequest, opts ...grpc.CallOption) (*MsgIbcTransferResponse, error)
+	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	// GrantAllowance grants fee allowance to the grantee on the granter's
+	// account with the provided expiration time.
+	GrantAllowance(ctx context.Context, in *MsgGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error)
+	// AddFinalizeActivateMarker
+	AddFinalizeActivateMarker(ctx context.Context, in *MsgAddFinalizeActivateMarkerRequest, opts ...grpc.CallOption) (*MsgAddFinalizeActivateMarkerResponse, error)
+	// SupplyIncreaseProposal can only be called via gov proposal
+	SupplyIncreaseProposal(ctx context.Context, in *MsgSupplyIncreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyIncreaseProposalResponse, error)
+	// SupplyDecreaseProposal can only be called via gov proposal
+	SupplyDecreaseProposal(ctx context.Context, in *MsgSupplyDecreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyDecreaseProposalResponse, error)
+	// UpdateRequiredAttributes will only succeed if signer has transfer authority
+	UpdateRequiredAttributes(ctx context.Context, in *MsgUpdateRequiredAttributesRequest, opts ...grpc.CallOption) (*MsgUpdateRequiredAttributesResponse, error)
+	// UpdateForcedTransfer updates the allow_forced_transfer field of a marker via governance proposal.
+	UpdateForcedTransfer(ctx context.Context, in *MsgUpdateForcedTransferRequest, opts ...grpc.CallOption) (*MsgUpdateForcedTransferResponse, error)
+	// UpdateRequireDepositAccess updates the require_deposit_access field of a marker. Signer must have admin access or
+	// be the governance module account.
+	UpdateRequireDepositAccess(ctx context.Context, in *MsgUpdateRequireDepositAccessRequest, opts ...grpc.CallOption) (*MsgUpdateRequireDepositAccessResponse, error)
+	// SetAccountData sets the accountdata for a denom. Signer must have deposit authority.
+	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
+	// UpdateSendDenyList will only succeed if signer has admin authority
+	UpdateSendDenyList(ctx context.Context, in *MsgUpdateSendDenyListRequest, opts ...grpc.CallOption) (*MsgUpdateSendDenyListResponse, error)
+	// AddNetAssetValues sets the net asset value for a marker.
+	AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error)
+	// SetAdministratorProposal sets administrators with specific access on the marker
+	SetAdministratorProposal(ctx context.Context, in *MsgSetAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgSetAdministratorProposalResponse, error)
+	// RemoveAdministratorProposal removes administrators with specific access on the marker
+	RemoveAdministratorProposal(ctx context.Context, in *MsgRemoveAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgRemoveAdministratorProposalResponse, error)
+	// ChangeStatusProposal is a governance proposal change marker status
+	ChangeStatusProposal(ctx context.Context, in *MsgChangeStatusProposalRequest, opts ...grpc.CallOption) (*MsgChangeStatusProposalResponse, error)
+	// WithdrawEscrowProposal is a governance proposal to withdraw escrow coins from a marker
+	WithdrawEscrowProposal(ctx context.Context, in *MsgWithdrawEscrowProposalRequest, opts ...grpc.CallOption) (*MsgWithdrawEscrowProposalResponse, error)
+	// SetDenomMetadataProposal is a governance proposal to set marker metadata
+	SetDenomMetadataProposal(ctx context.Context, in *MsgSetDenomMetadataProposalRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataProposalResponse, error)
+	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RevokeGrantAllowance revokes a fee allowance granted by a admin to a grantee.
+	RevokeGrantAllowance(ctx context.Context, in *MsgRevokeGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgRevokeGrantAllowanceResponse, error)
+	// FreezeHolder freezes an amount of a restricted marker's coin in a holder's account. Signer must have freeze access.
+	FreezeHolder(ctx context.Context, in *MsgFreezeHolderRequest, opts ...grpc.CallOption) (*MsgFreezeHolderResponse, error)
+	// UnfreezeHolder removes a freeze from a holder's account. Signer must have freeze access.
+	UnfreezeHolder(ctx context.Context, in *MsgUnfreezeHolderRequest, opts ...grpc.CallOption) (*MsgUnfreezeHolderResponse, error)
+	// DistributeToHolders distributes a payout pro-rata to all holders of a marker's coin. Signer must have admin access.
+	DistributeToHolders(ctx context.Context, in *MsgDistributeToHoldersRequest, opts ...grpc.CallOption) (*MsgDistributeToHoldersResponse, error)
+	// ClaimDistribution claims a distribution payment that could not be sent directly to the holder.
+	ClaimDistribution(ctx context.Context, in *MsgClaimDistributionRequest, opts ...grpc.CallOption) (*MsgClaimDistributionResponse, error)
+	// SetRedemptionPayoutDenom sets the denom that a marker's redemptions are paid out in. Signer must have admin access.
+	SetRedemptionPayoutDenom(ctx context.Context, in *MsgSetRedemptionPayoutDenomRequest, opts ...grpc.CallOption) (*MsgSetRedemptionPayoutDenomResponse, error)
+	// RequestRedemption escrows some of a holder's marker coins in the marker module account until the redemption is processed.
+	RequestRedemption(ctx context.Context, in *MsgRequestRedemptionRequest, opts ...grpc.CallOption) (*MsgRequestRedemptionResponse, error)
+	// FulfillRedemption burns the escrowed coins of a redemption and pays out the holder. Signer must have burn access.
+	FulfillRedemption(ctx context.Context, in *MsgFulfillRedemptionRequest, opts ...grpc.CallOption) (*MsgFulfillRedemptionResponse, error)
+	// RejectRedemption returns the escrowed coins of a redemption to the holder. Signer must have admin access.
+	RejectRedemption(ctx context.Context, in *MsgRejectRedemptionRequest, opts ...grpc.CallOption) (*MsgRejectRedemptionResponse, error)
+	// SetTransferLimits sets the rolling 24 hour transfer limits of a restricted marker. Signer must have admin access.
+	SetTransferLimits(ctx context.Context, in *MsgSetTransferLimitsRequest, opts ...grpc.CallOption) (*MsgSetTransferLimitsResponse, error)
+	// SetAccessGrantTerms sets the expiry and limits of an address's access grant on a marker. Signer must have admin
+	// access.
+	SetAccessGrantTerms(ctx context.Context, in *MsgSetAccessGrantTermsRequest, opts ...grpc.CallOption) (*MsgSetAccessGrantTermsResponse, error)
+	// SetApprovalThreshold sets the number of approvals needed for mints, withdrawals or forced transfers of a marker.
+	// Signer must have admin access.
+	SetApprovalThreshold(ctx context.Context, in *MsgSetApprovalThresholdRequest, opts ...grpc.CallOption) (*MsgSetApprovalThresholdResponse, error)
+	// ApproveOperation approves a pending operation, executing it once it has enough approvals.
+	ApproveOperation(ctx context.Context, in *MsgApproveOperationRequest, opts ...grpc.CallOption) (*MsgApproveOperationResponse, error)
+	// CancelOperation removes a pending operation. Signer must be the proposer or have admin access.
+	CancelOperation(ctx context.Context, in *MsgCancelOperationRequest, opts ...grpc.CallOption) (*MsgCancelOperationResponse, error)
+	// SetAttributeRequirements sets the attribute value conditions that the recipients of a restricted marker's coins
+	// must meet. Signer must have transfer access or be the governance module account address.
+	SetAttributeRequirements(ctx context.Context, in *MsgSetAttributeRequirementsRequest, opts ...grpc.CallOption) (*MsgSetAttributeRequirementsResponse, error)
+	// TakeHolderSnapshot starts recording the current cap table of a marker so it can be queried later.
+	// Signer must have admin or transfer access on the marker.
+	TakeHolderSnapshot(ctx context.Context, in *MsgTakeHolderSnapshotRequest, opts ...grpc.CallOption) (*MsgTakeHolderSnapshotResponse, error)
+	// SetIssuanceSchedule sets the hard cap and the schedule of amounts that can be minted over time for a marker.
+	// Signer must have admin access or be the governance module account address.
+	SetIssuanceSchedule(ctx context.Context, in *MsgSetIssuanceScheduleRequest, opts ...grpc.CallOption) (*MsgSetIssuanceScheduleResponse, error)
+	// SetNetAssetValueMaxAge sets the number of blocks after which a marker's net asset values are stale.
+	// Signer must have admin access or be the governance module account address.
+	SetNetAssetValueMaxAge(ctx context.Context, in *MsgSetNetAssetValueMaxAgeRequest, opts ...grpc.CallOption) (*MsgSetNetAssetValueMaxAgeResponse, error)
 }
 
-func (m *MsgAddMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgAddMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgAddAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error) {
+	out := new(MsgFinalizeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Finalize", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgAddAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Access) > 0 {
-		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error) {
+	out := new(MsgActivateResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Activate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error) {
+	out := new(MsgCancelResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Cancel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDeleteAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error) {
+	out := new(MsgDeleteResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Delete", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error) {
+	out := new(MsgMintResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Mint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RemovedAddress) > 0 {
-		i -= len(m.RemovedAddress)
-		copy(dAtA[i:], m.RemovedAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
+	out := new(MsgBurnResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Burn", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error) {
+	out := new(MsgAddAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddAccess", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error) {
+	out := new(MsgDeleteAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/DeleteAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Withdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFinalizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error) {
+	out := new(MsgAddMarkerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddMarker", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgFinalizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error) {
+	out := new(MsgTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFinalizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) IbcTransfer(ctx context.Context, in *MsgIbcTransferRequest, opts ...grpc.CallOption) (*MsgIbcTransferResponse, error) {
+	out := new(MsgIbcTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/IbcTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgFinalizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomMetadata", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgFinalizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GrantAllowance(ctx context.Context, in *MsgGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error) {
+	out := new(MsgGrantAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/GrantAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFinalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) AddFinalizeActivateMarker(ctx context.Context, in *MsgAddFinalizeActivateMarkerRequest, opts ...grpc.CallOption) (*MsgAddFinalizeActivateMarkerResponse, error) {
+	out := new(MsgAddFinalizeActivateMarkerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddFinalizeActivateMarker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgActivateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SupplyIncreaseProposal(ctx context.Context, in *MsgSupplyIncreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyIncreaseProposalResponse, error) {
+	out := new(MsgSupplyIncreaseProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SupplyIncreaseProposal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgActivateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SupplyDecreaseProposal(ctx context.Context, in *MsgSupplyDecreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyDecreaseProposalResponse, error) {
+	out := new(MsgSupplyDecreaseProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SupplyDecreaseProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgActivateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateRequiredAttributes(ctx context.Context, in *MsgUpdateRequiredAttributesRequest, opts ...grpc.CallOption) (*MsgUpdateRequiredAttributesResponse, error) {
+	out := new(MsgUpdateRequiredAttributesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateRequiredAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgActivateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateForcedTransfer(ctx context.Context, in *MsgUpdateForcedTransferRequest, opts ...grpc.CallOption) (*MsgUpdateForcedTransferResponse, error) {
+	out := new(MsgUpdateForcedTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateForcedTransfer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgActivateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateRequireDepositAccess(ctx context.Context, in *MsgUpdateRequireDepositAccessRequest, opts ...grpc.CallOption) (*MsgUpdateRequireDepositAccessResponse, error) {
+	out := new(MsgUpdateRequireDepositAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateRequireDepositAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error) {
+	out := new(MsgSetAccountDataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAccountData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCancelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateSendDenyList(ctx context.Context, in *MsgUpdateSendDenyListRequest, opts ...grpc.CallOption) (*MsgUpdateSendDenyListResponse, error) {
+	out := new(MsgUpdateSendDenyListResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateSendDenyList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error) {
+	out := new(MsgAddNetAssetValuesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddNetAssetValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetAdministratorProposal(ctx context.Context, in *MsgSetAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgSetAdministratorProposalResponse, error) {
+	out := new(MsgSetAdministratorProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAdministratorProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCancelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveAdministratorProposal(ctx context.Context, in *MsgRemoveAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgRemoveAdministratorProposalResponse, error) {
+	out := new(MsgRemoveAdministratorProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RemoveAdministratorProposal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ChangeStatusProposal(ctx context.Context, in *MsgChangeStatusProposalRequest, opts ...grpc.CallOption) (*MsgChangeStatusProposalResponse, error) {
+	out := new(MsgChangeStatusProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ChangeStatusProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) WithdrawEscrowProposal(ctx context.Context, in *MsgWithdrawEscrowProposalRequest, opts ...grpc.CallOption) (*MsgWithdrawEscrowProposalResponse, error) {
+	out := new(MsgWithdrawEscrowProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/WithdrawEscrowProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetDenomMetadataProposal(ctx context.Context, in *MsgSetDenomMetadataProposalRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataProposalResponse, error) {
+	out := new(MsgSetDenomMetadataProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomMetadataProposal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RevokeGrantAllowance(ctx context.Context, in *MsgRevokeGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgRevokeGrantAllowanceResponse, error) {
+	out := new(MsgRevokeGrantAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RevokeGrantAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FreezeHolder(ctx context.Context, in *MsgFreezeHolderRequest, opts ...grpc.CallOption) (*MsgFreezeHolderResponse, error) {
+	out := new(MsgFreezeHolderResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/FreezeHolder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UnfreezeHolder(ctx context.Context, in *MsgUnfreezeHolderRequest, opts ...grpc.CallOption) (*MsgUnfreezeHolderResponse, error) {
+	out := new(MsgUnfreezeHolderResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UnfreezeHolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) DistributeToHolders(ctx context.Context, in *MsgDistributeToHoldersRequest, opts ...grpc.CallOption) (*MsgDistributeToHoldersResponse, error) {
+	out := new(MsgDistributeToHoldersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/DistributeToHolders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgMintRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ClaimDistribution(ctx context.Context, in *MsgClaimDistributionRequest, opts ...grpc.CallOption) (*MsgClaimDistributionResponse, error) {
+	out := new(MsgClaimDistributionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ClaimDistribution", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgMintRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetRedemptionPayoutDenom(ctx context.Context, in *MsgSetRedemptionPayoutDenomRequest, opts ...grpc.CallOption) (*MsgSetRedemptionPayoutDenomResponse, error) {
+	out := new(MsgSetRedemptionPayoutDenomResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetRedemptionPayoutDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) RequestRedemption(ctx context.Context, in *MsgRequestRedemptionRequest, opts ...grpc.CallOption) (*MsgRequestRedemptionResponse, error) {
+	out := new(MsgRequestRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RequestRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FulfillRedemption(ctx context.Context, in *MsgFulfillRedemptionRequest, opts ...grpc.CallOption) (*MsgFulfillRedemptionResponse, error) {
+	out := new(MsgFulfillRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/FulfillRedemption", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RejectRedemption(ctx context.Context, in *MsgRejectRedemptionRequest, opts ...grpc.CallOption) (*MsgRejectRedemptionResponse, error) {
+	out := new(MsgRejectRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RejectRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SetTransferLimits(ctx context.Context, in *MsgSetTransferLimitsRequest, opts ...grpc.CallOption) (*MsgSetTransferLimitsResponse, error) {
+	out := new(MsgSetTransferLimitsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetTransferLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgBurnRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetAccessGrantTerms(ctx context.Context, in *MsgSetAccessGrantTermsRequest, opts ...grpc.CallOption) (*MsgSetAccessGrantTermsResponse, error) {
+	out := new(MsgSetAccessGrantTermsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAccessGrantTerms", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgBurnRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetApprovalThreshold(ctx context.Context, in *MsgSetApprovalThresholdRequest, opts ...grpc.CallOption) (*MsgSetApprovalThresholdResponse, error) {
+	out := new(MsgSetApprovalThresholdResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetApprovalThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgBurnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) ApproveOperation(ctx context.Context, in *MsgApproveOperationRequest, opts ...grpc.CallOption) (*MsgApproveOperationResponse, error) {
+	out := new(MsgApproveOperationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ApproveOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return out, nil
+}
+
+func (c *msgClient) CancelOperation(ctx context.Context, in *MsgCancelOperationRequest, opts ...grpc.CallOption) (*MsgCancelOperationResponse, error) {
+	out := new(MsgCancelOperationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/CancelOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetAttributeRequirements(ctx context.Context, in *MsgSetAttributeRequirementsRequest, opts ...grpc.CallOption) (*MsgSetAttributeRequirementsResponse, error) {
+	out := new(MsgSetAttributeRequirementsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAttributeRequirements", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TakeHolderSnapshot(ctx context.Context, in *MsgTakeHolderSnapshotRequest, opts ...grpc.CallOption) (*MsgTakeHolderSnapshotResponse, error) {
+	out := new(MsgTakeHolderSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/TakeHolderSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgWithdrawRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetIssuanceSchedule(ctx context.Context, in *MsgSetIssuanceScheduleRequest, opts ...grpc.CallOption) (*MsgSetIssuanceScheduleResponse, error) {
+	out := new(MsgSetIssuanceScheduleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetIssuanceSchedule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EventTag) > 0 {
-		i -= len(m.EventTag)
-		copy(dAtA[i:], m.EventTag)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EventTag)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetNetAssetValueMaxAge(ctx context.Context, in *MsgSetNetAssetValueMaxAgeRequest, opts ...grpc.CallOption) (*MsgSetNetAssetValueMaxAgeResponse, error) {
+	out := new(MsgSetNetAssetValueMaxAgeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetNetAssetValueMaxAge", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Finalize
+	Finalize(context.Context, *MsgFinalizeRequest) (*MsgFinalizeResponse, error)
+	// Activate
+	Activate(context.Context, *MsgActivateRequest) (*MsgActivateResponse, error)
+	// Cancel
+	Cancel(context.Context, *MsgCancelRequest) (*MsgCancelResponse, error)
+	// Delete
+	Delete(context.Context, *MsgDeleteRequest) (*MsgDeleteResponse, error)
+	// Mint
+	Mint(context.Context, *MsgMintRequest) (*MsgMintResponse, error)
+	// Burn
+	Burn(context.Context, *MsgBurnRequest) (*MsgBurnResponse, error)
+	// AddAccess
+	AddAccess(context.Context, *MsgAddAccessRequest) (*MsgAddAccessResponse, error)
+	// DeleteAccess
+	DeleteAccess(context.Context, *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error)
+	// Withdraw
+	Withdraw(context.Context, *MsgWithdrawRequest) (*MsgWithdrawResponse, error)
+	// AddMarker
+	AddMarker(context.Context, *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error)
+	// Transfer marker denominated coin between accounts
+	Transfer(context.Context, *MsgTransferRequest) (*MsgTransferResponse, error)
+	// Transfer over ibc any marker(including restricted markers) between ibc accounts.
+	// The relayer is still needed to accomplish ibc middleware relays.
+	IbcTransfer(context.Context, *MsgIbcTransferRequest) (*MsgIbcTransferResponse, error)
+	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error)
+	// GrantAllowance grants fee allowance to the grantee on the granter's
+	// account with the provided expiration time.
+	GrantAllowance(context.Context, *MsgGrantAllowanceRequest) (*MsgGrantAllowanceResponse, error)
+	// AddFinalizeActivateMarker
+	AddFinalizeActivateMarker(context.Context, *MsgAddFinalizeActivateMarkerRequest) (*MsgAddFinalizeActivateMarkerResponse, error)
+	// SupplyIncreaseProposal can only be called via gov proposal
+	SupplyIncreaseProposal(context.Context, *MsgSupplyIncreaseProposalRequest) (*MsgSupplyIncreaseProposalResponse, error)
+	// SupplyDecreaseProposal can only be called via gov proposal
+	SupplyDecreaseProposal(context.Context, *MsgSupplyDecreaseProposalRequest) (*MsgSupplyDecreaseProposalResponse, error)
+	// UpdateRequiredAttributes will only succeed if signer has transfer authority
+	UpdateRequiredAttributes(context.Context, *MsgUpdateRequiredAttributesRequest) (*MsgUpdateRequiredAttributesResponse, error)
+	// UpdateForcedTransfer updates the allow_forced_transfer field of a marker via governance proposal.
+	UpdateForcedTransfer(context.Context, *MsgUpdateForcedTransferRequest) (*MsgUpdateForcedTransferResponse, error)
+	// UpdateRequireDepositAccess updates the require_deposit_access field of a marker. Signer must have admin access or
+	// be the governance module account.
+	UpdateRequireDepositAccess(context.Context, *MsgUpdateRequireDepositAccessRequest) (*MsgUpdateRequireDepositAccessResponse, error)
+	// SetAccountData sets the accountdata for a denom. Signer must have deposit authority.
+	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
+	// UpdateSendDenyList will only succeed if signer has admin authority
+	UpdateSendDenyList(context.Context, *MsgUpdateSendDenyListRequest) (*MsgUpdateSendDenyListResponse, error)
+	// AddNetAssetValues sets the net asset value for a marker.
+	AddNetAssetValues(context.Context, *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error)
+	// SetAdministratorProposal sets administrators with specific access on the marker
+	SetAdministratorProposal(context.Context, *MsgSetAdministratorProposalRequest) (*MsgSetAdministratorProposalResponse, error)
+	// RemoveAdministratorProposal removes administrators with specific access on the marker
+	RemoveAdministratorProposal(context.Context, *MsgRemoveAdministratorProposalRequest) (*MsgRemoveAdministratorProposalResponse, error)
+	// ChangeStatusProposal is a governance proposal change marker status
+	ChangeStatusProposal(context.Context, *MsgChangeStatusProposalRequest) (*MsgChangeStatusProposalResponse, error)
+	// WithdrawEscrowProposal is a governance proposal to withdraw escrow coins from a marker
+	WithdrawEscrowProposal(context.Context, *MsgWithdrawEscrowProposalRequest) (*MsgWithdrawEscrowProposalResponse, error)
+	// SetDenomMetadataProposal is a governance proposal to set marker metadata
+	SetDenomMetadataProposal(context.Context, *MsgSetDenomMetadataProposalRequest) (*MsgSetDenomMetadataProposalResponse, error)
+	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
+	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+	// RevokeGrantAllowance revokes a fee allowance granted by a admin to a grantee.
+	RevokeGrantAllowance(context.Context, *MsgRevokeGrantAllowanceRequest) (*MsgRevokeGrantAllowanceResponse, error)
+	// FreezeHolder freezes an amount of a restricted marker's coin in a holder's account. Signer must have freeze access.
+	FreezeHolder(context.Context, *MsgFreezeHolderRequest) (*MsgFreezeHolderResponse, error)
+	// UnfreezeHolder removes a freeze from a holder's account. Signer must have freeze access.
+	UnfreezeHolder(context.Context, *MsgUnfreezeHolderRequest) (*MsgUnfreezeHolderResponse, error)
+	// DistributeToHolders distributes a payout pro-rata to all holders of a marker's coin. Signer must have admin access.
+	DistributeToHolders(context.Context, *MsgDistributeToHoldersRequest) (*MsgDistributeToHoldersResponse, error)
+	// ClaimDistribution claims a distribution payment that could not be sent directly to the holder.
+	ClaimDistribution(context.Context, *MsgClaimDistributionRequest) (*MsgClaimDistributionResponse, error)
+	// SetRedemptionPayoutDenom sets the denom that a marker's redemptions are paid out in. Signer must have admin access.
+	SetRedemptionPayoutDenom(context.Context, *MsgSetRedemptionPayoutDenomRequest) (*MsgSetRedemptionPayoutDenomResponse, error)
+	// RequestRedemption escrows some of a holder's marker coins in the marker module account until the redemption is processed.
+	RequestRedemption(context.Context, *MsgRequestRedemptionRequest) (*MsgRequestRedemptionResponse, error)
+	// FulfillRedemption burns the escrowed coins of a redemption and pays out the holder. Signer must have burn access.
+	FulfillRedemption(context.Context, *MsgFulfillRedemptionRequest) (*MsgFulfillRedemptionResponse, error)
+	// RejectRedemption returns the escrowed coins of a redemption to the holder. Signer must have admin access.
+	RejectRedemption(context.Context, *MsgRejectRedemptionRequest) (*MsgRejectRedemptionResponse, error)
+	// SetTransferLimits sets the rolling 24 hour transfer limits of a restricted marker. Signer must have admin access.
+	SetTransferLimits(context.Context, *MsgSetTransferLimitsRequest) (*MsgSetTransferLimitsResponse, error)
+	// SetAccessGrantTerms sets the expiry and limits of an address's access grant on a marker. Signer must have admin
+	// access.
+	SetAccessGrantTerms(context.Context, *MsgSetAccessGrantTermsRequest) (*MsgSetAccessGrantTermsResponse, error)
+	// SetApprovalThreshold sets the number of approvals needed for mints, withdrawals or forced transfers of a marker.
+	// Signer must have admin access.
+	SetApprovalThreshold(context.Context, *MsgSetApprovalThresholdRequest) (*MsgSetApprovalThresholdResponse, error)
+	// ApproveOperation approves a pending operation, executing it once it has enough approvals.
+	ApproveOperation(context.Context, *MsgApproveOperationRequest) (*MsgApproveOperationResponse, error)
+	// CancelOperation removes a pending operation. Signer must be the proposer or have admin access.
+	CancelOperation(context.Context, *MsgCancelOperationRequest) (*MsgCancelOperationResponse, error)
+	// SetAttributeRequirements sets the attribute value conditions that the recipients of a restricted marker's coins
+	// must meet. Signer must have transfer access or be the governance module account address.
+	SetAttributeRequirements(context.Context, *MsgSetAttributeRequirementsRequest) (*MsgSetAttributeRequirementsResponse, error)
+	// TakeHolderSnapshot starts recording the current cap table of a marker so it can be queried later.
+	// Signer must have admin or transfer access on the marker.
+	TakeHolderSnapshot(context.Context, *MsgTakeHolderSnapshotRequest) (*MsgTakeHolderSnapshotResponse, error)
+	// SetIssuanceSchedule sets the hard cap and the schedule of amounts that can be minted over time for a marker.
+	// Signer must have admin access or be the governance module account address.
+	SetIssuanceSchedule(context.Context, *MsgSetIssuanceScheduleRequest) (*MsgSetIssuanceScheduleResponse, error)
+	// SetNetAssetValueMaxAge sets the number of blocks after which a marker's net asset values are stale.
+	// Signer must have admin access or be the governance module account address.
+	SetNetAssetValueMaxAge(context.Context, *MsgSetNetAssetValueMaxAgeRequest) (*MsgSetNetAssetValueMaxAgeResponse, error)
 }
 
-func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgTransferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) Finalize(ctx context.Context, req *MsgFinalizeRequest) (*MsgFinalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Finalize not implemented")
 }
-
-func (m *MsgTransferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) Activate(ctx context.Context, req *MsgActivateRequest) (*MsgActivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
 }
-
-func (m *MsgTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) Cancel(ctx context.Context, req *MsgCancelRequest) (*MsgCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
 }
-
-func (m *MsgTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) Delete(ctx context.Context, req *MsgDeleteRequest) (*MsgDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-
-func (m *MsgTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMintRequest) (*MsgMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
 }
-
-func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurnRequest) (*MsgBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
 }
-
-func (m *MsgIbcTransferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) AddAccess(ctx context.Context, req *MsgAddAccessRequest) (*MsgAddAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAccess not implemented")
 }
-
-func (m *MsgIbcTransferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) DeleteAccess(ctx context.Context, req *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccess not implemented")
 }
-
-func (m *MsgIbcTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Transfer.Size()
-		i -= size
-		if _, err := m.Transfer.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdrawRequest) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
-
-func (m *MsgIbcTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) AddMarker(ctx context.Context, req *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMarker not implemented")
 }
-
-func (m *MsgIbcTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransferRequest) (*MsgTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
 }
-
-func (m *MsgIbcTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) IbcTransfer(ctx context.Context, req *MsgIbcTransferRequest) (*MsgIbcTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IbcTransfer not implemented")
 }
-
-func (m *MsgSetDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrantAllowanceRequest) (*MsgGrantAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAllowance not implemented")
+}
+func (*UnimplementedMsgServer) AddFinalizeActivateMarker(ctx context.Context, req *MsgAddFinalizeActivateMarkerRequest) (*MsgAddFinalizeActivateMarkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFinalizeActivateMarker not implemented")
+}
+func (*UnimplementedMsgServer) SupplyIncreaseProposal(ctx context.Context, req *MsgSupplyIncreaseProposalRequest) (*MsgSupplyIncreaseProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyIncreaseProposal not implemented")
+}
+func (*UnimplementedMsgServer) SupplyDecreaseProposal(ctx context.Context, req *MsgSupplyDecreaseProposalRequest) (*MsgSupplyDecreaseProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyDecreaseProposal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRequiredAttributes(ctx context.Context, req *MsgUpdateRequiredAttributesRequest) (*MsgUpdateRequiredAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRequiredAttributes not implemented")
+}
+func (*UnimplementedMsgServer) UpdateForcedTransfer(ctx context.Context, req *MsgUpdateForcedTransferRequest) (*MsgUpdateForcedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateForcedTransfer not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRequireDepositAccess(ctx context.Context, req *MsgUpdateRequireDepositAccessRequest) (*MsgUpdateRequireDepositAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRequireDepositAccess not implemented")
+}
+func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountData not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSendDenyList(ctx context.Context, req *MsgUpdateSendDenyListRequest) (*MsgUpdateSendDenyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSendDenyList not implemented")
+}
+func (*UnimplementedMsgServer) AddNetAssetValues(ctx context.Context, req *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNetAssetValues not implemented")
+}
+func (*UnimplementedMsgServer) SetAdministratorProposal(ctx context.Context, req *MsgSetAdministratorProposalRequest) (*MsgSetAdministratorProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdministratorProposal not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAdministratorProposal(ctx context.Context, req *MsgRemoveAdministratorProposalRequest) (*MsgRemoveAdministratorProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAdministratorProposal not implemented")
+}
+func (*UnimplementedMsgServer) ChangeStatusProposal(ctx context.Context, req *MsgChangeStatusProposalRequest) (*MsgChangeStatusProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeStatusProposal not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawEscrowProposal(ctx context.Context, req *MsgWithdrawEscrowProposalRequest) (*MsgWithdrawEscrowProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawEscrowProposal not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomMetadataProposal(ctx context.Context, req *MsgSetDenomMetadataProposalRequest) (*MsgSetDenomMetadataProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadataProposal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RevokeGrantAllowance(ctx context.Context, req *MsgRevokeGrantAllowanceRequest) (*MsgRevokeGrantAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeGrantAllowance not implemented")
+}
+func (*UnimplementedMsgServer) FreezeHolder(ctx context.Context, req *MsgFreezeHolderRequest) (*MsgFreezeHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeHolder not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeHolder(ctx context.Context, req *MsgUnfreezeHolderRequest) (*MsgUnfreezeHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeHolder not implemented")
+}
+func (*UnimplementedMsgServer) DistributeToHolders(ctx context.Context, req *MsgDistributeToHoldersRequest) (*MsgDistributeToHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributeToHolders not implemented")
+}
+func (*UnimplementedMsgServer) ClaimDistribution(ctx context.Context, req *MsgClaimDistributionRequest) (*MsgClaimDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimDistribution not implemented")
+}
+func (*UnimplementedMsgServer) SetRedemptionPayoutDenom(ctx context.Context, req *MsgSetRedemptionPayoutDenomRequest) (*MsgSetRedemptionPayoutDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRedemptionPayoutDenom not implemented")
+}
+func (*UnimplementedMsgServer) RequestRedemption(ctx context.Context, req *MsgRequestRedemptionRequest) (*MsgRequestRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRedemption not implemented")
+}
+func (*UnimplementedMsgServer) FulfillRedemption(ctx context.Context, req *MsgFulfillRedemptionRequest) (*MsgFulfillRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillRedemption not implemented")
+}
+func (*UnimplementedMsgServer) RejectRedemption(ctx context.Context, req *MsgRejectRedemptionRequest) (*MsgRejectRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRedemption not implemented")
+}
+func (*UnimplementedMsgServer) SetTransferLimits(ctx context.Context, req *MsgSetTransferLimitsRequest) (*MsgSetTransferLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferLimits not implemented")
+}
+func (*UnimplementedMsgServer) SetAccessGrantTerms(ctx context.Context, req *MsgSetAccessGrantTermsRequest) (*MsgSetAccessGrantTermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccessGrantTerms not implemented")
+}
+func (*UnimplementedMsgServer) SetApprovalThreshold(ctx context.Context, req *MsgSetApprovalThresholdRequest) (*MsgSetApprovalThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetApprovalThreshold not implemented")
+}
+func (*UnimplementedMsgServer) ApproveOperation(ctx context.Context, req *MsgApproveOperationRequest) (*MsgApproveOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveOperation not implemented")
+}
+func (*UnimplementedMsgServer) CancelOperation(ctx context.Context, req *MsgCancelOperationRequest) (*MsgCancelOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
+func (*UnimplementedMsgServer) SetAttributeRequirements(ctx context.Context, req *MsgSetAttributeRequirementsRequest) (*MsgSetAttributeRequirementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttributeRequirements not implemented")
+}
+func (*UnimplementedMsgServer) TakeHolderSnapshot(ctx context.Context, req *MsgTakeHolderSnapshotRequest) (*MsgTakeHolderSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakeHolderSnapshot not implemented")
+}
+func (*UnimplementedMsgServer) SetIssuanceSchedule(ctx context.Context, req *MsgSetIssuanceScheduleRequest) (*MsgSetIssuanceScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIssuanceSchedule not implemented")
+}
+func (*UnimplementedMsgServer) SetNetAssetValueMaxAge(ctx context.Context, req *MsgSetNetAssetValueMaxAgeRequest) (*MsgSetNetAssetValueMaxAgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNetAssetValueMaxAge not implemented")
 }
 
-func (m *MsgSetDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgSetDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_Finalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.Metadata.Size()
-		i -= size
-		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).Finalize(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Finalize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Finalize(ctx, req.(*MsgFinalizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgActivateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSetDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).Activate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Activate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Activate(ctx, req.(*MsgActivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Cancel(ctx, req.(*MsgCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddFinalizeActivateMarkerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Delete(ctx, req.(*MsgDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddFinalizeActivateMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Mint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Mint(ctx, req.(*MsgMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddFinalizeActivateMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequireDepositAccess {
-		i--
-		if m.RequireDepositAccess {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.UsdMills != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
-		i--
-		dAtA[i] = 0x68
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Volume != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Volume))
-		i--
-		dAtA[i] = 0x60
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
 	}
-	if m.UsdCents != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UsdCents))
-		i--
-		dAtA[i] = 0x58
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Burn",
 	}
-	if len(m.RequiredAttributes) > 0 {
-		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x52
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurnRequest))
 	}
-	if m.AllowForcedTransfer {
-		i--
-		if m.AllowForcedTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AllowGovernanceControl {
-		i--
-		if m.AllowGovernanceControl {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
+	if interceptor == nil {
+		return srv.(MsgServer).AddAccess(ctx, in)
 	}
-	if m.SupplyFixed {
-		i--
-		if m.SupplyFixed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddAccess",
 	}
-	if len(m.AccessList) > 0 {
-		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAccess(ctx, req.(*MsgAddAccessRequest))
 	}
-	if m.MarkerType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarkerType))
-		i--
-		dAtA[i] = 0x28
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x22
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteAccess(ctx, in)
 	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x1a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/DeleteAccess",
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteAccess(ctx, req.(*MsgDeleteAccessRequest))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddFinalizeActivateMarkerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddFinalizeActivateMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddFinalizeActivateMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSupplyIncreaseProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMarkerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSupplyIncreaseProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).AddMarker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddMarker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMarker(ctx, req.(*MsgAddMarkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSupplyIncreaseProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TargetAddress) > 0 {
-		i -= len(m.TargetAddress)
-		copy(dAtA[i:], m.TargetAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).Transfer(ctx, in)
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Transfer",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Transfer(ctx, req.(*MsgTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSupplyIncreaseProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_IbcTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIbcTransferRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).IbcTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/IbcTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IbcTransfer(ctx, req.(*MsgIbcTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSupplyIncreaseProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSupplyIncreaseProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSupplyDecreaseProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadataRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSupplyDecreaseProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_GrantAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/GrantAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantAllowance(ctx, req.(*MsgGrantAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSupplyDecreaseProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_AddFinalizeActivateMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFinalizeActivateMarkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).AddFinalizeActivateMarker(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddFinalizeActivateMarker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFinalizeActivateMarker(ctx, req.(*MsgAddFinalizeActivateMarkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSupplyDecreaseProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SupplyIncreaseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSupplyIncreaseProposalRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SupplyIncreaseProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SupplyIncreaseProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SupplyIncreaseProposal(ctx, req.(*MsgSupplyIncreaseProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSupplyDecreaseProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SupplyDecreaseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSupplyDecreaseProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SupplyDecreaseProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SupplyDecreaseProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SupplyDecreaseProposal(ctx, req.(*MsgSupplyDecreaseProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSupplyDecreaseProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdateRequiredAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRequiredAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRequiredAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateRequiredAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRequiredAttributes(ctx, req.(*MsgUpdateRequiredAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRequiredAttributesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateForcedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateForcedTransferRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateForcedTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateForcedTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateForcedTransfer(ctx, req.(*MsgUpdateForcedTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRequiredAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_UpdateRequireDepositAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRequireDepositAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRequireDepositAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateRequireDepositAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRequireDepositAccess(ctx, req.(*MsgUpdateRequireDepositAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRequiredAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TransferAuthority) > 0 {
-		i -= len(m.TransferAuthority)
-		copy(dAtA[i:], m.TransferAuthority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferAuthority)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_SetAccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAccountDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.AddRequiredAttributes) > 0 {
-		for iNdEx := len(m.AddRequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AddRequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.AddRequiredAttributes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AddRequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAccountData(ctx, in)
 	}
-	if len(m.RemoveRequiredAttributes) > 0 {
-		for iNdEx := len(m.RemoveRequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemoveRequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.RemoveRequiredAttributes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveRequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetAccountData",
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAccountData(ctx, req.(*MsgSetAccountDataRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRequiredAttributesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateSendDenyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSendDenyListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSendDenyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateSendDenyList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSendDenyList(ctx, req.(*MsgUpdateSendDenyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRequiredAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateRequiredAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateForcedTransferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddNetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddNetAssetValuesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddNetAssetValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddNetAssetValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddNetAssetValues(ctx, req.(*MsgAddNetAssetValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateForcedTransferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SetAdministratorProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAdministratorProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAdministratorProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetAdministratorProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAdministratorProposal(ctx, req.(*MsgSetAdministratorProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateForcedTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_RemoveAdministratorProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAdministratorProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AllowForcedTransfer {
-		i--
-		if m.AllowForcedTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAdministratorProposal(ctx, in)
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/RemoveAdministratorProposal",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAdministratorProposal(ctx, req.(*MsgRemoveAdministratorProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateForcedTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ChangeStatusProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeStatusProposalRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeStatusProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ChangeStatusProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeStatusProposal(ctx, req.(*MsgChangeStatusProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateForcedTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_WithdrawEscrowProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawEscrowProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawEscrowProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/WithdrawEscrowProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawEscrowProposal(ctx, req.(*MsgWithdrawEscrowProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateForcedTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SetDenomMetadataProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadataProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadataProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetDenomMetadataProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadataProposal(ctx, req.(*MsgSetDenomMetadataProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRequireDepositAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRequireDepositAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_RevokeGrantAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeGrantAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeGrantAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/RevokeGrantAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeGrantAllowance(ctx, req.(*MsgRevokeGrantAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRequireDepositAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_FreezeHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.RequireDepositAccess {
-		i--
-		if m.RequireDepositAccess {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeHolder(ctx, in)
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/FreezeHolder",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeHolder(ctx, req.(*MsgFreezeHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRequireDepositAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UnfreezeHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeHolderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UnfreezeHolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeHolder(ctx, req.(*MsgUnfreezeHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRequireDepositAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DistributeToHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDistributeToHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DistributeToHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/DistributeToHolders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DistributeToHolders(ctx, req.(*MsgDistributeToHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRequireDepositAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_ClaimDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ClaimDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimDistribution(ctx, req.(*MsgClaimDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetRedemptionPayoutDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRedemptionPayoutDenomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetRedemptionPayoutDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetRedemptionPayoutDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRedemptionPayoutDenom(ctx, req.(*MsgSetRedemptionPayoutDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_RequestRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/RequestRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestRedemption(ctx, req.(*MsgRequestRedemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_FulfillRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillRedemption(ctx, in)
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/FulfillRedemption",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillRedemption(ctx, req.(*MsgFulfillRedemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RejectRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectRedemptionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RejectRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/RejectRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectRedemption(ctx, req.(*MsgRejectRedemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SetTransferLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTransferLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTransferLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetTransferLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTransferLimits(ctx, req.(*MsgSetTransferLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SetAccessGrantTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAccessGrantTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAccessGrantTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetAccessGrantTerms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAccessGrantTerms(ctx, req.(*MsgSetAccessGrantTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSendDenyListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetApprovalThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetApprovalThresholdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetApprovalThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetApprovalThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetApprovalThreshold(ctx, req.(*MsgSetApprovalThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSendDenyListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ApproveOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ApproveOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveOperation(ctx, req.(*MsgApproveOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSendDenyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.AddDeniedAddresses) > 0 {
-		for iNdEx := len(m.AddDeniedAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AddDeniedAddresses[iNdEx])
-			copy(dAtA[i:], m.AddDeniedAddresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AddDeniedAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOperation(ctx, in)
 	}
-	if len(m.RemoveDeniedAddresses) > 0 {
-		for iNdEx := len(m.RemoveDeniedAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemoveDeniedAddresses[iNdEx])
-			copy(dAtA[i:], m.RemoveDeniedAddresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveDeniedAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/CancelOperation",
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOperation(ctx, req.(*MsgCancelOperationRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSendDenyListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetAttributeRequirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAttributeRequirementsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetAttributeRequirements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetAttributeRequirements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAttributeRequirements(ctx, req.(*MsgSetAttributeRequirementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSendDenyListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_TakeHolderSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTakeHolderSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TakeHolderSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/TakeHolderSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TakeHolderSnapshot(ctx, req.(*MsgTakeHolderSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSendDenyListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SetIssuanceSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIssuanceScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIssuanceSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetIssuanceSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIssuanceSchedule(ctx, req.(*MsgSetIssuanceScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetNetAssetValueMaxAge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNetAssetValueMaxAgeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetNetAssetValueMaxAge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetNetAssetValueMaxAge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNetAssetValueMaxAge(ctx, req.(*MsgSetNetAssetValueMaxAgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.marker.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Finalize",
+			Handler:    _Msg_Finalize_Handler,
+		},
+		{
+			MethodName: "Activate",
+			Handler:    _Msg_Activate_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _Msg_Cancel_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Msg_Delete_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Msg_Mint_Handler,
+		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "AddAccess",
+			Handler:    _Msg_AddAccess_Handler,
+		},
+		{
+			MethodName: "DeleteAccess",
+			Handler:    _Msg_DeleteAccess_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "AddMarker",
+			Handler:    _Msg_AddMarker_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _Msg_Transfer_Handler,
+		},
+		{
+			MethodName: "IbcTransfer",
+			Handler:    _Msg_IbcTransfer_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "GrantAllowance",
+			Handler:    _Msg_GrantAllowance_Handler,
+		},
+		{
+			MethodName: "AddFinalizeActivateMarker",
+			Handler:    _Msg_AddFinalizeActivateMarker_Handler,
+		},
+		{
+			MethodName: "SupplyIncreaseProposal",
+			Handler:    _Msg_SupplyIncreaseProposal_Handler,
+		},
+		{
+			MethodName: "SupplyDecreaseProposal",
+			Handler:    _Msg_SupplyDecreaseProposal_Handler,
+		},
+		{
+			MethodName: "UpdateRequiredAttributes",
+			Handler:    _Msg_UpdateRequiredAttributes_Handler,
+		},
+		{
+			MethodName: "UpdateForcedTransfer",
+			Handler:    _Msg_UpdateForcedTransfer_Handler,
+		},
+		{
+			MethodName: "UpdateRequireDepositAccess",
+			Handler:    _Msg_UpdateRequireDepositAccess_Handler,
+		},
+		{
+			MethodName: "SetAccountData",
+			Handler:    _Msg_SetAccountData_Handler,
+		},
+		{
+			MethodName: "UpdateSendDenyList",
+			Handler:    _Msg_UpdateSendDenyList_Handler,
+		},
+		{
+			MethodName: "AddNetAssetValues",
+			Handler:    _Msg_AddNetAssetValues_Handler,
+		},
+		{
+			MethodName: "SetAdministratorProposal",
+			Handler:    _Msg_SetAdministratorProposal_Handler,
+		},
+		{
+			MethodName: "RemoveAdministratorProposal",
+			Handler:    _Msg_RemoveAdministratorProposal_Handler,
+		},
+		{
+			MethodName: "ChangeStatusProposal",
+			Handler:    _Msg_ChangeStatusProposal_Handler,
+		},
+		{
+			MethodName: "WithdrawEscrowProposal",
+			Handler:    _Msg_WithdrawEscrowProposal_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadataProposal",
+			Handler:    _Msg_SetDenomMetadataProposal_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RevokeGrantAllowance",
+			Handler:    _Msg_RevokeGrantAllowance_Handler,
+		},
+		{
+			MethodName: "FreezeHolder",
+			Handler:    _Msg_FreezeHolder_Handler,
+		},
+		{
+			MethodName: "UnfreezeHolder",
+			Handler:    _Msg_UnfreezeHolder_Handler,
+		},
+		{
+			MethodName: "DistributeToHolders",
+			Handler:    _Msg_DistributeToHolders_Handler,
+		},
+		{
+			MethodName: "ClaimDistribution",
+			Handler:    _Msg_ClaimDistribution_Handler,
+		},
+		{
+			MethodName: "SetRedemptionPayoutDenom",
+			Handler:    _Msg_SetRedemptionPayoutDenom_Handler,
+		},
+		{
+			MethodName: "RequestRedemption",
+			Handler:    _Msg_RequestRedemption_Handler,
+		},
+		{
+			MethodName: "FulfillRedemption",
+			Handler:    _Msg_FulfillRedemption_Handler,
+		},
+		{
+			MethodName: "RejectRedemption",
+			Handler:    _Msg_RejectRedemption_Handler,
+		},
+		{
+			MethodName: "SetTransferLimits",
+			Handler:    _Msg_SetTransferLimits_Handler,
+		},
+		{
+			MethodName: "SetAccessGrantTerms",
+			Handler:    _Msg_SetAccessGrantTerms_Handler,
+		},
+		{
+			MethodName: "SetApprovalThreshold",
+			Handler:    _Msg_SetApprovalThreshold_Handler,
+		},
+		{
+			MethodName: "ApproveOperation",
+			Handler:    _Msg_ApproveOperation_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _Msg_CancelOperation_Handler,
+		},
+		{
+			MethodName: "SetAttributeRequirements",
+			Handler:    _Msg_SetAttributeRequirements_Handler,
+		},
+		{
+			MethodName: "TakeHolderSnapshot",
+			Handler:    _Msg_TakeHolderSnapshot_Handler,
+		},
+		{
+			MethodName: "SetIssuanceSchedule",
+			Handler:    _Msg_SetIssuanceSchedule_Handler,
+		},
+		{
+			MethodName: "SetNetAssetValueMaxAge",
+			Handler:    _Msg_SetNetAssetValueMaxAge_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/marker/v1/tx.proto",
+}
 
-func (m *MsgAddNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgGrantAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x1a
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Administrator) > 0 {
 		i -= len(m.Administrator)
@@ -6686,7 +7587,7 @@ func (m *MsgAddNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgGrantAllowanceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6696,12 +7597,12 @@ func (m *MsgAddNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgGrantAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgGrantAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6709,7 +7610,7 @@ func (m *MsgAddNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetAdministratorProposalRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddMarkerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6719,48 +7620,132 @@ func (m *MsgSetAdministratorProposalRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetAdministratorProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetAdministratorProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.RequireDepositAccess {
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Access) > 0 {
-		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+		if m.RequireDepositAccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x78
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+	if m.UsdMills != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x70
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSetAdministratorProposalResponse) Marshal() (dAtA []byte, err error) {
+	if m.Volume != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.UsdCents != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdCents))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MarkerType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMarkerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6770,12 +7755,12 @@ func (m *MsgSetAdministratorProposalResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetAdministratorProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetAdministratorProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6783,7 +7768,7 @@ func (m *MsgSetAdministratorProposalResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveAdministratorProposalRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddAccessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6793,32 +7778,37 @@ func (m *MsgRemoveAdministratorProposalRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveAdministratorProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddAccessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveAdministratorProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RemovedAddress) > 0 {
-		for iNdEx := len(m.RemovedAddress) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemovedAddress[iNdEx])
-			copy(dAtA[i:], m.RemovedAddress[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddress[iNdEx])))
+	if len(m.Access) > 0 {
+		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -6829,7 +7819,7 @@ func (m *MsgRemoveAdministratorProposalRequest) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveAdministratorProposalResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddAccessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6839,12 +7829,12 @@ func (m *MsgRemoveAdministratorProposalResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveAdministratorProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddAccessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveAdministratorProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6852,7 +7842,7 @@ func (m *MsgRemoveAdministratorProposalResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeStatusProposalRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteAccessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6862,27 +7852,29 @@ func (m *MsgChangeStatusProposalRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChangeStatusProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteAccessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChangeStatusProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.RemovedAddress) > 0 {
+		i -= len(m.RemovedAddress)
+		copy(dAtA[i:], m.RemovedAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddress)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.NewStatus != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NewStatus))
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
@@ -6894,7 +7886,7 @@ func (m *MsgChangeStatusProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeStatusProposalResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteAccessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6904,12 +7896,12 @@ func (m *MsgChangeStatusProposalResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChangeStatusProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteAccessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChangeStatusProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6917,7 +7909,7 @@ func (m *MsgChangeStatusProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawEscrowProposalRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgFinalizeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6927,43 +7919,22 @@ func (m *MsgWithdrawEscrowProposalRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawEscrowProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFinalizeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawEscrowProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFinalizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TargetAddress) > 0 {
-		i -= len(m.TargetAddress)
-		copy(dAtA[i:], m.TargetAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+		dAtA[i] = 0x12
 	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
@@ -6975,7 +7946,7 @@ func (m *MsgWithdrawEscrowProposalRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawEscrowProposalResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgFinalizeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6985,12 +7956,12 @@ func (m *MsgWithdrawEscrowProposalResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawEscrowProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFinalizeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawEscrowProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFinalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6998,7 +7969,7 @@ func (m *MsgWithdrawEscrowProposalResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDenomMetadataProposalRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgActivateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7008,37 +7979,34 @@ func (m *MsgSetDenomMetadataProposalRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetDenomMetadataProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgActivateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetDenomMetadataProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgActivateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
 		i--
 		dAtA[i] = 0x12
 	}
-	{
-		size := m.Metadata.Size()
-		i -= size
-		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDenomMetadataProposalResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgActivateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7048,12 +8016,12 @@ func (m *MsgSetDenomMetadataProposalResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetDenomMetadataProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgActivateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetDenomMetadataProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7061,7 +8029,7 @@ func (m *MsgSetDenomMetadataProposalResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7071,37 +8039,34 @@ func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7111,12 +8076,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7124,7 +8089,7 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeGrantAllowanceRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7134,23 +8099,16 @@ func (m *MsgRevokeGrantAllowanceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRevokeGrantAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevokeGrantAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Administrator) > 0 {
 		i -= len(m.Administrator)
 		copy(dAtA[i:], m.Administrator)
@@ -7168,7 +8126,7 @@ func (m *MsgRevokeGrantAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeGrantAllowanceResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7178,12 +8136,12 @@ func (m *MsgRevokeGrantAllowanceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRevokeGrantAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevokeGrantAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7191,985 +8149,8216 @@ func (m *MsgRevokeGrantAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgMintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgGrantAllowanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgMintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrantAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddMarkerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
-	}
-	if m.MarkerType != 0 {
-		n += 1 + sovTx(uint64(m.MarkerType))
-	}
-	if len(m.AccessList) > 0 {
-		for _, e := range m.AccessList {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.SupplyFixed {
-		n += 2
-	}
-	if m.AllowGovernanceControl {
-		n += 2
-	}
-	if m.AllowForcedTransfer {
-		n += 2
-	}
-	if len(m.RequiredAttributes) > 0 {
-		for _, s := range m.RequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.UsdCents != 0 {
-		n += 1 + sovTx(uint64(m.UsdCents))
-	}
-	if m.Volume != 0 {
-		n += 1 + sovTx(uint64(m.Volume))
-	}
-	if m.UsdMills != 0 {
-		n += 1 + sovTx(uint64(m.UsdMills))
-	}
-	if m.RequireDepositAccess {
-		n += 2
+	if m.PendingOperationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PendingOperationId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddMarkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBurnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBurnRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Access) > 0 {
-		for _, e := range m.Access {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RemovedAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFinalizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.EventTag) > 0 {
+		i -= len(m.EventTag)
+		copy(dAtA[i:], m.EventTag)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EventTag)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFinalizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgActivateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.PendingOperationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PendingOperationId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgActivateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Reason != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.PendingOperationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PendingOperationId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgIbcTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMintRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgIbcTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIbcTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Transfer.Size()
+		i -= size
+		if _, err := m.Transfer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMintResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgIbcTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBurnRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgIbcTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBurnResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgIbcTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
-	}
-	l = len(m.EventTag)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTransferRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Metadata.Size()
+		i -= size
+		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgIbcTransferRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Transfer.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSetDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgIbcTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDenomMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Metadata.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAddFinalizeActivateMarkerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetDenomMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgAddFinalizeActivateMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddFinalizeActivateMarkerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddFinalizeActivateMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.RequireDepositAccess {
+		i--
+		if m.RequireDepositAccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.UsdMills != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
+		i--
+		dAtA[i] = 0x68
 	}
-	if m.MarkerType != 0 {
-		n += 1 + sovTx(uint64(m.MarkerType))
+	if m.Volume != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x60
 	}
-	if len(m.AccessList) > 0 {
-		for _, e := range m.AccessList {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.UsdCents != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdCents))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x52
 		}
 	}
-	if m.SupplyFixed {
-		n += 2
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
 	if m.AllowGovernanceControl {
-		n += 2
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.AllowForcedTransfer {
-		n += 2
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.RequiredAttributes) > 0 {
-		for _, s := range m.RequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if m.UsdCents != 0 {
-		n += 1 + sovTx(uint64(m.UsdCents))
+	if m.MarkerType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Volume != 0 {
-		n += 1 + sovTx(uint64(m.Volume))
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.UsdMills != 0 {
-		n += 1 + sovTx(uint64(m.UsdMills))
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.RequireDepositAccess {
-		n += 2
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddFinalizeActivateMarkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddFinalizeActivateMarkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSupplyIncreaseProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.TargetAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgAddFinalizeActivateMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSupplyIncreaseProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddFinalizeActivateMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSupplyDecreaseProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSupplyIncreaseProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSupplyDecreaseProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSupplyIncreaseProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateRequiredAttributesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSupplyIncreaseProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.RemoveRequiredAttributes) > 0 {
-		for _, s := range m.RemoveRequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.TargetAddress) > 0 {
+		i -= len(m.TargetAddress)
+		copy(dAtA[i:], m.TargetAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.AddRequiredAttributes) > 0 {
-		for _, s := range m.AddRequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.TransferAuthority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateRequiredAttributesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSupplyIncreaseProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateForcedTransferRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSupplyIncreaseProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupplyIncreaseProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AllowForcedTransfer {
-		n += 2
-	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateForcedTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSupplyDecreaseProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateRequireDepositAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSupplyDecreaseProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupplyDecreaseProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.RequireDepositAccess {
-		n += 2
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateRequireDepositAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSupplyDecreaseProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetAccountDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSupplyDecreaseProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetAccountDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSupplyDecreaseProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateSendDenyListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateRequiredAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRequiredAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRequiredAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TransferAuthority) > 0 {
+		i -= len(m.TransferAuthority)
+		copy(dAtA[i:], m.TransferAuthority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferAuthority)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.RemoveDeniedAddresses) > 0 {
-		for _, s := range m.RemoveDeniedAddresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.AddRequiredAttributes) > 0 {
+		for iNdEx := len(m.AddRequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddRequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.AddRequiredAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddRequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.AddDeniedAddresses) > 0 {
-		for _, s := range m.AddDeniedAddresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.RemoveRequiredAttributes) > 0 {
+		for iNdEx := len(m.RemoveRequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveRequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RemoveRequiredAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveRequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateSendDenyListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateRequiredAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddNetAssetValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.NetAssetValues) > 0 {
-		for _, e := range m.NetAssetValues {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgUpdateRequiredAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddNetAssetValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateRequiredAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetAdministratorProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateForcedTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateForcedTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateForcedTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Access) > 0 {
-		for _, e := range m.Access {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetAdministratorProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateForcedTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateForcedTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateForcedTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveAdministratorProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateRequireDepositAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRequireDepositAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRequireDepositAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.RemovedAddress) > 0 {
-		for _, s := range m.RemovedAddress {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.RequireDepositAccess {
+		i--
+		if m.RequireDepositAccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveAdministratorProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateRequireDepositAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRequireDepositAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRequireDepositAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeStatusProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.NewStatus != 0 {
-		n += 1 + sovTx(uint64(m.NewStatus))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeStatusProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawEscrowProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateSendDenyListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSendDenyListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSendDenyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.AddDeniedAddresses) > 0 {
+		for iNdEx := len(m.AddDeniedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddDeniedAddresses[iNdEx])
+			copy(dAtA[i:], m.AddDeniedAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddDeniedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.TargetAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RemoveDeniedAddresses) > 0 {
+		for iNdEx := len(m.RemoveDeniedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveDeniedAddresses[iNdEx])
+			copy(dAtA[i:], m.RemoveDeniedAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveDeniedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawEscrowProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateSendDenyListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetDenomMetadataProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateSendDenyListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSendDenyListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Metadata.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAdministratorProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAdministratorProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAdministratorProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Access) > 0 {
+		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAdministratorProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAdministratorProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAdministratorProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAdministratorProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAdministratorProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAdministratorProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RemovedAddress) > 0 {
+		for iNdEx := len(m.RemovedAddress) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedAddress[iNdEx])
+			copy(dAtA[i:], m.RemovedAddress[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddress[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAdministratorProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAdministratorProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAdministratorProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeStatusProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeStatusProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeStatusProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NewStatus != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeStatusProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeStatusProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeStatusProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawEscrowProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawEscrowProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawEscrowProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TargetAddress) > 0 {
+		i -= len(m.TargetAddress)
+		copy(dAtA[i:], m.TargetAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawEscrowProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawEscrowProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawEscrowProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadataProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Metadata.Size()
+		i -= size
+		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadataProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeGrantAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeGrantAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeGrantAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeGrantAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeGrantAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeGrantAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeHolderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeHolderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeHolderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintTx(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeHolderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeHolderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeHolderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeHolderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeHolderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeHolderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeHolderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeHolderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeHolderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDistributeToHoldersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDistributeToHoldersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDistributeToHoldersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Payout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDistributeToHoldersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDistributeToHoldersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDistributeToHoldersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRedemptionPayoutDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRedemptionPayoutDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRedemptionPayoutDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayoutDenom) > 0 {
+		i -= len(m.PayoutDenom)
+		copy(dAtA[i:], m.PayoutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayoutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRedemptionPayoutDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRedemptionPayoutDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRedemptionPayoutDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestRedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RedemptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedemptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillRedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Payout != nil {
+		{
+			size, err := m.Payout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RedemptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedemptionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Payout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectRedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RedemptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedemptionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTransferLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MarkerDailyLimit.Size()
+		i -= size
+		if _, err := m.MarkerDailyLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.HolderDailyLimit.Size()
+		i -= size
+		if _, err := m.HolderDailyLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTransferLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAccessGrantTermsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccessGrantTermsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccessGrantTermsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddresses) > 0 {
+		for iNdEx := len(m.WithdrawAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WithdrawAddresses[iNdEx])
+			copy(dAtA[i:], m.WithdrawAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.MintDailyLimit.Size()
+		i -= size
+		if _, err := m.MintDailyLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ExpiresAt != nil {
+		n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintTx(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAccessGrantTermsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccessGrantTermsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccessGrantTermsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetApprovalThresholdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetApprovalThresholdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetApprovalThresholdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Permission != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetApprovalThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetApprovalThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetApprovalThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OperationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OperationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OperationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OperationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAttributeRequirementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAttributeRequirementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttributeRequirementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransferAuthority) > 0 {
+		i -= len(m.TransferAuthority)
+		copy(dAtA[i:], m.TransferAuthority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferAuthority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Requirements) > 0 {
+		for iNdEx := len(m.Requirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAttributeRequirementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAttributeRequirementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttributeRequirementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTakeHolderSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTakeHolderSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTakeHolderSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTakeHolderSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTakeHolderSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTakeHolderSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SnapshotId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIssuanceScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIssuanceScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIssuanceScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Tranches) > 0 {
+		for iNdEx := len(m.Tranches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tranches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.HardCap.Size()
+		i -= size
+		if _, err := m.HardCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIssuanceScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIssuanceScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIssuanceScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNetAssetValueMaxAgeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNetAssetValueMaxAgeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNetAssetValueMaxAgeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxAge != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxAge))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNetAssetValueMaxAgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNetAssetValueMaxAgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNetAssetValueMaxAgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGrantAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddMarkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.MarkerType != 0 {
+		n += 1 + sovTx(uint64(m.MarkerType))
+	}
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for _, s := range m.RequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UsdCents != 0 {
+		n += 1 + sovTx(uint64(m.UsdCents))
+	}
+	if m.Volume != 0 {
+		n += 1 + sovTx(uint64(m.Volume))
+	}
+	if m.UsdMills != 0 {
+		n += 1 + sovTx(uint64(m.UsdMills))
+	}
+	if m.RequireDepositAccess {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAddMarkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Access) > 0 {
+		for _, e := range m.Access {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RemovedAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFinalizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinalizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgActivateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgActivateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingOperationId != 0 {
+		n += 1 + sovTx(uint64(m.PendingOperationId))
+	}
+	return n
+}
+
+func (m *MsgBurnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	l = len(m.EventTag)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingOperationId != 0 {
+		n += 1 + sovTx(uint64(m.PendingOperationId))
+	}
+	return n
+}
+
+func (m *MsgTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTx(uint64(m.Reason))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingOperationId != 0 {
+		n += 1 + sovTx(uint64(m.PendingOperationId))
+	}
+	return n
+}
+
+func (m *MsgIbcTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transfer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIbcTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddFinalizeActivateMarkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarkerType != 0 {
+		n += 1 + sovTx(uint64(m.MarkerType))
+	}
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for _, s := range m.RequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UsdCents != 0 {
+		n += 1 + sovTx(uint64(m.UsdCents))
+	}
+	if m.Volume != 0 {
+		n += 1 + sovTx(uint64(m.Volume))
+	}
+	if m.UsdMills != 0 {
+		n += 1 + sovTx(uint64(m.UsdMills))
+	}
+	if m.RequireDepositAccess {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAddFinalizeActivateMarkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSupplyIncreaseProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSupplyIncreaseProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSupplyDecreaseProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSupplyDecreaseProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRequiredAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RemoveRequiredAttributes) > 0 {
+		for _, s := range m.RemoveRequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddRequiredAttributes) > 0 {
+		for _, s := range m.AddRequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TransferAuthority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRequiredAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateForcedTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateForcedTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRequireDepositAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequireDepositAccess {
+		n += 2
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRequireDepositAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAccountDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAccountDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSendDenyListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RemoveDeniedAddresses) > 0 {
+		for _, s := range m.RemoveDeniedAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddDeniedAddresses) > 0 {
+		for _, s := range m.AddDeniedAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateSendDenyListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddNetAssetValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddNetAssetValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAdministratorProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Access) > 0 {
+		for _, e := range m.Access {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAdministratorProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAdministratorProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RemovedAddress) > 0 {
+		for _, s := range m.RemovedAddress {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAdministratorProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeStatusProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewStatus != 0 {
+		n += 1 + sovTx(uint64(m.NewStatus))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeStatusProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawEscrowProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawEscrowProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomMetadataProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDenomMetadataProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeGrantAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeGrantAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezeHolderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeHolderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeHolderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeHolderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDistributeToHoldersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Payout.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDistributeToHoldersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovTx(uint64(m.DistributionId))
+	}
+	return n
+}
+
+func (m *MsgClaimDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DistributionId != 0 {
+		n += 1 + sovTx(uint64(m.DistributionId))
+	}
+	return n
+}
+
+func (m *MsgClaimDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRedemptionPayoutDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PayoutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRedemptionPayoutDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestRedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRequestRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RedemptionId != 0 {
+		n += 1 + sovTx(uint64(m.RedemptionId))
+	}
+	return n
+}
+
+func (m *MsgFulfillRedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RedemptionId != 0 {
+		n += 1 + sovTx(uint64(m.RedemptionId))
+	}
+	if m.Payout != nil {
+		l = m.Payout.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFulfillRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Payout.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRejectRedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RedemptionId != 0 {
+		n += 1 + sovTx(uint64(m.RedemptionId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTransferLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.HolderDailyLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MarkerDailyLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetTransferLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAccessGrantTermsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MintDailyLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.WithdrawAddresses) > 0 {
+		for _, s := range m.WithdrawAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAccessGrantTermsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetApprovalThresholdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovTx(uint64(m.Permission))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MsgSetApprovalThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationId != 0 {
+		n += 1 + sovTx(uint64(m.OperationId))
+	}
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCancelOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationId != 0 {
+		n += 1 + sovTx(uint64(m.OperationId))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAttributeRequirementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Requirements) > 0 {
+		for _, e := range m.Requirements {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TransferAuthority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAttributeRequirementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTakeHolderSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTakeHolderSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotId != 0 {
+		n += 1 + sovTx(uint64(m.SnapshotId))
+	}
+	return n
+}
+
+func (m *MsgSetIssuanceScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.HardCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Tranches) > 0 {
+		for _, e := range m.Tranches {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetIssuanceScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetNetAssetValueMaxAgeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxAge != 0 {
+		n += 1 + sovTx(uint64(m.MaxAge))
+	}
+	l = len(m.Authority)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgSetNetAssetValueMaxAgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarkerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessGrant{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForcedTransfer = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdCents", wireType)
+			}
+			m.UsdCents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdCents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
+			}
+			m.UsdMills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdMills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireDepositAccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireDepositAccess = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Access = append(m.Access, AccessGrant{})
+			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOperationId", wireType)
+			}
+			m.PendingOperationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingOperationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOperationId", wireType)
+			}
+			m.PendingOperationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingOperationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= ForcedTransferReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOperationId", wireType)
+			}
+			m.PendingOperationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingOperationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIbcTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIbcTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIbcTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIbcTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIbcTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessGrant{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForcedTransfer = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdCents", wireType)
+			}
+			m.UsdCents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdCents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
+			}
+			m.UsdMills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdMills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireDepositAccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireDepositAccess = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalizeActivateMarkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupplyIncreaseProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupplyIncreaseProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupplyIncreaseProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupplyIncreaseProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupplyIncreaseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSetDenomMetadataProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupplyDecreaseProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupplyDecreaseProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRevokeGrantAllowanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSupplyDecreaseProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupplyDecreaseProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupplyDecreaseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRevokeGrantAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8192,10 +16381,10 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRequiredAttributesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRequiredAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8232,7 +16421,7 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveRequiredAttributes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8260,11 +16449,11 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.RemoveRequiredAttributes = append(m.RemoveRequiredAttributes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddRequiredAttributes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8292,13 +16481,13 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.AddRequiredAttributes = append(m.AddRequiredAttributes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferAuthority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8308,27 +16497,23 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Allowance == nil {
-				m.Allowance = &types.Any{}
+				return ErrInvalidLengthTx
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TransferAuthority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8351,7 +16536,7 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRequiredAttributesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8374,10 +16559,10 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRequiredAttributesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRequiredAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8401,7 +16586,7 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8424,17 +16609,17 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarkerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateForcedTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateForcedTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8444,30 +16629,29 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8477,27 +16661,15 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.AllowForcedTransfer = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8525,143 +16697,111 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= MarkerStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MarkerType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarkerType |= MarkerType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateForcedTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateForcedTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateForcedTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessList = append(m.AccessList, AccessGrant{})
-			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SupplyFixed = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRequireDepositAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			m.AllowGovernanceControl = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.AllowForcedTransfer = bool(v != 0)
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRequireDepositAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRequireDepositAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8689,13 +16829,13 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdCents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireDepositAccess", wireType)
 			}
-			m.UsdCents = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8705,16 +16845,17 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UsdCents |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			m.RequireDepositAccess = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			m.Volume = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8724,50 +16865,24 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
-			}
-			m.UsdMills = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsdMills |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequireDepositAccess", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.RequireDepositAccess = bool(v != 0)
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8789,7 +16904,7 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRequireDepositAccessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8812,10 +16927,10 @@ func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRequireDepositAccessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRequireDepositAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8839,7 +16954,7 @@ func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8862,10 +16977,10 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAccountDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8902,7 +17017,7 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8930,13 +17045,13 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8946,25 +17061,23 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Access = append(m.Access, AccessGrant{})
-			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8987,7 +17100,7 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9010,10 +17123,10 @@ func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAccountDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9037,7 +17150,7 @@ func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9060,10 +17173,10 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSendDenyListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSendDenyListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9100,7 +17213,7 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveDeniedAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9128,11 +17241,11 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.RemoveDeniedAddresses = append(m.RemoveDeniedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddDeniedAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9160,7 +17273,39 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemovedAddress = string(dAtA[iNdEx:postIndex])
+			m.AddDeniedAddresses = append(m.AddDeniedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9183,7 +17328,7 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSendDenyListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9206,10 +17351,10 @@ func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSendDenyListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSendDenyListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9233,7 +17378,7 @@ func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9256,10 +17401,10 @@ func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9326,6 +17471,40 @@ func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9347,7 +17526,7 @@ func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9370,10 +17549,10 @@ func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9397,7 +17576,7 @@ func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9420,10 +17599,10 @@ func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAdministratorProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAdministratorProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9445,22 +17624,56 @@ func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Access = append(m.Access, AccessGrant{})
+			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9488,7 +17701,7 @@ func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9511,7 +17724,7 @@ func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9534,10 +17747,10 @@ func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAdministratorProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAdministratorProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9561,7 +17774,7 @@ func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9584,10 +17797,10 @@ func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAdministratorProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAdministratorProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9624,7 +17837,7 @@ func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9652,7 +17865,39 @@ func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.RemovedAddress = append(m.RemovedAddress, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9675,7 +17920,7 @@ func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9698,10 +17943,10 @@ func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAdministratorProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAdministratorProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9725,7 +17970,7 @@ func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9748,10 +17993,10 @@ func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeStatusProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeStatusProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9787,8 +18032,27 @@ func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			m.NewStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewStatus |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9816,7 +18080,7 @@ func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9839,7 +18103,7 @@ func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeStatusProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9862,10 +18126,10 @@ func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeStatusProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeStatusProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9889,7 +18153,7 @@ func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9912,13 +18176,45 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawEscrowProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawEscrowProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -9947,13 +18243,14 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9981,11 +18278,11 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10013,7 +18310,7 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10036,7 +18333,7 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawEscrowProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10059,10 +18356,10 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawEscrowProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawEscrowProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10086,7 +18383,7 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10109,15 +18406,15 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadataProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadataProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10144,13 +18441,13 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10178,7 +18475,7 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10201,7 +18498,7 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadataProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10224,10 +18521,10 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadataProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadataProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10251,7 +18548,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10274,79 +18571,15 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10374,11 +18607,11 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10405,62 +18638,10 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
-			}
-			m.MarketId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventTag", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EventTag = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10482,7 +18663,7 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10505,10 +18686,10 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10532,7 +18713,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10555,48 +18736,15 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeGrantAllowanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeGrantAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10624,11 +18772,11 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10656,11 +18804,11 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10688,7 +18836,7 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10711,7 +18859,7 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10734,10 +18882,10 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeGrantAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10761,7 +18909,7 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezeHolderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10784,15 +18932,15 @@ func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIbcTransferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezeHolderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIbcTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezeHolderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10819,7 +18967,7 @@ func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10855,109 +19003,41 @@ func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgIbcTransferResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIbcTransferResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIbcTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10984,13 +19064,13 @@ func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11018,7 +19098,7 @@ func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11041,7 +19121,7 @@ func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezeHolderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11064,10 +19144,10 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezeHolderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezeHolderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11091,7 +19171,7 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUnfreezeHolderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11114,48 +19194,15 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnfreezeHolderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnfreezeHolderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11183,11 +19230,11 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11215,124 +19262,11 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
-			}
-			m.MarkerType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarkerType |= MarkerType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccessList = append(m.AccessList, AccessGrant{})
-			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SupplyFixed = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowGovernanceControl = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowForcedTransfer = bool(v != 0)
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11360,85 +19294,8 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdCents", wireType)
-			}
-			m.UsdCents = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsdCents |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
-			}
-			m.Volume = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
-			}
-			m.UsdMills = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsdMills |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequireDepositAccess", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequireDepositAccess = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11460,7 +19317,7 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddFinalizeActivateMarkerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnfreezeHolderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11483,10 +19340,10 @@ func (m *MsgAddFinalizeActivateMarkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnfreezeHolderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnfreezeHolderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11510,7 +19367,7 @@ func (m *MsgAddFinalizeActivateMarkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDistributeToHoldersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11533,17 +19390,17 @@ func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSupplyIncreaseProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDistributeToHoldersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSupplyIncreaseProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDistributeToHoldersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11553,28 +19410,27 @@ func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11602,13 +19458,13 @@ func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11618,23 +19474,24 @@ func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11657,7 +19514,7 @@ func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSupplyIncreaseProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDistributeToHoldersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11680,12 +19537,31 @@ func (m *MsgSupplyIncreaseProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSupplyIncreaseProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDistributeToHoldersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSupplyIncreaseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDistributeToHoldersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			m.DistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11707,7 +19583,7 @@ func (m *MsgSupplyIncreaseProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimDistributionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11730,17 +19606,17 @@ func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSupplyDecreaseProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimDistributionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSupplyDecreaseProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11750,30 +19626,29 @@ func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
 			}
-			var stringLen uint64
+			m.DistributionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11783,24 +19658,11 @@ func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DistributionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11822,7 +19684,7 @@ func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSupplyDecreaseProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11845,10 +19707,10 @@ func (m *MsgSupplyDecreaseProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSupplyDecreaseProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSupplyDecreaseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11872,7 +19734,7 @@ func (m *MsgSupplyDecreaseProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRedemptionPayoutDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11895,10 +19757,10 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRequiredAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRedemptionPayoutDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRequiredAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRedemptionPayoutDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11935,7 +19797,7 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveRequiredAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11963,43 +19825,11 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveRequiredAttributes = append(m.RemoveRequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddRequiredAttributes", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AddRequiredAttributes = append(m.AddRequiredAttributes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12027,7 +19857,7 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferAuthority = string(dAtA[iNdEx:postIndex])
+			m.PayoutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12050,7 +19880,7 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRequiredAttributesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRedemptionPayoutDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12073,10 +19903,10 @@ func (m *MsgUpdateRequiredAttributesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRequiredAttributesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRedemptionPayoutDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRequiredAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRedemptionPayoutDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12100,7 +19930,7 @@ func (m *MsgUpdateRequiredAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestRedemptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12123,15 +19953,15 @@ func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateForcedTransferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestRedemptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateForcedTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12159,33 +19989,13 @@ func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowForcedTransfer = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12195,23 +20005,24 @@ func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12234,7 +20045,7 @@ func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateForcedTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12257,12 +20068,31 @@ func (m *MsgUpdateForcedTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateForcedTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestRedemptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateForcedTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
+			}
+			m.RedemptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12284,7 +20114,7 @@ func (m *MsgUpdateForcedTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRequireDepositAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgFulfillRedemptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12307,15 +20137,15 @@ func (m *MsgUpdateRequireDepositAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRequireDepositAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFulfillRedemptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRequireDepositAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFulfillRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12343,13 +20173,13 @@ func (m *MsgUpdateRequireDepositAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequireDepositAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
 			}
-			var v int
+			m.RedemptionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12359,17 +20189,16 @@ func (m *MsgUpdateRequireDepositAccessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.RedemptionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequireDepositAccess = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12379,23 +20208,27 @@ func (m *MsgUpdateRequireDepositAccessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if m.Payout == nil {
+				m.Payout = &types1.Coin{}
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12418,7 +20251,7 @@ func (m *MsgUpdateRequireDepositAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRequireDepositAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFulfillRedemptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12441,12 +20274,45 @@ func (m *MsgUpdateRequireDepositAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRequireDepositAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFulfillRedemptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRequireDepositAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFulfillRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12468,7 +20334,7 @@ func (m *MsgUpdateRequireDepositAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectRedemptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12491,15 +20357,15 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAccountDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectRedemptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12527,13 +20393,13 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
 			}
-			var stringLen uint64
+			m.RedemptionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12543,27 +20409,14 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RedemptionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12591,7 +20444,7 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12614,7 +20467,7 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectRedemptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12637,10 +20490,10 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAccountDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectRedemptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12664,7 +20517,7 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTransferLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12687,10 +20540,10 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSendDenyListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTransferLimitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSendDenyListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTransferLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12727,7 +20580,7 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveDeniedAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12755,11 +20608,11 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveDeniedAddresses = append(m.RemoveDeniedAddresses, string(dAtA[iNdEx:postIndex]))
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddDeniedAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderDailyLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12787,11 +20640,13 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddDeniedAddresses = append(m.AddDeniedAddresses, string(dAtA[iNdEx:postIndex]))
+			if err := m.HolderDailyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerDailyLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12819,7 +20674,9 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if err := m.MarkerDailyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12842,7 +20699,7 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSendDenyListResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTransferLimitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12865,10 +20722,10 @@ func (m *MsgUpdateSendDenyListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSendDenyListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTransferLimitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSendDenyListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTransferLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12892,7 +20749,7 @@ func (m *MsgUpdateSendDenyListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccessGrantTermsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12915,10 +20772,10 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAccessGrantTermsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAccessGrantTermsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12987,7 +20844,39 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13014,11 +20903,79 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
-			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDailyLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintDailyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddresses = append(m.WithdrawAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13040,7 +20997,7 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccessGrantTermsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13063,10 +21020,10 @@ func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAccessGrantTermsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAccessGrantTermsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13090,7 +21047,7 @@ func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetApprovalThresholdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13113,10 +21070,10 @@ func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAdministratorProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetApprovalThresholdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAdministratorProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetApprovalThresholdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13153,9 +21110,9 @@ func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13165,31 +21122,29 @@ func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Access = append(m.Access, AccessGrant{})
-			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
-			var stringLen uint64
+			m.Permission = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13199,24 +21154,30 @@ func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Permission |= Access(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13238,7 +21199,7 @@ func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetApprovalThresholdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13261,10 +21222,10 @@ func (m *MsgSetAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAdministratorProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetApprovalThresholdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAdministratorProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetApprovalThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13288,7 +21249,7 @@ func (m *MsgSetAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveOperationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13311,17 +21272,17 @@ func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAdministratorProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveOperationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAdministratorProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
 			}
-			var stringLen uint64
+			m.OperationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13331,59 +21292,14 @@ func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OperationId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemovedAddress = append(m.RemovedAddress, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13411,7 +21327,7 @@ func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Approver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13434,7 +21350,7 @@ func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveOperationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13457,12 +21373,32 @@ func (m *MsgRemoveAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAdministratorProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveOperationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAdministratorProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13484,7 +21420,7 @@ func (m *MsgRemoveAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelOperationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13507,49 +21443,17 @@ func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeStatusProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelOperationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeStatusProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
 			}
-			m.NewStatus = 0
+			m.OperationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13559,14 +21463,14 @@ func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewStatus |= MarkerStatus(b&0x7F) << shift
+				m.OperationId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13594,7 +21498,7 @@ func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13617,7 +21521,7 @@ func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeStatusProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelOperationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13640,10 +21544,10 @@ func (m *MsgChangeStatusProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeStatusProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelOperationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeStatusProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13667,7 +21571,7 @@ func (m *MsgChangeStatusProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAttributeRequirementsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13690,10 +21594,10 @@ func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawEscrowProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAttributeRequirementsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawEscrowProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAttributeRequirementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13730,7 +21634,7 @@ func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requirements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13757,46 +21661,14 @@ func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requirements = append(m.Requirements, AttributeRequirement{})
+			if err := m.Requirements[len(m.Requirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferAuthority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13824,7 +21696,7 @@ func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.TransferAuthority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13847,7 +21719,7 @@ func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawEscrowProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAttributeRequirementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13870,10 +21742,10 @@ func (m *MsgWithdrawEscrowProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawEscrowProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAttributeRequirementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawEscrowProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAttributeRequirementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13897,7 +21769,7 @@ func (m *MsgWithdrawEscrowProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgTakeHolderSnapshotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13920,17 +21792,17 @@ func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTakeHolderSnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTakeHolderSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13940,28 +21812,27 @@ func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13989,7 +21860,7 @@ func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14012,7 +21883,7 @@ func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTakeHolderSnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14035,12 +21906,31 @@ func (m *MsgSetDenomMetadataProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTakeHolderSnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTakeHolderSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotId", wireType)
+			}
+			m.SnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14062,7 +21952,7 @@ func (m *MsgSetDenomMetadataProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIssuanceScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14085,15 +21975,15 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIssuanceScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIssuanceScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14121,11 +22011,45 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HardCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HardCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tranches", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14152,10 +22076,43 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tranches = append(m.Tranches, IssuanceTranche{})
+			if err := m.Tranches[len(m.Tranches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14177,7 +22134,7 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIssuanceScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14200,10 +22157,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIssuanceScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIssuanceScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14227,7 +22184,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetNetAssetValueMaxAgeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14250,10 +22207,10 @@ func (m *MsgRevokeGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeGrantAllowanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetNetAssetValueMaxAgeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeGrantAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetNetAssetValueMaxAgeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14289,10 +22246,10 @@ func (m *MsgRevokeGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
-			var stringLen uint64
+			m.MaxAge = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14302,27 +22259,14 @@ func (m *MsgRevokeGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxAge |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14350,7 +22294,7 @@ func (m *MsgRevokeGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14373,7 +22317,7 @@ func (m *MsgRevokeGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetNetAssetValueMaxAgeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14396,10 +22340,10 @@ func (m *MsgRevokeGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeGrantAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetNetAssetValueMaxAgeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetNetAssetValueMaxAgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
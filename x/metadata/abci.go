@@ -0,0 +1,17 @@
+package metadata
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// BeginBlocker returns the begin blocker for the metadata module.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
+	k.EmitNetAssetValueStaleEvents(ctx)
+	k.ExpireScopeOwnershipTransfers(ctx)
+	k.ExpireScopeDataAccess(ctx)
+}
@@ -382,7 +382,7 @@ specification_id: %s`,
 		s.recordSpecID,
 	)
 
-	s.scopeSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"contract_spec_ids\":[\"%s\"]}",
+	s.scopeSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"contract_spec_ids\":[\"%s\"],\"supersedes\":\"\",\"superseded_by\":\"\"}",
 		s.scopeSpecID,
 		s.user1AddrStr,
 		s.contractSpecID,
@@ -394,7 +394,9 @@ owner_addresses:
 - %s
 parties_involved:
 - PARTY_TYPE_OWNER
-specification_id: %s`,
+specification_id: %s
+superseded_by: ""
+supersedes: ""`,
 		s.contractSpecID,
 		s.user1AddrStr,
 		s.scopeSpecID,
@@ -439,15 +441,19 @@ type_name: recordtypename`,
 			eKey = "\"\""
 		}
 		return fmt.Sprintf(`encryption_key: %s
+encryption_key_since: null
+encryption_key_since_height: "0"
+endpoints: []
 locator_uri: %s
-owner: %s`,
+owner: %s
+prior_encryption_keys: []`,
 			eKey,
 			loc.LocatorUri,
 			loc.Owner,
 		)
 	}
 	locAsJson := func(loc metadatatypes.ObjectStoreLocator) string {
-		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\"}",
+		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\",\"endpoints\":[],\"encryption_key_since_height\":\"0\",\"encryption_key_since\":null,\"prior_encryption_keys\":[]}",
 			loc.Owner,
 			loc.LocatorUri,
 			loc.EncryptionKey,
@@ -3967,3 +3973,49 @@ func (s *IntegrationCLITestSuite) TestParseNetAssertValueString() {
 		})
 	}
 }
+
+func (s *IntegrationCLITestSuite) TestParseOSLocatorEndpointsString() {
+	testCases := []struct {
+		name      string
+		endpoints string
+		expErr    string
+		expResult []metadatatypes.ObjectStoreEndpoint
+	}{
+		{
+			name:      "empty string",
+			endpoints: "",
+		},
+		{
+			name:      "empty uri",
+			endpoints: "http://foo.com,1;,2",
+			expErr:    `invalid endpoint ",2", expected [uri,priority] or [uri]`,
+		},
+		{
+			name:      "invalid priority",
+			endpoints: "http://foo.com,first",
+			expErr:    `invalid endpoint priority "first": strconv.ParseUint: parsing "first": invalid syntax`,
+		},
+		{
+			name:      "single endpoint without priority",
+			endpoints: "http://foo.com",
+			expResult: []metadatatypes.ObjectStoreEndpoint{{Uri: "http://foo.com"}},
+		},
+		{
+			name:      "multiple endpoints",
+			endpoints: "http://foo.com:8080/objects,2;http://bar.com,1",
+			expResult: []metadatatypes.ObjectStoreEndpoint{{Uri: "http://foo.com:8080/objects", Priority: 2}, {Uri: "http://bar.com", Priority: 1}},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			result, err := cli.ParseOSLocatorEndpointsString(tc.endpoints)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr)
+				s.Assert().Empty(result)
+			} else {
+				s.Assert().NoError(err)
+				s.Assert().Equal(tc.expResult, result)
+			}
+		})
+	}
+}
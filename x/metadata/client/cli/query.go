@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -60,6 +62,13 @@ func GetQueryCmd() *cobra.Command {
 		GetOSLocatorCmd(),
 		GetAccountDataCmd(),
 		GetCmdNetAssetValuesQuery(),
+		GetScopeHistoryCmd(),
+		GetRecordHistoryCmd(),
+		GetScopeOwnershipTransfersCmd(),
+		GetScopeSearchCmd(),
+		GetRecordCommitmentCmd(),
+		GetScopeDataAccessCmd(),
+		GetScopeTombstoneCmd(),
 	)
 	return queryCmd
 }
@@ -1117,8 +1126,14 @@ func GetCmdNetAssetValuesQuery() *cobra.Command {
 		Use:     "net-asset-values [scope-id]",
 		Aliases: []string{"nav", "navs"},
 		Short:   "Get scope's net asset values'",
-		Example: fmt.Sprintf(`$ %s query metadata net-asset-values scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel`, version.AppName),
-		Args:    cobra.ExactArgs(1),
+		Long: `Get a scope's current net asset values, the ones in effect at a given time (--as-of),
+or the recorded history of them (--history, optionally limited with --start-time and --end-time).
+Times must be in RFC 3339 format (e.g. 2026-01-02T15:04:05Z).`,
+		Example: fmt.Sprintf(`$ %[1]s query metadata net-asset-values scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+$ %[1]s query metadata net-asset-values scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel --price-denom usd --as-of 2026-01-02T15:04:05Z
+$ %[1]s query metadata net-asset-values scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel --history --start-time 2026-01-01T00:00:00Z`,
+			version.AppName),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -1130,19 +1145,255 @@ func GetCmdNetAssetValuesQuery() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			req := &types.QueryScopeNetAssetValuesRequest{Id: id}
+
+			flagSet := cmd.Flags()
+			if req.PriceDenom, err = flagSet.GetString(FlagPriceDenom); err != nil {
+				return err
+			}
+			if req.History, err = flagSet.GetBool(FlagHistory); err != nil {
+				return err
+			}
+			if req.AsOf, err = getTimeFlag(flagSet, FlagAsOf); err != nil {
+				return err
+			}
+			if req.StartTime, err = getTimeFlag(flagSet, FlagStartTime); err != nil {
+				return err
+			}
+			if req.EndTime, err = getTimeFlag(flagSet, FlagEndTime); err != nil {
+				return err
+			}
 
 			var response *types.QueryScopeNetAssetValuesResponse
-			if response, err = queryClient.ScopeNetAssetValues(
-				context.Background(),
-				&types.QueryScopeNetAssetValuesRequest{Id: id},
-			); err != nil {
+			if response, err = queryClient.ScopeNetAssetValues(context.Background(), req); err != nil {
 				fmt.Printf("failed to query scope %q net asset values details: %v\n", id, err)
 				return nil
 			}
 			return clientCtx.PrintProto(response)
 		},
 	}
+	cmd.Flags().String(FlagPriceDenom, "", "Only include net asset values with this price denom")
+	cmd.Flags().String(FlagAsOf, "", "Get the net asset values that were in effect at this time")
+	cmd.Flags().Bool(FlagHistory, false, "Get the recorded history of net asset values")
+	cmd.Flags().String(FlagStartTime, "", "Only include history recorded at or after this time")
+	cmd.Flags().String(FlagEndTime, "", "Only include history recorded before this time")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetScopeHistoryCmd is the CLI command for querying the prior versions of a scope or one of its sessions.
+func GetScopeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scope-history <scope-id> [session-id]",
+		Short: "Get the prior versions of a scope or one of its sessions",
+		Long: `Get the prior versions of a scope, ordered from oldest to newest.
+If a session id is provided, the prior versions of that session are returned instead.
+The scope id can be a bech32 scope address or a uuid.
+The session id can be a bech32 session address or a uuid.`,
+		Example: fmt.Sprintf(`%[1]s scope-history scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s scope-history 91978ba2-5f35-459a-86a7-feca1b0512e0 5803f8bc-6067-4eb5-951f-2121671c2ec0`, cmdStart),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.ScopeHistoryRequest{ScopeId: strings.TrimSpace(args[0]), Pagination: pageReq}
+			if len(args) > 1 {
+				req.SessionId = strings.TrimSpace(args[1])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeHistory(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "versions")
+	return cmd
+}
+
+// GetRecordHistoryCmd is the CLI command for querying the prior versions of a record.
+func GetRecordHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-history {<record-id>|<scope-id> <record-name>}",
+		Short: "Get the prior versions of a record",
+		Long: `Get the prior versions of a record, ordered from oldest to newest.
+The record can be identified by its bech32 record address, or by a scope id and the record's name.
+The scope id can be a bech32 scope address or a uuid.`,
+		Example: fmt.Sprintf(`%[1]s record-history record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
+%[1]s record-history scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel recordname`, cmdStart),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.RecordHistoryRequest{Pagination: pageReq}
+			if len(args) == 1 {
+				req.RecordAddr = strings.TrimSpace(args[0])
+			} else {
+				req.ScopeId = strings.TrimSpace(args[0])
+				req.Name = strings.TrimSpace(args[1])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RecordHistory(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "versions")
+	return cmd
+}
+
+// GetScopeOwnershipTransfersCmd is the CLI command for querying pending scope ownership transfers.
+func GetScopeOwnershipTransfersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ownership-transfers [scope-id] [--recipient <address>]",
+		Short: "Get pending scope ownership transfers",
+		Long: `Get pending scope ownership transfers.
+If a scope id is provided, only the transfer of that scope is returned.
+If a --recipient is provided, only the transfers that address needs to accept are returned.
+The scope id can be a bech32 scope address or a uuid.`,
+		Example: fmt.Sprintf(`%[1]s ownership-transfers
+%[1]s ownership-transfers scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s ownership-transfers --recipient pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, cmdStart),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.ScopeOwnershipTransfersRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				req.ScopeId = strings.TrimSpace(args[0])
+			}
+			if req.Recipient, err = cmd.Flags().GetString(FlagRecipient); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeOwnershipTransfers(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(FlagRecipient, "", "only get the transfers that this address needs to accept")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfers")
+	return cmd
+}
+
+// GetScopeSearchCmd returns the command handler for searching scopes using several filters.
+func GetScopeSearchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scope-search",
+		Short: "Search for scopes that match all of the provided filters",
+		Long: `Search for scopes that match all of the provided filters.
+At least one of --owner, --data-access, --value-owner, or --scope-spec is required.
+The --role filter requires an --owner.
+The --scope-spec can be a bech32 scope specification address or a uuid.
+A height filter of zero means that end of the range is open.`,
+		Example: fmt.Sprintf(`%[1]s scope-search --owner pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --role servicer
+%[1]s scope-search --scope-spec scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m --nav-denom usd
+%[1]s scope-search --value-owner pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --min-updated-height 1000`, cmdStart),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.ScopeSearchRequest{
+				ExcludeIdInfo:  excludeIDInfo,
+				IncludeRequest: includeRequest,
+				Pagination:     pageReq,
+			}
+
+			flagSet := cmd.Flags()
+			if req.ScopeSpecId, err = flagSet.GetString(FlagScopeSpec); err != nil {
+				return err
+			}
+			if req.Owner, err = flagSet.GetString(FlagOwner); err != nil {
+				return err
+			}
+			role, err := flagSet.GetString(FlagRole)
+			if err != nil {
+				return err
+			}
+			if len(role) > 0 {
+				if req.OwnerRole, err = parsePartyType(role); err != nil {
+					return err
+				}
+			}
+			if req.ValueOwner, err = flagSet.GetString(FlagValueOwner); err != nil {
+				return err
+			}
+			if req.DataAccess, err = flagSet.GetString(FlagDataAccess); err != nil {
+				return err
+			}
+			if req.NavDenom, err = flagSet.GetString(FlagNavDenom); err != nil {
+				return err
+			}
+			if req.MinCreatedHeight, err = flagSet.GetInt64(FlagMinCreatedHeight); err != nil {
+				return err
+			}
+			if req.MaxCreatedHeight, err = flagSet.GetInt64(FlagMaxCreatedHeight); err != nil {
+				return err
+			}
+			if req.MinUpdatedHeight, err = flagSet.GetInt64(FlagMinUpdatedHeight); err != nil {
+				return err
+			}
+			if req.MaxUpdatedHeight, err = flagSet.GetInt64(FlagMaxUpdatedHeight); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeSearch(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(FlagScopeSpec, "", "only get scopes with this scope specification")
+	cmd.Flags().String(FlagOwner, "", "only get scopes with this owner")
+	cmd.Flags().String(FlagRole, "", "only get scopes where the --owner has this role, e.g. servicer")
+	cmd.Flags().String(FlagValueOwner, "", "only get scopes with this value owner")
+	cmd.Flags().String(FlagDataAccess, "", "only get scopes with this address in their data access list")
+	cmd.Flags().String(FlagNavDenom, "", "only get scopes with a net asset value in this denom")
+	cmd.Flags().Int64(FlagMinCreatedHeight, 0, "only get scopes created at or after this block height")
+	cmd.Flags().Int64(FlagMaxCreatedHeight, 0, "only get scopes created at or before this block height")
+	cmd.Flags().Int64(FlagMinUpdatedHeight, 0, "only get scopes last updated at or after this block height")
+	cmd.Flags().Int64(FlagMaxUpdatedHeight, 0, "only get scopes last updated at or before this block height")
+	addExcludeIDInfoFlag(cmd)
+	addIncludeRequestFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scopes")
 	return cmd
 }
 
@@ -1200,3 +1451,108 @@ func addExcludeIDInfoFlag(cmd *cobra.Command) {
 func addIncludeRequestFlag(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&includeRequest, "include-request", false, "include the query request in the output")
 }
+
+// getTimeFlag gets an optional RFC 3339 time from the provided flag. Returns nil if the flag is not set.
+func getTimeFlag(flagSet *pflag.FlagSet, name string) (*time.Time, error) {
+	val, err := flagSet.GetString(name)
+	if err != nil || len(val) == 0 {
+		return nil, err
+	}
+	rv, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s %q: %w", name, val, err)
+	}
+	return &rv, nil
+}
+
+// GetRecordCommitmentCmd is the CLI command for querying the merkle proof of a record in its scope's records root.
+func GetRecordCommitmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-commitment {<record-id>|<scope-id> <record-name>}",
+		Short: "Get the merkle root over a record's scope's records, and the proof of the record in it",
+		Long: `Get the merkle root over all the records in a record's scope, and the proof that the record is included in it.
+The record can be identified by its bech32 record address, or by a scope id and the record's name.
+The scope id can be a bech32 scope address or a uuid.`,
+		Example: fmt.Sprintf(`%[1]s record-commitment record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
+%[1]s record-commitment scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel recordname`, cmdStart),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			req := &types.RecordCommitmentRequest{}
+			if len(args) == 1 {
+				req.RecordAddr = strings.TrimSpace(args[0])
+			} else {
+				req.ScopeId = strings.TrimSpace(args[0])
+				req.Name = strings.TrimSpace(args[1])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RecordCommitment(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetScopeDataAccessCmd returns the command handler for the metadata scope data access query.
+func GetScopeDataAccessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scope-data-access <scope-id>",
+		Short: "Get the addresses with data access to a scope, and when that access expires",
+		Long: `Get the addresses with data access to a scope, and when that access expires.
+The scope id can be a bech32 scope address or a uuid.`,
+		Example: fmt.Sprintf(`%[1]s scope-data-access scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s scope-data-access 91978ba2-5f35-459a-86a7-feca1b0512e0`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeDataAccess(context.Background(), &types.ScopeDataAccessRequest{ScopeId: strings.TrimSpace(args[0])})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetScopeTombstoneCmd returns the command handler for the record of an archived scope.
+func GetScopeTombstoneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scope-tombstone <scope-id>",
+		Short: "Get the record of an archived scope",
+		Long: `Get the record of an archived scope: the hash of everything that was archived, and when and by whom.
+The scope id can be a bech32 scope address or a uuid.`,
+		Example: fmt.Sprintf(`%[1]s scope-tombstone scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s scope-tombstone 91978ba2-5f35-459a-86a7-feca1b0512e0`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeTombstone(context.Background(), &types.ScopeTombstoneRequest{ScopeId: strings.TrimSpace(args[0])})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
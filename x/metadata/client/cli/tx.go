@@ -4,8 +4,10 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
@@ -26,6 +28,29 @@ const (
 	AddSwitch              = "add"
 	RemoveSwitch           = "remove"
 	FlagUsdMills           = "usd-mills"
+	FlagPriceDenom         = "price-denom"
+	FlagAsOf               = "as-of"
+	FlagHistory            = "history"
+	FlagStartTime          = "start-time"
+	FlagEndTime            = "end-time"
+	FlagOwners             = "owners"
+	FlagValueOwner         = "value-owner"
+	FlagRecipient          = "recipient"
+	FlagOutputSchema       = "output-schema"
+	FlagOutputValue        = "output-value"
+	FlagScopeSpec          = "scope-spec"
+	FlagOwner              = "owner"
+	FlagRole               = "role"
+	FlagDataAccess         = "data-access"
+	FlagNavDenom           = "nav-denom"
+	FlagMinCreatedHeight   = "min-created-height"
+	FlagMaxCreatedHeight   = "max-created-height"
+	FlagMinUpdatedHeight   = "min-updated-height"
+	FlagMaxUpdatedHeight   = "max-updated-height"
+	FlagExpiration         = "expiration"
+	FlagEndpoints          = "endpoints"
+	FlagEncryptionKey      = "encryption-key"
+	FlagSupersedes         = "supersedes"
 )
 
 // NewTxCmd is the top-level command for Metadata CLI transactions.
@@ -71,6 +96,14 @@ func NewTxCmd() *cobra.Command {
 		SetAccountDataCmd(),
 
 		GetCmdAddNetAssetValues(),
+		GetCmdSetNetAssetValueMaxAge(),
+		GetCmdPruneScopeHistory(),
+		GetCmdProposeScopeOwnershipTransfer(),
+		GetCmdAcceptScopeOwnershipTransfer(),
+		GetCmdCancelScopeOwnershipTransfer(),
+		GetCmdWriteScopeBundle(),
+		GetCmdArchiveScope(),
+		GetCmdMigrateScopeSpec(),
 	)
 
 	return txCmd
@@ -196,7 +229,11 @@ func AddRemoveScopeDataAccessCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "scope-data-access {add|remove} [scope-id] [data-access]",
 		Short: "Add or remove a metadata scope data access on to the provenance blockchain",
+		Long: `Add or remove a metadata scope data access on to the provenance blockchain.
+When adding, the --expiration flag can be used to have the access removed automatically once that time has passed.
+The expiration is an RFC 3339 timestamp, e.g. 2026-07-01T00:00:00Z.`,
 		Example: fmt.Sprintf(`$ %[1]s tx metadata scope-data-access add scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+$ %[1]s tx metadata scope-data-access add scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --expiration 2026-07-01T00:00:00Z
 $ %[1]s tx metadata scope-data-access remove scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, version.AppName),
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -228,7 +265,16 @@ $ %[1]s tx metadata scope-data-access remove scope1qzhpuff00wpy2yuf7xr0rp8aucqst
 			dataAccess := strings.Split(args[2], ",")
 			var msg sdk.Msg
 			if removeOrAdd == AddSwitch {
-				msg = types.NewMsgAddScopeDataAccessRequest(scopeID, dataAccess, signers)
+				addMsg := types.NewMsgAddScopeDataAccessRequest(scopeID, dataAccess, signers)
+				expStr, _ := cmd.Flags().GetString(FlagExpiration)
+				if len(expStr) > 0 {
+					expiration, expErr := time.Parse(time.RFC3339, expStr)
+					if expErr != nil {
+						return fmt.Errorf("invalid expiration %q: %w", expStr, expErr)
+					}
+					addMsg.Expiration = &expiration
+				}
+				msg = addMsg
 			} else {
 				msg = types.NewMsgDeleteScopeDataAccessRequest(scopeID, dataAccess, signers)
 			}
@@ -238,6 +284,7 @@ $ %[1]s tx metadata scope-data-access remove scope1qzhpuff00wpy2yuf7xr0rp8aucqst
 	}
 
 	addSignersFlagToCmd(cmd)
+	cmd.Flags().String(FlagExpiration, "", "The time (RFC 3339) after which added data access expires")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -392,10 +439,11 @@ func MigrateValueOwnerCmd() *cobra.Command {
 // BindOsLocatorCmd creates a command for binding an owner to uri in the object store.
 func BindOsLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "bind-locator [owner] [uri]",
-		Short:   "Bind a uri to an owner address on the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"`, version.AppName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "bind-locator [owner] [uri]",
+		Short: "Bind a uri to an owner address on the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"
+$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com" --endpoints "http://bar.com,1;http://baz.com,2"`, version.AppName),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -410,12 +458,20 @@ func BindOsLocatorCmd() *cobra.Command {
 			objectStoreLocator := types.ObjectStoreLocator{
 				LocatorUri: args[1], Owner: args[0],
 			}
+			objectStoreLocator.EncryptionKey, _ = cmd.Flags().GetString(FlagEncryptionKey)
+			endpointsStr, _ := cmd.Flags().GetString(FlagEndpoints)
+			objectStoreLocator.Endpoints, err = ParseOSLocatorEndpointsString(endpointsStr)
+			if err != nil {
+				return err
+			}
 
 			addOSLocator := *types.NewMsgBindOSLocatorRequest(objectStoreLocator)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &addOSLocator)
 		},
 	}
 
+	cmd.Flags().String(FlagEndpoints, "", "Additional endpoints of the object store, e.g. uri1,priority;uri2,priority")
+	cmd.Flags().String(FlagEncryptionKey, "", "The bech32 address of the encryption key used by the object store")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -474,12 +530,20 @@ func ModifyOsLocatorCmd() *cobra.Command {
 			objectStoreLocator := types.ObjectStoreLocator{
 				LocatorUri: args[1], Owner: args[0],
 			}
+			objectStoreLocator.EncryptionKey, _ = cmd.Flags().GetString(FlagEncryptionKey)
+			endpointsStr, _ := cmd.Flags().GetString(FlagEndpoints)
+			objectStoreLocator.Endpoints, err = ParseOSLocatorEndpointsString(endpointsStr)
+			if err != nil {
+				return err
+			}
 
 			modifyOSLocator := *types.NewMsgModifyOSLocatorRequest(objectStoreLocator)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &modifyOSLocator)
 		},
 	}
 
+	cmd.Flags().String(FlagEndpoints, "", "Additional endpoints of the object store, e.g. uri1,priority;uri2,priority")
+	cmd.Flags().String(FlagEncryptionKey, "", "The bech32 address of the encryption key used by the object store")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -530,12 +594,20 @@ func WriteScopeSpecificationCmd() *cobra.Command {
 				ContractSpecIds: contractSpecIDs,
 			}
 
+			if supersedes, _ := cmd.Flags().GetString(FlagSupersedes); len(supersedes) > 0 {
+				scopeSpec.Supersedes, err = types.MetadataAddressFromBech32(supersedes)
+				if err != nil {
+					return fmt.Errorf("invalid %s: %w", FlagSupersedes, err)
+				}
+			}
+
 			msg := types.NewMsgWriteScopeSpecificationRequest(scopeSpec, signers)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	addSignersFlagToCmd(cmd)
+	cmd.Flags().String(FlagSupersedes, "", "The id of the scope specification that this one is a newer version of")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -790,7 +862,8 @@ contract-spec-id  - a bech32 address string for a contract specification - If pr
 session-id        - a bech32 address string for the session this record belongs to
   Either a contract-spec-id or a session-id must be provided (but not both).
   If a contract-spec-id is provided, a new session will be created using it as the specification for the session, and the record will be part of that session.
-  If a session-id is provided, the record will be part of that session (a new session is NOT created).`,
+  If a session-id is provided, the record will be part of that session (a new session is NOT created).
+The --output-value flag provides the JSON value of an output. It can be provided once per output, in the same order as the outputs.`,
 		Example: fmt.Sprintf(`$ %[1]s tx metadata write-record scope1qp... \
 recspec1qh... \
 recordname \
@@ -840,6 +913,16 @@ contractspec-name
 			if err != nil {
 				return err
 			}
+			outputValues, err := cmd.Flags().GetStringArray(FlagOutputValue)
+			if err != nil {
+				return err
+			}
+			if len(outputValues) > len(outputs) {
+				return fmt.Errorf("too many --%s values (%d) for %d outputs", FlagOutputValue, len(outputValues), len(outputs))
+			}
+			for i, value := range outputValues {
+				outputs[i].Value = value
+			}
 
 			parties, err := parseParties(args[6])
 			if err != nil {
@@ -890,6 +973,7 @@ contractspec-name
 		},
 	}
 
+	cmd.Flags().StringArray(FlagOutputValue, nil, "A JSON value of an output (once per output, in the same order as the outputs)")
 	addSignersFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -907,7 +991,8 @@ name                  - record name
 input-specifications  - semi-colon delimited list of input specifications <name>,<type-name>,<source-value>
 type-name             - contract specification type name
 result-types          - result definition type. Accepted values: proposed, record, record_list
-responsible-parties   - comma delimited list of party types.  Accepted values: originator,servicer,investor,custodian,owner,affiliate,omnibus,provenance`,
+responsible-parties   - comma delimited list of party types.  Accepted values: originator,servicer,investor,custodian,owner,affiliate,omnibus,provenance
+The --output-schema flag provides a JSON schema that the value of each output of records using this specification must conform to.`,
 		Example: fmt.Sprintf(`$ %[1]s tx metadata write-record-specification recspec1qh... \
 recordname \
 inputname1,typename1,hashvalue; \
@@ -943,6 +1028,11 @@ owner,originator`, version.AppName),
 				return err
 			}
 
+			outputSchema, err := cmd.Flags().GetString(FlagOutputSchema)
+			if err != nil {
+				return err
+			}
+
 			recordSpecification := types.RecordSpecification{
 				SpecificationId:    specificationID,
 				Name:               recordName,
@@ -950,6 +1040,7 @@ owner,originator`, version.AppName),
 				TypeName:           args[3],
 				ResultType:         resultType,
 				ResponsibleParties: partyTypes,
+				OutputSchema:       outputSchema,
 			}
 
 			msg := types.NewMsgWriteRecordSpecificationRequest(recordSpecification, signers)
@@ -957,6 +1048,7 @@ owner,originator`, version.AppName),
 		},
 	}
 
+	cmd.Flags().String(FlagOutputSchema, "", "A JSON schema that the value of each output must conform to")
 	addSignersFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -1265,6 +1357,345 @@ least common denominator to achieve the desired precision.
 	return cmd
 }
 
+// GetCmdSetNetAssetValueMaxAge returns a CLI command for setting the max age of a scope's net asset values.
+func GetCmdSetNetAssetValueMaxAge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-nav-max-age <scope-metadata-address> <max-age>",
+		Aliases: []string{"set-net-asset-value-max-age"},
+		Short:   "Set the number of blocks after which a scope's net asset values are stale",
+		Long: `Set the number of blocks after which a scope's net asset values are stale.
+A max age of 0 means the scope's net asset values are never stale.`,
+		Example: fmt.Sprintf(`$ %[1]s tx %[2]s set-nav-max-age %[3]s 100000`, version.AppName, types.ModuleName, "scope1qzhp...tsk0cn"),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid metadata address %q: %w", args[0], err)
+			}
+			if !scopeID.IsScopeAddress() {
+				return fmt.Errorf("metadata address is not scope address: %v", scopeID.String())
+			}
+
+			maxAge, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid max age %q: %w", args[1], err)
+			}
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetNetAssetValueMaxAgeRequest(scopeID.String(), signers, maxAge)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPruneScopeHistory returns a command for removing the oldest prior versions of a scope and its sessions and records.
+func GetCmdPruneScopeHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-scope-history <scope-metadata-address> <keep>",
+		Short: "Remove the oldest prior versions of a scope and of its sessions and records",
+		Long: `Remove the oldest prior versions of a scope and of its sessions and records.
+The most recent <keep> prior versions of each are kept. At least one must be kept.`,
+		Example: fmt.Sprintf(`$ %[1]s tx %[2]s prune-scope-history %[3]s 10`, version.AppName, types.ModuleName, "scope1qzhp...tsk0cn"),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid metadata address %q: %w", args[0], err)
+			}
+			if !scopeID.IsScopeAddress() {
+				return fmt.Errorf("metadata address is not scope address: %v", scopeID.String())
+			}
+
+			keep, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid keep %q: %w", args[1], err)
+			}
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPruneScopeHistoryRequest(scopeID.String(), signers, uint32(keep))
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdProposeScopeOwnershipTransfer returns a command for proposing new owners and/or a new value owner for a scope.
+func GetCmdProposeScopeOwnershipTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-scope-ownership-transfer <scope-id> <expiration> [--owners <parties>] [--value-owner <address>]",
+		Short: "Propose new owners and/or a new value owner for a scope",
+		Long: `Propose new owners and/or a new value owner for a scope.
+The change is only made once the new owners accept it, which they must do before the <expiration>.
+The <expiration> is an RFC 3339 timestamp, e.g. 2026-07-01T00:00:00Z.
+The --owners are the full list of new owners for the scope, in the format "<address>[,<party type>][;<address>[,<party type>]...]".`,
+		Example: fmt.Sprintf(`$ %[1]s tx %[2]s propose-scope-ownership-transfer %[3]s 2026-07-01T00:00:00Z --owners %[4]s
+$ %[1]s tx %[2]s propose-scope-ownership-transfer %[3]s 2026-07-01T00:00:00Z --value-owner %[4]s`,
+			version.AppName, types.ModuleName, "scope1qzhp...tsk0cn", "pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42"),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid scope id %q: %w", args[0], err)
+			}
+			expiration, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return fmt.Errorf("invalid expiration %q: %w", args[1], err)
+			}
+
+			ownersStr, err := cmd.Flags().GetString(FlagOwners)
+			if err != nil {
+				return err
+			}
+			owners, err := parseParties(ownersStr)
+			if err != nil {
+				return err
+			}
+			valueOwner, err := cmd.Flags().GetString(FlagValueOwner)
+			if err != nil {
+				return err
+			}
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgProposeScopeOwnershipTransferRequest(scopeID, owners, valueOwner, expiration, signers)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagOwners, "", "the new owners of the scope")
+	cmd.Flags().String(FlagValueOwner, "", "the new value owner of the scope")
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAcceptScopeOwnershipTransfer returns a command for accepting a pending scope ownership transfer.
+func GetCmdAcceptScopeOwnershipTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-scope-ownership-transfer <scope-id>",
+		Short: "Accept a pending ownership transfer of a scope",
+		Long: `Accept a pending ownership transfer of a scope, applying it.
+All of the transfer's recipients must sign.`,
+		Example: fmt.Sprintf(`$ %[1]s tx %[2]s accept-scope-ownership-transfer %[3]s`, version.AppName, types.ModuleName, "scope1qzhp...tsk0cn"),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid scope id %q: %w", args[0], err)
+			}
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptScopeOwnershipTransferRequest(scopeID, signers)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelScopeOwnershipTransfer returns a command for cancelling (or declining) a pending scope ownership transfer.
+func GetCmdCancelScopeOwnershipTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scope-ownership-transfer <scope-id>",
+		Short: "Cancel or decline a pending ownership transfer of a scope",
+		Long: `Cancel or decline a pending ownership transfer of a scope.
+It can be signed by any of the transfer's proposers or recipients.`,
+		Example: fmt.Sprintf(`$ %[1]s tx %[2]s cancel-scope-ownership-transfer %[3]s`, version.AppName, types.ModuleName, "scope1qzhp...tsk0cn"),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid scope id %q: %w", args[0], err)
+			}
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelScopeOwnershipTransferRequest(scopeID, signers)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdWriteScopeBundle creates a command for adding or updating a scope with some of its sessions and records.
+func GetCmdWriteScopeBundle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "write-scope-bundle <bundle-file>",
+		Short: "Add or update a scope along with some of its sessions and records",
+		Long: `Add or update a scope along with some of its sessions and records in a single msg.
+The file must contain a JSON object with a "scope", and optionally "sessions" and "records" lists.
+The scope is written first, then the sessions, then the records. If any of them fail, none are written.
+The signers are used for every part of the bundle.`,
+		Example: fmt.Sprintf(`$ %[1]s tx %[2]s write-scope-bundle loan.json --%[3]s 1234`, version.AppName, types.ModuleName, FlagUsdMills),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("could not read bundle file %q: %w", args[0], err)
+			}
+			msg := &types.MsgWriteScopeBundleRequest{}
+			if err = clientCtx.Codec.UnmarshalJSON(bz, msg); err != nil {
+				return fmt.Errorf("could not parse bundle file %q: %w", args[0], err)
+			}
+
+			msg.Signers, err = parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(FlagUsdMills) {
+				if msg.UsdMills, err = cmd.Flags().GetUint64(FlagUsdMills); err != nil {
+					return err
+				}
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addSignersFlagToCmd(cmd)
+	cmd.Flags().Uint64(FlagUsdMills, 0, "Net asset value of the scope in usd mills (e.g. 1234 = $1.234), overrides the one in the file")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdArchiveScope creates a command for removing a scope along with all of its sessions, records, and net asset values.
+func GetCmdArchiveScope() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive-scope <scope-id>",
+		Short: "Remove a scope along with all of its sessions, records, and net asset values",
+		Long: `Remove a scope along with all of its sessions, records, and net asset values in a single msg.
+An event is emitted with everything that was removed, and a tombstone with the hash of it is kept.
+The signers must have the authority to delete the scope.`,
+		Example: fmt.Sprintf(`$ %[1]s tx %[2]s archive-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgArchiveScopeRequest(scopeID, signers)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdMigrateScopeSpec creates a command for moving scopes to a newer version of their scope specification.
+func GetCmdMigrateScopeSpec() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-scope-spec <scope-spec-id> <scope-id> [<scope-id 2> ...]",
+		Short: "Move one or more scopes to a newer version of their scope specification",
+		Long: `Move one or more scopes to a newer version of their scope specification.
+The scope specification must supersede (directly or through other versions) the one each scope currently uses.
+The sessions and records of each scope must use contract specifications allowed by the new scope specification.
+The signers must have the authority to update the owners of each scope.`,
+		Example: fmt.Sprintf(`$ %[1]s tx %[2]s migrate-scope-spec scopespec1qn7jh3jvw4gytq9r5x770e8yj74s9t479r scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn scope1qqg3uff00wpy2yuf7xr0rp8aucqs902xhw`,
+			version.AppName, types.ModuleName),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			specID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid scope spec id %q: %w", args[0], err)
+			}
+
+			scopeIDs := make([]types.MetadataAddress, len(args[1:]))
+			for i, arg := range args[1:] {
+				scopeIDs[i], err = types.MetadataAddressFromBech32(arg)
+				if err != nil {
+					return fmt.Errorf("invalid scope id %d %q: %w", i+1, arg, err)
+				}
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMigrateScopeSpecRequest(scopeIDs, specID, signers)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // addSignersFlagToCmd adds the standard --signers flag to a command.
 // See also: parseSigners.
 func addSignersFlagToCmd(cmd *cobra.Command) {
@@ -1330,3 +1761,28 @@ func ParseNetAssetValueString(netAssetValuesString string) ([]types.NetAssetValu
 	}
 	return netAssetValues, nil
 }
+
+// ParseOSLocatorEndpointsString splits a string (example uri1,1;uri2,2) into a list of ObjectStoreEndpoint's.
+// The priority is optional and defaults to 0.
+func ParseOSLocatorEndpointsString(endpointsString string) ([]types.ObjectStoreEndpoint, error) {
+	if len(endpointsString) == 0 {
+		return nil, nil
+	}
+	entries := strings.Split(endpointsString, ";")
+	endpoints := make([]types.ObjectStoreEndpoint, len(entries))
+	for i, entry := range entries {
+		uri, priorityStr, hasPriority := strings.Cut(entry, ",")
+		if len(strings.TrimSpace(uri)) == 0 {
+			return nil, fmt.Errorf("invalid endpoint %q, expected [uri,priority] or [uri]", entry)
+		}
+		endpoints[i].Uri = strings.TrimSpace(uri)
+		if hasPriority {
+			priority, err := strconv.ParseUint(strings.TrimSpace(priorityStr), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid endpoint priority %q: %w", priorityStr, err)
+			}
+			endpoints[i].Priority = uint32(priority)
+		}
+	}
+	return endpoints, nil
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetScopeArchive gets everything that currently exists for a scope: the scope (with its value owner),
+// and its sessions, records, and net asset values.
+func (k Keeper) GetScopeArchive(ctx sdk.Context, scopeID types.MetadataAddress) (*types.ScopeArchive, error) {
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", scopeID)
+	}
+	k.PopulateScopeValueOwner(ctx, &scope)
+
+	archive := &types.ScopeArchive{Scope: scope}
+	err := k.IterateSessions(ctx, scopeID, func(session types.Session) bool {
+		archive.Sessions = append(archive.Sessions, session)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get scope %s sessions: %w", scopeID, err)
+	}
+	err = k.IterateRecords(ctx, scopeID, func(record types.Record) bool {
+		archive.Records = append(archive.Records, record)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get scope %s records: %w", scopeID, err)
+	}
+	err = k.IterateNetAssetValues(ctx, scopeID, func(nav types.NetAssetValue) bool {
+		archive.NetAssetValues = append(archive.NetAssetValues, nav)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get scope %s net asset values: %w", scopeID, err)
+	}
+	return archive, nil
+}
+
+// ArchiveScope removes a scope along with its sessions, records, net asset values, and the prior versions of them.
+// Before anything is removed, an event is emitted with all of it, and a tombstone is kept with the hash of it.
+// The provided context should have the transfer agents needed to burn the scope's value owner coin.
+func (k Keeper) ArchiveScope(ctx sdk.Context, scopeID types.MetadataAddress) (*types.ScopeTombstone, error) {
+	archive, err := k.GetScopeArchive(ctx, scopeID)
+	if err != nil {
+		return nil, err
+	}
+	archiveHash, err := archive.Hash()
+	if err != nil {
+		return nil, err
+	}
+	k.EmitEvent(ctx, types.NewEventScopeArchived(*archive, archiveHash))
+
+	if err = k.RemoveScope(ctx, scopeID); err != nil {
+		return nil, fmt.Errorf("could not remove scope %s: %w", scopeID, err)
+	}
+	k.RemoveNetAssetValues(ctx, scopeID)
+	// The tombstone replaces the history, so that nothing about the archived scope is left to prune.
+	if _, err = k.RemoveScopeHistory(ctx, scopeID); err != nil {
+		return nil, fmt.Errorf("could not remove scope %s history: %w", scopeID, err)
+	}
+
+	tombstone := types.NewScopeTombstone(scopeID, archiveHash, types.NewEntryChange(ctx, true))
+	if err = k.SetScopeTombstone(ctx, tombstone); err != nil {
+		return nil, err
+	}
+	return &tombstone, nil
+}
+
+// SetScopeTombstone stores the record of an archived scope, replacing any it already has.
+func (k Keeper) SetScopeTombstone(ctx sdk.Context, tombstone types.ScopeTombstone) error {
+	if err := tombstone.Validate(); err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ScopeTombstoneKey(tombstone.ScopeId), k.cdc.MustMarshal(&tombstone))
+	return nil
+}
+
+// GetScopeTombstone gets the record of an archived scope.
+func (k Keeper) GetScopeTombstone(ctx sdk.Context, scopeID types.MetadataAddress) (types.ScopeTombstone, bool) {
+	var tombstone types.ScopeTombstone
+	bz := ctx.KVStore(k.storeKey).Get(types.ScopeTombstoneKey(scopeID))
+	if len(bz) == 0 {
+		return tombstone, false
+	}
+	k.cdc.MustUnmarshal(bz, &tombstone)
+	return tombstone, true
+}
+
+// IterateScopeTombstones iterates over the records of all archived scopes.
+func (k Keeper) IterateScopeTombstones(ctx sdk.Context, handler func(tombstone types.ScopeTombstone) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.ScopeTombstonePrefix)
+	defer it.Close() //nolint:errcheck // close error safe to ignore in this context.
+
+	for ; it.Valid(); it.Next() {
+		var tombstone types.ScopeTombstone
+		if err := k.cdc.Unmarshal(it.Value(), &tombstone); err != nil {
+			return fmt.Errorf("could not unmarshal scope tombstone: %w", err)
+		}
+		if handler(tombstone) {
+			break
+		}
+	}
+	return nil
+}
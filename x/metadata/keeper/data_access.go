@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// SetScopeDataAccessGrant stores the expiration of an address's data access to a scope, replacing any it already has.
+func (k Keeper) SetScopeDataAccessGrant(ctx sdk.Context, grant types.ScopeDataAccessGrant) error {
+	if err := grant.Validate(); err != nil {
+		return err
+	}
+	addr := sdk.MustAccAddressFromBech32(grant.Address)
+	k.RemoveScopeDataAccessGrant(ctx, grant.ScopeId, addr)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ScopeDataAccessGrantKey(grant.ScopeId, addr), k.cdc.MustMarshal(&grant))
+	store.Set(types.ScopeDataAccessExpirationKey(grant.Expiration, grant.ScopeId, addr), []byte{})
+	return nil
+}
+
+// GetScopeDataAccessGrant gets the expiration of an address's data access to a scope.
+// It returns false if that data access does not expire (or the address does not have data access).
+func (k Keeper) GetScopeDataAccessGrant(ctx sdk.Context, scopeID types.MetadataAddress, addr sdk.AccAddress) (types.ScopeDataAccessGrant, bool) {
+	var grant types.ScopeDataAccessGrant
+	bz := ctx.KVStore(k.storeKey).Get(types.ScopeDataAccessGrantKey(scopeID, addr))
+	if len(bz) == 0 {
+		return grant, false
+	}
+	k.cdc.MustUnmarshal(bz, &grant)
+	return grant, true
+}
+
+// RemoveScopeDataAccessGrant deletes the expiration of an address's data access to a scope (if it has one).
+func (k Keeper) RemoveScopeDataAccessGrant(ctx sdk.Context, scopeID types.MetadataAddress, addr sdk.AccAddress) {
+	grant, found := k.GetScopeDataAccessGrant(ctx, scopeID, addr)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ScopeDataAccessExpirationKey(grant.Expiration, scopeID, addr))
+	store.Delete(types.ScopeDataAccessGrantKey(scopeID, addr))
+}
+
+// IterateScopeDataAccessGrants iterates over the data access expirations of all scopes.
+func (k Keeper) IterateScopeDataAccessGrants(ctx sdk.Context, handler func(grant types.ScopeDataAccessGrant) (stop bool)) error {
+	return k.iterateScopeDataAccessGrants(ctx, types.ScopeDataAccessGrantPrefix, handler)
+}
+
+// GetScopeDataAccessGrants gets the data access expirations of a scope.
+func (k Keeper) GetScopeDataAccessGrants(ctx sdk.Context, scopeID types.MetadataAddress) ([]types.ScopeDataAccessGrant, error) {
+	var rv []types.ScopeDataAccessGrant
+	err := k.iterateScopeDataAccessGrants(ctx, types.ScopeDataAccessGrantKeyPrefix(scopeID), func(grant types.ScopeDataAccessGrant) bool {
+		rv = append(rv, grant)
+		return false
+	})
+	return rv, err
+}
+
+// iterateScopeDataAccessGrants iterates over the data access expirations under the provided prefix.
+func (k Keeper) iterateScopeDataAccessGrants(ctx sdk.Context, prefix []byte, handler func(grant types.ScopeDataAccessGrant) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, prefix)
+	defer it.Close() //nolint:errcheck // close error safe to ignore in this context.
+
+	for ; it.Valid(); it.Next() {
+		var grant types.ScopeDataAccessGrant
+		if err := k.cdc.Unmarshal(it.Value(), &grant); err != nil {
+			return fmt.Errorf("could not unmarshal scope data access grant: %w", err)
+		}
+		if handler(grant) {
+			break
+		}
+	}
+	return nil
+}
+
+// removeStaleScopeDataAccessGrants deletes the data access expirations of a scope for addresses no longer in its data access.
+// If the scope is nil, all of the scope's data access expirations are deleted.
+func (k Keeper) removeStaleScopeDataAccessGrants(ctx sdk.Context, scopeID types.MetadataAddress, scope *types.Scope) {
+	grants, err := k.GetScopeDataAccessGrants(ctx, scopeID)
+	if err != nil {
+		panic(fmt.Errorf("could not get the data access grants of %s: %w", scopeID, err))
+	}
+	for _, grant := range grants {
+		if scope == nil || !slices.Contains(scope.DataAccess, grant.Address) {
+			k.RemoveScopeDataAccessGrant(ctx, scopeID, sdk.MustAccAddressFromBech32(grant.Address))
+		}
+	}
+}
+
+// HasScopeDataAccess returns true if the provided address is in the scope's data access and that access has not expired.
+func (k Keeper) HasScopeDataAccess(ctx sdk.Context, scope types.Scope, addr string) bool {
+	if !slices.Contains(scope.DataAccess, addr) {
+		return false
+	}
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return false
+	}
+	grant, found := k.GetScopeDataAccessGrant(ctx, scope.ScopeId, accAddr)
+	return !found || !grant.IsExpired(ctx.BlockTime())
+}
+
+// ExpireScopeDataAccess removes the data access to scopes that expired before the current block.
+func (k Keeper) ExpireScopeDataAccess(ctx sdk.Context) {
+	blockTime := ctx.BlockTime()
+	store := ctx.KVStore(k.storeKey)
+	// The index only has the expiration seconds, so iterate to the end of the current second, then check each one.
+	end := types.ScopeDataAccessExpirationKeyPrefix(blockTime.Add(time.Second))
+	it := store.Iterator(types.ScopeDataAccessExpirationPrefix, end)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key()[len(types.ScopeDataAccessExpirationPrefix)+8:])
+	}
+	it.Close() //nolint:errcheck // close error safe to ignore in this context.
+
+	for _, key := range keys {
+		// Each key is the 17 byte scope id (type byte + uuid) followed by the address.
+		scopeID, addr := types.MetadataAddress(key[:17]), sdk.AccAddress(key[17:])
+		grant, found := k.GetScopeDataAccessGrant(ctx, scopeID, addr)
+		if !found || !grant.IsExpired(blockTime) {
+			continue
+		}
+		k.RemoveScopeDataAccessGrant(ctx, scopeID, addr)
+		scope, found := k.GetScope(ctx, scopeID)
+		if found && slices.Contains(scope.DataAccess, grant.Address) {
+			scope.RemoveDataAccess([]string{grant.Address})
+			if err := k.SetScope(ctx, scope); err != nil {
+				k.Logger(ctx).Error("could not remove expired data access", "scope_id", scopeID.String(),
+					"address", grant.Address, "err", err)
+				continue
+			}
+		}
+		k.EmitEvent(ctx, types.NewEventScopeDataAccessExpired(scopeID, grant.Address))
+	}
+}
@@ -34,6 +34,7 @@ type AttrKeeper interface {
 type MarkerKeeper interface {
 	GetMarkerByDenom(ctx sdk.Context, denom string) (markertypes.MarkerAccountI, error)
 	IsMarkerAccount(ctx sdk.Context, addr sdk.AccAddress) bool
+	GetMaxNavHistory(ctx sdk.Context) uint32
 }
 
 type BankKeeper interface {
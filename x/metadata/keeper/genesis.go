@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"strings"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
@@ -28,7 +26,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	}
 	if data.Records != nil {
 		for _, r := range data.Records {
-			k.SetRecord(ctx, r)
+			k.setRecord(ctx, r)
+		}
+		if err := k.RebuildScopeRecordsRoots(ctx); err != nil {
+			panic(err)
 		}
 	}
 	if data.ScopeSpecifications != nil {
@@ -48,27 +49,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	}
 	if data.ObjectStoreLocators != nil {
 		for _, s := range data.ObjectStoreLocators {
-			addr, err := sdk.AccAddressFromBech32(s.Owner)
-			if err != nil {
-				panic(err)
-			}
-			encryptionKey := sdk.AccAddress{}
-			if strings.TrimSpace(s.EncryptionKey) != "" {
-				encryptionKey, _ = sdk.AccAddressFromBech32(s.EncryptionKey)
-			}
-			err = k.ImportOSLocatorRecord(ctx, addr, encryptionKey, s.LocatorUri)
-			if err != nil {
+			if err := k.ImportOSLocator(ctx, s); err != nil {
 				panic(err)
 			}
 		}
 	}
 
 	for _, mNavs := range data.NetAssetValues {
+		address, err := types.MetadataAddressFromBech32(mNavs.Address)
+		if err != nil {
+			panic(err)
+		}
 		for _, nav := range mNavs.NetAssetValues {
-			address, err := types.MetadataAddressFromBech32(mNavs.Address)
-			if err != nil {
-				panic(err)
-			}
 			// Extra guard here in case volume is null or invalid
 			volume := nav.GetVolume()
 			if volume < 1 {
@@ -79,6 +71,53 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 				panic(err)
 			}
 		}
+		// Setting the net asset values above might have recorded some history, so replace it with what's provided.
+		if len(mNavs.History) > 0 {
+			k.RemoveNetAssetValueHistory(ctx, address)
+			for _, record := range mNavs.History {
+				if err = k.SetNetAssetValueRecord(ctx, address, record); err != nil {
+					panic(err)
+				}
+			}
+		}
+		k.SetNetAssetValueMaxAge(ctx, address, mNavs.MaxAge)
+	}
+
+	for _, scopeVersion := range data.ScopeVersions {
+		if err := k.SetScopeVersion(ctx, scopeVersion); err != nil {
+			panic(err)
+		}
+	}
+	for _, sessionVersion := range data.SessionVersions {
+		if err := k.SetSessionVersion(ctx, sessionVersion); err != nil {
+			panic(err)
+		}
+	}
+	for _, recordVersion := range data.RecordVersions {
+		if err := k.SetRecordVersion(ctx, recordVersion); err != nil {
+			panic(err)
+		}
+	}
+	for _, transfer := range data.ScopeOwnershipTransfers {
+		if err := k.SetScopeOwnershipTransfer(ctx, transfer); err != nil {
+			panic(err)
+		}
+	}
+	// Setting the scopes above recorded the current height for them, so replace that with what's provided.
+	for _, heights := range data.ScopeHeights {
+		if err := k.SetScopeHeights(ctx, heights); err != nil {
+			panic(err)
+		}
+	}
+	for _, grant := range data.ScopeDataAccessGrants {
+		if err := k.SetScopeDataAccessGrant(ctx, grant); err != nil {
+			panic(err)
+		}
+	}
+	for _, tombstone := range data.ScopeTombstones {
+		if err := k.SetScopeTombstone(ctx, tombstone); err != nil {
+			panic(err)
+		}
 	}
 }
 
@@ -163,10 +202,86 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		var history []types.NetAssetValueRecord
+		err = k.IterateNetAssetValueHistory(ctx, scopes[i].ScopeId, "", func(record types.NetAssetValueRecord) (stop bool) {
+			history = append(history, record)
+			return false
+		})
+		if err != nil {
+			panic(err)
+		}
 		markerNavs.Address = scopes[i].ScopeId.String()
 		markerNavs.NetAssetValues = navs
+		markerNavs.History = history
+		markerNavs.MaxAge = k.GetNetAssetValueMaxAge(ctx, scopes[i].ScopeId)
 		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(types.Params{}, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, markerNetAssetValues)
+	var scopeVersions []types.ScopeVersion
+	err := k.IterateScopeVersions(ctx, types.MetadataAddress{}, func(scopeVersion types.ScopeVersion) bool {
+		scopeVersions = append(scopeVersions, scopeVersion)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	var sessionVersions []types.SessionVersion
+	err = k.IterateSessionVersions(ctx, types.MetadataAddress{}, func(sessionVersion types.SessionVersion) bool {
+		sessionVersions = append(sessionVersions, sessionVersion)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	var recordVersions []types.RecordVersion
+	err = k.IterateRecordVersions(ctx, types.MetadataAddress{}, func(recordVersion types.RecordVersion) bool {
+		recordVersions = append(recordVersions, recordVersion)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	var transfers []types.ScopeOwnershipTransfer
+	err = k.IterateScopeOwnershipTransfers(ctx, func(transfer types.ScopeOwnershipTransfer) bool {
+		transfers = append(transfers, transfer)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var scopeHeights []types.ScopeHeights
+	err = k.IterateScopeHeights(ctx, func(heights types.ScopeHeights) bool {
+		scopeHeights = append(scopeHeights, heights)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	var dataAccessGrants []types.ScopeDataAccessGrant
+	err = k.IterateScopeDataAccessGrants(ctx, func(grant types.ScopeDataAccessGrant) bool {
+		dataAccessGrants = append(dataAccessGrants, grant)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	var tombstones []types.ScopeTombstone
+	err = k.IterateScopeTombstones(ctx, func(tombstone types.ScopeTombstone) bool {
+		tombstones = append(tombstones, tombstone)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(types.Params{}, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, markerNetAssetValues)
+	genState.ScopeVersions = scopeVersions
+	genState.SessionVersions = sessionVersions
+	genState.RecordVersions = recordVersions
+	genState.ScopeOwnershipTransfers = transfers
+	genState.ScopeHeights = scopeHeights
+	genState.ScopeDataAccessGrants = dataAccessGrants
+	genState.ScopeTombstones = tombstones
+	return genState
 }
@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// nextEntryVersion gets the number to use for the next prior version of a scope, session, or record.
+func (k Keeper) nextEntryVersion(store storetypes.KVStore, id types.MetadataAddress) uint64 {
+	it := storetypes.KVStoreReversePrefixIterator(store, types.EntryVersionKeyPrefix(id))
+	defer it.Close() //nolint:errcheck // close error safe to ignore in this context.
+	if !it.Valid() {
+		return 1
+	}
+	key := it.Key()
+	return sdk.BigEndianToUint64(key[len(key)-8:]) + 1
+}
+
+// addEntryVersion stores a prior version of a scope, session, or record, then removes the oldest
+// versions of it beyond MaxEntryVersions.
+func (k Keeper) addEntryVersion(store storetypes.KVStore, id types.MetadataAddress, version uint64, entryVersion proto.Message) {
+	store.Set(types.EntryVersionKey(id, version), k.cdc.MustMarshal(entryVersion))
+	k.pruneEntryVersions(store, types.EntryVersionKeyPrefix(id), types.MaxEntryVersions)
+}
+
+// pruneEntryVersions removes all but the most recent keep versions of each entry with versions under the provided prefix.
+// Returns the number of versions removed.
+func (k Keeper) pruneEntryVersions(store storetypes.KVStore, prefix []byte, keep int) int {
+	it := storetypes.KVStoreReversePrefixIterator(store, prefix)
+	var toRemove [][]byte
+	var lastID []byte
+	count := 0
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		id := key[:len(key)-8]
+		if !bytes.Equal(id, lastID) {
+			lastID = id
+			count = 0
+		}
+		count++
+		if count > keep {
+			toRemove = append(toRemove, key)
+		}
+	}
+	it.Close() //nolint:errcheck // close error safe to ignore in this context.
+
+	for _, key := range toRemove {
+		store.Delete(key)
+	}
+	return len(toRemove)
+}
+
+// addScopeVersion records the provided scope as a prior version of itself.
+func (k Keeper) addScopeVersion(ctx sdk.Context, scope types.Scope, deleted bool) {
+	store := ctx.KVStore(k.storeKey)
+	version := k.nextEntryVersion(store, scope.ScopeId)
+	k.addEntryVersion(store, scope.ScopeId, version, &types.ScopeVersion{
+		Version: version,
+		Scope:   scope,
+		Change:  types.NewEntryChange(ctx, deleted),
+	})
+}
+
+// addScopeValueOwnerVersion records an existing scope as a prior version of itself, with the value owner that
+// it had before its value owner was changed. Nothing is recorded if the scope does not exist (yet).
+func (k Keeper) addScopeValueOwnerVersion(ctx sdk.Context, scopeID types.MetadataAddress, prevValueOwner sdk.AccAddress) {
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return
+	}
+	if len(prevValueOwner) > 0 {
+		scope.ValueOwnerAddress = prevValueOwner.String()
+	}
+	k.addScopeVersion(ctx, scope, false)
+}
+
+// addSessionVersion records the provided session as a prior version of itself.
+func (k Keeper) addSessionVersion(ctx sdk.Context, session types.Session, deleted bool) {
+	store := ctx.KVStore(k.storeKey)
+	version := k.nextEntryVersion(store, session.SessionId)
+	k.addEntryVersion(store, session.SessionId, version, &types.SessionVersion{
+		Version: version,
+		Session: session,
+		Change:  types.NewEntryChange(ctx, deleted),
+	})
+}
+
+// addRecordVersion records the provided record as a prior version of itself.
+func (k Keeper) addRecordVersion(ctx sdk.Context, recordID types.MetadataAddress, record types.Record, deleted bool) {
+	store := ctx.KVStore(k.storeKey)
+	version := k.nextEntryVersion(store, recordID)
+	k.addEntryVersion(store, recordID, version, &types.RecordVersion{
+		Version: version,
+		Record:  record,
+		Change:  types.NewEntryChange(ctx, deleted),
+	})
+}
+
+// GetLatestScopeVersion gets the most recent prior version of a scope.
+// If the scope has been deleted, this is the version recorded when it was deleted.
+func (k Keeper) GetLatestScopeVersion(ctx sdk.Context, scopeID types.MetadataAddress) (types.ScopeVersion, bool) {
+	var scopeVersion types.ScopeVersion
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStoreReversePrefixIterator(store, types.EntryVersionKeyPrefix(scopeID))
+	defer it.Close() //nolint:errcheck // close error safe to ignore in this context.
+	if !it.Valid() {
+		return scopeVersion, false
+	}
+	k.cdc.MustUnmarshal(it.Value(), &scopeVersion)
+	return scopeVersion, true
+}
+
+// SetScopeVersion stores a prior version of a scope.
+func (k Keeper) SetScopeVersion(ctx sdk.Context, scopeVersion types.ScopeVersion) error {
+	if err := scopeVersion.Validate(); err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EntryVersionKey(scopeVersion.Scope.ScopeId, scopeVersion.Version), k.cdc.MustMarshal(&scopeVersion))
+	return nil
+}
+
+// SetSessionVersion stores a prior version of a session.
+func (k Keeper) SetSessionVersion(ctx sdk.Context, sessionVersion types.SessionVersion) error {
+	if err := sessionVersion.Validate(); err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EntryVersionKey(sessionVersion.Session.SessionId, sessionVersion.Version), k.cdc.MustMarshal(&sessionVersion))
+	return nil
+}
+
+// SetRecordVersion stores a prior version of a record.
+func (k Keeper) SetRecordVersion(ctx sdk.Context, recordVersion types.RecordVersion) error {
+	if err := recordVersion.Validate(); err != nil {
+		return err
+	}
+	recordID := recordVersion.Record.GetRecordAddress()
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EntryVersionKey(recordID, recordVersion.Version), k.cdc.MustMarshal(&recordVersion))
+	return nil
+}
+
+// entryVersionIteratorPrefix gets the prefix for iterating over the prior versions of the entry with the provided id.
+// If the id is empty, the prefix for iterating over the prior versions of all entries of the provided type is returned.
+func entryVersionIteratorPrefix(id types.MetadataAddress, typePrefix []byte) []byte {
+	if len(id) == 0 {
+		return append(types.EntryVersionPrefix, typePrefix...)
+	}
+	return types.EntryVersionKeyPrefix(id)
+}
+
+// iterateEntryVersions iterates over the prior versions stored under the provided prefix, ordered by entry, then version.
+func (k Keeper) iterateEntryVersions(ctx sdk.Context, prefix []byte, handler func(bz []byte) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, prefix)
+	defer it.Close() //nolint:errcheck // close error safe to ignore in this context.
+
+	for ; it.Valid(); it.Next() {
+		stop, err := handler(it.Value())
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateScopeVersions iterates over the prior versions of a scope, from oldest to newest.
+// If the scope id is empty, the prior versions of all scopes are iterated.
+func (k Keeper) IterateScopeVersions(ctx sdk.Context, scopeID types.MetadataAddress, handler func(types.ScopeVersion) (stop bool)) error {
+	return k.iterateEntryVersions(ctx, entryVersionIteratorPrefix(scopeID, types.ScopeKeyPrefix), func(bz []byte) (bool, error) {
+		var scopeVersion types.ScopeVersion
+		if err := k.cdc.Unmarshal(bz, &scopeVersion); err != nil {
+			return true, fmt.Errorf("could not unmarshal scope version: %w", err)
+		}
+		return handler(scopeVersion), nil
+	})
+}
+
+// IterateSessionVersions iterates over the prior versions of a session, from oldest to newest.
+// If the session id is empty, the prior versions of all sessions are iterated.
+func (k Keeper) IterateSessionVersions(ctx sdk.Context, sessionID types.MetadataAddress, handler func(types.SessionVersion) (stop bool)) error {
+	return k.iterateEntryVersions(ctx, entryVersionIteratorPrefix(sessionID, types.SessionKeyPrefix), func(bz []byte) (bool, error) {
+		var sessionVersion types.SessionVersion
+		if err := k.cdc.Unmarshal(bz, &sessionVersion); err != nil {
+			return true, fmt.Errorf("could not unmarshal session version: %w", err)
+		}
+		return handler(sessionVersion), nil
+	})
+}
+
+// IterateRecordVersions iterates over the prior versions of a record, from oldest to newest.
+// If the record id is empty, the prior versions of all records are iterated.
+func (k Keeper) IterateRecordVersions(ctx sdk.Context, recordID types.MetadataAddress, handler func(types.RecordVersion) (stop bool)) error {
+	return k.iterateEntryVersions(ctx, entryVersionIteratorPrefix(recordID, types.RecordKeyPrefix), func(bz []byte) (bool, error) {
+		var recordVersion types.RecordVersion
+		if err := k.cdc.Unmarshal(bz, &recordVersion); err != nil {
+			return true, fmt.Errorf("could not unmarshal record version: %w", err)
+		}
+		return handler(recordVersion), nil
+	})
+}
+
+// PruneScopeHistory removes all but the most recent keep prior versions of a scope and of each of its sessions
+// and records. Returns the number of versions removed.
+func (k Keeper) PruneScopeHistory(ctx sdk.Context, scopeID types.MetadataAddress, keep uint32) (int, error) {
+	prefixes, err := types.ScopeEntryVersionKeyPrefixes(scopeID)
+	if err != nil {
+		return 0, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	removed := 0
+	for _, prefix := range prefixes {
+		removed += k.pruneEntryVersions(store, prefix, int(keep))
+	}
+	return removed, nil
+}
+
+// RemoveScopeHistory removes all prior versions of a scope and of its sessions and records.
+// Returns the number of versions removed.
+func (k Keeper) RemoveScopeHistory(ctx sdk.Context, scopeID types.MetadataAddress) (int, error) {
+	return k.PruneScopeHistory(ctx, scopeID, 0)
+}
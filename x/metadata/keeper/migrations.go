@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate4to5 builds the index of scopes by owner address and role, and records the heights of existing scopes.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.Logger(ctx).Info("migrating metadata module from version 4 to 5 (building scope owner role index and scope heights)")
+	if err := m.keeper.RebuildScopeRoleIndex(ctx); err != nil {
+		return err
+	}
+	return m.keeper.BackfillScopeHeights(ctx)
+}
+
+// Migrate5to6 calculates the merkle roots over the records of each scope.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.keeper.Logger(ctx).Info("migrating metadata module from version 5 to 6 (calculating scope records roots)")
+	return m.keeper.RebuildScopeRecordsRoots(ctx)
+}
@@ -387,6 +387,10 @@ func (k *MockMarkerKeeper) IsMarkerAccount(_ sdk.Context, addr sdk.AccAddress) b
 	return k.IsMarkerAccountResults[string(addr)]
 }
 
+func (k *MockMarkerKeeper) GetMaxNavHistory(_ sdk.Context) uint32 {
+	return 0
+}
+
 // ensure that the MockBankKeeper implements keeper.BankKeeper.
 var _ keeper.BankKeeper = (*MockBankKeeper)(nil)
 
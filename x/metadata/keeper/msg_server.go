@@ -33,14 +33,25 @@ func (k msgServer) WriteScope(
 	msg *types.MsgWriteScopeRequest,
 ) (*types.MsgWriteScopeResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "WriteScope")
-	ctx := UnwrapMetadataContext(goCtx)
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
 
 	//nolint:errcheck,gosec // G104: the error was checked when msg.ValidateBasic was called before getting here.
 	msg.ConvertOptionalFields()
 
-	transferAgents, err := k.ValidateWriteScope(ctx, msg)
+	if err := k.writeScope(ctx, msg, nil); err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteScope, msg.GetSignerStrs()))
+	return types.NewMsgWriteScopeResponse(msg.Scope.ScopeId), nil
+}
+
+// writeScope validates and stores the scope (and optional net asset value) in a MsgWriteScopeRequest.
+// If signers is not nil, the signatures required from the scope's owners are added to it instead of being checked.
+func (k msgServer) writeScope(ctx sdk.Context, msg *types.MsgWriteScopeRequest, signers *signerRequirements) error {
+	transferAgents, err := k.validateWriteScope(ctx, msg, signers)
 	if err != nil {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
 	// Do not set a NAV entry at this time unless a value greater than zero is specified.  This avoids the common case of
@@ -51,17 +62,15 @@ func (k msgServer) WriteScope(
 		nav := types.NewNetAssetValue(sdk.NewCoin(types.UsdDenom, usdMills), 1)
 		err = k.AddSetNetAssetValues(ctx, msg.Scope.ScopeId, []types.NetAssetValue{nav}, types.ModuleName)
 		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 		}
 	}
 
 	err = k.SetScope(markertypes.WithTransferAgents(ctx, transferAgents...), msg.Scope)
 	if err != nil {
-		return nil, fmt.Errorf("could not write scope %q: %w", msg.Scope.ScopeId, err)
+		return fmt.Errorf("could not write scope %q: %w", msg.Scope.ScopeId, err)
 	}
-
-	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteScope, msg.GetSignerStrs()))
-	return types.NewMsgWriteScopeResponse(msg.Scope.ScopeId), nil
+	return nil
 }
 
 // DeleteScope deletes a scope and all associated Records, Sessions.
@@ -70,7 +79,7 @@ func (k msgServer) DeleteScope(
 	msg *types.MsgDeleteScopeRequest,
 ) (*types.MsgDeleteScopeResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "DeleteScope")
-	ctx := UnwrapMetadataContext(goCtx)
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
 
 	transferAgents, err := k.ValidateDeleteScope(ctx, msg)
 	if err != nil {
@@ -94,7 +103,7 @@ func (k msgServer) AddScopeDataAccess(
 	msg *types.MsgAddScopeDataAccessRequest,
 ) (*types.MsgAddScopeDataAccessResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "AddScopeDataAccess")
-	ctx := UnwrapMetadataContext(goCtx)
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
 
 	existing, found := k.GetScope(ctx, msg.ScopeId)
 	if !found {
@@ -112,6 +121,18 @@ func (k msgServer) AddScopeDataAccess(
 		return nil, fmt.Errorf("could not update scope %q: %w", msg.ScopeId, err)
 	}
 
+	// Any expiration that an address already had is replaced, or cleared if there's no expiration now.
+	for _, addr := range msg.DataAccess {
+		if msg.Expiration == nil {
+			k.RemoveScopeDataAccessGrant(ctx, msg.ScopeId, sdk.MustAccAddressFromBech32(addr))
+			continue
+		}
+		grant := types.NewScopeDataAccessGrant(msg.ScopeId, addr, *msg.Expiration)
+		if err = k.SetScopeDataAccessGrant(ctx, grant); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AddScopeDataAccess, msg.GetSignerStrs()))
 	return &types.MsgAddScopeDataAccessResponse{}, nil
 }
@@ -122,7 +143,7 @@ func (k msgServer) DeleteScopeDataAccess(
 	msg *types.MsgDeleteScopeDataAccessRequest,
 ) (*types.MsgDeleteScopeDataAccessResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "DeleteScopeDataAccess")
-	ctx := UnwrapMetadataContext(goCtx)
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
 
 	existing, found := k.GetScope(ctx, msg.ScopeId)
 	if !found {
@@ -150,7 +171,7 @@ func (k msgServer) AddScopeOwner(
 	msg *types.MsgAddScopeOwnerRequest,
 ) (*types.MsgAddScopeOwnerResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "AddScopeOwner")
-	ctx := UnwrapMetadataContext(goCtx)
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
 
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -186,7 +207,7 @@ func (k msgServer) DeleteScopeOwner(
 	msg *types.MsgDeleteScopeOwnerRequest,
 ) (*types.MsgDeleteScopeOwnerResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "DeleteScopeOwner")
-	ctx := UnwrapMetadataContext(goCtx)
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
 
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -222,7 +243,7 @@ func (k msgServer) UpdateValueOwners(
 	msg *types.MsgUpdateValueOwnersRequest,
 ) (*types.MsgUpdateValueOwnersResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "UpdateValueOwners")
-	ctx := UnwrapMetadataContext(goCtx)
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
 
 	links, err := k.GetScopeValueOwners(ctx, msg.ScopeIds)
 	if err != nil {
@@ -249,7 +270,7 @@ func (k msgServer) MigrateValueOwner(
 	msg *types.MsgMigrateValueOwnerRequest,
 ) (*types.MsgMigrateValueOwnerResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "MigrateValueOwner")
-	ctx := UnwrapMetadataContext(goCtx)
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
 
 	addr, err := sdk.AccAddressFromBech32(msg.Existing)
 	if err != nil {
@@ -284,27 +305,36 @@ func (k msgServer) WriteSession(
 	msg *types.MsgWriteSessionRequest,
 ) (*types.MsgWriteSessionResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "WriteSession")
-	ctx := UnwrapMetadataContext(goCtx)
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
 
 	//nolint:errcheck,gosec // G104: the error was checked when msg.ValidateBasic was called before getting here.
 	msg.ConvertOptionalFields()
 
+	if err := k.writeSession(ctx, msg, nil); err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteSession, msg.GetSignerStrs()))
+	return types.NewMsgWriteSessionResponse(msg.Session.SessionId), nil
+}
+
+// writeSession validates and stores the session in a MsgWriteSessionRequest.
+// If signers is not nil, the signatures required for the session are added to it instead of being checked.
+func (k msgServer) writeSession(ctx sdk.Context, msg *types.MsgWriteSessionRequest, signers *signerRequirements) error {
 	var existing *types.Session
 	var existingAudit *types.AuditFields
 	if e, found := k.GetSession(ctx, msg.Session.SessionId); found {
 		existing = &e
 		existingAudit = existing.Audit
 	}
-	if err := k.ValidateWriteSession(ctx, existing, msg); err != nil {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	if err := k.validateWriteSession(ctx, existing, msg, signers); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
 	msg.Session.Audit = existingAudit.UpdateAudit(ctx.BlockTime(), strings.Join(msg.Signers, ", "), "")
 
 	k.SetSession(ctx, msg.Session)
-
-	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteSession, msg.GetSignerStrs()))
-	return types.NewMsgWriteSessionResponse(msg.Session.SessionId), nil
+	return nil
 }
 
 // WriteRecord adds or updates a record.
@@ -313,11 +343,25 @@ func (k msgServer) WriteRecord(
 	msg *types.MsgWriteRecordRequest,
 ) (*types.MsgWriteRecordResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "WriteRecord")
-	ctx := UnwrapMetadataContext(goCtx)
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
 
 	//nolint:errcheck,gosec // G104: the error was checked when msg.ValidateBasic was called before getting here.
 	msg.ConvertOptionalFields()
 
+	recordID, err := k.writeRecord(ctx, msg, nil)
+	if err != nil {
+		return nil, err
+	}
+	k.updateScopeRecordsRoot(ctx, recordID.MustGetAsScopeAddress())
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteRecord, msg.GetSignerStrs()))
+	return types.NewMsgWriteRecordResponse(recordID), nil
+}
+
+// writeRecord validates and stores the record in a MsgWriteRecordRequest, returning the record's id.
+// The scope's records root is not updated; that's left to the caller so it's only done once per scope.
+// If signers is not nil, the signatures required for the record are added to it instead of being checked.
+func (k msgServer) writeRecord(ctx sdk.Context, msg *types.MsgWriteRecordRequest, signers *signerRequirements) (types.MetadataAddress, error) {
 	scopeUUID, err := msg.Record.SessionId.ScopeUUID()
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -329,20 +373,18 @@ func (k msgServer) WriteRecord(
 	if e, found := k.GetRecord(ctx, recordID); found {
 		existing = &e
 	}
-	if err = k.ValidateWriteRecord(ctx, existing, msg); err != nil {
+	if err = k.validateWriteRecord(ctx, existing, msg, signers); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
-	k.SetRecord(ctx, msg.Record)
+	k.setRecord(ctx, msg.Record)
 
 	// Remove the old session if it doesn't have any records in it anymore.
 	// Note that the RemoveSession does the record checking part.
 	if existing != nil && !existing.SessionId.Equals(msg.Record.SessionId) {
 		k.RemoveSession(ctx, existing.SessionId)
 	}
-
-	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteRecord, msg.GetSignerStrs()))
-	return types.NewMsgWriteRecordResponse(recordID), nil
+	return recordID, nil
 }
 
 // DeleteRecord deletes a record.
@@ -351,7 +393,7 @@ func (k msgServer) DeleteRecord(
 	msg *types.MsgDeleteRecordRequest,
 ) (*types.MsgDeleteRecordResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "DeleteRecord")
-	ctx := UnwrapMetadataContext(goCtx)
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
 
 	if err := k.ValidateDeleteRecord(ctx, msg.RecordId, msg); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -374,9 +416,12 @@ func (k msgServer) WriteScopeSpecification(
 	//nolint:errcheck,gosec // G104: the error was checked when msg.ValidateBasic was called before getting here.
 	msg.ConvertOptionalFields()
 
+	// The superseded by field is managed by the module, so whatever is already there is kept.
+	msg.Specification.SupersededBy = nil
 	var existing *types.ScopeSpecification
 	if e, found := k.GetScopeSpecification(ctx, msg.Specification.SpecificationId); found {
 		existing = &e
+		msg.Specification.SupersededBy = existing.SupersededBy
 		if err := k.ValidateSignersWithoutParties(ctx, existing.OwnerAddresses, msg); err != nil {
 			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 		}
@@ -385,7 +430,22 @@ func (k msgServer) WriteScopeSpecification(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	// Only the owners of a scope spec can declare a newer version of it.
+	supersedes := msg.Specification.Supersedes
+	newlySupersedes := !supersedes.Empty() && (existing == nil || !existing.Supersedes.Equals(supersedes))
+	if newlySupersedes {
+		oldSpec, _ := k.GetScopeSpecification(ctx, supersedes)
+		if err := k.ValidateSignersWithoutParties(ctx, oldSpec.OwnerAddresses, msg); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot supersede scope specification %s: %v", supersedes, err)
+		}
+	}
+
 	k.SetScopeSpecification(ctx, msg.Specification)
+	if newlySupersedes {
+		if err := k.SupersedeScopeSpecification(ctx, supersedes, msg.Specification.SpecificationId); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteScopeSpecification, msg.GetSignerStrs()))
 	return types.NewMsgWriteScopeSpecificationResponse(msg.Specification.SpecificationId), nil
@@ -553,7 +613,11 @@ func (k msgServer) DeleteContractSpecFromScopeSpec(
 		return nil, sdkerrors.ErrNotFound.Wrapf("contract specification %s not found in scope specification %s", msg.ContractSpecificationId, msg.ScopeSpecificationId)
 	}
 
+	existing := scopeSpec
 	scopeSpec.ContractSpecIds = updateContractSpecIDs
+	if err := k.validateScopeSpecChangesAllowed(ctx, &existing, scopeSpec); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
 	k.SetScopeSpecification(ctx, scopeSpec)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteContractSpecFromScopeSpec, msg.GetSignerStrs()))
@@ -658,13 +722,14 @@ func (k msgServer) BindOSLocator(
 	}
 
 	// Bind owner to URI
-	if err := k.SetOSLocator(ctx, ownerAddress, encryptionKey, msg.Locator.LocatorUri); err != nil {
+	if err := k.SetOSLocator(ctx, ownerAddress, encryptionKey, msg.Locator.LocatorUri, msg.Locator.Endpoints...); err != nil {
 		ctx.Logger().Error("unable to bind name", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_BindOSLocator, msg.GetSignerStrs()))
-	return &types.MsgBindOSLocatorResponse{Locator: msg.Locator}, nil
+	locator, _ := k.GetOsLocatorRecord(ctx, ownerAddress)
+	return &types.MsgBindOSLocatorResponse{Locator: locator}, nil
 }
 
 // DeleteOSLocator deletes an existing ObjectStoreLocator record.
@@ -733,13 +798,14 @@ func (k msgServer) ModifyOSLocator(
 		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot delete os locator.")
 	}
 	// Modify
-	if err := k.Keeper.ModifyOSLocator(ctx, ownerAddr, encryptionKey, msg.Locator.LocatorUri); err != nil {
+	if err := k.Keeper.ModifyOSLocator(ctx, ownerAddr, encryptionKey, msg.Locator.LocatorUri, msg.Locator.Endpoints...); err != nil {
 		ctx.Logger().Error("error deleting name", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ModifyOSLocator, msg.GetSignerStrs()))
-	return &types.MsgModifyOSLocatorResponse{Locator: msg.Locator}, nil
+	locator, _ := k.GetOsLocatorRecord(ctx, ownerAddr)
+	return &types.MsgModifyOSLocatorResponse{Locator: locator}, nil
 }
 
 // SetAccountData associates some basic data with a metadata address.
@@ -787,3 +853,228 @@ func (k msgServer) AddNetAssetValues(goCtx context.Context, msg *types.MsgAddNet
 
 	return &types.MsgAddNetAssetValuesResponse{}, nil
 }
+
+// SetNetAssetValueMaxAge sets the number of blocks after which a scope's net asset values are stale.
+func (k msgServer) SetNetAssetValueMaxAge(goCtx context.Context, msg *types.MsgSetNetAssetValueMaxAgeRequest) (*types.MsgSetNetAssetValueMaxAgeResponse, error) {
+	ctx := UnwrapMetadataContext(goCtx)
+
+	scopeID, err := types.MetadataAddressFromBech32(msg.ScopeId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrap(fmt.Sprintf("scope not found: %v", scopeID.String()))
+	}
+
+	err = k.ValidateSignersWithoutParties(ctx, scope.GetAllOwnerAddresses(), msg)
+	if err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	prevMaxAge := k.GetNetAssetValueMaxAge(ctx, scopeID)
+	k.Keeper.SetNetAssetValueMaxAge(ctx, scopeID, msg.MaxAge)
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventSetNetAssetValueMaxAge(scopeID, msg.MaxAge)); err != nil {
+		return nil, err
+	}
+	// Net asset values that are only stale because of the new max age won't be caught by the begin blocker.
+	k.emitStaleNetAssetValueEvents(ctx, scopeID, msg.MaxAge, func(nav types.NetAssetValue) bool {
+		return nav.IsStale(prevMaxAge, ctx.BlockHeight())
+	})
+
+	return &types.MsgSetNetAssetValueMaxAgeResponse{}, nil
+}
+
+// PruneScopeHistory removes the oldest prior versions of a scope and of its sessions and records.
+func (k msgServer) PruneScopeHistory(goCtx context.Context, msg *types.MsgPruneScopeHistoryRequest) (*types.MsgPruneScopeHistoryResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "PruneScopeHistory")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	scopeID, err := types.MetadataAddressFromBech32(msg.ScopeId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		// A deleted scope's history can still be pruned by the owners it had when it was deleted.
+		scopeVersion, hasHistory := k.GetLatestScopeVersion(ctx, scopeID)
+		if !hasHistory {
+			return nil, sdkerrors.ErrNotFound.Wrap(fmt.Sprintf("scope not found: %v", scopeID.String()))
+		}
+		scope = scopeVersion.Scope
+	}
+
+	err = k.ValidateSignersWithoutParties(ctx, scope.GetAllOwnerAddresses(), msg)
+	if err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	if _, err = k.Keeper.PruneScopeHistory(ctx, scopeID, msg.Keep); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_PruneScopeHistory, msg.GetSignerStrs()))
+	return &types.MsgPruneScopeHistoryResponse{}, nil
+}
+
+// ProposeScopeOwnershipTransfer proposes new owners and/or a new value owner for a scope.
+func (k msgServer) ProposeScopeOwnershipTransfer(
+	goCtx context.Context,
+	msg *types.MsgProposeScopeOwnershipTransferRequest,
+) (*types.MsgProposeScopeOwnershipTransferResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "ProposeScopeOwnershipTransfer")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if _, found := k.GetScope(ctx, msg.ScopeId); !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope not found with id %s", msg.ScopeId)
+	}
+
+	if err := k.Keeper.ProposeScopeOwnershipTransfer(ctx, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ProposeScopeOwnershipTransfer, msg.GetSignerStrs()))
+	return &types.MsgProposeScopeOwnershipTransferResponse{}, nil
+}
+
+// AcceptScopeOwnershipTransfer accepts a pending scope ownership transfer, applying it to the scope.
+func (k msgServer) AcceptScopeOwnershipTransfer(
+	goCtx context.Context,
+	msg *types.MsgAcceptScopeOwnershipTransferRequest,
+) (*types.MsgAcceptScopeOwnershipTransferResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "AcceptScopeOwnershipTransfer")
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err := k.Keeper.AcceptScopeOwnershipTransfer(ctx, msg.ScopeId, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AcceptScopeOwnershipTransfer, msg.GetSignerStrs()))
+	return &types.MsgAcceptScopeOwnershipTransferResponse{}, nil
+}
+
+// CancelScopeOwnershipTransfer cancels (or declines) a pending scope ownership transfer.
+func (k msgServer) CancelScopeOwnershipTransfer(
+	goCtx context.Context,
+	msg *types.MsgCancelScopeOwnershipTransferRequest,
+) (*types.MsgCancelScopeOwnershipTransferResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "CancelScopeOwnershipTransfer")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if _, found := k.GetScopeOwnershipTransfer(ctx, msg.ScopeId); !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope %s does not have a pending ownership transfer", msg.ScopeId)
+	}
+
+	if err := k.Keeper.CancelScopeOwnershipTransfer(ctx, msg.ScopeId, msg.GetSignerStrs()); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_CancelScopeOwnershipTransfer, msg.GetSignerStrs()))
+	return &types.MsgCancelScopeOwnershipTransferResponse{}, nil
+}
+
+// WriteScopeBundle adds or updates a scope along with some of its sessions and records.
+// Each part is validated and written as if it were in its own msg with the bundle's signers, but in a cache.
+// The signatures required by all the parts are collected and checked once at the end, before the cache is written.
+// An authorization accepted while checking them is reused by the authz cache, the same as in any other msg.
+func (k msgServer) WriteScopeBundle(
+	goCtx context.Context,
+	msg *types.MsgWriteScopeBundleRequest,
+) (*types.MsgWriteScopeBundleResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "WriteScopeBundle")
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
+	cacheCtx, writeCache := ctx.CacheContext()
+	signers := newSignerRequirements()
+
+	if err := k.writeScope(cacheCtx, msg.GetWriteScopeRequest(), signers); err != nil {
+		return nil, err
+	}
+
+	sessionIDs := make([]types.MetadataAddress, 0, len(msg.Sessions))
+	for _, sessionMsg := range msg.GetWriteSessionRequests() {
+		if err := k.writeSession(cacheCtx, sessionMsg, signers); err != nil {
+			return nil, err
+		}
+		sessionIDs = append(sessionIDs, sessionMsg.Session.SessionId)
+	}
+
+	recordIDs := make([]types.MetadataAddress, 0, len(msg.Records))
+	for _, recordMsg := range msg.GetWriteRecordRequests() {
+		recordID, err := k.writeRecord(cacheCtx, recordMsg, signers)
+		if err != nil {
+			return nil, err
+		}
+		recordIDs = append(recordIDs, recordID)
+	}
+	if len(recordIDs) > 0 {
+		k.updateScopeRecordsRoot(cacheCtx, msg.Scope.ScopeId)
+	}
+
+	if err := k.validateSignerRequirements(cacheCtx, signers, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	writeCache()
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteScopeBundle, msg.GetSignerStrs()))
+	return types.NewMsgWriteScopeBundleResponse(msg.Scope.ScopeId, sessionIDs, recordIDs), nil
+}
+
+// ArchiveScope removes a scope along with all of its sessions, records, net asset values, and their history,
+// emitting an event with everything that was removed and keeping a tombstone of it.
+func (k msgServer) ArchiveScope(
+	goCtx context.Context,
+	msg *types.MsgArchiveScopeRequest,
+) (*types.MsgArchiveScopeResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "ArchiveScope")
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
+
+	transferAgents, err := k.ValidateArchiveScope(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	tombstone, err := k.Keeper.ArchiveScope(markertypes.WithTransferAgents(ctx, transferAgents...), msg.ScopeId)
+	if err != nil {
+		return nil, fmt.Errorf("could not archive scope %q: %w", msg.ScopeId, err)
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ArchiveScope, msg.GetSignerStrs()))
+	return &types.MsgArchiveScopeResponse{Tombstone: *tombstone}, nil
+}
+
+// MigrateScopeSpec changes the scope specification of one or more scopes to a newer version of it.
+func (k msgServer) MigrateScopeSpec(
+	goCtx context.Context,
+	msg *types.MsgMigrateScopeSpecRequest,
+) (*types.MsgMigrateScopeSpecResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "MigrateScopeSpec")
+	ctx := types.WithChangedBy(UnwrapMetadataContext(goCtx), msg.GetSignerStrs())
+
+	if err := k.ValidateMigrateScopeSpec(ctx, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	for _, scopeID := range msg.ScopeIds {
+		if err := k.Keeper.MigrateScopeSpec(ctx, scopeID, msg.SpecificationId); err != nil {
+			return nil, fmt.Errorf("could not migrate scope %q: %w", scopeID, err)
+		}
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_MigrateScopeSpec, msg.GetSignerStrs()))
+	return &types.MsgMigrateScopeSpecResponse{}, nil
+}
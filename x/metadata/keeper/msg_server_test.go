@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -18,6 +19,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/provenance-io/provenance/app"
@@ -1110,10 +1112,9 @@ func (s *MsgServerTestSuite) TestAddAndDeleteScopeDataAccess() {
 			errorMsg: fmt.Sprintf("scope not found with id %s: not found", dneScopeID),
 		},
 		{
-			name:     "should fail to ADD address to data access, validate add failure",
-			addMsg:   types.NewMsgAddScopeDataAccessRequest(scopeID, []string{s.user1}, []string{s.user1}),
-			signers:  []string{s.user1},
-			errorMsg: fmt.Sprintf("address already exists for data access %s: invalid request", s.user1),
+			name:    "should successfully ADD address already in data access",
+			addMsg:  types.NewMsgAddScopeDataAccessRequest(scopeID, []string{s.user1}, []string{s.user1}),
+			signers: []string{s.user1},
 		},
 		{
 			name:    "should successfully ADD address to data access",
@@ -1234,6 +1235,66 @@ func (s *MsgServerTestSuite) TestAddAndDeleteScopeDataAccess() {
 		assert.Equal(t, scopeA.ValueOwnerAddress, scopeC.ValueOwnerAddress, "add ValueOwnerAddress")
 		assert.Equal(t, scopeA.Owners, scopeC.Owners, "add Owners")
 	})
+
+	s.T().Run("re-adding data access replaces or clears its expiration", func(t *testing.T) {
+		user2Addr := sdk.MustAccAddressFromBech32(s.user2)
+		ctx := s.ctx.WithBlockTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+		addMsg := func(expiration *time.Time) *types.MsgAddScopeDataAccessRequest {
+			rv := types.NewMsgAddScopeDataAccessRequest(scopeID, []string{s.user2}, []string{s.user1})
+			rv.Expiration = expiration
+			return rv
+		}
+
+		exp1 := ctx.BlockTime().Add(time.Hour)
+		_, err := s.msgServer.AddScopeDataAccess(ctx, addMsg(&exp1))
+		require.NoError(t, err, "AddScopeDataAccess with first expiration")
+		grant, found := s.app.MetadataKeeper.GetScopeDataAccessGrant(ctx, scopeID, user2Addr)
+		if assert.True(t, found, "grant found after first expiration") {
+			assert.Equal(t, exp1, grant.Expiration, "grant expiration after first expiration")
+		}
+
+		exp2 := ctx.BlockTime().Add(48 * time.Hour)
+		_, err = s.msgServer.AddScopeDataAccess(ctx, addMsg(&exp2))
+		require.NoError(t, err, "AddScopeDataAccess with second expiration")
+		grant, found = s.app.MetadataKeeper.GetScopeDataAccessGrant(ctx, scopeID, user2Addr)
+		if assert.True(t, found, "grant found after second expiration") {
+			assert.Equal(t, exp2, grant.Expiration, "grant expiration after second expiration")
+		}
+
+		_, err = s.msgServer.AddScopeDataAccess(ctx, addMsg(nil))
+		require.NoError(t, err, "AddScopeDataAccess without an expiration")
+		_, found = s.app.MetadataKeeper.GetScopeDataAccessGrant(ctx, scopeID, user2Addr)
+		assert.False(t, found, "grant found after re-adding without an expiration")
+
+		scope, found := s.app.MetadataKeeper.GetScope(ctx, scopeID)
+		require.True(t, found, "GetScope found")
+		assert.Equal(t, []string{s.user1, s.user2}, scope.DataAccess, "scope data access")
+	})
+
+	s.T().Run("expiring data access is exported and removed on delete", func(t *testing.T) {
+		ctx := s.ctx.WithBlockTime(time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC))
+		addMsg := types.NewMsgAddScopeDataAccessRequest(scopeID, []string{user3}, []string{s.user1})
+		blockTime := ctx.BlockTime()
+		addMsg.Expiration = &blockTime
+		_, err := s.msgServer.AddScopeDataAccess(ctx, addMsg)
+		assert.ErrorContains(t, err, "must be after the current block time", "AddScopeDataAccess with an expiration that isn't in the future")
+
+		expiration := ctx.BlockTime().Add(time.Hour)
+		addMsg.Expiration = &expiration
+		_, err = s.msgServer.AddScopeDataAccess(ctx, addMsg)
+		require.NoError(t, err, "AddScopeDataAccess with an expiration")
+
+		genState := s.app.MetadataKeeper.ExportGenesis(ctx)
+		require.NoError(t, genState.Validate(), "exported genesis Validate")
+		assert.Equal(t, []types.ScopeDataAccessGrant{types.NewScopeDataAccessGrant(scopeID, user3, expiration)},
+			genState.ScopeDataAccessGrants, "exported data access grants")
+
+		delMsg := types.NewMsgDeleteScopeDataAccessRequest(scopeID, []string{user3}, []string{s.user1})
+		_, err = s.msgServer.DeleteScopeDataAccess(ctx, delMsg)
+		require.NoError(t, err, "DeleteScopeDataAccess")
+		_, found := s.app.MetadataKeeper.GetScopeDataAccessGrant(ctx, scopeID, sdk.MustAccAddressFromBech32(user3))
+		assert.False(t, found, "grant found after DeleteScopeDataAccess")
+	})
 }
 
 func (s *MsgServerTestSuite) TestAddAndDeleteScopeOwners() {
@@ -1599,12 +1660,32 @@ func (s *MsgServerTestSuite) TestUpdateValueOwners() {
 					if s.Assert().NoError(err2, "[%d]: error from GetScopeValueOwner(%q)", i, scopeID) {
 						s.Assert().Equal(msg.ValueOwnerAddress, actVO.String(), "[%d]: addr from GetScopeValueOwner(%q)", i, scopeID)
 					}
+					for _, starter := range tc.starters {
+						if starter.ScopeId.Equals(scopeID) && starter.ValueOwnerAddress != newValueOwner {
+							s.assertValueOwnerVersion(ctx, starter, tc.signers)
+						}
+					}
 				}
 			}
 		})
 	}
 }
 
+// assertValueOwnerVersion asserts that the most recent prior version of a scope is the provided scope
+// (with its previous value owner), and that it was changed by the provided signers.
+func (s *MsgServerTestSuite) assertValueOwnerVersion(ctx sdk.Context, prevScope types.Scope, signers []string) {
+	var last *types.ScopeVersion
+	err := s.app.MetadataKeeper.IterateScopeVersions(ctx, prevScope.ScopeId, func(scopeVersion types.ScopeVersion) bool {
+		last = &scopeVersion
+		return false
+	})
+	s.Require().NoError(err, "IterateScopeVersions(%q)", prevScope.ScopeId)
+	if s.Assert().NotNil(last, "last version of scope %q", prevScope.ScopeId) {
+		s.Assert().Equal(prevScope, last.Scope, "last version of scope %q", prevScope.ScopeId)
+		s.Assert().Equal(signers, last.Change.ChangedBy, "last version of scope %q changed by", prevScope.ScopeId)
+	}
+}
+
 func (s *MsgServerTestSuite) TestMigrateValueOwner() {
 	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
 	storeScope := func(valueOwner string, scopeID types.MetadataAddress) {
@@ -1698,6 +1779,9 @@ func (s *MsgServerTestSuite) TestMigrateValueOwner() {
 					if s.Assert().NoError(err2, "[%d]: error from GetScopeValueOwner(%q)", i, scopeID) {
 						s.Assert().Equal(tc.msg.Proposed, actVO.String(), "[%d]: addr from GetScopeValueOwner(%q)", i, scopeID)
 					}
+					prevScope, _ := s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+					prevScope.ValueOwnerAddress = tc.msg.Existing
+					s.assertValueOwnerVersion(s.ctx, prevScope, tc.msg.Signers)
 				}
 			}
 		})
@@ -1996,6 +2080,170 @@ func (s *MsgServerTestSuite) TestWriteDeleteRecord() {
 	})
 }
 
+func (s *MsgServerTestSuite) TestWriteScopeBundle() {
+	cSpecUUID := uuid.New()
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(cSpecUUID),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("bundlesource"),
+		ClassName:       "bundleclass",
+	}
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+
+	sSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ContractSpecIds: []types.MetadataAddress{cSpec.SpecificationId},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, sSpec)
+
+	rSpec := types.RecordSpecification{
+		SpecificationId: types.RecordSpecMetadataAddress(cSpecUUID, "terms"),
+		Name:            "terms",
+		Inputs: []*types.InputSpecification{
+			{
+				Name:     "ri1",
+				TypeName: "string",
+				Source:   types.NewInputSpecificationSourceHash("ri1hash"),
+			},
+		},
+		TypeName:           "string",
+		ResultType:         types.DefinitionType_DEFINITION_TYPE_RECORD,
+		ResponsibleParties: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	}
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, rSpec)
+
+	scopeUUID := uuid.New()
+	scope := types.Scope{
+		ScopeId:           types.ScopeMetadataAddress(scopeUUID),
+		SpecificationId:   sSpec.SpecificationId,
+		Owners:            ownerPartyList(s.user1),
+		ValueOwnerAddress: s.user1,
+	}
+	session := types.Session{
+		SessionId:       types.SessionMetadataAddress(scopeUUID, uuid.New()),
+		SpecificationId: cSpec.SpecificationId,
+		Parties:         ownerPartyList(s.user1),
+		Name:            cSpec.ClassName,
+	}
+	record := types.Record{
+		Name:      rSpec.Name,
+		SessionId: session.SessionId,
+		Process: types.Process{
+			ProcessId: &types.Process_Hash{Hash: "bundleprochash"},
+			Name:      "bundleproc",
+			Method:    "bundleprocmethod",
+		},
+		Inputs: []types.RecordInput{
+			{
+				Name:     rSpec.Inputs[0].Name,
+				Source:   &types.RecordInput_Hash{Hash: "bundlehash"},
+				TypeName: rSpec.Inputs[0].TypeName,
+				Status:   types.RecordInputStatus_Proposed,
+			},
+		},
+		Outputs: []types.RecordOutput{
+			{
+				Hash:   "bundleout",
+				Status: types.ResultStatus_RESULT_STATUS_PASS,
+			},
+		},
+		SpecificationId: rSpec.SpecificationId,
+	}
+	recordID := types.RecordMetadataAddress(scopeUUID, rSpec.Name)
+
+	s.Run("record with an unknown spec", func() {
+		badRecord := record
+		badRecord.SpecificationId = types.RecordSpecMetadataAddress(uuid.New(), rSpec.Name)
+		msg := types.NewMsgWriteScopeBundleRequest(scope, []types.Session{session}, []types.Record{badRecord}, []string{s.user1}, 0)
+		ctx, _ := s.ctx.CacheContext()
+		_, err := s.msgServer.WriteScopeBundle(ctx, msg)
+		s.Assert().ErrorContains(err, "proposed specification id", "WriteScopeBundle error")
+	})
+
+	s.Run("scope, session and record", func() {
+		msg := types.NewMsgWriteScopeBundleRequest(scope, []types.Session{session}, []types.Record{record}, []string{s.user1}, 1234)
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		resp, err := s.msgServer.WriteScopeBundle(ctx, msg)
+		s.Require().NoError(err, "WriteScopeBundle")
+		s.Assert().Equal(types.NewMsgWriteScopeBundleResponse(scope.ScopeId, []types.MetadataAddress{session.SessionId},
+			[]types.MetadataAddress{recordID}), resp, "WriteScopeBundle response")
+
+		_, found := s.app.MetadataKeeper.GetScope(s.ctx, scope.ScopeId)
+		s.Assert().True(found, "scope found")
+		actualSession, found := s.app.MetadataKeeper.GetSession(s.ctx, session.SessionId)
+		if s.Assert().True(found, "session found") {
+			s.Assert().Equal(s.user1, actualSession.Audit.CreatedBy, "session created by")
+		}
+		actualRecord, found := s.app.MetadataKeeper.GetRecord(s.ctx, recordID)
+		if s.Assert().True(found, "record found") {
+			s.Assert().Equal(record, actualRecord, "record")
+		}
+		nav, err := s.app.MetadataKeeper.GetNetAssetValue(s.ctx, scope.ScopeId.Denom(), types.UsdDenom)
+		if s.Assert().NoError(err, "GetNetAssetValue usd") && s.Assert().NotNil(nav, "usd net asset value") {
+			s.Assert().Equal("1234", nav.Price.Amount.String(), "usd net asset value amount")
+		}
+
+		expEvent := s.untypeEvent(types.NewEventTxCompleted(types.TxEndpoint_WriteScopeBundle, []string{s.user1}))
+		s.Assert().Contains(ctx.EventManager().Events(), expEvent, "WriteScopeBundle events")
+	})
+
+	s.Run("update by a non-owner", func() {
+		updated := scope
+		updated.DataAccess = []string{s.user2}
+		msg := types.NewMsgWriteScopeBundleRequest(updated, []types.Session{session}, nil, []string{s.user2}, 0)
+		_, err := s.msgServer.WriteScopeBundle(s.ctx, msg)
+		s.Assert().ErrorContains(err, "missing signature: "+s.user1, "WriteScopeBundle error")
+	})
+
+	s.Run("update by a grantee missing a session authorization", func() {
+		ctx, _ := s.ctx.CacheContext()
+		scopeMsgType := types.TypeURLMsgWriteScopeRequest
+		err := s.app.AuthzKeeper.SaveGrant(ctx, s.user2Addr, s.user1Addr, authz.NewCountAuthorization(scopeMsgType, 1), nil)
+		s.Require().NoError(err, "SaveGrant 1 -> 2 %s", scopeMsgType)
+
+		updated := scope
+		updated.DataAccess = []string{s.user2}
+		msg := types.NewMsgWriteScopeBundleRequest(updated, []types.Session{session}, []types.Record{record}, []string{s.user2}, 0)
+		_, err = s.msgServer.WriteScopeBundle(ctx, msg)
+		s.Assert().ErrorContains(err, "missing required signature: "+s.user1, "WriteScopeBundle error")
+
+		actualScope, found := s.app.MetadataKeeper.GetScope(ctx, scope.ScopeId)
+		if s.Assert().True(found, "scope found") {
+			s.Assert().Empty(actualScope.DataAccess, "scope data access")
+		}
+		auth, _ := s.app.AuthzKeeper.GetAuthorization(ctx, s.user2Addr, s.user1Addr, scopeMsgType)
+		s.Assert().NotNil(auth, "GetAuthorization %s after failed bundle", scopeMsgType)
+	})
+
+	s.Run("update by a grantee with single use authorizations", func() {
+		ctx, _ := s.ctx.CacheContext()
+		msgTypes := []string{types.TypeURLMsgWriteScopeRequest, types.TypeURLMsgWriteSessionRequest}
+		for _, msgType := range msgTypes {
+			err := s.app.AuthzKeeper.SaveGrant(ctx, s.user2Addr, s.user1Addr, authz.NewCountAuthorization(msgType, 1), nil)
+			s.Require().NoError(err, "SaveGrant 1 -> 2 %s", msgType)
+		}
+
+		updated := scope
+		updated.DataAccess = []string{s.user2}
+		msg := types.NewMsgWriteScopeBundleRequest(updated, []types.Session{session}, []types.Record{record}, []string{s.user2}, 0)
+		_, err := s.msgServer.WriteScopeBundle(ctx, msg)
+		s.Require().NoError(err, "WriteScopeBundle")
+
+		actualScope, found := s.app.MetadataKeeper.GetScope(ctx, scope.ScopeId)
+		if s.Assert().True(found, "scope found") {
+			s.Assert().Equal(updated.DataAccess, actualScope.DataAccess, "scope data access")
+		}
+		// The record's signatures are satisfied by the session's authorization, so each is only used once.
+		for _, msgType := range msgTypes {
+			auth, _ := s.app.AuthzKeeper.GetAuthorization(ctx, s.user2Addr, s.user1Addr, msgType)
+			s.Assert().Nil(auth, "GetAuthorization %s after its only use", msgType)
+		}
+	})
+}
+
 // TODO: WriteScopeSpecification tests
 // TODO: DeleteScopeSpecification tests
 // TODO: WriteContractSpecification tests
@@ -2179,6 +2427,296 @@ func (s *MsgServerTestSuite) TestDeleteContractSpecFromScopeSpec() {
 // TODO: DeleteOSLocator tests
 // TODO: ModifyOSLocator tests
 
+// specVersionData holds the ids of the specs and scope written by writeSpecVersionData.
+type specVersionData struct {
+	cSpecID1  types.MetadataAddress
+	cSpecID2  types.MetadataAddress
+	specID1   types.MetadataAddress
+	scopeID   types.MetadataAddress
+	sessionID types.MetadataAddress
+}
+
+// writeSpecVersionData writes two contract specs, a scope spec that allows both, and a scope using that scope spec.
+// The scope's session uses the first contract spec, and its record uses a record spec from the second.
+func (s *MsgServerTestSuite) writeSpecVersionData() specVersionData {
+	scopeUUID := uuid.New()
+	rv := specVersionData{
+		cSpecID1:  types.ContractSpecMetadataAddress(uuid.New()),
+		cSpecID2:  types.ContractSpecMetadataAddress(uuid.New()),
+		specID1:   types.ScopeSpecMetadataAddress(uuid.New()),
+		scopeID:   types.ScopeMetadataAddress(scopeUUID),
+		sessionID: types.SessionMetadataAddress(scopeUUID, uuid.New()),
+	}
+
+	mdKeeper := s.app.MetadataKeeper
+	for _, cSpecID := range []types.MetadataAddress{rv.cSpecID1, rv.cSpecID2} {
+		mdKeeper.SetContractSpecification(s.ctx, *types.NewContractSpecification(cSpecID, nil, []string{s.user1},
+			[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, types.NewContractSpecificationSourceHash("hash"), "class"))
+	}
+	s.writeScopeSpec(s.newScopeSpec(rv.specID1, nil, rv.cSpecID1, rv.cSpecID2))
+
+	scope := types.NewScope(rv.scopeID, rv.specID1, ownerPartyList(s.user1), nil, s.user1, false)
+	s.Require().NoError(mdKeeper.SetScope(s.ctx, *scope), "SetScope")
+	mdKeeper.SetSession(s.ctx, *types.NewSession("session", rv.sessionID, rv.cSpecID1, ownerPartyList(s.user1), nil))
+	record := newCommitmentRecord(rv.sessionID, "loan", "loanhash")
+	record.SpecificationId = rv.cSpecID2.MustGetAsRecordSpecAddress("loan")
+	mdKeeper.SetRecord(s.ctx, record)
+	return rv
+}
+
+// newScopeSpec creates a new scope spec owned by user1 that requires an owner party.
+func (s *MsgServerTestSuite) newScopeSpec(specID, supersedes types.MetadataAddress, cSpecIDs ...types.MetadataAddress) types.ScopeSpecification {
+	rv := *types.NewScopeSpecification(specID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, cSpecIDs)
+	rv.Supersedes = supersedes
+	return rv
+}
+
+// writeScopeSpec writes the scope spec using the msg server signed by user1, requiring it to succeed.
+func (s *MsgServerTestSuite) writeScopeSpec(spec types.ScopeSpecification) {
+	_, err := s.msgServer.WriteScopeSpecification(s.ctx, types.NewMsgWriteScopeSpecificationRequest(spec, []string{s.user1}))
+	s.Require().NoError(err, "WriteScopeSpecification %s", spec.SpecificationId)
+}
+
+func (s *MsgServerTestSuite) TestWriteScopeSpecificationVersions() {
+	data := s.writeSpecVersionData()
+	specID1 := data.specID1
+	specID2 := types.ScopeSpecMetadataAddress(uuid.New())
+	specID3 := types.ScopeSpecMetadataAddress(uuid.New())
+
+	tests := []struct {
+		name            string
+		spec            types.ScopeSpecification
+		signer          string
+		expErr          string
+		expEvents       sdk.Events
+		checkSpecID     types.MetadataAddress
+		expSupersededBy types.MetadataAddress
+	}{
+		{
+			name:        "superseding a scope spec owned by someone else",
+			spec:        s.newScopeSpec(specID2, specID1, data.cSpecID1),
+			signer:      s.user2,
+			expErr:      "cannot supersede scope specification " + specID1.String(),
+			checkSpecID: specID1,
+		},
+		{
+			name:            "new version",
+			spec:            s.newScopeSpec(specID2, specID1, data.cSpecID1),
+			signer:          s.user1,
+			expEvents:       sdk.Events{s.untypeEvent(types.NewEventScopeSpecificationSuperseded(specID1, specID2))},
+			checkSpecID:     specID1,
+			expSupersededBy: specID2,
+		},
+		{
+			name:            "rewriting the old version without superseded by keeps it",
+			spec:            s.newScopeSpec(specID1, nil, data.cSpecID1, data.cSpecID2),
+			signer:          s.user1,
+			checkSpecID:     specID1,
+			expSupersededBy: specID2,
+		},
+		{
+			name:   "changing what a version supersedes",
+			spec:   s.newScopeSpec(specID2, specID3, data.cSpecID1),
+			signer: s.user1,
+			expErr: "cannot change the scope specification that " + specID2.String() + " supersedes",
+		},
+		{
+			name:   "superseding an already superseded spec",
+			spec:   s.newScopeSpec(specID3, specID1, data.cSpecID1),
+			signer: s.user1,
+			expErr: "scope specification " + specID1.String() + " is already superseded by " + specID2.String(),
+		},
+		{
+			name:   "superseding a newer version",
+			spec:   s.newScopeSpec(specID1, specID2, data.cSpecID1, data.cSpecID2),
+			signer: s.user1,
+			expErr: "since it is a newer version of " + specID1.String(),
+		},
+		{
+			name:            "second new version",
+			spec:            s.newScopeSpec(specID3, specID2, data.cSpecID1),
+			signer:          s.user1,
+			checkSpecID:     specID2,
+			expSupersededBy: specID3,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			msg := types.NewMsgWriteScopeSpecificationRequest(tc.spec, []string{tc.signer})
+			_, err := s.msgServer.WriteScopeSpecification(s.ctx.WithEventManager(em), msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "WriteScopeSpecification error")
+			} else {
+				s.Assert().NoError(err, "WriteScopeSpecification error")
+			}
+			for _, expEvent := range tc.expEvents {
+				s.Assert().Contains(em.Events(), expEvent, "WriteScopeSpecification events")
+			}
+			if len(tc.checkSpecID) > 0 {
+				spec, found := s.app.MetadataKeeper.GetScopeSpecification(s.ctx, tc.checkSpecID)
+				s.Require().True(found, "GetScopeSpecification(%s) found", tc.checkSpecID)
+				s.Assert().Equal(tc.expSupersededBy, spec.SupersededBy, "%s superseded by", tc.checkSpecID)
+			}
+		})
+	}
+
+	s.Run("removing a version unlinks it from the others", func() {
+		_, err := s.msgServer.DeleteScopeSpecification(s.ctx, types.NewMsgDeleteScopeSpecificationRequest(specID2, []string{s.user1}))
+		s.Require().NoError(err, "DeleteScopeSpecification v2")
+		spec1, found := s.app.MetadataKeeper.GetScopeSpecification(s.ctx, specID1)
+		s.Require().True(found, "GetScopeSpecification v1 found")
+		s.Assert().Empty(spec1.SupersededBy, "v1 superseded by after removing v2")
+		spec3, found := s.app.MetadataKeeper.GetScopeSpecification(s.ctx, specID3)
+		s.Require().True(found, "GetScopeSpecification v3 found")
+		s.Assert().Empty(spec3.Supersedes, "v3 supersedes after removing v2")
+	})
+}
+
+func (s *MsgServerTestSuite) TestScopeSpecChangesWhileInUse() {
+	data := s.writeSpecVersionData()
+	unusedSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	s.writeScopeSpec(s.newScopeSpec(unusedSpecID, nil, data.cSpecID1, data.cSpecID2))
+	withAffiliate := func(specID types.MetadataAddress) types.ScopeSpecification {
+		rv := s.newScopeSpec(specID, nil, data.cSpecID1, data.cSpecID2)
+		rv.PartiesInvolved = append(rv.PartiesInvolved, types.PartyType_PARTY_TYPE_AFFILIATE)
+		return rv
+	}
+	withDescription := s.newScopeSpec(data.specID1, nil, data.cSpecID2, data.cSpecID1)
+	withDescription.Description = types.NewDescription("loan", "A loan.", "", "")
+
+	tests := []struct {
+		name   string
+		spec   types.ScopeSpecification
+		expErr string
+	}{
+		{
+			name:   "removing a contract spec from a spec in use",
+			spec:   s.newScopeSpec(data.specID1, nil, data.cSpecID2),
+			expErr: "cannot remove contract specification " + data.cSpecID1.String(),
+		},
+		{
+			name:   "adding a party type to a spec in use",
+			spec:   withAffiliate(data.specID1),
+			expErr: "cannot add party type AFFILIATE",
+		},
+		{
+			name: "rewriting a spec in use without breaking changes",
+			spec: withDescription,
+		},
+		{
+			name: "adding a party type to a spec not in use",
+			spec: withAffiliate(unusedSpecID),
+		},
+		{
+			name: "removing a contract spec from a spec not in use",
+			spec: s.newScopeSpec(unusedSpecID, nil, data.cSpecID2),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.WriteScopeSpecification(s.ctx, types.NewMsgWriteScopeSpecificationRequest(tc.spec, []string{s.user1}))
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "WriteScopeSpecification error")
+			} else {
+				s.Assert().NoError(err, "WriteScopeSpecification error")
+			}
+		})
+	}
+
+	s.Run("removing a contract spec from a spec in use by msg", func() {
+		_, err := s.msgServer.DeleteContractSpecFromScopeSpec(s.ctx, &types.MsgDeleteContractSpecFromScopeSpecRequest{
+			ContractSpecificationId: data.cSpecID2,
+			ScopeSpecificationId:    data.specID1,
+			Signers:                 []string{s.user1},
+		})
+		s.Assert().ErrorContains(err, "cannot remove contract specification "+data.cSpecID2.String(), "DeleteContractSpecFromScopeSpec error")
+	})
+}
+
+func (s *MsgServerTestSuite) TestMigrateScopeSpec() {
+	// v2 doesn't allow the session's contract spec, v3 doesn't allow the record's, and v4 allows both.
+	data := s.writeSpecVersionData()
+	specID2 := types.ScopeSpecMetadataAddress(uuid.New())
+	specID3 := types.ScopeSpecMetadataAddress(uuid.New())
+	specID4 := types.ScopeSpecMetadataAddress(uuid.New())
+	unrelatedSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	s.writeScopeSpec(s.newScopeSpec(specID2, data.specID1, data.cSpecID2))
+	s.writeScopeSpec(s.newScopeSpec(specID3, specID2, data.cSpecID1))
+	s.writeScopeSpec(s.newScopeSpec(specID4, specID3, data.cSpecID1, data.cSpecID2))
+	s.writeScopeSpec(s.newScopeSpec(unrelatedSpecID, nil, data.cSpecID1, data.cSpecID2))
+
+	tests := []struct {
+		name      string
+		specID    types.MetadataAddress
+		signer    string
+		expErr    string
+		expEvents sdk.Events
+	}{
+		{
+			name:   "session contract spec not allowed",
+			specID: specID2,
+			signer: s.user1,
+			expErr: "session " + data.sessionID.String() + " contract specification " + data.cSpecID1.String() + " is not allowed",
+		},
+		{
+			name:   "record contract spec not allowed",
+			specID: specID3,
+			signer: s.user1,
+			expErr: `record "loan" contract specification ` + data.cSpecID2.String() + " is not allowed",
+		},
+		{
+			name:   "unrelated spec",
+			specID: unrelatedSpecID,
+			signer: s.user1,
+			expErr: "is not a newer version of " + data.specID1.String(),
+		},
+		{
+			name:   "signed by a non-owner",
+			specID: specID4,
+			signer: s.user2,
+			expErr: "missing signature",
+		},
+		{
+			name:   "current spec",
+			specID: data.specID1,
+			signer: s.user1,
+			expErr: "already uses scope specification",
+		},
+		{
+			name:      "newer version that allows everything",
+			specID:    specID4,
+			signer:    s.user1,
+			expEvents: sdk.Events{s.untypeEvent(types.NewEventScopeSpecMigrated(data.scopeID, data.specID1, specID4))},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			msg := types.NewMsgMigrateScopeSpecRequest([]types.MetadataAddress{data.scopeID}, tc.specID, []string{tc.signer})
+			_, err := s.msgServer.MigrateScopeSpec(s.ctx.WithEventManager(em), msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "MigrateScopeSpec error")
+				return
+			}
+			s.Require().NoError(err, "MigrateScopeSpec error")
+			scope, found := s.app.MetadataKeeper.GetScope(s.ctx, data.scopeID)
+			s.Require().True(found, "GetScope after migration found")
+			s.Assert().Equal(tc.specID, scope.SpecificationId, "scope spec after migration")
+			for _, expEvent := range tc.expEvents {
+				s.Assert().Contains(em.Events(), expEvent, "MigrateScopeSpec events")
+			}
+		})
+	}
+
+	s.Run("old version can have breaking changes once it is not in use", func() {
+		s.writeScopeSpec(s.newScopeSpec(data.specID1, nil, data.cSpecID1))
+	})
+}
+
 func (s *MsgServerTestSuite) TestSetAccountData() {
 	scopeSpec := types.ScopeSpecification{
 		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
@@ -2425,3 +2963,466 @@ func (s *MsgServerTestSuite) TestAddNetAssetValue() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestPruneScopeHistory() {
+	scopeUUID := s.newUUID("prune", 1)
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	sessionID := types.SessionMetadataAddress(scopeUUID, s.newUUID("session", 1))
+	recordID := types.RecordMetadataAddress(scopeUUID, "loan")
+	newRecord := func(hash string) types.Record {
+		process := types.NewProcess("process", &types.Process_Hash{Hash: "HASH"}, "method")
+		outputs := []types.RecordOutput{*types.NewRecordOutput(hash, types.ResultStatus_RESULT_STATUS_PASS)}
+		return *types.NewRecord("loan", sessionID, *process, nil, outputs, nil)
+	}
+	recordVersions := func() []uint64 {
+		var rv []uint64
+		err := s.app.MetadataKeeper.IterateRecordVersions(s.ctx, recordID, func(recordVersion types.RecordVersion) bool {
+			rv = append(rv, recordVersion.Version)
+			return false
+		})
+		s.Require().NoError(err, "IterateRecordVersions")
+		return rv
+	}
+
+	mdKeeper := s.app.MetadataKeeper
+	ctx := types.WithChangedBy(s.ctx, []string{s.user1})
+	scope := types.NewScope(scopeID, nil, ownerPartyList(s.user1), nil, "", false)
+	s.Require().NoError(mdKeeper.SetScope(ctx, *scope), "SetScope")
+	for i := 0; i < types.MaxEntryVersions+2; i++ {
+		mdKeeper.SetRecord(ctx, newRecord(uuid.NewString()))
+	}
+	versions := recordVersions()
+	s.Require().Len(versions, types.MaxEntryVersions, "number of record versions kept")
+	s.Assert().Equal(uint64(2), versions[0], "oldest record version kept")
+
+	tests := []struct {
+		name        string
+		msg         *types.MsgPruneScopeHistoryRequest
+		expErr      string
+		expVersions []uint64
+	}{
+		{
+			name:        "not signed by an owner",
+			msg:         types.NewMsgPruneScopeHistoryRequest(scopeID.String(), []string{s.user2}, 1),
+			expErr:      "missing signature: " + s.user1,
+			expVersions: versions,
+		},
+		{
+			name:        "keep three",
+			msg:         types.NewMsgPruneScopeHistoryRequest(scopeID.String(), []string{s.user1}, 3),
+			expVersions: []uint64{99, 100, 101},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.PruneScopeHistory(ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "PruneScopeHistory error")
+			} else {
+				s.Assert().NoError(err, "PruneScopeHistory error")
+			}
+			s.Assert().Equal(tc.expVersions, recordVersions(), "record versions")
+		})
+	}
+
+	// Version numbers keep going after a prune.
+	mdKeeper.SetRecord(ctx, newRecord("next"))
+	resp, err := mdKeeper.RecordHistory(s.ctx, &types.RecordHistoryRequest{RecordAddr: recordID.String()})
+	s.Require().NoError(err, "RecordHistory")
+	s.Require().Len(resp.RecordVersions, 4, "record versions")
+	s.Assert().Equal(uint64(102), resp.RecordVersions[3].Version, "newest record version")
+
+	// A deleted scope's history can still be pruned by the owners it had when it was deleted.
+	s.Require().NoError(mdKeeper.RemoveScope(ctx, scopeID), "RemoveScope")
+	versions = recordVersions()
+	s.Require().Equal([]uint64{99, 100, 101, 102, 103}, versions, "record versions after RemoveScope")
+	_, err = s.msgServer.PruneScopeHistory(ctx, types.NewMsgPruneScopeHistoryRequest(scopeID.String(), []string{s.user2}, 1))
+	s.Assert().ErrorContains(err, "missing signature: "+s.user1, "PruneScopeHistory error: deleted scope, not signed by an owner")
+	s.Assert().Equal(versions, recordVersions(), "record versions after failed prune of deleted scope")
+	_, err = s.msgServer.PruneScopeHistory(ctx, types.NewMsgPruneScopeHistoryRequest(scopeID.String(), []string{s.user1}, 1))
+	s.Assert().NoError(err, "PruneScopeHistory error: deleted scope")
+	s.Assert().Equal([]uint64{103}, recordVersions(), "record versions after prune of deleted scope")
+	scopeVersion, found := mdKeeper.GetLatestScopeVersion(s.ctx, scopeID)
+	s.Require().True(found, "GetLatestScopeVersion found")
+	s.Assert().True(scopeVersion.Change.Deleted, "latest scope version deleted")
+
+	// Without any history, there's nothing to prune.
+	unknownID := types.ScopeMetadataAddress(s.newUUID("prune", 2))
+	_, err = s.msgServer.PruneScopeHistory(ctx, types.NewMsgPruneScopeHistoryRequest(unknownID.String(), []string{s.user1}, 1))
+	s.Assert().ErrorContains(err, "scope not found: "+unknownID.String(), "PruneScopeHistory error: unknown scope")
+}
+
+// ownershipTransferScope writes a new scope that the seller owns and is the value owner of.
+func (s *MsgServerTestSuite) ownershipTransferScope(i int, seller string) types.MetadataAddress {
+	scopeSpecID := s.scopeSpecID(i)
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, []string{seller}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *scopeSpec)
+
+	scopeID := s.scopeID(i)
+	scope := types.NewScope(scopeID, scopeSpecID, ownerPartyList(seller), nil, "", false)
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, *scope), "SetScope")
+	s.Require().NoError(s.app.MetadataKeeper.SetScopeValueOwner(s.ctx, scopeID, seller), "SetScopeValueOwner")
+	return scopeID
+}
+
+// proposeOwnershipTransfer proposes that the buyer becomes the only owner and the value owner of a scope.
+func (s *MsgServerTestSuite) proposeOwnershipTransfer(ctx sdk.Context, scopeID types.MetadataAddress, buyer string, expiration time.Time, signers ...string) error {
+	msg := types.NewMsgProposeScopeOwnershipTransferRequest(scopeID, ownerPartyList(buyer), buyer, expiration, signers)
+	_, err := s.msgServer.ProposeScopeOwnershipTransfer(ctx, msg)
+	return err
+}
+
+func (s *MsgServerTestSuite) TestProposeScopeOwnershipTransfer() {
+	seller := newAddr("transfer_seller").String()
+	buyer := newAddr("transfer_buyer").String()
+	other := newAddr("transfer_other").String()
+	blockTime := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockTime(blockTime)
+	scopeID := s.ownershipTransferScope(1, seller)
+
+	tests := []struct {
+		name       string
+		expiration time.Time
+		signers    []string
+		expErr     string
+	}{
+		{
+			name:       "not signed by an owner",
+			expiration: blockTime.Add(time.Hour),
+			signers:    []string{other},
+			expErr:     "missing signature: " + seller,
+		},
+		{
+			name:       "expiration is not in the future",
+			expiration: blockTime,
+			signers:    []string{seller},
+			expErr:     "must be after the current block time",
+		},
+		{
+			name:       "expiration is too far in the future",
+			expiration: blockTime.Add(types.MaxScopeOwnershipTransferDuration + time.Second),
+			signers:    []string{seller},
+			expErr:     "cannot be more than",
+		},
+		{
+			name:       "signed by the owner",
+			expiration: blockTime.Add(time.Hour),
+			signers:    []string{seller},
+		},
+		{
+			name:       "already pending",
+			expiration: blockTime.Add(time.Hour),
+			signers:    []string{seller},
+			expErr:     "already has a pending ownership transfer",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.proposeOwnershipTransfer(ctx, scopeID, buyer, tc.expiration, tc.signers...)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "ProposeScopeOwnershipTransfer error")
+			} else {
+				s.Assert().NoError(err, "ProposeScopeOwnershipTransfer error")
+			}
+		})
+	}
+
+	transfer, found := s.app.MetadataKeeper.GetScopeOwnershipTransfer(ctx, scopeID)
+	s.Require().True(found, "transfer found after propose")
+	s.Assert().Equal([]string{buyer}, transfer.Recipients, "transfer recipients")
+	s.Assert().Equal(seller, transfer.CurrentValueOwnerAddress, "transfer current value owner")
+
+	genState := s.app.MetadataKeeper.ExportGenesis(ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	s.Assert().Equal([]types.ScopeOwnershipTransfer{transfer}, genState.ScopeOwnershipTransfers, "exported transfers")
+}
+
+func (s *MsgServerTestSuite) TestAcceptScopeOwnershipTransfer() {
+	seller := newAddr("transfer_seller").String()
+	buyer := newAddr("transfer_buyer").String()
+	other := newAddr("transfer_other").String()
+	blockTime := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	expiration := blockTime.Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		setup     func(scopeID types.MetadataAddress)
+		blockTime time.Time
+		signers   []string
+		expErr    string
+	}{
+		{
+			name:      "not signed by the recipient",
+			blockTime: blockTime,
+			signers:   []string{other},
+			expErr:    "missing signature: " + buyer,
+		},
+		{
+			name: "owners changed since the proposal",
+			setup: func(scopeID types.MetadataAddress) {
+				scope, _ := s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+				scope.Owners = ownerPartyList(seller, other)
+				s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, scope), "SetScope with new owners")
+			},
+			blockTime: blockTime,
+			signers:   []string{buyer},
+			expErr:    "owners have changed since the ownership transfer was proposed",
+		},
+		{
+			name:      "after the expiration",
+			blockTime: expiration.Add(time.Second),
+			signers:   []string{buyer},
+			expErr:    "ownership transfer expired",
+		},
+		{
+			name:      "signed by the recipient",
+			blockTime: blockTime,
+			signers:   []string{buyer},
+		},
+	}
+
+	for i, tc := range tests {
+		s.Run(tc.name, func() {
+			scopeID := s.ownershipTransferScope(i+1, seller)
+			s.Require().NoError(s.proposeOwnershipTransfer(s.ctx.WithBlockTime(blockTime), scopeID, buyer, expiration, seller), "propose")
+			if tc.setup != nil {
+				tc.setup(scopeID)
+			}
+
+			ctx := s.ctx.WithBlockTime(tc.blockTime)
+			accept := types.NewMsgAcceptScopeOwnershipTransferRequest(scopeID, tc.signers)
+			_, err := s.msgServer.AcceptScopeOwnershipTransfer(ctx, accept)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "AcceptScopeOwnershipTransfer error")
+				return
+			}
+			s.Require().NoError(err, "AcceptScopeOwnershipTransfer error")
+
+			scope, found := s.app.MetadataKeeper.GetScope(ctx, scopeID)
+			s.Require().True(found, "scope found after accept")
+			s.Assert().Equal(ownerPartyList(buyer), scope.Owners, "scope owners after accept")
+			valueOwner, err := s.app.MetadataKeeper.GetScopeValueOwner(ctx, scopeID)
+			s.Require().NoError(err, "GetScopeValueOwner after accept")
+			s.Assert().Equal(buyer, valueOwner.String(), "scope value owner after accept")
+			_, found = s.app.MetadataKeeper.GetScopeOwnershipTransfer(ctx, scopeID)
+			s.Assert().False(found, "transfer found after accept")
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestCancelScopeOwnershipTransfer() {
+	seller := newAddr("transfer_seller").String()
+	buyer := newAddr("transfer_buyer").String()
+	other := newAddr("transfer_other").String()
+	blockTime := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockTime(blockTime)
+	scopeID := s.ownershipTransferScope(1, seller)
+	s.Require().NoError(s.proposeOwnershipTransfer(ctx, scopeID, buyer, blockTime.Add(time.Hour), seller), "propose")
+
+	tests := []struct {
+		name        string
+		signers     []string
+		expErr      string
+		expTransfer bool
+	}{
+		{
+			name:        "signed by someone else",
+			signers:     []string{other},
+			expErr:      "only a proposer or recipient",
+			expTransfer: true,
+		},
+		{
+			name:    "declined by the recipient",
+			signers: []string{buyer},
+		},
+		{
+			name:    "cancelled after being declined",
+			signers: []string{seller},
+			expErr:  "does not have a pending ownership transfer",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			msg := types.NewMsgCancelScopeOwnershipTransferRequest(scopeID, tc.signers)
+			_, err := s.msgServer.CancelScopeOwnershipTransfer(ctx, msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "CancelScopeOwnershipTransfer error")
+			} else {
+				s.Assert().NoError(err, "CancelScopeOwnershipTransfer error")
+			}
+			_, found := s.app.MetadataKeeper.GetScopeOwnershipTransfer(ctx, scopeID)
+			s.Assert().Equal(tc.expTransfer, found, "transfer found")
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestExpireScopeOwnershipTransfers() {
+	seller := newAddr("transfer_seller").String()
+	buyer := newAddr("transfer_buyer").String()
+	blockTime := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	expiration := blockTime.Add(time.Hour)
+	scopeID := s.ownershipTransferScope(1, seller)
+	s.Require().NoError(s.proposeOwnershipTransfer(s.ctx.WithBlockTime(blockTime), scopeID, buyer, expiration, seller), "propose")
+
+	ctx := s.ctx.WithBlockTime(expiration).WithEventManager(sdk.NewEventManager())
+	s.app.MetadataKeeper.ExpireScopeOwnershipTransfers(ctx)
+	s.Assert().Empty(ctx.EventManager().Events(), "events at the expiration")
+	_, found := s.app.MetadataKeeper.GetScopeOwnershipTransfer(ctx, scopeID)
+	s.Assert().True(found, "transfer found at the expiration")
+
+	ctx = s.ctx.WithBlockTime(expiration.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	s.app.MetadataKeeper.ExpireScopeOwnershipTransfers(ctx)
+	expEvents := sdk.Events{s.untypeEvent(types.NewEventScopeOwnershipTransferExpired(scopeID))}
+	s.AssertEqualEvents(expEvents, ctx.EventManager().Events(), "events after the expiration")
+	_, found = s.app.MetadataKeeper.GetScopeOwnershipTransfer(ctx, scopeID)
+	s.Assert().False(found, "transfer found after the expiration")
+}
+
+// writeArchivableScope writes a scope owned by the provided owner with a session, two records and a net asset value.
+// It returns the ids of the scope and its session.
+func writeArchivableScope(ctx sdk.Context, mdKeeper keeper.Keeper, owner string) (types.MetadataAddress, types.MetadataAddress, error) {
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	scope := types.NewScope(scopeID, nil, ownerPartyList(owner), nil, owner, false)
+	if err := mdKeeper.SetScope(ctx, *scope); err != nil {
+		return nil, nil, err
+	}
+	mdKeeper.SetSession(ctx, *types.NewSession("session", sessionID, types.ContractSpecMetadataAddress(uuid.New()), ownerPartyList(owner), nil))
+	mdKeeper.SetRecord(ctx, newCommitmentRecord(sessionID, "loan", "loanhash"))
+	mdKeeper.SetRecord(ctx, newCommitmentRecord(sessionID, "payments", "paymentshash"))
+	nav := types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1000), 1)
+	if err := mdKeeper.SetNetAssetValue(ctx, scopeID, nav, "test"); err != nil {
+		return nil, nil, err
+	}
+	return scopeID, sessionID, nil
+}
+
+func (s *MsgServerTestSuite) TestArchiveScope() {
+	blockTime := time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockHeight(40).WithBlockTime(blockTime)
+	mdKeeper := s.app.MetadataKeeper
+	scopeID, sessionID, err := writeArchivableScope(ctx, mdKeeper, s.user1)
+	s.Require().NoError(err, "writeArchivableScope")
+	// Update the scope and a record so that there's history to remove.
+	scope, found := mdKeeper.GetScope(ctx, scopeID)
+	s.Require().True(found, "GetScope found")
+	scope.RequirePartyRollup = true
+	s.Require().NoError(mdKeeper.SetScope(ctx, scope), "SetScope update")
+	mdKeeper.SetRecord(ctx, newCommitmentRecord(sessionID, "loan", "loanhash2"))
+	loanID, err := sessionID.AsRecordAddress("loan")
+	s.Require().NoError(err, "AsRecordAddress")
+	countVersions := func() (scopeVersions, sessionVersions, recordVersions int) {
+		s.Require().NoError(mdKeeper.IterateScopeVersions(ctx, scopeID, func(types.ScopeVersion) bool {
+			scopeVersions++
+			return false
+		}), "IterateScopeVersions")
+		s.Require().NoError(mdKeeper.IterateSessionVersions(ctx, sessionID, func(types.SessionVersion) bool {
+			sessionVersions++
+			return false
+		}), "IterateSessionVersions")
+		s.Require().NoError(mdKeeper.IterateRecordVersions(ctx, loanID, func(types.RecordVersion) bool {
+			recordVersions++
+			return false
+		}), "IterateRecordVersions")
+		return
+	}
+	scopeVersions, _, recordVersions := countVersions()
+	s.Require().NotZero(scopeVersions, "scope versions before archive")
+	s.Require().NotZero(recordVersions, "record versions before archive")
+
+	archive, err := mdKeeper.GetScopeArchive(ctx, scopeID)
+	s.Require().NoError(err, "GetScopeArchive")
+	archiveHash, err := archive.Hash()
+	s.Require().NoError(err, "Hash")
+	_, found = mdKeeper.GetScopeRecordsRoot(ctx, scopeID)
+	s.Require().True(found, "records root found before archive")
+
+	expTombstone := types.ScopeTombstone{
+		ScopeId:     scopeID,
+		ArchiveHash: archiveHash,
+		Change:      types.EntryChange{ChangedBy: []string{s.user1}, Height: 40, BlockTime: blockTime, Deleted: true},
+	}
+
+	tests := []struct {
+		name      string
+		signers   []string
+		expErr    string
+		expEvents sdk.Events
+	}{
+		{
+			name:    "signed by a non-owner",
+			signers: []string{s.user2},
+			expErr:  "missing signature",
+		},
+		{
+			name:      "signed by the owner",
+			signers:   []string{s.user1},
+			expEvents: sdk.Events{s.untypeEvent(types.NewEventScopeArchived(*archive, archiveHash))},
+		},
+		{
+			name:    "already archived",
+			signers: []string{s.user1},
+			expErr:  "scope not found with id",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			resp, err := s.msgServer.ArchiveScope(ctx.WithEventManager(em), types.NewMsgArchiveScopeRequest(scopeID, tc.signers))
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "ArchiveScope error")
+				return
+			}
+			s.Require().NoError(err, "ArchiveScope error")
+			s.Assert().Equal(expTombstone, resp.Tombstone, "ArchiveScope response tombstone")
+			for _, expEvent := range tc.expEvents {
+				s.Assert().Contains(em.Events(), expEvent, "ArchiveScope events")
+			}
+		})
+	}
+
+	s.Run("archived data is removed", func() {
+		_, found := mdKeeper.GetScope(ctx, scopeID)
+		s.Assert().False(found, "scope found after archive")
+		_, found = mdKeeper.GetSession(ctx, sessionID)
+		s.Assert().False(found, "session found after archive")
+		records, err := mdKeeper.GetRecords(ctx, scopeID, "")
+		s.Require().NoError(err, "GetRecords after archive")
+		s.Assert().Empty(records, "records after archive")
+		_, found = mdKeeper.GetScopeRecordsRoot(ctx, scopeID)
+		s.Assert().False(found, "records root found after archive")
+		var navs []types.NetAssetValue
+		err = mdKeeper.IterateNetAssetValues(ctx, scopeID, func(nav types.NetAssetValue) bool {
+			navs = append(navs, nav)
+			return false
+		})
+		s.Require().NoError(err, "IterateNetAssetValues after archive")
+		s.Assert().Empty(navs, "net asset values after archive")
+		scopeVersions, sessionVersions, recordVersions := countVersions()
+		s.Assert().Zero(scopeVersions, "scope versions after archive")
+		s.Assert().Zero(sessionVersions, "session versions after archive")
+		s.Assert().Zero(recordVersions, "record versions after archive")
+
+		genState := mdKeeper.ExportGenesis(ctx)
+		s.Require().NoError(genState.Validate(), "exported genesis Validate")
+		s.Assert().Equal([]types.ScopeTombstone{expTombstone}, genState.ScopeTombstones, "exported scope tombstones")
+	})
+
+	s.Run("archived scope cannot be written again", func() {
+		scope := types.NewScope(scopeID, types.ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), nil, s.user1, false)
+		_, err := s.msgServer.WriteScope(ctx, types.NewMsgWriteScopeRequest(*scope, []string{s.user1}, 0))
+		s.Assert().EqualError(err, fmt.Sprintf("scope %s has been archived and cannot be written again: invalid request", scopeID),
+			"WriteScope of an archived scope")
+		_, found := mdKeeper.GetScope(ctx, scopeID)
+		s.Assert().False(found, "scope found after writing an archived scope")
+
+		bundle := &types.MsgWriteScopeBundleRequest{Scope: *scope, Signers: []string{s.user1}}
+		_, err = s.msgServer.WriteScopeBundle(ctx, bundle)
+		s.Assert().ErrorContains(err, "has been archived and cannot be written again", "WriteScopeBundle of an archived scope")
+	})
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// addNetAssetValueRecord records a net asset value in the scope's history, then removes the oldest entries
+// (for that price denom) beyond the marker module's max nav history param. Nothing is recorded if that param is zero.
+func (k Keeper) addNetAssetValueRecord(ctx sdk.Context, scopeID types.MetadataAddress, nav types.NetAssetValue, source string) error {
+	maxHistory := k.markerKeeper.GetMaxNavHistory(ctx)
+	if maxHistory == 0 {
+		return nil
+	}
+
+	record := types.NetAssetValueRecord{NetAssetValue: nav, BlockTime: ctx.BlockTime().UTC(), Source: source}
+	if err := k.SetNetAssetValueRecord(ctx, scopeID, record); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStoreReversePrefixIterator(store, types.NetAssetValueHistoryDenomKeyPrefix(scopeID, nav.Price.Denom))
+	var toRemove [][]byte
+	count := uint32(0)
+	for ; it.Valid(); it.Next() {
+		count++
+		if count > maxHistory {
+			toRemove = append(toRemove, it.Key())
+		}
+	}
+	it.Close() //nolint:errcheck // close error safe to ignore in this context.
+
+	for _, key := range toRemove {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// SetNetAssetValueRecord stores an entry in a scope's net asset value history.
+func (k Keeper) SetNetAssetValueRecord(ctx sdk.Context, scopeID types.MetadataAddress, record types.NetAssetValueRecord) error {
+	if err := record.Validate(); err != nil {
+		return err
+	}
+	record.BlockTime = record.BlockTime.UTC()
+	bz, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal net asset value history: %w", err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NetAssetValueHistoryKey(scopeID, record.NetAssetValue.Price.Denom, record.BlockTime), bz)
+	return nil
+}
+
+// IterateNetAssetValueHistory iterates over a scope's net asset value history, ordered by price denom, then time.
+// If a price denom is provided, only the history for that price denom is iterated.
+func (k Keeper) IterateNetAssetValueHistory(ctx sdk.Context, scopeID types.MetadataAddress, priceDenom string, handler func(record types.NetAssetValueRecord) (stop bool)) error {
+	prefix := types.NetAssetValueHistoryKeyPrefix(scopeID)
+	if len(priceDenom) > 0 {
+		prefix = types.NetAssetValueHistoryDenomKeyPrefix(scopeID, priceDenom)
+	}
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, prefix)
+	defer it.Close() //nolint:errcheck // close error safe to ignore in this context.
+
+	for ; it.Valid(); it.Next() {
+		var record types.NetAssetValueRecord
+		if err := k.cdc.Unmarshal(it.Value(), &record); err != nil {
+			return err
+		}
+		if handler(record) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetNetAssetValueHistory gets a scope's net asset value history, optionally limited to a price denom
+// and to entries recorded at or after the start time and before the end time.
+func (k Keeper) GetNetAssetValueHistory(ctx sdk.Context, scopeID types.MetadataAddress, priceDenom string, start, end *time.Time) ([]types.NetAssetValueRecord, error) {
+	var rv []types.NetAssetValueRecord
+	err := k.IterateNetAssetValueHistory(ctx, scopeID, priceDenom, func(record types.NetAssetValueRecord) bool {
+		if (start == nil || !record.BlockTime.Before(*start)) && (end == nil || record.BlockTime.Before(*end)) {
+			rv = append(rv, record)
+		}
+		return false
+	})
+	return rv, err
+}
+
+// GetNetAssetValuesAsOf gets the net asset values (from the history) that were in effect for a scope at the given time.
+// There is at most one result per price denom; price denoms without any history at or before that time are not included.
+func (k Keeper) GetNetAssetValuesAsOf(ctx sdk.Context, scopeID types.MetadataAddress, priceDenom string, asOf time.Time) ([]types.NetAssetValue, error) {
+	var rv []types.NetAssetValue
+	lastDenom := ""
+	err := k.IterateNetAssetValueHistory(ctx, scopeID, priceDenom, func(record types.NetAssetValueRecord) bool {
+		if record.BlockTime.After(asOf) {
+			return false
+		}
+		if len(rv) > 0 && lastDenom == record.NetAssetValue.Price.Denom {
+			rv[len(rv)-1] = record.NetAssetValue
+		} else {
+			rv = append(rv, record.NetAssetValue)
+			lastDenom = record.NetAssetValue.Price.Denom
+		}
+		return false
+	})
+	return rv, err
+}
+
+// RemoveNetAssetValueHistory removes all of a scope's net asset value history.
+func (k Keeper) RemoveNetAssetValueHistory(ctx sdk.Context, scopeID types.MetadataAddress) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.NetAssetValueHistoryKeyPrefix(scopeID))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close() //nolint:errcheck // close error safe to ignore in this context.
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/provutils"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// SetNetAssetValueMaxAge sets the number of blocks after which a scope's net asset values are stale.
+// A max age of zero removes it. The scope's net asset values are moved to the heights they now become stale at
+// in the stale queue.
+func (k Keeper) SetNetAssetValueMaxAge(ctx sdk.Context, scopeID types.MetadataAddress, maxAge uint64) {
+	prevMaxAge := k.GetNetAssetValueMaxAge(ctx, scopeID)
+	store := ctx.KVStore(k.storeKey)
+	key := types.NetAssetValueMaxAgeKey(scopeID)
+	if maxAge == 0 {
+		store.Delete(key)
+	} else {
+		store.Set(key, sdk.Uint64ToBigEndian(maxAge))
+	}
+	if maxAge == prevMaxAge {
+		return
+	}
+
+	var navs []types.NetAssetValue
+	err := k.IterateNetAssetValues(ctx, scopeID, func(nav types.NetAssetValue) bool {
+		navs = append(navs, nav)
+		return false
+	})
+	if err != nil {
+		panic(fmt.Errorf("could not read scope %s net asset values: %w", scopeID, err))
+	}
+	for _, nav := range navs {
+		k.dequeueNetAssetValueStaleness(ctx, scopeID, nav, prevMaxAge)
+		k.queueNetAssetValueStaleness(ctx, scopeID, nav, maxAge)
+	}
+}
+
+// GetNetAssetValueMaxAge gets the number of blocks after which a scope's net asset values are stale.
+// Returns zero if the scope doesn't have a max age.
+func (k Keeper) GetNetAssetValueMaxAge(ctx sdk.Context, scopeID types.MetadataAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NetAssetValueMaxAgeKey(scopeID))
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// queueNetAssetValueStaleness adds a scope's net asset value to the stale queue at the height it becomes stale at.
+// It does nothing if the net asset value never becomes stale or is already stale.
+func (k Keeper) queueNetAssetValueStaleness(ctx sdk.Context, scopeID types.MetadataAddress, nav types.NetAssetValue, maxAge uint64) {
+	staleHeight := provutils.NavStaleQueueHeight(nav.UpdatedBlockHeight, maxAge, ctx.BlockHeight())
+	if staleHeight == 0 {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.NetAssetValueStaleQueueKey(staleHeight, scopeID, nav.Price.Denom), []byte{})
+}
+
+// dequeueNetAssetValueStaleness removes a scope's net asset value from the stale queue.
+func (k Keeper) dequeueNetAssetValueStaleness(ctx sdk.Context, scopeID types.MetadataAddress, nav types.NetAssetValue, maxAge uint64) {
+	staleHeight := provutils.NavStaleHeight(nav.UpdatedBlockHeight, maxAge)
+	if staleHeight == 0 {
+		return
+	}
+	ctx.KVStore(k.storeKey).Delete(types.NetAssetValueStaleQueueKey(staleHeight, scopeID, nav.Price.Denom))
+}
+
+// EmitNetAssetValueStaleEvents emits an EventNetAssetValueStale for each scope net asset value that
+// became stale in the current block. Only the entries in the stale queue for the current height are looked at.
+func (k Keeper) EmitNetAssetValueStaleEvents(ctx sdk.Context) {
+	if ctx.BlockHeight() <= 0 {
+		return
+	}
+	height := uint64(ctx.BlockHeight())
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.NetAssetValueStaleQueueKeyPrefix(height)
+	it := storetypes.KVStorePrefixIterator(store, prefix)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close() //nolint:errcheck // close error safe to ignore in this context.
+
+	for _, key := range keys {
+		store.Delete(key)
+		// After the prefix, each key is the 17 byte scope id (type byte + uuid) followed by the price denom.
+		scopeID, denom := types.MetadataAddress(key[len(prefix):len(prefix)+17]), string(key[len(prefix)+17:])
+		nav, err := k.GetNetAssetValue(ctx, scopeID.Denom(), denom)
+		if err != nil || nav == nil {
+			continue
+		}
+		maxAge := k.GetNetAssetValueMaxAge(ctx, scopeID)
+		if provutils.NavStaleHeight(nav.UpdatedBlockHeight, maxAge) != height {
+			continue
+		}
+		k.emitNetAssetValueStaleEvent(ctx, scopeID, *nav, maxAge)
+	}
+}
+
+// emitStaleNetAssetValueEvents emits an EventNetAssetValueStale for each of a scope's net asset values
+// that is stale with the provided max age, but for which wasStale returns false.
+func (k Keeper) emitStaleNetAssetValueEvents(ctx sdk.Context, scopeID types.MetadataAddress, maxAge uint64, wasStale func(nav types.NetAssetValue) bool) {
+	var navs []types.NetAssetValue
+	err := k.IterateNetAssetValues(ctx, scopeID, func(nav types.NetAssetValue) bool {
+		if nav.IsStale(maxAge, ctx.BlockHeight()) && !wasStale(nav) {
+			navs = append(navs, nav)
+		}
+		return false
+	})
+	if err != nil {
+		ctx.Logger().Error("failed to read net asset values", "scope_id", scopeID.String(), "error", err)
+		return
+	}
+	for _, nav := range navs {
+		k.emitNetAssetValueStaleEvent(ctx, scopeID, nav, maxAge)
+	}
+}
+
+// emitNetAssetValueStaleEvent emits an EventNetAssetValueStale for a scope's net asset value.
+func (k Keeper) emitNetAssetValueStaleEvent(ctx sdk.Context, scopeID types.MetadataAddress, nav types.NetAssetValue, maxAge uint64) {
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventNetAssetValueStale(scopeID, nav, maxAge)); err != nil {
+		ctx.Logger().Error("failed to emit net asset value stale event", "scope_id", scopeID.String(), "price_denom", nav.Price.Denom, "error", err)
+	}
+}
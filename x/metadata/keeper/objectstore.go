@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"slices"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -34,13 +35,17 @@ func (k Keeper) OSLocatorExists(ctx sdk.Context, ownerAddr sdk.AccAddress) bool
 }
 
 // SetOSLocator binds an OS Locator to an address in the kvstore.
+// Any endpoints provided are stored as additional endpoints of the locator.
 // An error is returned if no account exists for the address.
 // An error is returned if an OS Locator already exists for the address.
-func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
+func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string, endpoints ...types.ObjectStoreEndpoint) error {
 	urlToPersist, err := k.checkValidURI(uri, ctx)
 	if err != nil {
 		return err
 	}
+	if endpoints, err = k.checkValidEndpoints(ctx, endpoints); err != nil {
+		return err
+	}
 	if account := k.authKeeper.GetAccount(ctx, ownerAddr); account == nil {
 		return types.ErrInvalidAddress
 	}
@@ -51,6 +56,13 @@ func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAd
 	}
 
 	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, urlToPersist.String())
+	record.Endpoints = endpoints
+	if len(encryptionKey) > 0 {
+		markEncryptionKeyActive(ctx, &record)
+	}
+	if err = record.Validate(); err != nil {
+		return err
+	}
 	bz, err := k.cdc.Marshal(&record)
 	if err != nil {
 		return err
@@ -103,16 +115,50 @@ func (k Keeper) GetOSLocatorByScope(ctx sdk.Context, scopeID string) ([]types.Ob
 		signers[i] = addr
 	}
 
-	// may not have object locators defined for all owners
-	locators := make([]types.ObjectStoreLocator, 0, len(signers))
-	for _, addr := range signers {
+	return k.getOSLocators(ctx, signers), nil
+}
+
+// GetDataAccessOSLocatorsByScope gets the Object Store Locators of a scope's data access addresses whose access
+// hasn't expired. Data access addresses that are also owners of the scope are skipped since their locators are
+// already provided by GetOSLocatorByScope.
+func (k Keeper) GetDataAccessOSLocatorsByScope(ctx sdk.Context, scopeID string) ([]types.ObjectStoreLocator, error) {
+	scopeAddr, err := ParseScopeID(scopeID)
+	if err != nil {
+		return []types.ObjectStoreLocator{}, err
+	}
+
+	scope, found := k.GetScope(ctx, scopeAddr)
+	if !found {
+		return []types.ObjectStoreLocator{}, fmt.Errorf("scope [%s] not found", scopeID)
+	}
+
+	owners := scope.GetAllOwnerAddresses()
+	grantees := make([]sdk.AccAddress, 0, len(scope.DataAccess))
+	for _, da := range scope.DataAccess {
+		if slices.Contains(owners, da) || !k.HasScopeDataAccess(ctx, scope, da) {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(da)
+		if err != nil {
+			continue
+		}
+		grantees = append(grantees, addr)
+	}
+
+	return k.getOSLocators(ctx, grantees), nil
+}
+
+// getOSLocators gets the Object Store Locators of the provided addresses, skipping those that don't have one.
+func (k Keeper) getOSLocators(ctx sdk.Context, addrs []sdk.AccAddress) []types.ObjectStoreLocator {
+	locators := make([]types.ObjectStoreLocator, 0, len(addrs))
+	for _, addr := range addrs {
 		loc, found := k.GetOsLocatorRecord(ctx, addr)
 		if !found {
 			continue
 		}
 		locators = append(locators, loc)
 	}
-	return locators, nil
+	return locators
 }
 
 // RemoveOSLocator removes an os locator record from the kvstore.
@@ -128,39 +174,102 @@ func (k Keeper) RemoveOSLocator(ctx sdk.Context, ownerAddr sdk.AccAddress) error
 }
 
 // ModifyOSLocator updates an existing os locator entry in the kvstore, returns an error if it doesn't exist.
-func (k Keeper) ModifyOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
+// The locator's endpoints are replaced with the ones provided.
+// If the encryption key is changing, the previous one is kept in the locator's prior encryption keys,
+// dropping the oldest one if there are already MaxOSLocatorPriorEncryptionKeys of them.
+func (k Keeper) ModifyOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string, endpoints ...types.ObjectStoreEndpoint) error {
 	urlToPersist, err := k.checkValidURI(uri, ctx)
 	if err != nil {
 		return err
 	}
-	key := types.GetOSLocatorKey(ownerAddr)
-	store := ctx.KVStore(k.storeKey)
-	if !store.Has(key) {
+	if endpoints, err = k.checkValidEndpoints(ctx, endpoints); err != nil {
+		return err
+	}
+	existing, found := k.GetOsLocatorRecord(ctx, ownerAddr)
+	if !found {
 		return types.ErrAddressNotBound
 	}
 
 	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, urlToPersist.String())
+	record.Endpoints = endpoints
+	record.PriorEncryptionKeys = existing.PriorEncryptionKeys
+	if record.EncryptionKey == existing.EncryptionKey {
+		record.EncryptionKeySinceHeight = existing.EncryptionKeySinceHeight
+		record.EncryptionKeySince = existing.EncryptionKeySince
+	} else {
+		if len(existing.EncryptionKey) > 0 {
+			record.PriorEncryptionKeys = append(record.PriorEncryptionKeys, types.PriorEncryptionKey{
+				EncryptionKey:    existing.EncryptionKey,
+				ValidFromHeight:  existing.EncryptionKeySinceHeight,
+				ValidFrom:        existing.EncryptionKeySince,
+				ValidUntilHeight: ctx.BlockHeight(),
+				ValidUntil:       ctx.BlockTime(),
+			})
+			if extra := len(record.PriorEncryptionKeys) - types.MaxOSLocatorPriorEncryptionKeys; extra > 0 {
+				record.PriorEncryptionKeys = record.PriorEncryptionKeys[extra:]
+			}
+		}
+		// This is marked even if the key is being removed, so lookups by height can find the prior keys.
+		markEncryptionKeyActive(ctx, &record)
+	}
+	if err = record.Validate(); err != nil {
+		return err
+	}
+
 	bz, err := k.cdc.Marshal(&record)
 	if err != nil {
 		return err
 	}
-	store.Set(key, bz)
+	ctx.KVStore(k.storeKey).Set(types.GetOSLocatorKey(ownerAddr), bz)
 	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(record.Owner))
 	return nil
 }
 
+// markEncryptionKeyActive sets the height and time at which a locator's encryption key became active to now.
+func markEncryptionKeyActive(ctx sdk.Context, record *types.ObjectStoreLocator) {
+	blockTime := ctx.BlockTime()
+	record.EncryptionKeySinceHeight = ctx.BlockHeight()
+	record.EncryptionKeySince = &blockTime
+}
+
+// checkValidEndpoints checks the uri of each endpoint, returning the endpoints with the normalized uris.
+func (k Keeper) checkValidEndpoints(ctx sdk.Context, endpoints []types.ObjectStoreEndpoint) ([]types.ObjectStoreEndpoint, error) {
+	if len(endpoints) == 0 {
+		return nil, nil
+	}
+	rv := make([]types.ObjectStoreEndpoint, len(endpoints))
+	for i, endpoint := range endpoints {
+		endpointURL, err := k.checkValidURI(endpoint.Uri, ctx)
+		if err != nil {
+			return nil, fmt.Errorf("invalid endpoint uri %q: %w", endpoint.Uri, err)
+		}
+		rv[i] = types.ObjectStoreEndpoint{Uri: endpointURL.String(), Priority: endpoint.Priority}
+	}
+	return rv, nil
+}
+
 // ImportOSLocatorRecord binds a name to an address in the kvstore.
 // Different from SetOSLocator in that there is less validation here.
 // The uri format is not checked, and the owner address account is not looked up.
 // This also does not emit any events.
 func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
+	return k.ImportOSLocator(ctx, types.NewOSLocatorRecord(ownerAddr, encryptionKey, uri))
+}
+
+// ImportOSLocator stores an os locator, including its endpoints and encryption key history, in the kvstore.
+// Like ImportOSLocatorRecord, the uris are not checked, the owner address account is not looked up,
+// and no events are emitted.
+func (k Keeper) ImportOSLocator(ctx sdk.Context, record types.ObjectStoreLocator) error {
+	ownerAddr, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
 	key := types.GetOSLocatorKey(ownerAddr)
 	store := ctx.KVStore(k.storeKey)
 	if store.Has(key) {
 		return types.ErrOSLocatorAlreadyBound
 	}
 
-	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, uri)
 	bz, err := k.cdc.Marshal(&record)
 	if err != nil {
 		return err
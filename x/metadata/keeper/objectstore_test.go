@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+type OSLocatorTestSuite struct {
+	suite.Suite
+
+	app       *simapp.App
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	blockTime time.Time
+
+	owner sdk.AccAddress
+	key1  sdk.AccAddress
+	key2  sdk.AccAddress
+}
+
+func (s *OSLocatorTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T())
+	s.blockTime = time.Date(2026, 8, 3, 14, 0, 0, 0, time.UTC)
+	s.ctx = FreshCtx(s.app).WithBlockTime(s.blockTime).WithBlockHeight(100)
+	s.msgServer = keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+
+	s.owner = sdk.AccAddress("locator_owner_______")
+	s.key1 = sdk.AccAddress("locator_key_1_______")
+	s.key2 = sdk.AccAddress("locator_key_2_______")
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.owner))
+}
+
+func TestOSLocatorTestSuite(t *testing.T) {
+	suite.Run(t, new(OSLocatorTestSuite))
+}
+
+// locator gets the owner's os locator, requiring it to exist.
+func (s *OSLocatorTestSuite) locator() types.ObjectStoreLocator {
+	locator, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.owner)
+	s.Require().True(found, "GetOsLocatorRecord found")
+	return locator
+}
+
+func (s *OSLocatorTestSuite) TestBindOSLocatorWithEndpoints() {
+	locator := types.NewOSLocatorRecord(s.owner, s.key1, "https://primary.example.com")
+	locator.Endpoints = []types.ObjectStoreEndpoint{
+		{Uri: "https://backup2.example.com", Priority: 2},
+		{Uri: "https://backup1.example.com", Priority: 1},
+	}
+	resp, err := s.msgServer.BindOSLocator(s.ctx, types.NewMsgBindOSLocatorRequest(locator))
+	s.Require().NoError(err, "BindOSLocator")
+	s.Assert().Equal(s.locator(), resp.Locator, "BindOSLocator response locator")
+
+	actual := s.locator()
+	s.Assert().Equal(locator.Endpoints, actual.Endpoints, "endpoints")
+	s.Assert().Equal(int64(100), actual.EncryptionKeySinceHeight, "encryption key since height")
+	s.Assert().Equal(&s.blockTime, actual.EncryptionKeySince, "encryption key since")
+	s.Assert().Equal([]string{"https://primary.example.com", "https://backup1.example.com", "https://backup2.example.com"},
+		actual.GetURIs(), "GetURIs")
+
+	locator.Owner = sdk.AccAddress("locator_other_______").String()
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, sdk.MustAccAddressFromBech32(locator.Owner)))
+	locator.Endpoints = append(locator.Endpoints, types.ObjectStoreEndpoint{Uri: locator.LocatorUri})
+	_, err = s.msgServer.BindOSLocator(s.ctx, types.NewMsgBindOSLocatorRequest(locator))
+	s.Assert().ErrorContains(err, "duplicate endpoint uri: https://primary.example.com", "BindOSLocator with a duplicate endpoint")
+}
+
+func (s *OSLocatorTestSuite) TestModifyOSLocatorKeyHistory() {
+	uri := "https://primary.example.com"
+	s.Require().NoError(s.app.MetadataKeeper.SetOSLocator(s.ctx, s.owner, s.key1, uri), "SetOSLocator")
+
+	// Modifying without changing the key should not add any history.
+	s.ctx = s.ctx.WithBlockHeight(150).WithBlockTime(s.blockTime.Add(time.Hour))
+	endpoints := []types.ObjectStoreEndpoint{{Uri: "https://backup.example.com", Priority: 1}}
+	s.Require().NoError(s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.owner, s.key1, uri, endpoints...), "ModifyOSLocator same key")
+	actual := s.locator()
+	s.Assert().Equal(endpoints, actual.Endpoints, "endpoints after modify")
+	s.Assert().Equal(int64(100), actual.EncryptionKeySinceHeight, "encryption key since height after modify with same key")
+	s.Assert().Empty(actual.PriorEncryptionKeys, "prior encryption keys after modify with same key")
+
+	// Rotating the key should move the old one into the history.
+	rotatedAt := s.blockTime.Add(2 * time.Hour)
+	s.ctx = s.ctx.WithBlockHeight(200).WithBlockTime(rotatedAt)
+	s.Require().NoError(s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.owner, s.key2, uri), "ModifyOSLocator new key")
+	actual = s.locator()
+	s.Assert().Empty(actual.Endpoints, "endpoints after modify without endpoints")
+	s.Assert().Equal(s.key2.String(), actual.EncryptionKey, "encryption key after rotation")
+	s.Assert().Equal(int64(200), actual.EncryptionKeySinceHeight, "encryption key since height after rotation")
+	s.Assert().Equal(&rotatedAt, actual.EncryptionKeySince, "encryption key since after rotation")
+	expPrior := []types.PriorEncryptionKey{{
+		EncryptionKey:    s.key1.String(),
+		ValidFromHeight:  100,
+		ValidFrom:        &s.blockTime,
+		ValidUntilHeight: 200,
+		ValidUntil:       rotatedAt,
+	}}
+	s.Assert().Equal(expPrior, actual.PriorEncryptionKeys, "prior encryption keys after rotation")
+
+	// Removing the key should keep the history too.
+	s.ctx = s.ctx.WithBlockHeight(300).WithBlockTime(s.blockTime.Add(3 * time.Hour))
+	s.Require().NoError(s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.owner, nil, uri), "ModifyOSLocator no key")
+	actual = s.locator()
+	s.Assert().Len(actual.PriorEncryptionKeys, 2, "prior encryption keys after removing the key")
+
+	tests := []struct {
+		height int64
+		exp    string
+	}{
+		{height: 99, exp: ""},
+		{height: 100, exp: s.key1.String()},
+		{height: 199, exp: s.key1.String()},
+		{height: 200, exp: s.key2.String()},
+		{height: 299, exp: s.key2.String()},
+		{height: 300, exp: ""},
+	}
+	for _, tc := range tests {
+		s.Assert().Equal(tc.exp, actual.GetEncryptionKeyAtHeight(tc.height), "GetEncryptionKeyAtHeight(%d)", tc.height)
+	}
+
+	// The history should survive a genesis export and import.
+	genState := s.app.MetadataKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	s.Require().NoError(s.app.MetadataKeeper.RemoveOSLocator(s.ctx, s.owner), "RemoveOSLocator")
+	for _, record := range genState.ObjectStoreLocators {
+		s.Require().NoError(s.app.MetadataKeeper.ImportOSLocator(s.ctx, record), "ImportOSLocator")
+	}
+	s.Assert().Equal(actual, s.locator(), "locator after genesis round trip")
+}
+
+func (s *OSLocatorTestSuite) TestModifyOSLocatorKeyHistoryLimit() {
+	uri := "https://primary.example.com"
+	s.Require().NoError(s.app.MetadataKeeper.SetOSLocator(s.ctx, s.owner, s.key1, uri), "SetOSLocator")
+
+	// Alternate between the two keys so that every modify adds a prior key.
+	keys := []sdk.AccAddress{s.key2, s.key1}
+	rotations := types.MaxOSLocatorPriorEncryptionKeys + 3
+	for i := 1; i <= rotations; i++ {
+		s.ctx = s.ctx.WithBlockHeight(100 + int64(i)*10).WithBlockTime(s.blockTime.Add(time.Duration(i) * time.Hour))
+		s.Require().NoError(s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.owner, keys[(i-1)%2], uri), "ModifyOSLocator %d", i)
+	}
+
+	actual := s.locator()
+	s.Require().Len(actual.PriorEncryptionKeys, types.MaxOSLocatorPriorEncryptionKeys, "prior encryption keys")
+	// The three oldest should have been dropped, so the first one left became active at the 3rd rotation.
+	s.Assert().Equal(int64(130), actual.PriorEncryptionKeys[0].ValidFromHeight, "oldest prior key valid from height")
+	last := actual.PriorEncryptionKeys[len(actual.PriorEncryptionKeys)-1]
+	s.Assert().Equal(actual.EncryptionKeySinceHeight, last.ValidUntilHeight, "newest prior key valid until height")
+	s.Assert().Equal("", actual.GetEncryptionKeyAtHeight(125), "GetEncryptionKeyAtHeight of a dropped key")
+	s.Assert().Equal(s.key2.String(), actual.GetEncryptionKeyAtHeight(135), "GetEncryptionKeyAtHeight of the oldest kept key")
+}
+
+func (s *OSLocatorTestSuite) TestModifyOSLocatorWithKeyHistoryInMsg() {
+	s.Require().NoError(s.app.MetadataKeeper.SetOSLocator(s.ctx, s.owner, s.key1, "https://primary.example.com"), "SetOSLocator")
+	locator := types.NewOSLocatorRecord(s.owner, s.key2, "https://primary.example.com")
+	locator.PriorEncryptionKeys = []types.PriorEncryptionKey{{EncryptionKey: s.key1.String(), ValidUntil: s.blockTime}}
+	err := types.NewMsgModifyOSLocatorRequest(locator).ValidateBasic()
+	s.Assert().EqualError(err, "encryption key history cannot be provided", "ValidateBasic")
+}
@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// SetScopeOwnershipTransfer stores a pending scope ownership transfer, replacing any the scope already has.
+func (k Keeper) SetScopeOwnershipTransfer(ctx sdk.Context, transfer types.ScopeOwnershipTransfer) error {
+	if err := transfer.Validate(); err != nil {
+		return err
+	}
+	k.RemoveScopeOwnershipTransfer(ctx, transfer.ScopeId)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ScopeOwnershipTransferKey(transfer.ScopeId), k.cdc.MustMarshal(&transfer))
+	store.Set(types.ScopeOwnershipTransferExpirationKey(transfer.Expiration, transfer.ScopeId), []byte{})
+	return nil
+}
+
+// GetScopeOwnershipTransfer gets the pending ownership transfer of a scope.
+func (k Keeper) GetScopeOwnershipTransfer(ctx sdk.Context, scopeID types.MetadataAddress) (types.ScopeOwnershipTransfer, bool) {
+	var transfer types.ScopeOwnershipTransfer
+	bz := ctx.KVStore(k.storeKey).Get(types.ScopeOwnershipTransferKey(scopeID))
+	if len(bz) == 0 {
+		return transfer, false
+	}
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return transfer, true
+}
+
+// RemoveScopeOwnershipTransfer deletes the pending ownership transfer of a scope (if it has one).
+func (k Keeper) RemoveScopeOwnershipTransfer(ctx sdk.Context, scopeID types.MetadataAddress) {
+	transfer, found := k.GetScopeOwnershipTransfer(ctx, scopeID)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ScopeOwnershipTransferExpirationKey(transfer.Expiration, scopeID))
+	store.Delete(types.ScopeOwnershipTransferKey(scopeID))
+}
+
+// IterateScopeOwnershipTransfers iterates over all pending scope ownership transfers.
+func (k Keeper) IterateScopeOwnershipTransfers(ctx sdk.Context, handler func(transfer types.ScopeOwnershipTransfer) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.ScopeOwnershipTransferPrefix)
+	defer it.Close() //nolint:errcheck // close error safe to ignore in this context.
+
+	for ; it.Valid(); it.Next() {
+		var transfer types.ScopeOwnershipTransfer
+		if err := k.cdc.Unmarshal(it.Value(), &transfer); err != nil {
+			return fmt.Errorf("could not unmarshal scope ownership transfer: %w", err)
+		}
+		if handler(transfer) {
+			break
+		}
+	}
+	return nil
+}
+
+// ProposeScopeOwnershipTransfer validates and stores a proposed change of a scope's owners and/or value owner.
+// The signers of the msg must be allowed to make the change directly, but it is only applied once the recipients accept it.
+func (k Keeper) ProposeScopeOwnershipTransfer(ctx sdk.Context, msg *types.MsgProposeScopeOwnershipTransferRequest) error {
+	existing, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return fmt.Errorf("scope not found with id %s", msg.ScopeId)
+	}
+	if _, pending := k.GetScopeOwnershipTransfer(ctx, msg.ScopeId); pending {
+		return fmt.Errorf("scope %s already has a pending ownership transfer", msg.ScopeId)
+	}
+
+	blockTime := ctx.BlockTime()
+	if !msg.Expiration.After(blockTime) {
+		return fmt.Errorf("expiration %s must be after the current block time %s", msg.Expiration.UTC(), blockTime.UTC())
+	}
+	if msg.Expiration.Sub(blockTime) > types.MaxScopeOwnershipTransferDuration {
+		return fmt.Errorf("expiration %s cannot be more than %s after the current block time %s",
+			msg.Expiration.UTC(), types.MaxScopeOwnershipTransferDuration, blockTime.UTC())
+	}
+
+	if len(msg.Owners) > 0 {
+		proposed := existing
+		proposed.Owners = msg.Owners
+		if err := k.ValidateUpdateScopeOwners(ctx, existing, proposed, msg); err != nil {
+			return err
+		}
+	}
+
+	valueOwner, err := k.GetScopeValueOwner(ctx, msg.ScopeId)
+	if err != nil {
+		return err
+	}
+	if len(msg.ValueOwnerAddress) > 0 {
+		links := types.AccMDLinks{types.NewAccMDLink(valueOwner, msg.ScopeId)}
+		if _, err = k.ValidateUpdateValueOwners(ctx, links, msg.ValueOwnerAddress, msg); err != nil {
+			return err
+		}
+	}
+
+	recipients := types.GetScopeOwnershipTransferRecipients(existing.Owners, msg.Owners, msg.ValueOwnerAddress)
+	if len(recipients) == 0 {
+		return errors.New("the transfer does not have any new owners to accept it")
+	}
+
+	transfer := types.ScopeOwnershipTransfer{
+		ScopeId:                  msg.ScopeId,
+		Owners:                   msg.Owners,
+		ValueOwnerAddress:        msg.ValueOwnerAddress,
+		Recipients:               recipients,
+		ProposedBy:               msg.Signers,
+		CurrentOwners:            existing.Owners,
+		CurrentValueOwnerAddress: valueOwner.String(),
+		Expiration:               msg.Expiration,
+	}
+	if err = k.SetScopeOwnershipTransfer(ctx, transfer); err != nil {
+		return err
+	}
+	k.EmitEvent(ctx, types.NewEventScopeOwnershipTransferProposed(transfer))
+	return nil
+}
+
+// AcceptScopeOwnershipTransfer applies a scope's pending ownership transfer once all of its recipients have signed.
+// The transfer is rejected if the scope's owners or value owner have changed since it was proposed.
+func (k Keeper) AcceptScopeOwnershipTransfer(ctx sdk.Context, scopeID types.MetadataAddress, msg types.MetadataMsg) error {
+	transfer, found := k.GetScopeOwnershipTransfer(ctx, scopeID)
+	if !found {
+		return fmt.Errorf("scope %s does not have a pending ownership transfer", scopeID)
+	}
+	if transfer.IsExpired(ctx.BlockTime()) {
+		return fmt.Errorf("scope %s ownership transfer expired at %s", scopeID, transfer.Expiration.UTC())
+	}
+	if err := k.ValidateSignersWithoutParties(ctx, transfer.Recipients, msg); err != nil {
+		return err
+	}
+
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return fmt.Errorf("scope not found with id %s", scopeID)
+	}
+	valueOwner, err := k.GetScopeValueOwner(ctx, scopeID)
+	if err != nil {
+		return err
+	}
+	if !types.EqualParties(scope.Owners, transfer.CurrentOwners) || valueOwner.String() != transfer.CurrentValueOwnerAddress {
+		return fmt.Errorf("scope %s owners have changed since the ownership transfer was proposed", scopeID)
+	}
+
+	k.RemoveScopeOwnershipTransfer(ctx, scopeID)
+	if len(transfer.Owners) > 0 {
+		scope.Owners = transfer.Owners
+		if err = k.SetScope(ctx, scope); err != nil {
+			return fmt.Errorf("could not update scope %s owners: %w", scopeID, err)
+		}
+	}
+	if len(transfer.ValueOwnerAddress) > 0 {
+		transferAgents := make([]sdk.AccAddress, 0, len(transfer.ProposedBy))
+		for _, addr := range transfer.ProposedBy {
+			transferAgents = append(transferAgents, sdk.MustAccAddressFromBech32(addr))
+		}
+		if err = k.SetScopeValueOwner(markertypes.WithTransferAgents(ctx, transferAgents...), scopeID, transfer.ValueOwnerAddress); err != nil {
+			return fmt.Errorf("could not update scope %s value owner: %w", scopeID, err)
+		}
+	}
+
+	k.EmitEvent(ctx, types.NewEventScopeOwnershipTransferAccepted(scopeID))
+	return nil
+}
+
+// CancelScopeOwnershipTransfer removes a scope's pending ownership transfer.
+// It can be cancelled by any of its proposers, or declined by any of its recipients.
+func (k Keeper) CancelScopeOwnershipTransfer(ctx sdk.Context, scopeID types.MetadataAddress, signers []string) error {
+	transfer, found := k.GetScopeOwnershipTransfer(ctx, scopeID)
+	if !found {
+		return fmt.Errorf("scope %s does not have a pending ownership transfer", scopeID)
+	}
+
+	allowed := false
+	for _, signer := range signers {
+		if transfer.IsProposer(signer) || transfer.IsRecipient(signer) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return fmt.Errorf("only a proposer or recipient of the scope %s ownership transfer can cancel it", scopeID)
+	}
+
+	k.RemoveScopeOwnershipTransfer(ctx, scopeID)
+	k.EmitEvent(ctx, types.NewEventScopeOwnershipTransferCancelled(scopeID, signers))
+	return nil
+}
+
+// ExpireScopeOwnershipTransfers removes the pending scope ownership transfers that expired before the current block.
+func (k Keeper) ExpireScopeOwnershipTransfers(ctx sdk.Context) {
+	blockTime := ctx.BlockTime()
+	store := ctx.KVStore(k.storeKey)
+	// The index only has the expiration seconds, so iterate to the end of the current second, then check each one.
+	end := types.ScopeOwnershipTransferExpirationKeyPrefix(blockTime.Add(time.Second))
+	it := store.Iterator(types.ScopeOwnershipTransferExpirationPrefix, end)
+	var scopeIDs []types.MetadataAddress
+	for ; it.Valid(); it.Next() {
+		scopeIDs = append(scopeIDs, types.MetadataAddress(it.Key()[len(types.ScopeOwnershipTransferExpirationPrefix)+8:]))
+	}
+	it.Close() //nolint:errcheck // close error safe to ignore in this context.
+
+	for _, scopeID := range scopeIDs {
+		transfer, found := k.GetScopeOwnershipTransfer(ctx, scopeID)
+		if !found || !transfer.IsExpired(blockTime) {
+			continue
+		}
+		k.RemoveScopeOwnershipTransfer(ctx, scopeID)
+		k.EmitEvent(ctx, types.NewEventScopeOwnershipTransferExpired(scopeID))
+	}
+}
@@ -185,6 +185,59 @@ func (k Keeper) ScopesAll(c context.Context, req *types.ScopesAllRequest) (*type
 	return &retval, nil
 }
 
+// ScopeSearch returns the scopes that match all of the filters in the provided request.
+func (k Keeper) ScopeSearch(c context.Context, req *types.ScopeSearchRequest) (*types.ScopeSearchResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeSearch")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopeSearchResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	filter, err := newScopeSearchFilter(req)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if filter.usesValueOwnerIndex() {
+		// The value owner index is in the bank module, so the other filters are applied to each page it provides.
+		var links types.AccMDLinks
+		links, retval.Pagination, err = k.bankKeeper.GetScopesForValueOwner(ctx, filter.valueOwner, getPageRequest(req))
+		if err != nil {
+			return &retval, sdkerrors.ErrInvalidRequest.Wrapf("error collecting results: %v", err)
+		}
+		for _, link := range links {
+			scope, found := k.GetScope(ctx, link.MDAddr)
+			if found && filter.matches(ctx, k, scope) {
+				k.PopulateScopeValueOwner(ctx, &scope)
+				retval.Scopes = append(retval.Scopes, types.WrapScope(&scope, !req.ExcludeIdInfo))
+			}
+		}
+		return &retval, nil
+	}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), filter.indexPrefix())
+	retval.Pagination, err = query.FilteredPaginate(prefixStore, getPageRequest(req), func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		scope, found := k.GetScope(ctx, key)
+		if !found || !filter.matches(ctx, k, scope) {
+			return false, nil
+		}
+		if accumulate {
+			k.PopulateScopeValueOwner(ctx, &scope)
+			retval.Scopes = append(retval.Scopes, types.WrapScope(&scope, !req.ExcludeIdInfo))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &retval, nil
+}
+
 // Sessions returns sessions based on the provided request.
 func (k Keeper) Sessions(c context.Context, req *types.SessionsRequest) (*types.SessionsResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "Sessions")
@@ -1133,6 +1186,12 @@ func (k Keeper) OSLocatorsByScope(ctx context.Context, request *types.OSLocators
 	}
 	retval.Locators = locators
 
+	dataAccessLocators, err := k.GetDataAccessOSLocatorsByScope(ctxSDK, request.ScopeId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	retval.DataAccessLocators = dataAccessLocators
+
 	return &retval, nil
 }
 
@@ -1343,16 +1402,283 @@ func (k Keeper) ScopeNetAssetValues(c context.Context, req *types.QueryScopeNetA
 		return &types.QueryScopeNetAssetValuesResponse{}, fmt.Errorf("error extracting scope address: %w", err)
 	}
 
+	if req.AsOf != nil && (req.History || req.StartTime != nil || req.EndTime != nil) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("as of cannot be combined with a history request")
+	}
+	if !req.History && (req.StartTime != nil || req.EndTime != nil) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("start and end times are only allowed with a history request")
+	}
+
+	maxAge := k.GetNetAssetValueMaxAge(ctx, scopeID)
+
+	if req.History {
+		history, histErr := k.GetNetAssetValueHistory(ctx, scopeID, req.PriceDenom, req.StartTime, req.EndTime)
+		if histErr != nil {
+			return nil, histErr
+		}
+		return &types.QueryScopeNetAssetValuesResponse{History: history, MaxAge: maxAge}, nil
+	}
+
+	if req.AsOf != nil {
+		navs, asOfErr := k.GetNetAssetValuesAsOf(ctx, scopeID, req.PriceDenom, *req.AsOf)
+		if asOfErr != nil {
+			return nil, asOfErr
+		}
+		return &types.QueryScopeNetAssetValuesResponse{NetAssetValues: navs, MaxAge: maxAge}, nil
+	}
+
 	var navs []types.NetAssetValue
 	err = k.IterateNetAssetValues(ctx, scopeID, func(nav types.NetAssetValue) (stop bool) {
-		navs = append(navs, nav)
+		if len(req.PriceDenom) == 0 || nav.Price.Denom == req.PriceDenom {
+			nav.Stale = nav.IsStale(maxAge, ctx.BlockHeight())
+			navs = append(navs, nav)
+		}
 		return false
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.QueryScopeNetAssetValuesResponse{NetAssetValues: navs}, nil
+	return &types.QueryScopeNetAssetValuesResponse{NetAssetValues: navs, MaxAge: maxAge}, nil
+}
+
+// ScopeHistory returns the prior versions of a scope, or of one of its sessions.
+func (k Keeper) ScopeHistory(c context.Context, req *types.ScopeHistoryRequest) (*types.ScopeHistoryResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeHistory")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(req.ScopeId) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty scope id")
+	}
+
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	entryAddr := scopeAddr
+	if len(req.SessionId) > 0 {
+		entryAddr, err = ParseSessionID(req.ScopeId, req.SessionId)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retval := types.ScopeHistoryResponse{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.EntryVersionKeyPrefix(entryAddr))
+	retval.Pagination, err = query.Paginate(prefixStore, getPageRequest(req), func(_, value []byte) error {
+		if entryAddr.IsSessionAddress() {
+			var sessionVersion types.SessionVersion
+			if vErr := k.cdc.Unmarshal(value, &sessionVersion); vErr != nil {
+				return vErr
+			}
+			retval.SessionVersions = append(retval.SessionVersions, sessionVersion)
+			return nil
+		}
+		var scopeVersion types.ScopeVersion
+		if vErr := k.cdc.Unmarshal(value, &scopeVersion); vErr != nil {
+			return vErr
+		}
+		retval.ScopeVersions = append(retval.ScopeVersions, scopeVersion)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &retval, nil
+}
+
+// RecordHistory returns the prior versions of a record.
+func (k Keeper) RecordHistory(c context.Context, req *types.RecordHistoryRequest) (*types.RecordHistoryResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "RecordHistory")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	var recordAddr types.MetadataAddress
+	switch {
+	case len(req.RecordAddr) > 0:
+		var err error
+		recordAddr, err = ParseRecordAddr(req.RecordAddr)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	case len(req.ScopeId) > 0 && len(req.Name) > 0:
+		scopeAddr, err := ParseScopeID(req.ScopeId)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		recordAddr, err = scopeAddr.AsRecordAddress(req.Name)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	default:
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("either a record address, or a scope id and name, must be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retval := types.RecordHistoryResponse{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.EntryVersionKeyPrefix(recordAddr))
+	var err error
+	retval.Pagination, err = query.Paginate(prefixStore, getPageRequest(req), func(_, value []byte) error {
+		var recordVersion types.RecordVersion
+		if vErr := k.cdc.Unmarshal(value, &recordVersion); vErr != nil {
+			return vErr
+		}
+		retval.RecordVersions = append(retval.RecordVersions, recordVersion)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &retval, nil
+}
+
+// ScopeOwnershipTransfers returns pending scope ownership transfers.
+func (k Keeper) ScopeOwnershipTransfers(c context.Context, req *types.ScopeOwnershipTransfersRequest) (*types.ScopeOwnershipTransfersResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeOwnershipTransfers")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(req.Recipient) > 0 {
+		if _, err := sdk.AccAddressFromBech32(req.Recipient); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid recipient %q: %v", req.Recipient, err)
+		}
+	}
+	isIncluded := func(transfer types.ScopeOwnershipTransfer) bool {
+		return len(req.Recipient) == 0 || transfer.IsRecipient(req.Recipient)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retval := types.ScopeOwnershipTransfersResponse{}
+	if len(req.ScopeId) > 0 {
+		scopeAddr, err := ParseScopeID(req.ScopeId)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		if transfer, found := k.GetScopeOwnershipTransfer(ctx, scopeAddr); found && isIncluded(transfer) {
+			retval.Transfers = append(retval.Transfers, transfer)
+		}
+		return &retval, nil
+	}
+
+	var err error
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScopeOwnershipTransferPrefix)
+	retval.Pagination, err = query.FilteredPaginate(prefixStore, getPageRequest(req), func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var transfer types.ScopeOwnershipTransfer
+		if vErr := k.cdc.Unmarshal(value, &transfer); vErr != nil {
+			return false, vErr
+		}
+		if !isIncluded(transfer) {
+			return false, nil
+		}
+		if accumulate {
+			retval.Transfers = append(retval.Transfers, transfer)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &retval, nil
+}
+
+// RecordCommitment returns the merkle root over the records of a record's scope, and the proof of the record in it.
+func (k Keeper) RecordCommitment(c context.Context, req *types.RecordCommitmentRequest) (*types.RecordCommitmentResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "RecordCommitment")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	var recordAddr types.MetadataAddress
+	switch {
+	case len(req.RecordAddr) > 0:
+		var err error
+		recordAddr, err = ParseRecordAddr(req.RecordAddr)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	case len(req.ScopeId) > 0 && len(req.Name) > 0:
+		scopeAddr, err := ParseScopeID(req.ScopeId)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		recordAddr, err = scopeAddr.AsRecordAddress(req.Name)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	default:
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("either a record address, or a scope id and name, must be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	root, leaf, proof, err := k.GetRecordCommitment(ctx, recordAddr)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrap(err.Error())
+	}
+	return &types.RecordCommitmentResponse{Root: root, Leaf: leaf, Proof: proof}, nil
+}
+
+// ScopeDataAccess returns the addresses with data access to a scope, and when that access expires.
+func (k Keeper) ScopeDataAccess(c context.Context, req *types.ScopeDataAccessRequest) (*types.ScopeDataAccessResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeDataAccess")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(req.ScopeId) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty scope id")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	scope, found := k.GetScope(ctx, scopeAddr)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope %s not found", scopeAddr)
+	}
+
+	blockTime := ctx.BlockTime()
+	retval := types.ScopeDataAccessResponse{}
+	for _, addr := range scope.DataAccess {
+		if !k.HasScopeDataAccess(ctx, scope, addr) {
+			continue
+		}
+		entry := types.ScopeDataAccessEntry{Address: addr}
+		if accAddr, aErr := sdk.AccAddressFromBech32(addr); aErr == nil {
+			if grant, hasGrant := k.GetScopeDataAccessGrant(ctx, scopeAddr, accAddr); hasGrant {
+				remaining := grant.Expiration.Sub(blockTime)
+				entry.Expiration = &grant.Expiration
+				entry.Remaining = &remaining
+			}
+		}
+		retval.DataAccess = append(retval.DataAccess, entry)
+	}
+	return &retval, nil
+}
+
+// ScopeTombstone returns the record of an archived scope.
+func (k Keeper) ScopeTombstone(c context.Context, req *types.ScopeTombstoneRequest) (*types.ScopeTombstoneResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeTombstone")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(req.ScopeId) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty scope id")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	tombstone, found := k.GetScopeTombstone(ctx, scopeAddr)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope %s tombstone not found", scopeAddr)
+	}
+	return &types.ScopeTombstoneResponse{Tombstone: tombstone}, nil
 }
 
 // hasPageRequest is just for use with the getPageRequest func below.
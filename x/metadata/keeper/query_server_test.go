@@ -2281,10 +2281,627 @@ func (s *QueryServerTestSuite) TestScopeNetAssetValuesQuery() {
 	}
 }
 
+// writeHistoryData writes a scope, session and record, then changes the scope and record and deletes the record.
+// It returns the scope and record as they were first written, the session, the record as it was when deleted,
+// and the change of the updates.
+func (s *QueryServerTestSuite) writeHistoryData() (types.Scope, types.Session, types.Record, types.Record, types.EntryChange) {
+	blockTime := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockHeight(20).WithBlockTime(blockTime)
+	newScope := func(dataAccess ...string) types.Scope {
+		return *types.NewScope(s.scopeID, nil, ownerPartyList(s.user1), dataAccess, "", false)
+	}
+	newRecord := func(hash string) types.Record {
+		process := types.NewProcess("process", &types.Process_Hash{Hash: "HASH"}, "method")
+		outputs := []types.RecordOutput{*types.NewRecordOutput(hash, types.ResultStatus_RESULT_STATUS_PASS)}
+		return *types.NewRecord(s.recordName, s.sessionID, *process, nil, outputs, nil)
+	}
+
+	mdKeeper := s.app.MetadataKeeper
+	scope, record1, record2 := newScope(), newRecord("hash1"), newRecord("hash2")
+	s.Require().NoError(mdKeeper.SetScope(ctx, scope), "SetScope")
+	session := *types.NewSession(s.sessionName, s.sessionID, s.cSpecID, ownerPartyList(s.user1), nil)
+	mdKeeper.SetSession(ctx, session)
+	mdKeeper.SetRecord(ctx, record1)
+
+	// Writing the same values again doesn't record anything.
+	s.Require().NoError(mdKeeper.SetScope(ctx, scope), "SetScope unchanged")
+	mdKeeper.SetRecord(ctx, record1)
+
+	ctx = types.WithChangedBy(ctx.WithBlockHeight(21).WithBlockTime(blockTime.Add(time.Minute)), []string{s.user1})
+	s.Require().NoError(mdKeeper.SetScope(ctx, newScope(s.user2)), "SetScope with data access")
+	mdKeeper.SetRecord(ctx, record2)
+	mdKeeper.RemoveRecord(ctx.WithBlockHeight(22), s.recordID)
+
+	change := types.EntryChange{ChangedBy: []string{s.user1}, Height: 21, BlockTime: blockTime.Add(time.Minute)}
+	return scope, session, record1, record2, change
+}
+
+func (s *QueryServerTestSuite) TestScopeHistoryQuery() {
+	scope, session, _, _, change := s.writeHistoryData()
+	expScopeVersions := []types.ScopeVersion{{Version: 1, Scope: scope, Change: change}}
+
+	tests := []struct {
+		name               string
+		req                *types.ScopeHistoryRequest
+		expErr             string
+		expScopeVersions   []types.ScopeVersion
+		expSessionVersions int
+	}{
+		{
+			name:   "empty scope id",
+			req:    &types.ScopeHistoryRequest{},
+			expErr: "empty scope id",
+		},
+		{
+			name:             "by scope id",
+			req:              &types.ScopeHistoryRequest{ScopeId: s.scopeID.String()},
+			expScopeVersions: expScopeVersions,
+		},
+		{
+			name:             "by scope uuid",
+			req:              &types.ScopeHistoryRequest{ScopeId: s.scopeUUID.String()},
+			expScopeVersions: expScopeVersions,
+		},
+		{
+			name:               "with a session id",
+			req:                &types.ScopeHistoryRequest{ScopeId: s.scopeID.String(), SessionId: s.sessionID.String()},
+			expScopeVersions:   expScopeVersions,
+			expSessionVersions: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.queryClient.ScopeHistory(gocontext.Background(), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "ScopeHistory error")
+				return
+			}
+			s.Require().NoError(err, "ScopeHistory error")
+			s.Assert().Equal(tc.expScopeVersions, resp.ScopeVersions, "scope versions")
+			s.Require().Len(resp.SessionVersions, tc.expSessionVersions, "session versions")
+			// Deleting the last record also deleted the session.
+			for i, sessionVersion := range resp.SessionVersions {
+				s.Assert().Equal(session, sessionVersion.Session, "session version[%d]", i)
+				s.Assert().True(sessionVersion.Change.Deleted, "session version[%d] deleted", i)
+			}
+		})
+	}
+
+	genState := s.app.MetadataKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	s.Assert().Equal(expScopeVersions, genState.ScopeVersions, "exported scope versions")
+	s.Assert().Len(genState.SessionVersions, 1, "exported session versions")
+}
+
+func (s *QueryServerTestSuite) TestRecordHistoryQuery() {
+	_, _, record1, record2, change := s.writeHistoryData()
+	deleteChange := change
+	deleteChange.Height = 22
+	deleteChange.Deleted = true
+	expRecordVersions := []types.RecordVersion{
+		{Version: 1, Record: record1, Change: change},
+		{Version: 2, Record: record2, Change: deleteChange},
+	}
+
+	tests := []struct {
+		name              string
+		req               *types.RecordHistoryRequest
+		expErr            string
+		expRecordVersions []types.RecordVersion
+		expNextKey        bool
+	}{
+		{
+			name:              "by record address",
+			req:               &types.RecordHistoryRequest{RecordAddr: s.recordID.String()},
+			expRecordVersions: expRecordVersions,
+		},
+		{
+			name:              "by scope and name with a limit",
+			req:               &types.RecordHistoryRequest{ScopeId: s.scopeID.String(), Name: s.recordName, Pagination: &query.PageRequest{Limit: 1}},
+			expRecordVersions: expRecordVersions[:1],
+			expNextKey:        true,
+		},
+		{
+			name:   "scope without a name",
+			req:    &types.RecordHistoryRequest{ScopeId: s.scopeID.String()},
+			expErr: "either a record address, or a scope id and name, must be provided",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.queryClient.RecordHistory(gocontext.Background(), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "RecordHistory error")
+				return
+			}
+			s.Require().NoError(err, "RecordHistory error")
+			s.Assert().Equal(tc.expRecordVersions, resp.RecordVersions, "record versions")
+			s.Assert().Equal(tc.expNextKey, resp.Pagination != nil && len(resp.Pagination.NextKey) > 0, "has next key")
+		})
+	}
+
+	genState := s.app.MetadataKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	s.Assert().Equal(expRecordVersions, genState.RecordVersions, "exported record versions")
+}
+
+func (s *QueryServerTestSuite) TestScopeOwnershipTransfersQuery() {
+	buyer := sdk.AccAddress("transfer_buyer______").String()
+	other := sdk.AccAddress("transfer_other______").String()
+	expiration := time.Date(2026, 7, 1, 10, 0, 0, 0, time.UTC)
+	newTransfer := func(recipient string) types.ScopeOwnershipTransfer {
+		return types.ScopeOwnershipTransfer{
+			ScopeId:                  types.ScopeMetadataAddress(uuid.New()),
+			Owners:                   ownerPartyList(recipient),
+			ValueOwnerAddress:        recipient,
+			Recipients:               []string{recipient},
+			ProposedBy:               []string{s.user1},
+			CurrentOwners:            ownerPartyList(s.user1),
+			CurrentValueOwnerAddress: s.user1,
+			Expiration:               expiration,
+		}
+	}
+	transfer1 := newTransfer(buyer)
+	transfer2 := newTransfer(s.user2)
+	for _, transfer := range []types.ScopeOwnershipTransfer{transfer1, transfer2} {
+		s.Require().NoError(s.app.MetadataKeeper.SetScopeOwnershipTransfer(s.ctx, transfer), "SetScopeOwnershipTransfer(%s)", transfer.ScopeId)
+	}
+
+	tests := []struct {
+		name         string
+		req          *types.ScopeOwnershipTransfersRequest
+		expErr       string
+		expTransfers []types.ScopeOwnershipTransfer
+	}{
+		{
+			name:   "invalid recipient",
+			req:    &types.ScopeOwnershipTransfersRequest{Recipient: "notabech32"},
+			expErr: `invalid recipient "notabech32"`,
+		},
+		{
+			name:         "by recipient",
+			req:          &types.ScopeOwnershipTransfersRequest{Recipient: buyer},
+			expTransfers: []types.ScopeOwnershipTransfer{transfer1},
+		},
+		{
+			name:         "by a recipient without any",
+			req:          &types.ScopeOwnershipTransfersRequest{Recipient: other},
+			expTransfers: nil,
+		},
+		{
+			name:         "by scope id",
+			req:          &types.ScopeOwnershipTransfersRequest{ScopeId: transfer2.ScopeId.String()},
+			expTransfers: []types.ScopeOwnershipTransfer{transfer2},
+		},
+		{
+			name:         "by scope id and another recipient",
+			req:          &types.ScopeOwnershipTransfersRequest{ScopeId: transfer2.ScopeId.String(), Recipient: buyer},
+			expTransfers: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.queryClient.ScopeOwnershipTransfers(gocontext.Background(), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "ScopeOwnershipTransfers error")
+				return
+			}
+			s.Require().NoError(err, "ScopeOwnershipTransfers error")
+			if len(tc.expTransfers) == 0 {
+				s.Assert().Empty(resp.Transfers, "transfers")
+			} else {
+				s.Assert().Equal(tc.expTransfers, resp.Transfers, "transfers")
+			}
+		})
+	}
+}
+
+func (s *QueryServerTestSuite) TestScopeSearchQuery() {
+	ctx := s.ctx.WithBlockHeight(5)
+	servicer := sdk.AccAddress("search_servicer_____").String()
+	investor := sdk.AccAddress("search_investor_____").String()
+	reader := sdk.AccAddress("search_reader_______").String()
+	specX := types.ScopeSpecMetadataAddress(uuid.New())
+	specY := types.ScopeSpecMetadataAddress(uuid.New())
+
+	// A: spec X, serviced by the servicer, value owned by the investor, readable by the reader.
+	scopeA := types.Scope{
+		ScopeId:         types.ScopeMetadataAddress(uuid.New()),
+		SpecificationId: specX,
+		Owners: []types.Party{
+			{Address: servicer, Role: types.PartyType_PARTY_TYPE_SERVICER},
+			{Address: investor, Role: types.PartyType_PARTY_TYPE_OWNER},
+		},
+		DataAccess:        []string{reader},
+		ValueOwnerAddress: investor,
+	}
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(ctx, scopeA), "SetScope A")
+
+	// B: spec X, owned (not serviced) by the servicer, with a usd net asset value.
+	scopeB := types.Scope{
+		ScopeId:           types.ScopeMetadataAddress(uuid.New()),
+		SpecificationId:   specX,
+		Owners:            ownerPartyList(servicer),
+		ValueOwnerAddress: servicer,
+	}
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(ctx, scopeB), "SetScope B")
+	nav := types.NewNetAssetValue(sdk.NewCoin("usd", sdkmath.NewInt(100)), 1)
+	s.Require().NoError(s.app.MetadataKeeper.SetNetAssetValue(ctx, scopeB.ScopeId, nav, "test"), "SetNetAssetValue B")
+
+	// C: spec Y, serviced by the servicer, created later.
+	scopeC := types.Scope{
+		ScopeId:           types.ScopeMetadataAddress(uuid.New()),
+		SpecificationId:   specY,
+		Owners:            []types.Party{{Address: servicer, Role: types.PartyType_PARTY_TYPE_SERVICER}},
+		ValueOwnerAddress: servicer,
+	}
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(ctx.WithBlockHeight(10), scopeC), "SetScope C")
+
+	idA, idB, idC := scopeA.ScopeId, scopeB.ScopeId, scopeC.ScopeId
+
+	tests := []struct {
+		name   string
+		req    *types.ScopeSearchRequest
+		exp    []types.MetadataAddress
+		expErr string
+	}{
+		{
+			name:   "no filters",
+			req:    &types.ScopeSearchRequest{},
+			expErr: "at least one of owner, data access, value owner, or scope spec id is required",
+		},
+		{
+			name:   "only unindexed filters",
+			req:    &types.ScopeSearchRequest{NavDenom: "usd", MinCreatedHeight: 1},
+			expErr: "at least one of owner, data access, value owner, or scope spec id is required",
+		},
+		{
+			name: "scope spec",
+			req:  &types.ScopeSearchRequest{ScopeSpecId: specX.String()},
+			exp:  []types.MetadataAddress{idA, idB},
+		},
+		{
+			name: "owner with role and scope spec",
+			req:  &types.ScopeSearchRequest{Owner: servicer, OwnerRole: types.PartyType_PARTY_TYPE_SERVICER, ScopeSpecId: specX.String()},
+			exp:  []types.MetadataAddress{idA},
+		},
+		{
+			name: "owner with role",
+			req:  &types.ScopeSearchRequest{Owner: servicer, OwnerRole: types.PartyType_PARTY_TYPE_SERVICER},
+			exp:  []types.MetadataAddress{idA, idC},
+		},
+		{
+			name: "owner",
+			req:  &types.ScopeSearchRequest{Owner: servicer},
+			exp:  []types.MetadataAddress{idA, idB, idC},
+		},
+		{
+			name: "data access address is not an owner",
+			req:  &types.ScopeSearchRequest{Owner: reader},
+		},
+		{
+			name: "data access",
+			req:  &types.ScopeSearchRequest{DataAccess: reader},
+			exp:  []types.MetadataAddress{idA},
+		},
+		{
+			name: "value owner",
+			req:  &types.ScopeSearchRequest{ValueOwner: investor},
+			exp:  []types.MetadataAddress{idA},
+		},
+		{
+			name: "value owner with scope spec",
+			req:  &types.ScopeSearchRequest{ValueOwner: servicer, ScopeSpecId: specY.String()},
+			exp:  []types.MetadataAddress{idC},
+		},
+		{
+			name: "value owner with nav denom",
+			req:  &types.ScopeSearchRequest{ValueOwner: servicer, NavDenom: "usd"},
+			exp:  []types.MetadataAddress{idB},
+		},
+		{
+			name: "value owner and owner",
+			req:  &types.ScopeSearchRequest{ValueOwner: investor, Owner: servicer},
+			exp:  []types.MetadataAddress{idA},
+		},
+		{
+			name: "nav denom",
+			req:  &types.ScopeSearchRequest{ScopeSpecId: specX.String(), NavDenom: "usd"},
+			exp:  []types.MetadataAddress{idB},
+		},
+		{
+			name: "min created height",
+			req:  &types.ScopeSearchRequest{Owner: servicer, MinCreatedHeight: 8},
+			exp:  []types.MetadataAddress{idC},
+		},
+		{
+			name: "max updated height",
+			req:  &types.ScopeSearchRequest{Owner: servicer, MaxUpdatedHeight: 5},
+			exp:  []types.MetadataAddress{idA, idB},
+		},
+		{
+			name:   "role without owner",
+			req:    &types.ScopeSearchRequest{OwnerRole: types.PartyType_PARTY_TYPE_SERVICER},
+			expErr: "an owner is required when searching by owner role",
+		},
+		{
+			name:   "invalid height range",
+			req:    &types.ScopeSearchRequest{Owner: servicer, MinUpdatedHeight: 10, MaxUpdatedHeight: 5},
+			expErr: "min updated height 10 cannot be greater than max updated height 5",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.queryClient.ScopeSearch(gocontext.Background(), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "ScopeSearch error")
+				return
+			}
+			s.Require().NoError(err, "ScopeSearch error")
+			var actual []types.MetadataAddress
+			for _, wrapper := range resp.Scopes {
+				actual = append(actual, wrapper.Scope.ScopeId)
+			}
+			s.Assert().ElementsMatch(tc.exp, actual, "ScopeSearch scope ids")
+		})
+	}
+}
+
+// newCommitmentRecord creates a record in a session with the provided name and output hashes.
+func newCommitmentRecord(sessionID types.MetadataAddress, name string, hashes ...string) types.Record {
+	outputs := make([]types.RecordOutput, len(hashes))
+	for i, hash := range hashes {
+		outputs[i] = types.RecordOutput{Hash: hash, Status: types.ResultStatus_RESULT_STATUS_PASS}
+	}
+	process := types.NewProcess("process", &types.Process_Hash{Hash: "hash"}, "method")
+	return *types.NewRecord(name, sessionID, *process, nil, outputs, nil)
+}
+
+func (s *QueryServerTestSuite) TestRecordCommitmentQuery() {
+	mdKeeper := s.app.MetadataKeeper
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), nil, s.user1, false)
+	s.Require().NoError(mdKeeper.SetScope(s.ctx, *scope), "SetScope")
+	mdKeeper.SetSession(s.ctx, *types.NewSession(s.sessionName, s.sessionID, s.cSpecID, ownerPartyList(s.user1), nil))
+	terms := newCommitmentRecord(s.sessionID, "terms", "termshash")
+	appraisal := newCommitmentRecord(s.sessionID, "appraisal", "appraisalhash1", "appraisalhash2")
+	servicing := newCommitmentRecord(s.sessionID, "servicing", "servicinghash")
+	for _, record := range []types.Record{terms, appraisal, servicing} {
+		mdKeeper.SetRecord(s.ctx, record)
+	}
+	mdKeeper.RemoveRecord(s.ctx, s.scopeID.MustGetAsRecordAddress(servicing.Name))
+	root, found := mdKeeper.GetScopeRecordsRoot(s.ctx, s.scopeID)
+	s.Require().True(found, "GetScopeRecordsRoot found")
+
+	tests := []struct {
+		name      string
+		req       *types.RecordCommitmentRequest
+		expErr    string
+		expRecord types.Record
+		otherLeaf []byte
+	}{
+		{
+			name:   "no record",
+			req:    &types.RecordCommitmentRequest{},
+			expErr: "either a record address, or a scope id and name, must be provided",
+		},
+		{
+			name:   "deleted record",
+			req:    &types.RecordCommitmentRequest{ScopeId: s.scopeID.String(), Name: servicing.Name},
+			expErr: "not found",
+		},
+		{
+			name:      "by scope id and name",
+			req:       &types.RecordCommitmentRequest{ScopeId: s.scopeID.String(), Name: terms.Name},
+			expRecord: terms,
+			otherLeaf: types.RecordCommitmentLeaf(appraisal),
+		},
+		{
+			name:      "by scope uuid and name",
+			req:       &types.RecordCommitmentRequest{ScopeId: s.scopeUUID.String(), Name: appraisal.Name},
+			expRecord: appraisal,
+			otherLeaf: types.RecordCommitmentLeaf(terms),
+		},
+		{
+			name:      "by record address",
+			req:       &types.RecordCommitmentRequest{RecordAddr: s.scopeID.MustGetAsRecordAddress(terms.Name).String()},
+			expRecord: terms,
+			otherLeaf: types.RecordCommitmentLeaf(servicing),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.queryClient.RecordCommitment(gocontext.Background(), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "RecordCommitment error")
+				return
+			}
+			s.Require().NoError(err, "RecordCommitment error")
+			s.Assert().Equal(root, resp.Root, "RecordCommitment root")
+			s.Assert().Equal(types.RecordCommitmentLeaf(tc.expRecord), resp.Leaf, "RecordCommitment leaf")
+			s.Assert().NoError(resp.Proof.Verify(root, resp.Leaf), "proof Verify with the record's leaf")
+			s.Assert().Error(resp.Proof.Verify(root, tc.otherLeaf), "proof Verify with another leaf")
+		})
+	}
+}
+
+func (s *QueryServerTestSuite) TestScopeDataAccessQuery() {
+	blockTime := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	expiration := blockTime.Add(time.Hour)
+	remaining := 15 * time.Minute
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1, s.user2}, s.user1, false)
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, *scope), "SetScope")
+	s.Require().NoError(s.app.MetadataKeeper.SetScopeDataAccessGrant(s.ctx, types.NewScopeDataAccessGrant(s.scopeID, s.user2, expiration)), "SetScopeDataAccessGrant")
+	dneScopeID := types.ScopeMetadataAddress(uuid.New())
+
+	tests := []struct {
+		name      string
+		scopeID   string
+		blockTime time.Time
+		expErr    string
+		expAccess []types.ScopeDataAccessEntry
+	}{
+		{
+			name:      "empty scope id",
+			blockTime: blockTime,
+			expErr:    "empty scope id",
+		},
+		{
+			name:      "scope not found",
+			scopeID:   dneScopeID.String(),
+			blockTime: blockTime,
+			expErr:    fmt.Sprintf("scope %s not found", dneScopeID),
+		},
+		{
+			name:      "before the expiration",
+			scopeID:   s.scopeID.String(),
+			blockTime: expiration.Add(-remaining),
+			expAccess: []types.ScopeDataAccessEntry{
+				{Address: s.user1},
+				{Address: s.user2, Expiration: &expiration, Remaining: &remaining},
+			},
+		},
+		{
+			name:      "after the expiration",
+			scopeID:   s.scopeID.String(),
+			blockTime: expiration.Add(time.Second),
+			expAccess: []types.ScopeDataAccessEntry{{Address: s.user1}},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithBlockTime(tc.blockTime)
+			resp, err := s.app.MetadataKeeper.ScopeDataAccess(ctx, &types.ScopeDataAccessRequest{ScopeId: tc.scopeID})
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "ScopeDataAccess error")
+				return
+			}
+			s.Require().NoError(err, "ScopeDataAccess error")
+			s.Assert().Equal(tc.expAccess, resp.DataAccess, "ScopeDataAccess data access")
+		})
+	}
+}
+
+func (s *QueryServerTestSuite) TestScopeTombstoneQuery() {
+	tombstone := types.ScopeTombstone{
+		ScopeId:     s.scopeID,
+		ArchiveHash: bytes.Repeat([]byte{'a'}, 32),
+		Change:      types.EntryChange{ChangedBy: []string{s.user1}, Height: 40, BlockTime: time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC), Deleted: true},
+	}
+	s.Require().NoError(s.app.MetadataKeeper.SetScopeTombstone(s.ctx, tombstone), "SetScopeTombstone")
+	otherScopeID := types.ScopeMetadataAddress(uuid.New())
+
+	tests := []struct {
+		name         string
+		scopeID      string
+		expErr       string
+		expTombstone types.ScopeTombstone
+	}{
+		{
+			name:   "empty scope id",
+			expErr: "empty scope id",
+		},
+		{
+			name:    "scope that wasn't archived",
+			scopeID: otherScopeID.String(),
+			expErr:  "tombstone not found",
+		},
+		{
+			name:         "by scope id",
+			scopeID:      s.scopeID.String(),
+			expTombstone: tombstone,
+		},
+		{
+			name:         "by scope uuid",
+			scopeID:      s.scopeUUID.String(),
+			expTombstone: tombstone,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.queryClient.ScopeTombstone(gocontext.Background(), &types.ScopeTombstoneRequest{ScopeId: tc.scopeID})
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "ScopeTombstone error")
+				return
+			}
+			s.Require().NoError(err, "ScopeTombstone error")
+			s.Assert().Equal(tc.expTombstone, resp.Tombstone, "ScopeTombstone tombstone")
+		})
+	}
+}
+
 // TODO: OSLocatorParams tests
 // TODO: OSLocator tests
 // TODO: OSLocatorsByURI tests
-// TODO: OSLocatorsByScope tests
+
+func (s *QueryServerTestSuite) TestOSLocatorsByScopeQuery() {
+	blockTime := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	expiration := blockTime.Add(time.Hour)
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user2Addr))
+	for _, addr := range []sdk.AccAddress{s.user1Addr, s.user2Addr} {
+		s.Require().NoError(s.app.MetadataKeeper.SetOSLocator(s.ctx, addr, addr, "https://example.com/"+addr.String()), "SetOSLocator %s", addr)
+	}
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user2}, s.user1, false)
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, *scope), "SetScope")
+	s.Require().NoError(s.app.MetadataKeeper.SetScopeDataAccessGrant(s.ctx, types.NewScopeDataAccessGrant(s.scopeID, s.user2, expiration)), "SetScopeDataAccessGrant")
+
+	tests := []struct {
+		name        string
+		scopeID     string
+		blockTime   time.Time
+		expErr      string
+		expOwners   []string
+		expGrantees []string
+	}{
+		{
+			name:      "empty scope id",
+			blockTime: blockTime,
+			expErr:    "scope id cannot be empty",
+		},
+		{
+			name:        "before the data access expiration",
+			scopeID:     s.scopeID.String(),
+			blockTime:   blockTime,
+			expOwners:   []string{s.user1},
+			expGrantees: []string{s.user2},
+		},
+		{
+			name:      "after the data access expiration",
+			scopeID:   s.scopeID.String(),
+			blockTime: expiration.Add(time.Second),
+			expOwners: []string{s.user1},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithBlockTime(tc.blockTime)
+			resp, err := s.app.MetadataKeeper.OSLocatorsByScope(ctx, &types.OSLocatorsByScopeRequest{ScopeId: tc.scopeID})
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "OSLocatorsByScope error")
+				return
+			}
+			s.Require().NoError(err, "OSLocatorsByScope error")
+			var owners []string
+			for _, loc := range resp.Locators {
+				owners = append(owners, loc.Owner)
+			}
+			s.Assert().Equal(tc.expOwners, owners, "OSLocatorsByScope locator owners")
+			var grantees []string
+			for _, loc := range resp.DataAccessLocators {
+				grantees = append(grantees, loc.Owner)
+			}
+			s.Assert().Equal(tc.expGrantees, grantees, "OSLocatorsByScope data access locator owners")
+		})
+	}
+}
+
 // TODO: OSAllLocators tests
 
 // TODO: Helper IsBase64 tests
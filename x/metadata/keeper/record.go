@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/provenance-io/provenance/internal/provutils"
+	assettypes "github.com/provenance-io/provenance/x/asset/types"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -56,24 +58,43 @@ func (k Keeper) GetRecords(ctx sdk.Context, scopeAddress types.MetadataAddress,
 	return records, nil
 }
 
-// SetRecord stores a record in the module kv store.
+// SetRecord stores a record in the module kv store and updates its scope's records root.
 func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
+	k.setRecord(ctx, record)
+	k.updateScopeRecordsRoot(ctx, record.SessionId.MustGetAsScopeAddress())
+}
+
+// setRecord stores a record in the module kv store without updating its scope's records root.
+// When used, updateScopeRecordsRoot must be called once all of the scope's records have been written.
+func (k Keeper) setRecord(ctx sdk.Context, record types.Record) {
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshal(&record)
 
 	recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
 
 	var event proto.Message = types.NewEventRecordCreated(recordID, record.SessionId)
-	if store.Has(recordID) {
+	if oldBz := store.Get(recordID); oldBz != nil {
 		event = types.NewEventRecordUpdated(recordID, record.SessionId)
+		if !bytes.Equal(oldBz, b) {
+			var oldRecord types.Record
+			k.cdc.MustUnmarshal(oldBz, &oldRecord)
+			k.addRecordVersion(ctx, recordID, oldRecord, false)
+		}
 	}
 
 	store.Set(recordID, b)
 	k.EmitEvent(ctx, event)
 }
 
-// RemoveRecord removes a record from the module kv store.
+// RemoveRecord removes a record from the module kv store and updates its scope's records root.
 func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
+	k.removeRecord(ctx, id)
+	k.updateScopeRecordsRoot(ctx, id.MustGetAsScopeAddress())
+}
+
+// removeRecord removes a record from the module kv store without updating its scope's records root.
+// When used, updateScopeRecordsRoot must be called once all of the scope's records have been removed.
+func (k Keeper) removeRecord(ctx sdk.Context, id types.MetadataAddress) {
 	if !id.IsRecordAddress() {
 		panic(fmt.Errorf("invalid address, address must be for a record"))
 	}
@@ -81,6 +102,7 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 	if !found {
 		return
 	}
+	k.addRecordVersion(ctx, id, record, true)
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(id)
 	k.EmitEvent(ctx, types.NewEventRecordDeleted(id))
@@ -120,6 +142,17 @@ func (k Keeper) ValidateWriteRecord(
 	ctx sdk.Context,
 	existing *types.Record,
 	msg *types.MsgWriteRecordRequest,
+) error {
+	return k.validateWriteRecord(ctx, existing, msg, nil)
+}
+
+// validateWriteRecord is the same as ValidateWriteRecord, except if signers is not nil,
+// the signatures required for the record are added to it instead of being checked now.
+func (k Keeper) validateWriteRecord(
+	ctx sdk.Context,
+	existing *types.Record,
+	msg *types.MsgWriteRecordRequest,
+	signers *signerRequirements,
 ) error {
 	proposed := &msg.Record
 	if err := proposed.ValidateBasic(); err != nil {
@@ -191,7 +224,9 @@ func (k Keeper) ValidateWriteRecord(
 		if oldSession != nil {
 			reqSigs = append(reqSigs, oldSession.GetAllPartyAddresses()...)
 		}
-		if err = k.ValidateSignersWithoutParties(ctx, reqSigs, msg); err != nil {
+		if signers != nil {
+			signers.add(signerRequirement{msg: msg, addrs: reqSigs})
+		} else if err = k.ValidateSignersWithoutParties(ctx, reqSigs, msg); err != nil {
 			return err
 		}
 	} else {
@@ -205,7 +240,9 @@ func (k Keeper) ValidateWriteRecord(
 		if oldSession != nil {
 			reqParties = append(reqParties, oldSession.Parties...)
 		}
-		if err = k.ValidateSignersWithParties(ctx, reqParties, session.Parties, recSpec.ResponsibleParties, msg); err != nil {
+		if signers != nil {
+			signers.add(signerRequirement{msg: msg, reqParties: reqParties, availableParties: session.Parties, reqRoles: recSpec.ResponsibleParties})
+		} else if err = k.ValidateSignersWithParties(ctx, reqParties, session.Parties, recSpec.ResponsibleParties, msg); err != nil {
 			return err
 		}
 	}
@@ -302,6 +339,30 @@ func (k Keeper) ValidateWriteRecord(
 	// case types.DefinitionType_DEFINITION_TYPE_PROPOSED: ignored
 	// case types.DefinitionType_DEFINITION_TYPE_UNSPECIFIED: ignored
 
+	return validateRecordOutputValues(recSpec, proposed.Outputs)
+}
+
+// validateRecordOutputValues makes sure the value of each non-skipped output conforms
+// to the record specification's output schema (if it has one).
+func validateRecordOutputValues(recSpec types.RecordSpecification, outputs []types.RecordOutput) error {
+	schema, err := recSpec.GetOutputJSONSchema()
+	if err != nil {
+		return fmt.Errorf("invalid record specification %s output schema: %w", recSpec.SpecificationId, err)
+	}
+	if schema == nil {
+		return nil
+	}
+	for i, output := range outputs {
+		if output.Status == types.ResultStatus_RESULT_STATUS_SKIP {
+			continue
+		}
+		if len(output.Value) == 0 {
+			return fmt.Errorf("output %d is missing a value required by the record specification output schema", i)
+		}
+		if err = assettypes.ValidateDataWithJSONSchema(schema, []byte(output.Value)); err != nil {
+			return fmt.Errorf("output %d value does not match the record specification output schema: %w", i, err)
+		}
+	}
 	return nil
 }
 
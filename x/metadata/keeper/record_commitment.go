@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetScopeRecordsRoot gets the merkle root over all of a scope's records.
+// It returns false if the scope does not have any records.
+func (k Keeper) GetScopeRecordsRoot(ctx sdk.Context, scopeID types.MetadataAddress) ([]byte, bool) {
+	root := ctx.KVStore(k.storeKey).Get(types.ScopeRecordsRootKey(scopeID))
+	return root, len(root) > 0
+}
+
+// getScopeRecordIDsAndLeaves gets the ids and commitment leaves of all of a scope's records, ordered by record id.
+func (k Keeper) getScopeRecordIDsAndLeaves(ctx sdk.Context, scopeID types.MetadataAddress) ([]types.MetadataAddress, [][]byte, error) {
+	var ids []types.MetadataAddress
+	var leaves [][]byte
+	err := k.IterateRecords(ctx, scopeID, func(record types.Record) bool {
+		ids = append(ids, record.SessionId.MustGetAsRecordAddress(record.Name))
+		leaves = append(leaves, types.RecordCommitmentLeaf(record))
+		return false
+	})
+	return ids, leaves, err
+}
+
+// updateScopeRecordsRoot recalculates and stores the merkle root over all of a scope's records.
+// If the scope no longer has any records, its root is deleted.
+func (k Keeper) updateScopeRecordsRoot(ctx sdk.Context, scopeID types.MetadataAddress) {
+	_, leaves, err := k.getScopeRecordIDsAndLeaves(ctx, scopeID)
+	if err != nil {
+		panic(fmt.Errorf("could not get the records of %s: %w", scopeID, err))
+	}
+	store := ctx.KVStore(k.storeKey)
+	if len(leaves) == 0 {
+		store.Delete(types.ScopeRecordsRootKey(scopeID))
+		return
+	}
+	store.Set(types.ScopeRecordsRootKey(scopeID), merkle.HashFromByteSlices(leaves))
+}
+
+// GetRecordCommitment gets a scope's records root along with the commitment leaf of a record in it,
+// and the proof that the leaf is included in the root.
+func (k Keeper) GetRecordCommitment(ctx sdk.Context, recordID types.MetadataAddress) ([]byte, []byte, *types.RecordCommitmentProof, error) {
+	scopeID, err := recordID.AsScopeAddress()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ids, leaves, err := k.getScopeRecordIDsAndLeaves(ctx, scopeID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for i, id := range ids {
+		if id.Equals(recordID) {
+			root, proofs := merkle.ProofsFromByteSlices(leaves)
+			return root, leaves[i], types.NewRecordCommitmentProof(proofs[i]), nil
+		}
+	}
+	return nil, nil, nil, fmt.Errorf("record %s not found", recordID)
+}
+
+// RebuildScopeRecordsRoots recalculates and stores the merkle roots over the records of all scopes.
+func (k Keeper) RebuildScopeRecordsRoots(ctx sdk.Context) error {
+	return k.IterateScopes(ctx, func(scope types.Scope) bool {
+		k.updateScopeRecordsRoot(ctx, scope.ScopeId)
+		return false
+	})
+}
@@ -1014,3 +1014,87 @@ func (s *RecordKeeperTestSuite) TestValidateWriteRecord() {
 		})
 	}
 }
+
+func (s *RecordKeeperTestSuite) TestValidateWriteRecordOutputSchema() {
+	ctx := s.FreshCtx()
+	scopeUUID := uuid.New()
+	scope := types.NewScope(types.ScopeMetadataAddress(scopeUUID), s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1, false)
+	s.app.MetadataKeeper.SetScope(ctx, *scope)
+
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	session := types.NewSession(s.sessionName, sessionID, s.contractSpecID, ownerPartyList(s.user1), &types.AuditFields{CreatedBy: s.user1})
+	s.app.MetadataKeeper.SetSession(ctx, *session)
+
+	s.app.MetadataKeeper.SetContractSpecification(ctx, types.ContractSpecification{
+		SpecificationId: s.contractSpecID,
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ClassName:       "classname",
+	})
+	recordName := "schemarecord"
+	recordSpec := types.NewRecordSpecification(
+		types.RecordSpecMetadataAddress(s.contractSpecUUID, recordName),
+		recordName,
+		[]*types.InputSpecification{},
+		"TestRecordTypeName",
+		types.DefinitionType_DEFINITION_TYPE_RECORD_LIST,
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	)
+	recordSpec.OutputSchema = `{"type":"object","required":["status"],"properties":{"status":{"type":"string"}}}`
+	s.app.MetadataKeeper.SetRecordSpecification(ctx, *recordSpec)
+
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	output := func(value string, status types.ResultStatus) types.RecordOutput {
+		return types.RecordOutput{Hash: "justsomeoutput", Status: status, Value: value}
+	}
+
+	tests := []struct {
+		name    string
+		outputs []types.RecordOutput
+		expErr  string
+	}{
+		{
+			name:    "conforming value",
+			outputs: []types.RecordOutput{output(`{"status":"funded"}`, types.ResultStatus_RESULT_STATUS_PASS)},
+		},
+		{
+			name: "skipped output without a value",
+			outputs: []types.RecordOutput{
+				output(`{"status":"funded"}`, types.ResultStatus_RESULT_STATUS_PASS),
+				output("", types.ResultStatus_RESULT_STATUS_SKIP),
+			},
+		},
+		{
+			name:    "missing value",
+			outputs: []types.RecordOutput{output("", types.ResultStatus_RESULT_STATUS_PASS)},
+			expErr:  "output 0 is missing a value required by the record specification output schema",
+		},
+		{
+			name: "non-conforming value",
+			outputs: []types.RecordOutput{
+				output(`{"status":"funded"}`, types.ResultStatus_RESULT_STATUS_PASS),
+				output(`{"amount":"5"}`, types.ResultStatus_RESULT_STATUS_FAIL),
+			},
+			expErr: "output 1 value does not match the record specification output schema: required status cannot be empty",
+		},
+		{
+			name:    "wrong value type",
+			outputs: []types.RecordOutput{output(`{"status":5}`, types.ResultStatus_RESULT_STATUS_PASS)},
+			expErr: "output 0 value does not match the record specification output schema: " +
+				"invalid property status definition: invalid string definition",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			record := types.NewRecord(recordName, sessionID, *process, []types.RecordInput{}, tc.outputs, recordSpec.SpecificationId)
+			msg := &types.MsgWriteRecordRequest{Record: *record, Signers: []string{s.user1}}
+			err := s.app.MetadataKeeper.ValidateWriteRecord(s.FreshCtx(), nil, msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "ValidateWriteRecord")
+			} else {
+				s.Assert().NoError(err, "ValidateWriteRecord")
+			}
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"slices"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -142,6 +144,7 @@ func (k Keeper) SetScope(ctx sdk.Context, scope types.Scope) error {
 }
 
 // writeScopeToState writes the given scope to state, updates the related indexes, and emits the appropriate events.
+// If the scope is changing, its previous version is recorded in its history.
 // It's split out from SetScope only so that unit tests can write scopes that have something in the value owner field.
 func (k Keeper) writeScopeToState(ctx sdk.Context, scope types.Scope) {
 	store := ctx.KVStore(k.storeKey)
@@ -149,7 +152,8 @@ func (k Keeper) writeScopeToState(ctx sdk.Context, scope types.Scope) {
 
 	var oldScope *types.Scope
 	var event proto.Message = types.NewEventScopeCreated(scope.ScopeId)
-	if store.Has(scope.ScopeId) {
+	isNew := !store.Has(scope.ScopeId)
+	if !isNew {
 		event = types.NewEventScopeUpdated(scope.ScopeId)
 		if oldScopeBytes := store.Get(scope.ScopeId); len(oldScopeBytes) > 0 {
 			os, err := k.readScopeBz(oldScopeBytes)
@@ -162,8 +166,18 @@ func (k Keeper) writeScopeToState(ctx sdk.Context, scope types.Scope) {
 		}
 	}
 
+	if oldScope != nil && !bytes.Equal(k.cdc.MustMarshal(oldScope), b) {
+		prevScope := *oldScope
+		k.PopulateScopeValueOwner(ctx, &prevScope)
+		k.addScopeVersion(ctx, prevScope, false)
+	}
+
 	store.Set(scope.ScopeId, b)
 	k.indexScope(store, &scope, oldScope)
+	k.markScopeWritten(ctx, scope.ScopeId, isNew)
+	if oldScope != nil && len(oldScope.DataAccess) > 0 {
+		k.removeStaleScopeDataAccessGrants(ctx, scope.ScopeId, &scope)
+	}
 	k.EmitEvent(ctx, event)
 }
 
@@ -178,13 +192,17 @@ func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) error {
 	if !found {
 		return nil
 	}
+	// The deleted version includes the value owner that's about to be burned.
+	deletedScope := scope
+	k.PopulateScopeValueOwner(ctx, &deletedScope)
 
 	// Burn the scope's value owner coin.
 	if err := k.SetScopeValueOwner(ctx, id, ""); err != nil {
 		return fmt.Errorf("could not remove scope %s value owner: %w", id, err)
 	}
 
-	// Remove all records. The sessions are deleted by RemoveRecord as the last record in each is deleted.
+	// Remove all records. The sessions are deleted by removeRecord as the last record in each is deleted.
+	// The records root is deleted once they're all gone instead of being recalculated for each one.
 	store := ctx.KVStore(k.storeKey)
 	prefix, _ := id.ScopeRecordIteratorPrefix() // Can't return an error because we know it's a valid scope id.
 	iter := storetypes.KVStorePrefixIterator(store, prefix)
@@ -194,9 +212,14 @@ func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) error {
 		}
 	}()
 	for ; iter.Valid(); iter.Next() {
-		k.RemoveRecord(ctx, iter.Key())
+		k.removeRecord(ctx, iter.Key())
 	}
+	store.Delete(types.ScopeRecordsRootKey(id))
 
+	k.addScopeVersion(ctx, deletedScope, true)
+	k.RemoveScopeOwnershipTransfer(ctx, id)
+	k.removeStaleScopeDataAccessGrants(ctx, id, nil)
+	k.RemoveScopeHeights(ctx, id)
 	k.indexScope(store, nil, &scope)
 	store.Delete(id)
 	k.EmitEvent(ctx, types.NewEventScopeDeleted(scope.ScopeId))
@@ -263,6 +286,7 @@ func (k Keeper) SetScopeValueOwner(ctx sdk.Context, scopeID types.MetadataAddres
 		return nil
 	}
 
+	prevValueOwner := fromAddr
 	coins := sdk.Coins{coin}
 	if len(fromAddr) == 0 {
 		// If there's no current value owner, we'll mint it and send it from the module account.
@@ -280,8 +304,10 @@ func (k Keeper) SetScopeValueOwner(ctx sdk.Context, scopeID types.MetadataAddres
 		if err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
 			return fmt.Errorf("could not burn scope coin %q: %w", coins, err)
 		}
+		return nil
 	}
 
+	k.addScopeValueOwnerVersion(ctx, scopeID, prevValueOwner)
 	return nil
 }
 
@@ -323,6 +349,12 @@ func (k Keeper) SetScopeValueOwners(ctx sdk.Context, links types.AccMDLinks, new
 		}
 	}
 
+	for _, link := range links {
+		if !toAddr.Equals(link.AccAddr) {
+			k.addScopeValueOwnerVersion(ctx, link.MDAddr, link.AccAddr)
+		}
+	}
+
 	return nil
 }
 
@@ -330,9 +362,21 @@ func (k Keeper) SetScopeValueOwners(ctx sdk.Context, links types.AccMDLinks, new
 type scopeIndexValues struct {
 	ScopeID         types.MetadataAddress
 	Addresses       []sdk.AccAddress
+	OwnerRoles      []ownerRole
 	SpecificationID types.MetadataAddress
 }
 
+// ownerRole is an owner address and one of its roles in a scope.
+type ownerRole struct {
+	Address sdk.AccAddress
+	Role    types.PartyType
+}
+
+// Equals returns true if this ownerRole has the same address and role as the provided one.
+func (o ownerRole) Equals(other ownerRole) bool {
+	return o.Role == other.Role && o.Address.Equals(other.Address)
+}
+
 // getScopeIndexValues extracts the values used to index a scope.
 func getScopeIndexValues(scope *types.Scope) *scopeIndexValues {
 	if scope == nil {
@@ -352,12 +396,19 @@ func getScopeIndexValues(scope *types.Scope) *scopeIndexValues {
 		}
 	}
 	for _, owner := range scope.Owners {
+		addr, err := sdk.AccAddressFromBech32(owner.Address)
 		if !knownAddrs[owner.Address] {
-			if addr, err := sdk.AccAddressFromBech32(owner.Address); err == nil {
+			if err == nil {
 				rv.Addresses = append(rv.Addresses, addr)
 			}
 			knownAddrs[owner.Address] = true
 		}
+		if err == nil {
+			role := ownerRole{Address: addr, Role: owner.Role}
+			if !slices.ContainsFunc(rv.OwnerRoles, role.Equals) {
+				rv.OwnerRoles = append(rv.OwnerRoles, role)
+			}
+		}
 	}
 	return &rv
 }
@@ -375,6 +426,7 @@ func getMissingScopeIndexValues(required, found *scopeIndexValues) *scopeIndexVa
 	rv.Addresses = provutils.FindMissingFunc(required.Addresses, found.Addresses, func(a1, a2 sdk.AccAddress) bool {
 		return a1.Equals(a2)
 	})
+	rv.OwnerRoles = provutils.FindMissingFunc(required.OwnerRoles, found.OwnerRoles, ownerRole.Equals)
 	if !required.SpecificationID.Equals(found.SpecificationID) {
 		rv.SpecificationID = required.SpecificationID
 	}
@@ -386,10 +438,13 @@ func (v scopeIndexValues) IndexKeys() [][]byte {
 	if v.ScopeID.Empty() {
 		return nil
 	}
-	rv := make([][]byte, 0, len(v.Addresses)+2)
+	rv := make([][]byte, 0, len(v.Addresses)+len(v.OwnerRoles)+1)
 	for _, addr := range v.Addresses {
 		rv = append(rv, types.GetAddressScopeCacheKey(addr, v.ScopeID))
 	}
+	for _, owner := range v.OwnerRoles {
+		rv = append(rv, types.GetAddressRoleScopeCacheKey(owner.Address, owner.Role, v.ScopeID))
+	}
 	if !v.SpecificationID.Empty() {
 		rv = append(rv, types.GetScopeSpecScopeCacheKey(v.SpecificationID, v.ScopeID))
 	}
@@ -430,6 +485,17 @@ func (k Keeper) indexScope(store storetypes.KVStore, newScope, oldScope *types.S
 func (k Keeper) ValidateWriteScope(
 	ctx sdk.Context,
 	msg *types.MsgWriteScopeRequest,
+) ([]sdk.AccAddress, error) {
+	return k.validateWriteScope(ctx, msg, nil)
+}
+
+// validateWriteScope is the same as ValidateWriteScope, except if signers is not nil, the signatures required
+// from the scope's owners are added to it (instead of being checked now), and the smart contract signers aren't checked.
+// The value owner signatures are still checked now, and the signers used for them are also added to it.
+func (k Keeper) validateWriteScope(
+	ctx sdk.Context,
+	msg *types.MsgWriteScopeRequest,
+	signers *signerRequirements,
 ) ([]sdk.AccAddress, error) {
 	proposed := msg.Scope
 	if err := proposed.ValidateBasic(); err != nil {
@@ -439,6 +505,9 @@ func (k Keeper) ValidateWriteScope(
 	var existing *types.Scope
 	if e, found := k.GetScope(ctx, msg.Scope.ScopeId); found {
 		existing = &e
+	} else if _, archived := k.GetScopeTombstone(ctx, msg.Scope.ScopeId); archived {
+		// An archived scope's tombstone is the final word on it, so its id cannot be used again.
+		return nil, fmt.Errorf("scope %s has been archived and cannot be written again", msg.Scope.ScopeId)
 	}
 
 	// If the scope already exists:
@@ -494,7 +563,9 @@ func (k Keeper) ValidateWriteScope(
 			//   - If not new, all existing owners must sign.
 			//   - Value owner signer restrictions are applied.
 			if existing != nil && !existing.Equals(proposed) {
-				if validatedParties, err = k.validateAllRequiredSigned(ctx, existing.GetAllOwnerAddresses(), msg); err != nil {
+				if signers != nil {
+					signers.add(signerRequirement{msg: msg, addrs: existing.GetAllOwnerAddresses()})
+				} else if validatedParties, err = k.validateAllRequiredSigned(ctx, existing.GetAllOwnerAddresses(), msg); err != nil {
 					return nil, err
 				}
 			}
@@ -507,7 +578,9 @@ func (k Keeper) ValidateWriteScope(
 			//   - Value owner signer restrictions are applied.
 			// Note: This means that a scope can be initially written without consideration for signers and roles.
 			if existing != nil {
-				if validatedParties, err = k.validateAllRequiredPartiesSigned(ctx, existing.Owners, existing.Owners, scopeSpec.PartiesInvolved, msg); err != nil {
+				if signers != nil {
+					signers.add(signerRequirement{msg: msg, reqParties: existing.Owners, availableParties: existing.Owners, reqRoles: scopeSpec.PartiesInvolved})
+				} else if validatedParties, err = k.validateAllRequiredPartiesSigned(ctx, existing.Owners, existing.Owners, scopeSpec.PartiesInvolved, msg); err != nil {
 					return nil, err
 				}
 			}
@@ -519,6 +592,11 @@ func (k Keeper) ValidateWriteScope(
 		return nil, err
 	}
 
+	if signers != nil {
+		signers.usedSigners.AlsoUse(usedSigners)
+		return transferAgents, nil
+	}
+
 	usedSigners.AlsoUse(types.GetUsedSigners(validatedParties))
 	if err = k.validateSmartContractSigners(ctx, usedSigners, msg); err != nil {
 		return nil, err
@@ -529,9 +607,21 @@ func (k Keeper) ValidateWriteScope(
 // ValidateDeleteScope checks the current scope and the proposed removal scope to determine if the proposed remove is valid
 // based on the existing state
 func (k Keeper) ValidateDeleteScope(ctx sdk.Context, msg *types.MsgDeleteScopeRequest) ([]sdk.AccAddress, error) {
-	scope, found := k.GetScope(ctx, msg.ScopeId)
+	return k.validateRemoveScope(ctx, msg.ScopeId, msg)
+}
+
+// ValidateArchiveScope checks that the signers of the msg have the authority to archive the scope.
+// The requirements are the same as those for deleting the scope.
+func (k Keeper) ValidateArchiveScope(ctx sdk.Context, msg *types.MsgArchiveScopeRequest) ([]sdk.AccAddress, error) {
+	return k.validateRemoveScope(ctx, msg.ScopeId, msg)
+}
+
+// validateRemoveScope checks that the signers of the msg have the authority to remove the scope.
+// The transfer agents needed to burn the scope's value owner coin are returned.
+func (k Keeper) validateRemoveScope(ctx sdk.Context, scopeID types.MetadataAddress, msg types.MetadataMsg) ([]sdk.AccAddress, error) {
+	scope, found := k.GetScope(ctx, scopeID)
 	if !found {
-		return nil, fmt.Errorf("scope not found with id %s", msg.ScopeId)
+		return nil, fmt.Errorf("scope not found with id %s", scopeID)
 	}
 
 	var err error
@@ -649,15 +739,22 @@ func (k Keeper) ValidateAddScopeDataAccess(
 		return fmt.Errorf("data access list cannot be empty")
 	}
 
+	// Addresses that already have data access are allowed so that their expiration can be replaced or cleared.
 	for _, da := range msg.DataAccess {
 		_, err := sdk.AccAddressFromBech32(da)
 		if err != nil {
 			return fmt.Errorf("failed to decode data access address %s : %w", da, err)
 		}
-		for _, eda := range existing.DataAccess {
-			if da == eda {
-				return fmt.Errorf("address already exists for data access %s", eda)
-			}
+	}
+
+	if msg.Expiration != nil {
+		blockTime := ctx.BlockTime()
+		if !msg.Expiration.After(blockTime) {
+			return fmt.Errorf("expiration %s must be after the current block time %s", msg.Expiration.UTC(), blockTime.UTC())
+		}
+		if msg.Expiration.Sub(blockTime) > types.MaxScopeDataAccessDuration {
+			return fmt.Errorf("expiration %s cannot be more than %s after the current block time %s",
+				msg.Expiration.UTC(), types.MaxScopeDataAccessDuration, blockTime.UTC())
 		}
 	}
 
@@ -842,7 +939,8 @@ func (k Keeper) AddSetNetAssetValues(ctx sdk.Context, scopeID types.MetadataAddr
 }
 
 // GetNetAssetValue gets the NAV record for the given scopeID with the given price denom.
-// If it doesn't exist then nil, nil is returned.
+// If it doesn't exist then nil, nil is returned. The result is flagged as stale if it is
+// older than the scope's net asset value max age.
 func (k Keeper) GetNetAssetValue(ctx sdk.Context, metadataDenom, priceDenom string) (*types.NetAssetValue, error) {
 	scopeID, err := types.MetadataAddressFromDenom(metadataDenom)
 	if err != nil {
@@ -866,6 +964,7 @@ func (k Keeper) GetNetAssetValue(ctx sdk.Context, metadataDenom, priceDenom stri
 	if scopeNAV.Volume < 1 {
 		scopeNAV.Volume = 1
 	}
+	scopeNAV.Stale = scopeNAV.IsStale(k.GetNetAssetValueMaxAge(ctx, scopeID), ctx.BlockHeight())
 
 	return &scopeNAV, nil
 }
@@ -873,6 +972,7 @@ func (k Keeper) GetNetAssetValue(ctx sdk.Context, metadataDenom, priceDenom stri
 // SetNetAssetValue adds/updates a net asset value to scope
 func (k Keeper) SetNetAssetValue(ctx sdk.Context, scopeID types.MetadataAddress, netAssetValue types.NetAssetValue, source string) error {
 	netAssetValue.UpdatedBlockHeight = uint64(ctx.BlockHeight()) //nolint:gosec // G115
+	netAssetValue.Stale = false
 	if err := netAssetValue.Validate(); err != nil {
 		return err
 	}
@@ -887,15 +987,30 @@ func (k Keeper) SetNetAssetValue(ctx sdk.Context, scopeID types.MetadataAddress,
 		return err
 	}
 
-	key := types.NetAssetValueKey(scopeID, netAssetValue.Price.Denom)
-	store := ctx.KVStore(k.storeKey)
+	if err := k.setNetAssetValue(ctx, scopeID, netAssetValue); err != nil {
+		return err
+	}
 
-	bz, err := k.cdc.Marshal(&netAssetValue)
+	return k.addNetAssetValueRecord(ctx, scopeID, netAssetValue, source)
+}
+
+// setNetAssetValue stores a scope's net asset value and moves it to the height it now becomes stale at in the stale queue.
+func (k Keeper) setNetAssetValue(ctx sdk.Context, scopeID types.MetadataAddress, netAssetValue types.NetAssetValue) error {
+	maxAge := k.GetNetAssetValueMaxAge(ctx, scopeID)
+	existing, err := k.GetNetAssetValue(ctx, scopeID.Denom(), netAssetValue.Price.Denom)
 	if err != nil {
 		return err
 	}
-	store.Set(key, bz)
+	if existing != nil {
+		k.dequeueNetAssetValueStaleness(ctx, scopeID, *existing, maxAge)
+	}
 
+	bz, err := k.cdc.Marshal(&netAssetValue)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.NetAssetValueKey(scopeID, netAssetValue.Price.Denom), bz)
+	k.queueNetAssetValueStaleness(ctx, scopeID, netAssetValue, maxAge)
 	return nil
 }
 
@@ -920,21 +1035,32 @@ func (k Keeper) IterateNetAssetValues(ctx sdk.Context, scopeID types.MetadataAdd
 // RemoveNetAssetValues removes all net asset values for a scope
 func (k Keeper) RemoveNetAssetValues(ctx sdk.Context, scopeID types.MetadataAddress) {
 	store := ctx.KVStore(k.storeKey)
+	maxAge := k.GetNetAssetValueMaxAge(ctx, scopeID)
 	it := storetypes.KVStorePrefixIterator(store, types.NetAssetValueKeyPrefix(scopeID))
 	var keys [][]byte
+	var navs []types.NetAssetValue
 	for ; it.Valid(); it.Next() {
+		var nav types.NetAssetValue
+		if err := k.cdc.Unmarshal(it.Value(), &nav); err != nil {
+			panic(fmt.Errorf("could not read scope %s net asset value: %w", scopeID, err))
+		}
 		keys = append(keys, it.Key())
+		navs = append(navs, nav)
 	}
 	defer it.Close() //nolint:errcheck // close error safe to ignore in this context.
 
-	for _, key := range keys {
+	for i, key := range keys {
 		store.Delete(key)
+		k.dequeueNetAssetValueStaleness(ctx, scopeID, navs[i], maxAge)
 	}
+	k.RemoveNetAssetValueHistory(ctx, scopeID)
+	k.SetNetAssetValueMaxAge(ctx, scopeID, 0)
 }
 
 // SetNetAssetValueWithBlockHeight adds/updates a net asset value to scope with a specific block height
 func (k Keeper) SetNetAssetValueWithBlockHeight(ctx sdk.Context, scopeID types.MetadataAddress, netAssetValue types.NetAssetValue, source string, blockHeight uint64) error {
 	netAssetValue.UpdatedBlockHeight = blockHeight
+	netAssetValue.Stale = false
 	if err := netAssetValue.Validate(); err != nil {
 		return err
 	}
@@ -944,13 +1070,5 @@ func (k Keeper) SetNetAssetValueWithBlockHeight(ctx sdk.Context, scopeID types.M
 		return err
 	}
 
-	key := types.NetAssetValueKey(scopeID, netAssetValue.Price.Denom)
-	bz, err := k.cdc.Marshal(&netAssetValue)
-	if err != nil {
-		return err
-	}
-	store := ctx.KVStore(k.storeKey)
-	store.Set(key, bz)
-
-	return nil
+	return k.setNetAssetValue(ctx, scopeID, netAssetValue)
 }
@@ -0,0 +1,253 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// SetScopeHeights stores the block heights at which a scope was created and last updated.
+func (k Keeper) SetScopeHeights(ctx sdk.Context, heights types.ScopeHeights) error {
+	if err := heights.Validate(); err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ScopeHeightsKey(heights.ScopeId), k.cdc.MustMarshal(&heights))
+	return nil
+}
+
+// GetScopeHeights gets the block heights at which a scope was created and last updated.
+func (k Keeper) GetScopeHeights(ctx sdk.Context, scopeID types.MetadataAddress) (types.ScopeHeights, bool) {
+	var heights types.ScopeHeights
+	bz := ctx.KVStore(k.storeKey).Get(types.ScopeHeightsKey(scopeID))
+	if len(bz) == 0 {
+		return heights, false
+	}
+	k.cdc.MustUnmarshal(bz, &heights)
+	return heights, true
+}
+
+// RemoveScopeHeights deletes the block heights of a scope.
+func (k Keeper) RemoveScopeHeights(ctx sdk.Context, scopeID types.MetadataAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.ScopeHeightsKey(scopeID))
+}
+
+// IterateScopeHeights iterates over the block heights of all scopes.
+func (k Keeper) IterateScopeHeights(ctx sdk.Context, handler func(heights types.ScopeHeights) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.ScopeHeightsPrefix)
+	defer it.Close() //nolint:errcheck // close error safe to ignore in this context.
+
+	for ; it.Valid(); it.Next() {
+		var heights types.ScopeHeights
+		if err := k.cdc.Unmarshal(it.Value(), &heights); err != nil {
+			return fmt.Errorf("could not unmarshal scope heights: %w", err)
+		}
+		if handler(heights) {
+			break
+		}
+	}
+	return nil
+}
+
+// markScopeWritten sets the updated height of a scope that was just written (and its created height if it's new).
+// Scopes that existed before their heights were tracked are left with a created height of zero.
+func (k Keeper) markScopeWritten(ctx sdk.Context, scopeID types.MetadataAddress, isNew bool) {
+	heights, found := k.GetScopeHeights(ctx, scopeID)
+	if !found {
+		heights.ScopeId = scopeID
+		if isNew {
+			heights.CreatedHeight = ctx.BlockHeight()
+		}
+	}
+	heights.UpdatedHeight = ctx.BlockHeight()
+	ctx.KVStore(k.storeKey).Set(types.ScopeHeightsKey(scopeID), k.cdc.MustMarshal(&heights))
+}
+
+// RebuildScopeRoleIndex adds the owner address and role index entries for all existing scopes.
+func (k Keeper) RebuildScopeRoleIndex(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	return k.IterateScopes(ctx, func(scope types.Scope) bool {
+		values := getScopeIndexValues(&scope)
+		for _, owner := range values.OwnerRoles {
+			store.Set(types.GetAddressRoleScopeCacheKey(owner.Address, owner.Role, scope.ScopeId), []byte{0x01})
+		}
+		return false
+	})
+}
+
+// BackfillScopeHeights adds heights for all existing scopes that don't have them yet.
+// Their created height is left at zero and their updated height is set to the current block height
+// since neither is known, but they were last written no later than now.
+func (k Keeper) BackfillScopeHeights(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	return k.IterateScopes(ctx, func(scope types.Scope) bool {
+		key := types.ScopeHeightsKey(scope.ScopeId)
+		if store.Has(key) {
+			return false
+		}
+		heights := types.ScopeHeights{ScopeId: scope.ScopeId, UpdatedHeight: ctx.BlockHeight()}
+		store.Set(key, k.cdc.MustMarshal(&heights))
+		return false
+	})
+}
+
+// scopeSearchFilter is the parsed set of criteria that a scope must meet to be included in a scope search.
+type scopeSearchFilter struct {
+	scopeSpecID types.MetadataAddress
+	owner       sdk.AccAddress
+	ownerRole   types.PartyType
+	valueOwner  sdk.AccAddress
+	dataAccess  sdk.AccAddress
+	navDenom    string
+
+	minCreatedHeight int64
+	maxCreatedHeight int64
+	minUpdatedHeight int64
+	maxUpdatedHeight int64
+}
+
+// newScopeSearchFilter parses and validates the criteria in a ScopeSearchRequest.
+func newScopeSearchFilter(req *types.ScopeSearchRequest) (*scopeSearchFilter, error) {
+	rv := &scopeSearchFilter{
+		ownerRole:        req.OwnerRole,
+		navDenom:         req.NavDenom,
+		minCreatedHeight: req.MinCreatedHeight,
+		maxCreatedHeight: req.MaxCreatedHeight,
+		minUpdatedHeight: req.MinUpdatedHeight,
+		maxUpdatedHeight: req.MaxUpdatedHeight,
+	}
+
+	var err error
+	if len(req.ScopeSpecId) > 0 {
+		if rv.scopeSpecID, err = ParseScopeSpecID(req.ScopeSpecId); err != nil {
+			return nil, err
+		}
+	}
+	if len(req.Owner) > 0 {
+		if rv.owner, err = sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, fmt.Errorf("invalid owner %q: %w", req.Owner, err)
+		}
+	}
+	if rv.ownerRole != types.PartyType_PARTY_TYPE_UNSPECIFIED {
+		if _, known := types.PartyType_name[int32(rv.ownerRole)]; !known {
+			return nil, fmt.Errorf("unknown owner role %d", rv.ownerRole)
+		}
+		if len(rv.owner) == 0 {
+			return nil, fmt.Errorf("an owner is required when searching by owner role")
+		}
+	}
+	if len(req.ValueOwner) > 0 {
+		if rv.valueOwner, err = sdk.AccAddressFromBech32(req.ValueOwner); err != nil {
+			return nil, fmt.Errorf("invalid value owner %q: %w", req.ValueOwner, err)
+		}
+	}
+	if len(req.DataAccess) > 0 {
+		if rv.dataAccess, err = sdk.AccAddressFromBech32(req.DataAccess); err != nil {
+			return nil, fmt.Errorf("invalid data access address %q: %w", req.DataAccess, err)
+		}
+	}
+	if len(rv.navDenom) > 0 {
+		if err = sdk.ValidateDenom(rv.navDenom); err != nil {
+			return nil, fmt.Errorf("invalid nav denom: %w", err)
+		}
+	}
+	if err = validateHeightRange("created", rv.minCreatedHeight, rv.maxCreatedHeight); err != nil {
+		return nil, err
+	}
+	if err = validateHeightRange("updated", rv.minUpdatedHeight, rv.maxUpdatedHeight); err != nil {
+		return nil, err
+	}
+	if len(rv.owner) == 0 && len(rv.dataAccess) == 0 && len(rv.valueOwner) == 0 && len(rv.scopeSpecID) == 0 {
+		return nil, fmt.Errorf("at least one of owner, data access, value owner, or scope spec id is required")
+	}
+	return rv, nil
+}
+
+// validateHeightRange returns an error if the provided min and max heights cannot be used as a range.
+// A zero min or max means that end of the range is open.
+func validateHeightRange(name string, minHeight, maxHeight int64) error {
+	if minHeight < 0 {
+		return fmt.Errorf("min %s height %d cannot be negative", name, minHeight)
+	}
+	if maxHeight < 0 {
+		return fmt.Errorf("max %s height %d cannot be negative", name, maxHeight)
+	}
+	if maxHeight != 0 && minHeight > maxHeight {
+		return fmt.Errorf("min %s height %d cannot be greater than max %s height %d", name, minHeight, name, maxHeight)
+	}
+	return nil
+}
+
+// usesValueOwnerIndex returns true if the value owner is the most selective indexed criteria of this filter.
+// The value owner index is in the bank module, so those scopes are looked up using the bank keeper.
+func (f scopeSearchFilter) usesValueOwnerIndex() bool {
+	return len(f.owner) == 0 && len(f.dataAccess) == 0 && len(f.valueOwner) > 0
+}
+
+// indexPrefix gets the store prefix of the most selective metadata index that can be used for this filter.
+// The keys under each index prefix end with a scope id.
+// This should not be used if usesValueOwnerIndex is true.
+func (f scopeSearchFilter) indexPrefix() []byte {
+	switch {
+	case len(f.owner) > 0 && f.ownerRole != types.PartyType_PARTY_TYPE_UNSPECIFIED:
+		return types.GetAddressRoleScopeCacheIteratorPrefix(f.owner, f.ownerRole)
+	case len(f.owner) > 0:
+		return types.GetAddressScopeCacheIteratorPrefix(f.owner)
+	case len(f.dataAccess) > 0:
+		return types.GetAddressScopeCacheIteratorPrefix(f.dataAccess)
+	default:
+		return types.GetScopeSpecScopeCacheIteratorPrefix(f.scopeSpecID)
+	}
+}
+
+// matches returns true if the provided scope meets all of the criteria of this filter.
+func (f scopeSearchFilter) matches(ctx sdk.Context, k Keeper, scope types.Scope) bool {
+	if len(f.scopeSpecID) > 0 && !f.scopeSpecID.Equals(scope.SpecificationId) {
+		return false
+	}
+	if len(f.owner) > 0 {
+		owner := f.owner.String()
+		isOwner := slices.ContainsFunc(scope.Owners, func(party types.Party) bool {
+			return party.Address == owner && (f.ownerRole == types.PartyType_PARTY_TYPE_UNSPECIFIED || party.Role == f.ownerRole)
+		})
+		if !isOwner {
+			return false
+		}
+	}
+	if len(f.dataAccess) > 0 && !slices.Contains(scope.DataAccess, f.dataAccess.String()) {
+		return false
+	}
+	if len(f.navDenom) > 0 && !ctx.KVStore(k.storeKey).Has(types.NetAssetValueKey(scope.ScopeId, f.navDenom)) {
+		return false
+	}
+	if f.minCreatedHeight != 0 || f.maxCreatedHeight != 0 || f.minUpdatedHeight != 0 || f.maxUpdatedHeight != 0 {
+		heights, found := k.GetScopeHeights(ctx, scope.ScopeId)
+		if !found {
+			return false
+		}
+		if (f.minCreatedHeight != 0 || f.maxCreatedHeight != 0) &&
+			(heights.CreatedHeight == 0 || !inHeightRange(heights.CreatedHeight, f.minCreatedHeight, f.maxCreatedHeight)) {
+			return false
+		}
+		if !inHeightRange(heights.UpdatedHeight, f.minUpdatedHeight, f.maxUpdatedHeight) {
+			return false
+		}
+	}
+	if len(f.valueOwner) > 0 {
+		valueOwner, err := k.GetScopeValueOwner(ctx, scope.ScopeId)
+		if err != nil || !f.valueOwner.Equals(valueOwner) {
+			return false
+		}
+	}
+	return true
+}
+
+// inHeightRange returns true if the height is in the provided range. A zero min or max means that end is open.
+func inHeightRange(height, minHeight, maxHeight int64) bool {
+	return (minHeight == 0 || height >= minHeight) && (maxHeight == 0 || height <= maxHeight)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -12,6 +13,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -2480,12 +2482,18 @@ func (s *ScopeKeeperTestSuite) TestValidateScopeAddDataAccess() {
 	dneSpecID := types.ScopeSpecMetadataAddress(uuid.New())
 
 	otherAddr := sdk.AccAddress("blah_blah_blah_blah_").String()
+	blockTime := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	expiration := func(d time.Duration) *time.Time {
+		rv := blockTime.Add(d)
+		return &rv
+	}
 
 	cases := []struct {
 		name            string
 		dataAccessAddrs []string
 		existing        types.Scope
 		signers         []string
+		expiration      *time.Time
 		errorMsg        string
 	}{
 		{
@@ -2496,11 +2504,44 @@ func (s *ScopeKeeperTestSuite) TestValidateScopeAddDataAccess() {
 			errorMsg:        "data access list cannot be empty",
 		},
 		{
-			name:            "should fail to validate add scope data access, user is already on the data access list",
+			name:            "user is already on the data access list",
+			dataAccessAddrs: []string{s.user1},
+			existing:        scope,
+			signers:         []string{s.user1},
+			errorMsg:        "",
+		},
+		{
+			name:            "user is already on the data access list with a new expiration",
 			dataAccessAddrs: []string{s.user1},
 			existing:        scope,
 			signers:         []string{s.user1},
-			errorMsg:        fmt.Sprintf("address already exists for data access %s", s.user1),
+			expiration:      expiration(time.Hour),
+			errorMsg:        "",
+		},
+		{
+			name:            "expiration at the current block time",
+			dataAccessAddrs: []string{s.user2},
+			existing:        scope,
+			signers:         []string{s.user1},
+			expiration:      expiration(0),
+			errorMsg:        "expiration 2026-03-01 12:00:00 +0000 UTC must be after the current block time 2026-03-01 12:00:00 +0000 UTC",
+		},
+		{
+			name:            "expiration at the max duration",
+			dataAccessAddrs: []string{s.user2},
+			existing:        scope,
+			signers:         []string{s.user1},
+			expiration:      expiration(types.MaxScopeDataAccessDuration),
+			errorMsg:        "",
+		},
+		{
+			name:            "expiration after the max duration",
+			dataAccessAddrs: []string{s.user2},
+			existing:        scope,
+			signers:         []string{s.user1},
+			expiration:      expiration(types.MaxScopeDataAccessDuration + time.Second),
+			errorMsg: "expiration 2027-03-01 12:00:01 +0000 UTC cannot be more than 8760h0m0s after " +
+				"the current block time 2026-03-01 12:00:00 +0000 UTC",
 		},
 		{
 			name:            "should fail to validate add scope data access, incorrect signer for scope",
@@ -2597,8 +2638,9 @@ func (s *ScopeKeeperTestSuite) TestValidateScopeAddDataAccess() {
 			msg := &types.MsgAddScopeDataAccessRequest{
 				DataAccess: tc.dataAccessAddrs,
 				Signers:    tc.signers,
+				Expiration: tc.expiration,
 			}
-			err := s.app.MetadataKeeper.ValidateAddScopeDataAccess(s.FreshCtx(), tc.existing, msg)
+			err := s.app.MetadataKeeper.ValidateAddScopeDataAccess(s.FreshCtx().WithBlockTime(blockTime), tc.existing, msg)
 			if len(tc.errorMsg) > 0 {
 				s.Assert().EqualError(err, tc.errorMsg, "ValidateAddScopeDataAccess")
 			} else {
@@ -3025,6 +3067,7 @@ func (s *ScopeKeeperTestSuite) TestScopeIndexing() {
 	ownerToRemove := randomUser()
 	valueOwnerOrig := randomUser()
 	valueOwnerNew := randomUser()
+	owner := types.PartyType_PARTY_TYPE_OWNER
 
 	scopeV1 := types.Scope{
 		ScopeId:           scopeID,
@@ -3052,6 +3095,9 @@ func (s *ScopeKeeperTestSuite) TestScopeIndexing() {
 			{types.GetAddressScopeCacheKey(ownerConstant.Addr, scopeID), "ownerConstant address index"},
 			{types.GetAddressScopeCacheKey(ownerToRemove.Addr, scopeID), "ownerToRemove address index"},
 
+			{types.GetAddressRoleScopeCacheKey(ownerConstant.Addr, owner, scopeID), "ownerConstant role index"},
+			{types.GetAddressRoleScopeCacheKey(ownerToRemove.Addr, owner, scopeID), "ownerToRemove role index"},
+
 			{types.GetScopeSpecScopeCacheKey(specIDOrig, scopeID), "specIDOrig spec index"},
 		}
 
@@ -3071,6 +3117,9 @@ func (s *ScopeKeeperTestSuite) TestScopeIndexing() {
 			{types.GetAddressScopeCacheKey(ownerConstant.Addr, scopeID), "ownerConstant address index"},
 			{types.GetAddressScopeCacheKey(ownerToAdd.Addr, scopeID), "ownerToAdd address index"},
 
+			{types.GetAddressRoleScopeCacheKey(ownerConstant.Addr, owner, scopeID), "ownerConstant role index"},
+			{types.GetAddressRoleScopeCacheKey(ownerToAdd.Addr, owner, scopeID), "ownerToAdd role index"},
+
 			{types.GetScopeSpecScopeCacheKey(specIDNew, scopeID), "specIDNew spec index"},
 		}
 		unexpectedIndexes := []struct {
@@ -3080,6 +3129,8 @@ func (s *ScopeKeeperTestSuite) TestScopeIndexing() {
 			{types.GetAddressScopeCacheKey(ownerToRemove.Addr, scopeID), "ownerToRemove address index"},
 			{types.GetAddressScopeCacheKey(valueOwnerOrig.Addr, scopeID), "valueOwnerOrig address index"},
 
+			{types.GetAddressRoleScopeCacheKey(ownerToRemove.Addr, owner, scopeID), "ownerToRemove role index"},
+
 			{types.GetScopeSpecScopeCacheKey(specIDOrig, scopeID), "specIDOrig spec index"},
 		}
 
@@ -3105,6 +3156,10 @@ func (s *ScopeKeeperTestSuite) TestScopeIndexing() {
 			{types.GetAddressScopeCacheKey(valueOwnerOrig.Addr, scopeID), "valueOwnerOrig address index"},
 			{types.GetAddressScopeCacheKey(valueOwnerNew.Addr, scopeID), "valueOwnerNew address index"},
 
+			{types.GetAddressRoleScopeCacheKey(ownerConstant.Addr, owner, scopeID), "ownerConstant role index"},
+			{types.GetAddressRoleScopeCacheKey(ownerToRemove.Addr, owner, scopeID), "ownerToRemove role index"},
+			{types.GetAddressRoleScopeCacheKey(ownerToAdd.Addr, owner, scopeID), "ownerToAdd role index"},
+
 			{types.GetScopeSpecScopeCacheKey(specIDOrig, scopeID), "specIDOrig spec index"},
 			{types.GetScopeSpecScopeCacheKey(specIDNew, scopeID), "specIDNew spec index"},
 		}
@@ -3118,6 +3173,302 @@ func (s *ScopeKeeperTestSuite) TestScopeIndexing() {
 	})
 }
 
+func (s *ScopeKeeperTestSuite) TestExpireScopeDataAccess() {
+	ctx := s.FreshCtx().WithBlockTime(time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC))
+	expiration := ctx.BlockTime().Add(time.Hour)
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user2, s.user3}, s.user1, false)
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(ctx, *scope), "SetScope")
+	s.Require().NoError(s.app.MetadataKeeper.SetScopeDataAccessGrant(ctx, types.NewScopeDataAccessGrant(s.scopeID, s.user3, expiration)), "SetScopeDataAccessGrant")
+	expiredEvent, err := sdk.TypedEventToEvent(types.NewEventScopeDataAccessExpired(s.scopeID, s.user3))
+	s.Require().NoError(err, "TypedEventToEvent NewEventScopeDataAccessExpired")
+
+	tests := []struct {
+		name          string
+		blockTime     time.Time
+		expEvents     sdk.Events
+		expDataAccess []string
+		expGrant      bool
+	}{
+		{
+			name:          "at the expiration",
+			blockTime:     expiration,
+			expDataAccess: []string{s.user2, s.user3},
+			expGrant:      true,
+		},
+		{
+			name:          "after the expiration",
+			blockTime:     expiration.Add(time.Second),
+			expEvents:     sdk.Events{expiredEvent},
+			expDataAccess: []string{s.user2},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := ctx.WithBlockTime(tc.blockTime).WithEventManager(sdk.NewEventManager())
+			s.app.MetadataKeeper.ExpireScopeDataAccess(ctx)
+			for _, expEvent := range tc.expEvents {
+				s.Assert().Contains(ctx.EventManager().Events(), expEvent, "ExpireScopeDataAccess events")
+			}
+			if len(tc.expEvents) == 0 {
+				s.Assert().Empty(ctx.EventManager().Events(), "ExpireScopeDataAccess events")
+			}
+			scope, found := s.app.MetadataKeeper.GetScope(ctx, s.scopeID)
+			s.Require().True(found, "GetScope found")
+			s.Assert().Equal(tc.expDataAccess, scope.DataAccess, "scope data access")
+			_, found = s.app.MetadataKeeper.GetScopeDataAccessGrant(ctx, s.scopeID, s.user3Addr)
+			s.Assert().Equal(tc.expGrant, found, "GetScopeDataAccessGrant found")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestScopeDataAccessGrantRemoval() {
+	ctx := s.FreshCtx()
+	expiration := time.Date(2026, 7, 1, 10, 0, 0, 0, time.UTC)
+	setup := func() types.Scope {
+		scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user2, s.user3}, s.user1, false)
+		s.Require().NoError(s.app.MetadataKeeper.SetScope(ctx, *scope), "SetScope")
+		s.Require().NoError(s.app.MetadataKeeper.SetScopeDataAccessGrant(ctx, types.NewScopeDataAccessGrant(s.scopeID, s.user3, expiration)), "SetScopeDataAccessGrant")
+		return *scope
+	}
+
+	tests := []struct {
+		name   string
+		remove func(scope types.Scope) error
+	}{
+		{
+			name: "scope written without the address",
+			remove: func(scope types.Scope) error {
+				scope.DataAccess = []string{s.user2}
+				return s.app.MetadataKeeper.SetScope(ctx, scope)
+			},
+		},
+		{
+			name: "scope removed",
+			remove: func(_ types.Scope) error {
+				return s.app.MetadataKeeper.RemoveScope(ctx, s.scopeID)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.Require().NoError(tc.remove(setup()), "removing the data access")
+			_, found := s.app.MetadataKeeper.GetScopeDataAccessGrant(ctx, s.scopeID, s.user3Addr)
+			s.Assert().False(found, "GetScopeDataAccessGrant found")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestGetScopeArchive() {
+	ctx := s.FreshCtx()
+	scopeID, sessionID, err := writeArchivableScope(ctx, s.app.MetadataKeeper, s.user1)
+	s.Require().NoError(err, "writeArchivableScope")
+	dneScopeID := types.ScopeMetadataAddress(uuid.New())
+
+	tests := []struct {
+		name           string
+		scopeID        types.MetadataAddress
+		expErr         string
+		expRecordNames []string
+	}{
+		{
+			name:    "unknown scope",
+			scopeID: dneScopeID,
+			expErr:  "scope not found with id",
+		},
+		{
+			name:           "scope with a session, records and net asset value",
+			scopeID:        scopeID,
+			expRecordNames: []string{"loan", "payments"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			archive, err := s.app.MetadataKeeper.GetScopeArchive(ctx, tc.scopeID)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "GetScopeArchive error")
+				return
+			}
+			s.Require().NoError(err, "GetScopeArchive error")
+			s.Assert().Equal(s.user1, archive.Scope.ValueOwnerAddress, "archived scope value owner")
+			if s.Assert().Len(archive.Sessions, 1, "archived sessions") {
+				s.Assert().Equal(sessionID, archive.Sessions[0].SessionId, "archived session id")
+			}
+			recordNames := make([]string, len(archive.Records))
+			for i, record := range archive.Records {
+				recordNames[i] = record.Name
+			}
+			s.Assert().ElementsMatch(tc.expRecordNames, recordNames, "archived record names")
+			if s.Assert().Len(archive.NetAssetValues, 1, "archived net asset values") {
+				s.Assert().Equal(int64(1000), archive.NetAssetValues[0].Price.Amount.Int64(), "archived net asset value price")
+			}
+
+			hash1, err := archive.Hash()
+			s.Require().NoError(err, "Hash")
+			s.Assert().Len(hash1, 32, "archive hash")
+			again, err := s.app.MetadataKeeper.GetScopeArchive(ctx, tc.scopeID)
+			s.Require().NoError(err, "GetScopeArchive again")
+			hash2, err := again.Hash()
+			s.Require().NoError(err, "Hash again")
+			s.Assert().Equal(hash1, hash2, "archive hashes of the same state")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestScopeHeights() {
+	ctx := s.FreshCtx().WithBlockHeight(5)
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user2}, "", false)
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(ctx, *scope), "SetScope")
+
+	heights, found := s.app.MetadataKeeper.GetScopeHeights(ctx, s.scopeID)
+	s.Require().True(found, "GetScopeHeights found")
+	s.Assert().Equal(types.ScopeHeights{ScopeId: s.scopeID, CreatedHeight: 5, UpdatedHeight: 5}, heights, "scope heights")
+
+	scope.DataAccess = nil
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(ctx.WithBlockHeight(12), *scope), "SetScope update")
+	heights, _ = s.app.MetadataKeeper.GetScopeHeights(ctx, s.scopeID)
+	s.Assert().Equal(types.ScopeHeights{ScopeId: s.scopeID, CreatedHeight: 5, UpdatedHeight: 12}, heights, "scope heights after update")
+
+	genState := s.app.MetadataKeeper.ExportGenesis(ctx)
+	s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	s.Assert().Contains(genState.ScopeHeights, heights, "exported scope heights")
+
+	s.Require().NoError(s.app.MetadataKeeper.RemoveScope(ctx, s.scopeID), "RemoveScope")
+	_, found = s.app.MetadataKeeper.GetScopeHeights(ctx, s.scopeID)
+	s.Assert().False(found, "GetScopeHeights found after RemoveScope")
+}
+
+func (s *ScopeKeeperTestSuite) TestMigrate4to5() {
+	ctx := s.FreshCtx()
+	scope := types.Scope{
+		ScopeId:         s.scopeID,
+		SpecificationId: s.scopeSpecID,
+		Owners:          []types.Party{{Address: s.user1, Role: types.PartyType_PARTY_TYPE_SERVICER}},
+	}
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(ctx, scope), "SetScope")
+
+	store := ctx.KVStore(s.app.GetKey(types.ModuleName))
+	roleKey := types.GetAddressRoleScopeCacheKey(s.user1Addr, types.PartyType_PARTY_TYPE_SERVICER, s.scopeID)
+	s.Require().True(store.Has(roleKey), "role index entry after SetScope")
+	store.Delete(roleKey)
+
+	// A scope written after the heights were tracked keeps its heights.
+	trackedScope := scope
+	trackedScope.ScopeId = types.ScopeMetadataAddress(uuid.New())
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(ctx, trackedScope), "SetScope tracked")
+	trackedHeights, found := s.app.MetadataKeeper.GetScopeHeights(ctx, trackedScope.ScopeId)
+	s.Require().True(found, "GetScopeHeights found for tracked scope")
+	s.app.MetadataKeeper.RemoveScopeHeights(ctx, s.scopeID)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 50)
+	s.Require().NoError(keeper.NewMigrator(s.app.MetadataKeeper).Migrate4to5(ctx), "Migrate4to5")
+	s.Assert().True(store.Has(roleKey), "role index entry after Migrate4to5")
+
+	heights, found := s.app.MetadataKeeper.GetScopeHeights(ctx, s.scopeID)
+	s.Require().True(found, "GetScopeHeights found after Migrate4to5")
+	expHeights := types.ScopeHeights{ScopeId: s.scopeID, CreatedHeight: 0, UpdatedHeight: ctx.BlockHeight()}
+	s.Assert().Equal(expHeights, heights, "heights after Migrate4to5")
+	heights, _ = s.app.MetadataKeeper.GetScopeHeights(ctx, trackedScope.ScopeId)
+	s.Assert().Equal(trackedHeights, heights, "heights of tracked scope after Migrate4to5")
+}
+
+// writeCommitmentScope stores a new scope with one session, and the provided number of records in it.
+func (s *ScopeKeeperTestSuite) writeCommitmentScope(ctx sdk.Context, count int) (types.MetadataAddress, types.MetadataAddress) {
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scope := types.NewScope(scopeID, s.scopeSpecID, ownerPartyList(s.user1), nil, s.user1, false)
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(ctx, *scope), "SetScope")
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	s.app.MetadataKeeper.SetSession(ctx, *types.NewSession("session", sessionID, types.ContractSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), nil))
+	for i := 0; i < count; i++ {
+		s.app.MetadataKeeper.SetRecord(ctx, newCommitmentRecord(sessionID, fmt.Sprintf("record%d", i), fmt.Sprintf("hash%d", i)))
+	}
+	return scopeID, sessionID
+}
+
+func (s *ScopeKeeperTestSuite) TestScopeRecordsRoot() {
+	ctx := s.FreshCtx()
+	mdKeeper := s.app.MetadataKeeper
+	scopeID, sessionID := s.writeCommitmentScope(ctx, 0)
+	assertCommitment := func(record types.Record) []byte {
+		s.T().Helper()
+		root, found := mdKeeper.GetScopeRecordsRoot(ctx, scopeID)
+		s.Require().True(found, "GetScopeRecordsRoot found")
+		commitRoot, leaf, proof, err := mdKeeper.GetRecordCommitment(ctx, scopeID.MustGetAsRecordAddress(record.Name))
+		s.Require().NoError(err, "GetRecordCommitment(%q)", record.Name)
+		s.Assert().Equal(root, commitRoot, "GetRecordCommitment(%q) root", record.Name)
+		s.Assert().Equal(types.RecordCommitmentLeaf(record), leaf, "GetRecordCommitment(%q) leaf", record.Name)
+		s.Assert().NoError(proof.Verify(root, leaf), "GetRecordCommitment(%q) proof Verify", record.Name)
+		return root
+	}
+
+	_, found := mdKeeper.GetScopeRecordsRoot(ctx, scopeID)
+	s.Assert().False(found, "GetScopeRecordsRoot found before any records")
+
+	terms := newCommitmentRecord(sessionID, "terms", "termshash")
+	mdKeeper.SetRecord(ctx, terms)
+	rootWithTerms := assertCommitment(terms)
+
+	appraisal := newCommitmentRecord(sessionID, "appraisal", "appraisalhash1", "appraisalhash2")
+	mdKeeper.SetRecord(ctx, appraisal)
+	root := assertCommitment(terms)
+	s.Assert().NotEqual(rootWithTerms, root, "root after adding a record")
+	s.Assert().Equal(root, assertCommitment(appraisal), "root of the appraisal commitment")
+
+	updated := newCommitmentRecord(sessionID, "terms", "newtermshash")
+	mdKeeper.SetRecord(ctx, updated)
+	s.Assert().NotEqual(root, assertCommitment(updated), "root after updating a record")
+
+	mdKeeper.RemoveRecord(ctx, scopeID.MustGetAsRecordAddress(appraisal.Name))
+	assertCommitment(updated)
+	mdKeeper.RemoveRecord(ctx, scopeID.MustGetAsRecordAddress(updated.Name))
+	_, found = mdKeeper.GetScopeRecordsRoot(ctx, scopeID)
+	s.Assert().False(found, "GetScopeRecordsRoot found after removing all records")
+}
+
+func (s *ScopeKeeperTestSuite) TestScopeRecordsRootManyRecords() {
+	ctx := s.FreshCtx()
+	mdKeeper := s.app.MetadataKeeper
+	scopeID, _ := s.writeCommitmentScope(ctx, 300)
+	root, found := mdKeeper.GetScopeRecordsRoot(ctx, scopeID)
+	s.Require().True(found, "GetScopeRecordsRoot found")
+	for _, name := range []string{"record0", "record150", "record299"} {
+		commitRoot, leaf, proof, err := mdKeeper.GetRecordCommitment(ctx, scopeID.MustGetAsRecordAddress(name))
+		s.Require().NoError(err, "GetRecordCommitment(%q)", name)
+		s.Assert().Equal(root, commitRoot, "GetRecordCommitment(%q) root", name)
+		s.Assert().NoError(proof.Verify(root, leaf), "GetRecordCommitment(%q) proof Verify", name)
+	}
+
+	// The root isn't recalculated as each record is removed, so the cost of removing a scope grows linearly.
+	removeGas := func(scopeID types.MetadataAddress) storetypes.Gas {
+		gasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		s.Require().NoError(mdKeeper.RemoveScope(gasCtx, scopeID), "RemoveScope")
+		return gasCtx.GasMeter().GasConsumed()
+	}
+	scopeID150, _ := s.writeCommitmentScope(ctx, 150)
+	gas150 := removeGas(scopeID150)
+	gas300 := removeGas(scopeID)
+	s.Assert().Less(gas300, 3*gas150, "gas to remove a scope with 300 records vs 150 records (%d)", gas150)
+	_, found = mdKeeper.GetScopeRecordsRoot(ctx, scopeID)
+	s.Assert().False(found, "GetScopeRecordsRoot found after RemoveScope")
+}
+
+func (s *ScopeKeeperTestSuite) TestMigrate5to6() {
+	ctx := s.FreshCtx()
+	scopeID, _ := s.writeCommitmentScope(ctx, 2)
+	root, found := s.app.MetadataKeeper.GetScopeRecordsRoot(ctx, scopeID)
+	s.Require().True(found, "GetScopeRecordsRoot found")
+	store := ctx.KVStore(s.app.GetKey(types.ModuleName))
+	store.Delete(types.ScopeRecordsRootKey(scopeID))
+
+	s.Require().NoError(keeper.NewMigrator(s.app.MetadataKeeper).Migrate5to6(ctx), "Migrate5to6")
+	actual, found := s.app.MetadataKeeper.GetScopeRecordsRoot(ctx, scopeID)
+	s.Require().True(found, "GetScopeRecordsRoot found after Migrate5to6")
+	s.Assert().Equal(root, actual, "root after Migrate5to6")
+}
+
 func (s *ScopeKeeperTestSuite) TestValidateUpdateValueOwners() {
 	newUUID := func(i string) uuid.UUID {
 		str := strings.ReplaceAll("xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", "x", i)
@@ -3524,6 +3875,91 @@ func (s *ScopeKeeperTestSuite) TestSetNetAssetValue() {
 	}
 }
 
+func (s *ScopeKeeperTestSuite) TestEmitNetAssetValueStaleEvents() {
+	tests := []struct {
+		name       string
+		maxAge     uint64
+		updateAt   int64
+		newMaxAge  uint64
+		removeNAVs bool
+		expHeight  int64
+	}{
+		{
+			name:      "no max age",
+			expHeight: 0,
+		},
+		{
+			name:      "max age",
+			maxAge:    5,
+			expHeight: 16,
+		},
+		{
+			name:      "value updated before it is stale",
+			maxAge:    5,
+			updateAt:  13,
+			expHeight: 19,
+		},
+		{
+			name:      "max age raised",
+			maxAge:    5,
+			newMaxAge: 8,
+			expHeight: 19,
+		},
+		{
+			name:      "max age lowered",
+			maxAge:    8,
+			newMaxAge: 3,
+			expHeight: 14,
+		},
+		{
+			name:       "values removed",
+			maxAge:     5,
+			removeNAVs: true,
+			expHeight:  0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			scopeID := types.ScopeMetadataAddress(uuid.New())
+			ctx := s.FreshCtx().WithBlockHeight(10)
+			nav := types.NewNetAssetValue(sdk.NewInt64Coin("usd", 100), 1)
+			s.Require().NoError(s.app.MetadataKeeper.SetNetAssetValue(ctx, scopeID, nav, "test"), "SetNetAssetValue")
+			s.app.MetadataKeeper.SetNetAssetValueMaxAge(ctx, scopeID, tc.maxAge)
+			if tc.newMaxAge != 0 {
+				s.app.MetadataKeeper.SetNetAssetValueMaxAge(ctx.WithBlockHeight(11), scopeID, tc.newMaxAge)
+			}
+			if tc.removeNAVs {
+				s.app.MetadataKeeper.RemoveNetAssetValues(ctx, scopeID)
+			}
+
+			maxAge := s.app.MetadataKeeper.GetNetAssetValueMaxAge(ctx, scopeID)
+			var actHeights []int64
+			for height := int64(11); height <= 25; height++ {
+				if height == tc.updateAt {
+					s.Require().NoError(s.app.MetadataKeeper.SetNetAssetValue(ctx.WithBlockHeight(height), scopeID, nav, "test"), "SetNetAssetValue at height %d", height)
+				}
+				hCtx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+				s.app.MetadataKeeper.EmitNetAssetValueStaleEvents(hCtx)
+				for _, event := range hCtx.EventManager().Events() {
+					s.Assert().Equal("provenance.metadata.v1.EventNetAssetValueStale", event.Type, "event type at height %d", height)
+					actHeights = append(actHeights, height)
+				}
+			}
+
+			var expHeights []int64
+			if tc.expHeight != 0 {
+				expHeights = append(expHeights, tc.expHeight)
+				staleNAV, err := s.app.MetadataKeeper.GetNetAssetValue(ctx.WithBlockHeight(tc.expHeight), scopeID.Denom(), "usd")
+				s.Require().NoError(err, "GetNetAssetValue at height %d", tc.expHeight)
+				s.Assert().True(staleNAV.Stale, "stale at height %d", tc.expHeight)
+				s.Assert().Equal(uint64(tc.expHeight), staleNAV.UpdatedBlockHeight+maxAge+1, "stale height")
+			}
+			s.Assert().Equal(expHeights, actHeights, "heights with a stale event")
+		})
+	}
+}
+
 func (s *ScopeKeeperTestSuite) TestRemoveNetAssetValues() {
 	scopeID := types.ScopeMetadataAddress(uuid.New())
 	tests := []struct {
@@ -3570,3 +4006,45 @@ func (s *ScopeKeeperTestSuite) TestRemoveNetAssetValues() {
 		})
 	}
 }
+
+func (s *ScopeKeeperTestSuite) TestNetAssetValueHistory() {
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	startTime := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	at := func(hours int) time.Time {
+		return startTime.Add(time.Duration(hours) * time.Hour)
+	}
+	ctx := s.FreshCtx()
+	setNav := func(hours int, amount int64) {
+		nav := types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, amount), 1)
+		err := s.app.MetadataKeeper.SetNetAssetValue(ctx.WithBlockTime(at(hours)), scopeID, nav, "test")
+		s.Require().NoError(err, "SetNetAssetValue(%d, %d)", hours, amount)
+	}
+	setNav(0, 10)
+	setNav(1, 11)
+	setNav(3, 12)
+
+	history, err := s.app.MetadataKeeper.GetNetAssetValueHistory(ctx, scopeID, "", nil, nil)
+	s.Require().NoError(err, "GetNetAssetValueHistory")
+	s.Require().Len(history, 3, "history")
+	s.Assert().Equal(at(1), history[1].BlockTime, "history[1] block time")
+	s.Assert().Equal("test", history[1].Source, "history[1] source")
+
+	asOf := at(2)
+	resp, err := s.app.MetadataKeeper.ScopeNetAssetValues(ctx, &types.QueryScopeNetAssetValuesRequest{Id: scopeID.String(), AsOf: &asOf})
+	s.Require().NoError(err, "ScopeNetAssetValues as of")
+	s.Require().Len(resp.NetAssetValues, 1, "ScopeNetAssetValues as of")
+	s.Assert().Equal(int64(11), resp.NetAssetValues[0].Price.Amount.Int64(), "as of price")
+
+	resp, err = s.app.MetadataKeeper.ScopeNetAssetValues(ctx, &types.QueryScopeNetAssetValuesRequest{Id: scopeID.String(), History: true, StartTime: &asOf})
+	s.Require().NoError(err, "ScopeNetAssetValues history")
+	s.Require().Len(resp.History, 1, "ScopeNetAssetValues history")
+	s.Assert().Equal(int64(12), resp.History[0].NetAssetValue.Price.Amount.Int64(), "history price")
+
+	_, err = s.app.MetadataKeeper.ScopeNetAssetValues(ctx, &types.QueryScopeNetAssetValuesRequest{Id: scopeID.String(), StartTime: &asOf})
+	s.Assert().ErrorContains(err, "start and end times are only allowed with a history request", "ScopeNetAssetValues start without history")
+
+	s.app.MetadataKeeper.RemoveNetAssetValues(ctx, scopeID)
+	history, err = s.app.MetadataKeeper.GetNetAssetValueHistory(ctx, scopeID, "", nil, nil)
+	s.Require().NoError(err, "GetNetAssetValueHistory after removal")
+	s.Assert().Empty(history, "history after removal")
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
@@ -32,8 +33,13 @@ func (k Keeper) SetSession(ctx sdk.Context, session types.Session) {
 	b := k.cdc.MustMarshal(&session)
 
 	var event proto.Message = types.NewEventSessionCreated(session.SessionId)
-	if store.Has(session.SessionId) {
+	if oldBz := store.Get(session.SessionId); oldBz != nil {
 		event = types.NewEventSessionUpdated(session.SessionId)
+		if !bytes.Equal(oldBz, b) {
+			var oldSession types.Session
+			k.cdc.MustUnmarshal(oldBz, &oldSession)
+			k.addSessionVersion(ctx, oldSession, false)
+		}
 	}
 
 	store.Set(session.SessionId, b)
@@ -47,10 +53,12 @@ func (k Keeper) RemoveSession(ctx sdk.Context, id types.MetadataAddress) {
 	}
 	store := ctx.KVStore(k.storeKey)
 
-	if !store.Has(id) || k.sessionHasRecords(ctx, id) {
+	session, found := k.GetSession(ctx, id)
+	if !found || k.sessionHasRecords(ctx, id) {
 		return
 	}
 
+	k.addSessionVersion(ctx, session, true)
 	store.Delete(id)
 	k.EmitEvent(ctx, types.NewEventSessionDeleted(id))
 }
@@ -104,6 +112,12 @@ func (k Keeper) IterateSessions(ctx sdk.Context, scopeID types.MetadataAddress,
 // ValidateWriteSession checks the current session and the proposed session to determine if the proposed changes are valid
 // based on the existing state
 func (k Keeper) ValidateWriteSession(ctx sdk.Context, existing *types.Session, msg *types.MsgWriteSessionRequest) error {
+	return k.validateWriteSession(ctx, existing, msg, nil)
+}
+
+// validateWriteSession is the same as ValidateWriteSession, except if signers is not nil,
+// the signatures required for the session are added to it instead of being checked now.
+func (k Keeper) validateWriteSession(ctx sdk.Context, existing *types.Session, msg *types.MsgWriteSessionRequest, signers *signerRequirements) error {
 	proposed := msg.Session
 	if err := proposed.ValidateBasic(); err != nil {
 		return err
@@ -167,7 +181,9 @@ func (k Keeper) ValidateWriteSession(ctx sdk.Context, existing *types.Session, m
 		if err = k.validateProvenanceRole(ctx, types.BuildPartyDetails(nil, proposed.Parties)); err != nil {
 			return err
 		}
-		if err = k.ValidateSignersWithoutParties(ctx, scope.GetAllOwnerAddresses(), msg); err != nil {
+		if signers != nil {
+			signers.add(signerRequirement{msg: msg, addrs: scope.GetAllOwnerAddresses()})
+		} else if err = k.ValidateSignersWithoutParties(ctx, scope.GetAllOwnerAddresses(), msg); err != nil {
 			return err
 		}
 	} else {
@@ -200,7 +216,9 @@ func (k Keeper) ValidateWriteSession(ctx sdk.Context, existing *types.Session, m
 			// provided to ValidateSignersWithParties, which does those.
 		}
 		reqParties = append(reqParties, scope.Owners...)
-		if err = k.ValidateSignersWithParties(ctx, reqParties, availableParties, contractSpec.PartiesInvolved, msg); err != nil {
+		if signers != nil {
+			signers.add(signerRequirement{msg: msg, reqParties: reqParties, availableParties: availableParties, reqRoles: contractSpec.PartiesInvolved})
+		} else if err = k.ValidateSignersWithParties(ctx, reqParties, availableParties, contractSpec.PartiesInvolved, msg); err != nil {
 			return err
 		}
 	}
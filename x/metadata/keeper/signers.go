@@ -53,6 +53,99 @@ func (k Keeper) ValidateSignersWithParties(
 	return k.validateSmartContractSigners(ctx, types.GetUsedSigners(parties), msg)
 }
 
+// signerRequirement is a set of signatures required by a msg.
+// If there are no parties or roles, each of the addrs must sign (as in ValidateSignersWithoutParties).
+// Otherwise, the parties and roles must be satisfied (as in ValidateSignersWithParties).
+type signerRequirement struct {
+	msg              types.MetadataMsg
+	addrs            []string
+	reqParties       []types.Party
+	availableParties []types.Party
+	reqRoles         []types.PartyType
+}
+
+// hasParties returns true if this requirement involves parties or roles.
+func (r signerRequirement) hasParties() bool {
+	return len(r.reqParties) > 0 || len(r.availableParties) > 0 || len(r.reqRoles) > 0
+}
+
+// key returns a string that is the same for requirements that are satisfied by the same signers.
+func (r signerRequirement) key() string {
+	return fmt.Sprintf("%s|%v|%v|%v|%v", sdk.MsgTypeURL(r.msg), r.addrs, r.reqParties, r.availableParties, r.reqRoles)
+}
+
+// signerRequirements collects the signatures required by several parts of a msg so that they can be checked together.
+type signerRequirements struct {
+	reqs        []signerRequirement
+	seen        map[string]bool
+	usedSigners types.UsedSignersMap
+}
+
+// newSignerRequirements creates a new, empty signerRequirements.
+func newSignerRequirements() *signerRequirements {
+	return &signerRequirements{
+		seen:        make(map[string]bool),
+		usedSigners: types.NewUsedSignersMap(),
+	}
+}
+
+// add records a requirement unless an identical one has already been added.
+func (r *signerRequirements) add(req signerRequirement) {
+	key := req.key()
+	if r.seen[key] {
+		return
+	}
+	r.seen[key] = true
+	r.reqs = append(r.reqs, req)
+}
+
+// validateSignerRequirements checks all of the collected signer requirements at once.
+// The required addresses of each msg type are combined so that each address is only checked once,
+// and each distinct set of parties and roles is only checked once.
+// Then it makes sure that any signers that are smart contracts are allowed to sign the provided msg.
+func (k Keeper) validateSignerRequirements(ctx sdk.Context, reqs *signerRequirements, msg types.MetadataMsg) error {
+	usedSigners := types.NewUsedSignersMap().AlsoUse(reqs.usedSigners)
+
+	var addrReqs []*signerRequirement
+	addrReqsByType := make(map[string]*signerRequirement)
+	for _, req := range reqs.reqs {
+		if req.hasParties() {
+			parties, err := k.validateAllRequiredPartiesSigned(ctx, req.reqParties, req.availableParties, req.reqRoles, req.msg)
+			if err != nil {
+				return err
+			}
+			if err = k.validateProvenanceRole(ctx, parties); err != nil {
+				return err
+			}
+			usedSigners.AlsoUse(types.GetUsedSigners(parties))
+			continue
+		}
+
+		typeURL := sdk.MsgTypeURL(req.msg)
+		combined, found := addrReqsByType[typeURL]
+		if !found {
+			combined = &signerRequirement{msg: req.msg}
+			addrReqsByType[typeURL] = combined
+			addrReqs = append(addrReqs, combined)
+		}
+		for _, addr := range req.addrs {
+			if !slices.Contains(combined.addrs, addr) {
+				combined.addrs = append(combined.addrs, addr)
+			}
+		}
+	}
+
+	for _, req := range addrReqs {
+		parties, err := k.validateAllRequiredSigned(ctx, req.addrs, req.msg)
+		if err != nil {
+			return err
+		}
+		usedSigners.AlsoUse(types.GetUsedSigners(parties))
+	}
+
+	return k.validateSmartContractSigners(ctx, usedSigners, msg)
+}
+
 // validateAllRequiredPartiesSigned ensures the following:
 //   - All optional=false reqParties have signed.
 //   - All required roles are present in availableParties and are signers.
@@ -194,7 +287,8 @@ func getAuthzMessageTypeURLs(msgTypeURL string) []string {
 	}
 	switch msgTypeURL {
 	case types.TypeURLMsgAddScopeDataAccessRequest, types.TypeURLMsgDeleteScopeDataAccessRequest,
-		types.TypeURLMsgAddScopeOwnerRequest, types.TypeURLMsgDeleteScopeOwnerRequest:
+		types.TypeURLMsgAddScopeOwnerRequest, types.TypeURLMsgDeleteScopeOwnerRequest,
+		types.TypeURLMsgMigrateScopeSpecRequest:
 		urls = append(urls, types.TypeURLMsgWriteScopeRequest)
 	case types.TypeURLMsgWriteRecordRequest:
 		urls = append(urls, types.TypeURLMsgWriteSessionRequest)
@@ -204,6 +298,8 @@ func getAuthzMessageTypeURLs(msgTypeURL string) []string {
 		urls = append(urls, types.TypeURLMsgWriteContractSpecificationRequest)
 	case types.TypeURLMsgDeleteRecordSpecificationRequest:
 		urls = append(urls, types.TypeURLMsgDeleteContractSpecificationRequest)
+	case types.TypeURLMsgArchiveScopeRequest:
+		urls = append(urls, types.TypeURLMsgDeleteScopeRequest)
 	}
 	return urls
 }
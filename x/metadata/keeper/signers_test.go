@@ -2716,6 +2716,8 @@ func TestGetAuthzMessageTypeURLs(t *testing.T) {
 		newCase(types.TypeURLMsgDeleteOSLocatorRequest),
 		newCase(types.TypeURLMsgModifyOSLocatorRequest),
 		newCase(types.TypeURLMsgSetAccountDataRequest),
+		newCase(types.TypeURLMsgArchiveScopeRequest, types.TypeURLMsgDeleteScopeRequest),
+		newCase(types.TypeURLMsgMigrateScopeSpecRequest, types.TypeURLMsgWriteScopeRequest),
 	}
 
 	for _, tc := range tests {
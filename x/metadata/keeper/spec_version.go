@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// SupersedeScopeSpecification records that the scope spec with newSpecID is a newer version of the one with oldSpecID.
+// It does nothing if the old scope spec already has that newer version.
+func (k Keeper) SupersedeScopeSpecification(ctx sdk.Context, oldSpecID, newSpecID types.MetadataAddress) error {
+	oldSpec, found := k.GetScopeSpecification(ctx, oldSpecID)
+	if !found {
+		return fmt.Errorf("scope specification %s not found", oldSpecID)
+	}
+	if oldSpec.SupersededBy.Equals(newSpecID) {
+		return nil
+	}
+	if !oldSpec.SupersededBy.Empty() {
+		return fmt.Errorf("scope specification %s is already superseded by %s", oldSpecID, oldSpec.SupersededBy)
+	}
+
+	oldSpec.SupersededBy = newSpecID
+	k.SetScopeSpecification(ctx, oldSpec)
+	k.EmitEvent(ctx, types.NewEventScopeSpecificationSuperseded(oldSpecID, newSpecID))
+	return nil
+}
+
+// unlinkScopeSpecVersions removes the references to the given scope spec from its older and newer versions.
+// It should be used when the scope spec is being removed.
+func (k Keeper) unlinkScopeSpecVersions(ctx sdk.Context, spec types.ScopeSpecification) {
+	if !spec.Supersedes.Empty() {
+		if older, found := k.GetScopeSpecification(ctx, spec.Supersedes); found && older.SupersededBy.Equals(spec.SpecificationId) {
+			older.SupersededBy = nil
+			k.SetScopeSpecification(ctx, older)
+		}
+	}
+	if !spec.SupersededBy.Empty() {
+		if newer, found := k.GetScopeSpecification(ctx, spec.SupersededBy); found && newer.Supersedes.Equals(spec.SpecificationId) {
+			newer.Supersedes = nil
+			k.SetScopeSpecification(ctx, newer)
+		}
+	}
+}
+
+// isNewerScopeSpecVersion returns true if the scope spec with newerID can be reached by following
+// the superseded by links starting at the scope spec with specID.
+func (k Keeper) isNewerScopeSpecVersion(ctx sdk.Context, specID, newerID types.MetadataAddress) bool {
+	seen := make(map[string]bool)
+	for id := specID; !id.Empty() && !seen[string(id)]; {
+		seen[string(id)] = true
+		spec, found := k.GetScopeSpecification(ctx, id)
+		if !found {
+			return false
+		}
+		if spec.SupersededBy.Equals(newerID) {
+			return true
+		}
+		id = spec.SupersededBy
+	}
+	return false
+}
+
+// validateScopeSpecSupersedes makes sure that the supersedes field of a proposed scope spec is okay.
+// Once set, it cannot be changed. When first set, the scope spec it refers to must exist and not
+// already have a newer version, and it cannot create a loop of versions.
+func (k Keeper) validateScopeSpecSupersedes(ctx sdk.Context, existing *types.ScopeSpecification, proposed types.ScopeSpecification) error {
+	if existing != nil && !existing.Supersedes.Empty() {
+		if !proposed.Supersedes.Equals(existing.Supersedes) {
+			return fmt.Errorf("cannot change the scope specification that %s supersedes from %s",
+				proposed.SpecificationId, existing.Supersedes)
+		}
+		return nil
+	}
+	if proposed.Supersedes.Empty() {
+		return nil
+	}
+
+	oldSpec, found := k.GetScopeSpecification(ctx, proposed.Supersedes)
+	if !found {
+		return fmt.Errorf("superseded scope specification %s not found", proposed.Supersedes)
+	}
+	if !oldSpec.SupersededBy.Empty() && !oldSpec.SupersededBy.Equals(proposed.SpecificationId) {
+		return fmt.Errorf("scope specification %s is already superseded by %s", oldSpec.SpecificationId, oldSpec.SupersededBy)
+	}
+	if existing != nil && k.isNewerScopeSpecVersion(ctx, proposed.SpecificationId, proposed.Supersedes) {
+		return fmt.Errorf("scope specification %s cannot supersede %s since it is a newer version of %s",
+			proposed.SpecificationId, proposed.Supersedes, proposed.SpecificationId)
+	}
+	return nil
+}
+
+// validateScopeSpecChangesAllowed makes sure that changes to a scope spec won't invalidate any scopes using it.
+// If the scope spec is in use, contract specs cannot be removed from it and party types cannot be added to it.
+// Those changes require a new version of the scope spec instead.
+func (k Keeper) validateScopeSpecChangesAllowed(ctx sdk.Context, existing *types.ScopeSpecification, proposed types.ScopeSpecification) error {
+	if existing == nil || !k.isScopeSpecUsed(ctx, existing.SpecificationId) {
+		return nil
+	}
+	if removed := getNewContractSpecIDs(existing, &proposed); len(removed) > 0 {
+		return fmt.Errorf("cannot remove contract specification %s from scope specification %s while it is in use",
+			removed[0], existing.SpecificationId)
+	}
+	for _, party := range proposed.PartiesInvolved {
+		if !partyTypeIn(party, existing.PartiesInvolved) {
+			return fmt.Errorf("cannot add party type %s to scope specification %s while it is in use",
+				party.SimpleString(), existing.SpecificationId)
+		}
+	}
+	return nil
+}
+
+// partyTypeIn returns true if the party type is in the list of party types.
+func partyTypeIn(party types.PartyType, parties []types.PartyType) bool {
+	for _, p := range parties {
+		if p == party {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateMigrateScopeSpec makes sure that each scope in the msg can be moved to the scope spec in the msg,
+// and that the signers have the authority to do so.
+// The scope spec must be a newer version of the one each scope currently uses, the scope's owners must satisfy
+// its parties involved, and the scope's sessions and records must use contract specs that it allows.
+func (k Keeper) ValidateMigrateScopeSpec(ctx sdk.Context, msg *types.MsgMigrateScopeSpecRequest) error {
+	newSpec, found := k.GetScopeSpecification(ctx, msg.SpecificationId)
+	if !found {
+		return fmt.Errorf("scope specification %s not found", msg.SpecificationId)
+	}
+
+	for _, scopeID := range msg.ScopeIds {
+		existing, found := k.GetScope(ctx, scopeID)
+		if !found {
+			return fmt.Errorf("scope not found with id %s", scopeID)
+		}
+		if existing.SpecificationId.Equals(newSpec.SpecificationId) {
+			return fmt.Errorf("scope %s already uses scope specification %s", scopeID, newSpec.SpecificationId)
+		}
+		if !k.isNewerScopeSpecVersion(ctx, existing.SpecificationId, newSpec.SpecificationId) {
+			return fmt.Errorf("scope specification %s is not a newer version of %s (used by scope %s)",
+				newSpec.SpecificationId, existing.SpecificationId, scopeID)
+		}
+
+		proposed := existing
+		proposed.SpecificationId = newSpec.SpecificationId
+		if err := k.ValidateUpdateScopeOwners(ctx, existing, proposed, msg); err != nil {
+			return fmt.Errorf("cannot migrate scope %s: %w", scopeID, err)
+		}
+		if err := k.validateScopeContentsForSpec(ctx, scopeID, newSpec); err != nil {
+			return fmt.Errorf("cannot migrate scope %s: %w", scopeID, err)
+		}
+	}
+
+	return nil
+}
+
+// validateScopeContentsForSpec makes sure that all the sessions and records in a scope
+// use contract specs that are allowed by the provided scope spec.
+func (k Keeper) validateScopeContentsForSpec(ctx sdk.Context, scopeID types.MetadataAddress, spec types.ScopeSpecification) error {
+	allowed := make(map[string]bool, len(spec.ContractSpecIds))
+	for _, id := range spec.ContractSpecIds {
+		allowed[string(id)] = true
+	}
+
+	var err error
+	itErr := k.IterateSessions(ctx, scopeID, func(session types.Session) bool {
+		if !allowed[string(session.SpecificationId)] {
+			err = fmt.Errorf("session %s contract specification %s is not allowed by scope specification %s",
+				session.SessionId, session.SpecificationId, spec.SpecificationId)
+		}
+		return err != nil
+	})
+	if itErr != nil {
+		return fmt.Errorf("could not get scope %s sessions: %w", scopeID, itErr)
+	}
+	if err != nil {
+		return err
+	}
+
+	itErr = k.IterateRecords(ctx, scopeID, func(record types.Record) bool {
+		if record.SpecificationId.Empty() {
+			return false
+		}
+		contractSpecID, csErr := record.SpecificationId.AsContractSpecAddress()
+		if csErr != nil {
+			err = fmt.Errorf("invalid record %q specification id %s: %w", record.Name, record.SpecificationId, csErr)
+		} else if !allowed[string(contractSpecID)] {
+			err = fmt.Errorf("record %q contract specification %s is not allowed by scope specification %s",
+				record.Name, contractSpecID, spec.SpecificationId)
+		}
+		return err != nil
+	})
+	if itErr != nil {
+		return fmt.Errorf("could not get scope %s records: %w", scopeID, itErr)
+	}
+	return err
+}
+
+// MigrateScopeSpec changes the scope spec used by a scope. It assumes that ValidateMigrateScopeSpec has been used.
+func (k Keeper) MigrateScopeSpec(ctx sdk.Context, scopeID, specID types.MetadataAddress) error {
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return fmt.Errorf("scope not found with id %s", scopeID)
+	}
+
+	oldSpecID := scope.SpecificationId
+	scope.SpecificationId = specID
+	if err := k.SetScope(ctx, scope); err != nil {
+		return err
+	}
+	k.EmitEvent(ctx, types.NewEventScopeSpecMigrated(scopeID, oldSpecID, specID))
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/provenance-io/provenance/internal/provutils"
+	assettypes "github.com/provenance-io/provenance/x/asset/types"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -152,6 +153,17 @@ func (k Keeper) ValidateWriteRecordSpecification(_ sdk.Context, existing *types.
 		}
 	}
 
+	// The output schema must be usable by the same validator that checks the record output values.
+	schema, err := proposed.GetOutputJSONSchema()
+	if err != nil {
+		return fmt.Errorf("invalid record spec output schema: %w", err)
+	}
+	if schema != nil {
+		if err = assettypes.ValidateJSONSchemaDefinition(schema); err != nil {
+			return fmt.Errorf("invalid record spec output schema: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -475,6 +487,7 @@ func (k Keeper) RemoveScopeSpecification(ctx sdk.Context, scopeSpecID types.Meta
 
 	k.indexScopeSpecification(ctx, nil, &scopeSpec)
 	store.Delete(scopeSpecID)
+	k.unlinkScopeSpecVersions(ctx, scopeSpec)
 	k.EmitEvent(ctx, types.NewEventScopeSpecificationDeleted(scopeSpecID))
 	return nil
 }
@@ -611,7 +624,11 @@ func (k Keeper) ValidateWriteScopeSpecification(ctx sdk.Context, existing *types
 		}
 	}
 
-	return nil
+	if err := k.validateScopeSpecSupersedes(ctx, existing, proposed); err != nil {
+		return err
+	}
+
+	return k.validateScopeSpecChangesAllowed(ctx, existing, proposed)
 }
 
 // getNewContractSpecIDs gets all contract spec ids in proposed that are not in existing.
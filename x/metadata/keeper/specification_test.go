@@ -779,6 +779,18 @@ func (s *SpecKeeperTestSuite) TestGetRecordSpecificationsForContractSpecificatio
 
 func (s *SpecKeeperTestSuite) TestValidateWriteRecordSpecification() {
 	contractSpecUUIDOther := uuid.New()
+	withOutputSchema := func(outputSchema string) *types.RecordSpecification {
+		rv := types.NewRecordSpecification(
+			types.RecordSpecMetadataAddress(s.contractSpecUUID1, "name"),
+			"name",
+			[]*types.InputSpecification{},
+			"typename",
+			types.DefinitionType_DEFINITION_TYPE_RECORD,
+			[]types.PartyType{types.PartyType_PARTY_TYPE_SERVICER},
+		)
+		rv.OutputSchema = outputSchema
+		return rv
+	}
 	tests := []struct {
 		name     string
 		existing *types.RecordSpecification
@@ -841,6 +853,24 @@ func (s *SpecKeeperTestSuite) TestValidateWriteRecordSpecification() {
 				types.RecordSpecMetadataAddress(s.contractSpecUUID1, "foo"),
 				types.RecordSpecMetadataAddress(contractSpecUUIDOther, "foo")),
 		},
+		{
+			"output schema with supported types",
+			nil,
+			withOutputSchema(`{"type":"object","required":["status"],"properties":{"status":{"type":"string"},"tags":{"type":"array","items":{"type":"string"}}}}`),
+			"",
+		},
+		{
+			"output schema without a type",
+			nil,
+			withOutputSchema(`{"required":["status"]}`),
+			"invalid record spec output schema: type is required",
+		},
+		{
+			"output schema with a number property",
+			nil,
+			withOutputSchema(`{"type":"object","properties":{"amount":{"type":"number"}}}`),
+			`invalid record spec output schema: invalid property amount definition: unsupported type "number": expected object, array, or string`,
+		},
 		// Names must match - cannot be tested. A changed name will change the spec id.
 		// So either ValidateBasic will catch that the hashed name doesn't match its part in the ID,
 		// or the ValidateWriteRecordSpecification will catch the changing specification id.
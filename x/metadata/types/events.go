@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/hex"
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -18,6 +20,14 @@ const (
 	TxEndpoint_DeleteScopeOwner      TxEndpoint = "DeleteScopeOwner"
 	TxEndpoint_UpdateValueOwners     TxEndpoint = "UpdateValueOwners"
 	TxEndpoint_MigrateValueOwner     TxEndpoint = "MigrateValueOwner"
+	TxEndpoint_PruneScopeHistory     TxEndpoint = "PruneScopeHistory"
+
+	TxEndpoint_ProposeScopeOwnershipTransfer TxEndpoint = "ProposeScopeOwnershipTransfer"
+	TxEndpoint_AcceptScopeOwnershipTransfer  TxEndpoint = "AcceptScopeOwnershipTransfer"
+	TxEndpoint_CancelScopeOwnershipTransfer  TxEndpoint = "CancelScopeOwnershipTransfer"
+
+	TxEndpoint_WriteScopeBundle TxEndpoint = "WriteScopeBundle"
+	TxEndpoint_ArchiveScope     TxEndpoint = "ArchiveScope"
 
 	TxEndpoint_WriteSession TxEndpoint = "WriteSession"
 
@@ -26,6 +36,7 @@ const (
 
 	TxEndpoint_WriteScopeSpecification  TxEndpoint = "WriteScopeSpecification"
 	TxEndpoint_DeleteScopeSpecification TxEndpoint = "DeleteScopeSpecification"
+	TxEndpoint_MigrateScopeSpec         TxEndpoint = "MigrateScopeSpec"
 
 	TxEndpoint_WriteContractSpecification  TxEndpoint = "WriteContractSpecification"
 	TxEndpoint_DeleteContractSpecification TxEndpoint = "DeleteContractSpecification"
@@ -195,3 +206,86 @@ func NewEventSetNetAssetValue(scopeID MetadataAddress, price sdk.Coin, volume ui
 		Volume:  strconv.FormatUint(volume, 10),
 	}
 }
+
+// NewEventSetNetAssetValueMaxAge returns a new instance of EventSetNetAssetValueMaxAge
+func NewEventSetNetAssetValueMaxAge(scopeID MetadataAddress, maxAge uint64) *EventSetNetAssetValueMaxAge {
+	return &EventSetNetAssetValueMaxAge{
+		ScopeId: scopeID.String(),
+		MaxAge:  strconv.FormatUint(maxAge, 10),
+	}
+}
+
+// NewEventNetAssetValueStale returns a new instance of EventNetAssetValueStale
+func NewEventNetAssetValueStale(scopeID MetadataAddress, nav NetAssetValue, maxAge uint64) *EventNetAssetValueStale {
+	return &EventNetAssetValueStale{
+		ScopeId:            scopeID.String(),
+		PriceDenom:         nav.Price.Denom,
+		UpdatedBlockHeight: strconv.FormatUint(nav.UpdatedBlockHeight, 10),
+		MaxAge:             strconv.FormatUint(maxAge, 10),
+	}
+}
+
+// NewEventScopeOwnershipTransferProposed returns a new instance of EventScopeOwnershipTransferProposed
+func NewEventScopeOwnershipTransferProposed(transfer ScopeOwnershipTransfer) *EventScopeOwnershipTransferProposed {
+	return &EventScopeOwnershipTransferProposed{
+		ScopeAddr:  transfer.ScopeId.String(),
+		Recipients: transfer.Recipients,
+		Expiration: transfer.Expiration.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+// NewEventScopeOwnershipTransferAccepted returns a new instance of EventScopeOwnershipTransferAccepted
+func NewEventScopeOwnershipTransferAccepted(scopeID MetadataAddress) *EventScopeOwnershipTransferAccepted {
+	return &EventScopeOwnershipTransferAccepted{
+		ScopeAddr: scopeID.String(),
+	}
+}
+
+// NewEventScopeOwnershipTransferCancelled returns a new instance of EventScopeOwnershipTransferCancelled
+func NewEventScopeOwnershipTransferCancelled(scopeID MetadataAddress, cancelledBy []string) *EventScopeOwnershipTransferCancelled {
+	return &EventScopeOwnershipTransferCancelled{
+		ScopeAddr:   scopeID.String(),
+		CancelledBy: cancelledBy,
+	}
+}
+
+// NewEventScopeOwnershipTransferExpired returns a new instance of EventScopeOwnershipTransferExpired
+func NewEventScopeOwnershipTransferExpired(scopeID MetadataAddress) *EventScopeOwnershipTransferExpired {
+	return &EventScopeOwnershipTransferExpired{
+		ScopeAddr: scopeID.String(),
+	}
+}
+
+// NewEventScopeDataAccessExpired returns a new instance of EventScopeDataAccessExpired
+func NewEventScopeDataAccessExpired(scopeID MetadataAddress, addr string) *EventScopeDataAccessExpired {
+	return &EventScopeDataAccessExpired{
+		ScopeAddr: scopeID.String(),
+		Address:   addr,
+	}
+}
+
+// NewEventScopeArchived returns a new instance of EventScopeArchived
+func NewEventScopeArchived(archive ScopeArchive, archiveHash []byte) *EventScopeArchived {
+	return &EventScopeArchived{
+		ScopeAddr:   archive.Scope.ScopeId.String(),
+		ArchiveHash: hex.EncodeToString(archiveHash),
+		Archive:     &archive,
+	}
+}
+
+// NewEventScopeSpecificationSuperseded returns a new instance of EventScopeSpecificationSuperseded
+func NewEventScopeSpecificationSuperseded(scopeSpecificationID, supersededByID MetadataAddress) *EventScopeSpecificationSuperseded {
+	return &EventScopeSpecificationSuperseded{
+		ScopeSpecificationAddr: scopeSpecificationID.String(),
+		SupersededByAddr:       supersededByID.String(),
+	}
+}
+
+// NewEventScopeSpecMigrated returns a new instance of EventScopeSpecMigrated
+func NewEventScopeSpecMigrated(scopeID, fromSpecID, toSpecID MetadataAddress) *EventScopeSpecMigrated {
+	return &EventScopeSpecMigrated{
+		ScopeAddr:                  scopeID.String(),
+		FromScopeSpecificationAddr: fromSpecID.String(),
+		ToScopeSpecificationAddr:   toSpecID.String(),
+	}
+}
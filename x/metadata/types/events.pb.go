@@ -1232,509 +1232,684 @@ func (m *EventSetNetAssetValue) GetVolume() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventTxCompleted)(nil), "provenance.metadata.v1.EventTxCompleted")
-	proto.RegisterType((*EventScopeCreated)(nil), "provenance.metadata.v1.EventScopeCreated")
-	proto.RegisterType((*EventScopeUpdated)(nil), "provenance.metadata.v1.EventScopeUpdated")
-	proto.RegisterType((*EventScopeDeleted)(nil), "provenance.metadata.v1.EventScopeDeleted")
-	proto.RegisterType((*EventSessionCreated)(nil), "provenance.metadata.v1.EventSessionCreated")
-	proto.RegisterType((*EventSessionUpdated)(nil), "provenance.metadata.v1.EventSessionUpdated")
-	proto.RegisterType((*EventSessionDeleted)(nil), "provenance.metadata.v1.EventSessionDeleted")
-	proto.RegisterType((*EventRecordCreated)(nil), "provenance.metadata.v1.EventRecordCreated")
-	proto.RegisterType((*EventRecordUpdated)(nil), "provenance.metadata.v1.EventRecordUpdated")
-	proto.RegisterType((*EventRecordDeleted)(nil), "provenance.metadata.v1.EventRecordDeleted")
-	proto.RegisterType((*EventScopeSpecificationCreated)(nil), "provenance.metadata.v1.EventScopeSpecificationCreated")
-	proto.RegisterType((*EventScopeSpecificationUpdated)(nil), "provenance.metadata.v1.EventScopeSpecificationUpdated")
-	proto.RegisterType((*EventScopeSpecificationDeleted)(nil), "provenance.metadata.v1.EventScopeSpecificationDeleted")
-	proto.RegisterType((*EventContractSpecificationCreated)(nil), "provenance.metadata.v1.EventContractSpecificationCreated")
-	proto.RegisterType((*EventContractSpecificationUpdated)(nil), "provenance.metadata.v1.EventContractSpecificationUpdated")
-	proto.RegisterType((*EventContractSpecificationDeleted)(nil), "provenance.metadata.v1.EventContractSpecificationDeleted")
-	proto.RegisterType((*EventRecordSpecificationCreated)(nil), "provenance.metadata.v1.EventRecordSpecificationCreated")
-	proto.RegisterType((*EventRecordSpecificationUpdated)(nil), "provenance.metadata.v1.EventRecordSpecificationUpdated")
-	proto.RegisterType((*EventRecordSpecificationDeleted)(nil), "provenance.metadata.v1.EventRecordSpecificationDeleted")
-	proto.RegisterType((*EventOSLocatorCreated)(nil), "provenance.metadata.v1.EventOSLocatorCreated")
-	proto.RegisterType((*EventOSLocatorUpdated)(nil), "provenance.metadata.v1.EventOSLocatorUpdated")
-	proto.RegisterType((*EventOSLocatorDeleted)(nil), "provenance.metadata.v1.EventOSLocatorDeleted")
-	proto.RegisterType((*EventSetNetAssetValue)(nil), "provenance.metadata.v1.EventSetNetAssetValue")
+// EventSetNetAssetValueMaxAge event emitted when the maximum age of a scope's net asset values is set.
+type EventSetNetAssetValueMaxAge struct {
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	MaxAge  string `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("provenance/metadata/v1/events.proto", fileDescriptor_476cf6cf9459cf25)
+func (m *EventSetNetAssetValueMaxAge) Reset()         { *m = EventSetNetAssetValueMaxAge{} }
+func (m *EventSetNetAssetValueMaxAge) String() string { return proto.CompactTextString(m) }
+func (*EventSetNetAssetValueMaxAge) ProtoMessage()    {}
+func (*EventSetNetAssetValueMaxAge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{23}
 }
-
-var fileDescriptor_476cf6cf9459cf25 = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6f, 0x13, 0x31,
-	0x10, 0xcd, 0x26, 0xd0, 0x36, 0x53, 0x0e, 0xb0, 0x40, 0xd8, 0x80, 0xd8, 0x7e, 0x70, 0xe9, 0xa5,
-	0x89, 0x0a, 0x1c, 0x10, 0x07, 0xa4, 0x12, 0x38, 0x20, 0x21, 0x40, 0x49, 0x01, 0xa9, 0x17, 0x70,
-	0xed, 0xa1, 0x58, 0x24, 0xeb, 0x95, 0xed, 0xa4, 0xe1, 0x5f, 0xf0, 0x07, 0xf8, 0x3f, 0x1c, 0x7b,
-	0xe4, 0x88, 0x92, 0x3f, 0x82, 0xd6, 0x1f, 0x64, 0xdb, 0xa4, 0x2c, 0x10, 0x0a, 0x3d, 0xbe, 0xf1,
-	0xbc, 0xf7, 0xc6, 0x6f, 0x27, 0x91, 0xe1, 0x56, 0x2a, 0xc5, 0x00, 0x13, 0x92, 0x50, 0x6c, 0xf6,
-	0x50, 0x13, 0x46, 0x34, 0x69, 0x0e, 0xb6, 0x9a, 0x38, 0xc0, 0x44, 0xab, 0x46, 0x2a, 0x85, 0x16,
-	0x61, 0x6d, 0xd2, 0xd4, 0xf0, 0x4d, 0x8d, 0xc1, 0xd6, 0xfa, 0x5b, 0xb8, 0xf8, 0x38, 0xeb, 0xdb,
-	0x19, 0xb6, 0x44, 0x2f, 0xed, 0xa2, 0x46, 0x16, 0xd6, 0x60, 0xa1, 0x27, 0x58, 0xbf, 0x8b, 0x51,
-	0xb0, 0x1a, 0x6c, 0x54, 0xdb, 0x0e, 0x85, 0xd7, 0x61, 0x09, 0x13, 0x96, 0x0a, 0x9e, 0xe8, 0xa8,
-	0x6c, 0x4e, 0x7e, 0xe0, 0x30, 0x82, 0x45, 0xc5, 0xf7, 0x13, 0x94, 0x2a, 0xaa, 0xac, 0x56, 0x36,
-	0xaa, 0x6d, 0x0f, 0xd7, 0x6f, 0xc3, 0x25, 0xe3, 0xd0, 0xa1, 0x22, 0xc5, 0x96, 0x44, 0x92, 0x59,
-	0xdc, 0x04, 0x50, 0x19, 0x7e, 0x43, 0x18, 0x93, 0xce, 0xa6, 0x6a, 0x2a, 0xdb, 0x8c, 0xc9, 0xa3,
-	0x9c, 0x97, 0x29, 0xfb, 0x6d, 0xce, 0x23, 0xb4, 0x57, 0x29, 0xe0, 0xbc, 0x86, 0xcb, 0x96, 0x83,
-	0x4a, 0x71, 0x91, 0xf8, 0xe9, 0xd6, 0xe0, 0x82, 0xb2, 0x95, 0x3c, 0x6f, 0xd9, 0xd5, 0x32, 0xe6,
-	0x31, 0xe1, 0x72, 0x81, 0xb0, 0xbf, 0xc2, 0x5f, 0x17, 0xf6, 0xf7, 0x9c, 0x5f, 0xf8, 0x00, 0x42,
-	0x23, 0xdc, 0x46, 0x2a, 0x24, 0xf3, 0x49, 0xac, 0xc0, 0xb2, 0x34, 0x85, 0xbc, 0x2c, 0xd8, 0x92,
-	0x51, 0x3d, 0x6e, 0x5c, 0x2e, 0x32, 0xae, 0xfc, 0xdc, 0xd8, 0x27, 0xf5, 0x0f, 0x8c, 0x77, 0x8e,
-	0x18, 0xfb, 0x24, 0x0b, 0x8d, 0x0b, 0x54, 0x77, 0x21, 0x9e, 0xac, 0x61, 0x27, 0x45, 0xca, 0xdf,
-	0x71, 0x4a, 0x74, 0x6e, 0xbb, 0xee, 0x41, 0x64, 0x05, 0x54, 0xfe, 0x34, 0x6f, 0x57, 0x53, 0x53,
-	0xe4, 0x02, 0x6d, 0x1f, 0xdb, 0x69, 0x68, 0xfb, 0x64, 0xfe, 0x5c, 0x9b, 0xc2, 0x9a, 0xd1, 0x6e,
-	0x89, 0x44, 0x4b, 0x42, 0xf5, 0xcc, 0x58, 0x1e, 0xc0, 0x0d, 0xea, 0xce, 0x4f, 0x76, 0xa8, 0xd3,
-	0x59, 0x12, 0xc5, 0x26, 0x3e, 0x9f, 0x53, 0x35, 0xf1, 0x41, 0xcd, 0x6b, 0xf2, 0x39, 0x80, 0x95,
-	0xdc, 0x66, 0xce, 0x4c, 0xeb, 0x3e, 0xd4, 0xdd, 0x9a, 0x9e, 0xe8, 0x70, 0x4d, 0x4e, 0xd3, 0xcd,
-	0x06, 0x17, 0xcc, 0x57, 0x9e, 0x67, 0x3e, 0x1f, 0xf4, 0x59, 0x9d, 0xcf, 0x7f, 0xa3, 0xff, 0x39,
-	0xdf, 0x26, 0x5c, 0x35, 0xe3, 0x3d, 0xef, 0x3c, 0x15, 0x94, 0x68, 0x21, 0xfd, 0x47, 0xbd, 0x02,
-	0xe7, 0xc5, 0x41, 0x82, 0x7e, 0x00, 0x0b, 0xa6, 0xdb, 0x7d, 0xc6, 0xbf, 0xd8, 0xee, 0xaf, 0x3c,
-	0xbb, 0x7d, 0xe8, 0xda, 0x3b, 0xa8, 0x9f, 0xa1, 0xde, 0x56, 0x0a, 0xf5, 0x2b, 0xd2, 0xed, 0x63,
-	0x58, 0x87, 0x25, 0xfb, 0x73, 0xe7, 0xcc, 0x31, 0x16, 0x0d, 0x7e, 0x62, 0x94, 0x52, 0xc9, 0x29,
-	0xba, 0xab, 0x5a, 0x90, 0x3d, 0x1b, 0x94, 0xe8, 0x4b, 0x8a, 0xee, 0x4f, 0xd1, 0xa1, 0xac, 0x3e,
-	0x10, 0xdd, 0x7e, 0x0f, 0xa3, 0x73, 0xb6, 0x6e, 0xd1, 0xc3, 0x0f, 0x5f, 0x46, 0x71, 0x70, 0x38,
-	0x8a, 0x83, 0x6f, 0xa3, 0x38, 0xf8, 0x34, 0x8e, 0x4b, 0x87, 0xe3, 0xb8, 0xf4, 0x75, 0x1c, 0x97,
-	0xa0, 0xce, 0x45, 0x63, 0xf6, 0x7b, 0xe5, 0x45, 0xb0, 0x7b, 0x77, 0x9f, 0xeb, 0xf7, 0xfd, 0xbd,
-	0x06, 0x15, 0xbd, 0xe6, 0xa4, 0x69, 0x93, 0x8b, 0x1c, 0x6a, 0x0e, 0x27, 0x2f, 0x21, 0xfd, 0x31,
-	0x45, 0xb5, 0xb7, 0x60, 0x9e, 0x41, 0x77, 0xbe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xd9, 0xf3,
-	0xfa, 0x2d, 0x09, 0x00, 0x00,
+func (m *EventSetNetAssetValueMaxAge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSetNetAssetValueMaxAge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetNetAssetValueMaxAge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventTxCompleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSetNetAssetValueMaxAge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetNetAssetValueMaxAge.Merge(m, src)
+}
+func (m *EventSetNetAssetValueMaxAge) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetNetAssetValueMaxAge) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetNetAssetValueMaxAge.DiscardUnknown(m)
 }
 
-func (m *EventTxCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Endpoint) > 0 {
-		i -= len(m.Endpoint)
-		copy(dAtA[i:], m.Endpoint)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Endpoint)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Module) > 0 {
-		i -= len(m.Module)
-		copy(dAtA[i:], m.Module)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Module)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventSetNetAssetValueMaxAge proto.InternalMessageInfo
+
+func (m *EventSetNetAssetValueMaxAge) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventScopeCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSetNetAssetValueMaxAge) GetMaxAge() string {
+	if m != nil {
+		return m.MaxAge
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventScopeCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventNetAssetValueStale event emitted when a scope's net asset value becomes older than the maximum age.
+type EventNetAssetValueStale struct {
+	ScopeId            string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	PriceDenom         string `protobuf:"bytes,2,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	UpdatedBlockHeight string `protobuf:"bytes,3,opt,name=updated_block_height,json=updatedBlockHeight,proto3" json:"updated_block_height,omitempty"`
+	MaxAge             string `protobuf:"bytes,4,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
 }
 
-func (m *EventScopeCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventNetAssetValueStale) Reset()         { *m = EventNetAssetValueStale{} }
+func (m *EventNetAssetValueStale) String() string { return proto.CompactTextString(m) }
+func (*EventNetAssetValueStale) ProtoMessage()    {}
+func (*EventNetAssetValueStale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{24}
+}
+func (m *EventNetAssetValueStale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNetAssetValueStale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNetAssetValueStale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventNetAssetValueStale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNetAssetValueStale.Merge(m, src)
+}
+func (m *EventNetAssetValueStale) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNetAssetValueStale) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNetAssetValueStale.DiscardUnknown(m)
 }
 
-func (m *EventScopeUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventNetAssetValueStale proto.InternalMessageInfo
+
+func (m *EventNetAssetValueStale) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventScopeUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventNetAssetValueStale) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
 }
 
-func (m *EventScopeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventNetAssetValueStale) GetUpdatedBlockHeight() string {
+	if m != nil {
+		return m.UpdatedBlockHeight
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventScopeDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNetAssetValueStale) GetMaxAge() string {
+	if m != nil {
+		return m.MaxAge
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventScopeDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventScopeOwnershipTransferProposed is an event message indicating a scope ownership transfer has been proposed.
+type EventScopeOwnershipTransferProposed struct {
+	// scope_addr is the bech32 address string of the scope id being transferred.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// recipients are the addresses that need to accept the transfer.
+	Recipients []string `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// expiration is the time after which the transfer can no longer be accepted.
+	Expiration string `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
-func (m *EventScopeDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventScopeOwnershipTransferProposed) Reset()         { *m = EventScopeOwnershipTransferProposed{} }
+func (m *EventScopeOwnershipTransferProposed) String() string { return proto.CompactTextString(m) }
+func (*EventScopeOwnershipTransferProposed) ProtoMessage()    {}
+func (*EventScopeOwnershipTransferProposed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{25}
 }
-
-func (m *EventSessionCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventScopeOwnershipTransferProposed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeOwnershipTransferProposed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeOwnershipTransferProposed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventSessionCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventScopeOwnershipTransferProposed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeOwnershipTransferProposed.Merge(m, src)
+}
+func (m *EventScopeOwnershipTransferProposed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeOwnershipTransferProposed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeOwnershipTransferProposed.DiscardUnknown(m)
 }
 
-func (m *EventSessionCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_EventScopeOwnershipTransferProposed proto.InternalMessageInfo
+
+func (m *EventScopeOwnershipTransferProposed) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
 	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventScopeOwnershipTransferProposed) GetRecipients() []string {
+	if m != nil {
+		return m.Recipients
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventSessionUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventScopeOwnershipTransferProposed) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSessionUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventScopeOwnershipTransferAccepted is an event message indicating a scope ownership transfer has been accepted.
+type EventScopeOwnershipTransferAccepted struct {
+	// scope_addr is the bech32 address string of the scope id that was transferred.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
 }
 
-func (m *EventSessionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventScopeOwnershipTransferAccepted) Reset()         { *m = EventScopeOwnershipTransferAccepted{} }
+func (m *EventScopeOwnershipTransferAccepted) String() string { return proto.CompactTextString(m) }
+func (*EventScopeOwnershipTransferAccepted) ProtoMessage()    {}
+func (*EventScopeOwnershipTransferAccepted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{26}
+}
+func (m *EventScopeOwnershipTransferAccepted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeOwnershipTransferAccepted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeOwnershipTransferAccepted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventScopeOwnershipTransferAccepted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeOwnershipTransferAccepted.Merge(m, src)
+}
+func (m *EventScopeOwnershipTransferAccepted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeOwnershipTransferAccepted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeOwnershipTransferAccepted.DiscardUnknown(m)
 }
 
-func (m *EventSessionDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventScopeOwnershipTransferAccepted proto.InternalMessageInfo
+
+func (m *EventScopeOwnershipTransferAccepted) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSessionDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventScopeOwnershipTransferCancelled is an event message indicating a scope ownership transfer has been cancelled.
+type EventScopeOwnershipTransferCancelled struct {
+	// scope_addr is the bech32 address string of the scope id that was going to be transferred.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// cancelled_by are the addresses that cancelled the transfer.
+	CancelledBy []string `protobuf:"bytes,2,rep,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
 }
 
-func (m *EventSessionDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventScopeOwnershipTransferCancelled) Reset()         { *m = EventScopeOwnershipTransferCancelled{} }
+func (m *EventScopeOwnershipTransferCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventScopeOwnershipTransferCancelled) ProtoMessage()    {}
+func (*EventScopeOwnershipTransferCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{27}
 }
-
-func (m *EventRecordCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventScopeOwnershipTransferCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeOwnershipTransferCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeOwnershipTransferCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventRecordCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventScopeOwnershipTransferCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeOwnershipTransferCancelled.Merge(m, src)
+}
+func (m *EventScopeOwnershipTransferCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeOwnershipTransferCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeOwnershipTransferCancelled.DiscardUnknown(m)
 }
 
-func (m *EventRecordCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventScopeOwnershipTransferCancelled proto.InternalMessageInfo
+
+func (m *EventScopeOwnershipTransferCancelled) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventRecordUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventScopeOwnershipTransferCancelled) GetCancelledBy() []string {
+	if m != nil {
+		return m.CancelledBy
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventRecordUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventScopeOwnershipTransferExpired is an event message indicating a scope ownership transfer expired without being
+// accepted.
+type EventScopeOwnershipTransferExpired struct {
+	// scope_addr is the bech32 address string of the scope id that was going to be transferred.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
 }
 
-func (m *EventRecordUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventScopeOwnershipTransferExpired) Reset()         { *m = EventScopeOwnershipTransferExpired{} }
+func (m *EventScopeOwnershipTransferExpired) String() string { return proto.CompactTextString(m) }
+func (*EventScopeOwnershipTransferExpired) ProtoMessage()    {}
+func (*EventScopeOwnershipTransferExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{28}
+}
+func (m *EventScopeOwnershipTransferExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeOwnershipTransferExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeOwnershipTransferExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventScopeOwnershipTransferExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeOwnershipTransferExpired.Merge(m, src)
+}
+func (m *EventScopeOwnershipTransferExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeOwnershipTransferExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeOwnershipTransferExpired.DiscardUnknown(m)
 }
 
-func (m *EventRecordDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventScopeOwnershipTransferExpired proto.InternalMessageInfo
+
+func (m *EventScopeOwnershipTransferExpired) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventRecordDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventScopeDataAccessExpired is an event message indicating an address's data access to a scope has expired.
+type EventScopeDataAccessExpired struct {
+	// scope_addr is the bech32 address string of the scope id.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// address is the bech32 address that no longer has data access to the scope.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *EventRecordDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventScopeDataAccessExpired) Reset()         { *m = EventScopeDataAccessExpired{} }
+func (m *EventScopeDataAccessExpired) String() string { return proto.CompactTextString(m) }
+func (*EventScopeDataAccessExpired) ProtoMessage()    {}
+func (*EventScopeDataAccessExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{29}
 }
-
-func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventScopeDataAccessExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeDataAccessExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeDataAccessExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventScopeSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventScopeDataAccessExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeDataAccessExpired.Merge(m, src)
+}
+func (m *EventScopeDataAccessExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeDataAccessExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeDataAccessExpired.DiscardUnknown(m)
 }
 
-func (m *EventScopeSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventScopeDataAccessExpired proto.InternalMessageInfo
+
+func (m *EventScopeDataAccessExpired) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventScopeDataAccessExpired) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventScopeSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventScopeArchived is an event message indicating a scope has been archived and removed, along with its sessions,
+// records, and net asset values.
+type EventScopeArchived struct {
+	// scope_addr is the bech32 address string of the scope id that was archived.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// archive_hash is the hex encoded SHA-256 hash of the protobuf encoding of the archive.
+	ArchiveHash string `protobuf:"bytes,2,opt,name=archive_hash,json=archiveHash,proto3" json:"archive_hash,omitempty"`
+	// archive is everything that existed for the scope when it was archived.
+	Archive *ScopeArchive `protobuf:"bytes,3,opt,name=archive,proto3" json:"archive,omitempty"`
 }
 
-func (m *EventScopeSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventScopeArchived) Reset()         { *m = EventScopeArchived{} }
+func (m *EventScopeArchived) String() string { return proto.CompactTextString(m) }
+func (*EventScopeArchived) ProtoMessage()    {}
+func (*EventScopeArchived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{30}
+}
+func (m *EventScopeArchived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeArchived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeArchived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventScopeArchived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeArchived.Merge(m, src)
+}
+func (m *EventScopeArchived) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeArchived) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeArchived.DiscardUnknown(m)
 }
 
-func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_EventScopeArchived proto.InternalMessageInfo
+
+func (m *EventScopeArchived) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventScopeArchived) GetArchiveHash() string {
+	if m != nil {
+		return m.ArchiveHash
+	}
+	return ""
+}
+
+func (m *EventScopeArchived) GetArchive() *ScopeArchive {
+	if m != nil {
+		return m.Archive
+	}
+	return nil
+}
+
+// EventScopeSpecificationSuperseded is an event message indicating a scope specification has a newer version.
+type EventScopeSpecificationSuperseded struct {
+	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
+	// superseded.
+	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
+	// superseded_by_addr is the bech32 address string of the specification id of the newer version.
+	SupersededByAddr string `protobuf:"bytes,2,opt,name=superseded_by_addr,json=supersededByAddr,proto3" json:"superseded_by_addr,omitempty"`
+}
+
+func (m *EventScopeSpecificationSuperseded) Reset()         { *m = EventScopeSpecificationSuperseded{} }
+func (m *EventScopeSpecificationSuperseded) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecificationSuperseded) ProtoMessage()    {}
+func (*EventScopeSpecificationSuperseded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{31}
+}
+func (m *EventScopeSpecificationSuperseded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeSpecificationSuperseded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeSpecificationSuperseded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScopeSpecificationSuperseded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecificationSuperseded.Merge(m, src)
+}
+func (m *EventScopeSpecificationSuperseded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeSpecificationSuperseded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecificationSuperseded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScopeSpecificationSuperseded proto.InternalMessageInfo
+
+func (m *EventScopeSpecificationSuperseded) GetScopeSpecificationAddr() string {
+	if m != nil {
+		return m.ScopeSpecificationAddr
+	}
+	return ""
+}
+
+func (m *EventScopeSpecificationSuperseded) GetSupersededByAddr() string {
+	if m != nil {
+		return m.SupersededByAddr
+	}
+	return ""
+}
+
+// EventScopeSpecMigrated is an event message indicating a scope has been moved to a newer version of its scope
+// specification.
+type EventScopeSpecMigrated struct {
+	// scope_addr is the bech32 address string of the scope id that was migrated.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// from_scope_specification_addr is the bech32 address string of the scope specification it previously used.
+	FromScopeSpecificationAddr string `protobuf:"bytes,2,opt,name=from_scope_specification_addr,json=fromScopeSpecificationAddr,proto3" json:"from_scope_specification_addr,omitempty"`
+	// to_scope_specification_addr is the bech32 address string of the scope specification it now uses.
+	ToScopeSpecificationAddr string `protobuf:"bytes,3,opt,name=to_scope_specification_addr,json=toScopeSpecificationAddr,proto3" json:"to_scope_specification_addr,omitempty"`
+}
+
+func (m *EventScopeSpecMigrated) Reset()         { *m = EventScopeSpecMigrated{} }
+func (m *EventScopeSpecMigrated) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecMigrated) ProtoMessage()    {}
+func (*EventScopeSpecMigrated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{32}
+}
+func (m *EventScopeSpecMigrated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeSpecMigrated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeSpecMigrated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScopeSpecMigrated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecMigrated.Merge(m, src)
+}
+func (m *EventScopeSpecMigrated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeSpecMigrated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecMigrated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScopeSpecMigrated proto.InternalMessageInfo
+
+func (m *EventScopeSpecMigrated) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventScopeSpecMigrated) GetFromScopeSpecificationAddr() string {
+	if m != nil {
+		return m.FromScopeSpecificationAddr
+	}
+	return ""
+}
+
+func (m *EventScopeSpecMigrated) GetToScopeSpecificationAddr() string {
+	if m != nil {
+		return m.ToScopeSpecificationAddr
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventTxCompleted)(nil), "provenance.metadata.v1.EventTxCompleted")
+	proto.RegisterType((*EventScopeCreated)(nil), "provenance.metadata.v1.EventScopeCreated")
+	proto.RegisterType((*EventScopeUpdated)(nil), "provenance.metadata.v1.EventScopeUpdated")
+	proto.RegisterType((*EventScopeDeleted)(nil), "provenance.metadata.v1.EventScopeDeleted")
+	proto.RegisterType((*EventSessionCreated)(nil), "provenance.metadata.v1.EventSessionCreated")
+	proto.RegisterType((*EventSessionUpdated)(nil), "provenance.metadata.v1.EventSessionUpdated")
+	proto.RegisterType((*EventSessionDeleted)(nil), "provenance.metadata.v1.EventSessionDeleted")
+	proto.RegisterType((*EventRecordCreated)(nil), "provenance.metadata.v1.EventRecordCreated")
+	proto.RegisterType((*EventRecordUpdated)(nil), "provenance.metadata.v1.EventRecordUpdated")
+	proto.RegisterType((*EventRecordDeleted)(nil), "provenance.metadata.v1.EventRecordDeleted")
+	proto.RegisterType((*EventScopeSpecificationCreated)(nil), "provenance.metadata.v1.EventScopeSpecificationCreated")
+	proto.RegisterType((*EventScopeSpecificationUpdated)(nil), "provenance.metadata.v1.EventScopeSpecificationUpdated")
+	proto.RegisterType((*EventScopeSpecificationDeleted)(nil), "provenance.metadata.v1.EventScopeSpecificationDeleted")
+	proto.RegisterType((*EventContractSpecificationCreated)(nil), "provenance.metadata.v1.EventContractSpecificationCreated")
+	proto.RegisterType((*EventContractSpecificationUpdated)(nil), "provenance.metadata.v1.EventContractSpecificationUpdated")
+	proto.RegisterType((*EventContractSpecificationDeleted)(nil), "provenance.metadata.v1.EventContractSpecificationDeleted")
+	proto.RegisterType((*EventRecordSpecificationCreated)(nil), "provenance.metadata.v1.EventRecordSpecificationCreated")
+	proto.RegisterType((*EventRecordSpecificationUpdated)(nil), "provenance.metadata.v1.EventRecordSpecificationUpdated")
+	proto.RegisterType((*EventRecordSpecificationDeleted)(nil), "provenance.metadata.v1.EventRecordSpecificationDeleted")
+	proto.RegisterType((*EventOSLocatorCreated)(nil), "provenance.metadata.v1.EventOSLocatorCreated")
+	proto.RegisterType((*EventOSLocatorUpdated)(nil), "provenance.metadata.v1.EventOSLocatorUpdated")
+	proto.RegisterType((*EventOSLocatorDeleted)(nil), "provenance.metadata.v1.EventOSLocatorDeleted")
+	proto.RegisterType((*EventSetNetAssetValue)(nil), "provenance.metadata.v1.EventSetNetAssetValue")
+	proto.RegisterType((*EventSetNetAssetValueMaxAge)(nil), "provenance.metadata.v1.EventSetNetAssetValueMaxAge")
+	proto.RegisterType((*EventNetAssetValueStale)(nil), "provenance.metadata.v1.EventNetAssetValueStale")
+	proto.RegisterType((*EventScopeOwnershipTransferProposed)(nil), "provenance.metadata.v1.EventScopeOwnershipTransferProposed")
+	proto.RegisterType((*EventScopeOwnershipTransferAccepted)(nil), "provenance.metadata.v1.EventScopeOwnershipTransferAccepted")
+	proto.RegisterType((*EventScopeOwnershipTransferCancelled)(nil), "provenance.metadata.v1.EventScopeOwnershipTransferCancelled")
+	proto.RegisterType((*EventScopeOwnershipTransferExpired)(nil), "provenance.metadata.v1.EventScopeOwnershipTransferExpired")
+	proto.RegisterType((*EventScopeDataAccessExpired)(nil), "provenance.metadata.v1.EventScopeDataAccessExpired")
+	proto.RegisterType((*EventScopeArchived)(nil), "provenance.metadata.v1.EventScopeArchived")
+	proto.RegisterType((*EventScopeSpecificationSuperseded)(nil), "provenance.metadata.v1.EventScopeSpecificationSuperseded")
+	proto.RegisterType((*EventScopeSpecMigrated)(nil), "provenance.metadata.v1.EventScopeSpecMigrated")
+}
+
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/events.proto", fileDescriptor_476cf6cf9459cf25)
+}
+
+var fileDescriptor_476cf6cf9459cf25 = []byte{
+	// 913 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0x25, 0x69, 0x9e, 0x7b, 0x28, 0x4b, 0x49, 0x9c, 0x44, 0x75, 0x9a, 0x6d, 0x0f,
+	0x3d, 0x50, 0x9b, 0x16, 0x0e, 0x08, 0x89, 0x4a, 0x8e, 0x53, 0xa9, 0x48, 0x94, 0x96, 0x38, 0x14,
+	0xa9, 0x17, 0x33, 0x9e, 0x7d, 0xf1, 0x8e, 0xba, 0xbb, 0xb3, 0x9a, 0x19, 0xbb, 0xf6, 0x9d, 0x1b,
+	0x17, 0x4e, 0xdc, 0x90, 0xf8, 0x1a, 0x7c, 0x03, 0x8e, 0x3d, 0x72, 0x44, 0xc9, 0x17, 0x41, 0x33,
+	0x3b, 0x13, 0xaf, 0x1b, 0xdb, 0x6b, 0x08, 0x85, 0x1e, 0xdf, 0xbf, 0xdf, 0xef, 0xf7, 0xde, 0x8c,
+	0x77, 0x9e, 0xe1, 0x76, 0x26, 0xf8, 0x10, 0x53, 0x92, 0x52, 0x6c, 0x26, 0xa8, 0x48, 0x48, 0x14,
+	0x69, 0x0e, 0xef, 0x37, 0x71, 0x88, 0xa9, 0x92, 0x8d, 0x4c, 0x70, 0xc5, 0xfd, 0xcd, 0x49, 0x52,
+	0xc3, 0x25, 0x35, 0x86, 0xf7, 0x77, 0x82, 0x39, 0xc5, 0x92, 0xf2, 0x0c, 0xf3, 0xda, 0xe0, 0x7b,
+	0xb8, 0xfe, 0x48, 0x63, 0x1d, 0x8f, 0xda, 0x3c, 0xc9, 0x62, 0x54, 0x18, 0xfa, 0x9b, 0xb0, 0x96,
+	0xf0, 0x70, 0x10, 0x63, 0xcd, 0xbb, 0xe5, 0xdd, 0xdd, 0x38, 0xb2, 0x96, 0xbf, 0x03, 0x57, 0x31,
+	0x0d, 0x33, 0xce, 0x52, 0x55, 0xab, 0x98, 0xc8, 0xb9, 0xed, 0xd7, 0x60, 0x5d, 0xb2, 0x7e, 0x8a,
+	0x42, 0xd6, 0x56, 0x6f, 0xad, 0xde, 0xdd, 0x38, 0x72, 0x66, 0xf0, 0x00, 0xde, 0x37, 0x0c, 0x1d,
+	0xcd, 0xda, 0x16, 0x48, 0x34, 0xc5, 0x4d, 0x00, 0xa3, 0xa2, 0x4b, 0xc2, 0x50, 0x58, 0x9a, 0x0d,
+	0xe3, 0x69, 0x85, 0xa1, 0x98, 0xae, 0xf9, 0x36, 0x0b, 0xff, 0x76, 0xcd, 0x21, 0xc6, 0xb8, 0x44,
+	0xcd, 0x77, 0xf0, 0x41, 0x5e, 0x83, 0x52, 0x32, 0x9e, 0x3a, 0x75, 0xfb, 0x70, 0x4d, 0xe6, 0x9e,
+	0x62, 0x5d, 0xd5, 0xfa, 0x74, 0xe5, 0x1b, 0xc0, 0x95, 0x12, 0x60, 0xd7, 0xc2, 0xbf, 0x0e, 0xec,
+	0xfa, 0xbc, 0x3c, 0xf0, 0x2b, 0xf0, 0x0d, 0xf0, 0x11, 0x52, 0x2e, 0x42, 0x37, 0x89, 0x3d, 0xa8,
+	0x0a, 0xe3, 0x28, 0xc2, 0x42, 0xee, 0x32, 0xa8, 0x6f, 0x12, 0x57, 0xca, 0x88, 0x57, 0x17, 0x13,
+	0xbb, 0x49, 0xfd, 0x07, 0xc4, 0xc7, 0x53, 0xc4, 0x6e, 0x92, 0xa5, 0xc4, 0x25, 0xa8, 0x2f, 0xa0,
+	0x3e, 0xb9, 0x86, 0x9d, 0x0c, 0x29, 0x3b, 0x61, 0x94, 0xa8, 0xc2, 0xed, 0xfa, 0x0c, 0x6a, 0x39,
+	0x80, 0x2c, 0x46, 0x8b, 0x74, 0x9b, 0xf2, 0x42, 0x71, 0x09, 0xb6, 0x1b, 0xdb, 0xdb, 0xc0, 0x76,
+	0x93, 0xf9, 0xe7, 0xd8, 0x14, 0xf6, 0x0d, 0x76, 0x9b, 0xa7, 0x4a, 0x10, 0xaa, 0x66, 0x8e, 0xe5,
+	0x21, 0xec, 0x52, 0x1b, 0x9f, 0xcf, 0xb0, 0x4d, 0x67, 0x41, 0x94, 0x93, 0xb8, 0xf9, 0xbc, 0x55,
+	0x12, 0x37, 0xa8, 0xcb, 0x92, 0xfc, 0xe2, 0xc1, 0x5e, 0xe1, 0x66, 0xce, 0x9c, 0xd6, 0xe7, 0xb0,
+	0x6d, 0xaf, 0xe9, 0x5c, 0x86, 0x2d, 0x71, 0xb1, 0xdc, 0xdc, 0xe0, 0x12, 0x7d, 0x95, 0xcb, 0xe8,
+	0x73, 0x83, 0x7e, 0x57, 0xf5, 0xb9, 0x33, 0xfa, 0x3f, 0xf5, 0xdd, 0x83, 0x0f, 0x8d, 0xbc, 0xa7,
+	0x9d, 0xaf, 0x38, 0x25, 0x8a, 0x0b, 0x77, 0xa8, 0x37, 0xe0, 0x3d, 0xfe, 0x2a, 0x45, 0x27, 0x20,
+	0x37, 0x2e, 0xa6, 0xbb, 0x19, 0x2f, 0x99, 0xee, 0x5a, 0x9e, 0x9d, 0x3e, 0xb2, 0xe9, 0x1d, 0x54,
+	0x5f, 0xa3, 0x6a, 0x49, 0x89, 0xea, 0x39, 0x89, 0x07, 0xe8, 0x6f, 0xc3, 0xd5, 0xfc, 0xe7, 0xce,
+	0x42, 0x5b, 0xb1, 0x6e, 0xec, 0x2f, 0x0d, 0x52, 0x26, 0x18, 0x45, 0xdb, 0x6a, 0x6e, 0xe8, 0xb5,
+	0x41, 0xf2, 0x81, 0xa0, 0x68, 0x3f, 0x8a, 0xd6, 0xd2, 0xfe, 0x21, 0x8f, 0x07, 0x09, 0xd6, 0xae,
+	0xe4, 0xfe, 0xdc, 0x0a, 0xbe, 0x81, 0xdd, 0x99, 0xcc, 0x4f, 0xc8, 0xa8, 0xd5, 0x5f, 0xc8, 0xbf,
+	0x05, 0xeb, 0x09, 0x19, 0x75, 0x49, 0xdf, 0x29, 0x58, 0x4b, 0x4c, 0x4d, 0xf0, 0xab, 0x07, 0x5b,
+	0x06, 0x73, 0x0a, 0xb0, 0xa3, 0x48, 0xbc, 0x10, 0x6f, 0x0f, 0xaa, 0xa6, 0x85, 0x6e, 0x88, 0x29,
+	0x4f, 0x2c, 0x26, 0x18, 0xd7, 0xa1, 0xf6, 0xf8, 0x1f, 0xc3, 0x8d, 0x41, 0x3e, 0xf4, 0x6e, 0x2f,
+	0xe6, 0xf4, 0x65, 0x37, 0x42, 0xd6, 0x8f, 0x94, 0x6d, 0xd4, 0xb7, 0xb1, 0x03, 0x1d, 0x7a, 0x6c,
+	0x22, 0x45, 0x89, 0x57, 0xa6, 0x24, 0xfe, 0xe0, 0xc1, 0xed, 0xc9, 0x87, 0xf6, 0xa9, 0x3e, 0x03,
+	0x19, 0xb1, 0xec, 0x58, 0x90, 0x54, 0x9e, 0xa0, 0x78, 0x26, 0x78, 0xc6, 0x65, 0xe9, 0xe6, 0xe2,
+	0xd7, 0x41, 0xbf, 0x49, 0x2c, 0x63, 0x7a, 0x0f, 0xac, 0x55, 0xcc, 0xca, 0x55, 0xf0, 0xe8, 0x38,
+	0x8e, 0x32, 0x26, 0xcc, 0xad, 0xb3, 0x3a, 0x0b, 0x9e, 0xe0, 0x70, 0xa1, 0x8a, 0x16, 0xa5, 0x98,
+	0x2d, 0xb1, 0x3f, 0x45, 0x70, 0x67, 0x01, 0x4a, 0x9b, 0xa4, 0x14, 0xe3, 0xb8, 0xbc, 0x99, 0x7d,
+	0xb8, 0x46, 0x5d, 0x6e, 0xb7, 0x37, 0xb6, 0xed, 0x54, 0xcf, 0x7d, 0x07, 0xe3, 0xa0, 0x0d, 0xc1,
+	0x02, 0xa6, 0x47, 0xba, 0xb1, 0x72, 0xb9, 0xcf, 0xdd, 0x8d, 0x33, 0x2b, 0x22, 0x51, 0x44, 0xf7,
+	0x29, 0xe5, 0x72, 0xd5, 0x7a, 0xc5, 0xd5, 0x01, 0x94, 0xd2, 0xde, 0x10, 0x67, 0x06, 0x3f, 0x7b,
+	0xe0, 0x4f, 0x80, 0x5b, 0x82, 0x46, 0x6c, 0xb8, 0x54, 0xd7, 0x24, 0x4f, 0xed, 0x46, 0x44, 0x46,
+	0x6e, 0x83, 0xb1, 0xbe, 0xc7, 0x44, 0x46, 0xfe, 0x43, 0x58, 0xb7, 0xa6, 0x39, 0xc2, 0xea, 0x83,
+	0x3b, 0x8d, 0xd9, 0xbb, 0x7e, 0xa3, 0xc8, 0x7c, 0xe4, 0x8a, 0x82, 0x1f, 0x3d, 0xfb, 0x5e, 0x5d,
+	0x7c, 0xd5, 0x3b, 0x83, 0x0c, 0x85, 0xc4, 0xf0, 0x32, 0x0f, 0xbb, 0xff, 0x11, 0xf8, 0xf2, 0x1c,
+	0xa7, 0xdb, 0x1b, 0x17, 0x3f, 0x80, 0xd7, 0x27, 0x91, 0x83, 0xb1, 0x19, 0xff, 0x6f, 0x1e, 0x6c,
+	0x4e, 0xab, 0x79, 0xc2, 0xfa, 0x62, 0x89, 0xdd, 0xde, 0x6f, 0xc1, 0xcd, 0x13, 0xc1, 0x93, 0xee,
+	0x5c, 0x99, 0x39, 0xe5, 0x8e, 0x4e, 0xea, 0xcc, 0x96, 0xfa, 0x05, 0xec, 0x2a, 0x3e, 0x1f, 0x20,
+	0xff, 0x85, 0xd4, 0x14, 0x9f, 0x5d, 0x7e, 0xf0, 0xf2, 0xf7, 0xd3, 0xba, 0xf7, 0xfa, 0xb4, 0xee,
+	0xfd, 0x79, 0x5a, 0xf7, 0x7e, 0x3a, 0xab, 0xaf, 0xbc, 0x3e, 0xab, 0xaf, 0xfc, 0x71, 0x56, 0x5f,
+	0x81, 0x6d, 0xc6, 0xe7, 0x1c, 0xca, 0x33, 0xef, 0xc5, 0xa7, 0x7d, 0xa6, 0xa2, 0x41, 0xaf, 0x41,
+	0x79, 0xd2, 0x9c, 0x24, 0xdd, 0x63, 0xbc, 0x60, 0x35, 0x47, 0x93, 0x7f, 0x67, 0x6a, 0x9c, 0xa1,
+	0xec, 0xad, 0x99, 0xff, 0x66, 0x9f, 0xfc, 0x35, 0x00, 0xd1, 0xda, 0xb1, 0xb3, 0xfe, 0x0d, 0x00,
+	0x00,
+}
+
+func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1744,27 +1919,43 @@ func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventScopeSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventTxCompleted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventScopeSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventTxCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Module)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1774,27 +1965,27 @@ func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventContractSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeCreated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventContractSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1804,27 +1995,27 @@ func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventContractSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventContractSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventContractSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeDeleted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1834,27 +2025,27 @@ func (m *EventContractSpecificationDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventContractSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeDeleted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventContractSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationCreated) Marshal() (dAtA []byte, err error) {
+func (m *EventSessionCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1864,34 +2055,34 @@ func (m *EventRecordSpecificationCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventRecordSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSessionCreated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRecordSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSessionCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.RecordSpecificationAddr) > 0 {
-		i -= len(m.RecordSpecificationAddr)
-		copy(dAtA[i:], m.RecordSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventSessionUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1901,34 +2092,34 @@ func (m *EventRecordSpecificationUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventRecordSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSessionUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRecordSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSessionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordSpecificationAddr) > 0 {
-		i -= len(m.RecordSpecificationAddr)
-		copy(dAtA[i:], m.RecordSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+func (m *EventSessionDeleted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1938,34 +2129,34 @@ func (m *EventRecordSpecificationDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventRecordSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSessionDeleted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRecordSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSessionDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.RecordSpecificationAddr) > 0 {
-		i -= len(m.RecordSpecificationAddr)
-		copy(dAtA[i:], m.RecordSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorCreated) Marshal() (dAtA []byte, err error) {
+func (m *EventRecordCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1975,27 +2166,41 @@ func (m *EventOSLocatorCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOSLocatorCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRecordCreated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOSLocatorCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRecordCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventRecordUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2005,27 +2210,41 @@ func (m *EventOSLocatorUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOSLocatorUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRecordUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOSLocatorUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRecordUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorDeleted) Marshal() (dAtA []byte, err error) {
+func (m *EventRecordDeleted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2035,27 +2254,34 @@ func (m *EventOSLocatorDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOSLocatorDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRecordDeleted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOSLocatorDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRecordDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSetNetAssetValue) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2065,430 +2291,2537 @@ func (m *EventSetNetAssetValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSetNetAssetValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSetNetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Volume) > 0 {
-		i -= len(m.Volume)
-		copy(dAtA[i:], m.Volume)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Volume)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeId)))
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventTxCompleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Module)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Endpoint)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
-		}
-	}
-	return n
+
+func (m *EventScopeSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventScopeCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventScopeSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventSessionCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventContractSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSessionUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSessionDeleted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventContractSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventRecordSpecificationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.RecordSpecificationAddr) > 0 {
+		i -= len(m.RecordSpecificationAddr)
+		copy(dAtA[i:], m.RecordSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventRecordSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.RecordSpecificationAddr) > 0 {
+		i -= len(m.RecordSpecificationAddr)
+		copy(dAtA[i:], m.RecordSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordDeleted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventRecordSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.RecordSpecificationAddr) > 0 {
+		i -= len(m.RecordSpecificationAddr)
+		copy(dAtA[i:], m.RecordSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeSpecificationCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventOSLocatorCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOSLocatorCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOSLocatorCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeSpecificationUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventOSLocatorUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventScopeSpecificationDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventOSLocatorUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventContractSpecificationCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventOSLocatorUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventContractSpecificationUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventOSLocatorDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOSLocatorDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOSLocatorDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventContractSpecificationDeleted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetNetAssetValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetNetAssetValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetNetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Volume) > 0 {
+		i -= len(m.Volume)
+		copy(dAtA[i:], m.Volume)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Volume)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetNetAssetValueMaxAge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetNetAssetValueMaxAge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetNetAssetValueMaxAge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.MaxAge) > 0 {
+		i -= len(m.MaxAge)
+		copy(dAtA[i:], m.MaxAge)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MaxAge)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventNetAssetValueStale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNetAssetValueStale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNetAssetValueStale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.MaxAge) > 0 {
+		i -= len(m.MaxAge)
+		copy(dAtA[i:], m.MaxAge)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MaxAge)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.UpdatedBlockHeight) > 0 {
+		i -= len(m.UpdatedBlockHeight)
+		copy(dAtA[i:], m.UpdatedBlockHeight)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBlockHeight)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationDeleted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventScopeOwnershipTransferProposed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeOwnershipTransferProposed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeOwnershipTransferProposed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Recipients[iNdEx])
+			copy(dAtA[i:], m.Recipients[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipients[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventScopeOwnershipTransferAccepted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeOwnershipTransferAccepted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeOwnershipTransferAccepted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeOwnershipTransferCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeOwnershipTransferCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeOwnershipTransferCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelledBy) > 0 {
+		for iNdEx := len(m.CancelledBy) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CancelledBy[iNdEx])
+			copy(dAtA[i:], m.CancelledBy[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.CancelledBy[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeOwnershipTransferExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeOwnershipTransferExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeOwnershipTransferExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeDataAccessExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeDataAccessExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeDataAccessExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeArchived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeArchived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeArchived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Archive != nil {
+		{
+			size, err := m.Archive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ArchiveHash) > 0 {
+		i -= len(m.ArchiveHash)
+		copy(dAtA[i:], m.ArchiveHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ArchiveHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecificationSuperseded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationSuperseded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationSuperseded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SupersededByAddr) > 0 {
+		i -= len(m.SupersededByAddr)
+		copy(dAtA[i:], m.SupersededByAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SupersededByAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecMigrated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecMigrated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecMigrated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToScopeSpecificationAddr) > 0 {
+		i -= len(m.ToScopeSpecificationAddr)
+		copy(dAtA[i:], m.ToScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromScopeSpecificationAddr) > 0 {
+		i -= len(m.FromScopeSpecificationAddr)
+		copy(dAtA[i:], m.FromScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FromScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventTxCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventScopeCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSessionCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSessionUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSessionDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecificationCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecificationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecificationDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractSpecificationCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractSpecificationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractSpecificationDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetNetAssetValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Volume)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetNetAssetValueMaxAge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MaxAge)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNetAssetValueStale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.UpdatedBlockHeight)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MaxAge)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeOwnershipTransferProposed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, s := range m.Recipients {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeOwnershipTransferAccepted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeOwnershipTransferCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.CancelledBy) > 0 {
+		for _, s := range m.CancelledBy {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventScopeOwnershipTransferExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeDataAccessExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeArchived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ArchiveHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Archive != nil {
+		l = m.Archive.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecificationSuperseded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SupersededByAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecMigrated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FromScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ToScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTxCompleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTxCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSessionCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSessionCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSessionUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSessionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSessionDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSessionDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventOSLocatorUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *EventOSLocatorDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventSetNetAssetValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Volume)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
+func (m *EventScopeSpecificationCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2511,15 +4844,15 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTxCompleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeSpecificationCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTxCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2547,11 +4880,61 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Module = string(dAtA[iNdEx:postIndex])
+			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeSpecificationUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeSpecificationUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2579,11 +4962,61 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeSpecificationDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeSpecificationDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2611,7 +5044,7 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2634,7 +5067,7 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
+func (m *EventContractSpecificationCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2657,15 +5090,15 @@ func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractSpecificationCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2693,7 +5126,7 @@ func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2716,7 +5149,7 @@ func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventContractSpecificationUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2739,15 +5172,15 @@ func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractSpecificationUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2775,7 +5208,7 @@ func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2798,7 +5231,7 @@ func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventContractSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2821,15 +5254,15 @@ func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractSpecificationDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2857,7 +5290,7 @@ func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2880,7 +5313,7 @@ func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
+func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2903,15 +5336,15 @@ func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSessionCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordSpecificationCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSessionCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2939,11 +5372,11 @@ func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2971,7 +5404,7 @@ func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2994,7 +5427,7 @@ func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3017,15 +5450,15 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSessionUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordSpecificationUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSessionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3053,11 +5486,11 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3085,7 +5518,7 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3108,7 +5541,7 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3131,15 +5564,15 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSessionDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordSpecificationDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSessionDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3167,11 +5600,11 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3199,7 +5632,7 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3222,7 +5655,7 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
+func (m *EventOSLocatorCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3245,15 +5678,15 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOSLocatorCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOSLocatorCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3281,11 +5714,61 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOSLocatorUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOSLocatorUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOSLocatorUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3313,11 +5796,61 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOSLocatorDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOSLocatorDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOSLocatorDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3345,7 +5878,7 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3368,7 +5901,7 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3391,15 +5924,15 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetNetAssetValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetNetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3427,11 +5960,11 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3459,11 +5992,11 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3491,7 +6024,39 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3514,7 +6079,7 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventSetNetAssetValueMaxAge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3537,15 +6102,15 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetNetAssetValueMaxAge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetNetAssetValueMaxAge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3573,11 +6138,11 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3605,7 +6170,7 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.MaxAge = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3628,7 +6193,7 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeSpecificationCreated) Unmarshal(dAtA []byte) error {
+func (m *EventNetAssetValueStale) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3651,15 +6216,15 @@ func (m *EventScopeSpecificationCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeSpecificationCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNetAssetValueStale: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNetAssetValueStale: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3687,61 +6252,11 @@ func (m *EventScopeSpecificationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventScopeSpecificationUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeSpecificationUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3769,61 +6284,43 @@ func (m *EventScopeSpecificationUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventScopeSpecificationDeleted) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeSpecificationDeleted: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.UpdatedBlockHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3851,7 +6348,7 @@ func (m *EventScopeSpecificationDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.MaxAge = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3874,7 +6371,7 @@ func (m *EventScopeSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventContractSpecificationCreated) Unmarshal(dAtA []byte) error {
+func (m *EventScopeOwnershipTransferProposed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3897,15 +6394,15 @@ func (m *EventContractSpecificationCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventContractSpecificationCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeOwnershipTransferProposed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContractSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeOwnershipTransferProposed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3933,61 +6430,43 @@ func (m *EventContractSpecificationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventContractSpecificationUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventContractSpecificationUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContractSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Recipients = append(m.Recipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4015,7 +6494,7 @@ func (m *EventContractSpecificationUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.Expiration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4038,7 +6517,7 @@ func (m *EventContractSpecificationUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventContractSpecificationDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventScopeOwnershipTransferAccepted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4061,15 +6540,15 @@ func (m *EventContractSpecificationDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventContractSpecificationDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeOwnershipTransferAccepted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContractSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeOwnershipTransferAccepted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4097,7 +6576,7 @@ func (m *EventContractSpecificationDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4120,7 +6599,7 @@ func (m *EventContractSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
+func (m *EventScopeOwnershipTransferCancelled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4143,15 +6622,15 @@ func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordSpecificationCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeOwnershipTransferCancelled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeOwnershipTransferCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4179,11 +6658,11 @@ func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4211,7 +6690,7 @@ func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.CancelledBy = append(m.CancelledBy, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4234,7 +6713,7 @@ func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventScopeOwnershipTransferExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4257,47 +6736,15 @@ func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordSpecificationUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeOwnershipTransferExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeOwnershipTransferExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4325,7 +6772,7 @@ func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4348,7 +6795,7 @@ func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventScopeDataAccessExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4371,15 +6818,15 @@ func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordSpecificationDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeDataAccessExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeDataAccessExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4407,11 +6854,11 @@ func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4439,7 +6886,7 @@ func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4462,7 +6909,7 @@ func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOSLocatorCreated) Unmarshal(dAtA []byte) error {
+func (m *EventScopeArchived) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4485,15 +6932,15 @@ func (m *EventOSLocatorCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOSLocatorCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeArchived: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOSLocatorCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeArchived: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4521,63 +6968,45 @@ func (m *EventOSLocatorCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveHash", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventOSLocatorUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventOSLocatorUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOSLocatorUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ArchiveHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4587,23 +7016,27 @@ func (m *EventOSLocatorUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if m.Archive == nil {
+				m.Archive = &ScopeArchive{}
+			}
+			if err := m.Archive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4626,7 +7059,7 @@ func (m *EventOSLocatorUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOSLocatorDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventScopeSpecificationSuperseded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4649,15 +7082,15 @@ func (m *EventOSLocatorDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOSLocatorDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeSpecificationSuperseded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOSLocatorDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeSpecificationSuperseded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4685,7 +7118,39 @@ func (m *EventOSLocatorDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededByAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersededByAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4708,7 +7173,7 @@ func (m *EventOSLocatorDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
+func (m *EventScopeSpecMigrated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4731,15 +7196,15 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetNetAssetValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeSpecMigrated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetNetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeSpecMigrated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4767,11 +7232,11 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromScopeSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4799,43 +7264,11 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.FromScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToScopeSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4863,7 +7296,7 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volume = string(dAtA[iNdEx:postIndex])
+			m.ToScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
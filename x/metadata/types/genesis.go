@@ -1,7 +1,44 @@
 package types
 
+import "fmt"
+
 // Validate ensures the genesis state is valid.
 func (state GenesisState) Validate() error {
+	for i, scopeVersion := range state.ScopeVersions {
+		if err := scopeVersion.Validate(); err != nil {
+			return fmt.Errorf("scope_versions[%d]: %w", i, err)
+		}
+	}
+	for i, sessionVersion := range state.SessionVersions {
+		if err := sessionVersion.Validate(); err != nil {
+			return fmt.Errorf("session_versions[%d]: %w", i, err)
+		}
+	}
+	for i, recordVersion := range state.RecordVersions {
+		if err := recordVersion.Validate(); err != nil {
+			return fmt.Errorf("record_versions[%d]: %w", i, err)
+		}
+	}
+	for i, transfer := range state.ScopeOwnershipTransfers {
+		if err := transfer.Validate(); err != nil {
+			return fmt.Errorf("scope_ownership_transfers[%d]: %w", i, err)
+		}
+	}
+	for i, heights := range state.ScopeHeights {
+		if err := heights.Validate(); err != nil {
+			return fmt.Errorf("scope_heights[%d]: %w", i, err)
+		}
+	}
+	for i, grant := range state.ScopeDataAccessGrants {
+		if err := grant.Validate(); err != nil {
+			return fmt.Errorf("scope_data_access_grants[%d]: %w", i, err)
+		}
+	}
+	for i, tombstone := range state.ScopeTombstones {
+		if err := tombstone.Validate(); err != nil {
+			return fmt.Errorf("scope_tombstones[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
 
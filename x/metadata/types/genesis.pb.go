@@ -38,6 +38,18 @@ type GenesisState struct {
 	ObjectStoreLocators    []ObjectStoreLocator    `protobuf:"bytes,9,rep,name=object_store_locators,json=objectStoreLocators,proto3" json:"object_store_locators"`
 	// Net asset values assigned to scopes
 	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,10,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+	// The prior versions of scopes, sessions, and records
+	ScopeVersions   []ScopeVersion   `protobuf:"bytes,11,rep,name=scope_versions,json=scopeVersions,proto3" json:"scope_versions"`
+	SessionVersions []SessionVersion `protobuf:"bytes,12,rep,name=session_versions,json=sessionVersions,proto3" json:"session_versions"`
+	RecordVersions  []RecordVersion  `protobuf:"bytes,13,rep,name=record_versions,json=recordVersions,proto3" json:"record_versions"`
+	// scope_ownership_transfers are the pending scope ownership transfers.
+	ScopeOwnershipTransfers []ScopeOwnershipTransfer `protobuf:"bytes,14,rep,name=scope_ownership_transfers,json=scopeOwnershipTransfers,proto3" json:"scope_ownership_transfers"`
+	// scope_heights are the block heights at which scopes were created and last updated.
+	ScopeHeights []ScopeHeights `protobuf:"bytes,15,rep,name=scope_heights,json=scopeHeights,proto3" json:"scope_heights"`
+	// scope_data_access_grants are the expirations of data access to scopes.
+	ScopeDataAccessGrants []ScopeDataAccessGrant `protobuf:"bytes,16,rep,name=scope_data_access_grants,json=scopeDataAccessGrants,proto3" json:"scope_data_access_grants"`
+	// scope_tombstones are the records of archived scopes.
+	ScopeTombstones []ScopeTombstone `protobuf:"bytes,17,rep,name=scope_tombstones,json=scopeTombstones,proto3" json:"scope_tombstones"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +91,10 @@ type MarkerNetAssetValues struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// net_asset_values that are assigned to scope
 	NetAssetValues []NetAssetValue `protobuf:"bytes,2,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+	// history is the recorded history of the scope's net asset values
+	History []NetAssetValueRecord `protobuf:"bytes,3,rep,name=history,proto3" json:"history"`
+	// max_age is the number of blocks after which the scope's net asset values are stale. Zero means they never are.
+	MaxAge uint64 `protobuf:"varint,4,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
 }
 
 func (m *MarkerNetAssetValues) Reset()         { *m = MarkerNetAssetValues{} }
@@ -124,42 +140,56 @@ func init() {
 }
 
 var fileDescriptor_a835c20198efc302 = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x6d, 0x5a, 0x92, 0x74, 0x8b, 0x00, 0x2d, 0x69, 0x31, 0x95, 0x70, 0xaa, 0x88, 0x8a,
-	0xa8, 0x50, 0x5b, 0x2d, 0x9c, 0x00, 0x21, 0xb5, 0x1c, 0xb8, 0x00, 0xad, 0x12, 0xc1, 0xa1, 0x42,
-	0xb2, 0x36, 0x9b, 0x6d, 0x30, 0x4d, 0x3c, 0xd6, 0xce, 0x36, 0x82, 0x37, 0xe0, 0x08, 0x6f, 0xd0,
-	0xc7, 0xe9, 0xb1, 0x47, 0x4e, 0x08, 0x25, 0x17, 0xae, 0xbc, 0x01, 0xca, 0x7a, 0xdd, 0x34, 0x8d,
-	0x37, 0xdc, 0x6c, 0xcf, 0xff, 0xfd, 0xff, 0x8c, 0x3d, 0x5e, 0xf2, 0x20, 0x95, 0x30, 0x10, 0x09,
-	0x4b, 0xb8, 0x08, 0xfb, 0x42, 0xb1, 0x0e, 0x53, 0x2c, 0x1c, 0x6c, 0x87, 0x5d, 0x91, 0x08, 0x8c,
-	0x31, 0x48, 0x25, 0x28, 0xa0, 0xab, 0x13, 0x55, 0x90, 0xab, 0x82, 0xc1, 0xf6, 0x5a, 0xb5, 0x0b,
-	0x5d, 0xd0, 0x92, 0x70, 0x7c, 0x95, 0xa9, 0xd7, 0x36, 0x2c, 0x9e, 0x17, 0x64, 0x26, 0xab, 0x5b,
-	0x64, 0xc8, 0x21, 0x15, 0x46, 0xb3, 0x69, 0xd3, 0xa4, 0x82, 0xc7, 0x47, 0x31, 0x67, 0x2a, 0x86,
-	0xc4, 0x68, 0x1b, 0x16, 0x2d, 0xb4, 0x3f, 0x0b, 0xae, 0x50, 0x81, 0x34, 0xae, 0xf5, 0xbf, 0x25,
-	0x72, 0xe3, 0x75, 0x36, 0x60, 0x4b, 0x31, 0x25, 0xe8, 0x0b, 0x52, 0x4a, 0x99, 0x64, 0x7d, 0xf4,
-	0xdc, 0x75, 0xb7, 0xb1, 0xbc, 0xe3, 0x07, 0xc5, 0x03, 0x07, 0x07, 0x5a, 0xb5, 0xb7, 0x78, 0xf6,
-	0xab, 0xe6, 0x34, 0x0d, 0x43, 0x9f, 0x93, 0x92, 0xee, 0x19, 0xbd, 0x6b, 0xeb, 0x0b, 0x8d, 0xe5,
-	0x9d, 0xfb, 0x36, 0xba, 0x35, 0x56, 0xe5, 0x70, 0x86, 0xd0, 0x5d, 0x52, 0x41, 0x81, 0x18, 0x43,
-	0x82, 0xde, 0x82, 0xc6, 0x6b, 0x56, 0x3c, 0xd3, 0x19, 0x83, 0x0b, 0x8c, 0xbe, 0x24, 0x65, 0x29,
-	0x38, 0xc8, 0x0e, 0x7a, 0x8b, 0xda, 0xc1, 0xda, 0x7e, 0x53, 0xcb, 0x8c, 0x41, 0x0e, 0x51, 0x4e,
-	0xaa, 0xba, 0x99, 0x68, 0xea, 0xad, 0xa2, 0x77, 0x5d, 0x9b, 0x6d, 0xce, 0x9d, 0xa6, 0x75, 0x19,
-	0x31, 0xc6, 0x77, 0x70, 0xa6, 0x82, 0xb4, 0x47, 0xee, 0x72, 0x48, 0x94, 0x64, 0x5c, 0x5d, 0xcd,
-	0x29, 0xe9, 0x9c, 0x2d, 0x5b, 0xce, 0x2b, 0x83, 0x15, 0x45, 0xad, 0xf2, 0xa2, 0x22, 0xd2, 0x23,
-	0xb2, 0x92, 0x4d, 0x77, 0x35, 0xab, 0xac, 0xb3, 0x1e, 0xcd, 0x7f, 0x41, 0x45, 0x49, 0x55, 0x39,
-	0x5b, 0x42, 0x7a, 0x48, 0x28, 0x44, 0x18, 0xf5, 0x80, 0x33, 0x05, 0x32, 0x32, 0x4b, 0x54, 0xd1,
-	0x4b, 0xf4, 0xd0, 0x16, 0xb2, 0xdf, 0x7a, 0x93, 0xe9, 0xa7, 0xb6, 0xe9, 0x16, 0x4c, 0x3f, 0xa6,
-	0x1d, 0xb2, 0x92, 0xad, 0x6e, 0xa4, 0x77, 0x37, 0x0f, 0x41, 0x6f, 0x69, 0xfe, 0x77, 0xd9, 0xd7,
-	0x50, 0x6b, 0xcc, 0x18, 0xc3, 0xfc, 0xbb, 0xc0, 0x4c, 0x05, 0xe9, 0x47, 0x72, 0x3b, 0x11, 0x2a,
-	0x62, 0x88, 0x42, 0x45, 0x03, 0xd6, 0x3b, 0x11, 0xe8, 0x11, 0x1d, 0xf0, 0xd8, 0x16, 0xf0, 0x96,
-	0xc9, 0x63, 0x21, 0xdf, 0x09, 0xb5, 0x3b, 0x86, 0x3e, 0x68, 0xc6, 0x44, 0xdc, 0x4c, 0xa6, 0x9e,
-	0x3e, 0xab, 0x7c, 0x3b, 0xad, 0x39, 0x7f, 0x4e, 0x6b, 0x4e, 0xfd, 0x87, 0x4b, 0xaa, 0x45, 0x20,
-	0xf5, 0x48, 0x99, 0x75, 0x3a, 0x52, 0x60, 0xf6, 0xf3, 0x2d, 0x35, 0xf3, 0x5b, 0xfa, 0xbe, 0xa0,
-	0xb5, 0xec, 0x0f, 0xdb, 0xb0, 0xb5, 0x36, 0xe5, 0xfd, 0xbf, 0x9e, 0xf6, 0x8e, 0xcf, 0x86, 0xbe,
-	0x7b, 0x3e, 0xf4, 0xdd, 0xdf, 0x43, 0xdf, 0xfd, 0x3e, 0xf2, 0x9d, 0xf3, 0x91, 0xef, 0xfc, 0x1c,
-	0xf9, 0x0e, 0xb9, 0x17, 0x83, 0x25, 0xe2, 0xc0, 0x3d, 0x7c, 0xda, 0x8d, 0xd5, 0xa7, 0x93, 0x76,
-	0xc0, 0xa1, 0x1f, 0x4e, 0x44, 0x5b, 0x31, 0x5c, 0xba, 0x0b, 0xbf, 0x4c, 0xce, 0x20, 0xf5, 0x35,
-	0x15, 0xd8, 0x2e, 0xe9, 0xb3, 0xe7, 0xc9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x60, 0x12,
-	0x92, 0x72, 0x05, 0x00, 0x00,
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x4e, 0xe3, 0x46,
+	0x18, 0x8f, 0x49, 0x9a, 0xc0, 0x04, 0x12, 0x3a, 0x0d, 0x60, 0x90, 0x9a, 0x44, 0x08, 0xda, 0x88,
+	0x96, 0x44, 0xd0, 0x9e, 0xda, 0xaa, 0x52, 0x68, 0x25, 0x2a, 0xf5, 0x4f, 0x68, 0x42, 0xa9, 0x84,
+	0x2a, 0x59, 0x13, 0x67, 0x70, 0x5c, 0x88, 0xc7, 0x9a, 0x6f, 0xc8, 0xc2, 0x1b, 0xec, 0x71, 0x1f,
+	0x81, 0xc7, 0xe1, 0xc8, 0x71, 0x4f, 0xab, 0x15, 0x5c, 0xf6, 0x0d, 0xf6, 0xb2, 0x87, 0x95, 0x67,
+	0xc6, 0x49, 0x0c, 0xb6, 0xd9, 0x5b, 0xf2, 0x7d, 0xbf, 0x3f, 0x33, 0xdf, 0xfc, 0xc6, 0x83, 0xb6,
+	0x7c, 0xce, 0xc6, 0xd4, 0x23, 0x9e, 0x4d, 0x5b, 0x23, 0x2a, 0xc8, 0x80, 0x08, 0xd2, 0x1a, 0xef,
+	0xb5, 0x1c, 0xea, 0x51, 0x70, 0xa1, 0xe9, 0x73, 0x26, 0x18, 0x5e, 0x9d, 0xa2, 0x9a, 0x21, 0xaa,
+	0x39, 0xde, 0xdb, 0xa8, 0x38, 0xcc, 0x61, 0x12, 0xd2, 0x0a, 0x7e, 0x29, 0xf4, 0xc6, 0x76, 0x82,
+	0xe6, 0x84, 0xa9, 0x60, 0x9b, 0x09, 0x30, 0xb0, 0x99, 0x4f, 0x35, 0x66, 0x27, 0x09, 0xe3, 0x53,
+	0xdb, 0x3d, 0x73, 0x6d, 0x22, 0x5c, 0xe6, 0x69, 0x6c, 0x23, 0x01, 0xcb, 0xfa, 0xff, 0x53, 0x5b,
+	0x80, 0x60, 0x5c, 0xab, 0x6e, 0x7e, 0x28, 0xa2, 0xc5, 0x43, 0xb5, 0xc1, 0x9e, 0x20, 0x82, 0xe2,
+	0x9f, 0x50, 0xde, 0x27, 0x9c, 0x8c, 0xc0, 0x34, 0xea, 0x46, 0xa3, 0xb8, 0x5f, 0x6d, 0xc6, 0x6f,
+	0xb8, 0x79, 0x24, 0x51, 0x07, 0xb9, 0xdb, 0x37, 0xb5, 0x4c, 0x57, 0x73, 0xf0, 0x8f, 0x28, 0x2f,
+	0xd7, 0x0c, 0xe6, 0x5c, 0x3d, 0xdb, 0x28, 0xee, 0x7f, 0x99, 0xc4, 0xee, 0x05, 0xa8, 0x90, 0xac,
+	0x28, 0xb8, 0x8d, 0xe6, 0x81, 0x02, 0xb8, 0xcc, 0x03, 0x33, 0x2b, 0xe9, 0xb5, 0x44, 0xba, 0xc2,
+	0x69, 0x81, 0x09, 0x0d, 0xff, 0x8c, 0x0a, 0x9c, 0xda, 0x8c, 0x0f, 0xc0, 0xcc, 0xd5, 0xb3, 0x69,
+	0xcb, 0xef, 0x4a, 0x98, 0x16, 0x08, 0x49, 0xd8, 0x46, 0x15, 0xb9, 0x18, 0x2b, 0x32, 0x55, 0x30,
+	0x3f, 0x93, 0x62, 0x3b, 0xa9, 0xbb, 0xe9, 0xcd, 0x52, 0xb4, 0xf0, 0x17, 0xf0, 0xa4, 0x03, 0xf8,
+	0x02, 0xad, 0xd9, 0xcc, 0x13, 0x9c, 0xd8, 0xe2, 0xb1, 0x4f, 0x5e, 0xfa, 0xec, 0x26, 0xf9, 0xfc,
+	0xa2, 0x69, 0x71, 0x56, 0xab, 0x76, 0x5c, 0x13, 0xf0, 0x19, 0x5a, 0x51, 0xbb, 0x7b, 0xec, 0x55,
+	0x90, 0x5e, 0xdf, 0xa4, 0x0f, 0x28, 0xce, 0xa9, 0xc2, 0x9f, 0xb6, 0x00, 0x9f, 0x22, 0xcc, 0x2c,
+	0xb0, 0x2e, 0x98, 0x4d, 0x04, 0xe3, 0x96, 0x0e, 0xd1, 0xbc, 0x0c, 0xd1, 0xd7, 0x49, 0x26, 0x9d,
+	0xde, 0x1f, 0x0a, 0x1f, 0x49, 0x53, 0x99, 0x45, 0xcb, 0x78, 0x80, 0x56, 0x54, 0x74, 0x2d, 0x99,
+	0xdd, 0xd0, 0x04, 0xcc, 0x85, 0xf4, 0x73, 0xe9, 0x48, 0x52, 0x2f, 0xe0, 0x68, 0xc1, 0xf0, 0x5c,
+	0xd8, 0x93, 0x0e, 0xe0, 0xff, 0xd0, 0xb2, 0x47, 0x85, 0x45, 0x00, 0xa8, 0xb0, 0xc6, 0xe4, 0xe2,
+	0x92, 0x82, 0x89, 0xa4, 0xc1, 0xb7, 0x49, 0x06, 0x7f, 0x12, 0x7e, 0x4e, 0xf9, 0x5f, 0x54, 0xb4,
+	0x03, 0xd2, 0x89, 0xe4, 0x68, 0x8b, 0x92, 0x17, 0xa9, 0xe2, 0xbf, 0x51, 0x49, 0x45, 0x6b, 0x4c,
+	0xb9, 0xca, 0x78, 0x51, 0x6a, 0x6f, 0xa5, 0x86, 0xea, 0x44, 0x81, 0xb5, 0xe6, 0x12, 0xcc, 0xd4,
+	0x00, 0xff, 0x8b, 0x96, 0x75, 0xf2, 0xa7, 0xa2, 0x8b, 0x52, 0xf4, 0xab, 0x67, 0x2e, 0x4e, 0x54,
+	0xb6, 0x0c, 0x91, 0x2a, 0xe0, 0x63, 0x54, 0xd6, 0x99, 0x99, 0xe8, 0x2e, 0x49, 0xdd, 0xed, 0xf4,
+	0xb4, 0x44, 0x65, 0x4b, 0x7c, 0xb6, 0x08, 0xd8, 0x47, 0xeb, 0x6a, 0x02, 0xec, 0x85, 0x47, 0x39,
+	0x0c, 0x5d, 0xdf, 0x12, 0x9c, 0x78, 0x70, 0x46, 0x39, 0x98, 0x25, 0xa9, 0xdf, 0x4c, 0x1d, 0x46,
+	0x27, 0xe4, 0x1d, 0x6b, 0x9a, 0x36, 0x5a, 0x83, 0xd8, 0x2e, 0xe0, 0x0e, 0x52, 0x13, 0xb3, 0x86,
+	0xd4, 0x75, 0x86, 0x02, 0xcc, 0xf2, 0x27, 0x8c, 0xfc, 0x37, 0x85, 0xd5, 0xda, 0x8b, 0x30, 0x53,
+	0xc3, 0xe7, 0xc8, 0x54, 0x82, 0x01, 0xc3, 0x22, 0xb6, 0x4d, 0x01, 0x2c, 0x87, 0x13, 0x4f, 0x80,
+	0xb9, 0x9c, 0x1e, 0x15, 0xa9, 0xfd, 0x2b, 0x11, 0xa4, 0x2d, 0x59, 0x87, 0x01, 0x49, 0x7b, 0xac,
+	0x40, 0x4c, 0x4f, 0x1d, 0xaf, 0x34, 0x13, 0x6c, 0xd4, 0x07, 0xc1, 0x3c, 0x0a, 0xe6, 0xe7, 0xcf,
+	0x1c, 0x6f, 0x80, 0x3f, 0x0e, 0xe1, 0x93, 0xe3, 0x8d, 0x54, 0xe1, 0x87, 0xf9, 0x97, 0x37, 0xb5,
+	0xcc, 0xbb, 0x9b, 0x5a, 0x66, 0xf3, 0xbd, 0x81, 0x2a, 0x71, 0x19, 0xc6, 0x26, 0x2a, 0x90, 0xc1,
+	0x80, 0x53, 0x50, 0xef, 0xc0, 0x42, 0x37, 0xfc, 0x8b, 0xff, 0x89, 0xb9, 0x25, 0x73, 0xe9, 0xe1,
+	0x88, 0x68, 0x27, 0x5c, 0x8f, 0xdf, 0x51, 0x61, 0xe8, 0x06, 0xb7, 0xfb, 0xda, 0xcc, 0xa6, 0x7f,
+	0x98, 0x22, 0x6a, 0xd1, 0xcf, 0xb8, 0x56, 0xc0, 0x6b, 0xa8, 0x30, 0x22, 0x57, 0x16, 0x71, 0xa8,
+	0x99, 0xab, 0x1b, 0x8d, 0x5c, 0x37, 0x3f, 0x22, 0x57, 0x6d, 0x87, 0x4e, 0x77, 0x7e, 0x70, 0x7e,
+	0x7b, 0x5f, 0x35, 0xee, 0xee, 0xab, 0xc6, 0xdb, 0xfb, 0xaa, 0xf1, 0xea, 0xa1, 0x9a, 0xb9, 0x7b,
+	0xa8, 0x66, 0x5e, 0x3f, 0x54, 0x33, 0x68, 0xdd, 0x65, 0x09, 0xd6, 0x47, 0xc6, 0xe9, 0xf7, 0x8e,
+	0x2b, 0x86, 0x97, 0xfd, 0xa6, 0xcd, 0x46, 0xad, 0x29, 0x68, 0xd7, 0x65, 0x33, 0xff, 0x5a, 0x57,
+	0xd3, 0x47, 0x57, 0x5c, 0xfb, 0x14, 0xfa, 0x79, 0xf9, 0xd8, 0x7e, 0xf7, 0x71, 0x00, 0x6e, 0x71,
+	0xde, 0xd1, 0x63, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -182,6 +212,108 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScopeTombstones) > 0 {
+		for iNdEx := len(m.ScopeTombstones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeTombstones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ScopeDataAccessGrants) > 0 {
+		for iNdEx := len(m.ScopeDataAccessGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeDataAccessGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ScopeHeights) > 0 {
+		for iNdEx := len(m.ScopeHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ScopeOwnershipTransfers) > 0 {
+		for iNdEx := len(m.ScopeOwnershipTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeOwnershipTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.RecordVersions) > 0 {
+		for iNdEx := len(m.RecordVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.SessionVersions) > 0 {
+		for iNdEx := len(m.SessionVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ScopeVersions) > 0 {
+		for iNdEx := len(m.ScopeVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.NetAssetValues) > 0 {
 		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -337,6 +469,25 @@ func (m *MarkerNetAssetValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxAge))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.NetAssetValues) > 0 {
 		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -430,6 +581,48 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScopeVersions) > 0 {
+		for _, e := range m.ScopeVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SessionVersions) > 0 {
+		for _, e := range m.SessionVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecordVersions) > 0 {
+		for _, e := range m.RecordVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScopeOwnershipTransfers) > 0 {
+		for _, e := range m.ScopeOwnershipTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScopeHeights) > 0 {
+		for _, e := range m.ScopeHeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScopeDataAccessGrants) > 0 {
+		for _, e := range m.ScopeDataAccessGrants {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScopeTombstones) > 0 {
+		for _, e := range m.ScopeTombstones {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -449,6 +642,15 @@ func (m *MarkerNetAssetValues) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxAge != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxAge))
+	}
 	return n
 }
 
@@ -825,6 +1027,244 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeVersions = append(m.ScopeVersions, ScopeVersion{})
+			if err := m.ScopeVersions[len(m.ScopeVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionVersions = append(m.SessionVersions, SessionVersion{})
+			if err := m.SessionVersions[len(m.SessionVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordVersions = append(m.RecordVersions, RecordVersion{})
+			if err := m.RecordVersions[len(m.RecordVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeOwnershipTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeOwnershipTransfers = append(m.ScopeOwnershipTransfers, ScopeOwnershipTransfer{})
+			if err := m.ScopeOwnershipTransfers[len(m.ScopeOwnershipTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeHeights = append(m.ScopeHeights, ScopeHeights{})
+			if err := m.ScopeHeights[len(m.ScopeHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeDataAccessGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeDataAccessGrants = append(m.ScopeDataAccessGrants, ScopeDataAccessGrant{})
+			if err := m.ScopeDataAccessGrants[len(m.ScopeDataAccessGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeTombstones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeTombstones = append(m.ScopeTombstones, ScopeTombstone{})
+			if err := m.ScopeTombstones[len(m.ScopeTombstones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -941,6 +1381,59 @@ func (m *MarkerNetAssetValues) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, NetAssetValueRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			m.MaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxEntryVersions is the most prior versions that are kept for a single scope, session, or record.
+// Once there are more, the oldest ones are removed.
+const MaxEntryVersions = 100
+
+// changedByKey is the context key for the signers of a change to scopes, sessions, or records.
+type changedByKey struct{}
+
+// WithChangedBy returns a new context with the provided signers recorded as the ones making
+// changes to scopes, sessions, and records.
+func WithChangedBy(ctx sdk.Context, signers []string) sdk.Context {
+	return ctx.WithValue(changedByKey{}, signers)
+}
+
+// GetChangedBy gets the signers making changes to scopes, sessions, and records from the provided context.
+func GetChangedBy(ctx sdk.Context) []string {
+	val := ctx.Value(changedByKey{})
+	if val == nil {
+		return nil
+	}
+	rv, _ := val.([]string)
+	return rv
+}
+
+// NewEntryChange creates a new EntryChange for a change being made in the provided context.
+func NewEntryChange(ctx sdk.Context, deleted bool) EntryChange {
+	return EntryChange{
+		ChangedBy: GetChangedBy(ctx),
+		Height:    ctx.BlockHeight(),
+		BlockTime: ctx.BlockTime().UTC(),
+		Deleted:   deleted,
+	}
+}
+
+// Validate returns an error if this EntryChange is not in a valid state.
+func (c EntryChange) Validate() error {
+	for _, signer := range c.ChangedBy {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return fmt.Errorf("invalid changed by address %q: %w", signer, err)
+		}
+	}
+	if c.Height < 0 {
+		return fmt.Errorf("invalid change height %d: cannot be negative", c.Height)
+	}
+	return nil
+}
+
+// Validate returns an error if this ScopeVersion is not in a valid state.
+func (v ScopeVersion) Validate() error {
+	if v.Version == 0 {
+		return errors.New("invalid scope version: cannot be zero")
+	}
+	if err := v.Scope.ScopeId.ValidateIsScopeAddress(); err != nil {
+		return fmt.Errorf("invalid scope version %d: %w", v.Version, err)
+	}
+	if err := v.Change.Validate(); err != nil {
+		return fmt.Errorf("invalid scope %s version %d: %w", v.Scope.ScopeId, v.Version, err)
+	}
+	return nil
+}
+
+// Validate returns an error if this SessionVersion is not in a valid state.
+func (v SessionVersion) Validate() error {
+	if v.Version == 0 {
+		return errors.New("invalid session version: cannot be zero")
+	}
+	if !v.Session.SessionId.IsSessionAddress() {
+		return fmt.Errorf("invalid session version %d: invalid session id %q", v.Version, v.Session.SessionId)
+	}
+	if err := v.Change.Validate(); err != nil {
+		return fmt.Errorf("invalid session %s version %d: %w", v.Session.SessionId, v.Version, err)
+	}
+	return nil
+}
+
+// Validate returns an error if this RecordVersion is not in a valid state.
+func (v RecordVersion) Validate() error {
+	if v.Version == 0 {
+		return errors.New("invalid record version: cannot be zero")
+	}
+	if !v.Record.SessionId.IsSessionAddress() || len(v.Record.Name) == 0 {
+		return fmt.Errorf("invalid record version %d: invalid session id %q or name %q",
+			v.Version, v.Record.SessionId, v.Record.Name)
+	}
+	if err := v.Change.Validate(); err != nil {
+		return fmt.Errorf("invalid record %q version %d: %w", v.Record.Name, v.Version, err)
+	}
+	return nil
+}
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -52,6 +56,8 @@ const (
 // - 0x14<contract_spec_id><scope_spec_id>: 0x01
 //
 // - 0x20<owner_address><contract_spec_id>: 0x01
+//
+// - 0x29<party_address><party_role><scope_id>: 0x01
 var (
 	// ScopeKeyPrefix is the key for scope records in metadata store
 	ScopeKeyPrefix = []byte{0x00}
@@ -86,6 +92,42 @@ var (
 
 	// OSLocatorParamPrefix prefix for os locator params
 	OSLocatorParamPrefix = []byte{0x23}
+
+	// NetAssetValueHistoryPrefix prefix for the net asset value history of scopes
+	NetAssetValueHistoryPrefix = []byte{0x24}
+
+	// NetAssetValueMaxAgePrefix prefix for the maximum ages of the net asset values of scopes
+	NetAssetValueMaxAgePrefix = []byte{0x25}
+
+	// EntryVersionPrefix prefix for the prior versions of scopes, sessions, and records
+	EntryVersionPrefix = []byte{0x26}
+
+	// ScopeOwnershipTransferPrefix prefix for pending scope ownership transfers
+	ScopeOwnershipTransferPrefix = []byte{0x27}
+
+	// ScopeOwnershipTransferExpirationPrefix prefix for the expiration index of pending scope ownership transfers
+	ScopeOwnershipTransferExpirationPrefix = []byte{0x28}
+
+	// AddressRoleScopeCacheKeyPrefix for scope lookup by owner address and role
+	AddressRoleScopeCacheKeyPrefix = []byte{0x29}
+
+	// ScopeHeightsPrefix prefix for the block heights at which scopes were created and last updated
+	ScopeHeightsPrefix = []byte{0x2A}
+
+	// ScopeRecordsRootPrefix prefix for the merkle roots over the records of scopes
+	ScopeRecordsRootPrefix = []byte{0x2B}
+
+	// ScopeDataAccessGrantPrefix prefix for the expirations of data access to scopes
+	ScopeDataAccessGrantPrefix = []byte{0x2C}
+
+	// ScopeDataAccessExpirationPrefix prefix for the expiration index of data access to scopes
+	ScopeDataAccessExpirationPrefix = []byte{0x2D}
+
+	// ScopeTombstonePrefix prefix for the records of archived scopes
+	ScopeTombstonePrefix = []byte{0x2E}
+
+	// NetAssetValueStaleQueuePrefix prefix for the heights at which the net asset values of scopes become stale
+	NetAssetValueStaleQueuePrefix = []byte{0x2F}
 )
 
 // GetAddressScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
@@ -98,6 +140,18 @@ func GetAddressScopeCacheKey(addr sdk.AccAddress, scopeID MetadataAddress) []byt
 	return append(GetAddressScopeCacheIteratorPrefix(addr), scopeID.Bytes()...)
 }
 
+// GetAddressRoleScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries where the given
+// address is an owner with the given role. The role is a 4 byte big-endian number.
+func GetAddressRoleScopeCacheIteratorPrefix(addr sdk.AccAddress, role PartyType) []byte {
+	rv := append(AddressRoleScopeCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+	return binary.BigEndian.AppendUint32(rv, uint32(role))
+}
+
+// GetAddressRoleScopeCacheKey returns the store key for an address and role cache entry
+func GetAddressRoleScopeCacheKey(addr sdk.AccAddress, role PartyType, scopeID MetadataAddress) []byte {
+	return append(GetAddressRoleScopeCacheIteratorPrefix(addr, role), scopeID.Bytes()...)
+}
+
 // GetScopeSpecScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
 func GetScopeSpecScopeCacheIteratorPrefix(scopeSpecID MetadataAddress) []byte {
 	return append(ScopeSpecScopeCacheKeyPrefix, scopeSpecID.Bytes()...)
@@ -152,3 +206,129 @@ func NetAssetValueKeyPrefix(scopeAddr MetadataAddress) []byte {
 func NetAssetValueKey(scopeAddr MetadataAddress, denom string) []byte {
 	return append(NetAssetValueKeyPrefix(scopeAddr), denom...)
 }
+
+// NetAssetValueMaxAgeKey returns key [prefix][scope address] for the maximum age of a scope's net asset values
+func NetAssetValueMaxAgeKey(scopeAddr MetadataAddress) []byte {
+	return append(NetAssetValueMaxAgePrefix, address.MustLengthPrefix(scopeAddr.Bytes())...)
+}
+
+// NetAssetValueHistoryKeyPrefix returns the [prefix][scope address] part of a scope net asset value history key.
+func NetAssetValueHistoryKeyPrefix(scopeAddr MetadataAddress) []byte {
+	return append(NetAssetValueHistoryPrefix, address.MustLengthPrefix(scopeAddr.Bytes())...)
+}
+
+// NetAssetValueHistoryDenomKeyPrefix returns the [prefix][scope address][price denom] part of a scope net asset value history key.
+func NetAssetValueHistoryDenomKeyPrefix(scopeAddr MetadataAddress, denom string) []byte {
+	return append(NetAssetValueHistoryKeyPrefix(scopeAddr), address.MustLengthPrefix([]byte(denom))...)
+}
+
+// NetAssetValueHistoryKey returns key [prefix][scope address][price denom][block time] for an entry in a scope's net asset value history
+func NetAssetValueHistoryKey(scopeAddr MetadataAddress, denom string, blockTime time.Time) []byte {
+	return append(NetAssetValueHistoryDenomKeyPrefix(scopeAddr, denom), sdk.FormatTimeBytes(blockTime)...)
+}
+
+// EntryVersionKeyPrefix returns the [prefix][metadata address] part of the keys of the prior versions of a
+// scope, session, or record. The metadata address is not length-prefixed since its length is fixed by its type.
+func EntryVersionKeyPrefix(id MetadataAddress) []byte {
+	return append(EntryVersionPrefix, id.Bytes()...)
+}
+
+// EntryVersionKey returns key [prefix][metadata address][version] for a prior version of a scope, session, or record.
+func EntryVersionKey(id MetadataAddress, version uint64) []byte {
+	return append(EntryVersionKeyPrefix(id), sdk.Uint64ToBigEndian(version)...)
+}
+
+// ParseEntryVersionKey extracts the metadata address and version from a key created by EntryVersionKey.
+func ParseEntryVersionKey(key []byte) (MetadataAddress, uint64, error) {
+	if len(key) < len(EntryVersionPrefix)+9 || key[0] != EntryVersionPrefix[0] {
+		return nil, 0, fmt.Errorf("invalid entry version key %X", key)
+	}
+	id := MetadataAddress(key[len(EntryVersionPrefix) : len(key)-8])
+	if err := id.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("invalid entry version key %X: %w", key, err)
+	}
+	return id, sdk.BigEndianToUint64(key[len(key)-8:]), nil
+}
+
+// ScopeEntryVersionKeyPrefixes returns the key prefixes of the prior versions of a scope and of its sessions and records.
+func ScopeEntryVersionKeyPrefixes(scopeID MetadataAddress) ([][]byte, error) {
+	sessionPrefix, err := scopeID.ScopeSessionIteratorPrefix()
+	if err != nil {
+		return nil, err
+	}
+	recordPrefix, err := scopeID.ScopeRecordIteratorPrefix()
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{
+		EntryVersionKeyPrefix(scopeID),
+		append(EntryVersionPrefix, sessionPrefix...),
+		append(EntryVersionPrefix, recordPrefix...),
+	}, nil
+}
+
+// ScopeOwnershipTransferKey returns key [prefix][scope address] for a pending scope ownership transfer.
+func ScopeOwnershipTransferKey(scopeID MetadataAddress) []byte {
+	return append(ScopeOwnershipTransferPrefix, scopeID.Bytes()...)
+}
+
+// ScopeOwnershipTransferExpirationKeyPrefix returns the [prefix][expiration] part of a scope ownership transfer
+// expiration index key. The expiration is in unix seconds.
+func ScopeOwnershipTransferExpirationKeyPrefix(expiration time.Time) []byte {
+	return append(ScopeOwnershipTransferExpirationPrefix, sdk.Uint64ToBigEndian(uint64(expiration.Unix()))...)
+}
+
+// ScopeOwnershipTransferExpirationKey returns key [prefix][expiration][scope address] for the expiration index
+// of a pending scope ownership transfer.
+func ScopeOwnershipTransferExpirationKey(expiration time.Time, scopeID MetadataAddress) []byte {
+	return append(ScopeOwnershipTransferExpirationKeyPrefix(expiration), scopeID.Bytes()...)
+}
+
+// ScopeHeightsKey returns key [prefix][scope address] for the block heights at which a scope was created and last updated.
+func ScopeHeightsKey(scopeID MetadataAddress) []byte {
+	return append(ScopeHeightsPrefix, scopeID.Bytes()...)
+}
+
+// ScopeRecordsRootKey returns key [prefix][scope address] for the merkle root over a scope's records.
+func ScopeRecordsRootKey(scopeID MetadataAddress) []byte {
+	return append(ScopeRecordsRootPrefix, scopeID.Bytes()...)
+}
+
+// ScopeDataAccessGrantKeyPrefix returns the [prefix][scope address] part of a scope data access grant key.
+func ScopeDataAccessGrantKeyPrefix(scopeID MetadataAddress) []byte {
+	return append(ScopeDataAccessGrantPrefix, scopeID.Bytes()...)
+}
+
+// ScopeDataAccessGrantKey returns key [prefix][scope address][address] for the expiration of an address's
+// data access to a scope.
+func ScopeDataAccessGrantKey(scopeID MetadataAddress, addr sdk.AccAddress) []byte {
+	return append(ScopeDataAccessGrantKeyPrefix(scopeID), addr.Bytes()...)
+}
+
+// ScopeDataAccessExpirationKeyPrefix returns the [prefix][expiration] part of a scope data access expiration
+// index key. The expiration is in unix seconds.
+func ScopeDataAccessExpirationKeyPrefix(expiration time.Time) []byte {
+	return append(ScopeDataAccessExpirationPrefix, sdk.Uint64ToBigEndian(uint64(expiration.Unix()))...)
+}
+
+// ScopeDataAccessExpirationKey returns key [prefix][expiration][scope address][address] for the expiration index
+// of an address's data access to a scope.
+func ScopeDataAccessExpirationKey(expiration time.Time, scopeID MetadataAddress, addr sdk.AccAddress) []byte {
+	return append(append(ScopeDataAccessExpirationKeyPrefix(expiration), scopeID.Bytes()...), addr.Bytes()...)
+}
+
+// ScopeTombstoneKey returns key [prefix][scope address] for the record of an archived scope.
+func ScopeTombstoneKey(scopeID MetadataAddress) []byte {
+	return append(ScopeTombstonePrefix, scopeID.Bytes()...)
+}
+
+// NetAssetValueStaleQueueKeyPrefix returns the [prefix][stale height] part of a net asset value stale queue key.
+func NetAssetValueStaleQueueKeyPrefix(staleHeight uint64) []byte {
+	return append(NetAssetValueStaleQueuePrefix, sdk.Uint64ToBigEndian(staleHeight)...)
+}
+
+// NetAssetValueStaleQueueKey returns key [prefix][stale height][scope address][price denom] for the entry in the
+// stale queue of a scope's net asset value.
+func NetAssetValueStaleQueueKey(staleHeight uint64, scopeID MetadataAddress, denom string) []byte {
+	return append(append(NetAssetValueStaleQueueKeyPrefix(staleHeight), scopeID.Bytes()...), denom...)
+}
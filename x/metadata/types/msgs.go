@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -35,6 +36,8 @@ const (
 	TypeURLMsgDeleteOSLocatorRequest                 = "/provenance.metadata.v1.MsgDeleteOSLocatorRequest"
 	TypeURLMsgModifyOSLocatorRequest                 = "/provenance.metadata.v1.MsgModifyOSLocatorRequest"
 	TypeURLMsgSetAccountDataRequest                  = "/provenance.metadata.v1.MsgSetAccountDataRequest"
+	TypeURLMsgArchiveScopeRequest                    = "/provenance.metadata.v1.MsgArchiveScopeRequest"
+	TypeURLMsgMigrateScopeSpecRequest                = "/provenance.metadata.v1.MsgMigrateScopeSpecRequest"
 )
 
 // MetadataMsg extends the sdk.Msg interface with functions common to x/metadata messages.
@@ -80,6 +83,14 @@ var AllRequestMsgs = []MetadataMsg{
 	(*MsgSetAccountDataRequest)(nil),
 
 	(*MsgAddNetAssetValuesRequest)(nil),
+	(*MsgSetNetAssetValueMaxAgeRequest)(nil),
+	(*MsgPruneScopeHistoryRequest)(nil),
+	(*MsgProposeScopeOwnershipTransferRequest)(nil),
+	(*MsgAcceptScopeOwnershipTransferRequest)(nil),
+	(*MsgCancelScopeOwnershipTransferRequest)(nil),
+	(*MsgWriteScopeBundleRequest)(nil),
+	(*MsgArchiveScopeRequest)(nil),
+	(*MsgMigrateScopeSpecRequest)(nil),
 }
 
 // We still need these deprecated messages to be sdk.Msg for the codec.
@@ -210,6 +221,9 @@ func (msg MsgAddScopeDataAccessRequest) ValidateBasic() error {
 			return fmt.Errorf("data access address is invalid: %s", da)
 		}
 	}
+	if msg.Expiration != nil && msg.Expiration.IsZero() {
+		return fmt.Errorf("data access expiration cannot be zero")
+	}
 	if len(msg.Signers) < 1 {
 		return fmt.Errorf("at least one signer is required")
 	}
@@ -563,6 +577,9 @@ func (msg MsgWriteScopeSpecificationRequest) ValidateBasic() error {
 	if err := ValidateGenericPartyTypeUsage(msg.Specification.PartiesInvolved, msg.Specification.Description); err != nil {
 		return err
 	}
+	if !msg.Specification.SupersededBy.Empty() {
+		return errors.New("superseded by cannot be provided")
+	}
 
 	return msg.Specification.ValidateBasic()
 }
@@ -842,6 +859,9 @@ func (msg MsgBindOSLocatorRequest) ValidateBasic() error {
 	if err != nil {
 		return err
 	}
+	if err = msg.Locator.ValidateNoKeyHistory(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -887,6 +907,9 @@ func (msg MsgModifyOSLocatorRequest) ValidateBasic() error {
 	if err != nil {
 		return err
 	}
+	if err = msg.Locator.ValidateNoKeyHistory(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -952,6 +975,10 @@ func (msg MsgAddNetAssetValuesRequest) ValidateBasic() error {
 			return fmt.Errorf("scope net asset value must not have update height set")
 		}
 
+		if nav.Stale {
+			return fmt.Errorf("scope net asset value must not have stale set")
+		}
+
 		if seen[nav.Price.Denom] {
 			return fmt.Errorf("list of net asset values contains duplicates")
 		}
@@ -968,6 +995,359 @@ func (msg MsgAddNetAssetValuesRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgSetNetAssetValueMaxAgeRequest  ------------------
+
+// NewMsgSetNetAssetValueMaxAgeRequest creates a new msg instance
+func NewMsgSetNetAssetValueMaxAgeRequest(scopeID string, signers []string, maxAge uint64) *MsgSetNetAssetValueMaxAgeRequest {
+	return &MsgSetNetAssetValueMaxAgeRequest{
+		ScopeId: scopeID,
+		Signers: signers,
+		MaxAge:  maxAge,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgSetNetAssetValueMaxAgeRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+func (msg MsgSetNetAssetValueMaxAgeRequest) ValidateBasic() error {
+	scopeID, err := MetadataAddressFromBech32(msg.ScopeId)
+	if err != nil {
+		return fmt.Errorf("invalid metadata address %q: %w", msg.ScopeId, err)
+	}
+	if !scopeID.IsScopeAddress() {
+		return fmt.Errorf("metadata address is not scope address: %v", scopeID.String())
+	}
+
+	for _, signer := range msg.Signers {
+		_, err := sdk.AccAddressFromBech32(signer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ------------------  MsgPruneScopeHistoryRequest  ------------------
+
+// NewMsgPruneScopeHistoryRequest creates a new msg instance
+func NewMsgPruneScopeHistoryRequest(scopeID string, signers []string, keep uint32) *MsgPruneScopeHistoryRequest {
+	return &MsgPruneScopeHistoryRequest{
+		ScopeId: scopeID,
+		Signers: signers,
+		Keep:    keep,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgPruneScopeHistoryRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+func (msg MsgPruneScopeHistoryRequest) ValidateBasic() error {
+	scopeID, err := MetadataAddressFromBech32(msg.ScopeId)
+	if err != nil {
+		return fmt.Errorf("invalid metadata address %q: %w", msg.ScopeId, err)
+	}
+	if !scopeID.IsScopeAddress() {
+		return fmt.Errorf("metadata address is not scope address: %v", scopeID.String())
+	}
+
+	if msg.Keep == 0 {
+		return fmt.Errorf("at least one version must be kept")
+	}
+
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	for _, signer := range msg.Signers {
+		_, err := sdk.AccAddressFromBech32(signer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ------------------  MsgProposeScopeOwnershipTransferRequest  ------------------
+
+// NewMsgProposeScopeOwnershipTransferRequest creates a new msg instance
+func NewMsgProposeScopeOwnershipTransferRequest(
+	scopeID MetadataAddress,
+	owners []Party,
+	valueOwnerAddress string,
+	expiration time.Time,
+	signers []string,
+) *MsgProposeScopeOwnershipTransferRequest {
+	return &MsgProposeScopeOwnershipTransferRequest{
+		ScopeId:           scopeID,
+		Owners:            owners,
+		ValueOwnerAddress: valueOwnerAddress,
+		Expiration:        expiration,
+		Signers:           signers,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgProposeScopeOwnershipTransferRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgProposeScopeOwnershipTransferRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if len(msg.Owners) == 0 && len(msg.ValueOwnerAddress) == 0 {
+		return errors.New("at least one of owners or value owner address is required")
+	}
+	if len(msg.Owners) > 0 {
+		if err := ValidatePartiesBasic(msg.Owners); err != nil {
+			return fmt.Errorf("invalid owners: %w", err)
+		}
+	}
+	if len(msg.ValueOwnerAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.ValueOwnerAddress); err != nil {
+			return fmt.Errorf("invalid value owner address %q: %w", msg.ValueOwnerAddress, err)
+		}
+	}
+	if msg.Expiration.IsZero() {
+		return errors.New("expiration is required")
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgAcceptScopeOwnershipTransferRequest  ------------------
+
+// NewMsgAcceptScopeOwnershipTransferRequest creates a new msg instance
+func NewMsgAcceptScopeOwnershipTransferRequest(scopeID MetadataAddress, signers []string) *MsgAcceptScopeOwnershipTransferRequest {
+	return &MsgAcceptScopeOwnershipTransferRequest{
+		ScopeId: scopeID,
+		Signers: signers,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgAcceptScopeOwnershipTransferRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgAcceptScopeOwnershipTransferRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgCancelScopeOwnershipTransferRequest  ------------------
+
+// NewMsgCancelScopeOwnershipTransferRequest creates a new msg instance
+func NewMsgCancelScopeOwnershipTransferRequest(scopeID MetadataAddress, signers []string) *MsgCancelScopeOwnershipTransferRequest {
+	return &MsgCancelScopeOwnershipTransferRequest{
+		ScopeId: scopeID,
+		Signers: signers,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgCancelScopeOwnershipTransferRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgCancelScopeOwnershipTransferRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgWriteScopeBundleRequest  ------------------
+
+// NewMsgWriteScopeBundleRequest creates a new msg instance
+func NewMsgWriteScopeBundleRequest(scope Scope, sessions []Session, records []Record, signers []string, usdMills uint64) *MsgWriteScopeBundleRequest {
+	return &MsgWriteScopeBundleRequest{
+		Scope:    scope,
+		Sessions: sessions,
+		Records:  records,
+		Signers:  signers,
+		UsdMills: usdMills,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgWriteScopeBundleRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgWriteScopeBundleRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	if err := msg.Scope.ValidateBasic(); err != nil {
+		return err
+	}
+
+	sessionIDs := make(map[string]bool, len(msg.Sessions))
+	for i, session := range msg.Sessions {
+		if err := session.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid session %d: %w", i, err)
+		}
+		if err := msg.validateInScope(session.SessionId); err != nil {
+			return fmt.Errorf("invalid session %d: %w", i, err)
+		}
+		if sessionIDs[session.SessionId.String()] {
+			return fmt.Errorf("duplicate session id %s", session.SessionId)
+		}
+		sessionIDs[session.SessionId.String()] = true
+	}
+
+	recordNames := make(map[string]bool, len(msg.Records))
+	for i, record := range msg.Records {
+		if err := record.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid record %d: %w", i, err)
+		}
+		if err := msg.validateInScope(record.SessionId); err != nil {
+			return fmt.Errorf("invalid record %d: %w", i, err)
+		}
+		if recordNames[record.Name] {
+			return fmt.Errorf("duplicate record name %q", record.Name)
+		}
+		recordNames[record.Name] = true
+	}
+	return nil
+}
+
+// validateInScope returns an error if the provided id is not part of this bundle's scope.
+func (msg MsgWriteScopeBundleRequest) validateInScope(id MetadataAddress) error {
+	scopeID, err := id.AsScopeAddress()
+	if err != nil {
+		return err
+	}
+	if !msg.Scope.ScopeId.Equals(scopeID) {
+		return fmt.Errorf("%s is not part of scope %s", id, msg.Scope.ScopeId)
+	}
+	return nil
+}
+
+// GetWriteScopeRequest gets the MsgWriteScopeRequest for the scope part of this bundle.
+func (msg MsgWriteScopeBundleRequest) GetWriteScopeRequest() *MsgWriteScopeRequest {
+	return NewMsgWriteScopeRequest(msg.Scope, msg.Signers, msg.UsdMills)
+}
+
+// GetWriteSessionRequests gets a MsgWriteSessionRequest for each of the sessions in this bundle.
+func (msg MsgWriteScopeBundleRequest) GetWriteSessionRequests() []*MsgWriteSessionRequest {
+	rv := make([]*MsgWriteSessionRequest, len(msg.Sessions))
+	for i, session := range msg.Sessions {
+		rv[i] = NewMsgWriteSessionRequest(session, msg.Signers)
+	}
+	return rv
+}
+
+// GetWriteRecordRequests gets a MsgWriteRecordRequest for each of the records in this bundle.
+func (msg MsgWriteScopeBundleRequest) GetWriteRecordRequests() []*MsgWriteRecordRequest {
+	rv := make([]*MsgWriteRecordRequest, len(msg.Records))
+	for i, record := range msg.Records {
+		rv[i] = NewMsgWriteRecordRequest(record, nil, "", msg.Signers, nil)
+	}
+	return rv
+}
+
+// NewMsgWriteScopeBundleResponse creates a new response for a bundle with the provided ids.
+func NewMsgWriteScopeBundleResponse(scopeID MetadataAddress, sessionIDs, recordIDs []MetadataAddress) *MsgWriteScopeBundleResponse {
+	rv := &MsgWriteScopeBundleResponse{ScopeIdInfo: GetScopeIDInfo(scopeID)}
+	for _, sessionID := range sessionIDs {
+		rv.SessionIdInfos = append(rv.SessionIdInfos, GetSessionIDInfo(sessionID))
+	}
+	for _, recordID := range recordIDs {
+		rv.RecordIdInfos = append(rv.RecordIdInfos, GetRecordIDInfo(recordID))
+	}
+	return rv
+}
+
+// ------------------  MsgArchiveScopeRequest  ------------------
+
+// NewMsgArchiveScopeRequest creates a new msg instance
+func NewMsgArchiveScopeRequest(scopeID MetadataAddress, signers []string) *MsgArchiveScopeRequest {
+	return &MsgArchiveScopeRequest{
+		ScopeId: scopeID,
+		Signers: signers,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgArchiveScopeRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgArchiveScopeRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("invalid scope address")
+	}
+	return nil
+}
+
+// ------------------  MsgMigrateScopeSpecRequest  ------------------
+
+// NewMsgMigrateScopeSpecRequest creates a new msg instance
+func NewMsgMigrateScopeSpecRequest(scopeIDs []MetadataAddress, specID MetadataAddress, signers []string) *MsgMigrateScopeSpecRequest {
+	return &MsgMigrateScopeSpecRequest{
+		ScopeIds:        scopeIDs,
+		SpecificationId: specID,
+		Signers:         signers,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgMigrateScopeSpecRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgMigrateScopeSpecRequest) ValidateBasic() error {
+	if len(msg.ScopeIds) == 0 {
+		return fmt.Errorf("at least one scope id is required")
+	}
+	seen := make(map[string]bool, len(msg.ScopeIds))
+	for i, scopeID := range msg.ScopeIds {
+		if !scopeID.IsScopeAddress() {
+			return fmt.Errorf("scope id[%d]: %q: invalid scope id", i, scopeID.String())
+		}
+		if seen[string(scopeID)] {
+			return fmt.Errorf("scope id[%d]: %q: duplicate scope id", i, scopeID.String())
+		}
+		seen[string(scopeID)] = true
+	}
+
+	if err := msg.SpecificationId.ValidateIsScopeSpecificationAddress(); err != nil {
+		return fmt.Errorf("invalid scope specification id: %w", err)
+	}
+
+	if len(msg.Signers) == 0 {
+		return fmt.Errorf("at least one signer is required")
+	}
+
+	return nil
+}
+
 // ------------------  SessionIdComponents  ------------------
 
 func (msg *SessionIdComponents) GetSessionAddr() (MetadataAddress, error) {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -80,6 +81,14 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signers []string) sdk.Msg { return &MsgDeleteRecordSpecificationRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgSetAccountDataRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgAddNetAssetValuesRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgSetNetAssetValueMaxAgeRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgPruneScopeHistoryRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgProposeScopeOwnershipTransferRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgAcceptScopeOwnershipTransferRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgCancelScopeOwnershipTransferRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgWriteScopeBundleRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgArchiveScopeRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgMigrateScopeSpecRequest{Signers: signers} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, singleSignerMsgMakers, multiSignerMsgMakers)
@@ -203,6 +212,16 @@ func TestAddScopeDataAccessValidateBasic(t *testing.T) {
 			true,
 			"at least one signer is required",
 		},
+		"should fail to validate basic, zero expiration": {
+			&MsgAddScopeDataAccessRequest{
+				ScopeId:    actualScopeId,
+				DataAccess: []string{"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"},
+				Signers:    []string{"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"},
+				Expiration: &time.Time{},
+			},
+			true,
+			"data access expiration cannot be zero",
+		},
 		"should successfully validate basic": {
 			NewMsgAddScopeDataAccessRequest(actualScopeId, []string{"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"}, []string{"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"}),
 			false,
@@ -747,7 +766,7 @@ func TestBindOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgBindOSLocatorRequest", sdk.MsgTypeURL(bindRequestMsg))
 
 	bz, _ := GetCdc(t).MarshalJSON(bindRequestMsg)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"endpoints\":[],\"encryption_key_since_height\":\"0\",\"encryption_key_since\":null,\"prior_encryption_keys\":[]}}", string(bz))
 }
 
 func TestModifyOSLocator(t *testing.T) {
@@ -759,7 +778,7 @@ func TestModifyOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgModifyOSLocatorRequest", sdk.MsgTypeURL(modifyRequest))
 
 	bz, _ := GetCdc(t).MarshalJSON(modifyRequest)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"endpoints\":[],\"encryption_key_since_height\":\"0\",\"encryption_key_since\":null,\"prior_encryption_keys\":[]}}", string(bz))
 }
 
 func TestDeleteOSLocator(t *testing.T) {
@@ -771,7 +790,7 @@ func TestDeleteOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgDeleteOSLocatorRequest", sdk.MsgTypeURL(deleteRequest))
 
 	bz, _ := GetCdc(t).MarshalJSON(deleteRequest)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"endpoints\":[],\"encryption_key_since_height\":\"0\",\"encryption_key_since\":null,\"prior_encryption_keys\":[]}}", string(bz))
 }
 
 func TestBindOSLocatorInvalid(t *testing.T) {
@@ -946,6 +965,11 @@ func TestMsgAddNetAssetValueValidateBasic(t *testing.T) {
 			msg:    MsgAddNetAssetValuesRequest{ScopeId: scopeID, NetAssetValues: []NetAssetValue{invalidNetAssetValue2}, Signers: []string{addr}},
 			expErr: "scope net asset value must not have update height set",
 		},
+		{
+			name:   "stale is set",
+			msg:    MsgAddNetAssetValuesRequest{ScopeId: scopeID, NetAssetValues: []NetAssetValue{{Price: sdk.NewInt64Coin("hotdog", 100), Stale: true}}, Signers: []string{addr}},
+			expErr: "scope net asset value must not have stale set",
+		},
 		{
 			name:   "duplicate net asset values",
 			msg:    MsgAddNetAssetValuesRequest{ScopeId: scopeID, NetAssetValues: []NetAssetValue{netAssetValue1, netAssetValue2, netAssetValue2}, Signers: []string{addr}},
@@ -984,3 +1008,352 @@ func TestMsgAddNetAssetValueValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetNetAssetValueMaxAgeValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	scopeID := "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel"
+	sessionID := "session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr"
+
+	tests := []struct {
+		name   string
+		msg    *MsgSetNetAssetValueMaxAgeRequest
+		expErr string
+	}{
+		{
+			name: "should succeed",
+			msg:  NewMsgSetNetAssetValueMaxAgeRequest(scopeID, []string{addr}, 100),
+		},
+		{
+			name: "zero max age",
+			msg:  NewMsgSetNetAssetValueMaxAgeRequest(scopeID, []string{addr}, 0),
+		},
+		{
+			name:   "incorrect meta address",
+			msg:    NewMsgSetNetAssetValueMaxAgeRequest("", []string{addr}, 100),
+			expErr: `invalid metadata address "": empty address string is not allowed`,
+		},
+		{
+			name:   "not scope meta address",
+			msg:    NewMsgSetNetAssetValueMaxAgeRequest(sessionID, []string{addr}, 100),
+			expErr: "metadata address is not scope address: session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr",
+		},
+		{
+			name:   "invalid signer address",
+			msg:    NewMsgSetNetAssetValueMaxAgeRequest(scopeID, []string{"invalid"}, 100),
+			expErr: "decoding bech32 failed: invalid bech32 string length 7",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgPruneScopeHistoryValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	scopeID := "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel"
+	sessionID := "session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr"
+
+	tests := []struct {
+		name   string
+		msg    *MsgPruneScopeHistoryRequest
+		expErr string
+	}{
+		{
+			name: "should succeed",
+			msg:  NewMsgPruneScopeHistoryRequest(scopeID, []string{addr}, 1),
+		},
+		{
+			name:   "incorrect meta address",
+			msg:    NewMsgPruneScopeHistoryRequest("", []string{addr}, 1),
+			expErr: `invalid metadata address "": empty address string is not allowed`,
+		},
+		{
+			name:   "not scope meta address",
+			msg:    NewMsgPruneScopeHistoryRequest(sessionID, []string{addr}, 1),
+			expErr: "metadata address is not scope address: session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr",
+		},
+		{
+			name:   "nothing kept",
+			msg:    NewMsgPruneScopeHistoryRequest(scopeID, []string{addr}, 0),
+			expErr: "at least one version must be kept",
+		},
+		{
+			name:   "no signers",
+			msg:    NewMsgPruneScopeHistoryRequest(scopeID, nil, 1),
+			expErr: "at least one signer is required",
+		},
+		{
+			name:   "invalid signer address",
+			msg:    NewMsgPruneScopeHistoryRequest(scopeID, []string{"invalid"}, 1),
+			expErr: "decoding bech32 failed: invalid bech32 string length 7",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgProposeScopeOwnershipTransferValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	buyer := sdk.AccAddress("buyer_______________").String()
+	scopeID := ScopeMetadataAddress(uuid.MustParse("91978ba2-5f35-459a-86a7-feca1b0512e0"))
+	scopeSpecID := ScopeSpecMetadataAddress(uuid.MustParse("91978ba2-5f35-459a-86a7-feca1b0512e0"))
+	owners := []Party{{Address: buyer, Role: PartyType_PARTY_TYPE_OWNER}}
+	expiration := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		msg    *MsgProposeScopeOwnershipTransferRequest
+		expErr string
+	}{
+		{
+			name: "new owners",
+			msg:  NewMsgProposeScopeOwnershipTransferRequest(scopeID, owners, "", expiration, []string{addr}),
+		},
+		{
+			name: "new value owner",
+			msg:  NewMsgProposeScopeOwnershipTransferRequest(scopeID, nil, buyer, expiration, []string{addr}),
+		},
+		{
+			name:   "not a scope id",
+			msg:    NewMsgProposeScopeOwnershipTransferRequest(scopeSpecID, owners, "", expiration, []string{addr}),
+			expErr: "address is not a scope id: " + scopeSpecID.String(),
+		},
+		{
+			name:   "nothing to transfer",
+			msg:    NewMsgProposeScopeOwnershipTransferRequest(scopeID, nil, "", expiration, []string{addr}),
+			expErr: "at least one of owners or value owner address is required",
+		},
+		{
+			name:   "invalid owner",
+			msg:    NewMsgProposeScopeOwnershipTransferRequest(scopeID, []Party{{Address: "invalid", Role: PartyType_PARTY_TYPE_OWNER}}, "", expiration, []string{addr}),
+			expErr: "invalid owners: invalid party address [invalid]: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name:   "invalid value owner",
+			msg:    NewMsgProposeScopeOwnershipTransferRequest(scopeID, nil, "invalid", expiration, []string{addr}),
+			expErr: `invalid value owner address "invalid": decoding bech32 failed: invalid bech32 string length 7`,
+		},
+		{
+			name:   "no expiration",
+			msg:    NewMsgProposeScopeOwnershipTransferRequest(scopeID, owners, "", time.Time{}, []string{addr}),
+			expErr: "expiration is required",
+		},
+		{
+			name:   "no signers",
+			msg:    NewMsgProposeScopeOwnershipTransferRequest(scopeID, owners, "", expiration, nil),
+			expErr: "at least one signer is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgWriteScopeBundleValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	scopeUUID := uuid.MustParse("91978ba2-5f35-459a-86a7-feca1b0512e0")
+	scopeID := ScopeMetadataAddress(scopeUUID)
+	otherScopeID := ScopeMetadataAddress(uuid.MustParse("5803f8bc-6067-4eb5-951f-2121671c2ec0"))
+	scopeSpecID := ScopeSpecMetadataAddress(uuid.MustParse("dc83ea70-eacd-40fe-9adf-1cf6148bf8a2"))
+	contractSpecID := ContractSpecMetadataAddress(uuid.MustParse("def6bc0a-c9dd-4874-948f-5206e6060a84"))
+	scope := *NewScope(scopeID, scopeSpecID, []Party{{Address: addr, Role: PartyType_PARTY_TYPE_OWNER}}, nil, addr, false)
+
+	sessionID := scopeID.MustGetAsSessionAddress(uuid.MustParse("a2f5b1e0-1c7d-4b6a-9c51-0d8e3b7f2a41"))
+	otherSessionID := otherScopeID.MustGetAsSessionAddress(uuid.MustParse("a2f5b1e0-1c7d-4b6a-9c51-0d8e3b7f2a41"))
+	session := *NewSession("loan", sessionID, contractSpecID, []Party{{Address: addr, Role: PartyType_PARTY_TYPE_OWNER}}, nil)
+	otherSession := *NewSession("loan", otherSessionID, contractSpecID, []Party{{Address: addr, Role: PartyType_PARTY_TYPE_OWNER}}, nil)
+
+	process := *NewProcess("process", &Process_Hash{Hash: "hash"}, "method")
+	record := *NewRecord("terms", sessionID, process, nil, nil, nil)
+	otherRecord := *NewRecord("terms", otherSessionID, process, nil, nil, nil)
+
+	tests := []struct {
+		name   string
+		msg    *MsgWriteScopeBundleRequest
+		expErr string
+	}{
+		{
+			name: "scope only",
+			msg:  NewMsgWriteScopeBundleRequest(scope, nil, nil, []string{addr}, 0),
+		},
+		{
+			name: "scope with a session and record",
+			msg:  NewMsgWriteScopeBundleRequest(scope, []Session{session}, []Record{record}, []string{addr}, 1234),
+		},
+		{
+			name:   "no signers",
+			msg:    NewMsgWriteScopeBundleRequest(scope, []Session{session}, []Record{record}, nil, 0),
+			expErr: "at least one signer is required",
+		},
+		{
+			name:   "invalid session",
+			msg:    NewMsgWriteScopeBundleRequest(scope, []Session{{SessionId: sessionID}}, nil, []string{addr}, 0),
+			expErr: "invalid session 0: ",
+		},
+		{
+			name:   "session in another scope",
+			msg:    NewMsgWriteScopeBundleRequest(scope, []Session{session, otherSession}, nil, []string{addr}, 0),
+			expErr: "invalid session 1: " + otherSessionID.String() + " is not part of scope " + scopeID.String(),
+		},
+		{
+			name:   "duplicate session",
+			msg:    NewMsgWriteScopeBundleRequest(scope, []Session{session, session}, nil, []string{addr}, 0),
+			expErr: "duplicate session id " + sessionID.String(),
+		},
+		{
+			name:   "invalid record",
+			msg:    NewMsgWriteScopeBundleRequest(scope, nil, []Record{{SessionId: sessionID, Process: process}}, []string{addr}, 0),
+			expErr: "invalid record 0: invalid/missing name for record",
+		},
+		{
+			name:   "record in another scope",
+			msg:    NewMsgWriteScopeBundleRequest(scope, nil, []Record{otherRecord}, []string{addr}, 0),
+			expErr: "invalid record 0: " + otherSessionID.String() + " is not part of scope " + scopeID.String(),
+		},
+		{
+			name:   "duplicate record name",
+			msg:    NewMsgWriteScopeBundleRequest(scope, []Session{session}, []Record{record, record}, []string{addr}, 0),
+			expErr: `duplicate record name "terms"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgArchiveScopeValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	scopeUUID := uuid.New()
+	scopeID := ScopeMetadataAddress(scopeUUID)
+
+	tests := []struct {
+		name   string
+		msg    *MsgArchiveScopeRequest
+		expErr string
+	}{
+		{
+			name: "should succeed",
+			msg:  NewMsgArchiveScopeRequest(scopeID, []string{addr}),
+		},
+		{
+			name:   "no signers",
+			msg:    NewMsgArchiveScopeRequest(scopeID, nil),
+			expErr: "at least one signer is required",
+		},
+		{
+			name:   "not a scope id",
+			msg:    NewMsgArchiveScopeRequest(SessionMetadataAddress(scopeUUID, uuid.New()), []string{addr}),
+			expErr: "invalid scope address",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgMigrateScopeSpecValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	scopeID1 := ScopeMetadataAddress(uuid.New())
+	scopeID2 := ScopeMetadataAddress(uuid.New())
+	specID := ScopeSpecMetadataAddress(uuid.New())
+	sessionID := SessionMetadataAddress(uuid.New(), uuid.New())
+
+	tests := []struct {
+		name   string
+		msg    *MsgMigrateScopeSpecRequest
+		expErr string
+	}{
+		{
+			name: "should succeed",
+			msg:  NewMsgMigrateScopeSpecRequest([]MetadataAddress{scopeID1, scopeID2}, specID, []string{addr}),
+		},
+		{
+			name:   "no scope ids",
+			msg:    NewMsgMigrateScopeSpecRequest(nil, specID, []string{addr}),
+			expErr: "at least one scope id is required",
+		},
+		{
+			name:   "not a scope id",
+			msg:    NewMsgMigrateScopeSpecRequest([]MetadataAddress{scopeID1, sessionID}, specID, []string{addr}),
+			expErr: fmt.Sprintf("scope id[1]: %q: invalid scope id", sessionID),
+		},
+		{
+			name:   "duplicate scope id",
+			msg:    NewMsgMigrateScopeSpecRequest([]MetadataAddress{scopeID1, scopeID2, scopeID1}, specID, []string{addr}),
+			expErr: fmt.Sprintf("scope id[2]: %q: duplicate scope id", scopeID1),
+		},
+		{
+			name:   "not a scope spec id",
+			msg:    NewMsgMigrateScopeSpecRequest([]MetadataAddress{scopeID1}, scopeID2, []string{addr}),
+			expErr: fmt.Sprintf("invalid scope specification id: invalid scope specification id %q: wrong type", scopeID2),
+		},
+		{
+			name:   "no signers",
+			msg:    NewMsgMigrateScopeSpecRequest([]MetadataAddress{scopeID1}, specID, nil),
+			expErr: "at least one signer is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgWriteScopeSpecificationSupersededBy(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	spec := *NewScopeSpecification(ScopeSpecMetadataAddress(uuid.New()), nil, []string{addr}, []PartyType{PartyType_PARTY_TYPE_OWNER}, nil)
+	spec.Supersedes = ScopeSpecMetadataAddress(uuid.New())
+	msg := NewMsgWriteScopeSpecificationRequest(spec, []string{addr})
+	assert.NoError(t, msg.ValidateBasic(), "ValidateBasic with supersedes")
+
+	msg.Specification.SupersededBy = ScopeSpecMetadataAddress(uuid.New())
+	assert.EqualError(t, msg.ValidateBasic(), "superseded by cannot be provided", "ValidateBasic with superseded by")
+}
@@ -1,13 +1,22 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxOSLocatorEndpoints is the most additional endpoints an object store locator can have.
+const MaxOSLocatorEndpoints = 10
+
+// MaxOSLocatorPriorEncryptionKeys is the most prior encryption keys an object store locator keeps.
+// When the encryption key changes after that many, the oldest prior key is dropped.
+const MaxOSLocatorPriorEncryptionKeys = 20
+
 // NewOSLocatorRecord creates a oslocator for a given address.
 func NewOSLocatorRecord(ownerAddr, encryptionKey sdk.AccAddress, uri string) ObjectStoreLocator {
 	return ObjectStoreLocator{
@@ -40,5 +49,86 @@ func (r ObjectStoreLocator) Validate() error {
 				r.Owner, r.EncryptionKey)
 		}
 	}
+
+	if len(r.Endpoints) > MaxOSLocatorEndpoints {
+		return fmt.Errorf("locator cannot have more than %d endpoints", MaxOSLocatorEndpoints)
+	}
+	seen := []string{r.LocatorUri}
+	for i, endpoint := range r.Endpoints {
+		if strings.TrimSpace(endpoint.Uri) == "" {
+			return fmt.Errorf("endpoint %d uri cannot be empty", i)
+		}
+		if _, err := url.Parse(endpoint.Uri); err != nil {
+			return fmt.Errorf("invalid endpoint %d uri: %s", i, endpoint.Uri)
+		}
+		if slices.Contains(seen, endpoint.Uri) {
+			return fmt.Errorf("duplicate endpoint uri: %s", endpoint.Uri)
+		}
+		seen = append(seen, endpoint.Uri)
+	}
+
+	if len(r.PriorEncryptionKeys) > MaxOSLocatorPriorEncryptionKeys {
+		return fmt.Errorf("locator cannot have more than %d prior encryption keys", MaxOSLocatorPriorEncryptionKeys)
+	}
+	for i, prior := range r.PriorEncryptionKeys {
+		if err := prior.Validate(); err != nil {
+			return fmt.Errorf("invalid prior encryption key %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ValidateNoKeyHistory returns an error if any of the encryption key history fields are set.
+// Those fields are managed by the chain, so they cannot be provided in a msg.
+func (r ObjectStoreLocator) ValidateNoKeyHistory() error {
+	if r.EncryptionKeySinceHeight != 0 || r.EncryptionKeySince != nil || len(r.PriorEncryptionKeys) > 0 {
+		return errors.New("encryption key history cannot be provided")
+	}
+	return nil
+}
+
+// GetURIs gets all of the endpoint uris of this locator in the order they should be tried:
+// the locator uri, followed by the endpoints ordered by priority.
+func (r ObjectStoreLocator) GetURIs() []string {
+	endpoints := slices.Clone(r.Endpoints)
+	slices.SortStableFunc(endpoints, func(a, b ObjectStoreEndpoint) int {
+		return int(a.Priority) - int(b.Priority)
+	})
+	rv := make([]string, 0, 1+len(endpoints))
+	rv = append(rv, r.LocatorUri)
+	for _, endpoint := range endpoints {
+		rv = append(rv, endpoint.Uri)
+	}
+	return rv
+}
+
+// GetEncryptionKeyAtHeight gets the encryption key that was active at the provided block height,
+// e.g. the height at which a record was written. An empty string is returned if there was no key at that height.
+func (r ObjectStoreLocator) GetEncryptionKeyAtHeight(height int64) string {
+	if height >= r.EncryptionKeySinceHeight {
+		return r.EncryptionKey
+	}
+	for _, prior := range r.PriorEncryptionKeys {
+		if height >= prior.ValidFromHeight && height < prior.ValidUntilHeight {
+			return prior.EncryptionKey
+		}
+	}
+	return ""
+}
+
+// Validate returns an error if this PriorEncryptionKey is not in a valid state.
+func (k PriorEncryptionKey) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(k.EncryptionKey); err != nil {
+		return fmt.Errorf("invalid encryption key address: %s", k.EncryptionKey)
+	}
+	if k.ValidFromHeight < 0 {
+		return fmt.Errorf("valid from height %d cannot be negative", k.ValidFromHeight)
+	}
+	if k.ValidUntilHeight < k.ValidFromHeight {
+		return fmt.Errorf("valid until height %d cannot be less than valid from height %d", k.ValidUntilHeight, k.ValidFromHeight)
+	}
+	if k.ValidUntil.IsZero() {
+		return errors.New("valid until time cannot be zero")
+	}
 	return nil
 }
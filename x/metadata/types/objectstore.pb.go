@@ -8,15 +8,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +37,19 @@ type ObjectStoreLocator struct {
 	LocatorUri string `protobuf:"bytes,2,opt,name=locator_uri,json=locatorUri,proto3" json:"locator_uri,omitempty"`
 	// owners encryption key address
 	EncryptionKey string `protobuf:"bytes,3,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	// endpoints are additional endpoint uris for the owner's object store, e.g. for disaster recovery.
+	// The locator_uri is always the primary endpoint.
+	Endpoints []ObjectStoreEndpoint `protobuf:"bytes,4,rep,name=endpoints,proto3" json:"endpoints"`
+	// encryption_key_since_height is the block height at which the current encryption key became active.
+	// It is zero if the key was set before key history was kept. It cannot be provided in a msg.
+	EncryptionKeySinceHeight int64 `protobuf:"varint,5,opt,name=encryption_key_since_height,json=encryptionKeySinceHeight,proto3" json:"encryption_key_since_height,omitempty"`
+	// encryption_key_since is the block time at which the current encryption key became active.
+	// It is empty if the key was set before key history was kept. It cannot be provided in a msg.
+	EncryptionKeySince *time.Time `protobuf:"bytes,6,opt,name=encryption_key_since,json=encryptionKeySince,proto3,stdtime" json:"encryption_key_since,omitempty"`
+	// prior_encryption_keys are the encryption keys the owner previously used, oldest first, along with when each
+	// was active. They are recorded whenever the encryption key changes, and cannot be provided in a msg.
+	// At most 20 are kept; once there are that many, the oldest is dropped when the key changes again.
+	PriorEncryptionKeys []PriorEncryptionKey `protobuf:"bytes,7,rep,name=prior_encryption_keys,json=priorEncryptionKeys,proto3" json:"prior_encryption_keys"`
 }
 
 func (m *ObjectStoreLocator) Reset()         { *m = ObjectStoreLocator{} }
@@ -89,6 +106,174 @@ func (m *ObjectStoreLocator) GetEncryptionKey() string {
 	return ""
 }
 
+func (m *ObjectStoreLocator) GetEndpoints() []ObjectStoreEndpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+func (m *ObjectStoreLocator) GetEncryptionKeySinceHeight() int64 {
+	if m != nil {
+		return m.EncryptionKeySinceHeight
+	}
+	return 0
+}
+
+func (m *ObjectStoreLocator) GetEncryptionKeySince() *time.Time {
+	if m != nil {
+		return m.EncryptionKeySince
+	}
+	return nil
+}
+
+func (m *ObjectStoreLocator) GetPriorEncryptionKeys() []PriorEncryptionKey {
+	if m != nil {
+		return m.PriorEncryptionKeys
+	}
+	return nil
+}
+
+// ObjectStoreEndpoint is an additional endpoint uri of an object store.
+type ObjectStoreEndpoint struct {
+	// uri is the endpoint uri.
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// priority is the order in which this endpoint should be tried; lower values are tried first.
+	// The locator_uri of the locator is always tried before any of its endpoints.
+	Priority uint32 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (m *ObjectStoreEndpoint) Reset()         { *m = ObjectStoreEndpoint{} }
+func (m *ObjectStoreEndpoint) String() string { return proto.CompactTextString(m) }
+func (*ObjectStoreEndpoint) ProtoMessage()    {}
+func (*ObjectStoreEndpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d17fc5ccfa1c263, []int{1}
+}
+func (m *ObjectStoreEndpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectStoreEndpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectStoreEndpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObjectStoreEndpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectStoreEndpoint.Merge(m, src)
+}
+func (m *ObjectStoreEndpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectStoreEndpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectStoreEndpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectStoreEndpoint proto.InternalMessageInfo
+
+func (m *ObjectStoreEndpoint) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *ObjectStoreEndpoint) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+// PriorEncryptionKey is an encryption key that an object store locator owner previously used.
+type PriorEncryptionKey struct {
+	// encryption_key is the encryption key address.
+	EncryptionKey string `protobuf:"bytes,1,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	// valid_from_height is the block height at which this key became active.
+	// It is zero if the key was set before key history was kept.
+	ValidFromHeight int64 `protobuf:"varint,2,opt,name=valid_from_height,json=validFromHeight,proto3" json:"valid_from_height,omitempty"`
+	// valid_from is the block time at which this key became active.
+	// It is empty if the key was set before key history was kept.
+	ValidFrom *time.Time `protobuf:"bytes,3,opt,name=valid_from,json=validFrom,proto3,stdtime" json:"valid_from,omitempty"`
+	// valid_until_height is the block height at which this key was replaced.
+	ValidUntilHeight int64 `protobuf:"varint,4,opt,name=valid_until_height,json=validUntilHeight,proto3" json:"valid_until_height,omitempty"`
+	// valid_until is the block time at which this key was replaced.
+	ValidUntil time.Time `protobuf:"bytes,5,opt,name=valid_until,json=validUntil,proto3,stdtime" json:"valid_until"`
+}
+
+func (m *PriorEncryptionKey) Reset()         { *m = PriorEncryptionKey{} }
+func (m *PriorEncryptionKey) String() string { return proto.CompactTextString(m) }
+func (*PriorEncryptionKey) ProtoMessage()    {}
+func (*PriorEncryptionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d17fc5ccfa1c263, []int{2}
+}
+func (m *PriorEncryptionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriorEncryptionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriorEncryptionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriorEncryptionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriorEncryptionKey.Merge(m, src)
+}
+func (m *PriorEncryptionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriorEncryptionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriorEncryptionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriorEncryptionKey proto.InternalMessageInfo
+
+func (m *PriorEncryptionKey) GetEncryptionKey() string {
+	if m != nil {
+		return m.EncryptionKey
+	}
+	return ""
+}
+
+func (m *PriorEncryptionKey) GetValidFromHeight() int64 {
+	if m != nil {
+		return m.ValidFromHeight
+	}
+	return 0
+}
+
+func (m *PriorEncryptionKey) GetValidFrom() *time.Time {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return nil
+}
+
+func (m *PriorEncryptionKey) GetValidUntilHeight() int64 {
+	if m != nil {
+		return m.ValidUntilHeight
+	}
+	return 0
+}
+
+func (m *PriorEncryptionKey) GetValidUntil() time.Time {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return time.Time{}
+}
+
 // Params defines the parameters for the metadata-locator module methods.
 type OSLocatorParams struct {
 	MaxUriLength uint32 `protobuf:"varint,1,opt,name=max_uri_length,json=maxUriLength,proto3,customtype=uint32" json:"max_uri_length"`
@@ -98,7 +283,7 @@ func (m *OSLocatorParams) Reset()         { *m = OSLocatorParams{} }
 func (m *OSLocatorParams) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorParams) ProtoMessage()    {}
 func (*OSLocatorParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d17fc5ccfa1c263, []int{1}
+	return fileDescriptor_3d17fc5ccfa1c263, []int{3}
 }
 func (m *OSLocatorParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -129,6 +314,8 @@ var xxx_messageInfo_OSLocatorParams proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*ObjectStoreLocator)(nil), "provenance.metadata.v1.ObjectStoreLocator")
+	proto.RegisterType((*ObjectStoreEndpoint)(nil), "provenance.metadata.v1.ObjectStoreEndpoint")
+	proto.RegisterType((*PriorEncryptionKey)(nil), "provenance.metadata.v1.PriorEncryptionKey")
 	proto.RegisterType((*OSLocatorParams)(nil), "provenance.metadata.v1.OSLocatorParams")
 }
 
@@ -137,28 +324,45 @@ func init() {
 }
 
 var fileDescriptor_3d17fc5ccfa1c263 = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4f, 0x02, 0x31,
-	0x14, 0xc7, 0xef, 0x34, 0x92, 0x58, 0x05, 0x93, 0x0b, 0x51, 0x64, 0x38, 0x0c, 0x89, 0x09, 0x31,
-	0xf1, 0x1a, 0x84, 0xc9, 0x91, 0xc5, 0x41, 0x12, 0x08, 0x84, 0xc5, 0x85, 0x94, 0xb3, 0x29, 0x15,
-	0xda, 0x77, 0x69, 0x0b, 0x72, 0x8b, 0x83, 0x9f, 0xc0, 0x8f, 0xe2, 0xc7, 0x60, 0x64, 0x34, 0x0e,
-	0xc4, 0xc0, 0xe0, 0xd7, 0x30, 0xd7, 0xc3, 0x9c, 0x83, 0xdb, 0x7b, 0xff, 0xfe, 0xde, 0xff, 0xb5,
-	0xff, 0xa2, 0x5a, 0xa4, 0x60, 0x4e, 0x25, 0x91, 0x21, 0xc5, 0x82, 0x1a, 0xf2, 0x48, 0x0c, 0xc1,
-	0xf3, 0x3a, 0x86, 0xd1, 0x13, 0x0d, 0x8d, 0x36, 0xa0, 0x68, 0x10, 0x29, 0x30, 0xe0, 0x9d, 0x66,
-	0x64, 0xf0, 0x4b, 0x06, 0xf3, 0x7a, 0xf9, 0x2c, 0x04, 0x2d, 0x40, 0x63, 0xa1, 0x59, 0x32, 0x28,
-	0x34, 0x4b, 0x07, 0xca, 0x45, 0x06, 0x0c, 0x6c, 0x89, 0x93, 0x2a, 0x55, 0xab, 0x2f, 0xc8, 0xeb,
-	0x58, 0xef, 0x7e, 0xe2, 0xdd, 0x86, 0x90, 0x18, 0x50, 0x5e, 0x11, 0x1d, 0xc0, 0xb3, 0xa4, 0xaa,
-	0xe4, 0x5e, 0xb8, 0xb5, 0xc3, 0x5e, 0xda, 0x78, 0x15, 0x74, 0x34, 0x4d, 0x81, 0xe1, 0x4c, 0xf1,
-	0xd2, 0x9e, 0x3d, 0x43, 0x3b, 0x69, 0xa0, 0xb8, 0x77, 0x89, 0x0a, 0x54, 0x86, 0x2a, 0x8e, 0x0c,
-	0x07, 0x39, 0x9c, 0xd0, 0xb8, 0xb4, 0x6f, 0x99, 0x7c, 0xa6, 0xde, 0xd3, 0xf8, 0x16, 0xbd, 0x7e,
-	0xbf, 0x5f, 0xa5, 0x9e, 0xd5, 0x3b, 0x74, 0xd2, 0xe9, 0xef, 0xd6, 0x76, 0x89, 0x22, 0x42, 0x7b,
-	0x4d, 0x54, 0x10, 0x64, 0x91, 0xac, 0x18, 0x4e, 0xa9, 0x64, 0x66, 0x6c, 0x6f, 0x91, 0x6f, 0x15,
-	0x96, 0xeb, 0x8a, 0xf3, 0xb9, 0xae, 0xe4, 0x66, 0x5c, 0x9a, 0xc6, 0x4d, 0xef, 0x58, 0x90, 0xc5,
-	0x40, 0xf1, 0xb6, 0x65, 0x5a, 0x93, 0xe5, 0xc6, 0x77, 0x57, 0x1b, 0xdf, 0xfd, 0xda, 0xf8, 0xee,
-	0xdb, 0xd6, 0x77, 0x56, 0x5b, 0xdf, 0xf9, 0xd8, 0xfa, 0x0e, 0x3a, 0xe7, 0xf6, 0xb1, 0xff, 0x84,
-	0xd5, 0x75, 0x1f, 0x9a, 0x8c, 0x9b, 0xf1, 0x6c, 0x14, 0x84, 0x20, 0x70, 0x06, 0x5d, 0x73, 0xf8,
-	0xd3, 0xe1, 0x45, 0xf6, 0x17, 0x26, 0x8e, 0xa8, 0x1e, 0xe5, 0x6c, 0x78, 0x8d, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x5f, 0x78, 0x72, 0x38, 0xaf, 0x01, 0x00, 0x00,
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0xeb, 0xb4, 0x5f, 0x7b, 0xf3, 0xf5, 0x87, 0x69, 0x01, 0x13, 0x24, 0x27, 0x8a, 0x84,
+	0x14, 0x15, 0xb0, 0xd5, 0x9f, 0x15, 0x12, 0x42, 0x0a, 0x2a, 0x20, 0x51, 0x29, 0x91, 0x43, 0x36,
+	0x6c, 0x2c, 0xc7, 0x99, 0x3a, 0x43, 0x33, 0x1e, 0x6b, 0x66, 0x12, 0x92, 0x2d, 0x4f, 0xd0, 0x17,
+	0xe0, 0x1d, 0x78, 0x8c, 0x2c, 0xbb, 0x44, 0x2c, 0x0a, 0x4a, 0x16, 0xbc, 0x06, 0xf2, 0xd8, 0xa9,
+	0x13, 0x25, 0x15, 0xec, 0xe6, 0xce, 0x3d, 0xf7, 0x9c, 0x7b, 0xcf, 0xf5, 0x18, 0xaa, 0x11, 0x67,
+	0x03, 0x1c, 0x7a, 0xa1, 0x8f, 0x6d, 0x8a, 0xa5, 0xd7, 0xf1, 0xa4, 0x67, 0x0f, 0x8e, 0x6c, 0xd6,
+	0xfe, 0x84, 0x7d, 0x29, 0x24, 0xe3, 0xd8, 0x8a, 0x38, 0x93, 0x0c, 0x3d, 0xc8, 0x90, 0xd6, 0x0c,
+	0x69, 0x0d, 0x8e, 0x8a, 0x0f, 0x7d, 0x26, 0x28, 0x13, 0x36, 0x15, 0x41, 0x5c, 0x48, 0x45, 0x90,
+	0x14, 0x14, 0x0f, 0x02, 0x16, 0x30, 0x75, 0xb4, 0xe3, 0x53, 0x7a, 0x5b, 0x0a, 0x18, 0x0b, 0x7a,
+	0xd8, 0x56, 0x51, 0xbb, 0x7f, 0x61, 0x4b, 0x42, 0xb1, 0x90, 0x1e, 0x8d, 0x12, 0x40, 0x65, 0xac,
+	0x03, 0xaa, 0x2b, 0xf5, 0x66, 0xac, 0x7e, 0xce, 0x7c, 0x4f, 0x32, 0x8e, 0x0e, 0x60, 0x9d, 0x7d,
+	0x0e, 0x31, 0x37, 0xb4, 0xb2, 0x56, 0xdd, 0x72, 0x92, 0x00, 0x95, 0xa0, 0xd0, 0x4b, 0x00, 0x6e,
+	0x9f, 0x13, 0x63, 0x4d, 0xe5, 0x20, 0xbd, 0x6a, 0x71, 0x82, 0x9e, 0xc0, 0x0e, 0x0e, 0x7d, 0x3e,
+	0x8a, 0x24, 0x61, 0xa1, 0x7b, 0x89, 0x47, 0x86, 0xae, 0x30, 0xdb, 0xd9, 0xed, 0x7b, 0x3c, 0x42,
+	0x75, 0xd8, 0xc2, 0x61, 0x27, 0x62, 0x24, 0x94, 0xc2, 0xc8, 0x97, 0xf5, 0x6a, 0xe1, 0xf8, 0xa9,
+	0xb5, 0x7a, 0x60, 0x6b, 0xae, 0xb9, 0xb3, 0xb4, 0xa6, 0x96, 0x1f, 0xdf, 0x94, 0x72, 0x4e, 0xc6,
+	0x81, 0x5e, 0xc2, 0xe3, 0x45, 0x5d, 0x57, 0x90, 0xd0, 0xc7, 0x6e, 0x17, 0x93, 0xa0, 0x2b, 0x8d,
+	0xf5, 0xb2, 0x56, 0xd5, 0x1d, 0x63, 0xa1, 0x89, 0x66, 0x0c, 0x78, 0xa7, 0xf2, 0xc8, 0x81, 0x83,
+	0x55, 0xe5, 0xc6, 0x46, 0x59, 0xab, 0x16, 0x8e, 0x8b, 0x56, 0x62, 0xa2, 0x35, 0x33, 0xd1, 0xfa,
+	0x30, 0x33, 0xb1, 0x96, 0xbf, 0xfa, 0x59, 0xd2, 0x1c, 0xb4, 0xcc, 0x8c, 0x3a, 0x70, 0x3f, 0xe2,
+	0x84, 0x71, 0x77, 0x91, 0x59, 0x18, 0xff, 0xa9, 0x79, 0x0f, 0xef, 0x9a, 0xb7, 0x11, 0x17, 0x9d,
+	0xcd, 0xf3, 0xa5, 0xe3, 0xee, 0x47, 0x4b, 0x19, 0xf1, 0x02, 0xbe, 0xfc, 0xfe, 0x76, 0x98, 0x6c,
+	0xa7, 0xf2, 0x1a, 0xf6, 0x57, 0x98, 0x85, 0xf6, 0x40, 0x8f, 0x97, 0x95, 0x2c, 0x32, 0x3e, 0xa2,
+	0x22, 0x6c, 0x2a, 0x2e, 0x22, 0x47, 0x6a, 0x87, 0xdb, 0xce, 0x6d, 0x5c, 0xf9, 0xba, 0x06, 0x68,
+	0xb9, 0x85, 0x15, 0x8b, 0xd5, 0x56, 0x2d, 0xf6, 0x10, 0xee, 0x0d, 0xbc, 0x1e, 0xe9, 0xb8, 0x17,
+	0x9c, 0xd1, 0x99, 0xfb, 0x6b, 0xca, 0xfd, 0x5d, 0x95, 0x78, 0xc3, 0x19, 0x4d, 0x4d, 0x7f, 0x05,
+	0x90, 0x61, 0x0d, 0xfd, 0x1f, 0xad, 0xde, 0xba, 0xa5, 0x41, 0xcf, 0x00, 0x25, 0x04, 0xfd, 0x50,
+	0x92, 0xde, 0x4c, 0x2d, 0xaf, 0xd4, 0xf6, 0x54, 0xa6, 0x15, 0x27, 0x52, 0xb9, 0x33, 0x28, 0xcc,
+	0xa1, 0x8d, 0xf5, 0xbf, 0xea, 0x6d, 0xc6, 0xae, 0x2b, 0x4d, 0xc8, 0xc8, 0x2a, 0x6f, 0x61, 0xb7,
+	0xde, 0x4c, 0x5f, 0x49, 0xc3, 0xe3, 0x1e, 0x15, 0xe8, 0x14, 0x76, 0xa8, 0x37, 0x8c, 0x5f, 0x84,
+	0xdb, 0xc3, 0x61, 0x20, 0xbb, 0xca, 0x9b, 0xed, 0xda, 0x4e, 0x4c, 0xf0, 0xe3, 0xa6, 0xb4, 0xd1,
+	0x27, 0xa1, 0x3c, 0x39, 0x76, 0xfe, 0xa7, 0xde, 0xb0, 0xc5, 0xc9, 0xb9, 0xc2, 0xd4, 0x2e, 0xc7,
+	0x13, 0x53, 0xbb, 0x9e, 0x98, 0xda, 0xaf, 0x89, 0xa9, 0x5d, 0x4d, 0xcd, 0xdc, 0xf5, 0xd4, 0xcc,
+	0x7d, 0x9f, 0x9a, 0x39, 0x78, 0x44, 0xd8, 0x1d, 0x1f, 0x47, 0x43, 0xfb, 0x78, 0x1a, 0x10, 0xd9,
+	0xed, 0xb7, 0x2d, 0x9f, 0x51, 0x3b, 0x03, 0x3d, 0x27, 0x6c, 0x2e, 0xb2, 0x87, 0xd9, 0xcf, 0x45,
+	0x8e, 0x22, 0x2c, 0xda, 0x1b, 0x6a, 0xbe, 0x93, 0x3f, 0x03, 0x00, 0x7b, 0xcc, 0x5f, 0x28, 0x80,
+	0x04, 0x00, 0x00,
 }
 
 func (m *ObjectStoreLocator) Marshal() (dAtA []byte, err error) {
@@ -181,6 +385,49 @@ func (m *ObjectStoreLocator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriorEncryptionKeys) > 0 {
+		for iNdEx := len(m.PriorEncryptionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriorEncryptionKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintObjectstore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.EncryptionKeySince != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EncryptionKeySince, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EncryptionKeySince):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintObjectstore(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EncryptionKeySinceHeight != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.EncryptionKeySinceHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintObjectstore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.EncryptionKey) > 0 {
 		i -= len(m.EncryptionKey)
 		copy(dAtA[i:], m.EncryptionKey)
@@ -205,6 +452,99 @@ func (m *ObjectStoreLocator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ObjectStoreEndpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectStoreEndpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectStoreEndpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintObjectstore(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriorEncryptionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriorEncryptionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriorEncryptionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ValidUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ValidUntil):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintObjectstore(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if m.ValidUntilHeight != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.ValidUntilHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ValidFrom != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ValidFrom):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintObjectstore(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ValidFromHeight != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.ValidFromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EncryptionKey) > 0 {
+		i -= len(m.EncryptionKey)
+		copy(dAtA[i:], m.EncryptionKey)
+		i = encodeVarintObjectstore(dAtA, i, uint64(len(m.EncryptionKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OSLocatorParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -262,6 +602,66 @@ func (m *ObjectStoreLocator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovObjectstore(uint64(l))
 	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovObjectstore(uint64(l))
+		}
+	}
+	if m.EncryptionKeySinceHeight != 0 {
+		n += 1 + sovObjectstore(uint64(m.EncryptionKeySinceHeight))
+	}
+	if m.EncryptionKeySince != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EncryptionKeySince)
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	if len(m.PriorEncryptionKeys) > 0 {
+		for _, e := range m.PriorEncryptionKeys {
+			l = e.Size()
+			n += 1 + l + sovObjectstore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ObjectStoreEndpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovObjectstore(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *PriorEncryptionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EncryptionKey)
+	if l > 0 {
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	if m.ValidFromHeight != 0 {
+		n += 1 + sovObjectstore(uint64(m.ValidFromHeight))
+	}
+	if m.ValidFrom != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ValidFrom)
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	if m.ValidUntilHeight != 0 {
+		n += 1 + sovObjectstore(uint64(m.ValidUntilHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ValidUntil)
+	n += 1 + l + sovObjectstore(uint64(l))
 	return n
 }
 
@@ -408,6 +808,419 @@ func (m *ObjectStoreLocator) Unmarshal(dAtA []byte) error {
 			}
 			m.EncryptionKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, ObjectStoreEndpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKeySinceHeight", wireType)
+			}
+			m.EncryptionKeySinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EncryptionKeySinceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKeySince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EncryptionKeySince == nil {
+				m.EncryptionKeySince = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EncryptionKeySince, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorEncryptionKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorEncryptionKeys = append(m.PriorEncryptionKeys, PriorEncryptionKey{})
+			if err := m.PriorEncryptionKeys[len(m.PriorEncryptionKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjectstore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectStoreEndpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjectstore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectStoreEndpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectStoreEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjectstore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriorEncryptionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjectstore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriorEncryptionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriorEncryptionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFromHeight", wireType)
+			}
+			m.ValidFromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidFromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidFrom == nil {
+				m.ValidFrom = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntilHeight", wireType)
+			}
+			m.ValidUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ValidUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjectstore(dAtA[iNdEx:])
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestObjectStoreLocatorValidate(t *testing.T) {
+	owner := sdk.AccAddress("locator_owner_______")
+	key := sdk.AccAddress("locator_key_________")
+	newLocator := func(endpoints ...ObjectStoreEndpoint) ObjectStoreLocator {
+		rv := NewOSLocatorRecord(owner, key, "https://primary.example.com")
+		rv.Endpoints = endpoints
+		return rv
+	}
+	tooMany := make([]ObjectStoreEndpoint, MaxOSLocatorEndpoints+1)
+	for i := range tooMany {
+		tooMany[i].Uri = fmt.Sprintf("https://backup%d.example.com", i)
+	}
+
+	tests := []struct {
+		name    string
+		locator ObjectStoreLocator
+		expErr  string
+	}{
+		{
+			name:    "no endpoints",
+			locator: newLocator(),
+		},
+		{
+			name:    "max endpoints",
+			locator: newLocator(tooMany[:MaxOSLocatorEndpoints]...),
+		},
+		{
+			name:    "too many endpoints",
+			locator: newLocator(tooMany...),
+			expErr:  fmt.Sprintf("locator cannot have more than %d endpoints", MaxOSLocatorEndpoints),
+		},
+		{
+			name:    "empty endpoint uri",
+			locator: newLocator(ObjectStoreEndpoint{Uri: "https://backup.example.com"}, ObjectStoreEndpoint{Uri: " "}),
+			expErr:  "endpoint 1 uri cannot be empty",
+		},
+		{
+			name:    "invalid endpoint uri",
+			locator: newLocator(ObjectStoreEndpoint{Uri: "://backup.example.com"}),
+			expErr:  "invalid endpoint 0 uri: ://backup.example.com",
+		},
+		{
+			name:    "endpoint same as locator uri",
+			locator: newLocator(ObjectStoreEndpoint{Uri: "https://primary.example.com"}),
+			expErr:  "duplicate endpoint uri: https://primary.example.com",
+		},
+		{
+			name: "duplicate endpoints",
+			locator: newLocator(
+				ObjectStoreEndpoint{Uri: "https://backup.example.com", Priority: 1},
+				ObjectStoreEndpoint{Uri: "https://backup.example.com", Priority: 2},
+			),
+			expErr: "duplicate endpoint uri: https://backup.example.com",
+		},
+		{
+			name: "valid prior encryption key",
+			locator: func() ObjectStoreLocator {
+				rv := newLocator()
+				rv.PriorEncryptionKeys = []PriorEncryptionKey{{EncryptionKey: key.String(), ValidFromHeight: 3, ValidUntilHeight: 5, ValidUntil: time.Unix(1000, 0)}}
+				return rv
+			}(),
+		},
+		{
+			name: "prior encryption key until before from",
+			locator: func() ObjectStoreLocator {
+				rv := newLocator()
+				rv.PriorEncryptionKeys = []PriorEncryptionKey{{EncryptionKey: key.String(), ValidFromHeight: 5, ValidUntilHeight: 3, ValidUntil: time.Unix(1000, 0)}}
+				return rv
+			}(),
+			expErr: "invalid prior encryption key 0: valid until height 3 cannot be less than valid from height 5",
+		},
+		{
+			name: "prior encryption key without until time",
+			locator: func() ObjectStoreLocator {
+				rv := newLocator()
+				rv.PriorEncryptionKeys = []PriorEncryptionKey{{EncryptionKey: key.String(), ValidUntilHeight: 3}}
+				return rv
+			}(),
+			expErr: "invalid prior encryption key 0: valid until time cannot be zero",
+		},
+		{
+			name: "too many prior encryption keys",
+			locator: func() ObjectStoreLocator {
+				rv := newLocator()
+				prior := PriorEncryptionKey{EncryptionKey: key.String(), ValidFromHeight: 3, ValidUntilHeight: 5, ValidUntil: time.Unix(1000, 0)}
+				for i := 0; i <= MaxOSLocatorPriorEncryptionKeys; i++ {
+					rv.PriorEncryptionKeys = append(rv.PriorEncryptionKeys, prior)
+				}
+				return rv
+			}(),
+			expErr: fmt.Sprintf("locator cannot have more than %d prior encryption keys", MaxOSLocatorPriorEncryptionKeys),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.locator.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestObjectStoreLocatorGetURIs(t *testing.T) {
+	locator := NewOSLocatorRecord(sdk.AccAddress("locator_owner_______"), nil, "https://primary.example.com")
+	assert.Equal(t, []string{"https://primary.example.com"}, locator.GetURIs(), "GetURIs without endpoints")
+
+	locator.Endpoints = []ObjectStoreEndpoint{
+		{Uri: "https://c.example.com", Priority: 5},
+		{Uri: "https://a.example.com", Priority: 1},
+		{Uri: "https://b.example.com", Priority: 5},
+	}
+	exp := []string{"https://primary.example.com", "https://a.example.com", "https://c.example.com", "https://b.example.com"}
+	assert.Equal(t, exp, locator.GetURIs(), "GetURIs with endpoints")
+	assert.Equal(t, "https://c.example.com", locator.Endpoints[0].Uri, "first endpoint after GetURIs")
+}
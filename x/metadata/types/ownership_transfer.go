@@ -0,0 +1,90 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxScopeOwnershipTransferDuration is the longest that a scope ownership transfer can wait to be accepted.
+const MaxScopeOwnershipTransferDuration = 30 * 24 * time.Hour
+
+// GetScopeOwnershipTransferRecipients gets the addresses that must accept a change of a scope's owners and/or value owner:
+// the new value owner (if provided), and each new owner that isn't one of the current owners.
+func GetScopeOwnershipTransferRecipients(currentOwners, owners []Party, valueOwner string) []string {
+	var rv []string
+	if len(valueOwner) > 0 {
+		rv = append(rv, valueOwner)
+	}
+	current := GetPartyAddresses(currentOwners)
+	for _, addr := range GetPartyAddresses(owners) {
+		if !slices.Contains(current, addr) && !slices.Contains(rv, addr) {
+			rv = append(rv, addr)
+		}
+	}
+	return rv
+}
+
+// IsRecipient returns true if the provided address needs to accept this transfer.
+func (t ScopeOwnershipTransfer) IsRecipient(addr string) bool {
+	return slices.Contains(t.Recipients, addr)
+}
+
+// IsProposer returns true if the provided address signed the proposal of this transfer.
+func (t ScopeOwnershipTransfer) IsProposer(addr string) bool {
+	return slices.Contains(t.ProposedBy, addr)
+}
+
+// IsExpired returns true if this transfer can no longer be accepted at the provided block time.
+func (t ScopeOwnershipTransfer) IsExpired(blockTime time.Time) bool {
+	return blockTime.After(t.Expiration)
+}
+
+// Validate returns an error if this ScopeOwnershipTransfer is not in a valid state.
+func (t ScopeOwnershipTransfer) Validate() error {
+	if err := t.ScopeId.ValidateIsScopeAddress(); err != nil {
+		return fmt.Errorf("invalid scope ownership transfer scope id: %w", err)
+	}
+	if len(t.Owners) == 0 && len(t.ValueOwnerAddress) == 0 {
+		return fmt.Errorf("scope %s ownership transfer must have either owners or a value owner address", t.ScopeId)
+	}
+	if len(t.Owners) > 0 {
+		if err := ValidatePartiesBasic(t.Owners); err != nil {
+			return fmt.Errorf("invalid scope %s ownership transfer owners: %w", t.ScopeId, err)
+		}
+	}
+	if len(t.ValueOwnerAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(t.ValueOwnerAddress); err != nil {
+			return fmt.Errorf("invalid scope %s ownership transfer value owner address %q: %w", t.ScopeId, t.ValueOwnerAddress, err)
+		}
+	}
+	if len(t.Recipients) == 0 {
+		return fmt.Errorf("scope %s ownership transfer must have at least one recipient", t.ScopeId)
+	}
+	for _, addr := range t.Recipients {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid scope %s ownership transfer recipient %q: %w", t.ScopeId, addr, err)
+		}
+	}
+	if len(t.ProposedBy) == 0 {
+		return fmt.Errorf("scope %s ownership transfer must have at least one proposer", t.ScopeId)
+	}
+	for _, addr := range t.ProposedBy {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid scope %s ownership transfer proposer %q: %w", t.ScopeId, addr, err)
+		}
+	}
+	if len(t.CurrentValueOwnerAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(t.CurrentValueOwnerAddress); err != nil {
+			return fmt.Errorf("invalid scope %s ownership transfer current value owner address %q: %w",
+				t.ScopeId, t.CurrentValueOwnerAddress, err)
+		}
+	}
+	if t.Expiration.IsZero() {
+		return errors.New("scope ownership transfer expiration cannot be zero")
+	}
+	return nil
+}
@@ -10,19 +10,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -498,6 +503,226 @@ func (m *ScopesAllResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// ScopeSearchRequest is the request type for the Query/ScopeSearch RPC method.
+type ScopeSearchRequest struct {
+	// scope_spec_id, if provided, limits the results to scopes with this scope specification. It can either be a uuid,
+	// e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope specification address, e.g.
+	// scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
+	ScopeSpecId string `protobuf:"bytes,1,opt,name=scope_spec_id,json=scopeSpecId,proto3" json:"scope_spec_id,omitempty"`
+	// owner, if provided, limits the results to scopes that have this bech32 address as an owner.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// owner_role, if provided, limits the results to scopes where the owner has this role. Requires an owner.
+	OwnerRole PartyType `protobuf:"varint,3,opt,name=owner_role,json=ownerRole,proto3,enum=provenance.metadata.v1.PartyType" json:"owner_role,omitempty"`
+	// value_owner, if provided, limits the results to scopes with this bech32 address as the value owner.
+	ValueOwner string `protobuf:"bytes,4,opt,name=value_owner,json=valueOwner,proto3" json:"value_owner,omitempty"`
+	// data_access, if provided, limits the results to scopes that have this bech32 address in their data access list.
+	DataAccess string `protobuf:"bytes,5,opt,name=data_access,json=dataAccess,proto3" json:"data_access,omitempty"`
+	// nav_denom, if provided, limits the results to scopes that have a net asset value in this price denom.
+	NavDenom string `protobuf:"bytes,6,opt,name=nav_denom,json=navDenom,proto3" json:"nav_denom,omitempty"`
+	// min_created_height, if not zero, limits the results to scopes created at or after this block height.
+	MinCreatedHeight int64 `protobuf:"varint,7,opt,name=min_created_height,json=minCreatedHeight,proto3" json:"min_created_height,omitempty"`
+	// max_created_height, if not zero, limits the results to scopes created at or before this block height.
+	MaxCreatedHeight int64 `protobuf:"varint,8,opt,name=max_created_height,json=maxCreatedHeight,proto3" json:"max_created_height,omitempty"`
+	// min_updated_height, if not zero, limits the results to scopes last updated at or after this block height.
+	MinUpdatedHeight int64 `protobuf:"varint,9,opt,name=min_updated_height,json=minUpdatedHeight,proto3" json:"min_updated_height,omitempty"`
+	// max_updated_height, if not zero, limits the results to scopes last updated at or before this block height.
+	MaxUpdatedHeight int64 `protobuf:"varint,10,opt,name=max_updated_height,json=maxUpdatedHeight,proto3" json:"max_updated_height,omitempty"`
+	// exclude_id_info is a flag for whether to exclude the id info from the response.
+	ExcludeIdInfo bool `protobuf:"varint,12,opt,name=exclude_id_info,json=excludeIdInfo,proto3" json:"exclude_id_info,omitempty"`
+	// include_request is a flag for whether to include this request in your result.
+	IncludeRequest bool `protobuf:"varint,98,opt,name=include_request,json=includeRequest,proto3" json:"include_request,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ScopeSearchRequest) Reset()         { *m = ScopeSearchRequest{} }
+func (m *ScopeSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeSearchRequest) ProtoMessage()    {}
+func (*ScopeSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{7}
+}
+func (m *ScopeSearchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeSearchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSearchRequest.Merge(m, src)
+}
+func (m *ScopeSearchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeSearchRequest proto.InternalMessageInfo
+
+func (m *ScopeSearchRequest) GetScopeSpecId() string {
+	if m != nil {
+		return m.ScopeSpecId
+	}
+	return ""
+}
+
+func (m *ScopeSearchRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ScopeSearchRequest) GetOwnerRole() PartyType {
+	if m != nil {
+		return m.OwnerRole
+	}
+	return PartyType_PARTY_TYPE_UNSPECIFIED
+}
+
+func (m *ScopeSearchRequest) GetValueOwner() string {
+	if m != nil {
+		return m.ValueOwner
+	}
+	return ""
+}
+
+func (m *ScopeSearchRequest) GetDataAccess() string {
+	if m != nil {
+		return m.DataAccess
+	}
+	return ""
+}
+
+func (m *ScopeSearchRequest) GetNavDenom() string {
+	if m != nil {
+		return m.NavDenom
+	}
+	return ""
+}
+
+func (m *ScopeSearchRequest) GetMinCreatedHeight() int64 {
+	if m != nil {
+		return m.MinCreatedHeight
+	}
+	return 0
+}
+
+func (m *ScopeSearchRequest) GetMaxCreatedHeight() int64 {
+	if m != nil {
+		return m.MaxCreatedHeight
+	}
+	return 0
+}
+
+func (m *ScopeSearchRequest) GetMinUpdatedHeight() int64 {
+	if m != nil {
+		return m.MinUpdatedHeight
+	}
+	return 0
+}
+
+func (m *ScopeSearchRequest) GetMaxUpdatedHeight() int64 {
+	if m != nil {
+		return m.MaxUpdatedHeight
+	}
+	return 0
+}
+
+func (m *ScopeSearchRequest) GetExcludeIdInfo() bool {
+	if m != nil {
+		return m.ExcludeIdInfo
+	}
+	return false
+}
+
+func (m *ScopeSearchRequest) GetIncludeRequest() bool {
+	if m != nil {
+		return m.IncludeRequest
+	}
+	return false
+}
+
+func (m *ScopeSearchRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ScopeSearchResponse is the response type for the Query/ScopeSearch RPC method.
+type ScopeSearchResponse struct {
+	// scopes are the wrapped scopes that match the filters.
+	Scopes []*ScopeWrapper `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *ScopeSearchRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ScopeSearchResponse) Reset()         { *m = ScopeSearchResponse{} }
+func (m *ScopeSearchResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeSearchResponse) ProtoMessage()    {}
+func (*ScopeSearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{8}
+}
+func (m *ScopeSearchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeSearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeSearchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeSearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSearchResponse.Merge(m, src)
+}
+func (m *ScopeSearchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeSearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSearchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeSearchResponse proto.InternalMessageInfo
+
+func (m *ScopeSearchResponse) GetScopes() []*ScopeWrapper {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *ScopeSearchResponse) GetRequest() *ScopeSearchRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ScopeSearchResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // SessionsRequest is the request type for the Query/Sessions RPC method.
 type SessionsRequest struct {
 	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
@@ -525,7 +750,7 @@ func (m *SessionsRequest) Reset()         { *m = SessionsRequest{} }
 func (m *SessionsRequest) String() string { return proto.CompactTextString(m) }
 func (*SessionsRequest) ProtoMessage()    {}
 func (*SessionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{7}
+	return fileDescriptor_a68790bc0b96eeb9, []int{9}
 }
 func (m *SessionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -626,7 +851,7 @@ func (m *SessionsResponse) Reset()         { *m = SessionsResponse{} }
 func (m *SessionsResponse) String() string { return proto.CompactTextString(m) }
 func (*SessionsResponse) ProtoMessage()    {}
 func (*SessionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{8}
+	return fileDescriptor_a68790bc0b96eeb9, []int{10}
 }
 func (m *SessionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,7 +922,7 @@ func (m *SessionWrapper) Reset()         { *m = SessionWrapper{} }
 func (m *SessionWrapper) String() string { return proto.CompactTextString(m) }
 func (*SessionWrapper) ProtoMessage()    {}
 func (*SessionWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{9}
+	return fileDescriptor_a68790bc0b96eeb9, []int{11}
 }
 func (m *SessionWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +986,7 @@ func (m *SessionsAllRequest) Reset()         { *m = SessionsAllRequest{} }
 func (m *SessionsAllRequest) String() string { return proto.CompactTextString(m) }
 func (*SessionsAllRequest) ProtoMessage()    {}
 func (*SessionsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{10}
+	return fileDescriptor_a68790bc0b96eeb9, []int{12}
 }
 func (m *SessionsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -825,7 +1050,7 @@ func (m *SessionsAllResponse) Reset()         { *m = SessionsAllResponse{} }
 func (m *SessionsAllResponse) String() string { return proto.CompactTextString(m) }
 func (*SessionsAllResponse) ProtoMessage()    {}
 func (*SessionsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{11}
+	return fileDescriptor_a68790bc0b96eeb9, []int{13}
 }
 func (m *SessionsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -902,7 +1127,7 @@ func (m *RecordsRequest) Reset()         { *m = RecordsRequest{} }
 func (m *RecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordsRequest) ProtoMessage()    {}
 func (*RecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{12}
+	return fileDescriptor_a68790bc0b96eeb9, []int{14}
 }
 func (m *RecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1228,7 @@ func (m *RecordsResponse) Reset()         { *m = RecordsResponse{} }
 func (m *RecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordsResponse) ProtoMessage()    {}
 func (*RecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{13}
+	return fileDescriptor_a68790bc0b96eeb9, []int{15}
 }
 func (m *RecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1074,7 +1299,7 @@ func (m *RecordWrapper) Reset()         { *m = RecordWrapper{} }
 func (m *RecordWrapper) String() string { return proto.CompactTextString(m) }
 func (*RecordWrapper) ProtoMessage()    {}
 func (*RecordWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{14}
+	return fileDescriptor_a68790bc0b96eeb9, []int{16}
 }
 func (m *RecordWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1363,7 @@ func (m *RecordsAllRequest) Reset()         { *m = RecordsAllRequest{} }
 func (m *RecordsAllRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordsAllRequest) ProtoMessage()    {}
 func (*RecordsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{15}
+	return fileDescriptor_a68790bc0b96eeb9, []int{17}
 }
 func (m *RecordsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1202,7 +1427,7 @@ func (m *RecordsAllResponse) Reset()         { *m = RecordsAllResponse{} }
 func (m *RecordsAllResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordsAllResponse) ProtoMessage()    {}
 func (*RecordsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{16}
+	return fileDescriptor_a68790bc0b96eeb9, []int{18}
 }
 func (m *RecordsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1265,7 +1490,7 @@ func (m *OwnershipRequest) Reset()         { *m = OwnershipRequest{} }
 func (m *OwnershipRequest) String() string { return proto.CompactTextString(m) }
 func (*OwnershipRequest) ProtoMessage()    {}
 func (*OwnershipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{17}
+	return fileDescriptor_a68790bc0b96eeb9, []int{19}
 }
 func (m *OwnershipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1329,7 +1554,7 @@ func (m *OwnershipResponse) Reset()         { *m = OwnershipResponse{} }
 func (m *OwnershipResponse) String() string { return proto.CompactTextString(m) }
 func (*OwnershipResponse) ProtoMessage()    {}
 func (*OwnershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{18}
+	return fileDescriptor_a68790bc0b96eeb9, []int{20}
 }
 func (m *OwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1392,7 +1617,7 @@ func (m *ValueOwnershipRequest) Reset()         { *m = ValueOwnershipRequest{} }
 func (m *ValueOwnershipRequest) String() string { return proto.CompactTextString(m) }
 func (*ValueOwnershipRequest) ProtoMessage()    {}
 func (*ValueOwnershipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{19}
+	return fileDescriptor_a68790bc0b96eeb9, []int{21}
 }
 func (m *ValueOwnershipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1456,7 +1681,7 @@ func (m *ValueOwnershipResponse) Reset()         { *m = ValueOwnershipResponse{}
 func (m *ValueOwnershipResponse) String() string { return proto.CompactTextString(m) }
 func (*ValueOwnershipResponse) ProtoMessage()    {}
 func (*ValueOwnershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{20}
+	return fileDescriptor_a68790bc0b96eeb9, []int{22}
 }
 func (m *ValueOwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1527,7 +1752,7 @@ func (m *ScopeSpecificationRequest) Reset()         { *m = ScopeSpecificationReq
 func (m *ScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*ScopeSpecificationRequest) ProtoMessage()    {}
 func (*ScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{21}
+	return fileDescriptor_a68790bc0b96eeb9, []int{23}
 }
 func (m *ScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1832,7 @@ func (m *ScopeSpecificationResponse) Reset()         { *m = ScopeSpecificationRe
 func (m *ScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*ScopeSpecificationResponse) ProtoMessage()    {}
 func (*ScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{22}
+	return fileDescriptor_a68790bc0b96eeb9, []int{24}
 }
 func (m *ScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1676,7 +1901,7 @@ func (m *ScopeSpecificationWrapper) Reset()         { *m = ScopeSpecificationWra
 func (m *ScopeSpecificationWrapper) String() string { return proto.CompactTextString(m) }
 func (*ScopeSpecificationWrapper) ProtoMessage()    {}
 func (*ScopeSpecificationWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{23}
+	return fileDescriptor_a68790bc0b96eeb9, []int{25}
 }
 func (m *ScopeSpecificationWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1733,7 +1958,7 @@ func (m *ScopeSpecificationsAllRequest) Reset()         { *m = ScopeSpecificatio
 func (m *ScopeSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
 func (*ScopeSpecificationsAllRequest) ProtoMessage()    {}
 func (*ScopeSpecificationsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{24}
+	return fileDescriptor_a68790bc0b96eeb9, []int{26}
 }
 func (m *ScopeSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1797,7 +2022,7 @@ func (m *ScopeSpecificationsAllResponse) Reset()         { *m = ScopeSpecificati
 func (m *ScopeSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
 func (*ScopeSpecificationsAllResponse) ProtoMessage()    {}
 func (*ScopeSpecificationsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{25}
+	return fileDescriptor_a68790bc0b96eeb9, []int{27}
 }
 func (m *ScopeSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1867,7 +2092,7 @@ func (m *ContractSpecificationRequest) Reset()         { *m = ContractSpecificat
 func (m *ContractSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractSpecificationRequest) ProtoMessage()    {}
 func (*ContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{26}
+	return fileDescriptor_a68790bc0b96eeb9, []int{28}
 }
 func (m *ContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1939,7 +2164,7 @@ func (m *ContractSpecificationResponse) Reset()         { *m = ContractSpecifica
 func (m *ContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractSpecificationResponse) ProtoMessage()    {}
 func (*ContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{27}
+	return fileDescriptor_a68790bc0b96eeb9, []int{29}
 }
 func (m *ContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2001,7 +2226,7 @@ func (m *ContractSpecificationWrapper) Reset()         { *m = ContractSpecificat
 func (m *ContractSpecificationWrapper) String() string { return proto.CompactTextString(m) }
 func (*ContractSpecificationWrapper) ProtoMessage()    {}
 func (*ContractSpecificationWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{28}
+	return fileDescriptor_a68790bc0b96eeb9, []int{30}
 }
 func (m *ContractSpecificationWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2058,7 +2283,7 @@ func (m *ContractSpecificationsAllRequest) Reset()         { *m = ContractSpecif
 func (m *ContractSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractSpecificationsAllRequest) ProtoMessage()    {}
 func (*ContractSpecificationsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{29}
+	return fileDescriptor_a68790bc0b96eeb9, []int{31}
 }
 func (m *ContractSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2122,7 +2347,7 @@ func (m *ContractSpecificationsAllResponse) Reset()         { *m = ContractSpeci
 func (m *ContractSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractSpecificationsAllResponse) ProtoMessage()    {}
 func (*ContractSpecificationsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{30}
+	return fileDescriptor_a68790bc0b96eeb9, []int{32}
 }
 func (m *ContractSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2194,7 +2419,7 @@ func (m *RecordSpecificationsForContractSpecificationRequest) String() string {
 }
 func (*RecordSpecificationsForContractSpecificationRequest) ProtoMessage() {}
 func (*RecordSpecificationsForContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{31}
+	return fileDescriptor_a68790bc0b96eeb9, []int{33}
 }
 func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2265,7 +2490,7 @@ func (m *RecordSpecificationsForContractSpecificationResponse) String() string {
 }
 func (*RecordSpecificationsForContractSpecificationResponse) ProtoMessage() {}
 func (*RecordSpecificationsForContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{32}
+	return fileDescriptor_a68790bc0b96eeb9, []int{34}
 }
 func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2343,7 +2568,7 @@ func (m *RecordSpecificationRequest) Reset()         { *m = RecordSpecificationR
 func (m *RecordSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordSpecificationRequest) ProtoMessage()    {}
 func (*RecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{33}
+	return fileDescriptor_a68790bc0b96eeb9, []int{35}
 }
 func (m *RecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2412,7 +2637,7 @@ func (m *RecordSpecificationResponse) Reset()         { *m = RecordSpecification
 func (m *RecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordSpecificationResponse) ProtoMessage()    {}
 func (*RecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{34}
+	return fileDescriptor_a68790bc0b96eeb9, []int{36}
 }
 func (m *RecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2467,7 +2692,7 @@ func (m *RecordSpecificationWrapper) Reset()         { *m = RecordSpecificationW
 func (m *RecordSpecificationWrapper) String() string { return proto.CompactTextString(m) }
 func (*RecordSpecificationWrapper) ProtoMessage()    {}
 func (*RecordSpecificationWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{35}
+	return fileDescriptor_a68790bc0b96eeb9, []int{37}
 }
 func (m *RecordSpecificationWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2524,7 +2749,7 @@ func (m *RecordSpecificationsAllRequest) Reset()         { *m = RecordSpecificat
 func (m *RecordSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordSpecificationsAllRequest) ProtoMessage()    {}
 func (*RecordSpecificationsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{36}
+	return fileDescriptor_a68790bc0b96eeb9, []int{38}
 }
 func (m *RecordSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2588,7 +2813,7 @@ func (m *RecordSpecificationsAllResponse) Reset()         { *m = RecordSpecifica
 func (m *RecordSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordSpecificationsAllResponse) ProtoMessage()    {}
 func (*RecordSpecificationsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{37}
+	return fileDescriptor_a68790bc0b96eeb9, []int{39}
 }
 func (m *RecordSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2873,7 @@ func (m *GetByAddrRequest) Reset()         { *m = GetByAddrRequest{} }
 func (m *GetByAddrRequest) String() string { return proto.CompactTextString(m) }
 func (*GetByAddrRequest) ProtoMessage()    {}
 func (*GetByAddrRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{38}
+	return fileDescriptor_a68790bc0b96eeb9, []int{40}
 }
 func (m *GetByAddrRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2706,7 +2931,7 @@ func (m *GetByAddrResponse) Reset()         { *m = GetByAddrResponse{} }
 func (m *GetByAddrResponse) String() string { return proto.CompactTextString(m) }
 func (*GetByAddrResponse) ProtoMessage()    {}
 func (*GetByAddrResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{39}
+	return fileDescriptor_a68790bc0b96eeb9, []int{41}
 }
 func (m *GetByAddrResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2794,7 +3019,7 @@ func (m *OSLocatorParamsRequest) Reset()         { *m = OSLocatorParamsRequest{}
 func (m *OSLocatorParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorParamsRequest) ProtoMessage()    {}
 func (*OSLocatorParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{40}
+	return fileDescriptor_a68790bc0b96eeb9, []int{42}
 }
 func (m *OSLocatorParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2842,7 +3067,7 @@ func (m *OSLocatorParamsResponse) Reset()         { *m = OSLocatorParamsResponse
 func (m *OSLocatorParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorParamsResponse) ProtoMessage()    {}
 func (*OSLocatorParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{41}
+	return fileDescriptor_a68790bc0b96eeb9, []int{43}
 }
 func (m *OSLocatorParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2896,7 +3121,7 @@ func (m *OSLocatorRequest) Reset()         { *m = OSLocatorRequest{} }
 func (m *OSLocatorRequest) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorRequest) ProtoMessage()    {}
 func (*OSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{42}
+	return fileDescriptor_a68790bc0b96eeb9, []int{44}
 }
 func (m *OSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2950,7 +3175,7 @@ func (m *OSLocatorResponse) Reset()         { *m = OSLocatorResponse{} }
 func (m *OSLocatorResponse) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorResponse) ProtoMessage()    {}
 func (*OSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{43}
+	return fileDescriptor_a68790bc0b96eeb9, []int{45}
 }
 func (m *OSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3006,7 +3231,7 @@ func (m *OSLocatorsByURIRequest) Reset()         { *m = OSLocatorsByURIRequest{}
 func (m *OSLocatorsByURIRequest) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorsByURIRequest) ProtoMessage()    {}
 func (*OSLocatorsByURIRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{44}
+	return fileDescriptor_a68790bc0b96eeb9, []int{46}
 }
 func (m *OSLocatorsByURIRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3069,7 +3294,7 @@ func (m *OSLocatorsByURIResponse) Reset()         { *m = OSLocatorsByURIResponse
 func (m *OSLocatorsByURIResponse) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorsByURIResponse) ProtoMessage()    {}
 func (*OSLocatorsByURIResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{45}
+	return fileDescriptor_a68790bc0b96eeb9, []int{47}
 }
 func (m *OSLocatorsByURIResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3130,7 +3355,7 @@ func (m *OSLocatorsByScopeRequest) Reset()         { *m = OSLocatorsByScopeReque
 func (m *OSLocatorsByScopeRequest) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorsByScopeRequest) ProtoMessage()    {}
 func (*OSLocatorsByScopeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{46}
+	return fileDescriptor_a68790bc0b96eeb9, []int{48}
 }
 func (m *OSLocatorsByScopeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3175,7 +3400,11 @@ func (m *OSLocatorsByScopeRequest) GetIncludeRequest() bool {
 
 // OSLocatorsByScopeResponse is the response type for the Query/OSLocatorsByScope RPC method.
 type OSLocatorsByScopeResponse struct {
+	// locators are the object store locators of the scope's owners.
 	Locators []ObjectStoreLocator `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators"`
+	// data_access_locators are the object store locators of the scope's data access addresses whose access hasn't
+	// expired. Addresses that are also owners of the scope are only included in locators.
+	DataAccessLocators []ObjectStoreLocator `protobuf:"bytes,2,rep,name=data_access_locators,json=dataAccessLocators,proto3" json:"data_access_locators"`
 	// request is a copy of the request that generated these results.
 	Request *OSLocatorsByScopeRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
@@ -3184,7 +3413,7 @@ func (m *OSLocatorsByScopeResponse) Reset()         { *m = OSLocatorsByScopeResp
 func (m *OSLocatorsByScopeResponse) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorsByScopeResponse) ProtoMessage()    {}
 func (*OSLocatorsByScopeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{47}
+	return fileDescriptor_a68790bc0b96eeb9, []int{49}
 }
 func (m *OSLocatorsByScopeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3220,6 +3449,13 @@ func (m *OSLocatorsByScopeResponse) GetLocators() []ObjectStoreLocator {
 	return nil
 }
 
+func (m *OSLocatorsByScopeResponse) GetDataAccessLocators() []ObjectStoreLocator {
+	if m != nil {
+		return m.DataAccessLocators
+	}
+	return nil
+}
+
 func (m *OSLocatorsByScopeResponse) GetRequest() *OSLocatorsByScopeRequest {
 	if m != nil {
 		return m.Request
@@ -3239,7 +3475,7 @@ func (m *OSAllLocatorsRequest) Reset()         { *m = OSAllLocatorsRequest{} }
 func (m *OSAllLocatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*OSAllLocatorsRequest) ProtoMessage()    {}
 func (*OSAllLocatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{48}
+	return fileDescriptor_a68790bc0b96eeb9, []int{50}
 }
 func (m *OSAllLocatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3295,7 +3531,7 @@ func (m *OSAllLocatorsResponse) Reset()         { *m = OSAllLocatorsResponse{} }
 func (m *OSAllLocatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*OSAllLocatorsResponse) ProtoMessage()    {}
 func (*OSAllLocatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{49}
+	return fileDescriptor_a68790bc0b96eeb9, []int{51}
 }
 func (m *OSAllLocatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3356,7 +3592,7 @@ func (m *AccountDataRequest) Reset()         { *m = AccountDataRequest{} }
 func (m *AccountDataRequest) String() string { return proto.CompactTextString(m) }
 func (*AccountDataRequest) ProtoMessage()    {}
 func (*AccountDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{50}
+	return fileDescriptor_a68790bc0b96eeb9, []int{52}
 }
 func (m *AccountDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3395,7 +3631,7 @@ func (m *AccountDataResponse) Reset()         { *m = AccountDataResponse{} }
 func (m *AccountDataResponse) String() string { return proto.CompactTextString(m) }
 func (*AccountDataResponse) ProtoMessage()    {}
 func (*AccountDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{51}
+	return fileDescriptor_a68790bc0b96eeb9, []int{53}
 }
 func (m *AccountDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3435,13 +3671,23 @@ func (m *AccountDataResponse) GetValue() string {
 type QueryScopeNetAssetValuesRequest struct {
 	// scopeid metadata address
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// price_denom, if provided, limits the results to net asset values with this price denom.
+	PriceDenom string `protobuf:"bytes,2,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// as_of, if provided, returns the net asset values (from the history) that were in effect at this time.
+	AsOf *time.Time `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3,stdtime" json:"as_of,omitempty"`
+	// history, if true, returns the recorded history of net asset values instead of the current ones.
+	History bool `protobuf:"varint,4,opt,name=history,proto3" json:"history,omitempty"`
+	// start_time, if provided, limits the history to entries recorded at or after this time.
+	StartTime *time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// end_time, if provided, limits the history to entries recorded before this time.
+	EndTime *time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
 }
 
 func (m *QueryScopeNetAssetValuesRequest) Reset()         { *m = QueryScopeNetAssetValuesRequest{} }
 func (m *QueryScopeNetAssetValuesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryScopeNetAssetValuesRequest) ProtoMessage()    {}
 func (*QueryScopeNetAssetValuesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{52}
+	return fileDescriptor_a68790bc0b96eeb9, []int{54}
 }
 func (m *QueryScopeNetAssetValuesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3477,24 +3723,63 @@ func (m *QueryScopeNetAssetValuesRequest) GetId() string {
 	return ""
 }
 
-// QueryNetAssetValuesRequest is the response type for the Query/NetAssetValues method.
-type QueryScopeNetAssetValuesResponse struct {
-	// net asset values for scope
-	NetAssetValues []NetAssetValue `protobuf:"bytes,1,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+func (m *QueryScopeNetAssetValuesRequest) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
 }
 
-func (m *QueryScopeNetAssetValuesResponse) Reset()         { *m = QueryScopeNetAssetValuesResponse{} }
-func (m *QueryScopeNetAssetValuesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryScopeNetAssetValuesResponse) ProtoMessage()    {}
-func (*QueryScopeNetAssetValuesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{53}
+func (m *QueryScopeNetAssetValuesRequest) GetAsOf() *time.Time {
+	if m != nil {
+		return m.AsOf
+	}
+	return nil
 }
-func (m *QueryScopeNetAssetValuesResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *QueryScopeNetAssetValuesRequest) GetHistory() bool {
+	if m != nil {
+		return m.History
+	}
+	return false
 }
-func (m *QueryScopeNetAssetValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryScopeNetAssetValuesResponse.Marshal(b, m, deterministic)
+
+func (m *QueryScopeNetAssetValuesRequest) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *QueryScopeNetAssetValuesRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+// QueryNetAssetValuesRequest is the response type for the Query/NetAssetValues method.
+type QueryScopeNetAssetValuesResponse struct {
+	// net asset values for scope
+	NetAssetValues []NetAssetValue `protobuf:"bytes,1,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+	// history is the recorded history of net asset values (only populated when history is requested).
+	History []NetAssetValueRecord `protobuf:"bytes,2,rep,name=history,proto3" json:"history"`
+	// max_age is the number of blocks after which the scope's net asset values are stale. Zero means they never are.
+	MaxAge uint64 `protobuf:"varint,3,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+}
+
+func (m *QueryScopeNetAssetValuesResponse) Reset()         { *m = QueryScopeNetAssetValuesResponse{} }
+func (m *QueryScopeNetAssetValuesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScopeNetAssetValuesResponse) ProtoMessage()    {}
+func (*QueryScopeNetAssetValuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{55}
+}
+func (m *QueryScopeNetAssetValuesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScopeNetAssetValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScopeNetAssetValuesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3523,3082 +3808,2613 @@ func (m *QueryScopeNetAssetValuesResponse) GetNetAssetValues() []NetAssetValue {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.metadata.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.metadata.v1.QueryParamsResponse")
-	proto.RegisterType((*ScopeRequest)(nil), "provenance.metadata.v1.ScopeRequest")
-	proto.RegisterType((*ScopeResponse)(nil), "provenance.metadata.v1.ScopeResponse")
-	proto.RegisterType((*ScopeWrapper)(nil), "provenance.metadata.v1.ScopeWrapper")
-	proto.RegisterType((*ScopesAllRequest)(nil), "provenance.metadata.v1.ScopesAllRequest")
-	proto.RegisterType((*ScopesAllResponse)(nil), "provenance.metadata.v1.ScopesAllResponse")
-	proto.RegisterType((*SessionsRequest)(nil), "provenance.metadata.v1.SessionsRequest")
-	proto.RegisterType((*SessionsResponse)(nil), "provenance.metadata.v1.SessionsResponse")
-	proto.RegisterType((*SessionWrapper)(nil), "provenance.metadata.v1.SessionWrapper")
-	proto.RegisterType((*SessionsAllRequest)(nil), "provenance.metadata.v1.SessionsAllRequest")
-	proto.RegisterType((*SessionsAllResponse)(nil), "provenance.metadata.v1.SessionsAllResponse")
-	proto.RegisterType((*RecordsRequest)(nil), "provenance.metadata.v1.RecordsRequest")
-	proto.RegisterType((*RecordsResponse)(nil), "provenance.metadata.v1.RecordsResponse")
-	proto.RegisterType((*RecordWrapper)(nil), "provenance.metadata.v1.RecordWrapper")
-	proto.RegisterType((*RecordsAllRequest)(nil), "provenance.metadata.v1.RecordsAllRequest")
-	proto.RegisterType((*RecordsAllResponse)(nil), "provenance.metadata.v1.RecordsAllResponse")
-	proto.RegisterType((*OwnershipRequest)(nil), "provenance.metadata.v1.OwnershipRequest")
-	proto.RegisterType((*OwnershipResponse)(nil), "provenance.metadata.v1.OwnershipResponse")
-	proto.RegisterType((*ValueOwnershipRequest)(nil), "provenance.metadata.v1.ValueOwnershipRequest")
-	proto.RegisterType((*ValueOwnershipResponse)(nil), "provenance.metadata.v1.ValueOwnershipResponse")
-	proto.RegisterType((*ScopeSpecificationRequest)(nil), "provenance.metadata.v1.ScopeSpecificationRequest")
-	proto.RegisterType((*ScopeSpecificationResponse)(nil), "provenance.metadata.v1.ScopeSpecificationResponse")
-	proto.RegisterType((*ScopeSpecificationWrapper)(nil), "provenance.metadata.v1.ScopeSpecificationWrapper")
-	proto.RegisterType((*ScopeSpecificationsAllRequest)(nil), "provenance.metadata.v1.ScopeSpecificationsAllRequest")
-	proto.RegisterType((*ScopeSpecificationsAllResponse)(nil), "provenance.metadata.v1.ScopeSpecificationsAllResponse")
-	proto.RegisterType((*ContractSpecificationRequest)(nil), "provenance.metadata.v1.ContractSpecificationRequest")
-	proto.RegisterType((*ContractSpecificationResponse)(nil), "provenance.metadata.v1.ContractSpecificationResponse")
-	proto.RegisterType((*ContractSpecificationWrapper)(nil), "provenance.metadata.v1.ContractSpecificationWrapper")
-	proto.RegisterType((*ContractSpecificationsAllRequest)(nil), "provenance.metadata.v1.ContractSpecificationsAllRequest")
-	proto.RegisterType((*ContractSpecificationsAllResponse)(nil), "provenance.metadata.v1.ContractSpecificationsAllResponse")
-	proto.RegisterType((*RecordSpecificationsForContractSpecificationRequest)(nil), "provenance.metadata.v1.RecordSpecificationsForContractSpecificationRequest")
-	proto.RegisterType((*RecordSpecificationsForContractSpecificationResponse)(nil), "provenance.metadata.v1.RecordSpecificationsForContractSpecificationResponse")
-	proto.RegisterType((*RecordSpecificationRequest)(nil), "provenance.metadata.v1.RecordSpecificationRequest")
-	proto.RegisterType((*RecordSpecificationResponse)(nil), "provenance.metadata.v1.RecordSpecificationResponse")
-	proto.RegisterType((*RecordSpecificationWrapper)(nil), "provenance.metadata.v1.RecordSpecificationWrapper")
-	proto.RegisterType((*RecordSpecificationsAllRequest)(nil), "provenance.metadata.v1.RecordSpecificationsAllRequest")
-	proto.RegisterType((*RecordSpecificationsAllResponse)(nil), "provenance.metadata.v1.RecordSpecificationsAllResponse")
-	proto.RegisterType((*GetByAddrRequest)(nil), "provenance.metadata.v1.GetByAddrRequest")
-	proto.RegisterType((*GetByAddrResponse)(nil), "provenance.metadata.v1.GetByAddrResponse")
-	proto.RegisterType((*OSLocatorParamsRequest)(nil), "provenance.metadata.v1.OSLocatorParamsRequest")
-	proto.RegisterType((*OSLocatorParamsResponse)(nil), "provenance.metadata.v1.OSLocatorParamsResponse")
-	proto.RegisterType((*OSLocatorRequest)(nil), "provenance.metadata.v1.OSLocatorRequest")
-	proto.RegisterType((*OSLocatorResponse)(nil), "provenance.metadata.v1.OSLocatorResponse")
-	proto.RegisterType((*OSLocatorsByURIRequest)(nil), "provenance.metadata.v1.OSLocatorsByURIRequest")
-	proto.RegisterType((*OSLocatorsByURIResponse)(nil), "provenance.metadata.v1.OSLocatorsByURIResponse")
-	proto.RegisterType((*OSLocatorsByScopeRequest)(nil), "provenance.metadata.v1.OSLocatorsByScopeRequest")
-	proto.RegisterType((*OSLocatorsByScopeResponse)(nil), "provenance.metadata.v1.OSLocatorsByScopeResponse")
-	proto.RegisterType((*OSAllLocatorsRequest)(nil), "provenance.metadata.v1.OSAllLocatorsRequest")
-	proto.RegisterType((*OSAllLocatorsResponse)(nil), "provenance.metadata.v1.OSAllLocatorsResponse")
-	proto.RegisterType((*AccountDataRequest)(nil), "provenance.metadata.v1.AccountDataRequest")
-	proto.RegisterType((*AccountDataResponse)(nil), "provenance.metadata.v1.AccountDataResponse")
-	proto.RegisterType((*QueryScopeNetAssetValuesRequest)(nil), "provenance.metadata.v1.QueryScopeNetAssetValuesRequest")
-	proto.RegisterType((*QueryScopeNetAssetValuesResponse)(nil), "provenance.metadata.v1.QueryScopeNetAssetValuesResponse")
-}
-
-func init() {
-	proto.RegisterFile("provenance/metadata/v1/query.proto", fileDescriptor_a68790bc0b96eeb9)
+func (m *QueryScopeNetAssetValuesResponse) GetHistory() []NetAssetValueRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
 }
 
-var fileDescriptor_a68790bc0b96eeb9 = []byte{
-	// 2913 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0x5b, 0x6c, 0x1c, 0x57,
-	0xf9, 0xcf, 0x99, 0x8d, 0x63, 0xfb, 0xf3, 0x35, 0x9f, 0x2f, 0xb1, 0x37, 0x8d, 0xed, 0x6e, 0x12,
-	0x5f, 0xe2, 0x64, 0x37, 0xbe, 0xc4, 0x49, 0xfa, 0x4f, 0xda, 0xda, 0xb9, 0xfd, 0xdd, 0xdc, 0x9c,
-	0x75, 0x43, 0x25, 0x23, 0xb0, 0xc6, 0xbb, 0x13, 0x77, 0xa9, 0x3d, 0xb3, 0x9d, 0x99, 0x4d, 0x1b,
-	0x59, 0x7e, 0x00, 0x21, 0xe0, 0x09, 0x25, 0x14, 0x2a, 0x4a, 0x55, 0x51, 0x21, 0x2a, 0x50, 0x08,
-	0x97, 0x3e, 0x94, 0x8b, 0x40, 0x88, 0x8b, 0x90, 0x22, 0xe0, 0xa1, 0x94, 0x17, 0xc4, 0x43, 0x84,
-	0x12, 0x24, 0x78, 0xe0, 0x19, 0x09, 0x78, 0x00, 0xed, 0xb9, 0xcc, 0x7d, 0x76, 0x67, 0x36, 0xeb,
-	0x40, 0xfa, 0xe6, 0x39, 0x7b, 0xbe, 0xef, 0x7c, 0xe7, 0xfb, 0x7e, 0xe7, 0x77, 0xce, 0xf9, 0xce,
-	0x67, 0x48, 0x15, 0x75, 0xed, 0xba, 0xa2, 0xca, 0x6a, 0x4e, 0xc9, 0xac, 0x2b, 0xa6, 0x9c, 0x97,
-	0x4d, 0x39, 0x73, 0x7d, 0x22, 0xf3, 0x72, 0x49, 0xd1, 0x6f, 0xa4, 0x8b, 0xba, 0x66, 0x6a, 0xd8,
-	0x6b, 0xf7, 0x49, 0x8b, 0x3e, 0xe9, 0xeb, 0x13, 0xc9, 0xee, 0x55, 0x6d, 0x55, 0xa3, 0x5d, 0x32,
-	0xe5, 0xbf, 0x58, 0xef, 0xe4, 0x81, 0x9c, 0x66, 0xac, 0x6b, 0x46, 0x66, 0x45, 0x36, 0x14, 0xa6,
-	0x26, 0x73, 0x7d, 0x62, 0x45, 0x31, 0xe5, 0x89, 0x4c, 0x51, 0x5e, 0x2d, 0xa8, 0xb2, 0x59, 0xd0,
-	0x54, 0xde, 0xf7, 0x89, 0x55, 0x4d, 0x5b, 0x5d, 0x53, 0x32, 0x72, 0xb1, 0x90, 0x91, 0x55, 0x55,
-	0x33, 0xe9, 0x8f, 0x06, 0xff, 0x75, 0x7f, 0x88, 0x6d, 0x96, 0x0d, 0xac, 0x5b, 0xd8, 0x14, 0x8c,
-	0x9c, 0x56, 0x54, 0x84, 0x51, 0x61, 0x7d, 0x8a, 0x4a, 0xae, 0x70, 0xad, 0x90, 0x73, 0x1a, 0x35,
-	0x1a, 0xd2, 0x57, 0x5b, 0xf9, 0x84, 0x92, 0x33, 0x0d, 0x53, 0xd3, 0x85, 0xd6, 0xdd, 0x7c, 0xaa,
-	0x62, 0x96, 0x4e, 0xaf, 0xa5, 0x4e, 0x02, 0x5e, 0x29, 0x7f, 0x2e, 0xc8, 0xba, 0xbc, 0x6e, 0x64,
-	0x95, 0x97, 0x4b, 0x8a, 0x61, 0xe2, 0x08, 0x74, 0x14, 0xd4, 0xdc, 0x5a, 0x29, 0xaf, 0x2c, 0xeb,
-	0xac, 0xa9, 0x6f, 0x65, 0x88, 0x8c, 0x36, 0x65, 0xdb, 0x79, 0x33, 0xef, 0x98, 0x7a, 0x83, 0x40,
-	0x97, 0x4b, 0xde, 0x28, 0x6a, 0xaa, 0xa1, 0xe0, 0x09, 0xd8, 0x51, 0xa4, 0x2d, 0x7d, 0x64, 0x88,
-	0x8c, 0xb6, 0x4c, 0x0e, 0xa4, 0x83, 0xa3, 0x93, 0x66, 0x72, 0x73, 0xdb, 0xef, 0xde, 0x1b, 0xdc,
-	0x96, 0xe5, 0x32, 0x78, 0x1a, 0x1a, 0x9d, 0xc3, 0xb6, 0x4c, 0x1e, 0x08, 0x13, 0xf7, 0xdb, 0x9e,
-	0x15, 0xa2, 0xa9, 0x2f, 0x48, 0xd0, 0xba, 0x58, 0xf6, 0xae, 0x98, 0x55, 0x3f, 0x34, 0x51, 0x6f,
-	0x2f, 0x17, 0xf2, 0xd4, 0xac, 0xe6, 0x6c, 0x23, 0xfd, 0x9e, 0xcf, 0xe3, 0x93, 0xd0, 0x6a, 0x28,
-	0x86, 0x51, 0xd0, 0xd4, 0x65, 0x39, 0x9f, 0xd7, 0xfb, 0x24, 0xfa, 0x73, 0x0b, 0x6f, 0x9b, 0xcd,
-	0xe7, 0x75, 0x1c, 0x84, 0x16, 0x5d, 0xc9, 0x69, 0x7a, 0x9e, 0xf5, 0x48, 0xd0, 0x1e, 0xc0, 0x9a,
-	0x68, 0x87, 0x31, 0xe8, 0x14, 0x4e, 0xe3, 0x72, 0x46, 0x1f, 0x50, 0xaf, 0x09, 0x67, 0x2e, 0xf2,
-	0x66, 0xb7, 0x7f, 0xcb, 0x0a, 0x8c, 0xbe, 0x16, 0x8f, 0x7f, 0x69, 0x2b, 0x0e, 0x43, 0x87, 0xf2,
-	0x2a, 0xeb, 0x58, 0xc8, 0x2f, 0x17, 0xd4, 0x6b, 0x5a, 0x5f, 0x2b, 0xed, 0xd8, 0xc6, 0x9b, 0xe7,
-	0xf3, 0xf3, 0xea, 0x35, 0x2d, 0x7a, 0xc0, 0x6e, 0x4a, 0xd0, 0xc6, 0x9d, 0xc2, 0x43, 0xf5, 0x14,
-	0x34, 0x50, 0x2f, 0xf0, 0x48, 0xed, 0x0b, 0x73, 0x35, 0x95, 0x7a, 0x41, 0x97, 0x8b, 0x45, 0x45,
-	0xcf, 0x32, 0x11, 0x9c, 0x83, 0x26, 0x6b, 0xaa, 0xd2, 0x50, 0x62, 0xb4, 0x65, 0x72, 0x38, 0x54,
-	0x9c, 0xf5, 0x13, 0x0a, 0x2c, 0x39, 0x7c, 0xa6, 0x1c, 0x6c, 0xe6, 0x83, 0x04, 0x55, 0xb1, 0x3f,
-	0x4c, 0x05, 0x73, 0x8a, 0xd0, 0x20, 0xa4, 0xf0, 0x69, 0x2f, 0x5a, 0x2a, 0x4f, 0xc1, 0x87, 0x93,
-	0xfb, 0x84, 0xe3, 0x84, 0x6b, 0xc6, 0x29, 0xb7, 0x47, 0xf6, 0x54, 0x56, 0xc7, 0x5d, 0x71, 0x0e,
-	0xda, 0x04, 0xb8, 0x58, 0x9c, 0x24, 0x2a, 0xbc, 0xb7, 0xa2, 0x30, 0x8b, 0x5e, 0xb6, 0xc5, 0xb0,
-	0x3f, 0xf0, 0x79, 0x40, 0xa6, 0xa8, 0xbc, 0xea, 0x2d, 0x6d, 0x09, 0xaa, 0x6d, 0xa4, 0xa2, 0xb6,
-	0xc5, 0xa2, 0x92, 0xe3, 0x1a, 0x3b, 0x0c, 0x77, 0x43, 0xea, 0x5b, 0x04, 0x3a, 0x69, 0x27, 0x63,
-	0x76, 0x6d, 0x4d, 0x2c, 0x88, 0x7a, 0xa3, 0x0b, 0xcf, 0x02, 0xd8, 0xec, 0xd9, 0x97, 0xa3, 0x36,
-	0x0f, 0xa7, 0x19, 0xff, 0xa4, 0xcb, 0x54, 0x9b, 0x66, 0xdc, 0xc3, 0xa9, 0x36, 0xbd, 0x20, 0xaf,
-	0x5a, 0xf1, 0x70, 0x48, 0xa6, 0xee, 0x11, 0xd8, 0xe9, 0xb0, 0xd6, 0x26, 0x15, 0x3a, 0xad, 0x32,
-	0xa9, 0x24, 0x22, 0x43, 0x95, 0xcb, 0xe0, 0x9c, 0x17, 0x26, 0xa3, 0x15, 0xc5, 0x1d, 0x7e, 0xb2,
-	0xa0, 0x82, 0xe7, 0x02, 0xe6, 0x37, 0x52, 0x75, 0x7e, 0xcc, 0x7c, 0xd7, 0x04, 0xef, 0x48, 0xd0,
-	0x21, 0xd8, 0x20, 0x02, 0x3d, 0xed, 0x01, 0x10, 0xf4, 0x54, 0xc8, 0x73, 0x72, 0x6a, 0xe6, 0x2d,
-	0xf3, 0xf9, 0xea, 0xd4, 0x64, 0x77, 0x50, 0xe5, 0x75, 0xa5, 0x6f, 0xbb, 0xb3, 0xc3, 0x25, 0x79,
-	0x5d, 0xc1, 0xbd, 0xd0, 0x66, 0x71, 0x17, 0x85, 0x3e, 0x23, 0xae, 0x56, 0x41, 0x5c, 0x14, 0xe2,
-	0xff, 0x3d, 0xd6, 0x7a, 0x5d, 0x82, 0x4e, 0xdb, 0x5d, 0x1f, 0x16, 0xe2, 0x9a, 0xf5, 0x22, 0x72,
-	0xa4, 0x8a, 0x0d, 0xfe, 0x3d, 0xee, 0x1f, 0x04, 0xda, 0xdd, 0x06, 0xe2, 0x71, 0x68, 0xe4, 0x26,
-	0x72, 0xc7, 0x0c, 0x56, 0xd1, 0x9a, 0x15, 0xfd, 0xf1, 0x22, 0x74, 0xd8, 0x30, 0x73, 0xb2, 0xd8,
-	0xfe, 0x2a, 0x2a, 0x38, 0xeb, 0xb4, 0x19, 0xce, 0x4f, 0xfc, 0x18, 0xf4, 0xe4, 0x34, 0xd5, 0xd4,
-	0xe5, 0x9c, 0x19, 0x44, 0x66, 0xa1, 0x9b, 0xfa, 0x29, 0x2e, 0xe4, 0xe0, 0x33, 0xcc, 0xf9, 0xda,
-	0x52, 0xdf, 0x26, 0x80, 0xc2, 0x31, 0x8f, 0x03, 0xa9, 0xfd, 0x95, 0x40, 0x97, 0xcb, 0x5e, 0x8e,
-	0x63, 0x27, 0x16, 0x49, 0x8d, 0x58, 0x8c, 0x7e, 0x62, 0xf2, 0x7b, 0x6c, 0x0b, 0xe8, 0xed, 0x6d,
-	0x09, 0xda, 0x39, 0x19, 0x08, 0x2f, 0x7a, 0x38, 0x8a, 0xf8, 0x38, 0xca, 0x49, 0x7f, 0x52, 0x25,
-	0xfa, 0x4b, 0x78, 0xe9, 0x0f, 0x61, 0xbb, 0x83, 0xd6, 0xe8, 0xdf, 0xd1, 0x08, 0x2d, 0xe8, 0xc4,
-	0xd6, 0x12, 0x7c, 0x62, 0xab, 0x3b, 0xa5, 0x7d, 0x51, 0x82, 0x0e, 0xcb, 0x45, 0x1f, 0x16, 0x46,
-	0x7b, 0xd6, 0x0b, 0xc3, 0xe1, 0xca, 0x0a, 0xfc, 0x84, 0xf6, 0x37, 0x02, 0x6d, 0x2e, 0xe5, 0x38,
-	0x03, 0x3b, 0x98, 0xfa, 0x6a, 0x57, 0x09, 0x26, 0x96, 0xe5, 0xbd, 0xf1, 0x39, 0x68, 0xe7, 0x80,
-	0x73, 0x73, 0xd9, 0xbe, 0xca, 0xf2, 0x9c, 0x70, 0x5a, 0x75, 0xc7, 0x17, 0xbe, 0x00, 0x5d, 0x5c,
-	0x57, 0x00, 0x8f, 0x8d, 0x56, 0x56, 0xe8, 0x60, 0xb1, 0x4e, 0xdd, 0xd3, 0x92, 0xba, 0x43, 0x60,
-	0x27, 0x77, 0xc5, 0xe3, 0x40, 0x61, 0x0f, 0x08, 0xa0, 0xd3, 0x5c, 0x8e, 0x5b, 0x07, 0x6e, 0x48,
-	0x4d, 0xb8, 0x39, 0xe5, 0xc5, 0xcd, 0x58, 0x15, 0xdc, 0x6c, 0x29, 0x7b, 0xbd, 0x45, 0xa0, 0xf3,
-	0xf2, 0x2b, 0xaa, 0xa2, 0x1b, 0x2f, 0x16, 0x8a, 0xc2, 0x85, 0x7d, 0xd0, 0x58, 0x26, 0x2e, 0xc5,
-	0x30, 0xc4, 0xe1, 0x8c, 0x7f, 0x3e, 0xfa, 0x28, 0xfc, 0x9c, 0xc0, 0x4e, 0x87, 0x7d, 0x3c, 0x08,
-	0x83, 0xc0, 0xae, 0x11, 0xcb, 0xa5, 0x52, 0x81, 0x07, 0xa2, 0x39, 0x0b, 0xb4, 0xe9, 0x6a, 0xb9,
-	0x25, 0xc6, 0x01, 0xd8, 0x3b, 0xf9, 0x2d, 0xf0, 0xf1, 0xd7, 0x08, 0xf4, 0x7c, 0x44, 0x5e, 0x2b,
-	0x29, 0xff, 0xcb, 0x8e, 0xfe, 0x0d, 0x81, 0x5e, 0xaf, 0x91, 0x51, 0xbd, 0x7d, 0xce, 0xeb, 0xed,
-	0x43, 0x61, 0xde, 0x0e, 0x74, 0xc3, 0x16, 0xb8, 0xfc, 0xdf, 0x04, 0xfa, 0xad, 0x7b, 0xa2, 0x95,
-	0x4e, 0x12, 0x3e, 0x1b, 0x83, 0x4e, 0x57, 0x9a, 0xc9, 0xbe, 0x85, 0x74, 0xb8, 0xda, 0xe7, 0xf3,
-	0x38, 0x0d, 0xbd, 0x22, 0x0e, 0xae, 0xf3, 0x9d, 0x48, 0x77, 0x74, 0xf3, 0x5f, 0x9d, 0xe7, 0x38,
-	0x03, 0x0f, 0x43, 0xb7, 0xfb, 0xf6, 0xc0, 0x65, 0xd8, 0x86, 0x8b, 0xae, 0x2b, 0x04, 0x93, 0xa8,
-	0xfb, 0x9e, 0xfb, 0xc9, 0x04, 0x24, 0x83, 0x3c, 0xc0, 0x63, 0xba, 0x02, 0x5d, 0xf6, 0xcd, 0xdb,
-	0xfa, 0x99, 0x6f, 0x3b, 0x13, 0x55, 0xaf, 0xde, 0x96, 0x84, 0xa0, 0x37, 0x34, 0x7c, 0x3f, 0xe1,
-	0x47, 0xa1, 0xdd, 0xe3, 0x33, 0xb6, 0x59, 0x4f, 0x47, 0x39, 0x0c, 0xfb, 0x46, 0x68, 0xcb, 0xb9,
-	0x5c, 0x7c, 0x15, 0x5a, 0x5d, 0xae, 0x65, 0x9b, 0xf8, 0x64, 0xf5, 0xfd, 0xc9, 0xa7, 0xb8, 0x45,
-	0x77, 0xc4, 0xe1, 0xbc, 0x17, 0xca, 0x31, 0x7c, 0xe1, 0xdb, 0xe0, 0x7f, 0x19, 0x88, 0x42, 0xb1,
-	0xd9, 0x2f, 0x40, 0x5b, 0x90, 0xf3, 0x0f, 0xc4, 0x18, 0xd0, 0xad, 0x20, 0x24, 0x9d, 0x22, 0x3d,
-	0x64, 0x3a, 0xe5, 0x87, 0x04, 0xf6, 0xf8, 0xc7, 0x7e, 0x2c, 0xf6, 0xf0, 0xb7, 0x25, 0x18, 0x08,
-	0x33, 0x9d, 0x2f, 0x84, 0x3c, 0x74, 0x07, 0x2c, 0x04, 0xb1, 0xb9, 0xd7, 0xb0, 0x12, 0xba, 0xfc,
-	0x2b, 0xc1, 0xc0, 0xcb, 0x5e, 0x58, 0x1d, 0x89, 0xae, 0x78, 0x6b, 0x0f, 0x00, 0xbf, 0x25, 0xf0,
-	0x44, 0xe0, 0xba, 0xab, 0x81, 0x2c, 0xc3, 0x68, 0x0f, 0x1e, 0x1d, 0xed, 0xfd, 0x4a, 0x82, 0x3d,
-	0x21, 0xd3, 0xe1, 0x01, 0x7f, 0x09, 0x7a, 0x5d, 0xac, 0xe4, 0x5d, 0x7f, 0xb5, 0xb1, 0x53, 0x4f,
-	0x2e, 0xe8, 0x57, 0x5c, 0x85, 0x1e, 0x87, 0x27, 0x1c, 0xf0, 0xaa, 0x9d, 0xae, 0xba, 0x75, 0xff,
-	0x6f, 0x06, 0x5e, 0xf2, 0x02, 0x2c, 0xde, 0x34, 0x7c, 0xd4, 0xf5, 0x41, 0x18, 0x2c, 0x04, 0x7b,
-	0x2d, 0x06, 0xb3, 0xd7, 0xa1, 0x78, 0xc3, 0x7a, 0x08, 0x2c, 0x34, 0x8b, 0x22, 0xd5, 0x25, 0x8b,
-	0xf2, 0x63, 0x02, 0x43, 0x81, 0x76, 0x3c, 0x16, 0x64, 0xf6, 0x5d, 0x09, 0x9e, 0xac, 0x60, 0x3d,
-	0x87, 0xf7, 0x3a, 0xec, 0x0a, 0x86, 0xb7, 0xa0, 0xb4, 0xda, 0xf0, 0xdd, 0x1b, 0x88, 0x6f, 0x03,
-	0xb3, 0x5e, 0xdc, 0x1d, 0x8b, 0xa5, 0x7e, 0x6b, 0xb9, 0xed, 0x5d, 0x02, 0x53, 0x01, 0x2b, 0xc9,
-	0x38, 0xab, 0xe9, 0xf5, 0xa2, 0xbc, 0xba, 0x13, 0xd8, 0x67, 0x12, 0x30, 0x1d, 0xcf, 0x66, 0x1e,
-	0xf8, 0x50, 0xaa, 0x21, 0x75, 0xa6, 0x9a, 0xa7, 0x61, 0x77, 0x30, 0xc2, 0xe8, 0xfd, 0x80, 0xe7,
-	0xb3, 0xfa, 0x03, 0xf1, 0x52, 0xbe, 0x2e, 0x54, 0x90, 0x77, 0x64, 0xf4, 0x83, 0xe5, 0x69, 0xf2,
-	0x4c, 0xf1, 0x42, 0xee, 0x7c, 0x8c, 0xa9, 0x55, 0x8b, 0xbd, 0xcd, 0x80, 0x77, 0x08, 0x24, 0x03,
-	0x14, 0xd4, 0x80, 0x11, 0x91, 0xb3, 0x93, 0x1c, 0x39, 0xbb, 0xba, 0xe3, 0xe6, 0x03, 0x02, 0xbb,
-	0x03, 0xcd, 0xe5, 0xf0, 0x50, 0xa0, 0x3b, 0x08, 0x1e, 0x9c, 0xb6, 0x6b, 0x41, 0x47, 0x57, 0x00,
-	0x3a, 0xf0, 0x82, 0x37, 0x38, 0x71, 0x34, 0xfb, 0x62, 0x70, 0x37, 0x38, 0x06, 0x62, 0x0f, 0xba,
-	0x12, 0xbc, 0x07, 0x8d, 0xc7, 0x19, 0xd2, 0xb3, 0x03, 0x85, 0x64, 0xbf, 0xa4, 0x87, 0xce, 0x7e,
-	0xfd, 0x88, 0xc0, 0x40, 0x10, 0x1e, 0x1f, 0x87, 0x9d, 0xe7, 0x1d, 0x09, 0x06, 0x43, 0x6d, 0x7f,
-	0xd4, 0xf4, 0xb3, 0xe0, 0x45, 0xd8, 0x4c, 0x9c, 0xe5, 0xbf, 0xa5, 0xfb, 0xcd, 0x28, 0x74, 0x9e,
-	0x53, 0xcc, 0xb9, 0x1b, 0x65, 0x9a, 0x12, 0x31, 0xe8, 0x86, 0x86, 0x32, 0xad, 0x89, 0xb4, 0x09,
-	0xfb, 0x48, 0xfd, 0x2e, 0x01, 0x3b, 0x1d, 0x5d, 0xb9, 0x0f, 0x8f, 0x78, 0x1e, 0x7d, 0xab, 0xbc,
-	0xc6, 0x8b, 0xd7, 0xde, 0xff, 0xf3, 0xa5, 0xc3, 0xab, 0x3e, 0x83, 0xd9, 0x79, 0xf0, 0x63, 0xde,
-	0x3c, 0x78, 0xb5, 0x9c, 0xb3, 0x95, 0xc8, 0x3c, 0x2f, 0xd2, 0x42, 0xec, 0x90, 0xbf, 0x9d, 0x4a,
-	0xc7, 0xb9, 0xbd, 0x82, 0x75, 0x53, 0x32, 0xf0, 0x79, 0x5f, 0xae, 0xa0, 0x81, 0xea, 0x8b, 0x7b,
-	0x9e, 0x74, 0x27, 0x09, 0x2e, 0x79, 0x92, 0x04, 0x3b, 0xa8, 0xce, 0x58, 0xfc, 0xe0, 0xca, 0x0e,
-	0xec, 0x86, 0x66, 0x55, 0x33, 0x97, 0xaf, 0x69, 0x25, 0x35, 0xdf, 0xd7, 0x48, 0x03, 0xda, 0xa4,
-	0x6a, 0xe6, 0xd9, 0xf2, 0x77, 0x6a, 0x16, 0x7a, 0x2f, 0x2f, 0x5e, 0xd0, 0x72, 0xb2, 0xa9, 0xe9,
-	0x35, 0x96, 0x18, 0xdd, 0x26, 0xb0, 0xcb, 0xa7, 0x83, 0x83, 0xe3, 0x8c, 0xa7, 0xcc, 0x28, 0xf4,
-	0x42, 0xef, 0x51, 0xe0, 0xa9, 0x37, 0xfa, 0x7f, 0xef, 0xf2, 0x49, 0x47, 0xd4, 0xe3, 0x23, 0xe7,
-	0x2b, 0xd0, 0x69, 0x75, 0x71, 0xa0, 0x5d, 0x7b, 0x45, 0x55, 0xc4, 0x9b, 0x17, 0xfb, 0x88, 0x3e,
-	0xff, 0xb7, 0x08, 0xec, 0x74, 0xe8, 0xe4, 0x33, 0x3f, 0x0d, 0x8d, 0x6b, 0xac, 0xa9, 0x5a, 0x8a,
-	0xe4, 0x32, 0x2d, 0x08, 0x5b, 0x34, 0x35, 0x5d, 0x11, 0x4a, 0x84, 0x68, 0x9c, 0x94, 0xb0, 0x67,
-	0x56, 0xf6, 0x94, 0xdf, 0x24, 0x8e, 0x18, 0x1b, 0x73, 0x37, 0xae, 0x66, 0xe7, 0xc5, 0xcc, 0x3b,
-	0x21, 0x51, 0xd2, 0x0b, 0x7c, 0xde, 0xe5, 0x3f, 0x1f, 0x3d, 0x4d, 0xff, 0xd3, 0x89, 0x1e, 0x61,
-	0x1d, 0xf7, 0xe1, 0x05, 0x68, 0xe2, 0x8e, 0x10, 0xe4, 0x12, 0xc3, 0x89, 0x1c, 0x42, 0x96, 0x86,
-	0x5a, 0x40, 0xe4, 0xf2, 0xd6, 0x16, 0x70, 0xef, 0xc7, 0xa1, 0xcf, 0x39, 0x56, 0xd4, 0x62, 0xb8,
-	0xc8, 0xd0, 0x7c, 0x8f, 0x40, 0x7f, 0xc0, 0x00, 0x5b, 0xe2, 0xde, 0xe7, 0xbc, 0xee, 0x3d, 0x1c,
-	0xc5, 0xbd, 0xc1, 0x15, 0x5f, 0x9f, 0x25, 0xd0, 0x7d, 0x79, 0x71, 0x76, 0x6d, 0x4d, 0x74, 0x8c,
-	0x4b, 0x4a, 0x75, 0x83, 0xe7, 0xdf, 0x09, 0xf4, 0x78, 0x2c, 0xd9, 0x12, 0xef, 0x9d, 0xf5, 0x7a,
-	0xef, 0x60, 0xb8, 0xf7, 0xfc, 0x7e, 0xd9, 0x02, 0x68, 0x66, 0x01, 0x67, 0x73, 0x39, 0xad, 0xa4,
-	0x9a, 0xa7, 0x65, 0x53, 0x16, 0x6e, 0x3d, 0x01, 0x6d, 0xc2, 0x16, 0xbb, 0x4c, 0xa0, 0x75, 0x6e,
-	0x57, 0x79, 0x36, 0x7f, 0xbc, 0x37, 0xd8, 0x71, 0x91, 0xff, 0x38, 0xcb, 0x5e, 0x84, 0xb2, 0xad,
-	0xeb, 0x8e, 0x86, 0xd4, 0x38, 0x74, 0xb9, 0x74, 0x72, 0x4f, 0x76, 0x43, 0xc3, 0x75, 0x79, 0xad,
-	0xa4, 0x08, 0xfe, 0xa5, 0x1f, 0xa9, 0x09, 0x18, 0xa4, 0xc5, 0xa3, 0x14, 0x21, 0x97, 0x14, 0x73,
-	0xd6, 0x30, 0x14, 0x93, 0x3e, 0xc5, 0x58, 0x68, 0x68, 0x07, 0xc9, 0x5a, 0x1c, 0x52, 0x21, 0x9f,
-	0xba, 0x01, 0x43, 0xe1, 0x22, 0x7c, 0xb0, 0xab, 0xd0, 0xa9, 0x2a, 0xe6, 0xb2, 0x5c, 0xfe, 0x69,
-	0x99, 0x8e, 0x54, 0xf5, 0x4d, 0xd4, 0xa5, 0x89, 0x47, 0xae, 0x5d, 0x75, 0xa9, 0x9f, 0x7c, 0x6f,
-	0x04, 0x1a, 0xe8, 0xd8, 0xf8, 0x79, 0x02, 0x3b, 0xd8, 0xe6, 0x83, 0x31, 0xaa, 0x62, 0x93, 0xe3,
-	0x91, 0xfa, 0xb2, 0x49, 0xa4, 0xc6, 0x3f, 0xf7, 0x97, 0xef, 0x1d, 0x20, 0x9f, 0xfa, 0xfd, 0x9f,
-	0x5f, 0x93, 0x86, 0x70, 0x20, 0x13, 0x52, 0x69, 0xcc, 0x37, 0xcf, 0x7f, 0x11, 0x68, 0x60, 0xe5,
-	0x14, 0x91, 0xea, 0x2e, 0x93, 0xfb, 0xab, 0xf4, 0xe2, 0x36, 0x7c, 0x9d, 0xd8, 0x46, 0x7c, 0x99,
-	0x2c, 0xcd, 0xe0, 0x74, 0x98, 0x1d, 0xfc, 0xd8, 0x96, 0xd9, 0x70, 0x56, 0xf0, 0x6e, 0xb2, 0xc2,
-	0xea, 0xa5, 0x69, 0x9c, 0x0c, 0x93, 0x63, 0x87, 0x98, 0xcc, 0x86, 0xa3, 0x2c, 0x85, 0x4b, 0xe1,
-	0x68, 0xa6, 0x52, 0xb5, 0x76, 0x66, 0x43, 0x30, 0xe7, 0x26, 0xde, 0x22, 0xd0, 0x6c, 0xd5, 0x0b,
-	0x62, 0xe4, 0x92, 0xc2, 0xe4, 0x58, 0x84, 0x9e, 0xdc, 0x13, 0x19, 0xdb, 0x11, 0xfb, 0x30, 0x55,
-	0xd1, 0x32, 0x23, 0x23, 0xaf, 0xad, 0xe1, 0xad, 0x04, 0x34, 0xd9, 0xa5, 0xc6, 0x11, 0x6b, 0xca,
-	0x92, 0xa3, 0xd5, 0x3b, 0x72, 0x83, 0xde, 0x95, 0x6c, 0x8b, 0xde, 0x91, 0x96, 0xa6, 0x70, 0x22,
-	0xaa, 0xbb, 0x44, 0xac, 0x8c, 0xa5, 0x67, 0xf0, 0x64, 0x5c, 0x21, 0x3b, 0xc0, 0x85, 0xfc, 0x66,
-	0x25, 0x40, 0x04, 0x07, 0x96, 0xc9, 0x2e, 0x9d, 0xc3, 0x33, 0x91, 0x07, 0xf6, 0x28, 0x52, 0xe5,
-	0x75, 0xc5, 0x52, 0x84, 0x07, 0x23, 0xe3, 0xb1, 0x8c, 0x93, 0x37, 0x08, 0xb4, 0x38, 0x4a, 0xaf,
-	0x30, 0x46, 0x7d, 0x56, 0xf8, 0xda, 0x0d, 0xa8, 0x26, 0x4b, 0x4d, 0xd8, 0xb1, 0x19, 0xc6, 0x7d,
-	0x55, 0x6c, 0x64, 0x78, 0x79, 0x6d, 0x3b, 0x34, 0x5a, 0xa5, 0x9b, 0xd1, 0x0a, 0x76, 0x92, 0x23,
-	0x55, 0xfb, 0x71, 0x7b, 0xbe, 0x9f, 0xb0, 0x0d, 0xba, 0x9d, 0x58, 0x9a, 0xc4, 0xc3, 0x31, 0xdd,
-	0x6f, 0x2c, 0x1d, 0xc3, 0x99, 0xd8, 0x21, 0xa3, 0xb1, 0x8a, 0x15, 0xec, 0xa0, 0xb0, 0x59, 0x26,
-	0x5c, 0xc4, 0xf3, 0xf5, 0x50, 0x24, 0xec, 0x8a, 0xc3, 0x66, 0x4e, 0x33, 0x4e, 0xe0, 0x53, 0x35,
-	0xc8, 0xf1, 0x51, 0xc3, 0x11, 0x1b, 0xb4, 0x60, 0xf0, 0x4b, 0x04, 0xc0, 0xae, 0xb6, 0xc1, 0xe8,
-	0x15, 0x39, 0xc9, 0x03, 0x51, 0xba, 0x72, 0x78, 0x1c, 0xb6, 0xd1, 0xb1, 0x1f, 0xf7, 0x56, 0x36,
-	0x90, 0xa1, 0xf5, 0x2b, 0x04, 0x9a, 0xad, 0x6a, 0x09, 0x8c, 0x5c, 0xc3, 0x12, 0xce, 0xb8, 0xbe,
-	0xe2, 0x8e, 0xd4, 0x31, 0xdb, 0xa8, 0x43, 0x38, 0x1e, 0x66, 0x94, 0x26, 0xe4, 0x32, 0x1b, 0xbc,
-	0x42, 0x65, 0x13, 0xbf, 0x43, 0xa0, 0xdd, 0x5d, 0xcf, 0x81, 0xf1, 0xea, 0x3e, 0x92, 0xe9, 0xa8,
-	0xdd, 0xb9, 0xad, 0x27, 0x6d, 0x5b, 0x2b, 0xac, 0x2d, 0x7a, 0x12, 0x09, 0x32, 0xf8, 0xa7, 0x04,
-	0xd0, 0x9f, 0x86, 0xc0, 0xf8, 0x2f, 0xfc, 0xc9, 0xc9, 0x38, 0x22, 0xdc, 0xf8, 0x59, 0xdb, 0xf8,
-	0x4a, 0x4b, 0x82, 0x6e, 0x6d, 0x45, 0x25, 0x97, 0xd9, 0xf0, 0xa6, 0x97, 0x37, 0xf1, 0x27, 0x04,
-	0x7a, 0x83, 0xdf, 0x87, 0xb1, 0xb6, 0xf7, 0xe4, 0xe4, 0x4c, 0x5c, 0x31, 0x3e, 0x99, 0x29, 0x7b,
-	0x32, 0xa3, 0x38, 0x5c, 0x75, 0x32, 0x0c, 0xcd, 0xbf, 0x26, 0xd0, 0x13, 0x98, 0xb6, 0xc1, 0x9a,
-	0x1e, 0x2b, 0x93, 0x47, 0x62, 0x4a, 0x71, 0xdb, 0x4f, 0xdb, 0xb6, 0x1f, 0xc7, 0xa3, 0x61, 0xb6,
-	0x8b, 0x44, 0x52, 0x58, 0x2c, 0xee, 0x12, 0xe8, 0x0f, 0x7d, 0xd2, 0xc2, 0x9a, 0x5f, 0xc1, 0x92,
-	0xc7, 0x6b, 0x90, 0xe4, 0x13, 0x9b, 0xb1, 0x27, 0x36, 0x8e, 0x63, 0x51, 0x26, 0xc6, 0xe2, 0xf2,
-	0xa6, 0x04, 0x07, 0xe3, 0x3c, 0x95, 0x60, 0x3d, 0x1f, 0x5c, 0x92, 0x17, 0xea, 0xa3, 0x8c, 0xfb,
-	0x60, 0xc1, 0xf6, 0xc1, 0x19, 0x3c, 0x55, 0x63, 0x70, 0x05, 0x07, 0xd3, 0x9c, 0xdf, 0x2d, 0x09,
-	0xba, 0x02, 0x4c, 0xc1, 0x1a, 0x1e, 0x36, 0x92, 0x53, 0xb1, 0x64, 0xf8, 0x94, 0x6e, 0x3a, 0x6e,
-	0x07, 0x9f, 0x26, 0x4b, 0xe7, 0x71, 0xfe, 0xe1, 0xa7, 0x25, 0xb6, 0xc9, 0x23, 0x55, 0x76, 0xa1,
-	0x10, 0xf0, 0xff, 0x8c, 0xc0, 0xae, 0x90, 0xec, 0x3a, 0xd6, 0x98, 0x8e, 0x4f, 0x1e, 0x8d, 0x2d,
-	0xc7, 0xfd, 0x33, 0x6d, 0xbb, 0x67, 0x0c, 0x47, 0xaa, 0x4f, 0x88, 0x1f, 0x04, 0x09, 0x34, 0x5b,
-	0x19, 0xf8, 0xf0, 0xad, 0xd5, 0x9b, 0xcf, 0x0f, 0xdf, 0x5a, 0x7d, 0xe9, 0xfc, 0x88, 0xc7, 0xd3,
-	0xf2, 0xf6, 0xc4, 0x36, 0x29, 0x63, 0x13, 0xbf, 0x41, 0xa0, 0xc3, 0x93, 0x77, 0xc5, 0x98, 0x09,
-	0xda, 0x64, 0x26, 0x72, 0xff, 0x58, 0x64, 0xce, 0xf3, 0x2b, 0xe2, 0x2a, 0xfc, 0x7a, 0xf9, 0x68,
-	0x22, 0x14, 0x62, 0xe4, 0x5c, 0x6a, 0x85, 0xa3, 0x89, 0x37, 0xef, 0x1b, 0x31, 0xb0, 0xc2, 0xae,
-	0x0d, 0xba, 0xe5, 0x6f, 0xe2, 0x6d, 0xa7, 0x0b, 0x59, 0xd6, 0x11, 0x63, 0xa6, 0x27, 0x23, 0xb8,
-	0xd0, 0x9d, 0x5e, 0x8d, 0x48, 0xbd, 0xc2, 0xd4, 0x92, 0x5e, 0xc8, 0x6c, 0x94, 0xf4, 0xc2, 0x26,
-	0xfe, 0xc0, 0x99, 0xf0, 0x16, 0x39, 0x3c, 0x8c, 0x9d, 0xee, 0x4b, 0x4e, 0xc4, 0x90, 0x88, 0x75,
-	0x98, 0x12, 0x26, 0xfb, 0x92, 0x01, 0x5f, 0x25, 0xd0, 0xe6, 0xca, 0x9f, 0x61, 0xac, 0x34, 0x5b,
-	0xf2, 0x50, 0xc4, 0xde, 0xb1, 0xd6, 0x92, 0xc8, 0x01, 0xd2, 0x15, 0xfe, 0x4d, 0x02, 0x2d, 0x8e,
-	0x1c, 0x59, 0xf8, 0x35, 0xd4, 0x9f, 0x9c, 0x0b, 0xbf, 0x86, 0x06, 0x24, 0xdd, 0x52, 0xcf, 0xda,
-	0xb6, 0x1d, 0xc1, 0xa9, 0xd0, 0x75, 0xce, 0x24, 0xe9, 0xe7, 0x86, 0x2b, 0xf3, 0xb7, 0x89, 0xbf,
-	0x20, 0xd0, 0x15, 0x90, 0x69, 0xc3, 0xa3, 0x15, 0x33, 0x59, 0xe1, 0xe9, 0xbc, 0xe4, 0xb1, 0xf8,
-	0x82, 0xb1, 0xee, 0x03, 0xaa, 0x62, 0xd2, 0xb4, 0x1f, 0xcb, 0xfa, 0x65, 0x36, 0x0a, 0xf9, 0xcd,
-	0xb9, 0x97, 0xee, 0xde, 0x1f, 0x20, 0xef, 0xdf, 0x1f, 0x20, 0x7f, 0xba, 0x3f, 0x40, 0x6e, 0x3e,
-	0x18, 0xd8, 0xf6, 0xfe, 0x83, 0x81, 0x6d, 0x7f, 0x78, 0x30, 0xb0, 0x0d, 0xfa, 0x0b, 0x5a, 0x88,
-	0x3d, 0x0b, 0x64, 0x69, 0x7a, 0xb5, 0x60, 0xbe, 0x58, 0x5a, 0x49, 0xe7, 0xb4, 0x75, 0xc7, 0x68,
-	0x87, 0x0a, 0x9a, 0x73, 0xec, 0x57, 0xed, 0xd1, 0xcd, 0x1b, 0x45, 0xc5, 0x58, 0xd9, 0x41, 0xff,
-	0xa5, 0x7f, 0xea, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x23, 0x56, 0x7e, 0x41, 0x2e, 0x41, 0x00,
-	0x00,
+func (m *QueryScopeNetAssetValuesResponse) GetMaxAge() uint64 {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of x/metadata module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Scope searches for a scope.
-	//
-	// The scope id, if provided, must either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address,
-	// e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. The session addr, if provided, must be a bech32 session address,
-	// e.g. session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
-	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
-	//
-	// * If only a scope_id is provided, that scope is returned.
-	// * If only a session_addr is provided, the scope containing that session is returned.
-	// * If only a record_addr is provided, the scope containing that record is returned.
-	// * If more than one of scope_id, session_addr, and record_addr are provided, and they don't refer to the same scope,
-	// a bad request is returned.
-	//
-	// Providing a session addr or record addr does not limit the sessions and records returned (if requested).
-	// Those parameters are only used to find the scope.
-	//
-	// By default, sessions and records are not included.
-	// Set include_sessions and/or include_records to true to include sessions and/or records.
-	Scope(ctx context.Context, in *ScopeRequest, opts ...grpc.CallOption) (*ScopeResponse, error)
-	// ScopesAll retrieves all scopes.
-	ScopesAll(ctx context.Context, in *ScopesAllRequest, opts ...grpc.CallOption) (*ScopesAllResponse, error)
-	// Sessions searches for sessions.
-	//
-	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
-	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly, the session_id can either be a uuid or session address, e.g.
-	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
-	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
-	//
-	// * If only a scope_id is provided, all sessions in that scope are returned.
-	// * If only a session_id is provided, it must be an address, and that single session is returned.
-	// * If the session_id is a uuid, then either a scope_id or record_addr must also be provided, and that single session
-	// is returned.
-	// * If only a record_addr is provided, the session containing that record will be returned.
-	// * If a record_name is provided then either a scope_id, session_id as an address, or record_addr must also be
-	// provided, and the session containing that record will be returned.
-	//
-	// A bad request is returned if:
-	// * The session_id is a uuid and is provided without a scope_id or record_addr.
-	// * A record_name is provided without any way to identify the scope (e.g. a scope_id, a session_id as an address, or
-	// a record_addr).
-	// * Two or more of scope_id, session_id as an address, and record_addr are provided and don't all refer to the same
-	// scope.
-	// * A record_addr (or scope_id and record_name) is provided with a session_id and that session does not contain such
-	// a record.
-	// * A record_addr and record_name are both provided, but reference different records.
-	//
-	// By default, the scope and records are not included.
-	// Set include_scope and/or include_records to true to include the scope and/or records.
-	Sessions(ctx context.Context, in *SessionsRequest, opts ...grpc.CallOption) (*SessionsResponse, error)
-	// SessionsAll retrieves all sessions.
-	SessionsAll(ctx context.Context, in *SessionsAllRequest, opts ...grpc.CallOption) (*SessionsAllResponse, error)
-	// Records searches for records.
-	//
-	// The record_addr, if provided, must be a bech32 record address, e.g.
-	// record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3. The scope-id can either be scope uuid, e.g.
-	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly,
-	// the session_id can either be a uuid or session address, e.g.
-	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The name is the name of the record you're
-	// interested in.
-	//
-	// * If only a record_addr is provided, that single record will be returned.
-	// * If only a scope_id is provided, all records in that scope will be returned.
-	// * If only a session_id (or scope_id/session_id), all records in that session will be returned.
-	// * If a name is provided with a scope_id and/or session_id, that single record will be returned.
-	//
-	// A bad request is returned if:
-	// * The session_id is a uuid and no scope_id is provided.
-	// * There are two or more of record_addr, session_id, and scope_id, and they don't all refer to the same scope.
-	// * A name is provided, but not a scope_id and/or a session_id.
-	// * A name and record_addr are provided and the name doesn't match the record_addr.
-	//
-	// By default, the scope and sessions are not included.
-	// Set include_scope and/or include_sessions to true to include the scope and/or sessions.
-	Records(ctx context.Context, in *RecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error)
-	// RecordsAll retrieves all records.
-	RecordsAll(ctx context.Context, in *RecordsAllRequest, opts ...grpc.CallOption) (*RecordsAllResponse, error)
-	// Ownership returns the scope identifiers that have the given address in the owners list.
-	Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error)
-	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
-	ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error)
-	// ScopeSpecification returns a scope specification for the given specification id.
-	//
-	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
-	// specification address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
-	//
-	// By default, the contract and record specifications are not included.
-	// Set include_contract_specs and/or include_record_specs to true to include contract and/or record specifications.
-	ScopeSpecification(ctx context.Context, in *ScopeSpecificationRequest, opts ...grpc.CallOption) (*ScopeSpecificationResponse, error)
-	// ScopeSpecificationsAll retrieves all scope specifications.
-	ScopeSpecificationsAll(ctx context.Context, in *ScopeSpecificationsAllRequest, opts ...grpc.CallOption) (*ScopeSpecificationsAllResponse, error)
-	// ContractSpecification returns a contract specification for the given specification id.
-	//
-	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
-	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
-	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
-	// address, then the contract specification that contains that record specification is looked up.
-	//
-	// By default, the record specifications for this contract specification are not included.
-	// Set include_record_specs to true to include them in the result.
-	ContractSpecification(ctx context.Context, in *ContractSpecificationRequest, opts ...grpc.CallOption) (*ContractSpecificationResponse, error)
-	// ContractSpecificationsAll retrieves all contract specifications.
-	ContractSpecificationsAll(ctx context.Context, in *ContractSpecificationsAllRequest, opts ...grpc.CallOption) (*ContractSpecificationsAllResponse, error)
-	// RecordSpecificationsForContractSpecification returns the record specifications for the given input.
-	//
-	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
-	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
-	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
-	// address, then the contract specification that contains that record specification is used.
-	RecordSpecificationsForContractSpecification(ctx context.Context, in *RecordSpecificationsForContractSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationsForContractSpecificationResponse, error)
-	// RecordSpecification returns a record specification for the given input.
-	RecordSpecification(ctx context.Context, in *RecordSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationResponse, error)
-	// RecordSpecificationsAll retrieves all record specifications.
-	RecordSpecificationsAll(ctx context.Context, in *RecordSpecificationsAllRequest, opts ...grpc.CallOption) (*RecordSpecificationsAllResponse, error)
-	// GetByAddr retrieves metadata given any address(es).
-	GetByAddr(ctx context.Context, in *GetByAddrRequest, opts ...grpc.CallOption) (*GetByAddrResponse, error)
-	// OSLocatorParams returns all parameters for the object store locator sub module.
-	OSLocatorParams(ctx context.Context, in *OSLocatorParamsRequest, opts ...grpc.CallOption) (*OSLocatorParamsResponse, error)
-	// OSLocator returns an ObjectStoreLocator by its owner's address.
-	OSLocator(ctx context.Context, in *OSLocatorRequest, opts ...grpc.CallOption) (*OSLocatorResponse, error)
-	// OSLocatorsByURI returns all ObjectStoreLocator entries for a locator uri.
-	OSLocatorsByURI(ctx context.Context, in *OSLocatorsByURIRequest, opts ...grpc.CallOption) (*OSLocatorsByURIResponse, error)
-	// OSLocatorsByScope returns all ObjectStoreLocator entries for a for all signer's present in the specified scope.
-	OSLocatorsByScope(ctx context.Context, in *OSLocatorsByScopeRequest, opts ...grpc.CallOption) (*OSLocatorsByScopeResponse, error)
-	// OSAllLocators returns all ObjectStoreLocator entries.
-	OSAllLocators(ctx context.Context, in *OSAllLocatorsRequest, opts ...grpc.CallOption) (*OSAllLocatorsResponse, error)
-	// AccountData gets the account data associated with a metadata address.
-	// Currently, only scope ids are supported.
-	AccountData(ctx context.Context, in *AccountDataRequest, opts ...grpc.CallOption) (*AccountDataResponse, error)
-	// ScopeNetAssetValues returns net asset values for scope
-	ScopeNetAssetValues(ctx context.Context, in *QueryScopeNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryScopeNetAssetValuesResponse, error)
+// ScopeHistoryRequest is the request type for the Query/ScopeHistory RPC method.
+type ScopeHistoryRequest struct {
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// session_id, if provided, is the session to get the history of instead of the scope. It can either be a uuid,
+	// e.g. 5803f8bc-6067-4eb5-951f-2121671c2ec0 or a bech32 session address, e.g.
+	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr.
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *ScopeHistoryRequest) Reset()         { *m = ScopeHistoryRequest{} }
+func (m *ScopeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeHistoryRequest) ProtoMessage()    {}
+func (*ScopeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{56}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *ScopeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ScopeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeHistoryRequest.Merge(m, src)
+}
+func (m *ScopeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeHistoryRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Scope(ctx context.Context, in *ScopeRequest, opts ...grpc.CallOption) (*ScopeResponse, error) {
-	out := new(ScopeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Scope", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScopeHistoryRequest proto.InternalMessageInfo
+
+func (m *ScopeHistoryRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ScopesAll(ctx context.Context, in *ScopesAllRequest, opts ...grpc.CallOption) (*ScopesAllResponse, error) {
-	out := new(ScopesAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopesAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeHistoryRequest) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Sessions(ctx context.Context, in *SessionsRequest, opts ...grpc.CallOption) (*SessionsResponse, error) {
-	out := new(SessionsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Sessions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SessionsAll(ctx context.Context, in *SessionsAllRequest, opts ...grpc.CallOption) (*SessionsAllResponse, error) {
-	out := new(SessionsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/SessionsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// ScopeHistoryResponse is the response type for the Query/ScopeHistory RPC method.
+type ScopeHistoryResponse struct {
+	// scope_versions are the prior versions of the scope (only populated when no session_id is requested).
+	ScopeVersions []ScopeVersion `protobuf:"bytes,1,rep,name=scope_versions,json=scopeVersions,proto3" json:"scope_versions"`
+	// session_versions are the prior versions of the session (only populated when a session_id is requested).
+	SessionVersions []SessionVersion `protobuf:"bytes,2,rep,name=session_versions,json=sessionVersions,proto3" json:"session_versions"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Records(ctx context.Context, in *RecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error) {
-	out := new(RecordsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Records", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeHistoryResponse) Reset()         { *m = ScopeHistoryResponse{} }
+func (m *ScopeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeHistoryResponse) ProtoMessage()    {}
+func (*ScopeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{57}
+}
+func (m *ScopeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ScopeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeHistoryResponse.Merge(m, src)
+}
+func (m *ScopeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) RecordsAll(ctx context.Context, in *RecordsAllRequest, opts ...grpc.CallOption) (*RecordsAllResponse, error) {
-	out := new(RecordsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScopeHistoryResponse proto.InternalMessageInfo
+
+func (m *ScopeHistoryResponse) GetScopeVersions() []ScopeVersion {
+	if m != nil {
+		return m.ScopeVersions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error) {
-	out := new(OwnershipResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Ownership", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeHistoryResponse) GetSessionVersions() []SessionVersion {
+	if m != nil {
+		return m.SessionVersions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error) {
-	out := new(ValueOwnershipResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ValueOwnership", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ScopeSpecification(ctx context.Context, in *ScopeSpecificationRequest, opts ...grpc.CallOption) (*ScopeSpecificationResponse, error) {
-	out := new(ScopeSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// RecordHistoryRequest is the request type for the Query/RecordHistory RPC method.
+type RecordHistoryRequest struct {
+	// record_addr is a bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. It is only used if no record_addr is provided.
+	ScopeId string `protobuf:"bytes,2,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// name is the name of the record. It is only used if no record_addr is provided.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) ScopeSpecificationsAll(ctx context.Context, in *ScopeSpecificationsAllRequest, opts ...grpc.CallOption) (*ScopeSpecificationsAllResponse, error) {
-	out := new(ScopeSpecificationsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSpecificationsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RecordHistoryRequest) Reset()         { *m = RecordHistoryRequest{} }
+func (m *RecordHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordHistoryRequest) ProtoMessage()    {}
+func (*RecordHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{58}
+}
+func (m *RecordHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RecordHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordHistoryRequest.Merge(m, src)
+}
+func (m *RecordHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordHistoryRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ContractSpecification(ctx context.Context, in *ContractSpecificationRequest, opts ...grpc.CallOption) (*ContractSpecificationResponse, error) {
-	out := new(ContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ContractSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RecordHistoryRequest proto.InternalMessageInfo
+
+func (m *RecordHistoryRequest) GetRecordAddr() string {
+	if m != nil {
+		return m.RecordAddr
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ContractSpecificationsAll(ctx context.Context, in *ContractSpecificationsAllRequest, opts ...grpc.CallOption) (*ContractSpecificationsAllResponse, error) {
-	out := new(ContractSpecificationsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ContractSpecificationsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RecordHistoryRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) RecordSpecificationsForContractSpecification(ctx context.Context, in *RecordSpecificationsForContractSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationsForContractSpecificationResponse, error) {
-	out := new(RecordSpecificationsForContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecificationsForContractSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RecordHistoryRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) RecordSpecification(ctx context.Context, in *RecordSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationResponse, error) {
-	out := new(RecordSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RecordHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RecordSpecificationsAll(ctx context.Context, in *RecordSpecificationsAllRequest, opts ...grpc.CallOption) (*RecordSpecificationsAllResponse, error) {
-	out := new(RecordSpecificationsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecificationsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+// RecordHistoryResponse is the response type for the Query/RecordHistory RPC method.
+type RecordHistoryResponse struct {
+	// record_versions are the prior versions of the record.
+	RecordVersions []RecordVersion `protobuf:"bytes,1,rep,name=record_versions,json=recordVersions,proto3" json:"record_versions"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *RecordHistoryResponse) Reset()         { *m = RecordHistoryResponse{} }
+func (m *RecordHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordHistoryResponse) ProtoMessage()    {}
+func (*RecordHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{59}
+}
+func (m *RecordHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RecordHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordHistoryResponse.Merge(m, src)
+}
+func (m *RecordHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetByAddr(ctx context.Context, in *GetByAddrRequest, opts ...grpc.CallOption) (*GetByAddrResponse, error) {
-	out := new(GetByAddrResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/GetByAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RecordHistoryResponse proto.InternalMessageInfo
+
+func (m *RecordHistoryResponse) GetRecordVersions() []RecordVersion {
+	if m != nil {
+		return m.RecordVersions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OSLocatorParams(ctx context.Context, in *OSLocatorParamsRequest, opts ...grpc.CallOption) (*OSLocatorParamsResponse, error) {
-	out := new(OSLocatorParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RecordHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OSLocator(ctx context.Context, in *OSLocatorRequest, opts ...grpc.CallOption) (*OSLocatorResponse, error) {
-	out := new(OSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
+// ScopeOwnershipTransfersRequest is the request type for the Query/ScopeOwnershipTransfers RPC method.
+type ScopeOwnershipTransfersRequest struct {
+	// scope_id, if provided, limits the results to the transfer of this scope. It can either be a uuid, e.g.
+	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// recipient, if provided, limits the results to the transfers that this bech32 address needs to accept.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ScopeOwnershipTransfersRequest) Reset()         { *m = ScopeOwnershipTransfersRequest{} }
+func (m *ScopeOwnershipTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeOwnershipTransfersRequest) ProtoMessage()    {}
+func (*ScopeOwnershipTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{60}
+}
+func (m *ScopeOwnershipTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeOwnershipTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeOwnershipTransfersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ScopeOwnershipTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeOwnershipTransfersRequest.Merge(m, src)
+}
+func (m *ScopeOwnershipTransfersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeOwnershipTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeOwnershipTransfersRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) OSLocatorsByURI(ctx context.Context, in *OSLocatorsByURIRequest, opts ...grpc.CallOption) (*OSLocatorsByURIResponse, error) {
-	out := new(OSLocatorsByURIResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorsByURI", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScopeOwnershipTransfersRequest proto.InternalMessageInfo
+
+func (m *ScopeOwnershipTransfersRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) OSLocatorsByScope(ctx context.Context, in *OSLocatorsByScopeRequest, opts ...grpc.CallOption) (*OSLocatorsByScopeResponse, error) {
-	out := new(OSLocatorsByScopeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorsByScope", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeOwnershipTransfersRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) OSAllLocators(ctx context.Context, in *OSAllLocatorsRequest, opts ...grpc.CallOption) (*OSAllLocatorsResponse, error) {
-	out := new(OSAllLocatorsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSAllLocators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeOwnershipTransfersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AccountData(ctx context.Context, in *AccountDataRequest, opts ...grpc.CallOption) (*AccountDataResponse, error) {
-	out := new(AccountDataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/AccountData", in, out, opts...)
-	if err != nil {
-		return nil, err
+// ScopeOwnershipTransfersResponse is the response type for the Query/ScopeOwnershipTransfers RPC method.
+type ScopeOwnershipTransfersResponse struct {
+	// transfers are the pending scope ownership transfers.
+	Transfers []ScopeOwnershipTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ScopeOwnershipTransfersResponse) Reset()         { *m = ScopeOwnershipTransfersResponse{} }
+func (m *ScopeOwnershipTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeOwnershipTransfersResponse) ProtoMessage()    {}
+func (*ScopeOwnershipTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{61}
+}
+func (m *ScopeOwnershipTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeOwnershipTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeOwnershipTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ScopeOwnershipTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeOwnershipTransfersResponse.Merge(m, src)
+}
+func (m *ScopeOwnershipTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeOwnershipTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeOwnershipTransfersResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ScopeNetAssetValues(ctx context.Context, in *QueryScopeNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryScopeNetAssetValuesResponse, error) {
-	out := new(QueryScopeNetAssetValuesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeNetAssetValues", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScopeOwnershipTransfersResponse proto.InternalMessageInfo
+
+func (m *ScopeOwnershipTransfersResponse) GetTransfers() []ScopeOwnershipTransfer {
+	if m != nil {
+		return m.Transfers
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of x/metadata module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Scope searches for a scope.
-	//
-	// The scope id, if provided, must either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address,
-	// e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. The session addr, if provided, must be a bech32 session address,
-	// e.g. session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
-	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
-	//
-	// * If only a scope_id is provided, that scope is returned.
-	// * If only a session_addr is provided, the scope containing that session is returned.
-	// * If only a record_addr is provided, the scope containing that record is returned.
-	// * If more than one of scope_id, session_addr, and record_addr are provided, and they don't refer to the same scope,
-	// a bad request is returned.
-	//
-	// Providing a session addr or record addr does not limit the sessions and records returned (if requested).
-	// Those parameters are only used to find the scope.
-	//
-	// By default, sessions and records are not included.
-	// Set include_sessions and/or include_records to true to include sessions and/or records.
-	Scope(context.Context, *ScopeRequest) (*ScopeResponse, error)
-	// ScopesAll retrieves all scopes.
-	ScopesAll(context.Context, *ScopesAllRequest) (*ScopesAllResponse, error)
-	// Sessions searches for sessions.
-	//
-	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
-	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly, the session_id can either be a uuid or session address, e.g.
-	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
-	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
-	//
-	// * If only a scope_id is provided, all sessions in that scope are returned.
-	// * If only a session_id is provided, it must be an address, and that single session is returned.
-	// * If the session_id is a uuid, then either a scope_id or record_addr must also be provided, and that single session
-	// is returned.
-	// * If only a record_addr is provided, the session containing that record will be returned.
-	// * If a record_name is provided then either a scope_id, session_id as an address, or record_addr must also be
-	// provided, and the session containing that record will be returned.
-	//
-	// A bad request is returned if:
-	// * The session_id is a uuid and is provided without a scope_id or record_addr.
-	// * A record_name is provided without any way to identify the scope (e.g. a scope_id, a session_id as an address, or
-	// a record_addr).
-	// * Two or more of scope_id, session_id as an address, and record_addr are provided and don't all refer to the same
-	// scope.
-	// * A record_addr (or scope_id and record_name) is provided with a session_id and that session does not contain such
-	// a record.
-	// * A record_addr and record_name are both provided, but reference different records.
-	//
-	// By default, the scope and records are not included.
-	// Set include_scope and/or include_records to true to include the scope and/or records.
-	Sessions(context.Context, *SessionsRequest) (*SessionsResponse, error)
-	// SessionsAll retrieves all sessions.
-	SessionsAll(context.Context, *SessionsAllRequest) (*SessionsAllResponse, error)
-	// Records searches for records.
-	//
-	// The record_addr, if provided, must be a bech32 record address, e.g.
-	// record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3. The scope-id can either be scope uuid, e.g.
-	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly,
-	// the session_id can either be a uuid or session address, e.g.
-	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The name is the name of the record you're
-	// interested in.
-	//
-	// * If only a record_addr is provided, that single record will be returned.
-	// * If only a scope_id is provided, all records in that scope will be returned.
-	// * If only a session_id (or scope_id/session_id), all records in that session will be returned.
-	// * If a name is provided with a scope_id and/or session_id, that single record will be returned.
-	//
-	// A bad request is returned if:
-	// * The session_id is a uuid and no scope_id is provided.
-	// * There are two or more of record_addr, session_id, and scope_id, and they don't all refer to the same scope.
-	// * A name is provided, but not a scope_id and/or a session_id.
-	// * A name and record_addr are provided and the name doesn't match the record_addr.
-	//
-	// By default, the scope and sessions are not included.
-	// Set include_scope and/or include_sessions to true to include the scope and/or sessions.
-	Records(context.Context, *RecordsRequest) (*RecordsResponse, error)
-	// RecordsAll retrieves all records.
-	RecordsAll(context.Context, *RecordsAllRequest) (*RecordsAllResponse, error)
-	// Ownership returns the scope identifiers that have the given address in the owners list.
-	Ownership(context.Context, *OwnershipRequest) (*OwnershipResponse, error)
-	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
-	ValueOwnership(context.Context, *ValueOwnershipRequest) (*ValueOwnershipResponse, error)
-	// ScopeSpecification returns a scope specification for the given specification id.
-	//
-	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
-	// specification address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
-	//
-	// By default, the contract and record specifications are not included.
-	// Set include_contract_specs and/or include_record_specs to true to include contract and/or record specifications.
-	ScopeSpecification(context.Context, *ScopeSpecificationRequest) (*ScopeSpecificationResponse, error)
-	// ScopeSpecificationsAll retrieves all scope specifications.
-	ScopeSpecificationsAll(context.Context, *ScopeSpecificationsAllRequest) (*ScopeSpecificationsAllResponse, error)
-	// ContractSpecification returns a contract specification for the given specification id.
-	//
-	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
-	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
-	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
-	// address, then the contract specification that contains that record specification is looked up.
-	//
-	// By default, the record specifications for this contract specification are not included.
-	// Set include_record_specs to true to include them in the result.
-	ContractSpecification(context.Context, *ContractSpecificationRequest) (*ContractSpecificationResponse, error)
-	// ContractSpecificationsAll retrieves all contract specifications.
-	ContractSpecificationsAll(context.Context, *ContractSpecificationsAllRequest) (*ContractSpecificationsAllResponse, error)
-	// RecordSpecificationsForContractSpecification returns the record specifications for the given input.
-	//
-	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
-	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
-	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
-	// address, then the contract specification that contains that record specification is used.
-	RecordSpecificationsForContractSpecification(context.Context, *RecordSpecificationsForContractSpecificationRequest) (*RecordSpecificationsForContractSpecificationResponse, error)
-	// RecordSpecification returns a record specification for the given input.
-	RecordSpecification(context.Context, *RecordSpecificationRequest) (*RecordSpecificationResponse, error)
-	// RecordSpecificationsAll retrieves all record specifications.
-	RecordSpecificationsAll(context.Context, *RecordSpecificationsAllRequest) (*RecordSpecificationsAllResponse, error)
-	// GetByAddr retrieves metadata given any address(es).
-	GetByAddr(context.Context, *GetByAddrRequest) (*GetByAddrResponse, error)
-	// OSLocatorParams returns all parameters for the object store locator sub module.
-	OSLocatorParams(context.Context, *OSLocatorParamsRequest) (*OSLocatorParamsResponse, error)
-	// OSLocator returns an ObjectStoreLocator by its owner's address.
-	OSLocator(context.Context, *OSLocatorRequest) (*OSLocatorResponse, error)
-	// OSLocatorsByURI returns all ObjectStoreLocator entries for a locator uri.
-	OSLocatorsByURI(context.Context, *OSLocatorsByURIRequest) (*OSLocatorsByURIResponse, error)
-	// OSLocatorsByScope returns all ObjectStoreLocator entries for a for all signer's present in the specified scope.
-	OSLocatorsByScope(context.Context, *OSLocatorsByScopeRequest) (*OSLocatorsByScopeResponse, error)
-	// OSAllLocators returns all ObjectStoreLocator entries.
-	OSAllLocators(context.Context, *OSAllLocatorsRequest) (*OSAllLocatorsResponse, error)
-	// AccountData gets the account data associated with a metadata address.
-	// Currently, only scope ids are supported.
-	AccountData(context.Context, *AccountDataRequest) (*AccountDataResponse, error)
-	// ScopeNetAssetValues returns net asset values for scope
-	ScopeNetAssetValues(context.Context, *QueryScopeNetAssetValuesRequest) (*QueryScopeNetAssetValuesResponse, error)
+func (m *ScopeOwnershipTransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// RecordCommitmentRequest is the request type for the Query/RecordCommitment RPC method.
+type RecordCommitmentRequest struct {
+	// record_addr is a bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. It is only used if no record_addr is provided.
+	ScopeId string `protobuf:"bytes,2,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// name is the name of the record. It is only used if no record_addr is provided.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *RecordCommitmentRequest) Reset()         { *m = RecordCommitmentRequest{} }
+func (m *RecordCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordCommitmentRequest) ProtoMessage()    {}
+func (*RecordCommitmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{62}
 }
-func (*UnimplementedQueryServer) Scope(ctx context.Context, req *ScopeRequest) (*ScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Scope not implemented")
+func (m *RecordCommitmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ScopesAll(ctx context.Context, req *ScopesAllRequest) (*ScopesAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScopesAll not implemented")
+func (m *RecordCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordCommitmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Sessions(ctx context.Context, req *SessionsRequest) (*SessionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sessions not implemented")
+func (m *RecordCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordCommitmentRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) SessionsAll(ctx context.Context, req *SessionsAllRequest) (*SessionsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SessionsAll not implemented")
+func (m *RecordCommitmentRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Records(ctx context.Context, req *RecordsRequest) (*RecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Records not implemented")
+func (m *RecordCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordCommitmentRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) RecordsAll(ctx context.Context, req *RecordsAllRequest) (*RecordsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordsAll not implemented")
+
+var xxx_messageInfo_RecordCommitmentRequest proto.InternalMessageInfo
+
+func (m *RecordCommitmentRequest) GetRecordAddr() string {
+	if m != nil {
+		return m.RecordAddr
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) Ownership(ctx context.Context, req *OwnershipRequest) (*OwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ownership not implemented")
+
+func (m *RecordCommitmentRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) ValueOwnership(ctx context.Context, req *ValueOwnershipRequest) (*ValueOwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValueOwnership not implemented")
+
+func (m *RecordCommitmentRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) ScopeSpecification(ctx context.Context, req *ScopeSpecificationRequest) (*ScopeSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScopeSpecification not implemented")
+
+// RecordCommitmentResponse is the response type for the Query/RecordCommitment RPC method.
+type RecordCommitmentResponse struct {
+	// root is the merkle root over all the records in the scope.
+	Root []byte `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// leaf is the leaf data committed to for the record: its name followed by each of its output hashes,
+	// each preceded by its length as a 4-byte big-endian number.
+	Leaf []byte `protobuf:"bytes,2,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	// proof is the proof that the leaf is included in the root.
+	Proof *RecordCommitmentProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
 }
-func (*UnimplementedQueryServer) ScopeSpecificationsAll(ctx context.Context, req *ScopeSpecificationsAllRequest) (*ScopeSpecificationsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScopeSpecificationsAll not implemented")
+
+func (m *RecordCommitmentResponse) Reset()         { *m = RecordCommitmentResponse{} }
+func (m *RecordCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordCommitmentResponse) ProtoMessage()    {}
+func (*RecordCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{63}
 }
-func (*UnimplementedQueryServer) ContractSpecification(ctx context.Context, req *ContractSpecificationRequest) (*ContractSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractSpecification not implemented")
+func (m *RecordCommitmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ContractSpecificationsAll(ctx context.Context, req *ContractSpecificationsAllRequest) (*ContractSpecificationsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractSpecificationsAll not implemented")
+func (m *RecordCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordCommitmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) RecordSpecificationsForContractSpecification(ctx context.Context, req *RecordSpecificationsForContractSpecificationRequest) (*RecordSpecificationsForContractSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecificationsForContractSpecification not implemented")
+func (m *RecordCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordCommitmentResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) RecordSpecification(ctx context.Context, req *RecordSpecificationRequest) (*RecordSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecification not implemented")
+func (m *RecordCommitmentResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) RecordSpecificationsAll(ctx context.Context, req *RecordSpecificationsAllRequest) (*RecordSpecificationsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecificationsAll not implemented")
+func (m *RecordCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordCommitmentResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetByAddr(ctx context.Context, req *GetByAddrRequest) (*GetByAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetByAddr not implemented")
+
+var xxx_messageInfo_RecordCommitmentResponse proto.InternalMessageInfo
+
+func (m *RecordCommitmentResponse) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) OSLocatorParams(ctx context.Context, req *OSLocatorParamsRequest) (*OSLocatorParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorParams not implemented")
+
+func (m *RecordCommitmentResponse) GetLeaf() []byte {
+	if m != nil {
+		return m.Leaf
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) OSLocator(ctx context.Context, req *OSLocatorRequest) (*OSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSLocator not implemented")
+
+func (m *RecordCommitmentResponse) GetProof() *RecordCommitmentProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) OSLocatorsByURI(ctx context.Context, req *OSLocatorsByURIRequest) (*OSLocatorsByURIResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorsByURI not implemented")
+
+// RecordCommitmentProof is an RFC 6962 merkle tree inclusion proof of a record in its scope's records root.
+// The leaves are ordered by record id.
+type RecordCommitmentProof struct {
+	// total is the number of leaves (records) in the tree.
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// index is the position of the proven leaf in the tree.
+	Index int64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// leaf_hash is the hash of the proven leaf, i.e. sha256(0x00 || leaf).
+	LeafHash []byte `protobuf:"bytes,3,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	// aunts are the sibling hashes from the leaf up to (but not including) the root.
+	Aunts [][]byte `protobuf:"bytes,4,rep,name=aunts,proto3" json:"aunts,omitempty"`
 }
-func (*UnimplementedQueryServer) OSLocatorsByScope(ctx context.Context, req *OSLocatorsByScopeRequest) (*OSLocatorsByScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorsByScope not implemented")
+
+func (m *RecordCommitmentProof) Reset()         { *m = RecordCommitmentProof{} }
+func (m *RecordCommitmentProof) String() string { return proto.CompactTextString(m) }
+func (*RecordCommitmentProof) ProtoMessage()    {}
+func (*RecordCommitmentProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{64}
 }
-func (*UnimplementedQueryServer) OSAllLocators(ctx context.Context, req *OSAllLocatorsRequest) (*OSAllLocatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSAllLocators not implemented")
+func (m *RecordCommitmentProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AccountData(ctx context.Context, req *AccountDataRequest) (*AccountDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountData not implemented")
+func (m *RecordCommitmentProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordCommitmentProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ScopeNetAssetValues(ctx context.Context, req *QueryScopeNetAssetValuesRequest) (*QueryScopeNetAssetValuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScopeNetAssetValues not implemented")
+func (m *RecordCommitmentProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordCommitmentProof.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *RecordCommitmentProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordCommitmentProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordCommitmentProof.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_RecordCommitmentProof proto.InternalMessageInfo
+
+func (m *RecordCommitmentProof) GetTotal() int64 {
+	if m != nil {
+		return m.Total
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Scope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScopeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Scope(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Scope",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Scope(ctx, req.(*ScopeRequest))
+func (m *RecordCommitmentProof) GetIndex() int64 {
+	if m != nil {
+		return m.Index
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ScopesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScopesAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ScopesAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ScopesAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ScopesAll(ctx, req.(*ScopesAllRequest))
+func (m *RecordCommitmentProof) GetLeafHash() []byte {
+	if m != nil {
+		return m.LeafHash
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Sessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Sessions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Sessions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Sessions(ctx, req.(*SessionsRequest))
+func (m *RecordCommitmentProof) GetAunts() [][]byte {
+	if m != nil {
+		return m.Aunts
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SessionsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SessionsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/SessionsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SessionsAll(ctx, req.(*SessionsAllRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ScopeDataAccessRequest is the request type for the Query/ScopeDataAccess RPC method.
+type ScopeDataAccessRequest struct {
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
 }
 
-func _Query_Records_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Records(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Records",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Records(ctx, req.(*RecordsRequest))
+func (m *ScopeDataAccessRequest) Reset()         { *m = ScopeDataAccessRequest{} }
+func (m *ScopeDataAccessRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeDataAccessRequest) ProtoMessage()    {}
+func (*ScopeDataAccessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{65}
+}
+func (m *ScopeDataAccessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeDataAccessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeDataAccessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ScopeDataAccessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeDataAccessRequest.Merge(m, src)
+}
+func (m *ScopeDataAccessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeDataAccessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeDataAccessRequest.DiscardUnknown(m)
 }
 
-func _Query_RecordsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/RecordsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordsAll(ctx, req.(*RecordsAllRequest))
+var xxx_messageInfo_ScopeDataAccessRequest proto.InternalMessageInfo
+
+func (m *ScopeDataAccessRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Ownership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OwnershipRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Ownership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Ownership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Ownership(ctx, req.(*OwnershipRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ScopeDataAccessResponse is the response type for the Query/ScopeDataAccess RPC method.
+type ScopeDataAccessResponse struct {
+	// data_access are the addresses with data access to the scope.
+	DataAccess []ScopeDataAccessEntry `protobuf:"bytes,1,rep,name=data_access,json=dataAccess,proto3" json:"data_access"`
 }
 
-func _Query_ValueOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValueOwnershipRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValueOwnership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ValueOwnership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValueOwnership(ctx, req.(*ValueOwnershipRequest))
+func (m *ScopeDataAccessResponse) Reset()         { *m = ScopeDataAccessResponse{} }
+func (m *ScopeDataAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeDataAccessResponse) ProtoMessage()    {}
+func (*ScopeDataAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{66}
+}
+func (m *ScopeDataAccessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeDataAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeDataAccessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ScopeDataAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeDataAccessResponse.Merge(m, src)
+}
+func (m *ScopeDataAccessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeDataAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeDataAccessResponse.DiscardUnknown(m)
 }
 
-func _Query_ScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScopeSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ScopeSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ScopeSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ScopeSpecification(ctx, req.(*ScopeSpecificationRequest))
+var xxx_messageInfo_ScopeDataAccessResponse proto.InternalMessageInfo
+
+func (m *ScopeDataAccessResponse) GetDataAccess() []ScopeDataAccessEntry {
+	if m != nil {
+		return m.DataAccess
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ScopeSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScopeSpecificationsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ScopeSpecificationsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ScopeSpecificationsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ScopeSpecificationsAll(ctx, req.(*ScopeSpecificationsAllRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ScopeDataAccessEntry is an address with data access to a scope.
+type ScopeDataAccessEntry struct {
+	// address is the bech32 address with data access to the scope.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// expiration is the time after which the address no longer has data access. It is empty if the access does not
+	// expire.
+	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// remaining is how long the access has left as of the current block time. It is empty if the access does not
+	// expire.
+	Remaining *time.Duration `protobuf:"bytes,3,opt,name=remaining,proto3,stdduration" json:"remaining,omitempty"`
 }
 
-func _Query_ContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ContractSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractSpecification(ctx, req.(*ContractSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ScopeDataAccessEntry) Reset()         { *m = ScopeDataAccessEntry{} }
+func (m *ScopeDataAccessEntry) String() string { return proto.CompactTextString(m) }
+func (*ScopeDataAccessEntry) ProtoMessage()    {}
+func (*ScopeDataAccessEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{67}
 }
-
-func _Query_ContractSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractSpecificationsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractSpecificationsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ContractSpecificationsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractSpecificationsAll(ctx, req.(*ContractSpecificationsAllRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ScopeDataAccessEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_RecordSpecificationsForContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordSpecificationsForContractSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordSpecificationsForContractSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/RecordSpecificationsForContractSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordSpecificationsForContractSpecification(ctx, req.(*RecordSpecificationsForContractSpecificationRequest))
+func (m *ScopeDataAccessEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeDataAccessEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ScopeDataAccessEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeDataAccessEntry.Merge(m, src)
+}
+func (m *ScopeDataAccessEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeDataAccessEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeDataAccessEntry.DiscardUnknown(m)
 }
 
-func _Query_RecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/RecordSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordSpecification(ctx, req.(*RecordSpecificationRequest))
+var xxx_messageInfo_ScopeDataAccessEntry proto.InternalMessageInfo
+
+func (m *ScopeDataAccessEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_RecordSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordSpecificationsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordSpecificationsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/RecordSpecificationsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordSpecificationsAll(ctx, req.(*RecordSpecificationsAllRequest))
+func (m *ScopeDataAccessEntry) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetByAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByAddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetByAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/GetByAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetByAddr(ctx, req.(*GetByAddrRequest))
+func (m *ScopeDataAccessEntry) GetRemaining() *time.Duration {
+	if m != nil {
+		return m.Remaining
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_OSLocatorParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSLocatorParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSLocatorParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSLocatorParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSLocatorParams(ctx, req.(*OSLocatorParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ScopeTombstoneRequest is the request type for the Query/ScopeTombstone RPC method.
+type ScopeTombstoneRequest struct {
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
 }
 
-func _Query_OSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSLocatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSLocator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSLocator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSLocator(ctx, req.(*OSLocatorRequest))
+func (m *ScopeTombstoneRequest) Reset()         { *m = ScopeTombstoneRequest{} }
+func (m *ScopeTombstoneRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeTombstoneRequest) ProtoMessage()    {}
+func (*ScopeTombstoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{68}
+}
+func (m *ScopeTombstoneRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeTombstoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeTombstoneRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ScopeTombstoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeTombstoneRequest.Merge(m, src)
+}
+func (m *ScopeTombstoneRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeTombstoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeTombstoneRequest.DiscardUnknown(m)
 }
 
-func _Query_OSLocatorsByURI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSLocatorsByURIRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSLocatorsByURI(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSLocatorsByURI",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSLocatorsByURI(ctx, req.(*OSLocatorsByURIRequest))
+var xxx_messageInfo_ScopeTombstoneRequest proto.InternalMessageInfo
+
+func (m *ScopeTombstoneRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_OSLocatorsByScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSLocatorsByScopeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSLocatorsByScope(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSLocatorsByScope",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSLocatorsByScope(ctx, req.(*OSLocatorsByScopeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ScopeTombstoneResponse is the response type for the Query/ScopeTombstone RPC method.
+type ScopeTombstoneResponse struct {
+	// tombstone is the record of the archived scope.
+	Tombstone ScopeTombstone `protobuf:"bytes,1,opt,name=tombstone,proto3" json:"tombstone"`
 }
 
-func _Query_OSAllLocators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSAllLocatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSAllLocators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSAllLocators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSAllLocators(ctx, req.(*OSAllLocatorsRequest))
+func (m *ScopeTombstoneResponse) Reset()         { *m = ScopeTombstoneResponse{} }
+func (m *ScopeTombstoneResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeTombstoneResponse) ProtoMessage()    {}
+func (*ScopeTombstoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{69}
+}
+func (m *ScopeTombstoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeTombstoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeTombstoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ScopeTombstoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeTombstoneResponse.Merge(m, src)
+}
+func (m *ScopeTombstoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeTombstoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeTombstoneResponse.DiscardUnknown(m)
 }
 
-func _Query_AccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/AccountData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountData(ctx, req.(*AccountDataRequest))
+var xxx_messageInfo_ScopeTombstoneResponse proto.InternalMessageInfo
+
+func (m *ScopeTombstoneResponse) GetTombstone() ScopeTombstone {
+	if m != nil {
+		return m.Tombstone
 	}
-	return interceptor(ctx, in, info, handler)
+	return ScopeTombstone{}
 }
 
-func _Query_ScopeNetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryScopeNetAssetValuesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ScopeNetAssetValues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ScopeNetAssetValues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ScopeNetAssetValues(ctx, req.(*QueryScopeNetAssetValuesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.metadata.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Scope",
-			Handler:    _Query_Scope_Handler,
-		},
-		{
-			MethodName: "ScopesAll",
-			Handler:    _Query_ScopesAll_Handler,
-		},
-		{
-			MethodName: "Sessions",
-			Handler:    _Query_Sessions_Handler,
-		},
-		{
-			MethodName: "SessionsAll",
-			Handler:    _Query_SessionsAll_Handler,
-		},
-		{
-			MethodName: "Records",
-			Handler:    _Query_Records_Handler,
-		},
-		{
-			MethodName: "RecordsAll",
-			Handler:    _Query_RecordsAll_Handler,
-		},
-		{
-			MethodName: "Ownership",
-			Handler:    _Query_Ownership_Handler,
-		},
-		{
-			MethodName: "ValueOwnership",
-			Handler:    _Query_ValueOwnership_Handler,
-		},
-		{
-			MethodName: "ScopeSpecification",
-			Handler:    _Query_ScopeSpecification_Handler,
-		},
-		{
-			MethodName: "ScopeSpecificationsAll",
-			Handler:    _Query_ScopeSpecificationsAll_Handler,
-		},
-		{
-			MethodName: "ContractSpecification",
-			Handler:    _Query_ContractSpecification_Handler,
-		},
-		{
-			MethodName: "ContractSpecificationsAll",
-			Handler:    _Query_ContractSpecificationsAll_Handler,
-		},
-		{
-			MethodName: "RecordSpecificationsForContractSpecification",
-			Handler:    _Query_RecordSpecificationsForContractSpecification_Handler,
-		},
-		{
-			MethodName: "RecordSpecification",
-			Handler:    _Query_RecordSpecification_Handler,
-		},
-		{
-			MethodName: "RecordSpecificationsAll",
-			Handler:    _Query_RecordSpecificationsAll_Handler,
-		},
-		{
-			MethodName: "GetByAddr",
-			Handler:    _Query_GetByAddr_Handler,
-		},
-		{
-			MethodName: "OSLocatorParams",
-			Handler:    _Query_OSLocatorParams_Handler,
-		},
-		{
-			MethodName: "OSLocator",
-			Handler:    _Query_OSLocator_Handler,
-		},
-		{
-			MethodName: "OSLocatorsByURI",
-			Handler:    _Query_OSLocatorsByURI_Handler,
-		},
-		{
-			MethodName: "OSLocatorsByScope",
-			Handler:    _Query_OSLocatorsByScope_Handler,
-		},
-		{
-			MethodName: "OSAllLocators",
-			Handler:    _Query_OSAllLocators_Handler,
-		},
-		{
-			MethodName: "AccountData",
-			Handler:    _Query_AccountData_Handler,
-		},
-		{
-			MethodName: "ScopeNetAssetValues",
-			Handler:    _Query_ScopeNetAssetValues_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/metadata/v1/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.metadata.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.metadata.v1.QueryParamsResponse")
+	proto.RegisterType((*ScopeRequest)(nil), "provenance.metadata.v1.ScopeRequest")
+	proto.RegisterType((*ScopeResponse)(nil), "provenance.metadata.v1.ScopeResponse")
+	proto.RegisterType((*ScopeWrapper)(nil), "provenance.metadata.v1.ScopeWrapper")
+	proto.RegisterType((*ScopesAllRequest)(nil), "provenance.metadata.v1.ScopesAllRequest")
+	proto.RegisterType((*ScopesAllResponse)(nil), "provenance.metadata.v1.ScopesAllResponse")
+	proto.RegisterType((*ScopeSearchRequest)(nil), "provenance.metadata.v1.ScopeSearchRequest")
+	proto.RegisterType((*ScopeSearchResponse)(nil), "provenance.metadata.v1.ScopeSearchResponse")
+	proto.RegisterType((*SessionsRequest)(nil), "provenance.metadata.v1.SessionsRequest")
+	proto.RegisterType((*SessionsResponse)(nil), "provenance.metadata.v1.SessionsResponse")
+	proto.RegisterType((*SessionWrapper)(nil), "provenance.metadata.v1.SessionWrapper")
+	proto.RegisterType((*SessionsAllRequest)(nil), "provenance.metadata.v1.SessionsAllRequest")
+	proto.RegisterType((*SessionsAllResponse)(nil), "provenance.metadata.v1.SessionsAllResponse")
+	proto.RegisterType((*RecordsRequest)(nil), "provenance.metadata.v1.RecordsRequest")
+	proto.RegisterType((*RecordsResponse)(nil), "provenance.metadata.v1.RecordsResponse")
+	proto.RegisterType((*RecordWrapper)(nil), "provenance.metadata.v1.RecordWrapper")
+	proto.RegisterType((*RecordsAllRequest)(nil), "provenance.metadata.v1.RecordsAllRequest")
+	proto.RegisterType((*RecordsAllResponse)(nil), "provenance.metadata.v1.RecordsAllResponse")
+	proto.RegisterType((*OwnershipRequest)(nil), "provenance.metadata.v1.OwnershipRequest")
+	proto.RegisterType((*OwnershipResponse)(nil), "provenance.metadata.v1.OwnershipResponse")
+	proto.RegisterType((*ValueOwnershipRequest)(nil), "provenance.metadata.v1.ValueOwnershipRequest")
+	proto.RegisterType((*ValueOwnershipResponse)(nil), "provenance.metadata.v1.ValueOwnershipResponse")
+	proto.RegisterType((*ScopeSpecificationRequest)(nil), "provenance.metadata.v1.ScopeSpecificationRequest")
+	proto.RegisterType((*ScopeSpecificationResponse)(nil), "provenance.metadata.v1.ScopeSpecificationResponse")
+	proto.RegisterType((*ScopeSpecificationWrapper)(nil), "provenance.metadata.v1.ScopeSpecificationWrapper")
+	proto.RegisterType((*ScopeSpecificationsAllRequest)(nil), "provenance.metadata.v1.ScopeSpecificationsAllRequest")
+	proto.RegisterType((*ScopeSpecificationsAllResponse)(nil), "provenance.metadata.v1.ScopeSpecificationsAllResponse")
+	proto.RegisterType((*ContractSpecificationRequest)(nil), "provenance.metadata.v1.ContractSpecificationRequest")
+	proto.RegisterType((*ContractSpecificationResponse)(nil), "provenance.metadata.v1.ContractSpecificationResponse")
+	proto.RegisterType((*ContractSpecificationWrapper)(nil), "provenance.metadata.v1.ContractSpecificationWrapper")
+	proto.RegisterType((*ContractSpecificationsAllRequest)(nil), "provenance.metadata.v1.ContractSpecificationsAllRequest")
+	proto.RegisterType((*ContractSpecificationsAllResponse)(nil), "provenance.metadata.v1.ContractSpecificationsAllResponse")
+	proto.RegisterType((*RecordSpecificationsForContractSpecificationRequest)(nil), "provenance.metadata.v1.RecordSpecificationsForContractSpecificationRequest")
+	proto.RegisterType((*RecordSpecificationsForContractSpecificationResponse)(nil), "provenance.metadata.v1.RecordSpecificationsForContractSpecificationResponse")
+	proto.RegisterType((*RecordSpecificationRequest)(nil), "provenance.metadata.v1.RecordSpecificationRequest")
+	proto.RegisterType((*RecordSpecificationResponse)(nil), "provenance.metadata.v1.RecordSpecificationResponse")
+	proto.RegisterType((*RecordSpecificationWrapper)(nil), "provenance.metadata.v1.RecordSpecificationWrapper")
+	proto.RegisterType((*RecordSpecificationsAllRequest)(nil), "provenance.metadata.v1.RecordSpecificationsAllRequest")
+	proto.RegisterType((*RecordSpecificationsAllResponse)(nil), "provenance.metadata.v1.RecordSpecificationsAllResponse")
+	proto.RegisterType((*GetByAddrRequest)(nil), "provenance.metadata.v1.GetByAddrRequest")
+	proto.RegisterType((*GetByAddrResponse)(nil), "provenance.metadata.v1.GetByAddrResponse")
+	proto.RegisterType((*OSLocatorParamsRequest)(nil), "provenance.metadata.v1.OSLocatorParamsRequest")
+	proto.RegisterType((*OSLocatorParamsResponse)(nil), "provenance.metadata.v1.OSLocatorParamsResponse")
+	proto.RegisterType((*OSLocatorRequest)(nil), "provenance.metadata.v1.OSLocatorRequest")
+	proto.RegisterType((*OSLocatorResponse)(nil), "provenance.metadata.v1.OSLocatorResponse")
+	proto.RegisterType((*OSLocatorsByURIRequest)(nil), "provenance.metadata.v1.OSLocatorsByURIRequest")
+	proto.RegisterType((*OSLocatorsByURIResponse)(nil), "provenance.metadata.v1.OSLocatorsByURIResponse")
+	proto.RegisterType((*OSLocatorsByScopeRequest)(nil), "provenance.metadata.v1.OSLocatorsByScopeRequest")
+	proto.RegisterType((*OSLocatorsByScopeResponse)(nil), "provenance.metadata.v1.OSLocatorsByScopeResponse")
+	proto.RegisterType((*OSAllLocatorsRequest)(nil), "provenance.metadata.v1.OSAllLocatorsRequest")
+	proto.RegisterType((*OSAllLocatorsResponse)(nil), "provenance.metadata.v1.OSAllLocatorsResponse")
+	proto.RegisterType((*AccountDataRequest)(nil), "provenance.metadata.v1.AccountDataRequest")
+	proto.RegisterType((*AccountDataResponse)(nil), "provenance.metadata.v1.AccountDataResponse")
+	proto.RegisterType((*QueryScopeNetAssetValuesRequest)(nil), "provenance.metadata.v1.QueryScopeNetAssetValuesRequest")
+	proto.RegisterType((*QueryScopeNetAssetValuesResponse)(nil), "provenance.metadata.v1.QueryScopeNetAssetValuesResponse")
+	proto.RegisterType((*ScopeHistoryRequest)(nil), "provenance.metadata.v1.ScopeHistoryRequest")
+	proto.RegisterType((*ScopeHistoryResponse)(nil), "provenance.metadata.v1.ScopeHistoryResponse")
+	proto.RegisterType((*RecordHistoryRequest)(nil), "provenance.metadata.v1.RecordHistoryRequest")
+	proto.RegisterType((*RecordHistoryResponse)(nil), "provenance.metadata.v1.RecordHistoryResponse")
+	proto.RegisterType((*ScopeOwnershipTransfersRequest)(nil), "provenance.metadata.v1.ScopeOwnershipTransfersRequest")
+	proto.RegisterType((*ScopeOwnershipTransfersResponse)(nil), "provenance.metadata.v1.ScopeOwnershipTransfersResponse")
+	proto.RegisterType((*RecordCommitmentRequest)(nil), "provenance.metadata.v1.RecordCommitmentRequest")
+	proto.RegisterType((*RecordCommitmentResponse)(nil), "provenance.metadata.v1.RecordCommitmentResponse")
+	proto.RegisterType((*RecordCommitmentProof)(nil), "provenance.metadata.v1.RecordCommitmentProof")
+	proto.RegisterType((*ScopeDataAccessRequest)(nil), "provenance.metadata.v1.ScopeDataAccessRequest")
+	proto.RegisterType((*ScopeDataAccessResponse)(nil), "provenance.metadata.v1.ScopeDataAccessResponse")
+	proto.RegisterType((*ScopeDataAccessEntry)(nil), "provenance.metadata.v1.ScopeDataAccessEntry")
+	proto.RegisterType((*ScopeTombstoneRequest)(nil), "provenance.metadata.v1.ScopeTombstoneRequest")
+	proto.RegisterType((*ScopeTombstoneResponse)(nil), "provenance.metadata.v1.ScopeTombstoneResponse")
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/query.proto", fileDescriptor_a68790bc0b96eeb9)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
-	}
-	return len(dAtA) - i, nil
+var fileDescriptor_a68790bc0b96eeb9 = []byte{
+	// 3958 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5c, 0x6b, 0x6c, 0x1c, 0xd7,
+	0x57, 0xcf, 0x9d, 0xf5, 0xf3, 0xf8, 0x99, 0xeb, 0x47, 0x36, 0x93, 0xc6, 0x76, 0xb7, 0x79, 0xd8,
+	0x71, 0xb2, 0x1b, 0xdb, 0x79, 0xf5, 0xdf, 0xa6, 0x89, 0x9d, 0x77, 0x93, 0xe6, 0xb1, 0x4e, 0x5a,
+	0xc9, 0x08, 0x56, 0xe3, 0xdd, 0xb1, 0x3d, 0xd4, 0x3b, 0xb3, 0x9d, 0x99, 0x35, 0xb6, 0x2c, 0x23,
+	0x15, 0x50, 0x01, 0x21, 0xa1, 0x94, 0x42, 0x69, 0xa9, 0x2a, 0x5a, 0x44, 0x55, 0x54, 0x4a, 0x69,
+	0x85, 0x28, 0x20, 0x50, 0x55, 0x40, 0x48, 0x11, 0xf0, 0xa1, 0x94, 0x2f, 0x88, 0x0f, 0x2d, 0x4a,
+	0x90, 0xe0, 0x03, 0xe2, 0x43, 0x3f, 0x20, 0x01, 0x1f, 0x40, 0x73, 0x1f, 0xf3, 0x9e, 0xdd, 0x99,
+	0xcd, 0x3a, 0xff, 0xa6, 0xdf, 0x3c, 0x77, 0xcf, 0x39, 0x73, 0xee, 0xef, 0x9c, 0x7b, 0xce, 0xbd,
+	0xf7, 0x9c, 0x31, 0x64, 0x2a, 0xba, 0xb6, 0x26, 0xab, 0x92, 0x5a, 0x94, 0x73, 0x65, 0xd9, 0x94,
+	0x4a, 0x92, 0x29, 0xe5, 0xd6, 0xa6, 0x72, 0xaf, 0x54, 0x65, 0x7d, 0x23, 0x5b, 0xd1, 0x35, 0x53,
+	0xc3, 0xc3, 0x0e, 0x4d, 0x96, 0xd3, 0x64, 0xd7, 0xa6, 0xc4, 0xc1, 0x65, 0x6d, 0x59, 0x23, 0x24,
+	0x39, 0xeb, 0x2f, 0x4a, 0x2d, 0x1e, 0x2a, 0x6a, 0x46, 0x59, 0x33, 0x72, 0x8b, 0x92, 0x21, 0x53,
+	0x31, 0xb9, 0xb5, 0xa9, 0x45, 0xd9, 0x94, 0xa6, 0x72, 0x15, 0x69, 0x59, 0x51, 0x25, 0x53, 0xd1,
+	0x54, 0x46, 0xfb, 0xc4, 0xb2, 0xa6, 0x2d, 0xaf, 0xca, 0x39, 0xa9, 0xa2, 0xe4, 0x24, 0x55, 0xd5,
+	0x4c, 0xf2, 0xa3, 0xc1, 0x7e, 0x1d, 0x61, 0xbf, 0x92, 0xa7, 0xc5, 0xea, 0x52, 0xae, 0x54, 0xd5,
+	0xdd, 0xdc, 0xa3, 0xfe, 0xdf, 0x4d, 0xa5, 0x2c, 0x1b, 0xa6, 0x54, 0xae, 0x30, 0x82, 0xfd, 0x11,
+	0x93, 0xb3, 0x27, 0x41, 0xc9, 0xa2, 0x30, 0x30, 0x8a, 0x5a, 0x45, 0xe6, 0xb3, 0x8a, 0xa2, 0xa9,
+	0xc8, 0x45, 0x65, 0x49, 0x29, 0xba, 0xf5, 0x1a, 0x8f, 0xa0, 0xd5, 0x16, 0x7f, 0x5a, 0x2e, 0x9a,
+	0x86, 0xa9, 0xe9, 0x5c, 0xea, 0x1e, 0x86, 0x15, 0x87, 0xc9, 0x0d, 0x7b, 0xe6, 0x34, 0xe0, 0x5b,
+	0xd6, 0xe3, 0x4d, 0x49, 0x97, 0xca, 0x46, 0x5e, 0x7e, 0xa5, 0x2a, 0x1b, 0x26, 0x3e, 0x08, 0x7d,
+	0x8a, 0x5a, 0x5c, 0xad, 0x96, 0xe4, 0x82, 0x4e, 0x87, 0xd2, 0x8b, 0x63, 0x68, 0xbc, 0x23, 0xdf,
+	0xcb, 0x86, 0x19, 0x61, 0xe6, 0x6d, 0x04, 0x03, 0x1e, 0x7e, 0xa3, 0xa2, 0xa9, 0x86, 0x8c, 0x9f,
+	0x85, 0xb6, 0x0a, 0x19, 0x49, 0xa3, 0x31, 0x34, 0xde, 0x35, 0x3d, 0x92, 0x0d, 0x37, 0x6f, 0x96,
+	0xf2, 0xcd, 0xb5, 0xdc, 0xfb, 0x66, 0x74, 0x47, 0x9e, 0xf1, 0xe0, 0xf3, 0xd0, 0xee, 0x7e, 0x6d,
+	0xd7, 0xf4, 0xa1, 0x28, 0xf6, 0xa0, 0xee, 0x79, 0xce, 0x9a, 0xf9, 0x35, 0x01, 0xba, 0xe7, 0x2d,
+	0x74, 0xf9, 0xac, 0x76, 0x43, 0x07, 0x41, 0xbb, 0xa0, 0x94, 0x88, 0x5a, 0x9d, 0xf9, 0x76, 0xf2,
+	0x7c, 0xa5, 0x84, 0x9f, 0x84, 0x6e, 0x43, 0x36, 0x0c, 0x45, 0x53, 0x0b, 0x52, 0xa9, 0xa4, 0xa7,
+	0x05, 0xf2, 0x73, 0x17, 0x1b, 0x9b, 0x2d, 0x95, 0x74, 0x3c, 0x0a, 0x5d, 0xba, 0x5c, 0xd4, 0xf4,
+	0x12, 0xa5, 0x48, 0x11, 0x0a, 0xa0, 0x43, 0x84, 0x60, 0x02, 0xfa, 0x39, 0x68, 0x8c, 0xcf, 0x48,
+	0x03, 0x41, 0x8d, 0x83, 0x39, 0xcf, 0x86, 0xbd, 0xf8, 0x5a, 0x02, 0x8c, 0x74, 0x97, 0x0f, 0x5f,
+	0x32, 0x8a, 0x0f, 0x40, 0x9f, 0xbc, 0x4e, 0x09, 0x95, 0x52, 0x41, 0x51, 0x97, 0xb4, 0x74, 0x37,
+	0x21, 0xec, 0x61, 0xc3, 0x57, 0x4a, 0x57, 0xd4, 0x25, 0x2d, 0xbe, 0xc1, 0xee, 0x0a, 0xd0, 0xc3,
+	0x40, 0x61, 0xa6, 0xfa, 0x11, 0xb4, 0x12, 0x14, 0x98, 0xa5, 0xf6, 0x45, 0x41, 0x4d, 0xb8, 0x5e,
+	0xd2, 0xa5, 0x4a, 0x45, 0xd6, 0xf3, 0x94, 0x05, 0xcf, 0x41, 0x87, 0x3d, 0x55, 0x61, 0x2c, 0x35,
+	0xde, 0x35, 0x7d, 0x20, 0x92, 0x9d, 0xd2, 0x71, 0x01, 0x36, 0x1f, 0x3e, 0x63, 0x19, 0x9b, 0x62,
+	0x90, 0x22, 0x22, 0xf6, 0x47, 0x89, 0xa0, 0xa0, 0x70, 0x09, 0x9c, 0x0b, 0x3f, 0xe7, 0xf7, 0x96,
+	0xda, 0x53, 0x08, 0xf8, 0xc9, 0x7d, 0xc4, 0xfc, 0x84, 0x49, 0xc6, 0x33, 0x5e, 0x44, 0xf6, 0xd6,
+	0x16, 0xc7, 0xa0, 0xb8, 0x04, 0x3d, 0xdc, 0xb9, 0xa8, 0x9d, 0x04, 0xc2, 0xfc, 0x54, 0x4d, 0x66,
+	0x6a, 0xbd, 0x7c, 0x97, 0xe1, 0x3c, 0xe0, 0xdb, 0x80, 0xa9, 0x20, 0x6b, 0xd5, 0xdb, 0xd2, 0x52,
+	0x44, 0xda, 0xc1, 0x9a, 0xd2, 0xe6, 0x2b, 0x72, 0x91, 0x49, 0xec, 0x33, 0xbc, 0x03, 0x99, 0xdf,
+	0x47, 0xd0, 0x4f, 0x88, 0x8c, 0xd9, 0xd5, 0x55, 0xbe, 0x20, 0x9a, 0xed, 0x5d, 0xf8, 0x22, 0x80,
+	0x13, 0x7e, 0xd3, 0x45, 0xa2, 0xf3, 0x81, 0x2c, 0x8d, 0x3f, 0x59, 0x2b, 0x56, 0x67, 0x69, 0xec,
+	0x61, 0xb1, 0x3a, 0x7b, 0x53, 0x5a, 0xb6, 0xed, 0xe1, 0xe2, 0xcc, 0x7c, 0x83, 0x60, 0xa7, 0x4b,
+	0x5b, 0x27, 0xa8, 0x90, 0x69, 0x59, 0x41, 0x25, 0x15, 0xdb, 0x55, 0x19, 0x0f, 0x9e, 0xf3, 0xbb,
+	0xc9, 0x78, 0x4d, 0x76, 0x17, 0x4e, 0xb6, 0xab, 0xe0, 0x4b, 0x21, 0xf3, 0x3b, 0x58, 0x77, 0x7e,
+	0x54, 0x7d, 0xcf, 0x04, 0xbf, 0x6c, 0x01, 0x4c, 0x6d, 0x26, 0x4b, 0x7a, 0x71, 0x85, 0xe3, 0x97,
+	0x81, 0x1e, 0x8f, 0xed, 0x59, 0x98, 0xea, 0x72, 0x59, 0x13, 0x0f, 0x42, 0xab, 0xf6, 0x33, 0xaa,
+	0xcc, 0x63, 0x14, 0x7d, 0xc0, 0x67, 0x01, 0xc8, 0x1f, 0x05, 0x5d, 0x5b, 0x95, 0x89, 0xb7, 0xf4,
+	0x4e, 0x3f, 0x59, 0x23, 0xe8, 0x9a, 0x1b, 0xb7, 0x37, 0x2a, 0x72, 0xbe, 0x93, 0x30, 0xe5, 0xb5,
+	0x55, 0xd9, 0x8a, 0x6f, 0x6b, 0xd2, 0x6a, 0x55, 0x2e, 0x50, 0xe9, 0x2d, 0x34, 0xbe, 0x91, 0xa1,
+	0x1b, 0xe4, 0x15, 0xa3, 0xd0, 0x65, 0x09, 0x28, 0x48, 0xc5, 0xa2, 0x6c, 0x18, 0xe9, 0x56, 0x4a,
+	0x60, 0x0d, 0xcd, 0x92, 0x11, 0xbc, 0x07, 0x3a, 0x55, 0x69, 0xad, 0x50, 0x92, 0x55, 0xad, 0x9c,
+	0x6e, 0x23, 0x3f, 0x77, 0xa8, 0xd2, 0xda, 0x79, 0xeb, 0x19, 0x1f, 0x06, 0x5c, 0x56, 0xd4, 0x42,
+	0x51, 0x97, 0x25, 0x53, 0x2e, 0x15, 0x56, 0x64, 0x65, 0x79, 0xc5, 0x4c, 0xb7, 0x8f, 0xa1, 0xf1,
+	0x54, 0xbe, 0xbf, 0xac, 0xa8, 0xe7, 0xe8, 0x0f, 0x97, 0xc9, 0x38, 0xa1, 0x96, 0xd6, 0xfd, 0xd4,
+	0x1d, 0x8c, 0x5a, 0x5a, 0x0f, 0x52, 0x2b, 0x6a, 0xa1, 0x5a, 0x29, 0xb9, 0xa9, 0x3b, 0x6d, 0xd9,
+	0x77, 0x2a, 0x25, 0x1f, 0xb5, 0xb4, 0xee, 0xa7, 0x06, 0x5b, 0xb6, 0x97, 0xfa, 0x7b, 0xbb, 0x46,
+	0xee, 0x23, 0x18, 0xf0, 0xb8, 0x50, 0x53, 0x56, 0x49, 0xfc, 0xd4, 0x1b, 0x74, 0xdf, 0x6d, 0x58,
+	0x27, 0x1f, 0x0b, 0xd0, 0xc7, 0xb3, 0x66, 0x8c, 0x34, 0xbe, 0x17, 0x80, 0xa7, 0x71, 0xa5, 0xc4,
+	0x16, 0x48, 0x27, 0x1b, 0xb9, 0x52, 0xaa, 0x9f, 0xc2, 0x1d, 0x02, 0x55, 0x2a, 0xcb, 0x7c, 0x0d,
+	0xd0, 0xa1, 0xeb, 0x52, 0x59, 0xc6, 0x4f, 0x41, 0x8f, 0x9d, 0xe3, 0x49, 0x8a, 0xa0, 0x09, 0xbe,
+	0x9b, 0x27, 0x78, 0x6b, 0xec, 0xc7, 0x98, 0xdd, 0xdf, 0x14, 0xa0, 0xdf, 0x81, 0xeb, 0x87, 0x92,
+	0xe0, 0x67, 0xfd, 0x3e, 0x79, 0xb0, 0x8e, 0x0e, 0xc1, 0xbd, 0xe0, 0x7f, 0x23, 0xe8, 0xf5, 0x2a,
+	0x88, 0x9f, 0x86, 0x76, 0xa6, 0x22, 0x03, 0x66, 0xb4, 0x8e, 0xd4, 0x3c, 0xa7, 0xc7, 0x2f, 0x40,
+	0x9f, 0xe3, 0x66, 0xee, 0x6c, 0xbf, 0xbf, 0x8e, 0x08, 0x96, 0x9d, 0x7b, 0x0c, 0xf7, 0x23, 0xfe,
+	0x49, 0x18, 0x2a, 0x6a, 0xaa, 0xa9, 0x4b, 0x45, 0x33, 0x2c, 0xe9, 0x47, 0xae, 0xc0, 0x73, 0x8c,
+	0xc9, 0x95, 0xf7, 0x71, 0x31, 0x30, 0x96, 0xf9, 0x03, 0x04, 0x98, 0x03, 0xf3, 0x38, 0x24, 0xff,
+	0x7f, 0xb7, 0x02, 0x9b, 0x5b, 0x5f, 0xe6, 0xc7, 0x6e, 0x5f, 0x44, 0x0d, 0xfa, 0x62, 0x82, 0xf0,
+	0x16, 0x40, 0x6c, 0x1b, 0xc2, 0xdb, 0x7b, 0x02, 0xf4, 0xb2, 0x60, 0xc0, 0x51, 0xf4, 0xc5, 0x28,
+	0x14, 0x88, 0x51, 0xee, 0xf0, 0x27, 0xd4, 0x0a, 0x7f, 0x29, 0x7f, 0xf8, 0xc3, 0xd0, 0xe2, 0x0a,
+	0x6b, 0x2d, 0x6a, 0xec, 0x80, 0x16, 0x76, 0xb2, 0xe9, 0x0a, 0x3f, 0xd9, 0x34, 0x3d, 0xa4, 0xfd,
+	0xba, 0x00, 0x7d, 0x36, 0x44, 0x3f, 0x94, 0x88, 0x76, 0xd6, 0xef, 0x86, 0x07, 0x6a, 0x0b, 0x08,
+	0x06, 0xb4, 0xff, 0x40, 0xd0, 0xe3, 0x11, 0x8e, 0x4f, 0x40, 0x1b, 0x15, 0x5f, 0xef, 0xc8, 0x4d,
+	0xd9, 0xf2, 0x8c, 0x1a, 0x3f, 0x0f, 0xbd, 0xcc, 0xe1, 0xbc, 0xb1, 0x6c, 0x5f, 0x6d, 0x7e, 0x16,
+	0x70, 0xba, 0x75, 0xd7, 0x13, 0x7e, 0x09, 0x06, 0x98, 0xac, 0x90, 0x38, 0x36, 0x5e, 0x5b, 0xa0,
+	0x2b, 0x8a, 0xf5, 0xeb, 0xbe, 0x91, 0xcc, 0xc7, 0x08, 0x76, 0x32, 0x28, 0x1e, 0x87, 0x10, 0xf6,
+	0x00, 0x01, 0x76, 0xab, 0xcb, 0xfc, 0xd6, 0xe5, 0x37, 0xa8, 0x21, 0xbf, 0x39, 0xe7, 0xf7, 0x9b,
+	0x89, 0x3a, 0x7e, 0xb3, 0xad, 0xd1, 0xeb, 0x5d, 0x04, 0xfd, 0xe4, 0x68, 0x60, 0xac, 0x28, 0x15,
+	0x0e, 0x61, 0x1a, 0xda, 0xad, 0xc0, 0x65, 0x9d, 0x10, 0xd8, 0xe6, 0x8c, 0x3d, 0x3e, 0x7a, 0x2b,
+	0xfc, 0x25, 0x82, 0x9d, 0x2e, 0xfd, 0x98, 0x11, 0x46, 0x81, 0x1e, 0xa7, 0x0a, 0xd5, 0xaa, 0xc2,
+	0x0c, 0xd1, 0x99, 0x07, 0x32, 0x74, 0xc7, 0x1a, 0x49, 0x70, 0x50, 0xf4, 0x4f, 0x7e, 0x1b, 0x30,
+	0xfe, 0x1d, 0x04, 0x43, 0x2f, 0xda, 0x67, 0xb0, 0xef, 0x29, 0xd0, 0x7f, 0x87, 0x60, 0xd8, 0xaf,
+	0x64, 0x5c, 0xb4, 0x2f, 0xf9, 0xd1, 0x3e, 0x12, 0x85, 0x76, 0x28, 0x0c, 0xdb, 0x00, 0xf9, 0xff,
+	0x21, 0xd8, 0x6d, 0xdf, 0xa7, 0xd8, 0xd7, 0xae, 0x1c, 0xb3, 0x09, 0xe8, 0xf7, 0x5c, 0xc7, 0x3a,
+	0xa7, 0x90, 0x3e, 0xcf, 0xf8, 0x95, 0x12, 0x3e, 0x06, 0xc3, 0xdc, 0x0e, 0x9e, 0xfd, 0x1d, 0xbf,
+	0x16, 0x1c, 0x64, 0xbf, 0xba, 0xf7, 0x71, 0x06, 0x3e, 0x0a, 0x83, 0xde, 0xd3, 0x03, 0xe3, 0xa1,
+	0x09, 0x17, 0x7b, 0x8e, 0x10, 0x94, 0xa3, 0xe9, 0x39, 0xf7, 0xd5, 0x14, 0x88, 0x61, 0x08, 0x30,
+	0x9b, 0x2e, 0xc2, 0x80, 0x73, 0x4b, 0x61, 0xff, 0xcc, 0xd2, 0xce, 0x54, 0xdd, 0x2b, 0x2a, 0x9b,
+	0x83, 0x87, 0x37, 0x6c, 0x04, 0x7e, 0xc2, 0x3f, 0x01, 0xbd, 0x3e, 0xcc, 0x68, 0xb2, 0x3e, 0x16,
+	0x67, 0x33, 0x1c, 0x78, 0x43, 0x4f, 0xd1, 0x03, 0xf1, 0x1d, 0xe8, 0xf6, 0x40, 0x4b, 0x93, 0xf8,
+	0x74, 0xfd, 0xfc, 0x14, 0x10, 0xdc, 0xa5, 0xbb, 0xec, 0x70, 0xd5, 0xef, 0xca, 0x09, 0xb0, 0x08,
+	0x24, 0xf8, 0xbf, 0x0e, 0xf5, 0x42, 0x9e, 0xec, 0x6f, 0x42, 0x4f, 0x18, 0xf8, 0x87, 0x12, 0xbc,
+	0xd0, 0x2b, 0x20, 0xe2, 0xda, 0x51, 0x78, 0xc8, 0x6b, 0xc7, 0x3f, 0x45, 0xb0, 0x37, 0xf8, 0xee,
+	0xc7, 0x22, 0x87, 0xbf, 0x27, 0xc0, 0x48, 0x94, 0xea, 0x6c, 0x21, 0x94, 0x60, 0x30, 0x64, 0x21,
+	0xf0, 0xe4, 0xde, 0xc0, 0x4a, 0x18, 0x08, 0xae, 0x04, 0x03, 0xdf, 0xf0, 0xbb, 0xd5, 0xf1, 0xf8,
+	0x82, 0xb7, 0x77, 0x03, 0xf0, 0xf7, 0x08, 0x9e, 0x08, 0x5d, 0x77, 0x0d, 0x04, 0xcb, 0xa8, 0xb0,
+	0x07, 0x8f, 0x2e, 0xec, 0xfd, 0x8d, 0x00, 0x7b, 0x23, 0xa6, 0xc3, 0x0c, 0xfe, 0x32, 0x0c, 0x7b,
+	0xa2, 0x92, 0x7f, 0xfd, 0x35, 0x16, 0x9d, 0x86, 0x8a, 0x61, 0xbf, 0xe2, 0x65, 0x18, 0x72, 0x21,
+	0xe1, 0x72, 0xaf, 0xc6, 0xc3, 0xd5, 0xa0, 0x1e, 0xfc, 0xcd, 0xc0, 0xd7, 0xfd, 0x0e, 0x96, 0x6c,
+	0x1a, 0x81, 0xd0, 0xf5, 0x75, 0x94, 0x5b, 0xf0, 0xe8, 0x35, 0x1f, 0x1e, 0xbd, 0x8e, 0x24, 0x7b,
+	0xad, 0x2f, 0x80, 0x45, 0xde, 0xa2, 0x08, 0x4d, 0xb9, 0x45, 0xf9, 0x73, 0x04, 0x63, 0xa1, 0x7a,
+	0x3c, 0x16, 0xc1, 0xec, 0x0f, 0x05, 0x78, 0xb2, 0x86, 0xf6, 0xcc, 0xbd, 0xcb, 0xb0, 0x2b, 0xdc,
+	0xbd, 0x79, 0x48, 0x6b, 0xcc, 0xbf, 0x87, 0x43, 0xfd, 0xdb, 0xc0, 0x79, 0xbf, 0xdf, 0x9d, 0x4a,
+	0x24, 0x7e, 0x7b, 0x63, 0xdb, 0x67, 0x08, 0x66, 0x42, 0x56, 0x92, 0x71, 0x51, 0xd3, 0x9b, 0x15,
+	0xf2, 0x9a, 0x1e, 0xc0, 0x5e, 0x4b, 0xc1, 0xb1, 0x64, 0x3a, 0x33, 0xc3, 0x47, 0x86, 0x1a, 0xd4,
+	0xe4, 0x50, 0xf3, 0x1c, 0xec, 0x09, 0xf7, 0x30, 0x72, 0x3e, 0x60, 0xf7, 0x59, 0xbb, 0x43, 0xfd,
+	0xc5, 0x3a, 0x2e, 0xd4, 0xe0, 0x77, 0xdd, 0xe8, 0x87, 0xf3, 0x93, 0xcb, 0x33, 0xd9, 0xef, 0x72,
+	0x57, 0x13, 0x4c, 0xad, 0x9e, 0xed, 0x9d, 0x08, 0xf8, 0x31, 0x02, 0x31, 0x44, 0x40, 0x03, 0x3e,
+	0xc2, 0xef, 0xec, 0x04, 0xd7, 0x9d, 0x5d, 0xd3, 0xfd, 0xe6, 0x6b, 0x04, 0x7b, 0x42, 0xd5, 0x65,
+	0xee, 0x21, 0xc3, 0x60, 0x98, 0x7b, 0xb0, 0xb0, 0xdd, 0x88, 0x77, 0x0c, 0x84, 0x78, 0x07, 0xbe,
+	0xe6, 0x37, 0x4e, 0x12, 0xc9, 0x01, 0x1b, 0xdc, 0x0b, 0xb7, 0x01, 0xcf, 0x41, 0xb7, 0xc2, 0x73,
+	0xd0, 0x64, 0x92, 0x57, 0xfa, 0x32, 0x50, 0xc4, 0xed, 0x97, 0xf0, 0xd0, 0xb7, 0x5f, 0x7f, 0x86,
+	0x60, 0x24, 0xcc, 0x1f, 0x1f, 0x87, 0xcc, 0xf3, 0x81, 0x00, 0xa3, 0x91, 0xba, 0x3f, 0xea, 0xf0,
+	0x73, 0xd3, 0xef, 0x61, 0x27, 0x92, 0x2c, 0xff, 0x6d, 0xcd, 0x37, 0xe3, 0xd0, 0x7f, 0x49, 0x36,
+	0xe7, 0x36, 0xac, 0x30, 0xc5, 0x6d, 0x30, 0x08, 0xad, 0x56, 0x58, 0xe3, 0xd7, 0x26, 0xf4, 0x21,
+	0xf3, 0x0f, 0x29, 0xd8, 0xe9, 0x22, 0x65, 0x18, 0x1e, 0xf7, 0x95, 0x7d, 0xeb, 0x74, 0xad, 0x30,
+	0x62, 0xfc, 0x4c, 0xe0, 0x3a, 0xbc, 0x6e, 0x19, 0xcc, 0x66, 0xc0, 0xa7, 0xfc, 0xf7, 0xe0, 0xf5,
+	0xee, 0x9c, 0x39, 0x39, 0xbe, 0xca, 0xaf, 0x85, 0xe8, 0x26, 0xbf, 0x65, 0x2c, 0x55, 0x6b, 0x8b,
+	0x16, 0x72, 0x7a, 0x05, 0xfb, 0xa4, 0x64, 0xe0, 0xdb, 0x81, 0xbb, 0x82, 0xd6, 0xb1, 0x54, 0x03,
+	0xfb, 0x49, 0xef, 0x25, 0xc1, 0x75, 0xdf, 0x25, 0x41, 0xdb, 0x58, 0x2a, 0x69, 0x7c, 0xf0, 0xdc,
+	0x0e, 0x58, 0xdd, 0x11, 0x9a, 0x59, 0x58, 0xd2, 0xaa, 0x6a, 0x29, 0xdd, 0x4e, 0x0c, 0xda, 0xa1,
+	0x6a, 0xe6, 0x45, 0xeb, 0x39, 0x33, 0x0b, 0xc3, 0x37, 0xe6, 0xaf, 0x69, 0x45, 0xc9, 0xd4, 0xf4,
+	0x06, 0x5b, 0xf1, 0x3e, 0x42, 0xb0, 0x2b, 0x20, 0x83, 0x39, 0xc7, 0x05, 0x5f, 0x3b, 0x5e, 0xe4,
+	0x81, 0xde, 0x27, 0xc0, 0xd7, 0x97, 0x77, 0xd9, 0xbf, 0x7c, 0xb2, 0x31, 0xe5, 0x04, 0x82, 0xf3,
+	0x2d, 0xe8, 0xb7, 0x49, 0x5c, 0xde, 0x4e, 0x5b, 0x4f, 0x90, 0xbb, 0xb1, 0x25, 0xf6, 0xfc, 0xdf,
+	0xb5, 0x6e, 0x7b, 0x1d, 0x99, 0x6c, 0xe6, 0xe7, 0xa1, 0x7d, 0x95, 0x0e, 0xd5, 0xbb, 0x22, 0xb9,
+	0x41, 0x1a, 0x27, 0xe7, 0x4d, 0x4d, 0x97, 0xb9, 0x10, 0xce, 0x9a, 0xe4, 0x4a, 0xd8, 0x37, 0x2b,
+	0x67, 0xca, 0xef, 0x20, 0x97, 0x8d, 0x8d, 0xb9, 0x8d, 0x3b, 0xf9, 0x2b, 0x7c, 0xe6, 0xfd, 0x90,
+	0xaa, 0xea, 0x0a, 0x9b, 0xb7, 0xf5, 0xe7, 0xa3, 0x0f, 0xd3, 0xff, 0xe3, 0xf6, 0x1e, 0xae, 0x1d,
+	0xc3, 0xf0, 0x1a, 0x74, 0x30, 0x20, 0x78, 0x70, 0x49, 0x00, 0x22, 0x73, 0x21, 0x5b, 0x42, 0x23,
+	0x4e, 0xe4, 0x41, 0x6b, 0x1b, 0x62, 0xef, 0x4f, 0x41, 0xda, 0xfd, 0xae, 0xb8, 0x4d, 0xa3, 0xf1,
+	0xbb, 0x64, 0x05, 0xd8, 0x1d, 0xf2, 0x82, 0x6d, 0x81, 0x77, 0x11, 0x06, 0x5d, 0x5d, 0x5a, 0x05,
+	0x5b, 0xb2, 0xd0, 0xa0, 0x64, 0xec, 0x34, 0x78, 0xf1, 0x09, 0xe0, 0xe7, 0xfd, 0x26, 0x3c, 0x1a,
+	0xc7, 0x84, 0xe1, 0xdd, 0x97, 0xbf, 0x88, 0x60, 0xf0, 0xc6, 0xfc, 0xec, 0xea, 0x2a, 0x27, 0x4c,
+	0x1a, 0xf8, 0x9a, 0xb6, 0x04, 0xfe, 0x0b, 0xc1, 0x90, 0x4f, 0x93, 0x6d, 0xb1, 0xd0, 0x45, 0x3f,
+	0x7a, 0x87, 0xa3, 0xd1, 0x0b, 0xe2, 0xb2, 0x0d, 0xee, 0x9f, 0x07, 0x3c, 0x5b, 0x2c, 0x6a, 0x55,
+	0xd5, 0x3c, 0x2f, 0x99, 0x12, 0x87, 0xf5, 0x59, 0xe8, 0xe1, 0xba, 0x38, 0xad, 0x08, 0xdd, 0x73,
+	0xbb, 0xac, 0xd9, 0xfc, 0xf3, 0x37, 0xa3, 0x7d, 0x2f, 0xb0, 0x1f, 0x67, 0x69, 0xd5, 0x29, 0xdf,
+	0x5d, 0x76, 0x0d, 0x64, 0x26, 0x61, 0xc0, 0x23, 0x93, 0x21, 0x39, 0x08, 0xad, 0xa4, 0xa3, 0x90,
+	0xc7, 0x78, 0xf2, 0x90, 0x79, 0x5f, 0x80, 0x51, 0xd2, 0xc9, 0x4d, 0x5c, 0xe4, 0xba, 0x6c, 0xce,
+	0x1a, 0x86, 0x6c, 0x92, 0x7a, 0x8f, 0xed, 0x0e, 0xbd, 0x20, 0xd8, 0x2b, 0x50, 0x50, 0x48, 0x2f,
+	0x57, 0x45, 0x57, 0x8a, 0x32, 0x6b, 0x37, 0xa4, 0xe7, 0x23, 0x20, 0x43, 0xb4, 0xe1, 0xf0, 0x38,
+	0xb4, 0x4a, 0x46, 0x41, 0x5b, 0x62, 0xd5, 0x67, 0x31, 0x4b, 0x1b, 0xf9, 0xb3, 0xbc, 0x91, 0x3f,
+	0x7b, 0x9b, 0x37, 0xf2, 0xcf, 0xb5, 0xdc, 0xfd, 0x76, 0x14, 0xe5, 0x5b, 0x24, 0xe3, 0xc6, 0x92,
+	0x55, 0x56, 0x5b, 0x51, 0x0c, 0x53, 0xd3, 0x37, 0x48, 0x9f, 0x44, 0x47, 0x9e, 0x3f, 0xe2, 0x33,
+	0x00, 0x86, 0x29, 0xe9, 0x66, 0xc1, 0x54, 0xca, 0x72, 0xba, 0x35, 0xa6, 0xd4, 0x4e, 0xc2, 0x63,
+	0x8d, 0x5a, 0x7b, 0x2d, 0x59, 0x2d, 0x51, 0xf6, 0xb6, 0x98, 0xec, 0xed, 0xb2, 0x5a, 0xb2, 0xc6,
+	0x32, 0xdf, 0x22, 0x18, 0x8b, 0xc6, 0x88, 0xc1, 0x7b, 0x07, 0xfa, 0x55, 0xd9, 0x2c, 0x48, 0xd6,
+	0x4f, 0x05, 0x82, 0x6d, 0xdd, 0x4a, 0xb3, 0x47, 0x12, 0xf3, 0xd5, 0x5e, 0xd5, 0x23, 0xde, 0x2a,
+	0x6c, 0x70, 0x4c, 0x84, 0xda, 0xbb, 0x20, 0x8f, 0x34, 0xba, 0x25, 0x62, 0x32, 0x6d, 0x18, 0x77,
+	0x41, 0xbb, 0xd5, 0x7e, 0x29, 0x2d, 0xd3, 0x36, 0xd5, 0x96, 0x7c, 0x5b, 0x59, 0x5a, 0x9f, 0x5d,
+	0x96, 0x33, 0xbf, 0xc9, 0x1b, 0x1a, 0x2f, 0x53, 0xca, 0x87, 0xef, 0xf7, 0x6b, 0x56, 0x64, 0xf8,
+	0x15, 0x01, 0x06, 0xbd, 0x9a, 0x31, 0xbc, 0x6f, 0x41, 0x2f, 0x55, 0x6d, 0x4d, 0xd6, 0xdd, 0x8d,
+	0x49, 0xb5, 0x3b, 0x52, 0x5e, 0xa4, 0xc4, 0x0c, 0x98, 0x1e, 0xc3, 0x35, 0x66, 0xe0, 0x97, 0xa0,
+	0x9f, 0x4f, 0xc9, 0x16, 0x1a, 0xaf, 0x4f, 0xc5, 0x2b, 0xb6, 0xcf, 0xf0, 0x8c, 0x1a, 0xcd, 0x0b,
+	0x17, 0x9f, 0x20, 0x18, 0xa4, 0xa6, 0xf5, 0x19, 0xea, 0x61, 0x5a, 0x97, 0xf8, 0x3d, 0x47, 0xca,
+	0x75, 0xcf, 0xd1, 0x2c, 0xf3, 0x7d, 0x8e, 0x60, 0xc8, 0xa7, 0x30, 0xb3, 0xdf, 0x6d, 0xe8, 0x63,
+	0x1a, 0xfb, 0x0c, 0x58, 0xa7, 0x31, 0xc3, 0x0b, 0x75, 0xaf, 0xee, 0x1e, 0x6c, 0x22, 0xd2, 0xef,
+	0x23, 0x56, 0x82, 0xb2, 0xab, 0xde, 0xb7, 0x75, 0x49, 0x35, 0x96, 0x64, 0x3d, 0x4e, 0x33, 0xec,
+	0x13, 0xd0, 0xa9, 0xcb, 0x45, 0xa5, 0xa2, 0xc8, 0xaa, 0xc9, 0xd7, 0x86, 0x3d, 0xd0, 0x34, 0x70,
+	0xbf, 0x40, 0x30, 0x1a, 0xa9, 0x23, 0x83, 0x39, 0x0f, 0x9d, 0x26, 0x1f, 0x64, 0x00, 0x67, 0x6b,
+	0xae, 0x90, 0x80, 0x2c, 0x86, 0xb4, 0x23, 0xa6, 0x79, 0x20, 0x2b, 0xb0, 0x8b, 0x1a, 0xf5, 0x9c,
+	0x56, 0x2e, 0x2b, 0x66, 0x59, 0x56, 0xcd, 0x6d, 0x72, 0x68, 0x6b, 0xaf, 0x93, 0x0e, 0xbe, 0x8b,
+	0x81, 0x84, 0xa1, 0x45, 0xd7, 0x34, 0x93, 0xa6, 0xd9, 0x3c, 0xf9, 0xdb, 0x1a, 0x5b, 0x95, 0xa5,
+	0x25, 0x22, 0xbb, 0x3b, 0x4f, 0xfe, 0xc6, 0xe7, 0xa0, 0xb5, 0xa2, 0x6b, 0x76, 0x5e, 0x3b, 0x52,
+	0xdb, 0x53, 0x9d, 0x17, 0xdd, 0xb4, 0x98, 0xf2, 0x94, 0x37, 0xb3, 0x06, 0x43, 0xa1, 0xbf, 0x5b,
+	0x09, 0xda, 0xd4, 0x4c, 0x69, 0x95, 0xa8, 0x91, 0xca, 0xd3, 0x07, 0x6b, 0x54, 0x51, 0x4b, 0xf2,
+	0x3a, 0x51, 0x24, 0x95, 0xa7, 0x0f, 0xd6, 0x89, 0xd6, 0xd2, 0xa8, 0xb0, 0x22, 0x19, 0x2b, 0x44,
+	0x9b, 0xee, 0x7c, 0x87, 0x35, 0x70, 0x59, 0x32, 0x56, 0x2c, 0x16, 0xa9, 0xaa, 0x9a, 0xf4, 0x6c,
+	0xdf, 0x9d, 0xa7, 0x0f, 0x99, 0x19, 0x18, 0x26, 0x06, 0x3e, 0x6f, 0x6f, 0x2a, 0xeb, 0x3b, 0x72,
+	0x46, 0x85, 0x5d, 0x01, 0x26, 0x06, 0xda, 0xbc, 0xf7, 0x9b, 0x04, 0xea, 0x5b, 0x87, 0x6b, 0xfa,
+	0x96, 0x23, 0xe5, 0x82, 0x6a, 0xea, 0x1b, 0xcc, 0xb3, 0x5c, 0xdf, 0x31, 0x58, 0x57, 0xff, 0x83,
+	0x61, 0xa4, 0x35, 0x5a, 0x6e, 0xce, 0x02, 0xc8, 0xeb, 0x15, 0x85, 0x7e, 0x39, 0x98, 0x16, 0x62,
+	0x26, 0x77, 0x17, 0x0f, 0x3e, 0x6d, 0xad, 0xd6, 0xb2, 0xa4, 0xa8, 0x8a, 0xba, 0xcc, 0x4c, 0xbb,
+	0x3b, 0x20, 0xe0, 0x3c, 0xfb, 0x36, 0x71, 0xae, 0xe5, 0x2d, 0xb2, 0xb7, 0xb0, 0x39, 0x32, 0xd3,
+	0x30, 0x44, 0x54, 0xbe, 0xad, 0x95, 0x17, 0x0d, 0x53, 0x53, 0x63, 0x9c, 0x5f, 0x32, 0x25, 0x18,
+	0xf6, 0xf3, 0x30, 0x58, 0x9f, 0x87, 0x4e, 0x93, 0x0f, 0xb2, 0x73, 0xf3, 0x81, 0x9a, 0xa0, 0xda,
+	0x22, 0xec, 0x85, 0xca, 0x07, 0xa6, 0xff, 0x73, 0x06, 0x5a, 0xc9, 0xc6, 0x05, 0xff, 0x2a, 0x82,
+	0x36, 0x7a, 0x1f, 0x80, 0x13, 0x7c, 0xd0, 0x27, 0x4e, 0xc6, 0xa2, 0xa5, 0x9a, 0x67, 0x26, 0x7f,
+	0xe9, 0xdf, 0x3e, 0x3d, 0x84, 0x7e, 0xee, 0x1f, 0xff, 0xf5, 0x0d, 0x61, 0x0c, 0x8f, 0xe4, 0x22,
+	0x3e, 0x92, 0x64, 0xf7, 0x19, 0xff, 0x8b, 0xa0, 0x95, 0xa8, 0x8f, 0x63, 0x7d, 0x32, 0x26, 0xee,
+	0xaf, 0x43, 0xc5, 0x74, 0xf8, 0x5d, 0xe4, 0x28, 0xf1, 0x16, 0xc2, 0xe3, 0xb9, 0x5a, 0x9f, 0x7e,
+	0xe6, 0x36, 0xb9, 0x79, 0xb6, 0x16, 0x4e, 0xe0, 0x63, 0x91, 0xb4, 0x34, 0x9b, 0xe7, 0x36, 0xdd,
+	0x9f, 0x29, 0x6e, 0x51, 0x11, 0x0b, 0xc7, 0xf0, 0x74, 0x14, 0x1f, 0x0d, 0x56, 0xb9, 0x4d, 0x57,
+	0x1c, 0x63, 0x5c, 0xf8, 0x75, 0x04, 0x9d, 0xf6, 0xa7, 0x4e, 0x38, 0xf6, 0xd7, 0x50, 0xe2, 0x44,
+	0x0c, 0x4a, 0x86, 0x44, 0xce, 0x01, 0x62, 0x1f, 0xce, 0xd4, 0xc4, 0xc1, 0xc8, 0x49, 0xab, 0xab,
+	0xf8, 0xb7, 0x10, 0x74, 0xb9, 0x3e, 0x2c, 0xc1, 0x09, 0xbe, 0x3e, 0x11, 0x27, 0x63, 0xd1, 0x32,
+	0xcd, 0xa6, 0x1d, 0xcd, 0x0e, 0xe2, 0xfd, 0x75, 0x34, 0x33, 0xa8, 0x32, 0xaf, 0xa7, 0xa0, 0xc3,
+	0xf9, 0x84, 0x33, 0xe6, 0x37, 0x08, 0xe2, 0x78, 0x7d, 0x42, 0xa6, 0xd3, 0x67, 0x82, 0xa3, 0xd4,
+	0x07, 0x02, 0x3e, 0x1c, 0xdb, 0x17, 0x2c, 0xdf, 0x99, 0xc1, 0x53, 0x71, 0xfd, 0x8c, 0x0b, 0x30,
+	0x16, 0xce, 0xe0, 0xd3, 0x49, 0x99, 0xbc, 0x6f, 0xad, 0xe1, 0xb1, 0xe1, 0x9e, 0x47, 0x79, 0x17,
+	0x2e, 0xe1, 0x0b, 0xb1, 0x5f, 0xec, 0x13, 0x64, 0x65, 0x52, 0x5b, 0x10, 0x7e, 0xdb, 0x72, 0x18,
+	0xa7, 0x55, 0x1f, 0x27, 0xe8, 0xe7, 0x17, 0x27, 0x63, 0xd1, 0x32, 0xe3, 0x4c, 0x39, 0xb6, 0x39,
+	0x80, 0xf7, 0xd5, 0x31, 0x0d, 0x75, 0xe6, 0x37, 0x5a, 0xa0, 0xdd, 0xfe, 0xd4, 0x27, 0x5e, 0x83,
+	0xb7, 0x78, 0xb0, 0x2e, 0x1d, 0xd3, 0xe7, 0xf3, 0x94, 0xa3, 0xd0, 0x47, 0xa9, 0x68, 0x67, 0x09,
+	0x33, 0xc3, 0xc2, 0x34, 0x3e, 0x9a, 0x10, 0x7e, 0x63, 0xe1, 0x14, 0x3e, 0x91, 0xd8, 0x64, 0xc4,
+	0x56, 0x89, 0x8c, 0x1d, 0xe6, 0x65, 0xb6, 0x0a, 0x2f, 0xe0, 0xab, 0xcd, 0x10, 0xc4, 0xf5, 0x4a,
+	0x12, 0x6e, 0xdd, 0x6a, 0x3c, 0x8b, 0x7f, 0xd4, 0x00, 0x1f, 0x7b, 0x2b, 0xfe, 0x0d, 0x04, 0xe0,
+	0x74, 0x67, 0xe3, 0xf8, 0x1d, 0xdc, 0xe2, 0xa1, 0x38, 0xa4, 0xcc, 0x3d, 0x8e, 0x3a, 0xde, 0xb1,
+	0x1f, 0x3f, 0x55, 0xdb, 0x39, 0xec, 0xd0, 0xdb, 0x69, 0xef, 0xbb, 0x71, 0xec, 0x9e, 0x67, 0x71,
+	0x22, 0x06, 0x25, 0x53, 0xea, 0x94, 0xa3, 0xd4, 0x11, 0x3c, 0x19, 0xa5, 0x94, 0xc6, 0xf9, 0x72,
+	0x9b, 0x6c, 0x7b, 0xb5, 0x85, 0x3f, 0x41, 0xd0, 0xeb, 0xed, 0xff, 0xc5, 0xc9, 0xfa, 0x84, 0xc5,
+	0x6c, 0x5c, 0x72, 0xa6, 0xeb, 0x69, 0x47, 0xd7, 0x1a, 0xab, 0x85, 0xdc, 0xb1, 0x84, 0x29, 0xfc,
+	0x05, 0xe2, 0x1f, 0xf8, 0x7a, 0x4a, 0xc4, 0xc9, 0x3b, 0x42, 0xc5, 0xe9, 0x24, 0x2c, 0x4c, 0xf9,
+	0x59, 0x47, 0xf9, 0x5a, 0x4e, 0x6e, 0x09, 0x30, 0x2a, 0x72, 0x31, 0xb7, 0xe9, 0x6f, 0x47, 0xd8,
+	0xc2, 0x7f, 0x81, 0x60, 0x38, 0xf8, 0x06, 0xe2, 0xb2, 0x8d, 0xf5, 0x1f, 0x8a, 0x27, 0x92, 0xb2,
+	0xb1, 0xc9, 0xcc, 0x38, 0x93, 0x19, 0xc7, 0x07, 0xea, 0x4e, 0x86, 0x7a, 0xf3, 0xdf, 0x22, 0x18,
+	0x0a, 0x2d, 0xf3, 0xe1, 0x86, 0x9a, 0xdb, 0xc4, 0xe3, 0x09, 0xb9, 0x98, 0xee, 0xe7, 0x1d, 0xdd,
+	0x9f, 0xc6, 0x27, 0xa3, 0x74, 0xe7, 0x85, 0xc7, 0x28, 0x5b, 0xdc, 0x43, 0xb0, 0x3b, 0xb2, 0x05,
+	0x0a, 0x37, 0xdc, 0x35, 0x25, 0x3e, 0xdd, 0x00, 0x27, 0x9b, 0xd8, 0x09, 0x67, 0x62, 0x93, 0x78,
+	0x22, 0xce, 0xc4, 0xa8, 0x5d, 0xde, 0x11, 0xe0, 0x70, 0x92, 0xd6, 0x1a, 0xdc, 0xcc, 0x06, 0x1d,
+	0xf1, 0x5a, 0x73, 0x84, 0x31, 0x0c, 0x6e, 0x3a, 0x18, 0x5c, 0xc0, 0xe7, 0x1a, 0x34, 0x2e, 0x8f,
+	0xc1, 0xa4, 0x46, 0xfc, 0xba, 0x00, 0x03, 0x21, 0xaa, 0xe0, 0x06, 0x1a, 0x61, 0xc4, 0x99, 0x44,
+	0x3c, 0x6c, 0x4a, 0x77, 0x5d, 0x47, 0x97, 0x5f, 0x40, 0xf8, 0x78, 0x9d, 0xc4, 0x11, 0x3e, 0xa5,
+	0x85, 0xab, 0xf8, 0xca, 0xc3, 0xa3, 0xc1, 0xf3, 0xe5, 0x97, 0x08, 0x76, 0x85, 0xa8, 0x4c, 0x5c,
+	0xbf, 0xc1, 0xf6, 0x0d, 0xf1, 0x64, 0x62, 0x3e, 0x86, 0xcf, 0x31, 0x07, 0x9e, 0x09, 0x7c, 0xb0,
+	0x3e, 0x3a, 0x6c, 0x23, 0x88, 0xa0, 0xd3, 0xee, 0xd8, 0x88, 0x4e, 0xad, 0xfe, 0xfe, 0x0f, 0x71,
+	0x22, 0x06, 0x65, 0xa2, 0xed, 0xa9, 0x95, 0x9e, 0x68, 0x92, 0x32, 0xb6, 0xf0, 0x87, 0x08, 0xfa,
+	0x7c, 0x75, 0x7a, 0x9c, 0xb0, 0xa0, 0x2f, 0xe6, 0x62, 0xd3, 0x27, 0x0a, 0xe6, 0xac, 0x56, 0xc6,
+	0xcf, 0xe9, 0x6f, 0x5a, 0x5b, 0x13, 0x2e, 0x10, 0xc7, 0xae, 0xbd, 0x8b, 0x13, 0x31, 0x28, 0x13,
+	0x19, 0x96, 0xeb, 0xb5, 0x49, 0x52, 0xfe, 0x16, 0xfe, 0xc8, 0x0d, 0x21, 0xad, 0x52, 0xe3, 0x84,
+	0xe5, 0x6c, 0x31, 0x17, 0x9b, 0x3e, 0x51, 0xe8, 0xe5, 0xaa, 0x56, 0x75, 0x25, 0xb7, 0x59, 0xd5,
+	0x95, 0x2d, 0xfc, 0x27, 0xee, 0x06, 0x09, 0x5e, 0x8f, 0xc5, 0x89, 0x4b, 0xb7, 0xe2, 0x54, 0x02,
+	0x8e, 0x44, 0x9b, 0x29, 0xae, 0xb2, 0x7f, 0x2f, 0x8f, 0x7f, 0x1b, 0x41, 0x8f, 0xa7, 0x16, 0x8a,
+	0x13, 0x95, 0x4c, 0xc5, 0x23, 0x31, 0xa9, 0x13, 0xad, 0x25, 0xa6, 0x2d, 0x5d, 0xe1, 0xbf, 0x87,
+	0xa0, 0xcb, 0x55, 0xef, 0x8c, 0x3e, 0x86, 0x06, 0x0b, 0xad, 0xe2, 0x64, 0x2c, 0x5a, 0xa6, 0xdb,
+	0x59, 0x47, 0xb7, 0xe3, 0x78, 0x26, 0x72, 0x9d, 0x53, 0x4e, 0xf2, 0xb8, 0xe9, 0xa9, 0xe2, 0x6e,
+	0xe1, 0xbf, 0xe2, 0x65, 0x36, 0x6f, 0x0d, 0x11, 0x9f, 0xac, 0x79, 0xcd, 0x16, 0x5d, 0x99, 0x15,
+	0x4f, 0x25, 0x67, 0x4c, 0x74, 0x1e, 0x50, 0x65, 0x93, 0x14, 0x34, 0x69, 0x3d, 0x33, 0xb7, 0x69,
+	0x79, 0xc4, 0x77, 0xfc, 0x7f, 0x36, 0xb1, 0x8a, 0x0e, 0xae, 0x7d, 0xf9, 0xe3, 0x2d, 0x54, 0x89,
+	0x87, 0xe3, 0x11, 0x33, 0x2d, 0x5f, 0x73, 0xe5, 0xc4, 0xcd, 0x04, 0x07, 0x67, 0x56, 0xf0, 0x7c,
+	0xf8, 0xe3, 0x2f, 0x13, 0x84, 0xbf, 0xb3, 0xbf, 0xf9, 0xe6, 0xb3, 0x3e, 0x5c, 0x3b, 0x8f, 0xf9,
+	0xa6, 0x7d, 0x24, 0x26, 0x35, 0x9b, 0xf7, 0xcf, 0xbb, 0xe6, 0xbd, 0x9e, 0xf0, 0x9e, 0x87, 0xcf,
+	0xfd, 0x2c, 0x7e, 0xae, 0xb1, 0x4b, 0x03, 0x7b, 0xd2, 0x5f, 0x0a, 0xec, 0xf6, 0x3f, 0x58, 0x5f,
+	0xc2, 0x27, 0x92, 0x15, 0x91, 0x8c, 0xba, 0xe9, 0xbf, 0x4e, 0x21, 0x2b, 0xf3, 0x47, 0x2e, 0x48,
+	0x3e, 0x44, 0x71, 0x8e, 0xb0, 0x76, 0xc1, 0x6a, 0xe1, 0x34, 0x7e, 0x26, 0x36, 0x14, 0x41, 0xfe,
+	0x85, 0x1a, 0xc7, 0x87, 0x90, 0xb7, 0xe5, 0x36, 0xed, 0x4a, 0xdf, 0x16, 0x7e, 0x55, 0x80, 0x7e,
+	0x7f, 0xb5, 0x07, 0xe7, 0xe2, 0xd6, 0x8d, 0x38, 0x68, 0x47, 0xe3, 0x33, 0x30, 0xb4, 0x7e, 0xd9,
+	0x85, 0xd6, 0xcf, 0x2e, 0x9c, 0xc3, 0xb3, 0x0d, 0xba, 0x42, 0xd1, 0xd1, 0xfa, 0x64, 0x22, 0x2f,
+	0x74, 0x31, 0xfe, 0x31, 0x82, 0x3e, 0x5f, 0x4d, 0x07, 0x67, 0x63, 0xd6, 0x89, 0xea, 0x66, 0xea,
+	0x88, 0xea, 0x54, 0x92, 0x63, 0xb8, 0x7b, 0xf6, 0xae, 0x8a, 0x16, 0xfe, 0xd4, 0xfa, 0xc7, 0x35,
+	0x9e, 0x0a, 0x4b, 0xf4, 0xc5, 0x47, 0x68, 0x01, 0x48, 0xcc, 0xc6, 0x25, 0x67, 0x4a, 0x9f, 0x71,
+	0x94, 0xae, 0x51, 0x57, 0x08, 0x28, 0x6d, 0x17, 0x7c, 0xe6, 0x5e, 0xbe, 0x77, 0x7f, 0x04, 0x7d,
+	0x75, 0x7f, 0x04, 0xfd, 0xcb, 0xfd, 0x11, 0x74, 0xf7, 0xc1, 0xc8, 0x8e, 0xaf, 0x1e, 0x8c, 0xec,
+	0xf8, 0xa7, 0x07, 0x23, 0x3b, 0x60, 0xb7, 0xa2, 0x45, 0x28, 0x73, 0x13, 0x2d, 0x1c, 0x5b, 0x56,
+	0xcc, 0x95, 0xea, 0x62, 0xb6, 0xa8, 0x95, 0x5d, 0x2f, 0x3d, 0xa2, 0x68, 0x6e, 0x15, 0xd6, 0x1d,
+	0x25, 0xcc, 0x8d, 0x8a, 0x6c, 0x2c, 0xb6, 0x91, 0xda, 0xd8, 0xcc, 0xff, 0x0f, 0x00, 0x34, 0x6e,
+	0x07, 0xeb, 0x63, 0x54, 0x00, 0x00,
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of x/metadata module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Scope searches for a scope.
+	//
+	// The scope id, if provided, must either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address,
+	// e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. The session addr, if provided, must be a bech32 session address,
+	// e.g. session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
+	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	//
+	// * If only a scope_id is provided, that scope is returned.
+	// * If only a session_addr is provided, the scope containing that session is returned.
+	// * If only a record_addr is provided, the scope containing that record is returned.
+	// * If more than one of scope_id, session_addr, and record_addr are provided, and they don't refer to the same scope,
+	// a bad request is returned.
+	//
+	// Providing a session addr or record addr does not limit the sessions and records returned (if requested).
+	// Those parameters are only used to find the scope.
+	//
+	// By default, sessions and records are not included.
+	// Set include_sessions and/or include_records to true to include sessions and/or records.
+	Scope(ctx context.Context, in *ScopeRequest, opts ...grpc.CallOption) (*ScopeResponse, error)
+	// ScopesAll retrieves all scopes.
+	ScopesAll(ctx context.Context, in *ScopesAllRequest, opts ...grpc.CallOption) (*ScopesAllResponse, error)
+	// ScopeSearch retrieves the scopes that match all of the provided filters.
+	//
+	// The most selective provided filter is used to pick the index to iterate over (owner with role, owner,
+	// data access address, value owner, then scope specification). At least one of those filters is required.
+	// When the value owner index is used, the other filters are applied to each page of that index, so a page
+	// can have fewer scopes than the limit even if there are more results.
+	ScopeSearch(ctx context.Context, in *ScopeSearchRequest, opts ...grpc.CallOption) (*ScopeSearchResponse, error)
+	// Sessions searches for sessions.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly, the session_id can either be a uuid or session address, e.g.
+	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
+	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	//
+	// * If only a scope_id is provided, all sessions in that scope are returned.
+	// * If only a session_id is provided, it must be an address, and that single session is returned.
+	// * If the session_id is a uuid, then either a scope_id or record_addr must also be provided, and that single session
+	// is returned.
+	// * If only a record_addr is provided, the session containing that record will be returned.
+	// * If a record_name is provided then either a scope_id, session_id as an address, or record_addr must also be
+	// provided, and the session containing that record will be returned.
+	//
+	// A bad request is returned if:
+	// * The session_id is a uuid and is provided without a scope_id or record_addr.
+	// * A record_name is provided without any way to identify the scope (e.g. a scope_id, a session_id as an address, or
+	// a record_addr).
+	// * Two or more of scope_id, session_id as an address, and record_addr are provided and don't all refer to the same
+	// scope.
+	// * A record_addr (or scope_id and record_name) is provided with a session_id and that session does not contain such
+	// a record.
+	// * A record_addr and record_name are both provided, but reference different records.
+	//
+	// By default, the scope and records are not included.
+	// Set include_scope and/or include_records to true to include the scope and/or records.
+	Sessions(ctx context.Context, in *SessionsRequest, opts ...grpc.CallOption) (*SessionsResponse, error)
+	// SessionsAll retrieves all sessions.
+	SessionsAll(ctx context.Context, in *SessionsAllRequest, opts ...grpc.CallOption) (*SessionsAllResponse, error)
+	// Records searches for records.
+	//
+	// The record_addr, if provided, must be a bech32 record address, e.g.
+	// record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3. The scope-id can either be scope uuid, e.g.
+	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly,
+	// the session_id can either be a uuid or session address, e.g.
+	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The name is the name of the record you're
+	// interested in.
+	//
+	// * If only a record_addr is provided, that single record will be returned.
+	// * If only a scope_id is provided, all records in that scope will be returned.
+	// * If only a session_id (or scope_id/session_id), all records in that session will be returned.
+	// * If a name is provided with a scope_id and/or session_id, that single record will be returned.
+	//
+	// A bad request is returned if:
+	// * The session_id is a uuid and no scope_id is provided.
+	// * There are two or more of record_addr, session_id, and scope_id, and they don't all refer to the same scope.
+	// * A name is provided, but not a scope_id and/or a session_id.
+	// * A name and record_addr are provided and the name doesn't match the record_addr.
+	//
+	// By default, the scope and sessions are not included.
+	// Set include_scope and/or include_sessions to true to include the scope and/or sessions.
+	Records(ctx context.Context, in *RecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error)
+	// RecordsAll retrieves all records.
+	RecordsAll(ctx context.Context, in *RecordsAllRequest, opts ...grpc.CallOption) (*RecordsAllResponse, error)
+	// Ownership returns the scope identifiers that have the given address in the owners list.
+	Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error)
+	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
+	ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error)
+	// ScopeSpecification returns a scope specification for the given specification id.
+	//
+	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
+	// specification address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
+	//
+	// By default, the contract and record specifications are not included.
+	// Set include_contract_specs and/or include_record_specs to true to include contract and/or record specifications.
+	ScopeSpecification(ctx context.Context, in *ScopeSpecificationRequest, opts ...grpc.CallOption) (*ScopeSpecificationResponse, error)
+	// ScopeSpecificationsAll retrieves all scope specifications.
+	ScopeSpecificationsAll(ctx context.Context, in *ScopeSpecificationsAllRequest, opts ...grpc.CallOption) (*ScopeSpecificationsAllResponse, error)
+	// ContractSpecification returns a contract specification for the given specification id.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
+	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
+	// address, then the contract specification that contains that record specification is looked up.
+	//
+	// By default, the record specifications for this contract specification are not included.
+	// Set include_record_specs to true to include them in the result.
+	ContractSpecification(ctx context.Context, in *ContractSpecificationRequest, opts ...grpc.CallOption) (*ContractSpecificationResponse, error)
+	// ContractSpecificationsAll retrieves all contract specifications.
+	ContractSpecificationsAll(ctx context.Context, in *ContractSpecificationsAllRequest, opts ...grpc.CallOption) (*ContractSpecificationsAllResponse, error)
+	// RecordSpecificationsForContractSpecification returns the record specifications for the given input.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
+	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
+	// address, then the contract specification that contains that record specification is used.
+	RecordSpecificationsForContractSpecification(ctx context.Context, in *RecordSpecificationsForContractSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationsForContractSpecificationResponse, error)
+	// RecordSpecification returns a record specification for the given input.
+	RecordSpecification(ctx context.Context, in *RecordSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationResponse, error)
+	// RecordSpecificationsAll retrieves all record specifications.
+	RecordSpecificationsAll(ctx context.Context, in *RecordSpecificationsAllRequest, opts ...grpc.CallOption) (*RecordSpecificationsAllResponse, error)
+	// GetByAddr retrieves metadata given any address(es).
+	GetByAddr(ctx context.Context, in *GetByAddrRequest, opts ...grpc.CallOption) (*GetByAddrResponse, error)
+	// OSLocatorParams returns all parameters for the object store locator sub module.
+	OSLocatorParams(ctx context.Context, in *OSLocatorParamsRequest, opts ...grpc.CallOption) (*OSLocatorParamsResponse, error)
+	// OSLocator returns an ObjectStoreLocator by its owner's address.
+	OSLocator(ctx context.Context, in *OSLocatorRequest, opts ...grpc.CallOption) (*OSLocatorResponse, error)
+	// OSLocatorsByURI returns all ObjectStoreLocator entries for a locator uri.
+	OSLocatorsByURI(ctx context.Context, in *OSLocatorsByURIRequest, opts ...grpc.CallOption) (*OSLocatorsByURIResponse, error)
+	// OSLocatorsByScope returns all ObjectStoreLocator entries for a for all signer's present in the specified scope.
+	OSLocatorsByScope(ctx context.Context, in *OSLocatorsByScopeRequest, opts ...grpc.CallOption) (*OSLocatorsByScopeResponse, error)
+	// OSAllLocators returns all ObjectStoreLocator entries.
+	OSAllLocators(ctx context.Context, in *OSAllLocatorsRequest, opts ...grpc.CallOption) (*OSAllLocatorsResponse, error)
+	// AccountData gets the account data associated with a metadata address.
+	// Currently, only scope ids are supported.
+	AccountData(ctx context.Context, in *AccountDataRequest, opts ...grpc.CallOption) (*AccountDataResponse, error)
+	// ScopeNetAssetValues returns net asset values for scope
+	ScopeNetAssetValues(ctx context.Context, in *QueryScopeNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryScopeNetAssetValuesResponse, error)
+	// ScopeHistory returns the prior versions of a scope, or of one of its sessions if a session_id is provided.
+	// Versions are ordered from oldest to newest.
+	ScopeHistory(ctx context.Context, in *ScopeHistoryRequest, opts ...grpc.CallOption) (*ScopeHistoryResponse, error)
+	// RecordHistory returns the prior versions of a record, ordered from oldest to newest.
+	// The record is identified by either a record_addr, or a scope_id and name.
+	RecordHistory(ctx context.Context, in *RecordHistoryRequest, opts ...grpc.CallOption) (*RecordHistoryResponse, error)
+	// ScopeOwnershipTransfers returns pending scope ownership transfers.
+	// They can be limited to a single scope, or to the transfers that an address needs to accept.
+	ScopeOwnershipTransfers(ctx context.Context, in *ScopeOwnershipTransfersRequest, opts ...grpc.CallOption) (*ScopeOwnershipTransfersResponse, error)
+	// RecordCommitment returns the merkle root over all the records in a record's scope, and a proof that the
+	// record is included in it.
+	// The record is identified by either a record_addr, or a scope_id and name.
+	RecordCommitment(ctx context.Context, in *RecordCommitmentRequest, opts ...grpc.CallOption) (*RecordCommitmentResponse, error)
+	// ScopeDataAccess returns the addresses with data access to a scope, and when that access expires.
+	ScopeDataAccess(ctx context.Context, in *ScopeDataAccessRequest, opts ...grpc.CallOption) (*ScopeDataAccessResponse, error)
+	// ScopeTombstone returns the record of an archived scope.
+	ScopeTombstone(ctx context.Context, in *ScopeTombstoneRequest, opts ...grpc.CallOption) (*ScopeTombstoneResponse, error)
 }
 
-func (m *ScopeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ScopeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Scope(ctx context.Context, in *ScopeRequest, opts ...grpc.CallOption) (*ScopeResponse, error) {
+	out := new(ScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Scope", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.IncludeRecords {
-		i--
-		if m.IncludeRecords {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.IncludeSessions {
-		i--
-		if m.IncludeSessions {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ScopesAll(ctx context.Context, in *ScopesAllRequest, opts ...grpc.CallOption) (*ScopesAllResponse, error) {
+	out := new(ScopesAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopesAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ScopeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ScopeSearch(ctx context.Context, in *ScopeSearchRequest, opts ...grpc.CallOption) (*ScopeSearchResponse, error) {
+	out := new(ScopeSearchResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSearch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ScopeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Sessions(ctx context.Context, in *SessionsRequest, opts ...grpc.CallOption) (*SessionsResponse, error) {
+	out := new(SessionsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Sessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (c *queryClient) SessionsAll(ctx context.Context, in *SessionsAllRequest, opts ...grpc.CallOption) (*SessionsAllResponse, error) {
+	out := new(SessionsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/SessionsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Scope != nil {
-		{
-			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) Records(ctx context.Context, in *RecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error) {
+	out := new(RecordsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Records", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ScopeWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RecordsAll(ctx context.Context, in *RecordsAllRequest, opts ...grpc.CallOption) (*RecordsAllResponse, error) {
+	out := new(RecordsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordsAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ScopeWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error) {
+	out := new(OwnershipResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Ownership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ScopeWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		{
-			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (c *queryClient) ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error) {
+	out := new(ValueOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ValueOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ScopeIdInfo != nil {
-		{
-			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *queryClient) ScopeSpecification(ctx context.Context, in *ScopeSpecificationRequest, opts ...grpc.CallOption) (*ScopeSpecificationResponse, error) {
+	out := new(ScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Scope != nil {
-		{
-			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) ScopeSpecificationsAll(ctx context.Context, in *ScopeSpecificationsAllRequest, opts ...grpc.CallOption) (*ScopeSpecificationsAllResponse, error) {
+	out := new(ScopeSpecificationsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSpecificationsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ScopesAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContractSpecification(ctx context.Context, in *ContractSpecificationRequest, opts ...grpc.CallOption) (*ContractSpecificationResponse, error) {
+	out := new(ContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ContractSpecification", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ScopesAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContractSpecificationsAll(ctx context.Context, in *ContractSpecificationsAllRequest, opts ...grpc.CallOption) (*ContractSpecificationsAllResponse, error) {
+	out := new(ContractSpecificationsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ContractSpecificationsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ScopesAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func (c *queryClient) RecordSpecificationsForContractSpecification(ctx context.Context, in *RecordSpecificationsForContractSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationsForContractSpecificationResponse, error) {
+	out := new(RecordSpecificationsForContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecificationsForContractSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+	return out, nil
+}
+
+func (c *queryClient) RecordSpecification(ctx context.Context, in *RecordSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationResponse, error) {
+	out := new(RecordSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+	return out, nil
+}
+
+func (c *queryClient) RecordSpecificationsAll(ctx context.Context, in *RecordSpecificationsAllRequest, opts ...grpc.CallOption) (*RecordSpecificationsAllResponse, error) {
+	out := new(RecordSpecificationsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecificationsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ScopesAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetByAddr(ctx context.Context, in *GetByAddrRequest, opts ...grpc.CallOption) (*GetByAddrResponse, error) {
+	out := new(GetByAddrResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/GetByAddr", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ScopesAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OSLocatorParams(ctx context.Context, in *OSLocatorParamsRequest, opts ...grpc.CallOption) (*OSLocatorParamsResponse, error) {
+	out := new(OSLocatorParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ScopesAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.Scopes) > 0 {
-		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) OSLocator(ctx context.Context, in *OSLocatorRequest, opts ...grpc.CallOption) (*OSLocatorResponse, error) {
+	out := new(OSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SessionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OSLocatorsByURI(ctx context.Context, in *OSLocatorsByURIRequest, opts ...grpc.CallOption) (*OSLocatorsByURIResponse, error) {
+	out := new(OSLocatorsByURIResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorsByURI", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SessionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OSLocatorsByScope(ctx context.Context, in *OSLocatorsByScopeRequest, opts ...grpc.CallOption) (*OSLocatorsByScopeResponse, error) {
+	out := new(OSLocatorsByScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorsByScope", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.IncludeRecords {
-		i--
-		if m.IncludeRecords {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.IncludeScope {
-		i--
-		if m.IncludeScope {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.RecordName) > 0 {
-		i -= len(m.RecordName)
-		copy(dAtA[i:], m.RecordName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) OSAllLocators(ctx context.Context, in *OSAllLocatorsRequest, opts ...grpc.CallOption) (*OSAllLocatorsResponse, error) {
+	out := new(OSAllLocatorsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSAllLocators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SessionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AccountData(ctx context.Context, in *AccountDataRequest, opts ...grpc.CallOption) (*AccountDataResponse, error) {
+	out := new(AccountDataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/AccountData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SessionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ScopeNetAssetValues(ctx context.Context, in *QueryScopeNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryScopeNetAssetValuesResponse, error) {
+	out := new(QueryScopeNetAssetValuesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeNetAssetValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+func (c *queryClient) ScopeHistory(ctx context.Context, in *ScopeHistoryRequest, opts ...grpc.CallOption) (*ScopeHistoryResponse, error) {
+	out := new(ScopeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return out, nil
+}
+
+func (c *queryClient) RecordHistory(ctx context.Context, in *RecordHistoryRequest, opts ...grpc.CallOption) (*RecordHistoryResponse, error) {
+	out := new(RecordHistoryResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return out, nil
+}
+
+func (c *queryClient) ScopeOwnershipTransfers(ctx context.Context, in *ScopeOwnershipTransfersRequest, opts ...grpc.CallOption) (*ScopeOwnershipTransfersResponse, error) {
+	out := new(ScopeOwnershipTransfersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeOwnershipTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Scope != nil {
-		{
-			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) RecordCommitment(ctx context.Context, in *RecordCommitmentRequest, opts ...grpc.CallOption) (*RecordCommitmentResponse, error) {
+	out := new(RecordCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SessionWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ScopeDataAccess(ctx context.Context, in *ScopeDataAccessRequest, opts ...grpc.CallOption) (*ScopeDataAccessResponse, error) {
+	out := new(ScopeDataAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeDataAccess", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SessionWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ScopeTombstone(ctx context.Context, in *ScopeTombstoneRequest, opts ...grpc.CallOption) (*ScopeTombstoneResponse, error) {
+	out := new(ScopeTombstoneResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeTombstone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SessionWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractSpecIdInfo != nil {
-		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SessionIdInfo != nil {
-		{
-			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Session != nil {
-		{
-			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/metadata module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Scope searches for a scope.
+	//
+	// The scope id, if provided, must either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address,
+	// e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. The session addr, if provided, must be a bech32 session address,
+	// e.g. session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
+	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	//
+	// * If only a scope_id is provided, that scope is returned.
+	// * If only a session_addr is provided, the scope containing that session is returned.
+	// * If only a record_addr is provided, the scope containing that record is returned.
+	// * If more than one of scope_id, session_addr, and record_addr are provided, and they don't refer to the same scope,
+	// a bad request is returned.
+	//
+	// Providing a session addr or record addr does not limit the sessions and records returned (if requested).
+	// Those parameters are only used to find the scope.
+	//
+	// By default, sessions and records are not included.
+	// Set include_sessions and/or include_records to true to include sessions and/or records.
+	Scope(context.Context, *ScopeRequest) (*ScopeResponse, error)
+	// ScopesAll retrieves all scopes.
+	ScopesAll(context.Context, *ScopesAllRequest) (*ScopesAllResponse, error)
+	// ScopeSearch retrieves the scopes that match all of the provided filters.
+	//
+	// The most selective provided filter is used to pick the index to iterate over (owner with role, owner,
+	// data access address, value owner, then scope specification). At least one of those filters is required.
+	// When the value owner index is used, the other filters are applied to each page of that index, so a page
+	// can have fewer scopes than the limit even if there are more results.
+	ScopeSearch(context.Context, *ScopeSearchRequest) (*ScopeSearchResponse, error)
+	// Sessions searches for sessions.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly, the session_id can either be a uuid or session address, e.g.
+	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
+	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	//
+	// * If only a scope_id is provided, all sessions in that scope are returned.
+	// * If only a session_id is provided, it must be an address, and that single session is returned.
+	// * If the session_id is a uuid, then either a scope_id or record_addr must also be provided, and that single session
+	// is returned.
+	// * If only a record_addr is provided, the session containing that record will be returned.
+	// * If a record_name is provided then either a scope_id, session_id as an address, or record_addr must also be
+	// provided, and the session containing that record will be returned.
+	//
+	// A bad request is returned if:
+	// * The session_id is a uuid and is provided without a scope_id or record_addr.
+	// * A record_name is provided without any way to identify the scope (e.g. a scope_id, a session_id as an address, or
+	// a record_addr).
+	// * Two or more of scope_id, session_id as an address, and record_addr are provided and don't all refer to the same
+	// scope.
+	// * A record_addr (or scope_id and record_name) is provided with a session_id and that session does not contain such
+	// a record.
+	// * A record_addr and record_name are both provided, but reference different records.
+	//
+	// By default, the scope and records are not included.
+	// Set include_scope and/or include_records to true to include the scope and/or records.
+	Sessions(context.Context, *SessionsRequest) (*SessionsResponse, error)
+	// SessionsAll retrieves all sessions.
+	SessionsAll(context.Context, *SessionsAllRequest) (*SessionsAllResponse, error)
+	// Records searches for records.
+	//
+	// The record_addr, if provided, must be a bech32 record address, e.g.
+	// record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3. The scope-id can either be scope uuid, e.g.
+	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly,
+	// the session_id can either be a uuid or session address, e.g.
+	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The name is the name of the record you're
+	// interested in.
+	//
+	// * If only a record_addr is provided, that single record will be returned.
+	// * If only a scope_id is provided, all records in that scope will be returned.
+	// * If only a session_id (or scope_id/session_id), all records in that session will be returned.
+	// * If a name is provided with a scope_id and/or session_id, that single record will be returned.
+	//
+	// A bad request is returned if:
+	// * The session_id is a uuid and no scope_id is provided.
+	// * There are two or more of record_addr, session_id, and scope_id, and they don't all refer to the same scope.
+	// * A name is provided, but not a scope_id and/or a session_id.
+	// * A name and record_addr are provided and the name doesn't match the record_addr.
+	//
+	// By default, the scope and sessions are not included.
+	// Set include_scope and/or include_sessions to true to include the scope and/or sessions.
+	Records(context.Context, *RecordsRequest) (*RecordsResponse, error)
+	// RecordsAll retrieves all records.
+	RecordsAll(context.Context, *RecordsAllRequest) (*RecordsAllResponse, error)
+	// Ownership returns the scope identifiers that have the given address in the owners list.
+	Ownership(context.Context, *OwnershipRequest) (*OwnershipResponse, error)
+	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
+	ValueOwnership(context.Context, *ValueOwnershipRequest) (*ValueOwnershipResponse, error)
+	// ScopeSpecification returns a scope specification for the given specification id.
+	//
+	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
+	// specification address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
+	//
+	// By default, the contract and record specifications are not included.
+	// Set include_contract_specs and/or include_record_specs to true to include contract and/or record specifications.
+	ScopeSpecification(context.Context, *ScopeSpecificationRequest) (*ScopeSpecificationResponse, error)
+	// ScopeSpecificationsAll retrieves all scope specifications.
+	ScopeSpecificationsAll(context.Context, *ScopeSpecificationsAllRequest) (*ScopeSpecificationsAllResponse, error)
+	// ContractSpecification returns a contract specification for the given specification id.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
+	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
+	// address, then the contract specification that contains that record specification is looked up.
+	//
+	// By default, the record specifications for this contract specification are not included.
+	// Set include_record_specs to true to include them in the result.
+	ContractSpecification(context.Context, *ContractSpecificationRequest) (*ContractSpecificationResponse, error)
+	// ContractSpecificationsAll retrieves all contract specifications.
+	ContractSpecificationsAll(context.Context, *ContractSpecificationsAllRequest) (*ContractSpecificationsAllResponse, error)
+	// RecordSpecificationsForContractSpecification returns the record specifications for the given input.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
+	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
+	// address, then the contract specification that contains that record specification is used.
+	RecordSpecificationsForContractSpecification(context.Context, *RecordSpecificationsForContractSpecificationRequest) (*RecordSpecificationsForContractSpecificationResponse, error)
+	// RecordSpecification returns a record specification for the given input.
+	RecordSpecification(context.Context, *RecordSpecificationRequest) (*RecordSpecificationResponse, error)
+	// RecordSpecificationsAll retrieves all record specifications.
+	RecordSpecificationsAll(context.Context, *RecordSpecificationsAllRequest) (*RecordSpecificationsAllResponse, error)
+	// GetByAddr retrieves metadata given any address(es).
+	GetByAddr(context.Context, *GetByAddrRequest) (*GetByAddrResponse, error)
+	// OSLocatorParams returns all parameters for the object store locator sub module.
+	OSLocatorParams(context.Context, *OSLocatorParamsRequest) (*OSLocatorParamsResponse, error)
+	// OSLocator returns an ObjectStoreLocator by its owner's address.
+	OSLocator(context.Context, *OSLocatorRequest) (*OSLocatorResponse, error)
+	// OSLocatorsByURI returns all ObjectStoreLocator entries for a locator uri.
+	OSLocatorsByURI(context.Context, *OSLocatorsByURIRequest) (*OSLocatorsByURIResponse, error)
+	// OSLocatorsByScope returns all ObjectStoreLocator entries for a for all signer's present in the specified scope.
+	OSLocatorsByScope(context.Context, *OSLocatorsByScopeRequest) (*OSLocatorsByScopeResponse, error)
+	// OSAllLocators returns all ObjectStoreLocator entries.
+	OSAllLocators(context.Context, *OSAllLocatorsRequest) (*OSAllLocatorsResponse, error)
+	// AccountData gets the account data associated with a metadata address.
+	// Currently, only scope ids are supported.
+	AccountData(context.Context, *AccountDataRequest) (*AccountDataResponse, error)
+	// ScopeNetAssetValues returns net asset values for scope
+	ScopeNetAssetValues(context.Context, *QueryScopeNetAssetValuesRequest) (*QueryScopeNetAssetValuesResponse, error)
+	// ScopeHistory returns the prior versions of a scope, or of one of its sessions if a session_id is provided.
+	// Versions are ordered from oldest to newest.
+	ScopeHistory(context.Context, *ScopeHistoryRequest) (*ScopeHistoryResponse, error)
+	// RecordHistory returns the prior versions of a record, ordered from oldest to newest.
+	// The record is identified by either a record_addr, or a scope_id and name.
+	RecordHistory(context.Context, *RecordHistoryRequest) (*RecordHistoryResponse, error)
+	// ScopeOwnershipTransfers returns pending scope ownership transfers.
+	// They can be limited to a single scope, or to the transfers that an address needs to accept.
+	ScopeOwnershipTransfers(context.Context, *ScopeOwnershipTransfersRequest) (*ScopeOwnershipTransfersResponse, error)
+	// RecordCommitment returns the merkle root over all the records in a record's scope, and a proof that the
+	// record is included in it.
+	// The record is identified by either a record_addr, or a scope_id and name.
+	RecordCommitment(context.Context, *RecordCommitmentRequest) (*RecordCommitmentResponse, error)
+	// ScopeDataAccess returns the addresses with data access to a scope, and when that access expires.
+	ScopeDataAccess(context.Context, *ScopeDataAccessRequest) (*ScopeDataAccessResponse, error)
+	// ScopeTombstone returns the record of an archived scope.
+	ScopeTombstone(context.Context, *ScopeTombstoneRequest) (*ScopeTombstoneResponse, error)
 }
 
-func (m *SessionsAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *SessionsAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *SessionsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) Scope(ctx context.Context, req *ScopeRequest) (*ScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scope not implemented")
 }
-
-func (m *SessionsAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ScopesAll(ctx context.Context, req *ScopesAllRequest) (*ScopesAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopesAll not implemented")
 }
-
-func (m *SessionsAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ScopeSearch(ctx context.Context, req *ScopeSearchRequest) (*ScopeSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeSearch not implemented")
 }
-
-func (m *SessionsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) Sessions(ctx context.Context, req *SessionsRequest) (*SessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sessions not implemented")
 }
-
-func (m *RecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) SessionsAll(ctx context.Context, req *SessionsAllRequest) (*SessionsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionsAll not implemented")
+}
+func (*UnimplementedQueryServer) Records(ctx context.Context, req *RecordsRequest) (*RecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Records not implemented")
+}
+func (*UnimplementedQueryServer) RecordsAll(ctx context.Context, req *RecordsAllRequest) (*RecordsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordsAll not implemented")
+}
+func (*UnimplementedQueryServer) Ownership(ctx context.Context, req *OwnershipRequest) (*OwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ownership not implemented")
+}
+func (*UnimplementedQueryServer) ValueOwnership(ctx context.Context, req *ValueOwnershipRequest) (*ValueOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValueOwnership not implemented")
+}
+func (*UnimplementedQueryServer) ScopeSpecification(ctx context.Context, req *ScopeSpecificationRequest) (*ScopeSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeSpecification not implemented")
+}
+func (*UnimplementedQueryServer) ScopeSpecificationsAll(ctx context.Context, req *ScopeSpecificationsAllRequest) (*ScopeSpecificationsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeSpecificationsAll not implemented")
+}
+func (*UnimplementedQueryServer) ContractSpecification(ctx context.Context, req *ContractSpecificationRequest) (*ContractSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractSpecification not implemented")
+}
+func (*UnimplementedQueryServer) ContractSpecificationsAll(ctx context.Context, req *ContractSpecificationsAllRequest) (*ContractSpecificationsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractSpecificationsAll not implemented")
+}
+func (*UnimplementedQueryServer) RecordSpecificationsForContractSpecification(ctx context.Context, req *RecordSpecificationsForContractSpecificationRequest) (*RecordSpecificationsForContractSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecificationsForContractSpecification not implemented")
+}
+func (*UnimplementedQueryServer) RecordSpecification(ctx context.Context, req *RecordSpecificationRequest) (*RecordSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecification not implemented")
+}
+func (*UnimplementedQueryServer) RecordSpecificationsAll(ctx context.Context, req *RecordSpecificationsAllRequest) (*RecordSpecificationsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecificationsAll not implemented")
+}
+func (*UnimplementedQueryServer) GetByAddr(ctx context.Context, req *GetByAddrRequest) (*GetByAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByAddr not implemented")
+}
+func (*UnimplementedQueryServer) OSLocatorParams(ctx context.Context, req *OSLocatorParamsRequest) (*OSLocatorParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorParams not implemented")
+}
+func (*UnimplementedQueryServer) OSLocator(ctx context.Context, req *OSLocatorRequest) (*OSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocator not implemented")
+}
+func (*UnimplementedQueryServer) OSLocatorsByURI(ctx context.Context, req *OSLocatorsByURIRequest) (*OSLocatorsByURIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorsByURI not implemented")
+}
+func (*UnimplementedQueryServer) OSLocatorsByScope(ctx context.Context, req *OSLocatorsByScopeRequest) (*OSLocatorsByScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorsByScope not implemented")
+}
+func (*UnimplementedQueryServer) OSAllLocators(ctx context.Context, req *OSAllLocatorsRequest) (*OSAllLocatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSAllLocators not implemented")
+}
+func (*UnimplementedQueryServer) AccountData(ctx context.Context, req *AccountDataRequest) (*AccountDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountData not implemented")
+}
+func (*UnimplementedQueryServer) ScopeNetAssetValues(ctx context.Context, req *QueryScopeNetAssetValuesRequest) (*QueryScopeNetAssetValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeNetAssetValues not implemented")
+}
+func (*UnimplementedQueryServer) ScopeHistory(ctx context.Context, req *ScopeHistoryRequest) (*ScopeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeHistory not implemented")
+}
+func (*UnimplementedQueryServer) RecordHistory(ctx context.Context, req *RecordHistoryRequest) (*RecordHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordHistory not implemented")
+}
+func (*UnimplementedQueryServer) ScopeOwnershipTransfers(ctx context.Context, req *ScopeOwnershipTransfersRequest) (*ScopeOwnershipTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeOwnershipTransfers not implemented")
+}
+func (*UnimplementedQueryServer) RecordCommitment(ctx context.Context, req *RecordCommitmentRequest) (*RecordCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordCommitment not implemented")
+}
+func (*UnimplementedQueryServer) ScopeDataAccess(ctx context.Context, req *ScopeDataAccessRequest) (*ScopeDataAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeDataAccess not implemented")
+}
+func (*UnimplementedQueryServer) ScopeTombstone(ctx context.Context, req *ScopeTombstoneRequest) (*ScopeTombstoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeTombstone not implemented")
 }
 
-func (m *RecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *RecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.IncludeSessions {
-		i--
-		if m.IncludeSessions {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Params",
 	}
-	if m.IncludeScope {
-		i--
-		if m.IncludeScope {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Scope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).Scope(ctx, in)
 	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Scope",
 	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Scope(ctx, req.(*ScopeRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ScopesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopesAllRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ScopesAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopesAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopesAll(ctx, req.(*ScopesAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ScopeSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeSearch(ctx, req.(*ScopeSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+func _Query_Sessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Sessions(ctx, in)
 	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Sessions",
 	}
-	if m.Scope != nil {
-		{
-			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sessions(ctx, req.(*SessionsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SessionsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionsAllRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RecordWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).SessionsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/SessionsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SessionsAll(ctx, req.(*SessionsAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RecordSpecIdInfo != nil {
-		{
-			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func _Query_Records_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.RecordIdInfo != nil {
-		{
-			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).Records(ctx, in)
 	}
-	if m.Record != nil {
-		{
-			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Records",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Records(ctx, req.(*RecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordsAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RecordsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordsAllRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RecordsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordsAll(ctx, req.(*RecordsAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordsAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Ownership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Ownership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Ownership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Ownership(ctx, req.(*OwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_ValueOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValueOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+	if interceptor == nil {
+		return srv.(QueryServer).ValueOwnership(ctx, in)
 	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ValueOwnership",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValueOwnership(ctx, req.(*ValueOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordsAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeSpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeSpecification(ctx, req.(*ScopeSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordsAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RecordsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_ScopeSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeSpecificationsAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeSpecificationsAll(ctx, in)
 	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeSpecificationsAll",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeSpecificationsAll(ctx, req.(*ScopeSpecificationsAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OwnershipRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractSpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+	if interceptor == nil {
+		return srv.(QueryServer).ContractSpecification(ctx, in)
 	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ContractSpecification",
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractSpecification(ctx, req.(*ContractSpecificationRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OwnershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ContractSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractSpecificationsAllRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ContractSpecificationsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ContractSpecificationsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractSpecificationsAll(ctx, req.(*ContractSpecificationsAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RecordSpecificationsForContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSpecificationsForContractSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordSpecificationsForContractSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordSpecificationsForContractSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordSpecificationsForContractSpecification(ctx, req.(*RecordSpecificationsForContractSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_RecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	if interceptor == nil {
+		return srv.(QueryServer).RecordSpecification(ctx, in)
 	}
-	if len(m.ScopeUuids) > 0 {
-		for iNdEx := len(m.ScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ScopeUuids[iNdEx])
-			copy(dAtA[i:], m.ScopeUuids[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeUuids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordSpecification",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordSpecification(ctx, req.(*RecordSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValueOwnershipRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RecordSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSpecificationsAllRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RecordSpecificationsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordSpecificationsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordSpecificationsAll(ctx, req.(*RecordSpecificationsAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValueOwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetByAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetByAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/GetByAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetByAddr(ctx, req.(*GetByAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValueOwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_OSLocatorParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocatorParams(ctx, in)
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocatorParams",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocatorParams(ctx, req.(*OSLocatorParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValueOwnershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocator(ctx, req.(*OSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValueOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_OSLocatorsByURI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorsByURIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocatorsByURI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocatorsByURI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocatorsByURI(ctx, req.(*OSLocatorsByURIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValueOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_OSLocatorsByScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorsByScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocatorsByScope(ctx, in)
 	}
-	if len(m.ScopeUuids) > 0 {
-		for iNdEx := len(m.ScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ScopeUuids[iNdEx])
-			copy(dAtA[i:], m.ScopeUuids[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeUuids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocatorsByScope",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocatorsByScope(ctx, req.(*OSLocatorsByScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OSAllLocators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSAllLocatorsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OSAllLocators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSAllLocators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSAllLocators(ctx, req.(*OSAllLocatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/AccountData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountData(ctx, req.(*AccountDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+func _Query_ScopeNetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScopeNetAssetValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.IncludeRecordSpecs {
-		i--
-		if m.IncludeRecordSpecs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeNetAssetValues(ctx, in)
 	}
-	if m.IncludeContractSpecs {
-		i--
-		if m.IncludeContractSpecs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeNetAssetValues",
 	}
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeNetAssetValues(ctx, req.(*QueryScopeNetAssetValuesRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ScopeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeHistory(ctx, req.(*ScopeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+func _Query_RecordHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RecordSpecs) > 0 {
-		for iNdEx := len(m.RecordSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordHistory(ctx, in)
 	}
-	if len(m.ContractSpecs) > 0 {
-		for iNdEx := len(m.ContractSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContractSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordHistory",
 	}
-	if m.ScopeSpecification != nil {
-		{
-			size, err := m.ScopeSpecification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordHistory(ctx, req.(*RecordHistoryRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ScopeOwnershipTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeOwnershipTransfersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ScopeSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ScopeSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		{
-			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeOwnershipTransfers(ctx, in)
 	}
-	if m.Specification != nil {
-		{
-			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeOwnershipTransfers",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeOwnershipTransfers(ctx, req.(*ScopeOwnershipTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RecordCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordCommitmentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ScopeSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ScopeSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+	if interceptor == nil {
+		return srv.(QueryServer).RecordCommitment(ctx, in)
 	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordCommitment",
 	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordCommitment(ctx, req.(*RecordCommitmentRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeDataAccessRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ScopeSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ScopeSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeDataAccess(ctx, in)
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeDataAccess",
 	}
-	if len(m.ScopeSpecifications) > 0 {
-		for iNdEx := len(m.ScopeSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ScopeSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeDataAccess(ctx, req.(*ScopeDataAccessRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ScopeTombstone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeTombstoneRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeTombstone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeTombstone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeTombstone(ctx, req.(*ScopeTombstoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.metadata.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Scope",
+			Handler:    _Query_Scope_Handler,
+		},
+		{
+			MethodName: "ScopesAll",
+			Handler:    _Query_ScopesAll_Handler,
+		},
+		{
+			MethodName: "ScopeSearch",
+			Handler:    _Query_ScopeSearch_Handler,
+		},
+		{
+			MethodName: "Sessions",
+			Handler:    _Query_Sessions_Handler,
+		},
+		{
+			MethodName: "SessionsAll",
+			Handler:    _Query_SessionsAll_Handler,
+		},
+		{
+			MethodName: "Records",
+			Handler:    _Query_Records_Handler,
+		},
+		{
+			MethodName: "RecordsAll",
+			Handler:    _Query_RecordsAll_Handler,
+		},
+		{
+			MethodName: "Ownership",
+			Handler:    _Query_Ownership_Handler,
+		},
+		{
+			MethodName: "ValueOwnership",
+			Handler:    _Query_ValueOwnership_Handler,
+		},
+		{
+			MethodName: "ScopeSpecification",
+			Handler:    _Query_ScopeSpecification_Handler,
+		},
+		{
+			MethodName: "ScopeSpecificationsAll",
+			Handler:    _Query_ScopeSpecificationsAll_Handler,
+		},
+		{
+			MethodName: "ContractSpecification",
+			Handler:    _Query_ContractSpecification_Handler,
+		},
+		{
+			MethodName: "ContractSpecificationsAll",
+			Handler:    _Query_ContractSpecificationsAll_Handler,
+		},
+		{
+			MethodName: "RecordSpecificationsForContractSpecification",
+			Handler:    _Query_RecordSpecificationsForContractSpecification_Handler,
+		},
+		{
+			MethodName: "RecordSpecification",
+			Handler:    _Query_RecordSpecification_Handler,
+		},
+		{
+			MethodName: "RecordSpecificationsAll",
+			Handler:    _Query_RecordSpecificationsAll_Handler,
+		},
+		{
+			MethodName: "GetByAddr",
+			Handler:    _Query_GetByAddr_Handler,
+		},
+		{
+			MethodName: "OSLocatorParams",
+			Handler:    _Query_OSLocatorParams_Handler,
+		},
+		{
+			MethodName: "OSLocator",
+			Handler:    _Query_OSLocator_Handler,
+		},
+		{
+			MethodName: "OSLocatorsByURI",
+			Handler:    _Query_OSLocatorsByURI_Handler,
+		},
+		{
+			MethodName: "OSLocatorsByScope",
+			Handler:    _Query_OSLocatorsByScope_Handler,
+		},
+		{
+			MethodName: "OSAllLocators",
+			Handler:    _Query_OSAllLocators_Handler,
+		},
+		{
+			MethodName: "AccountData",
+			Handler:    _Query_AccountData_Handler,
+		},
+		{
+			MethodName: "ScopeNetAssetValues",
+			Handler:    _Query_ScopeNetAssetValues_Handler,
+		},
+		{
+			MethodName: "ScopeHistory",
+			Handler:    _Query_ScopeHistory_Handler,
+		},
+		{
+			MethodName: "RecordHistory",
+			Handler:    _Query_RecordHistory_Handler,
+		},
+		{
+			MethodName: "ScopeOwnershipTransfers",
+			Handler:    _Query_ScopeOwnershipTransfers_Handler,
+		},
+		{
+			MethodName: "RecordCommitment",
+			Handler:    _Query_RecordCommitment_Handler,
+		},
+		{
+			MethodName: "ScopeDataAccess",
+			Handler:    _Query_ScopeDataAccess_Handler,
+		},
+		{
+			MethodName: "ScopeTombstone",
+			Handler:    _Query_ScopeTombstone_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/metadata/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
@@ -6614,37 +6430,10 @@ func (m *ContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x90
 	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.IncludeRecordSpecs {
-		i--
-		if m.IncludeRecordSpecs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6654,12 +6443,12 @@ func (m *ContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6678,36 +6467,20 @@ func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.RecordSpecifications) > 0 {
-		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.ContractSpecification != nil {
-		{
-			size, err := m.ContractSpecification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+func (m *ScopeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6717,66 +6490,105 @@ func (m *ContractSpecificationWrapper) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
-		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Specification != nil {
-		{
-			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ContractSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x60
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	if m.IncludeRecords {
+		i--
+		if m.IncludeRecords {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.IncludeSessions {
+		i--
+		if m.IncludeSessions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.Request != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6786,34 +6598,52 @@ func (m *ContractSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (in
 		i--
 		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x92
 	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
 	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x60
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScopeWrapper) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6823,19 +6653,19 @@ func (m *ContractSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeWrapper) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.ScopeSpecIdInfo != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6843,13 +6673,11 @@ func (m *ContractSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x1a
 	}
-	if m.Request != nil {
+	if m.ScopeIdInfo != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6857,28 +6685,24 @@ func (m *ContractSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0x12
 	}
-	if len(m.ContractSpecifications) > 0 {
-		for iNdEx := len(m.ContractSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContractSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScopesAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6888,16 +6712,30 @@ func (m *RecordSpecificationsForContractSpecificationRequest) Marshal() (dAtA []
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopesAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopesAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.IncludeRequest {
 		i--
 		if m.IncludeRequest {
@@ -6920,17 +6758,10 @@ func (m *RecordSpecificationsForContractSpecificationRequest) MarshalToSizedBuff
 		i--
 		dAtA[i] = 0x60
 	}
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScopesAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6940,19 +6771,19 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Marshal() (dAtA [
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopesAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopesAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6962,26 +6793,26 @@ func (m *RecordSpecificationsForContractSpecificationResponse) MarshalToSizedBuf
 		i--
 		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0x9a
 	}
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ContractSpecificationUuid) > 0 {
-		i -= len(m.ContractSpecificationUuid)
-		copy(dAtA[i:], m.ContractSpecificationUuid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationUuid)))
+		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x92
 	}
-	if len(m.RecordSpecifications) > 0 {
-		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6995,7 +6826,7 @@ func (m *RecordSpecificationsForContractSpecificationResponse) MarshalToSizedBuf
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSearchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7005,16 +6836,30 @@ func (m *RecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSearchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.IncludeRequest {
 		i--
 		if m.IncludeRequest {
@@ -7037,24 +6882,70 @@ func (m *RecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x60
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+	if m.MaxUpdatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxUpdatedHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x50
 	}
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+	if m.MinUpdatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinUpdatedHeight))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x48
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	if m.MaxCreatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxCreatedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinCreatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinCreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.NavDenom) > 0 {
+		i -= len(m.NavDenom)
+		copy(dAtA[i:], m.NavDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NavDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DataAccess) > 0 {
+		i -= len(m.DataAccess)
+		copy(dAtA[i:], m.DataAccess)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataAccess)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValueOwner) > 0 {
+		i -= len(m.ValueOwner)
+		copy(dAtA[i:], m.ValueOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValueOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OwnerRole != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OwnerRole))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeSpecId) > 0 {
+		i -= len(m.ScopeSpecId)
+		copy(dAtA[i:], m.ScopeSpecId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeSpecId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeSearchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7064,16 +6955,30 @@ func (m *RecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSearchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.Request != nil {
 		{
 			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
@@ -7088,9 +6993,181 @@ func (m *RecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i--
 		dAtA[i] = 0x92
 	}
-	if m.RecordSpecification != nil {
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.IncludeRecords {
+		i--
+		if m.IncludeRecords {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.IncludeScope {
+		i--
+		if m.IncludeScope {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.RecordName) > 0 {
+		i -= len(m.RecordName)
+		copy(dAtA[i:], m.RecordName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
 		{
-			size, err := m.RecordSpecification.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7103,7 +7180,7 @@ func (m *RecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+func (m *SessionWrapper) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7113,19 +7190,31 @@ func (m *RecordSpecificationWrapper) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionWrapper) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RecordSpecIdInfo != nil {
+	if m.ContractSpecIdInfo != nil {
 		{
-			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SessionIdInfo != nil {
+		{
+			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7135,9 +7224,9 @@ func (m *RecordSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Specification != nil {
+	if m.Session != nil {
 		{
-			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7150,7 +7239,7 @@ func (m *RecordSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+func (m *SessionsAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7160,12 +7249,12 @@ func (m *RecordSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionsAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7209,7 +7298,7 @@ func (m *RecordSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionsAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7219,12 +7308,12 @@ func (m *RecordSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionsAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7257,10 +7346,10 @@ func (m *RecordSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.RecordSpecifications) > 0 {
-		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7274,7 +7363,7 @@ func (m *RecordSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *GetByAddrRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7284,29 +7373,90 @@ func (m *GetByAddrRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addrs) > 0 {
-		for iNdEx := len(m.Addrs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addrs[iNdEx])
-			copy(dAtA[i:], m.Addrs[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addrs[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.IncludeSessions {
+		i--
+		if m.IncludeSessions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.IncludeScope {
+		i--
+		if m.IncludeScope {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetByAddrResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7316,66 +7466,29 @@ func (m *GetByAddrResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NotFound) > 0 {
-		for iNdEx := len(m.NotFound) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.NotFound[iNdEx])
-			copy(dAtA[i:], m.NotFound[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.NotFound[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.RecordSpecs) > 0 {
-		for iNdEx := len(m.RecordSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.ContractSpecs) > 0 {
-		for iNdEx := len(m.ContractSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContractSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.ScopeSpecs) > 0 {
-		for iNdEx := len(m.ScopeSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ScopeSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x22
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
 	if len(m.Records) > 0 {
 		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
@@ -7405,24 +7518,22 @@ func (m *GetByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Scopes) > 0 {
-		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordWrapper) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7432,32 +7543,56 @@ func (m *OSLocatorParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordWrapper) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IncludeRequest {
+	if m.RecordSpecIdInfo != nil {
+		{
+			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x1a
+	}
+	if m.RecordIdInfo != nil {
+		{
+			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6
+		dAtA[i] = 0x12
+	}
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x90
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordsAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7467,19 +7602,19 @@ func (m *OSLocatorParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordsAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7489,22 +7624,34 @@ func (m *OSLocatorParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0x9a
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordsAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7514,58 +7661,30 @@ func (m *OSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordsAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0x90
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *OSLocatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x9a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	if m.Request != nil {
 		{
 			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
@@ -7580,22 +7699,24 @@ func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x92
 	}
-	if m.Locator != nil {
-		{
-			size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByURIRequest) Marshal() (dAtA []byte, err error) {
+func (m *OwnershipRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7605,12 +7726,12 @@ func (m *OSLocatorsByURIRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByURIRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *OwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByURIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7641,17 +7762,17 @@ func (m *OSLocatorsByURIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x90
 	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uri)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByURIResponse) Marshal() (dAtA []byte, err error) {
+func (m *OwnershipResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7661,12 +7782,12 @@ func (m *OSLocatorsByURIResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByURIResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *OwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7699,16 +7820,11 @@ func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.Locators) > 0 {
-		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
+	if len(m.ScopeUuids) > 0 {
+		for iNdEx := len(m.ScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeUuids[iNdEx])
+			copy(dAtA[i:], m.ScopeUuids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeUuids[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7716,7 +7832,7 @@ func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByScopeRequest) Marshal() (dAtA []byte, err error) {
+func (m *ValueOwnershipRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7726,16 +7842,30 @@ func (m *OSLocatorsByScopeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByScopeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValueOwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValueOwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.IncludeRequest {
 		i--
 		if m.IncludeRequest {
@@ -7748,17 +7878,17 @@ func (m *OSLocatorsByScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i--
 		dAtA[i] = 0x90
 	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByScopeResponse) Marshal() (dAtA []byte, err error) {
+func (m *ValueOwnershipResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7768,16 +7898,30 @@ func (m *OSLocatorsByScopeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValueOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValueOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.Request != nil {
 		{
 			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
@@ -7792,16 +7936,11 @@ func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.Locators) > 0 {
-		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
+	if len(m.ScopeUuids) > 0 {
+		for iNdEx := len(m.ScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeUuids[iNdEx])
+			copy(dAtA[i:], m.ScopeUuids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeUuids[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7809,7 +7948,7 @@ func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *OSAllLocatorsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7819,46 +7958,69 @@ func (m *OSAllLocatorsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSAllLocatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSAllLocatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
 		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x90
 	}
-	if m.IncludeRequest {
+	if m.ExcludeIdInfo {
 		i--
-		if m.IncludeRequest {
+		if m.ExcludeIdInfo {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x6
+		dAtA[i] = 0x60
+	}
+	if m.IncludeRecordSpecs {
 		i--
-		dAtA[i] = 0x90
+		if m.IncludeRecordSpecs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.IncludeContractSpecs {
+		i--
+		if m.IncludeContractSpecs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSAllLocatorsResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7868,30 +8030,16 @@ func (m *OSAllLocatorsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSAllLocatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
 	if m.Request != nil {
 		{
 			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
@@ -7906,10 +8054,10 @@ func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.Locators) > 0 {
-		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RecordSpecs) > 0 {
+		for iNdEx := len(m.RecordSpecs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RecordSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7917,13 +8065,39 @@ func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AccountDataRequest) Marshal() (dAtA []byte, err error) {
+	if len(m.ContractSpecs) > 0 {
+		for iNdEx := len(m.ContractSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ScopeSpecification != nil {
+		{
+			size, err := m.ScopeSpecification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeSpecificationWrapper) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7933,30 +8107,44 @@ func (m *AccountDataRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.MetadataAddr.Size()
-		i -= size
-		if _, err := m.MetadataAddr.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.ScopeSpecIdInfo != nil {
+		{
+			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Specification != nil {
+		{
+			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountDataResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7966,27 +8154,56 @@ func (m *AccountDataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryScopeNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7996,27 +8213,62 @@ func (m *QueryScopeNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryScopeNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryScopeNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ScopeSpecifications) > 0 {
+		for iNdEx := len(m.ScopeSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryScopeNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
+func (m *ContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8026,1204 +8278,5936 @@ func (m *QueryScopeNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryScopeNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryScopeNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.IncludeRecordSpecs {
+		i--
+		if m.IncludeRecordSpecs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		n += 3
-	}
-	return n
+
+func (m *ContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
 	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *ScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeSessions {
-		n += 2
-	}
-	if m.IncludeRecords {
-		n += 2
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.ExcludeIdInfo {
-		n += 2
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.IncludeRequest {
-		n += 3
+	if m.ContractSpecification != nil {
+		{
+			size, err := m.ContractSpecification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Specification != nil {
+		{
+			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopeWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ScopeIdInfo != nil {
-		l = m.ScopeIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ScopeSpecIdInfo != nil {
-		l = m.ScopeSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ContractSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ScopesAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ContractSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ExcludeIdInfo {
-		n += 2
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	if m.IncludeRequest {
-		n += 3
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopesAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Scopes) > 0 {
-		for _, e := range m.Scopes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.ContractSpecifications) > 0 {
+		for iNdEx := len(m.ContractSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RecordName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeScope {
-		n += 2
-	}
-	if m.IncludeRecords {
-		n += 2
-	}
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
+func (m *RecordSpecificationsForContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SessionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordSpecificationsForContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationsForContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionWrapper) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordSpecificationsForContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationsForContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationsForContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Session != nil {
-		l = m.Session.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.SessionIdInfo != nil {
-		l = m.SessionIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractSpecificationUuid) > 0 {
+		i -= len(m.ContractSpecificationUuid)
+		copy(dAtA[i:], m.ContractSpecificationUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationUuid)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
 	if m.ExcludeIdInfo {
-		n += 2
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.IncludeRequest {
-		n += 3
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeScope {
-		n += 2
-	}
-	if m.IncludeSessions {
-		n += 2
-	}
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
-	}
-	return n
+func (m *RecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.RecordSpecification != nil {
+		{
+			size, err := m.RecordSpecification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Record != nil {
-		l = m.Record.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.RecordIdInfo != nil {
-		l = m.RecordIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.RecordSpecIdInfo != nil {
-		l = m.RecordSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *RecordSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *RecordSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.RecordSpecIdInfo != nil {
+		{
+			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.Specification != nil {
+		{
+			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OwnershipRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	if m.IncludeRequest {
-		n += 3
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.ScopeUuids) > 0 {
-		for _, s := range m.ScopeUuids {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ValueOwnershipRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeRequest {
-		n += 3
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *RecordSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValueOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeUuids) > 0 {
-		for _, s := range m.ScopeUuids {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpecificationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeContractSpecs {
-		n += 2
-	}
-	if m.IncludeRecordSpecs {
-		n += 2
-	}
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
+func (m *GetByAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ScopeSpecification != nil {
-		l = m.ScopeSpecification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.ContractSpecs) > 0 {
-		for _, e := range m.ContractSpecs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.RecordSpecs) > 0 {
-		for _, e := range m.RecordSpecs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Addrs) > 0 {
+		for iNdEx := len(m.Addrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addrs[iNdEx])
+			copy(dAtA[i:], m.Addrs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addrs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Specification != nil {
-		l = m.Specification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ScopeSpecIdInfo != nil {
-		l = m.ScopeSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *GetByAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *GetByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeSpecificationsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeSpecifications) > 0 {
-		for _, e := range m.ScopeSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.NotFound) > 0 {
+		for iNdEx := len(m.NotFound) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NotFound[iNdEx])
+			copy(dAtA[i:], m.NotFound[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.NotFound[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.RecordSpecs) > 0 {
+		for iNdEx := len(m.RecordSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.ContractSpecs) > 0 {
+		for iNdEx := len(m.ContractSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	return n
-}
-
-func (m *ContractSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpecificationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ScopeSpecs) > 0 {
+		for iNdEx := len(m.ScopeSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.IncludeRecordSpecs {
-		n += 2
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.ExcludeIdInfo {
-		n += 2
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.IncludeRequest {
-		n += 3
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OSLocatorParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecification != nil {
-		l = m.ContractSpecification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.RecordSpecifications) > 0 {
-		for _, e := range m.RecordSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationWrapper) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OSLocatorParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Specification != nil {
-		l = m.Specification.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ExcludeIdInfo {
-		n += 2
-	}
 	if m.IncludeRequest {
-		n += 3
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecifications) > 0 {
-		for _, e := range m.ContractSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.Locator != nil {
+		{
+			size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpecificationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
+func (m *OSLocatorsByURIRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OSLocatorsByURIRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByURIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RecordSpecifications) > 0 {
-		for _, e := range m.RecordSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	l = len(m.ContractSpecificationUuid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpecificationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
+func (m *OSLocatorsByURIResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OSLocatorsByURIResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RecordSpecification != nil {
-		l = m.RecordSpecification.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	return n
+	if len(m.Locators) > 0 {
+		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Specification != nil {
-		l = m.Specification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.RecordSpecIdInfo != nil {
-		l = m.RecordSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *OSLocatorsByScopeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OSLocatorsByScopeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ExcludeIdInfo {
-		n += 2
-	}
 	if m.IncludeRequest {
-		n += 3
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OSLocatorsByScopeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorsByScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RecordSpecifications) > 0 {
-		for _, e := range m.RecordSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	return n
-}
-
-func (m *GetByAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.DataAccessLocators) > 0 {
+		for iNdEx := len(m.DataAccessLocators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataAccessLocators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Addrs) > 0 {
-		for _, s := range m.Addrs {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Locators) > 0 {
+		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetByAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OSAllLocatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OSAllLocatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSAllLocatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Scopes) > 0 {
-		for _, e := range m.Scopes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if len(m.ScopeSpecs) > 0 {
-		for _, e := range m.ScopeSpecs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSAllLocatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ContractSpecs) > 0 {
-		for _, e := range m.ContractSpecs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *OSAllLocatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if len(m.RecordSpecs) > 0 {
-		for _, e := range m.RecordSpecs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if len(m.NotFound) > 0 {
-		for _, s := range m.NotFound {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Locators) > 0 {
+		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccountDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IncludeRequest {
-		n += 3
+	{
+		size := m.MetadataAddr.Size()
+		i -= size
+		if _, err := m.MetadataAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccountDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeRequest {
-		n += 3
+func (m *QueryScopeNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryScopeNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScopeNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Locator != nil {
-		l = m.Locator.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		n69, err69 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err69 != nil {
+			return 0, err69
+		}
+		i -= n69
+		i = encodeVarintQuery(dAtA, i, uint64(n69))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.StartTime != nil {
+		n70, err70 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err70 != nil {
+			return 0, err70
+		}
+		i -= n70
+		i = encodeVarintQuery(dAtA, i, uint64(n70))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *OSLocatorsByURIRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.History {
+		i--
+		if m.History {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.AsOf != nil {
+		n71, err71 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.AsOf, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AsOf):])
+		if err71 != nil {
+			return 0, err71
+		}
+		i -= n71
+		i = encodeVarintQuery(dAtA, i, uint64(n71))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.IncludeRequest {
-		n += 3
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByURIResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryScopeNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScopeNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScopeNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Locators) > 0 {
-		for _, e := range m.Locators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.MaxAge != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxAge))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScopeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.IncludeRequest {
-		n += 3
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScopeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Locators) > 0 {
-		for _, e := range m.Locators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.SessionVersions) > 0 {
+		for iNdEx := len(m.SessionVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.ScopeVersions) > 0 {
+		for iNdEx := len(m.ScopeVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OSAllLocatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IncludeRequest {
-		n += 3
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	if len(m.RecordVersions) > 0 {
+		for iNdEx := len(m.RecordVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OSAllLocatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScopeOwnershipTransfersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeOwnershipTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeOwnershipTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Locators) > 0 {
-		for _, e := range m.Locators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeOwnershipTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeOwnershipTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeOwnershipTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordCommitmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Leaf) > 0 {
+		i -= len(m.Leaf)
+		copy(dAtA[i:], m.Leaf)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Leaf)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordCommitmentProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordCommitmentProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordCommitmentProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aunts) > 0 {
+		for iNdEx := len(m.Aunts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aunts[iNdEx])
+			copy(dAtA[i:], m.Aunts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Aunts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LeafHash) > 0 {
+		i -= len(m.LeafHash)
+		copy(dAtA[i:], m.LeafHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LeafHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataAccess) > 0 {
+		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataAccess[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeDataAccessEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeDataAccessEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeDataAccessEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != nil {
+		n79, err79 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Remaining, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Remaining):])
+		if err79 != nil {
+			return 0, err79
+		}
+		i -= n79
+		i = encodeVarintQuery(dAtA, i, uint64(n79))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Expiration != nil {
+		n80, err80 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err80 != nil {
+			return 0, err80
+		}
+		i -= n80
+		i = encodeVarintQuery(dAtA, i, uint64(n80))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeTombstoneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeTombstoneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeTombstoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeTombstoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeTombstoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeTombstoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Tombstone.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeSessions {
+		n += 2
+	}
+	if m.IncludeRecords {
+		n += 2
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *ScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ScopeSpecIdInfo != nil {
+		l = m.ScopeSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopesAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopesAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeSpecId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OwnerRole != 0 {
+		n += 1 + sovQuery(uint64(m.OwnerRole))
+	}
+	l = len(m.ValueOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DataAccess)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NavDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinCreatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinCreatedHeight))
+	}
+	if m.MaxCreatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxCreatedHeight))
+	}
+	if m.MinUpdatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinUpdatedHeight))
+	}
+	if m.MaxUpdatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxUpdatedHeight))
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSearchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecordName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeScope {
+		n += 2
+	}
+	if m.IncludeRecords {
+		n += 2
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *SessionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Session != nil {
+		l = m.Session.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeScope {
+		n += 2
+	}
+	if m.IncludeSessions {
+		n += 2
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *RecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordIdInfo != nil {
+		l = m.RecordIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordSpecIdInfo != nil {
+		l = m.RecordSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OwnershipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeUuids) > 0 {
+		for _, s := range m.ScopeUuids {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValueOwnershipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValueOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeUuids) > 0 {
+		for _, s := range m.ScopeUuids {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeContractSpecs {
+		n += 2
+	}
+	if m.IncludeRecordSpecs {
+		n += 2
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *ScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeSpecification != nil {
+		l = m.ScopeSpecification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ContractSpecs) > 0 {
+		for _, e := range m.ContractSpecs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RecordSpecs) > 0 {
+		for _, e := range m.RecordSpecs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Specification != nil {
+		l = m.Specification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ScopeSpecIdInfo != nil {
+		l = m.ScopeSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeSpecifications) > 0 {
+		for _, e := range m.ScopeSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRecordSpecs {
+		n += 2
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *ContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecification != nil {
+		l = m.ContractSpecification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractSpecificationWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Specification != nil {
+		l = m.Specification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractSpecificationsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractSpecificationsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractSpecifications) > 0 {
+		for _, e := range m.ContractSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationsForContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *RecordSpecificationsForContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ContractSpecificationUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *RecordSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordSpecification != nil {
+		l = m.RecordSpecification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Specification != nil {
+		l = m.Specification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordSpecIdInfo != nil {
+		l = m.RecordSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetByAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetByAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ScopeSpecs) > 0 {
+		for _, e := range m.ScopeSpecs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ContractSpecs) > 0 {
+		for _, e := range m.ContractSpecs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RecordSpecs) > 0 {
+		for _, e := range m.RecordSpecs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NotFound) > 0 {
+		for _, s := range m.NotFound {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OSLocatorParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *OSLocatorParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *OSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Locator != nil {
+		l = m.Locator.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByURIRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByURIResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *OSLocatorsByScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DataAccessLocators) > 0 {
+		for _, e := range m.DataAccessLocators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSAllLocatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSAllLocatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MetadataAddr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AccountDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScopeNetAssetValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AsOf != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AsOf)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.History {
+		n += 2
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScopeNetAssetValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxAge != 0 {
+		n += 1 + sovQuery(uint64(m.MaxAge))
+	}
+	return n
+}
+
+func (m *ScopeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeVersions) > 0 {
+		for _, e := range m.ScopeVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SessionVersions) > 0 {
+		for _, e := range m.SessionVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecordVersions) > 0 {
+		for _, e := range m.RecordVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeOwnershipTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeOwnershipTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Leaf)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordCommitmentProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = len(m.LeafHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Aunts) > 0 {
+		for _, b := range m.Aunts {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScopeDataAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeDataAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DataAccess) > 0 {
+		for _, e := range m.DataAccess {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScopeDataAccessEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Remaining != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Remaining)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeTombstoneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeTombstoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tombstone.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &QueryParamsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSessions = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRecords = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &ScopeWrapper{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ScopeRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &Scope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
+			}
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeSpecIdInfo == nil {
+				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
+			}
+			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopesAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopesAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopesAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopesAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, &ScopeWrapper{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ScopesAllRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeSearchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeSearchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeSpecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerRole", wireType)
+			}
+			m.OwnerRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerRole |= PartyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NavDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCreatedHeight", wireType)
+			}
+			m.MinCreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCreatedHeight", wireType)
+			}
+			m.MaxCreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUpdatedHeight", wireType)
+			}
+			m.MinUpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUpdatedHeight", wireType)
+			}
+			m.MaxUpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeSearchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeSearchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, &ScopeWrapper{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ScopeSearchRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeScope = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRecords = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &ScopeWrapper{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &SessionsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Session == nil {
+				m.Session = &Session{}
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdInfo == nil {
+				m.SessionIdInfo = &SessionIdInfo{}
+			}
+			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractSpecIdInfo == nil {
+				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
+			}
+			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *AccountDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.MetadataAddr.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *AccountDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryScopeNetAssetValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryScopeNetAssetValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for _, e := range m.NetAssetValues {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9246,12 +14230,32 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionsAllRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeIdInfo = bool(v != 0)
 		case 98:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
@@ -9272,6 +14276,42 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IncludeRequest = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9293,7 +14333,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9316,15 +14356,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9351,13 +14391,50 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &SessionsAllRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9384,10 +14461,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &QueryParamsRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9412,7 +14489,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9435,15 +14512,15 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9471,11 +14548,11 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9503,11 +14580,11 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9535,11 +14612,43 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScope", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9556,10 +14665,10 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeSessions = bool(v != 0)
+			m.IncludeScope = bool(v != 0)
 		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9576,7 +14685,7 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeRecords = bool(v != 0)
+			m.IncludeSessions = bool(v != 0)
 		case 12:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
@@ -9638,7 +14747,7 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9661,10 +14770,10 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9801,7 +14910,7 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &ScopeRequest{}
+				m.Request = &RecordsRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9828,7 +14937,7 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
+func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9851,15 +14960,15 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordWrapper: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9886,16 +14995,16 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scope == nil {
-				m.Scope = &Scope{}
+			if m.Record == nil {
+				m.Record = &Record{}
 			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9922,16 +15031,16 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeIdInfo == nil {
-				m.ScopeIdInfo = &ScopeIdInfo{}
+			if m.RecordIdInfo == nil {
+				m.RecordIdInfo = &RecordIdInfo{}
 			}
-			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9958,10 +15067,10 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeSpecIdInfo == nil {
-				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
+			if m.RecordSpecIdInfo == nil {
+				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
 			}
-			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9986,7 +15095,7 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10009,10 +15118,10 @@ func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopesAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordsAllRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopesAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 12:
@@ -10112,7 +15221,7 @@ func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10135,15 +15244,15 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopesAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopesAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10170,8 +15279,8 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, &ScopeWrapper{})
-			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10205,12 +15314,150 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &ScopesAllRequest{}
+				m.Request = &RecordsAllRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnershipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -10241,7 +15488,7 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10268,7 +15515,7 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
+func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10291,15 +15538,15 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10327,13 +15574,13 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10343,61 +15590,33 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Request == nil {
+				m.Request = &OwnershipRequest{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10407,104 +15626,28 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScope", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeScope = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeRecords = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ExcludeIdInfo = bool(v != 0)
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IncludeRequest = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10526,7 +15669,7 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
+func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10549,53 +15692,17 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValueOwnershipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValueOwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Scope == nil {
-				m.Scope = &ScopeWrapper{}
-			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10605,31 +15712,29 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &SessionWrapper{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10639,29 +15744,15 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 98:
+			m.IncludeRequest = bool(v != 0)
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10688,10 +15779,10 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &SessionsRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10716,7 +15807,7 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
+func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10739,17 +15830,17 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValueOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValueOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10759,31 +15850,27 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Session == nil {
-				m.Session = &Session{}
-			}
-			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10810,16 +15897,16 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdInfo == nil {
-				m.SessionIdInfo = &SessionIdInfo{}
+			if m.Request == nil {
+				m.Request = &ValueOwnershipRequest{}
 			}
-			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10846,10 +15933,10 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractSpecIdInfo == nil {
-				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10874,7 +15961,7 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10897,15 +15984,47 @@ func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 12:
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeContractSpecs", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10922,10 +16041,10 @@ func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ExcludeIdInfo = bool(v != 0)
-		case 98:
+			m.IncludeContractSpecs = bool(v != 0)
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecordSpecs", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10942,12 +16061,12 @@ func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			m.IncludeRecordSpecs = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10957,28 +16076,32 @@ func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.IncludeRequest = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11000,7 +16123,7 @@ func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11023,15 +16146,15 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11058,14 +16181,16 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &SessionWrapper{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ScopeSpecification == nil {
+				m.ScopeSpecification = &ScopeSpecificationWrapper{}
+			}
+			if err := m.ScopeSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11092,16 +16217,48 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &SessionsAllRequest{}
+			m.ContractSpecs = append(m.ContractSpecs, &ContractSpecificationWrapper{})
+			if err := m.ContractSpecs[len(m.ContractSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordSpecs = append(m.RecordSpecs, &RecordSpecificationWrapper{})
+			if err := m.RecordSpecs[len(m.RecordSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11128,10 +16285,10 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Request == nil {
+				m.Request = &ScopeSpecificationRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11156,7 +16313,7 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11171,57 +16328,25 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeSpecificationWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11231,29 +16356,33 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			if m.Specification == nil {
+				m.Specification = &ScopeSpecification{}
+			}
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11263,79 +16392,81 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if m.ScopeSpecIdInfo == nil {
+				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScope", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.IncludeScope = bool(v != 0)
-		case 11:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeSpecificationsAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11352,10 +16483,10 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeSessions = bool(v != 0)
-		case 12:
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11372,12 +16503,12 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ExcludeIdInfo = bool(v != 0)
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			m.IncludeRequest = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11387,12 +16518,28 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11414,7 +16561,7 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11437,15 +16584,15 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeSpecificationsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11472,16 +16619,14 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scope == nil {
-				m.Scope = &ScopeWrapper{}
-			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScopeSpecifications = append(m.ScopeSpecifications, &ScopeSpecificationWrapper{})
+			if err := m.ScopeSpecifications[len(m.ScopeSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11508,48 +16653,16 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &SessionWrapper{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Request == nil {
+				m.Request = &ScopeSpecificationsAllRequest{}
 			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11576,10 +16689,10 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &RecordsRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11604,7 +16717,7 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
+func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11627,17 +16740,17 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11647,33 +16760,29 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Record == nil {
-				m.Record = &Record{}
-			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecordSpecs", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11683,33 +16792,17 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RecordIdInfo == nil {
-				m.RecordIdInfo = &RecordIdInfo{}
-			}
-			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
+			m.IncludeRecordSpecs = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11719,28 +16812,32 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RecordSpecIdInfo == nil {
-				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.IncludeRequest = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11762,7 +16859,7 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11785,17 +16882,17 @@ func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecification", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11805,17 +16902,33 @@ func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ExcludeIdInfo = bool(v != 0)
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractSpecification == nil {
+				m.ContractSpecification = &ContractSpecificationWrapper{}
+			}
+			if err := m.ContractSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11825,15 +16938,29 @@ func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
-		case 99:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
+			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11860,10 +16987,10 @@ func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Request == nil {
+				m.Request = &ContractSpecificationRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11888,7 +17015,7 @@ func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11911,49 +17038,15 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSpecificationWrapper: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 98:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11980,16 +17073,16 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &RecordsAllRequest{}
+			if m.Specification == nil {
+				m.Specification = &ContractSpecification{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12016,10 +17109,10 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.ContractSpecIdInfo == nil {
+				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12044,7 +17137,7 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
+func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12067,17 +17160,17 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnershipRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSpecificationsAllRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12087,24 +17180,12 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ExcludeIdInfo = bool(v != 0)
 		case 98:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
@@ -12182,7 +17263,7 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12205,17 +17286,17 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSpecificationsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecifications", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12225,23 +17306,25 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
+			m.ContractSpecifications = append(m.ContractSpecifications, &ContractSpecificationWrapper{})
+			if err := m.ContractSpecifications[len(m.ContractSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 98:
 			if wireType != 2 {
@@ -12273,7 +17356,7 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &OwnershipRequest{}
+				m.Request = &ContractSpecificationsAllRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -12336,7 +17419,7 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12359,15 +17442,15 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueOwnershipRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueOwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12395,11 +17478,11 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 98:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12416,12 +17499,12 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12431,28 +17514,12 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IncludeRequest = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12474,7 +17541,7 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12497,15 +17564,49 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
+			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12533,13 +17634,13 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
+			m.ContractSpecificationUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 98:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12549,31 +17650,27 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &ValueOwnershipRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 99:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12600,10 +17697,10 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Request == nil {
+				m.Request = &RecordSpecificationsForContractSpecificationRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12628,7 +17725,7 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12651,10 +17748,10 @@ func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12689,11 +17786,11 @@ func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.SpecificationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeContractSpecs", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12703,32 +17800,24 @@ func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeContractSpecs = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecordSpecs", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.IncludeRecordSpecs = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 12:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
@@ -12790,7 +17879,7 @@ func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12813,85 +17902,15 @@ func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationResponse: wiretype end group for non-group")
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecification", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ScopeSpecification == nil {
-				m.ScopeSpecification = &ScopeSpecificationWrapper{}
-			}
-			if err := m.ScopeSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractSpecs = append(m.ContractSpecs, &ContractSpecificationWrapper{})
-			if err := m.ContractSpecs[len(m.ContractSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12918,8 +17937,10 @@ func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecs = append(m.RecordSpecs, &RecordSpecificationWrapper{})
-			if err := m.RecordSpecs[len(m.RecordSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RecordSpecification == nil {
+				m.RecordSpecification = &RecordSpecificationWrapper{}
+			}
+			if err := m.RecordSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12953,7 +17974,7 @@ func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &ScopeSpecificationRequest{}
+				m.Request = &RecordSpecificationRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -12980,7 +18001,7 @@ func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13003,10 +18024,10 @@ func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationWrapper: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13039,7 +18060,7 @@ func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Specification == nil {
-				m.Specification = &ScopeSpecification{}
+				m.Specification = &RecordSpecification{}
 			}
 			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13047,7 +18068,7 @@ func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13074,10 +18095,10 @@ func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeSpecIdInfo == nil {
-				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
+			if m.RecordSpecIdInfo == nil {
+				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
 			}
-			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13102,7 +18123,7 @@ func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13125,10 +18146,10 @@ func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationsAllRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 12:
@@ -13228,7 +18249,7 @@ func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13251,15 +18272,15 @@ func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecifications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13286,8 +18307,8 @@ func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecifications = append(m.ScopeSpecifications, &ScopeSpecificationWrapper{})
-			if err := m.ScopeSpecifications[len(m.ScopeSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
+			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13321,7 +18342,7 @@ func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &ScopeSpecificationsAllRequest{}
+				m.Request = &RecordSpecificationsAllRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13384,7 +18405,7 @@ func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *GetByAddrRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13407,15 +18428,15 @@ func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetByAddrRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13443,68 +18464,8 @@ func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecordSpecs", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeRecordSpecs = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ExcludeIdInfo = bool(v != 0)
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeRequest = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -13526,7 +18487,7 @@ func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13549,15 +18510,15 @@ func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetByAddrResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13584,16 +18545,14 @@ func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractSpecification == nil {
-				m.ContractSpecification = &ContractSpecificationWrapper{}
-			}
-			if err := m.ContractSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Scopes = append(m.Scopes, &Scope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13620,14 +18579,14 @@ func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
-			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sessions = append(m.Sessions, &Session{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13654,66 +18613,82 @@ func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &ContractSpecificationRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecs", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.ScopeSpecs = append(m.ScopeSpecs, &ScopeSpecification{})
+			if err := m.ScopeSpecs[len(m.ScopeSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ContractSpecs = append(m.ContractSpecs, &ContractSpecification{})
+			if err := m.ContractSpecs[len(m.ContractSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationWrapper: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13740,18 +18715,16 @@ func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Specification == nil {
-				m.Specification = &ContractSpecification{}
-			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecordSpecs = append(m.RecordSpecs, &RecordSpecification{})
+			if err := m.RecordSpecs[len(m.RecordSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotFound", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13761,27 +18734,23 @@ func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractSpecIdInfo == nil {
-				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
-			}
-			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NotFound = append(m.NotFound, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13804,7 +18773,7 @@ func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13827,32 +18796,12 @@ func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ExcludeIdInfo = bool(v != 0)
 		case 98:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
@@ -13873,42 +18822,6 @@ func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IncludeRequest = bool(v != 0)
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -13930,7 +18843,7 @@ func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13953,15 +18866,15 @@ func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecifications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13988,8 +18901,7 @@ func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecifications = append(m.ContractSpecifications, &ContractSpecificationWrapper{})
-			if err := m.ContractSpecifications[len(m.ContractSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14023,48 +18935,12 @@ func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &ContractSpecificationsAllRequest{}
+				m.Request = &OSLocatorParamsRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -14086,7 +18962,7 @@ func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14109,15 +18985,15 @@ func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []b
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14145,28 +19021,8 @@ func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []b
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ExcludeIdInfo = bool(v != 0)
 		case 98:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
@@ -14208,7 +19064,7 @@ func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []b
 	}
 	return nil
 }
-func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14231,15 +19087,15 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14266,16 +19122,18 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
-			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Locator == nil {
+				m.Locator = &ObjectStoreLocator{}
+			}
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14285,27 +19143,81 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationUuid = string(dAtA[iNdEx:postIndex])
+			if m.Request == nil {
+				m.Request = &OSLocatorRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSLocatorsByURIRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSLocatorsByURIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14333,11 +19245,31 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.Uri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14364,10 +19296,10 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &RecordSpecificationsForContractSpecificationRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14392,7 +19324,7 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []
 	}
 	return nil
 }
-func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14415,17 +19347,17 @@ func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorsByURIResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorsByURIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14435,29 +19367,31 @@ func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			m.Locators = append(m.Locators, ObjectStoreLocator{})
+			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14467,29 +19401,33 @@ func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Request == nil {
+				m.Request = &OSLocatorsByURIRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14499,32 +19437,28 @@ func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ExcludeIdInfo = bool(v != 0)
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.IncludeRequest = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -14546,7 +19480,7 @@ func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorsByScopeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14569,17 +19503,17 @@ func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorsByScopeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorsByScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14589,33 +19523,29 @@ func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecordSpecification == nil {
-				m.RecordSpecification = &RecordSpecificationWrapper{}
-			}
-			if err := m.RecordSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 98:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14625,28 +19555,12 @@ func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &RecordSpecificationRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IncludeRequest = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -14668,7 +19582,7 @@ func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14691,15 +19605,15 @@ func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorsByScopeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorsByScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14726,16 +19640,14 @@ func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Specification == nil {
-				m.Specification = &RecordSpecification{}
-			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Locators = append(m.Locators, ObjectStoreLocator{})
+			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccessLocators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14762,10 +19674,44 @@ func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecordSpecIdInfo == nil {
-				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
+			m.DataAccessLocators = append(m.DataAccessLocators, ObjectStoreLocator{})
+			if err := m.DataAccessLocators[len(m.DataAccessLocators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &OSLocatorsByScopeRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14790,7 +19736,7 @@ func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *OSAllLocatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14803,42 +19749,22 @@ func (m *RecordSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationsAllRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ExcludeIdInfo = bool(v != 0)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSAllLocatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSAllLocatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 98:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
@@ -14916,7 +19842,7 @@ func (m *RecordSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14939,15 +19865,15 @@ func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSAllLocatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSAllLocatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14974,8 +19900,8 @@ func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
-			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Locators = append(m.Locators, ObjectStoreLocator{})
+			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15009,7 +19935,7 @@ func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &RecordSpecificationsAllRequest{}
+				m.Request = &OSAllLocatorsRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -15072,7 +19998,7 @@ func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetByAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *AccountDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15095,15 +20021,98 @@ func (m *GetByAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetByAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MetadataAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15131,7 +20140,7 @@ func (m *GetByAddrRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15154,7 +20163,7 @@ func (m *GetByAddrRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScopeNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15177,17 +20186,17 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetByAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScopeNetAssetValuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScopeNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15197,29 +20206,59 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, &Scope{})
-			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsOf", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15246,14 +20285,36 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &Session{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AsOf == nil {
+				m.AsOf = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.AsOf, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.History = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15280,14 +20341,16 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &Record{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15314,14 +20377,66 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecs = append(m.ScopeSpecs, &ScopeSpecification{})
-			if err := m.ScopeSpecs[len(m.ScopeSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScopeNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScopeNetAssetValuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScopeNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15348,14 +20463,14 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecs = append(m.ContractSpecs, &ContractSpecification{})
-			if err := m.ContractSpecs[len(m.ContractSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15382,16 +20497,16 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecs = append(m.RecordSpecs, &RecordSpecification{})
-			if err := m.RecordSpecs[len(m.RecordSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.History = append(m.History, NetAssetValueRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotFound", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
-			var stringLen uint64
+			m.MaxAge = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15401,24 +20516,11 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxAge |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NotFound = append(m.NotFound, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -15440,7 +20542,7 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15463,17 +20565,17 @@ func (m *OSLocatorParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15483,12 +20585,92 @@ func (m *OSLocatorParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -15510,7 +20692,7 @@ func (m *OSLocatorParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15533,15 +20715,15 @@ func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeVersions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15568,13 +20750,14 @@ func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScopeVersions = append(m.ScopeVersions, ScopeVersion{})
+			if err := m.ScopeVersions[len(m.ScopeVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionVersions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15601,10 +20784,44 @@ func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &OSLocatorParamsRequest{}
+			m.SessionVersions = append(m.SessionVersions, SessionVersion{})
+			if err := m.SessionVersions[len(m.SessionVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15629,7 +20846,7 @@ func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15652,15 +20869,15 @@ func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15688,13 +20905,77 @@ func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var v int
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15704,12 +20985,28 @@ func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -15731,7 +21028,7 @@ func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15754,15 +21051,15 @@ func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordVersions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15789,16 +21086,14 @@ func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Locator == nil {
-				m.Locator = &ObjectStoreLocator{}
-			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecordVersions = append(m.RecordVersions, RecordVersion{})
+			if err := m.RecordVersions[len(m.RecordVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15825,10 +21120,10 @@ func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &OSLocatorRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15853,7 +21148,7 @@ func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeOwnershipTransfersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15876,15 +21171,15 @@ func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorsByURIRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeOwnershipTransfersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorsByURIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeOwnershipTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15912,13 +21207,13 @@ func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15928,12 +21223,24 @@ func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -15991,7 +21298,7 @@ func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeOwnershipTransfersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16014,49 +21321,15 @@ func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorsByURIResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeOwnershipTransfersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorsByURIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeOwnershipTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Locators = append(m.Locators, ObjectStoreLocator{})
-			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 98:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16083,10 +21356,8 @@ func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &OSLocatorsByURIRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Transfers = append(m.Transfers, ScopeOwnershipTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16147,7 +21418,7 @@ func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorsByScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordCommitmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16170,15 +21441,15 @@ func (m *OSLocatorsByScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorsByScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordCommitmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorsByScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16206,83 +21477,13 @@ func (m *OSLocatorsByScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeRequest = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorsByScopeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorsByScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16292,31 +21493,29 @@ func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locators = append(m.Locators, ObjectStoreLocator{})
-			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 98:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16326,27 +21525,23 @@ func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &OSLocatorsByScopeRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16369,7 +21564,7 @@ func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSAllLocatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordCommitmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16392,17 +21587,51 @@ func (m *OSAllLocatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSAllLocatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordCommitmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSAllLocatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16412,15 +21641,29 @@ func (m *OSAllLocatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
-		case 99:
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leaf = append(m.Leaf[:0], dAtA[iNdEx:postIndex]...)
+			if m.Leaf == nil {
+				m.Leaf = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16447,10 +21690,10 @@ func (m *OSAllLocatorsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Proof == nil {
+				m.Proof = &RecordCommitmentProof{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16475,7 +21718,7 @@ func (m *OSAllLocatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordCommitmentProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16498,17 +21741,55 @@ func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSAllLocatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordCommitmentProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSAllLocatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordCommitmentProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16518,31 +21799,31 @@ func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locators = append(m.Locators, ObjectStoreLocator{})
-			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LeafHash = append(m.LeafHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LeafHash == nil {
+				m.LeafHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 98:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aunts", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16552,33 +21833,79 @@ func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &OSAllLocatorsRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Aunts = append(m.Aunts, make([]byte, postIndex-iNdEx))
+			copy(m.Aunts[len(m.Aunts)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 99:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeDataAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16588,27 +21915,23 @@ func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16631,7 +21954,7 @@ func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountDataRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16654,17 +21977,17 @@ func (m *AccountDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeDataAccessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16674,22 +21997,23 @@ func (m *AccountDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MetadataAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DataAccess = append(m.DataAccess, ScopeDataAccessEntry{})
+			if err := m.DataAccess[len(m.DataAccess)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16714,7 +22038,7 @@ func (m *AccountDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountDataResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeDataAccessEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16737,15 +22061,15 @@ func (m *AccountDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeDataAccessEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeDataAccessEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16773,7 +22097,79 @@ func (m *AccountDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Remaining == nil {
+				m.Remaining = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Remaining, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16796,7 +22192,7 @@ func (m *AccountDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScopeNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeTombstoneRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16819,15 +22215,15 @@ func (m *QueryScopeNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScopeNetAssetValuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeTombstoneRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScopeNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeTombstoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16855,7 +22251,7 @@ func (m *QueryScopeNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16878,7 +22274,7 @@ func (m *QueryScopeNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScopeNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeTombstoneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16901,15 +22297,15 @@ func (m *QueryScopeNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScopeNetAssetValuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeTombstoneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScopeNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeTombstoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16936,8 +22332,7 @@ func (m *QueryScopeNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
-			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tombstone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+)
+
+// RecordCommitmentLeaf gets the data that a scope's records root commits to for the provided record:
+// its name followed by each of its output hashes, each preceded by its length as a 4-byte big-endian number.
+func RecordCommitmentLeaf(record Record) []byte {
+	parts := make([]string, 0, 1+len(record.Outputs))
+	parts = append(parts, record.Name)
+	for _, output := range record.Outputs {
+		parts = append(parts, output.Hash)
+	}
+
+	var rv []byte
+	for _, part := range parts {
+		rv = binary.BigEndian.AppendUint32(rv, uint32(len(part))) //nolint:gosec // G115: names and hashes are much shorter than 4GB.
+		rv = append(rv, part...)
+	}
+	return rv
+}
+
+// NewRecordCommitmentProof creates a RecordCommitmentProof from a merkle proof.
+func NewRecordCommitmentProof(proof *merkle.Proof) *RecordCommitmentProof {
+	return &RecordCommitmentProof{
+		Total:    proof.Total,
+		Index:    proof.Index,
+		LeafHash: proof.LeafHash,
+		Aunts:    proof.Aunts,
+	}
+}
+
+// Verify returns an error if this proof does not show that the provided leaf is included in the provided root.
+func (p RecordCommitmentProof) Verify(root, leaf []byte) error {
+	proof := merkle.Proof{
+		Total:    p.Total,
+		Index:    p.Index,
+		LeafHash: p.LeafHash,
+		Aunts:    p.Aunts,
+	}
+	return proof.Verify(root, leaf)
+}
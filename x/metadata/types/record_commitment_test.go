@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordCommitmentLeaf(t *testing.T) {
+	record := Record{
+		Name: "terms",
+		Outputs: []RecordOutput{
+			{Hash: "ab"},
+			{Hash: ""},
+		},
+	}
+	expected := []byte{0, 0, 0, 5, 't', 'e', 'r', 'm', 's', 0, 0, 0, 2, 'a', 'b', 0, 0, 0, 0}
+	assert.Equal(t, expected, RecordCommitmentLeaf(record), "RecordCommitmentLeaf")
+
+	// Without length prefixes, these two records would have the same leaf.
+	recordA := Record{Name: "ab", Outputs: []RecordOutput{{Hash: "c"}}}
+	recordB := Record{Name: "a", Outputs: []RecordOutput{{Hash: "bc"}}}
+	assert.NotEqual(t, RecordCommitmentLeaf(recordA), RecordCommitmentLeaf(recordB), "leaves of records with the same concatenation")
+}
+
+func TestRecordCommitmentProofVerify(t *testing.T) {
+	leaves := [][]byte{[]byte("one"), []byte("two"), []byte("three")}
+	root, proofs := merkle.ProofsFromByteSlices(leaves)
+
+	for i, leaf := range leaves {
+		proof := NewRecordCommitmentProof(proofs[i])
+		require.NoError(t, proof.Verify(root, leaf), "Verify leaf %d", i)
+		assert.Error(t, proof.Verify(root, []byte("four")), "Verify leaf %d proof with another leaf", i)
+	}
+
+	proof := NewRecordCommitmentProof(proofs[0])
+	assert.Error(t, proof.Verify(merkle.HashFromByteSlices(leaves[:2]), leaves[0]), "Verify with another root")
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"slices"
@@ -14,7 +16,9 @@ import (
 const (
 	// A sane default for maximum length of an audit message string (memo)
 	maxAuditMessageLength = 200
-	UsdDenom              = "usd"
+	// Default max length (in bytes) of a RecordOutput.Value
+	maxRecordOutputValueLength = 5000
+	UsdDenom                   = "usd"
 )
 
 // NewScope creates a new instance.
@@ -354,6 +358,13 @@ func NewRecordOutput(hash string, status ResultStatus) *RecordOutput {
 
 // ValidateBasic performs a static check over the record output format
 func (ro RecordOutput) ValidateBasic() error {
+	if len(ro.Value) > maxRecordOutputValueLength {
+		return fmt.Errorf("record output value exceeds maximum length (expected <= %d got: %d)",
+			maxRecordOutputValueLength, len(ro.Value))
+	}
+	if len(ro.Value) > 0 && !json.Valid([]byte(ro.Value)) {
+		return fmt.Errorf("invalid record output value, value is not valid JSON")
+	}
 	if ro.Status == ResultStatus_RESULT_STATUS_SKIP {
 		return nil
 	}
@@ -584,3 +595,96 @@ func NewNetAssetValue(price sdk.Coin, volume uint64) NetAssetValue {
 func (mnav *NetAssetValue) Validate() error {
 	return mnav.Price.Validate()
 }
+
+// IsStale returns true if the net asset value is more than maxAge blocks old at the provided height.
+// A max age of zero means that net asset values are never stale.
+func (mnav *NetAssetValue) IsStale(maxAge uint64, height int64) bool {
+	return provutils.IsNavStale(mnav.UpdatedBlockHeight, maxAge, height)
+}
+
+// Validate returns an error if this NetAssetValueRecord is not in a valid state.
+func (r NetAssetValueRecord) Validate() error {
+	if err := r.NetAssetValue.Validate(); err != nil {
+		return fmt.Errorf("invalid net asset value record: %w", err)
+	}
+	return nil
+}
+
+// Validate returns an error if these ScopeHeights are not valid.
+func (h ScopeHeights) Validate() error {
+	if err := h.ScopeId.ValidateIsScopeAddress(); err != nil {
+		return fmt.Errorf("invalid scope heights scope id: %w", err)
+	}
+	if h.CreatedHeight < 0 {
+		return fmt.Errorf("scope %s created height %d cannot be negative", h.ScopeId, h.CreatedHeight)
+	}
+	if h.UpdatedHeight < h.CreatedHeight {
+		return fmt.Errorf("scope %s updated height %d cannot be less than its created height %d",
+			h.ScopeId, h.UpdatedHeight, h.CreatedHeight)
+	}
+	return nil
+}
+
+// MaxScopeDataAccessDuration is the longest that expiring data access to a scope can be granted for.
+const MaxScopeDataAccessDuration = 365 * 24 * time.Hour
+
+// NewScopeDataAccessGrant creates a new ScopeDataAccessGrant instance.
+func NewScopeDataAccessGrant(scopeID MetadataAddress, addr string, expiration time.Time) ScopeDataAccessGrant {
+	return ScopeDataAccessGrant{
+		ScopeId:    scopeID,
+		Address:    addr,
+		Expiration: expiration,
+	}
+}
+
+// IsExpired returns true if this data access has lapsed at the provided block time.
+func (g ScopeDataAccessGrant) IsExpired(blockTime time.Time) bool {
+	return blockTime.After(g.Expiration)
+}
+
+// Validate returns an error if this ScopeDataAccessGrant is not in a valid state.
+func (g ScopeDataAccessGrant) Validate() error {
+	if err := g.ScopeId.ValidateIsScopeAddress(); err != nil {
+		return fmt.Errorf("invalid scope data access grant scope id: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Address); err != nil {
+		return fmt.Errorf("invalid scope %s data access grant address %q: %w", g.ScopeId, g.Address, err)
+	}
+	if g.Expiration.IsZero() {
+		return fmt.Errorf("scope %s data access grant expiration cannot be zero", g.ScopeId)
+	}
+	return nil
+}
+
+// Hash returns the SHA-256 hash of the protobuf encoding of this ScopeArchive.
+func (a ScopeArchive) Hash() ([]byte, error) {
+	bz, err := a.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal scope %s archive: %w", a.Scope.ScopeId, err)
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+// NewScopeTombstone creates a new ScopeTombstone instance.
+func NewScopeTombstone(scopeID MetadataAddress, archiveHash []byte, change EntryChange) ScopeTombstone {
+	return ScopeTombstone{
+		ScopeId:     scopeID,
+		ArchiveHash: archiveHash,
+		Change:      change,
+	}
+}
+
+// Validate returns an error if this ScopeTombstone is not in a valid state.
+func (t ScopeTombstone) Validate() error {
+	if err := t.ScopeId.ValidateIsScopeAddress(); err != nil {
+		return fmt.Errorf("invalid scope tombstone scope id: %w", err)
+	}
+	if len(t.ArchiveHash) != sha256.Size {
+		return fmt.Errorf("scope %s tombstone archive hash length %d must be %d", t.ScopeId, len(t.ArchiveHash), sha256.Size)
+	}
+	if err := t.Change.Validate(); err != nil {
+		return fmt.Errorf("invalid scope %s tombstone change: %w", t.ScopeId, err)
+	}
+	return nil
+}
@@ -562,6 +562,9 @@ type RecordOutput struct {
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	// Status of the process execution associated with this output indicating success,failure, or pending
 	Status ResultStatus `protobuf:"varint,2,opt,name=status,proto3,enum=provenance.metadata.v1.ResultStatus" json:"status,omitempty"`
+	// value is an optional JSON value of the output, e.g. a status or amount. It can be at most 5000 bytes.
+	// If the record's specification has an output_schema, the value is required and must conform to it.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
 func (m *RecordOutput) Reset()      { *m = RecordOutput{} }
@@ -610,6 +613,13 @@ func (m *RecordOutput) GetStatus() ResultStatus {
 	return ResultStatus_RESULT_STATUS_UNSPECIFIED
 }
 
+func (m *RecordOutput) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 // A Party is an address with/in a given role associated with a contract
 type Party struct {
 	// address of the account (on chain)
@@ -774,6 +784,9 @@ type NetAssetValue struct {
 	// Typically this will be null (equivalent to one) or one.  The only reason this would be more than
 	// one is for cases where the precision of the price denom is insufficient to represent the actual price
 	Volume uint64 `protobuf:"varint,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	// stale is true if the net asset value is older than the scope's maximum net asset value age.
+	// It is only populated when a net asset value is read, and is never stored.
+	Stale bool `protobuf:"varint,4,opt,name=stale,proto3" json:"stale,omitempty"`
 }
 
 func (m *NetAssetValue) Reset()         { *m = NetAssetValue{} }
@@ -830,595 +843,830 @@ func (m *NetAssetValue) GetVolume() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("provenance.metadata.v1.RecordInputStatus", RecordInputStatus_name, RecordInputStatus_value)
-	proto.RegisterEnum("provenance.metadata.v1.ResultStatus", ResultStatus_name, ResultStatus_value)
-	proto.RegisterType((*Scope)(nil), "provenance.metadata.v1.Scope")
-	proto.RegisterType((*Session)(nil), "provenance.metadata.v1.Session")
-	proto.RegisterType((*Record)(nil), "provenance.metadata.v1.Record")
-	proto.RegisterType((*Process)(nil), "provenance.metadata.v1.Process")
-	proto.RegisterType((*RecordInput)(nil), "provenance.metadata.v1.RecordInput")
-	proto.RegisterType((*RecordOutput)(nil), "provenance.metadata.v1.RecordOutput")
-	proto.RegisterType((*Party)(nil), "provenance.metadata.v1.Party")
-	proto.RegisterType((*AuditFields)(nil), "provenance.metadata.v1.AuditFields")
-	proto.RegisterType((*NetAssetValue)(nil), "provenance.metadata.v1.NetAssetValue")
+func (m *NetAssetValue) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
 }
 
-func init() {
-	proto.RegisterFile("provenance/metadata/v1/scope.proto", fileDescriptor_edeea634bfb18aba)
+// NetAssetValueRecord is an entry in the history of a scope's net asset values.
+type NetAssetValueRecord struct {
+	// net_asset_value is the net asset value that was set.
+	NetAssetValue NetAssetValue `protobuf:"bytes,1,opt,name=net_asset_value,json=netAssetValue,proto3" json:"net_asset_value"`
+	// block_time is the time of the block in which the net asset value was set.
+	BlockTime time.Time `protobuf:"bytes,2,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// source is where the net asset value came from, e.g. an exchange market or a module.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 }
 
-var fileDescriptor_edeea634bfb18aba = []byte{
-	// 1138 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xfa, 0xbf, 0x9f, 0x5d, 0xea, 0x4e, 0xab, 0xe2, 0x1a, 0x6a, 0x1b, 0xc3, 0xc1, 0x44,
-	0x62, 0xdd, 0x18, 0x8a, 0x44, 0x01, 0x21, 0xbb, 0x49, 0xa9, 0x45, 0x49, 0xac, 0x71, 0xc2, 0x81,
-	0xcb, 0x6a, 0xbd, 0x3b, 0xb5, 0x57, 0xb1, 0x77, 0x96, 0x9d, 0x59, 0xb7, 0x86, 0x0b, 0xe7, 0x9c,
-	0xc2, 0x8d, 0x4b, 0x24, 0xf8, 0x14, 0x7c, 0x85, 0x72, 0xeb, 0x11, 0x01, 0x2a, 0x28, 0xb9, 0xf2,
-	0x21, 0xd0, 0xcc, 0xce, 0xfa, 0x0f, 0x71, 0xa2, 0x44, 0xe2, 0xb6, 0xef, 0xbd, 0xdf, 0x9b, 0xf7,
-	0xde, 0xef, 0xbd, 0x37, 0xb3, 0x50, 0xf7, 0x7c, 0x3a, 0x25, 0xae, 0xe9, 0x5a, 0xa4, 0x39, 0x21,
-	0xdc, 0xb4, 0x4d, 0x6e, 0x36, 0xa7, 0x9b, 0x4d, 0x66, 0x51, 0x8f, 0xe8, 0x9e, 0x4f, 0x39, 0x45,
-	0xb7, 0x17, 0x18, 0x3d, 0xc2, 0xe8, 0xd3, 0xcd, 0x72, 0xc5, 0xa2, 0x6c, 0x42, 0x59, 0x73, 0x60,
-	0x32, 0xd2, 0x9c, 0x6e, 0x0e, 0x08, 0x37, 0x37, 0x9b, 0x16, 0x75, 0xdc, 0xd0, 0xaf, 0x7c, 0x6b,
-	0x48, 0x87, 0x54, 0x7e, 0x36, 0xc5, 0x97, 0xd2, 0x56, 0x87, 0x94, 0x0e, 0xc7, 0xa4, 0x29, 0xa5,
-	0x41, 0xf0, 0xb4, 0xc9, 0x9d, 0x09, 0x61, 0xdc, 0x9c, 0x78, 0x0a, 0x50, 0xfb, 0x2f, 0xc0, 0x26,
-	0xcc, 0xf2, 0x1d, 0x8f, 0x53, 0x5f, 0x21, 0x36, 0xce, 0x4b, 0xda, 0x23, 0x96, 0xf3, 0xd4, 0xb1,
-	0x4c, 0xee, 0x50, 0x95, 0x44, 0xfd, 0xd7, 0x38, 0xa4, 0xfa, 0xa2, 0x18, 0xd4, 0x82, 0xac, 0xac,
-	0xca, 0x70, 0xec, 0x92, 0x56, 0xd3, 0x1a, 0x85, 0xce, 0xeb, 0x2f, 0x5e, 0x55, 0x63, 0xbf, 0xbf,
-	0xaa, 0x5e, 0xff, 0x52, 0x1d, 0xd2, 0xb6, 0x6d, 0x9f, 0x30, 0x86, 0x33, 0x12, 0xd8, 0xb5, 0x51,
-	0x07, 0x8a, 0x2b, 0x87, 0x0a, 0xdf, 0xf8, 0xc5, 0xbe, 0xd7, 0x57, 0x1c, 0xba, 0x36, 0xfa, 0x18,
-	0xd2, 0xf4, 0x99, 0x4b, 0x7c, 0x56, 0x4a, 0xd4, 0x12, 0x8d, 0x7c, 0xeb, 0xae, 0xbe, 0x9e, 0x4f,
-	0xbd, 0x67, 0xfa, 0x7c, 0xd6, 0x49, 0x8a, 0x83, 0xb1, 0x72, 0x41, 0x55, 0xc8, 0x0b, 0xb3, 0x61,
-	0x5a, 0x16, 0x61, 0xac, 0x94, 0xac, 0x25, 0x1a, 0x39, 0x0c, 0x32, 0x9e, 0xd4, 0x20, 0x1d, 0x6e,
-	0x4e, 0xcd, 0x71, 0x40, 0x0c, 0xe9, 0x60, 0x98, 0x61, 0x16, 0xa5, 0x54, 0x4d, 0x6b, 0xe4, 0xf0,
-	0x0d, 0x69, 0xda, 0x15, 0x16, 0x95, 0x1e, 0xba, 0x07, 0xb7, 0x7c, 0xf2, 0x4d, 0xe0, 0xf8, 0xc4,
-	0xf0, 0x44, 0x3c, 0xc3, 0xa7, 0xe3, 0x71, 0xe0, 0x95, 0xd2, 0x35, 0xad, 0x91, 0xc5, 0x48, 0xd9,
-	0x64, 0x2a, 0x58, 0x5a, 0x1e, 0x64, 0x7f, 0xfc, 0xa9, 0x1a, 0xfb, 0xfe, 0xcf, 0x9a, 0x56, 0xff,
-	0x25, 0x0e, 0x99, 0x3e, 0x61, 0xcc, 0xa1, 0x2e, 0xfa, 0x10, 0x80, 0x85, 0x9f, 0x97, 0xe0, 0x33,
-	0xa7, 0xa0, 0xff, 0x13, 0xa3, 0x9f, 0x42, 0x46, 0xe4, 0xee, 0x90, 0x2b, 0x51, 0x1a, 0xf9, 0x20,
-	0x04, 0x49, 0xd7, 0x9c, 0x90, 0x52, 0x52, 0x72, 0x24, 0xbf, 0x51, 0x09, 0x32, 0x16, 0x75, 0x39,
-	0x79, 0xce, 0x25, 0x75, 0x05, 0x1c, 0x89, 0xe8, 0x23, 0x48, 0x99, 0x81, 0xed, 0xf0, 0x92, 0x55,
-	0xd3, 0x1a, 0xf9, 0xd6, 0xdb, 0xe7, 0x85, 0x6a, 0x0b, 0xd0, 0x23, 0x87, 0x8c, 0x6d, 0x86, 0x43,
-	0x8f, 0x25, 0xe6, 0xfe, 0x89, 0x43, 0x1a, 0x13, 0x8b, 0xfa, 0xf6, 0x3c, 0xba, 0xb6, 0x14, 0x7d,
-	0x95, 0xcc, 0xf8, 0xa5, 0xc9, 0xfc, 0x0c, 0x32, 0x9e, 0x4f, 0xe5, 0x64, 0x24, 0x64, 0x76, 0xd5,
-	0x73, 0x89, 0x08, 0x61, 0x73, 0x2a, 0x42, 0x11, 0xb5, 0x21, 0xed, 0xb8, 0x5e, 0xc0, 0xc3, 0xc9,
-	0xba, 0xa0, 0xba, 0x30, 0xf9, 0xae, 0xc0, 0x46, 0x13, 0x1a, 0x3a, 0xa2, 0x2d, 0xc8, 0xd0, 0x80,
-	0xcb, 0x33, 0x52, 0xf2, 0x8c, 0x77, 0x2e, 0x3e, 0x63, 0x57, 0x82, 0xa3, 0x44, 0x94, 0xeb, 0xda,
-	0xb1, 0x48, 0x5f, 0x6d, 0x2c, 0x96, 0xe8, 0xfe, 0x0e, 0x32, 0xaa, 0x60, 0x54, 0x86, 0x4c, 0xb4,
-	0x13, 0x92, 0xf1, 0xc7, 0x31, 0x1c, 0x29, 0xd0, 0x2d, 0x48, 0x8e, 0x4c, 0x36, 0x92, 0x84, 0x0b,
-	0x83, 0x94, 0xe6, 0x0d, 0x4a, 0x2c, 0x35, 0xe8, 0x36, 0xa4, 0x27, 0x84, 0x8f, 0xa8, 0xad, 0x86,
-	0x46, 0x49, 0x0f, 0x92, 0x22, 0x64, 0xa7, 0x00, 0xa0, 0x08, 0x35, 0x1c, 0xbb, 0xfe, 0x87, 0x06,
-	0xf9, 0x25, 0xba, 0xd6, 0x36, 0xbc, 0x05, 0x39, 0x5f, 0x42, 0x16, 0xfd, 0xbe, 0xb9, 0xa6, 0xc6,
-	0xc7, 0x31, 0x9c, 0x0d, 0x71, 0x5d, 0x7b, 0x9e, 0x6d, 0x62, 0x25, 0xdb, 0x37, 0x20, 0xc7, 0x67,
-	0x1e, 0x31, 0x96, 0x26, 0x3a, 0x2b, 0x14, 0x3b, 0x22, 0x4c, 0x1b, 0xd2, 0x8c, 0x9b, 0x3c, 0x08,
-	0xef, 0x83, 0xd7, 0x5a, 0xef, 0x5e, 0xa2, 0xbd, 0x7d, 0xe9, 0x80, 0x95, 0xa3, 0xaa, 0x30, 0x0b,
-	0x69, 0x46, 0x03, 0xdf, 0x22, 0xf5, 0xa7, 0x50, 0x58, 0xee, 0xa3, 0xa8, 0x4e, 0x66, 0xa5, 0xaa,
-	0x93, 0x39, 0x7d, 0x32, 0x0f, 0x1b, 0x97, 0x61, 0x2f, 0x98, 0x08, 0x16, 0x8c, 0xd7, 0x46, 0xac,
-	0x7f, 0x0b, 0x29, 0xb9, 0xbc, 0x62, 0x33, 0x57, 0x1a, 0xb8, 0x68, 0xdf, 0x7d, 0x48, 0xfa, 0x74,
-	0x4c, 0x54, 0x90, 0xb7, 0x2e, 0xbc, 0x03, 0xf6, 0x66, 0x1e, 0xc1, 0x12, 0x8e, 0xca, 0x90, 0xa5,
-	0x9e, 0x18, 0x19, 0x73, 0x2c, 0xb9, 0xcc, 0xe2, 0xb9, 0xac, 0x62, 0xff, 0x10, 0x87, 0xfc, 0xd2,
-	0x3a, 0xa3, 0xcf, 0xa1, 0x60, 0xf9, 0xc4, 0xe4, 0xc4, 0x36, 0x6c, 0x93, 0x87, 0x9d, 0xcc, 0xb7,
-	0xca, 0x7a, 0xf8, 0x50, 0xe9, 0xd1, 0x43, 0xa5, 0xef, 0x45, 0x2f, 0x59, 0x27, 0x2b, 0x86, 0xf6,
-	0xe8, 0xaf, 0xaa, 0x86, 0xf3, 0xca, 0x73, 0xcb, 0xe4, 0x04, 0xdd, 0x05, 0x88, 0x0e, 0x1a, 0xcc,
-	0xc2, 0xb1, 0xc3, 0x39, 0xa5, 0xe9, 0xcc, 0x44, 0x9c, 0xc0, 0xb3, 0x17, 0x71, 0x12, 0x57, 0x89,
-	0xa3, 0x3c, 0xa3, 0x38, 0xd1, 0x41, 0x83, 0x99, 0x9a, 0x8a, 0x9c, 0xd2, 0x74, 0x24, 0xa5, 0x53,
-	0xe2, 0x8b, 0x3b, 0x44, 0xce, 0xc5, 0x35, 0x1c, 0x89, 0xc2, 0x32, 0x21, 0x8c, 0x99, 0x43, 0x22,
-	0xb7, 0x2f, 0x87, 0x23, 0xb1, 0x7e, 0xa4, 0xc1, 0xb5, 0x1d, 0xc2, 0xdb, 0x8c, 0x11, 0xfe, 0x95,
-	0x78, 0x55, 0xd0, 0x7d, 0x48, 0x79, 0xbe, 0x63, 0x45, 0x74, 0xdc, 0xd1, 0xc3, 0xdf, 0x01, 0x5d,
-	0xfc, 0x0e, 0xe8, 0xea, 0x77, 0x40, 0x7f, 0x48, 0x1d, 0x57, 0xed, 0x7a, 0x88, 0x16, 0x0f, 0xd0,
-	0x3c, 0xb7, 0x31, 0xb5, 0x0e, 0x8c, 0x11, 0x71, 0x86, 0x23, 0x2e, 0xd9, 0x48, 0x62, 0x14, 0x65,
-	0x29, 0x4c, 0x8f, 0xa5, 0x45, 0x2c, 0xdf, 0x94, 0x8e, 0x03, 0xb5, 0x92, 0x49, 0xac, 0xa4, 0x8d,
-	0x9f, 0x35, 0xb8, 0x71, 0x66, 0x70, 0xd1, 0x3d, 0xa8, 0xe2, 0xed, 0x87, 0xbb, 0x78, 0xcb, 0xe8,
-	0xee, 0xf4, 0xf6, 0xf7, 0x8c, 0xfe, 0x5e, 0x7b, 0x6f, 0xbf, 0x6f, 0xec, 0xef, 0xf4, 0x7b, 0xdb,
-	0x0f, 0xbb, 0x8f, 0xba, 0xdb, 0x5b, 0xc5, 0x58, 0x39, 0x7f, 0x78, 0x5c, 0xcb, 0xec, 0xbb, 0x07,
-	0x2e, 0x7d, 0xe6, 0x22, 0x1d, 0xde, 0x5c, 0xe7, 0xd1, 0xc3, 0xbb, 0xbd, 0xdd, 0xfe, 0xf6, 0x56,
-	0x51, 0x2b, 0x17, 0x0e, 0x8f, 0x6b, 0xd9, 0x9e, 0x4f, 0x3d, 0xca, 0x88, 0x8d, 0x36, 0xa0, 0xbc,
-	0x0e, 0x1f, 0xea, 0x8a, 0xf1, 0x32, 0x1c, 0x1e, 0xd7, 0xd4, 0x6d, 0xbf, 0x11, 0x88, 0x75, 0x59,
-	0x0c, 0x39, 0xba, 0x0b, 0x77, 0xf0, 0x76, 0x7f, 0xff, 0xc9, 0xfa, 0xbc, 0xd0, 0x6d, 0x40, 0xab,
-	0xe6, 0x5e, 0xbb, 0xdf, 0x2f, 0x6a, 0x67, 0xf5, 0xfd, 0x2f, 0xba, 0xbd, 0x62, 0xfc, 0xac, 0xfe,
-	0x51, 0xbb, 0xfb, 0xa4, 0x98, 0xe8, 0x1c, 0xbc, 0x38, 0xa9, 0x68, 0x2f, 0x4f, 0x2a, 0xda, 0xdf,
-	0x27, 0x15, 0xed, 0xe8, 0xb4, 0x12, 0x7b, 0x79, 0x5a, 0x89, 0xfd, 0x76, 0x5a, 0x89, 0xc1, 0x1d,
-	0x87, 0x9e, 0xb3, 0x28, 0x3d, 0xed, 0xeb, 0x0f, 0x86, 0x0e, 0x1f, 0x05, 0x03, 0xdd, 0xa2, 0x93,
-	0xe6, 0x02, 0xf4, 0x9e, 0x43, 0x97, 0xa4, 0xe6, 0xf3, 0xc5, 0x3f, 0x97, 0xb8, 0x68, 0xd8, 0x20,
-	0x2d, 0x07, 0xf3, 0xfd, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x50, 0x80, 0x91, 0x4c, 0x0a,
-	0x00, 0x00,
+func (m *NetAssetValueRecord) Reset()         { *m = NetAssetValueRecord{} }
+func (m *NetAssetValueRecord) String() string { return proto.CompactTextString(m) }
+func (*NetAssetValueRecord) ProtoMessage()    {}
+func (*NetAssetValueRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{9}
 }
-
-func (m *Scope) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NetAssetValueRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetAssetValueRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetAssetValueRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Scope) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NetAssetValueRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetAssetValueRecord.Merge(m, src)
+}
+func (m *NetAssetValueRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetAssetValueRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetAssetValueRecord.DiscardUnknown(m)
 }
 
-func (m *Scope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequirePartyRollup {
-		i--
-		if m.RequirePartyRollup {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.ValueOwnerAddress) > 0 {
-		i -= len(m.ValueOwnerAddress)
-		copy(dAtA[i:], m.ValueOwnerAddress)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.ValueOwnerAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.DataAccess) > 0 {
-		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DataAccess[iNdEx])
-			copy(dAtA[i:], m.DataAccess[iNdEx])
-			i = encodeVarintScope(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Owners) > 0 {
-		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintScope(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size := m.SpecificationId.Size()
-		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintScope(dAtA, i, uint64(size))
+var xxx_messageInfo_NetAssetValueRecord proto.InternalMessageInfo
+
+func (m *NetAssetValueRecord) GetNetAssetValue() NetAssetValue {
+	if m != nil {
+		return m.NetAssetValue
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintScope(dAtA, i, uint64(size))
+	return NetAssetValue{}
+}
+
+func (m *NetAssetValueRecord) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *Session) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NetAssetValueRecord) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Session) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EntryChange describes the change that replaced a version of a scope, session, or record.
+type EntryChange struct {
+	// changed_by is the list of signers of the change.
+	ChangedBy []string `protobuf:"bytes,1,rep,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	// height is the block height at which the change was made.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// block_time is the time of the block in which the change was made.
+	BlockTime time.Time `protobuf:"bytes,3,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// deleted is true if the change deleted the entry.
+	Deleted bool `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
 }
 
-func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Audit != nil {
-		{
-			size, err := m.Audit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintScope(dAtA, i, uint64(size))
+func (m *EntryChange) Reset()         { *m = EntryChange{} }
+func (m *EntryChange) String() string { return proto.CompactTextString(m) }
+func (*EntryChange) ProtoMessage()    {}
+func (*EntryChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{10}
+}
+func (m *EntryChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntryChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntryChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+		return b[:n], nil
 	}
-	if len(m.Context) > 0 {
-		i -= len(m.Context)
-		copy(dAtA[i:], m.Context)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.Context)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *EntryChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntryChange.Merge(m, src)
+}
+func (m *EntryChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntryChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntryChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntryChange proto.InternalMessageInfo
+
+func (m *EntryChange) GetChangedBy() []string {
+	if m != nil {
+		return m.ChangedBy
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *EntryChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(m.Parties) > 0 {
-		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintScope(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return 0
+}
+
+func (m *EntryChange) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
 	}
-	{
-		size := m.SpecificationId.Size()
-		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintScope(dAtA, i, uint64(size))
+	return time.Time{}
+}
+
+func (m *EntryChange) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.SessionId.Size()
-		i -= size
-		if _, err := m.SessionId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return false
+}
+
+// ScopeVersion is a prior version of a scope.
+type ScopeVersion struct {
+	// version is the number of this version of the scope. The first version of a scope is 1.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// scope is the scope as it was before the change.
+	Scope Scope `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope"`
+	// change describes the change that replaced this version.
+	Change EntryChange `protobuf:"bytes,3,opt,name=change,proto3" json:"change"`
+}
+
+func (m *ScopeVersion) Reset()         { *m = ScopeVersion{} }
+func (m *ScopeVersion) String() string { return proto.CompactTextString(m) }
+func (*ScopeVersion) ProtoMessage()    {}
+func (*ScopeVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{11}
+}
+func (m *ScopeVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintScope(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ScopeVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeVersion.Merge(m, src)
+}
+func (m *ScopeVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeVersion.DiscardUnknown(m)
 }
 
-func (m *Record) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScopeVersion proto.InternalMessageInfo
+
+func (m *ScopeVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Record) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScopeVersion) GetScope() Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return Scope{}
 }
 
-func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SpecificationId.Size()
-		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintScope(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.Outputs) > 0 {
-		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintScope(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Inputs) > 0 {
-		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintScope(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func (m *ScopeVersion) GetChange() EntryChange {
+	if m != nil {
+		return m.Change
 	}
-	{
-		size, err := m.Process.MarshalToSizedBuffer(dAtA[:i])
+	return EntryChange{}
+}
+
+// SessionVersion is a prior version of a session.
+type SessionVersion struct {
+	// version is the number of this version of the session. The first version of a session is 1.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// session is the session as it was before the change.
+	Session Session `protobuf:"bytes,2,opt,name=session,proto3" json:"session"`
+	// change describes the change that replaced this version.
+	Change EntryChange `protobuf:"bytes,3,opt,name=change,proto3" json:"change"`
+}
+
+func (m *SessionVersion) Reset()         { *m = SessionVersion{} }
+func (m *SessionVersion) String() string { return proto.CompactTextString(m) }
+func (*SessionVersion) ProtoMessage()    {}
+func (*SessionVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{12}
+}
+func (m *SessionVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintScope(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.SessionId.Size()
-		i -= size
-		if _, err := m.SessionId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintScope(dAtA, i, uint64(size))
+}
+func (m *SessionVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionVersion.Merge(m, src)
+}
+func (m *SessionVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionVersion proto.InternalMessageInfo
+
+func (m *SessionVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *SessionVersion) GetSession() Session {
+	if m != nil {
+		return m.Session
 	}
-	return len(dAtA) - i, nil
+	return Session{}
 }
 
-func (m *Process) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SessionVersion) GetChange() EntryChange {
+	if m != nil {
+		return m.Change
 	}
-	return dAtA[:n], nil
+	return EntryChange{}
 }
 
-func (m *Process) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RecordVersion is a prior version of a record.
+type RecordVersion struct {
+	// version is the number of this version of the record. The first version of a record is 1.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// record is the record as it was before the change.
+	Record Record `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+	// change describes the change that replaced this version.
+	Change EntryChange `protobuf:"bytes,3,opt,name=change,proto3" json:"change"`
 }
 
-func (m *Process) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Method) > 0 {
-		i -= len(m.Method)
-		copy(dAtA[i:], m.Method)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.Method)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ProcessId != nil {
-		{
-			size := m.ProcessId.Size()
-			i -= size
-			if _, err := m.ProcessId.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *RecordVersion) Reset()         { *m = RecordVersion{} }
+func (m *RecordVersion) String() string { return proto.CompactTextString(m) }
+func (*RecordVersion) ProtoMessage()    {}
+func (*RecordVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{13}
+}
+func (m *RecordVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RecordVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordVersion.Merge(m, src)
+}
+func (m *RecordVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordVersion.DiscardUnknown(m)
 }
 
-func (m *Process_Address) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RecordVersion proto.InternalMessageInfo
+
+func (m *RecordVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func (m *Process_Address) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Address)
-	copy(dAtA[i:], m.Address)
-	i = encodeVarintScope(dAtA, i, uint64(len(m.Address)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *RecordVersion) GetRecord() Record {
+	if m != nil {
+		return m.Record
+	}
+	return Record{}
 }
-func (m *Process_Hash) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *RecordVersion) GetChange() EntryChange {
+	if m != nil {
+		return m.Change
+	}
+	return EntryChange{}
 }
 
-func (m *Process_Hash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Hash)
-	copy(dAtA[i:], m.Hash)
-	i = encodeVarintScope(dAtA, i, uint64(len(m.Hash)))
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
+// ScopeOwnershipTransfer is a pending change to the owners and/or value owner of a scope that is waiting on the
+// acceptance of the new owners.
+type ScopeOwnershipTransfer struct {
+	// scope_id is the id of the scope being transferred.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// owners are the proposed owners of the scope. If empty, the scope's owners are not changed.
+	Owners []Party `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners"`
+	// value_owner_address is the proposed value owner of the scope. If empty, the scope's value owner is not changed.
+	ValueOwnerAddress string `protobuf:"bytes,3,opt,name=value_owner_address,json=valueOwnerAddress,proto3" json:"value_owner_address,omitempty"`
+	// recipients are the addresses that must accept the transfer: the proposed value owner, and each proposed owner
+	// that isn't already an owner of the scope.
+	Recipients []string `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// proposed_by are the addresses that signed the proposal.
+	ProposedBy []string `protobuf:"bytes,5,rep,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
+	// current_owners are the owners of the scope when the transfer was proposed.
+	CurrentOwners []Party `protobuf:"bytes,6,rep,name=current_owners,json=currentOwners,proto3" json:"current_owners"`
+	// current_value_owner_address is the value owner of the scope when the transfer was proposed.
+	CurrentValueOwnerAddress string `protobuf:"bytes,7,opt,name=current_value_owner_address,json=currentValueOwnerAddress,proto3" json:"current_value_owner_address,omitempty"`
+	// expiration is the time after which the transfer can no longer be accepted.
+	Expiration time.Time `protobuf:"bytes,8,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *ScopeOwnershipTransfer) Reset()         { *m = ScopeOwnershipTransfer{} }
+func (m *ScopeOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*ScopeOwnershipTransfer) ProtoMessage()    {}
+func (*ScopeOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{14}
+}
+func (m *ScopeOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *RecordInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScopeOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ScopeOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeOwnershipTransfer.Merge(m, src)
+}
+func (m *ScopeOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeOwnershipTransfer.DiscardUnknown(m)
 }
 
-func (m *RecordInput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ScopeOwnershipTransfer proto.InternalMessageInfo
+
+func (m *ScopeOwnershipTransfer) GetOwners() []Party {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
 }
 
-func (m *RecordInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintScope(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
+func (m *ScopeOwnershipTransfer) GetValueOwnerAddress() string {
+	if m != nil {
+		return m.ValueOwnerAddress
 	}
-	if len(m.TypeName) > 0 {
-		i -= len(m.TypeName)
-		copy(dAtA[i:], m.TypeName)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.TypeName)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *ScopeOwnershipTransfer) GetRecipients() []string {
+	if m != nil {
+		return m.Recipients
 	}
-	if m.Source != nil {
-		{
-			size := m.Source.Size()
-			i -= size
-			if _, err := m.Source.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	return nil
+}
+
+func (m *ScopeOwnershipTransfer) GetProposedBy() []string {
+	if m != nil {
+		return m.ProposedBy
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *ScopeOwnershipTransfer) GetCurrentOwners() []Party {
+	if m != nil {
+		return m.CurrentOwners
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *RecordInput_RecordId) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScopeOwnershipTransfer) GetCurrentValueOwnerAddress() string {
+	if m != nil {
+		return m.CurrentValueOwnerAddress
+	}
+	return ""
 }
 
-func (m *RecordInput_RecordId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	{
-		size := m.RecordId.Size()
-		i -= size
-		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ScopeOwnershipTransfer) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+// ScopeHeights are the block heights at which a scope was created and last updated.
+type ScopeHeights struct {
+	// scope_id is the id of the scope.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// created_height is the block height at which the scope was created. It is zero if the scope was created
+	// before its heights were tracked.
+	CreatedHeight int64 `protobuf:"varint,2,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// updated_height is the block height at which the scope was last written.
+	// It is the height at which the heights started being tracked if the scope hasn't been written since then.
+	UpdatedHeight int64 `protobuf:"varint,3,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+}
+
+func (m *ScopeHeights) Reset()         { *m = ScopeHeights{} }
+func (m *ScopeHeights) String() string { return proto.CompactTextString(m) }
+func (*ScopeHeights) ProtoMessage()    {}
+func (*ScopeHeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{15}
+}
+func (m *ScopeHeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeHeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeHeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintScope(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
 }
-func (m *RecordInput_Hash) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScopeHeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeHeights.Merge(m, src)
+}
+func (m *ScopeHeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeHeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeHeights.DiscardUnknown(m)
 }
 
-func (m *RecordInput_Hash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Hash)
-	copy(dAtA[i:], m.Hash)
-	i = encodeVarintScope(dAtA, i, uint64(len(m.Hash)))
-	i--
-	dAtA[i] = 0x1a
-	return len(dAtA) - i, nil
+var xxx_messageInfo_ScopeHeights proto.InternalMessageInfo
+
+func (m *ScopeHeights) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
 }
-func (m *RecordOutput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *ScopeHeights) GetUpdatedHeight() int64 {
+	if m != nil {
+		return m.UpdatedHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RecordOutput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ScopeDataAccessGrant is the expiration of an address's data access to a scope.
+// Data access without an expiration does not have a grant.
+type ScopeDataAccessGrant struct {
+	// scope_id is the id of the scope.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// address is the bech32 address that has data access to the scope.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// expiration is the time after which the address no longer has data access to the scope.
+	Expiration time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration"`
 }
 
-func (m *RecordOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintScope(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x10
+func (m *ScopeDataAccessGrant) Reset()         { *m = ScopeDataAccessGrant{} }
+func (m *ScopeDataAccessGrant) String() string { return proto.CompactTextString(m) }
+func (*ScopeDataAccessGrant) ProtoMessage()    {}
+func (*ScopeDataAccessGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{16}
+}
+func (m *ScopeDataAccessGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeDataAccessGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeDataAccessGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ScopeDataAccessGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeDataAccessGrant.Merge(m, src)
+}
+func (m *ScopeDataAccessGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeDataAccessGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeDataAccessGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeDataAccessGrant proto.InternalMessageInfo
+
+func (m *ScopeDataAccessGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Party) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScopeDataAccessGrant) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *Party) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ScopeArchive is everything that existed for a scope when it was archived.
+// The sessions, records, and net asset values are ordered the same as they are in state.
+type ScopeArchive struct {
+	// scope is the scope that was archived, including its value owner.
+	Scope Scope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope"`
+	// sessions are the scope's sessions.
+	Sessions []Session `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions"`
+	// records are the scope's records.
+	Records []Record `protobuf:"bytes,3,rep,name=records,proto3" json:"records"`
+	// net_asset_values are the scope's net asset values.
+	NetAssetValues []NetAssetValue `protobuf:"bytes,4,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
 }
 
-func (m *Party) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Optional {
-		i--
-		if m.Optional {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ScopeArchive) Reset()         { *m = ScopeArchive{} }
+func (m *ScopeArchive) String() string { return proto.CompactTextString(m) }
+func (*ScopeArchive) ProtoMessage()    {}
+func (*ScopeArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{17}
+}
+func (m *ScopeArchive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeArchive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.Role != 0 {
-		i = encodeVarintScope(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *ScopeArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeArchive.Merge(m, src)
+}
+func (m *ScopeArchive) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeArchive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeArchive proto.InternalMessageInfo
+
+func (m *ScopeArchive) GetScope() Scope {
+	if m != nil {
+		return m.Scope
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return Scope{}
+}
+
+func (m *ScopeArchive) GetSessions() []Session {
+	if m != nil {
+		return m.Sessions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *AuditFields) Marshal() (dAtA []byte, err error) {
+func (m *ScopeArchive) GetRecords() []Record {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *ScopeArchive) GetNetAssetValues() []NetAssetValue {
+	if m != nil {
+		return m.NetAssetValues
+	}
+	return nil
+}
+
+// ScopeTombstone is the compact record of an archived scope that is kept after the scope is removed.
+type ScopeTombstone struct {
+	// scope_id is the id of the scope that was archived.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// archive_hash is the SHA-256 hash of the protobuf encoding of the scope's ScopeArchive.
+	ArchiveHash []byte `protobuf:"bytes,2,opt,name=archive_hash,json=archiveHash,proto3" json:"archive_hash,omitempty"`
+	// change describes when and by whom the scope was archived.
+	Change EntryChange `protobuf:"bytes,3,opt,name=change,proto3" json:"change"`
+}
+
+func (m *ScopeTombstone) Reset()         { *m = ScopeTombstone{} }
+func (m *ScopeTombstone) String() string { return proto.CompactTextString(m) }
+func (*ScopeTombstone) ProtoMessage()    {}
+func (*ScopeTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{18}
+}
+func (m *ScopeTombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeTombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeTombstone.Merge(m, src)
+}
+func (m *ScopeTombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeTombstone proto.InternalMessageInfo
+
+func (m *ScopeTombstone) GetArchiveHash() []byte {
+	if m != nil {
+		return m.ArchiveHash
+	}
+	return nil
+}
+
+func (m *ScopeTombstone) GetChange() EntryChange {
+	if m != nil {
+		return m.Change
+	}
+	return EntryChange{}
+}
+
+func init() {
+	proto.RegisterEnum("provenance.metadata.v1.RecordInputStatus", RecordInputStatus_name, RecordInputStatus_value)
+	proto.RegisterEnum("provenance.metadata.v1.ResultStatus", ResultStatus_name, ResultStatus_value)
+	proto.RegisterType((*Scope)(nil), "provenance.metadata.v1.Scope")
+	proto.RegisterType((*Session)(nil), "provenance.metadata.v1.Session")
+	proto.RegisterType((*Record)(nil), "provenance.metadata.v1.Record")
+	proto.RegisterType((*Process)(nil), "provenance.metadata.v1.Process")
+	proto.RegisterType((*RecordInput)(nil), "provenance.metadata.v1.RecordInput")
+	proto.RegisterType((*RecordOutput)(nil), "provenance.metadata.v1.RecordOutput")
+	proto.RegisterType((*Party)(nil), "provenance.metadata.v1.Party")
+	proto.RegisterType((*AuditFields)(nil), "provenance.metadata.v1.AuditFields")
+	proto.RegisterType((*NetAssetValue)(nil), "provenance.metadata.v1.NetAssetValue")
+	proto.RegisterType((*NetAssetValueRecord)(nil), "provenance.metadata.v1.NetAssetValueRecord")
+	proto.RegisterType((*EntryChange)(nil), "provenance.metadata.v1.EntryChange")
+	proto.RegisterType((*ScopeVersion)(nil), "provenance.metadata.v1.ScopeVersion")
+	proto.RegisterType((*SessionVersion)(nil), "provenance.metadata.v1.SessionVersion")
+	proto.RegisterType((*RecordVersion)(nil), "provenance.metadata.v1.RecordVersion")
+	proto.RegisterType((*ScopeOwnershipTransfer)(nil), "provenance.metadata.v1.ScopeOwnershipTransfer")
+	proto.RegisterType((*ScopeHeights)(nil), "provenance.metadata.v1.ScopeHeights")
+	proto.RegisterType((*ScopeDataAccessGrant)(nil), "provenance.metadata.v1.ScopeDataAccessGrant")
+	proto.RegisterType((*ScopeArchive)(nil), "provenance.metadata.v1.ScopeArchive")
+	proto.RegisterType((*ScopeTombstone)(nil), "provenance.metadata.v1.ScopeTombstone")
+}
+
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/scope.proto", fileDescriptor_edeea634bfb18aba)
+}
+
+var fileDescriptor_edeea634bfb18aba = []byte{
+	// 1633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6f, 0x1b, 0x5d,
+	0x15, 0xf7, 0xf8, 0xed, 0x63, 0x27, 0x75, 0x6f, 0xa3, 0xe0, 0xba, 0xd4, 0x76, 0x0d, 0x95, 0x42,
+	0x24, 0xec, 0x26, 0x50, 0x24, 0x4a, 0x4b, 0x65, 0x27, 0x69, 0x63, 0x28, 0x89, 0x35, 0x4e, 0xba,
+	0x60, 0x33, 0x1a, 0xcf, 0xdc, 0xda, 0xa3, 0xda, 0x73, 0x87, 0x99, 0x3b, 0x6e, 0x0d, 0x2c, 0x58,
+	0x77, 0x55, 0x76, 0x6c, 0x2a, 0x81, 0x2a, 0xb1, 0x60, 0xc1, 0x96, 0x3f, 0x81, 0x22, 0x36, 0x5d,
+	0x22, 0x40, 0x05, 0xb5, 0xdb, 0xef, 0x8f, 0xf8, 0x74, 0x5f, 0x7e, 0x24, 0x76, 0x9a, 0xe4, 0xeb,
+	0xce, 0xf7, 0xdc, 0x73, 0xee, 0x39, 0xe7, 0x77, 0x9e, 0x63, 0xa8, 0x7a, 0x3e, 0x19, 0x61, 0xd7,
+	0x74, 0x2d, 0x5c, 0x1f, 0x62, 0x6a, 0xda, 0x26, 0x35, 0xeb, 0xa3, 0xad, 0x7a, 0x60, 0x11, 0x0f,
+	0xd7, 0x3c, 0x9f, 0x50, 0x82, 0xd6, 0xa7, 0x3c, 0x35, 0xc5, 0x53, 0x1b, 0x6d, 0x15, 0x4b, 0x16,
+	0x09, 0x86, 0x24, 0xa8, 0x77, 0xcd, 0x00, 0xd7, 0x47, 0x5b, 0x5d, 0x4c, 0xcd, 0xad, 0xba, 0x45,
+	0x1c, 0x57, 0xc8, 0x15, 0xd7, 0x7a, 0xa4, 0x47, 0xf8, 0xcf, 0x3a, 0xfb, 0x25, 0xa9, 0xe5, 0x1e,
+	0x21, 0xbd, 0x01, 0xae, 0xf3, 0x53, 0x37, 0x7c, 0x56, 0xa7, 0xce, 0x10, 0x07, 0xd4, 0x1c, 0x7a,
+	0x92, 0xa1, 0x72, 0x92, 0xc1, 0xc6, 0x81, 0xe5, 0x3b, 0x1e, 0x25, 0xbe, 0xe4, 0xd8, 0x5c, 0x66,
+	0xb4, 0x87, 0x2d, 0xe7, 0x99, 0x63, 0x99, 0xd4, 0x21, 0xd2, 0x88, 0xea, 0x3f, 0xa2, 0x90, 0xe8,
+	0x30, 0x67, 0xd0, 0x36, 0xa4, 0xb9, 0x57, 0x86, 0x63, 0x17, 0xb4, 0x8a, 0xb6, 0x91, 0x6b, 0x7e,
+	0xeb, 0xdd, 0x87, 0x72, 0xe4, 0xdf, 0x1f, 0xca, 0x57, 0x7e, 0x21, 0x1f, 0x69, 0xd8, 0xb6, 0x8f,
+	0x83, 0x40, 0x4f, 0x71, 0xc6, 0x96, 0x8d, 0x9a, 0x90, 0x9f, 0x7b, 0x94, 0xc9, 0x46, 0xcf, 0x96,
+	0xbd, 0x32, 0x27, 0xd0, 0xb2, 0xd1, 0x4f, 0x20, 0x49, 0x5e, 0xb8, 0xd8, 0x0f, 0x0a, 0xb1, 0x4a,
+	0x6c, 0x23, 0xbb, 0x7d, 0xb3, 0xb6, 0x18, 0xcf, 0x5a, 0xdb, 0xf4, 0xe9, 0xb8, 0x19, 0x67, 0x0f,
+	0xeb, 0x52, 0x04, 0x95, 0x21, 0xcb, 0xae, 0x0d, 0xd3, 0xb2, 0x70, 0x10, 0x14, 0xe2, 0x95, 0xd8,
+	0x46, 0x46, 0x07, 0xae, 0x8f, 0x53, 0x50, 0x0d, 0xae, 0x8d, 0xcc, 0x41, 0x88, 0x0d, 0x2e, 0x60,
+	0x98, 0xc2, 0x8a, 0x42, 0xa2, 0xa2, 0x6d, 0x64, 0xf4, 0xab, 0xfc, 0xea, 0x90, 0xdd, 0x48, 0xf3,
+	0xd0, 0x1d, 0x58, 0xf3, 0xf1, 0xaf, 0x42, 0xc7, 0xc7, 0x86, 0xc7, 0xf4, 0x19, 0x3e, 0x19, 0x0c,
+	0x42, 0xaf, 0x90, 0xac, 0x68, 0x1b, 0x69, 0x1d, 0xc9, 0x3b, 0x6e, 0x8a, 0xce, 0x6f, 0xee, 0xa5,
+	0xff, 0xf0, 0xc7, 0x72, 0xe4, 0x77, 0xff, 0xad, 0x68, 0xd5, 0xbf, 0x45, 0x21, 0xd5, 0xc1, 0x41,
+	0xe0, 0x10, 0x17, 0xfd, 0x08, 0x20, 0x10, 0x3f, 0xcf, 0x81, 0x67, 0x46, 0xb2, 0x7e, 0x21, 0x44,
+	0x1f, 0x40, 0x8a, 0xd9, 0xee, 0xe0, 0x0b, 0x41, 0xaa, 0x64, 0x10, 0x82, 0xb8, 0x6b, 0x0e, 0x71,
+	0x21, 0xce, 0x31, 0xe2, 0xbf, 0x51, 0x01, 0x52, 0x16, 0x71, 0x29, 0x7e, 0x49, 0x39, 0x74, 0x39,
+	0x5d, 0x1d, 0xd1, 0x8f, 0x21, 0x61, 0x86, 0xb6, 0x43, 0x0b, 0x56, 0x45, 0xdb, 0xc8, 0x6e, 0x7f,
+	0x67, 0x99, 0xaa, 0x06, 0x63, 0x7a, 0xe4, 0xe0, 0x81, 0x1d, 0xe8, 0x42, 0x62, 0x06, 0xb9, 0xaf,
+	0xa2, 0x90, 0xd4, 0xb1, 0x45, 0x7c, 0x7b, 0xa2, 0x5d, 0x9b, 0xd1, 0x3e, 0x0f, 0x66, 0xf4, 0xdc,
+	0x60, 0x3e, 0x84, 0x94, 0xe7, 0x13, 0x9e, 0x19, 0x31, 0x6e, 0x5d, 0x79, 0x29, 0x10, 0x82, 0x6d,
+	0x02, 0x85, 0x38, 0xa2, 0x06, 0x24, 0x1d, 0xd7, 0x0b, 0xa9, 0xc8, 0xac, 0x33, 0xbc, 0x13, 0xc6,
+	0xb7, 0x18, 0xaf, 0xca, 0x50, 0x21, 0x88, 0x76, 0x21, 0x45, 0x42, 0xca, 0xdf, 0x48, 0xf0, 0x37,
+	0xbe, 0x7b, 0xf6, 0x1b, 0x87, 0x21, 0x9d, 0x3e, 0xa2, 0x44, 0x17, 0xa6, 0x45, 0xf2, 0x62, 0x69,
+	0x31, 0x03, 0xf7, 0x6f, 0x20, 0x25, 0x1d, 0x46, 0x45, 0x48, 0xa9, 0x9a, 0xe0, 0x88, 0xef, 0x47,
+	0x74, 0x45, 0x40, 0x6b, 0x10, 0xef, 0x9b, 0x41, 0xbf, 0x10, 0x95, 0x17, 0xfc, 0x34, 0x09, 0x50,
+	0x6c, 0x26, 0x40, 0xeb, 0x90, 0x1c, 0x62, 0xda, 0x27, 0xb6, 0x4c, 0x1a, 0x79, 0xba, 0x17, 0x67,
+	0x2a, 0x9b, 0x39, 0x00, 0x09, 0xa8, 0xe1, 0xd8, 0xd5, 0xff, 0x68, 0x90, 0x9d, 0x81, 0x6b, 0x61,
+	0xc0, 0xb7, 0x21, 0xe3, 0x73, 0x96, 0x69, 0xbc, 0xaf, 0x2d, 0xf0, 0x71, 0x3f, 0xa2, 0xa7, 0x05,
+	0x5f, 0xcb, 0x9e, 0x58, 0x1b, 0x9b, 0xb3, 0xf6, 0x06, 0x64, 0xe8, 0xd8, 0xc3, 0xc6, 0x4c, 0x46,
+	0xa7, 0x19, 0xe1, 0x80, 0xa9, 0x69, 0x40, 0x32, 0xa0, 0x26, 0x0d, 0x45, 0x3f, 0x58, 0xdd, 0xfe,
+	0xde, 0x39, 0xc2, 0xdb, 0xe1, 0x02, 0xba, 0x14, 0x94, 0x1e, 0xa6, 0x21, 0x19, 0x90, 0xd0, 0xb7,
+	0x70, 0xf5, 0xb7, 0x90, 0x9b, 0x8d, 0x23, 0xf3, 0x8e, 0x5b, 0x25, 0xbd, 0xe3, 0x36, 0xdd, 0x9f,
+	0xa8, 0x8d, 0x72, 0xb5, 0x67, 0x64, 0x44, 0x10, 0x0e, 0x4e, 0x68, 0x44, 0x6b, 0x90, 0xe0, 0x6d,
+	0x4b, 0x06, 0x40, 0x1c, 0x84, 0x1d, 0xd5, 0x5f, 0x43, 0x82, 0x97, 0x34, 0xab, 0xd7, 0xb9, 0xb0,
+	0x4e, 0x83, 0x7a, 0x17, 0xe2, 0x3e, 0x19, 0x60, 0xa9, 0xfa, 0xd6, 0x99, 0x9d, 0xe1, 0x68, 0xec,
+	0x61, 0x9d, 0xb3, 0xa3, 0x22, 0xa4, 0x89, 0xc7, 0x12, 0xc9, 0x1c, 0x70, 0xc5, 0x69, 0x7d, 0x72,
+	0x96, 0xba, 0x7f, 0x1f, 0x85, 0xec, 0x4c, 0x91, 0xa3, 0xc7, 0x90, 0xb3, 0x7c, 0x6c, 0x52, 0x6c,
+	0x1b, 0xb6, 0x49, 0x45, 0x7c, 0xb3, 0xdb, 0xc5, 0x9a, 0x18, 0x5f, 0x35, 0x35, 0xbe, 0x6a, 0x47,
+	0x6a, 0xbe, 0x35, 0xd3, 0x2c, 0x95, 0x5f, 0xff, 0xaf, 0xac, 0xe9, 0x59, 0x29, 0xb9, 0x6b, 0x52,
+	0x8c, 0x6e, 0x02, 0xa8, 0x87, 0xba, 0x63, 0x91, 0x8c, 0x7a, 0x46, 0x52, 0x9a, 0x63, 0xa6, 0x27,
+	0xf4, 0xec, 0xa9, 0x9e, 0xd8, 0x45, 0xf4, 0x48, 0x49, 0xa5, 0x47, 0x3d, 0xd4, 0x1d, 0xcb, 0x5c,
+	0xc9, 0x48, 0x4a, 0x93, 0x43, 0x3a, 0xc2, 0x3e, 0xeb, 0x2c, 0x3c, 0x5b, 0x56, 0x74, 0x75, 0x64,
+	0x37, 0x43, 0x1c, 0x04, 0x66, 0x0f, 0xf3, 0x9a, 0xcc, 0xe8, 0xea, 0x58, 0xfd, 0xb3, 0x06, 0x2b,
+	0x07, 0x98, 0x36, 0x82, 0x00, 0xd3, 0xa7, 0x2c, 0x4e, 0xe8, 0x2e, 0x24, 0x3c, 0xdf, 0xb1, 0x14,
+	0x1c, 0xd7, 0x6b, 0x62, 0x49, 0xa8, 0xb1, 0x25, 0xa1, 0x26, 0x97, 0x84, 0xda, 0x0e, 0x71, 0x5c,
+	0xd9, 0x01, 0x04, 0x37, 0x1b, 0x4b, 0x13, 0xdb, 0x06, 0xc4, 0x7a, 0x6e, 0xf4, 0xb1, 0xd3, 0xeb,
+	0x53, 0x8e, 0x46, 0x5c, 0x47, 0xca, 0x4a, 0x76, 0xb5, 0xcf, 0x6f, 0x58, 0x49, 0x8e, 0xc8, 0x20,
+	0x94, 0x85, 0x1a, 0xd7, 0xe5, 0x89, 0xa5, 0x4f, 0x40, 0xcd, 0x81, 0x28, 0x86, 0xb4, 0x2e, 0x0e,
+	0xd5, 0xbf, 0x6b, 0x70, 0x6d, 0xce, 0x50, 0xd9, 0x8d, 0x3b, 0x70, 0xc5, 0xc5, 0xd4, 0x30, 0x19,
+	0xdd, 0x10, 0x69, 0x27, 0x0c, 0xbf, 0xbd, 0x2c, 0x71, 0xe6, 0x5e, 0x91, 0x4e, 0xac, 0xb8, 0x73,
+	0x18, 0xec, 0x00, 0x08, 0x27, 0xd8, 0x6a, 0x53, 0x88, 0x5e, 0x20, 0x5e, 0x19, 0x2e, 0xc7, 0x6e,
+	0xd0, 0xba, 0x2a, 0x39, 0x59, 0x07, 0xaa, 0x00, 0xdf, 0x6a, 0x90, 0xdd, 0x73, 0xa9, 0x3f, 0xde,
+	0xe9, 0x9b, 0x6e, 0x4f, 0x64, 0x0f, 0xff, 0xc5, 0xa3, 0xaa, 0xf1, 0x05, 0x21, 0x23, 0x29, 0xcd,
+	0x31, 0x7b, 0x66, 0x06, 0xca, 0x98, 0x2e, 0x4f, 0x27, 0x6c, 0x8c, 0x5d, 0xce, 0xc6, 0x02, 0xa4,
+	0x6c, 0x3c, 0xc0, 0x14, 0xdb, 0x12, 0x6d, 0x75, 0x64, 0x89, 0x91, 0xe3, 0x6b, 0xd7, 0xd3, 0x69,
+	0x0e, 0xa9, 0xec, 0xd2, 0x78, 0xbc, 0xd4, 0x91, 0x0d, 0x58, 0xbe, 0x6e, 0x49, 0xa0, 0x96, 0xce,
+	0x72, 0xfe, 0x9c, 0xca, 0x1a, 0x2e, 0xc1, 0xfa, 0x9b, 0xf0, 0x54, 0x3a, 0xb0, 0x74, 0x7c, 0xcd,
+	0x00, 0xa6, 0xc6, 0x97, 0x10, 0xac, 0xfe, 0x55, 0x83, 0x55, 0xb9, 0xd3, 0x7c, 0xde, 0xd4, 0x87,
+	0x90, 0x92, 0xc3, 0xb7, 0x10, 0x3d, 0x7b, 0xde, 0xca, 0x27, 0xd5, 0x98, 0x93, 0x52, 0x5f, 0xc2,
+	0xe0, 0xbf, 0x68, 0xb0, 0x22, 0x92, 0xf7, 0xf3, 0xf6, 0xde, 0x87, 0xa4, 0x18, 0x1f, 0xd2, 0xdc,
+	0xd2, 0xd9, 0xfd, 0x5f, 0x69, 0x12, 0x32, 0x5f, 0xc2, 0xd8, 0x7f, 0xc6, 0x60, 0x9d, 0xc7, 0x8d,
+	0xef, 0xa0, 0x41, 0xdf, 0xf1, 0x8e, 0x7c, 0xd3, 0x0d, 0x9e, 0x61, 0xff, 0x52, 0xeb, 0xf8, 0x74,
+	0x95, 0x8e, 0x5e, 0x7c, 0x95, 0x5e, 0xb2, 0x29, 0xc7, 0x96, 0x6d, 0xca, 0x25, 0x00, 0x1f, 0x5b,
+	0x8e, 0xe7, 0x60, 0x97, 0x4e, 0x36, 0xef, 0x29, 0x85, 0xad, 0xe6, 0x9e, 0x4f, 0x3c, 0x12, 0x88,
+	0xca, 0x4b, 0x08, 0x06, 0x45, 0x6a, 0x8e, 0xd1, 0xcf, 0x60, 0xd5, 0x0a, 0x7d, 0x1f, 0xbb, 0xd4,
+	0x90, 0x56, 0x27, 0xcf, 0x6f, 0xf5, 0x8a, 0x14, 0x15, 0xc0, 0xa1, 0x07, 0x70, 0x43, 0xbd, 0xb5,
+	0xc8, 0x89, 0x14, 0x77, 0xa2, 0x20, 0x59, 0x9e, 0x9e, 0xf2, 0x65, 0x17, 0x00, 0xbf, 0xf4, 0x1c,
+	0x9f, 0xaf, 0x4a, 0x85, 0xf4, 0x05, 0xaa, 0x7d, 0x46, 0xae, 0xfa, 0x5a, 0x15, 0xb5, 0x68, 0xc1,
+	0xc1, 0xa5, 0x62, 0x78, 0x1b, 0x56, 0xd5, 0xb4, 0x9b, 0x6b, 0x4c, 0x2b, 0x92, 0x2a, 0xdb, 0xfb,
+	0x6d, 0x58, 0x55, 0x03, 0x41, 0xb2, 0xc5, 0x04, 0x9b, 0xa4, 0x0a, 0x36, 0x56, 0x0d, 0x6b, 0xdc,
+	0xa4, 0xdd, 0xc9, 0x27, 0xd1, 0x63, 0xdf, 0x74, 0xe9, 0xa5, 0x4c, 0x9b, 0x59, 0x2a, 0xa2, 0xf3,
+	0x4b, 0xc5, 0x3c, 0x7e, 0xb1, 0x4b, 0xe2, 0xf7, 0x36, 0x2a, 0xf1, 0x6b, 0xf8, 0x56, 0xdf, 0x19,
+	0xe1, 0x69, 0xeb, 0xd3, 0x2e, 0xd1, 0xfa, 0xd2, 0xb2, 0xa9, 0xa8, 0x62, 0x38, 0x67, 0x2f, 0x9a,
+	0x88, 0xa1, 0x9f, 0x42, 0x4a, 0x54, 0xba, 0xfa, 0x8c, 0x3a, 0x5f, 0x7b, 0x50, 0x42, 0xe8, 0x18,
+	0xf2, 0x27, 0x66, 0xa7, 0xfa, 0x8c, 0xb8, 0xd0, 0xf0, 0x5c, 0x9d, 0x1b, 0x9e, 0x01, 0x0b, 0xe9,
+	0x2a, 0x77, 0xf8, 0x88, 0x0c, 0xbb, 0x01, 0x25, 0xee, 0xe5, 0x3e, 0xdd, 0x6f, 0x41, 0xce, 0x14,
+	0x30, 0x1b, 0x93, 0x25, 0x3f, 0xa7, 0x67, 0x25, 0x6d, 0x9f, 0xed, 0xa9, 0xdf, 0xbc, 0xc1, 0x6d,
+	0xfe, 0x49, 0x83, 0xab, 0xa7, 0x96, 0x67, 0x74, 0x07, 0xca, 0xfa, 0xde, 0xce, 0xa1, 0xbe, 0x6b,
+	0xb4, 0x0e, 0xda, 0xc7, 0x47, 0x46, 0xe7, 0xa8, 0x71, 0x74, 0xdc, 0x31, 0x8e, 0x0f, 0x3a, 0xed,
+	0xbd, 0x9d, 0xd6, 0xa3, 0xd6, 0xde, 0x6e, 0x3e, 0x52, 0xcc, 0xbe, 0x7a, 0x53, 0x49, 0x1d, 0xbb,
+	0xcf, 0x5d, 0xf2, 0xc2, 0x45, 0x35, 0xf8, 0xf6, 0x22, 0x89, 0xb6, 0x7e, 0xd8, 0x3e, 0xec, 0xec,
+	0xed, 0xe6, 0xb5, 0x62, 0xee, 0xd5, 0x9b, 0x4a, 0xba, 0x2d, 0xbb, 0x0b, 0xda, 0x84, 0xe2, 0x22,
+	0x7e, 0x41, 0xcb, 0x47, 0x8b, 0xf0, 0xea, 0x4d, 0x45, 0x7e, 0x71, 0x6e, 0x86, 0x90, 0x9b, 0x5d,
+	0xb4, 0xd1, 0x4d, 0xb8, 0xae, 0xef, 0x75, 0x8e, 0x9f, 0x2c, 0xb6, 0x0b, 0xad, 0x03, 0x9a, 0xbf,
+	0x6e, 0x37, 0x3a, 0x9d, 0xbc, 0x76, 0x9a, 0xde, 0xf9, 0x79, 0xab, 0x9d, 0x8f, 0x9e, 0xa6, 0x3f,
+	0x6a, 0xb4, 0x9e, 0xe4, 0x63, 0xcd, 0xe7, 0xef, 0x3e, 0x96, 0xb4, 0xf7, 0x1f, 0x4b, 0xda, 0xff,
+	0x3f, 0x96, 0xb4, 0xd7, 0x9f, 0x4a, 0x91, 0xf7, 0x9f, 0x4a, 0x91, 0x7f, 0x7d, 0x2a, 0x45, 0xe0,
+	0xba, 0x43, 0x96, 0x20, 0xdd, 0xd6, 0x7e, 0xf9, 0xc3, 0x9e, 0x43, 0xfb, 0x61, 0xb7, 0x66, 0x91,
+	0x61, 0x7d, 0xca, 0xf4, 0x7d, 0x87, 0xcc, 0x9c, 0xea, 0x2f, 0xa7, 0xff, 0xfb, 0xb0, 0x8f, 0x9d,
+	0xa0, 0x9b, 0xe4, 0x45, 0xf8, 0x83, 0xaf, 0x07, 0x00, 0x16, 0x5c, 0xed, 0x69, 0xd0, 0x12, 0x00,
+	0x00,
+}
+
+func (m *Scope) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1428,62 +1676,80 @@ func (m *AuditFields) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AuditFields) MarshalTo(dAtA []byte) (int, error) {
+func (m *Scope) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Scope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.Message)))
+	if m.RequirePartyRollup {
 		i--
-		dAtA[i] = 0x32
-	}
-	if m.Version != 0 {
-		i = encodeVarintScope(dAtA, i, uint64(m.Version))
+		if m.RequirePartyRollup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x30
 	}
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.UpdatedBy)))
+	if len(m.ValueOwnerAddress) > 0 {
+		i -= len(m.ValueOwnerAddress)
+		copy(dAtA[i:], m.ValueOwnerAddress)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.ValueOwnerAddress)))
 		i--
-		dAtA[i] = 0x22
-	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedDate):])
-	if err3 != nil {
-		return 0, err3
+		dAtA[i] = 0x2a
 	}
-	i -= n3
-	i = encodeVarintScope(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.CreatedBy) > 0 {
-		i -= len(m.CreatedBy)
-		copy(dAtA[i:], m.CreatedBy)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.CreatedBy)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.DataAccess) > 0 {
+		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccess[iNdEx])
+			copy(dAtA[i:], m.DataAccess[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedDate):])
-	if err4 != nil {
-		return 0, err4
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
 	}
-	i -= n4
-	i = encodeVarintScope(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *NetAssetValue) Marshal() (dAtA []byte, err error) {
+func (m *Session) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1493,32 +1759,74 @@ func (m *NetAssetValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NetAssetValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *Session) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Volume != 0 {
-		i = encodeVarintScope(dAtA, i, uint64(m.Volume))
+	if m.Audit != nil {
+		{
+			size, err := m.Audit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScope(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.UpdatedBlockHeight != 0 {
-		i = encodeVarintScope(dAtA, i, uint64(m.UpdatedBlockHeight))
+	if len(m.Context) > 0 {
+		i -= len(m.Context)
+		copy(dAtA[i:], m.Context)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Context)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Parties) > 0 {
+		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SessionId.Size()
 		i -= size
+		if _, err := m.SessionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintScope(dAtA, i, uint64(size))
 	}
 	i--
@@ -1526,332 +1834,1567 @@ func (m *NetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
-	offset -= sovScope(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Record) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Scope) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Record) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, e := range m.Owners {
-			l = e.Size()
-			n += 1 + l + sovScope(uint64(l))
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
 	}
-	if len(m.DataAccess) > 0 {
-		for _, s := range m.DataAccess {
-			l = len(s)
-			n += 1 + l + sovScope(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	l = len(m.ValueOwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
-	}
-	if m.RequirePartyRollup {
-		n += 2
-	}
-	return n
-}
-
-func (m *Session) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	var l int
-	_ = l
-	l = m.SessionId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	if len(m.Parties) > 0 {
-		for _, e := range m.Parties {
-			l = e.Size()
-			n += 1 + l + sovScope(uint64(l))
+	{
+		size, err := m.Process.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SessionId.Size()
+		i -= size
+		if _, err := m.SessionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
 	}
-	l = len(m.Context)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Audit != nil {
-		l = m.Audit.Size()
-		n += 2 + l + sovScope(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Process) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Record) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Process) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Process) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.SessionId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	l = m.Process.Size()
-	n += 1 + l + sovScope(uint64(l))
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovScope(uint64(l))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Outputs) > 0 {
-		for _, e := range m.Outputs {
-			l = e.Size()
-			n += 1 + l + sovScope(uint64(l))
+	if m.ProcessId != nil {
+		{
+			size := m.ProcessId.Size()
+			i -= size
+			if _, err := m.ProcessId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
 	}
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Process) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProcessId != nil {
-		n += m.ProcessId.Size()
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
-	}
-	l = len(m.Method)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
-	}
-	return n
+func (m *Process_Address) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Process_Address) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	n += 1 + l + sovScope(uint64(l))
-	return n
+func (m *Process_Address) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintScope(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (m *Process_Hash) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	n += 1 + l + sovScope(uint64(l))
-	return n
+func (m *Process_Hash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *RecordInput) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Process_Hash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Hash)
+	copy(dAtA[i:], m.Hash)
+	i = encodeVarintScope(dAtA, i, uint64(len(m.Hash)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *RecordInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Source != nil {
-		n += m.Source.Size()
+	if len(m.TypeName) > 0 {
+		i -= len(m.TypeName)
+		copy(dAtA[i:], m.TypeName)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.TypeName)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.TypeName)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	if m.Source != nil {
+		{
+			size := m.Source.Size()
+			i -= size
+			if _, err := m.Source.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if m.Status != 0 {
-		n += 1 + sovScope(uint64(m.Status))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordInput_RecordId) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.RecordId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	return n
+func (m *RecordInput_RecordId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *RecordInput_Hash) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *RecordInput_RecordId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	{
+		size := m.RecordId.Size()
+		i -= size
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	n += 1 + l + sovScope(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
 }
-func (m *RecordOutput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordInput_Hash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordInput_Hash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Hash)
+	copy(dAtA[i:], m.Hash)
+	i = encodeVarintScope(dAtA, i, uint64(len(m.Hash)))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+func (m *RecordOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Status != 0 {
-		n += 1 + sovScope(uint64(m.Status))
+		i = encodeVarintScope(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Party) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Party) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Party) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Party) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	if m.Optional {
+		i--
+		if m.Optional {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.Role != 0 {
-		n += 1 + sovScope(uint64(m.Role))
+		i = encodeVarintScope(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Optional {
-		n += 2
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AuditFields) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AuditFields) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditFields) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedDate)
-	n += 1 + l + sovScope(uint64(l))
-	l = len(m.CreatedBy)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedDate)
-	n += 1 + l + sovScope(uint64(l))
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if m.Version != 0 {
-		n += 1 + sovScope(uint64(m.Version))
+		i = encodeVarintScope(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedDate):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintScope(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CreatedBy) > 0 {
+		i -= len(m.CreatedBy)
+		copy(dAtA[i:], m.CreatedBy)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.CreatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedDate):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintScope(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *NetAssetValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NetAssetValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NetAssetValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovScope(uint64(l))
-	if m.UpdatedBlockHeight != 0 {
-		n += 1 + sovScope(uint64(m.UpdatedBlockHeight))
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.Volume != 0 {
-		n += 1 + sovScope(uint64(m.Volume))
+		i = encodeVarintScope(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.UpdatedBlockHeight != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.UpdatedBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovScope(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *NetAssetValueRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozScope(x uint64) (n int) {
-	return sovScope(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *NetAssetValueRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *Scope) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForOwners := "[]Party{"
-	for _, f := range this.Owners {
-		repeatedStringForOwners += fmt.Sprintf("%v", f) + ","
+
+func (m *NetAssetValueRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	repeatedStringForOwners += "}"
-	s := strings.Join([]string{`&Scope{`,
-		`ScopeId:` + fmt.Sprintf("%v", this.ScopeId) + `,`,
-		`SpecificationId:` + fmt.Sprintf("%v", this.SpecificationId) + `,`,
-		`Owners:` + repeatedStringForOwners + `,`,
-		`DataAccess:` + fmt.Sprintf("%v", this.DataAccess) + `,`,
-		`ValueOwnerAddress:` + fmt.Sprintf("%v", this.ValueOwnerAddress) + `,`,
-		`RequirePartyRollup:` + fmt.Sprintf("%v", this.RequirePartyRollup) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Session) String() string {
-	if this == nil {
-		return "nil"
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	repeatedStringForParties := "[]Party{"
-	for _, f := range this.Parties {
-		repeatedStringForParties += fmt.Sprintf("%v", f) + ","
+	i -= n6
+	i = encodeVarintScope(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.NetAssetValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
 	}
-	repeatedStringForParties += "}"
-	s := strings.Join([]string{`&Session{`,
-		`SessionId:` + fmt.Sprintf("%v", this.SessionId) + `,`,
-		`SpecificationId:` + fmt.Sprintf("%v", this.SpecificationId) + `,`,
-		`Parties:` + repeatedStringForParties + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Context:` + fmt.Sprintf("%v", this.Context) + `,`,
-		`Audit:` + strings.Replace(fmt.Sprintf("%v", this.Audit), "AuditFields", "AuditFields", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *Record) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForInputs := "[]RecordInput{"
-	for _, f := range this.Inputs {
-		repeatedStringForInputs += fmt.Sprintf("%v", f) + ","
-	}
-	repeatedStringForInputs += "}"
-	repeatedStringForOutputs := "[]RecordOutput{"
-	for _, f := range this.Outputs {
-		repeatedStringForOutputs += fmt.Sprintf("%v", f) + ","
+
+func (m *EntryChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntryChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntryChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintScope(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChangedBy) > 0 {
+		for iNdEx := len(m.ChangedBy) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedBy[iNdEx])
+			copy(dAtA[i:], m.ChangedBy[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.ChangedBy[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Version != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Version != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Version != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintScope(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x42
+	if len(m.CurrentValueOwnerAddress) > 0 {
+		i -= len(m.CurrentValueOwnerAddress)
+		copy(dAtA[i:], m.CurrentValueOwnerAddress)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.CurrentValueOwnerAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CurrentOwners) > 0 {
+		for iNdEx := len(m.CurrentOwners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ProposedBy) > 0 {
+		for iNdEx := len(m.ProposedBy) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProposedBy[iNdEx])
+			copy(dAtA[i:], m.ProposedBy[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.ProposedBy[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Recipients[iNdEx])
+			copy(dAtA[i:], m.Recipients[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.Recipients[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ValueOwnerAddress) > 0 {
+		i -= len(m.ValueOwnerAddress)
+		copy(dAtA[i:], m.ValueOwnerAddress)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.ValueOwnerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeHeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeHeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeHeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeDataAccessGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeDataAccessGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeDataAccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintScope(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeArchive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeArchive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeArchive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeTombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeTombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeTombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ArchiveHash) > 0 {
+		i -= len(m.ArchiveHash)
+		copy(dAtA[i:], m.ArchiveHash)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.ArchiveHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScope(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Scope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = len(m.ValueOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.RequirePartyRollup {
+		n += 2
+	}
+	return n
+}
+
+func (m *Session) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SessionId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Parties) > 0 {
+		for _, e := range m.Parties {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.Context)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Audit != nil {
+		l = m.Audit.Size()
+		n += 2 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *Record) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = m.SessionId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = m.Process.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
+func (m *Process) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProcessId != nil {
+		n += m.ProcessId.Size()
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *Process_Address) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+func (m *Process_Hash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+func (m *RecordInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Source != nil {
+		n += m.Source.Size()
+	}
+	l = len(m.TypeName)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovScope(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *RecordInput_RecordId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+func (m *RecordInput_Hash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+func (m *RecordOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovScope(uint64(m.Status))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *Party) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovScope(uint64(m.Role))
+	}
+	if m.Optional {
+		n += 2
+	}
+	return n
+}
+
+func (m *AuditFields) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedDate)
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedDate)
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovScope(uint64(m.Version))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *NetAssetValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if m.UpdatedBlockHeight != 0 {
+		n += 1 + sovScope(uint64(m.UpdatedBlockHeight))
+	}
+	if m.Volume != 0 {
+		n += 1 + sovScope(uint64(m.Volume))
+	}
+	if m.Stale {
+		n += 2
+	}
+	return n
+}
+
+func (m *NetAssetValueRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetAssetValue.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *EntryChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChangedBy) > 0 {
+		for _, s := range m.ChangedBy {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovScope(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovScope(uint64(l))
+	if m.Deleted {
+		n += 2
+	}
+	return n
+}
+
+func (m *ScopeVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovScope(uint64(m.Version))
+	}
+	l = m.Scope.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = m.Change.Size()
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
+func (m *SessionVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovScope(uint64(m.Version))
+	}
+	l = m.Session.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = m.Change.Size()
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
+func (m *RecordVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovScope(uint64(m.Version))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = m.Change.Size()
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
+func (m *ScopeOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = len(m.ValueOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, s := range m.Recipients {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if len(m.ProposedBy) > 0 {
+		for _, s := range m.ProposedBy {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if len(m.CurrentOwners) > 0 {
+		for _, e := range m.CurrentOwners {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = len(m.CurrentValueOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
+func (m *ScopeHeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if m.CreatedHeight != 0 {
+		n += 1 + sovScope(uint64(m.CreatedHeight))
+	}
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovScope(uint64(m.UpdatedHeight))
+	}
+	return n
+}
+
+func (m *ScopeDataAccessGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
+func (m *ScopeArchive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Scope.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScopeTombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.ArchiveHash)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = m.Change.Size()
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
+func sovScope(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScope(x uint64) (n int) {
+	return sovScope(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Scope) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOwners := "[]Party{"
+	for _, f := range this.Owners {
+		repeatedStringForOwners += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForOwners += "}"
+	s := strings.Join([]string{`&Scope{`,
+		`ScopeId:` + fmt.Sprintf("%v", this.ScopeId) + `,`,
+		`SpecificationId:` + fmt.Sprintf("%v", this.SpecificationId) + `,`,
+		`Owners:` + repeatedStringForOwners + `,`,
+		`DataAccess:` + fmt.Sprintf("%v", this.DataAccess) + `,`,
+		`ValueOwnerAddress:` + fmt.Sprintf("%v", this.ValueOwnerAddress) + `,`,
+		`RequirePartyRollup:` + fmt.Sprintf("%v", this.RequirePartyRollup) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Session) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParties := "[]Party{"
+	for _, f := range this.Parties {
+		repeatedStringForParties += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForParties += "}"
+	s := strings.Join([]string{`&Session{`,
+		`SessionId:` + fmt.Sprintf("%v", this.SessionId) + `,`,
+		`SpecificationId:` + fmt.Sprintf("%v", this.SpecificationId) + `,`,
+		`Parties:` + repeatedStringForParties + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Context:` + fmt.Sprintf("%v", this.Context) + `,`,
+		`Audit:` + strings.Replace(fmt.Sprintf("%v", this.Audit), "AuditFields", "AuditFields", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Record) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForInputs := "[]RecordInput{"
+	for _, f := range this.Inputs {
+		repeatedStringForInputs += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForInputs += "}"
+	repeatedStringForOutputs := "[]RecordOutput{"
+	for _, f := range this.Outputs {
+		repeatedStringForOutputs += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForOutputs += "}"
 	s := strings.Join([]string{`&Record{`,
@@ -1865,15 +3408,1755 @@ func (this *Record) String() string {
 	}, "")
 	return s
 }
-func valueToStringScope(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func valueToStringScope(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Scope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Scope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Scope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, Party{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequirePartyRollup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequirePartyRollup = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Session) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Session: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Session: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SessionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parties = append(m.Parties, Party{})
+			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = append(m.Context[:0], dAtA[iNdEx:postIndex]...)
+			if m.Context == nil {
+				m.Context = []byte{}
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Audit == nil {
+				m.Audit = &AuditFields{}
+			}
+			if err := m.Audit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Record) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Record: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Record: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SessionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, RecordInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, RecordOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Process) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Process: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Process: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessId = &Process_Address{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessId = &Process_Hash{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var vv MetadataAddress
+			v := &vv
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Source = &RecordInput_RecordId{*v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = &RecordInput_Hash{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RecordInputStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ResultStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Party) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Party: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Party: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= PartyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optional = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditFields) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetAssetValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
+			}
+			m.UpdatedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Scope) Unmarshal(dAtA []byte) error {
+func (m *NetAssetValueRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1896,17 +5179,17 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Scope: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetAssetValueRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Scope: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetAssetValueRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValue", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -1916,61 +5199,28 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetAssetValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowScope
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthScope
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1997,46 +5247,13 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owners = append(m.Owners, Party{})
-			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowScope
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthScope
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2064,28 +5281,8 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequirePartyRollup", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowScope
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequirePartyRollup = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -2107,7 +5304,7 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Session) Unmarshal(dAtA []byte) error {
+func (m *EntryChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2130,17 +5327,17 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Session: wiretype end group for non-group")
+			return fmt.Errorf("proto: EntryChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Session: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EntryChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedBy", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2150,30 +5347,29 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SessionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChangedBy = append(m.ChangedBy, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2183,28 +5379,14 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthScope
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2231,16 +5413,15 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parties = append(m.Parties, Party{})
-			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2250,29 +5431,86 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthScope
+			m.Deleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthScope
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2282,29 +5520,28 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Context = append(m.Context[:0], dAtA[iNdEx:postIndex]...)
-			if m.Context == nil {
-				m.Context = []byte{}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 99:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Audit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2331,10 +5568,7 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Audit == nil {
-				m.Audit = &AuditFields{}
-			}
-			if err := m.Audit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2359,7 +5593,7 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Record) Unmarshal(dAtA []byte) error {
+func (m *SessionVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2382,17 +5616,17 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Record: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Record: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2402,29 +5636,16 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthScope
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2434,28 +5655,28 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SessionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2482,15 +5703,65 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2500,29 +5771,14 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthScope
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Inputs = append(m.Inputs, RecordInput{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2549,16 +5805,15 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Outputs = append(m.Outputs, RecordOutput{})
-			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2568,22 +5823,22 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2608,7 +5863,7 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Process) Unmarshal(dAtA []byte) error {
+func (m *ScopeOwnershipTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2631,17 +5886,17 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Process: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeOwnershipTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Process: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2651,29 +5906,30 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessId = &Process_Address{string(dAtA[iNdEx:postIndex])}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2683,27 +5939,29 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessId = &Process_Hash{string(dAtA[iNdEx:postIndex])}
+			m.Owners = append(m.Owners, Party{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2731,11 +5989,11 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2763,61 +6021,11 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			m.Recipients = append(m.Recipients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipScope(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthScope
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RecordInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowScope
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2845,13 +6053,13 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ProposedBy = append(m.ProposedBy, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOwners", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2861,63 +6069,29 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var vv MetadataAddress
-			v := &vv
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CurrentOwners = append(m.CurrentOwners, Party{})
+			if err := m.CurrentOwners[len(m.CurrentOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Source = &RecordInput_RecordId{*v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowScope
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthScope
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Source = &RecordInput_Hash{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValueOwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2945,13 +6119,13 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TypeName = string(dAtA[iNdEx:postIndex])
+			m.CurrentValueOwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2961,11 +6135,25 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= RecordInputStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -2987,7 +6175,7 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordOutput) Unmarshal(dAtA []byte) error {
+func (m *ScopeHeights) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3010,17 +6198,17 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeHeights: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeHeights: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3030,29 +6218,30 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
 			}
-			m.Status = 0
+			m.CreatedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3062,7 +6251,26 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ResultStatus(b&0x7F) << shift
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3088,7 +6296,7 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Party) Unmarshal(dAtA []byte) error {
+func (m *ScopeDataAccessGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3111,17 +6319,17 @@ func (m *Party) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Party: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeDataAccessGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Party: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeDataAccessGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3131,29 +6339,30 @@ func (m *Party) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Role = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3163,16 +6372,29 @@ func (m *Party) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= PartyType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3182,12 +6404,25 @@ func (m *Party) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Optional = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -3209,7 +6444,7 @@ func (m *Party) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuditFields) Unmarshal(dAtA []byte) error {
+func (m *ScopeArchive) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3232,15 +6467,15 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuditFields: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeArchive: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuditFields: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeArchive: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3267,15 +6502,15 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedDate, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3285,27 +6520,29 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			m.Sessions = append(m.Sessions, Session{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3332,15 +6569,16 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedDate, dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3350,74 +6588,25 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowScope
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowScope
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthScope
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3440,7 +6629,7 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
+func (m *ScopeTombstone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3463,17 +6652,17 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetAssetValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeTombstone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3483,30 +6672,30 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveHash", wireType)
 			}
-			m.UpdatedBlockHeight = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3516,16 +6705,31 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedBlockHeight |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchiveHash = append(m.ArchiveHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ArchiveHash == nil {
+				m.ArchiveHash = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
 			}
-			m.Volume = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3535,11 +6739,25 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
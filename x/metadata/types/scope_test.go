@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/hex"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -680,6 +681,31 @@ func (s *ScopeTestSuite) TestRecordValidateBasic() {
 			"",
 			false,
 		},
+		{
+			"Valid record, record output with a JSON value",
+			NewRecord("name", sessionID, *validPs,
+				[]RecordInput{*validRI},
+				[]RecordOutput{{Hash: "hash", Status: ResultStatus_RESULT_STATUS_PASS, Value: `{"status":"funded"}`}}, nil),
+			"",
+			false,
+		},
+		{
+			"Invalid record, record output value not JSON",
+			NewRecord("name", sessionID, *validPs,
+				[]RecordInput{*validRI},
+				[]RecordOutput{{Hash: "hash", Status: ResultStatus_RESULT_STATUS_PASS, Value: "funded"}}, nil),
+			"invalid record output: invalid record output value, value is not valid JSON",
+			true,
+		},
+		{
+			"Invalid record, record output value too long",
+			NewRecord("name", sessionID, *validPs,
+				[]RecordInput{*validRI},
+				[]RecordOutput{{Hash: "hash", Status: ResultStatus_RESULT_STATUS_PASS, Value: `"` + strings.Repeat("x", maxRecordOutputValueLength) + `"`}}, nil),
+			fmt.Sprintf("invalid record output: record output value exceeds maximum length (expected <= %d got: %d)",
+				maxRecordOutputValueLength, maxRecordOutputValueLength+2),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
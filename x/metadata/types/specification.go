@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
@@ -22,6 +23,8 @@ const (
 	maxRecordSpecificationNameLength = 200
 	// Default max length for a RecordSpecification.TypeName
 	maxRecordSpecificationTypeNameLength = 1000
+	// Default max length (in bytes) for a RecordSpecification.OutputSchema
+	maxRecordSpecificationOutputSchemaLength = 10000
 	// Default max length for InputSpecification.Name
 	maxInputSpecificationNameLength = 200
 	// Default max length for a InputSpecification.TypeName
@@ -91,6 +94,22 @@ func (s ScopeSpecification) ValidateBasic() error {
 				i, PrefixContractSpecification, prefix)
 		}
 	}
+	if !s.Supersedes.Empty() {
+		if err = s.Supersedes.ValidateIsScopeSpecificationAddress(); err != nil {
+			return fmt.Errorf("invalid supersedes scope specification id: %w", err)
+		}
+		if s.Supersedes.Equals(s.SpecificationId) {
+			return errors.New("a scope specification cannot supersede itself")
+		}
+	}
+	if !s.SupersededBy.Empty() {
+		if err = s.SupersededBy.ValidateIsScopeSpecificationAddress(); err != nil {
+			return fmt.Errorf("invalid superseded by scope specification id: %w", err)
+		}
+		if s.SupersededBy.Equals(s.SpecificationId) {
+			return errors.New("a scope specification cannot be superseded by itself")
+		}
+	}
 	return nil
 }
 
@@ -236,9 +255,31 @@ func (s RecordSpecification) ValidateBasic() error {
 	if s.ResultType == DefinitionType_DEFINITION_TYPE_UNSPECIFIED {
 		return errors.New("record specification result type cannot be unspecified")
 	}
+	if len(s.OutputSchema) > maxRecordSpecificationOutputSchemaLength {
+		return fmt.Errorf("record specification output schema exceeds maximum length (expected <= %d got: %d)",
+			maxRecordSpecificationOutputSchemaLength, len(s.OutputSchema))
+	}
+	if len(s.OutputSchema) > 0 {
+		if _, err := s.GetOutputJSONSchema(); err != nil {
+			return fmt.Errorf("invalid record specification output schema: %w", err)
+		}
+	}
 	return nil
 }
 
+// GetOutputJSONSchema decodes the output schema of this RecordSpecification.
+// Returns nil if it doesn't have an output schema.
+func (s RecordSpecification) GetOutputJSONSchema() (map[string]interface{}, error) {
+	if len(s.OutputSchema) == 0 {
+		return nil, nil
+	}
+	var schema map[string]interface{}
+	if err := json.Unmarshal([]byte(s.OutputSchema), &schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
 // NewInputSpecification creates a new InputSpecification instance
 func NewInputSpecification(
 	name string,
@@ -163,6 +163,12 @@ type ScopeSpecification struct {
 	PartiesInvolved []PartyType `protobuf:"varint,4,rep,packed,name=parties_involved,json=partiesInvolved,proto3,enum=provenance.metadata.v1.PartyType" json:"parties_involved,omitempty"`
 	// A list of contract specification ids allowed for a scope based on this specification.
 	ContractSpecIds []MetadataAddress `protobuf:"bytes,5,rep,name=contract_spec_ids,json=contractSpecIds,proto3,customtype=MetadataAddress" json:"contract_spec_ids"`
+	// The id of the scope specification that this one is a newer version of.
+	// Once set, it cannot be changed.
+	Supersedes MetadataAddress `protobuf:"bytes,6,opt,name=supersedes,proto3,customtype=MetadataAddress" json:"supersedes"`
+	// The id of the scope specification that is a newer version of this one.
+	// This is set by the module when a scope specification that supersedes this one is written.
+	SupersededBy MetadataAddress `protobuf:"bytes,7,opt,name=superseded_by,json=supersededBy,proto3,customtype=MetadataAddress" json:"superseded_by"`
 }
 
 func (m *ScopeSpecification) Reset()      { *m = ScopeSpecification{} }
@@ -232,7 +238,6 @@ type ContractSpecification struct {
 	// contract
 	//
 	// Types that are valid to be assigned to Source:
-	//
 	//	*ContractSpecification_ResourceId
 	//	*ContractSpecification_Hash
 	Source isContractSpecification_Source `protobuf_oneof:"source"`
@@ -359,6 +364,11 @@ type RecordSpecification struct {
 	ResultType DefinitionType `protobuf:"varint,5,opt,name=result_type,json=resultType,proto3,enum=provenance.metadata.v1.DefinitionType" json:"result_type,omitempty"`
 	// Type of party responsible for this record
 	ResponsibleParties []PartyType `protobuf:"varint,6,rep,packed,name=responsible_parties,json=responsibleParties,proto3,enum=provenance.metadata.v1.PartyType" json:"responsible_parties,omitempty"`
+	// output_schema is an optional JSON schema that the value of each (non-skipped) output of records
+	// created with this specification must conform to. It can be at most 10000 bytes.
+	// Only the object (with properties and required), array (with items), and string types are supported, and each
+	// schema (including each property and items schema) must have one of those types. Other keywords (e.g. enum) are ignored.
+	OutputSchema string `protobuf:"bytes,7,opt,name=output_schema,json=outputSchema,proto3" json:"output_schema,omitempty"`
 }
 
 func (m *RecordSpecification) Reset()      { *m = RecordSpecification{} }
@@ -428,6 +438,13 @@ func (m *RecordSpecification) GetResponsibleParties() []PartyType {
 	return nil
 }
 
+func (m *RecordSpecification) GetOutputSchema() string {
+	if m != nil {
+		return m.OutputSchema
+	}
+	return ""
+}
+
 // InputSpecification defines a name, type_name, and source reference (either on or off chain) to define an input
 // parameter
 type InputSpecification struct {
@@ -438,7 +455,6 @@ type InputSpecification struct {
 	// source is either on chain (record_id) or off-chain (hash)
 	//
 	// Types that are valid to be assigned to Source:
-	//
 	//	*InputSpecification_RecordId
 	//	*InputSpecification_Hash
 	Source isInputSpecification_Source `protobuf_oneof:"source"`
@@ -616,66 +632,69 @@ func init() {
 }
 
 var fileDescriptor_1e2d1042057ea889 = []byte{
-	// 932 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0xe3, 0x34, 0x4d, 0x5e, 0x96, 0x76, 0x76, 0xda, 0xed, 0xba, 0xbb, 0x90, 0x84, 0x22,
-	0x41, 0x55, 0xa9, 0x89, 0x9a, 0x5d, 0x38, 0x70, 0x73, 0x12, 0xb7, 0x1d, 0x29, 0x6b, 0x47, 0x13,
-	0xa7, 0x68, 0xb9, 0x58, 0xae, 0x3d, 0xdb, 0x5a, 0x9b, 0xd8, 0x96, 0xc7, 0xc9, 0xd2, 0x13, 0xfc,
-	0x00, 0x0e, 0x1c, 0x39, 0x22, 0xf1, 0x27, 0x38, 0x73, 0xda, 0xe3, 0x1e, 0x11, 0x42, 0x15, 0x6a,
-	0xc5, 0x4f, 0xe0, 0xc2, 0x09, 0x79, 0x92, 0x6e, 0x9c, 0x90, 0x20, 0x0e, 0x1c, 0xf7, 0x94, 0x99,
-	0xf7, 0x7d, 0xef, 0xcd, 0x7b, 0xdf, 0x37, 0x13, 0x19, 0x0e, 0xc2, 0x28, 0x18, 0x33, 0xdf, 0xf6,
-	0x1d, 0x56, 0x1f, 0xb2, 0xd8, 0x76, 0xed, 0xd8, 0xae, 0x8f, 0x8f, 0xea, 0x3c, 0x64, 0x8e, 0xf7,
-	0xc2, 0x73, 0xec, 0xd8, 0x0b, 0xfc, 0x5a, 0x18, 0x05, 0x71, 0x80, 0x77, 0x66, 0xdc, 0xda, 0x1d,
-	0xb7, 0x36, 0x3e, 0x7a, 0xb4, 0x7d, 0x11, 0x5c, 0x04, 0x82, 0x52, 0x4f, 0x56, 0x13, 0xf6, 0xde,
-	0x1f, 0x59, 0xc0, 0x3d, 0x27, 0x08, 0x59, 0x2f, 0x5d, 0x0a, 0x37, 0x01, 0xcd, 0xd5, 0xb6, 0x3c,
-	0x57, 0x91, 0xaa, 0xd2, 0xfe, 0xbd, 0xe6, 0xc3, 0xd7, 0xd7, 0x95, 0xcc, 0xaf, 0xd7, 0x95, 0xcd,
-	0x67, 0xd3, 0xda, 0xaa, 0xeb, 0x46, 0x8c, 0x73, 0xba, 0x39, 0x97, 0x40, 0x5c, 0xac, 0x41, 0xc9,
-	0x65, 0xdc, 0x89, 0xbc, 0x30, 0x09, 0x28, 0xd9, 0xaa, 0xb4, 0x5f, 0x6a, 0x7c, 0x54, 0x5b, 0xde,
-	0x5e, 0xad, 0x3d, 0xa3, 0xd2, 0x74, 0x1e, 0xfe, 0x04, 0x36, 0x83, 0x57, 0x3e, 0x8b, 0x2c, 0x7b,
-	0x72, 0x10, 0xe3, 0x8a, 0x5c, 0x95, 0xf7, 0x8b, 0x74, 0x43, 0x84, 0xd5, 0xbb, 0x28, 0xee, 0x00,
-	0x0a, 0xed, 0x28, 0xf6, 0x18, 0xb7, 0x3c, 0x7f, 0x1c, 0x0c, 0xc6, 0xcc, 0x55, 0x72, 0x55, 0x79,
-	0x7f, 0xa3, 0xf1, 0xe1, 0xaa, 0x43, 0xbb, 0x76, 0x14, 0x5f, 0x99, 0x57, 0x21, 0xa3, 0x9b, 0xd3,
-	0x54, 0x32, 0xcd, 0xc4, 0x2d, 0xb8, 0xef, 0x04, 0x7e, 0x1c, 0xd9, 0x4e, 0x6c, 0x25, 0x93, 0x59,
-	0x9e, 0xcb, 0x95, 0xb5, 0xaa, 0xfc, 0xaf, 0x12, 0xdc, 0x65, 0x24, 0x62, 0x12, 0x97, 0x7f, 0x5e,
-	0xf8, 0xfe, 0x87, 0x4a, 0xe6, 0x9b, 0xdf, 0xaa, 0xd2, 0xde, 0x4f, 0x32, 0x3c, 0x68, 0xa5, 0xd0,
-	0x77, 0x52, 0xcf, 0xa4, 0x36, 0xa1, 0x14, 0x31, 0x1e, 0x8c, 0x22, 0x87, 0x25, 0xc3, 0xaf, 0x89,
-	0xe1, 0x8f, 0xfe, 0xba, 0xae, 0x1c, 0x5e, 0x78, 0xf1, 0xe5, 0xe8, 0xbc, 0xe6, 0x04, 0xc3, 0xba,
-	0x13, 0xf0, 0x61, 0xc0, 0xa7, 0x3f, 0x87, 0xdc, 0x7d, 0x59, 0x8f, 0xaf, 0x42, 0xc6, 0x6b, 0xaa,
-	0xe3, 0x4c, 0xfb, 0x3a, 0xcd, 0x50, 0xb8, 0xab, 0x43, 0x5c, 0xbc, 0x0d, 0xb9, 0x4b, 0x9b, 0x5f,
-	0x2a, 0xf9, 0xaa, 0xb4, 0x5f, 0x3c, 0xcd, 0x50, 0xb1, 0xc3, 0x1f, 0x00, 0x38, 0x03, 0x9b, 0x73,
-	0xcb, 0xb7, 0x87, 0x4c, 0x59, 0x4f, 0x30, 0x5a, 0x14, 0x11, 0xdd, 0x1e, 0xb2, 0x99, 0x61, 0xcd,
-	0x02, 0xe4, 0x27, 0xa5, 0xf6, 0xfe, 0xcc, 0xc2, 0x16, 0x65, 0x4e, 0x10, 0xb9, 0xff, 0xbf, 0x71,
-	0x18, 0x72, 0xa2, 0x91, 0xac, 0x68, 0x44, 0xac, 0x71, 0x13, 0xf2, 0x9e, 0x1f, 0x8e, 0xe2, 0x89,
-	0xf8, 0xa5, 0xc6, 0xc1, 0x2a, 0x49, 0x49, 0xc2, 0x9a, 0xeb, 0x89, 0x4e, 0x33, 0xf1, 0x63, 0x28,
-	0x26, 0xf2, 0x4c, 0xa6, 0xcc, 0x89, 0xe2, 0x85, 0x24, 0x90, 0x0c, 0x89, 0x4f, 0x84, 0xde, 0xa3,
-	0x41, 0x6c, 0x25, 0x21, 0xa1, 0xf7, 0x46, 0xe3, 0xe3, 0xd5, 0xb7, 0xe5, 0x85, 0xe7, 0x7b, 0x49,
-	0x75, 0xe1, 0x1e, 0x4c, 0x52, 0x93, 0x35, 0xa6, 0xb0, 0x15, 0x31, 0x1e, 0x06, 0x3e, 0xf7, 0xce,
-	0x07, 0xcc, 0x9a, 0xfa, 0xaa, 0xe4, 0xff, 0xeb, 0x4d, 0xc0, 0xa9, 0xec, 0xee, 0x24, 0x39, 0xf5,
-	0x64, 0x7e, 0x94, 0x00, 0xff, 0x73, 0xc4, 0xb7, 0x92, 0x49, 0x29, 0xc9, 0xe6, 0xc6, 0xcd, 0x2e,
-	0x8c, 0xdb, 0x80, 0x62, 0x24, 0xec, 0x4b, 0x0c, 0x92, 0x85, 0x41, 0x5b, 0x4b, 0xcc, 0x39, 0xcd,
-	0xd0, 0xc2, 0x84, 0x97, 0xba, 0x3c, 0xb9, 0xf4, 0xe5, 0x59, 0x7a, 0x3b, 0xbe, 0x86, 0x52, 0xea,
-	0x3d, 0x2d, 0xed, 0xae, 0x3a, 0xff, 0x3a, 0x65, 0x01, 0xcd, 0x3d, 0xbc, 0x0a, 0x94, 0x5e, 0xb1,
-	0x73, 0xee, 0xc5, 0xcc, 0x1a, 0x45, 0x83, 0xa9, 0x61, 0x30, 0x0d, 0xf5, 0xa3, 0x01, 0xde, 0x85,
-	0x82, 0xe7, 0x04, 0xbe, 0x40, 0xd7, 0x04, 0xba, 0x9e, 0xec, 0xfb, 0xd1, 0xe0, 0xe0, 0x5b, 0x09,
-	0x36, 0xe6, 0x3d, 0xc2, 0x15, 0x78, 0xdc, 0xd6, 0x8e, 0x89, 0x4e, 0x4c, 0x62, 0xe8, 0x96, 0xf9,
-	0xbc, 0xab, 0x59, 0x7d, 0xbd, 0xd7, 0xd5, 0x5a, 0xe4, 0x98, 0x68, 0x6d, 0x94, 0xc1, 0xef, 0x83,
-	0xb2, 0x48, 0xe8, 0x52, 0xa3, 0x6b, 0xf4, 0xb4, 0x36, 0x92, 0xf0, 0x23, 0xd8, 0x59, 0x44, 0xa9,
-	0xd6, 0x32, 0x68, 0x1b, 0x65, 0x97, 0x95, 0x9e, 0x60, 0x56, 0x87, 0xf4, 0x4c, 0x24, 0x1f, 0xfc,
-	0x2c, 0x43, 0xf1, 0xad, 0xc3, 0x49, 0xa9, 0xae, 0x4a, 0xcd, 0xe7, 0xcb, 0x9a, 0xd8, 0x85, 0x07,
-	0x29, 0xcc, 0xa0, 0xe4, 0x84, 0xe8, 0xaa, 0x69, 0x50, 0x24, 0xe1, 0x87, 0xb0, 0x95, 0x82, 0x7a,
-	0x1a, 0x3d, 0x23, 0x2d, 0x8d, 0xa2, 0xec, 0x02, 0x40, 0xf4, 0x33, 0xad, 0x97, 0x64, 0xc8, 0x58,
-	0x81, 0xed, 0x14, 0xd0, 0xea, 0xf7, 0x4c, 0xa3, 0x4d, 0x54, 0x1d, 0xe5, 0xf0, 0x36, 0xa0, 0xf4,
-	0x31, 0x5f, 0xe8, 0x1a, 0x45, 0x6b, 0x0b, 0x7c, 0xf5, 0xf8, 0x98, 0x74, 0x88, 0x6a, 0x6a, 0x28,
-	0x8f, 0x77, 0x00, 0xa7, 0xf9, 0xcf, 0x74, 0xd2, 0xec, 0xf7, 0xd0, 0xfa, 0x42, 0xbb, 0x5d, 0x6a,
-	0x9c, 0x69, 0xba, 0xaa, 0xb7, 0x34, 0x54, 0x58, 0x80, 0x5a, 0x86, 0x6e, 0x52, 0xa3, 0xd3, 0xd1,
-	0x28, 0x82, 0x85, 0x73, 0xce, 0xd4, 0x0e, 0x69, 0x8b, 0x19, 0x4b, 0x0b, 0xc8, 0x89, 0xa6, 0x6b,
-	0x94, 0xb4, 0xac, 0x23, 0x74, 0x6f, 0x05, 0xd2, 0x40, 0xef, 0xad, 0x40, 0x9e, 0xa0, 0x8d, 0x15,
-	0xc8, 0x53, 0xb4, 0xb9, 0x02, 0xf9, 0x14, 0xa1, 0x15, 0xc8, 0x67, 0xe8, 0x7e, 0xf3, 0xe5, 0xeb,
-	0x9b, 0xb2, 0xf4, 0xe6, 0xa6, 0x2c, 0xfd, 0x7e, 0x53, 0x96, 0xbe, 0xbb, 0x2d, 0x67, 0xde, 0xdc,
-	0x96, 0x33, 0xbf, 0xdc, 0x96, 0x33, 0xb0, 0xeb, 0x05, 0x2b, 0xde, 0x75, 0x57, 0xfa, 0xf2, 0x69,
-	0xea, 0xff, 0x7a, 0x46, 0x3a, 0xf4, 0x82, 0xd4, 0xae, 0xfe, 0xd5, 0xec, 0x0b, 0x46, 0xfc, 0x83,
-	0x9f, 0xe7, 0xc5, 0x97, 0xc8, 0x93, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x69, 0xf6, 0xec,
-	0xe5, 0x08, 0x00, 0x00,
+	// 981 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xeb, 0x34, 0x4d, 0x5e, 0xfa, 0x63, 0x76, 0xda, 0xed, 0xa6, 0xbb, 0x90, 0x84, 0xae,
+	0x04, 0x55, 0xa5, 0x26, 0x6a, 0x76, 0x01, 0x09, 0x71, 0xc9, 0x0f, 0xb7, 0x1d, 0x29, 0xeb, 0x44,
+	0x93, 0xb4, 0x68, 0xb9, 0x58, 0xae, 0x3d, 0xdb, 0x5a, 0x9b, 0x78, 0x2c, 0x8f, 0xd3, 0xa5, 0x27,
+	0xf8, 0x03, 0x38, 0x70, 0x44, 0x9c, 0x90, 0xf8, 0x27, 0x38, 0x73, 0xda, 0xe3, 0x1e, 0x11, 0x5a,
+	0x55, 0xa8, 0xfd, 0x2f, 0x38, 0x21, 0x8f, 0xdd, 0xc6, 0x09, 0x49, 0xc5, 0x81, 0x23, 0xa7, 0xcc,
+	0xbc, 0xef, 0x7b, 0x6f, 0xde, 0x7c, 0xdf, 0x9b, 0xc8, 0xb0, 0xeb, 0xf9, 0xfc, 0x82, 0xb9, 0xa6,
+	0x6b, 0xb1, 0xea, 0x90, 0x05, 0xa6, 0x6d, 0x06, 0x66, 0xf5, 0x62, 0xbf, 0x2a, 0x3c, 0x66, 0x39,
+	0xaf, 0x1c, 0xcb, 0x0c, 0x1c, 0xee, 0x56, 0x3c, 0x9f, 0x07, 0x1c, 0x6f, 0x8e, 0xb9, 0x95, 0x5b,
+	0x6e, 0xe5, 0x62, 0xff, 0xf1, 0xc6, 0x19, 0x3f, 0xe3, 0x92, 0x52, 0x0d, 0x57, 0x11, 0x7b, 0xfb,
+	0xbd, 0x0a, 0xb8, 0x67, 0x71, 0x8f, 0xf5, 0x92, 0xa5, 0x70, 0x03, 0xd0, 0x44, 0x6d, 0xc3, 0xb1,
+	0x0b, 0x4a, 0x59, 0xd9, 0x59, 0x6e, 0x3c, 0x7a, 0x7b, 0x55, 0x4a, 0xfd, 0x71, 0x55, 0x5a, 0x7b,
+	0x11, 0xd7, 0xae, 0xdb, 0xb6, 0xcf, 0x84, 0xa0, 0x6b, 0x13, 0x09, 0xc4, 0xc6, 0x1a, 0xe4, 0x6d,
+	0x26, 0x2c, 0xdf, 0xf1, 0xc2, 0x40, 0x61, 0xa1, 0xac, 0xec, 0xe4, 0x6b, 0x4f, 0x2b, 0xb3, 0xdb,
+	0xab, 0xb4, 0xc6, 0x54, 0x9a, 0xcc, 0xc3, 0x9f, 0xc0, 0x1a, 0x7f, 0xe3, 0x32, 0xdf, 0x30, 0xa3,
+	0x83, 0x98, 0x28, 0xa8, 0x65, 0x75, 0x27, 0x47, 0x57, 0x65, 0xb8, 0x7e, 0x1b, 0xc5, 0x6d, 0x40,
+	0x9e, 0xe9, 0x07, 0x0e, 0x13, 0x86, 0xe3, 0x5e, 0xf0, 0xc1, 0x05, 0xb3, 0x0b, 0xe9, 0xb2, 0xba,
+	0xb3, 0x5a, 0xfb, 0x68, 0xde, 0xa1, 0x5d, 0xd3, 0x0f, 0x2e, 0xfb, 0x97, 0x1e, 0xa3, 0x6b, 0x71,
+	0x2a, 0x89, 0x33, 0x71, 0x13, 0x1e, 0x58, 0xdc, 0x0d, 0x7c, 0xd3, 0x0a, 0x8c, 0xf0, 0x66, 0x86,
+	0x63, 0x8b, 0xc2, 0x62, 0x59, 0xbd, 0x57, 0x82, 0xdb, 0x8c, 0x50, 0x4c, 0x62, 0x0b, 0xfc, 0x39,
+	0x80, 0x18, 0x79, 0xcc, 0x17, 0xcc, 0x66, 0xa2, 0x90, 0xb9, 0x5f, 0xc0, 0x04, 0x15, 0x7f, 0x09,
+	0x2b, 0x77, 0x3b, 0xdb, 0x38, 0xbd, 0x2c, 0x2c, 0xdd, 0x9f, 0xbb, 0x3c, 0x66, 0x37, 0x2e, 0xbf,
+	0xc8, 0xfe, 0xf8, 0x73, 0x29, 0xf5, 0xdd, 0xfb, 0xb2, 0xb2, 0xfd, 0xab, 0x0a, 0x0f, 0x9b, 0x89,
+	0xa6, 0xfe, 0x77, 0x78, 0xec, 0x70, 0x1f, 0xf2, 0x3e, 0x13, 0x7c, 0xe4, 0x5b, 0x2c, 0xbc, 0xfc,
+	0xa2, 0xbc, 0xfc, 0xfe, 0x5f, 0x57, 0xa5, 0xbd, 0x33, 0x27, 0x38, 0x1f, 0x9d, 0x56, 0x2c, 0x3e,
+	0xac, 0x5a, 0x5c, 0x0c, 0xb9, 0x88, 0x7f, 0xf6, 0x84, 0xfd, 0xba, 0x1a, 0x5c, 0x7a, 0x4c, 0x54,
+	0xea, 0x96, 0x15, 0xf7, 0x75, 0x94, 0xa2, 0x70, 0x5b, 0x87, 0xd8, 0x78, 0x03, 0xd2, 0xe7, 0xa6,
+	0x38, 0x97, 0x66, 0xe7, 0x8e, 0x52, 0x54, 0xee, 0xf0, 0x87, 0x00, 0xd6, 0xc0, 0x14, 0xc2, 0x70,
+	0xcd, 0x21, 0x93, 0x66, 0xe6, 0x68, 0x4e, 0x46, 0x74, 0x73, 0xc8, 0xc6, 0x86, 0x35, 0xb2, 0x90,
+	0x89, 0x4a, 0x6d, 0xff, 0xa4, 0xc2, 0x3a, 0x65, 0x16, 0xf7, 0xed, 0xff, 0xde, 0x38, 0x0c, 0x69,
+	0xd9, 0xc8, 0x82, 0x6c, 0x44, 0xae, 0x71, 0x03, 0x32, 0x8e, 0xeb, 0x8d, 0x82, 0x48, 0xfc, 0x7c,
+	0x6d, 0x77, 0x9e, 0xa4, 0x24, 0x64, 0x4d, 0xf4, 0x44, 0xe3, 0x4c, 0xfc, 0x04, 0x72, 0xa1, 0x3c,
+	0xd1, 0x2d, 0xd3, 0xb2, 0x78, 0x36, 0x0c, 0x84, 0x97, 0xc4, 0x87, 0x52, 0xef, 0xd1, 0x20, 0x30,
+	0xc2, 0x90, 0xd4, 0x7b, 0xb5, 0xf6, 0xf1, 0xfc, 0x69, 0x79, 0xe5, 0xb8, 0x4e, 0x58, 0x5d, 0xba,
+	0x07, 0x51, 0x6a, 0xb8, 0xc6, 0x14, 0xd6, 0x7d, 0x26, 0x3c, 0xee, 0x0a, 0xe7, 0x74, 0xc0, 0x8c,
+	0xd8, 0xd7, 0x42, 0xe6, 0xdf, 0x4e, 0x02, 0x4e, 0x64, 0x77, 0xa3, 0x64, 0xfc, 0x14, 0x56, 0xf8,
+	0x28, 0xf0, 0x46, 0x81, 0x21, 0xac, 0x73, 0x36, 0x34, 0x63, 0x8f, 0x96, 0xa3, 0x60, 0x4f, 0xc6,
+	0x12, 0xef, 0xea, 0x17, 0x05, 0xf0, 0x3f, 0x75, 0xb8, 0xd3, 0x55, 0x49, 0xe8, 0x3a, 0xa1, 0xc9,
+	0xc2, 0x94, 0x26, 0x35, 0xc8, 0xf9, 0xd2, 0xe3, 0xd0, 0x45, 0x55, 0xba, 0xb8, 0x3e, 0xc3, 0xc1,
+	0xa3, 0x14, 0xcd, 0x46, 0xbc, 0xc4, 0x84, 0xa5, 0x93, 0x13, 0x36, 0x73, 0x84, 0xbe, 0x85, 0x7c,
+	0xe2, 0xd1, 0xcd, 0xec, 0xae, 0x3c, 0xf9, 0x84, 0x55, 0x09, 0x25, 0x43, 0xb8, 0x04, 0xf9, 0x37,
+	0xec, 0x54, 0x38, 0x01, 0x33, 0x46, 0xfe, 0x20, 0x76, 0x15, 0xe2, 0xd0, 0xb1, 0x3f, 0xc0, 0x5b,
+	0x90, 0x75, 0x2c, 0xee, 0x4a, 0x74, 0x51, 0xa2, 0x4b, 0xe1, 0xfe, 0xd8, 0x1f, 0xec, 0x7e, 0xaf,
+	0xc0, 0xea, 0xa4, 0x91, 0xb8, 0x04, 0x4f, 0x5a, 0xda, 0x01, 0xd1, 0x49, 0x9f, 0x74, 0x74, 0xa3,
+	0xff, 0xb2, 0xab, 0x19, 0xc7, 0x7a, 0xaf, 0xab, 0x35, 0xc9, 0x01, 0xd1, 0x5a, 0x28, 0x85, 0x3f,
+	0x80, 0xc2, 0x34, 0xa1, 0x4b, 0x3b, 0xdd, 0x4e, 0x4f, 0x6b, 0x21, 0x05, 0x3f, 0x86, 0xcd, 0x69,
+	0x94, 0x6a, 0xcd, 0x0e, 0x6d, 0xa1, 0x85, 0x59, 0xa5, 0x23, 0xcc, 0x68, 0x93, 0x5e, 0x1f, 0xa9,
+	0xbb, 0xbf, 0xa9, 0x90, 0xbb, 0x1b, 0x83, 0xb0, 0x54, 0xb7, 0x4e, 0xfb, 0x2f, 0x67, 0x35, 0xb1,
+	0x05, 0x0f, 0x13, 0x58, 0x87, 0x92, 0x43, 0xa2, 0xd7, 0xfb, 0x1d, 0x8a, 0x14, 0xfc, 0x08, 0xd6,
+	0x13, 0x50, 0x4f, 0xa3, 0x27, 0xa4, 0xa9, 0x51, 0xb4, 0x30, 0x05, 0x10, 0xfd, 0x44, 0xeb, 0x85,
+	0x19, 0x2a, 0x2e, 0xc0, 0x46, 0x02, 0x68, 0x1e, 0xf7, 0xfa, 0x9d, 0x16, 0xa9, 0xeb, 0x28, 0x8d,
+	0x37, 0x00, 0x25, 0x8f, 0xf9, 0x4a, 0xd7, 0x28, 0x5a, 0x9c, 0xe2, 0xd7, 0x0f, 0x0e, 0x48, 0x9b,
+	0xd4, 0xfb, 0x1a, 0xca, 0xe0, 0x4d, 0xc0, 0x49, 0xfe, 0x0b, 0x9d, 0x34, 0x8e, 0x7b, 0x68, 0x69,
+	0xaa, 0xdd, 0x2e, 0xed, 0x9c, 0x68, 0x7a, 0x5d, 0x6f, 0x6a, 0x28, 0x3b, 0x05, 0x35, 0x3b, 0x7a,
+	0x9f, 0x76, 0xda, 0x6d, 0x8d, 0x22, 0x98, 0x3a, 0xe7, 0xa4, 0xde, 0x26, 0x2d, 0x79, 0xc7, 0xfc,
+	0x14, 0x72, 0xa8, 0xe9, 0x1a, 0x25, 0x4d, 0x63, 0x1f, 0x2d, 0xcf, 0x41, 0x6a, 0x68, 0x65, 0x0e,
+	0xf2, 0x0c, 0xad, 0xce, 0x41, 0x9e, 0xa3, 0xb5, 0x39, 0xc8, 0xa7, 0x08, 0xcd, 0x41, 0x3e, 0x43,
+	0x0f, 0x1a, 0xaf, 0xdf, 0x5e, 0x17, 0x95, 0x77, 0xd7, 0x45, 0xe5, 0xcf, 0xeb, 0xa2, 0xf2, 0xc3,
+	0x4d, 0x31, 0xf5, 0xee, 0xa6, 0x98, 0xfa, 0xfd, 0xa6, 0x98, 0x82, 0x2d, 0x87, 0xcf, 0x79, 0xfc,
+	0x5d, 0xe5, 0xeb, 0xe7, 0x89, 0x3f, 0xf5, 0x31, 0x69, 0xcf, 0xe1, 0x89, 0x5d, 0xf5, 0x9b, 0xf1,
+	0xd7, 0x95, 0xfc, 0x9b, 0x3f, 0xcd, 0xc8, 0xaf, 0xa4, 0x67, 0x7f, 0x0f, 0x00, 0x49, 0x66, 0xa2,
+	0x7f, 0x81, 0x09, 0x00, 0x00,
 }
 
 func (m *ScopeSpecification) Marshal() (dAtA []byte, err error) {
@@ -698,6 +717,26 @@ func (m *ScopeSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SupersededBy.Size()
+		i -= size
+		if _, err := m.SupersededBy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSpecification(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Supersedes.Size()
+		i -= size
+		if _, err := m.Supersedes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSpecification(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.ContractSpecIds) > 0 {
 		for iNdEx := len(m.ContractSpecIds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -902,6 +941,13 @@ func (m *RecordSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OutputSchema) > 0 {
+		i -= len(m.OutputSchema)
+		copy(dAtA[i:], m.OutputSchema)
+		i = encodeVarintSpecification(dAtA, i, uint64(len(m.OutputSchema)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.ResponsibleParties) > 0 {
 		dAtA8 := make([]byte, len(m.ResponsibleParties)*10)
 		var j7 int
@@ -1138,6 +1184,10 @@ func (m *ScopeSpecification) Size() (n int) {
 			n += 1 + l + sovSpecification(uint64(l))
 		}
 	}
+	l = m.Supersedes.Size()
+	n += 1 + l + sovSpecification(uint64(l))
+	l = m.SupersededBy.Size()
+	n += 1 + l + sovSpecification(uint64(l))
 	return n
 }
 
@@ -1230,6 +1280,10 @@ func (m *RecordSpecification) Size() (n int) {
 		}
 		n += 1 + sovSpecification(uint64(l)) + l
 	}
+	l = len(m.OutputSchema)
+	if l > 0 {
+		n += 1 + l + sovSpecification(uint64(l))
+	}
 	return n
 }
 
@@ -1314,6 +1368,8 @@ func (this *ScopeSpecification) String() string {
 		`OwnerAddresses:` + fmt.Sprintf("%v", this.OwnerAddresses) + `,`,
 		`PartiesInvolved:` + fmt.Sprintf("%v", this.PartiesInvolved) + `,`,
 		`ContractSpecIds:` + fmt.Sprintf("%v", this.ContractSpecIds) + `,`,
+		`Supersedes:` + fmt.Sprintf("%v", this.Supersedes) + `,`,
+		`SupersededBy:` + fmt.Sprintf("%v", this.SupersededBy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1369,6 +1425,7 @@ func (this *RecordSpecification) String() string {
 		`TypeName:` + fmt.Sprintf("%v", this.TypeName) + `,`,
 		`ResultType:` + fmt.Sprintf("%v", this.ResultType) + `,`,
 		`ResponsibleParties:` + fmt.Sprintf("%v", this.ResponsibleParties) + `,`,
+		`OutputSchema:` + fmt.Sprintf("%v", this.OutputSchema) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1647,6 +1704,72 @@ func (m *ScopeSpecification) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supersedes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supersedes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupersededBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
@@ -2233,6 +2356,38 @@ func (m *RecordSpecification) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ResponsibleParties", wireType)
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
@@ -39,6 +39,15 @@ func (s *SpecificationTestSuite) SetupSuite() {
 }
 
 func (s *SpecificationTestSuite) TestScopeSpecValidateBasic() {
+	specID := ScopeSpecMetadataAddress(uuid.New())
+	notASpecID := ScopeMetadataAddress(uuid.New())
+	newVersionedSpec := func(supersedes, supersededBy MetadataAddress) *ScopeSpecification {
+		rv := NewScopeSpecification(specID, nil, []string{specTestBech32}, []PartyType{PartyType_PARTY_TYPE_OWNER}, nil)
+		rv.Supersedes = supersedes
+		rv.SupersededBy = supersededBy
+		return rv
+	}
+
 	tests := []struct {
 		name string
 		spec *ScopeSpecification
@@ -159,6 +168,32 @@ func (s *SpecificationTestSuite) TestScopeSpecValidateBasic() {
 			),
 			"invalid contract specification id prefix at index 2 (expected: contractspec, got scope)",
 		},
+		// Supersedes and SupersededBy tests.
+		{
+			"supersedes - not a scope spec",
+			newVersionedSpec(notASpecID, nil),
+			fmt.Sprintf("invalid supersedes scope specification id: invalid scope specification id %q: wrong type", notASpecID),
+		},
+		{
+			"supersedes - itself",
+			newVersionedSpec(specID, nil),
+			"a scope specification cannot supersede itself",
+		},
+		{
+			"superseded by - not a scope spec",
+			newVersionedSpec(nil, notASpecID),
+			fmt.Sprintf("invalid superseded by scope specification id: invalid scope specification id %q: wrong type", notASpecID),
+		},
+		{
+			"superseded by - itself",
+			newVersionedSpec(nil, specID),
+			"a scope specification cannot be superseded by itself",
+		},
+		{
+			"supersedes and superseded by - valid",
+			newVersionedSpec(ScopeSpecMetadataAddress(uuid.New()), ScopeSpecMetadataAddress(uuid.New())),
+			"",
+		},
 		// Simple valid case
 		{
 			"simple valid case",
@@ -689,6 +724,48 @@ func (s *SpecificationTestSuite) TestRecordSpecValidateBasic() {
 			},
 			"record specification result type cannot be unspecified",
 		},
+
+		// OutputSchema tests
+		{
+			"valid output schema",
+			&RecordSpecification{
+				SpecificationId:    RecordSpecMetadataAddress(contractSpecUUID, "recspecname"),
+				Name:               "recspecname",
+				Inputs:             []*InputSpecification{},
+				TypeName:           "recspectypename",
+				ResultType:         DefinitionType_DEFINITION_TYPE_RECORD,
+				ResponsibleParties: []PartyType{PartyType_PARTY_TYPE_OWNER},
+				OutputSchema:       `{"type":"object","required":["status"]}`,
+			},
+			"",
+		},
+		{
+			"output schema not a JSON object",
+			&RecordSpecification{
+				SpecificationId:    RecordSpecMetadataAddress(contractSpecUUID, "recspecname"),
+				Name:               "recspecname",
+				Inputs:             []*InputSpecification{},
+				TypeName:           "recspectypename",
+				ResultType:         DefinitionType_DEFINITION_TYPE_RECORD,
+				ResponsibleParties: []PartyType{PartyType_PARTY_TYPE_OWNER},
+				OutputSchema:       `["status"]`,
+			},
+			"invalid record specification output schema: json: cannot unmarshal array into Go value of type map[string]interface {}",
+		},
+		{
+			"output schema too long",
+			&RecordSpecification{
+				SpecificationId:    RecordSpecMetadataAddress(contractSpecUUID, "recspecname"),
+				Name:               "recspecname",
+				Inputs:             []*InputSpecification{},
+				TypeName:           "recspectypename",
+				ResultType:         DefinitionType_DEFINITION_TYPE_RECORD,
+				ResponsibleParties: []PartyType{PartyType_PARTY_TYPE_OWNER},
+				OutputSchema:       `{"type":"string","description":"` + strings.Repeat("x", maxRecordSpecificationOutputSchemaLength) + `"}`,
+			},
+			fmt.Sprintf("record specification output schema exceeds maximum length (expected <= %d got: %d)",
+				maxRecordSpecificationOutputSchemaLength, maxRecordSpecificationOutputSchemaLength+34),
+		},
 	}
 
 	for _, tt := range tests {
@@ -1153,6 +1230,8 @@ func (s *SpecificationTestSuite) TestScopeSpecString() {
 		"OwnerAddresses:[cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck]," +
 		"PartiesInvolved:[PARTY_TYPE_OWNER]," +
 		"ContractSpecIds:[contractspec1qd2qmt038k7yc0azq46htdlhgwzqg6cr9l]," +
+		"Supersedes:," +
+		"SupersededBy:," +
 		"}"
 
 	var actual string
@@ -1234,6 +1313,7 @@ func (s *SpecificationTestSuite) TestRecordSpecString() {
 		"TypeName:sometype," +
 		"ResultType:DEFINITION_TYPE_RECORD," +
 		"ResponsibleParties:[PARTY_TYPE_CUSTODIAN PARTY_TYPE_INVESTOR]," +
+		"OutputSchema:," +
 		"}"
 
 	var actual string
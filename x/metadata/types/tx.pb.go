@@ -10,19 +10,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	p8e "github.com/provenance-io/provenance/x/metadata/types/p8e"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -215,6 +219,10 @@ type MsgAddScopeDataAccessRequest struct {
 	DataAccess []string `protobuf:"bytes,2,rep,name=data_access,json=dataAccess,proto3" json:"data_access,omitempty"`
 	// signers is the list of address of those signing this request.
 	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	// expiration, if provided, is the time after which the added addresses no longer have data access to the scope.
+	// It can be at most 365 days after the current block time. If not provided, the added data access does not expire.
+	// Any expiration that an address already has is replaced (or cleared if this is not provided).
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *MsgAddScopeDataAccessRequest) Reset()         { *m = MsgAddScopeDataAccessRequest{} }
@@ -2334,2210 +2342,2244 @@ func (m *MsgAddNetAssetValuesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddNetAssetValuesResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgWriteScopeRequest)(nil), "provenance.metadata.v1.MsgWriteScopeRequest")
-	proto.RegisterType((*MsgWriteScopeResponse)(nil), "provenance.metadata.v1.MsgWriteScopeResponse")
-	proto.RegisterType((*MsgDeleteScopeRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeRequest")
-	proto.RegisterType((*MsgDeleteScopeResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeResponse")
-	proto.RegisterType((*MsgAddScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessRequest")
-	proto.RegisterType((*MsgAddScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessResponse")
-	proto.RegisterType((*MsgDeleteScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessRequest")
-	proto.RegisterType((*MsgDeleteScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessResponse")
-	proto.RegisterType((*MsgAddScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgAddScopeOwnerRequest")
-	proto.RegisterType((*MsgAddScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgAddScopeOwnerResponse")
-	proto.RegisterType((*MsgDeleteScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerRequest")
-	proto.RegisterType((*MsgDeleteScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerResponse")
-	proto.RegisterType((*MsgUpdateValueOwnersRequest)(nil), "provenance.metadata.v1.MsgUpdateValueOwnersRequest")
-	proto.RegisterType((*MsgUpdateValueOwnersResponse)(nil), "provenance.metadata.v1.MsgUpdateValueOwnersResponse")
-	proto.RegisterType((*MsgMigrateValueOwnerRequest)(nil), "provenance.metadata.v1.MsgMigrateValueOwnerRequest")
-	proto.RegisterType((*MsgMigrateValueOwnerResponse)(nil), "provenance.metadata.v1.MsgMigrateValueOwnerResponse")
-	proto.RegisterType((*MsgWriteSessionRequest)(nil), "provenance.metadata.v1.MsgWriteSessionRequest")
-	proto.RegisterType((*SessionIdComponents)(nil), "provenance.metadata.v1.SessionIdComponents")
-	proto.RegisterType((*MsgWriteSessionResponse)(nil), "provenance.metadata.v1.MsgWriteSessionResponse")
-	proto.RegisterType((*MsgWriteRecordRequest)(nil), "provenance.metadata.v1.MsgWriteRecordRequest")
-	proto.RegisterType((*MsgWriteRecordResponse)(nil), "provenance.metadata.v1.MsgWriteRecordResponse")
-	proto.RegisterType((*MsgDeleteRecordRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordRequest")
-	proto.RegisterType((*MsgDeleteRecordResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordResponse")
-	proto.RegisterType((*MsgWriteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationRequest")
-	proto.RegisterType((*MsgWriteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationResponse")
-	proto.RegisterType((*MsgDeleteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationRequest")
-	proto.RegisterType((*MsgDeleteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationResponse")
-	proto.RegisterType((*MsgWriteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationRequest")
-	proto.RegisterType((*MsgWriteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationResponse")
-	proto.RegisterType((*MsgAddContractSpecToScopeSpecRequest)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecRequest")
-	proto.RegisterType((*MsgAddContractSpecToScopeSpecResponse)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecResponse")
-	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecRequest")
-	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecResponse")
-	proto.RegisterType((*MsgDeleteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationRequest")
-	proto.RegisterType((*MsgDeleteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationResponse")
-	proto.RegisterType((*MsgWriteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationRequest")
-	proto.RegisterType((*MsgWriteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationResponse")
-	proto.RegisterType((*MsgDeleteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationRequest")
-	proto.RegisterType((*MsgDeleteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationResponse")
-	proto.RegisterType((*MsgBindOSLocatorRequest)(nil), "provenance.metadata.v1.MsgBindOSLocatorRequest")
-	proto.RegisterType((*MsgBindOSLocatorResponse)(nil), "provenance.metadata.v1.MsgBindOSLocatorResponse")
-	proto.RegisterType((*MsgDeleteOSLocatorRequest)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorRequest")
-	proto.RegisterType((*MsgDeleteOSLocatorResponse)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorResponse")
-	proto.RegisterType((*MsgModifyOSLocatorRequest)(nil), "provenance.metadata.v1.MsgModifyOSLocatorRequest")
-	proto.RegisterType((*MsgModifyOSLocatorResponse)(nil), "provenance.metadata.v1.MsgModifyOSLocatorResponse")
-	proto.RegisterType((*MsgSetAccountDataRequest)(nil), "provenance.metadata.v1.MsgSetAccountDataRequest")
-	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.metadata.v1.MsgSetAccountDataResponse")
-	proto.RegisterType((*MsgWriteP8EContractSpecRequest)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecRequest")
-	proto.RegisterType((*MsgWriteP8EContractSpecResponse)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecResponse")
-	proto.RegisterType((*MsgP8EMemorializeContractRequest)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractRequest")
-	proto.RegisterType((*MsgP8EMemorializeContractResponse)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractResponse")
-	proto.RegisterType((*MsgAddNetAssetValuesRequest)(nil), "provenance.metadata.v1.MsgAddNetAssetValuesRequest")
-	proto.RegisterType((*MsgAddNetAssetValuesResponse)(nil), "provenance.metadata.v1.MsgAddNetAssetValuesResponse")
+// MsgSetNetAssetValueMaxAgeRequest defines the Msg/SetNetAssetValueMaxAge request type
+type MsgSetNetAssetValueMaxAgeRequest struct {
+	ScopeId string   `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// max_age is the number of blocks after which the scope's net asset values are stale. Zero means they never are.
+	MaxAge uint64 `protobuf:"varint,3,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
 }
 
-func init() { proto.RegisterFile("provenance/metadata/v1/tx.proto", fileDescriptor_3a3a0892f91e3036) }
-
-var fileDescriptor_3a3a0892f91e3036 = []byte{
-	// 2139 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5f, 0x6c, 0x1c, 0x47,
-	0x19, 0xf7, 0xda, 0x89, 0xed, 0xfb, 0x6c, 0xc7, 0xce, 0xc4, 0x89, 0xcf, 0x1b, 0x72, 0xe7, 0x5e,
-	0x93, 0xd6, 0xb8, 0xc9, 0x1d, 0x71, 0x8d, 0x70, 0xf3, 0x07, 0xb0, 0x1b, 0x41, 0x5d, 0xf5, 0x48,
-	0x74, 0xd7, 0x34, 0x2a, 0x12, 0x3a, 0x36, 0xbb, 0xe3, 0xcb, 0x52, 0xdf, 0xce, 0xb1, 0xb3, 0xe7,
-	0x26, 0x8d, 0x88, 0x00, 0x89, 0x3f, 0xe2, 0x01, 0x15, 0x21, 0x55, 0x54, 0x20, 0x54, 0x09, 0x09,
-	0xf1, 0x58, 0x89, 0x37, 0x5e, 0x78, 0xcd, 0x13, 0xaa, 0xc4, 0x0b, 0x2a, 0x52, 0x85, 0x92, 0x87,
-	0xf2, 0xc8, 0x33, 0x0f, 0x80, 0x76, 0x76, 0xf6, 0xcf, 0xdc, 0xed, 0xcc, 0xee, 0x9d, 0x4b, 0x5c,
-	0xa9, 0x0f, 0x96, 0x3c, 0xb3, 0xdf, 0xbf, 0xdf, 0x37, 0xdf, 0x7c, 0xf3, 0xcd, 0x37, 0x07, 0xe5,
-	0xae, 0x4b, 0xf6, 0xb1, 0x63, 0x38, 0x26, 0xae, 0x75, 0xb0, 0x67, 0x58, 0x86, 0x67, 0xd4, 0xf6,
-	0x2f, 0xd6, 0xbc, 0xbb, 0xd5, 0xae, 0x4b, 0x3c, 0x82, 0x4e, 0xc5, 0x04, 0xd5, 0x90, 0xa0, 0xba,
-	0x7f, 0x51, 0x5f, 0x32, 0x09, 0xed, 0x10, 0x5a, 0xeb, 0xd0, 0xb6, 0x4f, 0xdf, 0xa1, 0xed, 0x80,
-	0x41, 0x5f, 0x6c, 0x93, 0x36, 0x61, 0xff, 0xd6, 0xfc, 0xff, 0xf8, 0xec, 0x39, 0x89, 0x9e, 0x48,
-	0x64, 0x40, 0xb6, 0x2a, 0x21, 0x23, 0xb7, 0xbf, 0x83, 0x4d, 0x8f, 0x7a, 0xc4, 0xc5, 0x9c, 0xf2,
-	0xac, 0x84, 0xb2, 0xbb, 0x89, 0xfd, 0x3f, 0x4e, 0x55, 0x91, 0x50, 0x51, 0x93, 0x74, 0x43, 0x9a,
-	0x35, 0x19, 0x4d, 0x17, 0x9b, 0xf6, 0xae, 0x6d, 0x1a, 0x9e, 0x4d, 0x9c, 0x80, 0xb6, 0xf2, 0xa1,
-	0x06, 0x8b, 0x75, 0xda, 0xbe, 0xe5, 0xda, 0x1e, 0x6e, 0xfa, 0x32, 0x1a, 0xf8, 0xbb, 0x3d, 0x4c,
-	0x3d, 0xf4, 0x02, 0x1c, 0x65, 0x32, 0x8b, 0xda, 0x8a, 0xb6, 0x3a, 0xb3, 0x7e, 0xa6, 0x9a, 0xee,
-	0xb6, 0x2a, 0x63, 0xda, 0x3e, 0xf2, 0xf0, 0xa3, 0xf2, 0x58, 0x23, 0xe0, 0x40, 0x45, 0x98, 0xa2,
-	0x76, 0xdb, 0xc1, 0x2e, 0x2d, 0x8e, 0xaf, 0x4c, 0xac, 0x16, 0x1a, 0xe1, 0x10, 0x9d, 0x01, 0x60,
-	0x24, 0xad, 0x5e, 0xcf, 0xb6, 0x8a, 0x13, 0x2b, 0xda, 0x6a, 0xa1, 0x51, 0x60, 0x33, 0x37, 0x7b,
-	0xb6, 0x85, 0x4e, 0x43, 0xc1, 0xb7, 0x31, 0xf8, 0x7a, 0x84, 0x7d, 0x9d, 0xf6, 0x27, 0xc2, 0x8f,
-	0x3d, 0x6a, 0xb5, 0x3a, 0xf6, 0xde, 0x1e, 0x2d, 0x1e, 0x5d, 0xd1, 0x56, 0x8f, 0x34, 0xa6, 0x7b,
-	0xd4, 0xaa, 0xfb, 0xe3, 0x4b, 0x8b, 0x3f, 0x7d, 0xaf, 0x3c, 0xf6, 0xcf, 0xf7, 0xca, 0x63, 0x3f,
-	0xfc, 0xf8, 0xfd, 0xb5, 0x50, 0x5d, 0xe5, 0xdb, 0x70, 0xb2, 0x0f, 0x1b, 0xed, 0x12, 0x87, 0x62,
-	0xf4, 0x75, 0x98, 0x0b, 0xec, 0xb0, 0xad, 0x96, 0xed, 0xec, 0x12, 0x0e, 0xf2, 0x69, 0x25, 0xc8,
-	0x1d, 0x6b, 0xc7, 0xd9, 0x25, 0x8d, 0x19, 0x1a, 0x0f, 0x2a, 0xf7, 0x99, 0x86, 0x6b, 0x78, 0x0f,
-	0xf7, 0xb9, 0x6f, 0x1d, 0xa6, 0x43, 0x0d, 0x4c, 0xf8, 0xec, 0xf6, 0x92, 0xef, 0xa2, 0x0f, 0x3f,
-	0x2a, 0xcf, 0xd7, 0xb9, 0xe0, 0x2d, 0xcb, 0x72, 0x31, 0xa5, 0x8d, 0x29, 0x2e, 0x50, 0xee, 0x37,
-	0x09, 0xbc, 0x22, 0x9c, 0xea, 0x57, 0x1e, 0xe0, 0xab, 0xfc, 0x4e, 0x83, 0xcf, 0xd5, 0x69, 0x7b,
-	0xcb, 0xb2, 0xd8, 0xfc, 0x35, 0x5f, 0x9b, 0x69, 0xfa, 0xca, 0x0e, 0x60, 0x5e, 0x19, 0x66, 0xfc,
-	0xf9, 0x96, 0xc1, 0x24, 0x71, 0x13, 0xc1, 0x8a, 0x64, 0x27, 0xed, 0x9f, 0xc8, 0x63, 0x7f, 0x19,
-	0xce, 0x48, 0x8c, 0xe4, 0x30, 0x7e, 0xaf, 0x41, 0x59, 0x44, 0xf8, 0x29, 0x45, 0x52, 0x81, 0x15,
-	0xb9, 0x9d, 0x1c, 0xcc, 0x9f, 0x34, 0x58, 0x4a, 0xc0, 0xbd, 0xfe, 0xa6, 0x83, 0xdd, 0x83, 0x80,
-	0xb8, 0x0c, 0x93, 0xe4, 0xcd, 0x28, 0x58, 0x14, 0x3b, 0xf4, 0x86, 0xe1, 0x7a, 0xf7, 0xf8, 0x0e,
-	0xe5, 0x2c, 0x43, 0x03, 0xd4, 0xa1, 0x38, 0x68, 0x3b, 0x07, 0xf6, 0x2b, 0x0d, 0x74, 0x11, 0xfd,
-	0x81, 0xb1, 0x9d, 0x12, 0xb0, 0x15, 0x46, 0x36, 0xfb, 0x0c, 0x9c, 0x4e, 0xb5, 0x8c, 0x5b, 0xfe,
-	0x47, 0x8d, 0x7d, 0xbf, 0xd9, 0xb5, 0x0c, 0x0f, 0xbf, 0x66, 0xec, 0xf5, 0x82, 0xef, 0x51, 0x6c,
-	0x6d, 0x40, 0x21, 0x34, 0x9d, 0x16, 0xb5, 0x95, 0x09, 0x95, 0xed, 0xd3, 0xdc, 0x76, 0x8a, 0xaa,
-	0x70, 0x62, 0xdf, 0x97, 0xd5, 0x62, 0x46, 0xb7, 0x8c, 0x80, 0xa0, 0x38, 0xce, 0xf2, 0xd9, 0xf1,
-	0xfd, 0x48, 0x0d, 0xe7, 0x1c, 0x1a, 0x54, 0x89, 0xed, 0xed, 0x14, 0xa3, 0x39, 0xaa, 0x1f, 0x05,
-	0xa8, 0xea, 0x76, 0xdb, 0x15, 0x28, 0x42, 0x54, 0x3a, 0x4c, 0xe3, 0xbb, 0x36, 0xf5, 0x6c, 0xa7,
-	0xcd, 0x16, 0xa4, 0xd0, 0x88, 0xc6, 0xfe, 0xb7, 0xae, 0x4b, 0xba, 0x84, 0x62, 0x8b, 0x1b, 0x1c,
-	0x8d, 0x47, 0xb4, 0x33, 0xc5, 0x0c, 0x6e, 0xe7, 0x4f, 0xc6, 0x59, 0xfe, 0x0a, 0xd2, 0x33, 0xa6,
-	0xd4, 0x26, 0x4e, 0x68, 0xe2, 0x57, 0x60, 0x8a, 0x06, 0x33, 0x3c, 0x33, 0x97, 0xa5, 0x99, 0x39,
-	0x20, 0xe3, 0xe1, 0x1d, 0x72, 0x29, 0x8e, 0xa0, 0x16, 0x9c, 0xe4, 0x44, 0x7e, 0xf2, 0x37, 0x49,
-	0xa7, 0x4b, 0x1c, 0xec, 0x78, 0x94, 0x9d, 0x46, 0x33, 0xeb, 0xcf, 0x65, 0x28, 0xda, 0xb1, 0x5e,
-	0x8c, 0x58, 0x1a, 0x27, 0xe8, 0xe0, 0xa4, 0xf2, 0x10, 0x93, 0x78, 0xea, 0xe7, 0x1a, 0x9c, 0x48,
-	0x91, 0x8f, 0xca, 0xc2, 0x71, 0xc9, 0xd6, 0xea, 0xa5, 0xb1, 0xe4, 0x81, 0x19, 0x11, 0xf8, 0x41,
-	0x16, 0x2c, 0x58, 0x44, 0xe0, 0x87, 0x17, 0x7a, 0x0a, 0x66, 0x43, 0xb4, 0x89, 0x23, 0x77, 0x86,
-	0xcf, 0xf9, 0x32, 0xb6, 0x11, 0x2c, 0x84, 0x41, 0x8e, 0x1d, 0xcf, 0xde, 0xb5, 0xb1, 0x5b, 0xb9,
-	0xc3, 0x52, 0x95, 0xb8, 0x32, 0xfc, 0xe8, 0xac, 0xc3, 0x7c, 0xc2, 0x7f, 0x89, 0xc3, 0xf3, 0x5c,
-	0xa6, 0xe7, 0xd8, 0xf1, 0x39, 0x47, 0x93, 0xc3, 0xca, 0x5f, 0xc7, 0xe3, 0x33, 0xba, 0x81, 0x4d,
-	0xe2, 0x5a, 0x61, 0x0c, 0x5c, 0x81, 0x49, 0x97, 0x4d, 0x70, 0xf9, 0x25, 0x99, 0xfc, 0x80, 0x2d,
-	0x4c, 0x70, 0x01, 0xcf, 0x61, 0x06, 0xc0, 0x79, 0x40, 0x26, 0x71, 0x3c, 0xd7, 0x30, 0xbd, 0x56,
-	0x7f, 0x24, 0x2c, 0x84, 0x5f, 0x9a, 0x61, 0x59, 0x73, 0x15, 0xa6, 0xba, 0x86, 0xeb, 0xd9, 0xd8,
-	0x2f, 0x6a, 0x72, 0xe7, 0xf1, 0x90, 0x47, 0x12, 0x50, 0x56, 0xbc, 0xb3, 0x42, 0xa7, 0xf2, 0xe5,
-	0x7b, 0x19, 0x8e, 0x05, 0x1e, 0xea, 0x5b, 0xbd, 0xb3, 0x6a, 0xef, 0xf2, 0xc5, 0x9b, 0x75, 0x13,
-	0xa3, 0xca, 0x83, 0x44, 0xfd, 0x21, 0xae, 0xdd, 0x06, 0x14, 0x22, 0x2d, 0x59, 0x49, 0x7f, 0x3a,
-	0x94, 0x39, 0x74, 0xfd, 0xb3, 0xcc, 0xa2, 0x54, 0xd4, 0xcf, 0x73, 0xcb, 0x43, 0x0d, 0x9e, 0x12,
-	0x4a, 0xbf, 0x66, 0xb2, 0xf6, 0x0d, 0xcd, 0x7c, 0x0d, 0xe6, 0x84, 0x9a, 0x98, 0xfb, 0x62, 0x4d,
-	0x59, 0x06, 0x0a, 0x92, 0xf8, 0x72, 0x88, 0x62, 0x14, 0xc1, 0x27, 0x24, 0x87, 0x89, 0x5c, 0xc9,
-	0xe1, 0x2d, 0xa8, 0xa8, 0x90, 0xf0, 0x75, 0x7d, 0x15, 0x50, 0xb0, 0x8b, 0x99, 0x78, 0x71, 0x6d,
-	0x9f, 0xcd, 0xc4, 0xc3, 0x97, 0x77, 0x9e, 0x8a, 0x13, 0xfe, 0xd1, 0x5e, 0x11, 0x0f, 0xd0, 0x54,
-	0x3f, 0x6e, 0xc3, 0x82, 0xe0, 0x80, 0x1c, 0xab, 0x3e, 0x2f, 0x30, 0x8c, 0xb0, 0xf8, 0xe7, 0xe0,
-	0x69, 0xa5, 0x65, 0x3c, 0x10, 0xfe, 0xa2, 0xc1, 0xd9, 0xd0, 0x7d, 0x2f, 0x26, 0xf6, 0xde, 0x00,
-	0x86, 0xd7, 0xd3, 0x63, 0xe1, 0x82, 0xcc, 0x77, 0xa9, 0xc2, 0x9e, 0x40, 0x38, 0xfc, 0x58, 0x83,
-	0x73, 0x19, 0x80, 0x78, 0x48, 0x7c, 0x0b, 0x4e, 0x8a, 0x79, 0x48, 0x8c, 0x8a, 0xb5, 0x3c, 0xc8,
-	0x78, 0x60, 0x20, 0x73, 0x60, 0xae, 0xf2, 0xef, 0xc0, 0xb3, 0x5b, 0x96, 0x95, 0x64, 0x78, 0x95,
-	0x44, 0x8b, 0x11, 0x7a, 0xb6, 0x09, 0xcb, 0x82, 0x1d, 0xc3, 0x84, 0xc9, 0x92, 0x99, 0x06, 0x71,
-	0xc7, 0x42, 0x75, 0x38, 0x15, 0xc7, 0xbb, 0x20, 0x71, 0x5c, 0x2d, 0x71, 0x91, 0x0e, 0x04, 0xcb,
-	0xce, 0xf0, 0xb5, 0xcd, 0xb3, 0x6c, 0x11, 0x54, 0xd8, 0x79, 0xfc, 0xfd, 0x57, 0x83, 0xcf, 0x47,
-	0x71, 0x9a, 0x24, 0xfe, 0x9a, 0x4b, 0x3a, 0x9f, 0x09, 0x57, 0x9d, 0x87, 0xb5, 0x3c, 0x0e, 0xe0,
-	0xfe, 0xfa, 0x75, 0x10, 0xde, 0x83, 0xe4, 0x9f, 0x8a, 0xa4, 0xb3, 0x0a, 0xcf, 0x64, 0x19, 0xc7,
-	0x71, 0xfc, 0x5d, 0x8b, 0xd3, 0x76, 0x70, 0x36, 0xa5, 0x82, 0xb8, 0x95, 0x9e, 0x75, 0x9e, 0x53,
-	0x9f, 0xc6, 0x07, 0xca, 0x39, 0xe9, 0xe5, 0xc9, 0x44, 0x7a, 0x79, 0x22, 0xf1, 0xc3, 0x03, 0x96,
-	0x7c, 0xe5, 0xe0, 0x78, 0x06, 0xba, 0x05, 0x27, 0x78, 0x19, 0x90, 0x92, 0x7f, 0x56, 0xb3, 0x31,
-	0xf2, 0xec, 0xb3, 0xe0, 0xf6, 0xcd, 0x54, 0xde, 0xd5, 0x12, 0xd9, 0x5f, 0xe1, 0xde, 0xc3, 0x88,
-	0x91, 0x67, 0x58, 0x5a, 0x54, 0x98, 0xc6, 0x23, 0xe4, 0x3e, 0xab, 0x5e, 0xb6, 0x6d, 0xc7, 0xba,
-	0xde, 0x7c, 0x85, 0x98, 0x86, 0x47, 0xa2, 0x1b, 0xda, 0xcb, 0x30, 0xb5, 0x17, 0xcc, 0x64, 0xe5,
-	0xea, 0xeb, 0xac, 0x8d, 0xd8, 0xf4, 0x88, 0x8b, 0xb9, 0x8c, 0xb0, 0x40, 0xe4, 0x02, 0xfa, 0x8c,
-	0xe4, 0xb3, 0x95, 0x5d, 0x76, 0x9f, 0xef, 0x53, 0x1e, 0x95, 0x88, 0x9f, 0x98, 0xf6, 0xca, 0xf7,
-	0x60, 0x39, 0x72, 0xc6, 0x21, 0xc0, 0xbc, 0x93, 0xe8, 0x4c, 0x3c, 0x09, 0xa0, 0x75, 0x62, 0xd9,
-	0xbb, 0xf7, 0x0e, 0x0d, 0xe8, 0x80, 0xfa, 0xff, 0x03, 0xd0, 0xdf, 0x6a, 0x2c, 0x74, 0x9a, 0xd8,
-	0xdb, 0x32, 0x4d, 0xd2, 0x73, 0xbc, 0x6b, 0x86, 0x67, 0xc4, 0x77, 0xb6, 0xb9, 0x50, 0x5a, 0x70,
-	0x25, 0xcd, 0xd8, 0x6c, 0xb3, 0x9d, 0xc4, 0x04, 0x5a, 0x84, 0xa3, 0xac, 0x3b, 0xc2, 0x3b, 0x0f,
-	0xc1, 0x60, 0xe8, 0xf3, 0xe6, 0x34, 0x5b, 0x89, 0x7e, 0xfb, 0xf8, 0xa6, 0x7b, 0x47, 0x83, 0x52,
-	0x98, 0xb9, 0x6e, 0x6c, 0x0a, 0x39, 0x3c, 0xc4, 0xd0, 0x80, 0xd9, 0x30, 0x0b, 0xfa, 0xa9, 0x20,
-	0x2b, 0x5b, 0x75, 0x37, 0xb1, 0x50, 0x31, 0x71, 0x7f, 0x09, 0x32, 0x14, 0x39, 0x64, 0xd2, 0xc7,
-	0x50, 0xd4, 0x2a, 0x8f, 0x83, 0x56, 0x67, 0xba, 0x61, 0x4f, 0xa4, 0xa0, 0x43, 0xaf, 0xc3, 0x62,
-	0x4a, 0xb6, 0x0e, 0xdb, 0x8b, 0xf9, 0xd3, 0xf5, 0xf1, 0xfe, 0x74, 0x1d, 0xa3, 0xfc, 0xcf, 0x38,
-	0x6b, 0x94, 0xde, 0xd8, 0xc4, 0x75, 0xdc, 0x21, 0xae, 0x6d, 0xec, 0xd9, 0x6f, 0x45, 0x58, 0xc3,
-	0x05, 0x58, 0xee, 0x6b, 0x18, 0x16, 0xe2, 0xbe, 0xe0, 0x32, 0x4c, 0xb7, 0x5d, 0xd2, 0xeb, 0x86,
-	0xc5, 0x4b, 0xa1, 0x31, 0xc5, 0xc6, 0x3b, 0x16, 0xda, 0x90, 0x56, 0x39, 0xc1, 0xd1, 0x96, 0x5e,
-	0xcc, 0x7c, 0x15, 0xfc, 0xeb, 0xa7, 0xed, 0x19, 0x7b, 0x94, 0xdd, 0xd0, 0x15, 0x17, 0x61, 0x7f,
-	0xa1, 0x1b, 0x9c, 0xb6, 0x11, 0x71, 0xf9, 0x12, 0x42, 0x5f, 0xb2, 0x57, 0x89, 0x0c, 0x09, 0x11,
-	0xd8, 0x88, 0x0b, 0xbd, 0x04, 0xe0, 0x47, 0x83, 0xe1, 0xf5, 0x5c, 0x4c, 0x8b, 0x93, 0xd9, 0xe1,
-	0xd6, 0x0c, 0xa9, 0x9b, 0xd8, 0x6b, 0x24, 0x78, 0xfd, 0x30, 0xb3, 0x9d, 0x7d, 0xf2, 0x06, 0x76,
-	0x8b, 0x53, 0x81, 0x77, 0xf8, 0x30, 0x5a, 0x80, 0x5f, 0x8c, 0xb3, 0x7b, 0xb1, 0x6c, 0x01, 0x3e,
-	0xe1, 0xe7, 0x91, 0xb4, 0x66, 0xd1, 0xf8, 0xe8, 0xcd, 0x22, 0xf4, 0x0a, 0xcc, 0x8b, 0xcd, 0x8b,
-	0x20, 0x25, 0xe4, 0xed, 0x5e, 0xcc, 0x25, 0xbb, 0x17, 0x71, 0x50, 0xfe, 0x39, 0xe8, 0x97, 0x6e,
-	0x59, 0xd6, 0x37, 0xb0, 0xb7, 0x45, 0x29, 0xf6, 0x58, 0xb3, 0x92, 0xe6, 0x88, 0x47, 0x79, 0x95,
-	0x75, 0x13, 0x16, 0x1c, 0xec, 0xb5, 0x0c, 0x5f, 0x5c, 0x8b, 0x25, 0xb2, 0xd0, 0x56, 0x29, 0x74,
-	0x41, 0x3b, 0x4f, 0x23, 0xc7, 0x1c, 0xc1, 0x24, 0x65, 0xa7, 0x35, 0x05, 0x40, 0xb0, 0x9e, 0xeb,
-	0xff, 0x2a, 0xc2, 0x44, 0x9d, 0xb6, 0x91, 0x0d, 0x10, 0xf7, 0x11, 0xd0, 0x79, 0x99, 0x21, 0x69,
-	0xef, 0x81, 0xfa, 0x85, 0x9c, 0xd4, 0x3c, 0x84, 0xf6, 0x60, 0x26, 0x71, 0x37, 0x47, 0x2a, 0xee,
-	0xc1, 0xd7, 0x33, 0xbd, 0x9a, 0x97, 0x9c, 0x6b, 0xfb, 0x81, 0x06, 0x68, 0xf0, 0x1d, 0x09, 0x6d,
-	0x28, 0xc4, 0x48, 0xdf, 0xc6, 0xf4, 0x2f, 0x0e, 0xc9, 0xc5, 0x6d, 0xf8, 0x99, 0x06, 0x27, 0x53,
-	0x5f, 0x80, 0xd0, 0x97, 0xf2, 0xa1, 0x19, 0xb4, 0x64, 0x73, 0x78, 0x46, 0x6e, 0x8c, 0x0b, 0x73,
-	0xc2, 0x63, 0x0d, 0xaa, 0xe5, 0x00, 0x95, 0x7c, 0x25, 0xd0, 0xbf, 0x90, 0x9f, 0x81, 0xeb, 0xbc,
-	0x0f, 0x0b, 0xfd, 0x2f, 0x2d, 0x68, 0x3d, 0x1f, 0x02, 0x41, 0xf3, 0xf3, 0x43, 0xf1, 0x70, 0xe5,
-	0x0f, 0xe0, 0xf8, 0xc0, 0x8b, 0x08, 0x52, 0x49, 0x92, 0x3d, 0xfa, 0xe8, 0x1b, 0xc3, 0x31, 0xc5,
-	0xfa, 0x07, 0x5e, 0x3a, 0x94, 0xfa, 0x65, 0xcf, 0x33, 0x4a, 0xfd, 0xd2, 0xc7, 0x14, 0x44, 0x60,
-	0x36, 0xd9, 0xae, 0x47, 0xd5, 0xcc, 0xed, 0x2a, 0xbc, 0xb8, 0xe8, 0xb5, 0xdc, 0xf4, 0xf1, 0x06,
-	0x4f, 0xdc, 0xff, 0x50, 0x66, 0x7a, 0x10, 0x1a, 0xc4, 0x7a, 0x35, 0x2f, 0x79, 0x0c, 0x2f, 0x79,
-	0xa3, 0x42, 0xd9, 0x09, 0x42, 0xd4, 0x57, 0xcb, 0x4d, 0xcf, 0x15, 0xbe, 0xad, 0xc1, 0x92, 0xa4,
-	0xe7, 0x8a, 0x5e, 0xc8, 0x95, 0x0a, 0xd3, 0x2e, 0xa4, 0xfa, 0xa5, 0x51, 0x58, 0xb9, 0x49, 0xbf,
-	0xd4, 0xa0, 0x28, 0xeb, 0x77, 0xa2, 0x4b, 0xf9, 0x36, 0x4d, 0xaa, 0x51, 0x97, 0x47, 0xe2, 0xe5,
-	0x56, 0xbd, 0xab, 0x81, 0x2e, 0x6f, 0x46, 0xa2, 0x2b, 0x59, 0x80, 0x55, 0x3d, 0x1e, 0xfd, 0xea,
-	0x88, 0xdc, 0xdc, 0xb6, 0xdf, 0x68, 0x70, 0x5a, 0xd1, 0xac, 0x41, 0x57, 0x33, 0x81, 0x2b, 0xad,
-	0xfb, 0xf2, 0xa8, 0xec, 0x09, 0xd7, 0xc9, 0x5b, 0x88, 0x4a, 0xd7, 0x65, 0x76, 0x5d, 0x95, 0xae,
-	0xcb, 0xee, 0x5b, 0xa2, 0x3f, 0x68, 0x50, 0xce, 0xe8, 0xd9, 0xa1, 0xad, 0xa1, 0xf0, 0xa7, 0x35,
-	0x3c, 0xf5, 0xed, 0x83, 0x88, 0x48, 0xec, 0x0b, 0x59, 0x2b, 0x0a, 0x5d, 0xca, 0x97, 0x68, 0x86,
-	0xde, 0x17, 0x99, 0xbd, 0xaf, 0x77, 0x34, 0x58, 0x96, 0x36, 0x81, 0xd0, 0xe5, 0x9c, 0xf9, 0x28,
-	0xd5, 0xae, 0x2b, 0xa3, 0x31, 0xc7, 0xa5, 0x81, 0xd0, 0xf7, 0x51, 0x96, 0x06, 0x69, 0xed, 0x29,
-	0x65, 0x69, 0x90, 0xde, 0x52, 0xba, 0x0b, 0xf3, 0x7d, 0x4d, 0x18, 0x74, 0x31, 0x13, 0xc4, 0x80,
-	0xde, 0xf5, 0x61, 0x58, 0x62, 0xcd, 0x7d, 0x5d, 0x11, 0xa5, 0xe6, 0xf4, 0x06, 0x8e, 0x52, 0xb3,
-	0xac, 0xe9, 0xd2, 0x83, 0x63, 0x62, 0x13, 0x02, 0xa9, 0xfc, 0x96, 0xda, 0x4f, 0xd1, 0x2f, 0x0e,
-	0xc1, 0x11, 0x17, 0x22, 0x03, 0x17, 0x01, 0x65, 0x21, 0x22, 0xbb, 0xf7, 0xe8, 0x1b, 0xc3, 0x31,
-	0x05, 0xfa, 0xf5, 0xa3, 0xdf, 0xff, 0xf8, 0xfd, 0x35, 0x6d, 0xfb, 0x8d, 0x87, 0x8f, 0x4a, 0xda,
-	0x07, 0x8f, 0x4a, 0xda, 0x3f, 0x1e, 0x95, 0xb4, 0xb7, 0x1f, 0x97, 0xc6, 0x3e, 0x78, 0x5c, 0x1a,
-	0xfb, 0xdb, 0xe3, 0xd2, 0x18, 0x2c, 0xdb, 0x44, 0x22, 0xf8, 0x86, 0xf6, 0xcd, 0x8d, 0xb6, 0xed,
-	0xdd, 0xe9, 0xdd, 0xae, 0x9a, 0xa4, 0x53, 0x8b, 0x89, 0x2e, 0xd8, 0x24, 0x31, 0xaa, 0xdd, 0x8d,
-	0x7f, 0xd5, 0xe8, 0xdd, 0xeb, 0x62, 0x7a, 0x7b, 0x92, 0xfd, 0x96, 0xf1, 0xf9, 0xff, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x0e, 0x34, 0xe1, 0xb8, 0xfc, 0x29, 0x00, 0x00,
+func (m *MsgSetNetAssetValueMaxAgeRequest) Reset()         { *m = MsgSetNetAssetValueMaxAgeRequest{} }
+func (m *MsgSetNetAssetValueMaxAgeRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNetAssetValueMaxAgeRequest) ProtoMessage()    {}
+func (*MsgSetNetAssetValueMaxAgeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{53}
+}
+func (m *MsgSetNetAssetValueMaxAgeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNetAssetValueMaxAgeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNetAssetValueMaxAgeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetNetAssetValueMaxAgeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNetAssetValueMaxAgeRequest.Merge(m, src)
+}
+func (m *MsgSetNetAssetValueMaxAgeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNetAssetValueMaxAgeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNetAssetValueMaxAgeRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetNetAssetValueMaxAgeRequest proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// WriteScope adds or updates a scope.
-	WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error)
-	// DeleteScope deletes a scope and all associated Records, Sessions.
-	DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error)
-	// AddScopeDataAccess adds data access AccAddress to scope
-	AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error)
-	// DeleteScopeDataAccess removes data access AccAddress from scope
-	DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error)
-	// AddScopeOwner adds new owner parties to a scope
-	AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error)
-	// DeleteScopeOwner removes owner parties (by addresses) from a scope
-	DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error)
-	// UpdateValueOwners sets the value owner of one or more scopes.
-	UpdateValueOwners(ctx context.Context, in *MsgUpdateValueOwnersRequest, opts ...grpc.CallOption) (*MsgUpdateValueOwnersResponse, error)
-	// MigrateValueOwner updates all scopes that have one value owner to have a another value owner.
-	MigrateValueOwner(ctx context.Context, in *MsgMigrateValueOwnerRequest, opts ...grpc.CallOption) (*MsgMigrateValueOwnerResponse, error)
-	// WriteSession adds or updates a session context.
-	WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error)
-	// WriteRecord adds or updates a record.
-	WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error)
-	// DeleteRecord deletes a record.
-	DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error)
-	// WriteScopeSpecification adds or updates a scope specification.
-	WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error)
-	// DeleteScopeSpecification deletes a scope specification.
-	DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error)
-	// WriteContractSpecification adds or updates a contract specification.
-	WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error)
-	// DeleteContractSpecification deletes a contract specification.
-	DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error)
-	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
-	AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error)
-	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
-	DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
-	// WriteRecordSpecification adds or updates a record specification.
-	WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error)
-	// DeleteRecordSpecification deletes a record specification.
-	DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error)
-	// BindOSLocator binds an owner address to a uri.
-	BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error)
-	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
-	DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error)
-	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
-	ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error)
-	// SetAccountData associates some basic data with a metadata address.
-	// Currently, only scope ids are supported.
-	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
-	// AddNetAssetValues sets the net asset value for a scope.
-	AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error)
+// MsgSetNetAssetValueMaxAgeResponse defines the Msg/SetNetAssetValueMaxAge response type
+type MsgSetNetAssetValueMaxAgeResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetNetAssetValueMaxAgeResponse) Reset()         { *m = MsgSetNetAssetValueMaxAgeResponse{} }
+func (m *MsgSetNetAssetValueMaxAgeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNetAssetValueMaxAgeResponse) ProtoMessage()    {}
+func (*MsgSetNetAssetValueMaxAgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{54}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetNetAssetValueMaxAgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error) {
-	out := new(MsgWriteScopeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScope", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetNetAssetValueMaxAgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNetAssetValueMaxAgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error) {
-	out := new(MsgDeleteScopeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScope", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSetNetAssetValueMaxAgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNetAssetValueMaxAgeResponse.Merge(m, src)
 }
-
-func (c *msgClient) AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error) {
-	out := new(MsgAddScopeDataAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeDataAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSetNetAssetValueMaxAgeResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error) {
-	out := new(MsgDeleteScopeDataAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeDataAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSetNetAssetValueMaxAgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNetAssetValueMaxAgeResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error) {
-	out := new(MsgAddScopeOwnerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgSetNetAssetValueMaxAgeResponse proto.InternalMessageInfo
 
-func (c *msgClient) DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error) {
-	out := new(MsgDeleteScopeOwnerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgPruneScopeHistoryRequest defines the Msg/PruneScopeHistory request type
+type MsgPruneScopeHistoryRequest struct {
+	ScopeId string   `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// keep is the number of the most recent prior versions to keep for the scope and for each of its sessions and
+	// records. It must be at least one.
+	Keep uint32 `protobuf:"varint,3,opt,name=keep,proto3" json:"keep,omitempty"`
 }
 
-func (c *msgClient) UpdateValueOwners(ctx context.Context, in *MsgUpdateValueOwnersRequest, opts ...grpc.CallOption) (*MsgUpdateValueOwnersResponse, error) {
-	out := new(MsgUpdateValueOwnersResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/UpdateValueOwners", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgPruneScopeHistoryRequest) Reset()         { *m = MsgPruneScopeHistoryRequest{} }
+func (m *MsgPruneScopeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneScopeHistoryRequest) ProtoMessage()    {}
+func (*MsgPruneScopeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{55}
 }
-
-func (c *msgClient) MigrateValueOwner(ctx context.Context, in *MsgMigrateValueOwnerRequest, opts ...grpc.CallOption) (*MsgMigrateValueOwnerResponse, error) {
-	out := new(MsgMigrateValueOwnerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/MigrateValueOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgPruneScopeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error) {
-	out := new(MsgWriteSessionResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteSession", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPruneScopeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneScopeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error) {
-	out := new(MsgWriteRecordResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgPruneScopeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneScopeHistoryRequest.Merge(m, src)
 }
-
-func (c *msgClient) DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error) {
-	out := new(MsgDeleteRecordResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgPruneScopeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneScopeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneScopeHistoryRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error) {
-	out := new(MsgWriteScopeSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopeSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgPruneScopeHistoryRequest proto.InternalMessageInfo
+
+// MsgPruneScopeHistoryResponse defines the Msg/PruneScopeHistory response type
+type MsgPruneScopeHistoryResponse struct {
 }
 
-func (c *msgClient) DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error) {
-	out := new(MsgDeleteScopeSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgPruneScopeHistoryResponse) Reset()         { *m = MsgPruneScopeHistoryResponse{} }
+func (m *MsgPruneScopeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneScopeHistoryResponse) ProtoMessage()    {}
+func (*MsgPruneScopeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{56}
 }
-
-func (c *msgClient) WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error) {
-	out := new(MsgWriteContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteContractSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgPruneScopeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error) {
-	out := new(MsgDeleteContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPruneScopeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneScopeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error) {
-	out := new(MsgAddContractSpecToScopeSpecResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgPruneScopeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneScopeHistoryResponse.Merge(m, src)
 }
-
-func (c *msgClient) DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
-	out := new(MsgDeleteContractSpecFromScopeSpecResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgPruneScopeHistoryResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error) {
-	out := new(MsgWriteRecordSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecordSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgPruneScopeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneScopeHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error) {
-	out := new(MsgDeleteRecordSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecordSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgPruneScopeHistoryResponse proto.InternalMessageInfo
 
-func (c *msgClient) BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error) {
-	out := new(MsgBindOSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/BindOSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgProposeScopeOwnershipTransferRequest defines the Msg/ProposeScopeOwnershipTransfer request type
+type MsgProposeScopeOwnershipTransferRequest struct {
+	// scope_id is the id of the scope to transfer.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// owners are the new owners of the scope. If empty, the scope's owners are not changed.
+	Owners []Party `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners"`
+	// value_owner_address is the new value owner of the scope. If empty, the scope's value owner is not changed.
+	ValueOwnerAddress string `protobuf:"bytes,3,opt,name=value_owner_address,json=valueOwnerAddress,proto3" json:"value_owner_address,omitempty"`
+	// expiration is the time after which the transfer can no longer be accepted.
+	Expiration time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,5,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (c *msgClient) DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error) {
-	out := new(MsgDeleteOSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteOSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgProposeScopeOwnershipTransferRequest) Reset() {
+	*m = MsgProposeScopeOwnershipTransferRequest{}
 }
-
-func (c *msgClient) ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error) {
-	out := new(MsgModifyOSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ModifyOSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgProposeScopeOwnershipTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeScopeOwnershipTransferRequest) ProtoMessage()    {}
+func (*MsgProposeScopeOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{57}
 }
-
-func (c *msgClient) SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error) {
-	out := new(MsgSetAccountDataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/SetAccountData", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgProposeScopeOwnershipTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error) {
-	out := new(MsgAddNetAssetValuesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddNetAssetValues", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeScopeOwnershipTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeScopeOwnershipTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// WriteScope adds or updates a scope.
-	WriteScope(context.Context, *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error)
-	// DeleteScope deletes a scope and all associated Records, Sessions.
-	DeleteScope(context.Context, *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error)
-	// AddScopeDataAccess adds data access AccAddress to scope
-	AddScopeDataAccess(context.Context, *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error)
-	// DeleteScopeDataAccess removes data access AccAddress from scope
-	DeleteScopeDataAccess(context.Context, *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error)
-	// AddScopeOwner adds new owner parties to a scope
-	AddScopeOwner(context.Context, *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error)
-	// DeleteScopeOwner removes owner parties (by addresses) from a scope
-	DeleteScopeOwner(context.Context, *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error)
-	// UpdateValueOwners sets the value owner of one or more scopes.
-	UpdateValueOwners(context.Context, *MsgUpdateValueOwnersRequest) (*MsgUpdateValueOwnersResponse, error)
-	// MigrateValueOwner updates all scopes that have one value owner to have a another value owner.
-	MigrateValueOwner(context.Context, *MsgMigrateValueOwnerRequest) (*MsgMigrateValueOwnerResponse, error)
-	// WriteSession adds or updates a session context.
-	WriteSession(context.Context, *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error)
-	// WriteRecord adds or updates a record.
-	WriteRecord(context.Context, *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error)
-	// DeleteRecord deletes a record.
-	DeleteRecord(context.Context, *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error)
-	// WriteScopeSpecification adds or updates a scope specification.
-	WriteScopeSpecification(context.Context, *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error)
-	// DeleteScopeSpecification deletes a scope specification.
-	DeleteScopeSpecification(context.Context, *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error)
-	// WriteContractSpecification adds or updates a contract specification.
-	WriteContractSpecification(context.Context, *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error)
-	// DeleteContractSpecification deletes a contract specification.
-	DeleteContractSpecification(context.Context, *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error)
-	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
-	AddContractSpecToScopeSpec(context.Context, *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error)
-	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
-	DeleteContractSpecFromScopeSpec(context.Context, *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
-	// WriteRecordSpecification adds or updates a record specification.
-	WriteRecordSpecification(context.Context, *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error)
-	// DeleteRecordSpecification deletes a record specification.
-	DeleteRecordSpecification(context.Context, *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error)
-	// BindOSLocator binds an owner address to a uri.
-	BindOSLocator(context.Context, *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error)
-	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
-	DeleteOSLocator(context.Context, *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error)
-	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
-	ModifyOSLocator(context.Context, *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error)
-	// SetAccountData associates some basic data with a metadata address.
-	// Currently, only scope ids are supported.
-	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
-	// AddNetAssetValues sets the net asset value for a scope.
-	AddNetAssetValues(context.Context, *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error)
+func (m *MsgProposeScopeOwnershipTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeScopeOwnershipTransferRequest.Merge(m, src)
+}
+func (m *MsgProposeScopeOwnershipTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeScopeOwnershipTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeScopeOwnershipTransferRequest.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgProposeScopeOwnershipTransferRequest proto.InternalMessageInfo
+
+// MsgProposeScopeOwnershipTransferResponse defines the Msg/ProposeScopeOwnershipTransfer response type
+type MsgProposeScopeOwnershipTransferResponse struct {
 }
 
-func (*UnimplementedMsgServer) WriteScope(ctx context.Context, req *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteScope not implemented")
+func (m *MsgProposeScopeOwnershipTransferResponse) Reset() {
+	*m = MsgProposeScopeOwnershipTransferResponse{}
 }
-func (*UnimplementedMsgServer) DeleteScope(ctx context.Context, req *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScope not implemented")
+func (m *MsgProposeScopeOwnershipTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeScopeOwnershipTransferResponse) ProtoMessage()    {}
+func (*MsgProposeScopeOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{58}
 }
-func (*UnimplementedMsgServer) AddScopeDataAccess(ctx context.Context, req *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddScopeDataAccess not implemented")
+func (m *MsgProposeScopeOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteScopeDataAccess(ctx context.Context, req *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeDataAccess not implemented")
+func (m *MsgProposeScopeOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeScopeOwnershipTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) AddScopeOwner(ctx context.Context, req *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddScopeOwner not implemented")
+func (m *MsgProposeScopeOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeScopeOwnershipTransferResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteScopeOwner(ctx context.Context, req *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeOwner not implemented")
+func (m *MsgProposeScopeOwnershipTransferResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateValueOwners(ctx context.Context, req *MsgUpdateValueOwnersRequest) (*MsgUpdateValueOwnersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateValueOwners not implemented")
+func (m *MsgProposeScopeOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeScopeOwnershipTransferResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) MigrateValueOwner(ctx context.Context, req *MsgMigrateValueOwnerRequest) (*MsgMigrateValueOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MigrateValueOwner not implemented")
+
+var xxx_messageInfo_MsgProposeScopeOwnershipTransferResponse proto.InternalMessageInfo
+
+// MsgAcceptScopeOwnershipTransferRequest defines the Msg/AcceptScopeOwnershipTransfer request type
+type MsgAcceptScopeOwnershipTransferRequest struct {
+	// scope_id is the id of the scope with the pending transfer.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
-func (*UnimplementedMsgServer) WriteSession(ctx context.Context, req *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteSession not implemented")
+
+func (m *MsgAcceptScopeOwnershipTransferRequest) Reset() {
+	*m = MsgAcceptScopeOwnershipTransferRequest{}
 }
-func (*UnimplementedMsgServer) WriteRecord(ctx context.Context, req *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteRecord not implemented")
+func (m *MsgAcceptScopeOwnershipTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptScopeOwnershipTransferRequest) ProtoMessage()    {}
+func (*MsgAcceptScopeOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{59}
 }
-func (*UnimplementedMsgServer) DeleteRecord(ctx context.Context, req *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
+func (m *MsgAcceptScopeOwnershipTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) WriteScopeSpecification(ctx context.Context, req *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteScopeSpecification not implemented")
+func (m *MsgAcceptScopeOwnershipTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptScopeOwnershipTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DeleteScopeSpecification(ctx context.Context, req *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeSpecification not implemented")
+func (m *MsgAcceptScopeOwnershipTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptScopeOwnershipTransferRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) WriteContractSpecification(ctx context.Context, req *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteContractSpecification not implemented")
+func (m *MsgAcceptScopeOwnershipTransferRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) DeleteContractSpecification(ctx context.Context, req *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecification not implemented")
+func (m *MsgAcceptScopeOwnershipTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptScopeOwnershipTransferRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddContractSpecToScopeSpec(ctx context.Context, req *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddContractSpecToScopeSpec not implemented")
+
+var xxx_messageInfo_MsgAcceptScopeOwnershipTransferRequest proto.InternalMessageInfo
+
+// MsgAcceptScopeOwnershipTransferResponse defines the Msg/AcceptScopeOwnershipTransfer response type
+type MsgAcceptScopeOwnershipTransferResponse struct {
 }
-func (*UnimplementedMsgServer) DeleteContractSpecFromScopeSpec(ctx context.Context, req *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecFromScopeSpec not implemented")
+
+func (m *MsgAcceptScopeOwnershipTransferResponse) Reset() {
+	*m = MsgAcceptScopeOwnershipTransferResponse{}
 }
-func (*UnimplementedMsgServer) WriteRecordSpecification(ctx context.Context, req *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteRecordSpecification not implemented")
+func (m *MsgAcceptScopeOwnershipTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptScopeOwnershipTransferResponse) ProtoMessage()    {}
+func (*MsgAcceptScopeOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{60}
 }
-func (*UnimplementedMsgServer) DeleteRecordSpecification(ctx context.Context, req *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecordSpecification not implemented")
+func (m *MsgAcceptScopeOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) BindOSLocator(ctx context.Context, req *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BindOSLocator not implemented")
+func (m *MsgAcceptScopeOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptScopeOwnershipTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DeleteOSLocator(ctx context.Context, req *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOSLocator not implemented")
+func (m *MsgAcceptScopeOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptScopeOwnershipTransferResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ModifyOSLocator(ctx context.Context, req *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyOSLocator not implemented")
+func (m *MsgAcceptScopeOwnershipTransferResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAccountData not implemented")
-}
-func (*UnimplementedMsgServer) AddNetAssetValues(ctx context.Context, req *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddNetAssetValues not implemented")
+func (m *MsgAcceptScopeOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptScopeOwnershipTransferResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgAcceptScopeOwnershipTransferResponse proto.InternalMessageInfo
+
+// MsgCancelScopeOwnershipTransferRequest defines the Msg/CancelScopeOwnershipTransfer request type
+type MsgCancelScopeOwnershipTransferRequest struct {
+	// scope_id is the id of the scope with the pending transfer.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func _Msg_WriteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteScopeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteScope(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteScope",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteScope(ctx, req.(*MsgWriteScopeRequest))
+func (m *MsgCancelScopeOwnershipTransferRequest) Reset() {
+	*m = MsgCancelScopeOwnershipTransferRequest{}
+}
+func (m *MsgCancelScopeOwnershipTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScopeOwnershipTransferRequest) ProtoMessage()    {}
+func (*MsgCancelScopeOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{61}
+}
+func (m *MsgCancelScopeOwnershipTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScopeOwnershipTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScopeOwnershipTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelScopeOwnershipTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScopeOwnershipTransferRequest.Merge(m, src)
+}
+func (m *MsgCancelScopeOwnershipTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScopeOwnershipTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScopeOwnershipTransferRequest.DiscardUnknown(m)
 }
 
-func _Msg_DeleteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScope(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScope",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScope(ctx, req.(*MsgDeleteScopeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgCancelScopeOwnershipTransferRequest proto.InternalMessageInfo
+
+// MsgCancelScopeOwnershipTransferResponse defines the Msg/CancelScopeOwnershipTransfer response type
+type MsgCancelScopeOwnershipTransferResponse struct {
 }
 
-func _Msg_AddScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddScopeDataAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddScopeDataAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/AddScopeDataAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddScopeDataAccess(ctx, req.(*MsgAddScopeDataAccessRequest))
+func (m *MsgCancelScopeOwnershipTransferResponse) Reset() {
+	*m = MsgCancelScopeOwnershipTransferResponse{}
+}
+func (m *MsgCancelScopeOwnershipTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScopeOwnershipTransferResponse) ProtoMessage()    {}
+func (*MsgCancelScopeOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{62}
+}
+func (m *MsgCancelScopeOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScopeOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScopeOwnershipTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelScopeOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScopeOwnershipTransferResponse.Merge(m, src)
+}
+func (m *MsgCancelScopeOwnershipTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScopeOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScopeOwnershipTransferResponse.DiscardUnknown(m)
 }
 
-func _Msg_DeleteScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeDataAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScopeDataAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeDataAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScopeDataAccess(ctx, req.(*MsgDeleteScopeDataAccessRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgCancelScopeOwnershipTransferResponse proto.InternalMessageInfo
+
+// MsgWriteScopeBundleRequest defines the Msg/WriteScopeBundle request type
+type MsgWriteScopeBundleRequest struct {
+	// scope is the Scope you want added or updated.
+	Scope Scope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope"`
+	// sessions are the Sessions (in the scope) you want added or updated. They are written after the scope.
+	Sessions []Session `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions"`
+	// records are the Records (in the scope) you want added or updated. They are written after the sessions.
+	Records []Record `protobuf:"bytes,3,rep,name=records,proto3" json:"records"`
+	// signers is the list of address of those signing this request.
+	// They are used as the signers of each part of the bundle.
+	Signers []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+	// usd_mills value of scope in usd mills (1234 = $1.234) used for net asset value
+	UsdMills uint64 `protobuf:"varint,5,opt,name=usd_mills,json=usdMills,proto3" json:"usd_mills,omitempty"`
 }
 
-func _Msg_AddScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddScopeOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddScopeOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/AddScopeOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddScopeOwner(ctx, req.(*MsgAddScopeOwnerRequest))
+func (m *MsgWriteScopeBundleRequest) Reset()         { *m = MsgWriteScopeBundleRequest{} }
+func (m *MsgWriteScopeBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteScopeBundleRequest) ProtoMessage()    {}
+func (*MsgWriteScopeBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{63}
+}
+func (m *MsgWriteScopeBundleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWriteScopeBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteScopeBundleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgWriteScopeBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteScopeBundleRequest.Merge(m, src)
+}
+func (m *MsgWriteScopeBundleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWriteScopeBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteScopeBundleRequest.DiscardUnknown(m)
 }
 
-func _Msg_DeleteScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScopeOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScopeOwner(ctx, req.(*MsgDeleteScopeOwnerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgWriteScopeBundleRequest proto.InternalMessageInfo
+
+// MsgWriteScopeBundleResponse defines the Msg/WriteScopeBundle response type
+type MsgWriteScopeBundleResponse struct {
+	// scope_id_info contains information about the id/address of the scope that was added or updated.
+	ScopeIdInfo *ScopeIdInfo `protobuf:"bytes,1,opt,name=scope_id_info,json=scopeIdInfo,proto3" json:"scope_id_info,omitempty"`
+	// session_id_infos contains information about the ids/addresses of the sessions that were added or updated.
+	SessionIdInfos []*SessionIdInfo `protobuf:"bytes,2,rep,name=session_id_infos,json=sessionIdInfos,proto3" json:"session_id_infos,omitempty"`
+	// record_id_infos contains information about the ids/addresses of the records that were added or updated.
+	RecordIdInfos []*RecordIdInfo `protobuf:"bytes,3,rep,name=record_id_infos,json=recordIdInfos,proto3" json:"record_id_infos,omitempty"`
 }
 
-func _Msg_UpdateValueOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateValueOwnersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateValueOwners(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/UpdateValueOwners",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateValueOwners(ctx, req.(*MsgUpdateValueOwnersRequest))
+func (m *MsgWriteScopeBundleResponse) Reset()         { *m = MsgWriteScopeBundleResponse{} }
+func (m *MsgWriteScopeBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteScopeBundleResponse) ProtoMessage()    {}
+func (*MsgWriteScopeBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{64}
+}
+func (m *MsgWriteScopeBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWriteScopeBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteScopeBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgWriteScopeBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteScopeBundleResponse.Merge(m, src)
+}
+func (m *MsgWriteScopeBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWriteScopeBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteScopeBundleResponse.DiscardUnknown(m)
 }
 
-func _Msg_MigrateValueOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMigrateValueOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MigrateValueOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/MigrateValueOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MigrateValueOwner(ctx, req.(*MsgMigrateValueOwnerRequest))
+var xxx_messageInfo_MsgWriteScopeBundleResponse proto.InternalMessageInfo
+
+func (m *MsgWriteScopeBundleResponse) GetScopeIdInfo() *ScopeIdInfo {
+	if m != nil {
+		return m.ScopeIdInfo
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_WriteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteSessionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteSession(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteSession",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteSession(ctx, req.(*MsgWriteSessionRequest))
+func (m *MsgWriteScopeBundleResponse) GetSessionIdInfos() []*SessionIdInfo {
+	if m != nil {
+		return m.SessionIdInfos
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_WriteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteRecord(ctx, req.(*MsgWriteRecordRequest))
+func (m *MsgWriteScopeBundleResponse) GetRecordIdInfos() []*RecordIdInfo {
+	if m != nil {
+		return m.RecordIdInfos
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteRecord(ctx, req.(*MsgDeleteRecordRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgArchiveScopeRequest defines the Msg/ArchiveScope request type
+type MsgArchiveScopeRequest struct {
+	// scope_id is the id of the scope to archive. Once archived, a scope with this id cannot be written again.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func _Msg_WriteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteScopeSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteScopeSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteScopeSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteScopeSpecification(ctx, req.(*MsgWriteScopeSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgArchiveScopeRequest) Reset()         { *m = MsgArchiveScopeRequest{} }
+func (m *MsgArchiveScopeRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgArchiveScopeRequest) ProtoMessage()    {}
+func (*MsgArchiveScopeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{65}
 }
-
-func _Msg_DeleteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScopeSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScopeSpecification(ctx, req.(*MsgDeleteScopeSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgArchiveScopeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_WriteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteContractSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteContractSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteContractSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteContractSpecification(ctx, req.(*MsgWriteContractSpecificationRequest))
+func (m *MsgArchiveScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgArchiveScopeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_DeleteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteContractSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteContractSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteContractSpecification(ctx, req.(*MsgDeleteContractSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgArchiveScopeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArchiveScopeRequest.Merge(m, src)
+}
+func (m *MsgArchiveScopeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgArchiveScopeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArchiveScopeRequest.DiscardUnknown(m)
 }
 
-func _Msg_AddContractSpecToScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddContractSpecToScopeSpecRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, req.(*MsgAddContractSpecToScopeSpecRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgArchiveScopeRequest proto.InternalMessageInfo
+
+// MsgArchiveScopeResponse defines the Msg/ArchiveScope response type
+type MsgArchiveScopeResponse struct {
+	// tombstone is the record kept of the archived scope.
+	Tombstone ScopeTombstone `protobuf:"bytes,1,opt,name=tombstone,proto3" json:"tombstone"`
 }
 
-func _Msg_DeleteContractSpecFromScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteContractSpecFromScopeSpecRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, req.(*MsgDeleteContractSpecFromScopeSpecRequest))
+func (m *MsgArchiveScopeResponse) Reset()         { *m = MsgArchiveScopeResponse{} }
+func (m *MsgArchiveScopeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgArchiveScopeResponse) ProtoMessage()    {}
+func (*MsgArchiveScopeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{66}
+}
+func (m *MsgArchiveScopeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgArchiveScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgArchiveScopeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgArchiveScopeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArchiveScopeResponse.Merge(m, src)
+}
+func (m *MsgArchiveScopeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgArchiveScopeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArchiveScopeResponse.DiscardUnknown(m)
 }
 
-func _Msg_WriteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteRecordSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteRecordSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteRecordSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteRecordSpecification(ctx, req.(*MsgWriteRecordSpecificationRequest))
+var xxx_messageInfo_MsgArchiveScopeResponse proto.InternalMessageInfo
+
+func (m *MsgArchiveScopeResponse) GetTombstone() ScopeTombstone {
+	if m != nil {
+		return m.Tombstone
 	}
-	return interceptor(ctx, in, info, handler)
+	return ScopeTombstone{}
 }
 
-func _Msg_DeleteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRecordSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteRecordSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecordSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteRecordSpecification(ctx, req.(*MsgDeleteRecordSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgMigrateScopeSpecRequest defines the Msg/MigrateScopeSpec request type
+type MsgMigrateScopeSpecRequest struct {
+	// scope_ids are the scope metadata addresses of all scopes to be migrated.
+	ScopeIds []MetadataAddress `protobuf:"bytes,1,rep,name=scope_ids,json=scopeIds,proto3,customtype=MetadataAddress" json:"scope_ids"`
+	// specification_id is the id of the scope specification to migrate the scopes to.
+	// It must be a newer version of the scope specification each scope currently uses.
+	SpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id"`
+	// signers is the list of addresses of those signing this request.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func _Msg_BindOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBindOSLocatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BindOSLocator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/BindOSLocator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BindOSLocator(ctx, req.(*MsgBindOSLocatorRequest))
+func (m *MsgMigrateScopeSpecRequest) Reset()         { *m = MsgMigrateScopeSpecRequest{} }
+func (m *MsgMigrateScopeSpecRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateScopeSpecRequest) ProtoMessage()    {}
+func (*MsgMigrateScopeSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{67}
+}
+func (m *MsgMigrateScopeSpecRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateScopeSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateScopeSpecRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgMigrateScopeSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateScopeSpecRequest.Merge(m, src)
+}
+func (m *MsgMigrateScopeSpecRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateScopeSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateScopeSpecRequest.DiscardUnknown(m)
 }
 
-func _Msg_DeleteOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteOSLocatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteOSLocator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteOSLocator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteOSLocator(ctx, req.(*MsgDeleteOSLocatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgMigrateScopeSpecRequest proto.InternalMessageInfo
+
+// MsgMigrateScopeSpecResponse defines the Msg/MigrateScopeSpec response type
+type MsgMigrateScopeSpecResponse struct {
 }
 
-func _Msg_ModifyOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgModifyOSLocatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ModifyOSLocator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/ModifyOSLocator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ModifyOSLocator(ctx, req.(*MsgModifyOSLocatorRequest))
+func (m *MsgMigrateScopeSpecResponse) Reset()         { *m = MsgMigrateScopeSpecResponse{} }
+func (m *MsgMigrateScopeSpecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateScopeSpecResponse) ProtoMessage()    {}
+func (*MsgMigrateScopeSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{68}
+}
+func (m *MsgMigrateScopeSpecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateScopeSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateScopeSpecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgMigrateScopeSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateScopeSpecResponse.Merge(m, src)
+}
+func (m *MsgMigrateScopeSpecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateScopeSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateScopeSpecResponse.DiscardUnknown(m)
 }
 
-func _Msg_SetAccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetAccountDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetAccountData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/SetAccountData",
+var xxx_messageInfo_MsgMigrateScopeSpecResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgWriteScopeRequest)(nil), "provenance.metadata.v1.MsgWriteScopeRequest")
+	proto.RegisterType((*MsgWriteScopeResponse)(nil), "provenance.metadata.v1.MsgWriteScopeResponse")
+	proto.RegisterType((*MsgDeleteScopeRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeRequest")
+	proto.RegisterType((*MsgDeleteScopeResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeResponse")
+	proto.RegisterType((*MsgAddScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessRequest")
+	proto.RegisterType((*MsgAddScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessResponse")
+	proto.RegisterType((*MsgDeleteScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessRequest")
+	proto.RegisterType((*MsgDeleteScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessResponse")
+	proto.RegisterType((*MsgAddScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgAddScopeOwnerRequest")
+	proto.RegisterType((*MsgAddScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgAddScopeOwnerResponse")
+	proto.RegisterType((*MsgDeleteScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerRequest")
+	proto.RegisterType((*MsgDeleteScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerResponse")
+	proto.RegisterType((*MsgUpdateValueOwnersRequest)(nil), "provenance.metadata.v1.MsgUpdateValueOwnersRequest")
+	proto.RegisterType((*MsgUpdateValueOwnersResponse)(nil), "provenance.metadata.v1.MsgUpdateValueOwnersResponse")
+	proto.RegisterType((*MsgMigrateValueOwnerRequest)(nil), "provenance.metadata.v1.MsgMigrateValueOwnerRequest")
+	proto.RegisterType((*MsgMigrateValueOwnerResponse)(nil), "provenance.metadata.v1.MsgMigrateValueOwnerResponse")
+	proto.RegisterType((*MsgWriteSessionRequest)(nil), "provenance.metadata.v1.MsgWriteSessionRequest")
+	proto.RegisterType((*SessionIdComponents)(nil), "provenance.metadata.v1.SessionIdComponents")
+	proto.RegisterType((*MsgWriteSessionResponse)(nil), "provenance.metadata.v1.MsgWriteSessionResponse")
+	proto.RegisterType((*MsgWriteRecordRequest)(nil), "provenance.metadata.v1.MsgWriteRecordRequest")
+	proto.RegisterType((*MsgWriteRecordResponse)(nil), "provenance.metadata.v1.MsgWriteRecordResponse")
+	proto.RegisterType((*MsgDeleteRecordRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordRequest")
+	proto.RegisterType((*MsgDeleteRecordResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordResponse")
+	proto.RegisterType((*MsgWriteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationRequest")
+	proto.RegisterType((*MsgWriteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationResponse")
+	proto.RegisterType((*MsgDeleteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationRequest")
+	proto.RegisterType((*MsgDeleteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationResponse")
+	proto.RegisterType((*MsgWriteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationRequest")
+	proto.RegisterType((*MsgWriteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationResponse")
+	proto.RegisterType((*MsgAddContractSpecToScopeSpecRequest)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecRequest")
+	proto.RegisterType((*MsgAddContractSpecToScopeSpecResponse)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecResponse")
+	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecRequest")
+	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecResponse")
+	proto.RegisterType((*MsgDeleteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationRequest")
+	proto.RegisterType((*MsgDeleteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationResponse")
+	proto.RegisterType((*MsgWriteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationRequest")
+	proto.RegisterType((*MsgWriteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationResponse")
+	proto.RegisterType((*MsgDeleteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationRequest")
+	proto.RegisterType((*MsgDeleteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationResponse")
+	proto.RegisterType((*MsgBindOSLocatorRequest)(nil), "provenance.metadata.v1.MsgBindOSLocatorRequest")
+	proto.RegisterType((*MsgBindOSLocatorResponse)(nil), "provenance.metadata.v1.MsgBindOSLocatorResponse")
+	proto.RegisterType((*MsgDeleteOSLocatorRequest)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorRequest")
+	proto.RegisterType((*MsgDeleteOSLocatorResponse)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorResponse")
+	proto.RegisterType((*MsgModifyOSLocatorRequest)(nil), "provenance.metadata.v1.MsgModifyOSLocatorRequest")
+	proto.RegisterType((*MsgModifyOSLocatorResponse)(nil), "provenance.metadata.v1.MsgModifyOSLocatorResponse")
+	proto.RegisterType((*MsgSetAccountDataRequest)(nil), "provenance.metadata.v1.MsgSetAccountDataRequest")
+	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.metadata.v1.MsgSetAccountDataResponse")
+	proto.RegisterType((*MsgWriteP8EContractSpecRequest)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecRequest")
+	proto.RegisterType((*MsgWriteP8EContractSpecResponse)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecResponse")
+	proto.RegisterType((*MsgP8EMemorializeContractRequest)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractRequest")
+	proto.RegisterType((*MsgP8EMemorializeContractResponse)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractResponse")
+	proto.RegisterType((*MsgAddNetAssetValuesRequest)(nil), "provenance.metadata.v1.MsgAddNetAssetValuesRequest")
+	proto.RegisterType((*MsgAddNetAssetValuesResponse)(nil), "provenance.metadata.v1.MsgAddNetAssetValuesResponse")
+	proto.RegisterType((*MsgSetNetAssetValueMaxAgeRequest)(nil), "provenance.metadata.v1.MsgSetNetAssetValueMaxAgeRequest")
+	proto.RegisterType((*MsgSetNetAssetValueMaxAgeResponse)(nil), "provenance.metadata.v1.MsgSetNetAssetValueMaxAgeResponse")
+	proto.RegisterType((*MsgPruneScopeHistoryRequest)(nil), "provenance.metadata.v1.MsgPruneScopeHistoryRequest")
+	proto.RegisterType((*MsgPruneScopeHistoryResponse)(nil), "provenance.metadata.v1.MsgPruneScopeHistoryResponse")
+	proto.RegisterType((*MsgProposeScopeOwnershipTransferRequest)(nil), "provenance.metadata.v1.MsgProposeScopeOwnershipTransferRequest")
+	proto.RegisterType((*MsgProposeScopeOwnershipTransferResponse)(nil), "provenance.metadata.v1.MsgProposeScopeOwnershipTransferResponse")
+	proto.RegisterType((*MsgAcceptScopeOwnershipTransferRequest)(nil), "provenance.metadata.v1.MsgAcceptScopeOwnershipTransferRequest")
+	proto.RegisterType((*MsgAcceptScopeOwnershipTransferResponse)(nil), "provenance.metadata.v1.MsgAcceptScopeOwnershipTransferResponse")
+	proto.RegisterType((*MsgCancelScopeOwnershipTransferRequest)(nil), "provenance.metadata.v1.MsgCancelScopeOwnershipTransferRequest")
+	proto.RegisterType((*MsgCancelScopeOwnershipTransferResponse)(nil), "provenance.metadata.v1.MsgCancelScopeOwnershipTransferResponse")
+	proto.RegisterType((*MsgWriteScopeBundleRequest)(nil), "provenance.metadata.v1.MsgWriteScopeBundleRequest")
+	proto.RegisterType((*MsgWriteScopeBundleResponse)(nil), "provenance.metadata.v1.MsgWriteScopeBundleResponse")
+	proto.RegisterType((*MsgArchiveScopeRequest)(nil), "provenance.metadata.v1.MsgArchiveScopeRequest")
+	proto.RegisterType((*MsgArchiveScopeResponse)(nil), "provenance.metadata.v1.MsgArchiveScopeResponse")
+	proto.RegisterType((*MsgMigrateScopeSpecRequest)(nil), "provenance.metadata.v1.MsgMigrateScopeSpecRequest")
+	proto.RegisterType((*MsgMigrateScopeSpecResponse)(nil), "provenance.metadata.v1.MsgMigrateScopeSpecResponse")
+}
+
+func init() { proto.RegisterFile("provenance/metadata/v1/tx.proto", fileDescriptor_3a3a0892f91e3036) }
+
+var fileDescriptor_3a3a0892f91e3036 = []byte{
+	// 2628 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xf7, 0xac, 0xbf, 0x8f, 0xed, 0xd8, 0xb9, 0x71, 0xec, 0xf5, 0x24, 0xf1, 0x3a, 0x9b, 0x2f,
+	0xd7, 0x4d, 0x76, 0x1b, 0xc7, 0x88, 0x7c, 0x36, 0xb1, 0x13, 0x41, 0x5c, 0xd5, 0x24, 0x5a, 0x27,
+	0x8d, 0x8a, 0x84, 0x96, 0xc9, 0xce, 0xf5, 0x66, 0xc8, 0xee, 0xcc, 0x32, 0x77, 0xd6, 0x75, 0x9a,
+	0x12, 0x01, 0x52, 0x0b, 0x42, 0x80, 0x82, 0x90, 0x2a, 0x2a, 0x10, 0x54, 0x3c, 0x00, 0x8f, 0x95,
+	0x78, 0xe3, 0x05, 0x9e, 0x50, 0x9e, 0x50, 0x25, 0x78, 0x40, 0x45, 0x2a, 0x28, 0x11, 0x2a, 0x7f,
+	0x03, 0x0f, 0x80, 0xe6, 0xce, 0xbd, 0xf3, 0xb1, 0x33, 0x73, 0x67, 0x66, 0x6d, 0xe2, 0x4a, 0x7d,
+	0x88, 0xe4, 0x99, 0x3d, 0x5f, 0xbf, 0x73, 0xcf, 0x3d, 0xf7, 0xcc, 0x39, 0x37, 0x50, 0x68, 0x99,
+	0xc6, 0x26, 0xd6, 0x15, 0xbd, 0x86, 0xcb, 0x4d, 0x6c, 0x29, 0xaa, 0x62, 0x29, 0xe5, 0xcd, 0xd3,
+	0x65, 0x6b, 0xab, 0xd4, 0x32, 0x0d, 0xcb, 0x40, 0x53, 0x1e, 0x41, 0x89, 0x13, 0x94, 0x36, 0x4f,
+	0xcb, 0xd3, 0x35, 0x83, 0x34, 0x0d, 0x52, 0x6e, 0x92, 0xba, 0x4d, 0xdf, 0x24, 0x75, 0x87, 0x41,
+	0x9e, 0xac, 0x1b, 0x75, 0x83, 0xfe, 0x59, 0xb6, 0xff, 0x62, 0x6f, 0x0b, 0x75, 0xc3, 0xa8, 0x37,
+	0x70, 0x99, 0x3e, 0xdd, 0x6d, 0x6f, 0x94, 0x2d, 0xad, 0x89, 0x89, 0xa5, 0x34, 0x5b, 0x8c, 0xe0,
+	0x58, 0x8c, 0x21, 0xae, 0x4e, 0x87, 0x6c, 0x3e, 0x86, 0xcc, 0xb8, 0xfb, 0x35, 0x5c, 0xb3, 0x88,
+	0x65, 0x98, 0x98, 0x51, 0x1e, 0x8d, 0xa1, 0x6c, 0x9d, 0xc5, 0xf6, 0x3f, 0x46, 0x55, 0x8c, 0xa1,
+	0x22, 0x35, 0xa3, 0xc5, 0x69, 0x16, 0xe2, 0x68, 0x5a, 0xb8, 0xa6, 0x6d, 0x68, 0x35, 0xc5, 0xd2,
+	0x0c, 0xdd, 0xa1, 0x2d, 0x7e, 0x24, 0xc1, 0xe4, 0x1a, 0xa9, 0xdf, 0x31, 0x35, 0x0b, 0xaf, 0xdb,
+	0x32, 0x2a, 0xf8, 0xeb, 0x6d, 0x4c, 0x2c, 0x74, 0x0e, 0xfa, 0xa9, 0xcc, 0xbc, 0x34, 0x27, 0xcd,
+	0x8f, 0x2c, 0x1e, 0x2a, 0x45, 0xfb, 0xb5, 0x44, 0x99, 0x56, 0xfa, 0x9e, 0x7c, 0x5c, 0xe8, 0xa9,
+	0x38, 0x1c, 0x28, 0x0f, 0x83, 0x44, 0xab, 0xeb, 0xd8, 0x24, 0xf9, 0xdc, 0x5c, 0xef, 0xfc, 0x70,
+	0x85, 0x3f, 0xa2, 0x43, 0x00, 0x94, 0xa4, 0xda, 0x6e, 0x6b, 0x6a, 0xbe, 0x77, 0x4e, 0x9a, 0x1f,
+	0xae, 0x0c, 0xd3, 0x37, 0xb7, 0xdb, 0x9a, 0x8a, 0x0e, 0xc0, 0xb0, 0x6d, 0xa3, 0xf3, 0x6b, 0x1f,
+	0xfd, 0x75, 0xc8, 0x7e, 0xc1, 0x7f, 0x6c, 0x13, 0xb5, 0xda, 0xd4, 0x1a, 0x0d, 0x92, 0xef, 0x9f,
+	0x93, 0xe6, 0xfb, 0x2a, 0x43, 0x6d, 0xa2, 0xae, 0xd9, 0xcf, 0xe7, 0x27, 0xbf, 0xfb, 0x7e, 0xa1,
+	0xe7, 0x5f, 0xef, 0x17, 0x7a, 0xbe, 0xfd, 0xc9, 0x07, 0x0b, 0x5c, 0x5d, 0xf1, 0xab, 0xb0, 0xbf,
+	0x03, 0x1b, 0x69, 0x19, 0x3a, 0xc1, 0xe8, 0x8b, 0x30, 0xe6, 0xd8, 0xa1, 0xa9, 0x55, 0x4d, 0xdf,
+	0x30, 0x18, 0xc8, 0x23, 0x42, 0x90, 0xab, 0xea, 0xaa, 0xbe, 0x61, 0x54, 0x46, 0x88, 0xf7, 0x50,
+	0x7c, 0x48, 0x35, 0x5c, 0xc3, 0x0d, 0xdc, 0xe1, 0xbe, 0x45, 0x18, 0xe2, 0x1a, 0xa8, 0xf0, 0xd1,
+	0x95, 0x69, 0xdb, 0x45, 0x1f, 0x7d, 0x5c, 0x18, 0x5f, 0x63, 0x82, 0x97, 0x55, 0xd5, 0xc4, 0x84,
+	0x54, 0x06, 0x99, 0xc0, 0x78, 0xbf, 0xc5, 0xc0, 0xcb, 0xc3, 0x54, 0xa7, 0x72, 0x07, 0x5f, 0xf1,
+	0x9f, 0x12, 0x1c, 0x5c, 0x23, 0xf5, 0x65, 0x55, 0xa5, 0xef, 0xaf, 0xd9, 0xda, 0x6a, 0x35, 0x5b,
+	0xd9, 0x36, 0xcc, 0x2b, 0xc0, 0x88, 0xfd, 0xbe, 0xaa, 0x50, 0x49, 0xcc, 0x44, 0x50, 0x5d, 0xd9,
+	0x7e, 0xfb, 0x7b, 0x83, 0xeb, 0x7e, 0x05, 0x00, 0x6f, 0xb5, 0x34, 0x93, 0x46, 0x1e, 0x5d, 0xd9,
+	0x91, 0x45, 0xb9, 0xe4, 0x6c, 0xb1, 0x12, 0xdf, 0x62, 0xa5, 0x5b, 0x7c, 0x8b, 0xad, 0xf4, 0x3d,
+	0xfe, 0x7b, 0x41, 0xaa, 0xf8, 0x78, 0x62, 0x3c, 0x50, 0x80, 0x43, 0x31, 0x30, 0x99, 0x23, 0x7e,
+	0x25, 0x41, 0x21, 0xe8, 0xa3, 0xdd, 0xf6, 0x45, 0x0c, 0x92, 0x22, 0xcc, 0xc5, 0xdb, 0xc9, 0xc0,
+	0xfc, 0x4e, 0x82, 0x69, 0x1f, 0xdc, 0x1b, 0x6f, 0xe8, 0xd8, 0xdc, 0x0e, 0x88, 0x0b, 0x30, 0x60,
+	0xbc, 0xe1, 0x86, 0x9b, 0x60, 0x8f, 0xdf, 0x54, 0x4c, 0xeb, 0x01, 0xdb, 0xe3, 0x8c, 0x25, 0x33,
+	0x40, 0x19, 0xf2, 0x61, 0xdb, 0x19, 0xb0, 0x9f, 0x48, 0x20, 0x07, 0xd1, 0x6f, 0x1b, 0xdb, 0x54,
+	0x00, 0xdb, 0x70, 0xd7, 0x66, 0x1f, 0x82, 0x03, 0x91, 0x96, 0x31, 0xcb, 0x7f, 0x2b, 0xd1, 0xdf,
+	0x6f, 0xb7, 0x54, 0xc5, 0xc2, 0xaf, 0x29, 0x8d, 0xb6, 0xf3, 0xbb, 0x1b, 0x5b, 0x4b, 0x30, 0xcc,
+	0x4d, 0x27, 0x79, 0x69, 0xae, 0x57, 0x64, 0xfb, 0x10, 0xb3, 0x9d, 0xa0, 0x12, 0xec, 0xdb, 0xb4,
+	0x65, 0x55, 0xa9, 0xd1, 0x55, 0xc5, 0x21, 0xc8, 0xe7, 0x68, 0x46, 0xdc, 0xbb, 0xe9, 0xaa, 0x61,
+	0x9c, 0x99, 0x41, 0xcd, 0xc2, 0xc1, 0x68, 0xa3, 0x19, 0xaa, 0xb7, 0x1d, 0x54, 0x6b, 0x5a, 0xdd,
+	0x0c, 0x50, 0x70, 0x54, 0x32, 0x0c, 0xe1, 0x2d, 0x8d, 0x58, 0x9a, 0x5e, 0xa7, 0x0b, 0x32, 0x5c,
+	0x71, 0x9f, 0xed, 0xdf, 0x5a, 0xa6, 0xd1, 0x32, 0x08, 0x56, 0x99, 0xc1, 0xee, 0x73, 0x97, 0x76,
+	0x46, 0x98, 0xc1, 0xec, 0xfc, 0x4e, 0x0e, 0xa6, 0xdc, 0x04, 0x8f, 0x09, 0xd1, 0x0c, 0x9d, 0x9b,
+	0x78, 0x19, 0x06, 0x89, 0xf3, 0x86, 0xe5, 0xf6, 0x42, 0x6c, 0x6e, 0x77, 0xc8, 0x58, 0x78, 0x73,
+	0x2e, 0xc1, 0x21, 0x56, 0x85, 0xfd, 0x8c, 0xc8, 0x3e, 0x3e, 0x6a, 0x46, 0xb3, 0x65, 0xe8, 0x58,
+	0xb7, 0x08, 0x3d, 0xcf, 0x46, 0x16, 0x5f, 0x4c, 0x50, 0xb4, 0xaa, 0x5e, 0x75, 0x59, 0x2a, 0xfb,
+	0x48, 0xf8, 0xa5, 0xf0, 0x18, 0x8c, 0xf1, 0xd4, 0x0f, 0x25, 0xd8, 0x17, 0x21, 0x1f, 0x15, 0x02,
+	0x07, 0x2e, 0x5d, 0xab, 0xeb, 0x3d, 0xfe, 0x23, 0xd7, 0x25, 0xb0, 0x83, 0x2c, 0x9f, 0x0b, 0x10,
+	0xd8, 0xe1, 0x85, 0x0e, 0xc3, 0x28, 0x47, 0xeb, 0x3b, 0xb4, 0x47, 0xd8, 0x3b, 0x5b, 0xc6, 0x0a,
+	0x82, 0x09, 0x1e, 0xe4, 0x58, 0xb7, 0xb4, 0x0d, 0x0d, 0x9b, 0xc5, 0x7b, 0x30, 0x1d, 0x5a, 0x19,
+	0x76, 0xf8, 0xae, 0xc1, 0xb8, 0xcf, 0x7f, 0xbe, 0xe3, 0xf7, 0x58, 0xa2, 0xe7, 0xe8, 0x01, 0x3c,
+	0x46, 0xfc, 0x8f, 0xc5, 0x3f, 0xe7, 0xbc, 0x53, 0xbe, 0x82, 0x6b, 0x86, 0xa9, 0xf2, 0x18, 0xb8,
+	0x08, 0x03, 0x26, 0x7d, 0xc1, 0xe4, 0xcf, 0xc6, 0xc9, 0x77, 0xd8, 0x78, 0x82, 0x73, 0x78, 0x76,
+	0x33, 0x00, 0x4e, 0x02, 0xaa, 0x19, 0xba, 0x65, 0x2a, 0x35, 0xab, 0xda, 0x19, 0x09, 0x13, 0xfc,
+	0x97, 0x75, 0x5e, 0x18, 0x5d, 0x82, 0xc1, 0x96, 0x62, 0x5a, 0x1a, 0xb6, 0xcb, 0xa2, 0xd4, 0x79,
+	0x9c, 0xf3, 0xc4, 0x04, 0x94, 0xea, 0xed, 0x2c, 0xee, 0x54, 0xb6, 0x7c, 0xaf, 0xc0, 0x1e, 0xc7,
+	0x43, 0x1d, 0xab, 0x77, 0x54, 0xec, 0x5d, 0xb6, 0x78, 0xa3, 0xa6, 0xef, 0xa9, 0xf8, 0xc8, 0x57,
+	0xc1, 0x04, 0xd7, 0x6e, 0x09, 0x86, 0x5d, 0x2d, 0x49, 0x49, 0x7f, 0x88, 0xcb, 0xcc, 0x5c, 0x41,
+	0xcd, 0xc0, 0x74, 0x48, 0x3f, 0xcb, 0x2d, 0x4f, 0x24, 0x38, 0x1c, 0x28, 0x1e, 0xd7, 0xfd, 0xd5,
+	0x33, 0x37, 0xf3, 0x35, 0x18, 0x0b, 0x54, 0xd5, 0xcc, 0x17, 0x0b, 0xc2, 0x42, 0x32, 0x20, 0x89,
+	0x2d, 0x47, 0x50, 0x8c, 0x20, 0xf8, 0x02, 0xc9, 0xa1, 0x37, 0x55, 0x72, 0x78, 0x13, 0x8a, 0x22,
+	0x24, 0x6c, 0x5d, 0x6f, 0x01, 0x72, 0x76, 0x31, 0x15, 0x1f, 0x5c, 0xdb, 0x13, 0x89, 0x78, 0xd8,
+	0xf2, 0x8e, 0x93, 0xe0, 0x0b, 0xfb, 0x68, 0x2f, 0x06, 0x0f, 0xd0, 0x48, 0x3f, 0xae, 0xc0, 0x44,
+	0xc0, 0x01, 0x29, 0x56, 0x7d, 0x3c, 0xc0, 0xd0, 0xc5, 0xe2, 0x1f, 0x83, 0x23, 0x42, 0xcb, 0x58,
+	0x20, 0xfc, 0x49, 0x82, 0xa3, 0xdc, 0x7d, 0x57, 0x7d, 0x7b, 0x2f, 0x84, 0xe1, 0xf5, 0xe8, 0x58,
+	0x38, 0x15, 0xe7, 0xbb, 0x48, 0x61, 0xcf, 0x21, 0x1c, 0xde, 0x91, 0xe0, 0x58, 0x02, 0x20, 0x16,
+	0x12, 0x5f, 0x81, 0xfd, 0xc1, 0x3c, 0x14, 0x8c, 0x8a, 0x85, 0x34, 0xc8, 0x58, 0x60, 0xa0, 0x5a,
+	0xe8, 0x5d, 0xf1, 0xdf, 0x8e, 0x67, 0x97, 0x55, 0xd5, 0xcf, 0x70, 0xcb, 0x70, 0x17, 0x83, 0x7b,
+	0x76, 0x1d, 0x66, 0x02, 0x76, 0x64, 0x09, 0x93, 0xe9, 0x5a, 0x14, 0xc4, 0x55, 0x15, 0xad, 0xc1,
+	0x94, 0x17, 0xef, 0x01, 0x89, 0x39, 0xb1, 0xc4, 0x49, 0x12, 0x0a, 0x96, 0xd5, 0xec, 0xb5, 0xcd,
+	0x09, 0x38, 0x96, 0x80, 0x9d, 0xc5, 0xdf, 0x7f, 0x25, 0x78, 0xc1, 0x8d, 0x53, 0x3f, 0xf1, 0x17,
+	0x4c, 0xa3, 0xf9, 0x99, 0x70, 0xd5, 0x49, 0x58, 0x48, 0xe3, 0x00, 0xe6, 0xaf, 0x9f, 0x3a, 0xe1,
+	0x1d, 0x26, 0xff, 0x54, 0x24, 0x9d, 0x79, 0x38, 0x9e, 0x64, 0x1c, 0xc3, 0xf1, 0x37, 0xc9, 0x4b,
+	0xdb, 0xce, 0xd9, 0x14, 0x09, 0xe2, 0x4e, 0x74, 0xd6, 0x79, 0x51, 0x7c, 0x1a, 0x6f, 0x2b, 0xe7,
+	0x44, 0x97, 0x27, 0xbd, 0xd1, 0xe5, 0x49, 0x8c, 0x1f, 0x1e, 0xc1, 0x11, 0x21, 0x38, 0x96, 0x81,
+	0xee, 0xc0, 0x3e, 0x56, 0x06, 0x44, 0xe4, 0x9f, 0xf9, 0x64, 0x8c, 0x2c, 0xfb, 0x4c, 0x98, 0x1d,
+	0x6f, 0x8a, 0xef, 0x49, 0xbe, 0xec, 0x2f, 0x70, 0xef, 0x6e, 0xc4, 0xc8, 0x71, 0x38, 0x2a, 0x36,
+	0x8d, 0x45, 0xc8, 0x43, 0x5a, 0xbd, 0xac, 0x68, 0xba, 0x7a, 0x63, 0xfd, 0x55, 0xa3, 0xa6, 0x58,
+	0x86, 0xfb, 0x85, 0xf6, 0x0a, 0x0c, 0x36, 0x9c, 0x37, 0x49, 0xb9, 0xfa, 0x06, 0x6d, 0x44, 0xae,
+	0x5b, 0x86, 0x89, 0x99, 0x0c, 0x5e, 0x20, 0x32, 0x01, 0x1d, 0x46, 0xb2, 0xb7, 0xc5, 0x0d, 0xc8,
+	0x87, 0x95, 0xbb, 0x25, 0xe2, 0x8e, 0x69, 0x2f, 0x7e, 0x03, 0x66, 0x5c, 0x67, 0xec, 0x02, 0xcc,
+	0x7b, 0xbe, 0xce, 0xc4, 0xf3, 0x00, 0xba, 0x66, 0xa8, 0xda, 0xc6, 0x83, 0x5d, 0x03, 0x1a, 0x52,
+	0xff, 0x7f, 0x00, 0xfa, 0x73, 0x89, 0x86, 0xce, 0x3a, 0xb6, 0x96, 0x6b, 0x35, 0xa3, 0xad, 0x5b,
+	0x76, 0xab, 0xcb, 0xfb, 0x66, 0x1b, 0xe3, 0xd2, 0x9c, 0x4f, 0xd2, 0x84, 0xcd, 0x36, 0xda, 0xf4,
+	0xbd, 0x40, 0x93, 0xd0, 0x4f, 0xbb, 0x23, 0xac, 0xf3, 0xe0, 0x3c, 0x64, 0x3e, 0x6f, 0x0e, 0xc0,
+	0x4c, 0x84, 0x7d, 0x6c, 0xd3, 0xbd, 0x2b, 0xc1, 0x2c, 0xcf, 0x5c, 0x37, 0xcf, 0x06, 0x72, 0x38,
+	0xc7, 0x50, 0x81, 0x51, 0x9e, 0x05, 0xed, 0x54, 0x90, 0x94, 0xad, 0xec, 0xe6, 0xbe, 0x5f, 0x0c,
+	0xf3, 0x57, 0x40, 0x86, 0x20, 0x87, 0x0c, 0xd8, 0x18, 0xf2, 0x52, 0xf1, 0x99, 0xd3, 0xea, 0x8c,
+	0x36, 0xec, 0xb9, 0x14, 0x74, 0xe8, 0x75, 0x98, 0x8c, 0xc8, 0xd6, 0xbc, 0xbd, 0x98, 0x3e, 0x5d,
+	0xef, 0xed, 0x4c, 0xd7, 0x1e, 0xca, 0xff, 0xe4, 0x68, 0xa3, 0xf4, 0xe6, 0x59, 0xbc, 0x86, 0x9b,
+	0x86, 0xa9, 0x29, 0x0d, 0xed, 0x4d, 0x17, 0x2b, 0x5f, 0x80, 0x99, 0x8e, 0x86, 0xe1, 0xb0, 0xd7,
+	0x17, 0x9c, 0x81, 0xa1, 0xba, 0x69, 0xb4, 0x5b, 0xbc, 0x78, 0x19, 0xae, 0x0c, 0xd2, 0xe7, 0x55,
+	0x15, 0x2d, 0xc5, 0x56, 0x39, 0xce, 0xd1, 0x16, 0x5d, 0xcc, 0x5c, 0x01, 0xfb, 0xf3, 0x53, 0xb3,
+	0x94, 0x06, 0xc9, 0xf7, 0x89, 0x3f, 0x84, 0xed, 0x85, 0xae, 0x30, 0xda, 0x8a, 0xcb, 0x65, 0x4b,
+	0xe0, 0xbe, 0xcc, 0xf7, 0x27, 0x4b, 0x70, 0xc1, 0xba, 0x5c, 0xe8, 0x3a, 0x80, 0x1d, 0x0d, 0x8a,
+	0xd5, 0x36, 0x31, 0xc9, 0x0f, 0x24, 0x87, 0xdb, 0x3a, 0xa7, 0x5e, 0xc7, 0x56, 0xc5, 0xc7, 0x6b,
+	0x87, 0x99, 0xa6, 0x6f, 0x1a, 0xf7, 0xb1, 0x99, 0x1f, 0x74, 0xbc, 0xc3, 0x1e, 0xdd, 0x05, 0xf8,
+	0x51, 0x0e, 0x0e, 0x0b, 0x16, 0x60, 0x87, 0x07, 0x2c, 0x51, 0xcd, 0xa2, 0x5c, 0xf7, 0xcd, 0x22,
+	0xf4, 0x2a, 0x8c, 0x07, 0x9b, 0x17, 0x4e, 0x4a, 0x48, 0xdb, 0xbd, 0x18, 0xf3, 0x77, 0x2f, 0xbc,
+	0xa0, 0xfc, 0xbd, 0xd3, 0x2f, 0x5d, 0x56, 0xd5, 0x2f, 0x61, 0x6b, 0x99, 0x10, 0x6c, 0xd1, 0x66,
+	0x25, 0x49, 0x11, 0x8f, 0xf1, 0x55, 0xd6, 0x6d, 0x98, 0xd0, 0xb1, 0x55, 0x55, 0x6c, 0x71, 0x55,
+	0x9a, 0xc8, 0xb8, 0xad, 0xb1, 0xd0, 0x03, 0xda, 0x59, 0x1a, 0xd9, 0xa3, 0x07, 0x4c, 0x12, 0x76,
+	0x5a, 0x23, 0x00, 0xb0, 0xac, 0xf7, 0x8e, 0x44, 0xb7, 0xdd, 0x3a, 0xb6, 0x02, 0x04, 0x6b, 0xca,
+	0xd6, 0x72, 0x1d, 0x6f, 0x0b, 0xe6, 0x34, 0x0c, 0x36, 0x95, 0xad, 0xaa, 0x52, 0xc7, 0x74, 0x9b,
+	0xf5, 0x55, 0x06, 0x9a, 0x54, 0x68, 0x8c, 0xa1, 0x47, 0xe0, 0xb0, 0xc0, 0x0e, 0x66, 0xed, 0x5b,
+	0x74, 0x39, 0x6e, 0x9a, 0x6d, 0xdd, 0xf9, 0xb0, 0xbf, 0xae, 0x11, 0xcb, 0x30, 0x1f, 0x6c, 0xcb,
+	0x4e, 0x04, 0x7d, 0xf7, 0x31, 0x6e, 0x51, 0x23, 0xc7, 0x2a, 0xf4, 0x6f, 0xa1, 0x2f, 0x23, 0xb4,
+	0x33, 0xeb, 0xfe, 0x90, 0x83, 0x13, 0x94, 0x80, 0x76, 0xc5, 0xbd, 0xa1, 0x02, 0xb9, 0xa7, 0xb5,
+	0x6e, 0x99, 0x8a, 0x4e, 0x36, 0x76, 0x71, 0xac, 0x13, 0x33, 0x7a, 0xe8, 0x8d, 0x1b, 0x3d, 0x5c,
+	0xcb, 0x38, 0xd9, 0x1b, 0xb2, 0xb5, 0x75, 0x4e, 0xf7, 0xfc, 0x6e, 0xef, 0x4f, 0x73, 0x42, 0x2f,
+	0xc0, 0x7c, 0xb2, 0x07, 0x99, 0xbb, 0xbf, 0x2f, 0xd1, 0x4f, 0x2e, 0x7b, 0x96, 0xd6, 0xb2, 0x76,
+	0xde, 0xdb, 0x59, 0x8b, 0xfb, 0x17, 0xe0, 0x44, 0xa2, 0x35, 0x41, 0xcb, 0xaf, 0xda, 0x0b, 0xd7,
+	0xf8, 0xb4, 0x58, 0x2e, 0xb6, 0x86, 0x59, 0xfe, 0xeb, 0x1c, 0xc8, 0xbc, 0x16, 0xa1, 0xa4, 0x2b,
+	0x6d, 0x5d, 0x6d, 0xec, 0xc4, 0xdd, 0x82, 0x65, 0x18, 0x62, 0x19, 0x9d, 0x87, 0x77, 0xca, 0xc1,
+	0x8e, 0xcb, 0x86, 0x5e, 0x86, 0x41, 0x27, 0x8d, 0xf3, 0x7c, 0x9a, 0x6e, 0x2e, 0xc0, 0x99, 0xfc,
+	0x7e, 0xeb, 0x0b, 0x35, 0xe3, 0xb2, 0x5e, 0x51, 0x78, 0x3b, 0x07, 0x07, 0x22, 0x3d, 0xb5, 0xd3,
+	0x07, 0xe9, 0x0d, 0x98, 0xe8, 0x38, 0x48, 0xb9, 0x03, 0x53, 0x9e, 0xa4, 0x7b, 0x02, 0x27, 0x29,
+	0xd9, 0xd9, 0xa3, 0xb4, 0xf8, 0x16, 0x9d, 0x04, 0x2c, 0x9b, 0xb5, 0x7b, 0xda, 0xe6, 0xf3, 0xbf,
+	0x49, 0x81, 0x61, 0x3a, 0xa4, 0xdd, 0xfd, 0xf2, 0x19, 0xb6, 0x8c, 0xe6, 0x5d, 0x62, 0x19, 0x3a,
+	0x8f, 0xd7, 0xe3, 0x42, 0xe7, 0xdf, 0xe2, 0xd4, 0x2c, 0x6e, 0x3c, 0xf6, 0xe2, 0x1f, 0x9d, 0x39,
+	0x37, 0x1b, 0x68, 0x86, 0x7a, 0x77, 0xdd, 0x0d, 0x8b, 0xa3, 0x3a, 0x14, 0xb9, 0xee, 0x3b, 0x14,
+	0x19, 0xa6, 0xe2, 0x61, 0x1c, 0x8e, 0xcf, 0x16, 0xff, 0x72, 0x18, 0x7a, 0xd7, 0x48, 0x1d, 0x69,
+	0x00, 0x5e, 0x60, 0xa3, 0x93, 0x71, 0x6e, 0x8b, 0xba, 0x7f, 0x24, 0x9f, 0x4a, 0x49, 0xcd, 0x96,
+	0xa9, 0x01, 0x23, 0xbe, 0x4e, 0x3e, 0x12, 0x71, 0x87, 0x6f, 0xeb, 0xc8, 0xa5, 0xb4, 0xe4, 0x4c,
+	0xdb, 0xb7, 0x24, 0x40, 0xe1, 0x5b, 0x27, 0x68, 0x49, 0x20, 0x26, 0xf6, 0x2e, 0x8e, 0xfc, 0xb9,
+	0x8c, 0x5c, 0xcc, 0x86, 0xef, 0x49, 0xb0, 0x3f, 0xf2, 0xbe, 0x08, 0xfa, 0x7c, 0x3a, 0x34, 0x61,
+	0x4b, 0xce, 0x66, 0x67, 0x64, 0xc6, 0x98, 0x30, 0x16, 0xb8, 0xda, 0x81, 0xca, 0x29, 0x40, 0xf9,
+	0xef, 0x14, 0xc8, 0x2f, 0xa5, 0x67, 0x60, 0x3a, 0x1f, 0xc2, 0x44, 0xe7, 0xbd, 0x0c, 0xb4, 0x98,
+	0x0e, 0x41, 0x40, 0xf3, 0x99, 0x4c, 0x3c, 0x4c, 0xf9, 0x23, 0xd8, 0x1b, 0xba, 0x3f, 0x81, 0x44,
+	0x92, 0xe2, 0xae, 0x88, 0xc8, 0x4b, 0xd9, 0x98, 0x3c, 0xfd, 0xa1, 0x7b, 0x11, 0x42, 0xfd, 0x71,
+	0x97, 0x39, 0xe4, 0xa5, 0x6c, 0x4c, 0x4c, 0xbf, 0x01, 0xa3, 0xfe, 0xe1, 0x3e, 0x2a, 0x25, 0x6e,
+	0xd7, 0xc0, 0xfd, 0x0c, 0xb9, 0x9c, 0x9a, 0xde, 0xdb, 0xe0, 0xbe, 0x6e, 0x31, 0x4a, 0x4c, 0x0f,
+	0x81, 0x71, 0xb2, 0x5c, 0x4a, 0x4b, 0xee, 0xc1, 0xf3, 0xf7, 0x5f, 0x51, 0x72, 0x82, 0x08, 0xea,
+	0x2b, 0xa7, 0xa6, 0x67, 0x0a, 0x1f, 0x4b, 0x30, 0x1d, 0x33, 0xa1, 0x45, 0xe7, 0x52, 0xa5, 0xc2,
+	0xa8, 0xf6, 0xb5, 0x7c, 0xbe, 0x1b, 0x56, 0x66, 0xd2, 0x8f, 0x25, 0xc8, 0xc7, 0x4d, 0x47, 0xd1,
+	0xf9, 0x74, 0x9b, 0x26, 0xd2, 0xa8, 0x0b, 0x5d, 0xf1, 0x32, 0xab, 0xde, 0x93, 0x40, 0x8e, 0x1f,
+	0x5d, 0xa2, 0x8b, 0x49, 0x80, 0x45, 0x13, 0x21, 0xf9, 0x52, 0x97, 0xdc, 0xcc, 0xb6, 0x9f, 0x49,
+	0x70, 0x40, 0x30, 0xda, 0x41, 0x97, 0x12, 0x81, 0x0b, 0xad, 0x7b, 0xb9, 0x5b, 0x76, 0x9f, 0xeb,
+	0xe2, 0x07, 0x8e, 0x42, 0xd7, 0x25, 0xce, 0x68, 0xe5, 0x4b, 0x5d, 0x72, 0x33, 0xdb, 0x7e, 0x23,
+	0x41, 0x21, 0x61, 0xc2, 0x87, 0x96, 0x33, 0xe1, 0x8f, 0x1a, 0x8f, 0xca, 0x2b, 0xdb, 0x11, 0xe1,
+	0xdb, 0x17, 0x71, 0x83, 0x2b, 0x74, 0x3e, 0x5d, 0xa2, 0xc9, 0xbc, 0x2f, 0x12, 0x27, 0x65, 0xef,
+	0x4a, 0x30, 0x13, 0x3b, 0x32, 0x42, 0x17, 0x52, 0xe6, 0xa3, 0x48, 0xbb, 0x2e, 0x76, 0xc7, 0xec,
+	0x95, 0x06, 0x81, 0x29, 0x91, 0xb0, 0x34, 0x88, 0x1a, 0x66, 0xc9, 0x2f, 0xa5, 0x67, 0x60, 0x3a,
+	0xb7, 0x60, 0xbc, 0x63, 0x64, 0x83, 0x4e, 0x27, 0x82, 0x08, 0xe9, 0x5d, 0xcc, 0xc2, 0xe2, 0x69,
+	0xee, 0x98, 0xa1, 0x08, 0x35, 0x47, 0x8f, 0x7b, 0xe4, 0xc5, 0x2c, 0x2c, 0x4c, 0x73, 0x1b, 0xf6,
+	0x04, 0x47, 0x16, 0x48, 0xe4, 0xb7, 0xc8, 0xe9, 0x8b, 0x7c, 0x3a, 0x03, 0x87, 0x57, 0x88, 0x84,
+	0xda, 0x86, 0xc2, 0x42, 0x24, 0xae, 0x4b, 0x2a, 0x2f, 0x65, 0x63, 0x62, 0xfa, 0x7f, 0x20, 0xc1,
+	0x54, 0x74, 0x3b, 0x10, 0x9d, 0x15, 0xa3, 0x89, 0xef, 0x64, 0xca, 0xe7, 0xba, 0xe0, 0xf4, 0xfc,
+	0x11, 0x6a, 0xfd, 0x09, 0xfd, 0x11, 0xd7, 0xa6, 0x94, 0x97, 0xb2, 0x31, 0x31, 0xfd, 0xbf, 0x94,
+	0xe0, 0x90, 0xb0, 0x31, 0x86, 0x2e, 0x0b, 0xe5, 0x26, 0x37, 0x25, 0xe5, 0x2b, 0xdd, 0x0b, 0x60,
+	0x46, 0xfe, 0x42, 0x82, 0x83, 0xa2, 0x16, 0x18, 0x12, 0x1d, 0x75, 0x29, 0x3a, 0x79, 0xf2, 0xe5,
+	0xae, 0xf9, 0x7d, 0x16, 0x8a, 0x5a, 0x5d, 0x42, 0x0b, 0x53, 0x74, 0xec, 0xe4, 0xcb, 0x5d, 0xf3,
+	0x7b, 0x9f, 0x3f, 0x9d, 0x5d, 0x23, 0xe1, 0xe7, 0x4f, 0x4c, 0x33, 0x4e, 0x3e, 0x93, 0x89, 0xc7,
+	0xab, 0x8f, 0xfd, 0xdd, 0x12, 0x61, 0x7d, 0x1c, 0xd1, 0xd4, 0x91, 0xcb, 0xa9, 0xe9, 0x3d, 0xb4,
+	0x9d, 0xed, 0x06, 0x21, 0xda, 0x98, 0x1e, 0x8b, 0x7c, 0x26, 0x13, 0x8f, 0xa3, 0x5c, 0xee, 0xff,
+	0xe6, 0x27, 0x1f, 0x2c, 0x48, 0x2b, 0xf7, 0x9f, 0x3c, 0x9d, 0x95, 0x3e, 0x7c, 0x3a, 0x2b, 0xfd,
+	0xe3, 0xe9, 0xac, 0xf4, 0xf8, 0xd9, 0x6c, 0xcf, 0x87, 0xcf, 0x66, 0x7b, 0xfe, 0xfa, 0x6c, 0xb6,
+	0x07, 0x66, 0x34, 0x23, 0x46, 0xee, 0x4d, 0xe9, 0xcb, 0x4b, 0x75, 0xcd, 0xba, 0xd7, 0xbe, 0x5b,
+	0xaa, 0x19, 0xcd, 0xb2, 0x47, 0x74, 0x4a, 0x33, 0x7c, 0x4f, 0xe5, 0x2d, 0xef, 0x7f, 0x6a, 0x59,
+	0x0f, 0x5a, 0x98, 0xdc, 0x1d, 0xa0, 0xcd, 0xf3, 0x33, 0xff, 0x1b, 0x00, 0xcf, 0x41, 0x93, 0xc2,
+	0xf1, 0x36, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// WriteScope adds or updates a scope.
+	WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error)
+	// DeleteScope deletes a scope and all associated Records, Sessions.
+	DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error)
+	// AddScopeDataAccess adds data access AccAddress to scope
+	AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error)
+	// DeleteScopeDataAccess removes data access AccAddress from scope
+	DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error)
+	// AddScopeOwner adds new owner parties to a scope
+	AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error)
+	// DeleteScopeOwner removes owner parties (by addresses) from a scope
+	DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error)
+	// UpdateValueOwners sets the value owner of one or more scopes.
+	UpdateValueOwners(ctx context.Context, in *MsgUpdateValueOwnersRequest, opts ...grpc.CallOption) (*MsgUpdateValueOwnersResponse, error)
+	// MigrateValueOwner updates all scopes that have one value owner to have a another value owner.
+	MigrateValueOwner(ctx context.Context, in *MsgMigrateValueOwnerRequest, opts ...grpc.CallOption) (*MsgMigrateValueOwnerResponse, error)
+	// WriteSession adds or updates a session context.
+	WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error)
+	// WriteRecord adds or updates a record.
+	WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error)
+	// DeleteRecord deletes a record.
+	DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error)
+	// WriteScopeSpecification adds or updates a scope specification.
+	WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error)
+	// DeleteScopeSpecification deletes a scope specification.
+	DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error)
+	// WriteContractSpecification adds or updates a contract specification.
+	WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error)
+	// DeleteContractSpecification deletes a contract specification.
+	DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error)
+	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
+	AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error)
+	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
+	DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
+	// WriteRecordSpecification adds or updates a record specification.
+	WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error)
+	// DeleteRecordSpecification deletes a record specification.
+	DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error)
+	// BindOSLocator binds an owner address to a uri.
+	BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error)
+	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
+	DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error)
+	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
+	ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error)
+	// SetAccountData associates some basic data with a metadata address.
+	// Currently, only scope ids are supported.
+	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
+	// AddNetAssetValues sets the net asset value for a scope.
+	AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error)
+	// SetNetAssetValueMaxAge sets the number of blocks after which a scope's net asset values are stale.
+	SetNetAssetValueMaxAge(ctx context.Context, in *MsgSetNetAssetValueMaxAgeRequest, opts ...grpc.CallOption) (*MsgSetNetAssetValueMaxAgeResponse, error)
+	// PruneScopeHistory removes the oldest prior versions of a scope and of its sessions and records.
+	PruneScopeHistory(ctx context.Context, in *MsgPruneScopeHistoryRequest, opts ...grpc.CallOption) (*MsgPruneScopeHistoryResponse, error)
+	// ProposeScopeOwnershipTransfer proposes new owners and/or a new value owner for a scope.
+	// The change is only made once the new owners accept it.
+	ProposeScopeOwnershipTransfer(ctx context.Context, in *MsgProposeScopeOwnershipTransferRequest, opts ...grpc.CallOption) (*MsgProposeScopeOwnershipTransferResponse, error)
+	// AcceptScopeOwnershipTransfer accepts a pending scope ownership transfer, applying it to the scope.
+	AcceptScopeOwnershipTransfer(ctx context.Context, in *MsgAcceptScopeOwnershipTransferRequest, opts ...grpc.CallOption) (*MsgAcceptScopeOwnershipTransferResponse, error)
+	// CancelScopeOwnershipTransfer cancels (or declines) a pending scope ownership transfer.
+	CancelScopeOwnershipTransfer(ctx context.Context, in *MsgCancelScopeOwnershipTransferRequest, opts ...grpc.CallOption) (*MsgCancelScopeOwnershipTransferResponse, error)
+	// WriteScopeBundle adds or updates a scope along with some of its sessions and records in a single msg.
+	WriteScopeBundle(ctx context.Context, in *MsgWriteScopeBundleRequest, opts ...grpc.CallOption) (*MsgWriteScopeBundleResponse, error)
+	// ArchiveScope removes a scope along with all of its sessions, records, net asset values, and their history,
+	// in a single msg, emitting an event with everything that was removed and keeping a tombstone of it.
+	ArchiveScope(ctx context.Context, in *MsgArchiveScopeRequest, opts ...grpc.CallOption) (*MsgArchiveScopeResponse, error)
+	// MigrateScopeSpec changes the scope specification of one or more scopes to a newer version of it.
+	MigrateScopeSpec(ctx context.Context, in *MsgMigrateScopeSpecRequest, opts ...grpc.CallOption) (*MsgMigrateScopeSpecResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error) {
+	out := new(MsgWriteScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScope", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetAccountData(ctx, req.(*MsgSetAccountDataRequest))
+	return out, nil
+}
+
+func (c *msgClient) DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error) {
+	out := new(MsgDeleteScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScope", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_AddNetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddNetAssetValuesRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error) {
+	out := new(MsgAddScopeDataAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeDataAccess", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddNetAssetValues(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error) {
+	out := new(MsgDeleteScopeDataAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeDataAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/AddNetAssetValues",
+	return out, nil
+}
+
+func (c *msgClient) AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error) {
+	out := new(MsgAddScopeOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddNetAssetValues(ctx, req.(*MsgAddNetAssetValuesRequest))
+	return out, nil
+}
+
+func (c *msgClient) DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error) {
+	out := new(MsgDeleteScopeOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.metadata.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "WriteScope",
-			Handler:    _Msg_WriteScope_Handler,
-		},
-		{
-			MethodName: "DeleteScope",
-			Handler:    _Msg_DeleteScope_Handler,
-		},
-		{
-			MethodName: "AddScopeDataAccess",
-			Handler:    _Msg_AddScopeDataAccess_Handler,
-		},
-		{
-			MethodName: "DeleteScopeDataAccess",
-			Handler:    _Msg_DeleteScopeDataAccess_Handler,
-		},
-		{
-			MethodName: "AddScopeOwner",
-			Handler:    _Msg_AddScopeOwner_Handler,
-		},
-		{
-			MethodName: "DeleteScopeOwner",
-			Handler:    _Msg_DeleteScopeOwner_Handler,
-		},
-		{
-			MethodName: "UpdateValueOwners",
-			Handler:    _Msg_UpdateValueOwners_Handler,
-		},
-		{
-			MethodName: "MigrateValueOwner",
-			Handler:    _Msg_MigrateValueOwner_Handler,
-		},
-		{
-			MethodName: "WriteSession",
-			Handler:    _Msg_WriteSession_Handler,
-		},
-		{
-			MethodName: "WriteRecord",
-			Handler:    _Msg_WriteRecord_Handler,
-		},
-		{
-			MethodName: "DeleteRecord",
-			Handler:    _Msg_DeleteRecord_Handler,
-		},
-		{
-			MethodName: "WriteScopeSpecification",
-			Handler:    _Msg_WriteScopeSpecification_Handler,
-		},
-		{
-			MethodName: "DeleteScopeSpecification",
-			Handler:    _Msg_DeleteScopeSpecification_Handler,
-		},
-		{
-			MethodName: "WriteContractSpecification",
-			Handler:    _Msg_WriteContractSpecification_Handler,
-		},
-		{
-			MethodName: "DeleteContractSpecification",
-			Handler:    _Msg_DeleteContractSpecification_Handler,
-		},
-		{
-			MethodName: "AddContractSpecToScopeSpec",
-			Handler:    _Msg_AddContractSpecToScopeSpec_Handler,
-		},
-		{
-			MethodName: "DeleteContractSpecFromScopeSpec",
-			Handler:    _Msg_DeleteContractSpecFromScopeSpec_Handler,
-		},
-		{
-			MethodName: "WriteRecordSpecification",
-			Handler:    _Msg_WriteRecordSpecification_Handler,
-		},
-		{
-			MethodName: "DeleteRecordSpecification",
-			Handler:    _Msg_DeleteRecordSpecification_Handler,
-		},
-		{
-			MethodName: "BindOSLocator",
-			Handler:    _Msg_BindOSLocator_Handler,
-		},
-		{
-			MethodName: "DeleteOSLocator",
-			Handler:    _Msg_DeleteOSLocator_Handler,
-		},
-		{
-			MethodName: "ModifyOSLocator",
-			Handler:    _Msg_ModifyOSLocator_Handler,
-		},
-		{
-			MethodName: "SetAccountData",
-			Handler:    _Msg_SetAccountData_Handler,
-		},
-		{
-			MethodName: "AddNetAssetValues",
-			Handler:    _Msg_AddNetAssetValues_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/metadata/v1/tx.proto",
+func (c *msgClient) UpdateValueOwners(ctx context.Context, in *MsgUpdateValueOwnersRequest, opts ...grpc.CallOption) (*MsgUpdateValueOwnersResponse, error) {
+	out := new(MsgUpdateValueOwnersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/UpdateValueOwners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWriteScopeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MigrateValueOwner(ctx context.Context, in *MsgMigrateValueOwnerRequest, opts ...grpc.CallOption) (*MsgMigrateValueOwnerResponse, error) {
+	out := new(MsgMigrateValueOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/MigrateValueOwner", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWriteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error) {
+	out := new(MsgWriteSessionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWriteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UsdMills != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ScopeUuid) > 0 {
-		i -= len(m.ScopeUuid)
-		copy(dAtA[i:], m.ScopeUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error) {
+	out := new(MsgWriteRecordResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgWriteScopeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error) {
+	out := new(MsgDeleteRecordResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecord", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWriteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error) {
+	out := new(MsgWriteScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopeSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWriteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScopeIdInfo != nil {
-		{
-			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error) {
+	out := new(MsgDeleteScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error) {
+	out := new(MsgWriteContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteContractSpecification", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error) {
+	out := new(MsgDeleteContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error) {
+	out := new(MsgAddContractSpecToScopeSpecResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
+	out := new(MsgDeleteContractSpecFromScopeSpecResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error) {
+	out := new(MsgWriteRecordSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecordSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error) {
+	out := new(MsgDeleteRecordSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecordSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error) {
+	out := new(MsgBindOSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/BindOSLocator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error) {
+	out := new(MsgDeleteOSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteOSLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.DataAccess) > 0 {
-		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DataAccess[iNdEx])
-			copy(dAtA[i:], m.DataAccess[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error) {
+	out := new(MsgModifyOSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ModifyOSLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error) {
+	out := new(MsgSetAccountDataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/SetAccountData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error) {
+	out := new(MsgAddNetAssetValuesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddNetAssetValues", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.DataAccess) > 0 {
-		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DataAccess[iNdEx])
-			copy(dAtA[i:], m.DataAccess[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) SetNetAssetValueMaxAge(ctx context.Context, in *MsgSetNetAssetValueMaxAgeRequest, opts ...grpc.CallOption) (*MsgSetNetAssetValueMaxAgeResponse, error) {
+	out := new(MsgSetNetAssetValueMaxAgeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/SetNetAssetValueMaxAge", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PruneScopeHistory(ctx context.Context, in *MsgPruneScopeHistoryRequest, opts ...grpc.CallOption) (*MsgPruneScopeHistoryResponse, error) {
+	out := new(MsgPruneScopeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/PruneScopeHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ProposeScopeOwnershipTransfer(ctx context.Context, in *MsgProposeScopeOwnershipTransferRequest, opts ...grpc.CallOption) (*MsgProposeScopeOwnershipTransferResponse, error) {
+	out := new(MsgProposeScopeOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ProposeScopeOwnershipTransfer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgAddScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Owners) > 0 {
-		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) AcceptScopeOwnershipTransfer(ctx context.Context, in *MsgAcceptScopeOwnershipTransferRequest, opts ...grpc.CallOption) (*MsgAcceptScopeOwnershipTransferResponse, error) {
+	out := new(MsgAcceptScopeOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AcceptScopeOwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelScopeOwnershipTransfer(ctx context.Context, in *MsgCancelScopeOwnershipTransferRequest, opts ...grpc.CallOption) (*MsgCancelScopeOwnershipTransferResponse, error) {
+	out := new(MsgCancelScopeOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/CancelScopeOwnershipTransfer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WriteScopeBundle(ctx context.Context, in *MsgWriteScopeBundleRequest, opts ...grpc.CallOption) (*MsgWriteScopeBundleResponse, error) {
+	out := new(MsgWriteScopeBundleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopeBundle", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgDeleteScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Owners) > 0 {
-		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Owners[iNdEx])
-			copy(dAtA[i:], m.Owners[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Owners[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) ArchiveScope(ctx context.Context, in *MsgArchiveScopeRequest, opts ...grpc.CallOption) (*MsgArchiveScopeResponse, error) {
+	out := new(MsgArchiveScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ArchiveScope", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MigrateScopeSpec(ctx context.Context, in *MsgMigrateScopeSpecRequest, opts ...grpc.CallOption) (*MsgMigrateScopeSpecResponse, error) {
+	out := new(MsgMigrateScopeSpecResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/MigrateScopeSpec", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// WriteScope adds or updates a scope.
+	WriteScope(context.Context, *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error)
+	// DeleteScope deletes a scope and all associated Records, Sessions.
+	DeleteScope(context.Context, *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error)
+	// AddScopeDataAccess adds data access AccAddress to scope
+	AddScopeDataAccess(context.Context, *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error)
+	// DeleteScopeDataAccess removes data access AccAddress from scope
+	DeleteScopeDataAccess(context.Context, *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error)
+	// AddScopeOwner adds new owner parties to a scope
+	AddScopeOwner(context.Context, *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error)
+	// DeleteScopeOwner removes owner parties (by addresses) from a scope
+	DeleteScopeOwner(context.Context, *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error)
+	// UpdateValueOwners sets the value owner of one or more scopes.
+	UpdateValueOwners(context.Context, *MsgUpdateValueOwnersRequest) (*MsgUpdateValueOwnersResponse, error)
+	// MigrateValueOwner updates all scopes that have one value owner to have a another value owner.
+	MigrateValueOwner(context.Context, *MsgMigrateValueOwnerRequest) (*MsgMigrateValueOwnerResponse, error)
+	// WriteSession adds or updates a session context.
+	WriteSession(context.Context, *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error)
+	// WriteRecord adds or updates a record.
+	WriteRecord(context.Context, *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error)
+	// DeleteRecord deletes a record.
+	DeleteRecord(context.Context, *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error)
+	// WriteScopeSpecification adds or updates a scope specification.
+	WriteScopeSpecification(context.Context, *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error)
+	// DeleteScopeSpecification deletes a scope specification.
+	DeleteScopeSpecification(context.Context, *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error)
+	// WriteContractSpecification adds or updates a contract specification.
+	WriteContractSpecification(context.Context, *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error)
+	// DeleteContractSpecification deletes a contract specification.
+	DeleteContractSpecification(context.Context, *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error)
+	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
+	AddContractSpecToScopeSpec(context.Context, *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error)
+	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
+	DeleteContractSpecFromScopeSpec(context.Context, *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
+	// WriteRecordSpecification adds or updates a record specification.
+	WriteRecordSpecification(context.Context, *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error)
+	// DeleteRecordSpecification deletes a record specification.
+	DeleteRecordSpecification(context.Context, *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error)
+	// BindOSLocator binds an owner address to a uri.
+	BindOSLocator(context.Context, *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error)
+	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
+	DeleteOSLocator(context.Context, *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error)
+	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
+	ModifyOSLocator(context.Context, *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error)
+	// SetAccountData associates some basic data with a metadata address.
+	// Currently, only scope ids are supported.
+	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
+	// AddNetAssetValues sets the net asset value for a scope.
+	AddNetAssetValues(context.Context, *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error)
+	// SetNetAssetValueMaxAge sets the number of blocks after which a scope's net asset values are stale.
+	SetNetAssetValueMaxAge(context.Context, *MsgSetNetAssetValueMaxAgeRequest) (*MsgSetNetAssetValueMaxAgeResponse, error)
+	// PruneScopeHistory removes the oldest prior versions of a scope and of its sessions and records.
+	PruneScopeHistory(context.Context, *MsgPruneScopeHistoryRequest) (*MsgPruneScopeHistoryResponse, error)
+	// ProposeScopeOwnershipTransfer proposes new owners and/or a new value owner for a scope.
+	// The change is only made once the new owners accept it.
+	ProposeScopeOwnershipTransfer(context.Context, *MsgProposeScopeOwnershipTransferRequest) (*MsgProposeScopeOwnershipTransferResponse, error)
+	// AcceptScopeOwnershipTransfer accepts a pending scope ownership transfer, applying it to the scope.
+	AcceptScopeOwnershipTransfer(context.Context, *MsgAcceptScopeOwnershipTransferRequest) (*MsgAcceptScopeOwnershipTransferResponse, error)
+	// CancelScopeOwnershipTransfer cancels (or declines) a pending scope ownership transfer.
+	CancelScopeOwnershipTransfer(context.Context, *MsgCancelScopeOwnershipTransferRequest) (*MsgCancelScopeOwnershipTransferResponse, error)
+	// WriteScopeBundle adds or updates a scope along with some of its sessions and records in a single msg.
+	WriteScopeBundle(context.Context, *MsgWriteScopeBundleRequest) (*MsgWriteScopeBundleResponse, error)
+	// ArchiveScope removes a scope along with all of its sessions, records, net asset values, and their history,
+	// in a single msg, emitting an event with everything that was removed and keeping a tombstone of it.
+	ArchiveScope(context.Context, *MsgArchiveScopeRequest) (*MsgArchiveScopeResponse, error)
+	// MigrateScopeSpec changes the scope specification of one or more scopes to a newer version of it.
+	MigrateScopeSpec(context.Context, *MsgMigrateScopeSpecRequest) (*MsgMigrateScopeSpecResponse, error)
 }
 
-func (m *MsgDeleteScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateValueOwnersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) WriteScope(ctx context.Context, req *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteScope not implemented")
+}
+func (*UnimplementedMsgServer) DeleteScope(ctx context.Context, req *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScope not implemented")
+}
+func (*UnimplementedMsgServer) AddScopeDataAccess(ctx context.Context, req *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScopeDataAccess not implemented")
+}
+func (*UnimplementedMsgServer) DeleteScopeDataAccess(ctx context.Context, req *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeDataAccess not implemented")
+}
+func (*UnimplementedMsgServer) AddScopeOwner(ctx context.Context, req *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScopeOwner not implemented")
+}
+func (*UnimplementedMsgServer) DeleteScopeOwner(ctx context.Context, req *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeOwner not implemented")
+}
+func (*UnimplementedMsgServer) UpdateValueOwners(ctx context.Context, req *MsgUpdateValueOwnersRequest) (*MsgUpdateValueOwnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValueOwners not implemented")
+}
+func (*UnimplementedMsgServer) MigrateValueOwner(ctx context.Context, req *MsgMigrateValueOwnerRequest) (*MsgMigrateValueOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateValueOwner not implemented")
+}
+func (*UnimplementedMsgServer) WriteSession(ctx context.Context, req *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteSession not implemented")
+}
+func (*UnimplementedMsgServer) WriteRecord(ctx context.Context, req *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteRecord not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRecord(ctx context.Context, req *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
+}
+func (*UnimplementedMsgServer) WriteScopeSpecification(ctx context.Context, req *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteScopeSpecification not implemented")
+}
+func (*UnimplementedMsgServer) DeleteScopeSpecification(ctx context.Context, req *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeSpecification not implemented")
+}
+func (*UnimplementedMsgServer) WriteContractSpecification(ctx context.Context, req *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteContractSpecification not implemented")
+}
+func (*UnimplementedMsgServer) DeleteContractSpecification(ctx context.Context, req *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecification not implemented")
+}
+func (*UnimplementedMsgServer) AddContractSpecToScopeSpec(ctx context.Context, req *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddContractSpecToScopeSpec not implemented")
+}
+func (*UnimplementedMsgServer) DeleteContractSpecFromScopeSpec(ctx context.Context, req *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecFromScopeSpec not implemented")
+}
+func (*UnimplementedMsgServer) WriteRecordSpecification(ctx context.Context, req *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteRecordSpecification not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRecordSpecification(ctx context.Context, req *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecordSpecification not implemented")
+}
+func (*UnimplementedMsgServer) BindOSLocator(ctx context.Context, req *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindOSLocator not implemented")
+}
+func (*UnimplementedMsgServer) DeleteOSLocator(ctx context.Context, req *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOSLocator not implemented")
+}
+func (*UnimplementedMsgServer) ModifyOSLocator(ctx context.Context, req *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyOSLocator not implemented")
+}
+func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountData not implemented")
+}
+func (*UnimplementedMsgServer) AddNetAssetValues(ctx context.Context, req *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNetAssetValues not implemented")
+}
+func (*UnimplementedMsgServer) SetNetAssetValueMaxAge(ctx context.Context, req *MsgSetNetAssetValueMaxAgeRequest) (*MsgSetNetAssetValueMaxAgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNetAssetValueMaxAge not implemented")
+}
+func (*UnimplementedMsgServer) PruneScopeHistory(ctx context.Context, req *MsgPruneScopeHistoryRequest) (*MsgPruneScopeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneScopeHistory not implemented")
+}
+func (*UnimplementedMsgServer) ProposeScopeOwnershipTransfer(ctx context.Context, req *MsgProposeScopeOwnershipTransferRequest) (*MsgProposeScopeOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeScopeOwnershipTransfer not implemented")
+}
+func (*UnimplementedMsgServer) AcceptScopeOwnershipTransfer(ctx context.Context, req *MsgAcceptScopeOwnershipTransferRequest) (*MsgAcceptScopeOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptScopeOwnershipTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CancelScopeOwnershipTransfer(ctx context.Context, req *MsgCancelScopeOwnershipTransferRequest) (*MsgCancelScopeOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScopeOwnershipTransfer not implemented")
+}
+func (*UnimplementedMsgServer) WriteScopeBundle(ctx context.Context, req *MsgWriteScopeBundleRequest) (*MsgWriteScopeBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteScopeBundle not implemented")
+}
+func (*UnimplementedMsgServer) ArchiveScope(ctx context.Context, req *MsgArchiveScopeRequest) (*MsgArchiveScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveScope not implemented")
+}
+func (*UnimplementedMsgServer) MigrateScopeSpec(ctx context.Context, req *MsgMigrateScopeSpecRequest) (*MsgMigrateScopeSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateScopeSpec not implemented")
 }
 
-func (m *MsgUpdateValueOwnersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgUpdateValueOwnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_WriteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ValueOwnerAddress) > 0 {
-		i -= len(m.ValueOwnerAddress)
-		copy(dAtA[i:], m.ValueOwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValueOwnerAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).WriteScope(ctx, in)
 	}
-	if len(m.ScopeIds) > 0 {
-		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.ScopeIds[iNdEx].Size()
-				i -= size
-				if _, err := m.ScopeIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteScope",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteScope(ctx, req.(*MsgWriteScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateValueOwnersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateValueOwnersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateValueOwnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScope(ctx, req.(*MsgDeleteScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMigrateValueOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddScopeDataAccessRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMigrateValueOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).AddScopeDataAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddScopeDataAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddScopeDataAccess(ctx, req.(*MsgAddScopeDataAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMigrateValueOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_DeleteScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeDataAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Proposed) > 0 {
-		i -= len(m.Proposed)
-		copy(dAtA[i:], m.Proposed)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposed)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScopeDataAccess(ctx, in)
 	}
-	if len(m.Existing) > 0 {
-		i -= len(m.Existing)
-		copy(dAtA[i:], m.Existing)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Existing)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeDataAccess",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScopeDataAccess(ctx, req.(*MsgDeleteScopeDataAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMigrateValueOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddScopeOwnerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMigrateValueOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMigrateValueOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddScopeOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddScopeOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddScopeOwner(ctx, req.(*MsgAddScopeOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteSessionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeOwnerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScopeOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScopeOwner(ctx, req.(*MsgDeleteScopeOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteSessionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_UpdateValueOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateValueOwnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateValueOwners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/UpdateValueOwners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateValueOwners(ctx, req.(*MsgUpdateValueOwnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_MigrateValueOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateValueOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SessionIdComponents != nil {
-		{
-			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateValueOwner(ctx, in)
 	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/MigrateValueOwner",
 	}
-	{
-		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateValueOwner(ctx, req.(*MsgMigrateValueOwnerRequest))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WriteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteSessionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).WriteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteSession(ctx, req.(*MsgWriteSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_WriteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteRecord(ctx, req.(*MsgWriteRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SessionUuid) > 0 {
-		i -= len(m.SessionUuid)
-		copy(dAtA[i:], m.SessionUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionUuid)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ScopeIdentifier != nil {
-		{
-			size := m.ScopeIdentifier.Size()
-			i -= size
-			if _, err := m.ScopeIdentifier.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRecord(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRecord(ctx, req.(*MsgDeleteRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents_ScopeUuid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_WriteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteScopeSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteScopeSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteScopeSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteScopeSpecification(ctx, req.(*MsgWriteScopeSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents_ScopeUuid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.ScopeUuid)
-	copy(dAtA[i:], m.ScopeUuid)
-	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-func (m *SessionIdComponents_ScopeAddr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DeleteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScopeSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScopeSpecification(ctx, req.(*MsgDeleteScopeSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents_ScopeAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.ScopeAddr)
-	copy(dAtA[i:], m.ScopeAddr)
-	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeAddr)))
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
-}
-func (m *MsgWriteSessionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WriteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteContractSpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).WriteContractSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteContractSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteContractSpecification(ctx, req.(*MsgWriteContractSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteSessionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DeleteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteContractSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteContractSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteContractSpecification(ctx, req.(*MsgDeleteContractSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SessionIdInfo != nil {
-		{
-			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Msg_AddContractSpecToScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddContractSpecToScopeSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, req.(*MsgAddContractSpecToScopeSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteContractSpecFromScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteContractSpecFromScopeSpecRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, req.(*MsgDeleteContractSpecFromScopeSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_WriteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteRecordSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteRecordSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteRecordSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteRecordSpecification(ctx, req.(*MsgWriteRecordSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Parties) > 0 {
-		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.ContractSpecUuid) > 0 {
-		i -= len(m.ContractSpecUuid)
-		copy(dAtA[i:], m.ContractSpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_DeleteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRecordSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SessionIdComponents != nil {
-		{
-			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRecordSpecification(ctx, in)
 	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecordSpecification",
 	}
-	{
-		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRecordSpecification(ctx, req.(*MsgDeleteRecordSpecificationRequest))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BindOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBindOSLocatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).BindOSLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/BindOSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BindOSLocator(ctx, req.(*MsgBindOSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DeleteOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteOSLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteOSLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteOSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteOSLocator(ctx, req.(*MsgDeleteOSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RecordIdInfo != nil {
-		{
-			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Msg_ModifyOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyOSLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyOSLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/ModifyOSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyOSLocator(ctx, req.(*MsgModifyOSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetAccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAccountDataRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAccountData(ctx, in)
 	}
-	{
-		size := m.RecordId.Size()
-		i -= size
-		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/SetAccountData",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAccountData(ctx, req.(*MsgSetAccountDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddNetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddNetAssetValuesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).AddNetAssetValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddNetAssetValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddNetAssetValues(ctx, req.(*MsgAddNetAssetValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SetNetAssetValueMaxAge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNetAssetValueMaxAgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNetAssetValueMaxAge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/SetNetAssetValueMaxAge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNetAssetValueMaxAge(ctx, req.(*MsgSetNetAssetValueMaxAgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_PruneScopeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneScopeHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).PruneScopeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/PruneScopeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneScopeHistory(ctx, req.(*MsgPruneScopeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ProposeScopeOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeScopeOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeScopeOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/ProposeScopeOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeScopeOwnershipTransfer(ctx, req.(*MsgProposeScopeOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_AcceptScopeOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptScopeOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptScopeOwnershipTransfer(ctx, in)
 	}
-	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AcceptScopeOwnershipTransfer",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptScopeOwnershipTransfer(ctx, req.(*MsgAcceptScopeOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelScopeOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScopeOwnershipTransferRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScopeOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/CancelScopeOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScopeOwnershipTransfer(ctx, req.(*MsgCancelScopeOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_WriteScopeBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteScopeBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteScopeBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteScopeBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteScopeBundle(ctx, req.(*MsgWriteScopeBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		{
-			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Msg_ArchiveScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgArchiveScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).ArchiveScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/ArchiveScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ArchiveScope(ctx, req.(*MsgArchiveScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MigrateScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateScopeSpecRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateScopeSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/MigrateScopeSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateScopeSpec(ctx, req.(*MsgMigrateScopeSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.metadata.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WriteScope",
+			Handler:    _Msg_WriteScope_Handler,
+		},
+		{
+			MethodName: "DeleteScope",
+			Handler:    _Msg_DeleteScope_Handler,
+		},
+		{
+			MethodName: "AddScopeDataAccess",
+			Handler:    _Msg_AddScopeDataAccess_Handler,
+		},
+		{
+			MethodName: "DeleteScopeDataAccess",
+			Handler:    _Msg_DeleteScopeDataAccess_Handler,
+		},
+		{
+			MethodName: "AddScopeOwner",
+			Handler:    _Msg_AddScopeOwner_Handler,
+		},
+		{
+			MethodName: "DeleteScopeOwner",
+			Handler:    _Msg_DeleteScopeOwner_Handler,
+		},
+		{
+			MethodName: "UpdateValueOwners",
+			Handler:    _Msg_UpdateValueOwners_Handler,
+		},
+		{
+			MethodName: "MigrateValueOwner",
+			Handler:    _Msg_MigrateValueOwner_Handler,
+		},
+		{
+			MethodName: "WriteSession",
+			Handler:    _Msg_WriteSession_Handler,
+		},
+		{
+			MethodName: "WriteRecord",
+			Handler:    _Msg_WriteRecord_Handler,
+		},
+		{
+			MethodName: "DeleteRecord",
+			Handler:    _Msg_DeleteRecord_Handler,
+		},
+		{
+			MethodName: "WriteScopeSpecification",
+			Handler:    _Msg_WriteScopeSpecification_Handler,
+		},
+		{
+			MethodName: "DeleteScopeSpecification",
+			Handler:    _Msg_DeleteScopeSpecification_Handler,
+		},
+		{
+			MethodName: "WriteContractSpecification",
+			Handler:    _Msg_WriteContractSpecification_Handler,
+		},
+		{
+			MethodName: "DeleteContractSpecification",
+			Handler:    _Msg_DeleteContractSpecification_Handler,
+		},
+		{
+			MethodName: "AddContractSpecToScopeSpec",
+			Handler:    _Msg_AddContractSpecToScopeSpec_Handler,
+		},
+		{
+			MethodName: "DeleteContractSpecFromScopeSpec",
+			Handler:    _Msg_DeleteContractSpecFromScopeSpec_Handler,
+		},
+		{
+			MethodName: "WriteRecordSpecification",
+			Handler:    _Msg_WriteRecordSpecification_Handler,
+		},
+		{
+			MethodName: "DeleteRecordSpecification",
+			Handler:    _Msg_DeleteRecordSpecification_Handler,
+		},
+		{
+			MethodName: "BindOSLocator",
+			Handler:    _Msg_BindOSLocator_Handler,
+		},
+		{
+			MethodName: "DeleteOSLocator",
+			Handler:    _Msg_DeleteOSLocator_Handler,
+		},
+		{
+			MethodName: "ModifyOSLocator",
+			Handler:    _Msg_ModifyOSLocator_Handler,
+		},
+		{
+			MethodName: "SetAccountData",
+			Handler:    _Msg_SetAccountData_Handler,
+		},
+		{
+			MethodName: "AddNetAssetValues",
+			Handler:    _Msg_AddNetAssetValues_Handler,
+		},
+		{
+			MethodName: "SetNetAssetValueMaxAge",
+			Handler:    _Msg_SetNetAssetValueMaxAge_Handler,
+		},
+		{
+			MethodName: "PruneScopeHistory",
+			Handler:    _Msg_PruneScopeHistory_Handler,
+		},
+		{
+			MethodName: "ProposeScopeOwnershipTransfer",
+			Handler:    _Msg_ProposeScopeOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptScopeOwnershipTransfer",
+			Handler:    _Msg_AcceptScopeOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "CancelScopeOwnershipTransfer",
+			Handler:    _Msg_CancelScopeOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "WriteScopeBundle",
+			Handler:    _Msg_WriteScopeBundle_Handler,
+		},
+		{
+			MethodName: "ArchiveScope",
+			Handler:    _Msg_ArchiveScope_Handler,
+		},
+		{
+			MethodName: "MigrateScopeSpec",
+			Handler:    _Msg_MigrateScopeSpec_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/metadata/v1/tx.proto",
+}
+
+func (m *MsgWriteScopeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.UsdMills != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ScopeUuid) > 0 {
+		i -= len(m.ScopeUuid)
+		copy(dAtA[i:], m.ScopeUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
@@ -4548,11 +4590,11 @@ func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (
 		}
 	}
 	{
-		size := m.SpecificationId.Size()
-		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4560,7 +4602,7 @@ func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4570,20 +4612,32 @@ func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ScopeIdInfo != nil {
+		{
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4593,23 +4647,16 @@ func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
@@ -4620,11 +4667,11 @@ func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 		}
 	}
 	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4632,7 +4679,7 @@ func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4642,32 +4689,20 @@ func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
-		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4677,16 +4712,26 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
@@ -4696,20 +4741,19 @@ func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte)
 			dAtA[i] = 0x1a
 		}
 	}
-	{
-		size := m.ScopeSpecificationId.Size()
-		i -= size
-		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.DataAccess) > 0 {
+		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccess[iNdEx])
+			copy(dAtA[i:], m.DataAccess[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	{
-		size := m.ContractSpecificationId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4719,7 +4763,7 @@ func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4729,12 +4773,12 @@ func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4742,7 +4786,7 @@ func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4752,12 +4796,12 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4771,20 +4815,19 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []
 			dAtA[i] = 0x1a
 		}
 	}
-	{
-		size := m.ScopeSpecificationId.Size()
-		i -= size
-		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.DataAccess) > 0 {
+		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccess[iNdEx])
+			copy(dAtA[i:], m.DataAccess[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	{
-		size := m.ContractSpecificationId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4794,7 +4837,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4804,12 +4847,12 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4817,7 +4860,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4827,12 +4870,12 @@ func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4843,13 +4886,27 @@ func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0x12
 		}
 	}
 	{
-		size := m.SpecificationId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4859,7 +4916,7 @@ func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4869,12 +4926,12 @@ func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4882,7 +4939,7 @@ func (m *MsgDeleteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4892,38 +4949,40 @@ func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecUuid) > 0 {
-		i -= len(m.ContractSpecUuid)
-		copy(dAtA[i:], m.ContractSpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
 			dAtA[i] = 0x12
 		}
 	}
 	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4931,7 +4990,7 @@ func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4941,32 +5000,20 @@ func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RecordSpecIdInfo != nil {
-		{
-			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateValueOwnersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4976,12 +5023,12 @@ func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateValueOwnersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateValueOwnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4992,23 +5039,34 @@ func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
-	{
-		size := m.SpecificationId.Size()
-		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.ValueOwnerAddress) > 0 {
+		i -= len(m.ValueOwnerAddress)
+		copy(dAtA[i:], m.ValueOwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValueOwnerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeIds) > 0 {
+		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ScopeIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ScopeIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateValueOwnersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5018,12 +5076,12 @@ func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateValueOwnersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateValueOwnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5031,7 +5089,7 @@ func (m *MsgDeleteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgMigrateValueOwnerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5041,30 +5099,43 @@ func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBindOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMigrateValueOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBindOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMigrateValueOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
+	if len(m.Proposed) > 0 {
+		i -= len(m.Proposed)
+		copy(dAtA[i:], m.Proposed)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposed)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Existing) > 0 {
+		i -= len(m.Existing)
+		copy(dAtA[i:], m.Existing)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Existing)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgMigrateValueOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5074,30 +5145,20 @@ func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBindOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMigrateValueOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBindOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMigrateValueOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteSessionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5107,18 +5168,46 @@ func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SessionIdComponents != nil {
+		{
+			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5130,7 +5219,7 @@ func (m *MsgDeleteOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionIdComponents) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5140,30 +5229,64 @@ func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionIdComponents) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionIdComponents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.SessionUuid) > 0 {
+		i -= len(m.SessionUuid)
+		copy(dAtA[i:], m.SessionUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ScopeIdentifier != nil {
+		{
+			size := m.ScopeIdentifier.Size()
+			i -= size
+			if _, err := m.ScopeIdentifier.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionIdComponents_ScopeUuid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionIdComponents_ScopeUuid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ScopeUuid)
+	copy(dAtA[i:], m.ScopeUuid)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
+func (m *SessionIdComponents_ScopeAddr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *SessionIdComponents_ScopeAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ScopeAddr)
+	copy(dAtA[i:], m.ScopeAddr)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeAddr)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *MsgWriteSessionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5173,30 +5296,32 @@ func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgModifyOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgModifyOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.SessionIdInfo != nil {
+		{
+			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteRecordRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5206,18 +5331,60 @@ func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgModifyOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgModifyOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Parties) > 0 {
+		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContractSpecUuid) > 0 {
+		i -= len(m.ContractSpecUuid)
+		copy(dAtA[i:], m.ContractSpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SessionIdComponents != nil {
+		{
+			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5229,7 +5396,7 @@ func (m *MsgModifyOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteRecordResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5239,12 +5406,47 @@ func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordIdInfo != nil {
+		{
+			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5255,20 +5457,13 @@ func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
 	{
-		size := m.MetadataAddr.Size()
+		size := m.RecordId.Size()
 		i -= size
-		if _, err := m.MetadataAddr.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -5278,7 +5473,7 @@ func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteRecordResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5288,12 +5483,12 @@ func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5301,7 +5496,7 @@ func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5311,16 +5506,23 @@ func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteP8EContractSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
@@ -5331,7 +5533,7 @@ func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		}
 	}
 	{
-		size, err := m.Contractspec.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5343,7 +5545,7 @@ func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5353,33 +5555,19 @@ func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteP8EContractSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteP8EContractSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecordSpecIdInfos) > 0 {
-		for iNdEx := len(m.RecordSpecIdInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.ContractSpecIdInfo != nil {
+	if m.ScopeSpecIdInfo != nil {
 		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5392,7 +5580,7 @@ func (m *MsgWriteP8EContractSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5402,84 +5590,62 @@ func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgP8EMemorializeContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Invoker) > 0 {
-		i -= len(m.Invoker)
-		copy(dAtA[i:], m.Invoker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Invoker)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Signatures != nil {
-		{
-			size, err := m.Signatures.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x32
 	}
-	if m.Contract != nil {
-		{
-			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.Recitals != nil {
-		{
-			size, err := m.Recitals.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ScopeSpecificationId) > 0 {
-		i -= len(m.ScopeSpecificationId)
-		copy(dAtA[i:], m.ScopeSpecificationId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeSpecificationId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.GroupId) > 0 {
-		i -= len(m.GroupId)
-		copy(dAtA[i:], m.GroupId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5489,45 +5655,68 @@ func (m *MsgP8EMemorializeContractResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgP8EMemorializeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecordIdInfos) > 0 {
-		for iNdEx := len(m.RecordIdInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	if m.SessionIdInfo != nil {
-		{
-			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	{
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.ScopeIdInfo != nil {
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
 		{
-			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5540,7 +5729,7 @@ func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5550,50 +5739,49 @@ func (m *MsgAddNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddContractSpecToScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.ScopeSpecificationId.Size()
+		i -= size
+		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ContractSpecificationId.Size()
+		i -= size
+		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5603,12 +5791,12 @@ func (m *MsgAddNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddContractSpecToScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5616,891 +5804,4541 @@ func (m *MsgAddNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgWriteScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Scope.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.ScopeUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ScopeSpecificationId.Size()
+		i -= size
+		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.UsdMills != 0 {
-		n += 1 + sovTx(uint64(m.UsdMills))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ContractSpecificationId.Size()
+		i -= size
+		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ScopeIdInfo != nil {
-		l = m.ScopeIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddScopeDataAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.DataAccess) > 0 {
-		for _, s := range m.DataAccess {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddScopeDataAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.DataAccess) > 0 {
-		for _, s := range m.DataAccess {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.ContractSpecUuid) > 0 {
+		i -= len(m.ContractSpecUuid)
+		copy(dAtA[i:], m.ContractSpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeDataAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddScopeOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, e := range m.Owners {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.RecordSpecIdInfo != nil {
+		{
+			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddScopeOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, s := range m.Owners {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateValueOwnersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeIds) > 0 {
-		for _, e := range m.ScopeIds {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.ValueOwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateValueOwnersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMigrateValueOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBindOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Existing)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Proposed)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateValueOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteSessionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Session.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.SessionIdComponents != nil {
-		l = m.SessionIdComponents.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionIdComponents) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ScopeIdentifier != nil {
-		n += m.ScopeIdentifier.Size()
-	}
-	l = len(m.SessionUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionIdComponents_ScopeUuid) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ScopeUuid)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
-func (m *SessionIdComponents_ScopeAddr) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeAddr)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *MsgModifyOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgWriteSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgModifyOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SessionIdInfo != nil {
-		l = m.SessionIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.SessionIdComponents != nil {
-		l = m.SessionIdComponents.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ContractSpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Parties) > 0 {
-		for _, e := range m.Parties {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RecordIdInfo != nil {
-		l = m.RecordIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgModifyOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgModifyOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.RecordId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteScopeSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Specification.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	{
+		size := m.MetadataAddr.Size()
+		i -= size
+		if _, err := m.MetadataAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		l = m.ScopeSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgSetAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteP8EContractSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Specification.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Contractspec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ContractSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ScopeSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgWriteP8EContractSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteP8EContractSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.RecordSpecIdInfos) > 0 {
+		for iNdEx := len(m.RecordSpecIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	var l int
-	_ = l
-	l = m.ContractSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ScopeSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgP8EMemorializeContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgP8EMemorializeContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *MsgWriteRecordSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Invoker) > 0 {
+		i -= len(m.Invoker)
+		copy(dAtA[i:], m.Invoker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Invoker)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	l = m.Specification.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.Signatures != nil {
+		{
+			size, err := m.Signatures.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.ContractSpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Contract != nil {
+		{
+			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *MsgWriteRecordSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Recitals != nil {
+		{
+			size, err := m.Recitals.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.RecordSpecIdInfo != nil {
-		l = m.RecordSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ScopeSpecificationId) > 0 {
+		i -= len(m.ScopeSpecificationId)
+		copy(dAtA[i:], m.ScopeSpecificationId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeSpecificationId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgP8EMemorializeContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgP8EMemorializeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.RecordIdInfos) > 0 {
+		for iNdEx := len(m.RecordIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if m.SessionIdInfo != nil {
+		{
+			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScopeIdInfo != nil {
+		{
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBindOSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgAddNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBindOSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteOSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgAddNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgModifyOSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgModifyOSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetNetAssetValueMaxAgeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetAccountDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetNetAssetValueMaxAgeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNetAssetValueMaxAgeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.MetadataAddr.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.MaxAge != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxAge))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetAccountDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetNetAssetValueMaxAgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNetAssetValueMaxAgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNetAssetValueMaxAgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPruneScopeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneScopeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneScopeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Contractspec.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if m.Keep != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Keep))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPruneScopeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneScopeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneScopeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.RecordSpecIdInfos) > 0 {
-		for _, e := range m.RecordSpecIdInfos {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposeScopeOwnershipTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeScopeOwnershipTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeScopeOwnershipTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.ScopeSpecificationId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err29 != nil {
+		return 0, err29
 	}
-	if m.Recitals != nil {
-		l = m.Recitals.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i -= n29
+	i = encodeVarintTx(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValueOwnerAddress) > 0 {
+		i -= len(m.ValueOwnerAddress)
+		copy(dAtA[i:], m.ValueOwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValueOwnerAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Contract != nil {
-		l = m.Contract.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Signatures != nil {
-		l = m.Signatures.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Invoker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposeScopeOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeScopeOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeScopeOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ScopeIdInfo != nil {
-		l = m.ScopeIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptScopeOwnershipTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.SessionIdInfo != nil {
-		l = m.SessionIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptScopeOwnershipTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptScopeOwnershipTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.RecordIdInfos) > 0 {
-		for _, e := range m.RecordIdInfos {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddNetAssetValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAcceptScopeOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptScopeOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptScopeOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScopeOwnershipTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScopeOwnershipTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScopeOwnershipTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.NetAssetValues) > 0 {
-		for _, e := range m.NetAssetValues {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddNetAssetValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelScopeOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScopeOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScopeOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgWriteScopeBundleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgWriteScopeBundleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteScopeBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UsdMills != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWriteScopeBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteScopeBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteScopeBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordIdInfos) > 0 {
+		for iNdEx := len(m.RecordIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SessionIdInfos) > 0 {
+		for iNdEx := len(m.SessionIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ScopeIdInfo != nil {
+		{
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgArchiveScopeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgArchiveScopeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArchiveScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgArchiveScopeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgArchiveScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArchiveScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Tombstone.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ScopeIds) > 0 {
+		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ScopeIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ScopeIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgWriteScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Scope.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ScopeUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UsdMills != 0 {
+		n += 1 + sovTx(uint64(m.UsdMills))
+	}
+	return n
+}
+
+func (m *MsgWriteScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddScopeDataAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddScopeDataAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteScopeDataAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeDataAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddScopeOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddScopeOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteScopeOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateValueOwnersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeIds) > 0 {
+		for _, e := range m.ScopeIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ValueOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateValueOwnersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateValueOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Existing)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Proposed)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMigrateValueOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Session.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SessionIdComponents != nil {
+		l = m.SessionIdComponents.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionIdComponents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdentifier != nil {
+		n += m.ScopeIdentifier.Size()
+	}
+	l = len(m.SessionUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionIdComponents_ScopeUuid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeUuid)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *SessionIdComponents_ScopeAddr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *MsgWriteSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SessionIdComponents != nil {
+		l = m.SessionIdComponents.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractSpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Parties) > 0 {
+		for _, e := range m.Parties {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordIdInfo != nil {
+		l = m.RecordIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeSpecIdInfo != nil {
+		l = m.ScopeSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddContractSpecToScopeSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ScopeSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddContractSpecToScopeSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ScopeSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteRecordSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ContractSpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteRecordSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordSpecIdInfo != nil {
+		l = m.RecordSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBindOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBindOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgModifyOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgModifyOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAccountDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MetadataAddr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAccountDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteP8EContractSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contractspec.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteP8EContractSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RecordSpecIdInfos) > 0 {
+		for _, e := range m.RecordSpecIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgP8EMemorializeContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScopeSpecificationId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Recitals != nil {
+		l = m.Recitals.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Contract != nil {
+		l = m.Contract.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Signatures != nil {
+		l = m.Signatures.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Invoker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgP8EMemorializeContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RecordIdInfos) > 0 {
+		for _, e := range m.RecordIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddNetAssetValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddNetAssetValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetNetAssetValueMaxAgeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxAge != 0 {
+		n += 1 + sovTx(uint64(m.MaxAge))
+	}
+	return n
+}
+
+func (m *MsgSetNetAssetValueMaxAgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPruneScopeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Keep != 0 {
+		n += 1 + sovTx(uint64(m.Keep))
+	}
+	return n
+}
+
+func (m *MsgPruneScopeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposeScopeOwnershipTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ValueOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposeScopeOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptScopeOwnershipTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAcceptScopeOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelScopeOwnershipTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelScopeOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteScopeBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Scope.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UsdMills != 0 {
+		n += 1 + sovTx(uint64(m.UsdMills))
+	}
+	return n
+}
+
+func (m *MsgWriteScopeBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SessionIdInfos) > 0 {
+		for _, e := range m.SessionIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RecordIdInfos) > 0 {
+		for _, e := range m.RecordIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgArchiveScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgArchiveScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tombstone.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMigrateScopeSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeIds) > 0 {
+		for _, e := range m.ScopeIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMigrateScopeSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
+			}
+			m.UsdMills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdMills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
+			}
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, Party{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValueOwnersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValueOwnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v MetadataAddress
+			m.ScopeIds = append(m.ScopeIds, v)
+			if err := m.ScopeIds[len(m.ScopeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateValueOwnersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValueOwnersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValueOwnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateValueOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateValueOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Existing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Existing = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateValueOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateValueOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateValueOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdComponents == nil {
+				m.SessionIdComponents = &SessionIdComponents{}
+			}
+			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6523,48 +10361,15 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionIdComponents: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionIdComponents: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6592,11 +10397,11 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.ScopeIdentifier = &SessionIdComponents_ScopeUuid{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6624,11 +10429,11 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeUuid = string(dAtA[iNdEx:postIndex])
+			m.ScopeIdentifier = &SessionIdComponents_ScopeAddr{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6656,27 +10461,8 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			m.SessionUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
-			}
-			m.UsdMills = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsdMills |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6698,7 +10484,7 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6721,15 +10507,15 @@ func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteSessionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6756,10 +10542,10 @@ func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeIdInfo == nil {
-				m.ScopeIdInfo = &ScopeIdInfo{}
+			if m.SessionIdInfo == nil {
+				m.SessionIdInfo = &SessionIdInfo{}
 			}
-			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6784,7 +10570,7 @@ func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6807,17 +10593,17 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6827,22 +10613,22 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6878,6 +10664,108 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdComponents == nil {
+				m.SessionIdComponents = &SessionIdComponents{}
+			}
+			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parties = append(m.Parties, Party{})
+			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6899,7 +10787,7 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6909,25 +10797,61 @@ func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.RecordIdInfo == nil {
+				m.RecordIdInfo = &RecordIdInfo{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6949,7 +10873,7 @@ func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6972,15 +10896,15 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7007,43 +10931,11 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -7096,7 +10988,7 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7119,10 +11011,10 @@ func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7146,7 +11038,7 @@ func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7169,17 +11061,17 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteScopeSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7189,28 +11081,28 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7238,11 +11130,11 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7270,7 +11162,7 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7293,7 +11185,7 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7316,12 +11208,48 @@ func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteScopeSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeSpecIdInfo == nil {
+				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
+			}
+			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7343,7 +11271,7 @@ func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7366,15 +11294,15 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteScopeSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7401,45 +11329,11 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owners = append(m.Owners, Party{})
-			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -7492,7 +11386,7 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7515,10 +11409,10 @@ func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteScopeSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7542,7 +11436,7 @@ func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7565,17 +11459,17 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteContractSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7585,28 +11479,28 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7634,11 +11528,11 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7666,7 +11560,7 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7689,7 +11583,7 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7699,25 +11593,61 @@ func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteContractSpecificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ContractSpecIdInfo == nil {
+				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7739,7 +11669,7 @@ func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAddContractSpecToScopeSpecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7762,15 +11692,15 @@ func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValueOwnersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValueOwnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7797,17 +11727,15 @@ func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v MetadataAddress
-			m.ScopeIds = append(m.ScopeIds, v)
-			if err := m.ScopeIds[len(m.ScopeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContractSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7817,23 +11745,24 @@ func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ScopeSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -7888,7 +11817,7 @@ func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateValueOwnersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddContractSpecToScopeSpecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7911,10 +11840,10 @@ func (m *MsgUpdateValueOwnersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValueOwnersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValueOwnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7938,7 +11867,7 @@ func (m *MsgUpdateValueOwnersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7961,17 +11890,17 @@ func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateValueOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateValueOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Existing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7981,29 +11910,30 @@ func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Existing = string(dAtA[iNdEx:postIndex])
+			if err := m.ContractSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8013,23 +11943,24 @@ func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposed = string(dAtA[iNdEx:postIndex])
+			if err := m.ScopeSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -8084,7 +12015,7 @@ func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateValueOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8107,10 +12038,10 @@ func (m *MsgMigrateValueOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateValueOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateValueOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8134,7 +12065,7 @@ func (m *MsgMigrateValueOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8157,17 +12088,17 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteSessionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteContractSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8177,22 +12108,22 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8228,74 +12159,56 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdComponents == nil {
-				m.SessionIdComponents = &SessionIdComponents{}
-			}
-			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteContractSpecificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8317,7 +12230,7 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8340,17 +12253,17 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionIdComponents: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteRecordSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionIdComponents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteRecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8360,27 +12273,28 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeIdentifier = &SessionIdComponents_ScopeUuid{string(dAtA[iNdEx:postIndex])}
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8408,11 +12322,11 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeIdentifier = &SessionIdComponents_ScopeAddr{string(dAtA[iNdEx:postIndex])}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8440,7 +12354,7 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionUuid = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8463,7 +12377,7 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8486,15 +12400,15 @@ func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteSessionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteRecordSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteRecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8521,10 +12435,10 @@ func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdInfo == nil {
-				m.SessionIdInfo = &SessionIdInfo{}
+			if m.RecordSpecIdInfo == nil {
+				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
 			}
-			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8549,7 +12463,7 @@ func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8572,17 +12486,17 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteRecordSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteRecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8592,22 +12506,22 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8643,108 +12557,56 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SessionIdComponents == nil {
-				m.SessionIdComponents = &SessionIdComponents{}
-			}
-			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecUuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parties = append(m.Parties, Party{})
-			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRecordSpecificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8766,7 +12628,7 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBindOSLocatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8789,15 +12651,15 @@ func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBindOSLocatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBindOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8824,10 +12686,7 @@ func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecordIdInfo == nil {
-				m.RecordIdInfo = &RecordIdInfo{}
-			}
-			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8852,7 +12711,7 @@ func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgBindOSLocatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8875,17 +12734,17 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBindOSLocatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBindOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8895,57 +12754,25 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8967,7 +12794,7 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteOSLocatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8990,12 +12817,45 @@ func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteOSLocatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9017,7 +12877,7 @@ func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteOSLocatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9040,15 +12900,15 @@ func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteOSLocatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9075,47 +12935,65 @@ func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModifyOSLocatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyOSLocatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9125,23 +13003,24 @@ func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9164,7 +13043,7 @@ func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyOSLocatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9187,15 +13066,15 @@ func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyOSLocatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9222,10 +13101,7 @@ func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeSpecIdInfo == nil {
-				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
-			}
-			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9250,7 +13126,7 @@ func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9273,15 +13149,15 @@ func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAccountDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataAddr", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9308,11 +13184,43 @@ func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MetadataAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -9365,7 +13273,7 @@ func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9388,10 +13296,10 @@ func (m *MsgDeleteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAccountDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9415,7 +13323,7 @@ func (m *MsgDeleteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9438,15 +13346,15 @@ func (m *MsgWriteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteContractSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteP8eContractSpecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteP8eContractSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contractspec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9473,7 +13381,7 @@ func (m *MsgWriteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Contractspec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9509,38 +13417,6 @@ func (m *MsgWriteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpecUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9562,7 +13438,7 @@ func (m *MsgWriteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9575,25 +13451,61 @@ func (m *MsgWriteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteP8eContractSpecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteP8eContractSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractSpecIdInfo == nil {
+				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
+			}
+			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteContractSpecificationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9620,10 +13532,8 @@ func (m *MsgWriteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractSpecIdInfo == nil {
-				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
-			}
-			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecordSpecIdInfos = append(m.RecordSpecIdInfos, &RecordSpecIdInfo{})
+			if err := m.RecordSpecIdInfos[len(m.RecordSpecIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9648,7 +13558,7 @@ func (m *MsgWriteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9671,17 +13581,17 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgP8eMemorializeContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgP8eMemorializeContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9691,30 +13601,29 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9724,28 +13633,27 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9773,113 +13681,13 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.ScopeSpecificationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddContractSpecToScopeSpecResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recitals", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9889,30 +13697,33 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Recitals == nil {
+				m.Recitals = &p8e.Recitals{}
+			}
+			if err := m.Recitals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9922,30 +13733,33 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Contract == nil {
+				m.Contract = &p8e.Contract{}
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9955,74 +13769,60 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if m.Signatures == nil {
+				m.Signatures = &p8e.SignatureSet{}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			if err := m.Signatures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invoker", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invoker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10044,7 +13844,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MsgDeleteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10067,17 +13867,17 @@ func (m *MsgDeleteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgP8eMemorializeContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgP8eMemorializeContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10087,30 +13887,33 @@ func (m *MsgDeleteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
+			}
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10120,74 +13923,62 @@ func (m *MsgDeleteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if m.SessionIdInfo == nil {
+				m.SessionIdInfo = &SessionIdInfo{}
+			}
+			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfos", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RecordIdInfos = append(m.RecordIdInfos, &RecordIdInfo{})
+			if err := m.RecordIdInfos[len(m.RecordIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecificationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10209,7 +14000,7 @@ func (m *MsgDeleteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10232,17 +14023,17 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10252,24 +14043,23 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -10305,9 +14095,9 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10317,23 +14107,25 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
+			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10356,7 +14148,7 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10379,48 +14171,12 @@ func (m *MsgWriteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RecordSpecIdInfo == nil {
-				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
-			}
-			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10442,7 +14198,7 @@ func (m *MsgWriteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetNetAssetValueMaxAgeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10465,17 +14221,17 @@ func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetNetAssetValueMaxAgeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetNetAssetValueMaxAgeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10485,24 +14241,23 @@ func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -10534,8 +14289,27 @@ func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			m.MaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10557,7 +14331,7 @@ func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetNetAssetValueMaxAgeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10580,10 +14354,10 @@ func (m *MsgDeleteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetNetAssetValueMaxAgeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetNetAssetValueMaxAgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10607,7 +14381,7 @@ func (m *MsgDeleteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBindOSLocatorRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneScopeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10630,17 +14404,17 @@ func (m *MsgBindOSLocatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBindOSLocatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneScopeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBindOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneScopeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10650,25 +14424,75 @@ func (m *MsgBindOSLocatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keep", wireType)
+			}
+			m.Keep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Keep |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10690,7 +14514,7 @@ func (m *MsgBindOSLocatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBindOSLocatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneScopeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10713,45 +14537,12 @@ func (m *MsgBindOSLocatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBindOSLocatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneScopeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBindOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneScopeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10773,7 +14564,7 @@ func (m *MsgBindOSLocatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteOSLocatorRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeScopeOwnershipTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10796,17 +14587,17 @@ func (m *MsgDeleteOSLocatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteOSLocatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeScopeOwnershipTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeScopeOwnershipTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10816,78 +14607,28 @@ func (m *MsgDeleteOSLocatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteOSLocatorResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteOSLocatorResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10914,63 +14655,46 @@ func (m *MsgDeleteOSLocatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Owners = append(m.Owners, Party{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgModifyOSLocatorRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyOSLocatorRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10997,10 +14721,42 @@ func (m *MsgModifyOSLocatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11022,7 +14778,7 @@ func (m *MsgModifyOSLocatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyOSLocatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeScopeOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11045,45 +14801,12 @@ func (m *MsgModifyOSLocatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyOSLocatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeScopeOwnershipTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeScopeOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11105,7 +14828,7 @@ func (m *MsgModifyOSLocatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptScopeOwnershipTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11128,15 +14851,15 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAccountDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptScopeOwnershipTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptScopeOwnershipTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -11163,43 +14886,11 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MetadataAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -11252,7 +14943,7 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptScopeOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11275,10 +14966,10 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAccountDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptScopeOwnershipTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptScopeOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11302,7 +14993,7 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScopeOwnershipTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11325,17 +15016,17 @@ func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteP8eContractSpecRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScopeOwnershipTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteP8eContractSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScopeOwnershipTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contractspec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11345,22 +15036,22 @@ func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Contractspec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11417,7 +15108,7 @@ func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScopeOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11440,82 +15131,12 @@ func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteP8eContractSpecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScopeOwnershipTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteP8eContractSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScopeOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ContractSpecIdInfo == nil {
-				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
-			}
-			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfos", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordSpecIdInfos = append(m.RecordSpecIdInfos, &RecordSpecIdInfo{})
-			if err := m.RecordSpecIdInfos[len(m.RecordSpecIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11537,7 +15158,7 @@ func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteScopeBundleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11560,17 +15181,17 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgP8eMemorializeContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteScopeBundleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgP8eMemorializeContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteScopeBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11580,29 +15201,64 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, Session{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11612,27 +15268,29 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupId = string(dAtA[iNdEx:postIndex])
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11660,13 +15318,13 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecificationId = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recitals", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
 			}
-			var msglen int
+			m.UsdMills = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11676,31 +15334,64 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UsdMills |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Recitals == nil {
-				m.Recitals = &p8e.Recitals{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteScopeBundleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if err := m.Recitals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopeBundleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopeBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11727,16 +15418,16 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Contract == nil {
-				m.Contract = &p8e.Contract{}
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
 			}
-			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11763,18 +15454,16 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Signatures == nil {
-				m.Signatures = &p8e.SignatureSet{}
-			}
-			if err := m.Signatures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SessionIdInfos = append(m.SessionIdInfos, &SessionIdInfo{})
+			if err := m.SessionIdInfos[len(m.SessionIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Invoker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11784,23 +15473,25 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Invoker = string(dAtA[iNdEx:postIndex])
+			m.RecordIdInfos = append(m.RecordIdInfos, &RecordIdInfo{})
+			if err := m.RecordIdInfos[len(m.RecordIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11823,7 +15514,7 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgArchiveScopeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11846,17 +15537,17 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgP8eMemorializeContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgArchiveScopeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgP8eMemorializeContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgArchiveScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11866,33 +15557,30 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeIdInfo == nil {
-				m.ScopeIdInfo = &ScopeIdInfo{}
-			}
-			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11902,31 +15590,77 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdInfo == nil {
-				m.SessionIdInfo = &SessionIdInfo{}
-			}
-			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgArchiveScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgArchiveScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgArchiveScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11953,8 +15687,7 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordIdInfos = append(m.RecordIdInfos, &RecordIdInfo{})
-			if err := m.RecordIdInfos[len(m.RecordIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tombstone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11979,7 +15712,7 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateScopeSpecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12002,17 +15735,17 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateScopeSpecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateScopeSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12022,29 +15755,32 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			var v MetadataAddress
+			m.ScopeIds = append(m.ScopeIds, v)
+			if err := m.ScopeIds[len(m.ScopeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12054,29 +15790,30 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12086,25 +15823,23 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
-			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12127,7 +15862,7 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateScopeSpecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12150,10 +15885,10 @@ func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateScopeSpecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateScopeSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
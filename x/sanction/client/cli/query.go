@@ -33,6 +33,8 @@ func QueryCmd() *cobra.Command {
 		QueryIsSanctionedCmd(),
 		QuerySanctionedAddressesCmd(),
 		QueryTemporaryEntriesCmd(),
+		QueryEmergencySanctionsCmd(),
+		QuerySeizuresCmd(),
 		QueryParamsCmd(),
 	)
 
@@ -188,6 +190,114 @@ Examples:
 	return cmd
 }
 
+// QueryEmergencySanctionsCmd returns a command for executing an EmergencySanctions query.
+func QueryEmergencySanctionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "emergency-sanctions [<address>]",
+		Aliases: []string{"emergency"},
+		Short:   "List emergency sanctions that are awaiting governance ratification",
+		Long: fmt.Sprintf(`List emergency sanctions that are awaiting governance ratification.
+If an address is provided, only the emergency sanction for that address is returned.
+Otherwise, all emergency sanctions are returned.
+
+Examples:
+  $ %[1]s emergency-sanctions
+  $ %[1]s emergency-sanctions %[2]s
+  $ %[1]s emergency
+  $ %[1]s emergency %[2]s
+`,
+			exampleQueryCmdBase, exampleQueryAddr1),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := sanction.QueryEmergencySanctionsRequest{}
+			if len(args) > 0 {
+				if _, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+					return err
+				}
+				req.Address = args[0]
+			}
+
+			req.Pagination, err = client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var res *sanction.QueryEmergencySanctionsResponse
+			queryClient := sanction.NewQueryClient(clientCtx)
+			res, err = queryClient.EmergencySanctions(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "emergency-sanctions")
+
+	return cmd
+}
+
+// QuerySeizuresCmd returns a command for executing a Seizures query.
+func QuerySeizuresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "seizures [<address>]",
+		Aliases: []string{"seized"},
+		Short:   "List records of funds seized from sanctioned addresses",
+		Long: fmt.Sprintf(`List records of funds seized from sanctioned addresses.
+If an address is provided, only seizures from that address are returned.
+Otherwise, all seizures are returned.
+
+Examples:
+  $ %[1]s seizures
+  $ %[1]s seizures %[2]s
+  $ %[1]s seized
+  $ %[1]s seized %[2]s
+`,
+			exampleQueryCmdBase, exampleQueryAddr1),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := sanction.QuerySeizuresRequest{}
+			if len(args) > 0 {
+				if _, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+					return err
+				}
+				req.Address = args[0]
+			}
+
+			req.Pagination, err = client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var res *sanction.QuerySeizuresResponse
+			queryClient := sanction.NewQueryClient(clientCtx)
+			res, err = queryClient.Seizures(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "seizures")
+
+	return cmd
+}
+
 // QueryParamsCmd returns a command for executing a Params query.
 func QueryParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
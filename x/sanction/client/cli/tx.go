@@ -2,11 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
@@ -24,6 +26,13 @@ var (
 	exampleTxAddr2 = sdk.AccAddress("exampleTxAddr2______")
 )
 
+const (
+	// FlagComplianceOfficers is the flag for providing the compliance officers in an update-params command.
+	FlagComplianceOfficers = "compliance-officers"
+	// FlagEmergencySanctionDuration is the flag for providing the emergency sanction duration in an update-params command.
+	FlagEmergencySanctionDuration = "emergency-sanction-duration"
+)
+
 // TxCmd returns the command with sub-commands for specific sanction module Tx interaction.
 func TxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -38,6 +47,9 @@ func TxCmd() *cobra.Command {
 		TxSanctionCmd(),
 		TxUnsanctionCmd(),
 		TxUpdateParamsCmd(),
+		TxEmergencySanctionCmd(),
+		TxSeizeSanctionedFundsCmd(),
+		TxReleaseSeizedFundsCmd(),
 	)
 
 	return txCmd
@@ -132,14 +144,19 @@ func TxUpdateParamsCmd() *cobra.Command {
 		Short: "Submit a governance proposal to update the sanction module's params",
 		Long: `Submit a governance proposal to update the sanction module's params.
 Both <immediate_sanction_min_deposit> and <immediate_unsanction_min_deposit> are required.
-They must be coins or empty strings.`,
+They must be coins or empty strings.
+
+The --%[3]s flag is a comma-separated list of addresses allowed to issue emergency sanctions.
+The --%[4]s flag is how long an emergency sanction lasts (e.g. 72h). Zero disables emergency sanctions.`,
 		Example: fmt.Sprintf(`
 $ %[1]s update-params 100%[2]s 150%[2]s
 $ %[1]s update-params '' 50%[2]s
 $ %[1]s update-params 75%[2]s ''
 $ %[1]s update-params '' ''
+$ %[1]s update-params 100%[2]s 150%[2]s --%[3]s %[5]s,%[6]s --%[4]s 48h
 `,
-			exampleTxCmdBase, sdk.DefaultBondDenom),
+			exampleTxCmdBase, sdk.DefaultBondDenom, FlagComplianceOfficers, FlagEmergencySanctionDuration,
+			exampleTxAddr1, exampleTxAddr2),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -168,6 +185,16 @@ $ %[1]s update-params '' ''
 				}
 			}
 
+			msgUpdateParams.Params.ComplianceOfficers, err = flagSet.GetStringSlice(FlagComplianceOfficers)
+			if err != nil {
+				return fmt.Errorf("invalid --%s value: %w", FlagComplianceOfficers, err)
+			}
+
+			msgUpdateParams.Params.EmergencySanctionDuration, err = flagSet.GetDuration(FlagEmergencySanctionDuration)
+			if err != nil {
+				return fmt.Errorf("invalid --%s value: %w", FlagEmergencySanctionDuration, err)
+			}
+
 			if err = msgUpdateParams.ValidateBasic(); err != nil {
 				return err
 			}
@@ -176,6 +203,147 @@ $ %[1]s update-params '' ''
 		},
 	}
 
+	cmd.Flags().StringSlice(FlagComplianceOfficers, nil, "The addresses allowed to issue emergency sanctions")
+	cmd.Flags().Duration(FlagEmergencySanctionDuration, sanction.DefaultEmergencySanctionDuration, "How long an emergency sanction lasts before it expires")
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+
+	return cmd
+}
+
+// TxEmergencySanctionCmd returns the command for submitting a MsgEmergencySanction tx.
+func TxEmergencySanctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "emergency-sanction <address 1> [<address 2> ...] --from <compliance officer>",
+		Aliases: []string{"emergency"},
+		Short:   "Immediately and temporarily sanction one or more addresses as a compliance officer",
+		Long: `Immediately and temporarily sanction one or more addresses as a compliance officer.
+At least one address is required; any number of addresses can be provided.
+Each address should be a valid bech32 encoded string.
+The --from address must be one of the compliance officers defined in the sanction module params.
+An emergency sanction expires unless it is ratified by a governance proposal before then.`,
+		Example: fmt.Sprintf(`
+$ %[1]s emergency-sanction %[2]s --from officer
+$ %[1]s emergency-sanction %[3]s %[2]s --from officer
+`,
+			exampleTxCmdBase, exampleTxAddr1, exampleTxAddr2),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &sanction.MsgEmergencySanction{
+				Addresses: args,
+				Signer:    clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// TxSeizeSanctionedFundsCmd returns the command for submitting a MsgSeizeSanctionedFunds governance proposal tx.
+func TxSeizeSanctionedFundsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "seize-funds <address> <amount>",
+		Aliases: []string{"seize"},
+		Short:   "Submit a governance proposal to seize funds from a sanctioned address",
+		Long: `Submit a governance proposal to seize funds from a sanctioned address.
+The <address> must be a valid bech32 encoded string of an address that is sanctioned when the proposal passes.
+The <amount> is the coins to move from that address into the sanction module's escrow account, e.g. 1000nhash,5foo.`,
+		Example: fmt.Sprintf(`
+$ %[1]s seize-funds %[2]s 1000nhash
+$ %[1]s seize %[2]s 1000nhash,5foo
+`,
+			exampleTxCmdBase, exampleTxAddr1),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[1], err)
+			}
+
+			msg := &sanction.MsgSeizeSanctionedFunds{
+				Address:   args[0],
+				Amount:    amount,
+				Authority: provcli.GetAuthority(flagSet),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+
+	return cmd
+}
+
+// TxReleaseSeizedFundsCmd returns the command for submitting a MsgReleaseSeizedFunds governance proposal tx.
+func TxReleaseSeizedFundsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "release-seized-funds <seizure id> [<destination>]",
+		Aliases: []string{"release-seized", "release"},
+		Short:   "Submit a governance proposal to send seized funds out of escrow",
+		Long: `Submit a governance proposal to send seized funds out of escrow.
+The <seizure id> is the id of the seizure record whose funds are to be sent.
+If a <destination> is provided, the funds are sent to that address.
+Otherwise, the funds are returned to the address they were seized from.`,
+		Example: fmt.Sprintf(`
+$ %[1]s release-seized-funds 3
+$ %[1]s release-seized-funds 3 %[2]s
+`,
+			exampleTxCmdBase, exampleTxAddr1),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+
+			seizureID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid seizure id %q: %w", args[0], err)
+			}
+
+			msg := &sanction.MsgReleaseSeizedFunds{
+				SeizureId: seizureID,
+				Authority: provcli.GetAuthority(flagSet),
+			}
+			if len(args) > 1 {
+				msg.Destination = args[1]
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 	govcli.AddGovPropFlagsToCmd(cmd)
 	provcli.AddAuthorityFlagToCmd(cmd)
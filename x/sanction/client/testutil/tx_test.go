@@ -1,6 +1,8 @@
 package testutil
 
 import (
+	"time"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
@@ -208,6 +210,8 @@ func (s *IntegrationTestSuite) TestTxUnsanctionCmd() {
 
 func (s *IntegrationTestSuite) TestTxUpdateParamsCmd() {
 	authority := s.getAuthority()
+	officer1 := sdk.AccAddress("1_compliance_officer").String()
+	officer2 := sdk.AccAddress("2_compliance_officer").String()
 
 	tests := []struct {
 		name       string
@@ -237,6 +241,7 @@ func (s *IntegrationTestSuite) TestTxUpdateParamsCmd() {
 				Params: &sanction.Params{
 					ImmediateSanctionMinDeposit:   sdk.NewCoins(sdk.NewInt64Coin("acoin", 1)),
 					ImmediateUnsanctionMinDeposit: sdk.NewCoins(sdk.NewInt64Coin("bcoin", 2)),
+					EmergencySanctionDuration:     sanction.DefaultEmergencySanctionDuration,
 				},
 				Authority: authority,
 			},
@@ -248,6 +253,7 @@ func (s *IntegrationTestSuite) TestTxUpdateParamsCmd() {
 				Params: &sanction.Params{
 					ImmediateSanctionMinDeposit:   nil,
 					ImmediateUnsanctionMinDeposit: sdk.NewCoins(sdk.NewInt64Coin("ccoin", 3)),
+					EmergencySanctionDuration:     sanction.DefaultEmergencySanctionDuration,
 				},
 				Authority: authority,
 			},
@@ -259,6 +265,7 @@ func (s *IntegrationTestSuite) TestTxUpdateParamsCmd() {
 				Params: &sanction.Params{
 					ImmediateSanctionMinDeposit:   sdk.NewCoins(sdk.NewInt64Coin("dcoin", 4)),
 					ImmediateUnsanctionMinDeposit: nil,
+					EmergencySanctionDuration:     sanction.DefaultEmergencySanctionDuration,
 				},
 				Authority: authority,
 			},
@@ -270,10 +277,32 @@ func (s *IntegrationTestSuite) TestTxUpdateParamsCmd() {
 				Params: &sanction.Params{
 					ImmediateSanctionMinDeposit:   nil,
 					ImmediateUnsanctionMinDeposit: nil,
+					EmergencySanctionDuration:     sanction.DefaultEmergencySanctionDuration,
 				},
 				Authority: authority,
 			},
 		},
+		{
+			name: "with emergency sanction flags",
+			args: []string{"7gcoin", "8hcoin",
+				"--" + client.FlagComplianceOfficers, officer1 + "," + officer2,
+				"--" + client.FlagEmergencySanctionDuration, "48h",
+			},
+			expPropMsg: &sanction.MsgUpdateParams{
+				Params: &sanction.Params{
+					ImmediateSanctionMinDeposit:   sdk.NewCoins(sdk.NewInt64Coin("gcoin", 7)),
+					ImmediateUnsanctionMinDeposit: sdk.NewCoins(sdk.NewInt64Coin("hcoin", 8)),
+					ComplianceOfficers:            []string{officer1, officer2},
+					EmergencySanctionDuration:     48 * time.Hour,
+				},
+				Authority: authority,
+			},
+		},
+		{
+			name:   "bad compliance officer",
+			args:   []string{"", "", "--" + client.FlagComplianceOfficers, "notanaddr"},
+			expErr: []string{"compliance officers[0]", `"notanaddr"`},
+		},
 		{
 			name:   "bad good",
 			args:   []string{"firscoinsbad", "5ecoin"},
@@ -8,8 +8,13 @@ import (
 const sanctionCodespace = "sanction"
 
 var (
-	ErrInvalidParams      = cerrs.Register(sanctionCodespace, 2, "invalid params")
-	ErrUnsanctionableAddr = cerrs.Register(sanctionCodespace, 3, "address cannot be sanctioned")
-	ErrInvalidTempStatus  = cerrs.Register(sanctionCodespace, 4, "invalid temp status")
-	ErrSanctionedAccount  = cerrs.Register(sanctionCodespace, 5, "account is sanctioned")
+	ErrInvalidParams              = cerrs.Register(sanctionCodespace, 2, "invalid params")
+	ErrUnsanctionableAddr         = cerrs.Register(sanctionCodespace, 3, "address cannot be sanctioned")
+	ErrInvalidTempStatus          = cerrs.Register(sanctionCodespace, 4, "invalid temp status")
+	ErrSanctionedAccount          = cerrs.Register(sanctionCodespace, 5, "account is sanctioned")
+	ErrNotComplianceOfficer       = cerrs.Register(sanctionCodespace, 6, "address is not a compliance officer")
+	ErrEmergencySanctionsDisabled = cerrs.Register(sanctionCodespace, 7, "emergency sanctions are not available")
+	ErrNotSanctionedAccount       = cerrs.Register(sanctionCodespace, 8, "account is not sanctioned")
+	ErrUnknownSeizure             = cerrs.Register(sanctionCodespace, 9, "unknown seizure")
+	ErrSeizureNotHeld             = cerrs.Register(sanctionCodespace, 10, "seized funds are not held")
 )
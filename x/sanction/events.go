@@ -1,6 +1,10 @@
 package sanction
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 func NewEventAddressSanctioned(addr sdk.AccAddress) *EventAddressSanctioned {
 	return &EventAddressSanctioned{
@@ -25,3 +29,34 @@ func NewEventTempAddressUnsanctioned(addr sdk.AccAddress) *EventTempAddressUnsan
 		Address: addr.String(),
 	}
 }
+
+func NewEventEmergencySanction(addr, officer sdk.AccAddress, expiresAt time.Time) *EventEmergencySanction {
+	return &EventEmergencySanction{
+		Address:   addr.String(),
+		Officer:   officer.String(),
+		ExpiresAt: expiresAt,
+	}
+}
+
+func NewEventEmergencySanctionExpired(addr sdk.AccAddress) *EventEmergencySanctionExpired {
+	return &EventEmergencySanctionExpired{
+		Address: addr.String(),
+	}
+}
+
+func NewEventFundsSeized(seizureID uint64, addr sdk.AccAddress, amount sdk.Coins) *EventFundsSeized {
+	return &EventFundsSeized{
+		SeizureId: seizureID,
+		Address:   addr.String(),
+		Amount:    amount.String(),
+	}
+}
+
+func NewEventSeizedFundsReleased(seizureID uint64, addr, recipient sdk.AccAddress, amount sdk.Coins) *EventSeizedFundsReleased {
+	return &EventSeizedFundsReleased{
+		SeizureId: seizureID,
+		Address:   addr.String(),
+		Recipient: recipient.String(),
+		Amount:    amount.String(),
+	}
+}
@@ -6,16 +6,21 @@ package sanction
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -240,12 +245,263 @@ func (m *EventParamsUpdated) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventParamsUpdated proto.InternalMessageInfo
 
+// EventEmergencySanction is an event emitted when a compliance officer issues an emergency sanction on an address.
+type EventEmergencySanction struct {
+	// address is the address that was sanctioned.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// officer is the address of the compliance officer that issued the sanction.
+	Officer string `protobuf:"bytes,2,opt,name=officer,proto3" json:"officer,omitempty"`
+	// expires_at is the time at which the emergency sanction will expire.
+	ExpiresAt time.Time `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+}
+
+func (m *EventEmergencySanction) Reset()         { *m = EventEmergencySanction{} }
+func (m *EventEmergencySanction) String() string { return proto.CompactTextString(m) }
+func (*EventEmergencySanction) ProtoMessage()    {}
+func (*EventEmergencySanction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9bc0752677962a, []int{5}
+}
+func (m *EventEmergencySanction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEmergencySanction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEmergencySanction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEmergencySanction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEmergencySanction.Merge(m, src)
+}
+func (m *EventEmergencySanction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEmergencySanction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEmergencySanction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEmergencySanction proto.InternalMessageInfo
+
+func (m *EventEmergencySanction) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventEmergencySanction) GetOfficer() string {
+	if m != nil {
+		return m.Officer
+	}
+	return ""
+}
+
+func (m *EventEmergencySanction) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+// EventEmergencySanctionExpired is an event emitted when an emergency sanction expires without being ratified.
+type EventEmergencySanctionExpired struct {
+	// address is the address that is no longer under an emergency sanction.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *EventEmergencySanctionExpired) Reset()         { *m = EventEmergencySanctionExpired{} }
+func (m *EventEmergencySanctionExpired) String() string { return proto.CompactTextString(m) }
+func (*EventEmergencySanctionExpired) ProtoMessage()    {}
+func (*EventEmergencySanctionExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9bc0752677962a, []int{6}
+}
+func (m *EventEmergencySanctionExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEmergencySanctionExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEmergencySanctionExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEmergencySanctionExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEmergencySanctionExpired.Merge(m, src)
+}
+func (m *EventEmergencySanctionExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEmergencySanctionExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEmergencySanctionExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEmergencySanctionExpired proto.InternalMessageInfo
+
+func (m *EventEmergencySanctionExpired) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// EventFundsSeized is an event emitted when funds are seized from a sanctioned address.
+type EventFundsSeized struct {
+	// seizure_id is the id of the seizure record.
+	SeizureId uint64 `protobuf:"varint,1,opt,name=seizure_id,json=seizureId,proto3" json:"seizure_id,omitempty"`
+	// address is the sanctioned address that the funds were seized from.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the funds that were seized.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventFundsSeized) Reset()         { *m = EventFundsSeized{} }
+func (m *EventFundsSeized) String() string { return proto.CompactTextString(m) }
+func (*EventFundsSeized) ProtoMessage()    {}
+func (*EventFundsSeized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9bc0752677962a, []int{7}
+}
+func (m *EventFundsSeized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFundsSeized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFundsSeized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFundsSeized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFundsSeized.Merge(m, src)
+}
+func (m *EventFundsSeized) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFundsSeized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFundsSeized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFundsSeized proto.InternalMessageInfo
+
+func (m *EventFundsSeized) GetSeizureId() uint64 {
+	if m != nil {
+		return m.SeizureId
+	}
+	return 0
+}
+
+func (m *EventFundsSeized) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventFundsSeized) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// EventSeizedFundsReleased is an event emitted when seized funds are sent out of escrow.
+type EventSeizedFundsReleased struct {
+	// seizure_id is the id of the seizure record.
+	SeizureId uint64 `protobuf:"varint,1,opt,name=seizure_id,json=seizureId,proto3" json:"seizure_id,omitempty"`
+	// address is the sanctioned address that the funds were seized from.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// recipient is the address that the funds were sent to.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the funds that were sent.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventSeizedFundsReleased) Reset()         { *m = EventSeizedFundsReleased{} }
+func (m *EventSeizedFundsReleased) String() string { return proto.CompactTextString(m) }
+func (*EventSeizedFundsReleased) ProtoMessage()    {}
+func (*EventSeizedFundsReleased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9bc0752677962a, []int{8}
+}
+func (m *EventSeizedFundsReleased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSeizedFundsReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSeizedFundsReleased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSeizedFundsReleased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSeizedFundsReleased.Merge(m, src)
+}
+func (m *EventSeizedFundsReleased) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSeizedFundsReleased) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSeizedFundsReleased.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSeizedFundsReleased proto.InternalMessageInfo
+
+func (m *EventSeizedFundsReleased) GetSeizureId() uint64 {
+	if m != nil {
+		return m.SeizureId
+	}
+	return 0
+}
+
+func (m *EventSeizedFundsReleased) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventSeizedFundsReleased) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventSeizedFundsReleased) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventAddressSanctioned)(nil), "cosmos.sanction.v1beta1.EventAddressSanctioned")
 	proto.RegisterType((*EventAddressUnsanctioned)(nil), "cosmos.sanction.v1beta1.EventAddressUnsanctioned")
 	proto.RegisterType((*EventTempAddressSanctioned)(nil), "cosmos.sanction.v1beta1.EventTempAddressSanctioned")
 	proto.RegisterType((*EventTempAddressUnsanctioned)(nil), "cosmos.sanction.v1beta1.EventTempAddressUnsanctioned")
 	proto.RegisterType((*EventParamsUpdated)(nil), "cosmos.sanction.v1beta1.EventParamsUpdated")
+	proto.RegisterType((*EventEmergencySanction)(nil), "cosmos.sanction.v1beta1.EventEmergencySanction")
+	proto.RegisterType((*EventEmergencySanctionExpired)(nil), "cosmos.sanction.v1beta1.EventEmergencySanctionExpired")
+	proto.RegisterType((*EventFundsSeized)(nil), "cosmos.sanction.v1beta1.EventFundsSeized")
+	proto.RegisterType((*EventSeizedFundsReleased)(nil), "cosmos.sanction.v1beta1.EventSeizedFundsReleased")
 }
 
 func init() {
@@ -253,23 +509,36 @@ func init() {
 }
 
 var fileDescriptor_ae9bc0752677962a = []byte{
-	// 249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x4e, 0xcc, 0x4b, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x87, 0xa8, 0xd2, 0x83, 0xa9, 0xd2, 0x83, 0xaa, 0x92, 0x92, 0x84, 0x48, 0xc4, 0x83,
-	0x95, 0xe9, 0x43, 0x55, 0x81, 0x39, 0x4a, 0x3e, 0x5c, 0x62, 0xae, 0x20, 0x33, 0x1c, 0x53, 0x52,
-	0x8a, 0x52, 0x8b, 0x8b, 0x83, 0xa1, 0x5a, 0x53, 0x53, 0x84, 0x8c, 0xb8, 0xd8, 0x13, 0x21, 0x82,
-	0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0x35, 0xc3, 0x94,
-	0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0xc1, 0x14, 0x2a, 0xf9, 0x71, 0x49, 0x20, 0x9b, 0x16, 0x9a,
-	0x57, 0x4c, 0x99, 0x79, 0x01, 0x5c, 0x52, 0x60, 0xf3, 0x42, 0x52, 0x73, 0x0b, 0xa8, 0xe3, 0xc2,
-	0x20, 0x2e, 0x19, 0x74, 0x13, 0x29, 0x76, 0xa5, 0x08, 0x97, 0x10, 0xd8, 0xcc, 0x80, 0xc4, 0xa2,
-	0xc4, 0xdc, 0xe2, 0xd0, 0x82, 0x94, 0xc4, 0x92, 0xd4, 0x14, 0x27, 0x8f, 0x13, 0x8f, 0xe4, 0x18,
-	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
-	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce,
-	0xcf, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcd, 0xcc, 0x47,
-	0xe2, 0xe9, 0x57, 0xc0, 0x23, 0x3b, 0x89, 0x0d, 0x1c, 0x55, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x07, 0x3a, 0xbf, 0xc3, 0x06, 0x02, 0x00, 0x00,
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x6b, 0x14, 0x31,
+	0x14, 0xc7, 0x37, 0xb5, 0x54, 0x27, 0x5e, 0x64, 0x58, 0xea, 0xb8, 0xd8, 0xd9, 0x32, 0x78, 0xe8,
+	0xa5, 0x19, 0x5a, 0xc1, 0x7b, 0x57, 0x56, 0x14, 0x44, 0xca, 0x6c, 0x7b, 0xf1, 0xb2, 0x64, 0x27,
+	0x6f, 0xc7, 0x40, 0x93, 0x0c, 0x49, 0x66, 0xa9, 0x05, 0xbf, 0x43, 0x3f, 0x8c, 0x57, 0x8f, 0x42,
+	0x8f, 0xc5, 0x93, 0x27, 0x95, 0xdd, 0x2f, 0x22, 0x9b, 0x64, 0x74, 0x15, 0x51, 0x69, 0xf5, 0x36,
+	0xef, 0xcd, 0xef, 0xfd, 0xff, 0xff, 0x84, 0x37, 0x83, 0x1f, 0x94, 0xca, 0x08, 0x65, 0x72, 0x43,
+	0x65, 0x69, 0xb9, 0x92, 0xf9, 0x6c, 0x6f, 0x02, 0x96, 0xee, 0xe5, 0x30, 0x03, 0x69, 0x0d, 0xa9,
+	0xb5, 0xb2, 0x2a, 0xbe, 0xeb, 0x29, 0xd2, 0x52, 0x24, 0x50, 0xbd, 0x7b, 0xfe, 0xc5, 0xd8, 0x61,
+	0x79, 0xa0, 0x5c, 0xd1, 0xeb, 0x56, 0xaa, 0x52, 0xbe, 0xbf, 0x7c, 0x0a, 0xdd, 0x7e, 0xa5, 0x54,
+	0x75, 0x02, 0xb9, 0xab, 0x26, 0xcd, 0x34, 0xb7, 0x5c, 0x80, 0xb1, 0x54, 0xd4, 0x1e, 0xc8, 0x9e,
+	0xe3, 0xcd, 0xe1, 0xd2, 0xfa, 0x80, 0x31, 0x0d, 0xc6, 0x8c, 0x82, 0x23, 0xb0, 0x78, 0x1f, 0xdf,
+	0xa4, 0xbe, 0x99, 0xa0, 0x6d, 0xb4, 0x13, 0x0d, 0x92, 0x0f, 0x6f, 0x77, 0xbb, 0xc1, 0xb3, 0xc5,
+	0xad, 0xe6, 0xb2, 0x2a, 0x5a, 0x30, 0x7b, 0x81, 0x93, 0x55, 0xb5, 0x63, 0x69, 0xae, 0xa7, 0x77,
+	0x88, 0x7b, 0x4e, 0xef, 0x08, 0x44, 0xfd, 0x6f, 0x12, 0x16, 0xf8, 0xfe, 0xcf, 0x8a, 0xd7, 0x4e,
+	0xd9, 0xc5, 0xb1, 0xd3, 0x3c, 0xa4, 0x9a, 0x0a, 0x73, 0x5c, 0x33, 0x6a, 0x81, 0x65, 0xef, 0x51,
+	0xb8, 0xda, 0xa1, 0x00, 0x5d, 0x81, 0x2c, 0x5f, 0xb7, 0xd1, 0xaf, 0x62, 0xb2, 0x9c, 0x51, 0xd3,
+	0x29, 0x2f, 0x41, 0x27, 0x6b, 0x7f, 0x9a, 0x09, 0x60, 0xfc, 0x18, 0x63, 0x38, 0xad, 0xb9, 0x06,
+	0x33, 0xa6, 0x36, 0xb9, 0xb1, 0x8d, 0x76, 0x6e, 0xef, 0xf7, 0x88, 0x5f, 0x09, 0xd2, 0xae, 0x04,
+	0x39, 0x6a, 0x57, 0x62, 0x70, 0xeb, 0xe2, 0x53, 0xbf, 0x73, 0xfe, 0xb9, 0x8f, 0x8a, 0x28, 0xcc,
+	0x1d, 0xd8, 0x6c, 0x84, 0xb7, 0x7e, 0x7d, 0x8c, 0xa1, 0x43, 0xae, 0x76, 0x65, 0x6f, 0xf0, 0x1d,
+	0x27, 0xfa, 0xa4, 0x91, 0xcc, 0x8c, 0x80, 0x9f, 0x01, 0x8b, 0xb7, 0x30, 0x36, 0xc0, 0xcf, 0x1a,
+	0x0d, 0x63, 0xce, 0x9c, 0xd4, 0x7a, 0x11, 0x85, 0xce, 0xb3, 0x1f, 0x6c, 0xd6, 0xfe, 0xf6, 0xd2,
+	0x36, 0xf1, 0x06, 0x15, 0xaa, 0x91, 0xfe, 0xf0, 0x51, 0x11, 0xaa, 0xec, 0x1d, 0x0a, 0x8b, 0xea,
+	0xad, 0x5d, 0x8a, 0x02, 0x4e, 0x80, 0x9a, 0xff, 0x93, 0xe3, 0x11, 0x8e, 0x34, 0x94, 0xbc, 0xe6,
+	0xd0, 0x46, 0xf9, 0xcd, 0xd4, 0x77, 0x74, 0x25, 0xff, 0xfa, 0x6a, 0xfe, 0xc1, 0xd3, 0x8b, 0x79,
+	0x8a, 0x2e, 0xe7, 0x29, 0xfa, 0x32, 0x4f, 0xd1, 0xf9, 0x22, 0xed, 0x5c, 0x2e, 0xd2, 0xce, 0xc7,
+	0x45, 0xda, 0x79, 0x49, 0x2a, 0x6e, 0x5f, 0x35, 0x13, 0x52, 0x2a, 0xb1, 0xfc, 0xe8, 0x67, 0x20,
+	0xa9, 0x2c, 0x61, 0x97, 0xab, 0x95, 0x2a, 0x3f, 0xfd, 0xf6, 0xff, 0x99, 0x6c, 0xb8, 0x35, 0x78,
+	0xf8, 0x75, 0x00, 0xe5, 0x8b, 0xeb, 0x3a, 0x99, 0x04, 0x00, 0x00,
 }
 
 func (m *EventAddressSanctioned) Marshal() (dAtA []byte, err error) {
@@ -415,85 +684,705 @@ func (m *EventParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventEmergencySanction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventAddressSanctioned) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventEmergencySanction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEmergencySanction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err1 != nil {
+		return 0, err1
 	}
-	return n
+	i -= n1
+	i = encodeVarintEvents(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Officer) > 0 {
+		i -= len(m.Officer)
+		copy(dAtA[i:], m.Officer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Officer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAddressUnsanctioned) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventEmergencySanctionExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEmergencySanctionExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEmergencySanctionExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventTempAddressSanctioned) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventFundsSeized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFundsSeized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFundsSeized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SeizureId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SeizureId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventTempAddressUnsanctioned) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSeizedFundsReleased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventSeizedFundsReleased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSeizedFundsReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SeizureId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SeizureId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventAddressSanctioned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAddressUnsanctioned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTempAddressSanctioned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTempAddressUnsanctioned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventEmergencySanction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Officer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventEmergencySanctionExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFundsSeized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SeizureId != 0 {
+		n += 1 + sovEvents(uint64(m.SeizureId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSeizedFundsReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SeizureId != 0 {
+		n += 1 + sovEvents(uint64(m.SeizureId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventAddressSanctioned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAddressSanctioned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAddressSanctioned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAddressUnsanctioned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAddressUnsanctioned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAddressUnsanctioned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTempAddressSanctioned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTempAddressSanctioned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTempAddressSanctioned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTempAddressUnsanctioned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTempAddressUnsanctioned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTempAddressUnsanctioned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventParamsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventParamsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventParamsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventAddressSanctioned) Unmarshal(dAtA []byte) error {
+func (m *EventEmergencySanction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -516,10 +1405,10 @@ func (m *EventAddressSanctioned) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAddressSanctioned: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventEmergencySanction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAddressSanctioned: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventEmergencySanction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -554,6 +1443,71 @@ func (m *EventAddressSanctioned) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Officer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Officer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -575,7 +1529,7 @@ func (m *EventAddressSanctioned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAddressUnsanctioned) Unmarshal(dAtA []byte) error {
+func (m *EventEmergencySanctionExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -598,10 +1552,10 @@ func (m *EventAddressUnsanctioned) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAddressUnsanctioned: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventEmergencySanctionExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAddressUnsanctioned: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventEmergencySanctionExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -657,7 +1611,7 @@ func (m *EventAddressUnsanctioned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTempAddressSanctioned) Unmarshal(dAtA []byte) error {
+func (m *EventFundsSeized) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -680,13 +1634,32 @@ func (m *EventTempAddressSanctioned) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTempAddressSanctioned: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFundsSeized: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTempAddressSanctioned: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFundsSeized: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeizureId", wireType)
+			}
+			m.SeizureId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeizureId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -718,6 +1691,38 @@ func (m *EventTempAddressSanctioned) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -739,7 +1744,7 @@ func (m *EventTempAddressSanctioned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTempAddressUnsanctioned) Unmarshal(dAtA []byte) error {
+func (m *EventSeizedFundsReleased) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -762,13 +1767,32 @@ func (m *EventTempAddressUnsanctioned) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTempAddressUnsanctioned: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSeizedFundsReleased: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTempAddressUnsanctioned: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSeizedFundsReleased: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeizureId", wireType)
+			}
+			m.SeizureId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeizureId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -800,56 +1824,70 @@ func (m *EventTempAddressUnsanctioned) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventParamsUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventParamsUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -19,6 +19,8 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	AppendSendRestriction(restriction banktypes.SendRestrictionFn)
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 // GovKeeper defines the gov functionality needed from within the sanction module.
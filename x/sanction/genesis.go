@@ -40,5 +40,51 @@ func (g GenesisState) Validate() error {
 			return sdkerrors.ErrInvalidAddress.Wrapf("temporary entries[%d], %q: %v", i, entry.Address, err)
 		}
 	}
+	for i, entry := range g.EmergencySanctions {
+		_, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("emergency sanctions[%d], %q: %v", i, entry.Address, err)
+		}
+		_, err = sdk.AccAddressFromBech32(entry.Officer)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("emergency sanctions[%d] officer, %q: %v", i, entry.Officer, err)
+		}
+		if entry.ExpiresAt.IsZero() {
+			return sdkerrors.ErrInvalidRequest.Wrapf("emergency sanctions[%d]: expires at cannot be zero", i)
+		}
+	}
+	seizureIDs := make(map[uint64]bool, len(g.Seizures))
+	for i, seizure := range g.Seizures {
+		if seizure.Id == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("seizures[%d]: id cannot be zero", i)
+		}
+		if seizureIDs[seizure.Id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("seizures[%d]: duplicate id %d", i, seizure.Id)
+		}
+		seizureIDs[seizure.Id] = true
+		if g.NextSeizureId != 0 && seizure.Id >= g.NextSeizureId {
+			return sdkerrors.ErrInvalidRequest.Wrapf("seizures[%d]: id %d is not less than the next seizure id %d",
+				i, seizure.Id, g.NextSeizureId)
+		}
+		_, err := sdk.AccAddressFromBech32(seizure.Address)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("seizures[%d], %q: %v", i, seizure.Address, err)
+		}
+		if err = seizure.Amount.Validate(); err != nil {
+			return sdkerrors.ErrInvalidCoins.Wrapf("seizures[%d] amount: %v", i, err)
+		}
+		switch seizure.Status {
+		case SEIZURE_STATUS_HELD:
+			if len(seizure.Recipient) > 0 {
+				return sdkerrors.ErrInvalidRequest.Wrapf("seizures[%d]: recipient must be empty while funds are held", i)
+			}
+		case SEIZURE_STATUS_RELEASED, SEIZURE_STATUS_RETURNED:
+			if _, err = sdk.AccAddressFromBech32(seizure.Recipient); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("seizures[%d] recipient, %q: %v", i, seizure.Recipient, err)
+			}
+		default:
+			return sdkerrors.ErrInvalidRequest.Wrapf("seizures[%d]: invalid status %s", i, seizure.Status)
+		}
+	}
 	return nil
 }
@@ -31,6 +31,12 @@ type GenesisState struct {
 	SanctionedAddresses []string `protobuf:"bytes,2,rep,name=sanctioned_addresses,json=sanctionedAddresses,proto3" json:"sanctioned_addresses,omitempty"`
 	// temporary_entries defines the temporary entries associated with on-going governance proposals.
 	TemporaryEntries []*TemporaryEntry `protobuf:"bytes,3,rep,name=temporary_entries,json=temporaryEntries,proto3" json:"temporary_entries,omitempty"`
+	// emergency_sanctions defines the emergency sanctions issued by compliance officers that have not yet expired.
+	EmergencySanctions []*EmergencySanction `protobuf:"bytes,4,rep,name=emergency_sanctions,json=emergencySanctions,proto3" json:"emergency_sanctions,omitempty"`
+	// seizures defines the records of funds seized from sanctioned addresses.
+	Seizures []*Seizure `protobuf:"bytes,5,rep,name=seizures,proto3" json:"seizures,omitempty"`
+	// next_seizure_id is the id to use for the next seizure.
+	NextSeizureId uint64 `protobuf:"varint,6,opt,name=next_seizure_id,json=nextSeizureId,proto3" json:"next_seizure_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +93,27 @@ func (m *GenesisState) GetTemporaryEntries() []*TemporaryEntry {
 	return nil
 }
 
+func (m *GenesisState) GetEmergencySanctions() []*EmergencySanction {
+	if m != nil {
+		return m.EmergencySanctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSeizures() []*Seizure {
+	if m != nil {
+		return m.Seizures
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextSeizureId() uint64 {
+	if m != nil {
+		return m.NextSeizureId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.sanction.v1beta1.GenesisState")
 }
@@ -96,26 +123,31 @@ func init() {
 }
 
 var fileDescriptor_78e0ba43b92003f6 = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xbd, 0x4e, 0xf3, 0x30,
-	0x18, 0x85, 0xe3, 0x2f, 0x52, 0xa5, 0x2f, 0x65, 0x80, 0x50, 0x89, 0xd0, 0xc1, 0x44, 0x48, 0x40,
-	0x96, 0xda, 0x6a, 0x19, 0x98, 0x5b, 0x09, 0x81, 0xc4, 0x82, 0xd2, 0x4e, 0x2c, 0x91, 0x93, 0xbc,
-	0x0a, 0x1e, 0x62, 0x47, 0xb6, 0xa9, 0xe8, 0x5d, 0x70, 0x31, 0x5c, 0x04, 0x63, 0xc5, 0xc4, 0x88,
-	0x92, 0x8d, 0xab, 0x40, 0xca, 0x4f, 0xe9, 0x92, 0xf1, 0xc8, 0xcf, 0x73, 0x5e, 0xeb, 0x38, 0x17,
-	0x89, 0xd4, 0xb9, 0xd4, 0x54, 0x33, 0x91, 0x18, 0x2e, 0x05, 0x5d, 0x4f, 0x63, 0x30, 0x6c, 0x4a,
-	0x33, 0x10, 0xa0, 0xb9, 0x26, 0x85, 0x92, 0x46, 0xba, 0x27, 0x0d, 0x46, 0x3a, 0x8c, 0xb4, 0xd8,
-	0xf8, 0xb2, 0xcf, 0xdf, 0x91, 0x75, 0xc1, 0xf8, 0xb4, 0xe1, 0xa2, 0x3a, 0xd1, 0xb6, 0xad, 0x0e,
-	0xe7, 0x3f, 0xc8, 0x39, 0xb8, 0x6b, 0xae, 0x2d, 0x0d, 0x33, 0xe0, 0xde, 0x38, 0x83, 0x82, 0x29,
-	0x96, 0x6b, 0x0f, 0xf9, 0x28, 0x18, 0xce, 0xce, 0x48, 0xcf, 0x75, 0xf2, 0x58, 0x63, 0x61, 0x8b,
-	0xbb, 0x0f, 0xce, 0xa8, 0x43, 0x20, 0x8d, 0x58, 0x9a, 0x2a, 0xd0, 0x1a, 0xb4, 0xf7, 0xcf, 0xb7,
-	0x83, 0xff, 0x0b, 0xef, 0xf3, 0x7d, 0x32, 0x6a, 0x9b, 0xe6, 0xcd, 0xdb, 0xd2, 0x28, 0x2e, 0xb2,
-	0xf0, 0xf8, 0xcf, 0x9a, 0x77, 0x92, 0xbb, 0x72, 0x8e, 0x0c, 0xe4, 0x85, 0x54, 0x4c, 0x6d, 0x22,
-	0x10, 0x46, 0x71, 0xd0, 0x9e, 0xed, 0xdb, 0xc1, 0x70, 0x76, 0xd5, 0xfb, 0xa1, 0x55, 0x67, 0xdc,
-	0x0a, 0xa3, 0x36, 0xe1, 0xa1, 0xd9, 0xcf, 0x1c, 0xf4, 0xe2, 0xfe, 0xa3, 0xc4, 0x68, 0x5b, 0x62,
-	0xf4, 0x5d, 0x62, 0xf4, 0x56, 0x61, 0x6b, 0x5b, 0x61, 0xeb, 0xab, 0xc2, 0xd6, 0x13, 0xc9, 0xb8,
-	0x79, 0x7e, 0x89, 0x49, 0x22, 0x73, 0x5a, 0x28, 0xb9, 0x06, 0xc1, 0x44, 0x02, 0x13, 0x2e, 0xf7,
-	0x12, 0x7d, 0xdd, 0xed, 0x1a, 0x0f, 0xea, 0xf5, 0xae, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3e,
-	0x07, 0x52, 0x36, 0xc2, 0x01, 0x00, 0x00,
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbd, 0x4e, 0xe3, 0x40,
+	0x14, 0x85, 0xe3, 0x75, 0x36, 0xda, 0x9d, 0xec, 0x6a, 0x77, 0x27, 0x91, 0xd6, 0xa4, 0x30, 0x16,
+	0x12, 0xc1, 0x42, 0x8a, 0xad, 0x84, 0x82, 0x86, 0x26, 0x91, 0x22, 0x40, 0x34, 0xc8, 0x4e, 0x05,
+	0x85, 0x35, 0xb1, 0xaf, 0xcc, 0x14, 0x9e, 0xb1, 0x66, 0x26, 0x51, 0xc2, 0x53, 0xf0, 0x22, 0x74,
+	0x3c, 0x04, 0x65, 0x44, 0x45, 0x89, 0x92, 0x17, 0x41, 0xf8, 0x27, 0x44, 0x48, 0x2e, 0xcf, 0x9d,
+	0xef, 0x7c, 0x77, 0x8a, 0x8b, 0x0e, 0x43, 0x2e, 0x13, 0x2e, 0x5d, 0x49, 0x58, 0xa8, 0x28, 0x67,
+	0xee, 0xbc, 0x3f, 0x05, 0x45, 0xfa, 0x6e, 0x0c, 0x0c, 0x24, 0x95, 0x4e, 0x2a, 0xb8, 0xe2, 0xf8,
+	0x7f, 0x8e, 0x39, 0x25, 0xe6, 0x14, 0x58, 0xa7, 0x5b, 0xd5, 0xdf, 0x92, 0x99, 0xa0, 0xb3, 0x97,
+	0x73, 0x41, 0x96, 0xdc, 0xc2, 0x96, 0x85, 0x83, 0x47, 0x1d, 0xfd, 0x3a, 0xcf, 0xb7, 0xf9, 0x8a,
+	0x28, 0xc0, 0xa7, 0xa8, 0x91, 0x12, 0x41, 0x12, 0x69, 0x68, 0x96, 0x66, 0x37, 0x07, 0xfb, 0x4e,
+	0xc5, 0x76, 0xe7, 0x3a, 0xc3, 0xbc, 0x02, 0xc7, 0x57, 0xa8, 0x5d, 0x22, 0x10, 0x05, 0x24, 0x8a,
+	0x04, 0x48, 0x09, 0xd2, 0xf8, 0x66, 0xe9, 0xf6, 0xcf, 0x91, 0xf1, 0xf2, 0xd4, 0x6b, 0x17, 0xa6,
+	0x61, 0xfe, 0xe6, 0x2b, 0x41, 0x59, 0xec, 0xb5, 0x3e, 0x5b, 0xc3, 0xb2, 0x84, 0x27, 0xe8, 0x9f,
+	0x82, 0x24, 0xe5, 0x82, 0x88, 0x65, 0x00, 0x4c, 0x09, 0x0a, 0xd2, 0xd0, 0x2d, 0xdd, 0x6e, 0x0e,
+	0x8e, 0x2a, 0x3f, 0x34, 0x29, 0x1b, 0x63, 0xa6, 0xc4, 0xd2, 0xfb, 0xab, 0x76, 0x33, 0x05, 0x89,
+	0x6f, 0x51, 0x0b, 0x12, 0x10, 0x31, 0xb0, 0x70, 0x19, 0x94, 0x75, 0x69, 0xd4, 0x33, 0xef, 0x71,
+	0xa5, 0x77, 0x5c, 0x76, 0xfc, 0xe2, 0xc5, 0xc3, 0xf0, 0x75, 0x24, 0xf1, 0x19, 0xfa, 0x21, 0x81,
+	0xde, 0xcf, 0x04, 0x48, 0xe3, 0x7b, 0x66, 0xb4, 0x2a, 0x8d, 0x7e, 0x0e, 0x7a, 0xdb, 0x06, 0xee,
+	0xa2, 0x3f, 0x0c, 0x16, 0x2a, 0x28, 0x06, 0x01, 0x8d, 0x8c, 0x86, 0xa5, 0xd9, 0x75, 0xef, 0xf7,
+	0xc7, 0xb8, 0xe0, 0x2f, 0xa3, 0xd1, 0xc5, 0xf3, 0xda, 0xd4, 0x56, 0x6b, 0x53, 0x7b, 0x5b, 0x9b,
+	0xda, 0xc3, 0xc6, 0xac, 0xad, 0x36, 0x66, 0xed, 0x75, 0x63, 0xd6, 0x6e, 0x9c, 0x98, 0xaa, 0xbb,
+	0xd9, 0xd4, 0x09, 0x79, 0xe2, 0xa6, 0x82, 0xcf, 0x81, 0x11, 0x16, 0x42, 0x8f, 0xf2, 0x9d, 0xe4,
+	0x2e, 0xb6, 0xa7, 0x31, 0x6d, 0x64, 0x07, 0x70, 0xf2, 0x3e, 0x00, 0x7f, 0xd5, 0xb7, 0xcb, 0x85,
+	0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +170,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextSeizureId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSeizureId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Seizures) > 0 {
+		for iNdEx := len(m.Seizures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Seizures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.EmergencySanctions) > 0 {
+		for iNdEx := len(m.EmergencySanctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmergencySanctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.TemporaryEntries) > 0 {
 		for iNdEx := len(m.TemporaryEntries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -209,6 +274,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.EmergencySanctions) > 0 {
+		for _, e := range m.EmergencySanctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Seizures) > 0 {
+		for _, e := range m.Seizures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextSeizureId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextSeizureId))
+	}
 	return n
 }
 
@@ -349,6 +429,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencySanctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencySanctions = append(m.EmergencySanctions, &EmergencySanction{})
+			if err := m.EmergencySanctions[len(m.EmergencySanctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seizures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seizures = append(m.Seizures, &Seizure{})
+			if err := m.Seizures[len(m.Seizures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSeizureId", wireType)
+			}
+			m.NextSeizureId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSeizureId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -531,6 +531,129 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			exp: []string{"temporary entries[4]", `"Woops. This isn't right."`, "invalid address", "decoding bech32 failed"},
 		},
+		{
+			name: "seizures ok",
+			gs: &sanction.GenesisState{
+				Seizures: []*sanction.Seizure{
+					{Id: 1, Address: sdk.AccAddress("seizedaddr0_________").String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("scoin", 1)), Status: sanction.SEIZURE_STATUS_HELD},
+					{Id: 3, Address: sdk.AccAddress("seizedaddr1_________").String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("scoin", 2)), Status: sanction.SEIZURE_STATUS_HELD},
+				},
+				NextSeizureId: 4,
+			},
+			exp: nil,
+		},
+		{
+			name: "seizure id zero",
+			gs: &sanction.GenesisState{
+				Seizures: []*sanction.Seizure{
+					{Id: 0, Address: sdk.AccAddress("seizedaddr0_________").String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("scoin", 1)), Status: sanction.SEIZURE_STATUS_HELD},
+				},
+			},
+			exp: []string{"seizures[0]: id cannot be zero"},
+		},
+		{
+			name: "duplicate seizure id",
+			gs: &sanction.GenesisState{
+				Seizures: []*sanction.Seizure{
+					{Id: 2, Address: sdk.AccAddress("seizedaddr0_________").String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("scoin", 1)), Status: sanction.SEIZURE_STATUS_HELD},
+					{Id: 2, Address: sdk.AccAddress("seizedaddr1_________").String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("scoin", 2)), Status: sanction.SEIZURE_STATUS_HELD},
+				},
+			},
+			exp: []string{"seizures[1]: duplicate id 2"},
+		},
+		{
+			name: "seizure id not less than next id",
+			gs: &sanction.GenesisState{
+				Seizures: []*sanction.Seizure{
+					{Id: 1, Address: sdk.AccAddress("seizedaddr0_________").String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("scoin", 1)), Status: sanction.SEIZURE_STATUS_HELD},
+					{Id: 4, Address: sdk.AccAddress("seizedaddr1_________").String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("scoin", 2)), Status: sanction.SEIZURE_STATUS_HELD},
+				},
+				NextSeizureId: 4,
+			},
+			exp: []string{"seizures[1]: id 4 is not less than the next seizure id 4"},
+		},
+		{
+			name: "seizure bad address",
+			gs: &sanction.GenesisState{
+				Seizures: []*sanction.Seizure{
+					{Id: 1, Address: "not1avalidaddr", Amount: sdk.NewCoins(sdk.NewInt64Coin("scoin", 1)), Status: sanction.SEIZURE_STATUS_HELD},
+				},
+			},
+			exp: []string{"invalid address", "seizures[0]", `"not1avalidaddr"`, "decoding bech32 failed"},
+		},
+		{
+			name: "seizure bad amount",
+			gs: &sanction.GenesisState{
+				Seizures: []*sanction.Seizure{
+					{
+						Id:      1,
+						Address: sdk.AccAddress("seizedaddr0_________").String(),
+						Amount:  sdk.Coins{sdk.NewInt64Coin("scoin", 1), sdk.NewInt64Coin("scoin", 2)},
+					},
+				},
+			},
+			exp: []string{"invalid coins", "seizures[0] amount", "duplicate denomination scoin"},
+		},
+		{
+			name: "seizure released and returned",
+			gs: &sanction.GenesisState{
+				Seizures: []*sanction.Seizure{
+					{
+						Id:        1,
+						Address:   sdk.AccAddress("seizedaddr0_________").String(),
+						Amount:    sdk.NewCoins(sdk.NewInt64Coin("scoin", 1)),
+						Status:    sanction.SEIZURE_STATUS_RELEASED,
+						Recipient: sdk.AccAddress("recipient___________").String(),
+					},
+					{
+						Id:        2,
+						Address:   sdk.AccAddress("seizedaddr0_________").String(),
+						Amount:    sdk.NewCoins(sdk.NewInt64Coin("scoin", 1)),
+						Status:    sanction.SEIZURE_STATUS_RETURNED,
+						Recipient: sdk.AccAddress("seizedaddr0_________").String(),
+					},
+				},
+			},
+			exp: nil,
+		},
+		{
+			name: "seizure unspecified status",
+			gs: &sanction.GenesisState{
+				Seizures: []*sanction.Seizure{
+					{Id: 1, Address: sdk.AccAddress("seizedaddr0_________").String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("scoin", 1))},
+				},
+			},
+			exp: []string{"seizures[0]: invalid status SEIZURE_STATUS_UNSPECIFIED"},
+		},
+		{
+			name: "seizure held with recipient",
+			gs: &sanction.GenesisState{
+				Seizures: []*sanction.Seizure{
+					{
+						Id:        1,
+						Address:   sdk.AccAddress("seizedaddr0_________").String(),
+						Amount:    sdk.NewCoins(sdk.NewInt64Coin("scoin", 1)),
+						Status:    sanction.SEIZURE_STATUS_HELD,
+						Recipient: sdk.AccAddress("recipient___________").String(),
+					},
+				},
+			},
+			exp: []string{"seizures[0]: recipient must be empty while funds are held"},
+		},
+		{
+			name: "seizure released without recipient",
+			gs: &sanction.GenesisState{
+				Seizures: []*sanction.Seizure{
+					{
+						Id:      1,
+						Address: sdk.AccAddress("seizedaddr0_________").String(),
+						Amount:  sdk.NewCoins(sdk.NewInt64Coin("scoin", 1)),
+						Status:  sanction.SEIZURE_STATUS_RELEASED,
+					},
+				},
+			},
+			exp: []string{"invalid address", "seizures[0] recipient", `""`, "empty address string is not allowed"},
+		},
 	}
 
 	for _, tc := range tests {
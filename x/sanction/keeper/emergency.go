@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/sanction"
+	"github.com/provenance-io/provenance/x/sanction/errors"
+)
+
+// EmergencySanctionAddresses creates emergency sanctions, issued by the provided compliance officer, for each address.
+// An emergency sanction is a temporary sanction (using EmergencyPropID) that expires after the
+// emergency sanction duration unless it is ratified by a governance proposal before then.
+// If an address already has an emergency sanction, it is replaced (restarting the expiration clock).
+func (k Keeper) EmergencySanctionAddresses(ctx sdk.Context, officer sdk.AccAddress, addrs ...sdk.AccAddress) error {
+	if !k.IsComplianceOfficer(ctx, officer.String()) {
+		return errors.ErrNotComplianceOfficer.Wrap(officer.String())
+	}
+	dur := k.GetEmergencySanctionDuration(ctx)
+	if dur <= 0 {
+		return errors.ErrEmergencySanctionsDisabled.Wrapf("emergency sanction duration is %s", dur)
+	}
+
+	expiresAt := ctx.BlockTime().Add(dur).UTC()
+	store := ctx.KVStore(k.storeKey)
+	for _, addr := range addrs {
+		if k.IsAddrThatCannotBeSanctioned(addr) {
+			return errors.ErrUnsanctionableAddr.Wrap(addr.String())
+		}
+		entry := &sanction.EmergencySanction{
+			Address:   addr.String(),
+			Officer:   officer.String(),
+			ExpiresAt: expiresAt,
+		}
+		if err := k.setEmergencySanction(store, entry); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(sanction.NewEventEmergencySanction(addr, officer, expiresAt)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setEmergencySanction writes the temporary entry, emergency sanction record, and expiration index entry for
+// the provided emergency sanction. Any previous emergency sanction record for the address is replaced.
+func (k Keeper) setEmergencySanction(store storetypes.KVStore, entry *sanction.EmergencySanction) error {
+	addr, err := sdk.AccAddressFromBech32(entry.Address)
+	if err != nil {
+		return fmt.Errorf("invalid emergency sanction address %q: %w", entry.Address, err)
+	}
+	bz, err := k.cdc.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	k.deleteEmergencySanction(store, addr)
+
+	val := []byte{SanctionB}
+	store.Set(CreateTemporaryKey(addr, EmergencyPropID), val)
+	store.Set(CreateProposalTempIndexKey(EmergencyPropID, addr), val)
+	store.Set(CreateEmergencySanctionKey(addr), bz)
+	store.Set(CreateEmergencyExpirationKey(entry.ExpiresAt, addr), []byte{})
+	return nil
+}
+
+// getEmergencySanction gets the emergency sanction record for the provided address, or nil if there isn't one.
+func (k Keeper) getEmergencySanction(store storetypes.KVStore, addr sdk.AccAddress) *sanction.EmergencySanction {
+	bz := store.Get(CreateEmergencySanctionKey(addr))
+	if len(bz) == 0 {
+		return nil
+	}
+	var rv sanction.EmergencySanction
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil
+	}
+	return &rv
+}
+
+// GetEmergencySanction gets the emergency sanction for the provided address, or nil if there isn't one.
+func (k Keeper) GetEmergencySanction(ctx sdk.Context, addr sdk.AccAddress) *sanction.EmergencySanction {
+	return k.getEmergencySanction(ctx.KVStore(k.storeKey), addr)
+}
+
+// deleteEmergencySanction deletes the temporary entry, emergency sanction record, and expiration index entry
+// for the provided address. Returns true if there was an emergency sanction record to delete.
+func (k Keeper) deleteEmergencySanction(store storetypes.KVStore, addr sdk.AccAddress) bool {
+	store.Delete(CreateTemporaryKey(addr, EmergencyPropID))
+	store.Delete(CreateProposalTempIndexKey(EmergencyPropID, addr))
+	existing := k.getEmergencySanction(store, addr)
+	if existing == nil {
+		return false
+	}
+	store.Delete(CreateEmergencyExpirationKey(existing.ExpiresAt, addr))
+	store.Delete(CreateEmergencySanctionKey(addr))
+	return true
+}
+
+// DeleteExpiredEmergencySanctions removes all emergency sanctions that have expired as of the block time.
+// Emergency sanctions that were ratified by governance will have already been removed when the permanent
+// sanction was enacted, so anything found here did not get ratified in time.
+func (k Keeper) DeleteExpiredEmergencySanctions(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var addrs []sdk.AccAddress
+	k.iterateExpiredEmergencySanctions(store, ctx.BlockTime(), func(addr sdk.AccAddress) bool {
+		addrs = append(addrs, addr)
+		return false
+	})
+
+	for _, addr := range addrs {
+		if !k.deleteEmergencySanction(store, addr) {
+			continue
+		}
+		if err := ctx.EventManager().EmitTypedEvent(sanction.NewEventEmergencySanctionExpired(addr)); err != nil {
+			ctx.Logger().Error("failed to emit emergency sanction expired event", "address", addr.String(), "error", err)
+		}
+	}
+}
+
+// iterateExpiredEmergencySanctions iterates over the expiration index entries that expire at or before the provided time.
+// The callback takes in the address and should return whether to stop iteration (true = stop, false = keep going).
+func (k Keeper) iterateExpiredEmergencySanctions(store storetypes.KVStore, asOf time.Time, cb func(addr sdk.AccAddress) (stop bool)) {
+	end := storetypes.PrefixEndBytes(CreateEmergencyExpirationPrefix(asOf))
+	iter := store.Iterator(EmergencyExpirationPrefix, end)
+	defer iter.Close() //nolint:errcheck // ignoring close error on iterator: not critical for this context.
+
+	for ; iter.Valid(); iter.Next() {
+		_, addr, err := ParseEmergencyExpirationKey(iter.Key())
+		if err != nil {
+			continue
+		}
+		if cb(addr) {
+			break
+		}
+	}
+}
+
+// getEmergencySanctionPrefixStore returns a kv store prefixed for emergency sanction records, and the prefix bytes used.
+// If an addr is provided, the store is prefixed for just the given address.
+func (k Keeper) getEmergencySanctionPrefixStore(ctx sdk.Context, addr sdk.AccAddress) (storetypes.KVStore, []byte) {
+	pre := EmergencySanctionPrefix
+	if len(addr) > 0 {
+		pre = CreateEmergencySanctionKey(addr)
+	}
+	return prefix.NewStore(ctx.KVStore(k.storeKey), pre), pre
+}
+
+// IterateEmergencySanctions iterates over all of the emergency sanction records.
+// The callback takes in the emergency sanction and should return whether to stop iteration (true = stop, false = keep going).
+func (k Keeper) IterateEmergencySanctions(ctx sdk.Context, cb func(entry *sanction.EmergencySanction) (stop bool)) {
+	store, _ := k.getEmergencySanctionPrefixStore(ctx, nil)
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close() //nolint:errcheck // ignoring close error on iterator: not critical for this context.
+
+	for ; iter.Valid(); iter.Next() {
+		var entry sanction.EmergencySanction
+		if err := k.cdc.Unmarshal(iter.Value(), &entry); err != nil {
+			continue
+		}
+		if cb(&entry) {
+			break
+		}
+	}
+}
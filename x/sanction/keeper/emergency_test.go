@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/sanction"
+	"github.com/provenance-io/provenance/x/sanction/keeper"
+)
+
+type EmergencyTestSuite struct {
+	BaseTestSuite
+
+	officer sdk.AccAddress
+	addr1   sdk.AccAddress
+	addr2   sdk.AccAddress
+}
+
+func (s *EmergencyTestSuite) SetupTest() {
+	s.BaseSetup()
+
+	s.officer = sdk.AccAddress("compliance_officer__")
+	s.addr1 = sdk.AccAddress("1_addr_emergency____")
+	s.addr2 = sdk.AccAddress("2_addr_emergency____")
+}
+
+func TestEmergencyTestSuite(t *testing.T) {
+	suite.Run(t, new(EmergencyTestSuite))
+}
+
+// setOfficerParams sets the params so that s.officer is a compliance officer with the provided duration.
+func (s *EmergencyTestSuite) setOfficerParams(dur time.Duration) {
+	s.T().Helper()
+	s.ReqOKSetParams(&sanction.Params{
+		ComplianceOfficers:        []string{s.officer.String()},
+		EmergencySanctionDuration: dur,
+	})
+}
+
+func (s *EmergencyTestSuite) TestEmergencySanctionAddresses() {
+	s.Run("not a compliance officer", func() {
+		s.ClearState()
+		s.setOfficerParams(time.Hour)
+		err := s.Keeper.EmergencySanctionAddresses(s.SdkCtx, s.addr2, s.addr1)
+		s.AssertErrorContents(err, []string{"address is not a compliance officer", s.addr2.String()}, "EmergencySanctionAddresses")
+		s.Assert().False(s.Keeper.IsSanctionedAddr(s.SdkCtx, s.addr1), "IsSanctionedAddr(addr1)")
+	})
+
+	s.Run("zero duration", func() {
+		s.ClearState()
+		s.setOfficerParams(0)
+		err := s.Keeper.EmergencySanctionAddresses(s.SdkCtx, s.officer, s.addr1)
+		s.AssertErrorContents(err, []string{"emergency sanctions are not available", "duration is 0s"}, "EmergencySanctionAddresses")
+		s.Assert().False(s.Keeper.IsSanctionedAddr(s.SdkCtx, s.addr1), "IsSanctionedAddr(addr1)")
+	})
+
+	s.Run("unsanctionable address", func() {
+		s.ClearState()
+		s.setOfficerParams(time.Hour)
+		k := s.Keeper.WithUnsanctionableAddrs(map[string]bool{string(s.addr2): true})
+		err := k.EmergencySanctionAddresses(s.SdkCtx, s.officer, s.addr2)
+		s.AssertErrorContents(err, []string{"address cannot be sanctioned", s.addr2.String()}, "EmergencySanctionAddresses")
+	})
+
+	s.Run("two addresses", func() {
+		s.ClearState()
+		s.setOfficerParams(time.Hour)
+		em := sdk.NewEventManager()
+		ctx := s.SdkCtx.WithEventManager(em)
+		s.RequireNotPanicsNoError(func() error {
+			return s.Keeper.EmergencySanctionAddresses(ctx, s.officer, s.addr1, s.addr2)
+		}, "EmergencySanctionAddresses")
+
+		expiresAt := s.BlockTime.Add(time.Hour).UTC()
+		var expEvents sdk.Events
+		for _, addr := range []sdk.AccAddress{s.addr1, s.addr2} {
+			s.Assert().True(s.Keeper.IsSanctionedAddr(s.SdkCtx, addr), "IsSanctionedAddr(%s)", addr)
+			exp := &sanction.EmergencySanction{Address: addr.String(), Officer: s.officer.String(), ExpiresAt: expiresAt}
+			s.Assert().Equal(exp, s.Keeper.GetEmergencySanction(s.SdkCtx, addr), "GetEmergencySanction(%s)", addr)
+			event, err := sdk.TypedEventToEvent(sanction.NewEventEmergencySanction(addr, s.officer, expiresAt))
+			s.Require().NoError(err, "TypedEventToEvent NewEventEmergencySanction")
+			expEvents = append(expEvents, event)
+		}
+		s.Assert().Equal(expEvents, em.Events(), "events emitted")
+
+		expTemp := []*sanction.TemporaryEntry{
+			newTempEntry(s.addr1, keeper.EmergencyPropID, true),
+			newTempEntry(s.addr2, keeper.EmergencyPropID, true),
+		}
+		s.Assert().Equal(expTemp, s.GetAllTempEntries(), "temporary entries")
+	})
+}
+
+func (s *EmergencyTestSuite) TestDeleteExpiredEmergencySanctions() {
+	s.ClearState()
+	s.setOfficerParams(time.Hour)
+	s.RequireNotPanicsNoError(func() error {
+		return s.Keeper.EmergencySanctionAddresses(s.SdkCtx, s.officer, s.addr1)
+	}, "EmergencySanctionAddresses(addr1)")
+	ctx2 := s.SdkCtx.WithBlockTime(s.BlockTime.Add(30 * time.Minute))
+	s.RequireNotPanicsNoError(func() error {
+		return s.Keeper.EmergencySanctionAddresses(ctx2, s.officer, s.addr2)
+	}, "EmergencySanctionAddresses(addr2)")
+
+	s.Run("before any expire", func() {
+		ctx := s.SdkCtx.WithBlockTime(s.BlockTime.Add(59 * time.Minute)).WithEventManager(sdk.NewEventManager())
+		s.Require().NotPanics(func() {
+			s.Keeper.DeleteExpiredEmergencySanctions(ctx)
+		}, "DeleteExpiredEmergencySanctions")
+		s.Assert().Empty(ctx.EventManager().Events(), "events emitted")
+		s.Assert().True(s.Keeper.IsSanctionedAddr(s.SdkCtx, s.addr1), "IsSanctionedAddr(addr1)")
+		s.Assert().True(s.Keeper.IsSanctionedAddr(s.SdkCtx, s.addr2), "IsSanctionedAddr(addr2)")
+	})
+
+	s.Run("first one expires", func() {
+		ctx := s.SdkCtx.WithBlockTime(s.BlockTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+		s.Require().NotPanics(func() {
+			s.Keeper.DeleteExpiredEmergencySanctions(ctx)
+		}, "DeleteExpiredEmergencySanctions")
+		event, err := sdk.TypedEventToEvent(sanction.NewEventEmergencySanctionExpired(s.addr1))
+		s.Require().NoError(err, "TypedEventToEvent NewEventEmergencySanctionExpired")
+		s.Assert().Equal(sdk.Events{event}, ctx.EventManager().Events(), "events emitted")
+		s.Assert().False(s.Keeper.IsSanctionedAddr(s.SdkCtx, s.addr1), "IsSanctionedAddr(addr1)")
+		s.Assert().Nil(s.Keeper.GetEmergencySanction(s.SdkCtx, s.addr1), "GetEmergencySanction(addr1)")
+		s.Assert().True(s.Keeper.IsSanctionedAddr(s.SdkCtx, s.addr2), "IsSanctionedAddr(addr2)")
+	})
+
+	s.Run("second one expires", func() {
+		ctx := s.SdkCtx.WithBlockTime(s.BlockTime.Add(2 * time.Hour)).WithEventManager(sdk.NewEventManager())
+		s.Require().NotPanics(func() {
+			s.Keeper.DeleteExpiredEmergencySanctions(ctx)
+		}, "DeleteExpiredEmergencySanctions")
+		event, err := sdk.TypedEventToEvent(sanction.NewEventEmergencySanctionExpired(s.addr2))
+		s.Require().NoError(err, "TypedEventToEvent NewEventEmergencySanctionExpired")
+		s.Assert().Equal(sdk.Events{event}, ctx.EventManager().Events(), "events emitted")
+		s.Assert().False(s.Keeper.IsSanctionedAddr(s.SdkCtx, s.addr2), "IsSanctionedAddr(addr2)")
+		s.Assert().Empty(s.GetAllTempEntries(), "temporary entries")
+		s.Assert().Empty(s.Keeper.GetAllEmergencySanctions(s.SdkCtx), "GetAllEmergencySanctions")
+	})
+}
+
+func (s *EmergencyTestSuite) TestEmergencySanctionRatified() {
+	s.ClearState()
+	s.setOfficerParams(time.Hour)
+	s.RequireNotPanicsNoError(func() error {
+		return s.Keeper.EmergencySanctionAddresses(s.SdkCtx, s.officer, s.addr1)
+	}, "EmergencySanctionAddresses(addr1)")
+
+	// This is what happens when a governance proposal to sanction the address passes.
+	s.ReqOKAddPermSanct("addr1", s.addr1)
+	s.ReqOKDelAddrTemp("addr1", s.addr1)
+
+	s.Assert().Nil(s.Keeper.GetEmergencySanction(s.SdkCtx, s.addr1), "GetEmergencySanction(addr1)")
+	s.Assert().Empty(s.GetAllTempEntries(), "temporary entries")
+
+	ctx := s.SdkCtx.WithBlockTime(s.BlockTime.Add(2 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	s.Require().NotPanics(func() {
+		s.Keeper.DeleteExpiredEmergencySanctions(ctx)
+	}, "DeleteExpiredEmergencySanctions")
+	s.Assert().Empty(ctx.EventManager().Events(), "events emitted")
+	s.Assert().True(s.Keeper.IsSanctionedAddr(s.SdkCtx, s.addr1), "IsSanctionedAddr(addr1)")
+}
@@ -51,6 +51,26 @@ func (k Keeper) InitGenesis(origCtx sdk.Context, genState *sanction.GenesisState
 			panic(fmt.Errorf("invalid temp entry[%d]: invalid status: %s", i, entry.Status))
 		}
 	}
+
+	store := ctx.KVStore(k.storeKey)
+	for i, entry := range genState.EmergencySanctions {
+		if err = k.setEmergencySanction(store, entry); err != nil {
+			panic(fmt.Errorf("error adding emergency sanction[%d]: %w", i, err))
+		}
+	}
+
+	nextSeizureID := genState.NextSeizureId
+	for i, seizure := range genState.Seizures {
+		if err = k.setSeizure(store, seizure); err != nil {
+			panic(fmt.Errorf("error adding seizure[%d]: %w", i, err))
+		}
+		if seizure.Id >= nextSeizureID {
+			nextSeizureID = seizure.Id + 1
+		}
+	}
+	if nextSeizureID > 0 {
+		k.setNextSeizureID(store, nextSeizureID)
+	}
 }
 
 // ExportGenesis reads this keeper's entire state and returns it as a GenesisState.
@@ -58,7 +78,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *sanction.GenesisState {
 	params := k.GetParams(ctx)
 	sanctionedAddrs := k.GetAllSanctionedAddresses(ctx)
 	tempEntries := k.GetAllTemporaryEntries(ctx)
-	return sanction.NewGenesisState(params, sanctionedAddrs, tempEntries)
+	rv := sanction.NewGenesisState(params, sanctionedAddrs, tempEntries)
+	rv.EmergencySanctions = k.GetAllEmergencySanctions(ctx)
+	rv.Seizures = k.GetAllSeizures(ctx)
+	if ctx.KVStore(k.storeKey).Has(NextSeizureIDKey) {
+		rv.NextSeizureId = k.GetNextSeizureID(ctx)
+	}
+	return rv
 }
 
 // GetAllSanctionedAddresses gets the bech32 string of every account that is sanctioned.
@@ -90,3 +116,25 @@ func (k Keeper) GetAllTemporaryEntries(ctx sdk.Context) []*sanction.TemporaryEnt
 	})
 	return rv
 }
+
+// GetAllEmergencySanctions gets all the emergency sanctions.
+// This is designed for use with ExportGenesis. See also IterateEmergencySanctions.
+func (k Keeper) GetAllEmergencySanctions(ctx sdk.Context) []*sanction.EmergencySanction {
+	var rv []*sanction.EmergencySanction
+	k.IterateEmergencySanctions(ctx, func(entry *sanction.EmergencySanction) bool {
+		rv = append(rv, entry)
+		return false
+	})
+	return rv
+}
+
+// GetAllSeizures gets all the seizure records.
+// This is designed for use with ExportGenesis. See also IterateSeizures.
+func (k Keeper) GetAllSeizures(ctx sdk.Context) []*sanction.Seizure {
+	var rv []*sanction.Seizure
+	k.IterateSeizures(ctx, func(seizure *sanction.Seizure) bool {
+		rv = append(rv, seizure)
+		return false
+	})
+	return rv
+}
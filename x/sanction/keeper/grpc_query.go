@@ -6,6 +6,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/store/prefix"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -94,6 +96,90 @@ func (k Keeper) TemporaryEntries(goCtx context.Context, req *sanction.QueryTempo
 	return resp, nil
 }
 
+func (k Keeper) EmergencySanctions(goCtx context.Context, req *sanction.QueryEmergencySanctionsRequest) (*sanction.QueryEmergencySanctionsResponse, error) {
+	var err error
+	var pagination *query.PageRequest
+	var addr sdk.AccAddress
+	if req != nil {
+		pagination = req.Pagination
+		if len(req.Address) > 0 {
+			addr, err = sdk.AccAddressFromBech32(req.Address)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+			}
+		}
+	}
+
+	resp := &sanction.QueryEmergencySanctionsResponse{}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store, _ := k.getEmergencySanctionPrefixStore(ctx, addr)
+	resp.Pagination, err = query.Paginate(
+		store, pagination,
+		func(_, value []byte) error {
+			var entry sanction.EmergencySanction
+			if uErr := k.cdc.Unmarshal(value, &entry); uErr != nil {
+				return uErr
+			}
+			resp.EmergencySanctions = append(resp.EmergencySanctions, &entry)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (k Keeper) Seizures(goCtx context.Context, req *sanction.QuerySeizuresRequest) (*sanction.QuerySeizuresResponse, error) {
+	var err error
+	var pagination *query.PageRequest
+	var addr sdk.AccAddress
+	if req != nil {
+		pagination = req.Pagination
+		if len(req.Address) > 0 {
+			addr, err = sdk.AccAddressFromBech32(req.Address)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+			}
+		}
+	}
+
+	resp := &sanction.QuerySeizuresResponse{}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if len(addr) == 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), SeizurePrefix)
+		resp.Pagination, err = query.Paginate(
+			store, pagination,
+			func(_, value []byte) error {
+				var seizure sanction.Seizure
+				if uErr := k.cdc.Unmarshal(value, &seizure); uErr != nil {
+					return uErr
+				}
+				resp.Seizures = append(resp.Seizures, &seizure)
+				return nil
+			},
+		)
+	} else {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), CreateSeizureAddrIndexPrefix(addr))
+		resp.Pagination, err = query.Paginate(
+			store, pagination,
+			func(key, _ []byte) error {
+				seizure := k.GetSeizure(ctx, sdk.BigEndianToUint64(key))
+				if seizure != nil {
+					resp.Seizures = append(resp.Seizures, seizure)
+				}
+				return nil
+			},
+		)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
 func (k Keeper) Params(goCtx context.Context, _ *sanction.QueryParamsRequest) (*sanction.QueryParamsResponse, error) {
 	resp := &sanction.QueryParamsResponse{}
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -824,6 +825,172 @@ func (s *QueryTestSuite) TestKeeper_TemporaryEntries() {
 	}
 }
 
+func (s *QueryTestSuite) TestKeeper_EmergencySanctions() {
+	officer := sdk.AccAddress("compliance_officer__")
+	expiresAt := s.BlockTime.Add(time.Hour).UTC()
+	newES := func(addr sdk.AccAddress) *sanction.EmergencySanction {
+		return &sanction.EmergencySanction{Address: addr.String(), Officer: officer.String(), ExpiresAt: expiresAt}
+	}
+	es1 := newES(sdk.AccAddress("1_addr_emergency____"))
+	es2 := newES(sdk.AccAddress("2_addr_emergency____"))
+	es3 := newES(sdk.AccAddress("3_addr_emergency____"))
+
+	s.ClearState()
+	s.Require().NotPanics(func() {
+		s.Keeper.InitGenesis(s.SdkCtx, &sanction.GenesisState{
+			EmergencySanctions: []*sanction.EmergencySanction{es2, es3, es1},
+		})
+	}, "InitGenesis")
+
+	tests := []struct {
+		name   string
+		req    *sanction.QueryEmergencySanctionsRequest
+		exp    []*sanction.EmergencySanction
+		expErr []string
+	}{
+		{
+			name: "nil req",
+			req:  nil,
+			exp:  []*sanction.EmergencySanction{es1, es2, es3},
+		},
+		{
+			name: "empty req",
+			req:  &sanction.QueryEmergencySanctionsRequest{},
+			exp:  []*sanction.EmergencySanction{es1, es2, es3},
+		},
+		{
+			name:   "bad address",
+			req:    &sanction.QueryEmergencySanctionsRequest{Address: "not1addr"},
+			expErr: []string{"invalid address", "InvalidArgument", "decoding bech32 failed"},
+		},
+		{
+			name: "specific address",
+			req:  &sanction.QueryEmergencySanctionsRequest{Address: es2.Address},
+			exp:  []*sanction.EmergencySanction{es2},
+		},
+		{
+			name: "address without an emergency sanction",
+			req:  &sanction.QueryEmergencySanctionsRequest{Address: officer.String()},
+			exp:  nil,
+		},
+		{
+			name: "limit 2",
+			req:  &sanction.QueryEmergencySanctionsRequest{Pagination: &query.PageRequest{Limit: 2}},
+			exp:  []*sanction.EmergencySanction{es1, es2},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var resp *sanction.QueryEmergencySanctionsResponse
+			var err error
+			testFunc := func() {
+				resp, err = s.Keeper.EmergencySanctions(s.StdlibCtx, tc.req)
+			}
+			s.Require().NotPanics(testFunc, "EmergencySanctions")
+			assertions.AssertErrorContents(s.T(), err, tc.expErr, "EmergencySanctions error")
+			if len(tc.expErr) > 0 {
+				s.Assert().Nil(resp, "EmergencySanctions response")
+				return
+			}
+			if s.Assert().NotNil(resp, "EmergencySanctions response") {
+				s.Assert().Equal(tc.exp, resp.EmergencySanctions, "EmergencySanctions response EmergencySanctions")
+			}
+		})
+	}
+}
+
+func (s *QueryTestSuite) TestKeeper_Seizures() {
+	addr1 := sdk.AccAddress("1_addr_seizure______")
+	addr2 := sdk.AccAddress("2_addr_seizure______")
+	seizedAt := s.BlockTime.UTC()
+	newSeizure := func(id uint64, addr sdk.AccAddress, amount int64) *sanction.Seizure {
+		return &sanction.Seizure{
+			Id:       id,
+			Address:  addr.String(),
+			Amount:   sdk.NewCoins(sdk.NewInt64Coin("scoin", amount)),
+			SeizedAt: seizedAt,
+		}
+	}
+	sz1 := newSeizure(1, addr1, 10)
+	sz2 := newSeizure(2, addr2, 20)
+	sz3 := newSeizure(3, addr1, 30)
+
+	s.ClearState()
+	s.Require().NotPanics(func() {
+		s.Keeper.InitGenesis(s.SdkCtx, &sanction.GenesisState{
+			Seizures: []*sanction.Seizure{sz3, sz1, sz2},
+		})
+	}, "InitGenesis")
+
+	tests := []struct {
+		name   string
+		req    *sanction.QuerySeizuresRequest
+		exp    []*sanction.Seizure
+		expErr []string
+	}{
+		{
+			name: "nil req",
+			req:  nil,
+			exp:  []*sanction.Seizure{sz1, sz2, sz3},
+		},
+		{
+			name: "empty req",
+			req:  &sanction.QuerySeizuresRequest{},
+			exp:  []*sanction.Seizure{sz1, sz2, sz3},
+		},
+		{
+			name:   "bad address",
+			req:    &sanction.QuerySeizuresRequest{Address: "not1addr"},
+			expErr: []string{"invalid address", "InvalidArgument", "decoding bech32 failed"},
+		},
+		{
+			name: "addr1",
+			req:  &sanction.QuerySeizuresRequest{Address: addr1.String()},
+			exp:  []*sanction.Seizure{sz1, sz3},
+		},
+		{
+			name: "addr2",
+			req:  &sanction.QuerySeizuresRequest{Address: addr2.String()},
+			exp:  []*sanction.Seizure{sz2},
+		},
+		{
+			name: "address without seizures",
+			req:  &sanction.QuerySeizuresRequest{Address: sdk.AccAddress("3_addr_seizure______").String()},
+			exp:  nil,
+		},
+		{
+			name: "limit 2",
+			req:  &sanction.QuerySeizuresRequest{Pagination: &query.PageRequest{Limit: 2}},
+			exp:  []*sanction.Seizure{sz1, sz2},
+		},
+		{
+			name: "addr1 limit 1",
+			req:  &sanction.QuerySeizuresRequest{Address: addr1.String(), Pagination: &query.PageRequest{Limit: 1}},
+			exp:  []*sanction.Seizure{sz1},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var resp *sanction.QuerySeizuresResponse
+			var err error
+			testFunc := func() {
+				resp, err = s.Keeper.Seizures(s.StdlibCtx, tc.req)
+			}
+			s.Require().NotPanics(testFunc, "Seizures")
+			assertions.AssertErrorContents(s.T(), err, tc.expErr, "Seizures error")
+			if len(tc.expErr) > 0 {
+				s.Assert().Nil(resp, "Seizures response")
+				return
+			}
+			if s.Assert().NotNil(resp, "Seizures response") {
+				s.Assert().Equal(tc.exp, resp.Seizures, "Seizures response Seizures")
+			}
+		})
+	}
+}
+
 func (s *QueryTestSuite) TestKeeper_Params() {
 	origMinSanct := sanction.DefaultImmediateSanctionMinDeposit
 	origMinUnsanct := sanction.DefaultImmediateUnsanctionMinDeposit
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
@@ -20,7 +23,8 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 
-	govKeeper sanction.GovKeeper
+	bankKeeper sanction.BankKeeper
+	govKeeper  sanction.GovKeeper
 
 	authority string
 
@@ -42,6 +46,7 @@ func NewKeeper(
 	rv := Keeper{
 		cdc:                         cdc,
 		storeKey:                    storeKey,
+		bankKeeper:                  bankKeeper,
 		govKeeper:                   WrapGovKeeper(govKeeper),
 		authority:                   authority,
 		unsanctionableAddrs:         make(map[string]bool),
@@ -177,6 +182,7 @@ func (k Keeper) DeleteGovPropTempEntries(ctx sdk.Context, govPropID uint64) {
 }
 
 // DeleteAddrTempEntries deletes all temporary entries for each given address.
+// Any emergency sanction records for the addresses are also deleted.
 func (k Keeper) DeleteAddrTempEntries(ctx sdk.Context, addrs ...sdk.AccAddress) {
 	if len(addrs) == 0 {
 		return
@@ -194,12 +200,17 @@ func (k Keeper) DeleteAddrTempEntries(ctx sdk.Context, addrs ...sdk.AccAddress)
 			k.IterateTemporaryEntries(ctx, addr, callback)
 		}
 	}
+	store := ctx.KVStore(k.storeKey)
 	if len(toRemove) > 0 {
-		store := ctx.KVStore(k.storeKey)
 		for _, key := range toRemove {
 			store.Delete(key)
 		}
 	}
+	for _, addr := range addrs {
+		if len(addr) > 0 {
+			k.deleteEmergencySanction(store, addr)
+		}
+	}
 }
 
 // getSanctionedAddressPrefixStore returns a kv store prefixed for sanctioned addresses, and the prefix bytes.
@@ -300,6 +311,10 @@ func (k Keeper) GetParams(ctx sdk.Context) *sanction.Params {
 			rv.ImmediateSanctionMinDeposit = toCoinsOrDefault(value, rv.ImmediateSanctionMinDeposit)
 		case ParamNameImmediateUnsanctionMinDeposit:
 			rv.ImmediateUnsanctionMinDeposit = toCoinsOrDefault(value, rv.ImmediateUnsanctionMinDeposit)
+		case ParamNameComplianceOfficers:
+			rv.ComplianceOfficers = toAddrStrings(value)
+		case ParamNameEmergencySanctionDuration:
+			rv.EmergencySanctionDuration = toDurationOrDefault(value, rv.EmergencySanctionDuration)
 		default:
 			panic(fmt.Errorf("unknown param key: %q", name))
 		}
@@ -316,9 +331,13 @@ func (k Keeper) SetParams(ctx sdk.Context, params *sanction.Params) error {
 	if params == nil {
 		k.deleteParam(store, ParamNameImmediateSanctionMinDeposit)
 		k.deleteParam(store, ParamNameImmediateUnsanctionMinDeposit)
+		k.deleteParam(store, ParamNameComplianceOfficers)
+		k.deleteParam(store, ParamNameEmergencySanctionDuration)
 	} else {
 		k.setParam(store, ParamNameImmediateSanctionMinDeposit, params.ImmediateSanctionMinDeposit.String())
 		k.setParam(store, ParamNameImmediateUnsanctionMinDeposit, params.ImmediateUnsanctionMinDeposit.String())
+		k.setParam(store, ParamNameComplianceOfficers, strings.Join(params.ComplianceOfficers, ","))
+		k.setParam(store, ParamNameEmergencySanctionDuration, params.EmergencySanctionDuration.String())
 	}
 	return ctx.EventManager().EmitTypedEvent(&sanction.EventParamsUpdated{})
 }
@@ -356,6 +375,29 @@ func (k Keeper) GetImmediateUnsanctionMinDeposit(ctx sdk.Context) sdk.Coins {
 	)
 }
 
+// GetComplianceOfficers gets the addresses that are allowed to issue emergency sanctions.
+func (k Keeper) GetComplianceOfficers(ctx sdk.Context) []string {
+	value, has := k.getParam(ctx.KVStore(k.storeKey), ParamNameComplianceOfficers)
+	if !has {
+		return sanction.DefaultComplianceOfficers
+	}
+	return toAddrStrings(value)
+}
+
+// IsComplianceOfficer returns true if the provided address is allowed to issue emergency sanctions.
+func (k Keeper) IsComplianceOfficer(ctx sdk.Context, addr string) bool {
+	return slices.Contains(k.GetComplianceOfficers(ctx), addr)
+}
+
+// GetEmergencySanctionDuration gets how long an emergency sanction lasts before expiring.
+func (k Keeper) GetEmergencySanctionDuration(ctx sdk.Context) time.Duration {
+	value, has := k.getParam(ctx.KVStore(k.storeKey), ParamNameEmergencySanctionDuration)
+	if !has {
+		return sanction.DefaultEmergencySanctionDuration
+	}
+	return toDurationOrDefault(value, sanction.DefaultEmergencySanctionDuration)
+}
+
 // getParam returns a param value and whether it existed.
 func (k Keeper) getParam(store storetypes.KVStore, name string) (string, bool) {
 	key := CreateParamKey(name)
@@ -398,6 +440,24 @@ func toCoinsOrDefault(coins string, dflt sdk.Coins) sdk.Coins {
 	return rv
 }
 
+// toAddrStrings splits a comma-separated list of addresses into its individual entries.
+// An empty string results in nil.
+func toAddrStrings(value string) []string {
+	if len(value) == 0 {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// toDurationOrDefault converts a string to a duration if possible or else returns the provided default.
+func toDurationOrDefault(value string, dflt time.Duration) time.Duration {
+	rv, err := time.ParseDuration(value)
+	if err != nil {
+		return dflt
+	}
+	return rv
+}
+
 // toAccAddrs converts the provided strings into a slice of sdk.AccAddress.
 // If any fail to convert, an error is returned.
 func toAccAddrs(addrs []string) ([]sdk.AccAddress, error) {
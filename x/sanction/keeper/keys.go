@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -21,18 +22,40 @@ import (
 // - 0x02<addr len (1 byte)><addr><gov prop id (8 bytes)> -> 0x01 or 0x00
 // Proposal id temp sanction index:
 // - 0x03<proposal id (8 bytes)><addr len (1 byte)><addr> -> 0x00 or 0x01
+// Emergency sanctions:
+// - 0x04<addr len (1 byte)><addr> -> protobuf(EmergencySanction)
+// Emergency sanction expiration index:
+// - 0x05<expiration (29 bytes)><addr len (1 byte)><addr> -> <nil>
+// Seizures:
+// - 0x06<seizure id (8 bytes)> -> protobuf(Seizure)
+// Seizure address index:
+// - 0x07<addr len (1 byte)><addr><seizure id (8 bytes)> -> <nil>
+// Next seizure id:
+// - 0x08 -> <seizure id (8 bytes)>
 var (
-	ParamsPrefix        = []byte{0x00}
-	SanctionedPrefix    = []byte{0x01}
-	TemporaryPrefix     = []byte{0x02}
-	ProposalIndexPrefix = []byte{0x03}
+	ParamsPrefix              = []byte{0x00}
+	SanctionedPrefix          = []byte{0x01}
+	TemporaryPrefix           = []byte{0x02}
+	ProposalIndexPrefix       = []byte{0x03}
+	EmergencySanctionPrefix   = []byte{0x04}
+	EmergencyExpirationPrefix = []byte{0x05}
+	SeizurePrefix             = []byte{0x06}
+	SeizureAddrIndexPrefix    = []byte{0x07}
+	NextSeizureIDKey          = []byte{0x08}
 )
 
 const (
 	ParamNameImmediateSanctionMinDeposit   = "immediate_sanction_min_deposit"
 	ParamNameImmediateUnsanctionMinDeposit = "immediate_unsanction_min_deposit"
+	ParamNameComplianceOfficers            = "compliance_officers"
+	ParamNameEmergencySanctionDuration     = "emergency_sanction_duration"
 )
 
+// EmergencyPropID is the "gov prop id" used for temporary entries created by an emergency sanction.
+// Governance proposal ids start at 1, so this will never conflict with an actual proposal,
+// and any temporary entry from a governance proposal will take precedence over an emergency one.
+const EmergencyPropID uint64 = 0
+
 // ConcatBz creates a single byte slice consisting of the two provided byte slices.
 // Like append() but always returns a new slice with its own underlying array.
 func ConcatBz(bz1, bz2 []byte) []byte {
@@ -190,3 +213,82 @@ func ParseProposalTempIndexKey(key []byte) (uint64, sdk.AccAddress) {
 	addr, _ := ParseLengthPrefixedBz(key[9:])
 	return govPropID, addr
 }
+
+// CreateEmergencySanctionKey creates the emergency sanction key for the provided address.
+//
+// - 0x04<addr len (1 byte)><addr>
+func CreateEmergencySanctionKey(addr sdk.AccAddress) []byte {
+	return ConcatBz(EmergencySanctionPrefix, address.MustLengthPrefix(addr))
+}
+
+// ParseEmergencySanctionKey extracts the address from the provided emergency sanction key.
+func ParseEmergencySanctionKey(key []byte) sdk.AccAddress {
+	addr, _ := ParseLengthPrefixedBz(key[1:])
+	return addr
+}
+
+// CreateEmergencyExpirationPrefix creates a key prefix for the emergency sanction expiration index.
+//
+// - 0x05<expiration (29 bytes)>
+func CreateEmergencyExpirationPrefix(expiresAt time.Time) []byte {
+	return concatBzPlusCap(EmergencyExpirationPrefix, sdk.FormatTimeBytes(expiresAt), 33)
+}
+
+// CreateEmergencyExpirationKey creates a key for the emergency sanction expiration index.
+//
+// - 0x05<expiration (29 bytes)><addr len (1 byte)><addr>
+func CreateEmergencyExpirationKey(expiresAt time.Time, addr sdk.AccAddress) []byte {
+	return append(CreateEmergencyExpirationPrefix(expiresAt), address.MustLengthPrefix(addr)...)
+}
+
+// ParseEmergencyExpirationKey extracts the expiration time and address from the provided emergency expiration key.
+func ParseEmergencyExpirationKey(key []byte) (time.Time, sdk.AccAddress, error) {
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	if len(key) < 1+timeLen {
+		return time.Time{}, nil, fmt.Errorf("invalid emergency expiration key %X: too short", key)
+	}
+	expiresAt, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("invalid emergency expiration key %X: %w", key, err)
+	}
+	addr, _ := ParseLengthPrefixedBz(key[1+timeLen:])
+	return expiresAt, addr, nil
+}
+
+// CreateSeizureKey creates the key for a seizure record.
+//
+// - 0x06<seizure id (8 bytes)>
+func CreateSeizureKey(id uint64) []byte {
+	return ConcatBz(SeizurePrefix, sdk.Uint64ToBigEndian(id))
+}
+
+// ParseSeizureKey extracts the seizure id from the provided seizure key.
+func ParseSeizureKey(key []byte) uint64 {
+	return sdk.BigEndianToUint64(key[1:9])
+}
+
+// CreateSeizureAddrIndexPrefix creates a key prefix for the seizure address index.
+//
+// If an address is provided:
+// - 0x07<addr len(1 byte)><addr>
+// If an address isn't provided:
+// - 0x07
+func CreateSeizureAddrIndexPrefix(addr sdk.AccAddress) []byte {
+	if len(addr) == 0 {
+		return ConcatBz(SeizureAddrIndexPrefix, []byte{})
+	}
+	return concatBzPlusCap(SeizureAddrIndexPrefix, address.MustLengthPrefix(addr), 8)
+}
+
+// CreateSeizureAddrIndexKey creates a key for the seizure address index.
+//
+// - 0x07<addr len (1 byte)><addr><seizure id (8 bytes)>
+func CreateSeizureAddrIndexKey(addr sdk.AccAddress, id uint64) []byte {
+	return append(CreateSeizureAddrIndexPrefix(addr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ParseSeizureAddrIndexKey extracts the address and seizure id from the provided seizure address index key.
+func ParseSeizureAddrIndexKey(key []byte) (sdk.AccAddress, uint64) {
+	addr, idBz := ParseLengthPrefixedBz(key[1:])
+	return addr, sdk.BigEndianToUint64(idBz)
+}
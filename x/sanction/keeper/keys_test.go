@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,6 +28,11 @@ func TestPrefixValues(t *testing.T) {
 		{name: "SanctionedPrefix", prefix: keeper.SanctionedPrefix, expected: []byte{0x01}},
 		{name: "TemporaryPrefix", prefix: keeper.TemporaryPrefix, expected: []byte{0x02}},
 		{name: "ProposalIndexPrefix", prefix: keeper.ProposalIndexPrefix, expected: []byte{0x03}},
+		{name: "EmergencySanctionPrefix", prefix: keeper.EmergencySanctionPrefix, expected: []byte{0x04}},
+		{name: "EmergencyExpirationPrefix", prefix: keeper.EmergencyExpirationPrefix, expected: []byte{0x05}},
+		{name: "SeizurePrefix", prefix: keeper.SeizurePrefix, expected: []byte{0x06}},
+		{name: "SeizureAddrIndexPrefix", prefix: keeper.SeizureAddrIndexPrefix, expected: []byte{0x07}},
+		{name: "NextSeizureIDKey", prefix: keeper.NextSeizureIDKey, expected: []byte{0x08}},
 	}
 
 	for i, p := range prefixes {
@@ -59,6 +65,16 @@ func TestConstValues(t *testing.T) {
 			value:     keeper.ParamNameImmediateUnsanctionMinDeposit,
 			exptected: "immediate_unsanction_min_deposit",
 		},
+		{
+			name:      "ParamNameComplianceOfficers",
+			value:     keeper.ParamNameComplianceOfficers,
+			exptected: "compliance_officers",
+		},
+		{
+			name:      "ParamNameEmergencySanctionDuration",
+			value:     keeper.ParamNameEmergencySanctionDuration,
+			exptected: "emergency_sanction_duration",
+		},
 	}
 
 	for i, c := range consts {
@@ -1075,3 +1091,212 @@ func TestParseProposalTempIndexKey(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateEmergencySanctionKey(t *testing.T) {
+	tests := []struct {
+		name string
+		addr sdk.AccAddress
+		exp  []byte
+	}{
+		{
+			name: "nil addr",
+			addr: nil,
+			exp:  []byte{keeper.EmergencySanctionPrefix[0]},
+		},
+		{
+			name: "4 byte address",
+			addr: sdk.AccAddress("test"),
+			exp:  append([]byte{keeper.EmergencySanctionPrefix[0], 4}, "test"...),
+		},
+		{
+			name: "20 byte address",
+			addr: sdk.AccAddress("test_20_byte_address"),
+			exp:  append([]byte{keeper.EmergencySanctionPrefix[0], 20}, "test_20_byte_address"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []byte
+			testFunc := func() {
+				actual = keeper.CreateEmergencySanctionKey(tc.addr)
+			}
+			require.NotPanics(t, testFunc, "CreateEmergencySanctionKey")
+			assert.Equal(t, tc.exp, actual, "CreateEmergencySanctionKey result")
+
+			var parsed sdk.AccAddress
+			testParse := func() {
+				parsed = keeper.ParseEmergencySanctionKey(actual)
+			}
+			require.NotPanics(t, testParse, "ParseEmergencySanctionKey")
+			if len(tc.addr) > 0 {
+				assert.Equal(t, tc.addr, parsed, "ParseEmergencySanctionKey result")
+			}
+		})
+	}
+}
+
+func TestCreateEmergencyExpirationKey(t *testing.T) {
+	expiresAt := time.Date(2024, 3, 14, 15, 9, 26, 535897932, time.UTC)
+	timeBz := sdk.FormatTimeBytes(expiresAt)
+	addr := sdk.AccAddress("test_20_byte_address")
+
+	expPrefix := append([]byte{keeper.EmergencyExpirationPrefix[0]}, timeBz...)
+	expKey := append(append([]byte{}, expPrefix...), 20)
+	expKey = append(expKey, addr...)
+
+	var actualPrefix []byte
+	testPrefix := func() {
+		actualPrefix = keeper.CreateEmergencyExpirationPrefix(expiresAt)
+	}
+	require.NotPanics(t, testPrefix, "CreateEmergencyExpirationPrefix")
+	assert.Equal(t, expPrefix, actualPrefix, "CreateEmergencyExpirationPrefix result")
+
+	var actualKey []byte
+	testKey := func() {
+		actualKey = keeper.CreateEmergencyExpirationKey(expiresAt, addr)
+	}
+	require.NotPanics(t, testKey, "CreateEmergencyExpirationKey")
+	assert.Equal(t, expKey, actualKey, "CreateEmergencyExpirationKey result")
+
+	earlier := keeper.CreateEmergencyExpirationKey(expiresAt.Add(-1*time.Second), sdk.AccAddress("zzzzzzzzzzzzzzzzzzzz"))
+	assert.Less(t, string(earlier), string(actualKey), "key of an earlier time compared to the key")
+}
+
+func TestParseEmergencyExpirationKey(t *testing.T) {
+	expiresAt := time.Date(2024, 3, 14, 15, 9, 26, 535897932, time.UTC)
+
+	tests := []struct {
+		name    string
+		key     []byte
+		expTime time.Time
+		expAddr sdk.AccAddress
+		expErr  []string
+	}{
+		{
+			name:   "nil",
+			key:    nil,
+			expErr: []string{"invalid emergency expiration key", "too short"},
+		},
+		{
+			name:   "just the prefix",
+			key:    keeper.EmergencyExpirationPrefix,
+			expErr: []string{"invalid emergency expiration key 05", "too short"},
+		},
+		{
+			name:   "bad time bytes",
+			key:    append([]byte{keeper.EmergencyExpirationPrefix[0]}, "not a time at all, not a time at all"...),
+			expErr: []string{"invalid emergency expiration key"},
+		},
+		{
+			name:    "20 byte addr",
+			key:     keeper.CreateEmergencyExpirationKey(expiresAt, sdk.AccAddress("this_test_addr_is_20")),
+			expTime: expiresAt,
+			expAddr: sdk.AccAddress("this_test_addr_is_20"),
+		},
+		{
+			name:    "32 byte addr",
+			key:     keeper.CreateEmergencyExpirationKey(expiresAt, sdk.AccAddress("this_test_addr_is_longer_with_32")),
+			expTime: expiresAt,
+			expAddr: sdk.AccAddress("this_test_addr_is_longer_with_32"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actualTime time.Time
+			var actualAddr sdk.AccAddress
+			var err error
+			testFunc := func() {
+				actualTime, actualAddr, err = keeper.ParseEmergencyExpirationKey(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseEmergencyExpirationKey")
+			assertions.AssertErrorContents(t, err, tc.expErr, "ParseEmergencyExpirationKey error")
+			if len(tc.expErr) == 0 {
+				assert.Equal(t, tc.expTime, actualTime, "ParseEmergencyExpirationKey time")
+				assert.Equal(t, tc.expAddr, actualAddr, "ParseEmergencyExpirationKey address")
+			}
+		})
+	}
+}
+
+func TestCreateSeizureKey(t *testing.T) {
+	tests := []struct {
+		name string
+		id   uint64
+		exp  []byte
+	}{
+		{name: "id 0", id: 0, exp: []byte{keeper.SeizurePrefix[0], 0, 0, 0, 0, 0, 0, 0, 0}},
+		{name: "id 1", id: 1, exp: []byte{keeper.SeizurePrefix[0], 0, 0, 0, 0, 0, 0, 0, 1}},
+		{name: "id 258", id: 258, exp: []byte{keeper.SeizurePrefix[0], 0, 0, 0, 0, 0, 0, 1, 2}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []byte
+			testFunc := func() {
+				actual = keeper.CreateSeizureKey(tc.id)
+			}
+			require.NotPanics(t, testFunc, "CreateSeizureKey")
+			assert.Equal(t, tc.exp, actual, "CreateSeizureKey result")
+
+			var id uint64
+			testParse := func() {
+				id = keeper.ParseSeizureKey(actual)
+			}
+			require.NotPanics(t, testParse, "ParseSeizureKey")
+			assert.Equal(t, tc.id, id, "ParseSeizureKey result")
+		})
+	}
+}
+
+func TestCreateSeizureAddrIndexKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		addr      sdk.AccAddress
+		id        uint64
+		expPrefix []byte
+		expKey    []byte
+	}{
+		{
+			name:      "4 byte addr",
+			addr:      sdk.AccAddress("test"),
+			id:        3,
+			expPrefix: append([]byte{keeper.SeizureAddrIndexPrefix[0], 4}, "test"...),
+			expKey:    append([]byte{keeper.SeizureAddrIndexPrefix[0], 4}, append([]byte("test"), 0, 0, 0, 0, 0, 0, 0, 3)...),
+		},
+		{
+			name:      "20 byte addr",
+			addr:      sdk.AccAddress("test_20_byte_address"),
+			id:        1000,
+			expPrefix: append([]byte{keeper.SeizureAddrIndexPrefix[0], 20}, "test_20_byte_address"...),
+			expKey:    append([]byte{keeper.SeizureAddrIndexPrefix[0], 20}, append([]byte("test_20_byte_address"), 0, 0, 0, 0, 0, 0, 3, 232)...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actualPrefix, actualKey []byte
+			testFunc := func() {
+				actualPrefix = keeper.CreateSeizureAddrIndexPrefix(tc.addr)
+				actualKey = keeper.CreateSeizureAddrIndexKey(tc.addr, tc.id)
+			}
+			require.NotPanics(t, testFunc, "CreateSeizureAddrIndexPrefix and CreateSeizureAddrIndexKey")
+			assert.Equal(t, tc.expPrefix, actualPrefix, "CreateSeizureAddrIndexPrefix result")
+			assert.Equal(t, tc.expKey, actualKey, "CreateSeizureAddrIndexKey result")
+
+			var addr sdk.AccAddress
+			var id uint64
+			testParse := func() {
+				addr, id = keeper.ParseSeizureAddrIndexKey(actualKey)
+			}
+			require.NotPanics(t, testParse, "ParseSeizureAddrIndexKey")
+			assert.Equal(t, tc.addr, addr, "ParseSeizureAddrIndexKey address")
+			assert.Equal(t, tc.id, id, "ParseSeizureAddrIndexKey id")
+		})
+	}
+
+	t.Run("no addr", func(t *testing.T) {
+		assert.Equal(t, keeper.SeizureAddrIndexPrefix, keeper.CreateSeizureAddrIndexPrefix(nil), "CreateSeizureAddrIndexPrefix(nil)")
+	})
+}
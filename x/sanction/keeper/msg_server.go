@@ -71,3 +71,64 @@ func (k Keeper) UpdateParams(goCtx context.Context, req *sanction.MsgUpdateParam
 
 	return &sanction.MsgUpdateParamsResponse{}, nil
 }
+
+func (k Keeper) EmergencySanction(goCtx context.Context, req *sanction.MsgEmergencySanction) (*sanction.MsgEmergencySanctionResponse, error) {
+	officer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid signer: %v", err)
+	}
+
+	toSanction, err := toAccAddrs(req.Addresses)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err = k.EmergencySanctionAddresses(ctx, officer, toSanction...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sanction.MsgEmergencySanctionResponse{}, nil
+}
+
+func (k Keeper) SeizeSanctionedFunds(goCtx context.Context, req *sanction.MsgSeizeSanctionedFunds) (*sanction.MsgSeizeSanctionedFundsResponse, error) {
+	if req.Authority != k.authority {
+		return nil, gov.ErrInvalidSigner.Wrapf("expected %q got %q", k.authority, req.Authority)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := k.SeizeFunds(ctx, addr, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sanction.MsgSeizeSanctionedFundsResponse{SeizureId: id}, nil
+}
+
+func (k Keeper) ReleaseSeizedFunds(goCtx context.Context, req *sanction.MsgReleaseSeizedFunds) (*sanction.MsgReleaseSeizedFundsResponse, error) {
+	if req.Authority != k.authority {
+		return nil, gov.ErrInvalidSigner.Wrapf("expected %q got %q", k.authority, req.Authority)
+	}
+
+	var destination sdk.AccAddress
+	if len(req.Destination) > 0 {
+		var err error
+		destination, err = sdk.AccAddressFromBech32(req.Destination)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid destination: %v", err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ReleaseFunds(ctx, req.SeizureId, destination); err != nil {
+		return nil, err
+	}
+
+	return &sanction.MsgReleaseSeizedFundsResponse{}, nil
+}
@@ -2,15 +2,19 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 
 	"github.com/provenance-io/provenance/testutil/assertions"
 	"github.com/provenance-io/provenance/x/sanction"
+	"github.com/provenance-io/provenance/x/sanction/keeper"
 )
 
 type MsgServerTestSuite struct {
@@ -659,3 +663,251 @@ func (s *MsgServerTestSuite) TestKeeper_UpdateParams() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestKeeper_EmergencySanction() {
+	officer := sdk.AccAddress("compliance_officer__")
+	addr1 := sdk.AccAddress("1_addr_emergency____")
+	addr2 := sdk.AccAddress("2_addr_emergency____")
+	params := &sanction.Params{
+		ComplianceOfficers:        []string{officer.String()},
+		EmergencySanctionDuration: time.Hour,
+	}
+	expiresAt := s.BlockTime.Add(time.Hour).UTC()
+
+	tests := []struct {
+		name     string
+		req      *sanction.MsgEmergencySanction
+		expErr   []string
+		expState *sanction.GenesisState
+	}{
+		{
+			name: "bad signer",
+			req: &sanction.MsgEmergencySanction{
+				Addresses: []string{addr1.String()},
+				Signer:    "notanaddr",
+			},
+			expErr: []string{"invalid address", "invalid signer", "decoding bech32 failed"},
+		},
+		{
+			name: "signer not a compliance officer",
+			req: &sanction.MsgEmergencySanction{
+				Addresses: []string{addr1.String()},
+				Signer:    addr2.String(),
+			},
+			expErr:   []string{"address is not a compliance officer", addr2.String()},
+			expState: &sanction.GenesisState{Params: params},
+		},
+		{
+			name: "bad address",
+			req: &sanction.MsgEmergencySanction{
+				Addresses: []string{addr1.String(), "addrnogood"},
+				Signer:    officer.String(),
+			},
+			expErr:   []string{"invalid address", "invalid address[1]", "decoding bech32 failed"},
+			expState: &sanction.GenesisState{Params: params},
+		},
+		{
+			name: "two addresses",
+			req: &sanction.MsgEmergencySanction{
+				Addresses: []string{addr1.String(), addr2.String()},
+				Signer:    officer.String(),
+			},
+			expState: &sanction.GenesisState{
+				Params: params,
+				TemporaryEntries: []*sanction.TemporaryEntry{
+					newTempEntry(addr1, keeper.EmergencyPropID, true),
+					newTempEntry(addr2, keeper.EmergencyPropID, true),
+				},
+				EmergencySanctions: []*sanction.EmergencySanction{
+					{Address: addr1.String(), Officer: officer.String(), ExpiresAt: expiresAt},
+					{Address: addr2.String(), Officer: officer.String(), ExpiresAt: expiresAt},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.ClearState()
+			s.ReqOKSetParams(params)
+
+			var err error
+			testFunc := func() {
+				_, err = s.Keeper.EmergencySanction(s.StdlibCtx, tc.req)
+			}
+			s.Require().NotPanics(testFunc, "EmergencySanction")
+			assertions.AssertErrorContents(s.T(), err, tc.expErr, "EmergencySanction error")
+			if tc.expState != nil {
+				s.ExportAndCheck(tc.expState)
+			}
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestKeeper_SeizeSanctionedFunds() {
+	sanctAddr := sdk.AccAddress("sanctioned_addr_____")
+	otherAddr := sdk.AccAddress("other_addr__________")
+	funds := sdk.NewCoins(sdk.NewInt64Coin("scoin", 100))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("scoin", 40))
+	escrowAddr := authtypes.NewModuleAddress(sanction.ModuleName)
+
+	tests := []struct {
+		name      string
+		req       *sanction.MsgSeizeSanctionedFunds
+		expResp   *sanction.MsgSeizeSanctionedFundsResponse
+		expErr    []string
+		expEscrow sdk.Coins
+	}{
+		{
+			name: "wrong authority",
+			req: &sanction.MsgSeizeSanctionedFunds{
+				Address:   sanctAddr.String(),
+				Amount:    amount,
+				Authority: otherAddr.String(),
+			},
+			expErr: []string{"expected gov account as only signer for proposal message", s.quotedAuthority, otherAddr.String()},
+		},
+		{
+			name: "bad address",
+			req: &sanction.MsgSeizeSanctionedFunds{
+				Address:   "notanaddr",
+				Amount:    amount,
+				Authority: s.Keeper.GetAuthority(),
+			},
+			expErr: []string{"invalid address", "decoding bech32 failed"},
+		},
+		{
+			name: "address not sanctioned",
+			req: &sanction.MsgSeizeSanctionedFunds{
+				Address:   otherAddr.String(),
+				Amount:    amount,
+				Authority: s.Keeper.GetAuthority(),
+			},
+			expErr: []string{"account is not sanctioned", otherAddr.String()},
+		},
+		{
+			name: "ok",
+			req: &sanction.MsgSeizeSanctionedFunds{
+				Address:   sanctAddr.String(),
+				Amount:    amount,
+				Authority: s.Keeper.GetAuthority(),
+			},
+			expResp:   &sanction.MsgSeizeSanctionedFundsResponse{SeizureId: 1},
+			expEscrow: amount,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.ClearState()
+			ctx, _ := s.SdkCtx.CacheContext()
+			s.Require().NoError(testutil.FundAccount(ctx, s.App.BankKeeper, sanctAddr, funds), "FundAccount sanctAddr")
+			s.Require().NoError(testutil.FundAccount(ctx, s.App.BankKeeper, otherAddr, funds), "FundAccount otherAddr")
+			s.Require().NoError(s.Keeper.SanctionAddresses(ctx, sanctAddr), "SanctionAddresses")
+
+			var resp *sanction.MsgSeizeSanctionedFundsResponse
+			var err error
+			testFunc := func() {
+				resp, err = s.Keeper.SeizeSanctionedFunds(ctx, tc.req)
+			}
+			s.Require().NotPanics(testFunc, "SeizeSanctionedFunds")
+			assertions.AssertErrorContents(s.T(), err, tc.expErr, "SeizeSanctionedFunds error")
+			s.Assert().Equal(tc.expResp, resp, "SeizeSanctionedFunds response")
+			s.Assert().Equal(tc.expEscrow.String(), s.App.BankKeeper.GetAllBalances(ctx, escrowAddr).String(), "escrow balance")
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestKeeper_ReleaseSeizedFunds() {
+	sanctAddr := sdk.AccAddress("sanctioned_addr_____")
+	destAddr := sdk.AccAddress("destination_addr____")
+	funds := sdk.NewCoins(sdk.NewInt64Coin("scoin", 100))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("scoin", 40))
+	escrowAddr := authtypes.NewModuleAddress(sanction.ModuleName)
+
+	tests := []struct {
+		name         string
+		req          *sanction.MsgReleaseSeizedFunds
+		expErr       []string
+		expSanct     sdk.Coins
+		expDest      sdk.Coins
+		expEscrow    sdk.Coins
+		expStatus    sanction.SeizureStatus
+		expRecipient sdk.AccAddress
+	}{
+		{
+			name:      "wrong authority",
+			req:       &sanction.MsgReleaseSeizedFunds{SeizureId: 1, Authority: destAddr.String()},
+			expErr:    []string{"expected gov account as only signer for proposal message", s.quotedAuthority, destAddr.String()},
+			expSanct:  funds.Sub(amount...),
+			expEscrow: amount,
+			expStatus: sanction.SEIZURE_STATUS_HELD,
+		},
+		{
+			name:      "bad destination",
+			req:       &sanction.MsgReleaseSeizedFunds{SeizureId: 1, Destination: "notanaddr", Authority: s.Keeper.GetAuthority()},
+			expErr:    []string{"invalid destination", "decoding bech32 failed"},
+			expSanct:  funds.Sub(amount...),
+			expEscrow: amount,
+			expStatus: sanction.SEIZURE_STATUS_HELD,
+		},
+		{
+			name:      "unknown seizure",
+			req:       &sanction.MsgReleaseSeizedFunds{SeizureId: 2, Authority: s.Keeper.GetAuthority()},
+			expErr:    []string{"unknown seizure", "id 2"},
+			expSanct:  funds.Sub(amount...),
+			expEscrow: amount,
+			expStatus: sanction.SEIZURE_STATUS_HELD,
+		},
+		{
+			name:         "return",
+			req:          &sanction.MsgReleaseSeizedFunds{SeizureId: 1, Authority: s.Keeper.GetAuthority()},
+			expSanct:     funds,
+			expStatus:    sanction.SEIZURE_STATUS_RETURNED,
+			expRecipient: sanctAddr,
+		},
+		{
+			name:         "release",
+			req:          &sanction.MsgReleaseSeizedFunds{SeizureId: 1, Destination: destAddr.String(), Authority: s.Keeper.GetAuthority()},
+			expSanct:     funds.Sub(amount...),
+			expDest:      amount,
+			expStatus:    sanction.SEIZURE_STATUS_RELEASED,
+			expRecipient: destAddr,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.ClearState()
+			ctx, _ := s.SdkCtx.CacheContext()
+			s.Require().NoError(testutil.FundAccount(ctx, s.App.BankKeeper, sanctAddr, funds), "FundAccount sanctAddr")
+			s.Require().NoError(s.Keeper.SanctionAddresses(ctx, sanctAddr), "SanctionAddresses")
+			_, err := s.Keeper.SeizeFunds(ctx, sanctAddr, amount)
+			s.Require().NoError(err, "SeizeFunds")
+
+			var resp *sanction.MsgReleaseSeizedFundsResponse
+			testFunc := func() {
+				resp, err = s.Keeper.ReleaseSeizedFunds(ctx, tc.req)
+			}
+			s.Require().NotPanics(testFunc, "ReleaseSeizedFunds")
+			assertions.AssertErrorContents(s.T(), err, tc.expErr, "ReleaseSeizedFunds error")
+			if len(tc.expErr) == 0 {
+				s.Assert().Equal(&sanction.MsgReleaseSeizedFundsResponse{}, resp, "ReleaseSeizedFunds response")
+			} else {
+				s.Assert().Nil(resp, "ReleaseSeizedFunds response")
+			}
+			s.Assert().Equal(tc.expSanct.String(), s.App.BankKeeper.GetAllBalances(ctx, sanctAddr).String(), "sanctioned balance")
+			s.Assert().Equal(tc.expDest.String(), s.App.BankKeeper.GetAllBalances(ctx, destAddr).String(), "destination balance")
+			s.Assert().Equal(tc.expEscrow.String(), s.App.BankKeeper.GetAllBalances(ctx, escrowAddr).String(), "escrow balance")
+
+			seizure := s.Keeper.GetSeizure(ctx, 1)
+			s.Require().NotNil(seizure, "GetSeizure(1)")
+			s.Assert().Equal(tc.expStatus, seizure.Status, "seizure status")
+			expRecipient := ""
+			if len(tc.expRecipient) > 0 {
+				expRecipient = tc.expRecipient.String()
+			}
+			s.Assert().Equal(expRecipient, seizure.Recipient, "seizure recipient")
+		})
+	}
+}
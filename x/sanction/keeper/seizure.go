@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/sanction"
+	"github.com/provenance-io/provenance/x/sanction/errors"
+)
+
+// SeizeFunds moves the provided amount from a sanctioned address into the sanction module's escrow account
+// and records the seizure. Both the sanction and marker send restrictions are bypassed (like a forced transfer).
+// Returns the id of the newly created seizure record.
+func (k Keeper) SeizeFunds(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) (uint64, error) {
+	if !k.IsSanctionedAddr(ctx, addr) {
+		return 0, errors.ErrNotSanctionedAccount.Wrap(addr.String())
+	}
+
+	sendCtx := markertypes.WithBypass(sanction.WithBypass(ctx))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(sendCtx, addr, sanction.ModuleName, amount); err != nil {
+		return 0, fmt.Errorf("could not seize %s from %s: %w", amount, addr, err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	id := k.getNextSeizureID(store)
+	seizure := &sanction.Seizure{
+		Id:       id,
+		Address:  addr.String(),
+		Amount:   amount,
+		SeizedAt: ctx.BlockTime().UTC(),
+		Status:   sanction.SEIZURE_STATUS_HELD,
+	}
+	if err := k.setSeizure(store, seizure); err != nil {
+		return 0, err
+	}
+	k.setNextSeizureID(store, id+1)
+
+	return id, ctx.EventManager().EmitTypedEvent(sanction.NewEventFundsSeized(id, addr, amount))
+}
+
+// ReleaseFunds sends the funds of a held seizure out of the sanction module's escrow account and updates the
+// seizure record. If no recipient is provided, the funds are returned to the address they were seized from.
+// Both the sanction and marker send restrictions are bypassed (like a forced transfer).
+func (k Keeper) ReleaseFunds(ctx sdk.Context, id uint64, recipient sdk.AccAddress) error {
+	seizure := k.GetSeizure(ctx, id)
+	if seizure == nil {
+		return errors.ErrUnknownSeizure.Wrapf("id %d", id)
+	}
+	if seizure.Status != sanction.SEIZURE_STATUS_HELD {
+		return errors.ErrSeizureNotHeld.Wrapf("seizure %d has status %s", id, seizure.Status)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(seizure.Address)
+	if err != nil {
+		return fmt.Errorf("invalid seizure %d address %q: %w", id, seizure.Address, err)
+	}
+	seizure.Status = sanction.SEIZURE_STATUS_RETURNED
+	if len(recipient) == 0 {
+		recipient = addr
+	} else if !recipient.Equals(addr) {
+		seizure.Status = sanction.SEIZURE_STATUS_RELEASED
+	}
+	seizure.Recipient = recipient.String()
+
+	sendCtx := markertypes.WithBypass(sanction.WithBypass(ctx))
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(sendCtx, sanction.ModuleName, recipient, seizure.Amount); err != nil {
+		return fmt.Errorf("could not send %s from escrow to %s: %w", seizure.Amount, recipient, err)
+	}
+
+	if err = k.setSeizure(ctx.KVStore(k.storeKey), seizure); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(sanction.NewEventSeizedFundsReleased(id, addr, recipient, seizure.Amount))
+}
+
+// getNextSeizureID gets the id to use for the next seizure record.
+func (k Keeper) getNextSeizureID(store storetypes.KVStore) uint64 {
+	bz := store.Get(NextSeizureIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setNextSeizureID sets the id to use for the next seizure record.
+func (k Keeper) setNextSeizureID(store storetypes.KVStore, id uint64) {
+	store.Set(NextSeizureIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetNextSeizureID gets the id that will be used for the next seizure record.
+func (k Keeper) GetNextSeizureID(ctx sdk.Context) uint64 {
+	return k.getNextSeizureID(ctx.KVStore(k.storeKey))
+}
+
+// setSeizure writes the provided seizure record and its address index entry.
+func (k Keeper) setSeizure(store storetypes.KVStore, seizure *sanction.Seizure) error {
+	addr, err := sdk.AccAddressFromBech32(seizure.Address)
+	if err != nil {
+		return fmt.Errorf("invalid seizure address %q: %w", seizure.Address, err)
+	}
+	bz, err := k.cdc.Marshal(seizure)
+	if err != nil {
+		return err
+	}
+	store.Set(CreateSeizureKey(seizure.Id), bz)
+	store.Set(CreateSeizureAddrIndexKey(addr, seizure.Id), []byte{})
+	return nil
+}
+
+// GetSeizure gets the seizure record with the provided id, or nil if it doesn't exist.
+func (k Keeper) GetSeizure(ctx sdk.Context, id uint64) *sanction.Seizure {
+	bz := ctx.KVStore(k.storeKey).Get(CreateSeizureKey(id))
+	if len(bz) == 0 {
+		return nil
+	}
+	var rv sanction.Seizure
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil
+	}
+	return &rv
+}
+
+// IterateSeizures iterates over all of the seizure records in order of id.
+// The callback takes in the seizure and should return whether to stop iteration (true = stop, false = keep going).
+func (k Keeper) IterateSeizures(ctx sdk.Context, cb func(seizure *sanction.Seizure) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), SeizurePrefix)
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close() //nolint:errcheck // ignoring close error on iterator: not critical for this context.
+
+	for ; iter.Valid(); iter.Next() {
+		var seizure sanction.Seizure
+		if err := k.cdc.Unmarshal(iter.Value(), &seizure); err != nil {
+			continue
+		}
+		if cb(&seizure) {
+			break
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/sanction"
+)
+
+type SeizureTestSuite struct {
+	BaseTestSuite
+
+	sanctionedAddr  sdk.AccAddress
+	otherAddr       sdk.AccAddress
+	escrowAddr      sdk.AccAddress
+	restrictedDenom string
+}
+
+func (s *SeizureTestSuite) SetupTest() {
+	s.BaseSetup()
+
+	s.sanctionedAddr = sdk.AccAddress("sanctionedAddr______")
+	s.otherAddr = sdk.AccAddress("otherAddr___________")
+	s.escrowAddr = authtypes.NewModuleAddress(sanction.ModuleName)
+	s.restrictedDenom = "restrictedcoin"
+
+	markerAcc := authtypes.NewBaseAccount(markertypes.MustGetMarkerAddress(s.restrictedDenom), nil, 0, 0)
+	marker := markertypes.NewMarkerAccount(markerAcc, sdk.NewInt64Coin(s.restrictedDenom, 1000), nil, nil,
+		markertypes.StatusActive, markertypes.MarkerType_RestrictedCoin, true, true, true, []string{})
+	s.App.MarkerKeeper.SetMarker(s.SdkCtx, s.App.MarkerKeeper.NewMarker(s.SdkCtx, marker))
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin("greatcoin", 500), sdk.NewInt64Coin(s.restrictedDenom, 100))
+	s.Require().NoError(testutil.FundAccount(markertypes.WithBypass(s.SdkCtx), s.App.BankKeeper, s.sanctionedAddr, funds), "FundAccount sanctionedAddr")
+	s.Require().NoError(testutil.FundAccount(markertypes.WithBypass(s.SdkCtx), s.App.BankKeeper, s.otherAddr, funds), "FundAccount otherAddr")
+	s.ReqOKAddPermSanct("sanctionedAddr", s.sanctionedAddr)
+}
+
+func TestSeizureTestSuite(t *testing.T) {
+	suite.Run(t, new(SeizureTestSuite))
+}
+
+func (s *SeizureTestSuite) TestSeizeFunds() {
+	s.Run("address not sanctioned", func() {
+		ctx, _ := s.SdkCtx.CacheContext()
+		amount := sdk.NewCoins(sdk.NewInt64Coin("greatcoin", 5))
+		_, err := s.Keeper.SeizeFunds(ctx, s.otherAddr, amount)
+		s.AssertErrorContents(err, []string{"account is not sanctioned", s.otherAddr.String()}, "SeizeFunds")
+	})
+
+	s.Run("insufficient funds", func() {
+		ctx, _ := s.SdkCtx.CacheContext()
+		amount := sdk.NewCoins(sdk.NewInt64Coin("greatcoin", 501))
+		_, err := s.Keeper.SeizeFunds(ctx, s.sanctionedAddr, amount)
+		s.AssertErrorContents(err, []string{"could not seize 501greatcoin from " + s.sanctionedAddr.String(), "insufficient funds"},
+			"SeizeFunds")
+	})
+
+	s.Run("restricted coin cannot normally be sent", func() {
+		ctx, _ := s.SdkCtx.CacheContext()
+		amount := sdk.NewCoins(sdk.NewInt64Coin(s.restrictedDenom, 1))
+		err := s.App.BankKeeper.SendCoins(ctx, s.otherAddr, s.sanctionedAddr, amount)
+		s.Assert().Error(err, "SendCoins of restricted coin without bypass")
+	})
+
+	s.Run("two seizures", func() {
+		amount1 := sdk.NewCoins(sdk.NewInt64Coin("greatcoin", 200), sdk.NewInt64Coin(s.restrictedDenom, 60))
+		amount2 := sdk.NewCoins(sdk.NewInt64Coin("greatcoin", 300))
+
+		em := sdk.NewEventManager()
+		ctx := s.SdkCtx.WithEventManager(em)
+		var id1, id2 uint64
+		s.RequireNotPanicsNoError(func() error {
+			var err error
+			id1, err = s.Keeper.SeizeFunds(ctx, s.sanctionedAddr, amount1)
+			return err
+		}, "SeizeFunds(amount1)")
+		s.RequireNotPanicsNoError(func() error {
+			var err error
+			id2, err = s.Keeper.SeizeFunds(ctx, s.sanctionedAddr, amount2)
+			return err
+		}, "SeizeFunds(amount2)")
+		s.Assert().Equal(1, int(id1), "first seizure id")
+		s.Assert().Equal(2, int(id2), "second seizure id")
+		s.Assert().Equal(3, int(s.Keeper.GetNextSeizureID(s.SdkCtx)), "GetNextSeizureID")
+
+		expBal := sdk.NewCoins(sdk.NewInt64Coin(s.restrictedDenom, 40))
+		s.Assert().Equal(expBal.String(), s.App.BankKeeper.GetAllBalances(s.SdkCtx, s.sanctionedAddr).String(), "sanctioned address balance")
+		expEscrow := amount1.Add(amount2...)
+		s.Assert().Equal(expEscrow.String(), s.App.BankKeeper.GetAllBalances(s.SdkCtx, s.escrowAddr).String(), "escrow balance")
+
+		expSeizure1 := &sanction.Seizure{Id: 1, Address: s.sanctionedAddr.String(), Amount: amount1, SeizedAt: s.BlockTime.UTC(),
+			Status: sanction.SEIZURE_STATUS_HELD}
+		expSeizure2 := &sanction.Seizure{Id: 2, Address: s.sanctionedAddr.String(), Amount: amount2, SeizedAt: s.BlockTime.UTC(),
+			Status: sanction.SEIZURE_STATUS_HELD}
+		s.Assert().Equal(expSeizure1, s.Keeper.GetSeizure(s.SdkCtx, 1), "GetSeizure(1)")
+		s.Assert().Equal(expSeizure2, s.Keeper.GetSeizure(s.SdkCtx, 2), "GetSeizure(2)")
+		s.Assert().Nil(s.Keeper.GetSeizure(s.SdkCtx, 3), "GetSeizure(3)")
+
+		var seizedEvents sdk.Events
+		for _, event := range em.Events() {
+			if event.Type == "cosmos.sanction.v1beta1.EventFundsSeized" {
+				seizedEvents = append(seizedEvents, event)
+			}
+		}
+		event1, err := sdk.TypedEventToEvent(sanction.NewEventFundsSeized(1, s.sanctionedAddr, amount1))
+		s.Require().NoError(err, "TypedEventToEvent NewEventFundsSeized(1)")
+		event2, err := sdk.TypedEventToEvent(sanction.NewEventFundsSeized(2, s.sanctionedAddr, amount2))
+		s.Require().NoError(err, "TypedEventToEvent NewEventFundsSeized(2)")
+		s.Assert().Equal(sdk.Events{event1, event2}, seizedEvents, "EventFundsSeized events")
+	})
+}
+
+func (s *SeizureTestSuite) TestReleaseFunds() {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("greatcoin", 200), sdk.NewInt64Coin(s.restrictedDenom, 60))
+	seize := func(ctx sdk.Context) uint64 {
+		var id uint64
+		s.RequireNotPanicsNoError(func() error {
+			var err error
+			id, err = s.Keeper.SeizeFunds(ctx, s.sanctionedAddr, amount)
+			return err
+		}, "SeizeFunds")
+		return id
+	}
+
+	s.Run("unknown seizure", func() {
+		ctx, _ := s.SdkCtx.CacheContext()
+		err := s.Keeper.ReleaseFunds(ctx, 5, nil)
+		s.AssertErrorContents(err, []string{"unknown seizure", "id 5"}, "ReleaseFunds")
+	})
+
+	s.Run("returned to the seized address", func() {
+		ctx, _ := s.SdkCtx.CacheContext()
+		em := sdk.NewEventManager()
+		ctx = ctx.WithEventManager(em)
+		origBal := s.App.BankKeeper.GetAllBalances(ctx, s.sanctionedAddr)
+		id := seize(ctx)
+
+		s.RequireNotPanicsNoError(func() error {
+			return s.Keeper.ReleaseFunds(ctx, id, nil)
+		}, "ReleaseFunds")
+		s.Assert().Equal(origBal.String(), s.App.BankKeeper.GetAllBalances(ctx, s.sanctionedAddr).String(), "sanctioned address balance")
+		s.Assert().Empty(s.App.BankKeeper.GetAllBalances(ctx, s.escrowAddr).String(), "escrow balance")
+
+		seizure := s.Keeper.GetSeizure(ctx, id)
+		s.Require().NotNil(seizure, "GetSeizure")
+		s.Assert().Equal(sanction.SEIZURE_STATUS_RETURNED, seizure.Status, "seizure status")
+		s.Assert().Equal(s.sanctionedAddr.String(), seizure.Recipient, "seizure recipient")
+
+		expEvent, err := sdk.TypedEventToEvent(sanction.NewEventSeizedFundsReleased(id, s.sanctionedAddr, s.sanctionedAddr, amount))
+		s.Require().NoError(err, "TypedEventToEvent NewEventSeizedFundsReleased")
+		s.Assert().Contains(em.Events(), expEvent, "emitted events")
+
+		err = s.Keeper.ReleaseFunds(ctx, id, nil)
+		s.AssertErrorContents(err, []string{"seized funds are not held", "seizure 1 has status SEIZURE_STATUS_RETURNED"},
+			"ReleaseFunds a second time")
+	})
+
+	s.Run("released to another address", func() {
+		ctx, _ := s.SdkCtx.CacheContext()
+		dest := sdk.AccAddress("destination_________")
+		id := seize(ctx)
+
+		s.RequireNotPanicsNoError(func() error {
+			return s.Keeper.ReleaseFunds(ctx, id, dest)
+		}, "ReleaseFunds")
+		s.Assert().Equal(amount.String(), s.App.BankKeeper.GetAllBalances(ctx, dest).String(), "destination balance")
+		s.Assert().Empty(s.App.BankKeeper.GetAllBalances(ctx, s.escrowAddr).String(), "escrow balance")
+
+		seizure := s.Keeper.GetSeizure(ctx, id)
+		s.Require().NotNil(seizure, "GetSeizure")
+		s.Assert().Equal(sanction.SEIZURE_STATUS_RELEASED, seizure.Status, "seizure status")
+		s.Assert().Equal(dest.String(), seizure.Recipient, "seizure recipient")
+
+		err := s.Keeper.ReleaseFunds(ctx, id, s.sanctionedAddr)
+		s.AssertErrorContents(err, []string{"seized funds are not held", "seizure 1 has status SEIZURE_STATUS_RELEASED"},
+			"ReleaseFunds a second time")
+	})
+}
@@ -32,7 +32,8 @@ var (
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.HasProposalMsgs     = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
 )
 
 type AppModuleBasic struct {
@@ -132,6 +133,12 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
+// BeginBlock removes any emergency sanctions that have expired without being ratified.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	am.keeper.DeleteExpiredEmergencySanctions(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // ____________________________________________________________________________
 
 // AppModuleSimulation functions
@@ -12,6 +12,9 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgSanction)(nil),
 	(*MsgUnsanction)(nil),
 	(*MsgUpdateParams)(nil),
+	(*MsgEmergencySanction)(nil),
+	(*MsgSeizeSanctionedFunds)(nil),
+	(*MsgReleaseSeizedFunds)(nil),
 }
 
 func NewMsgSanction(authority string, addrs ...sdk.AccAddress) *MsgSanction {
@@ -86,3 +89,84 @@ func (m MsgUpdateParams) ValidateBasic() error {
 	}
 	return nil
 }
+
+func NewMsgEmergencySanction(signer sdk.AccAddress, addrs ...sdk.AccAddress) *MsgEmergencySanction {
+	rv := &MsgEmergencySanction{
+		Signer: signer.String(),
+	}
+	for _, addr := range addrs {
+		rv.Addresses = append(rv.Addresses, addr.String())
+	}
+	return rv
+}
+
+func (m MsgEmergencySanction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("signer, %q: %v", m.Signer, err)
+	}
+	if len(m.Addresses) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("at least one address is required")
+	}
+	for i, addr := range m.Addresses {
+		_, err = sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("addresses[%d], %q: %v", i, addr, err)
+		}
+	}
+	return nil
+}
+
+func NewMsgSeizeSanctionedFunds(authority string, addr sdk.AccAddress, amount sdk.Coins) *MsgSeizeSanctionedFunds {
+	return &MsgSeizeSanctionedFunds{
+		Address:   addr.String(),
+		Amount:    amount,
+		Authority: authority,
+	}
+}
+
+func (m MsgSeizeSanctionedFunds) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("authority, %q: %v", m.Authority, err)
+	}
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("address, %q: %v", m.Address, err)
+	}
+	if err = m.Amount.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid amount: %v", err)
+	}
+	if m.Amount.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrap("amount cannot be zero")
+	}
+	return nil
+}
+
+func NewMsgReleaseSeizedFunds(authority string, seizureID uint64, destination sdk.AccAddress) *MsgReleaseSeizedFunds {
+	rv := &MsgReleaseSeizedFunds{
+		SeizureId: seizureID,
+		Authority: authority,
+	}
+	if len(destination) > 0 {
+		rv.Destination = destination.String()
+	}
+	return rv
+}
+
+func (m MsgReleaseSeizedFunds) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("authority, %q: %v", m.Authority, err)
+	}
+	if m.SeizureId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("seizure id cannot be zero")
+	}
+	if len(m.Destination) > 0 {
+		_, err = sdk.AccAddressFromBech32(m.Destination)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("destination, %q: %v", m.Destination, err)
+		}
+	}
+	return nil
+}
@@ -19,6 +19,9 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgSanction{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUnsanction{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParams{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgEmergencySanction{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgSeizeSanctionedFunds{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgReleaseSeizedFunds{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -548,3 +551,273 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMsgEmergencySanction(t *testing.T) {
+	tests := []struct {
+		name   string
+		signer sdk.AccAddress
+		addrs  []sdk.AccAddress
+		exp    *MsgEmergencySanction
+	}{
+		{
+			name:   "empty nil",
+			signer: nil,
+			addrs:  nil,
+			exp: &MsgEmergencySanction{
+				Addresses: nil,
+				Signer:    "",
+			},
+		},
+		{
+			name:   "just signer provided",
+			signer: sdk.AccAddress("officer_____________"),
+			addrs:  nil,
+			exp: &MsgEmergencySanction{
+				Addresses: nil,
+				Signer:    sdk.AccAddress("officer_____________").String(),
+			},
+		},
+		{
+			name:   "two addresses provided",
+			signer: sdk.AccAddress("officer_____________"),
+			addrs: []sdk.AccAddress{
+				sdk.AccAddress("testaddr0___________"),
+				sdk.AccAddress("testaddr1___________"),
+			},
+			exp: &MsgEmergencySanction{
+				Addresses: []string{
+					sdk.AccAddress("testaddr0___________").String(),
+					sdk.AccAddress("testaddr1___________").String(),
+				},
+				Signer: sdk.AccAddress("officer_____________").String(),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var msg *MsgEmergencySanction
+			testFunc := func() {
+				msg = NewMsgEmergencySanction(tc.signer, tc.addrs...)
+			}
+			require.NotPanics(t, testFunc, "NewMsgEmergencySanction")
+			assert.Equal(t, tc.exp, msg, "NewMsgEmergencySanction result")
+		})
+	}
+}
+
+func TestMsgEmergencySanction_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgEmergencySanction
+		exp  []string
+	}{
+		{
+			name: "control",
+			msg: &MsgEmergencySanction{
+				Addresses: []string{
+					sdk.AccAddress("addr0_______________").String(),
+					sdk.AccAddress("addr1_______________").String(),
+				},
+				Signer: sdk.AccAddress("officer_____________").String(),
+			},
+			exp: nil,
+		},
+		{
+			name: "empty signer",
+			msg: &MsgEmergencySanction{
+				Addresses: []string{sdk.AccAddress("addr0_______________").String()},
+				Signer:    "",
+			},
+			exp: []string{"invalid address", "signer", `""`, "empty address string is not allowed"},
+		},
+		{
+			name: "bad signer",
+			msg: &MsgEmergencySanction{
+				Addresses: []string{sdk.AccAddress("addr0_______________").String()},
+				Signer:    "bad1signer",
+			},
+			exp: []string{"invalid address", "signer", `"bad1signer"`, "decoding bech32 failed"},
+		},
+		{
+			name: "no addresses",
+			msg: &MsgEmergencySanction{
+				Addresses: nil,
+				Signer:    sdk.AccAddress("officer_____________").String(),
+			},
+			exp: []string{"invalid request", "at least one address is required"},
+		},
+		{
+			name: "bad second addr",
+			msg: &MsgEmergencySanction{
+				Addresses: []string{
+					sdk.AccAddress("addr0_______________").String(),
+					"bad1secondaddr",
+				},
+				Signer: sdk.AccAddress("officer_____________").String(),
+			},
+			exp: []string{"invalid address", "addresses[1]", `"bad1secondaddr"`, "decoding bech32 failed"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.msg.ValidateBasic()
+			}
+			require.NotPanics(t, testFunc, "ValidateBasic")
+			assertions.AssertErrorContents(t, err, tc.exp, "ValidateBasic result.")
+		})
+	}
+}
+
+func TestNewMsgSeizeSanctionedFunds(t *testing.T) {
+	addr := sdk.AccAddress("sanctioned_addr_____")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("acoin", 5), sdk.NewInt64Coin("bcoin", 3))
+	exp := &MsgSeizeSanctionedFunds{
+		Address:   addr.String(),
+		Amount:    amount,
+		Authority: "authority",
+	}
+
+	var msg *MsgSeizeSanctionedFunds
+	testFunc := func() {
+		msg = NewMsgSeizeSanctionedFunds("authority", addr, amount)
+	}
+	require.NotPanics(t, testFunc, "NewMsgSeizeSanctionedFunds")
+	assert.Equal(t, exp, msg, "NewMsgSeizeSanctionedFunds result")
+}
+
+func TestMsgSeizeSanctionedFunds_ValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	addr := sdk.AccAddress("sanctioned_addr_____").String()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("acoin", 5))
+
+	tests := []struct {
+		name string
+		msg  *MsgSeizeSanctionedFunds
+		exp  []string
+	}{
+		{
+			name: "control",
+			msg:  &MsgSeizeSanctionedFunds{Address: addr, Amount: amount, Authority: authority},
+			exp:  nil,
+		},
+		{
+			name: "empty authority",
+			msg:  &MsgSeizeSanctionedFunds{Address: addr, Amount: amount, Authority: ""},
+			exp:  []string{"invalid address", "authority", `""`, "empty address string is not allowed"},
+		},
+		{
+			name: "bad address",
+			msg:  &MsgSeizeSanctionedFunds{Address: "bad1addr", Amount: amount, Authority: authority},
+			exp:  []string{"invalid address", "address", `"bad1addr"`, "decoding bech32 failed"},
+		},
+		{
+			name: "nil amount",
+			msg:  &MsgSeizeSanctionedFunds{Address: addr, Amount: nil, Authority: authority},
+			exp:  []string{"invalid coins", "amount cannot be zero"},
+		},
+		{
+			name: "invalid amount",
+			msg: &MsgSeizeSanctionedFunds{
+				Address:   addr,
+				Amount:    sdk.Coins{sdk.NewInt64Coin("dupcoin", 1), sdk.NewInt64Coin("dupcoin", 2)},
+				Authority: authority,
+			},
+			exp: []string{"invalid coins", "invalid amount", "duplicate denomination dupcoin"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.msg.ValidateBasic()
+			}
+			require.NotPanics(t, testFunc, "ValidateBasic")
+			assertions.AssertErrorContents(t, err, tc.exp, "ValidateBasic result.")
+		})
+	}
+}
+
+func TestNewMsgReleaseSeizedFunds(t *testing.T) {
+	dest := sdk.AccAddress("destination_addr____")
+
+	tests := []struct {
+		name string
+		dest sdk.AccAddress
+		exp  *MsgReleaseSeizedFunds
+	}{
+		{
+			name: "no destination",
+			dest: nil,
+			exp:  &MsgReleaseSeizedFunds{SeizureId: 3, Authority: "authority"},
+		},
+		{
+			name: "with destination",
+			dest: dest,
+			exp:  &MsgReleaseSeizedFunds{SeizureId: 3, Destination: dest.String(), Authority: "authority"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var msg *MsgReleaseSeizedFunds
+			testFunc := func() {
+				msg = NewMsgReleaseSeizedFunds("authority", 3, tc.dest)
+			}
+			require.NotPanics(t, testFunc, "NewMsgReleaseSeizedFunds")
+			assert.Equal(t, tc.exp, msg, "NewMsgReleaseSeizedFunds result")
+		})
+	}
+}
+
+func TestMsgReleaseSeizedFunds_ValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	dest := sdk.AccAddress("destination_addr____").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgReleaseSeizedFunds
+		exp  []string
+	}{
+		{
+			name: "control",
+			msg:  &MsgReleaseSeizedFunds{SeizureId: 1, Destination: dest, Authority: authority},
+			exp:  nil,
+		},
+		{
+			name: "no destination",
+			msg:  &MsgReleaseSeizedFunds{SeizureId: 1, Authority: authority},
+			exp:  nil,
+		},
+		{
+			name: "empty authority",
+			msg:  &MsgReleaseSeizedFunds{SeizureId: 1, Authority: ""},
+			exp:  []string{"invalid address", "authority", `""`, "empty address string is not allowed"},
+		},
+		{
+			name: "zero seizure id",
+			msg:  &MsgReleaseSeizedFunds{SeizureId: 0, Authority: authority},
+			exp:  []string{"invalid request", "seizure id cannot be zero"},
+		},
+		{
+			name: "bad destination",
+			msg:  &MsgReleaseSeizedFunds{SeizureId: 1, Destination: "bad1addr", Authority: authority},
+			exp:  []string{"invalid address", "destination", `"bad1addr"`, "decoding bech32 failed"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.msg.ValidateBasic()
+			}
+			require.NotPanics(t, testFunc, "ValidateBasic")
+			assertions.AssertErrorContents(t, err, tc.exp, "ValidateBasic result.")
+		})
+	}
+}
@@ -331,6 +331,224 @@ func (m *QueryTemporaryEntriesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryEmergencySanctionsRequest defines the RPC request for listing emergency sanctions.
+type QueryEmergencySanctionsRequest struct {
+	// address is an optional address to restrict results to.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEmergencySanctionsRequest) Reset()         { *m = QueryEmergencySanctionsRequest{} }
+func (m *QueryEmergencySanctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmergencySanctionsRequest) ProtoMessage()    {}
+func (*QueryEmergencySanctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d9fc7de93fcbdc3, []int{6}
+}
+func (m *QueryEmergencySanctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmergencySanctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmergencySanctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmergencySanctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmergencySanctionsRequest.Merge(m, src)
+}
+func (m *QueryEmergencySanctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmergencySanctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmergencySanctionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmergencySanctionsRequest proto.InternalMessageInfo
+
+func (m *QueryEmergencySanctionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryEmergencySanctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEmergencySanctionsResponse defines the RPC response of an EmergencySanctions query.
+type QueryEmergencySanctionsResponse struct {
+	EmergencySanctions []*EmergencySanction `protobuf:"bytes,1,rep,name=emergency_sanctions,json=emergencySanctions,proto3" json:"emergency_sanctions,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEmergencySanctionsResponse) Reset()         { *m = QueryEmergencySanctionsResponse{} }
+func (m *QueryEmergencySanctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmergencySanctionsResponse) ProtoMessage()    {}
+func (*QueryEmergencySanctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d9fc7de93fcbdc3, []int{7}
+}
+func (m *QueryEmergencySanctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmergencySanctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmergencySanctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmergencySanctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmergencySanctionsResponse.Merge(m, src)
+}
+func (m *QueryEmergencySanctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmergencySanctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmergencySanctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmergencySanctionsResponse proto.InternalMessageInfo
+
+func (m *QueryEmergencySanctionsResponse) GetEmergencySanctions() []*EmergencySanction {
+	if m != nil {
+		return m.EmergencySanctions
+	}
+	return nil
+}
+
+func (m *QueryEmergencySanctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySeizuresRequest defines the RPC request for listing seizure records.
+type QuerySeizuresRequest struct {
+	// address is an optional address to restrict results to.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySeizuresRequest) Reset()         { *m = QuerySeizuresRequest{} }
+func (m *QuerySeizuresRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySeizuresRequest) ProtoMessage()    {}
+func (*QuerySeizuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d9fc7de93fcbdc3, []int{8}
+}
+func (m *QuerySeizuresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySeizuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySeizuresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySeizuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySeizuresRequest.Merge(m, src)
+}
+func (m *QuerySeizuresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySeizuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySeizuresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySeizuresRequest proto.InternalMessageInfo
+
+func (m *QuerySeizuresRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QuerySeizuresRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySeizuresResponse defines the RPC response of a Seizures query.
+type QuerySeizuresResponse struct {
+	Seizures []*Seizure `protobuf:"bytes,1,rep,name=seizures,proto3" json:"seizures,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySeizuresResponse) Reset()         { *m = QuerySeizuresResponse{} }
+func (m *QuerySeizuresResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySeizuresResponse) ProtoMessage()    {}
+func (*QuerySeizuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d9fc7de93fcbdc3, []int{9}
+}
+func (m *QuerySeizuresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySeizuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySeizuresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySeizuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySeizuresResponse.Merge(m, src)
+}
+func (m *QuerySeizuresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySeizuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySeizuresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySeizuresResponse proto.InternalMessageInfo
+
+func (m *QuerySeizuresResponse) GetSeizures() []*Seizure {
+	if m != nil {
+		return m.Seizures
+	}
+	return nil
+}
+
+func (m *QuerySeizuresResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryParamsRequest defines the RPC request for getting the sanction module params.
 type QueryParamsRequest struct {
 }
@@ -339,7 +557,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d9fc7de93fcbdc3, []int{6}
+	return fileDescriptor_9d9fc7de93fcbdc3, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +596,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d9fc7de93fcbdc3, []int{7}
+	return fileDescriptor_9d9fc7de93fcbdc3, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,6 +639,10 @@ func init() {
 	proto.RegisterType((*QuerySanctionedAddressesResponse)(nil), "cosmos.sanction.v1beta1.QuerySanctionedAddressesResponse")
 	proto.RegisterType((*QueryTemporaryEntriesRequest)(nil), "cosmos.sanction.v1beta1.QueryTemporaryEntriesRequest")
 	proto.RegisterType((*QueryTemporaryEntriesResponse)(nil), "cosmos.sanction.v1beta1.QueryTemporaryEntriesResponse")
+	proto.RegisterType((*QueryEmergencySanctionsRequest)(nil), "cosmos.sanction.v1beta1.QueryEmergencySanctionsRequest")
+	proto.RegisterType((*QueryEmergencySanctionsResponse)(nil), "cosmos.sanction.v1beta1.QueryEmergencySanctionsResponse")
+	proto.RegisterType((*QuerySeizuresRequest)(nil), "cosmos.sanction.v1beta1.QuerySeizuresRequest")
+	proto.RegisterType((*QuerySeizuresResponse)(nil), "cosmos.sanction.v1beta1.QuerySeizuresResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.sanction.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.sanction.v1beta1.QueryParamsResponse")
 }
@@ -430,45 +652,54 @@ func init() {
 }
 
 var fileDescriptor_9d9fc7de93fcbdc3 = []byte{
-	// 606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xc7, 0x3b, 0x1a, 0x8a, 0x3c, 0x30, 0x31, 0x03, 0x89, 0x65, 0x53, 0xb6, 0x75, 0x51, 0x68,
-	0x50, 0x76, 0x6d, 0x8d, 0xa8, 0x37, 0x21, 0xf1, 0xd7, 0x85, 0x60, 0xf1, 0xe4, 0x85, 0x4c, 0xb7,
-	0x93, 0x65, 0x63, 0x3b, 0xb3, 0xec, 0x6c, 0x89, 0x8d, 0xf1, 0xe2, 0xd9, 0x83, 0x89, 0x37, 0x0f,
-	0x5e, 0x3c, 0x68, 0xe2, 0xc5, 0x83, 0x7f, 0x84, 0x47, 0xa2, 0x17, 0x8f, 0xa6, 0xf5, 0x0f, 0x31,
-	0xcc, 0xcc, 0xf6, 0x07, 0xe9, 0x54, 0x20, 0x1c, 0x77, 0xe6, 0xfb, 0xbe, 0xef, 0x93, 0xef, 0xbc,
-	0xd7, 0xc2, 0xa2, 0xcf, 0x45, 0x93, 0x0b, 0x4f, 0x10, 0xe6, 0x27, 0x21, 0x67, 0xde, 0x7e, 0xb9,
-	0x46, 0x13, 0x52, 0xf6, 0xf6, 0x5a, 0x34, 0x6e, 0xbb, 0x51, 0xcc, 0x13, 0x8e, 0x2f, 0x2b, 0x91,
-	0x9b, 0x8a, 0x5c, 0x2d, 0xb2, 0x56, 0x74, 0x75, 0x8d, 0x08, 0xaa, 0x2a, 0x7a, 0xf5, 0x11, 0x09,
-	0x42, 0x46, 0xa4, 0x5a, 0x9a, 0x58, 0x4b, 0xa6, 0x4e, 0x3d, 0x57, 0xa5, 0x9b, 0x57, 0xba, 0x1d,
-	0xf9, 0xe5, 0xe9, 0xce, 0xea, 0x2a, 0x1f, 0x70, 0x1e, 0x34, 0xa8, 0x47, 0xa2, 0xd0, 0x23, 0x8c,
-	0xf1, 0x44, 0xfa, 0xeb, 0x5b, 0x67, 0x13, 0x72, 0x4f, 0x0f, 0x11, 0x9e, 0x88, 0x6d, 0xed, 0x48,
-	0xeb, 0x55, 0xba, 0xd7, 0xa2, 0x22, 0xc1, 0x15, 0x98, 0x24, 0xf5, 0x7a, 0x4c, 0x85, 0xc8, 0xa1,
-	0x22, 0x2a, 0x4d, 0x6d, 0xe4, 0x7e, 0x7e, 0x5f, 0x9d, 0xd3, 0xe6, 0xeb, 0xea, 0x66, 0x3b, 0x89,
-	0x43, 0x16, 0x54, 0x53, 0xa1, 0x73, 0x1f, 0xe6, 0x47, 0xf8, 0x89, 0x88, 0x33, 0x41, 0xf1, 0x22,
-	0x5c, 0x0c, 0xc5, 0x8e, 0xe8, 0x5d, 0x48, 0xdb, 0x0b, 0xd5, 0x99, 0x70, 0x40, 0xec, 0x84, 0x50,
-	0x90, 0x0e, 0xfd, 0x23, 0xdd, 0x8a, 0x8a, 0x14, 0xec, 0x21, 0x40, 0x3f, 0xa9, 0x9c, 0x5f, 0x44,
-	0xa5, 0xe9, 0xca, 0x92, 0xab, 0xc1, 0x0e, 0x63, 0x75, 0xd5, 0x43, 0xe8, 0xb0, 0xdc, 0x2d, 0x12,
-	0x50, 0x5d, 0x5b, 0x1d, 0xa8, 0x74, 0x3e, 0x21, 0x28, 0x9a, 0x7b, 0x69, 0xe8, 0x35, 0x98, 0x22,
-	0xe9, 0x61, 0x0e, 0x15, 0xcf, 0x8f, 0xcd, 0xa1, 0x2f, 0xc5, 0x8f, 0x46, 0x40, 0x2e, 0xff, 0x17,
-	0x52, 0x35, 0x1d, 0xa2, 0xfc, 0x80, 0x20, 0x2f, 0x29, 0x9f, 0xd1, 0x66, 0xc4, 0x63, 0x12, 0xb7,
-	0x1f, 0xb0, 0x24, 0x0e, 0xfb, 0x71, 0x9c, 0xe2, 0x9d, 0xce, 0x2c, 0xc2, 0xaf, 0x08, 0x16, 0x0c,
-	0x70, 0x3a, 0xbf, 0x75, 0x98, 0xa4, 0xea, 0x48, 0xa6, 0x37, 0x10, 0xc2, 0xd1, 0xcd, 0x70, 0x87,
-	0x3c, 0xda, 0xd5, 0xb4, 0xee, 0xec, 0xa2, 0x9c, 0x03, 0x2c, 0x61, 0xb7, 0x48, 0x4c, 0x9a, 0x69,
-	0x7e, 0xce, 0x26, 0xcc, 0x0e, 0x9d, 0x6a, 0xf0, 0x3b, 0x90, 0x8d, 0xe4, 0x89, 0x4c, 0x75, 0xba,
-	0x52, 0x30, 0x72, 0xeb, 0x42, 0x2d, 0xaf, 0x7c, 0x9c, 0x80, 0x09, 0x69, 0x88, 0x3f, 0x23, 0x98,
-	0x19, 0xdc, 0x04, 0x5c, 0x36, 0x7a, 0x98, 0xb6, 0xd0, 0xaa, 0x9c, 0xa4, 0x44, 0xa1, 0x3b, 0x37,
-	0xdf, 0xfc, 0xfa, 0xfb, 0xfe, 0xdc, 0x0a, 0x2e, 0x79, 0xa6, 0xdf, 0x0f, 0x7f, 0x97, 0xfa, 0x2f,
-	0xbc, 0x57, 0x7a, 0x1c, 0x5e, 0xe3, 0x6f, 0x08, 0x66, 0x47, 0x6c, 0x01, 0xbe, 0x3b, 0xbe, 0xbb,
-	0x79, 0x49, 0xad, 0x7b, 0xa7, 0xa8, 0xd4, 0xf8, 0x57, 0x25, 0xbe, 0x8d, 0xf3, 0x46, 0x7c, 0xd2,
-	0x68, 0xe0, 0x2f, 0x08, 0x2e, 0x1d, 0x9d, 0x3a, 0x7c, 0x7b, 0x7c, 0x57, 0xc3, 0x0a, 0x59, 0x6b,
-	0x27, 0x2d, 0xd3, 0xa4, 0xd7, 0x24, 0x69, 0x01, 0x2f, 0x18, 0x49, 0x13, 0xda, 0x8c, 0xf0, 0x5b,
-	0x04, 0x59, 0x35, 0x24, 0xf8, 0xfa, 0xf8, 0x4e, 0x43, 0x93, 0x69, 0xdd, 0x38, 0x9e, 0x58, 0xc3,
-	0x2c, 0x4b, 0x98, 0x2b, 0xb8, 0x60, 0x84, 0x51, 0x03, 0xba, 0xf1, 0xf8, 0x47, 0xc7, 0x46, 0x07,
-	0x1d, 0x1b, 0xfd, 0xe9, 0xd8, 0xe8, 0x5d, 0xd7, 0xce, 0x1c, 0x74, 0xed, 0xcc, 0xef, 0xae, 0x9d,
-	0x79, 0xee, 0x06, 0x61, 0xb2, 0xdb, 0xaa, 0xb9, 0x3e, 0x6f, 0x7a, 0x51, 0xcc, 0xf7, 0x29, 0x23,
-	0xcc, 0xa7, 0xab, 0x21, 0x1f, 0xf8, 0xf2, 0x5e, 0xf6, 0x8c, 0x6b, 0x59, 0xf9, 0x2f, 0x72, 0xeb,
-	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x42, 0x5f, 0xe8, 0x01, 0x12, 0x07, 0x00, 0x00,
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4d, 0x4f, 0xd4, 0x5e,
+	0x14, 0xc6, 0xb9, 0xff, 0x7f, 0xe4, 0xe5, 0x80, 0x89, 0xb9, 0x60, 0x1c, 0x1a, 0xe8, 0x0c, 0x05,
+	0x01, 0x47, 0x69, 0x65, 0x8c, 0x80, 0x89, 0x0b, 0x21, 0xc1, 0x97, 0x0d, 0xc1, 0xe2, 0x4a, 0x17,
+	0xe4, 0x4e, 0xb9, 0x29, 0x8d, 0x4c, 0x5b, 0x7a, 0x3b, 0x44, 0x34, 0x6e, 0x5c, 0xbb, 0x30, 0xea,
+	0xca, 0x18, 0x37, 0x2e, 0x34, 0x71, 0x63, 0xa2, 0xdf, 0x41, 0x97, 0x44, 0x37, 0x2e, 0x0d, 0xb8,
+	0xf3, 0x4b, 0x18, 0x6e, 0x4f, 0xcb, 0x0c, 0x70, 0x07, 0xd0, 0x59, 0xb0, 0x9c, 0x7b, 0xcf, 0xf3,
+	0x9c, 0x5f, 0x9e, 0xf6, 0x9c, 0x0e, 0x0c, 0x3a, 0x81, 0xa8, 0x04, 0xc2, 0x12, 0xcc, 0x77, 0x62,
+	0x2f, 0xf0, 0xad, 0xb5, 0xf1, 0x32, 0x8f, 0xd9, 0xb8, 0xb5, 0x5a, 0xe5, 0xd1, 0xba, 0x19, 0x46,
+	0x41, 0x1c, 0xd0, 0x33, 0x49, 0x91, 0x99, 0x16, 0x99, 0x58, 0xa4, 0x15, 0x51, 0x5d, 0x66, 0x82,
+	0x27, 0x8a, 0x4c, 0x1f, 0x32, 0xd7, 0xf3, 0x99, 0xac, 0x96, 0x26, 0xda, 0xb0, 0xaa, 0x53, 0xe6,
+	0x9a, 0xd4, 0xf5, 0x26, 0x75, 0x8b, 0xf2, 0x97, 0x85, 0x9d, 0x93, 0xab, 0x3e, 0x37, 0x08, 0xdc,
+	0x15, 0x6e, 0xb1, 0xd0, 0xb3, 0x98, 0xef, 0x07, 0xb1, 0xf4, 0xc7, 0x5b, 0x63, 0x0e, 0x72, 0xb7,
+	0xb7, 0x11, 0x6e, 0x89, 0x05, 0x74, 0xe4, 0x4b, 0x36, 0x5f, 0xad, 0x72, 0x11, 0xd3, 0x12, 0xb4,
+	0xb1, 0xa5, 0xa5, 0x88, 0x0b, 0x91, 0x23, 0x05, 0x32, 0xda, 0x31, 0x93, 0xfb, 0xf6, 0x79, 0xac,
+	0x07, 0xcd, 0xa7, 0x93, 0x9b, 0x85, 0x38, 0xf2, 0x7c, 0xd7, 0x4e, 0x0b, 0x8d, 0x6b, 0xd0, 0xbb,
+	0x8f, 0x9f, 0x08, 0x03, 0x5f, 0x70, 0x3a, 0x08, 0x27, 0x3d, 0xb1, 0x28, 0xb2, 0x0b, 0x69, 0xdb,
+	0x6e, 0x77, 0x79, 0x35, 0xc5, 0x86, 0x07, 0x79, 0xe9, 0xb0, 0x73, 0x84, 0xad, 0xb8, 0x48, 0xc1,
+	0xae, 0x03, 0xec, 0x24, 0x95, 0x73, 0x0a, 0x64, 0xb4, 0xb3, 0x34, 0x6c, 0x22, 0xd8, 0x76, 0xac,
+	0x66, 0xf2, 0x20, 0x30, 0x2c, 0x73, 0x9e, 0xb9, 0x1c, 0xb5, 0x76, 0x8d, 0xd2, 0x78, 0x4b, 0xa0,
+	0xa0, 0xee, 0x85, 0xd0, 0x13, 0xd0, 0xc1, 0xd2, 0xc3, 0x1c, 0x29, 0xfc, 0xdf, 0x30, 0x87, 0x9d,
+	0x52, 0x7a, 0x63, 0x1f, 0xc8, 0x91, 0x03, 0x21, 0x93, 0xa6, 0x75, 0x94, 0xaf, 0x08, 0xf4, 0x49,
+	0xca, 0x3b, 0xbc, 0x12, 0x06, 0x11, 0x8b, 0xd6, 0x67, 0xfd, 0x38, 0xf2, 0xb8, 0xf8, 0x87, 0xe7,
+	0xd4, 0xb4, 0x08, 0x3f, 0x10, 0xe8, 0x57, 0xc0, 0x61, 0x7e, 0xd3, 0xd0, 0xc6, 0x93, 0x23, 0x99,
+	0x5e, 0x4d, 0x08, 0xbb, 0x27, 0xc3, 0xac, 0xf3, 0x58, 0xb7, 0x53, 0x5d, 0xf3, 0xa2, 0x7c, 0x4d,
+	0x40, 0x97, 0xb4, 0xb3, 0x15, 0x1e, 0xb9, 0xdc, 0x77, 0xb2, 0x27, 0x7f, 0x2c, 0xc2, 0xfc, 0x42,
+	0x20, 0xaf, 0xc4, 0xc3, 0x38, 0xef, 0x41, 0x37, 0x4f, 0x6f, 0xb3, 0x51, 0x4a, 0xa3, 0x2d, 0x2a,
+	0xa3, 0xdd, 0xe3, 0x68, 0x53, 0xbe, 0xa7, 0x49, 0xf3, 0x82, 0x7e, 0x4e, 0xa0, 0x27, 0x99, 0x2c,
+	0xee, 0x3d, 0xac, 0x46, 0xc7, 0xe3, 0x5d, 0x7d, 0x43, 0xe0, 0xf4, 0x2e, 0x28, 0x0c, 0xf5, 0x2a,
+	0xb4, 0x0b, 0x3c, 0xc3, 0x24, 0x0b, 0xca, 0x24, 0x51, 0x6c, 0x67, 0x8a, 0xe6, 0xa5, 0xd6, 0x03,
+	0x54, 0xf2, 0xcd, 0xb3, 0x88, 0x55, 0xd2, 0xc8, 0x8c, 0x39, 0xe8, 0xae, 0x3b, 0x45, 0xe6, 0x49,
+	0x68, 0x0d, 0xe5, 0x89, 0x0c, 0xb2, 0xb3, 0x94, 0x57, 0x12, 0xa3, 0x10, 0xcb, 0x4b, 0xbf, 0xdb,
+	0xe0, 0x84, 0x34, 0xa4, 0xef, 0x08, 0x74, 0xd5, 0x2e, 0x6a, 0x3a, 0xae, 0xf4, 0x50, 0x7d, 0x24,
+	0xb4, 0xd2, 0x51, 0x24, 0x09, 0xba, 0x71, 0xf1, 0xc9, 0xf7, 0x5f, 0x2f, 0xfe, 0x2b, 0xd2, 0x51,
+	0x4b, 0xf5, 0x79, 0x73, 0x96, 0xb9, 0x73, 0xdf, 0x7a, 0x84, 0x6f, 0xc0, 0x63, 0xfa, 0x91, 0x40,
+	0xf7, 0x3e, 0x4b, 0x9a, 0x4e, 0x35, 0xee, 0xae, 0xfe, 0x86, 0x68, 0x57, 0xfe, 0x42, 0x89, 0xf8,
+	0x43, 0x12, 0x5f, 0xa7, 0x7d, 0x4a, 0x7c, 0xb6, 0xb2, 0x42, 0xdf, 0x13, 0x38, 0xb5, 0x7b, 0x29,
+	0xd2, 0xcb, 0x8d, 0xbb, 0x2a, 0x36, 0xbc, 0x36, 0x71, 0x54, 0x19, 0x92, 0x9e, 0x95, 0xa4, 0x79,
+	0xda, 0xaf, 0x24, 0x8d, 0x79, 0x25, 0xa4, 0x9f, 0x08, 0xd0, 0xbd, 0x2b, 0x87, 0x4e, 0x36, 0xee,
+	0xaa, 0xdc, 0xa1, 0xda, 0xd4, 0xd1, 0x85, 0x08, 0x5c, 0x94, 0xc0, 0x43, 0xd4, 0x50, 0x02, 0x67,
+	0x5b, 0x8b, 0xbe, 0x24, 0xd0, 0x9e, 0x4e, 0x32, 0x1d, 0x3b, 0xe0, 0x71, 0xd6, 0xaf, 0x21, 0xcd,
+	0x3c, 0x6c, 0x39, 0x72, 0x9d, 0x93, 0x5c, 0x83, 0x74, 0x40, 0xc9, 0x95, 0x6d, 0x83, 0xa7, 0x04,
+	0x5a, 0x93, 0x89, 0xa3, 0xe7, 0x1b, 0x77, 0xa9, 0x1b, 0x73, 0xed, 0xc2, 0xe1, 0x8a, 0x11, 0x68,
+	0x44, 0x02, 0x0d, 0xd0, 0xbc, 0x12, 0x28, 0x99, 0xf6, 0x99, 0x9b, 0x5f, 0x37, 0x75, 0xb2, 0xb1,
+	0xa9, 0x93, 0x9f, 0x9b, 0x3a, 0x79, 0xb6, 0xa5, 0xb7, 0x6c, 0x6c, 0xe9, 0x2d, 0x3f, 0xb6, 0xf4,
+	0x96, 0xbb, 0xa6, 0xeb, 0xc5, 0xcb, 0xd5, 0xb2, 0xe9, 0x04, 0x15, 0x2b, 0x8c, 0x82, 0x35, 0xee,
+	0x33, 0xdf, 0xe1, 0x63, 0x5e, 0x50, 0xf3, 0xcb, 0x7a, 0x90, 0x19, 0x97, 0x5b, 0xe5, 0x3f, 0xc6,
+	0x4b, 0x7f, 0x06, 0x00, 0x80, 0x10, 0x2d, 0x62, 0xfe, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -489,6 +720,10 @@ type QueryClient interface {
 	SanctionedAddresses(ctx context.Context, in *QuerySanctionedAddressesRequest, opts ...grpc.CallOption) (*QuerySanctionedAddressesResponse, error)
 	// TemporaryEntries returns temporary sanction/unsanction info.
 	TemporaryEntries(ctx context.Context, in *QueryTemporaryEntriesRequest, opts ...grpc.CallOption) (*QueryTemporaryEntriesResponse, error)
+	// EmergencySanctions returns the emergency sanctions issued by compliance officers that have not yet expired.
+	EmergencySanctions(ctx context.Context, in *QueryEmergencySanctionsRequest, opts ...grpc.CallOption) (*QueryEmergencySanctionsResponse, error)
+	// Seizures returns the records of funds seized from sanctioned addresses.
+	Seizures(ctx context.Context, in *QuerySeizuresRequest, opts ...grpc.CallOption) (*QuerySeizuresResponse, error)
 	// Params returns the sanction module's params.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -528,6 +763,24 @@ func (c *queryClient) TemporaryEntries(ctx context.Context, in *QueryTemporaryEn
 	return out, nil
 }
 
+func (c *queryClient) EmergencySanctions(ctx context.Context, in *QueryEmergencySanctionsRequest, opts ...grpc.CallOption) (*QueryEmergencySanctionsResponse, error) {
+	out := new(QueryEmergencySanctionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.sanction.v1beta1.Query/EmergencySanctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Seizures(ctx context.Context, in *QuerySeizuresRequest, opts ...grpc.CallOption) (*QuerySeizuresResponse, error) {
+	out := new(QuerySeizuresResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.sanction.v1beta1.Query/Seizures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.sanction.v1beta1.Query/Params", in, out, opts...)
@@ -545,6 +798,10 @@ type QueryServer interface {
 	SanctionedAddresses(context.Context, *QuerySanctionedAddressesRequest) (*QuerySanctionedAddressesResponse, error)
 	// TemporaryEntries returns temporary sanction/unsanction info.
 	TemporaryEntries(context.Context, *QueryTemporaryEntriesRequest) (*QueryTemporaryEntriesResponse, error)
+	// EmergencySanctions returns the emergency sanctions issued by compliance officers that have not yet expired.
+	EmergencySanctions(context.Context, *QueryEmergencySanctionsRequest) (*QueryEmergencySanctionsResponse, error)
+	// Seizures returns the records of funds seized from sanctioned addresses.
+	Seizures(context.Context, *QuerySeizuresRequest) (*QuerySeizuresResponse, error)
 	// Params returns the sanction module's params.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -562,6 +819,12 @@ func (*UnimplementedQueryServer) SanctionedAddresses(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) TemporaryEntries(ctx context.Context, req *QueryTemporaryEntriesRequest) (*QueryTemporaryEntriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TemporaryEntries not implemented")
 }
+func (*UnimplementedQueryServer) EmergencySanctions(ctx context.Context, req *QueryEmergencySanctionsRequest) (*QueryEmergencySanctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencySanctions not implemented")
+}
+func (*UnimplementedQueryServer) Seizures(ctx context.Context, req *QuerySeizuresRequest) (*QuerySeizuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Seizures not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -624,6 +887,42 @@ func _Query_TemporaryEntries_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EmergencySanctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmergencySanctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmergencySanctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.sanction.v1beta1.Query/EmergencySanctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmergencySanctions(ctx, req.(*QueryEmergencySanctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Seizures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySeizuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Seizures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.sanction.v1beta1.Query/Seizures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Seizures(ctx, req.(*QuerySeizuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -659,6 +958,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TemporaryEntries",
 			Handler:    _Query_TemporaryEntries_Handler,
 		},
+		{
+			MethodName: "EmergencySanctions",
+			Handler:    _Query_EmergencySanctions_Handler,
+		},
+		{
+			MethodName: "Seizures",
+			Handler:    _Query_Seizures_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -909,7 +1216,7 @@ func (m *QueryTemporaryEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryEmergencySanctionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -919,20 +1226,41 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEmergencySanctionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEmergencySanctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryEmergencySanctionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -942,19 +1270,19 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEmergencySanctionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEmergencySanctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -962,27 +1290,196 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.EmergencySanctions) > 0 {
+		for iNdEx := len(m.EmergencySanctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmergencySanctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySeizuresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryIsSanctionedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+
+func (m *QuerySeizuresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySeizuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySeizuresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySeizuresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySeizuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Seizures) > 0 {
+		for iNdEx := len(m.Seizures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Seizures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIsSanctionedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Address)
 	if l > 0 {
@@ -1071,6 +1568,78 @@ func (m *QueryTemporaryEntriesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEmergencySanctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEmergencySanctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EmergencySanctions) > 0 {
+		for _, e := range m.EmergencySanctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySeizuresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySeizuresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Seizures) > 0 {
+		for _, e := range m.Seizures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1693,6 +2262,482 @@ func (m *QueryTemporaryEntriesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEmergencySanctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmergencySanctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmergencySanctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmergencySanctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmergencySanctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmergencySanctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencySanctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencySanctions = append(m.EmergencySanctions, &EmergencySanction{})
+			if err := m.EmergencySanctions[len(m.EmergencySanctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySeizuresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySeizuresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySeizuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySeizuresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySeizuresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySeizuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seizures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seizures = append(m.Seizures, &Seizure{})
+			if err := m.Seizures[len(m.Seizures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package sanction
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -12,12 +14,20 @@ var (
 
 	// DefaultImmediateUnsanctionMinDeposit is the default to use for the MinDepositUnsanction.
 	DefaultImmediateUnsanctionMinDeposit sdk.Coins
+
+	// DefaultComplianceOfficers is the default to use for the ComplianceOfficers.
+	DefaultComplianceOfficers []string
+
+	// DefaultEmergencySanctionDuration is the default to use for the EmergencySanctionDuration.
+	DefaultEmergencySanctionDuration = 72 * time.Hour
 )
 
 func DefaultParams() *Params {
 	return &Params{
 		ImmediateSanctionMinDeposit:   DefaultImmediateSanctionMinDeposit,
 		ImmediateUnsanctionMinDeposit: DefaultImmediateUnsanctionMinDeposit,
+		ComplianceOfficers:            DefaultComplianceOfficers,
+		EmergencySanctionDuration:     DefaultEmergencySanctionDuration,
 	}
 }
 
@@ -28,5 +38,18 @@ func (p Params) ValidateBasic() error {
 	if err := p.ImmediateUnsanctionMinDeposit.Validate(); err != nil {
 		return sdkerrors.ErrInvalidCoins.Wrapf("invalid immediate unsanction min deposit: %s", err.Error())
 	}
+	seen := make(map[string]bool, len(p.ComplianceOfficers))
+	for i, addr := range p.ComplianceOfficers {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("compliance officers[%d], %q: %v", i, addr, err)
+		}
+		if seen[addr] {
+			return sdkerrors.ErrInvalidAddress.Wrapf("compliance officers[%d], %q: duplicate address", i, addr)
+		}
+		seen[addr] = true
+	}
+	if p.EmergencySanctionDuration < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid emergency sanction duration: %s cannot be negative", p.EmergencySanctionDuration)
+	}
 	return nil
 }
@@ -11,15 +11,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -59,6 +64,43 @@ func (TempStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9e632afabc7910f0, []int{0}
 }
 
+// SeizureStatus is the state of the funds of a seizure.
+type SeizureStatus int32
+
+const (
+	// SEIZURE_STATUS_UNSPECIFIED represents an unspecified status value.
+	SEIZURE_STATUS_UNSPECIFIED SeizureStatus = 0
+	// SEIZURE_STATUS_HELD indicates the funds are held in the sanction module's escrow account.
+	SEIZURE_STATUS_HELD SeizureStatus = 1
+	// SEIZURE_STATUS_RELEASED indicates the funds were sent from escrow to an address other than the one they were
+	// seized from.
+	SEIZURE_STATUS_RELEASED SeizureStatus = 2
+	// SEIZURE_STATUS_RETURNED indicates the funds were sent from escrow back to the address they were seized from.
+	SEIZURE_STATUS_RETURNED SeizureStatus = 3
+)
+
+var SeizureStatus_name = map[int32]string{
+	0: "SEIZURE_STATUS_UNSPECIFIED",
+	1: "SEIZURE_STATUS_HELD",
+	2: "SEIZURE_STATUS_RELEASED",
+	3: "SEIZURE_STATUS_RETURNED",
+}
+
+var SeizureStatus_value = map[string]int32{
+	"SEIZURE_STATUS_UNSPECIFIED": 0,
+	"SEIZURE_STATUS_HELD":        1,
+	"SEIZURE_STATUS_RELEASED":    2,
+	"SEIZURE_STATUS_RETURNED":    3,
+}
+
+func (x SeizureStatus) String() string {
+	return proto.EnumName(SeizureStatus_name, int32(x))
+}
+
+func (SeizureStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9e632afabc7910f0, []int{1}
+}
+
 // Params defines the configurable parameters of the sanction module.
 type Params struct {
 	// immediate_sanction_min_deposit is the minimum deposit for a sanction to happen immediately.
@@ -71,6 +113,12 @@ type Params struct {
 	// Otherwise, if an unsanction governance proposal is issued with a deposit at least this large, a temporary
 	// unsanction will be immediately issued that will expire when voting ends on the governance proposal.
 	ImmediateUnsanctionMinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=immediate_unsanction_min_deposit,json=immediateUnsanctionMinDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"immediate_unsanction_min_deposit"`
+	// compliance_officers are the addresses allowed to issue emergency sanctions without a governance proposal.
+	// Emergency sanctions are temporary and will expire unless ratified by a governance proposal.
+	ComplianceOfficers []string `protobuf:"bytes,3,rep,name=compliance_officers,json=complianceOfficers,proto3" json:"compliance_officers,omitempty"`
+	// emergency_sanction_duration is how long an emergency sanction lasts before it expires.
+	// If this is zero, emergency sanctions are not available.
+	EmergencySanctionDuration time.Duration `protobuf:"bytes,4,opt,name=emergency_sanction_duration,json=emergencySanctionDuration,proto3,stdduration" json:"emergency_sanction_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -120,11 +168,26 @@ func (m *Params) GetImmediateUnsanctionMinDeposit() github_com_cosmos_cosmos_sdk
 	return nil
 }
 
+func (m *Params) GetComplianceOfficers() []string {
+	if m != nil {
+		return m.ComplianceOfficers
+	}
+	return nil
+}
+
+func (m *Params) GetEmergencySanctionDuration() time.Duration {
+	if m != nil {
+		return m.EmergencySanctionDuration
+	}
+	return 0
+}
+
 // TemporaryEntry defines the information involved in a temporary sanction or unsanction.
 type TemporaryEntry struct {
 	// address is the address of this temporary entry.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// proposal_id is the governance proposal id associated with this temporary entry.
+	// A proposal_id of zero indicates an emergency sanction issued by a compliance officer.
 	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 	// status is whether the entry is a sanction or unsanction.
 	Status TempStatus `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.sanction.v1beta1.TempStatus" json:"status,omitempty"`
@@ -184,10 +247,169 @@ func (m *TemporaryEntry) GetStatus() TempStatus {
 	return TEMP_STATUS_UNSPECIFIED
 }
 
+// EmergencySanction defines the information about a temporary sanction issued by a compliance officer.
+type EmergencySanction struct {
+	// address is the address that is sanctioned.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// officer is the address of the compliance officer that issued the sanction.
+	Officer string `protobuf:"bytes,2,opt,name=officer,proto3" json:"officer,omitempty"`
+	// expires_at is the time at which this sanction will expire if it has not been ratified by governance.
+	ExpiresAt time.Time `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+}
+
+func (m *EmergencySanction) Reset()         { *m = EmergencySanction{} }
+func (m *EmergencySanction) String() string { return proto.CompactTextString(m) }
+func (*EmergencySanction) ProtoMessage()    {}
+func (*EmergencySanction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e632afabc7910f0, []int{2}
+}
+func (m *EmergencySanction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmergencySanction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmergencySanction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmergencySanction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencySanction.Merge(m, src)
+}
+func (m *EmergencySanction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmergencySanction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencySanction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmergencySanction proto.InternalMessageInfo
+
+func (m *EmergencySanction) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EmergencySanction) GetOfficer() string {
+	if m != nil {
+		return m.Officer
+	}
+	return ""
+}
+
+func (m *EmergencySanction) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+// Seizure is a record of funds that were moved from a sanctioned address into the sanction module's escrow account.
+type Seizure struct {
+	// id is the unique identifier of this seizure.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address is the sanctioned address that the funds were seized from.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the funds that were seized.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// seized_at is the block time at which the funds were seized.
+	SeizedAt time.Time `protobuf:"bytes,4,opt,name=seized_at,json=seizedAt,proto3,stdtime" json:"seized_at"`
+	// status is whether the funds are still held in escrow, or have been released or returned.
+	Status SeizureStatus `protobuf:"varint,5,opt,name=status,proto3,enum=cosmos.sanction.v1beta1.SeizureStatus" json:"status,omitempty"`
+	// recipient is the address that the funds were sent to when they were released or returned.
+	// It is empty while the funds are held.
+	Recipient string `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *Seizure) Reset()         { *m = Seizure{} }
+func (m *Seizure) String() string { return proto.CompactTextString(m) }
+func (*Seizure) ProtoMessage()    {}
+func (*Seizure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e632afabc7910f0, []int{3}
+}
+func (m *Seizure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Seizure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Seizure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Seizure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Seizure.Merge(m, src)
+}
+func (m *Seizure) XXX_Size() int {
+	return m.Size()
+}
+func (m *Seizure) XXX_DiscardUnknown() {
+	xxx_messageInfo_Seizure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Seizure proto.InternalMessageInfo
+
+func (m *Seizure) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Seizure) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Seizure) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Seizure) GetSeizedAt() time.Time {
+	if m != nil {
+		return m.SeizedAt
+	}
+	return time.Time{}
+}
+
+func (m *Seizure) GetStatus() SeizureStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SEIZURE_STATUS_UNSPECIFIED
+}
+
+func (m *Seizure) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.sanction.v1beta1.TempStatus", TempStatus_name, TempStatus_value)
+	proto.RegisterEnum("cosmos.sanction.v1beta1.SeizureStatus", SeizureStatus_name, SeizureStatus_value)
 	proto.RegisterType((*Params)(nil), "cosmos.sanction.v1beta1.Params")
 	proto.RegisterType((*TemporaryEntry)(nil), "cosmos.sanction.v1beta1.TemporaryEntry")
+	proto.RegisterType((*EmergencySanction)(nil), "cosmos.sanction.v1beta1.EmergencySanction")
+	proto.RegisterType((*Seizure)(nil), "cosmos.sanction.v1beta1.Seizure")
 }
 
 func init() {
@@ -195,38 +417,57 @@ func init() {
 }
 
 var fileDescriptor_9e632afabc7910f0 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x7d, 0x69, 0x15, 0xc4, 0x15, 0x55, 0xc5, 0xaa, 0xa8, 0x9b, 0x82, 0x63, 0x15, 0x09,
-	0x59, 0x91, 0x62, 0xab, 0x61, 0x64, 0xca, 0x1f, 0x57, 0x64, 0x68, 0x88, 0x62, 0x67, 0x61, 0xb1,
-	0x2e, 0xf6, 0xc9, 0x9c, 0xa8, 0xef, 0xac, 0xbb, 0x4b, 0x45, 0xbe, 0x01, 0x23, 0x33, 0x23, 0x48,
-	0x08, 0x75, 0xea, 0xc0, 0x87, 0xe8, 0x58, 0x31, 0x31, 0x15, 0x94, 0x0c, 0xfd, 0x1a, 0x28, 0xf6,
-	0xc5, 0x89, 0x10, 0xac, 0x2c, 0xb6, 0xef, 0x79, 0x9e, 0xf7, 0xd1, 0xcf, 0xf2, 0x6b, 0xf8, 0x2c,
-	0x62, 0x22, 0x65, 0xc2, 0x15, 0x88, 0x46, 0x92, 0x30, 0xea, 0x5e, 0x9c, 0x4c, 0xb0, 0x44, 0x27,
-	0xa5, 0xe0, 0x64, 0x9c, 0x49, 0xa6, 0x1f, 0x14, 0x39, 0xa7, 0x94, 0x55, 0xae, 0xf6, 0x10, 0xa5,
-	0x84, 0x32, 0x37, 0xbf, 0x16, 0xd9, 0x9a, 0xa9, 0x3a, 0x27, 0x48, 0xe0, 0xb2, 0x2f, 0x62, 0x44,
-	0x75, 0xd5, 0x0e, 0x0b, 0x3f, 0xcc, 0x4f, 0xae, 0x2a, 0x2e, 0xac, 0xfd, 0x84, 0x25, 0xac, 0xd0,
-	0x97, 0x4f, 0x85, 0x7a, 0x7c, 0x5b, 0x81, 0xd5, 0x21, 0xe2, 0x28, 0x15, 0xfa, 0x17, 0x00, 0x4d,
-	0x92, 0xa6, 0x38, 0x26, 0x48, 0xe2, 0x70, 0x45, 0x13, 0xa6, 0x84, 0x86, 0x31, 0xce, 0x98, 0x20,
-	0xd2, 0x00, 0xd6, 0x96, 0xbd, 0xd3, 0x3a, 0x74, 0x54, 0xf1, 0x92, 0x62, 0x45, 0xeb, 0x74, 0x19,
-	0xa1, 0x9d, 0xd3, 0xeb, 0xdb, 0xba, 0x76, 0xf9, 0xb3, 0x6e, 0x27, 0x44, 0xbe, 0x99, 0x4e, 0x9c,
-	0x88, 0xa5, 0x8a, 0x42, 0xdd, 0x9a, 0x22, 0x7e, 0xeb, 0xca, 0x59, 0x86, 0x45, 0x3e, 0x20, 0x3e,
-	0xde, 0x5d, 0x35, 0x1e, 0x9c, 0xe3, 0x04, 0x45, 0xb3, 0x70, 0xf9, 0x1e, 0xe2, 0xeb, 0xdd, 0x55,
-	0x03, 0x8c, 0x8e, 0x4a, 0x10, 0x5f, 0x71, 0x9c, 0x11, 0xda, 0x2b, 0x28, 0xf4, 0x4b, 0x00, 0xad,
-	0x35, 0xe8, 0x94, 0xfe, 0x15, 0xb5, 0xf2, 0xbf, 0x50, 0x9f, 0x94, 0x28, 0xe3, 0x92, 0x64, 0x0d,
-	0x7b, 0xfc, 0x09, 0xc0, 0xdd, 0x00, 0xa7, 0x19, 0xe3, 0x88, 0xcf, 0x3c, 0x2a, 0xf9, 0x4c, 0x6f,
-	0xc1, 0x7b, 0x28, 0x8e, 0x39, 0x16, 0xc2, 0x00, 0x16, 0xb0, 0xef, 0x77, 0x8c, 0xef, 0xdf, 0x9a,
-	0xfb, 0x0a, 0xb4, 0x5d, 0x38, 0xbe, 0xe4, 0x84, 0x26, 0xa3, 0x55, 0x50, 0xaf, 0xc3, 0x9d, 0x8c,
-	0xb3, 0x8c, 0x09, 0x74, 0x1e, 0x92, 0xd8, 0xa8, 0x58, 0xc0, 0xde, 0x1e, 0xc1, 0x95, 0xd4, 0x8f,
-	0xf5, 0x17, 0xb0, 0x2a, 0x24, 0x92, 0x53, 0x61, 0x6c, 0x59, 0xc0, 0xde, 0x6d, 0x3d, 0x75, 0xfe,
-	0xb1, 0x56, 0xce, 0x92, 0xc6, 0xcf, 0xa3, 0x23, 0x35, 0xd2, 0x20, 0x10, 0xae, 0x55, 0xfd, 0x08,
-	0x1e, 0x04, 0xde, 0xd9, 0x30, 0xf4, 0x83, 0x76, 0x30, 0xf6, 0xc3, 0xf1, 0xc0, 0x1f, 0x7a, 0xdd,
-	0xfe, 0x69, 0xdf, 0xeb, 0xed, 0x69, 0x7a, 0x0d, 0x3e, 0xda, 0x34, 0xfd, 0xf6, 0xa0, 0x1b, 0xf4,
-	0x5f, 0x0d, 0xbc, 0xde, 0x1e, 0xd0, 0x1f, 0x43, 0xe3, 0x8f, 0xc1, 0xb5, 0x5b, 0xa9, 0x6d, 0xbf,
-	0xff, 0x6c, 0x6a, 0x9d, 0x97, 0xd7, 0x73, 0x13, 0xdc, 0xcc, 0x4d, 0xf0, 0x6b, 0x6e, 0x82, 0x0f,
-	0x0b, 0x53, 0xbb, 0x59, 0x98, 0xda, 0x8f, 0x85, 0xa9, 0xbd, 0x76, 0x36, 0x3e, 0x44, 0xc6, 0xd9,
-	0x05, 0xa6, 0x88, 0x46, 0xb8, 0x49, 0xd8, 0xc6, 0xc9, 0x7d, 0x57, 0xfe, 0x3d, 0x93, 0x6a, 0xbe,
-	0xc1, 0xcf, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x31, 0x26, 0x2e, 0x68, 0x03, 0x00, 0x00,
+	// 798 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0xc7, 0x75, 0x92, 0x2a, 0x47, 0xe7, 0xd6, 0x48, 0x2e, 0x41, 0x45, 0x53, 0x2d, 0x25, 0xa8,
+	0x40, 0x20, 0x08, 0x30, 0x89, 0xa8, 0x40, 0x97, 0x02, 0x05, 0xf4, 0x83, 0x41, 0x04, 0x24, 0x8e,
+	0x41, 0x4a, 0x4b, 0x16, 0xe2, 0x44, 0x9e, 0xd9, 0x43, 0x45, 0x1e, 0xc1, 0x3b, 0x05, 0x51, 0xd6,
+	0x2e, 0x99, 0x8a, 0x8c, 0x45, 0xc7, 0x16, 0x28, 0x8a, 0x4c, 0x19, 0xfa, 0x07, 0x74, 0xcc, 0x18,
+	0x74, 0xea, 0xd4, 0x14, 0xf6, 0x90, 0x7f, 0xa3, 0x20, 0x79, 0xa4, 0x64, 0xd9, 0xae, 0xdb, 0x0e,
+	0x5e, 0x6c, 0xf1, 0xde, 0xf7, 0xbd, 0xfb, 0xbc, 0xef, 0x7b, 0x94, 0xe0, 0x5d, 0x97, 0xf1, 0x80,
+	0x71, 0x83, 0xe3, 0xd0, 0x15, 0x94, 0x85, 0xc6, 0xd3, 0x7b, 0x73, 0x22, 0xf0, 0xbd, 0xe2, 0x40,
+	0x8f, 0x62, 0x26, 0x18, 0x6a, 0x64, 0x3a, 0xbd, 0x38, 0x96, 0x3a, 0xf5, 0x16, 0x0e, 0x68, 0xc8,
+	0x8c, 0xf4, 0x6f, 0xa6, 0x55, 0x35, 0x59, 0x73, 0x8e, 0x39, 0x29, 0xea, 0xb9, 0x8c, 0xca, 0x5a,
+	0xea, 0x7e, 0x16, 0x77, 0xd2, 0x27, 0x43, 0x16, 0xce, 0x42, 0x77, 0x7c, 0xe6, 0xb3, 0xec, 0x3c,
+	0xf9, 0x94, 0x17, 0xf4, 0x19, 0xf3, 0x17, 0xc4, 0x48, 0x9f, 0xe6, 0xcb, 0x63, 0xc3, 0x5b, 0xc6,
+	0x78, 0x0d, 0xa7, 0xb6, 0xb6, 0xe3, 0x82, 0x06, 0x84, 0x0b, 0x1c, 0x44, 0x99, 0xa0, 0xf3, 0x5d,
+	0x15, 0xd6, 0x8e, 0x70, 0x8c, 0x03, 0x8e, 0x7e, 0x06, 0x50, 0xa3, 0x41, 0x40, 0x3c, 0x8a, 0x05,
+	0x71, 0xf2, 0x76, 0x9c, 0x80, 0x86, 0x8e, 0x47, 0x22, 0xc6, 0xa9, 0x50, 0x40, 0xbb, 0xd2, 0xdd,
+	0xed, 0xef, 0xeb, 0x92, 0x2c, 0x69, 0x23, 0x6f, 0x57, 0x1f, 0x31, 0x1a, 0x0e, 0xef, 0xbf, 0xf9,
+	0xb3, 0x55, 0x7a, 0xf5, 0xae, 0xd5, 0xf5, 0xa9, 0xf8, 0x7a, 0x39, 0xd7, 0x5d, 0x16, 0xc8, 0x36,
+	0xe4, 0xbf, 0x03, 0xee, 0x7d, 0x63, 0x88, 0x55, 0x44, 0x78, 0x9a, 0xc0, 0x7f, 0x78, 0xff, 0xba,
+	0xf7, 0xe1, 0x82, 0xf8, 0xd8, 0x5d, 0x39, 0x89, 0x11, 0xfc, 0x97, 0xf7, 0xaf, 0x7b, 0xc0, 0x6a,
+	0x16, 0x20, 0xb6, 0xe4, 0x78, 0x44, 0xc3, 0x71, 0x46, 0x81, 0x5e, 0x01, 0xd8, 0x5e, 0x83, 0x2e,
+	0xc3, 0x0b, 0x51, 0xcb, 0xd7, 0x85, 0xfa, 0x69, 0x81, 0x32, 0x0b, 0xf9, 0x79, 0xd8, 0x09, 0xbc,
+	0xed, 0xb2, 0x20, 0x5a, 0x50, 0x1c, 0xba, 0xc4, 0x61, 0xc7, 0xc7, 0xd4, 0x25, 0x31, 0x57, 0x2a,
+	0xed, 0x4a, 0xb7, 0x3e, 0x54, 0x7e, 0xff, 0xf5, 0xe0, 0x8e, 0x24, 0x1c, 0x78, 0x5e, 0x4c, 0x38,
+	0xb7, 0x45, 0x4c, 0x43, 0xdf, 0x42, 0xeb, 0xa4, 0xc7, 0x32, 0x07, 0xb9, 0xb0, 0x49, 0x02, 0x12,
+	0xfb, 0x24, 0x74, 0x57, 0xeb, 0xf9, 0xe4, 0x13, 0x57, 0xaa, 0x6d, 0x90, 0x76, 0x9c, 0x8d, 0x5c,
+	0xcf, 0x47, 0xae, 0x8f, 0xa5, 0x60, 0x78, 0x23, 0xe9, 0xf8, 0xfb, 0x77, 0x2d, 0x60, 0xed, 0x17,
+	0x75, 0x72, 0x7b, 0x73, 0x51, 0xe7, 0x47, 0x00, 0xf7, 0xa6, 0x24, 0x88, 0x58, 0x8c, 0xe3, 0x95,
+	0x19, 0x8a, 0x78, 0x85, 0xfa, 0x70, 0x07, 0x67, 0x70, 0x0a, 0x68, 0x83, 0x7f, 0xc4, 0xce, 0x85,
+	0xa8, 0x05, 0x77, 0xa3, 0x98, 0x45, 0x8c, 0xe3, 0x85, 0x43, 0x3d, 0xa5, 0xdc, 0x06, 0xdd, 0xaa,
+	0x05, 0xf3, 0xa3, 0x89, 0x87, 0xbe, 0x84, 0x35, 0x2e, 0xb0, 0x58, 0x26, 0x56, 0x80, 0xee, 0x5e,
+	0xff, 0x33, 0xfd, 0x92, 0xf7, 0x48, 0x4f, 0x68, 0xec, 0x54, 0x6a, 0xc9, 0x94, 0xce, 0x6f, 0x00,
+	0xde, 0x32, 0xb7, 0x5b, 0xf8, 0x5f, 0x9c, 0x7d, 0xb8, 0x23, 0x67, 0xa2, 0x94, 0xaf, 0xca, 0x91,
+	0x42, 0x34, 0x82, 0x90, 0x3c, 0x8b, 0x68, 0x4c, 0xb8, 0x83, 0x45, 0x8a, 0xbf, 0xdb, 0x57, 0xcf,
+	0xd9, 0x3e, 0xcd, 0xdf, 0xb4, 0xcc, 0xf7, 0x97, 0x89, 0xef, 0x75, 0x99, 0x37, 0x10, 0x9d, 0x6f,
+	0x2b, 0x70, 0xc7, 0x26, 0xf4, 0xf9, 0x32, 0x26, 0x68, 0x0f, 0x96, 0xa9, 0x97, 0x32, 0x57, 0xad,
+	0x32, 0xf5, 0x36, 0x1b, 0x29, 0xff, 0xdb, 0x46, 0x56, 0xb0, 0x86, 0x03, 0xb6, 0x0c, 0x45, 0xba,
+	0x5a, 0xd7, 0xb2, 0xf9, 0xf2, 0x42, 0x34, 0x80, 0x75, 0x4e, 0xe8, 0x73, 0xe2, 0x25, 0x76, 0x54,
+	0xff, 0x83, 0x1d, 0x37, 0xb2, 0xb4, 0x81, 0x40, 0x5f, 0x15, 0xdb, 0xf0, 0x41, 0xba, 0x0d, 0x77,
+	0x2f, 0xdd, 0x06, 0xe9, 0xd9, 0xd9, 0x85, 0x40, 0x5f, 0xc0, 0x7a, 0x4c, 0x5c, 0x1a, 0x51, 0x12,
+	0x0a, 0xa5, 0x76, 0x85, 0x67, 0x6b, 0x69, 0x8f, 0x42, 0xb8, 0x5e, 0x2f, 0xd4, 0x84, 0x8d, 0xa9,
+	0xf9, 0xe8, 0xc8, 0xb1, 0xa7, 0x83, 0xe9, 0xcc, 0x76, 0x66, 0x87, 0xf6, 0x91, 0x39, 0x9a, 0xdc,
+	0x9f, 0x98, 0xe3, 0x9b, 0x25, 0xa4, 0xc2, 0x8f, 0x37, 0x83, 0xf6, 0xe0, 0x70, 0x34, 0x9d, 0x3c,
+	0x3e, 0x34, 0xc7, 0x37, 0x01, 0xfa, 0x04, 0x2a, 0x5b, 0x89, 0xeb, 0x68, 0x59, 0xad, 0xbe, 0xf8,
+	0x49, 0x2b, 0xf5, 0x5e, 0x00, 0xf8, 0xd1, 0x19, 0x78, 0xa4, 0x41, 0xd5, 0x36, 0x27, 0x4f, 0x66,
+	0x96, 0x79, 0xf1, 0x8d, 0x0d, 0x78, 0x7b, 0x2b, 0xfe, 0xc0, 0x7c, 0x98, 0x5c, 0xd7, 0x84, 0x8d,
+	0xad, 0x80, 0x65, 0x3e, 0x34, 0x07, 0x76, 0x72, 0xdb, 0x85, 0xc1, 0xe9, 0xcc, 0x4a, 0x50, 0x2a,
+	0x19, 0xca, 0xf0, 0xc1, 0x9b, 0x13, 0x0d, 0xbc, 0x3d, 0xd1, 0xc0, 0x5f, 0x27, 0x1a, 0x78, 0x79,
+	0xaa, 0x95, 0xde, 0x9e, 0x6a, 0xa5, 0x3f, 0x4e, 0xb5, 0xd2, 0x13, 0x7d, 0x63, 0x25, 0xa2, 0x98,
+	0x3d, 0x25, 0x61, 0xf2, 0x0d, 0x74, 0x40, 0xd9, 0xc6, 0x93, 0xf1, 0xac, 0xf8, 0x09, 0x9c, 0xd7,
+	0xd2, 0xf9, 0x7e, 0xfe, 0xf7, 0x00, 0x7b, 0x56, 0x0d, 0xb5, 0x2d, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -249,6 +490,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EmergencySanctionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EmergencySanctionDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSanction(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ComplianceOfficers) > 0 {
+		for iNdEx := len(m.ComplianceOfficers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ComplianceOfficers[iNdEx])
+			copy(dAtA[i:], m.ComplianceOfficers[iNdEx])
+			i = encodeVarintSanction(dAtA, i, uint64(len(m.ComplianceOfficers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ImmediateUnsanctionMinDeposit) > 0 {
 		for iNdEx := len(m.ImmediateUnsanctionMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -320,6 +578,120 @@ func (m *TemporaryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EmergencySanction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmergencySanction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmergencySanction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSanction(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Officer) > 0 {
+		i -= len(m.Officer)
+		copy(dAtA[i:], m.Officer)
+		i = encodeVarintSanction(dAtA, i, uint64(len(m.Officer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSanction(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Seizure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Seizure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Seizure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSanction(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintSanction(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SeizedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SeizedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSanction(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSanction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSanction(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSanction(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSanction(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSanction(v)
 	base := offset
@@ -349,6 +721,14 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovSanction(uint64(l))
 		}
 	}
+	if len(m.ComplianceOfficers) > 0 {
+		for _, s := range m.ComplianceOfficers {
+			l = len(s)
+			n += 1 + l + sovSanction(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EmergencySanctionDuration)
+	n += 1 + l + sovSanction(uint64(l))
 	return n
 }
 
@@ -371,6 +751,56 @@ func (m *TemporaryEntry) Size() (n int) {
 	return n
 }
 
+func (m *EmergencySanction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSanction(uint64(l))
+	}
+	l = len(m.Officer)
+	if l > 0 {
+		n += 1 + l + sovSanction(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovSanction(uint64(l))
+	return n
+}
+
+func (m *Seizure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSanction(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSanction(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSanction(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SeizedAt)
+	n += 1 + l + sovSanction(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovSanction(uint64(m.Status))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSanction(uint64(l))
+	}
+	return n
+}
+
 func sovSanction(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -474,13 +904,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSanction(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceOfficers", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSanction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSanction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSanction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComplianceOfficers = append(m.ComplianceOfficers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencySanctionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSanction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSanction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSanction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EmergencySanctionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSanction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSanction
 			}
 			if (iNdEx + skippy) > l {
@@ -615,6 +1110,372 @@ func (m *TemporaryEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EmergencySanction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSanction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmergencySanction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmergencySanction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSanction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSanction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSanction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Officer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSanction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSanction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSanction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Officer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSanction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSanction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSanction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSanction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSanction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Seizure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSanction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Seizure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Seizure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSanction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSanction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSanction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSanction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSanction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSanction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSanction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeizedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSanction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSanction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSanction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SeizedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSanction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SeizureStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSanction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSanction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSanction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSanction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSanction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSanction(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package sanction_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,6 +34,7 @@ func TestDefaultParams(t *testing.T) {
 			exp: &sanction.Params{
 				ImmediateSanctionMinDeposit:   nil,
 				ImmediateUnsanctionMinDeposit: nil,
+				EmergencySanctionDuration:     sanction.DefaultEmergencySanctionDuration,
 			},
 		},
 		{
@@ -42,6 +44,7 @@ func TestDefaultParams(t *testing.T) {
 			exp: &sanction.Params{
 				ImmediateSanctionMinDeposit:   sdk.Coins{},
 				ImmediateUnsanctionMinDeposit: sdk.Coins{},
+				EmergencySanctionDuration:     sanction.DefaultEmergencySanctionDuration,
 			},
 		},
 		{
@@ -51,6 +54,7 @@ func TestDefaultParams(t *testing.T) {
 			exp: &sanction.Params{
 				ImmediateSanctionMinDeposit:   cz("100000scoin"),
 				ImmediateUnsanctionMinDeposit: cz("1000000ucoin"),
+				EmergencySanctionDuration:     sanction.DefaultEmergencySanctionDuration,
 			},
 		},
 	}
@@ -83,6 +87,9 @@ func TestDefaultParams(t *testing.T) {
 }
 
 func TestParams_ValidateBasic(t *testing.T) {
+	officer1 := sdk.AccAddress("1_compliance_officer").String()
+	officer2 := sdk.AccAddress("2_compliance_officer").String()
+
 	tests := []struct {
 		name   string
 		params *sanction.Params
@@ -128,6 +135,35 @@ func TestParams_ValidateBasic(t *testing.T) {
 			},
 			exp: []string{"invalid immediate unsanction min deposit", "duplicate denomination twocoin", "invalid coins"},
 		},
+		{
+			name: "with compliance officers",
+			params: &sanction.Params{
+				ComplianceOfficers:        []string{officer1, officer2},
+				EmergencySanctionDuration: 24 * time.Hour,
+			},
+			exp: nil,
+		},
+		{
+			name: "bad compliance officer",
+			params: &sanction.Params{
+				ComplianceOfficers: []string{officer1, "notgonnawork"},
+			},
+			exp: []string{"compliance officers[1], \"notgonnawork\"", "invalid address"},
+		},
+		{
+			name: "duplicate compliance officer",
+			params: &sanction.Params{
+				ComplianceOfficers: []string{officer1, officer2, officer1},
+			},
+			exp: []string{"compliance officers[2]", "duplicate address", "invalid address"},
+		},
+		{
+			name: "negative emergency sanction duration",
+			params: &sanction.Params{
+				EmergencySanctionDuration: -1 * time.Second,
+			},
+			exp: []string{"invalid emergency sanction duration", "-1s cannot be negative", "invalid request"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -5,12 +5,14 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
+	"github.com/provenance-io/provenance/x/sanction"
 	"github.com/provenance-io/provenance/x/sanction/keeper"
 )
 
-func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
 		case bytes.HasPrefix(kvA.Key, keeper.ParamsPrefix):
@@ -25,6 +27,27 @@ func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
 		case bytes.HasPrefix(kvA.Key, keeper.ProposalIndexPrefix):
 			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
 
+		case bytes.HasPrefix(kvA.Key, keeper.EmergencySanctionPrefix):
+			var esA, esB sanction.EmergencySanction
+			cdc.MustUnmarshal(kvA.Value, &esA)
+			cdc.MustUnmarshal(kvB.Value, &esB)
+			return fmt.Sprintf("%v\n%v", esA, esB)
+
+		case bytes.HasPrefix(kvA.Key, keeper.EmergencyExpirationPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, keeper.SeizurePrefix):
+			var sA, sB sanction.Seizure
+			cdc.MustUnmarshal(kvA.Value, &sA)
+			cdc.MustUnmarshal(kvB.Value, &sB)
+			return fmt.Sprintf("%v\n%v", sA, sB)
+
+		case bytes.HasPrefix(kvA.Key, keeper.SeizureAddrIndexPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key, keeper.NextSeizureIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid sanction key %X", kvA.Key))
 		}
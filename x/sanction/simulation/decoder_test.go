@@ -1,7 +1,9 @@
 package simulation_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/sanction"
 	"github.com/provenance-io/provenance/x/sanction/keeper"
 	"github.com/provenance-io/provenance/x/sanction/simulation"
 )
@@ -17,6 +20,30 @@ func TestDecodeStore(t *testing.T) {
 	cdc := simapp.MakeTestEncodingConfig(t).Marshaler
 	dec := simulation.NewDecodeStore(cdc)
 
+	expiresAt := time.Date(2024, 3, 14, 15, 9, 26, 0, time.UTC)
+	esA := sanction.EmergencySanction{
+		Address:   sdk.AccAddress("addra").String(),
+		Officer:   sdk.AccAddress("officer").String(),
+		ExpiresAt: expiresAt,
+	}
+	esB := sanction.EmergencySanction{
+		Address:   sdk.AccAddress("addrb").String(),
+		Officer:   sdk.AccAddress("officer").String(),
+		ExpiresAt: expiresAt.Add(time.Hour),
+	}
+	seizureA := sanction.Seizure{
+		Id:       1,
+		Address:  sdk.AccAddress("addra").String(),
+		Amount:   sdk.NewCoins(sdk.NewInt64Coin("acoin", 5)),
+		SeizedAt: expiresAt,
+	}
+	seizureB := sanction.Seizure{
+		Id:       2,
+		Address:  sdk.AccAddress("addrb").String(),
+		Amount:   sdk.NewCoins(sdk.NewInt64Coin("bcoin", 8)),
+		SeizedAt: expiresAt,
+	}
+
 	tests := []struct {
 		name     string
 		kvA      kv.Pair
@@ -48,6 +75,36 @@ func TestDecodeStore(t *testing.T) {
 			kvB:  kv.Pair{Key: keeper.CreateProposalTempIndexKey(1, sdk.AccAddress("addrb")), Value: []byte{55}},
 			exp:  "[54]\n[55]",
 		},
+		{
+			name: "emergency sanction",
+			kvA:  kv.Pair{Key: keeper.CreateEmergencySanctionKey(sdk.AccAddress("addra")), Value: cdc.MustMarshal(&esA)},
+			kvB:  kv.Pair{Key: keeper.CreateEmergencySanctionKey(sdk.AccAddress("addrb")), Value: cdc.MustMarshal(&esB)},
+			exp:  fmt.Sprintf("%v\n%v", esA, esB),
+		},
+		{
+			name: "emergency expiration",
+			kvA:  kv.Pair{Key: keeper.CreateEmergencyExpirationKey(expiresAt, sdk.AccAddress("addra")), Value: []byte{}},
+			kvB:  kv.Pair{Key: keeper.CreateEmergencyExpirationKey(expiresAt, sdk.AccAddress("addrb")), Value: []byte{}},
+			exp:  "[]\n[]",
+		},
+		{
+			name: "seizure",
+			kvA:  kv.Pair{Key: keeper.CreateSeizureKey(1), Value: cdc.MustMarshal(&seizureA)},
+			kvB:  kv.Pair{Key: keeper.CreateSeizureKey(2), Value: cdc.MustMarshal(&seizureB)},
+			exp:  fmt.Sprintf("%v\n%v", seizureA, seizureB),
+		},
+		{
+			name: "seizure address index",
+			kvA:  kv.Pair{Key: keeper.CreateSeizureAddrIndexKey(sdk.AccAddress("addra"), 1), Value: []byte{}},
+			kvB:  kv.Pair{Key: keeper.CreateSeizureAddrIndexKey(sdk.AccAddress("addrb"), 2), Value: []byte{}},
+			exp:  "[]\n[]",
+		},
+		{
+			name: "next seizure id",
+			kvA:  kv.Pair{Key: keeper.NextSeizureIDKey, Value: sdk.Uint64ToBigEndian(3)},
+			kvB:  kv.Pair{Key: keeper.NextSeizureIDKey, Value: sdk.Uint64ToBigEndian(4)},
+			exp:  "3\n4",
+		},
 		{
 			name:     "unknown",
 			kvA:      kv.Pair{Key: []byte{0x9a}, Value: []byte("valuea")},
@@ -7,7 +7,11 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -308,6 +312,312 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgEmergencySanction represents a message for a compliance officer to temporarily sanction addresses.
+type MsgEmergencySanction struct {
+	// addresses are the addresses to sanction.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// signer is the address of the compliance officer issuing the emergency sanction.
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgEmergencySanction) Reset()         { *m = MsgEmergencySanction{} }
+func (m *MsgEmergencySanction) String() string { return proto.CompactTextString(m) }
+func (*MsgEmergencySanction) ProtoMessage()    {}
+func (*MsgEmergencySanction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db49afb1d08944d, []int{6}
+}
+func (m *MsgEmergencySanction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEmergencySanction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEmergencySanction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEmergencySanction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmergencySanction.Merge(m, src)
+}
+func (m *MsgEmergencySanction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEmergencySanction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmergencySanction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEmergencySanction proto.InternalMessageInfo
+
+func (m *MsgEmergencySanction) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *MsgEmergencySanction) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgEmergencySanctionResponse defines the Msg/EmergencySanction response type.
+type MsgEmergencySanctionResponse struct {
+}
+
+func (m *MsgEmergencySanctionResponse) Reset()         { *m = MsgEmergencySanctionResponse{} }
+func (m *MsgEmergencySanctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEmergencySanctionResponse) ProtoMessage()    {}
+func (*MsgEmergencySanctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db49afb1d08944d, []int{7}
+}
+func (m *MsgEmergencySanctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEmergencySanctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEmergencySanctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEmergencySanctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmergencySanctionResponse.Merge(m, src)
+}
+func (m *MsgEmergencySanctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEmergencySanctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmergencySanctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEmergencySanctionResponse proto.InternalMessageInfo
+
+// MsgSeizeSanctionedFunds represents a message for the governance operation of seizing funds from a sanctioned address.
+type MsgSeizeSanctionedFunds struct {
+	// address is the sanctioned address to seize funds from.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the funds to move from the address into the sanction module's escrow account.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// authority is the address of the account with the authority to seize funds (most likely the governance module
+	// account).
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgSeizeSanctionedFunds) Reset()         { *m = MsgSeizeSanctionedFunds{} }
+func (m *MsgSeizeSanctionedFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgSeizeSanctionedFunds) ProtoMessage()    {}
+func (*MsgSeizeSanctionedFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db49afb1d08944d, []int{8}
+}
+func (m *MsgSeizeSanctionedFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSeizeSanctionedFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSeizeSanctionedFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSeizeSanctionedFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSeizeSanctionedFunds.Merge(m, src)
+}
+func (m *MsgSeizeSanctionedFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSeizeSanctionedFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSeizeSanctionedFunds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSeizeSanctionedFunds proto.InternalMessageInfo
+
+func (m *MsgSeizeSanctionedFunds) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgSeizeSanctionedFunds) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgSeizeSanctionedFunds) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgSeizeSanctionedFundsResponse defines the Msg/SeizeSanctionedFunds response type.
+type MsgSeizeSanctionedFundsResponse struct {
+	// seizure_id is the id of the seizure record that was created.
+	SeizureId uint64 `protobuf:"varint,1,opt,name=seizure_id,json=seizureId,proto3" json:"seizure_id,omitempty"`
+}
+
+func (m *MsgSeizeSanctionedFundsResponse) Reset()         { *m = MsgSeizeSanctionedFundsResponse{} }
+func (m *MsgSeizeSanctionedFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSeizeSanctionedFundsResponse) ProtoMessage()    {}
+func (*MsgSeizeSanctionedFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db49afb1d08944d, []int{9}
+}
+func (m *MsgSeizeSanctionedFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSeizeSanctionedFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSeizeSanctionedFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSeizeSanctionedFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSeizeSanctionedFundsResponse.Merge(m, src)
+}
+func (m *MsgSeizeSanctionedFundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSeizeSanctionedFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSeizeSanctionedFundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSeizeSanctionedFundsResponse proto.InternalMessageInfo
+
+func (m *MsgSeizeSanctionedFundsResponse) GetSeizureId() uint64 {
+	if m != nil {
+		return m.SeizureId
+	}
+	return 0
+}
+
+// MsgReleaseSeizedFunds represents a message for the governance operation of sending seized funds out of escrow.
+type MsgReleaseSeizedFunds struct {
+	// seizure_id is the id of the seizure record whose funds are to be sent out of escrow.
+	SeizureId uint64 `protobuf:"varint,1,opt,name=seizure_id,json=seizureId,proto3" json:"seizure_id,omitempty"`
+	// destination is the address to send the funds to.
+	// If empty, the funds are returned to the address they were seized from.
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// authority is the address of the account with the authority to release seized funds (most likely the governance
+	// module account).
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgReleaseSeizedFunds) Reset()         { *m = MsgReleaseSeizedFunds{} }
+func (m *MsgReleaseSeizedFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseSeizedFunds) ProtoMessage()    {}
+func (*MsgReleaseSeizedFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db49afb1d08944d, []int{10}
+}
+func (m *MsgReleaseSeizedFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseSeizedFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseSeizedFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseSeizedFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseSeizedFunds.Merge(m, src)
+}
+func (m *MsgReleaseSeizedFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseSeizedFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseSeizedFunds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseSeizedFunds proto.InternalMessageInfo
+
+func (m *MsgReleaseSeizedFunds) GetSeizureId() uint64 {
+	if m != nil {
+		return m.SeizureId
+	}
+	return 0
+}
+
+func (m *MsgReleaseSeizedFunds) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *MsgReleaseSeizedFunds) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgReleaseSeizedFundsResponse defines the Msg/ReleaseSeizedFunds response type.
+type MsgReleaseSeizedFundsResponse struct {
+}
+
+func (m *MsgReleaseSeizedFundsResponse) Reset()         { *m = MsgReleaseSeizedFundsResponse{} }
+func (m *MsgReleaseSeizedFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseSeizedFundsResponse) ProtoMessage()    {}
+func (*MsgReleaseSeizedFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db49afb1d08944d, []int{11}
+}
+func (m *MsgReleaseSeizedFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseSeizedFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseSeizedFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseSeizedFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseSeizedFundsResponse.Merge(m, src)
+}
+func (m *MsgReleaseSeizedFundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseSeizedFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseSeizedFundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseSeizedFundsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSanction)(nil), "cosmos.sanction.v1beta1.MsgSanction")
 	proto.RegisterType((*MsgSanctionResponse)(nil), "cosmos.sanction.v1beta1.MsgSanctionResponse")
@@ -315,37 +625,63 @@ func init() {
 	proto.RegisterType((*MsgUnsanctionResponse)(nil), "cosmos.sanction.v1beta1.MsgUnsanctionResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.sanction.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.sanction.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgEmergencySanction)(nil), "cosmos.sanction.v1beta1.MsgEmergencySanction")
+	proto.RegisterType((*MsgEmergencySanctionResponse)(nil), "cosmos.sanction.v1beta1.MsgEmergencySanctionResponse")
+	proto.RegisterType((*MsgSeizeSanctionedFunds)(nil), "cosmos.sanction.v1beta1.MsgSeizeSanctionedFunds")
+	proto.RegisterType((*MsgSeizeSanctionedFundsResponse)(nil), "cosmos.sanction.v1beta1.MsgSeizeSanctionedFundsResponse")
+	proto.RegisterType((*MsgReleaseSeizedFunds)(nil), "cosmos.sanction.v1beta1.MsgReleaseSeizedFunds")
+	proto.RegisterType((*MsgReleaseSeizedFundsResponse)(nil), "cosmos.sanction.v1beta1.MsgReleaseSeizedFundsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/sanction/v1beta1/tx.proto", fileDescriptor_7db49afb1d08944d) }
 
 var fileDescriptor_7db49afb1d08944d = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x4e, 0xcc, 0x4b, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x82, 0x4a, 0xe8, 0xe7, 0x16, 0xa7, 0xeb, 0x97, 0x19,
-	0x82, 0x28, 0x88, 0x0e, 0x29, 0x35, 0x5c, 0x66, 0xc2, 0x8d, 0x80, 0xa8, 0x93, 0x84, 0xa8, 0x8b,
-	0x07, 0xf3, 0xf4, 0xa1, 0xd6, 0x80, 0x39, 0x4a, 0xbd, 0x8c, 0x5c, 0xdc, 0xbe, 0xc5, 0xe9, 0xc1,
-	0x50, 0x0d, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xa9, 0xc5, 0x12,
-	0x8c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0x35, 0x39, 0x42, 0xe4,
-	0x82, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0xc1, 0xfa, 0x4a, 0x4b, 0x32, 0xf2, 0x8b,
-	0x32, 0x4b, 0x2a, 0x25, 0x98, 0x14, 0x18, 0x09, 0xe8, 0x83, 0x29, 0xb5, 0xe2, 0x6b, 0x7a, 0xbe,
-	0x41, 0x0b, 0xc1, 0x57, 0x12, 0xe5, 0x12, 0x46, 0x72, 0x4e, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e,
-	0x71, 0xaa, 0x52, 0x3f, 0x23, 0x17, 0xaf, 0x6f, 0x71, 0x7a, 0x68, 0x5e, 0xf1, 0x60, 0x71, 0xa8,
-	0x38, 0x97, 0x28, 0x8a, 0x83, 0xe0, 0x4e, 0x9d, 0xc4, 0xc8, 0xc5, 0x0f, 0x92, 0x29, 0x48, 0x49,
-	0x2c, 0x49, 0x0d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x16, 0x32, 0xe7, 0x62, 0x2b, 0x00, 0xb3, 0x24,
-	0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf5, 0x70, 0xc4, 0xb5, 0x1e, 0x44, 0x43, 0x10, 0x54,
-	0x39, 0xd5, 0x5c, 0x2b, 0xc9, 0x25, 0x8e, 0xe6, 0x26, 0x98, 0x7b, 0x8d, 0xf6, 0x31, 0x71, 0x31,
-	0xfb, 0x16, 0xa7, 0x0b, 0xc5, 0x71, 0x71, 0xc0, 0x53, 0x81, 0x0a, 0x4e, 0xf7, 0x21, 0x45, 0x8e,
-	0x94, 0x0e, 0x31, 0xaa, 0x60, 0xf6, 0x08, 0xa5, 0x70, 0x71, 0x21, 0x45, 0x9f, 0x1a, 0x3e, 0xbd,
-	0x08, 0x75, 0x52, 0x7a, 0xc4, 0xa9, 0x83, 0xdb, 0x92, 0xc5, 0xc5, 0x83, 0x12, 0xf2, 0x1a, 0x78,
-	0xf5, 0x23, 0xa9, 0x94, 0x32, 0x20, 0x56, 0x25, 0xcc, 0x2e, 0x29, 0xd6, 0x86, 0xe7, 0x1b, 0xb4,
-	0x18, 0x9d, 0x3c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
-	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x2f, 0x3d,
-	0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0xa0, 0x28, 0xbf, 0x2c, 0x35, 0x2f,
-	0x31, 0x2f, 0x39, 0x55, 0x37, 0x33, 0x1f, 0x89, 0xa7, 0x5f, 0x01, 0xcf, 0xad, 0x49, 0x6c, 0xe0,
-	0x3c, 0x69, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x51, 0x20, 0x2f, 0x2c, 0x04, 0x00, 0x00,
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x3b, 0x54, 0xaa, 0xbc, 0xa2, 0x86, 0xb5, 0x84, 0xb2, 0x91, 0x6d, 0xd3, 0x18, 0xd2,
+	0x10, 0xbb, 0x0b, 0x35, 0xa2, 0xe1, 0xa4, 0x18, 0x89, 0x1e, 0x9a, 0x98, 0x12, 0x2f, 0x1e, 0x24,
+	0xd3, 0xee, 0x64, 0x19, 0x65, 0x67, 0x9a, 0x9d, 0x2d, 0xa1, 0xc4, 0x83, 0xe1, 0x4e, 0x24, 0x1e,
+	0xfd, 0x0b, 0x8c, 0x27, 0x0e, 0x9e, 0x8c, 0x7f, 0x00, 0x47, 0xe2, 0xc9, 0x93, 0x1a, 0x38, 0xf0,
+	0x6f, 0x98, 0xdd, 0x9d, 0x1d, 0x16, 0xe9, 0x0f, 0x50, 0x0e, 0x5e, 0xd8, 0x9d, 0x7d, 0x9f, 0xf7,
+	0xde, 0x77, 0xe6, 0xcd, 0x7b, 0x14, 0x8a, 0x4d, 0x2e, 0x5c, 0x2e, 0x2c, 0x81, 0x59, 0xd3, 0xa7,
+	0x9c, 0x59, 0xeb, 0x73, 0x0d, 0xe2, 0xe3, 0x39, 0xcb, 0xdf, 0x30, 0x5b, 0x1e, 0xf7, 0xb9, 0x36,
+	0x11, 0x11, 0x66, 0x4c, 0x98, 0x92, 0xd0, 0xc7, 0xb0, 0x4b, 0x19, 0xb7, 0xc2, 0xbf, 0x11, 0xab,
+	0x1b, 0x32, 0x5a, 0x03, 0x0b, 0xa2, 0x22, 0x35, 0x39, 0x65, 0xd2, 0x2e, 0x63, 0x59, 0xae, 0x70,
+	0xac, 0xf5, 0xb9, 0xe0, 0x21, 0x0d, 0xd3, 0xbd, 0x64, 0xa8, 0xac, 0x11, 0x37, 0x19, 0x71, 0x2b,
+	0xe1, 0xca, 0x92, 0xca, 0x22, 0x53, 0xce, 0xe1, 0x0e, 0x8f, 0xbe, 0x07, 0x6f, 0xd1, 0xd7, 0xd2,
+	0x36, 0x82, 0x6c, 0x4d, 0x38, 0xcb, 0x32, 0x8c, 0x36, 0x0f, 0x23, 0xd8, 0xb6, 0x3d, 0x22, 0x04,
+	0x11, 0x79, 0x54, 0x4c, 0x97, 0x47, 0x16, 0xf3, 0xdf, 0x3e, 0x57, 0x72, 0x32, 0xd4, 0xc3, 0xc8,
+	0xb6, 0xec, 0x7b, 0x94, 0x39, 0xf5, 0x63, 0x34, 0xf4, 0x6b, 0xfb, 0xab, 0xdc, 0xa3, 0x7e, 0x27,
+	0x3f, 0x54, 0x44, 0x03, 0xfc, 0x62, 0x74, 0xe1, 0xda, 0xd6, 0xd1, 0xee, 0xcc, 0xf1, 0xba, 0x34,
+	0x0e, 0x37, 0x12, 0x72, 0xea, 0x44, 0xb4, 0x38, 0x13, 0xa4, 0xf4, 0x0e, 0xc1, 0xd5, 0x9a, 0x70,
+	0x9e, 0x33, 0xf1, 0xbf, 0x08, 0x9d, 0x80, 0xf1, 0x13, 0x82, 0x94, 0xd4, 0xf7, 0x08, 0xae, 0x07,
+	0x96, 0x96, 0x8d, 0x7d, 0xf2, 0x0c, 0x7b, 0xd8, 0x15, 0xda, 0x3d, 0xc8, 0xb4, 0xc2, 0xb7, 0x3c,
+	0x2a, 0xa2, 0x72, 0xb6, 0x5a, 0x30, 0x7b, 0x5c, 0x1a, 0x33, 0x72, 0xa8, 0x4b, 0xfc, 0xc2, 0xd4,
+	0x4e, 0xc2, 0xc4, 0x1f, 0x9a, 0x94, 0xde, 0x6d, 0x04, 0xb9, 0x9a, 0x70, 0x1e, 0xbb, 0xc4, 0x73,
+	0x08, 0x6b, 0x76, 0xfe, 0xf9, 0x2a, 0xcc, 0x42, 0x46, 0x50, 0x87, 0x11, 0x6f, 0xa0, 0x60, 0xc9,
+	0x2d, 0x64, 0x03, 0xb5, 0x72, 0x51, 0x32, 0xe0, 0x66, 0x37, 0x39, 0x4a, 0xef, 0xce, 0x50, 0xb8,
+	0x97, 0x65, 0x42, 0x37, 0x49, 0x6c, 0x24, 0xf6, 0x52, 0x9b, 0xd9, 0x42, 0xab, 0xc2, 0x65, 0xa9,
+	0x23, 0x8f, 0x06, 0xe4, 0x8e, 0x41, 0xad, 0x03, 0x19, 0xec, 0xf2, 0x36, 0xf3, 0xf3, 0x43, 0xc5,
+	0x74, 0x39, 0x5b, 0x9d, 0x8c, 0x6b, 0x13, 0x34, 0xa9, 0xaa, 0xcb, 0x23, 0x4e, 0xd9, 0xe2, 0xd2,
+	0xde, 0x8f, 0x42, 0xea, 0xd3, 0xcf, 0x42, 0xd9, 0xa1, 0xfe, 0x6a, 0xbb, 0x61, 0x36, 0xb9, 0x2b,
+	0x7b, 0x4c, 0x3e, 0x2a, 0xc2, 0x7e, 0x6d, 0xf9, 0x9d, 0x16, 0x11, 0xa1, 0x83, 0xf8, 0x70, 0xb4,
+	0x3b, 0x33, 0xba, 0x46, 0x1c, 0xdc, 0xec, 0xac, 0x04, 0x6d, 0x2e, 0x3e, 0x1e, 0xed, 0xce, 0xa0,
+	0xba, 0x4c, 0x78, 0xb2, 0xba, 0xe9, 0xbf, 0xaf, 0xee, 0x03, 0x28, 0xf4, 0x38, 0x91, 0xf8, 0xd4,
+	0xb4, 0x29, 0x00, 0x41, 0xe8, 0x66, 0xdb, 0x23, 0x2b, 0xd4, 0x0e, 0x0f, 0xe7, 0x52, 0x7d, 0x44,
+	0x7e, 0x79, 0x6a, 0x97, 0xbe, 0xa0, 0xf0, 0x3a, 0xd7, 0xc9, 0x1a, 0xc1, 0x82, 0x84, 0x91, 0xe4,
+	0x91, 0xf6, 0x77, 0xd4, 0x16, 0x20, 0x6b, 0x13, 0xe1, 0x53, 0x86, 0x83, 0xac, 0x03, 0x2b, 0x9e,
+	0x84, 0x2f, 0x6c, 0xfb, 0x05, 0x98, 0xea, 0xaa, 0x3d, 0xde, 0x7c, 0xf5, 0xeb, 0x30, 0xa4, 0x6b,
+	0xc2, 0xd1, 0x5e, 0xc2, 0x15, 0x75, 0xbb, 0x6f, 0xf5, 0x6c, 0xc1, 0xc4, 0xfc, 0xd1, 0x6f, 0x9f,
+	0x85, 0x52, 0x87, 0x6c, 0x03, 0x24, 0x26, 0xd4, 0x74, 0x3f, 0xdf, 0x63, 0x4e, 0x37, 0xcf, 0xc6,
+	0xa9, 0x2c, 0xaf, 0x60, 0xf4, 0xc4, 0x70, 0x29, 0xf7, 0xf5, 0x4f, 0x90, 0xfa, 0xec, 0x59, 0x49,
+	0x95, 0xab, 0x03, 0x63, 0xa7, 0x07, 0x43, 0xa5, 0x5f, 0x98, 0x53, 0xb8, 0x7e, 0xf7, 0x5c, 0xb8,
+	0x4a, 0xbd, 0x85, 0x20, 0xd7, 0xb5, 0xc9, 0xfb, 0xee, 0xa2, 0x9b, 0x87, 0x7e, 0xff, 0xbc, 0x1e,
+	0x4a, 0xc4, 0x1b, 0xd0, 0xba, 0xf4, 0x44, 0xdf, 0x8a, 0x9d, 0xe6, 0xf5, 0xf9, 0xf3, 0xf1, 0x71,
+	0x76, 0x7d, 0xf8, 0x6d, 0x30, 0x2e, 0x16, 0x9f, 0xec, 0x1d, 0x18, 0x68, 0xff, 0xc0, 0x40, 0xbf,
+	0x0e, 0x0c, 0xb4, 0x73, 0x68, 0xa4, 0xf6, 0x0f, 0x8d, 0xd4, 0xf7, 0x43, 0x23, 0xf5, 0xc2, 0x4c,
+	0x0c, 0xa2, 0x96, 0xc7, 0xd7, 0x09, 0xc3, 0xac, 0x49, 0x2a, 0x94, 0x27, 0x56, 0xd6, 0x86, 0xfa,
+	0x91, 0xd0, 0xc8, 0x84, 0xff, 0xf4, 0xef, 0xfc, 0x1e, 0x00, 0x3c, 0x17, 0x76, 0x6c, 0xd6, 0x08,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -366,6 +702,12 @@ type MsgClient interface {
 	Unsanction(ctx context.Context, in *MsgUnsanction, opts ...grpc.CallOption) (*MsgUnsanctionResponse, error)
 	// UpdateParams is a governance operation for updating the sanction module params.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// EmergencySanction is a compliance officer operation for immediately and temporarily sanctioning addresses.
+	EmergencySanction(ctx context.Context, in *MsgEmergencySanction, opts ...grpc.CallOption) (*MsgEmergencySanctionResponse, error)
+	// SeizeSanctionedFunds is a governance operation for moving funds from a sanctioned address into escrow.
+	SeizeSanctionedFunds(ctx context.Context, in *MsgSeizeSanctionedFunds, opts ...grpc.CallOption) (*MsgSeizeSanctionedFundsResponse, error)
+	// ReleaseSeizedFunds is a governance operation for sending seized funds out of escrow.
+	ReleaseSeizedFunds(ctx context.Context, in *MsgReleaseSeizedFunds, opts ...grpc.CallOption) (*MsgReleaseSeizedFundsResponse, error)
 }
 
 type msgClient struct {
@@ -403,6 +745,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) EmergencySanction(ctx context.Context, in *MsgEmergencySanction, opts ...grpc.CallOption) (*MsgEmergencySanctionResponse, error) {
+	out := new(MsgEmergencySanctionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.sanction.v1beta1.Msg/EmergencySanction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SeizeSanctionedFunds(ctx context.Context, in *MsgSeizeSanctionedFunds, opts ...grpc.CallOption) (*MsgSeizeSanctionedFundsResponse, error) {
+	out := new(MsgSeizeSanctionedFundsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.sanction.v1beta1.Msg/SeizeSanctionedFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseSeizedFunds(ctx context.Context, in *MsgReleaseSeizedFunds, opts ...grpc.CallOption) (*MsgReleaseSeizedFundsResponse, error) {
+	out := new(MsgReleaseSeizedFundsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.sanction.v1beta1.Msg/ReleaseSeizedFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Sanction is a governance operation for sanctioning addresses.
@@ -411,6 +780,12 @@ type MsgServer interface {
 	Unsanction(context.Context, *MsgUnsanction) (*MsgUnsanctionResponse, error)
 	// UpdateParams is a governance operation for updating the sanction module params.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// EmergencySanction is a compliance officer operation for immediately and temporarily sanctioning addresses.
+	EmergencySanction(context.Context, *MsgEmergencySanction) (*MsgEmergencySanctionResponse, error)
+	// SeizeSanctionedFunds is a governance operation for moving funds from a sanctioned address into escrow.
+	SeizeSanctionedFunds(context.Context, *MsgSeizeSanctionedFunds) (*MsgSeizeSanctionedFundsResponse, error)
+	// ReleaseSeizedFunds is a governance operation for sending seized funds out of escrow.
+	ReleaseSeizedFunds(context.Context, *MsgReleaseSeizedFunds) (*MsgReleaseSeizedFundsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -426,6 +801,15 @@ func (*UnimplementedMsgServer) Unsanction(ctx context.Context, req *MsgUnsanctio
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) EmergencySanction(ctx context.Context, req *MsgEmergencySanction) (*MsgEmergencySanctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencySanction not implemented")
+}
+func (*UnimplementedMsgServer) SeizeSanctionedFunds(ctx context.Context, req *MsgSeizeSanctionedFunds) (*MsgSeizeSanctionedFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeizeSanctionedFunds not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseSeizedFunds(ctx context.Context, req *MsgReleaseSeizedFunds) (*MsgReleaseSeizedFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSeizedFunds not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -485,6 +869,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_EmergencySanction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEmergencySanction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EmergencySanction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.sanction.v1beta1.Msg/EmergencySanction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EmergencySanction(ctx, req.(*MsgEmergencySanction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SeizeSanctionedFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSeizeSanctionedFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SeizeSanctionedFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.sanction.v1beta1.Msg/SeizeSanctionedFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SeizeSanctionedFunds(ctx, req.(*MsgSeizeSanctionedFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseSeizedFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseSeizedFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseSeizedFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.sanction.v1beta1.Msg/ReleaseSeizedFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseSeizedFunds(ctx, req.(*MsgReleaseSeizedFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.sanction.v1beta1.Msg",
@@ -502,6 +940,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "EmergencySanction",
+			Handler:    _Msg_EmergencySanction_Handler,
+		},
+		{
+			MethodName: "SeizeSanctionedFunds",
+			Handler:    _Msg_SeizeSanctionedFunds_Handler,
+		},
+		{
+			MethodName: "ReleaseSeizedFunds",
+			Handler:    _Msg_ReleaseSeizedFunds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/sanction/v1beta1/tx.proto",
@@ -696,16 +1146,222 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgEmergencySanction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *MsgEmergencySanction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEmergencySanction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEmergencySanctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEmergencySanctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEmergencySanctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSeizeSanctionedFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSeizeSanctionedFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSeizeSanctionedFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSeizeSanctionedFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSeizeSanctionedFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSeizeSanctionedFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SeizureId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SeizureId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseSeizedFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseSeizedFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseSeizedFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SeizureId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SeizureId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseSeizedFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseSeizedFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseSeizedFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *MsgSanction) Size() (n int) {
 	if m == nil {
@@ -719,83 +1375,671 @@ func (m *MsgSanction) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSanctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnsanction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnsanctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEmergencySanction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEmergencySanctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSeizeSanctionedFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSeizeSanctionedFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SeizureId != 0 {
+		n += 1 + sovTx(uint64(m.SeizureId))
+	}
+	return n
+}
+
+func (m *MsgReleaseSeizedFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SeizureId != 0 {
+		n += 1 + sovTx(uint64(m.SeizureId))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseSeizedFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSanction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSanction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSanction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSanctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSanctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSanctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnsanction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnsanction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnsanction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnsanctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnsanctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnsanctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSanctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUnsanction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgUnsanctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSanction) Unmarshal(dAtA []byte) error {
+func (m *MsgEmergencySanction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -818,10 +2062,10 @@ func (m *MsgSanction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSanction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEmergencySanction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSanction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEmergencySanction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -858,7 +2102,7 @@ func (m *MsgSanction) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -886,7 +2130,7 @@ func (m *MsgSanction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -909,7 +2153,7 @@ func (m *MsgSanction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSanctionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEmergencySanctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -932,10 +2176,10 @@ func (m *MsgSanctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSanctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEmergencySanctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSanctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEmergencySanctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -959,7 +2203,7 @@ func (m *MsgSanctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnsanction) Unmarshal(dAtA []byte) error {
+func (m *MsgSeizeSanctionedFunds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -982,15 +2226,15 @@ func (m *MsgUnsanction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnsanction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSeizeSanctionedFunds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnsanction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSeizeSanctionedFunds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1018,9 +2262,43 @@ func (m *MsgUnsanction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -1073,7 +2351,7 @@ func (m *MsgUnsanction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnsanctionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSeizeSanctionedFundsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1096,12 +2374,31 @@ func (m *MsgUnsanctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnsanctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSeizeSanctionedFundsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnsanctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSeizeSanctionedFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeizureId", wireType)
+			}
+			m.SeizureId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeizureId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1123,7 +2420,7 @@ func (m *MsgUnsanctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseSeizedFunds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1146,17 +2443,36 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseSeizedFunds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseSeizedFunds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeizureId", wireType)
+			}
+			m.SeizureId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeizureId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1166,29 +2482,25 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -1241,7 +2553,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseSeizedFundsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1264,10 +2576,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseSeizedFundsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseSeizedFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: